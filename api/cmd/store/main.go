@@ -0,0 +1,16 @@
+package main
+
+import (
+	"os"
+
+	"github.com/and-period/furumaru/api/internal/store/cmd"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	c := &cobra.Command{Use: "store [command]"}
+	cmd.RegisterCommand(c)
+	if err := c.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
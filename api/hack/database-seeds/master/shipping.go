@@ -79,7 +79,76 @@ var DefaultShippingRevision = &entity.ShippingRevision{
 			},
 		},
 	},
-	Box100Frozen:      220,
+	Box100Frozen: 220,
+	Box120Rates: []*entity.ShippingRate{
+		{
+			Number: 1,
+			Name:   "本州・四国・九州",
+			Price:  1980,
+			PrefectureCodes: []int32{
+				2, 3, 4, 5, 6, 7, 8, 9, 10,
+				11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+				21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+				31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
+				41, 42, 43, 44, 45, 46,
+			},
+		},
+		{
+			Number: 2,
+			Name:   "北海道・沖縄",
+			Price:  2840,
+			PrefectureCodes: []int32{
+				1, 47,
+			},
+		},
+	},
+	Box120Frozen: 330,
+	Box140Rates: []*entity.ShippingRate{
+		{
+			Number: 1,
+			Name:   "本州・四国・九州",
+			Price:  2310,
+			PrefectureCodes: []int32{
+				2, 3, 4, 5, 6, 7, 8, 9, 10,
+				11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+				21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+				31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
+				41, 42, 43, 44, 45, 46,
+			},
+		},
+		{
+			Number: 2,
+			Name:   "北海道・沖縄",
+			Price:  3170,
+			PrefectureCodes: []int32{
+				1, 47,
+			},
+		},
+	},
+	Box140Frozen: 330,
+	Box160Rates: []*entity.ShippingRate{
+		{
+			Number: 1,
+			Name:   "本州・四国・九州",
+			Price:  2640,
+			PrefectureCodes: []int32{
+				2, 3, 4, 5, 6, 7, 8, 9, 10,
+				11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+				21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+				31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
+				41, 42, 43, 44, 45, 46,
+			},
+		},
+		{
+			Number: 2,
+			Name:   "北海道・沖縄",
+			Price:  3500,
+			PrefectureCodes: []int32{
+				1, 47,
+			},
+		},
+	},
+	Box160Frozen:      440,
 	HasFreeShipping:   false,
 	FreeShippingRates: 0,
 }
@@ -206,6 +206,9 @@ type internalShippingRevision struct {
 	Box60RatesJSON          datatypes.JSON `gorm:"default:null;column:box60_rates"`  // 箱サイズ60の通常便配送料一覧(JSON)
 	Box80RatesJSON          datatypes.JSON `gorm:"default:null;column:box80_rates"`  // 箱サイズ80の通常便配送料一覧(JSON)
 	Box100RatesJSON         datatypes.JSON `gorm:"default:null;column:box100_rates"` // 箱サイズ100の通常便配送料一覧(JSON)
+	Box120RatesJSON         datatypes.JSON `gorm:"default:null;column:box120_rates"` // 箱サイズ120の通常便配送料一覧(JSON)
+	Box140RatesJSON         datatypes.JSON `gorm:"default:null;column:box140_rates"` // 箱サイズ140の通常便配送料一覧(JSON)
+	Box160RatesJSON         datatypes.JSON `gorm:"default:null;column:box160_rates"` // 箱サイズ160の通常便配送料一覧(JSON)
 }
 
 func newInternalShippingRevision(revision *entity.ShippingRevision) (*internalShippingRevision, error) {
@@ -221,11 +224,26 @@ func newInternalShippingRevision(revision *entity.ShippingRevision) (*internalSh
 	if err != nil {
 		return nil, fmt.Errorf("tidb: failed to marshal box100 rates: %w", err)
 	}
+	box120Rates, err := revision.Box120Rates.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("tidb: failed to marshal box120 rates: %w", err)
+	}
+	box140Rates, err := revision.Box140Rates.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("tidb: failed to marshal box140 rates: %w", err)
+	}
+	box160Rates, err := revision.Box160Rates.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("tidb: failed to marshal box160 rates: %w", err)
+	}
 	internal := &internalShippingRevision{
 		ShippingRevision: *revision,
 		Box60RatesJSON:   box60Rates,
 		Box80RatesJSON:   box80Rates,
 		Box100RatesJSON:  box100Rates,
+		Box120RatesJSON:  box120Rates,
+		Box140RatesJSON:  box140Rates,
+		Box160RatesJSON:  box160Rates,
 	}
 	return internal, nil
 }
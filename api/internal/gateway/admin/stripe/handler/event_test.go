@@ -6,6 +6,9 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/stretchr/testify/assert"
 	lib "github.com/stripe/stripe-go/v82"
 	"go.uber.org/mock/gomock"
@@ -34,9 +37,16 @@ func TestEvent_InvalidSignature(t *testing.T) {
 	h := newHandler(m)
 
 	body := []byte(`{"id": "evt_test"}`)
+	in := &store.ReceivePaymentEventInput{
+		ProviderType:      entity.PaymentProviderTypeStripe,
+		Payload:           body,
+		SignatureVerified: false,
+		SignatureRequired: true,
+	}
 	m.receiver.EXPECT().
 		Receive(body, "invalid-sig").
 		Return(nil, assert.AnError)
+	m.store.EXPECT().ReceivePaymentEvent(gomock.Any(), in).Return(exception.ErrUnauthenticated)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPost, "/stripe/webhooks", bytes.NewReader(body))
@@ -49,31 +59,62 @@ func TestEvent_InvalidSignature(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, ctx.Writer.Status())
 }
 
-func TestEvent_UnexpectedEvent(t *testing.T) {
+func TestEvent(t *testing.T) {
 	t.Parallel()
-	ctrl := gomock.NewController(t)
-	m := newMocks(ctrl)
-	h := newHandler(m)
-
+	body := []byte(`{"id": "evt_test", "type": "payment_intent.succeeded"}`)
 	event := &lib.Event{
-		Type:    "unknown.event",
+		ID:      "evt_test",
+		Type:    "payment_intent.succeeded",
 		Created: 1700000000,
-		Data:    &lib.EventData{Raw: []byte(`{}`)},
 	}
-	body := []byte(`{"type": "unknown.event"}`)
-	m.receiver.EXPECT().
-		Receive(body, "valid-sig").
-		Return(event, nil)
+	in := &store.ReceivePaymentEventInput{
+		ProviderType:      entity.PaymentProviderTypeStripe,
+		EventType:         "payment_intent.succeeded",
+		Payload:           body,
+		SignatureVerified: true,
+		SignatureRequired: true,
+	}
+	tests := []struct {
+		name   string
+		setup  func(m *mocks)
+		expect int
+	}{
+		{
+			name: "success",
+			setup: func(m *mocks) {
+				m.receiver.EXPECT().Receive(body, "valid-sig").Return(event, nil)
+				m.store.EXPECT().ReceivePaymentEvent(gomock.Any(), in).Return(nil)
+			},
+			expect: http.StatusNoContent,
+		},
+		{
+			name: "failed to receive payment event",
+			setup: func(m *mocks) {
+				m.receiver.EXPECT().Receive(body, "valid-sig").Return(event, nil)
+				m.store.EXPECT().ReceivePaymentEvent(gomock.Any(), in).Return(exception.ErrNotFound)
+			},
+			expect: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			m := newMocks(ctrl)
+			h := newHandler(m)
+			tt.setup(m)
 
-	w := httptest.NewRecorder()
-	req := httptest.NewRequest(http.MethodPost, "/stripe/webhooks", bytes.NewReader(body))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Stripe-Signature", "valid-sig")
-	ctx, _ := testGinContext(t, w)
-	ctx.Request = req
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/stripe/webhooks", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Stripe-Signature", "valid-sig")
+			ctx, _ := testGinContext(t, w)
+			ctx.Request = req
 
-	h.Event(ctx)
-	assert.Equal(t, http.StatusNoContent, ctx.Writer.Status())
+			h.Event(ctx)
+			assert.Equal(t, tt.expect, ctx.Writer.Status())
+		})
+	}
 }
 
 // errorReader implements io.Reader that always returns an error.
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"encoding/json"
 	"net/http/httptest"
 	"sync"
 	"testing"
@@ -10,7 +9,6 @@ import (
 	mock_stripe "github.com/and-period/furumaru/api/mock/pkg/stripe"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
-	lib "github.com/stripe/stripe-go/v82"
 	"go.uber.org/mock/gomock"
 )
 
@@ -58,29 +56,3 @@ func TestRoutes(t *testing.T) {
 	rg := gin.New().Group("")
 	h.Routes(rg)
 }
-
-func newPaymentIntentEvent(t *testing.T, eventType string, pi *lib.PaymentIntent) *lib.Event {
-	t.Helper()
-	raw, err := json.Marshal(pi)
-	if err != nil {
-		t.Fatal(err)
-	}
-	return &lib.Event{
-		Type:    lib.EventType(eventType),
-		Created: 1700000000,
-		Data:    &lib.EventData{Raw: raw},
-	}
-}
-
-func newChargeEvent(t *testing.T, charge *lib.Charge) *lib.Event {
-	t.Helper()
-	raw, err := json.Marshal(charge)
-	if err != nil {
-		t.Fatal(err)
-	}
-	return &lib.Event{
-		Type:    lib.EventType(eventTypeChargeRefunded),
-		Created: 1700000000,
-		Data:    &lib.EventData{Raw: raw},
-	}
-}
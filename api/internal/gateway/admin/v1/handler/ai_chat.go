@@ -39,9 +39,9 @@ var aiChatTools = []anthropic.Tool{
 			"originPrefectureCode": map[string]any{"type": "number", "minimum": 1, "maximum": 47, "description": "原産地の都道府県コード（1=北海道, 2=青森, ..., 47=沖縄）"},
 			"originCity":           map[string]any{"type": "string", "maxLength": 32, "description": "原産地の市区町村"},
 			"scope":                map[string]any{"type": "number", "minimum": 1, "maximum": 3, "description": "公開範囲: 1=全体公開, 2=LINE限定, 3=下書き"},
-			"box60Rate":            map[string]any{"type": "number", "minimum": 0, "maximum": 600, "description": "60サイズ箱の占有率（%）"},
-			"box80Rate":            map[string]any{"type": "number", "minimum": 0, "maximum": 250, "description": "80サイズ箱の占有率（%）"},
-			"box100Rate":           map[string]any{"type": "number", "minimum": 0, "maximum": 100, "description": "100サイズ箱の占有率（%）"},
+			"box60Rate":            map[string]any{"type": "number", "minimum": 0, "maximum": 2400, "description": "60サイズ箱の占有率（%）"},
+			"box80Rate":            map[string]any{"type": "number", "minimum": 0, "maximum": 1000, "description": "80サイズ箱の占有率（%）"},
+			"box100Rate":           map[string]any{"type": "number", "minimum": 0, "maximum": 400, "description": "100サイズ箱の占有率（%）"},
 		},
 	},
 	{
@@ -58,6 +58,8 @@ var auditRouteMap = map[string]auditRouteInfo{
 	"/v1/categories/:categoryId": {resourceType: "category", idParam: "categoryId"},
 	// 注文
 	"/v1/orders/-/export":                             {resourceType: "order", idParam: ""},
+	"/v1/orders/-/trackings":                          {resourceType: "order", idParam: ""},
+	"/v1/orders/-/shipments":                          {resourceType: "order", idParam: ""},
 	"/v1/orders/:orderId/draft":                       {resourceType: "order", idParam: "orderId"},
 	"/v1/orders/:orderId/capture":                     {resourceType: "order", idParam: "orderId"},
 	"/v1/orders/:orderId/complete":                    {resourceType: "order", idParam: "orderId"},
@@ -83,6 +85,14 @@ var auditRouteMap = map[string]auditRouteInfo{
 	"/v1/lives/:liveId": {resourceType: "live", idParam: "liveId"},
 	// ライブコメント
 	"/v1/lives/:liveId/comments/:commentId": {resourceType: "live_comment", idParam: "commentId"},
+	// ライブ商品ピン留め
+	"/v1/schedules/:scheduleId/pins":        {resourceType: "live_pin", idParam: ""},
+	"/v1/schedules/:scheduleId/pins/:pinId": {resourceType: "live_pin", idParam: "pinId"},
+	// コメントモデレーション
+	"/v1/coordinators/:coordinatorId/comment-ng-words":           {resourceType: "comment_ng_word", idParam: ""},
+	"/v1/coordinators/:coordinatorId/comment-ng-words/:ngWordId": {resourceType: "comment_ng_word", idParam: "ngWordId"},
+	"/v1/schedules/:scheduleId/comment-mutes":                    {resourceType: "comment_mute", idParam: ""},
+	"/v1/schedules/:scheduleId/comment-mutes/:muteId":            {resourceType: "comment_mute", idParam: "muteId"},
 	// 配信
 	"/v1/schedules/:scheduleId/broadcasts": {
 		resourceType: "broadcast",
@@ -92,6 +102,10 @@ var auditRouteMap = map[string]auditRouteInfo{
 		resourceType: "broadcast",
 		idParam:      "scheduleId",
 	},
+	"/v1/schedules/:scheduleId/broadcasts/captions/:language": {
+		resourceType: "broadcast",
+		idParam:      "scheduleId",
+	},
 	"/v1/schedules/:scheduleId/broadcasts/static-image": {
 		resourceType: "broadcast",
 		idParam:      "scheduleId",
@@ -108,6 +122,8 @@ var auditRouteMap = map[string]auditRouteInfo{
 		resourceType: "broadcast",
 		idParam:      "scheduleId",
 	},
+	"/v1/schedules/:scheduleId/broadcasts/clips":         {resourceType: "broadcast_clip", idParam: ""},
+	"/v1/schedules/:scheduleId/broadcasts/clips/:clipId": {resourceType: "broadcast_clip", idParam: "clipId"},
 	// 動画
 	"/v1/videos":          {resourceType: "video", idParam: ""},
 	"/v1/videos/:videoId": {resourceType: "video", idParam: "videoId"},
@@ -177,6 +193,12 @@ var auditRouteMap = map[string]auditRouteInfo{
 	"/v1/users/:userId": {resourceType: "user", idParam: "userId"},
 	// 決済システム
 	"/v1/payment-systems/:methodType": {resourceType: "payment_system", idParam: "methodType"},
+	// 決済イベント
+	"/v1/payment-events/:paymentEventId/replay": {resourceType: "payment_event", idParam: "paymentEventId"},
+	// 精算明細
+	"/v1/settlements/-/close":                    {resourceType: "settlement", idParam: ""},
+	"/v1/settlements/-/export":                   {resourceType: "settlement", idParam: ""},
+	"/v1/settlements/:settlementStatementId/pay": {resourceType: "settlement", idParam: "settlementStatementId"},
 	// 認証 (自身)
 	"/v1/auth/email":       {resourceType: "auth", idParam: ""},
 	"/v1/auth/password":    {resourceType: "auth", idParam: ""},
@@ -19,6 +19,8 @@ func (h *handler) broadcastRoutes(rg *gin.RouterGroup) {
 	r.POST("", h.UnpauseBroadcast)
 	r.DELETE("", h.PauseBroadcast)
 	r.POST("/archive-video", h.UploadBroadcastArchive)
+	r.GET("/captions/:language", h.GetBroadcastCaption)
+	r.PATCH("/captions/:language", h.UpdateBroadcastCaption)
 	r.POST("/static-image", h.ActivateBroadcastStaticImage)
 	r.DELETE("/static-image", h.DeactivateBroadcastStaticImage)
 	r.POST("/rtmp", h.ActivateBroadcastRTMP)
@@ -127,6 +129,64 @@ func (h *handler) UploadBroadcastArchive(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// @Summary     アーカイブ字幕取得
+// @Description アーカイブ動画の字幕ファイル(WebVTT)を取得します。
+// @Tags        Broadcast
+// @Router      /v1/schedules/{scheduleId}/broadcasts/captions/{language} [get]
+// @Security    bearerauth
+// @Param       scheduleId path string true "マルシェ開催スケジュールID" example("schedule-id")
+// @Param       language path string true "言語コード" Enums(ja, en)
+// @Produce     json
+// @Success     200 {object} types.BroadcastCaptionResponse
+// @Failure     404 {object} util.ErrorResponse "アーカイブ字幕が存在しない"
+func (h *handler) GetBroadcastCaption(ctx *gin.Context) {
+	in := &media.GetBroadcastCaptionInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		Language:   util.GetParam(ctx, "language"),
+	}
+	caption, err := h.media.GetBroadcastCaption(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.BroadcastCaptionResponse{
+		Caption: service.NewBroadcastCaption(caption).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     アーカイブ字幕更新
+// @Description アーカイブ動画の字幕ファイル(WebVTT)を編集して再公開します。
+// @Tags        Broadcast
+// @Router      /v1/schedules/{scheduleId}/broadcasts/captions/{language} [patch]
+// @Security    bearerauth
+// @Param       scheduleId path string true "マルシェ開催スケジュールID" example("schedule-id")
+// @Param       language path string true "言語コード" Enums(ja, en)
+// @Accept      json
+// @Param       request body types.UpdateBroadcastCaptionRequest true "アーカイブ字幕"
+// @Produce     json
+// @Success     204
+// @Failure     400 {object} util.ErrorResponse "字幕ファイルの形式が不正"
+// @Failure     404 {object} util.ErrorResponse "マルシェライブ配信が存在しない"
+// @Failure     412 {object} util.ErrorResponse "アーカイブ動画が存在しない"
+func (h *handler) UpdateBroadcastCaption(ctx *gin.Context) {
+	req := &types.UpdateBroadcastCaptionRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &media.UpdateBroadcastCaptionInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		Language:   util.GetParam(ctx, "language"),
+		Content:    req.Content,
+	}
+	if err := h.media.UpdateBroadcastCaption(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
 // @Summary     ライブ配信中の入力をRTMPへ切り替え
 // @Description ライブ配信の入力ソースをRTMPに切り替えます。
 // @Tags        Broadcast
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        BroadcastClip
+// @tag.description ライブ配信アーカイブ切り抜き関連
+func (h *handler) broadcastClipRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/schedules/:scheduleId/broadcasts/clips", h.authentication, h.filterAccessSchedule)
+
+	r.GET("", h.ListBroadcastClips)
+	r.POST("", h.CreateBroadcastClip)
+	r.DELETE("/:clipId", h.DeleteBroadcastClip)
+}
+
+// @Summary     アーカイブ切り抜き動画一覧取得
+// @Description 指定されたスケジュールのアーカイブ切り抜き動画一覧を生成状況とあわせて取得します。
+// @Tags        BroadcastClip
+// @Router      /v1/schedules/{scheduleId}/broadcasts/clips [get]
+// @Security    bearerauth
+// @Param       scheduleId path string true "マルシェ開催スケジュールID" example("schedule-id")
+// @Produce     json
+// @Success     200 {object} types.BroadcastClipsResponse
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+// @Failure     404 {object} util.ErrorResponse "マルシェライブ配信が存在しない"
+func (h *handler) ListBroadcastClips(ctx *gin.Context) {
+	in := &media.ListBroadcastClipsInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+	}
+	clips, err := h.media.ListBroadcastClips(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.BroadcastClipsResponse{
+		Clips: service.NewBroadcastClips(clips).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     アーカイブ切り抜き動画登録
+// @Description アーカイブ動画の切り抜き範囲を登録します。切り抜き動画は非同期で生成され、完了後に非公開のオンデマンド動画として登録されます。
+// @Tags        BroadcastClip
+// @Router      /v1/schedules/{scheduleId}/broadcasts/clips [post]
+// @Security    bearerauth
+// @Param       scheduleId path string true "マルシェ開催スケジュールID" example("schedule-id")
+// @Accept      json
+// @Param       request body types.CreateBroadcastClipRequest true "切り抜き動画情報"
+// @Produce     json
+// @Success     200 {object} types.BroadcastClipResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+// @Failure     404 {object} util.ErrorResponse "マルシェライブ配信が存在しない"
+// @Failure     412 {object} util.ErrorResponse "アーカイブ動画が存在しない"
+func (h *handler) CreateBroadcastClip(ctx *gin.Context) {
+	req := &types.CreateBroadcastClipRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &media.CreateBroadcastClipInput{
+		ScheduleID:   util.GetParam(ctx, "scheduleId"),
+		Title:        req.Title,
+		Description:  req.Description,
+		ThumbnailURL: req.ThumbnailURL,
+		StartOffset:  req.StartOffset,
+		EndOffset:    req.EndOffset,
+	}
+	clip, err := h.media.CreateBroadcastClip(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.BroadcastClipResponse{
+		Clip: service.NewBroadcastClip(clip).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     アーカイブ切り抜き動画削除
+// @Description 変換待ちまたは生成失敗の切り抜き動画を削除します。
+// @Tags        BroadcastClip
+// @Router      /v1/schedules/{scheduleId}/broadcasts/clips/{clipId} [delete]
+// @Security    bearerauth
+// @Param       scheduleId path string true "マルシェ開催スケジュールID" example("schedule-id")
+// @Param       clipId path string true "切り抜き動画ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     204
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+// @Failure     404 {object} util.ErrorResponse "切り抜き動画が存在しない"
+// @Failure     412 {object} util.ErrorResponse "変換中または生成済みのため削除できない"
+func (h *handler) DeleteBroadcastClip(ctx *gin.Context) {
+	in := &media.DeleteBroadcastClipInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		ClipID:     util.GetParam(ctx, "clipId"),
+	}
+	if err := h.media.DeleteBroadcastClip(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
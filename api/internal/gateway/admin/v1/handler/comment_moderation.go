@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        CommentModeration
+// @tag.description コメントモデレーション関連
+func (h *handler) commentModerationRoutes(rg *gin.RouterGroup) {
+	wr := rg.Group("/coordinators/:coordinatorId/comment-ng-words", h.authentication, h.filterAccessCommentNgWord)
+	wr.GET("", h.ListCommentNgWords)
+	wr.POST("", h.CreateCommentNgWord)
+	wr.DELETE("/:ngWordId", h.DeleteCommentNgWord)
+
+	sr := rg.Group("/schedules/:scheduleId", h.authentication, h.filterAccessSchedule)
+	sr.GET("/comment-mutes", h.ListLiveCommentMutes)
+	sr.POST("/comment-mutes", h.CreateLiveCommentMute)
+	sr.DELETE("/comment-mutes/:muteId", h.DeleteLiveCommentMute)
+	sr.GET("/comment-moderation-logs", h.ListLiveCommentModerationLogs)
+
+	vr := rg.Group("/videos/:videoId", h.authentication, h.filterAccessVideo)
+	vr.GET("/comment-moderation-logs", h.ListVideoCommentModerationLogs)
+}
+
+func (h *handler) filterAccessCommentNgWord(ctx *gin.Context) {
+	params := &filterAccessParams{
+		coordinator: func(ctx *gin.Context) (bool, error) {
+			coordinatorID := util.GetParam(ctx, "coordinatorId")
+			return currentAdmin(ctx, coordinatorID), nil
+		},
+	}
+	if err := filterAccess(ctx, params); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Next()
+}
+
+// @Summary     コメントNGワード一覧取得
+// @Description 指定されたコーディネーターのコメントNGワード一覧を取得します。ページネーションに対応しています。
+// @Tags        CommentModeration
+// @Router      /v1/coordinators/{coordinatorId}/comment-ng-words [get]
+// @Security    bearerauth
+// @Param       coordinatorId path string true "コーディネーターID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Produce     json
+// @Success     200 {object} types.CommentNgWordsResponse
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+func (h *handler) ListCommentNgWords(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &media.ListCommentNgWordsInput{
+		CoordinatorID: util.GetParam(ctx, "coordinatorId"),
+		Limit:         limit,
+		Offset:        offset,
+	}
+	words, total, err := h.media.ListCommentNgWords(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.CommentNgWordsResponse{
+		NgWords: service.NewCommentNgWords(words).Response(),
+		Total:   total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     コメントNGワード登録
+// @Description コーディネーターが主催するライブ配信・動画のコメントで使用を禁止するワードを登録します。
+// @Tags        CommentModeration
+// @Router      /v1/coordinators/{coordinatorId}/comment-ng-words [post]
+// @Security    bearerauth
+// @Param       coordinatorId path string true "コーディネーターID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.CreateCommentNgWordRequest true "NGワード情報"
+// @Produce     json
+// @Success     200 {object} types.CommentNgWordResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+// @Failure     404 {object} util.ErrorResponse "コーディネーターが存在しない"
+// @Failure     409 {object} util.ErrorResponse "既に登録済み"
+func (h *handler) CreateCommentNgWord(ctx *gin.Context) {
+	req := &types.CreateCommentNgWordRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	coordinator, err := h.getCoordinator(ctx, util.GetParam(ctx, "coordinatorId"))
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	in := &media.CreateCommentNgWordInput{
+		CoordinatorID: coordinator.ID,
+		Word:          req.Word,
+	}
+	word, err := h.media.CreateCommentNgWord(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.CommentNgWordResponse{
+		NgWord: service.NewCommentNgWord(word).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     コメントNGワード削除
+// @Description コメントNGワードを削除します。
+// @Tags        CommentModeration
+// @Router      /v1/coordinators/{coordinatorId}/comment-ng-words/{ngWordId} [delete]
+// @Security    bearerauth
+// @Param       coordinatorId path string true "コーディネーターID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       ngWordId path string true "NGワードID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     204
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+// @Failure     404 {object} util.ErrorResponse "NGワードが存在しない"
+func (h *handler) DeleteCommentNgWord(ctx *gin.Context) {
+	in := &media.DeleteCommentNgWordInput{
+		CoordinatorID: util.GetParam(ctx, "coordinatorId"),
+		NgWordID:      util.GetParam(ctx, "ngWordId"),
+	}
+	if err := h.media.DeleteCommentNgWord(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary     ライブコメントミュート一覧取得
+// @Description 指定されたスケジュールのライブ配信でミュート・シャドウバンされている投稿者の一覧を取得します。
+// @Tags        CommentModeration
+// @Router      /v1/schedules/{scheduleId}/comment-mutes [get]
+// @Security    bearerauth
+// @Param       scheduleId path string true "スケジュールID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.CommentMutesResponse
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+func (h *handler) ListLiveCommentMutes(ctx *gin.Context) {
+	in := &media.ListBroadcastCommentMutesInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+	}
+	mutes, err := h.media.ListBroadcastCommentMutes(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.CommentMutesResponse{
+		Mutes: service.NewCommentMutes(mutes).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     ライブコメントミュート登録
+// @Description 指定したコメントの投稿者を、ライブ配信中ミュートまたはシャドウバンします。
+// @Tags        CommentModeration
+// @Router      /v1/schedules/{scheduleId}/comment-mutes [post]
+// @Security    bearerauth
+// @Param       scheduleId path string true "スケジュールID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.CreateCommentMuteRequest true "ミュート情報"
+// @Produce     json
+// @Success     200 {object} types.CommentMuteResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+// @Failure     404 {object} util.ErrorResponse "コメントが存在しない"
+// @Failure     409 {object} util.ErrorResponse "既にミュート済み"
+// @Failure     412 {object} util.ErrorResponse "投稿者を特定できない"
+func (h *handler) CreateLiveCommentMute(ctx *gin.Context) {
+	req := &types.CreateCommentMuteRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &media.CreateBroadcastCommentMuteInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		CommentID:  req.CommentID,
+		Type:       service.NewCommentMuteTypeFromRequest(req.Type).MediaEntity(),
+		Reason:     req.Reason,
+		AdminID:    getAdminID(ctx),
+	}
+	mute, err := h.media.CreateBroadcastCommentMute(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.CommentMuteResponse{
+		Mute: service.NewCommentMute(mute).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     ライブコメントミュート解除
+// @Description ライブ配信のミュート・シャドウバンを解除します。
+// @Tags        CommentModeration
+// @Router      /v1/schedules/{scheduleId}/comment-mutes/{muteId} [delete]
+// @Security    bearerauth
+// @Param       scheduleId path string true "スケジュールID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       muteId path string true "ミュートID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     204
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+// @Failure     404 {object} util.ErrorResponse "ミュート情報が存在しない"
+func (h *handler) DeleteLiveCommentMute(ctx *gin.Context) {
+	in := &media.DeleteBroadcastCommentMuteInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		MuteID:     util.GetParam(ctx, "muteId"),
+		AdminID:    getAdminID(ctx),
+	}
+	if err := h.media.DeleteBroadcastCommentMute(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary     ライブコメントモデレーション履歴取得
+// @Description 指定されたスケジュールのライブ配信におけるコメントモデレーション履歴を新しい順に取得します。
+// @Tags        CommentModeration
+// @Router      /v1/schedules/{scheduleId}/comment-moderation-logs [get]
+// @Security    bearerauth
+// @Param       scheduleId path string true "スケジュールID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Produce     json
+// @Success     200 {object} types.CommentModerationLogsResponse
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+func (h *handler) ListLiveCommentModerationLogs(ctx *gin.Context) {
+	in := &media.GetBroadcastByScheduleIDInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+	}
+	broadcast, err := h.media.GetBroadcastByScheduleID(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.listCommentModerationLogs(ctx, entity.CommentModerationTargetTypeBroadcast, broadcast.ID)
+}
+
+// @Summary     動画コメントモデレーション履歴取得
+// @Description 指定された動画におけるコメントモデレーション履歴を新しい順に取得します。
+// @Tags        CommentModeration
+// @Router      /v1/videos/{videoId}/comment-moderation-logs [get]
+// @Security    bearerauth
+// @Param       videoId path string true "動画ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Produce     json
+// @Success     200 {object} types.CommentModerationLogsResponse
+// @Failure     403 {object} util.ErrorResponse "アクセス権限がない"
+func (h *handler) ListVideoCommentModerationLogs(ctx *gin.Context) {
+	h.listCommentModerationLogs(ctx, entity.CommentModerationTargetTypeVideo, util.GetParam(ctx, "videoId"))
+}
+
+func (h *handler) listCommentModerationLogs(
+	ctx *gin.Context, targetType entity.CommentModerationTargetType, targetID string,
+) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &media.ListCommentModerationLogsInput{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	logs, total, err := h.media.ListCommentModerationLogs(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.CommentModerationLogsResponse{
+		Logs:  service.NewCommentModerationLogs(logs).Response(),
+		Total: total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        DeliverySlotRule
+// @tag.description 配送日時指定ルール関連
+func (h *handler) deliverySlotRuleRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/delivery-slot-rules", h.authentication)
+
+	r.GET("", h.ListDeliverySlotRules)
+	r.PUT("/:shippingType", h.UpsertDeliverySlotRule)
+	r.DELETE("/:shippingType", h.DeleteDeliverySlotRule)
+}
+
+// @Summary     配送日時指定ルール一覧取得
+// @Description 店舗の配送方法ごとの配送日時指定ルールを取得します。
+// @Tags        DeliverySlotRule
+// @Router      /v1/delivery-slot-rules [get]
+// @Security    bearerauth
+// @Produce     json
+// @Success     200 {object} types.DeliverySlotRulesResponse
+func (h *handler) ListDeliverySlotRules(ctx *gin.Context) {
+	in := &store.ListDeliverySlotRulesInput{
+		ShopID: getShopID(ctx),
+	}
+	rules, err := h.store.ListDeliverySlotRules(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.DeliverySlotRulesResponse{
+		DeliverySlotRules: service.NewDeliverySlotRules(rules).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     配送日時指定ルール登録・更新
+// @Description 指定した配送方法の配送日時指定ルールを登録または更新します。
+// @Tags        DeliverySlotRule
+// @Router      /v1/delivery-slot-rules/{shippingType} [put]
+// @Security    bearerauth
+// @Param       shippingType path integer true "配送方法(1:常温・冷蔵便,2:冷凍便)" example(1)
+// @Accept      json
+// @Param       request body types.UpsertDeliverySlotRuleRequest true "配送日時指定ルール"
+// @Produce     json
+// @Success     200 {object} types.DeliverySlotRuleResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+func (h *handler) UpsertDeliverySlotRule(ctx *gin.Context) {
+	shippingType, err := util.GetParamInt32(ctx, "shippingType")
+	if err != nil {
+		h.badRequest(ctx, fmt.Errorf("handler: invalid shipping type: %s: %w", err.Error(), exception.ErrInvalidArgument))
+		return
+	}
+	req := &types.UpsertDeliverySlotRuleRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	shop, err := h.getShop(ctx, getShopID(ctx))
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	in := &store.UpsertDeliverySlotRuleInput{
+		ShopID:         shop.ID,
+		CoordinatorID:  shop.CoordinatorID,
+		ShippingType:   service.ShippingType(shippingType).StoreEntity(),
+		LeadDays:       req.LeadDays,
+		SelectableDays: req.SelectableDays,
+		BlackoutDates:  req.BlackoutDates,
+		TimeWindows:    service.NewDeliveryTimeWindowsFromRequest(req.TimeWindows).StoreEntities(),
+	}
+	rule, err := h.store.UpsertDeliverySlotRule(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.DeliverySlotRuleResponse{
+		DeliverySlotRule: service.NewDeliverySlotRule(rule).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     配送日時指定ルール削除
+// @Description 指定した配送方法の配送日時指定ルールを削除します。削除後はお届け希望日時を指定できなくなります。
+// @Tags        DeliverySlotRule
+// @Router      /v1/delivery-slot-rules/{shippingType} [delete]
+// @Security    bearerauth
+// @Param       shippingType path integer true "配送方法(1:常温・冷蔵便,2:冷凍便)" example(1)
+// @Produce     json
+// @Success     204
+func (h *handler) DeleteDeliverySlotRule(ctx *gin.Context) {
+	shippingType, err := util.GetParamInt32(ctx, "shippingType")
+	if err != nil {
+		h.badRequest(ctx, fmt.Errorf("handler: invalid shipping type: %s: %w", err.Error(), exception.ErrInvalidArgument))
+		return
+	}
+	in := &store.DeleteDeliverySlotRuleInput{
+		ShopID:       getShopID(ctx),
+		ShippingType: service.ShippingType(shippingType).StoreEntity(),
+	}
+	if err := h.store.DeleteDeliverySlotRule(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
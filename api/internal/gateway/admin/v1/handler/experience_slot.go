@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        ExperienceSlot
+// @tag.description 体験枠関連
+func (h *handler) experienceSlotRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/experiences/:experienceId/slots", h.authentication, h.filterAccessExperience)
+
+	r.GET("", h.ListExperienceSlots)
+	r.POST("", h.CreateExperienceSlot)
+	r.PATCH("/:slotId", h.filterAccessExperienceSlot, h.UpdateExperienceSlot)
+	r.DELETE("/:slotId", h.filterAccessExperienceSlot, h.DeleteExperienceSlot)
+}
+
+func (h *handler) filterAccessExperienceSlot(ctx *gin.Context) {
+	in := &store.GetExperienceSlotInput{
+		ExperienceSlotID: util.GetParam(ctx, "slotId"),
+	}
+	slot, err := h.store.GetExperienceSlot(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	if slot.ExperienceID != util.GetParam(ctx, "experienceId") {
+		h.httpError(ctx, exception.ErrNotFound)
+		return
+	}
+	ctx.Next()
+}
+
+// @Summary     体験枠一覧取得
+// @Description 体験に紐づく体験枠の一覧を取得します。
+// @Tags        ExperienceSlot
+// @Router      /v1/experiences/{experienceId}/slots [get]
+// @Security    bearerauth
+// @Param       experienceId path string true "体験ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.ExperienceSlotsResponse
+// @Failure     403 {object} util.ErrorResponse "体験の参照権限がない"
+// @Failure     404 {object} util.ErrorResponse "体験が存在しない"
+func (h *handler) ListExperienceSlots(ctx *gin.Context) {
+	in := &store.ListExperienceSlotsInput{
+		ExperienceID: util.GetParam(ctx, "experienceId"),
+	}
+	slots, err := h.store.ListExperienceSlots(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.ExperienceSlotsResponse{
+		Slots: service.NewExperienceSlots(slots).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     体験枠登録
+// @Description 体験枠を登録します。
+// @Tags        ExperienceSlot
+// @Router      /v1/experiences/{experienceId}/slots [post]
+// @Security    bearerauth
+// @Param       experienceId path string true "体験ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.CreateExperienceSlotRequest true "体験枠情報"
+// @Produce     json
+// @Success     200 {object} types.ExperienceSlotResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "体験の更新権限がない"
+// @Failure     404 {object} util.ErrorResponse "体験が存在しない"
+func (h *handler) CreateExperienceSlot(ctx *gin.Context) {
+	req := &types.CreateExperienceSlotRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.CreateExperienceSlotInput{
+		ExperienceID: util.GetParam(ctx, "experienceId"),
+		Capacity:     req.Capacity,
+		StartAt:      jst.ParseFromUnix(req.StartAt),
+		EndAt:        jst.ParseFromUnix(req.EndAt),
+	}
+	slot, err := h.store.CreateExperienceSlot(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.ExperienceSlotResponse{
+		Slot: service.NewExperienceSlot(slot).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     体験枠更新
+// @Description 体験枠の定員・開催日時を更新します。予約済み人数を下回る定員には変更できません。
+// @Tags        ExperienceSlot
+// @Router      /v1/experiences/{experienceId}/slots/{slotId} [patch]
+// @Security    bearerauth
+// @Param       experienceId path string true "体験ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       slotId path string true "体験枠ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.UpdateExperienceSlotRequest true "体験枠情報"
+// @Produce     json
+// @Success     204
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "体験の更新権限がない"
+// @Failure     404 {object} util.ErrorResponse "体験枠が存在しない"
+// @Failure     412 {object} util.ErrorResponse "予約済み人数が定員を超過する"
+func (h *handler) UpdateExperienceSlot(ctx *gin.Context) {
+	req := &types.UpdateExperienceSlotRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.UpdateExperienceSlotInput{
+		ExperienceSlotID: util.GetParam(ctx, "slotId"),
+		Capacity:         req.Capacity,
+		StartAt:          jst.ParseFromUnix(req.StartAt),
+		EndAt:            jst.ParseFromUnix(req.EndAt),
+	}
+	if err := h.store.UpdateExperienceSlot(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary     体験枠削除
+// @Description 体験枠を削除します。予約がある体験枠は削除できません。
+// @Tags        ExperienceSlot
+// @Router      /v1/experiences/{experienceId}/slots/{slotId} [delete]
+// @Security    bearerauth
+// @Param       experienceId path string true "体験ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       slotId path string true "体験枠ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     204
+// @Failure     403 {object} util.ErrorResponse "体験の削除権限がない"
+// @Failure     404 {object} util.ErrorResponse "体験枠が存在しない"
+// @Failure     412 {object} util.ErrorResponse "予約済みの体験枠"
+func (h *handler) DeleteExperienceSlot(ctx *gin.Context) {
+	in := &store.DeleteExperienceSlotInput{
+		ExperienceSlotID: util.GetParam(ctx, "slotId"),
+	}
+	if err := h.store.DeleteExperienceSlot(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
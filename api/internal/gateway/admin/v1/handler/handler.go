@@ -174,29 +174,39 @@ func (h *handler) Routes(rg *gin.RouterGroup) {
 	h.auditLogRoutes(v1)
 	h.authRoutes(v1)
 	h.broadcastRoutes(v1)
+	h.broadcastClipRoutes(v1)
 	h.categoryRoutes(v1)
 	h.contactRoutes(v1)
 	h.contactCategoryRoutes(v1)
 	h.contactReadRoutes(v1)
 	h.coordinatorRoutes(v1)
+	h.deliverySlotRuleRoutes(v1)
 	h.featureRequestRoutes(v1)
 	h.experienceRoutes(v1)
+	h.experienceSlotRoutes(v1)
 	h.experienceTypeRoutes(v1)
 	h.liveRoutes(v1)
 	h.liveCommentRoutes(v1)
+	h.livePinRoutes(v1)
+	h.commentModerationRoutes(v1)
 	h.messageRoutes(v1)
 	h.notificationRoutes(v1)
 	h.orderRoutes(v1)
+	h.orderClaimRoutes(v1)
 	h.paymentSystemRoutes(v1)
+	h.paymentEventRoutes(v1)
 	h.postalCodeRoutes(v1)
+	h.preorderBatchRoutes(v1)
 	h.producerRoutes(v1)
 	h.productRoutes(v1)
 	h.productReviewRoutes(v1)
 	h.productTagRoutes(v1)
 	h.productTypeRoutes(v1)
 	h.promotionRoutes(v1)
+	h.promotionCodeRoutes(v1)
 	h.relatedProducerRoutes(v1)
 	h.scheduleRoutes(v1)
+	h.settlementRoutes(v1)
 	h.shippingRoutes(v1)
 	h.shopRotues(v1)
 	h.spotRoutes(v1)
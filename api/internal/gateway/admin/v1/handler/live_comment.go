@@ -129,8 +129,9 @@ func (h *handler) UpdateLiveComment(ctx *gin.Context) {
 		return
 	}
 	in := &media.UpdateBroadcastCommentInput{
-		CommentID: util.GetParam(ctx, "commentId"),
-		Disabled:  req.Disabled,
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		CommentID:  util.GetParam(ctx, "commentId"),
+		Disabled:   req.Disabled,
 	}
 	if err := h.media.UpdateBroadcastComment(ctx, in); err != nil {
 		h.httpError(ctx, err)
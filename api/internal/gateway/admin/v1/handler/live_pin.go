@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/sync/errgroup"
+)
+
+// @tag.name        LivePin
+// @tag.description ライブ配信商品ピン留め関連
+func (h *handler) livePinRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/schedules/:scheduleId/pins", h.authentication, h.filterAccessSchedule)
+
+	r.GET("", h.ListLivePins)
+	r.POST("", h.PinLiveProduct)
+	r.DELETE("/:pinId", h.UnpinLiveProduct)
+}
+
+// @Summary     ピン留め一覧取得
+// @Description 指定されたスケジュールの商品ピン留め履歴と、ピン留め期間中の売上を取得します。配信終了後の売上は含みません。
+// @Tags        LivePin
+// @Router      /v1/schedules/{scheduleId}/pins [get]
+// @Security    bearerauth
+// @Param       scheduleId path string true "スケジュールID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.LivePinsResponse
+func (h *handler) ListLivePins(ctx *gin.Context) {
+	in := &store.ListLivePinsInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+	}
+	pins, err := h.store.ListLivePins(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	if len(pins) == 0 {
+		res := &types.LivePinsResponse{
+			LivePins: []*types.LivePin{},
+			Sales:    []*types.LivePinSales{},
+			Products: []*types.Product{},
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
+	var shopID string
+	if getAdminType(ctx).Response() == types.AdminTypeCoordinator {
+		shopID = getShopID(ctx)
+	}
+	now := h.now()
+
+	// 配信終了後の売上はピン留めに紐付けない
+	var endedAt time.Time
+	broadcastIn := &media.GetBroadcastByScheduleIDInput{
+		ScheduleID: in.ScheduleID,
+	}
+	broadcast, err := h.media.GetBroadcastByScheduleID(ctx, broadcastIn)
+	if err != nil && !errors.Is(err, exception.ErrNotFound) {
+		h.httpError(ctx, err)
+		return
+	}
+	if broadcast != nil {
+		endedAt = broadcast.EndedAt
+	}
+
+	var products service.Products
+	sales := make(service.LivePinSalesList, len(pins))
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		products, err = h.multiGetProducts(ectx, pins.ProductIDs())
+		return
+	})
+	for i, pin := range pins {
+		eg.Go(func() error {
+			startAt, endAt := pin.SalesPeriod(now, endedAt)
+			in := &store.AggregateOrdersByProductInput{
+				ShopID:       shopID,
+				ProductIDs:   []string{pin.ProductID},
+				CreatedAtGte: startAt,
+				CreatedAtLt:  endAt,
+			}
+			orders, err := h.store.AggregateOrdersByProduct(ectx, in)
+			if err != nil {
+				return err
+			}
+			sales[i] = service.NewLivePinSales(pin.ID, orders.Map()[pin.ProductID])
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.LivePinsResponse{
+		LivePins: service.NewLivePins(pins).Response(),
+		Sales:    sales.Response(),
+		Products: products.Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     商品ピン留め
+// @Description ライブ配信中の商品をピン留めします。ピン留め位置は配信開始からの経過秒数で記録されます。
+// @Tags        LivePin
+// @Router      /v1/schedules/{scheduleId}/pins [post]
+// @Security    bearerauth
+// @Param       scheduleId path string true "スケジュールID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.PinLiveProductRequest true "ピン留め情報"
+// @Produce     json
+// @Success     200 {object} types.LivePinResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     409 {object} util.ErrorResponse "既にピン留め済み"
+// @Failure     412 {object} util.ErrorResponse "配信期間外"
+func (h *handler) PinLiveProduct(ctx *gin.Context) {
+	req := &types.PinLiveProductRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	product, err := h.getProduct(ctx, req.ProductID)
+	if errors.Is(err, exception.ErrNotFound) {
+		h.badRequest(ctx, err)
+		return
+	}
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	in := &store.PinLiveProductInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		ProductID:  req.ProductID,
+		AdminID:    getAdminID(ctx),
+	}
+	pin, err := h.store.PinLiveProduct(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.LivePinResponse{
+		LivePin: service.NewLivePin(pin).Response(),
+		Product: product.Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     商品ピン留め解除
+// @Description ピン留め中の商品のピン留めを解除します。
+// @Tags        LivePin
+// @Router      /v1/schedules/{scheduleId}/pins/{pinId} [delete]
+// @Security    bearerauth
+// @Param       scheduleId path string true "スケジュールID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       pinId path string true "ピン留めID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     204
+// @Failure     404 {object} util.ErrorResponse "ピン留めが存在しない"
+// @Failure     412 {object} util.ErrorResponse "既にピン留め解除済み"
+func (h *handler) UnpinLiveProduct(ctx *gin.Context) {
+	in := &store.UnpinLiveProductInput{
+		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		LivePinID:  util.GetParam(ctx, "pinId"),
+	}
+	if err := h.store.UnpinLiveProduct(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
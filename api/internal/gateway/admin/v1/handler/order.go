@@ -23,12 +23,16 @@ func (h *handler) orderRoutes(rg *gin.RouterGroup) {
 
 	r.GET("", h.ListOrders)
 	r.POST("/-/export", h.ExportOrders)
+	r.POST("/-/trackings", h.ImportOrderTrackings)
+	r.POST("/-/shipments", h.ImportOrderShipments)
 	r.GET("/:orderId", h.filterAccessOrder, h.GetOrder)
 	r.POST("/:orderId/draft", h.filterAccessOrder, h.DraftOrder)
 	r.POST("/:orderId/capture", h.filterAccessOrder, h.CaptureOrder)
 	r.POST("/:orderId/complete", h.filterAccessOrder, h.CompleteOrder)
 	r.POST("/:orderId/cancel", h.filterAccessOrder, h.CancelOrder)
 	r.POST("/:orderId/refund", h.filterAccessOrder, h.RefundOrder)
+	r.GET("/:orderId/refunds", h.filterAccessOrder, h.ListOrderRefunds)
+	r.GET("/:orderId/invoice", h.filterAccessOrder, h.ExportOrderInvoice)
 	r.PATCH("/:orderId/fulfillments/:fulfillmentId", h.filterAccessOrder, h.UpdateOrderFulfillment)
 }
 
@@ -62,6 +66,7 @@ func (h *handler) filterAccessOrder(ctx *gin.Context) {
 // @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
 // @Param       statuses query []int32 false "注文ステータスフィルタ" collectionFormat(csv)
 // @Param       types query []int32 false "注文タイプフィルタ" collectionFormat(csv)
+// @Param       preorderBatchId query string false "予約注文バッチID" example("kSByoE6FetnPs5Byk3a9Zx")
 // @Produce     json
 // @Success     200 {object} types.OrdersResponse
 func (h *handler) ListOrders(ctx *gin.Context) {
@@ -87,11 +92,12 @@ func (h *handler) ListOrders(ctx *gin.Context) {
 	}
 
 	in := &store.ListOrdersInput{
-		ShopID:   getShopID(ctx),
-		Limit:    limit,
-		Offset:   offset,
-		Statuses: statuses,
-		Types:    otypes,
+		ShopID:          getShopID(ctx),
+		Limit:           limit,
+		Offset:          offset,
+		Statuses:        statuses,
+		Types:           otypes,
+		PreorderBatchID: util.GetQuery(ctx, "preorderBatchId", ""),
 	}
 	orders, total, err := h.store.ListOrders(ctx, in)
 	if err != nil {
@@ -173,10 +179,11 @@ func (h *handler) newOrderFileters(ctx *gin.Context) ([]sentity.OrderStatus, []s
 	}
 	if len(statuses) == 0 {
 		statuses = []sentity.OrderStatus{
-			sentity.OrderStatusWaiting,   // 受注待ち
-			sentity.OrderStatusPreparing, // 発送準備中
-			sentity.OrderStatusShipped,   // 発送完了
-			sentity.OrderStatusCompleted, // 完了
+			sentity.OrderStatusWaiting,           // 受注待ち
+			sentity.OrderStatusPreparing,         // 発送準備中
+			sentity.OrderStatusShipped,           // 発送完了
+			sentity.OrderStatusCompleted,         // 完了
+			sentity.OrderStatusPartiallyRefunded, // 一部返金
 		}
 	}
 
@@ -375,7 +382,7 @@ func (h *handler) CancelOrder(ctx *gin.Context) {
 }
 
 // @Summary     注文の返金依頼
-// @Description 注文の返金を依頼します。
+// @Description 注文の返金を依頼します。商品・金額の指定がない場合は未返金の残額をすべて返金します。
 // @Tags        Order
 // @Router      /v1/orders/{orderId}/refund [post]
 // @Security    bearerauth
@@ -384,17 +391,26 @@ func (h *handler) CancelOrder(ctx *gin.Context) {
 // @Param       orderId path string true "注文ID" example("kSByoE6FetnPs5Byk3a9Zx")
 // @Produce     json
 // @Success     204
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
 // @Failure     403 {object} util.ErrorResponse "操作の権限がない"
 // @Failure     404 {object} util.ErrorResponse "注文が存在しない"
+// @Failure     412 {object} util.ErrorResponse "返金可能な金額・数量を超えている"
 func (h *handler) RefundOrder(ctx *gin.Context) {
 	req := &types.RefundOrderRequest{}
 	if err := ctx.BindJSON(req); err != nil {
 		h.badRequest(ctx, err)
 		return
 	}
+	items, err := h.newRefundOrderItems(ctx, util.GetParam(ctx, "orderId"), req.Items)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
 	in := &store.RefundOrderInput{
 		OrderID:     util.GetParam(ctx, "orderId"),
 		Description: req.Description,
+		Amount:      req.Amount,
+		Items:       items,
 	}
 	if err := h.store.RefundOrder(ctx, in); err != nil {
 		h.httpError(ctx, err)
@@ -403,6 +419,78 @@ func (h *handler) RefundOrder(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+func (h *handler) newRefundOrderItems(
+	ctx context.Context, orderID string, reqs []*types.RefundOrderItemRequest,
+) ([]*store.RefundOrderItem, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+	order, err := h.store.GetOrder(ctx, &store.GetOrderInput{OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	in := &store.MultiGetProductsByRevisionInput{
+		ProductRevisionIDs: order.ProductRevisionIDs(),
+	}
+	products, err := h.store.MultiGetProductsByRevision(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	// 管理画面からは商品IDで指定されるため、注文時点の商品リビジョンに変換する
+	revisions := make(map[string]int64, len(products))
+	for _, p := range products {
+		revisions[p.ID] = p.ProductRevision.ID
+	}
+	res := make([]*store.RefundOrderItem, len(reqs))
+	for i, req := range reqs {
+		revisionID, ok := revisions[req.ProductID]
+		if !ok {
+			return nil, fmt.Errorf("handler: product is not ordered: %s: %w", req.ProductID, exception.ErrInvalidArgument)
+		}
+		res[i] = &store.RefundOrderItem{
+			ProductRevisionID: revisionID,
+			Quantity:          req.Quantity,
+		}
+	}
+	return res, nil
+}
+
+// @Summary     注文の返金明細一覧取得
+// @Description 注文に対して行った返金の明細一覧を取得します。
+// @Tags        Order
+// @Router      /v1/orders/{orderId}/refunds [get]
+// @Security    bearerauth
+// @Param       orderId path string true "注文ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.OrderRefundLinesResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "注文が存在しない"
+func (h *handler) ListOrderRefunds(ctx *gin.Context) {
+	in := &store.ListOrderRefundLinesInput{
+		OrderID: util.GetParam(ctx, "orderId"),
+	}
+	lines, err := h.store.ListOrderRefundLines(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	revisionIDs := make([]int64, 0, len(lines))
+	for _, line := range lines {
+		for _, item := range line.Items {
+			revisionIDs = append(revisionIDs, item.ProductRevisionID)
+		}
+	}
+	products, err := h.multiGetProductsByRevision(ctx, revisionIDs)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.OrderRefundLinesResponse{
+		Refunds: service.NewOrderRefundLines(lines, products.MapByRevision()).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
 // @Summary     注文の配送情報更新
 // @Description 注文の配送情報を更新します。
 // @Tags        Order
@@ -454,7 +542,9 @@ func (h *handler) ExportOrders(ctx *gin.Context) {
 	in := &store.ExportOrdersInput{
 		ShopID:          getShopID(ctx),
 		ShippingCarrier: sentity.ShippingCarrier(req.ShippingCarrier),
+		LabelFormat:     sentity.ShippingLabelFormat(req.LabelFormat),
 		EncodingType:    codes.CharacterEncodingType(req.CharacterEncodingType),
+		PreorderBatchID: req.PreorderBatchID,
 	}
 	value, err := h.store.ExportOrders(ctx, in)
 	if err != nil {
@@ -471,6 +561,144 @@ func (h *handler) ExportOrders(ctx *gin.Context) {
 	ctx.Status(http.StatusOK)
 }
 
+// @Summary     領収書・請求書のPDF出力
+// @Description 注文の領収書（決済済みの場合）または請求書をインボイス制度に対応したPDF形式で出力します。
+// @Tags        Order
+// @Router      /v1/orders/{orderId}/invoice [get]
+// @Security    bearerauth
+// @Param       orderId path string true "注文ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     application/pdf
+// @Success     200 {string} file "PDFファイル"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "注文が存在しない"
+// @Failure     412 {object} util.ErrorResponse "領収書・請求書を発行できない注文"
+func (h *handler) ExportOrderInvoice(ctx *gin.Context) {
+	in := &store.ExportOrderInvoiceInput{
+		OrderID: util.GetParam(ctx, "orderId"),
+	}
+	value, err := h.store.ExportOrderInvoice(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	filename := fmt.Sprintf("invoice_%s.pdf", in.OrderID)
+	ctx.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Writer.Header().Set("Content-Type", "application/pdf")
+	if _, err := ctx.Writer.Write(value); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// @Summary     配送状況の取り込み
+// @Description 配送業者の追跡ファイル(CSV)を取り込み、配達完了した配送情報を更新します。すべての配送が完了した注文は対応完了になります。
+// @Tags        Order
+// @Router      /v1/orders/-/trackings [post]
+// @Security    bearerauth
+// @Accept      multipart/form-data
+// @Param       shippingCarrier query integer true "配送会社" example(1)
+// @Param       characterEncodingType query integer false "文字コード種別" example(1)
+// @Param       file formData file true "追跡ファイル(CSV)"
+// @Produce     json
+// @Success     200 {object} types.ImportOrderTrackingsResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+func (h *handler) ImportOrderTrackings(ctx *gin.Context) {
+	carrier, err := util.GetQueryInt32(ctx, "shippingCarrier", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	encodingType, err := util.GetQueryInt32(ctx, "characterEncodingType", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	defer file.Close() //nolint:errcheck
+
+	in := &store.ImportOrderTrackingsInput{
+		ShopID:          getShopID(ctx),
+		ShippingCarrier: sentity.ShippingCarrier(carrier),
+		EncodingType:    codes.CharacterEncodingType(encodingType),
+		File:            file,
+	}
+	total, err := h.store.ImportOrderTrackings(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.ImportOrderTrackingsResponse{
+		Total: total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     送り状発行結果の取り込み
+// @Description 配送業者の送り状発行結果(CSV)をお客様管理番号（注文IDまたは注文管理番号）で注文と照合し、伝票番号を登録して発送済みにします。
+// @Tags        Order
+// @Router      /v1/orders/-/shipments [post]
+// @Security    bearerauth
+// @Accept      multipart/form-data
+// @Param       shippingCarrier query integer true "配送会社" example(1)
+// @Param       characterEncodingType query integer false "文字コード種別" example(1)
+// @Param       file formData file true "送り状発行結果(CSV)"
+// @Produce     json
+// @Success     200 {object} types.ImportOrderShipmentsResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+func (h *handler) ImportOrderShipments(ctx *gin.Context) {
+	carrier, err := util.GetQueryInt32(ctx, "shippingCarrier", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	encodingType, err := util.GetQueryInt32(ctx, "characterEncodingType", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	defer file.Close() //nolint:errcheck
+
+	in := &store.ImportOrderShipmentsInput{
+		ShopID:          getShopID(ctx),
+		ShippingCarrier: sentity.ShippingCarrier(carrier),
+		EncodingType:    codes.CharacterEncodingType(encodingType),
+		File:            file,
+	}
+	result, err := h.store.ImportOrderShipments(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.ImportOrderShipmentsResponse{
+		Total:     result.Total,
+		Skipped:   result.Skipped,
+		Unmatched: service.NewUnmatchedShipments(result.Unmatched).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
 func (h *handler) getOrder(ctx context.Context, orderID string) (*service.Order, error) {
 	in := &store.GetOrderInput{
 		OrderID: orderID,
@@ -0,0 +1,291 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/sync/errgroup"
+)
+
+// @tag.name        OrderClaim
+// @tag.description 返品・交換申請関連
+func (h *handler) orderClaimRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/order-claims", h.authentication)
+
+	r.GET("", h.ListOrderClaims)
+	r.GET("/:claimId", h.filterAccessOrderClaim, h.GetOrderClaim)
+	r.POST("/:claimId/approve", h.filterAccessOrderClaim, h.ApproveOrderClaim)
+	r.POST("/:claimId/reject", h.filterAccessOrderClaim, h.RejectOrderClaim)
+	r.POST("/:claimId/resolve", h.filterAccessOrderClaim, h.ResolveOrderClaim)
+}
+
+func (h *handler) filterAccessOrderClaim(ctx *gin.Context) {
+	params := &filterAccessParams{
+		coordinator: func(ctx *gin.Context) (bool, error) {
+			in := &store.GetOrderClaimInput{
+				ClaimID: util.GetParam(ctx, "claimId"),
+			}
+			claim, err := h.store.GetOrderClaim(ctx, in)
+			if err != nil {
+				return false, err
+			}
+			return currentAdmin(ctx, claim.CoordinatorID), nil
+		},
+		producer: func(_ *gin.Context) (bool, error) {
+			// TODO: フィルタリング実装までは全て拒否
+			return false, nil
+		},
+	}
+	if err := filterAccess(ctx, params); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Next()
+}
+
+// @Summary     返品・交換申請一覧取得
+// @Description 返品・交換申請の一覧を取得します。コーディネータは自分の店舗の申請のみ取得できます。
+// @Tags        OrderClaim
+// @Router      /v1/order-claims [get]
+// @Security    bearerauth
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Param       orderId query string false "注文ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       statuses query []int32 false "審査状況フィルタ(未指定時は対応中のもの)" collectionFormat(csv)
+// @Produce     json
+// @Success     200 {object} types.OrderClaimsResponse
+func (h *handler) ListOrderClaims(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	params, err := util.GetQueryInt32s(ctx, "statuses")
+	if err != nil {
+		h.badRequest(ctx, fmt.Errorf("handler: failed to get status query params: %s: %w", err.Error(), exception.ErrInvalidArgument))
+		return
+	}
+	statuses := make([]sentity.OrderClaimStatus, len(params))
+	for i := range params {
+		statuses[i] = sentity.OrderClaimStatus(params[i])
+	}
+	if len(statuses) == 0 {
+		statuses = []sentity.OrderClaimStatus{
+			sentity.OrderClaimStatusRequested, // 申請中
+			sentity.OrderClaimStatusApproved,  // 承認
+			sentity.OrderClaimStatusResolving, // 対応中
+		}
+	}
+
+	in := &store.ListOrderClaimsInput{
+		ShopID:   getShopID(ctx),
+		OrderID:  util.GetQuery(ctx, "orderId", ""),
+		Statuses: statuses,
+		Limit:    limit,
+		Offset:   offset,
+	}
+	claims, total, err := h.store.ListOrderClaims(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	if len(claims) == 0 {
+		res := &types.OrderClaimsResponse{
+			Claims:   []*types.OrderClaim{},
+			Users:    []*types.User{},
+			Products: []*types.Product{},
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
+	var (
+		users    service.Users
+		products service.Products
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		users, err = h.multiGetUsers(ectx, claims.UserIDs())
+		return
+	})
+	eg.Go(func() (err error) {
+		products, err = h.multiGetProductsByRevision(ectx, claims.ProductRevisionIDs())
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.OrderClaimsResponse{
+		Claims:   service.NewOrderClaims(claims, products.MapByRevision()).Response(),
+		Users:    users.Response(),
+		Products: products.Response(),
+		Total:    total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     返品・交換申請取得
+// @Description 返品・交換申請の詳細を取得します。
+// @Tags        OrderClaim
+// @Router      /v1/order-claims/{claimId} [get]
+// @Security    bearerauth
+// @Param       claimId path string true "返品・交換申請ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.OrderClaimResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "返品・交換申請が存在しない"
+func (h *handler) GetOrderClaim(ctx *gin.Context) {
+	h.orderClaimResponse(ctx, util.GetParam(ctx, "claimId"))
+}
+
+// @Summary     返品・交換申請の承認
+// @Description 返品・交換申請を承認します。承認後に返金・再発送・クーポン発行のいずれかで対応します。
+// @Tags        OrderClaim
+// @Router      /v1/order-claims/{claimId}/approve [post]
+// @Security    bearerauth
+// @Param       claimId path string true "返品・交換申請ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.ApproveOrderClaimRequest true "返品・交換申請の承認"
+// @Produce     json
+// @Success     200 {object} types.OrderClaimResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "返品・交換申請が存在しない"
+// @Failure     412 {object} util.ErrorResponse "承認できない審査状況"
+func (h *handler) ApproveOrderClaim(ctx *gin.Context) {
+	req := &types.ApproveOrderClaimRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &store.ApproveOrderClaimInput{
+		ClaimID: util.GetParam(ctx, "claimId"),
+		AdminID: getAdminID(ctx),
+		Comment: req.Comment,
+	}
+	if err := h.store.ApproveOrderClaim(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.orderClaimResponse(ctx, in.ClaimID)
+}
+
+// @Summary     返品・交換申請の却下
+// @Description 返品・交換申請を却下します。
+// @Tags        OrderClaim
+// @Router      /v1/order-claims/{claimId}/reject [post]
+// @Security    bearerauth
+// @Param       claimId path string true "返品・交換申請ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.RejectOrderClaimRequest true "返品・交換申請の却下"
+// @Produce     json
+// @Success     200 {object} types.OrderClaimResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "返品・交換申請が存在しない"
+// @Failure     412 {object} util.ErrorResponse "却下できない審査状況"
+func (h *handler) RejectOrderClaim(ctx *gin.Context) {
+	req := &types.RejectOrderClaimRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &store.RejectOrderClaimInput{
+		ClaimID: util.GetParam(ctx, "claimId"),
+		AdminID: getAdminID(ctx),
+		Comment: req.Comment,
+	}
+	if err := h.store.RejectOrderClaim(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.orderClaimResponse(ctx, in.ClaimID)
+}
+
+// @Summary     返品・交換申請の対応完了
+// @Description 承認済みの返品・交換申請に対して、返金・再発送・クーポン発行のいずれかで対応します。
+// @Tags        OrderClaim
+// @Router      /v1/order-claims/{claimId}/resolve [post]
+// @Security    bearerauth
+// @Param       claimId path string true "返品・交換申請ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.ResolveOrderClaimRequest true "返品・交換申請の対応"
+// @Produce     json
+// @Success     200 {object} types.OrderClaimResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "返品・交換申請が存在しない"
+// @Failure     412 {object} util.ErrorResponse "対応できない審査状況・返金可能額を超えている"
+func (h *handler) ResolveOrderClaim(ctx *gin.Context) {
+	req := &types.ResolveOrderClaimRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &store.ResolveOrderClaimInput{
+		ClaimID:        util.GetParam(ctx, "claimId"),
+		ResolutionType: service.OrderClaimResolutionType(req.ResolutionType).StoreEntity(),
+		Note:           req.Note,
+		CouponAmount:   req.CouponAmount,
+	}
+	if err := h.store.ResolveOrderClaim(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.orderClaimResponse(ctx, in.ClaimID)
+}
+
+func (h *handler) orderClaimResponse(ctx *gin.Context, claimID string) {
+	in := &store.GetOrderClaimInput{
+		ClaimID: claimID,
+	}
+	claim, err := h.store.GetOrderClaim(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	var (
+		user     *service.User
+		products service.Products
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		user, err = h.getUser(ectx, claim.UserID)
+		return
+	})
+	eg.Go(func() (err error) {
+		products, err = h.multiGetProductsByRevision(ectx, claim.ProductRevisionIDs())
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.OrderClaimResponse{
+		Claim:    service.NewOrderClaim(claim, products.MapByRevision()).Response(),
+		User:     user.Response(),
+		Products: products.Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        PaymentEvent
+// @tag.description 決済イベント関連
+func (h *handler) paymentEventRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/payment-events", h.authentication, h.filterAccessPaymentEvent)
+
+	r.GET("", h.ListPaymentEvents)
+	r.GET("/:paymentEventId", h.GetPaymentEvent)
+	r.POST("/:paymentEventId/replay", h.ReplayPaymentEvent)
+}
+
+func (h *handler) filterAccessPaymentEvent(ctx *gin.Context) {
+	// 決済イベントは管理者のみ参照・操作できる
+	params := &filterAccessParams{
+		coordinator: func(_ *gin.Context) (bool, error) {
+			return false, nil
+		},
+		producer: func(_ *gin.Context) (bool, error) {
+			return false, nil
+		},
+	}
+	if err := filterAccess(ctx, params); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Next()
+}
+
+// @Summary     決済イベント一覧取得
+// @Description 決済プロバイダーから受信したWebhookイベントの一覧を取得します。
+// @Tags        PaymentEvent
+// @Router      /v1/payment-events [get]
+// @Security    bearerauth
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Param       providerType query integer false "決済プロバイダー種別" example(1)
+// @Param       statuses query []int32 false "処理状況フィルタ" collectionFormat(csv)
+// @Produce     json
+// @Success     200 {object} types.PaymentEventsResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+func (h *handler) ListPaymentEvents(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	providerType, err := util.GetQueryInt32(ctx, "providerType", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	params, err := util.GetQueryInt32s(ctx, "statuses")
+	if err != nil {
+		h.badRequest(ctx, fmt.Errorf("handler: failed to get status query params: %s: %w", err.Error(), exception.ErrInvalidArgument))
+		return
+	}
+	statuses := make([]sentity.PaymentEventStatus, len(params))
+	for i := range params {
+		statuses[i] = service.PaymentEventStatus(params[i]).StoreEntity()
+	}
+
+	in := &store.ListPaymentEventsInput{
+		ProviderType: service.PaymentProviderType(providerType).StoreEntity(),
+		Statuses:     statuses,
+		Limit:        limit,
+		Offset:       offset,
+	}
+	events, total, err := h.store.ListPaymentEvents(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.PaymentEventsResponse{
+		PaymentEvents: service.NewPaymentEvents(events).Response(),
+		Total:         total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     決済イベント取得
+// @Description 決済イベントの詳細を取得します。
+// @Tags        PaymentEvent
+// @Router      /v1/payment-events/{paymentEventId} [get]
+// @Security    bearerauth
+// @Param       paymentEventId path string true "決済イベントID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.PaymentEventResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "決済イベントが存在しない"
+func (h *handler) GetPaymentEvent(ctx *gin.Context) {
+	h.paymentEventResponse(ctx, util.GetParam(ctx, "paymentEventId"))
+}
+
+// @Summary     決済イベントの再処理
+// @Description 未処理・処理失敗の決済イベントを再処理します。
+// @Tags        PaymentEvent
+// @Router      /v1/payment-events/{paymentEventId}/replay [post]
+// @Security    bearerauth
+// @Param       paymentEventId path string true "決済イベントID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.PaymentEventResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "決済イベントが存在しない"
+// @Failure     412 {object} util.ErrorResponse "処理済みの決済イベント"
+func (h *handler) ReplayPaymentEvent(ctx *gin.Context) {
+	in := &store.ReplayPaymentEventInput{
+		PaymentEventID: util.GetParam(ctx, "paymentEventId"),
+	}
+	if err := h.store.ReplayPaymentEvent(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.paymentEventResponse(ctx, in.PaymentEventID)
+}
+
+func (h *handler) paymentEventResponse(ctx *gin.Context, paymentEventID string) {
+	in := &store.GetPaymentEventInput{
+		PaymentEventID: paymentEventID,
+	}
+	event, err := h.store.GetPaymentEvent(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.PaymentEventResponse{
+		PaymentEvent: service.NewPaymentEvent(event).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
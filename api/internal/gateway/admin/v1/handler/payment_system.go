@@ -51,7 +51,7 @@ func (h *handler) ListPaymentSystems(ctx *gin.Context) {
 }
 
 // @Summary     決済システム更新
-// @Description 指定された決済手段のシステム状態を更新します。
+// @Description 指定された決済手段のシステム状態・決済手数料率を更新します。
 // @Tags        PaymentSystem
 // @Router      /v1/payment-systems/{methodType} [patch]
 // @Security    bearerauth
@@ -76,6 +76,7 @@ func (h *handler) UpdatePaymentSystem(ctx *gin.Context) {
 		MethodType:   service.PaymentMethodType(methodType).StoreEntity(),
 		Status:       service.PaymentSystemStatus(req.Status).StoreEntity(),
 		ProviderType: service.PaymentProviderType(req.ProviderType).StoreEntity(),
+		FeeRate:      req.FeeRate,
 	}
 	if err := h.store.UpdatePaymentSystem(ctx, in); err != nil {
 		h.httpError(ctx, err)
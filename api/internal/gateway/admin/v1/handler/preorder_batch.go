@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        PreorderBatch
+// @tag.description 予約注文バッチ関連
+func (h *handler) preorderBatchRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/preorder-batches", h.authentication)
+
+	r.GET("", h.ListPreorderBatches)
+	r.GET("/:preorderBatchId", h.filterAccessPreorderBatch, h.GetPreorderBatch)
+	r.POST("/:preorderBatchId/ready", h.filterAccessPreorderBatch, h.ReadyPreorderBatch)
+}
+
+func (h *handler) filterAccessPreorderBatch(ctx *gin.Context) {
+	params := &filterAccessParams{
+		coordinator: func(ctx *gin.Context) (bool, error) {
+			in := &store.GetPreorderBatchInput{
+				PreorderBatchID: util.GetParam(ctx, "preorderBatchId"),
+			}
+			batch, err := h.store.GetPreorderBatch(ctx, in)
+			if err != nil {
+				return false, err
+			}
+			return currentAdmin(ctx, batch.CoordinatorID), nil
+		},
+		producer: func(_ *gin.Context) (bool, error) {
+			// TODO: フィルタリング実装までは全て拒否
+			return false, nil
+		},
+	}
+	if err := filterAccess(ctx, params); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Next()
+}
+
+// @Summary     予約注文バッチ一覧取得
+// @Description 出荷予定期間ごとの予約注文バッチの一覧を取得します。コーディネータは自分の店舗のもののみ取得できます。
+// @Tags        PreorderBatch
+// @Router      /v1/preorder-batches [get]
+// @Security    bearerauth
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Param       statuses query []int32 false "状態フィルタ" collectionFormat(csv)
+// @Produce     json
+// @Success     200 {object} types.PreorderBatchesResponse
+func (h *handler) ListPreorderBatches(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	params, err := util.GetQueryInt32s(ctx, "statuses")
+	if err != nil {
+		h.badRequest(ctx, fmt.Errorf("handler: failed to get status query params: %s: %w", err.Error(), exception.ErrInvalidArgument))
+		return
+	}
+	statuses := make([]sentity.PreorderBatchStatus, len(params))
+	for i := range params {
+		statuses[i] = service.PreorderBatchStatus(params[i]).StoreEntity()
+	}
+
+	in := &store.ListPreorderBatchesInput{
+		ShopID:   getShopID(ctx),
+		Statuses: statuses,
+		Limit:    limit,
+		Offset:   offset,
+	}
+	batches, total, err := h.store.ListPreorderBatches(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.PreorderBatchesResponse{
+		PreorderBatches: service.NewPreorderBatches(batches).Response(),
+		Total:           total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     予約注文バッチ取得
+// @Description 予約注文バッチの詳細を取得します。
+// @Tags        PreorderBatch
+// @Router      /v1/preorder-batches/{preorderBatchId} [get]
+// @Security    bearerauth
+// @Param       preorderBatchId path string true "予約注文バッチID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.PreorderBatchResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "予約注文バッチが存在しない"
+func (h *handler) GetPreorderBatch(ctx *gin.Context) {
+	h.preorderBatchResponse(ctx, util.GetParam(ctx, "preorderBatchId"))
+}
+
+// @Summary     予約注文バッチの出荷準備完了
+// @Description 予約注文バッチを出荷準備完了にし、与信済みの予約注文の売上を確定します。
+// @Tags        PreorderBatch
+// @Router      /v1/preorder-batches/{preorderBatchId}/ready [post]
+// @Security    bearerauth
+// @Param       preorderBatchId path string true "予約注文バッチID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.PreorderBatchResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "予約注文バッチが存在しない"
+func (h *handler) ReadyPreorderBatch(ctx *gin.Context) {
+	in := &store.ReadyPreorderBatchInput{
+		PreorderBatchID: util.GetParam(ctx, "preorderBatchId"),
+	}
+	if err := h.store.ReadyPreorderBatch(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.preorderBatchResponse(ctx, in.PreorderBatchID)
+}
+
+func (h *handler) preorderBatchResponse(ctx *gin.Context, batchID string) {
+	in := &store.GetPreorderBatchInput{
+		PreorderBatchID: batchID,
+	}
+	batch, err := h.store.GetPreorderBatch(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.PreorderBatchResponse{
+		PreorderBatch: service.NewPreorderBatch(batch).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
@@ -26,6 +26,7 @@ func (h *handler) productRoutes(rg *gin.RouterGroup) {
 	r.POST("", h.CreateProduct)
 	r.PATCH("/-/sort", h.UpdateProductsPriority)
 	r.GET("/:productId", h.filterAccessProduct, h.GetProduct)
+	r.GET("/:productId/inventory", h.filterAccessProduct, h.GetProductInventory)
 	r.PATCH("/:productId", h.filterAccessProduct, h.UpdateProduct)
 	r.DELETE("/:productId", h.filterAccessProduct, h.DeleteProduct)
 }
@@ -288,6 +289,31 @@ func (h *handler) GetProduct(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, res)
 }
 
+// @Summary     商品在庫状況取得
+// @Description 指定された商品の販売可能数と、決済待ちで仮押さえされている数量を取得します。
+// @Tags        Product
+// @Router      /v1/products/{productId}/inventory [get]
+// @Security    bearerauth
+// @Param       productId path string true "商品ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.ProductInventoryResponse
+// @Failure     404 {object} util.ErrorResponse "商品が存在しない"
+func (h *handler) GetProductInventory(ctx *gin.Context) {
+	in := &store.GetProductInventoryInput{
+		ProductID: util.GetParam(ctx, "productId"),
+	}
+	inventory, err := h.store.GetProductInventory(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.ProductInventoryResponse{
+		Inventory: service.NewProductInventory(inventory).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
 // @Summary     商品登録
 // @Description 新しい商品を登録します。
 // @Tags        Product
@@ -373,34 +399,37 @@ func (h *handler) CreateProduct(ctx *gin.Context) {
 	}
 	weight, weightUnit := service.NewProductWeightFromRequest(req.Weight)
 	in := &store.CreateProductInput{
-		ShopID:               shop.ID,
-		CoordinatorID:        req.CoordinatorID,
-		ProducerID:           req.ProducerID,
-		TypeID:               req.TypeID,
-		TagIDs:               req.TagIDs,
-		Name:                 req.Name,
-		Description:          req.Description,
-		Scope:                service.ProductScope(req.Scope).StoreEntity(),
-		Inventory:            req.Inventory,
-		Weight:               weight,
-		WeightUnit:           weightUnit,
-		Item:                 1, // 1固定
-		ItemUnit:             req.ItemUnit,
-		ItemDescription:      req.ItemDescription,
-		Media:                productMedia,
-		Price:                req.Price,
-		Cost:                 req.Cost,
-		ExpirationDate:       req.ExpirationDate,
-		RecommendedPoints:    h.newProductPoints(req.RecommendedPoint1, req.RecommendedPoint2, req.RecommendedPoint3),
-		StorageMethodType:    service.StorageMethodType(req.StorageMethodType).StoreEntity(),
-		DeliveryType:         service.DeliveryType(req.DeliveryType).StoreEntity(),
-		Box60Rate:            req.Box60Rate,
-		Box80Rate:            req.Box80Rate,
-		Box100Rate:           req.Box100Rate,
-		OriginPrefectureCode: req.OriginPrefectureCode,
-		OriginCity:           req.OriginCity,
-		StartAt:              jst.ParseFromUnix(req.StartAt),
-		EndAt:                jst.ParseFromUnix(req.EndAt),
+		ShopID:                  shop.ID,
+		CoordinatorID:           req.CoordinatorID,
+		ProducerID:              req.ProducerID,
+		TypeID:                  req.TypeID,
+		TagIDs:                  req.TagIDs,
+		Name:                    req.Name,
+		Description:             req.Description,
+		Scope:                   service.ProductScope(req.Scope).StoreEntity(),
+		Inventory:               req.Inventory,
+		Weight:                  weight,
+		WeightUnit:              weightUnit,
+		Item:                    1, // 1固定
+		ItemUnit:                req.ItemUnit,
+		ItemDescription:         req.ItemDescription,
+		Media:                   productMedia,
+		Price:                   req.Price,
+		Cost:                    req.Cost,
+		TaxCategory:             service.TaxCategory(req.TaxCategory).StoreEntity(),
+		ExpirationDate:          req.ExpirationDate,
+		RecommendedPoints:       h.newProductPoints(req.RecommendedPoint1, req.RecommendedPoint2, req.RecommendedPoint3),
+		StorageMethodType:       service.StorageMethodType(req.StorageMethodType).StoreEntity(),
+		DeliveryType:            service.DeliveryType(req.DeliveryType).StoreEntity(),
+		Box60Rate:               req.Box60Rate,
+		Box80Rate:               req.Box80Rate,
+		Box100Rate:              req.Box100Rate,
+		OriginPrefectureCode:    req.OriginPrefectureCode,
+		OriginCity:              req.OriginCity,
+		StartAt:                 jst.ParseFromUnix(req.StartAt),
+		EndAt:                   jst.ParseFromUnix(req.EndAt),
+		ExpectedShippingStartAt: jst.ParseFromUnix(req.ExpectedShippingStartAt),
+		ExpectedShippingEndAt:   jst.ParseFromUnix(req.ExpectedShippingEndAt),
 	}
 	sproduct, err := h.store.CreateProduct(ctx, in)
 	if err != nil {
@@ -476,32 +505,35 @@ func (h *handler) UpdateProduct(ctx *gin.Context) {
 	}
 	weight, weightUnit := service.NewProductWeightFromRequest(req.Weight)
 	in := &store.UpdateProductInput{
-		ProductID:            util.GetParam(ctx, "productId"),
-		TypeID:               req.TypeID,
-		TagIDs:               req.TagIDs,
-		Name:                 req.Name,
-		Description:          req.Description,
-		Scope:                service.ProductScope(req.Scope).StoreEntity(),
-		Inventory:            req.Inventory,
-		Weight:               weight,
-		WeightUnit:           weightUnit,
-		Item:                 1, // 1固定
-		ItemUnit:             req.ItemUnit,
-		ItemDescription:      req.ItemDescription,
-		Media:                productMedia,
-		Price:                req.Price,
-		Cost:                 req.Cost,
-		ExpirationDate:       req.ExpirationDate,
-		RecommendedPoints:    h.newProductPoints(req.RecommendedPoint1, req.RecommendedPoint2, req.RecommendedPoint3),
-		StorageMethodType:    service.StorageMethodType(req.StorageMethodType).StoreEntity(),
-		DeliveryType:         service.DeliveryType(req.DeliveryType).StoreEntity(),
-		Box60Rate:            req.Box60Rate,
-		Box80Rate:            req.Box80Rate,
-		Box100Rate:           req.Box100Rate,
-		OriginPrefectureCode: req.OriginPrefectureCode,
-		OriginCity:           req.OriginCity,
-		StartAt:              jst.ParseFromUnix(req.StartAt),
-		EndAt:                jst.ParseFromUnix(req.EndAt),
+		ProductID:               util.GetParam(ctx, "productId"),
+		TypeID:                  req.TypeID,
+		TagIDs:                  req.TagIDs,
+		Name:                    req.Name,
+		Description:             req.Description,
+		Scope:                   service.ProductScope(req.Scope).StoreEntity(),
+		Inventory:               req.Inventory,
+		Weight:                  weight,
+		WeightUnit:              weightUnit,
+		Item:                    1, // 1固定
+		ItemUnit:                req.ItemUnit,
+		ItemDescription:         req.ItemDescription,
+		Media:                   productMedia,
+		Price:                   req.Price,
+		Cost:                    req.Cost,
+		TaxCategory:             service.TaxCategory(req.TaxCategory).StoreEntity(),
+		ExpirationDate:          req.ExpirationDate,
+		RecommendedPoints:       h.newProductPoints(req.RecommendedPoint1, req.RecommendedPoint2, req.RecommendedPoint3),
+		StorageMethodType:       service.StorageMethodType(req.StorageMethodType).StoreEntity(),
+		DeliveryType:            service.DeliveryType(req.DeliveryType).StoreEntity(),
+		Box60Rate:               req.Box60Rate,
+		Box80Rate:               req.Box80Rate,
+		Box100Rate:              req.Box100Rate,
+		OriginPrefectureCode:    req.OriginPrefectureCode,
+		OriginCity:              req.OriginCity,
+		StartAt:                 jst.ParseFromUnix(req.StartAt),
+		EndAt:                   jst.ParseFromUnix(req.EndAt),
+		ExpectedShippingStartAt: jst.ParseFromUnix(req.ExpectedShippingStartAt),
+		ExpectedShippingEndAt:   jst.ParseFromUnix(req.ExpectedShippingEndAt),
 	}
 	if err := h.store.UpdateProduct(ctx, in); err != nil {
 		h.httpError(ctx, err)
@@ -140,14 +140,19 @@ func (h *handler) ListPromotions(ctx *gin.Context) {
 	}
 
 	var (
-		aggregates map[string]*sentity.AggregatedOrderPromotion
-		shops      service.Shops
+		aggregates  map[string]*sentity.AggregatedOrderPromotion
+		redemptions map[string]*sentity.AggregatedPromotionRedemption
+		shops       service.Shops
 	)
 	eg, ectx := errgroup.WithContext(ctx)
 	eg.Go(func() (err error) {
 		aggregates, err = h.aggregateOrdersByPromotion(ectx, promotions.IDs()...)
 		return
 	})
+	eg.Go(func() (err error) {
+		redemptions, err = h.aggregatePromotionRedemptions(ectx, promotions.IDs()...)
+		return
+	})
 	eg.Go(func() (err error) {
 		shops, err = h.multiGetShops(ectx, promotions.ShopIDs())
 		return
@@ -158,7 +163,7 @@ func (h *handler) ListPromotions(ctx *gin.Context) {
 	}
 
 	res := &types.PromotionsResponse{
-		Promotions: service.NewPromotions(promotions, aggregates).Response(),
+		Promotions: service.NewPromotions(promotions, aggregates, redemptions).Response(),
 		Shops:      shops.Response(),
 		Total:      total,
 	}
@@ -248,16 +253,20 @@ func (h *handler) CreatePromotion(ctx *gin.Context) {
 	}
 
 	in := &store.CreatePromotionInput{
-		AdminID:      getAdminID(ctx),
-		Title:        req.Title,
-		Description:  req.Description,
-		Public:       req.Public,
-		DiscountType: service.DiscountType(req.DiscountType).StoreEntity(),
-		DiscountRate: req.DiscountRate,
-		Code:         req.Code,
-		CodeType:     sentity.PromotionCodeTypeAlways, // 回数無制限固定
-		StartAt:      jst.ParseFromUnix(req.StartAt),
-		EndAt:        jst.ParseFromUnix(req.EndAt),
+		AdminID:           getAdminID(ctx),
+		Title:             req.Title,
+		Description:       req.Description,
+		Public:            req.Public,
+		DiscountType:      service.DiscountType(req.DiscountType).StoreEntity(),
+		DiscountRate:      req.DiscountRate,
+		Rules:             newPromotionRuleInputs(req.Rules),
+		Code:              req.Code,
+		CodeType:          sentity.PromotionCodeTypeAlways, // 利用回数は上限設定で制御
+		StartAt:           jst.ParseFromUnix(req.StartAt),
+		EndAt:             jst.ParseFromUnix(req.EndAt),
+		UsageLimit:        req.UsageLimit,
+		UsageLimitPerUser: req.UsageLimitPerUser,
+		FirstPurchaseOnly: req.FirstPurchaseOnly,
 	}
 	promotion, err := h.store.CreatePromotion(ctx, in)
 	if err != nil {
@@ -267,7 +276,7 @@ func (h *handler) CreatePromotion(ctx *gin.Context) {
 
 	res := &types.PromotionResponse{
 		// 初回は集計結果が存在しないためnilで渡す
-		Promotion: service.NewPromotion(promotion, nil).Response(),
+		Promotion: service.NewPromotion(promotion, nil, nil).Response(),
 	}
 	if promotion.ShopID == "" {
 		ctx.JSON(http.StatusOK, res)
@@ -304,17 +313,21 @@ func (h *handler) UpdatePromotion(ctx *gin.Context) {
 	}
 
 	in := &store.UpdatePromotionInput{
-		PromotionID:  util.GetParam(ctx, "promotionId"),
-		AdminID:      getAdminID(ctx),
-		Title:        req.Title,
-		Description:  req.Description,
-		Public:       req.Public,
-		DiscountType: service.DiscountType(req.DiscountType).StoreEntity(),
-		DiscountRate: req.DiscountRate,
-		Code:         req.Code,
-		CodeType:     sentity.PromotionCodeTypeAlways, // 回数無制限固定
-		StartAt:      jst.ParseFromUnix(req.StartAt),
-		EndAt:        jst.ParseFromUnix(req.EndAt),
+		PromotionID:       util.GetParam(ctx, "promotionId"),
+		AdminID:           getAdminID(ctx),
+		Title:             req.Title,
+		Description:       req.Description,
+		Public:            req.Public,
+		DiscountType:      service.DiscountType(req.DiscountType).StoreEntity(),
+		DiscountRate:      req.DiscountRate,
+		Rules:             newPromotionRuleInputs(req.Rules),
+		Code:              req.Code,
+		CodeType:          sentity.PromotionCodeTypeAlways, // 利用回数は上限設定で制御
+		StartAt:           jst.ParseFromUnix(req.StartAt),
+		EndAt:             jst.ParseFromUnix(req.EndAt),
+		UsageLimit:        req.UsageLimit,
+		UsageLimitPerUser: req.UsageLimitPerUser,
+		FirstPurchaseOnly: req.FirstPurchaseOnly,
 	}
 	if err := h.store.UpdatePromotion(ctx, in); err != nil {
 		h.httpError(ctx, err)
@@ -357,11 +370,23 @@ func (h *handler) multiGetPromotions(ctx context.Context, promotionIDs []string)
 	if err != nil {
 		return nil, err
 	}
-	aggregates, err := h.aggregateOrdersByPromotion(ctx, promotionIDs...)
-	if err != nil {
+	var (
+		aggregates  map[string]*sentity.AggregatedOrderPromotion
+		redemptions map[string]*sentity.AggregatedPromotionRedemption
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		aggregates, err = h.aggregateOrdersByPromotion(ectx, promotionIDs...)
+		return
+	})
+	eg.Go(func() (err error) {
+		redemptions, err = h.aggregatePromotionRedemptions(ectx, promotionIDs...)
+		return
+	})
+	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
-	return service.NewPromotions(promotions, aggregates), nil
+	return service.NewPromotions(promotions, aggregates, redemptions), nil
 }
 
 func (h *handler) getPromotion(ctx context.Context, promotionID string) (*service.Promotion, error) {
@@ -372,11 +397,23 @@ func (h *handler) getPromotion(ctx context.Context, promotionID string) (*servic
 	if err != nil {
 		return nil, err
 	}
-	aggregates, err := h.aggregateOrdersByPromotion(ctx, promotionID)
-	if err != nil {
+	var (
+		aggregates  map[string]*sentity.AggregatedOrderPromotion
+		redemptions map[string]*sentity.AggregatedPromotionRedemption
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		aggregates, err = h.aggregateOrdersByPromotion(ectx, promotionID)
+		return
+	})
+	eg.Go(func() (err error) {
+		redemptions, err = h.aggregatePromotionRedemptions(ectx, promotionID)
+		return
+	})
+	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
-	return service.NewPromotion(promotion, aggregates[promotionID]), nil
+	return service.NewPromotion(promotion, aggregates[promotionID], redemptions[promotionID]), nil
 }
 
 func (h *handler) aggregateOrdersByPromotion(
@@ -395,3 +432,46 @@ func (h *handler) aggregateOrdersByPromotion(
 	}
 	return aggregates.Map(), nil
 }
+
+func (h *handler) aggregatePromotionRedemptions(
+	ctx context.Context,
+	promotionIDs ...string,
+) (map[string]*sentity.AggregatedPromotionRedemption, error) {
+	if len(promotionIDs) == 0 {
+		return map[string]*sentity.AggregatedPromotionRedemption{}, nil
+	}
+	in := &store.AggregatePromotionRedemptionsInput{
+		PromotionIDs: promotionIDs,
+	}
+	redemptions, err := h.store.AggregatePromotionRedemptions(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return redemptions.Map(), nil
+}
+
+func newPromotionRuleInputs(rules []*types.PromotionRuleRequest) []*store.PromotionRule {
+	res := make([]*store.PromotionRule, len(rules))
+	for i, rule := range rules {
+		tiers := make([]*store.PromotionRuleTier, len(rule.Tiers))
+		for j, tier := range rule.Tiers {
+			tiers[j] = &store.PromotionRuleTier{
+				Threshold: tier.Threshold,
+				Rate:      tier.Rate,
+			}
+		}
+		res[i] = &store.PromotionRule{
+			Type:           service.PromotionRuleType(rule.Type).StoreEntity(),
+			ProductIDs:     rule.ProductIDs,
+			ProductTypeIDs: rule.ProductTypeIDs,
+			CategoryIDs:    rule.CategoryIDs,
+			MinimumAmount:  rule.MinimumAmount,
+			DiscountRate:   rule.DiscountRate,
+			Tiers:          tiers,
+			BuyQuantity:    rule.BuyQuantity,
+			FreeQuantity:   rule.FreeQuantity,
+			MaxDiscount:    rule.MaxDiscount,
+		}
+	}
+	return res
+}
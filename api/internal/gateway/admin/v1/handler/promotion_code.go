@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        PromotionCode
+// @tag.description クーポンコード(一括発行)関連
+func (h *handler) promotionCodeRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/promotions/:promotionId/code-batches", h.authentication, h.filterAccessPromotion)
+
+	r.GET("", h.ListPromotionCodeBatches)
+	r.POST("", h.CreatePromotionCodeBatch)
+	r.GET("/:batchId", h.filterAccessPromotionCodeBatch, h.GetPromotionCodeBatch)
+	r.DELETE("/:batchId", h.filterAccessPromotionCodeBatch, h.VoidPromotionCodeBatch)
+	r.GET("/:batchId/codes", h.filterAccessPromotionCodeBatch, h.ListPromotionCodes)
+	r.POST("/:batchId/export", h.filterAccessPromotionCodeBatch, h.ExportPromotionCodes)
+}
+
+func (h *handler) filterAccessPromotionCodeBatch(ctx *gin.Context) {
+	in := &store.GetPromotionCodeBatchInput{
+		BatchID: util.GetParam(ctx, "batchId"),
+	}
+	batch, err := h.store.GetPromotionCodeBatch(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	if batch.PromotionID != util.GetParam(ctx, "promotionId") {
+		h.httpError(ctx, exception.ErrNotFound)
+		return
+	}
+	ctx.Next()
+}
+
+// @Summary     クーポンコード一括発行履歴取得
+// @Description プロモーションに紐づくクーポンコードの一括発行履歴を取得します。
+// @Tags        PromotionCode
+// @Router      /v1/promotions/{promotionId}/code-batches [get]
+// @Security    bearerauth
+// @Param       promotionId path string true "プロモーションID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Produce     json
+// @Success     200 {object} types.PromotionCodeBatchesResponse
+// @Failure     403 {object} util.ErrorResponse "プロモーションの参照権限がない"
+// @Failure     404 {object} util.ErrorResponse "プロモーションが存在しない"
+func (h *handler) ListPromotionCodeBatches(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.ListPromotionCodeBatchesInput{
+		PromotionID: util.GetParam(ctx, "promotionId"),
+		Limit:       limit,
+		Offset:      offset,
+	}
+	batches, total, err := h.store.ListPromotionCodeBatches(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.PromotionCodeBatchesResponse{
+		Batches: service.NewPromotionCodeBatches(batches).Response(),
+		Total:   total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     クーポンコード一括発行
+// @Description 使い切りのクーポンコードを指定数分まとめて発行します。
+// @Tags        PromotionCode
+// @Router      /v1/promotions/{promotionId}/code-batches [post]
+// @Security    bearerauth
+// @Param       promotionId path string true "プロモーションID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.CreatePromotionCodeBatchRequest true "一括発行情報"
+// @Produce     json
+// @Success     200 {object} types.PromotionCodeBatchResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "プロモーションの更新権限がない"
+// @Failure     404 {object} util.ErrorResponse "プロモーションが存在しない"
+func (h *handler) CreatePromotionCodeBatch(ctx *gin.Context) {
+	req := &types.CreatePromotionCodeBatchRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.CreatePromotionCodeBatchInput{
+		PromotionID: util.GetParam(ctx, "promotionId"),
+		Title:       req.Title,
+		Quantity:    req.Quantity,
+	}
+	batch, err := h.store.CreatePromotionCodeBatch(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.PromotionCodeBatchResponse{
+		Batch: service.NewPromotionCodeBatch(batch).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     クーポンコード一括発行情報取得
+// @Description クーポンコードの一括発行情報を取得します。
+// @Tags        PromotionCode
+// @Router      /v1/promotions/{promotionId}/code-batches/{batchId} [get]
+// @Security    bearerauth
+// @Param       promotionId path string true "プロモーションID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       batchId path string true "一括発行ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.PromotionCodeBatchResponse
+// @Failure     403 {object} util.ErrorResponse "プロモーションの参照権限がない"
+// @Failure     404 {object} util.ErrorResponse "一括発行情報が存在しない"
+func (h *handler) GetPromotionCodeBatch(ctx *gin.Context) {
+	in := &store.GetPromotionCodeBatchInput{
+		BatchID: util.GetParam(ctx, "batchId"),
+	}
+	batch, err := h.store.GetPromotionCodeBatch(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.PromotionCodeBatchResponse{
+		Batch: service.NewPromotionCodeBatch(batch).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     クーポンコード無効化
+// @Description 一括発行したクーポンコードのうち、未使用のものをまとめて無効化します。
+// @Tags        PromotionCode
+// @Router      /v1/promotions/{promotionId}/code-batches/{batchId} [delete]
+// @Security    bearerauth
+// @Param       promotionId path string true "プロモーションID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       batchId path string true "一括発行ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     204
+// @Failure     403 {object} util.ErrorResponse "プロモーションの更新権限がない"
+// @Failure     404 {object} util.ErrorResponse "一括発行情報が存在しない"
+// @Failure     412 {object} util.ErrorResponse "無効化済み"
+func (h *handler) VoidPromotionCodeBatch(ctx *gin.Context) {
+	in := &store.VoidPromotionCodeBatchInput{
+		BatchID: util.GetParam(ctx, "batchId"),
+	}
+	if err := h.store.VoidPromotionCodeBatch(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary     クーポンコード一覧取得
+// @Description 一括発行したクーポンコードの一覧と利用状況を取得します。
+// @Tags        PromotionCode
+// @Router      /v1/promotions/{promotionId}/code-batches/{batchId}/codes [get]
+// @Security    bearerauth
+// @Param       promotionId path string true "プロモーションID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       batchId path string true "一括発行ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Param       statuses query []int32 false "利用状況フィルタ" collectionFormat(csv)
+// @Produce     json
+// @Success     200 {object} types.PromotionCodesResponse
+// @Failure     403 {object} util.ErrorResponse "プロモーションの参照権限がない"
+// @Failure     404 {object} util.ErrorResponse "一括発行情報が存在しない"
+func (h *handler) ListPromotionCodes(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	values, err := util.GetQueryInt32s(ctx, "statuses")
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	statuses := make([]sentity.PromotionCodeStatus, len(values))
+	for i := range values {
+		statuses[i] = sentity.PromotionCodeStatus(values[i])
+	}
+
+	in := &store.ListPromotionCodesInput{
+		BatchID:  util.GetParam(ctx, "batchId"),
+		Statuses: statuses,
+		Limit:    limit,
+		Offset:   offset,
+	}
+	pcodes, total, err := h.store.ListPromotionCodes(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.PromotionCodesResponse{
+		Codes: service.NewPromotionCodes(pcodes).Response(),
+		Total: total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     クーポンコードのCSV出力
+// @Description 一括発行したクーポンコードを配布用のCSV形式で出力します。
+// @Tags        PromotionCode
+// @Router      /v1/promotions/{promotionId}/code-batches/{batchId}/export [post]
+// @Security    bearerauth
+// @Param       promotionId path string true "プロモーションID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       batchId path string true "一括発行ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Accept      json
+// @Param       request body types.ExportPromotionCodesRequest true "クーポンコードのCSV出力"
+// @Produce     text/csv
+// @Success     200 {string} file "CSVファイル"
+// @Failure     403 {object} util.ErrorResponse "プロモーションの参照権限がない"
+// @Failure     404 {object} util.ErrorResponse "一括発行情報が存在しない"
+func (h *handler) ExportPromotionCodes(ctx *gin.Context) {
+	req := &types.ExportPromotionCodesRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &store.ExportPromotionCodesInput{
+		BatchID:      util.GetParam(ctx, "batchId"),
+		EncodingType: codes.CharacterEncodingType(req.CharacterEncodingType),
+	}
+	value, err := h.store.ExportPromotionCodes(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	filename := fmt.Sprintf("promotion_codes_%s.csv", h.now().Format("20060102150405"))
+	ctx.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Writer.Header().Set("Content-Type", "text/csv")
+	if _, err := ctx.Writer.Write(value); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
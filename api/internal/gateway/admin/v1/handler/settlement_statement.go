@@ -0,0 +1,305 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        Settlement
+// @tag.description 精算関連
+func (h *handler) settlementRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/settlements", h.authentication, h.filterAccessSettlement)
+
+	r.GET("", h.ListSettlementStatements)
+	r.GET("/-/balances", h.ListSettlementBalances)
+	r.POST("/-/close", h.CloseSettlementPeriod)
+	r.POST("/-/export", h.ExportSettlementStatements)
+	r.GET("/:settlementStatementId", h.GetSettlementStatement)
+	r.POST("/:settlementStatementId/pay", h.PaySettlementStatement)
+	r.GET("/:settlementStatementId/export", h.ExportSettlementStatement)
+}
+
+func (h *handler) filterAccessSettlement(ctx *gin.Context) {
+	// 精算は管理者のみ操作できる
+	params := &filterAccessParams{
+		coordinator: func(_ *gin.Context) (bool, error) {
+			return false, nil
+		},
+		producer: func(_ *gin.Context) (bool, error) {
+			return false, nil
+		},
+	}
+	if err := filterAccess(ctx, params); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Next()
+}
+
+// @Summary     精算明細一覧取得
+// @Description 精算明細の一覧を取得します。
+// @Tags        Settlement
+// @Router      /v1/settlements [get]
+// @Security    bearerauth
+// @Param       limit query integer false "取得上限数(max:200)" default(20) example(20)
+// @Param       offset query integer false "取得開始位置(min:0)" default(0) example(0)
+// @Param       payeeType query integer false "精算先種別" example(1)
+// @Param       payeeId query string false "精算先ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Param       periodStartAt query integer false "精算対象期間(開始)" example(1640962800)
+// @Param       statuses query []int32 false "支払い状況フィルタ" collectionFormat(csv)
+// @Produce     json
+// @Success     200 {object} types.SettlementStatementsResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+func (h *handler) ListSettlementStatements(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	payeeType, err := util.GetQueryInt32(ctx, "payeeType", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	periodStartAt, err := util.GetQueryInt64(ctx, "periodStartAt", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	params, err := util.GetQueryInt32s(ctx, "statuses")
+	if err != nil {
+		h.badRequest(ctx, fmt.Errorf("handler: failed to get status query params: %s: %w", err.Error(), exception.ErrInvalidArgument))
+		return
+	}
+	statuses := make([]sentity.SettlementStatementStatus, len(params))
+	for i := range params {
+		statuses[i] = service.SettlementStatementStatus(params[i]).StoreEntity()
+	}
+
+	in := &store.ListSettlementStatementsInput{
+		PayeeType:     service.SettlementPayeeType(payeeType).StoreEntity(),
+		PayeeID:       util.GetQuery(ctx, "payeeId", ""),
+		PeriodStartAt: jst.ParseFromUnix(periodStartAt),
+		Statuses:      statuses,
+		Limit:         limit,
+		Offset:        offset,
+	}
+	statements, total, err := h.store.ListSettlementStatements(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.SettlementStatementsResponse{
+		SettlementStatements: service.NewSettlementStatements(statements).Response(),
+		Total:                total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     精算先ごとの支払い残高取得
+// @Description 精算先ごとの未払い・支払い済み金額を集計して取得します。
+// @Tags        Settlement
+// @Router      /v1/settlements/-/balances [get]
+// @Security    bearerauth
+// @Param       payeeType query integer false "精算先種別" example(1)
+// @Param       payeeId query string false "精算先ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.SettlementBalancesResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+func (h *handler) ListSettlementBalances(ctx *gin.Context) {
+	payeeType, err := util.GetQueryInt32(ctx, "payeeType", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.AggregateSettlementBalancesInput{
+		PayeeType: service.SettlementPayeeType(payeeType).StoreEntity(),
+		PayeeID:   util.GetQuery(ctx, "payeeId", ""),
+	}
+	balances, err := h.store.AggregateSettlementBalances(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.SettlementBalancesResponse{
+		SettlementBalances: service.NewSettlementBalances(balances).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     精算期間の締め
+// @Description 精算対象期間の注文を集計し、店舗・コーディネータ・生産者ごとの精算明細を作成します。
+// @Tags        Settlement
+// @Router      /v1/settlements/-/close [post]
+// @Security    bearerauth
+// @Accept      json
+// @Param       request body types.CloseSettlementPeriodRequest true "精算期間"
+// @Produce     json
+// @Success     200 {object} types.SettlementStatementsResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     409 {object} util.ErrorResponse "精算期間が締め済み、または締め済みの期間と重複している"
+// @Failure     412 {object} util.ErrorResponse "精算期間が終了していない"
+func (h *handler) CloseSettlementPeriod(ctx *gin.Context) {
+	req := &types.CloseSettlementPeriodRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.CloseSettlementPeriodInput{
+		PeriodStartAt: jst.ParseFromUnix(req.PeriodStartAt),
+		PeriodEndAt:   jst.ParseFromUnix(req.PeriodEndAt),
+	}
+	statements, err := h.store.CloseSettlementPeriod(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.SettlementStatementsResponse{
+		SettlementStatements: service.NewSettlementStatements(statements).Response(),
+		Total:                int64(len(statements)),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     精算明細のCSV出力
+// @Description 精算対象期間の精算明細をCSV形式で出力します。
+// @Tags        Settlement
+// @Router      /v1/settlements/-/export [post]
+// @Security    bearerauth
+// @Accept      json
+// @Param       request body types.ExportSettlementStatementsRequest true "精算明細のCSV出力"
+// @Produce     text/csv
+// @Success     200 {string} file "CSVファイル"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+func (h *handler) ExportSettlementStatements(ctx *gin.Context) {
+	req := &types.ExportSettlementStatementsRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.ExportSettlementStatementsInput{
+		PeriodStartAt: jst.ParseFromUnix(req.PeriodStartAt),
+		PayeeType:     service.SettlementPayeeType(req.PayeeType).StoreEntity(),
+		EncodingType:  codes.CharacterEncodingType(req.CharacterEncodingType),
+	}
+	value, err := h.store.ExportSettlementStatements(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	filename := fmt.Sprintf("settlements_%s.csv", in.PeriodStartAt.Format("200601"))
+	ctx.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Writer.Header().Set("Content-Type", "text/csv")
+	if _, err := ctx.Writer.Write(value); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// @Summary     精算明細取得
+// @Description 精算明細の詳細を取得します。
+// @Tags        Settlement
+// @Router      /v1/settlements/{settlementStatementId} [get]
+// @Security    bearerauth
+// @Param       settlementStatementId path string true "精算明細ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.SettlementStatementResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "精算明細が存在しない"
+func (h *handler) GetSettlementStatement(ctx *gin.Context) {
+	h.settlementStatementResponse(ctx, util.GetParam(ctx, "settlementStatementId"))
+}
+
+// @Summary     精算明細の支払い済み登録
+// @Description 精算明細を支払い済みにします。
+// @Tags        Settlement
+// @Router      /v1/settlements/{settlementStatementId}/pay [post]
+// @Security    bearerauth
+// @Param       settlementStatementId path string true "精算明細ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     json
+// @Success     200 {object} types.SettlementStatementResponse
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "精算明細が存在しない"
+// @Failure     412 {object} util.ErrorResponse "支払い済みの精算明細"
+func (h *handler) PaySettlementStatement(ctx *gin.Context) {
+	in := &store.PaySettlementStatementInput{
+		SettlementStatementID: util.GetParam(ctx, "settlementStatementId"),
+	}
+	if err := h.store.PaySettlementStatement(ctx, in); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.settlementStatementResponse(ctx, in.SettlementStatementID)
+}
+
+// @Summary     支払明細書のPDF出力
+// @Description 精算明細の支払明細書をPDF形式で出力します。
+// @Tags        Settlement
+// @Router      /v1/settlements/{settlementStatementId}/export [get]
+// @Security    bearerauth
+// @Param       settlementStatementId path string true "精算明細ID" example("kSByoE6FetnPs5Byk3a9Zx")
+// @Produce     application/pdf
+// @Success     200 {string} file "PDFファイル"
+// @Failure     403 {object} util.ErrorResponse "操作の権限がない"
+// @Failure     404 {object} util.ErrorResponse "精算明細が存在しない"
+func (h *handler) ExportSettlementStatement(ctx *gin.Context) {
+	in := &store.ExportSettlementStatementInput{
+		SettlementStatementID: util.GetParam(ctx, "settlementStatementId"),
+	}
+	value, err := h.store.ExportSettlementStatement(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	filename := fmt.Sprintf("settlement_%s.pdf", in.SettlementStatementID)
+	ctx.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Writer.Header().Set("Content-Type", "application/pdf")
+	if _, err := ctx.Writer.Write(value); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+func (h *handler) settlementStatementResponse(ctx *gin.Context, statementID string) {
+	in := &store.GetSettlementStatementInput{
+		SettlementStatementID: statementID,
+	}
+	statement, err := h.store.GetSettlementStatement(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.SettlementStatementResponse{
+		SettlementStatement: service.NewSettlementStatement(statement).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
 	"github.com/and-period/furumaru/api/internal/gateway/util"
 	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/sync/errgroup"
 )
@@ -86,6 +87,13 @@ func (h *handler) UpdateDefaultShipping(ctx *gin.Context) {
 		Box80Frozen:       req.Box80Frozen,
 		Box100Rates:       h.newShippingRatesForUpdateDefault(req.Box100Rates),
 		Box100Frozen:      req.Box100Frozen,
+		Box120Rates:       h.newShippingRatesForUpdateDefault(req.Box120Rates),
+		Box120Frozen:      req.Box120Frozen,
+		Box140Rates:       h.newShippingRatesForUpdateDefault(req.Box140Rates),
+		Box140Frozen:      req.Box140Frozen,
+		Box160Rates:       h.newShippingRatesForUpdateDefault(req.Box160Rates),
+		Box160Frozen:      req.Box160Frozen,
+		RegionRules:       h.newShippingRegionRulesForUpdateDefault(req.RegionRules),
 		HasFreeShipping:   req.HasFreeShipping,
 		FreeShippingRates: req.FreeShippingRates,
 	}
@@ -108,6 +116,24 @@ func (h *handler) newShippingRatesForUpdateDefault(in []*types.UpdateDefaultShip
 	return res
 }
 
+func (h *handler) newShippingRegionRulesForUpdateDefault(in []*types.UpdateDefaultShippingRegionRule) []*store.UpdateDefaultShippingRegionRule {
+	res := make([]*store.UpdateDefaultShippingRegionRule, len(in))
+	for i := range in {
+		excludedTypes := make([]sentity.ShippingType, len(in[i].ExcludedTypes))
+		for j := range in[i].ExcludedTypes {
+			excludedTypes[j] = service.ShippingType(in[i].ExcludedTypes[j]).StoreEntity()
+		}
+		res[i] = &store.UpdateDefaultShippingRegionRule{
+			Name:           in[i].Name,
+			PostalCodeFrom: in[i].PostalCodeFrom,
+			PostalCodeTo:   in[i].PostalCodeTo,
+			Surcharge:      in[i].Surcharge,
+			ExcludedTypes:  excludedTypes,
+		}
+	}
+	return res
+}
+
 // @Summary     配送設定一覧取得
 // @Description 指定されたコーディネーターの配送設定一覧を取得します。ページネーションに対応しています。
 // @Tags        Shipping
@@ -250,6 +276,13 @@ func (h *handler) CreateShipping(ctx *gin.Context) {
 		Box80Frozen:       req.Box80Frozen,
 		Box100Rates:       h.newShippingRatesForCreate(req.Box100Rates),
 		Box100Frozen:      req.Box100Frozen,
+		Box120Rates:       h.newShippingRatesForCreate(req.Box120Rates),
+		Box120Frozen:      req.Box120Frozen,
+		Box140Rates:       h.newShippingRatesForCreate(req.Box140Rates),
+		Box140Frozen:      req.Box140Frozen,
+		Box160Rates:       h.newShippingRatesForCreate(req.Box160Rates),
+		Box160Frozen:      req.Box160Frozen,
+		RegionRules:       h.newShippingRegionRulesForCreate(req.RegionRules),
 		HasFreeShipping:   req.HasFreeShipping,
 		FreeShippingRates: req.FreeShippingRates,
 	}
@@ -308,6 +341,13 @@ func (h *handler) UpdateShipping(ctx *gin.Context) {
 		Box80Frozen:       req.Box80Frozen,
 		Box100Rates:       h.newShippingRatesForUpdate(req.Box100Rates),
 		Box100Frozen:      req.Box100Frozen,
+		Box120Rates:       h.newShippingRatesForUpdate(req.Box120Rates),
+		Box120Frozen:      req.Box120Frozen,
+		Box140Rates:       h.newShippingRatesForUpdate(req.Box140Rates),
+		Box140Frozen:      req.Box140Frozen,
+		Box160Rates:       h.newShippingRatesForUpdate(req.Box160Rates),
+		Box160Frozen:      req.Box160Frozen,
+		RegionRules:       h.newShippingRegionRulesForUpdate(req.RegionRules),
 		HasFreeShipping:   req.HasFreeShipping,
 		FreeShippingRates: req.FreeShippingRates,
 	}
@@ -414,6 +454,24 @@ func (h *handler) newShippingRatesForCreate(in []*types.CreateShippingRate) []*s
 	return res
 }
 
+func (h *handler) newShippingRegionRulesForCreate(in []*types.CreateShippingRegionRule) []*store.CreateShippingRegionRule {
+	res := make([]*store.CreateShippingRegionRule, len(in))
+	for i := range in {
+		excludedTypes := make([]sentity.ShippingType, len(in[i].ExcludedTypes))
+		for j := range in[i].ExcludedTypes {
+			excludedTypes[j] = service.ShippingType(in[i].ExcludedTypes[j]).StoreEntity()
+		}
+		res[i] = &store.CreateShippingRegionRule{
+			Name:           in[i].Name,
+			PostalCodeFrom: in[i].PostalCodeFrom,
+			PostalCodeTo:   in[i].PostalCodeTo,
+			Surcharge:      in[i].Surcharge,
+			ExcludedTypes:  excludedTypes,
+		}
+	}
+	return res
+}
+
 func (h *handler) newShippingRatesForUpdate(in []*types.UpdateShippingRate) []*store.UpdateShippingRate {
 	res := make([]*store.UpdateShippingRate, len(in))
 	for i := range in {
@@ -425,3 +483,21 @@ func (h *handler) newShippingRatesForUpdate(in []*types.UpdateShippingRate) []*s
 	}
 	return res
 }
+
+func (h *handler) newShippingRegionRulesForUpdate(in []*types.UpdateShippingRegionRule) []*store.UpdateShippingRegionRule {
+	res := make([]*store.UpdateShippingRegionRule, len(in))
+	for i := range in {
+		excludedTypes := make([]sentity.ShippingType, len(in[i].ExcludedTypes))
+		for j := range in[i].ExcludedTypes {
+			excludedTypes[j] = service.ShippingType(in[i].ExcludedTypes[j]).StoreEntity()
+		}
+		res[i] = &store.UpdateShippingRegionRule{
+			Name:           in[i].Name,
+			PostalCodeFrom: in[i].PostalCodeFrom,
+			PostalCodeTo:   in[i].PostalCodeTo,
+			Surcharge:      in[i].Surcharge,
+			ExcludedTypes:  excludedTypes,
+		}
+	}
+	return res
+}
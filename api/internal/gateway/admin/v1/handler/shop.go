@@ -126,10 +126,11 @@ func (h *handler) UpdateShop(ctx *gin.Context) {
 	}
 
 	in := &user.UpdateShopInput{
-		ShopID:         ctx.Param("shopId"),
-		Name:           req.Name,
-		ProductTypeIDs: req.ProductTypeIDs,
-		BusinessDays:   req.BusinessDays,
+		ShopID:                    ctx.Param("shopId"),
+		Name:                      req.Name,
+		ProductTypeIDs:            req.ProductTypeIDs,
+		BusinessDays:              req.BusinessDays,
+		InvoiceRegistrationNumber: req.InvoiceRegistrationNumber,
 	}
 	if err := h.user.UpdateShop(ctx, in); err != nil {
 		h.httpError(ctx, err)
@@ -44,6 +44,30 @@ func (s BroadcastStatus) Response() types.BroadcastStatus {
 	return types.BroadcastStatus(s)
 }
 
+// BroadcastCaptionStatus - アーカイブ字幕の生成状況
+type BroadcastCaptionStatus types.BroadcastCaptionStatus
+
+type BroadcastCaption struct {
+	types.BroadcastCaption
+}
+
+func NewBroadcastCaptionStatus(status entity.BroadcastCaptionStatus) BroadcastCaptionStatus {
+	switch status {
+	case entity.BroadcastCaptionStatusProcessing:
+		return BroadcastCaptionStatus(types.BroadcastCaptionStatusProcessing)
+	case entity.BroadcastCaptionStatusPublished:
+		return BroadcastCaptionStatus(types.BroadcastCaptionStatusPublished)
+	case entity.BroadcastCaptionStatusFailed:
+		return BroadcastCaptionStatus(types.BroadcastCaptionStatusFailed)
+	default:
+		return BroadcastCaptionStatus(types.BroadcastCaptionStatusNone)
+	}
+}
+
+func (s BroadcastCaptionStatus) Response() types.BroadcastCaptionStatus {
+	return types.BroadcastCaptionStatus(s)
+}
+
 func NewBroadcast(broadcast *entity.Broadcast) *Broadcast {
 	res := &Broadcast{
 		Broadcast: types.Broadcast{
@@ -53,11 +77,15 @@ func NewBroadcast(broadcast *entity.Broadcast) *Broadcast {
 			InputURL:       broadcast.InputURL,
 			OutputURL:      broadcast.OutputURL,
 			ArchiveURL:     broadcast.ArchiveURL,
+			CaptionStatus:  NewBroadcastCaptionStatus(broadcast.CaptionStatus).Response(),
 			YoutubeAccount: broadcast.YoutubeAccount,
 			CreatedAt:      broadcast.CreatedAt.Unix(),
 			UpdatedAt:      broadcast.CreatedAt.Unix(),
 		},
 	}
+	if broadcast.ArchiveMetadata != nil {
+		res.Subtitles = broadcast.ArchiveMetadata.Subtitles
+	}
 	if broadcast.YoutubeBroadcastID != "" {
 		res.YoutubeViewerURL = fmt.Sprintf(YoutubeViewerURL, broadcast.YoutubeBroadcastID)
 		res.YoutubeAdminURL = fmt.Sprintf(youtubeAdminURL, broadcast.YoutubeBroadcastID)
@@ -72,6 +100,23 @@ func (b *Broadcast) Response() *types.Broadcast {
 	return &b.Broadcast
 }
 
+func NewBroadcastCaption(caption *entity.BroadcastCaption) *BroadcastCaption {
+	return &BroadcastCaption{
+		BroadcastCaption: types.BroadcastCaption{
+			Language: caption.Language,
+			URL:      caption.URL,
+			Content:  string(caption.Content),
+		},
+	}
+}
+
+func (c *BroadcastCaption) Response() *types.BroadcastCaption {
+	if c == nil {
+		return nil
+	}
+	return &c.BroadcastCaption
+}
+
 func NewBroadcasts(broadcasts entity.Broadcasts) Broadcasts {
 	res := make(Broadcasts, len(broadcasts))
 	for i := range broadcasts {
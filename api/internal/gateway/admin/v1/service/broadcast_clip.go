@@ -0,0 +1,76 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// BroadcastClipStatus - アーカイブ切り抜き動画の生成状況
+type BroadcastClipStatus types.BroadcastClipStatus
+
+type BroadcastClip struct {
+	types.BroadcastClip
+}
+
+type BroadcastClips []*BroadcastClip
+
+func NewBroadcastClipStatus(status entity.BroadcastClipStatus) BroadcastClipStatus {
+	switch status {
+	case entity.BroadcastClipStatusWaiting:
+		return BroadcastClipStatus(types.BroadcastClipStatusWaiting)
+	case entity.BroadcastClipStatusProcessing:
+		return BroadcastClipStatus(types.BroadcastClipStatusProcessing)
+	case entity.BroadcastClipStatusCompleted:
+		return BroadcastClipStatus(types.BroadcastClipStatusCompleted)
+	case entity.BroadcastClipStatusFailed:
+		return BroadcastClipStatus(types.BroadcastClipStatusFailed)
+	default:
+		return BroadcastClipStatus(types.BroadcastClipStatusUnknown)
+	}
+}
+
+func (s BroadcastClipStatus) Response() types.BroadcastClipStatus {
+	return types.BroadcastClipStatus(s)
+}
+
+func NewBroadcastClip(clip *entity.BroadcastClip) *BroadcastClip {
+	return &BroadcastClip{
+		BroadcastClip: types.BroadcastClip{
+			ID:           clip.ID,
+			ScheduleID:   clip.ScheduleID,
+			Title:        clip.Title,
+			Description:  clip.Description,
+			ThumbnailURL: clip.ThumbnailURL,
+			ProductIDs:   clip.ProductIDs,
+			StartOffset:  clip.StartOffset,
+			EndOffset:    clip.EndOffset,
+			Status:       NewBroadcastClipStatus(clip.Status).Response(),
+			Progress:     clip.Progress,
+			VideoID:      clip.VideoID,
+			ErrorMessage: clip.ErrorMessage,
+			CreatedAt:    jst.Unix(clip.CreatedAt),
+			UpdatedAt:    jst.Unix(clip.UpdatedAt),
+		},
+	}
+}
+
+func (c *BroadcastClip) Response() *types.BroadcastClip {
+	return &c.BroadcastClip
+}
+
+func NewBroadcastClips(clips entity.BroadcastClips) BroadcastClips {
+	res := make(BroadcastClips, len(clips))
+	for i := range clips {
+		res[i] = NewBroadcastClip(clips[i])
+	}
+	return res
+}
+
+func (cs BroadcastClips) Response() []*types.BroadcastClip {
+	res := make([]*types.BroadcastClip, len(cs))
+	for i := range cs {
+		res[i] = cs[i].Response()
+	}
+	return res
+}
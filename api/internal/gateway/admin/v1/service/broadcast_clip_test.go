@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcastClipStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.BroadcastClipStatus
+		expect BroadcastClipStatus
+	}{
+		{
+			name:   "waiting",
+			status: entity.BroadcastClipStatusWaiting,
+			expect: BroadcastClipStatus(types.BroadcastClipStatusWaiting),
+		},
+		{
+			name:   "processing",
+			status: entity.BroadcastClipStatusProcessing,
+			expect: BroadcastClipStatus(types.BroadcastClipStatusProcessing),
+		},
+		{
+			name:   "completed",
+			status: entity.BroadcastClipStatusCompleted,
+			expect: BroadcastClipStatus(types.BroadcastClipStatusCompleted),
+		},
+		{
+			name:   "failed",
+			status: entity.BroadcastClipStatusFailed,
+			expect: BroadcastClipStatus(types.BroadcastClipStatusFailed),
+		},
+		{
+			name:   "unknown",
+			status: entity.BroadcastClipStatusUnknown,
+			expect: BroadcastClipStatus(types.BroadcastClipStatusUnknown),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewBroadcastClipStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, types.BroadcastClipStatus(tt.expect), actual.Response())
+		})
+	}
+}
+
+func TestBroadcastClips(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		clips  entity.BroadcastClips
+		expect []*types.BroadcastClip
+	}{
+		{
+			name: "success",
+			clips: entity.BroadcastClips{
+				{
+					ID:            "clip-id",
+					BroadcastID:   "broadcast-id",
+					ScheduleID:    "schedule-id",
+					CoordinatorID: "coordinator-id",
+					Title:         "じゃがいもの収穫",
+					Description:   "じゃがいもの収穫シーンです。",
+					ThumbnailURL:  "https://example.com/thumbnail.png",
+					ProductIDs:    []string{"product-id"},
+					StartOffset:   60,
+					EndOffset:     180,
+					Status:        entity.BroadcastClipStatusCompleted,
+					JobID:         "job-id",
+					Progress:      100,
+					VideoID:       "video-id",
+					CreatedAt:     jst.Date(2022, 1, 1, 0, 0, 0, 0),
+					UpdatedAt:     jst.Date(2022, 1, 1, 0, 0, 0, 0),
+				},
+			},
+			expect: []*types.BroadcastClip{
+				{
+					ID:           "clip-id",
+					ScheduleID:   "schedule-id",
+					Title:        "じゃがいもの収穫",
+					Description:  "じゃがいもの収穫シーンです。",
+					ThumbnailURL: "https://example.com/thumbnail.png",
+					ProductIDs:   []string{"product-id"},
+					StartOffset:  60,
+					EndOffset:    180,
+					Status:       types.BroadcastClipStatusCompleted,
+					Progress:     100,
+					VideoID:      "video-id",
+					CreatedAt:    1640962800,
+					UpdatedAt:    1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewBroadcastClips(tt.clips)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
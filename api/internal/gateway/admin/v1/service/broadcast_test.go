@@ -73,8 +73,12 @@ func TestBroadcast(t *testing.T) {
 				YoutubeStreamKey:   "youtube-stream-key",
 				YoutubeStreamURL:   "rtmp://stream.example.com",
 				YoutubeBackupURL:   "rtmp://backup.example.com",
-				CreatedAt:          jst.Date(2022, 1, 1, 0, 0, 0, 0),
-				UpdatedAt:          jst.Date(2022, 1, 1, 0, 0, 0, 0),
+				ArchiveMetadata: &entity.BroadcastArchiveMetadata{
+					Subtitles: map[string]string{"ja": "http://example.com/caption-ja.vtt"},
+				},
+				CaptionStatus: entity.BroadcastCaptionStatusPublished,
+				CreatedAt:     jst.Date(2022, 1, 1, 0, 0, 0, 0),
+				UpdatedAt:     jst.Date(2022, 1, 1, 0, 0, 0, 0),
 			},
 			expect: &Broadcast{
 				Broadcast: types.Broadcast{
@@ -84,6 +88,8 @@ func TestBroadcast(t *testing.T) {
 					InputURL:         "rtmp://127.0.0.1:1935/app/instance",
 					OutputURL:        "http://example.com/index.m3u8",
 					ArchiveURL:       "http://example.com/index.mp4",
+					CaptionStatus:    types.BroadcastCaptionStatusPublished,
+					Subtitles:        map[string]string{"ja": "http://example.com/caption-ja.vtt"},
 					YoutubeAccount:   "youtube-account",
 					YoutubeViewerURL: "https://youtube.com/live/youtube-broadcast-id",
 					YoutubeAdminURL:  "https://studio.youtube.com/video/youtube-broadcast-id/livestreaming",
@@ -101,6 +107,73 @@ func TestBroadcast(t *testing.T) {
 	}
 }
 
+func TestBroadcastCaptionStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.BroadcastCaptionStatus
+		expect BroadcastCaptionStatus
+	}{
+		{
+			name:   "none",
+			status: entity.BroadcastCaptionStatusNone,
+			expect: BroadcastCaptionStatus(types.BroadcastCaptionStatusNone),
+		},
+		{
+			name:   "processing",
+			status: entity.BroadcastCaptionStatusProcessing,
+			expect: BroadcastCaptionStatus(types.BroadcastCaptionStatusProcessing),
+		},
+		{
+			name:   "published",
+			status: entity.BroadcastCaptionStatusPublished,
+			expect: BroadcastCaptionStatus(types.BroadcastCaptionStatusPublished),
+		},
+		{
+			name:   "failed",
+			status: entity.BroadcastCaptionStatusFailed,
+			expect: BroadcastCaptionStatus(types.BroadcastCaptionStatusFailed),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewBroadcastCaptionStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, types.BroadcastCaptionStatus(tt.expect), actual.Response())
+		})
+	}
+}
+
+func TestBroadcastCaption(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		caption *entity.BroadcastCaption
+		expect  *types.BroadcastCaption
+	}{
+		{
+			name: "success",
+			caption: &entity.BroadcastCaption{
+				Language: "ja",
+				URL:      "http://example.com/caption-ja.vtt",
+				Content:  []byte("WEBVTT\n"),
+			},
+			expect: &types.BroadcastCaption{
+				Language: "ja",
+				URL:      "http://example.com/caption-ja.vtt",
+				Content:  "WEBVTT\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewBroadcastCaption(tt.caption).Response())
+		})
+	}
+}
+
 func TestBroadcasts(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
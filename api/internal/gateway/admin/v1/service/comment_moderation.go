@@ -0,0 +1,189 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// CommentMuteType - コメントミュート種別
+type CommentMuteType types.CommentMuteType
+
+// CommentModerationAction - コメントモデレーション種別
+type CommentModerationAction types.CommentModerationAction
+
+type CommentNgWord struct {
+	types.CommentNgWord
+}
+
+type CommentNgWords []*CommentNgWord
+
+type CommentMute struct {
+	types.CommentMute
+}
+
+type CommentMutes []*CommentMute
+
+type CommentModerationLog struct {
+	types.CommentModerationLog
+}
+
+type CommentModerationLogs []*CommentModerationLog
+
+func NewCommentMuteType(typ entity.CommentMuteType) CommentMuteType {
+	switch typ {
+	case entity.CommentMuteTypeMute:
+		return CommentMuteType(types.CommentMuteTypeMute)
+	case entity.CommentMuteTypeShadowBan:
+		return CommentMuteType(types.CommentMuteTypeShadowBan)
+	default:
+		return CommentMuteType(types.CommentMuteTypeUnknown)
+	}
+}
+
+func NewCommentMuteTypeFromRequest(typ types.CommentMuteType) CommentMuteType {
+	return CommentMuteType(typ)
+}
+
+func (t CommentMuteType) MediaEntity() entity.CommentMuteType {
+	switch types.CommentMuteType(t) {
+	case types.CommentMuteTypeMute:
+		return entity.CommentMuteTypeMute
+	case types.CommentMuteTypeShadowBan:
+		return entity.CommentMuteTypeShadowBan
+	default:
+		return entity.CommentMuteTypeUnknown
+	}
+}
+
+func (t CommentMuteType) Response() types.CommentMuteType {
+	return types.CommentMuteType(t)
+}
+
+func NewCommentModerationAction(action entity.CommentModerationAction) CommentModerationAction {
+	switch action {
+	case entity.CommentModerationActionNgWord:
+		return CommentModerationAction(types.CommentModerationActionNgWord)
+	case entity.CommentModerationActionRateLimit:
+		return CommentModerationAction(types.CommentModerationActionRateLimit)
+	case entity.CommentModerationActionMuted:
+		return CommentModerationAction(types.CommentModerationActionMuted)
+	case entity.CommentModerationActionShadowBanned:
+		return CommentModerationAction(types.CommentModerationActionShadowBanned)
+	case entity.CommentModerationActionMuteCreated:
+		return CommentModerationAction(types.CommentModerationActionMuteCreated)
+	case entity.CommentModerationActionMuteDeleted:
+		return CommentModerationAction(types.CommentModerationActionMuteDeleted)
+	default:
+		return CommentModerationAction(types.CommentModerationActionUnknown)
+	}
+}
+
+func (a CommentModerationAction) Response() types.CommentModerationAction {
+	return types.CommentModerationAction(a)
+}
+
+func NewCommentNgWord(word *entity.CommentNgWord) *CommentNgWord {
+	return &CommentNgWord{
+		CommentNgWord: types.CommentNgWord{
+			ID:            word.ID,
+			CoordinatorID: word.CoordinatorID,
+			Word:          word.Word,
+			CreatedAt:     jst.Unix(word.CreatedAt),
+			UpdatedAt:     jst.Unix(word.UpdatedAt),
+		},
+	}
+}
+
+func (w *CommentNgWord) Response() *types.CommentNgWord {
+	return &w.CommentNgWord
+}
+
+func NewCommentNgWords(words entity.CommentNgWords) CommentNgWords {
+	res := make(CommentNgWords, len(words))
+	for i := range words {
+		res[i] = NewCommentNgWord(words[i])
+	}
+	return res
+}
+
+func (ws CommentNgWords) Response() []*types.CommentNgWord {
+	res := make([]*types.CommentNgWord, len(ws))
+	for i := range ws {
+		res[i] = ws[i].Response()
+	}
+	return res
+}
+
+func NewCommentMute(mute *entity.CommentMute) *CommentMute {
+	return &CommentMute{
+		CommentMute: types.CommentMute{
+			ID:          mute.ID,
+			BroadcastID: mute.BroadcastID,
+			UserID:      mute.UserID,
+			SessionID:   mute.SessionID,
+			Type:        NewCommentMuteType(mute.Type).Response(),
+			Reason:      mute.Reason,
+			AdminID:     mute.AdminID,
+			CreatedAt:   jst.Unix(mute.CreatedAt),
+			UpdatedAt:   jst.Unix(mute.UpdatedAt),
+		},
+	}
+}
+
+func (m *CommentMute) Response() *types.CommentMute {
+	return &m.CommentMute
+}
+
+func NewCommentMutes(mutes entity.CommentMutes) CommentMutes {
+	res := make(CommentMutes, len(mutes))
+	for i := range mutes {
+		res[i] = NewCommentMute(mutes[i])
+	}
+	return res
+}
+
+func (ms CommentMutes) Response() []*types.CommentMute {
+	res := make([]*types.CommentMute, len(ms))
+	for i := range ms {
+		res[i] = ms[i].Response()
+	}
+	return res
+}
+
+func NewCommentModerationLog(log *entity.CommentModerationLog) *CommentModerationLog {
+	return &CommentModerationLog{
+		CommentModerationLog: types.CommentModerationLog{
+			ID:        log.ID,
+			Action:    NewCommentModerationAction(log.Action).Response(),
+			CommentID: log.CommentID,
+			UserID:    log.UserID,
+			SessionID: log.SessionID,
+			ClientIP:  log.ClientIP,
+			Content:   log.Content,
+			Detail:    log.Detail,
+			AdminID:   log.AdminID,
+			CreatedAt: jst.Unix(log.CreatedAt),
+		},
+	}
+}
+
+func (l *CommentModerationLog) Response() *types.CommentModerationLog {
+	return &l.CommentModerationLog
+}
+
+func NewCommentModerationLogs(logs entity.CommentModerationLogs) CommentModerationLogs {
+	res := make(CommentModerationLogs, len(logs))
+	for i := range logs {
+		res[i] = NewCommentModerationLog(logs[i])
+	}
+	return res
+}
+
+func (ls CommentModerationLogs) Response() []*types.CommentModerationLog {
+	res := make([]*types.CommentModerationLog, len(ls))
+	for i := range ls {
+		res[i] = ls[i].Response()
+	}
+	return res
+}
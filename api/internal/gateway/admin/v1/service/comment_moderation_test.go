@@ -0,0 +1,263 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentMuteType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    entity.CommentMuteType
+		expect CommentMuteType
+	}{
+		{
+			name:   "mute",
+			typ:    entity.CommentMuteTypeMute,
+			expect: CommentMuteType(types.CommentMuteTypeMute),
+		},
+		{
+			name:   "shadow ban",
+			typ:    entity.CommentMuteTypeShadowBan,
+			expect: CommentMuteType(types.CommentMuteTypeShadowBan),
+		},
+		{
+			name:   "unknown",
+			typ:    entity.CommentMuteTypeUnknown,
+			expect: CommentMuteType(types.CommentMuteTypeUnknown),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentMuteType(tt.typ)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.typ, actual.MediaEntity())
+			assert.Equal(t, types.CommentMuteType(tt.expect), actual.Response())
+		})
+	}
+}
+
+func TestCommentMuteTypeFromRequest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    types.CommentMuteType
+		expect entity.CommentMuteType
+	}{
+		{
+			name:   "mute",
+			typ:    types.CommentMuteTypeMute,
+			expect: entity.CommentMuteTypeMute,
+		},
+		{
+			name:   "shadow ban",
+			typ:    types.CommentMuteTypeShadowBan,
+			expect: entity.CommentMuteTypeShadowBan,
+		},
+		{
+			name:   "unknown",
+			typ:    types.CommentMuteType(-1),
+			expect: entity.CommentMuteTypeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentMuteTypeFromRequest(tt.typ)
+			assert.Equal(t, tt.expect, actual.MediaEntity())
+		})
+	}
+}
+
+func TestCommentModerationAction(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		action entity.CommentModerationAction
+		expect types.CommentModerationAction
+	}{
+		{
+			name:   "ng word",
+			action: entity.CommentModerationActionNgWord,
+			expect: types.CommentModerationActionNgWord,
+		},
+		{
+			name:   "rate limit",
+			action: entity.CommentModerationActionRateLimit,
+			expect: types.CommentModerationActionRateLimit,
+		},
+		{
+			name:   "muted",
+			action: entity.CommentModerationActionMuted,
+			expect: types.CommentModerationActionMuted,
+		},
+		{
+			name:   "shadow banned",
+			action: entity.CommentModerationActionShadowBanned,
+			expect: types.CommentModerationActionShadowBanned,
+		},
+		{
+			name:   "mute created",
+			action: entity.CommentModerationActionMuteCreated,
+			expect: types.CommentModerationActionMuteCreated,
+		},
+		{
+			name:   "mute deleted",
+			action: entity.CommentModerationActionMuteDeleted,
+			expect: types.CommentModerationActionMuteDeleted,
+		},
+		{
+			name:   "unknown",
+			action: entity.CommentModerationAction(0),
+			expect: types.CommentModerationActionUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentModerationAction(tt.action)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
+
+func TestCommentNgWords(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		words  entity.CommentNgWords
+		expect []*types.CommentNgWord
+	}{
+		{
+			name: "success",
+			words: entity.CommentNgWords{
+				{
+					ID:            "word-id",
+					CoordinatorID: "coordinator-id",
+					Word:          "ばか",
+					CreatedAt:     now,
+					UpdatedAt:     now,
+				},
+			},
+			expect: []*types.CommentNgWord{
+				{
+					ID:            "word-id",
+					CoordinatorID: "coordinator-id",
+					Word:          "ばか",
+					CreatedAt:     1640962800,
+					UpdatedAt:     1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentNgWords(tt.words)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
+
+func TestCommentMutes(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		mutes  entity.CommentMutes
+		expect []*types.CommentMute
+	}{
+		{
+			name: "success",
+			mutes: entity.CommentMutes{
+				{
+					ID:          "mute-id",
+					BroadcastID: "broadcast-id",
+					SessionID:   "session-id",
+					Type:        entity.CommentMuteTypeShadowBan,
+					Reason:      "荒らし行為",
+					AdminID:     "admin-id",
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			expect: []*types.CommentMute{
+				{
+					ID:          "mute-id",
+					BroadcastID: "broadcast-id",
+					UserID:      "",
+					SessionID:   "session-id",
+					Type:        types.CommentMuteTypeShadowBan,
+					Reason:      "荒らし行為",
+					AdminID:     "admin-id",
+					CreatedAt:   1640962800,
+					UpdatedAt:   1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentMutes(tt.mutes)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
+
+func TestCommentModerationLogs(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		logs   entity.CommentModerationLogs
+		expect []*types.CommentModerationLog
+	}{
+		{
+			name: "success",
+			logs: entity.CommentModerationLogs{
+				{
+					ID:         "log-id",
+					TargetType: entity.CommentModerationTargetTypeBroadcast,
+					TargetID:   "broadcast-id",
+					Action:     entity.CommentModerationActionNgWord,
+					UserID:     "user-id",
+					SessionID:  "session-id",
+					ClientIP:   "127.0.0.1",
+					Content:    "ばかじゃないの",
+					Detail:     "ばか",
+					CreatedAt:  now,
+					UpdatedAt:  now,
+				},
+			},
+			expect: []*types.CommentModerationLog{
+				{
+					ID:        "log-id",
+					Action:    types.CommentModerationActionNgWord,
+					CommentID: "",
+					UserID:    "user-id",
+					SessionID: "session-id",
+					ClientIP:  "127.0.0.1",
+					Content:   "ばかじゃないの",
+					Detail:    "ばか",
+					AdminID:   "",
+					CreatedAt: 1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentModerationLogs(tt.logs)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// DeliveryTimeWindow - お届け希望時間帯
+type DeliveryTimeWindow types.DeliveryTimeWindow
+
+type DeliveryTimeWindows []DeliveryTimeWindow
+
+type DeliverySlotRule struct {
+	types.DeliverySlotRule
+}
+
+type DeliverySlotRules []*DeliverySlotRule
+
+func NewDeliveryTimeWindow(window entity.DeliveryTimeWindow) DeliveryTimeWindow {
+	switch window {
+	case entity.DeliveryTimeWindowMorning:
+		return DeliveryTimeWindow(types.DeliveryTimeWindowMorning)
+	case entity.DeliveryTimeWindow1214:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1214)
+	case entity.DeliveryTimeWindow1416:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1416)
+	case entity.DeliveryTimeWindow1618:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1618)
+	case entity.DeliveryTimeWindow1820:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1820)
+	case entity.DeliveryTimeWindow1921:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1921)
+	default:
+		return DeliveryTimeWindow(types.DeliveryTimeWindowUnspecified)
+	}
+}
+
+func (w DeliveryTimeWindow) StoreEntity() entity.DeliveryTimeWindow {
+	switch types.DeliveryTimeWindow(w) {
+	case types.DeliveryTimeWindowMorning:
+		return entity.DeliveryTimeWindowMorning
+	case types.DeliveryTimeWindow1214:
+		return entity.DeliveryTimeWindow1214
+	case types.DeliveryTimeWindow1416:
+		return entity.DeliveryTimeWindow1416
+	case types.DeliveryTimeWindow1618:
+		return entity.DeliveryTimeWindow1618
+	case types.DeliveryTimeWindow1820:
+		return entity.DeliveryTimeWindow1820
+	case types.DeliveryTimeWindow1921:
+		return entity.DeliveryTimeWindow1921
+	default:
+		return entity.DeliveryTimeWindowUnspecified
+	}
+}
+
+func (w DeliveryTimeWindow) Response() types.DeliveryTimeWindow {
+	return types.DeliveryTimeWindow(w)
+}
+
+func NewDeliveryTimeWindows(windows []entity.DeliveryTimeWindow) DeliveryTimeWindows {
+	res := make(DeliveryTimeWindows, len(windows))
+	for i := range windows {
+		res[i] = NewDeliveryTimeWindow(windows[i])
+	}
+	return res
+}
+
+func NewDeliveryTimeWindowsFromRequest(windows []types.DeliveryTimeWindow) DeliveryTimeWindows {
+	res := make(DeliveryTimeWindows, len(windows))
+	for i := range windows {
+		res[i] = DeliveryTimeWindow(windows[i])
+	}
+	return res
+}
+
+func (ws DeliveryTimeWindows) StoreEntities() []entity.DeliveryTimeWindow {
+	res := make([]entity.DeliveryTimeWindow, len(ws))
+	for i := range ws {
+		res[i] = ws[i].StoreEntity()
+	}
+	return res
+}
+
+func (ws DeliveryTimeWindows) Response() []types.DeliveryTimeWindow {
+	res := make([]types.DeliveryTimeWindow, len(ws))
+	for i := range ws {
+		res[i] = ws[i].Response()
+	}
+	return res
+}
+
+func NewDeliverySlotRule(rule *entity.DeliverySlotRule) *DeliverySlotRule {
+	blackoutDates := rule.BlackoutDates
+	if blackoutDates == nil {
+		blackoutDates = []string{}
+	}
+	return &DeliverySlotRule{
+		DeliverySlotRule: types.DeliverySlotRule{
+			ID:             rule.ID,
+			CoordinatorID:  rule.CoordinatorID,
+			ShippingType:   NewShippingType(rule.ShippingType).Response(),
+			LeadDays:       rule.LeadDays,
+			SelectableDays: rule.SelectableDays,
+			BlackoutDates:  blackoutDates,
+			TimeWindows:    NewDeliveryTimeWindows(rule.TimeWindows).Response(),
+			CreatedAt:      jst.Unix(rule.CreatedAt),
+			UpdatedAt:      jst.Unix(rule.UpdatedAt),
+		},
+	}
+}
+
+func (r *DeliverySlotRule) Response() *types.DeliverySlotRule {
+	return &r.DeliverySlotRule
+}
+
+func NewDeliverySlotRules(rules entity.DeliverySlotRules) DeliverySlotRules {
+	res := make(DeliverySlotRules, len(rules))
+	for i := range rules {
+		res[i] = NewDeliverySlotRule(rules[i])
+	}
+	return res
+}
+
+func (rs DeliverySlotRules) Response() []*types.DeliverySlotRule {
+	res := make([]*types.DeliverySlotRule, len(rs))
+	for i := range rs {
+		res[i] = rs[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExperienceSlotStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status entity.ExperienceSlotStatus
+		expect ExperienceSlotStatus
+	}{
+		{
+			name:   "accepting",
+			status: entity.ExperienceSlotStatusAccepting,
+			expect: ExperienceSlotStatus(types.ExperienceSlotStatusAccepting),
+		},
+		{
+			name:   "sold out",
+			status: entity.ExperienceSlotStatusSoldOut,
+			expect: ExperienceSlotStatus(types.ExperienceSlotStatusSoldOut),
+		},
+		{
+			name:   "finished",
+			status: entity.ExperienceSlotStatusFinished,
+			expect: ExperienceSlotStatus(types.ExperienceSlotStatusFinished),
+		},
+		{
+			name:   "unknown",
+			status: entity.ExperienceSlotStatusUnknown,
+			expect: ExperienceSlotStatus(types.ExperienceSlotStatusUnknown),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewExperienceSlotStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestExperienceSlots(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+
+	tests := []struct {
+		name   string
+		slots  entity.ExperienceSlots
+		expect ExperienceSlots
+	}{
+		{
+			name: "success",
+			slots: entity.ExperienceSlots{
+				{
+					ID:           "slot-id",
+					ExperienceID: "experience-id",
+					Status:       entity.ExperienceSlotStatusAccepting,
+					Capacity:     10,
+					Reserved:     3,
+					StartAt:      now,
+					EndAt:        now.Add(2 * time.Hour),
+					CreatedAt:    now,
+					UpdatedAt:    now,
+				},
+			},
+			expect: ExperienceSlots{
+				{
+					ExperienceSlot: types.ExperienceSlot{
+						ID:           "slot-id",
+						ExperienceID: "experience-id",
+						Status:       types.ExperienceSlotStatusAccepting,
+						Capacity:     10,
+						Reserved:     3,
+						Remaining:    7,
+						StartAt:      now.Unix(),
+						EndAt:        now.Add(2 * time.Hour).Unix(),
+						CreatedAt:    now.Unix(),
+						UpdatedAt:    now.Unix(),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewExperienceSlots(tt.slots)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestExperienceSlots_Response(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		slots  ExperienceSlots
+		expect []*types.ExperienceSlot
+	}{
+		{
+			name: "success",
+			slots: ExperienceSlots{
+				{
+					ExperienceSlot: types.ExperienceSlot{
+						ID:           "slot-id",
+						ExperienceID: "experience-id",
+						Status:       types.ExperienceSlotStatusAccepting,
+						Capacity:     10,
+						Remaining:    7,
+						StartAt:      1724491800,
+						EndAt:        1724499000,
+					},
+				},
+			},
+			expect: []*types.ExperienceSlot{
+				{
+					ID:           "slot-id",
+					ExperienceID: "experience-id",
+					Status:       types.ExperienceSlotStatusAccepting,
+					Capacity:     10,
+					Remaining:    7,
+					StartAt:      1724491800,
+					EndAt:        1724499000,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.slots.Response())
+		})
+	}
+}
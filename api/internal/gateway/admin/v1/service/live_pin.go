@@ -0,0 +1,85 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+type LivePin struct {
+	types.LivePin
+}
+
+type LivePins []*LivePin
+
+type LivePinSales struct {
+	types.LivePinSales
+}
+
+type LivePinSalesList []*LivePinSales
+
+func NewLivePin(pin *entity.LivePin) *LivePin {
+	return &LivePin{
+		LivePin: types.LivePin{
+			ID:             pin.ID,
+			ScheduleID:     pin.ScheduleID,
+			ProductID:      pin.ProductID,
+			AdminID:        pin.AdminID,
+			Pinned:         pin.Pinned(),
+			PinnedAt:       jst.Unix(pin.PinnedAt),
+			PinnedOffset:   pin.PinnedOffset,
+			UnpinnedAt:     jst.Unix(pin.UnpinnedAt),
+			UnpinnedOffset: pin.UnpinnedOffset,
+			CreatedAt:      jst.Unix(pin.CreatedAt),
+			UpdatedAt:      jst.Unix(pin.UpdatedAt),
+		},
+	}
+}
+
+func (p *LivePin) Response() *types.LivePin {
+	return &p.LivePin
+}
+
+func NewLivePins(pins entity.LivePins) LivePins {
+	res := make(LivePins, len(pins))
+	for i := range pins {
+		res[i] = NewLivePin(pins[i])
+	}
+	return res
+}
+
+func (ps LivePins) Response() []*types.LivePin {
+	res := make([]*types.LivePin, len(ps))
+	for i := range ps {
+		res[i] = ps[i].Response()
+	}
+	return res
+}
+
+func NewLivePinSales(livePinID string, order *entity.AggregatedOrderProduct) *LivePinSales {
+	res := &LivePinSales{
+		LivePinSales: types.LivePinSales{
+			LivePinID: livePinID,
+		},
+	}
+	if order == nil {
+		return res
+	}
+	res.OrderCount = order.OrderCount
+	res.UserCount = order.UserCount
+	res.Quantity = order.Quantity
+	res.SalesTotal = order.SalesTotal
+	return res
+}
+
+func (s *LivePinSales) Response() *types.LivePinSales {
+	return &s.LivePinSales
+}
+
+func (ss LivePinSalesList) Response() []*types.LivePinSales {
+	res := make([]*types.LivePinSales, len(ss))
+	for i := range ss {
+		res[i] = ss[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLivePin(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		pin    *entity.LivePin
+		expect *LivePin
+	}{
+		{
+			name: "pinned",
+			pin: &entity.LivePin{
+				ID:           "pin-id",
+				ScheduleID:   "schedule-id",
+				ProductID:    "product-id",
+				AdminID:      "admin-id",
+				PinnedAt:     now,
+				PinnedOffset: 60,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			},
+			expect: &LivePin{
+				LivePin: types.LivePin{
+					ID:             "pin-id",
+					ScheduleID:     "schedule-id",
+					ProductID:      "product-id",
+					AdminID:        "admin-id",
+					Pinned:         true,
+					PinnedAt:       1640962800,
+					PinnedOffset:   60,
+					UnpinnedAt:     0,
+					UnpinnedOffset: 0,
+					CreatedAt:      1640962800,
+					UpdatedAt:      1640962800,
+				},
+			},
+		},
+		{
+			name: "unpinned",
+			pin: &entity.LivePin{
+				ID:             "pin-id",
+				ScheduleID:     "schedule-id",
+				ProductID:      "product-id",
+				AdminID:        "admin-id",
+				PinnedAt:       now,
+				PinnedOffset:   60,
+				UnpinnedAt:     now.Add(90 * time.Second),
+				UnpinnedOffset: 150,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			},
+			expect: &LivePin{
+				LivePin: types.LivePin{
+					ID:             "pin-id",
+					ScheduleID:     "schedule-id",
+					ProductID:      "product-id",
+					AdminID:        "admin-id",
+					Pinned:         false,
+					PinnedAt:       1640962800,
+					PinnedOffset:   60,
+					UnpinnedAt:     1640962890,
+					UnpinnedOffset: 150,
+					CreatedAt:      1640962800,
+					UpdatedAt:      1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewLivePin(tt.pin)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestLivePins(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		pins   entity.LivePins
+		expect []*types.LivePin
+	}{
+		{
+			name: "success",
+			pins: entity.LivePins{
+				{
+					ID:           "pin-id",
+					ScheduleID:   "schedule-id",
+					ProductID:    "product-id",
+					AdminID:      "admin-id",
+					PinnedAt:     now,
+					PinnedOffset: 60,
+					CreatedAt:    now,
+					UpdatedAt:    now,
+				},
+			},
+			expect: []*types.LivePin{
+				{
+					ID:           "pin-id",
+					ScheduleID:   "schedule-id",
+					ProductID:    "product-id",
+					AdminID:      "admin-id",
+					Pinned:       true,
+					PinnedAt:     1640962800,
+					PinnedOffset: 60,
+					CreatedAt:    1640962800,
+					UpdatedAt:    1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewLivePins(tt.pins).Response()
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestLivePinSales(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		pinID  string
+		order  *entity.AggregatedOrderProduct
+		expect *types.LivePinSales
+	}{
+		{
+			name:  "success",
+			pinID: "pin-id",
+			order: &entity.AggregatedOrderProduct{
+				ProductID:     "product-id",
+				OrderCount:    3,
+				UserCount:     2,
+				Quantity:      4,
+				SalesTotal:    6000,
+				DiscountTotal: 100,
+			},
+			expect: &types.LivePinSales{
+				LivePinID:  "pin-id",
+				OrderCount: 3,
+				UserCount:  2,
+				Quantity:   4,
+				SalesTotal: 6000,
+			},
+		},
+		{
+			name:  "empty",
+			pinID: "pin-id",
+			order: nil,
+			expect: &types.LivePinSales{
+				LivePinID: "pin-id",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewLivePinSales(tt.pinID, tt.order).Response()
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -77,6 +77,8 @@ func NewOrderStatus(status entity.OrderStatus) OrderStatus {
 		return OrderStatus(types.OrderStatusRefunded)
 	case entity.OrderStatusFailed:
 		return OrderStatus(types.OrderStatusFailed)
+	case entity.OrderStatusPartiallyRefunded:
+		return OrderStatus(types.OrderStatusPartiallyRefunded)
 	default:
 		return OrderStatus(types.OrderStatusUnknown)
 	}
@@ -110,6 +112,7 @@ func NewOrder(order *entity.Order, addresses map[int64]*Address, products map[in
 			UserID:          order.UserID,
 			CoordinatorID:   order.CoordinatorID,
 			PromotionID:     order.PromotionID,
+			PreorderBatchID: order.PreorderBatchID,
 			ManagementID:    order.ManagementID,
 			ShippingMessage: order.ShippingMessage,
 			Type:            NewOrderType(order.Type).Response(),
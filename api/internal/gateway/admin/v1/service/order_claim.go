@@ -0,0 +1,173 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// OrderClaimType - 返品・交換申請理由
+type OrderClaimType types.OrderClaimType
+
+// OrderClaimStatus - 返品・交換申請の審査状況
+type OrderClaimStatus types.OrderClaimStatus
+
+// OrderClaimResolutionType - 返品・交換申請の対応方法
+type OrderClaimResolutionType types.OrderClaimResolutionType
+
+type OrderClaim struct {
+	types.OrderClaim
+}
+
+type OrderClaims []*OrderClaim
+
+func NewOrderClaimType(typ entity.OrderClaimType) OrderClaimType {
+	switch typ {
+	case entity.OrderClaimTypeSpoiled:
+		return OrderClaimType(types.OrderClaimTypeSpoiled)
+	case entity.OrderClaimTypeDamaged:
+		return OrderClaimType(types.OrderClaimTypeDamaged)
+	case entity.OrderClaimTypeWrongItem:
+		return OrderClaimType(types.OrderClaimTypeWrongItem)
+	case entity.OrderClaimTypeMissing:
+		return OrderClaimType(types.OrderClaimTypeMissing)
+	case entity.OrderClaimTypeOther:
+		return OrderClaimType(types.OrderClaimTypeOther)
+	default:
+		return OrderClaimType(types.OrderClaimTypeUnknown)
+	}
+}
+
+func (t OrderClaimType) StoreEntity() entity.OrderClaimType {
+	switch types.OrderClaimType(t) {
+	case types.OrderClaimTypeSpoiled:
+		return entity.OrderClaimTypeSpoiled
+	case types.OrderClaimTypeDamaged:
+		return entity.OrderClaimTypeDamaged
+	case types.OrderClaimTypeWrongItem:
+		return entity.OrderClaimTypeWrongItem
+	case types.OrderClaimTypeMissing:
+		return entity.OrderClaimTypeMissing
+	case types.OrderClaimTypeOther:
+		return entity.OrderClaimTypeOther
+	default:
+		return entity.OrderClaimTypeUnknown
+	}
+}
+
+func (t OrderClaimType) Response() types.OrderClaimType {
+	return types.OrderClaimType(t)
+}
+
+func NewOrderClaimStatus(status entity.OrderClaimStatus) OrderClaimStatus {
+	switch status {
+	case entity.OrderClaimStatusRequested:
+		return OrderClaimStatus(types.OrderClaimStatusRequested)
+	case entity.OrderClaimStatusApproved:
+		return OrderClaimStatus(types.OrderClaimStatusApproved)
+	case entity.OrderClaimStatusRejected:
+		return OrderClaimStatus(types.OrderClaimStatusRejected)
+	case entity.OrderClaimStatusResolved:
+		return OrderClaimStatus(types.OrderClaimStatusResolved)
+	case entity.OrderClaimStatusResolving:
+		return OrderClaimStatus(types.OrderClaimStatusResolving)
+	default:
+		return OrderClaimStatus(types.OrderClaimStatusUnknown)
+	}
+}
+
+func (s OrderClaimStatus) Response() types.OrderClaimStatus {
+	return types.OrderClaimStatus(s)
+}
+
+func NewOrderClaimResolutionType(typ entity.OrderClaimResolutionType) OrderClaimResolutionType {
+	switch typ {
+	case entity.OrderClaimResolutionTypeRefund:
+		return OrderClaimResolutionType(types.OrderClaimResolutionTypeRefund)
+	case entity.OrderClaimResolutionTypeReshipment:
+		return OrderClaimResolutionType(types.OrderClaimResolutionTypeReshipment)
+	case entity.OrderClaimResolutionTypeCoupon:
+		return OrderClaimResolutionType(types.OrderClaimResolutionTypeCoupon)
+	default:
+		return OrderClaimResolutionType(types.OrderClaimResolutionTypeUnknown)
+	}
+}
+
+func (t OrderClaimResolutionType) StoreEntity() entity.OrderClaimResolutionType {
+	switch types.OrderClaimResolutionType(t) {
+	case types.OrderClaimResolutionTypeRefund:
+		return entity.OrderClaimResolutionTypeRefund
+	case types.OrderClaimResolutionTypeReshipment:
+		return entity.OrderClaimResolutionTypeReshipment
+	case types.OrderClaimResolutionTypeCoupon:
+		return entity.OrderClaimResolutionTypeCoupon
+	default:
+		return entity.OrderClaimResolutionTypeUnknown
+	}
+}
+
+func (t OrderClaimResolutionType) Response() types.OrderClaimResolutionType {
+	return types.OrderClaimResolutionType(t)
+}
+
+func NewOrderClaim(claim *entity.OrderClaim, products map[int64]*Product) *OrderClaim {
+	items := make([]*types.OrderClaimItem, len(claim.Items))
+	for i, item := range claim.Items {
+		var productID string
+		if product, ok := products[item.ProductRevisionID]; ok {
+			productID = product.ID
+		}
+		items[i] = &types.OrderClaimItem{
+			ProductID: productID,
+			Quantity:  item.Quantity,
+		}
+	}
+	imageURLs := claim.ImageURLs
+	if imageURLs == nil {
+		imageURLs = []string{}
+	}
+	return &OrderClaim{
+		OrderClaim: types.OrderClaim{
+			ID:             claim.ID,
+			OrderID:        claim.OrderID,
+			UserID:         claim.UserID,
+			CoordinatorID:  claim.CoordinatorID,
+			Type:           NewOrderClaimType(claim.Type).Response(),
+			Status:         NewOrderClaimStatus(claim.Status).Response(),
+			Description:    claim.Description,
+			Items:          items,
+			ImageURLs:      imageURLs,
+			ReviewerID:     claim.ReviewerID,
+			ReviewComment:  claim.ReviewComment,
+			ResolutionType: NewOrderClaimResolutionType(claim.ResolutionType).Response(),
+			ResolutionNote: claim.ResolutionNote,
+			RefundLineID:   claim.RefundLineID,
+			PromotionID:    claim.PromotionID,
+			FulfillmentID:  claim.FulfillmentID,
+			ReviewedAt:     jst.Unix(claim.ReviewedAt),
+			ResolvedAt:     jst.Unix(claim.ResolvedAt),
+			CreatedAt:      jst.Unix(claim.CreatedAt),
+			UpdatedAt:      jst.Unix(claim.UpdatedAt),
+		},
+	}
+}
+
+func (c *OrderClaim) Response() *types.OrderClaim {
+	return &c.OrderClaim
+}
+
+func NewOrderClaims(claims entity.OrderClaims, products map[int64]*Product) OrderClaims {
+	res := make(OrderClaims, len(claims))
+	for i := range claims {
+		res[i] = NewOrderClaim(claims[i], products)
+	}
+	return res
+}
+
+func (cs OrderClaims) Response() []*types.OrderClaim {
+	res := make([]*types.OrderClaim, len(cs))
+	for i := range cs {
+		res[i] = cs[i].Response()
+	}
+	return res
+}
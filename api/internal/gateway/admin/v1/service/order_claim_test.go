@@ -0,0 +1,129 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderClaimType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    entity.OrderClaimType
+		expect OrderClaimType
+	}{
+		{name: "spoiled", typ: entity.OrderClaimTypeSpoiled, expect: OrderClaimType(types.OrderClaimTypeSpoiled)},
+		{name: "damaged", typ: entity.OrderClaimTypeDamaged, expect: OrderClaimType(types.OrderClaimTypeDamaged)},
+		{name: "wrong item", typ: entity.OrderClaimTypeWrongItem, expect: OrderClaimType(types.OrderClaimTypeWrongItem)},
+		{name: "missing", typ: entity.OrderClaimTypeMissing, expect: OrderClaimType(types.OrderClaimTypeMissing)},
+		{name: "other", typ: entity.OrderClaimTypeOther, expect: OrderClaimType(types.OrderClaimTypeOther)},
+		{name: "unknown", typ: entity.OrderClaimTypeUnknown, expect: OrderClaimType(types.OrderClaimTypeUnknown)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewOrderClaimType(tt.typ)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.typ, actual.StoreEntity())
+		})
+	}
+}
+
+func TestOrderClaimStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.OrderClaimStatus
+		expect types.OrderClaimStatus
+	}{
+		{name: "requested", status: entity.OrderClaimStatusRequested, expect: types.OrderClaimStatusRequested},
+		{name: "approved", status: entity.OrderClaimStatusApproved, expect: types.OrderClaimStatusApproved},
+		{name: "rejected", status: entity.OrderClaimStatusRejected, expect: types.OrderClaimStatusRejected},
+		{name: "resolved", status: entity.OrderClaimStatusResolved, expect: types.OrderClaimStatusResolved},
+		{name: "resolving", status: entity.OrderClaimStatusResolving, expect: types.OrderClaimStatusResolving},
+		{name: "unknown", status: entity.OrderClaimStatusUnknown, expect: types.OrderClaimStatusUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewOrderClaimStatus(tt.status).Response())
+		})
+	}
+}
+
+func TestOrderClaimResolutionType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    entity.OrderClaimResolutionType
+		expect types.OrderClaimResolutionType
+	}{
+		{name: "refund", typ: entity.OrderClaimResolutionTypeRefund, expect: types.OrderClaimResolutionTypeRefund},
+		{name: "reshipment", typ: entity.OrderClaimResolutionTypeReshipment, expect: types.OrderClaimResolutionTypeReshipment},
+		{name: "coupon", typ: entity.OrderClaimResolutionTypeCoupon, expect: types.OrderClaimResolutionTypeCoupon},
+		{name: "unknown", typ: entity.OrderClaimResolutionTypeUnknown, expect: types.OrderClaimResolutionTypeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewOrderClaimResolutionType(tt.typ)
+			assert.Equal(t, tt.expect, actual.Response())
+			assert.Equal(t, tt.typ, actual.StoreEntity())
+		})
+	}
+}
+
+func TestOrderClaims(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	claims := entity.OrderClaims{
+		{
+			ID:             "claim-id",
+			OrderID:        "order-id",
+			UserID:         "user-id",
+			ShopID:         "shop-id",
+			CoordinatorID:  "coordinator-id",
+			Type:           entity.OrderClaimTypeDamaged,
+			Status:         entity.OrderClaimStatusResolved,
+			Description:    "箱が潰れて中身が割れていました。",
+			Items:          entity.OrderClaimItems{{ProductRevisionID: 1, Quantity: 2}},
+			ReviewerID:     "admin-id",
+			ReviewComment:  "ご迷惑をおかけしました。",
+			ResolutionType: entity.OrderClaimResolutionTypeRefund,
+			RefundLineID:   "refund-line-id",
+			ReviewedAt:     now,
+			ResolvedAt:     now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+	}
+	products := map[int64]*Product{
+		1: {Product: types.Product{ID: "product-id"}, revisionID: 1},
+	}
+	expect := []*types.OrderClaim{
+		{
+			ID:             "claim-id",
+			OrderID:        "order-id",
+			UserID:         "user-id",
+			CoordinatorID:  "coordinator-id",
+			Type:           types.OrderClaimTypeDamaged,
+			Status:         types.OrderClaimStatusResolved,
+			Description:    "箱が潰れて中身が割れていました。",
+			Items:          []*types.OrderClaimItem{{ProductID: "product-id", Quantity: 2}},
+			ImageURLs:      []string{},
+			ReviewerID:     "admin-id",
+			ReviewComment:  "ご迷惑をおかけしました。",
+			ResolutionType: types.OrderClaimResolutionTypeRefund,
+			RefundLineID:   "refund-line-id",
+			ReviewedAt:     now.Unix(),
+			ResolvedAt:     now.Unix(),
+			CreatedAt:      now.Unix(),
+			UpdatedAt:      now.Unix(),
+		},
+	}
+	assert.Equal(t, expect, NewOrderClaims(claims, products).Response())
+}
@@ -31,6 +31,8 @@ func NewFulfillmentStatus(status entity.FulfillmentStatus) FulfillmentStatus {
 		return FulfillmentStatus(types.FulfillmentStatusUnfulfilled)
 	case entity.FulfillmentStatusFulfilled:
 		return FulfillmentStatus(types.FulfillmentStatusFulfilled)
+	case entity.FulfillmentStatusDelivered:
+		return FulfillmentStatus(types.FulfillmentStatusDelivered)
 	default:
 		return FulfillmentStatus(types.FulfillmentStatusUnknown)
 	}
@@ -46,6 +48,8 @@ func NewShippingCarrier(carrier entity.ShippingCarrier) ShippingCarrier {
 		return ShippingCarrier(types.ShippingCarrierYamato)
 	case entity.ShippingCarrierSagawa:
 		return ShippingCarrier(types.ShippingCarrierSagawa)
+	case entity.ShippingCarrierJapanPost:
+		return ShippingCarrier(types.ShippingCarrierJapanPost)
 	default:
 		return ShippingCarrier(types.ShippingCarrierUnknown)
 	}
@@ -63,6 +67,12 @@ func NewShippingSize(size entity.ShippingSize) ShippingSize {
 		return ShippingSize(types.ShippingSize80)
 	case entity.ShippingSize100:
 		return ShippingSize(types.ShippingSize100)
+	case entity.ShippingSize120:
+		return ShippingSize(types.ShippingSize120)
+	case entity.ShippingSize140:
+		return ShippingSize(types.ShippingSize140)
+	case entity.ShippingSize160:
+		return ShippingSize(types.ShippingSize160)
 	default:
 		return ShippingSize(types.ShippingSizeUnknown)
 	}
@@ -85,23 +95,43 @@ func NewShippingType(typ entity.ShippingType) ShippingType {
 	}
 }
 
+func (t ShippingType) StoreEntity() entity.ShippingType {
+	switch types.ShippingType(t) {
+	case types.ShippingTypeNormal:
+		return entity.ShippingTypeNormal
+	case types.ShippingTypeFrozen:
+		return entity.ShippingTypeFrozen
+	case types.ShippingTypePickup:
+		return entity.ShippingTypePickup
+	default:
+		return entity.ShippingTypeUnknown
+	}
+}
+
 func (t ShippingType) Response() types.ShippingType {
 	return types.ShippingType(t)
 }
 
 func NewOrderFulfillment(fulfillment *entity.OrderFulfillment, address *Address) *OrderFulfillment {
+	var deliveryDate string
+	if !fulfillment.DeliveryDate.IsZero() {
+		deliveryDate = jst.FormatYYYYMMDD(fulfillment.DeliveryDate)
+	}
 	return &OrderFulfillment{
 		OrderFulfillment: types.OrderFulfillment{
-			FulfillmentID:   fulfillment.ID,
-			TrackingNumber:  fulfillment.TrackingNumber,
-			Status:          NewFulfillmentStatus(fulfillment.Status).Response(),
-			ShippingCarrier: NewShippingCarrier(fulfillment.ShippingCarrier).Response(),
-			ShippingType:    NewShippingType(fulfillment.ShippingType).Response(),
-			BoxNumber:       fulfillment.BoxNumber,
-			BoxSize:         NewShippingSize(fulfillment.BoxSize).Response(),
-			BoxRate:         fulfillment.BoxRate,
-			ShippedAt:       jst.Unix(fulfillment.ShippedAt),
-			Address:         address.Response(),
+			FulfillmentID:      fulfillment.ID,
+			TrackingNumber:     fulfillment.TrackingNumber,
+			Status:             NewFulfillmentStatus(fulfillment.Status).Response(),
+			ShippingCarrier:    NewShippingCarrier(fulfillment.ShippingCarrier).Response(),
+			ShippingType:       NewShippingType(fulfillment.ShippingType).Response(),
+			BoxNumber:          fulfillment.BoxNumber,
+			BoxSize:            NewShippingSize(fulfillment.BoxSize).Response(),
+			BoxRate:            fulfillment.BoxRate,
+			ShippedAt:          jst.Unix(fulfillment.ShippedAt),
+			DeliveredAt:        jst.Unix(fulfillment.DeliveredAt),
+			DeliveryDate:       deliveryDate,
+			DeliveryTimeWindow: NewDeliveryTimeWindow(fulfillment.DeliveryTimeWindow).Response(),
+			Address:            address.Response(),
 		},
 		orderID: fulfillment.OrderID,
 	}
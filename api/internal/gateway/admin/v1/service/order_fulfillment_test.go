@@ -26,6 +26,11 @@ func TestFulfillmentStatus(t *testing.T) {
 			status: entity.FulfillmentStatusFulfilled,
 			expect: FulfillmentStatus(types.FulfillmentStatusFulfilled),
 		},
+		{
+			name:   "delivered",
+			status: entity.FulfillmentStatusDelivered,
+			expect: FulfillmentStatus(types.FulfillmentStatusDelivered),
+		},
 		{
 			name:   "unknown",
 			status: entity.FulfillmentStatusUnknown,
@@ -78,6 +83,11 @@ func TestShippingCarrier(t *testing.T) {
 			status: entity.ShippingCarrierSagawa,
 			expect: ShippingCarrier(types.ShippingCarrierSagawa),
 		},
+		{
+			name:   "japan post",
+			status: entity.ShippingCarrierJapanPost,
+			expect: ShippingCarrier(types.ShippingCarrierJapanPost),
+		},
 		{
 			name:   "unknown",
 			status: entity.ShippingCarrierUnknown,
@@ -135,6 +145,21 @@ func TestShippingSize(t *testing.T) {
 			status: entity.ShippingSize100,
 			expect: ShippingSize(types.ShippingSize100),
 		},
+		{
+			name:   "size 120",
+			status: entity.ShippingSize120,
+			expect: ShippingSize(types.ShippingSize120),
+		},
+		{
+			name:   "size 140",
+			status: entity.ShippingSize140,
+			expect: ShippingSize(types.ShippingSize140),
+		},
+		{
+			name:   "size 160",
+			status: entity.ShippingSize160,
+			expect: ShippingSize(types.ShippingSize160),
+		},
 		{
 			name:   "unknown",
 			status: entity.ShippingSizeUnknown,
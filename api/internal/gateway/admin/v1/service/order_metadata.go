@@ -17,6 +17,10 @@ func NewOrderMetadata(metadata *entity.OrderMetadata) *OrderMetadata {
 			OrderRequest:   metadata.OrderRequest,
 			PickupAt:       jst.Unix(metadata.PickupAt),
 			PickupLocation: metadata.PickupLocation,
+			Gift:           metadata.Gift,
+			NoshiType:      int32(metadata.NoshiType),
+			NoshiName:      metadata.NoshiName,
+			MessageCard:    metadata.MessageCard,
 		},
 		orderID: metadata.OrderID,
 	}
@@ -65,6 +65,26 @@ func TestNewOrderMetadata(t *testing.T) {
 				orderID: "order-id",
 			},
 		},
+		{
+			name: "gift",
+			metadata: &entity.OrderMetadata{
+				OrderID:     "order-id",
+				Gift:        true,
+				NoshiType:   entity.NoshiTypeOseibo,
+				NoshiName:   "山田",
+				MessageCard: "いつもありがとうございます。",
+			},
+			expect: &OrderMetadata{
+				OrderMetadata: types.OrderMetadata{
+					PickupAt:    0,
+					Gift:        true,
+					NoshiType:   int32(entity.NoshiTypeOseibo),
+					NoshiName:   "山田",
+					MessageCard: "いつもありがとうございます。",
+				},
+				orderID: "order-id",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -91,7 +91,7 @@ func NewPaymentStatus(status entity.PaymentStatus) PaymentStatus {
 		return PaymentStatus(types.PaymentStatusUnpaid)
 	case entity.PaymentStatusAuthorized:
 		return PaymentStatus(types.PaymentStatusAuthorized)
-	case entity.PaymentStatusCaptured:
+	case entity.PaymentStatusCaptured, entity.PaymentStatusPartiallyRefunded:
 		return PaymentStatus(types.PaymentStatusPaid)
 	case entity.PaymentStatusCanceled, entity.PaymentStatusRefunded:
 		return PaymentStatus(types.PaymentStatusCanceled)
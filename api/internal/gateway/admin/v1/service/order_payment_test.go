@@ -215,6 +215,11 @@ func TestPaymentStatus(t *testing.T) {
 			status: entity.PaymentStatusCaptured,
 			expect: PaymentStatus(types.PaymentStatusPaid),
 		},
+		{
+			name:   "partially refunded",
+			status: entity.PaymentStatusPartiallyRefunded,
+			expect: PaymentStatus(types.PaymentStatusPaid),
+		},
 		{
 			name:   "canceled",
 			status: entity.PaymentStatusCanceled,
@@ -16,6 +16,18 @@ type OrderRefund struct {
 
 type OrderRefunds []*OrderRefund
 
+// OrderRefundLineType - 返金種別
+type OrderRefundLineType types.OrderRefundLineType
+
+// OrderRefundLineStatus - 返金処理状況
+type OrderRefundLineStatus types.OrderRefundLineStatus
+
+type OrderRefundLine struct {
+	types.OrderRefundLine
+}
+
+type OrderRefundLines []*OrderRefundLine
+
 func NewRefundType(typ entity.RefundType) RefundType {
 	switch typ {
 	case entity.RefundTypeCanceled:
@@ -69,3 +81,86 @@ func NewOrderRefunds(payments entity.OrderPayments) OrderRefunds {
 	}
 	return res
 }
+
+func NewOrderRefundLineType(typ entity.OrderRefundLineType) OrderRefundLineType {
+	switch typ {
+	case entity.OrderRefundLineTypeFull:
+		return OrderRefundLineType(types.OrderRefundLineTypeFull)
+	case entity.OrderRefundLineTypeItem:
+		return OrderRefundLineType(types.OrderRefundLineTypeItem)
+	case entity.OrderRefundLineTypeAmount:
+		return OrderRefundLineType(types.OrderRefundLineTypeAmount)
+	default:
+		return OrderRefundLineType(types.OrderRefundLineTypeUnknown)
+	}
+}
+
+func (t OrderRefundLineType) Response() types.OrderRefundLineType {
+	return types.OrderRefundLineType(t)
+}
+
+func NewOrderRefundLineStatus(status entity.OrderRefundLineStatus) OrderRefundLineStatus {
+	switch status {
+	case entity.OrderRefundLineStatusPending:
+		return OrderRefundLineStatus(types.OrderRefundLineStatusPending)
+	case entity.OrderRefundLineStatusSucceeded:
+		return OrderRefundLineStatus(types.OrderRefundLineStatusSucceeded)
+	case entity.OrderRefundLineStatusFailed:
+		return OrderRefundLineStatus(types.OrderRefundLineStatusFailed)
+	default:
+		return OrderRefundLineStatus(types.OrderRefundLineStatusUnknown)
+	}
+}
+
+func (s OrderRefundLineStatus) Response() types.OrderRefundLineStatus {
+	return types.OrderRefundLineStatus(s)
+}
+
+func NewOrderRefundLine(line *entity.OrderRefundLine, products map[int64]*Product) *OrderRefundLine {
+	items := make([]*types.OrderRefundLineItem, len(line.Items))
+	for i, item := range line.Items {
+		var productID string
+		if product, ok := products[item.ProductRevisionID]; ok {
+			productID = product.ID
+		}
+		items[i] = &types.OrderRefundLineItem{
+			ProductID: productID,
+			Quantity:  item.Quantity,
+			Amount:    item.Amount,
+		}
+	}
+	return &OrderRefundLine{
+		OrderRefundLine: types.OrderRefundLine{
+			ID:         line.ID,
+			OrderID:    line.OrderID,
+			Type:       NewOrderRefundLineType(line.Type).Response(),
+			Status:     NewOrderRefundLineStatus(line.Status).Response(),
+			Amount:     line.Amount,
+			Reason:     line.Reason,
+			Items:      items,
+			RefundedAt: jst.Unix(line.RefundedAt),
+			CreatedAt:  jst.Unix(line.CreatedAt),
+			UpdatedAt:  jst.Unix(line.UpdatedAt),
+		},
+	}
+}
+
+func (l *OrderRefundLine) Response() *types.OrderRefundLine {
+	return &l.OrderRefundLine
+}
+
+func NewOrderRefundLines(lines entity.OrderRefundLines, products map[int64]*Product) OrderRefundLines {
+	res := make(OrderRefundLines, len(lines))
+	for i := range lines {
+		res[i] = NewOrderRefundLine(lines[i], products)
+	}
+	return res
+}
+
+func (ls OrderRefundLines) Response() []*types.OrderRefundLine {
+	res := make([]*types.OrderRefundLine, len(ls))
+	for i := range ls {
+		res[i] = ls[i].Response()
+	}
+	return res
+}
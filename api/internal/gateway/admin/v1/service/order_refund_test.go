@@ -203,3 +203,136 @@ func TestOrderRefunds(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderRefundLineType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    entity.OrderRefundLineType
+		expect OrderRefundLineType
+	}{
+		{
+			name:   "full",
+			typ:    entity.OrderRefundLineTypeFull,
+			expect: OrderRefundLineType(types.OrderRefundLineTypeFull),
+		},
+		{
+			name:   "item",
+			typ:    entity.OrderRefundLineTypeItem,
+			expect: OrderRefundLineType(types.OrderRefundLineTypeItem),
+		},
+		{
+			name:   "amount",
+			typ:    entity.OrderRefundLineTypeAmount,
+			expect: OrderRefundLineType(types.OrderRefundLineTypeAmount),
+		},
+		{
+			name:   "unknown",
+			typ:    entity.OrderRefundLineTypeUnknown,
+			expect: OrderRefundLineType(types.OrderRefundLineTypeUnknown),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewOrderRefundLineType(tt.typ))
+		})
+	}
+}
+
+func TestOrderRefundLineStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.OrderRefundLineStatus
+		expect OrderRefundLineStatus
+	}{
+		{
+			name:   "pending",
+			status: entity.OrderRefundLineStatusPending,
+			expect: OrderRefundLineStatus(types.OrderRefundLineStatusPending),
+		},
+		{
+			name:   "succeeded",
+			status: entity.OrderRefundLineStatusSucceeded,
+			expect: OrderRefundLineStatus(types.OrderRefundLineStatusSucceeded),
+		},
+		{
+			name:   "failed",
+			status: entity.OrderRefundLineStatusFailed,
+			expect: OrderRefundLineStatus(types.OrderRefundLineStatusFailed),
+		},
+		{
+			name:   "unknown",
+			status: entity.OrderRefundLineStatusUnknown,
+			expect: OrderRefundLineStatus(types.OrderRefundLineStatusUnknown),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewOrderRefundLineStatus(tt.status))
+		})
+	}
+}
+
+func TestOrderRefundLines(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	products := map[int64]*Product{
+		1: {
+			Product:    types.Product{ID: "product-id"},
+			revisionID: 1,
+		},
+	}
+	tests := []struct {
+		name     string
+		lines    entity.OrderRefundLines
+		products map[int64]*Product
+		expect   []*types.OrderRefundLine
+	}{
+		{
+			name: "success",
+			lines: entity.OrderRefundLines{
+				{
+					ID:      "line-id",
+					OrderID: "order-id",
+					Type:    entity.OrderRefundLineTypeItem,
+					Status:  entity.OrderRefundLineStatusSucceeded,
+					Amount:  800,
+					Reason:  "商品破損のため",
+					Items: entity.OrderRefundLineItems{
+						{ProductRevisionID: 1, Quantity: 2, Amount: 800},
+					},
+					RefundedAt: now,
+					CreatedAt:  now,
+					UpdatedAt:  now,
+				},
+			},
+			products: products,
+			expect: []*types.OrderRefundLine{
+				{
+					ID:      "line-id",
+					OrderID: "order-id",
+					Type:    types.OrderRefundLineTypeItem,
+					Status:  types.OrderRefundLineStatusSucceeded,
+					Amount:  800,
+					Reason:  "商品破損のため",
+					Items: []*types.OrderRefundLineItem{
+						{ProductID: "product-id", Quantity: 2, Amount: 800},
+					},
+					RefundedAt: 1640962800,
+					CreatedAt:  1640962800,
+					UpdatedAt:  1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewOrderRefundLines(tt.lines, tt.products)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+)
+
+// UnmatchedShipmentReason - 送り状発行結果の照合失敗理由
+type UnmatchedShipmentReason types.UnmatchedShipmentReason
+
+type UnmatchedShipment struct {
+	types.UnmatchedShipment
+}
+
+type UnmatchedShipments []*UnmatchedShipment
+
+func NewUnmatchedShipmentReason(reason entity.UnmatchedShipmentReason) UnmatchedShipmentReason {
+	switch reason {
+	case entity.UnmatchedShipmentReasonEmptyManagementID:
+		return UnmatchedShipmentReason(types.UnmatchedShipmentReasonEmptyManagementID)
+	case entity.UnmatchedShipmentReasonEmptyTrackingNumber:
+		return UnmatchedShipmentReason(types.UnmatchedShipmentReasonEmptyTrackingNumber)
+	case entity.UnmatchedShipmentReasonOrderNotFound:
+		return UnmatchedShipmentReason(types.UnmatchedShipmentReasonOrderNotFound)
+	case entity.UnmatchedShipmentReasonNotShippable:
+		return UnmatchedShipmentReason(types.UnmatchedShipmentReasonNotShippable)
+	case entity.UnmatchedShipmentReasonNoFulfillment:
+		return UnmatchedShipmentReason(types.UnmatchedShipmentReasonNoFulfillment)
+	default:
+		return UnmatchedShipmentReason(types.UnmatchedShipmentReasonUnknown)
+	}
+}
+
+func (r UnmatchedShipmentReason) Response() types.UnmatchedShipmentReason {
+	return types.UnmatchedShipmentReason(r)
+}
+
+func NewUnmatchedShipment(shipment *entity.UnmatchedShipment) *UnmatchedShipment {
+	return &UnmatchedShipment{
+		UnmatchedShipment: types.UnmatchedShipment{
+			Line:           shipment.Line,
+			ManagementID:   shipment.ManagementID,
+			TrackingNumber: shipment.TrackingNumber,
+			Reason:         NewUnmatchedShipmentReason(shipment.Reason).Response(),
+		},
+	}
+}
+
+func (s *UnmatchedShipment) Response() *types.UnmatchedShipment {
+	return &s.UnmatchedShipment
+}
+
+func NewUnmatchedShipments(shipments entity.UnmatchedShipments) UnmatchedShipments {
+	res := make(UnmatchedShipments, len(shipments))
+	for i := range shipments {
+		res[i] = NewUnmatchedShipment(shipments[i])
+	}
+	return res
+}
+
+func (ss UnmatchedShipments) Response() []*types.UnmatchedShipment {
+	res := make([]*types.UnmatchedShipment, len(ss))
+	for i := range ss {
+		res[i] = ss[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmatchedShipmentReason(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		reason entity.UnmatchedShipmentReason
+		expect types.UnmatchedShipmentReason
+	}{
+		{name: "empty management id", reason: entity.UnmatchedShipmentReasonEmptyManagementID, expect: types.UnmatchedShipmentReasonEmptyManagementID},
+		{name: "empty tracking number", reason: entity.UnmatchedShipmentReasonEmptyTrackingNumber, expect: types.UnmatchedShipmentReasonEmptyTrackingNumber},
+		{name: "order not found", reason: entity.UnmatchedShipmentReasonOrderNotFound, expect: types.UnmatchedShipmentReasonOrderNotFound},
+		{name: "not shippable", reason: entity.UnmatchedShipmentReasonNotShippable, expect: types.UnmatchedShipmentReasonNotShippable},
+		{name: "no fulfillment", reason: entity.UnmatchedShipmentReasonNoFulfillment, expect: types.UnmatchedShipmentReasonNoFulfillment},
+		{name: "unknown", reason: entity.UnmatchedShipmentReasonUnknown, expect: types.UnmatchedShipmentReasonUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewUnmatchedShipmentReason(tt.reason).Response())
+		})
+	}
+}
+
+func TestUnmatchedShipments(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		shipments entity.UnmatchedShipments
+		expect    []*types.UnmatchedShipment
+	}{
+		{
+			name: "success",
+			shipments: entity.UnmatchedShipments{
+				{
+					Line:           2,
+					ManagementID:   "order-id",
+					TrackingNumber: "1234-5678-9012",
+					Reason:         entity.UnmatchedShipmentReasonOrderNotFound,
+				},
+			},
+			expect: []*types.UnmatchedShipment{
+				{
+					Line:           2,
+					ManagementID:   "order-id",
+					TrackingNumber: "1234-5678-9012",
+					Reason:         types.UnmatchedShipmentReasonOrderNotFound,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewUnmatchedShipments(tt.shipments)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
@@ -157,6 +157,11 @@ func TestNewOrderStatus(t *testing.T) {
 			status: entity.OrderStatusFailed,
 			expect: types.OrderStatusFailed,
 		},
+		{
+			name:   "partially refunded",
+			status: entity.OrderStatusPartiallyRefunded,
+			expect: types.OrderStatusPartiallyRefunded,
+		},
 		{
 			name:   "unknown",
 			status: entity.OrderStatusUnknown,
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// PaymentEventStatus - 決済イベントの処理状況
+type PaymentEventStatus types.PaymentEventStatus
+
+type PaymentEvent struct {
+	types.PaymentEvent
+}
+
+type PaymentEvents []*PaymentEvent
+
+func NewPaymentEventStatus(status entity.PaymentEventStatus) PaymentEventStatus {
+	switch status {
+	case entity.PaymentEventStatusPending:
+		return PaymentEventStatus(types.PaymentEventStatusPending)
+	case entity.PaymentEventStatusSucceeded:
+		return PaymentEventStatus(types.PaymentEventStatusSucceeded)
+	case entity.PaymentEventStatusFailed:
+		return PaymentEventStatus(types.PaymentEventStatusFailed)
+	case entity.PaymentEventStatusIgnored:
+		return PaymentEventStatus(types.PaymentEventStatusIgnored)
+	case entity.PaymentEventStatusProcessing:
+		return PaymentEventStatus(types.PaymentEventStatusProcessing)
+	case entity.PaymentEventStatusRejected:
+		return PaymentEventStatus(types.PaymentEventStatusRejected)
+	default:
+		return PaymentEventStatus(types.PaymentEventStatusUnknown)
+	}
+}
+
+func (s PaymentEventStatus) StoreEntity() entity.PaymentEventStatus {
+	switch types.PaymentEventStatus(s) {
+	case types.PaymentEventStatusPending:
+		return entity.PaymentEventStatusPending
+	case types.PaymentEventStatusSucceeded:
+		return entity.PaymentEventStatusSucceeded
+	case types.PaymentEventStatusFailed:
+		return entity.PaymentEventStatusFailed
+	case types.PaymentEventStatusIgnored:
+		return entity.PaymentEventStatusIgnored
+	case types.PaymentEventStatusProcessing:
+		return entity.PaymentEventStatusProcessing
+	case types.PaymentEventStatusRejected:
+		return entity.PaymentEventStatusRejected
+	default:
+		return entity.PaymentEventStatusUnknown
+	}
+}
+
+func (s PaymentEventStatus) Response() types.PaymentEventStatus {
+	return types.PaymentEventStatus(s)
+}
+
+func NewPaymentEvent(event *entity.PaymentEvent) *PaymentEvent {
+	return &PaymentEvent{
+		PaymentEvent: types.PaymentEvent{
+			ID:                event.ID,
+			ProviderType:      NewPaymentProviderType(event.ProviderType).Response(),
+			EventID:           event.EventID,
+			EventType:         event.EventType,
+			Payload:           string(event.Payload),
+			SignatureVerified: event.SignatureVerified,
+			Status:            NewPaymentEventStatus(event.Status).Response(),
+			ErrorMessage:      event.ErrorMessage,
+			AttemptCount:      event.AttemptCount,
+			ProcessedAt:       jst.Unix(event.ProcessedAt),
+			CreatedAt:         jst.Unix(event.CreatedAt),
+			UpdatedAt:         jst.Unix(event.UpdatedAt),
+		},
+	}
+}
+
+func (e *PaymentEvent) Response() *types.PaymentEvent {
+	return &e.PaymentEvent
+}
+
+func NewPaymentEvents(events entity.PaymentEvents) PaymentEvents {
+	res := make(PaymentEvents, len(events))
+	for i := range events {
+		res[i] = NewPaymentEvent(events[i])
+	}
+	return res
+}
+
+func (es PaymentEvents) Response() []*types.PaymentEvent {
+	res := make([]*types.PaymentEvent, len(es))
+	for i := range es {
+		res[i] = es[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentEventStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.PaymentEventStatus
+		expect PaymentEventStatus
+	}{
+		{name: "pending", status: entity.PaymentEventStatusPending, expect: PaymentEventStatus(types.PaymentEventStatusPending)},
+		{name: "succeeded", status: entity.PaymentEventStatusSucceeded, expect: PaymentEventStatus(types.PaymentEventStatusSucceeded)},
+		{name: "failed", status: entity.PaymentEventStatusFailed, expect: PaymentEventStatus(types.PaymentEventStatusFailed)},
+		{name: "ignored", status: entity.PaymentEventStatusIgnored, expect: PaymentEventStatus(types.PaymentEventStatusIgnored)},
+		{name: "processing", status: entity.PaymentEventStatusProcessing, expect: PaymentEventStatus(types.PaymentEventStatusProcessing)},
+		{name: "rejected", status: entity.PaymentEventStatusRejected, expect: PaymentEventStatus(types.PaymentEventStatusRejected)},
+		{name: "unknown", status: entity.PaymentEventStatusUnknown, expect: PaymentEventStatus(types.PaymentEventStatusUnknown)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPaymentEventStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.status, actual.StoreEntity())
+		})
+	}
+}
+
+func TestPaymentEvents(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		events entity.PaymentEvents
+		expect []*types.PaymentEvent
+	}{
+		{
+			name: "success",
+			events: entity.PaymentEvents{
+				{
+					ID:                "payment-event-id",
+					ProviderType:      entity.PaymentProviderTypeStripe,
+					EventID:           "evt_test",
+					EventType:         "payment_intent.succeeded",
+					Payload:           []byte(`{"id":"evt_test"}`),
+					SignatureVerified: true,
+					Status:            entity.PaymentEventStatusFailed,
+					ErrorMessage:      "not found",
+					AttemptCount:      2,
+					ProcessedAt:       now,
+					CreatedAt:         now,
+					UpdatedAt:         now,
+				},
+			},
+			expect: []*types.PaymentEvent{
+				{
+					ID:                "payment-event-id",
+					ProviderType:      types.PaymentProviderTypeStripe,
+					EventID:           "evt_test",
+					EventType:         "payment_intent.succeeded",
+					Payload:           `{"id":"evt_test"}`,
+					SignatureVerified: true,
+					Status:            types.PaymentEventStatusFailed,
+					ErrorMessage:      "not found",
+					AttemptCount:      2,
+					ProcessedAt:       now.Unix(),
+					CreatedAt:         now.Unix(),
+					UpdatedAt:         now.Unix(),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPaymentEvents(tt.events)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
@@ -76,6 +76,7 @@ func NewPaymentSystem(system *entity.PaymentSystem) *PaymentSystem {
 			MethodType:   NewPaymentMethodType(system.MethodType).Response(),
 			ProviderType: NewPaymentProviderType(system.ProviderType).Response(),
 			Status:       NewPaymentSystemStatus(system.Status).Response(),
+			FeeRate:      system.FeeRate,
 			CreatedAt:    jst.Unix(system.CreatedAt),
 			UpdatedAt:    jst.Unix(system.UpdatedAt),
 		},
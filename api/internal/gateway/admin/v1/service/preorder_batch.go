@@ -0,0 +1,77 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// PreorderBatchStatus - 予約注文バッチの状態
+type PreorderBatchStatus types.PreorderBatchStatus
+
+type PreorderBatch struct {
+	types.PreorderBatch
+}
+
+type PreorderBatches []*PreorderBatch
+
+func NewPreorderBatchStatus(status entity.PreorderBatchStatus) PreorderBatchStatus {
+	switch status {
+	case entity.PreorderBatchStatusAccepting:
+		return PreorderBatchStatus(types.PreorderBatchStatusAccepting)
+	case entity.PreorderBatchStatusReady:
+		return PreorderBatchStatus(types.PreorderBatchStatusReady)
+	default:
+		return PreorderBatchStatus(types.PreorderBatchStatusUnknown)
+	}
+}
+
+func (s PreorderBatchStatus) StoreEntity() entity.PreorderBatchStatus {
+	switch types.PreorderBatchStatus(s) {
+	case types.PreorderBatchStatusAccepting:
+		return entity.PreorderBatchStatusAccepting
+	case types.PreorderBatchStatusReady:
+		return entity.PreorderBatchStatusReady
+	default:
+		return entity.PreorderBatchStatusUnknown
+	}
+}
+
+func (s PreorderBatchStatus) Response() types.PreorderBatchStatus {
+	return types.PreorderBatchStatus(s)
+}
+
+func NewPreorderBatch(batch *entity.PreorderBatch) *PreorderBatch {
+	return &PreorderBatch{
+		PreorderBatch: types.PreorderBatch{
+			ID:                      batch.ID,
+			CoordinatorID:           batch.CoordinatorID,
+			Status:                  NewPreorderBatchStatus(batch.Status).Response(),
+			ExpectedShippingStartAt: jst.Unix(batch.ExpectedShippingStartAt),
+			ExpectedShippingEndAt:   jst.Unix(batch.ExpectedShippingEndAt),
+			ReadyAt:                 jst.Unix(batch.ReadyAt),
+			CreatedAt:               jst.Unix(batch.CreatedAt),
+			UpdatedAt:               jst.Unix(batch.UpdatedAt),
+		},
+	}
+}
+
+func (b *PreorderBatch) Response() *types.PreorderBatch {
+	return &b.PreorderBatch
+}
+
+func NewPreorderBatches(batches entity.PreorderBatches) PreorderBatches {
+	res := make(PreorderBatches, len(batches))
+	for i := range batches {
+		res[i] = NewPreorderBatch(batches[i])
+	}
+	return res
+}
+
+func (bs PreorderBatches) Response() []*types.PreorderBatch {
+	res := make([]*types.PreorderBatch, len(bs))
+	for i := range bs {
+		res[i] = bs[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreorderBatchStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.PreorderBatchStatus
+		expect PreorderBatchStatus
+	}{
+		{name: "accepting", status: entity.PreorderBatchStatusAccepting, expect: PreorderBatchStatus(types.PreorderBatchStatusAccepting)},
+		{name: "ready", status: entity.PreorderBatchStatusReady, expect: PreorderBatchStatus(types.PreorderBatchStatusReady)},
+		{name: "unknown", status: entity.PreorderBatchStatusUnknown, expect: PreorderBatchStatus(types.PreorderBatchStatusUnknown)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPreorderBatchStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.status, actual.StoreEntity())
+		})
+	}
+}
+
+func TestPreorderBatches(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	tests := []struct {
+		name    string
+		batches entity.PreorderBatches
+		expect  []*types.PreorderBatch
+	}{
+		{
+			name: "success",
+			batches: entity.PreorderBatches{
+				{
+					ID:                      "batch-id",
+					ShopID:                  "shop-id",
+					CoordinatorID:           "coordinator-id",
+					Status:                  entity.PreorderBatchStatusReady,
+					ExpectedShippingStartAt: now.AddDate(0, 1, 0),
+					ExpectedShippingEndAt:   now.AddDate(0, 1, 7),
+					ReadyAt:                 now,
+					CreatedAt:               now,
+					UpdatedAt:               now,
+				},
+				{
+					ID:                      "batch-id2",
+					ShopID:                  "shop-id",
+					CoordinatorID:           "coordinator-id",
+					Status:                  entity.PreorderBatchStatusAccepting,
+					ExpectedShippingStartAt: now.AddDate(0, 2, 0),
+					ExpectedShippingEndAt:   now.AddDate(0, 2, 7),
+					CreatedAt:               now,
+					UpdatedAt:               now,
+				},
+			},
+			expect: []*types.PreorderBatch{
+				{
+					ID:                      "batch-id",
+					CoordinatorID:           "coordinator-id",
+					Status:                  types.PreorderBatchStatusReady,
+					ExpectedShippingStartAt: now.AddDate(0, 1, 0).Unix(),
+					ExpectedShippingEndAt:   now.AddDate(0, 1, 7).Unix(),
+					ReadyAt:                 now.Unix(),
+					CreatedAt:               now.Unix(),
+					UpdatedAt:               now.Unix(),
+				},
+				{
+					ID:                      "batch-id2",
+					CoordinatorID:           "coordinator-id",
+					Status:                  types.PreorderBatchStatusAccepting,
+					ExpectedShippingStartAt: now.AddDate(0, 2, 0).Unix(),
+					ExpectedShippingEndAt:   now.AddDate(0, 2, 7).Unix(),
+					ReadyAt:                 0,
+					CreatedAt:               now.Unix(),
+					UpdatedAt:               now.Unix(),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPreorderBatches(tt.batches)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
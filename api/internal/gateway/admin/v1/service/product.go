@@ -3,6 +3,7 @@ package service
 import (
 	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
 	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/and-period/furumaru/api/pkg/set"
 	"github.com/shopspring/decimal"
 )
@@ -16,6 +17,9 @@ type ProductScope types.ProductScope
 // StorageMethodType - 保存方法
 type StorageMethodType types.StorageMethodType
 
+// TaxCategory - 消費税区分
+type TaxCategory types.TaxCategory
+
 // DeliveryType - 配送方法
 type DeliveryType types.DeliveryType
 
@@ -117,6 +121,32 @@ func (t StorageMethodType) Response() types.StorageMethodType {
 	return types.StorageMethodType(t)
 }
 
+func NewTaxCategory(category entity.TaxCategory) TaxCategory {
+	switch category {
+	case entity.TaxCategoryStandard:
+		return TaxCategory(types.TaxCategoryStandard)
+	case entity.TaxCategoryReduced:
+		return TaxCategory(types.TaxCategoryReduced)
+	default:
+		return TaxCategory(types.TaxCategoryUnknown)
+	}
+}
+
+func (c TaxCategory) StoreEntity() entity.TaxCategory {
+	switch types.TaxCategory(c) {
+	case types.TaxCategoryStandard:
+		return entity.TaxCategoryStandard
+	case types.TaxCategoryReduced:
+		return entity.TaxCategoryReduced
+	default:
+		return entity.TaxCategoryUnknown
+	}
+}
+
+func (c TaxCategory) Response() types.TaxCategory {
+	return types.TaxCategory(c)
+}
+
 func NewDeliveryType(typ entity.DeliveryType) DeliveryType {
 	switch typ {
 	case entity.DeliveryTypeNormal:
@@ -188,38 +218,41 @@ func NewProduct(product *entity.Product) *Product {
 	}
 	return &Product{
 		Product: types.Product{
-			ID:                   product.ID,
-			CoordinatorID:        product.CoordinatorID,
-			ProducerID:           product.ProducerID,
-			CategoryID:           "",
-			ProductTypeID:        product.TypeID,
-			ProductTagIDs:        product.TagIDs,
-			Name:                 product.Name,
-			Description:          product.Description,
-			Scope:                NewProductScope(product.Scope).Response(),
-			Status:               NewProductStatus(product.Status).Response(),
-			Inventory:            product.Inventory,
-			Weight:               NewProductWeight(product.Weight, product.WeightUnit),
-			ItemUnit:             product.ItemUnit,
-			ItemDescription:      product.ItemDescription,
-			Media:                NewMultiProductMedia(product.Media).Response(),
-			Price:                product.Price,
-			Cost:                 product.Cost,
-			ExpirationDate:       product.ExpirationDate,
-			RecommendedPoint1:    point1,
-			RecommendedPoint2:    point2,
-			RecommendedPoint3:    point3,
-			StorageMethodType:    NewStorageMethodType(product.StorageMethodType).Response(),
-			DeliveryType:         NewDeliveryType(product.DeliveryType).Response(),
-			Box60Rate:            product.Box60Rate,
-			Box80Rate:            product.Box80Rate,
-			Box100Rate:           product.Box100Rate,
-			OriginPrefectureCode: product.OriginPrefectureCode,
-			OriginCity:           product.OriginCity,
-			StartAt:              product.StartAt.Unix(),
-			EndAt:                product.EndAt.Unix(),
-			CreatedAt:            product.CreatedAt.Unix(),
-			UpdatedAt:            product.CreatedAt.Unix(),
+			ID:                      product.ID,
+			CoordinatorID:           product.CoordinatorID,
+			ProducerID:              product.ProducerID,
+			CategoryID:              "",
+			ProductTypeID:           product.TypeID,
+			ProductTagIDs:           product.TagIDs,
+			Name:                    product.Name,
+			Description:             product.Description,
+			Scope:                   NewProductScope(product.Scope).Response(),
+			Status:                  NewProductStatus(product.Status).Response(),
+			Inventory:               product.Inventory,
+			Weight:                  NewProductWeight(product.Weight, product.WeightUnit),
+			ItemUnit:                product.ItemUnit,
+			ItemDescription:         product.ItemDescription,
+			Media:                   NewMultiProductMedia(product.Media).Response(),
+			Price:                   product.Price,
+			Cost:                    product.Cost,
+			TaxCategory:             NewTaxCategory(product.TaxCategory).Response(),
+			ExpirationDate:          product.ExpirationDate,
+			RecommendedPoint1:       point1,
+			RecommendedPoint2:       point2,
+			RecommendedPoint3:       point3,
+			StorageMethodType:       NewStorageMethodType(product.StorageMethodType).Response(),
+			DeliveryType:            NewDeliveryType(product.DeliveryType).Response(),
+			Box60Rate:               product.Box60Rate,
+			Box80Rate:               product.Box80Rate,
+			Box100Rate:              product.Box100Rate,
+			OriginPrefectureCode:    product.OriginPrefectureCode,
+			OriginCity:              product.OriginCity,
+			StartAt:                 product.StartAt.Unix(),
+			EndAt:                   product.EndAt.Unix(),
+			ExpectedShippingStartAt: jst.Unix(product.ExpectedShippingStartAt),
+			ExpectedShippingEndAt:   jst.Unix(product.ExpectedShippingEndAt),
+			CreatedAt:               product.CreatedAt.Unix(),
+			UpdatedAt:               product.CreatedAt.Unix(),
 		},
 		revisionID: product.ProductRevision.ID,
 	}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+)
+
+type ProductInventory struct {
+	types.ProductInventory
+}
+
+func NewProductInventory(inventory *entity.ProductInventory) *ProductInventory {
+	return &ProductInventory{
+		ProductInventory: types.ProductInventory{
+			ProductID: inventory.ProductID,
+			Available: inventory.Available,
+			Held:      inventory.Held,
+		},
+	}
+}
+
+func (i *ProductInventory) Response() *types.ProductInventory {
+	return &i.ProductInventory
+}
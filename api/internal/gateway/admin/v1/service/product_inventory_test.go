@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductInventory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inventory *entity.ProductInventory
+		expect    *ProductInventory
+	}{
+		{
+			name: "success",
+			inventory: &entity.ProductInventory{
+				ProductID: "product-id",
+				Available: 30,
+				Held:      5,
+			},
+			expect: &ProductInventory{
+				ProductInventory: types.ProductInventory{
+					ProductID: "product-id",
+					Available: 30,
+					Held:      5,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewProductInventory(tt.inventory)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestProductInventory_Response(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inventory *ProductInventory
+		expect    *types.ProductInventory
+	}{
+		{
+			name: "success",
+			inventory: &ProductInventory{
+				ProductInventory: types.ProductInventory{
+					ProductID: "product-id",
+					Available: 30,
+					Held:      5,
+				},
+			},
+			expect: &types.ProductInventory{
+				ProductID: "product-id",
+				Available: 30,
+				Held:      5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.inventory.Response())
+		})
+	}
+}
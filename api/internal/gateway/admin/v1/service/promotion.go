@@ -12,6 +12,9 @@ type PromotionStatus types.PromotionStatus
 // DiscountType - 割引計算方法
 type DiscountType types.DiscountType
 
+// PromotionRuleType - 適用ルール種別
+type PromotionRuleType types.PromotionRuleType
+
 // PromotionTargetType - プロモーションの対象
 type PromotionTargetType types.PromotionTargetType
 
@@ -21,6 +24,12 @@ type Promotion struct {
 
 type Promotions []*Promotion
 
+type PromotionRule struct {
+	types.PromotionRule
+}
+
+type PromotionRules []*PromotionRule
+
 func NewPromotionStatus(typ entity.PromotionStatus) PromotionStatus {
 	switch typ {
 	case entity.PromotionStatusPrivate:
@@ -48,6 +57,8 @@ func NewDiscountType(typ entity.DiscountType) DiscountType {
 		return DiscountType(types.DiscountTypeRate)
 	case entity.DiscountTypeFreeShipping:
 		return DiscountType(types.DiscountTypeFreeShipping)
+	case entity.DiscountTypeRule:
+		return DiscountType(types.DiscountTypeRule)
 	default:
 		return DiscountType(types.DiscountTypeUnknown)
 	}
@@ -61,6 +72,8 @@ func (t DiscountType) StoreEntity() entity.DiscountType {
 		return entity.DiscountTypeRate
 	case types.DiscountTypeFreeShipping:
 		return entity.DiscountTypeFreeShipping
+	case types.DiscountTypeRule:
+		return entity.DiscountTypeRule
 	default:
 		return entity.DiscountTypeUnknown
 	}
@@ -70,6 +83,40 @@ func (t DiscountType) Response() types.DiscountType {
 	return types.DiscountType(t)
 }
 
+func NewPromotionRuleType(typ entity.PromotionRuleType) PromotionRuleType {
+	switch typ {
+	case entity.PromotionRuleTypeAmount:
+		return PromotionRuleType(types.PromotionRuleTypeAmount)
+	case entity.PromotionRuleTypeRate:
+		return PromotionRuleType(types.PromotionRuleTypeRate)
+	case entity.PromotionRuleTypeTieredRate:
+		return PromotionRuleType(types.PromotionRuleTypeTieredRate)
+	case entity.PromotionRuleTypeBuyXGetY:
+		return PromotionRuleType(types.PromotionRuleTypeBuyXGetY)
+	default:
+		return PromotionRuleType(types.PromotionRuleTypeUnknown)
+	}
+}
+
+func (t PromotionRuleType) StoreEntity() entity.PromotionRuleType {
+	switch types.PromotionRuleType(t) {
+	case types.PromotionRuleTypeAmount:
+		return entity.PromotionRuleTypeAmount
+	case types.PromotionRuleTypeRate:
+		return entity.PromotionRuleTypeRate
+	case types.PromotionRuleTypeTieredRate:
+		return entity.PromotionRuleTypeTieredRate
+	case types.PromotionRuleTypeBuyXGetY:
+		return entity.PromotionRuleTypeBuyXGetY
+	default:
+		return entity.PromotionRuleTypeUnknown
+	}
+}
+
+func (t PromotionRuleType) Response() types.PromotionRuleType {
+	return types.PromotionRuleType(t)
+}
+
 func NewPromotionTargetType(typ entity.PromotionTargetType) PromotionTargetType {
 	switch typ {
 	case entity.PromotionTargetTypeAllShop:
@@ -85,30 +132,43 @@ func (t PromotionTargetType) Response() types.PromotionTargetType {
 	return types.PromotionTargetType(t)
 }
 
-func NewPromotion(promotion *entity.Promotion, aggregate *entity.AggregatedOrderPromotion) *Promotion {
-	var usedCount, usedAmount int64
+func NewPromotion(
+	promotion *entity.Promotion,
+	aggregate *entity.AggregatedOrderPromotion,
+	redemption *entity.AggregatedPromotionRedemption,
+) *Promotion {
+	var usedCount, usedAmount, redeemedCount int64
 	if aggregate != nil {
 		usedCount = aggregate.OrderCount
 		usedAmount = aggregate.DiscountTotal
 	}
+	if redemption != nil {
+		redeemedCount = redemption.RedemptionCount
+	}
 	return &Promotion{
 		Promotion: types.Promotion{
-			ID:           promotion.ID,
-			ShopID:       promotion.ShopID,
-			Title:        promotion.Title,
-			Description:  promotion.Description,
-			Status:       NewPromotionStatus(promotion.Status).Response(),
-			Public:       promotion.Public,
-			TargetType:   NewPromotionTargetType(promotion.TargetType).Response(),
-			DiscountType: NewDiscountType(promotion.DiscountType).Response(),
-			DiscountRate: promotion.DiscountRate,
-			Code:         promotion.Code,
-			UsedCount:    usedCount,
-			UsedAmount:   usedAmount,
-			StartAt:      promotion.StartAt.Unix(),
-			EndAt:        promotion.EndAt.Unix(),
-			CreatedAt:    promotion.CreatedAt.Unix(),
-			UpdatedAt:    promotion.UpdatedAt.Unix(),
+			ID:                promotion.ID,
+			ShopID:            promotion.ShopID,
+			Title:             promotion.Title,
+			Description:       promotion.Description,
+			Status:            NewPromotionStatus(promotion.Status).Response(),
+			Public:            promotion.Public,
+			TargetType:        NewPromotionTargetType(promotion.TargetType).Response(),
+			DiscountType:      NewDiscountType(promotion.DiscountType).Response(),
+			DiscountRate:      promotion.DiscountRate,
+			Rules:             NewPromotionRules(promotion.Rules).Response(),
+			Code:              promotion.Code,
+			UsedCount:         usedCount,
+			UsedAmount:        usedAmount,
+			UsageLimit:        promotion.UsageLimit,
+			UsageLimitPerUser: promotion.UsageLimitPerUser,
+			FirstPurchaseOnly: promotion.FirstPurchaseOnly,
+			RedeemedCount:     redeemedCount,
+			RemainingCount:    promotion.RemainingRedemptions(redeemedCount),
+			StartAt:           promotion.StartAt.Unix(),
+			EndAt:             promotion.EndAt.Unix(),
+			CreatedAt:         promotion.CreatedAt.Unix(),
+			UpdatedAt:         promotion.UpdatedAt.Unix(),
 		},
 	}
 }
@@ -120,10 +180,14 @@ func (p *Promotion) Response() *types.Promotion {
 	return &p.Promotion
 }
 
-func NewPromotions(promotions entity.Promotions, aggregates map[string]*entity.AggregatedOrderPromotion) Promotions {
+func NewPromotions(
+	promotions entity.Promotions,
+	aggregates map[string]*entity.AggregatedOrderPromotion,
+	redemptions map[string]*entity.AggregatedPromotionRedemption,
+) Promotions {
 	res := make(Promotions, len(promotions))
 	for i, p := range promotions {
-		res[i] = NewPromotion(promotions[i], aggregates[p.ID])
+		res[i] = NewPromotion(promotions[i], aggregates[p.ID], redemptions[p.ID])
 	}
 	return res
 }
@@ -149,3 +213,48 @@ func (ps Promotions) Response() []*types.Promotion {
 	}
 	return res
 }
+
+func NewPromotionRule(rule *entity.PromotionRule) *PromotionRule {
+	tiers := make([]*types.PromotionRuleTier, len(rule.Tiers))
+	for i, tier := range rule.Tiers {
+		tiers[i] = &types.PromotionRuleTier{
+			Threshold: tier.Threshold,
+			Rate:      tier.Rate,
+		}
+	}
+	return &PromotionRule{
+		PromotionRule: types.PromotionRule{
+			ID:             rule.ID,
+			Type:           NewPromotionRuleType(rule.Type).Response(),
+			ProductIDs:     rule.ProductIDs,
+			ProductTypeIDs: rule.ProductTypeIDs,
+			CategoryIDs:    rule.CategoryIDs,
+			MinimumAmount:  rule.MinimumAmount,
+			DiscountRate:   rule.DiscountRate,
+			Tiers:          tiers,
+			BuyQuantity:    rule.BuyQuantity,
+			FreeQuantity:   rule.FreeQuantity,
+			MaxDiscount:    rule.MaxDiscount,
+		},
+	}
+}
+
+func (r *PromotionRule) Response() *types.PromotionRule {
+	return &r.PromotionRule
+}
+
+func NewPromotionRules(rules entity.PromotionRules) PromotionRules {
+	res := make(PromotionRules, len(rules))
+	for i := range rules {
+		res[i] = NewPromotionRule(rules[i])
+	}
+	return res
+}
+
+func (rs PromotionRules) Response() []*types.PromotionRule {
+	res := make([]*types.PromotionRule, len(rs))
+	for i := range rs {
+		res[i] = rs[i].Response()
+	}
+	return res
+}
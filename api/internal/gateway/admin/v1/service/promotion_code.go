@@ -0,0 +1,129 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// PromotionCodeBatchStatus - クーポンコード一括発行の状態
+type PromotionCodeBatchStatus types.PromotionCodeBatchStatus
+
+// PromotionCodeStatus - クーポンコードの利用状況
+type PromotionCodeStatus types.PromotionCodeStatus
+
+type PromotionCodeBatch struct {
+	types.PromotionCodeBatch
+}
+
+type PromotionCodeBatches []*PromotionCodeBatch
+
+type PromotionCode struct {
+	types.PromotionCode
+}
+
+type PromotionCodes []*PromotionCode
+
+func NewPromotionCodeBatchStatus(status entity.PromotionCodeBatchStatus) PromotionCodeBatchStatus {
+	switch status {
+	case entity.PromotionCodeBatchStatusActive:
+		return PromotionCodeBatchStatus(types.PromotionCodeBatchStatusActive)
+	case entity.PromotionCodeBatchStatusVoided:
+		return PromotionCodeBatchStatus(types.PromotionCodeBatchStatusVoided)
+	default:
+		return PromotionCodeBatchStatus(types.PromotionCodeBatchStatusUnknown)
+	}
+}
+
+func (s PromotionCodeBatchStatus) Response() types.PromotionCodeBatchStatus {
+	return types.PromotionCodeBatchStatus(s)
+}
+
+func NewPromotionCodeStatus(status entity.PromotionCodeStatus) PromotionCodeStatus {
+	switch status {
+	case entity.PromotionCodeStatusAvailable:
+		return PromotionCodeStatus(types.PromotionCodeStatusAvailable)
+	case entity.PromotionCodeStatusReserved:
+		return PromotionCodeStatus(types.PromotionCodeStatusReserved)
+	case entity.PromotionCodeStatusRedeemed:
+		return PromotionCodeStatus(types.PromotionCodeStatusRedeemed)
+	case entity.PromotionCodeStatusVoided:
+		return PromotionCodeStatus(types.PromotionCodeStatusVoided)
+	default:
+		return PromotionCodeStatus(types.PromotionCodeStatusUnknown)
+	}
+}
+
+func (s PromotionCodeStatus) Response() types.PromotionCodeStatus {
+	return types.PromotionCodeStatus(s)
+}
+
+func NewPromotionCodeBatch(batch *entity.PromotionCodeBatch) *PromotionCodeBatch {
+	return &PromotionCodeBatch{
+		PromotionCodeBatch: types.PromotionCodeBatch{
+			ID:          batch.ID,
+			PromotionID: batch.PromotionID,
+			Title:       batch.Title,
+			Quantity:    batch.Quantity,
+			Status:      NewPromotionCodeBatchStatus(batch.Status).Response(),
+			VoidedAt:    jst.Unix(batch.VoidedAt),
+			CreatedAt:   batch.CreatedAt.Unix(),
+			UpdatedAt:   batch.UpdatedAt.Unix(),
+		},
+	}
+}
+
+func (b *PromotionCodeBatch) Response() *types.PromotionCodeBatch {
+	return &b.PromotionCodeBatch
+}
+
+func NewPromotionCodeBatches(batches entity.PromotionCodeBatches) PromotionCodeBatches {
+	res := make(PromotionCodeBatches, len(batches))
+	for i := range batches {
+		res[i] = NewPromotionCodeBatch(batches[i])
+	}
+	return res
+}
+
+func (bs PromotionCodeBatches) Response() []*types.PromotionCodeBatch {
+	res := make([]*types.PromotionCodeBatch, len(bs))
+	for i := range bs {
+		res[i] = bs[i].Response()
+	}
+	return res
+}
+
+func NewPromotionCode(code *entity.PromotionCode) *PromotionCode {
+	return &PromotionCode{
+		PromotionCode: types.PromotionCode{
+			Code:        code.Code,
+			BatchID:     code.BatchID,
+			PromotionID: code.PromotionID,
+			Status:      NewPromotionCodeStatus(code.Status).Response(),
+			OrderID:     code.OrderID,
+			RedeemedAt:  jst.Unix(code.RedeemedAt),
+			CreatedAt:   code.CreatedAt.Unix(),
+			UpdatedAt:   code.UpdatedAt.Unix(),
+		},
+	}
+}
+
+func (c *PromotionCode) Response() *types.PromotionCode {
+	return &c.PromotionCode
+}
+
+func NewPromotionCodes(codes entity.PromotionCodes) PromotionCodes {
+	res := make(PromotionCodes, len(codes))
+	for i := range codes {
+		res[i] = NewPromotionCode(codes[i])
+	}
+	return res
+}
+
+func (cs PromotionCodes) Response() []*types.PromotionCode {
+	res := make([]*types.PromotionCode, len(cs))
+	for i := range cs {
+		res[i] = cs[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromotionCodeBatchStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status entity.PromotionCodeBatchStatus
+		expect PromotionCodeBatchStatus
+	}{
+		{
+			name:   "active",
+			status: entity.PromotionCodeBatchStatusActive,
+			expect: PromotionCodeBatchStatus(types.PromotionCodeBatchStatusActive),
+		},
+		{
+			name:   "voided",
+			status: entity.PromotionCodeBatchStatusVoided,
+			expect: PromotionCodeBatchStatus(types.PromotionCodeBatchStatusVoided),
+		},
+		{
+			name:   "unknown",
+			status: entity.PromotionCodeBatchStatusUnknown,
+			expect: PromotionCodeBatchStatus(types.PromotionCodeBatchStatusUnknown),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPromotionCodeBatchStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, types.PromotionCodeBatchStatus(tt.expect), actual.Response())
+		})
+	}
+}
+
+func TestPromotionCodeStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status entity.PromotionCodeStatus
+		expect PromotionCodeStatus
+	}{
+		{
+			name:   "available",
+			status: entity.PromotionCodeStatusAvailable,
+			expect: PromotionCodeStatus(types.PromotionCodeStatusAvailable),
+		},
+		{
+			name:   "reserved",
+			status: entity.PromotionCodeStatusReserved,
+			expect: PromotionCodeStatus(types.PromotionCodeStatusReserved),
+		},
+		{
+			name:   "redeemed",
+			status: entity.PromotionCodeStatusRedeemed,
+			expect: PromotionCodeStatus(types.PromotionCodeStatusRedeemed),
+		},
+		{
+			name:   "voided",
+			status: entity.PromotionCodeStatusVoided,
+			expect: PromotionCodeStatus(types.PromotionCodeStatusVoided),
+		},
+		{
+			name:   "unknown",
+			status: entity.PromotionCodeStatusUnknown,
+			expect: PromotionCodeStatus(types.PromotionCodeStatusUnknown),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPromotionCodeStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, types.PromotionCodeStatus(tt.expect), actual.Response())
+		})
+	}
+}
+
+func TestPromotionCodeBatches(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	batches := entity.PromotionCodeBatches{
+		{
+			ID:          "batch-id",
+			PromotionID: "promotion-id",
+			Title:       "彦根マルシェ配布分",
+			Quantity:    100,
+			Status:      entity.PromotionCodeBatchStatusVoided,
+			VoidedAt:    now,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+	expect := []*types.PromotionCodeBatch{
+		{
+			ID:          "batch-id",
+			PromotionID: "promotion-id",
+			Title:       "彦根マルシェ配布分",
+			Quantity:    100,
+			Status:      types.PromotionCodeBatchStatusVoided,
+			VoidedAt:    now.Unix(),
+			CreatedAt:   now.Unix(),
+			UpdatedAt:   now.Unix(),
+		},
+	}
+	actual := NewPromotionCodeBatches(batches).Response()
+	assert.Equal(t, expect, actual)
+}
+
+func TestPromotionCodes(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	codes := entity.PromotionCodes{
+		{
+			Code:        "ABCD2345",
+			BatchID:     "batch-id",
+			PromotionID: "promotion-id",
+			Status:      entity.PromotionCodeStatusAvailable,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		{
+			Code:        "EFGH2345",
+			BatchID:     "batch-id",
+			PromotionID: "promotion-id",
+			Status:      entity.PromotionCodeStatusRedeemed,
+			OrderID:     "order-id",
+			RedeemedAt:  now,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+	expect := []*types.PromotionCode{
+		{
+			Code:        "ABCD2345",
+			BatchID:     "batch-id",
+			PromotionID: "promotion-id",
+			Status:      types.PromotionCodeStatusAvailable,
+			RedeemedAt:  0,
+			CreatedAt:   now.Unix(),
+			UpdatedAt:   now.Unix(),
+		},
+		{
+			Code:        "EFGH2345",
+			BatchID:     "batch-id",
+			PromotionID: "promotion-id",
+			Status:      types.PromotionCodeStatusRedeemed,
+			OrderID:     "order-id",
+			RedeemedAt:  now.Unix(),
+			CreatedAt:   now.Unix(),
+			UpdatedAt:   now.Unix(),
+		},
+	}
+	actual := NewPromotionCodes(codes).Response()
+	assert.Equal(t, expect, actual)
+}
@@ -73,6 +73,11 @@ func TestDiscountType(t *testing.T) {
 			discountType: entity.DiscountTypeFreeShipping,
 			expect:       types.DiscountTypeFreeShipping,
 		},
+		{
+			name:         "success to rule",
+			discountType: entity.DiscountTypeRule,
+			expect:       types.DiscountTypeRule,
+		},
 		{
 			name:         "success to unknown",
 			discountType: entity.DiscountTypeUnknown,
@@ -110,6 +115,11 @@ func TestDiscountType_StoreEntity(t *testing.T) {
 			discountType: DiscountType(types.DiscountTypeFreeShipping),
 			expect:       entity.DiscountTypeFreeShipping,
 		},
+		{
+			name:         "success to rule",
+			discountType: DiscountType(types.DiscountTypeRule),
+			expect:       entity.DiscountTypeRule,
+		},
 		{
 			name:         "success to unknown",
 			discountType: DiscountType(types.DiscountTypeUnknown),
@@ -124,6 +134,155 @@ func TestDiscountType_StoreEntity(t *testing.T) {
 	}
 }
 
+func TestPromotionRuleType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		ruleType entity.PromotionRuleType
+		expect   types.PromotionRuleType
+	}{
+		{
+			name:     "success to amount",
+			ruleType: entity.PromotionRuleTypeAmount,
+			expect:   types.PromotionRuleTypeAmount,
+		},
+		{
+			name:     "success to rate",
+			ruleType: entity.PromotionRuleTypeRate,
+			expect:   types.PromotionRuleTypeRate,
+		},
+		{
+			name:     "success to tiered rate",
+			ruleType: entity.PromotionRuleTypeTieredRate,
+			expect:   types.PromotionRuleTypeTieredRate,
+		},
+		{
+			name:     "success to buy x get y",
+			ruleType: entity.PromotionRuleTypeBuyXGetY,
+			expect:   types.PromotionRuleTypeBuyXGetY,
+		},
+		{
+			name:     "success to unknown",
+			ruleType: entity.PromotionRuleTypeUnknown,
+			expect:   types.PromotionRuleTypeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPromotionRuleType(tt.ruleType)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
+
+func TestPromotionRuleType_StoreEntity(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		ruleType PromotionRuleType
+		expect   entity.PromotionRuleType
+	}{
+		{
+			name:     "success to amount",
+			ruleType: PromotionRuleType(types.PromotionRuleTypeAmount),
+			expect:   entity.PromotionRuleTypeAmount,
+		},
+		{
+			name:     "success to rate",
+			ruleType: PromotionRuleType(types.PromotionRuleTypeRate),
+			expect:   entity.PromotionRuleTypeRate,
+		},
+		{
+			name:     "success to tiered rate",
+			ruleType: PromotionRuleType(types.PromotionRuleTypeTieredRate),
+			expect:   entity.PromotionRuleTypeTieredRate,
+		},
+		{
+			name:     "success to buy x get y",
+			ruleType: PromotionRuleType(types.PromotionRuleTypeBuyXGetY),
+			expect:   entity.PromotionRuleTypeBuyXGetY,
+		},
+		{
+			name:     "success to unknown",
+			ruleType: PromotionRuleType(types.PromotionRuleTypeUnknown),
+			expect:   entity.PromotionRuleTypeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.ruleType.StoreEntity())
+		})
+	}
+}
+
+func TestPromotionRules(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		rules  entity.PromotionRules
+		expect PromotionRules
+	}{
+		{
+			name: "success",
+			rules: entity.PromotionRules{
+				{
+					ID:            "rule-id01",
+					Type:          entity.PromotionRuleTypeTieredRate,
+					CategoryIDs:   []string{"category-id"},
+					MinimumAmount: 1000,
+					Tiers: entity.PromotionRuleTiers{
+						{Threshold: 1000, Rate: 5},
+						{Threshold: 3000, Rate: 10},
+					},
+					MaxDiscount: 500,
+				},
+				{
+					ID:           "rule-id02",
+					Type:         entity.PromotionRuleTypeBuyXGetY,
+					ProductIDs:   []string{"product-id"},
+					BuyQuantity:  2,
+					FreeQuantity: 1,
+				},
+			},
+			expect: PromotionRules{
+				{
+					PromotionRule: types.PromotionRule{
+						ID:            "rule-id01",
+						Type:          types.PromotionRuleTypeTieredRate,
+						CategoryIDs:   []string{"category-id"},
+						MinimumAmount: 1000,
+						Tiers: []*types.PromotionRuleTier{
+							{Threshold: 1000, Rate: 5},
+							{Threshold: 3000, Rate: 10},
+						},
+						MaxDiscount: 500,
+					},
+				},
+				{
+					PromotionRule: types.PromotionRule{
+						ID:           "rule-id02",
+						Type:         types.PromotionRuleTypeBuyXGetY,
+						ProductIDs:   []string{"product-id"},
+						Tiers:        []*types.PromotionRuleTier{},
+						BuyQuantity:  2,
+						FreeQuantity: 1,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPromotionRules(tt.rules)
+			assert.Equal(t, tt.expect, actual)
+			assert.Len(t, actual.Response(), len(tt.rules))
+		})
+	}
+}
+
 func TestPromotionTargetType(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -160,10 +319,11 @@ func TestPromotion(t *testing.T) {
 	t.Parallel()
 	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
 	tests := []struct {
-		name      string
-		promotion *entity.Promotion
-		aggregate *entity.AggregatedOrderPromotion
-		expect    *Promotion
+		name       string
+		promotion  *entity.Promotion
+		aggregate  *entity.AggregatedOrderPromotion
+		redemption *entity.AggregatedPromotionRedemption
+		expect     *Promotion
 	}{
 		{
 			name: "success",
@@ -179,6 +339,7 @@ func TestPromotion(t *testing.T) {
 				DiscountRate: 0,
 				Code:         "code0001",
 				CodeType:     entity.PromotionCodeTypeOnce,
+				UsageLimit:   100,
 				StartAt:      now,
 				EndAt:        now.AddDate(0, 1, 0),
 				CreatedAt:    now,
@@ -189,24 +350,32 @@ func TestPromotion(t *testing.T) {
 				OrderCount:    2,
 				DiscountTotal: 1000,
 			},
+			redemption: &entity.AggregatedPromotionRedemption{
+				PromotionID:     "promotion-id",
+				RedemptionCount: 3,
+			},
 			expect: &Promotion{
 				Promotion: types.Promotion{
-					ID:           "promotion-id",
-					ShopID:       "shop-id",
-					Status:       types.PromotionStatusEnabled,
-					Title:        "夏の採れたて野菜マルシェを開催!!",
-					Description:  "採れたての夏野菜を紹介するマルシェを開催ます!!",
-					Public:       true,
-					TargetType:   types.PromotionTargetTypeSpecificShop,
-					DiscountType: types.DiscountTypeFreeShipping,
-					DiscountRate: 0,
-					Code:         "code0001",
-					UsedCount:    2,
-					UsedAmount:   1000,
-					StartAt:      1640962800,
-					EndAt:        1643641200,
-					CreatedAt:    1640962800,
-					UpdatedAt:    1640962800,
+					ID:             "promotion-id",
+					ShopID:         "shop-id",
+					Status:         types.PromotionStatusEnabled,
+					Title:          "夏の採れたて野菜マルシェを開催!!",
+					Description:    "採れたての夏野菜を紹介するマルシェを開催ます!!",
+					Public:         true,
+					TargetType:     types.PromotionTargetTypeSpecificShop,
+					DiscountType:   types.DiscountTypeFreeShipping,
+					DiscountRate:   0,
+					Rules:          []*types.PromotionRule{},
+					Code:           "code0001",
+					UsedCount:      2,
+					UsedAmount:     1000,
+					UsageLimit:     100,
+					RedeemedCount:  3,
+					RemainingCount: 97,
+					StartAt:        1640962800,
+					EndAt:          1643641200,
+					CreatedAt:      1640962800,
+					UpdatedAt:      1640962800,
 				},
 			},
 		},
@@ -214,7 +383,7 @@ func TestPromotion(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			assert.Equal(t, tt.expect, NewPromotion(tt.promotion, tt.aggregate))
+			assert.Equal(t, tt.expect, NewPromotion(tt.promotion, tt.aggregate, tt.redemption))
 		})
 	}
 }
@@ -237,6 +406,7 @@ func TestPromotion_Response(t *testing.T) {
 					Public:       true,
 					DiscountType: types.DiscountTypeFreeShipping,
 					DiscountRate: 0,
+					Rules:        []*types.PromotionRule{},
 					Code:         "code0001",
 					UsedCount:    2,
 					UsedAmount:   1000,
@@ -254,6 +424,7 @@ func TestPromotion_Response(t *testing.T) {
 				Public:       true,
 				DiscountType: types.DiscountTypeFreeShipping,
 				DiscountRate: 0,
+				Rules:        []*types.PromotionRule{},
 				Code:         "code0001",
 				UsedCount:    2,
 				UsedAmount:   1000,
@@ -281,10 +452,11 @@ func TestPromotions(t *testing.T) {
 	t.Parallel()
 	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
 	tests := []struct {
-		name       string
-		promotions entity.Promotions
-		aggregates map[string]*entity.AggregatedOrderPromotion
-		expect     Promotions
+		name        string
+		promotions  entity.Promotions
+		aggregates  map[string]*entity.AggregatedOrderPromotion
+		redemptions map[string]*entity.AggregatedPromotionRedemption
+		expect      Promotions
 	}{
 		{
 			name: "success",
@@ -314,25 +486,28 @@ func TestPromotions(t *testing.T) {
 					DiscountTotal: 1000,
 				},
 			},
+			redemptions: map[string]*entity.AggregatedPromotionRedemption{},
 			expect: Promotions{
 				{
 					Promotion: types.Promotion{
-						ID:           "promotion-id",
-						ShopID:       "shop-id",
-						Status:       types.PromotionStatusEnabled,
-						Title:        "夏の採れたて野菜マルシェを開催!!",
-						Description:  "採れたての夏野菜を紹介するマルシェを開催ます!!",
-						Public:       true,
-						TargetType:   types.PromotionTargetTypeSpecificShop,
-						DiscountType: types.DiscountTypeFreeShipping,
-						DiscountRate: 0,
-						Code:         "code0001",
-						UsedCount:    2,
-						UsedAmount:   1000,
-						StartAt:      1640962800,
-						EndAt:        1643641200,
-						CreatedAt:    1640962800,
-						UpdatedAt:    1640962800,
+						ID:             "promotion-id",
+						ShopID:         "shop-id",
+						Status:         types.PromotionStatusEnabled,
+						Title:          "夏の採れたて野菜マルシェを開催!!",
+						Description:    "採れたての夏野菜を紹介するマルシェを開催ます!!",
+						Public:         true,
+						TargetType:     types.PromotionTargetTypeSpecificShop,
+						DiscountType:   types.DiscountTypeFreeShipping,
+						DiscountRate:   0,
+						Rules:          []*types.PromotionRule{},
+						Code:           "code0001",
+						UsedCount:      2,
+						UsedAmount:     1000,
+						RemainingCount: -1,
+						StartAt:        1640962800,
+						EndAt:          1643641200,
+						CreatedAt:      1640962800,
+						UpdatedAt:      1640962800,
 					},
 				},
 			},
@@ -341,7 +516,7 @@ func TestPromotions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			assert.Equal(t, tt.expect, NewPromotions(tt.promotions, tt.aggregates))
+			assert.Equal(t, tt.expect, NewPromotions(tt.promotions, tt.aggregates, tt.redemptions))
 		})
 	}
 }
@@ -366,6 +541,7 @@ func TestPromotions_ShopIDs(t *testing.T) {
 						Public:       true,
 						DiscountType: types.DiscountTypeFreeShipping,
 						DiscountRate: 0,
+						Rules:        []*types.PromotionRule{},
 						Code:         "code0001",
 						UsedCount:    2,
 						UsedAmount:   1000,
@@ -406,6 +582,7 @@ func TestPromotions_Map(t *testing.T) {
 						Public:       true,
 						DiscountType: types.DiscountTypeFreeShipping,
 						DiscountRate: 0,
+						Rules:        []*types.PromotionRule{},
 						Code:         "code0001",
 						UsedCount:    2,
 						UsedAmount:   1000,
@@ -426,6 +603,7 @@ func TestPromotions_Map(t *testing.T) {
 						Public:       true,
 						DiscountType: types.DiscountTypeFreeShipping,
 						DiscountRate: 0,
+						Rules:        []*types.PromotionRule{},
 						Code:         "code0001",
 						UsedCount:    2,
 						UsedAmount:   1000,
@@ -465,6 +643,7 @@ func TestPromotions_Response(t *testing.T) {
 						Public:       true,
 						DiscountType: types.DiscountTypeFreeShipping,
 						DiscountRate: 0,
+						Rules:        []*types.PromotionRule{},
 						Code:         "code0001",
 						UsedCount:    2,
 						UsedAmount:   1000,
@@ -484,6 +663,7 @@ func TestPromotions_Response(t *testing.T) {
 					Public:       true,
 					DiscountType: types.DiscountTypeFreeShipping,
 					DiscountRate: 0,
+					Rules:        []*types.PromotionRule{},
 					Code:         "code0001",
 					UsedCount:    2,
 					UsedAmount:   1000,
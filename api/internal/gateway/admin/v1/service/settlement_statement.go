@@ -0,0 +1,156 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// SettlementPayeeType - 精算先種別
+type SettlementPayeeType types.SettlementPayeeType
+
+// SettlementStatementStatus - 精算明細の支払い状況
+type SettlementStatementStatus types.SettlementStatementStatus
+
+type SettlementStatement struct {
+	types.SettlementStatement
+}
+
+type SettlementStatements []*SettlementStatement
+
+type SettlementBalance struct {
+	types.SettlementBalance
+}
+
+type SettlementBalances []*SettlementBalance
+
+func NewSettlementPayeeType(payeeType entity.SettlementPayeeType) SettlementPayeeType {
+	switch payeeType {
+	case entity.SettlementPayeeTypeShop:
+		return SettlementPayeeType(types.SettlementPayeeTypeShop)
+	case entity.SettlementPayeeTypeCoordinator:
+		return SettlementPayeeType(types.SettlementPayeeTypeCoordinator)
+	case entity.SettlementPayeeTypeProducer:
+		return SettlementPayeeType(types.SettlementPayeeTypeProducer)
+	default:
+		return SettlementPayeeType(types.SettlementPayeeTypeUnknown)
+	}
+}
+
+func (t SettlementPayeeType) StoreEntity() entity.SettlementPayeeType {
+	switch types.SettlementPayeeType(t) {
+	case types.SettlementPayeeTypeShop:
+		return entity.SettlementPayeeTypeShop
+	case types.SettlementPayeeTypeCoordinator:
+		return entity.SettlementPayeeTypeCoordinator
+	case types.SettlementPayeeTypeProducer:
+		return entity.SettlementPayeeTypeProducer
+	default:
+		return entity.SettlementPayeeTypeUnknown
+	}
+}
+
+func (t SettlementPayeeType) Response() types.SettlementPayeeType {
+	return types.SettlementPayeeType(t)
+}
+
+func NewSettlementStatementStatus(status entity.SettlementStatementStatus) SettlementStatementStatus {
+	switch status {
+	case entity.SettlementStatementStatusUnpaid:
+		return SettlementStatementStatus(types.SettlementStatementStatusUnpaid)
+	case entity.SettlementStatementStatusPaid:
+		return SettlementStatementStatus(types.SettlementStatementStatusPaid)
+	default:
+		return SettlementStatementStatus(types.SettlementStatementStatusUnknown)
+	}
+}
+
+func (s SettlementStatementStatus) StoreEntity() entity.SettlementStatementStatus {
+	switch types.SettlementStatementStatus(s) {
+	case types.SettlementStatementStatusUnpaid:
+		return entity.SettlementStatementStatusUnpaid
+	case types.SettlementStatementStatusPaid:
+		return entity.SettlementStatementStatusPaid
+	default:
+		return entity.SettlementStatementStatusUnknown
+	}
+}
+
+func (s SettlementStatementStatus) Response() types.SettlementStatementStatus {
+	return types.SettlementStatementStatus(s)
+}
+
+func NewSettlementStatement(statement *entity.SettlementStatement) *SettlementStatement {
+	return &SettlementStatement{
+		SettlementStatement: types.SettlementStatement{
+			ID:             statement.ID,
+			PayeeType:      NewSettlementPayeeType(statement.PayeeType).Response(),
+			PayeeID:        statement.PayeeID,
+			Status:         NewSettlementStatementStatus(statement.Status).Response(),
+			PeriodStartAt:  jst.Unix(statement.PeriodStartAt),
+			PeriodEndAt:    jst.Unix(statement.PeriodEndAt),
+			OrderCount:     statement.OrderCount,
+			SalesTotal:     statement.SalesTotal,
+			RefundTotal:    statement.RefundTotal,
+			CommissionRate: statement.CommissionRate,
+			CommissionFee:  statement.CommissionFee,
+			PaymentFee:     statement.PaymentFee,
+			PayoutTotal:    statement.PayoutTotal,
+			PaidAt:         jst.Unix(statement.PaidAt),
+			CreatedAt:      jst.Unix(statement.CreatedAt),
+			UpdatedAt:      jst.Unix(statement.UpdatedAt),
+		},
+	}
+}
+
+func (s *SettlementStatement) Response() *types.SettlementStatement {
+	return &s.SettlementStatement
+}
+
+func NewSettlementStatements(statements entity.SettlementStatements) SettlementStatements {
+	res := make(SettlementStatements, len(statements))
+	for i := range statements {
+		res[i] = NewSettlementStatement(statements[i])
+	}
+	return res
+}
+
+func (ss SettlementStatements) Response() []*types.SettlementStatement {
+	res := make([]*types.SettlementStatement, len(ss))
+	for i := range ss {
+		res[i] = ss[i].Response()
+	}
+	return res
+}
+
+func NewSettlementBalance(balance *entity.SettlementBalance) *SettlementBalance {
+	return &SettlementBalance{
+		SettlementBalance: types.SettlementBalance{
+			PayeeType:      NewSettlementPayeeType(balance.PayeeType).Response(),
+			PayeeID:        balance.PayeeID,
+			StatementCount: balance.StatementCount,
+			UnpaidTotal:    balance.UnpaidTotal,
+			PaidTotal:      balance.PaidTotal,
+		},
+	}
+}
+
+func (b *SettlementBalance) Response() *types.SettlementBalance {
+	return &b.SettlementBalance
+}
+
+func NewSettlementBalances(balances entity.SettlementBalances) SettlementBalances {
+	res := make(SettlementBalances, len(balances))
+	for i := range balances {
+		res[i] = NewSettlementBalance(balances[i])
+	}
+	return res
+}
+
+func (bs SettlementBalances) Response() []*types.SettlementBalance {
+	res := make([]*types.SettlementBalance, len(bs))
+	for i := range bs {
+		res[i] = bs[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/admin/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettlementPayeeType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		payeeType entity.SettlementPayeeType
+		expect    SettlementPayeeType
+	}{
+		{name: "shop", payeeType: entity.SettlementPayeeTypeShop, expect: SettlementPayeeType(types.SettlementPayeeTypeShop)},
+		{name: "coordinator", payeeType: entity.SettlementPayeeTypeCoordinator, expect: SettlementPayeeType(types.SettlementPayeeTypeCoordinator)},
+		{name: "producer", payeeType: entity.SettlementPayeeTypeProducer, expect: SettlementPayeeType(types.SettlementPayeeTypeProducer)},
+		{name: "unknown", payeeType: entity.SettlementPayeeTypeUnknown, expect: SettlementPayeeType(types.SettlementPayeeTypeUnknown)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewSettlementPayeeType(tt.payeeType)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.payeeType, actual.StoreEntity())
+		})
+	}
+}
+
+func TestSettlementStatementStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.SettlementStatementStatus
+		expect SettlementStatementStatus
+	}{
+		{name: "unpaid", status: entity.SettlementStatementStatusUnpaid, expect: SettlementStatementStatus(types.SettlementStatementStatusUnpaid)},
+		{name: "paid", status: entity.SettlementStatementStatusPaid, expect: SettlementStatementStatus(types.SettlementStatementStatusPaid)},
+		{name: "unknown", status: entity.SettlementStatementStatusUnknown, expect: SettlementStatementStatus(types.SettlementStatementStatusUnknown)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewSettlementStatementStatus(tt.status)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.status, actual.StoreEntity())
+		})
+	}
+}
+
+func TestSettlementStatements(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	endAt := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name       string
+		statements entity.SettlementStatements
+		expect     []*types.SettlementStatement
+	}{
+		{
+			name: "success",
+			statements: entity.SettlementStatements{
+				{
+					ID:             "statement-id",
+					PayeeType:      entity.SettlementPayeeTypeProducer,
+					PayeeID:        "producer-id",
+					Status:         entity.SettlementStatementStatusPaid,
+					PeriodStartAt:  startAt,
+					PeriodEndAt:    endAt,
+					OrderCount:     1,
+					SalesTotal:     3000,
+					CommissionRate: 10,
+					CommissionFee:  300,
+					PaymentFee:     108,
+					PayoutTotal:    2592,
+					PaidAt:         now,
+					CreatedAt:      now,
+					UpdatedAt:      now,
+				},
+			},
+			expect: []*types.SettlementStatement{
+				{
+					ID:             "statement-id",
+					PayeeType:      types.SettlementPayeeTypeProducer,
+					PayeeID:        "producer-id",
+					Status:         types.SettlementStatementStatusPaid,
+					PeriodStartAt:  startAt.Unix(),
+					PeriodEndAt:    endAt.Unix(),
+					OrderCount:     1,
+					SalesTotal:     3000,
+					CommissionRate: 10,
+					CommissionFee:  300,
+					PaymentFee:     108,
+					PayoutTotal:    2592,
+					PaidAt:         now.Unix(),
+					CreatedAt:      now.Unix(),
+					UpdatedAt:      now.Unix(),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewSettlementStatements(tt.statements)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
+
+func TestSettlementBalances(t *testing.T) {
+	t.Parallel()
+	balances := entity.SettlementBalances{
+		{
+			PayeeType:      entity.SettlementPayeeTypeShop,
+			PayeeID:        "shop-id",
+			StatementCount: 2,
+			UnpaidTotal:    2592,
+			PaidTotal:      1000,
+		},
+	}
+	expect := []*types.SettlementBalance{
+		{
+			PayeeType:      types.SettlementPayeeTypeShop,
+			PayeeID:        "shop-id",
+			StatementCount: 2,
+			UnpaidTotal:    2592,
+			PaidTotal:      1000,
+		},
+	}
+	actual := NewSettlementBalances(balances)
+	assert.Equal(t, expect, actual.Response())
+}
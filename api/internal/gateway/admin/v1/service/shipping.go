@@ -19,6 +19,12 @@ type ShippingRate struct {
 
 type ShippingRates []*ShippingRate
 
+type ShippingRegionRule struct {
+	types.ShippingRegionRule
+}
+
+type ShippingRegionRules []*ShippingRegionRule
+
 func NewShipping(shipping *entity.Shipping) *Shipping {
 	return &Shipping{
 		Shipping: types.Shipping{
@@ -31,6 +37,13 @@ func NewShipping(shipping *entity.Shipping) *Shipping {
 			Box80Frozen:       shipping.Box80Frozen,
 			Box100Rates:       NewShippingRates(shipping.Box100Rates).Response(),
 			Box100Frozen:      shipping.Box100Frozen,
+			Box120Rates:       NewShippingRates(shipping.Box120Rates).Response(),
+			Box120Frozen:      shipping.Box120Frozen,
+			Box140Rates:       NewShippingRates(shipping.Box140Rates).Response(),
+			Box140Frozen:      shipping.Box140Frozen,
+			Box160Rates:       NewShippingRates(shipping.Box160Rates).Response(),
+			Box160Frozen:      shipping.Box160Frozen,
+			RegionRules:       NewShippingRegionRules(shipping.RegionRules).Response(),
 			HasFreeShipping:   shipping.HasFreeShipping,
 			FreeShippingRates: shipping.FreeShippingRates,
 			CreatedAt:         shipping.CreatedAt.Unix(),
@@ -91,3 +104,40 @@ func (rs ShippingRates) Response() []*types.ShippingRate {
 	}
 	return res
 }
+
+func NewShippingRegionRule(rule *entity.ShippingRegionRule) *ShippingRegionRule {
+	excludedTypes := make([]types.ShippingType, len(rule.ExcludedTypes))
+	for i := range rule.ExcludedTypes {
+		excludedTypes[i] = NewShippingType(rule.ExcludedTypes[i]).Response()
+	}
+	return &ShippingRegionRule{
+		ShippingRegionRule: types.ShippingRegionRule{
+			Number:         rule.Number,
+			Name:           rule.Name,
+			PostalCodeFrom: rule.PostalCodeFrom,
+			PostalCodeTo:   rule.PostalCodeTo,
+			Surcharge:      rule.Surcharge,
+			ExcludedTypes:  excludedTypes,
+		},
+	}
+}
+
+func (r *ShippingRegionRule) Response() *types.ShippingRegionRule {
+	return &r.ShippingRegionRule
+}
+
+func NewShippingRegionRules(rules entity.ShippingRegionRules) ShippingRegionRules {
+	res := make(ShippingRegionRules, len(rules))
+	for i := range rules {
+		res[i] = NewShippingRegionRule(rules[i])
+	}
+	return res
+}
+
+func (rs ShippingRegionRules) Response() []*types.ShippingRegionRule {
+	res := make([]*types.ShippingRegionRule, len(rs))
+	for i := range rs {
+		res[i] = rs[i].Response()
+	}
+	return res
+}
@@ -15,14 +15,15 @@ type Shops []*Shop
 func NewShop(shop *entity.Shop) *Shop {
 	return &Shop{
 		Shop: types.Shop{
-			ID:             shop.ID,
-			Name:           shop.Name,
-			CoordinatorID:  shop.CoordinatorID,
-			ProducerIDs:    shop.ProducerIDs,
-			ProductTypeIDs: shop.ProductTypeIDs,
-			BusinessDays:   shop.BusinessDays,
-			CreatedAt:      shop.CreatedAt.Unix(),
-			UpdatedAt:      shop.UpdatedAt.Unix(),
+			ID:                        shop.ID,
+			Name:                      shop.Name,
+			CoordinatorID:             shop.CoordinatorID,
+			ProducerIDs:               shop.ProducerIDs,
+			ProductTypeIDs:            shop.ProductTypeIDs,
+			BusinessDays:              shop.BusinessDays,
+			InvoiceRegistrationNumber: shop.InvoiceRegistrationNumber,
+			CreatedAt:                 shop.CreatedAt.Unix(),
+			UpdatedAt:                 shop.UpdatedAt.Unix(),
 		},
 	}
 }
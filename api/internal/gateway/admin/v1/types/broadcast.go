@@ -11,6 +11,16 @@ const (
 	BroadcastStatusActive   BroadcastStatus = 4 // 配信中
 )
 
+// BroadcastCaptionStatus - アーカイブ字幕の生成状況
+type BroadcastCaptionStatus int32
+
+const (
+	BroadcastCaptionStatusNone       BroadcastCaptionStatus = 0 // 未生成
+	BroadcastCaptionStatusProcessing BroadcastCaptionStatus = 1 // 生成中
+	BroadcastCaptionStatusPublished  BroadcastCaptionStatus = 2 // 公開済み
+	BroadcastCaptionStatusFailed     BroadcastCaptionStatus = 3 // 生成失敗
+)
+
 // BroadcastViewerLogInterval - ライブ配信視聴ログ取得間隔
 type BroadcastViewerLogInterval string
 
@@ -22,17 +32,26 @@ const (
 
 // Broadcast - ライブ配信情報
 type Broadcast struct {
-	ID               string          `json:"id"`               // ライブ配信ID
-	ScheduleID       string          `json:"scheduleId"`       // 開催スケジュールID
-	Status           BroadcastStatus `json:"status"`           // ライブ配信状況
-	InputURL         string          `json:"inputUrl"`         // ライブ配信URL(入力)
-	OutputURL        string          `json:"outputUrl"`        // ライブ配信URL(出力)
-	ArchiveURL       string          `json:"archiveUrl"`       // オンデマンド配信URL
-	YoutubeAccount   string          `json:"youtubeAccount"`   // Youtubeアカウント
-	YoutubeViewerURL string          `json:"youtubeViewerUrl"` // Youtube視聴画面URL
-	YoutubeAdminURL  string          `json:"youtubeAdminUrl"`  // Youtube管理画面URL
-	CreatedAt        int64           `json:"createdAt"`        // 登録日時
-	UpdatedAt        int64           `json:"updatedAt"`        // 更新日時
+	ID               string                 `json:"id"`               // ライブ配信ID
+	ScheduleID       string                 `json:"scheduleId"`       // 開催スケジュールID
+	Status           BroadcastStatus        `json:"status"`           // ライブ配信状況
+	InputURL         string                 `json:"inputUrl"`         // ライブ配信URL(入力)
+	OutputURL        string                 `json:"outputUrl"`        // ライブ配信URL(出力)
+	ArchiveURL       string                 `json:"archiveUrl"`       // オンデマンド配信URL
+	CaptionStatus    BroadcastCaptionStatus `json:"captionStatus"`    // アーカイブ字幕の生成状況
+	Subtitles        map[string]string      `json:"subtitles"`        // アーカイブ字幕（key：言語コード,value：字幕ファイルURL）
+	YoutubeAccount   string                 `json:"youtubeAccount"`   // Youtubeアカウント
+	YoutubeViewerURL string                 `json:"youtubeViewerUrl"` // Youtube視聴画面URL
+	YoutubeAdminURL  string                 `json:"youtubeAdminUrl"`  // Youtube管理画面URL
+	CreatedAt        int64                  `json:"createdAt"`        // 登録日時
+	UpdatedAt        int64                  `json:"updatedAt"`        // 更新日時
+}
+
+// BroadcastCaption - アーカイブ字幕
+type BroadcastCaption struct {
+	Language string `json:"language"` // 言語コード
+	URL      string `json:"url"`      // 字幕ファイルURL
+	Content  string `json:"content"`  // 字幕ファイル(WebVTT)
 }
 
 // GuestBroadcast - ゲスト用ライブ配信情報
@@ -57,6 +76,10 @@ type UpdateBroadcastArchiveRequest struct {
 	ArchiveURL string `json:"archiveUrl" validate:"required,url"` // アーカイブ動画URL
 }
 
+type UpdateBroadcastCaptionRequest struct {
+	Content string `json:"content" validate:"required"` // 字幕ファイル(WebVTT)
+}
+
 type ActivateBroadcastMP4Request struct {
 	InputURL string `json:"inputUrl" validate:"required,url"` // 配信動画URL
 }
@@ -80,6 +103,10 @@ type BroadcastResponse struct {
 	Broadcast *Broadcast `json:"broadcast"` // ライブ配信情報
 }
 
+type BroadcastCaptionResponse struct {
+	Caption *BroadcastCaption `json:"caption"` // アーカイブ字幕
+}
+
 type GuestBroadcastResponse struct {
 	Broadcast *GuestBroadcast `json:"broadcast"` // ゲスト用ライブ配信情報
 }
@@ -0,0 +1,46 @@
+package types
+
+// BroadcastClipStatus - アーカイブ切り抜き動画の生成状況
+type BroadcastClipStatus int32
+
+const (
+	BroadcastClipStatusUnknown    BroadcastClipStatus = 0
+	BroadcastClipStatusWaiting    BroadcastClipStatus = 1 // 変換待ち
+	BroadcastClipStatusProcessing BroadcastClipStatus = 2 // 変換中
+	BroadcastClipStatusCompleted  BroadcastClipStatus = 3 // 生成完了
+	BroadcastClipStatusFailed     BroadcastClipStatus = 4 // 生成失敗
+)
+
+// BroadcastClip - ライブ配信アーカイブの切り抜き動画情報
+type BroadcastClip struct {
+	ID           string              `json:"id"`           // 切り抜き動画ID
+	ScheduleID   string              `json:"scheduleId"`   // 開催スケジュールID
+	Title        string              `json:"title"`        // タイトル
+	Description  string              `json:"description"`  // 説明
+	ThumbnailURL string              `json:"thumbnailUrl"` // サムネイルURL
+	ProductIDs   []string            `json:"productIds"`   // 紐付ける商品ID一覧
+	StartOffset  int64               `json:"startOffset"`  // 切り抜き開始位置(アーカイブ先頭からの経過秒数)
+	EndOffset    int64               `json:"endOffset"`    // 切り抜き終了位置(アーカイブ先頭からの経過秒数)
+	Status       BroadcastClipStatus `json:"status"`       // 生成状況
+	Progress     int64               `json:"progress"`     // 動画変換の進捗率(%)
+	VideoID      string              `json:"videoId"`      // 生成したオンデマンド動画ID
+	ErrorMessage string              `json:"errorMessage"` // 生成失敗時のエラー内容
+	CreatedAt    int64               `json:"createdAt"`    // 登録日時
+	UpdatedAt    int64               `json:"updatedAt"`    // 更新日時
+}
+
+type CreateBroadcastClipRequest struct {
+	Title        string `json:"title" validate:"required,max=128"`        // タイトル
+	Description  string `json:"description" validate:"max=2000"`          // 説明
+	ThumbnailURL string `json:"thumbnailUrl" validate:"omitempty,url"`    // サムネイルURL(未指定の場合はスケジュールのサムネイル)
+	StartOffset  int64  `json:"startOffset" validate:"min=0"`             // 切り抜き開始位置(アーカイブ先頭からの経過秒数)
+	EndOffset    int64  `json:"endOffset" validate:"gtfield=StartOffset"` // 切り抜き終了位置(アーカイブ先頭からの経過秒数)
+}
+
+type BroadcastClipResponse struct {
+	Clip *BroadcastClip `json:"clip"` // 切り抜き動画情報
+}
+
+type BroadcastClipsResponse struct {
+	Clips []*BroadcastClip `json:"clips"` // 切り抜き動画一覧
+}
@@ -0,0 +1,91 @@
+package types
+
+// CommentMuteType - コメントミュート種別
+type CommentMuteType int32
+
+const (
+	CommentMuteTypeUnknown   CommentMuteType = 0
+	CommentMuteTypeMute      CommentMuteType = 1 // ミュート（投稿を拒否）
+	CommentMuteTypeShadowBan CommentMuteType = 2 // シャドウバン（投稿者以外には表示しない）
+)
+
+// CommentModerationAction - コメントモデレーション種別
+type CommentModerationAction int32
+
+const (
+	CommentModerationActionUnknown      CommentModerationAction = 0
+	CommentModerationActionNgWord       CommentModerationAction = 1 // NGワードによる拒否
+	CommentModerationActionRateLimit    CommentModerationAction = 2 // 投稿頻度制限による拒否
+	CommentModerationActionMuted        CommentModerationAction = 3 // ミュートによる拒否
+	CommentModerationActionShadowBanned CommentModerationAction = 4 // シャドウバンによる非表示
+	CommentModerationActionMuteCreated  CommentModerationAction = 5 // ミュート登録
+	CommentModerationActionMuteDeleted  CommentModerationAction = 6 // ミュート解除
+)
+
+// CommentNgWord - コメントNGワード情報
+type CommentNgWord struct {
+	ID            string `json:"id"`            // NGワードID
+	CoordinatorID string `json:"coordinatorId"` // コーディネータID
+	Word          string `json:"word"`          // NGワード
+	CreatedAt     int64  `json:"createdAt"`     // 登録日時
+	UpdatedAt     int64  `json:"updatedAt"`     // 更新日時
+}
+
+// CommentMute - ライブ配信コメントのミュート情報
+type CommentMute struct {
+	ID          string          `json:"id"`          // ミュートID
+	BroadcastID string          `json:"broadcastId"` // ライブ配信ID
+	UserID      string          `json:"userId"`      // ユーザーID
+	SessionID   string          `json:"sessionId"`   // セッションID(ゲストの場合)
+	Type        CommentMuteType `json:"type"`        // ミュート種別
+	Reason      string          `json:"reason"`      // ミュート理由
+	AdminID     string          `json:"adminId"`     // 登録者ID
+	CreatedAt   int64           `json:"createdAt"`   // 登録日時
+	UpdatedAt   int64           `json:"updatedAt"`   // 更新日時
+}
+
+// CommentModerationLog - コメントモデレーション履歴
+type CommentModerationLog struct {
+	ID        string                  `json:"id"`        // 履歴ID
+	Action    CommentModerationAction `json:"action"`    // モデレーション種別
+	CommentID string                  `json:"commentId"` // コメントID
+	UserID    string                  `json:"userId"`    // ユーザーID
+	SessionID string                  `json:"sessionId"` // セッションID
+	ClientIP  string                  `json:"clientIp"`  // 投稿元IPアドレス
+	Content   string                  `json:"content"`   // コメント内容
+	Detail    string                  `json:"detail"`    // 詳細(一致したNGワード、ミュート理由など)
+	AdminID   string                  `json:"adminId"`   // 操作した管理者ID
+	CreatedAt int64                   `json:"createdAt"` // 登録日時
+}
+
+type CreateCommentNgWordRequest struct {
+	Word string `json:"word" validate:"required,max=64"` // NGワード
+}
+
+type CreateCommentMuteRequest struct {
+	CommentID string          `json:"commentId" validate:"required"`      // ミュート対象のコメントID
+	Type      CommentMuteType `json:"type" validate:"required,oneof=1 2"` // ミュート種別
+	Reason    string          `json:"reason" validate:"max=200"`          // ミュート理由
+}
+
+type CommentNgWordResponse struct {
+	NgWord *CommentNgWord `json:"ngWord"` // NGワード情報
+}
+
+type CommentNgWordsResponse struct {
+	NgWords []*CommentNgWord `json:"ngWords"` // NGワード一覧
+	Total   int64            `json:"total"`   // 合計数
+}
+
+type CommentMuteResponse struct {
+	Mute *CommentMute `json:"mute"` // ミュート情報
+}
+
+type CommentMutesResponse struct {
+	Mutes []*CommentMute `json:"mutes"` // ミュート一覧
+}
+
+type CommentModerationLogsResponse struct {
+	Logs  []*CommentModerationLog `json:"logs"`  // モデレーション履歴一覧
+	Total int64                   `json:"total"` // 合計数
+}
@@ -0,0 +1,42 @@
+package types
+
+// DeliveryTimeWindow - お届け希望時間帯
+type DeliveryTimeWindow int32
+
+const (
+	DeliveryTimeWindowUnspecified DeliveryTimeWindow = 0 // 指定なし
+	DeliveryTimeWindowMorning     DeliveryTimeWindow = 1 // 午前中
+	DeliveryTimeWindow1214        DeliveryTimeWindow = 2 // 12時〜14時
+	DeliveryTimeWindow1416        DeliveryTimeWindow = 3 // 14時〜16時
+	DeliveryTimeWindow1618        DeliveryTimeWindow = 4 // 16時〜18時
+	DeliveryTimeWindow1820        DeliveryTimeWindow = 5 // 18時〜20時
+	DeliveryTimeWindow1921        DeliveryTimeWindow = 6 // 19時〜21時
+)
+
+// DeliverySlotRule - 配送日時指定ルール
+type DeliverySlotRule struct {
+	ID             string               `json:"id"`             // 配送日時指定ルールID
+	CoordinatorID  string               `json:"coordinatorId"`  // コーディネータID
+	ShippingType   ShippingType         `json:"shippingType"`   // 配送方法
+	LeadDays       int64                `json:"leadDays"`       // 最短お届け日までの日数
+	SelectableDays int64                `json:"selectableDays"` // お届け日の選択可能日数(0:上限なし)
+	BlackoutDates  []string             `json:"blackoutDates"`  // お届け不可日一覧(YYYYMMDD)
+	TimeWindows    []DeliveryTimeWindow `json:"timeWindows"`    // 指定可能な時間帯一覧
+	CreatedAt      int64                `json:"createdAt"`      // 登録日時
+	UpdatedAt      int64                `json:"updatedAt"`      // 更新日時
+}
+
+type UpsertDeliverySlotRuleRequest struct {
+	LeadDays       int64                `json:"leadDays"`       // 最短お届け日までの日数
+	SelectableDays int64                `json:"selectableDays"` // お届け日の選択可能日数(0:上限なし)
+	BlackoutDates  []string             `json:"blackoutDates"`  // お届け不可日一覧(YYYYMMDD)
+	TimeWindows    []DeliveryTimeWindow `json:"timeWindows"`    // 指定可能な時間帯一覧
+}
+
+type DeliverySlotRuleResponse struct {
+	DeliverySlotRule *DeliverySlotRule `json:"deliverySlotRule"` // 配送日時指定ルール
+}
+
+type DeliverySlotRulesResponse struct {
+	DeliverySlotRules []*DeliverySlotRule `json:"deliverySlotRules"` // 配送日時指定ルール一覧
+}
@@ -0,0 +1,45 @@
+package types
+
+// ExperienceSlotStatus - 体験枠の受付状況
+type ExperienceSlotStatus int32
+
+const (
+	ExperienceSlotStatusUnknown   ExperienceSlotStatus = 0
+	ExperienceSlotStatusAccepting ExperienceSlotStatus = 1 // 受付中
+	ExperienceSlotStatusSoldOut   ExperienceSlotStatus = 2 // 満席
+	ExperienceSlotStatusFinished  ExperienceSlotStatus = 3 // 受付終了
+)
+
+// ExperienceSlot - 体験枠情報
+type ExperienceSlot struct {
+	ID           string               `json:"id"`           // 体験枠ID
+	ExperienceID string               `json:"experienceId"` // 体験ID
+	Status       ExperienceSlotStatus `json:"status"`       // 受付状況
+	Capacity     int64                `json:"capacity"`     // 定員
+	Reserved     int64                `json:"reserved"`     // 予約済み人数(仮押さえを含む)
+	Remaining    int64                `json:"remaining"`    // 残席数
+	StartAt      int64                `json:"startAt"`      // 開始日時
+	EndAt        int64                `json:"endAt"`        // 終了日時
+	CreatedAt    int64                `json:"createdAt"`    // 登録日時
+	UpdatedAt    int64                `json:"updatedAt"`    // 更新日時
+}
+
+type CreateExperienceSlotRequest struct {
+	Capacity int64 `json:"capacity" validate:"min=1"`                 // 定員
+	StartAt  int64 `json:"startAt" validate:"required"`               // 開始日時
+	EndAt    int64 `json:"endAt" validate:"required,gtfield=StartAt"` // 終了日時
+}
+
+type UpdateExperienceSlotRequest struct {
+	Capacity int64 `json:"capacity" validate:"min=1"`                 // 定員
+	StartAt  int64 `json:"startAt" validate:"required"`               // 開始日時
+	EndAt    int64 `json:"endAt" validate:"required,gtfield=StartAt"` // 終了日時
+}
+
+type ExperienceSlotResponse struct {
+	Slot *ExperienceSlot `json:"slot"` // 体験枠情報
+}
+
+type ExperienceSlotsResponse struct {
+	Slots []*ExperienceSlot `json:"slots"` // 体験枠一覧
+}
@@ -0,0 +1,40 @@
+package types
+
+// LivePin - ライブ配信中の商品ピン留め情報
+type LivePin struct {
+	ID             string `json:"id"`             // ピン留めID
+	ScheduleID     string `json:"scheduleId"`     // マルシェ開催スケジュールID
+	ProductID      string `json:"productId"`      // 商品ID
+	AdminID        string `json:"adminId"`        // ピン留めした管理者ID
+	Pinned         bool   `json:"pinned"`         // ピン留め中か
+	PinnedAt       int64  `json:"pinnedAt"`       // ピン留め日時
+	PinnedOffset   int64  `json:"pinnedOffset"`   // ピン留め位置(配信開始からの経過秒数)
+	UnpinnedAt     int64  `json:"unpinnedAt"`     // ピン留め解除日時
+	UnpinnedOffset int64  `json:"unpinnedOffset"` // ピン留め解除位置(配信開始からの経過秒数)
+	CreatedAt      int64  `json:"createdAt"`      // 登録日時
+	UpdatedAt      int64  `json:"updatedAt"`      // 更新日時
+}
+
+// LivePinSales - ピン留め期間中の売上情報
+type LivePinSales struct {
+	LivePinID  string `json:"livePinId"`  // ピン留めID
+	OrderCount int64  `json:"orderCount"` // 注文件数
+	UserCount  int64  `json:"userCount"`  // 購入者数
+	Quantity   int64  `json:"quantity"`   // 購入数量
+	SalesTotal int64  `json:"salesTotal"` // 売上合計金額(対象商品の購入金額の合計)
+}
+
+type PinLiveProductRequest struct {
+	ProductID string `json:"productId" validate:"required"` // 商品ID
+}
+
+type LivePinResponse struct {
+	LivePin *LivePin `json:"pin"`     // ピン留め情報
+	Product *Product `json:"product"` // 商品情報
+}
+
+type LivePinsResponse struct {
+	LivePins []*LivePin      `json:"pins"`     // ピン留め一覧
+	Sales    []*LivePinSales `json:"sales"`    // ピン留め期間中の売上一覧
+	Products []*Product      `json:"products"` // 商品一覧
+}
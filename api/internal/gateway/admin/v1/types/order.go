@@ -13,15 +13,16 @@ const (
 type OrderStatus int32
 
 const (
-	OrderStatusUnknown   OrderStatus = 0
-	OrderStatusUnpaid    OrderStatus = 1 // 支払い待ち
-	OrderStatusWaiting   OrderStatus = 2 // 受注待ち
-	OrderStatusPreparing OrderStatus = 3 // 発送準備中
-	OrderStatusShipped   OrderStatus = 4 // 発送完了
-	OrderStatusCompleted OrderStatus = 5 // 完了
-	OrderStatusCanceled  OrderStatus = 6 // キャンセル
-	OrderStatusRefunded  OrderStatus = 7 // 返金
-	OrderStatusFailed    OrderStatus = 8 // 失敗
+	OrderStatusUnknown           OrderStatus = 0
+	OrderStatusUnpaid            OrderStatus = 1 // 支払い待ち
+	OrderStatusWaiting           OrderStatus = 2 // 受注待ち
+	OrderStatusPreparing         OrderStatus = 3 // 発送準備中
+	OrderStatusShipped           OrderStatus = 4 // 発送完了
+	OrderStatusCompleted         OrderStatus = 5 // 完了
+	OrderStatusCanceled          OrderStatus = 6 // キャンセル
+	OrderStatusRefunded          OrderStatus = 7 // 返金
+	OrderStatusFailed            OrderStatus = 8 // 失敗
+	OrderStatusPartiallyRefunded OrderStatus = 9 // 一部返金
 )
 
 // OrderShippingType - 発送方法
@@ -40,6 +41,7 @@ type Order struct {
 	UserID          string              `json:"userId"`          // ユーザーID
 	CoordinatorID   string              `json:"coordinatorId"`   // コーディネータID
 	PromotionID     string              `json:"promotionId"`     // プロモーションID
+	PreorderBatchID string              `json:"preorderBatchId"` // 予約注文バッチID
 	ManagementID    int64               `json:"managementId"`    // 注文管理用ID
 	ShippingMessage string              `json:"shippingMessage"` // 発送連絡時のメッセージ
 	Type            OrderType           `json:"type"`            // 注文種別
@@ -60,6 +62,10 @@ type OrderMetadata struct {
 	OrderRequest   string `json:"orderRequest"`   // 要望・質問など自由入力
 	PickupAt       int64  `json:"pickupAt"`       // 受け取り日時
 	PickupLocation string `json:"pickupLocation"` // 受け取り場所
+	Gift           bool   `json:"gift"`           // ギフト注文
+	NoshiType      int32  `json:"noshiType"`      // のし種別
+	NoshiName      string `json:"noshiName"`      // のし名入れ
+	MessageCard    string `json:"messageCard"`    // メッセージカード本文
 }
 
 // OrderItem - 注文商品情報
@@ -68,6 +74,7 @@ type OrderItem struct {
 	ProductID     string `json:"productId"`     // 商品ID
 	Price         int64  `json:"price"`         // 購入価格(税込)
 	Quantity      int64  `json:"quantity"`      // 購入数量
+	TaxRate       int64  `json:"taxRate"`       // 適用税率(%)
 }
 
 // OrderExperience - 注文体験情報
@@ -102,7 +109,14 @@ type CompleteOrderRequest struct {
 }
 
 type RefundOrderRequest struct {
-	Description string `json:"description" validate:"required,max=2000"` // 返金理由
+	Description string                    `json:"description" validate:"required,max=2000"` // 返金理由
+	Amount      int64                     `json:"amount" validate:"min=0"`                  // 返金金額(金額指定の場合)
+	Items       []*RefundOrderItemRequest `json:"items" validate:"dive,required"`           // 返金対象商品一覧(商品単位の場合)
+}
+
+type RefundOrderItemRequest struct {
+	ProductID string `json:"productId" validate:"required"` // 商品ID
+	Quantity  int64  `json:"quantity" validate:"min=1"`     // 返金数量
 }
 
 type UpdateOrderFulfillmentRequest struct {
@@ -111,8 +125,10 @@ type UpdateOrderFulfillmentRequest struct {
 }
 
 type ExportOrdersRequest struct {
-	ShippingCarrier       int32 `json:"shippingCarrier" validate:"required"` // 配送会社
-	CharacterEncodingType int32 `json:"characterEncodingType" validate:""`   // 文字コード種別
+	ShippingCarrier       int32  `json:"shippingCarrier" validate:"required"` // 配送会社
+	LabelFormat           int32  `json:"labelFormat" validate:""`             // 送り状の出力形式
+	CharacterEncodingType int32  `json:"characterEncodingType" validate:""`   // 文字コード種別
+	PreorderBatchID       string `json:"preorderBatchId" validate:""`         // 予約注文バッチID
 }
 
 type OrderResponse struct {
@@ -124,6 +140,10 @@ type OrderResponse struct {
 	Experience  *Experience  `json:"experience"`  // 体験情報
 }
 
+type ImportOrderTrackingsResponse struct {
+	Total int64 `json:"total"` // 配達完了を反映した配送数
+}
+
 type OrdersResponse struct {
 	Orders       []*Order       `json:"orders"`       // 注文履歴一覧
 	Users        []*User        `json:"users"`        // 購入者一覧
@@ -0,0 +1,92 @@
+package types
+
+// OrderClaimType - 返品・交換申請理由
+type OrderClaimType int32
+
+const (
+	OrderClaimTypeUnknown   OrderClaimType = 0
+	OrderClaimTypeSpoiled   OrderClaimType = 1 // 傷み・腐敗
+	OrderClaimTypeDamaged   OrderClaimType = 2 // 破損
+	OrderClaimTypeWrongItem OrderClaimType = 3 // 誤配送
+	OrderClaimTypeMissing   OrderClaimType = 4 // 数量不足
+	OrderClaimTypeOther     OrderClaimType = 5 // その他
+)
+
+// OrderClaimStatus - 返品・交換申請の審査状況
+type OrderClaimStatus int32
+
+const (
+	OrderClaimStatusUnknown   OrderClaimStatus = 0
+	OrderClaimStatusRequested OrderClaimStatus = 1 // 申請中
+	OrderClaimStatusApproved  OrderClaimStatus = 2 // 承認
+	OrderClaimStatusRejected  OrderClaimStatus = 3 // 却下
+	OrderClaimStatusResolved  OrderClaimStatus = 4 // 対応完了
+	OrderClaimStatusResolving OrderClaimStatus = 5 // 対応中
+)
+
+// OrderClaimResolutionType - 返品・交換申請の対応方法
+type OrderClaimResolutionType int32
+
+const (
+	OrderClaimResolutionTypeUnknown    OrderClaimResolutionType = 0
+	OrderClaimResolutionTypeRefund     OrderClaimResolutionType = 1 // 返金
+	OrderClaimResolutionTypeReshipment OrderClaimResolutionType = 2 // 再発送
+	OrderClaimResolutionTypeCoupon     OrderClaimResolutionType = 3 // クーポン発行
+)
+
+// OrderClaim - 返品・交換申請
+type OrderClaim struct {
+	ID             string                   `json:"id"`             // 返品・交換申請ID
+	OrderID        string                   `json:"orderId"`        // 注文履歴ID
+	UserID         string                   `json:"userId"`         // 申請者ID
+	CoordinatorID  string                   `json:"coordinatorId"`  // コーディネータID
+	Type           OrderClaimType           `json:"type"`           // 申請理由
+	Status         OrderClaimStatus         `json:"status"`         // 審査状況
+	Description    string                   `json:"description"`    // 申請内容
+	Items          []*OrderClaimItem        `json:"items"`          // 対象商品一覧
+	ImageURLs      []string                 `json:"imageUrls"`      // 証拠画像URL一覧
+	ReviewerID     string                   `json:"reviewerId"`     // 審査者ID
+	ReviewComment  string                   `json:"reviewComment"`  // 審査コメント
+	ResolutionType OrderClaimResolutionType `json:"resolutionType"` // 対応方法
+	ResolutionNote string                   `json:"resolutionNote"` // 対応内容
+	RefundLineID   string                   `json:"refundLineId"`   // 返金明細ID
+	PromotionID    string                   `json:"promotionId"`    // お詫びクーポンのプロモーションID
+	FulfillmentID  string                   `json:"fulfillmentId"`  // 再発送の注文配送ID
+	ReviewedAt     int64                    `json:"reviewedAt"`     // 審査日時
+	ResolvedAt     int64                    `json:"resolvedAt"`     // 対応完了日時
+	CreatedAt      int64                    `json:"createdAt"`      // 申請日時
+	UpdatedAt      int64                    `json:"updatedAt"`      // 更新日時
+}
+
+// OrderClaimItem - 返品・交換申請の対象商品
+type OrderClaimItem struct {
+	ProductID string `json:"productId"` // 商品ID
+	Quantity  int64  `json:"quantity"`  // 対象数量
+}
+
+type ApproveOrderClaimRequest struct {
+	Comment string `json:"comment" validate:"omitempty,max=2000"` // 審査コメント
+}
+
+type RejectOrderClaimRequest struct {
+	Comment string `json:"comment" validate:"required,max=2000"` // 却下理由
+}
+
+type ResolveOrderClaimRequest struct {
+	ResolutionType OrderClaimResolutionType `json:"resolutionType" validate:"required"`      // 対応方法
+	Note           string                   `json:"note" validate:"omitempty,max=2000"`      // 対応内容
+	CouponAmount   int64                    `json:"couponAmount" validate:"omitempty,min=0"` // お詫びクーポンの割引金額(クーポン発行時のみ)
+}
+
+type OrderClaimResponse struct {
+	Claim    *OrderClaim `json:"claim"`    // 返品・交換申請
+	User     *User       `json:"user"`     // 申請者
+	Products []*Product  `json:"products"` // 対象商品一覧
+}
+
+type OrderClaimsResponse struct {
+	Claims   []*OrderClaim `json:"claims"`   // 返品・交換申請一覧
+	Users    []*User       `json:"users"`    // 申請者一覧
+	Products []*Product    `json:"products"` // 対象商品一覧
+	Total    int64         `json:"total"`    // 合計数
+}
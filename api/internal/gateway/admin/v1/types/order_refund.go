@@ -9,6 +9,26 @@ const (
 	RefundTypeRefunded RefundType = 2 // 返金
 )
 
+// OrderRefundLineType - 返金種別
+type OrderRefundLineType int32
+
+const (
+	OrderRefundLineTypeUnknown OrderRefundLineType = 0
+	OrderRefundLineTypeFull    OrderRefundLineType = 1 // 全額(残額)返金
+	OrderRefundLineTypeItem    OrderRefundLineType = 2 // 商品単位の返金
+	OrderRefundLineTypeAmount  OrderRefundLineType = 3 // 金額指定の返金
+)
+
+// OrderRefundLineStatus - 返金処理状況
+type OrderRefundLineStatus int32
+
+const (
+	OrderRefundLineStatusUnknown   OrderRefundLineStatus = 0
+	OrderRefundLineStatusPending   OrderRefundLineStatus = 1 // 返金処理中
+	OrderRefundLineStatusSucceeded OrderRefundLineStatus = 2 // 返金完了
+	OrderRefundLineStatusFailed    OrderRefundLineStatus = 3 // 返金失敗
+)
+
 // OrderRefund - 注文キャンセル情報
 type OrderRefund struct {
 	Total      int64      `json:"total"`      // 返金金額
@@ -17,3 +37,28 @@ type OrderRefund struct {
 	Canceled   bool       `json:"canceled"`   // 注文キャンセルフラグ
 	CanceledAt int64      `json:"canceledAt"` // 注文キャンセル日時
 }
+
+// OrderRefundLine - 注文返金明細
+type OrderRefundLine struct {
+	ID         string                 `json:"id"`         // 返金明細ID
+	OrderID    string                 `json:"orderId"`    // 注文履歴ID
+	Type       OrderRefundLineType    `json:"type"`       // 返金種別
+	Status     OrderRefundLineStatus  `json:"status"`     // 返金処理状況
+	Amount     int64                  `json:"amount"`     // 返金金額(税込)
+	Reason     string                 `json:"reason"`     // 返金理由
+	Items      []*OrderRefundLineItem `json:"items"`      // 返金対象商品一覧
+	RefundedAt int64                  `json:"refundedAt"` // 返金日時
+	CreatedAt  int64                  `json:"createdAt"`  // 登録日時
+	UpdatedAt  int64                  `json:"updatedAt"`  // 更新日時
+}
+
+// OrderRefundLineItem - 返金対象商品
+type OrderRefundLineItem struct {
+	ProductID string `json:"productId"` // 商品ID
+	Quantity  int64  `json:"quantity"`  // 返金数量
+	Amount    int64  `json:"amount"`    // 返金金額(税込)
+}
+
+type OrderRefundLinesResponse struct {
+	Refunds []*OrderRefundLine `json:"refunds"` // 返金明細一覧
+}
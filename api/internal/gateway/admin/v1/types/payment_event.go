@@ -0,0 +1,39 @@
+package types
+
+// PaymentEventStatus - 決済イベントの処理状況
+type PaymentEventStatus int32
+
+const (
+	PaymentEventStatusUnknown    PaymentEventStatus = 0
+	PaymentEventStatusPending    PaymentEventStatus = 1 // 未処理
+	PaymentEventStatusSucceeded  PaymentEventStatus = 2 // 処理済み
+	PaymentEventStatusFailed     PaymentEventStatus = 3 // 処理失敗
+	PaymentEventStatusIgnored    PaymentEventStatus = 4 // 処理対象外
+	PaymentEventStatusProcessing PaymentEventStatus = 5 // 処理中
+	PaymentEventStatusRejected   PaymentEventStatus = 6 // 受付拒否
+)
+
+// PaymentEvent - 決済イベント
+type PaymentEvent struct {
+	ID                string              `json:"id"`                // 決済イベントID
+	ProviderType      PaymentProviderType `json:"providerType"`      // 決済プロバイダー種別
+	EventID           string              `json:"eventId"`           // 決済プロバイダー側のイベントID
+	EventType         string              `json:"eventType"`         // イベント種別
+	Payload           string              `json:"payload"`           // 受信したリクエストボディ
+	SignatureVerified bool                `json:"signatureVerified"` // 署名検証結果
+	Status            PaymentEventStatus  `json:"status"`            // 処理状況
+	ErrorMessage      string              `json:"errorMessage"`      // エラー内容
+	AttemptCount      int64               `json:"attemptCount"`      // 処理試行回数
+	ProcessedAt       int64               `json:"processedAt"`       // 処理日時
+	CreatedAt         int64               `json:"createdAt"`         // 受信日時
+	UpdatedAt         int64               `json:"updatedAt"`         // 更新日時
+}
+
+type PaymentEventResponse struct {
+	PaymentEvent *PaymentEvent `json:"paymentEvent"` // 決済イベント
+}
+
+type PaymentEventsResponse struct {
+	PaymentEvents []*PaymentEvent `json:"paymentEvents"` // 決済イベント一覧
+	Total         int64           `json:"total"`         // 合計数
+}
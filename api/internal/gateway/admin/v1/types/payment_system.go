@@ -22,6 +22,7 @@ type PaymentSystem struct {
 	MethodType   PaymentMethodType   `json:"methodType"`   // 決済システム種別
 	ProviderType PaymentProviderType `json:"providerType"` // 決済プロバイダー種別
 	Status       PaymentSystemStatus `json:"status"`       // 決済システム状態
+	FeeRate      float64             `json:"feeRate"`      // 決済手数料率(%)
 	CreatedAt    int64               `json:"createdAt"`    // 登録日時
 	UpdatedAt    int64               `json:"updatedAt"`    // 更新日時
 }
@@ -29,6 +30,7 @@ type PaymentSystem struct {
 type UpdatePaymentSystemRequest struct {
 	Status       PaymentSystemStatus `json:"status" validate:"required"`       // 決済システム状態
 	ProviderType PaymentProviderType `json:"providerType" validate:"required"` // 決済プロバイダー種別
+	FeeRate      float64             `json:"feeRate" validate:"min=0,max=100"` // 決済手数料率(%)
 }
 
 type PaymentSystemsResponse struct {
@@ -0,0 +1,31 @@
+package types
+
+// PreorderBatchStatus - 予約注文バッチの状態
+type PreorderBatchStatus int32
+
+const (
+	PreorderBatchStatusUnknown   PreorderBatchStatus = 0
+	PreorderBatchStatusAccepting PreorderBatchStatus = 1 // 予約受付中
+	PreorderBatchStatusReady     PreorderBatchStatus = 2 // 出荷準備完了
+)
+
+// PreorderBatch - 予約注文バッチ
+type PreorderBatch struct {
+	ID                      string              `json:"id"`                      // 予約注文バッチID
+	CoordinatorID           string              `json:"coordinatorId"`           // コーディネータID
+	Status                  PreorderBatchStatus `json:"status"`                  // 予約注文バッチの状態
+	ExpectedShippingStartAt int64               `json:"expectedShippingStartAt"` // 出荷予定期間(開始)
+	ExpectedShippingEndAt   int64               `json:"expectedShippingEndAt"`   // 出荷予定期間(終了)
+	ReadyAt                 int64               `json:"readyAt"`                 // 出荷準備完了日時
+	CreatedAt               int64               `json:"createdAt"`               // 登録日時
+	UpdatedAt               int64               `json:"updatedAt"`               // 更新日時
+}
+
+type PreorderBatchResponse struct {
+	PreorderBatch *PreorderBatch `json:"preorderBatch"` // 予約注文バッチ
+}
+
+type PreorderBatchesResponse struct {
+	PreorderBatches []*PreorderBatch `json:"preorderBatches"` // 予約注文バッチ一覧
+	Total           int64            `json:"total"`           // 合計数
+}
@@ -33,6 +33,15 @@ const (
 	StorageMethodTypeFrozen       StorageMethodType = 4 // 冷凍保存
 )
 
+// TaxCategory - 消費税区分
+type TaxCategory int32
+
+const (
+	TaxCategoryUnknown  TaxCategory = 0
+	TaxCategoryStandard TaxCategory = 1 // 標準税率(10%)
+	TaxCategoryReduced  TaxCategory = 2 // 軽減税率(8%)
+)
+
 // DeliveryType - 配送方法
 type DeliveryType int32
 
@@ -45,38 +54,41 @@ const (
 
 // Product - 商品情報
 type Product struct {
-	ID                   string            `json:"id"`                   // 商品ID
-	CoordinatorID        string            `json:"coordinatorId"`        // コーディネータID
-	ProducerID           string            `json:"producerId"`           // 生産者ID
-	CategoryID           string            `json:"categoryId"`           // 商品種別ID
-	ProductTypeID        string            `json:"productTypeId"`        // 品目ID
-	ProductTagIDs        []string          `json:"productTagIds"`        // 商品タグID一覧
-	Name                 string            `json:"name"`                 // 商品名
-	Description          string            `json:"description"`          // 商品説明
-	Scope                ProductScope      `json:"scope"`                // 公開範囲
-	Status               ProductStatus     `json:"status"`               // 販売状況
-	Inventory            int64             `json:"inventory"`            // 在庫数
-	Weight               float64           `json:"weight"`               // 重量(kg,少数第一位まで)
-	ItemUnit             string            `json:"itemUnit"`             // 数量単位
-	ItemDescription      string            `json:"itemDescription"`      // 数量単位説明
-	Media                []*ProductMedia   `json:"media"`                // メディア一覧
-	Price                int64             `json:"price"`                // 販売価格(税込)
-	Cost                 int64             `json:"cost"`                 // 原価
-	ExpirationDate       int64             `json:"expirationDate"`       // 賞味期限(単位:日)
-	RecommendedPoint1    string            `json:"recommendedPoint1"`    // おすすめポイント1
-	RecommendedPoint2    string            `json:"recommendedPoint2"`    // おすすめポイント2
-	RecommendedPoint3    string            `json:"recommendedPoint3"`    // おすすめポイント3
-	StorageMethodType    StorageMethodType `json:"storageMethodType"`    // 保存方法
-	DeliveryType         DeliveryType      `json:"deliveryType"`         // 配送方法
-	Box60Rate            int64             `json:"box60Rate"`            // 箱の占有率(サイズ:60)
-	Box80Rate            int64             `json:"box80Rate"`            // 箱の占有率(サイズ:80)
-	Box100Rate           int64             `json:"box100Rate"`           // 箱の占有率(サイズ:100)
-	OriginPrefectureCode int32             `json:"originPrefectureCode"` // 原産地(都道府県)
-	OriginCity           string            `json:"originCity"`           // 原産地(市区町村)
-	StartAt              int64             `json:"startAt"`              // 販売開始日時
-	EndAt                int64             `json:"endAt"`                // 販売終了日時
-	CreatedAt            int64             `json:"createdAt"`            // 登録日時
-	UpdatedAt            int64             `json:"updatedAt"`            // 更新日時
+	ID                      string            `json:"id"`                      // 商品ID
+	CoordinatorID           string            `json:"coordinatorId"`           // コーディネータID
+	ProducerID              string            `json:"producerId"`              // 生産者ID
+	CategoryID              string            `json:"categoryId"`              // 商品種別ID
+	ProductTypeID           string            `json:"productTypeId"`           // 品目ID
+	ProductTagIDs           []string          `json:"productTagIds"`           // 商品タグID一覧
+	Name                    string            `json:"name"`                    // 商品名
+	Description             string            `json:"description"`             // 商品説明
+	Scope                   ProductScope      `json:"scope"`                   // 公開範囲
+	Status                  ProductStatus     `json:"status"`                  // 販売状況
+	Inventory               int64             `json:"inventory"`               // 在庫数
+	Weight                  float64           `json:"weight"`                  // 重量(kg,少数第一位まで)
+	ItemUnit                string            `json:"itemUnit"`                // 数量単位
+	ItemDescription         string            `json:"itemDescription"`         // 数量単位説明
+	Media                   []*ProductMedia   `json:"media"`                   // メディア一覧
+	Price                   int64             `json:"price"`                   // 販売価格(税込)
+	Cost                    int64             `json:"cost"`                    // 原価
+	TaxCategory             TaxCategory       `json:"taxCategory"`             // 消費税区分
+	ExpirationDate          int64             `json:"expirationDate"`          // 賞味期限(単位:日)
+	RecommendedPoint1       string            `json:"recommendedPoint1"`       // おすすめポイント1
+	RecommendedPoint2       string            `json:"recommendedPoint2"`       // おすすめポイント2
+	RecommendedPoint3       string            `json:"recommendedPoint3"`       // おすすめポイント3
+	StorageMethodType       StorageMethodType `json:"storageMethodType"`       // 保存方法
+	DeliveryType            DeliveryType      `json:"deliveryType"`            // 配送方法
+	Box60Rate               int64             `json:"box60Rate"`               // 箱の占有率(サイズ:60)
+	Box80Rate               int64             `json:"box80Rate"`               // 箱の占有率(サイズ:80)
+	Box100Rate              int64             `json:"box100Rate"`              // 箱の占有率(サイズ:100)
+	OriginPrefectureCode    int32             `json:"originPrefectureCode"`    // 原産地(都道府県)
+	OriginCity              string            `json:"originCity"`              // 原産地(市区町村)
+	StartAt                 int64             `json:"startAt"`                 // 販売開始日時
+	EndAt                   int64             `json:"endAt"`                   // 販売終了日時
+	ExpectedShippingStartAt int64             `json:"expectedShippingStartAt"` // 出荷予定期間(開始)
+	ExpectedShippingEndAt   int64             `json:"expectedShippingEndAt"`   // 出荷予定期間(終了)
+	CreatedAt               int64             `json:"createdAt"`               // 登録日時
+	UpdatedAt               int64             `json:"updatedAt"`               // 更新日時
 }
 
 // ProductMedia - 商品メディア情報
@@ -86,33 +98,36 @@ type ProductMedia struct {
 }
 
 type CreateProductRequest struct {
-	Name                 string                `json:"name" validate:"required,max=64"`                       // 商品名
-	Description          string                `json:"description" validate:"required,max=2000"`              // 商品説明
-	Scope                ProductScope          `json:"scope" validate:"required"`                             // 公開範囲
-	CoordinatorID        string                `json:"coordinatorId" validate:"required"`                     // コーディネータID
-	ProducerID           string                `json:"producerId" validate:"required"`                        // 生産者ID
-	TypeID               string                `json:"productTypeId" validate:"required"`                     // 品目ID
-	TagIDs               []string              `json:"productTagIds" validate:"max=8,dive,required"`          // 商品タグID一覧
-	Inventory            int64                 `json:"inventory" validate:"min=0"`                            // 在庫数
-	Weight               float64               `json:"weight" validate:"min=0"`                               // 重量(kg,少数第一位まで)
-	ItemUnit             string                `json:"itemUnit" validate:"required,max=16"`                   // 数量単位
-	ItemDescription      string                `json:"itemDescription" validate:"required,max=64"`            // 数量単位説明
-	Media                []*CreateProductMedia `json:"media" validate:"max=8,dive"`                           // メディア一覧
-	Price                int64                 `json:"price" validate:"min=0"`                                // 販売価格(税込)
-	Cost                 int64                 `json:"cost" validate:"min=0"`                                 // 原価(税込)
-	ExpirationDate       int64                 `json:"expirationDate" validate:"min=0"`                       // 賞味期限(単位:日)
-	RecommendedPoint1    string                `json:"recommendedPoint1" validate:"omitempty,max=128"`        // おすすめポイント1
-	RecommendedPoint2    string                `json:"recommendedPoint2" validate:"omitempty,max=128"`        // おすすめポイント2
-	RecommendedPoint3    string                `json:"recommendedPoint3" validate:"omitempty,max=128"`        // おすすめポイント3
-	StorageMethodType    StorageMethodType     `json:"storageMethodType" validate:"required"`                 // 保存方法
-	DeliveryType         DeliveryType          `json:"deliveryType" validate:"required"`                      // 配送方法
-	Box60Rate            int64                 `json:"box60Rate" validate:"min=0,max=600"`                    // 箱の占有率(サイズ:60)
-	Box80Rate            int64                 `json:"box80Rate" validate:"min=0,max=250"`                    // 箱の占有率(サイズ:80)
-	Box100Rate           int64                 `json:"box100Rate" validate:"min=0,max=100"`                   // 箱の占有率(サイズ:100)
-	OriginPrefectureCode int32                 `json:"originPrefectureCode" validate:"required,min=1,max=47"` // 原産地(都道府県)
-	OriginCity           string                `json:"originCity" validate:"max=32"`                          // 原産地(市区町村)
-	StartAt              int64                 `json:"startAt" validate:"required"`                           // 販売開始日時
-	EndAt                int64                 `json:"endAt" validate:"required,gtfield=StartAt"`             // 販売終了日時
+	Name                    string                `json:"name" validate:"required,max=64"`                                                                                   // 商品名
+	Description             string                `json:"description" validate:"required,max=2000"`                                                                          // 商品説明
+	Scope                   ProductScope          `json:"scope" validate:"required"`                                                                                         // 公開範囲
+	CoordinatorID           string                `json:"coordinatorId" validate:"required"`                                                                                 // コーディネータID
+	ProducerID              string                `json:"producerId" validate:"required"`                                                                                    // 生産者ID
+	TypeID                  string                `json:"productTypeId" validate:"required"`                                                                                 // 品目ID
+	TagIDs                  []string              `json:"productTagIds" validate:"max=8,dive,required"`                                                                      // 商品タグID一覧
+	Inventory               int64                 `json:"inventory" validate:"min=0"`                                                                                        // 在庫数
+	Weight                  float64               `json:"weight" validate:"min=0"`                                                                                           // 重量(kg,少数第一位まで)
+	ItemUnit                string                `json:"itemUnit" validate:"required,max=16"`                                                                               // 数量単位
+	ItemDescription         string                `json:"itemDescription" validate:"required,max=64"`                                                                        // 数量単位説明
+	Media                   []*CreateProductMedia `json:"media" validate:"max=8,dive"`                                                                                       // メディア一覧
+	Price                   int64                 `json:"price" validate:"min=0"`                                                                                            // 販売価格(税込)
+	Cost                    int64                 `json:"cost" validate:"min=0"`                                                                                             // 原価(税込)
+	TaxCategory             TaxCategory           `json:"taxCategory" validate:"omitempty,oneof=1 2"`                                                                        // 消費税区分（未指定の場合は標準税率）
+	ExpirationDate          int64                 `json:"expirationDate" validate:"min=0"`                                                                                   // 賞味期限(単位:日)
+	RecommendedPoint1       string                `json:"recommendedPoint1" validate:"omitempty,max=128"`                                                                    // おすすめポイント1
+	RecommendedPoint2       string                `json:"recommendedPoint2" validate:"omitempty,max=128"`                                                                    // おすすめポイント2
+	RecommendedPoint3       string                `json:"recommendedPoint3" validate:"omitempty,max=128"`                                                                    // おすすめポイント3
+	StorageMethodType       StorageMethodType     `json:"storageMethodType" validate:"required"`                                                                             // 保存方法
+	DeliveryType            DeliveryType          `json:"deliveryType" validate:"required"`                                                                                  // 配送方法
+	Box60Rate               int64                 `json:"box60Rate" validate:"min=0,max=2400"`                                                                               // 箱の占有率(サイズ:60)
+	Box80Rate               int64                 `json:"box80Rate" validate:"min=0,max=1000"`                                                                               // 箱の占有率(サイズ:80)
+	Box100Rate              int64                 `json:"box100Rate" validate:"min=0,max=400"`                                                                               // 箱の占有率(サイズ:100)
+	OriginPrefectureCode    int32                 `json:"originPrefectureCode" validate:"required,min=1,max=47"`                                                             // 原産地(都道府県)
+	OriginCity              string                `json:"originCity" validate:"max=32"`                                                                                      // 原産地(市区町村)
+	StartAt                 int64                 `json:"startAt" validate:"required"`                                                                                       // 販売開始日時
+	EndAt                   int64                 `json:"endAt" validate:"required,gtfield=StartAt"`                                                                         // 販売終了日時
+	ExpectedShippingStartAt int64                 `json:"expectedShippingStartAt" validate:"required_with=ExpectedShippingEndAt"`                                            // 出荷予定期間(開始)
+	ExpectedShippingEndAt   int64                 `json:"expectedShippingEndAt" validate:"required_with=ExpectedShippingStartAt,omitempty,gtefield=ExpectedShippingStartAt"` // 出荷予定期間(終了)
 }
 
 type CreateProductMedia struct {
@@ -121,31 +136,34 @@ type CreateProductMedia struct {
 }
 
 type UpdateProductRequest struct {
-	Name                 string                `json:"name" validate:"required,max=64"`                       // 商品名
-	Description          string                `json:"description" validate:"required,max=2000"`              // 商品説明
-	Scope                ProductScope          `json:"scope" validate:"required"`                             // 公開範囲
-	TypeID               string                `json:"productTypeId" validate:"required"`                     // 品目ID
-	TagIDs               []string              `json:"productTagIds" validate:"max=8,dive,required"`          // 商品タグID一覧
-	Inventory            int64                 `json:"inventory" validate:"min=0"`                            // 在庫数
-	Weight               float64               `json:"weight" validate:"min=0"`                               // 重量(kg,少数第一位まで)
-	ItemUnit             string                `json:"itemUnit" validate:"required,max=16"`                   // 数量単位
-	ItemDescription      string                `json:"itemDescription" validate:"required,max=64"`            // 数量単位説明
-	Media                []*UpdateProductMedia `json:"media" validate:"max=8,dive"`                           // メディア一覧
-	Price                int64                 `json:"price" validate:"min=0"`                                // 販売価格(税込)
-	Cost                 int64                 `json:"cost" validate:"min=0"`                                 // 原価(税込)
-	ExpirationDate       int64                 `json:"expirationDate" validate:"min=0"`                       // 賞味期限(単位:日)
-	RecommendedPoint1    string                `json:"recommendedPoint1" validate:"omitempty,max=128"`        // おすすめポイント1
-	RecommendedPoint2    string                `json:"recommendedPoint2" validate:"omitempty,max=128"`        // おすすめポイント2
-	RecommendedPoint3    string                `json:"recommendedPoint3" validate:"omitempty,max=128"`        // おすすめポイント3
-	StorageMethodType    StorageMethodType     `json:"storageMethodType" validate:"required"`                 // 保存方法
-	DeliveryType         DeliveryType          `json:"deliveryType" validate:"required"`                      // 配送方法
-	Box60Rate            int64                 `json:"box60Rate" validate:"min=0,max=600"`                    // 箱の占有率(サイズ:60)
-	Box80Rate            int64                 `json:"box80Rate" validate:"min=0,max=250"`                    // 箱の占有率(サイズ:80)
-	Box100Rate           int64                 `json:"box100Rate" validate:"min=0,max=100"`                   // 箱の占有率(サイズ:100)
-	OriginPrefectureCode int32                 `json:"originPrefectureCode" validate:"required,min=1,max=47"` // 原産地(都道府県)
-	OriginCity           string                `json:"originCity" validate:"max=32"`                          // 原産地(市区町村)
-	StartAt              int64                 `json:"startAt" validate:"required"`                           // 販売開始日時
-	EndAt                int64                 `json:"endAt" validate:"required,gtfield=StartAt"`             // 販売終了日時
+	Name                    string                `json:"name" validate:"required,max=64"`                                                                                   // 商品名
+	Description             string                `json:"description" validate:"required,max=2000"`                                                                          // 商品説明
+	Scope                   ProductScope          `json:"scope" validate:"required"`                                                                                         // 公開範囲
+	TypeID                  string                `json:"productTypeId" validate:"required"`                                                                                 // 品目ID
+	TagIDs                  []string              `json:"productTagIds" validate:"max=8,dive,required"`                                                                      // 商品タグID一覧
+	Inventory               int64                 `json:"inventory" validate:"min=0"`                                                                                        // 在庫数
+	Weight                  float64               `json:"weight" validate:"min=0"`                                                                                           // 重量(kg,少数第一位まで)
+	ItemUnit                string                `json:"itemUnit" validate:"required,max=16"`                                                                               // 数量単位
+	ItemDescription         string                `json:"itemDescription" validate:"required,max=64"`                                                                        // 数量単位説明
+	Media                   []*UpdateProductMedia `json:"media" validate:"max=8,dive"`                                                                                       // メディア一覧
+	Price                   int64                 `json:"price" validate:"min=0"`                                                                                            // 販売価格(税込)
+	Cost                    int64                 `json:"cost" validate:"min=0"`                                                                                             // 原価(税込)
+	TaxCategory             TaxCategory           `json:"taxCategory" validate:"omitempty,oneof=1 2"`                                                                        // 消費税区分（未指定の場合は標準税率）
+	ExpirationDate          int64                 `json:"expirationDate" validate:"min=0"`                                                                                   // 賞味期限(単位:日)
+	RecommendedPoint1       string                `json:"recommendedPoint1" validate:"omitempty,max=128"`                                                                    // おすすめポイント1
+	RecommendedPoint2       string                `json:"recommendedPoint2" validate:"omitempty,max=128"`                                                                    // おすすめポイント2
+	RecommendedPoint3       string                `json:"recommendedPoint3" validate:"omitempty,max=128"`                                                                    // おすすめポイント3
+	StorageMethodType       StorageMethodType     `json:"storageMethodType" validate:"required"`                                                                             // 保存方法
+	DeliveryType            DeliveryType          `json:"deliveryType" validate:"required"`                                                                                  // 配送方法
+	Box60Rate               int64                 `json:"box60Rate" validate:"min=0,max=2400"`                                                                               // 箱の占有率(サイズ:60)
+	Box80Rate               int64                 `json:"box80Rate" validate:"min=0,max=1000"`                                                                               // 箱の占有率(サイズ:80)
+	Box100Rate              int64                 `json:"box100Rate" validate:"min=0,max=400"`                                                                               // 箱の占有率(サイズ:100)
+	OriginPrefectureCode    int32                 `json:"originPrefectureCode" validate:"required,min=1,max=47"`                                                             // 原産地(都道府県)
+	OriginCity              string                `json:"originCity" validate:"max=32"`                                                                                      // 原産地(市区町村)
+	StartAt                 int64                 `json:"startAt" validate:"required"`                                                                                       // 販売開始日時
+	EndAt                   int64                 `json:"endAt" validate:"required,gtfield=StartAt"`                                                                         // 販売終了日時
+	ExpectedShippingStartAt int64                 `json:"expectedShippingStartAt" validate:"required_with=ExpectedShippingEndAt"`                                            // 出荷予定期間(開始)
+	ExpectedShippingEndAt   int64                 `json:"expectedShippingEndAt" validate:"required_with=ExpectedShippingStartAt,omitempty,gtefield=ExpectedShippingStartAt"` // 出荷予定期間(終了)
 }
 
 type UpdateProductMedia struct {
@@ -0,0 +1,12 @@
+package types
+
+// ProductInventory - 商品在庫状況
+type ProductInventory struct {
+	ProductID string `json:"productId"` // 商品ID
+	Available int64  `json:"available"` // 販売可能数
+	Held      int64  `json:"held"`      // 仮押さえ中の数量(決済待ち)
+}
+
+type ProductInventoryResponse struct {
+	Inventory *ProductInventory `json:"inventory"` // 商品在庫状況
+}
@@ -19,6 +19,18 @@ const (
 	DiscountTypeAmount       DiscountType = 1 // 固定額(円)
 	DiscountTypeRate         DiscountType = 2 // 料率計算(%)
 	DiscountTypeFreeShipping DiscountType = 3 // 送料無料
+	DiscountTypeRule         DiscountType = 4 // 適用ルールによる割引
+)
+
+// PromotionRuleType - 適用ルール種別
+type PromotionRuleType int32
+
+const (
+	PromotionRuleTypeUnknown    PromotionRuleType = 0
+	PromotionRuleTypeAmount     PromotionRuleType = 1 // 固定額(円)
+	PromotionRuleTypeRate       PromotionRuleType = 2 // 料率計算(%)
+	PromotionRuleTypeTieredRate PromotionRuleType = 3 // 段階料率(%)
+	PromotionRuleTypeBuyXGetY   PromotionRuleType = 4 // N個購入でM個無料
 )
 
 // PromotionTargetType - プロモーションの対象
@@ -32,44 +44,97 @@ const (
 
 // Promotion - プロモーション情報
 type Promotion struct {
-	ID           string              `json:"id"`           // プロモーションID
-	ShopID       string              `json:"shopId"`       // 店舗ID
-	Title        string              `json:"title"`        // タイトル
-	Description  string              `json:"description"`  // 詳細説明
-	Status       PromotionStatus     `json:"status"`       // ステータス
-	Public       bool                `json:"public"`       // 公開フラグ
-	TargetType   PromotionTargetType `json:"targetType"`   // 対象商品
-	DiscountType DiscountType        `json:"discountType"` // 割引計算方法
-	DiscountRate int64               `json:"discountRate"` // 割引額(%/円)
-	Code         string              `json:"code"`         // クーポンコード
-	StartAt      int64               `json:"startAt"`      // クーポン使用可能日時(開始)
-	EndAt        int64               `json:"endAt"`        // クーポン使用可能日時(終了)
-	UsedCount    int64               `json:"usedCount"`    // 使用回数
-	UsedAmount   int64               `json:"usedAmount"`   // 使用による割引合計額
-	CreatedAt    int64               `json:"createdAt"`    // 登録日時
-	UpdatedAt    int64               `json:"updatedAt"`    // 更新日時
+	ID                string              `json:"id"`                // プロモーションID
+	ShopID            string              `json:"shopId"`            // 店舗ID
+	Title             string              `json:"title"`             // タイトル
+	Description       string              `json:"description"`       // 詳細説明
+	Status            PromotionStatus     `json:"status"`            // ステータス
+	Public            bool                `json:"public"`            // 公開フラグ
+	TargetType        PromotionTargetType `json:"targetType"`        // 対象商品
+	DiscountType      DiscountType        `json:"discountType"`      // 割引計算方法
+	DiscountRate      int64               `json:"discountRate"`      // 割引額(%/円)
+	Rules             []*PromotionRule    `json:"rules"`             // 適用ルール一覧
+	Code              string              `json:"code"`              // クーポンコード
+	StartAt           int64               `json:"startAt"`           // クーポン使用可能日時(開始)
+	EndAt             int64               `json:"endAt"`             // クーポン使用可能日時(終了)
+	UsedCount         int64               `json:"usedCount"`         // 使用回数
+	UsedAmount        int64               `json:"usedAmount"`        // 使用による割引合計額
+	UsageLimit        int64               `json:"usageLimit"`        // 利用上限回数(全体, 0:無制限)
+	UsageLimitPerUser int64               `json:"usageLimitPerUser"` // 利用上限回数(ユーザー毎, 0:無制限)
+	FirstPurchaseOnly bool                `json:"firstPurchaseOnly"` // 初回購入限定
+	RedeemedCount     int64               `json:"redeemedCount"`     // 利用回数(決済待ちを含む)
+	RemainingCount    int64               `json:"remainingCount"`    // 残り利用可能回数(-1:無制限)
+	CreatedAt         int64               `json:"createdAt"`         // 登録日時
+	UpdatedAt         int64               `json:"updatedAt"`         // 更新日時
+}
+
+// PromotionRule - プロモーション適用ルール
+type PromotionRule struct {
+	ID             string               `json:"id"`             // ルールID
+	Type           PromotionRuleType    `json:"type"`           // ルール種別
+	ProductIDs     []string             `json:"productIds"`     // 対象商品ID一覧
+	ProductTypeIDs []string             `json:"productTypeIds"` // 対象品目ID一覧
+	CategoryIDs    []string             `json:"categoryIds"`    // 対象カテゴリID一覧
+	MinimumAmount  int64                `json:"minimumAmount"`  // 適用に必要な対象商品の購入金額(税込)
+	DiscountRate   int64                `json:"discountRate"`   // 割引額(%/円)
+	Tiers          []*PromotionRuleTier `json:"tiers"`          // 段階料率一覧
+	BuyQuantity    int64                `json:"buyQuantity"`    // 購入数量(N個購入)
+	FreeQuantity   int64                `json:"freeQuantity"`   // 無料数量(M個無料)
+	MaxDiscount    int64                `json:"maxDiscount"`    // 割引上限額(円)
+}
+
+// PromotionRuleTier - 段階料率
+type PromotionRuleTier struct {
+	Threshold int64 `json:"threshold"` // 適用に必要な対象商品の購入金額(税込)
+	Rate      int64 `json:"rate"`      // 割引率(%)
 }
 
 type CreatePromotionRequest struct {
-	Title        string       `json:"title" validate:"required,max=64"`
-	Description  string       `json:"description" validate:"required,max=2000"`
-	Public       bool         `json:"public" validate:""`
-	DiscountType DiscountType `json:"discountType" validate:"required"`
-	DiscountRate int64        `json:"discountRate" validate:"min=0"`
-	Code         string       `json:"code" validate:"len=8"`
-	StartAt      int64        `json:"startAt" validate:"required"`
-	EndAt        int64        `json:"endAt" validate:"required,gtfield=StartAt"`
+	Title             string                  `json:"title" validate:"required,max=64"`
+	Description       string                  `json:"description" validate:"required,max=2000"`
+	Public            bool                    `json:"public" validate:""`
+	DiscountType      DiscountType            `json:"discountType" validate:"required"`
+	DiscountRate      int64                   `json:"discountRate" validate:"min=0"`
+	Rules             []*PromotionRuleRequest `json:"rules" validate:"dive,required"`
+	Code              string                  `json:"code" validate:"len=8"`
+	StartAt           int64                   `json:"startAt" validate:"required"`
+	EndAt             int64                   `json:"endAt" validate:"required,gtfield=StartAt"`
+	UsageLimit        int64                   `json:"usageLimit" validate:"min=0"`
+	UsageLimitPerUser int64                   `json:"usageLimitPerUser" validate:"min=0"`
+	FirstPurchaseOnly bool                    `json:"firstPurchaseOnly" validate:""`
 }
 
 type UpdatePromotionRequest struct {
-	Title        string       `json:"title" validate:"required,max=64"`
-	Description  string       `json:"description" validate:"required,max=2000"`
-	Public       bool         `json:"public" validate:""`
-	DiscountType DiscountType `json:"discountType" validate:"required"`
-	DiscountRate int64        `json:"discountRate" validate:"min=0"`
-	Code         string       `json:"code" validate:"len=8"`
-	StartAt      int64        `json:"startAt" validate:"required"`
-	EndAt        int64        `json:"endAt" validate:"required,gtfield=StartAt"`
+	Title             string                  `json:"title" validate:"required,max=64"`
+	Description       string                  `json:"description" validate:"required,max=2000"`
+	Public            bool                    `json:"public" validate:""`
+	DiscountType      DiscountType            `json:"discountType" validate:"required"`
+	DiscountRate      int64                   `json:"discountRate" validate:"min=0"`
+	Rules             []*PromotionRuleRequest `json:"rules" validate:"dive,required"`
+	Code              string                  `json:"code" validate:"len=8"`
+	StartAt           int64                   `json:"startAt" validate:"required"`
+	EndAt             int64                   `json:"endAt" validate:"required,gtfield=StartAt"`
+	UsageLimit        int64                   `json:"usageLimit" validate:"min=0"`
+	UsageLimitPerUser int64                   `json:"usageLimitPerUser" validate:"min=0"`
+	FirstPurchaseOnly bool                    `json:"firstPurchaseOnly" validate:""`
+}
+
+type PromotionRuleRequest struct {
+	Type           PromotionRuleType           `json:"type" validate:"required"`
+	ProductIDs     []string                    `json:"productIds" validate:"dive,required"`
+	ProductTypeIDs []string                    `json:"productTypeIds" validate:"dive,required"`
+	CategoryIDs    []string                    `json:"categoryIds" validate:"dive,required"`
+	MinimumAmount  int64                       `json:"minimumAmount" validate:"min=0"`
+	DiscountRate   int64                       `json:"discountRate" validate:"min=0"`
+	Tiers          []*PromotionRuleTierRequest `json:"tiers" validate:"dive,required"`
+	BuyQuantity    int64                       `json:"buyQuantity" validate:"min=0"`
+	FreeQuantity   int64                       `json:"freeQuantity" validate:"min=0"`
+	MaxDiscount    int64                       `json:"maxDiscount" validate:"min=0"`
+}
+
+type PromotionRuleTierRequest struct {
+	Threshold int64 `json:"threshold" validate:"min=0"`
+	Rate      int64 `json:"rate" validate:"min=1,max=100"`
 }
 
 type PromotionResponse struct {
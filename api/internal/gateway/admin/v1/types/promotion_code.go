@@ -0,0 +1,68 @@
+package types
+
+// PromotionCodeBatchStatus - クーポンコード一括発行の状態
+type PromotionCodeBatchStatus int32
+
+const (
+	PromotionCodeBatchStatusUnknown PromotionCodeBatchStatus = 0
+	PromotionCodeBatchStatusActive  PromotionCodeBatchStatus = 1 // 有効
+	PromotionCodeBatchStatusVoided  PromotionCodeBatchStatus = 2 // 無効化済み
+)
+
+// PromotionCodeStatus - クーポンコードの利用状況
+type PromotionCodeStatus int32
+
+const (
+	PromotionCodeStatusUnknown   PromotionCodeStatus = 0
+	PromotionCodeStatusAvailable PromotionCodeStatus = 1 // 未使用
+	PromotionCodeStatusReserved  PromotionCodeStatus = 2 // 利用予約(決済待ち)
+	PromotionCodeStatusRedeemed  PromotionCodeStatus = 3 // 使用済み
+	PromotionCodeStatusVoided    PromotionCodeStatus = 4 // 無効
+)
+
+// PromotionCodeBatch - クーポンコード一括発行情報
+type PromotionCodeBatch struct {
+	ID          string                   `json:"id"`          // 一括発行ID
+	PromotionID string                   `json:"promotionId"` // プロモーションID
+	Title       string                   `json:"title"`       // 用途(配布先など)
+	Quantity    int64                    `json:"quantity"`    // 発行数
+	Status      PromotionCodeBatchStatus `json:"status"`      // 状態
+	VoidedAt    int64                    `json:"voidedAt"`    // 無効化日時
+	CreatedAt   int64                    `json:"createdAt"`   // 登録日時
+	UpdatedAt   int64                    `json:"updatedAt"`   // 更新日時
+}
+
+// PromotionCode - クーポンコード情報
+type PromotionCode struct {
+	Code        string              `json:"code"`        // クーポンコード
+	BatchID     string              `json:"batchId"`     // 一括発行ID
+	PromotionID string              `json:"promotionId"` // プロモーションID
+	Status      PromotionCodeStatus `json:"status"`      // 利用状況
+	OrderID     string              `json:"orderId"`     // 利用した注文履歴ID
+	RedeemedAt  int64               `json:"redeemedAt"`  // 利用日時
+	CreatedAt   int64               `json:"createdAt"`   // 登録日時
+	UpdatedAt   int64               `json:"updatedAt"`   // 更新日時
+}
+
+type CreatePromotionCodeBatchRequest struct {
+	Title    string `json:"title" validate:"required,max=64"`    // 用途(配布先など)
+	Quantity int64  `json:"quantity" validate:"min=1,max=10000"` // 発行数
+}
+
+type ExportPromotionCodesRequest struct {
+	CharacterEncodingType int32 `json:"characterEncodingType" validate:""` // 文字コード種別
+}
+
+type PromotionCodeBatchResponse struct {
+	Batch *PromotionCodeBatch `json:"batch"` // クーポンコード一括発行情報
+}
+
+type PromotionCodeBatchesResponse struct {
+	Batches []*PromotionCodeBatch `json:"batches"` // クーポンコード一括発行情報一覧
+	Total   int64                 `json:"total"`   // 合計数
+}
+
+type PromotionCodesResponse struct {
+	Codes []*PromotionCode `json:"codes"` // クーポンコード一覧
+	Total int64            `json:"total"` // 合計数
+}
@@ -0,0 +1,73 @@
+package types
+
+// SettlementPayeeType - 精算先種別
+type SettlementPayeeType int32
+
+const (
+	SettlementPayeeTypeUnknown     SettlementPayeeType = 0
+	SettlementPayeeTypeShop        SettlementPayeeType = 1 // 店舗
+	SettlementPayeeTypeCoordinator SettlementPayeeType = 2 // コーディネータ
+	SettlementPayeeTypeProducer    SettlementPayeeType = 3 // 生産者
+)
+
+// SettlementStatementStatus - 精算明細の支払い状況
+type SettlementStatementStatus int32
+
+const (
+	SettlementStatementStatusUnknown SettlementStatementStatus = 0
+	SettlementStatementStatusUnpaid  SettlementStatementStatus = 1 // 未払い
+	SettlementStatementStatusPaid    SettlementStatementStatus = 2 // 支払い済み
+)
+
+// SettlementStatement - 精算明細
+type SettlementStatement struct {
+	ID             string                    `json:"id"`             // 精算明細ID
+	PayeeType      SettlementPayeeType       `json:"payeeType"`      // 精算先種別
+	PayeeID        string                    `json:"payeeId"`        // 精算先ID
+	Status         SettlementStatementStatus `json:"status"`         // 支払い状況
+	PeriodStartAt  int64                     `json:"periodStartAt"`  // 精算対象期間(開始)
+	PeriodEndAt    int64                     `json:"periodEndAt"`    // 精算対象期間(終了)
+	OrderCount     int64                     `json:"orderCount"`     // 対象注文数
+	SalesTotal     int64                     `json:"salesTotal"`     // 売上金額(税込)
+	RefundTotal    int64                     `json:"refundTotal"`    // 返金金額(税込)
+	CommissionRate float64                   `json:"commissionRate"` // 販売手数料率(%)
+	CommissionFee  int64                     `json:"commissionFee"`  // 販売手数料
+	PaymentFee     int64                     `json:"paymentFee"`     // 決済手数料
+	PayoutTotal    int64                     `json:"payoutTotal"`    // 支払い金額
+	PaidAt         int64                     `json:"paidAt"`         // 支払い日時
+	CreatedAt      int64                     `json:"createdAt"`      // 登録日時
+	UpdatedAt      int64                     `json:"updatedAt"`      // 更新日時
+}
+
+// SettlementBalance - 精算先ごとの支払い残高
+type SettlementBalance struct {
+	PayeeType      SettlementPayeeType `json:"payeeType"`      // 精算先種別
+	PayeeID        string              `json:"payeeId"`        // 精算先ID
+	StatementCount int64               `json:"statementCount"` // 精算明細数
+	UnpaidTotal    int64               `json:"unpaidTotal"`    // 未払い金額
+	PaidTotal      int64               `json:"paidTotal"`      // 支払い済み金額
+}
+
+type CloseSettlementPeriodRequest struct {
+	PeriodStartAt int64 `json:"periodStartAt" validate:"required"` // 精算対象期間(開始)
+	PeriodEndAt   int64 `json:"periodEndAt" validate:"required"`   // 精算対象期間(終了)
+}
+
+type ExportSettlementStatementsRequest struct {
+	PeriodStartAt         int64               `json:"periodStartAt" validate:"required"` // 精算対象期間(開始)
+	PayeeType             SettlementPayeeType `json:"payeeType" validate:""`             // 精算先種別
+	CharacterEncodingType int32               `json:"characterEncodingType" validate:""` // 文字コード種別
+}
+
+type SettlementStatementResponse struct {
+	SettlementStatement *SettlementStatement `json:"settlementStatement"` // 精算明細
+}
+
+type SettlementStatementsResponse struct {
+	SettlementStatements []*SettlementStatement `json:"settlementStatements"` // 精算明細一覧
+	Total                int64                  `json:"total"`                // 合計数
+}
+
+type SettlementBalancesResponse struct {
+	SettlementBalances []*SettlementBalance `json:"settlementBalances"` // 支払い残高一覧
+}
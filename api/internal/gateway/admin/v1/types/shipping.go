@@ -2,19 +2,26 @@ package types
 
 // Shipping - 配送設定情報
 type Shipping struct {
-	ID                string          `json:"id"`                // 配送設定ID
-	Name              string          `json:"name"`              // 配送設定名
-	IsDefault         bool            `json:"isDefault"`         // デフォルト設定フラグ
-	Box60Rates        []*ShippingRate `json:"box60Rates"`        // 箱サイズ60の通常（常温・冷蔵便）配送料一覧
-	Box60Frozen       int64           `json:"box60Frozen"`       // 箱サイズ60の追加（冷凍便）追加配送料(税込)
-	Box80Rates        []*ShippingRate `json:"box80Rates"`        // 箱サイズ80の通常（常温・冷蔵便）配送料一覧
-	Box80Frozen       int64           `json:"box80Frozen"`       // 箱サイズ80の追加（冷凍便）追加配送料(税込)
-	Box100Rates       []*ShippingRate `json:"box100Rates"`       // 箱サイズ100の通常（常温・冷蔵便）配送料一覧
-	Box100Frozen      int64           `json:"box100Frozen"`      // 箱サイズ100の追加（冷凍便）追加配送料(税込)
-	HasFreeShipping   bool            `json:"hasFreeShipping"`   // 送料無料オプションの有無
-	FreeShippingRates int64           `json:"freeShippingRates"` // 送料無料になる金額(税込)
-	CreatedAt         int64           `json:"createdAt"`         // 登録日時
-	UpdatedAt         int64           `json:"updatedAt"`         // 更新日時
+	ID                string                `json:"id"`                // 配送設定ID
+	Name              string                `json:"name"`              // 配送設定名
+	IsDefault         bool                  `json:"isDefault"`         // デフォルト設定フラグ
+	Box60Rates        []*ShippingRate       `json:"box60Rates"`        // 箱サイズ60の通常（常温・冷蔵便）配送料一覧
+	Box60Frozen       int64                 `json:"box60Frozen"`       // 箱サイズ60の追加（冷凍便）追加配送料(税込)
+	Box80Rates        []*ShippingRate       `json:"box80Rates"`        // 箱サイズ80の通常（常温・冷蔵便）配送料一覧
+	Box80Frozen       int64                 `json:"box80Frozen"`       // 箱サイズ80の追加（冷凍便）追加配送料(税込)
+	Box100Rates       []*ShippingRate       `json:"box100Rates"`       // 箱サイズ100の通常（常温・冷蔵便）配送料一覧
+	Box100Frozen      int64                 `json:"box100Frozen"`      // 箱サイズ100の追加（冷凍便）追加配送料(税込)
+	Box120Rates       []*ShippingRate       `json:"box120Rates"`       // 箱サイズ120の通常（常温・冷蔵便）配送料一覧
+	Box120Frozen      int64                 `json:"box120Frozen"`      // 箱サイズ120の追加（冷凍便）追加配送料(税込)
+	Box140Rates       []*ShippingRate       `json:"box140Rates"`       // 箱サイズ140の通常（常温・冷蔵便）配送料一覧
+	Box140Frozen      int64                 `json:"box140Frozen"`      // 箱サイズ140の追加（冷凍便）追加配送料(税込)
+	Box160Rates       []*ShippingRate       `json:"box160Rates"`       // 箱サイズ160の通常（常温・冷蔵便）配送料一覧
+	Box160Frozen      int64                 `json:"box160Frozen"`      // 箱サイズ160の追加（冷凍便）追加配送料(税込)
+	RegionRules       []*ShippingRegionRule `json:"regionRules"`       // 地域別配送設定一覧
+	HasFreeShipping   bool                  `json:"hasFreeShipping"`   // 送料無料オプションの有無
+	FreeShippingRates int64                 `json:"freeShippingRates"` // 送料無料になる金額(税込)
+	CreatedAt         int64                 `json:"createdAt"`         // 登録日時
+	UpdatedAt         int64                 `json:"updatedAt"`         // 更新日時
 }
 
 type ShippingRate struct {
@@ -24,16 +31,32 @@ type ShippingRate struct {
 	PrefectureCodes []int32 `json:"prefectureCodes"` // 対象都道府県一覧
 }
 
+type ShippingRegionRule struct {
+	Number         int64          `json:"number"`         // No.
+	Name           string         `json:"name"`           // 地域名
+	PostalCodeFrom string         `json:"postalCodeFrom"` // 対象郵便番号(開始)
+	PostalCodeTo   string         `json:"postalCodeTo"`   // 対象郵便番号(終了)
+	Surcharge      int64          `json:"surcharge"`      // 追加配送料(税込)
+	ExcludedTypes  []ShippingType `json:"excludedTypes"`  // 配送不可の配送種別一覧
+}
+
 type CreateShippingRequest struct {
-	Name              string                `json:"name" validate:"required,max=64"`                       // 配送設定名
-	Box60Rates        []*CreateShippingRate `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
-	Box60Frozen       int64                 `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
-	Box80Rates        []*CreateShippingRate `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
-	Box80Frozen       int64                 `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
-	Box100Rates       []*CreateShippingRate `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
-	Box100Frozen      int64                 `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
-	HasFreeShipping   bool                  `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
-	FreeShippingRates int64                 `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
+	Name              string                      `json:"name" validate:"required,max=64"`                       // 配送設定名
+	Box60Rates        []*CreateShippingRate       `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
+	Box60Frozen       int64                       `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
+	Box80Rates        []*CreateShippingRate       `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
+	Box80Frozen       int64                       `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
+	Box100Rates       []*CreateShippingRate       `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
+	Box100Frozen      int64                       `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
+	Box120Rates       []*CreateShippingRate       `json:"box120Rates" validate:"omitempty,dive"`                 // 箱サイズ120の通常便配送料一覧
+	Box120Frozen      int64                       `json:"box120Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ120の冷凍便追加配送料(税込)
+	Box140Rates       []*CreateShippingRate       `json:"box140Rates" validate:"omitempty,dive"`                 // 箱サイズ140の通常便配送料一覧
+	Box140Frozen      int64                       `json:"box140Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ140の冷凍便追加配送料(税込)
+	Box160Rates       []*CreateShippingRate       `json:"box160Rates" validate:"omitempty,dive"`                 // 箱サイズ160の通常便配送料一覧
+	Box160Frozen      int64                       `json:"box160Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ160の冷凍便追加配送料(税込)
+	RegionRules       []*CreateShippingRegionRule `json:"regionRules" validate:"max=100,dive"`                   // 地域別配送設定一覧
+	HasFreeShipping   bool                        `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
+	FreeShippingRates int64                       `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
 }
 
 type CreateShippingRate struct {
@@ -42,16 +65,31 @@ type CreateShippingRate struct {
 	PrefectureCodes []int32 `json:"prefectureCodes" validate:"required,dive,min=1,max=47"` // 対象都道府県一覧
 }
 
+type CreateShippingRegionRule struct {
+	Name           string         `json:"name" validate:"required,max=64"`           // 地域名
+	PostalCodeFrom string         `json:"postalCodeFrom" validate:"required,max=16"` // 対象郵便番号(開始)
+	PostalCodeTo   string         `json:"postalCodeTo" validate:"omitempty,max=16"`  // 対象郵便番号(終了)
+	Surcharge      int64          `json:"surcharge" validate:"min=0,lt=10000000000"` // 追加配送料(税込)
+	ExcludedTypes  []ShippingType `json:"excludedTypes" validate:"dive,oneof=1 2"`   // 配送不可の配送種別一覧
+}
+
 type UpdateShippingRequest struct {
-	Name              string                `json:"name" validate:"required,max=64"`                       // 配送設定名
-	Box60Rates        []*UpdateShippingRate `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
-	Box60Frozen       int64                 `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
-	Box80Rates        []*UpdateShippingRate `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
-	Box80Frozen       int64                 `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
-	Box100Rates       []*UpdateShippingRate `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
-	Box100Frozen      int64                 `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
-	HasFreeShipping   bool                  `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
-	FreeShippingRates int64                 `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
+	Name              string                      `json:"name" validate:"required,max=64"`                       // 配送設定名
+	Box60Rates        []*UpdateShippingRate       `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
+	Box60Frozen       int64                       `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
+	Box80Rates        []*UpdateShippingRate       `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
+	Box80Frozen       int64                       `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
+	Box100Rates       []*UpdateShippingRate       `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
+	Box100Frozen      int64                       `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
+	Box120Rates       []*UpdateShippingRate       `json:"box120Rates" validate:"omitempty,dive"`                 // 箱サイズ120の通常便配送料一覧
+	Box120Frozen      int64                       `json:"box120Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ120の冷凍便追加配送料(税込)
+	Box140Rates       []*UpdateShippingRate       `json:"box140Rates" validate:"omitempty,dive"`                 // 箱サイズ140の通常便配送料一覧
+	Box140Frozen      int64                       `json:"box140Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ140の冷凍便追加配送料(税込)
+	Box160Rates       []*UpdateShippingRate       `json:"box160Rates" validate:"omitempty,dive"`                 // 箱サイズ160の通常便配送料一覧
+	Box160Frozen      int64                       `json:"box160Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ160の冷凍便追加配送料(税込)
+	RegionRules       []*UpdateShippingRegionRule `json:"regionRules" validate:"max=100,dive"`                   // 地域別配送設定一覧
+	HasFreeShipping   bool                        `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
+	FreeShippingRates int64                       `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
 }
 
 type UpdateShippingRate struct {
@@ -60,15 +98,30 @@ type UpdateShippingRate struct {
 	PrefectureCodes []int32 `json:"prefectureCodes" validate:"required,dive,min=1,max=47"` // 対象都道府県一覧
 }
 
+type UpdateShippingRegionRule struct {
+	Name           string         `json:"name" validate:"required,max=64"`           // 地域名
+	PostalCodeFrom string         `json:"postalCodeFrom" validate:"required,max=16"` // 対象郵便番号(開始)
+	PostalCodeTo   string         `json:"postalCodeTo" validate:"omitempty,max=16"`  // 対象郵便番号(終了)
+	Surcharge      int64          `json:"surcharge" validate:"min=0,lt=10000000000"` // 追加配送料(税込)
+	ExcludedTypes  []ShippingType `json:"excludedTypes" validate:"dive,oneof=1 2"`   // 配送不可の配送種別一覧
+}
+
 type UpsertShippingRequest struct {
-	Box60Rates        []*UpsertShippingRate `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
-	Box60Frozen       int64                 `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
-	Box80Rates        []*UpsertShippingRate `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
-	Box80Frozen       int64                 `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
-	Box100Rates       []*UpsertShippingRate `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
-	Box100Frozen      int64                 `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
-	HasFreeShipping   bool                  `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
-	FreeShippingRates int64                 `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
+	Box60Rates        []*UpsertShippingRate       `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
+	Box60Frozen       int64                       `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
+	Box80Rates        []*UpsertShippingRate       `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
+	Box80Frozen       int64                       `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
+	Box100Rates       []*UpsertShippingRate       `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
+	Box100Frozen      int64                       `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
+	Box120Rates       []*UpsertShippingRate       `json:"box120Rates" validate:"omitempty,dive"`                 // 箱サイズ120の通常便配送料一覧
+	Box120Frozen      int64                       `json:"box120Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ120の冷凍便追加配送料(税込)
+	Box140Rates       []*UpsertShippingRate       `json:"box140Rates" validate:"omitempty,dive"`                 // 箱サイズ140の通常便配送料一覧
+	Box140Frozen      int64                       `json:"box140Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ140の冷凍便追加配送料(税込)
+	Box160Rates       []*UpsertShippingRate       `json:"box160Rates" validate:"omitempty,dive"`                 // 箱サイズ160の通常便配送料一覧
+	Box160Frozen      int64                       `json:"box160Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ160の冷凍便追加配送料(税込)
+	RegionRules       []*UpsertShippingRegionRule `json:"regionRules" validate:"max=100,dive"`                   // 地域別配送設定一覧
+	HasFreeShipping   bool                        `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
+	FreeShippingRates int64                       `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
 }
 
 type UpsertShippingRate struct {
@@ -77,15 +130,30 @@ type UpsertShippingRate struct {
 	PrefectureCodes []int32 `json:"prefectureCodes" validate:"required,dive,min=1,max=47"` // 対象都道府県一覧
 }
 
+type UpsertShippingRegionRule struct {
+	Name           string         `json:"name" validate:"required,max=64"`           // 地域名
+	PostalCodeFrom string         `json:"postalCodeFrom" validate:"required,max=16"` // 対象郵便番号(開始)
+	PostalCodeTo   string         `json:"postalCodeTo" validate:"omitempty,max=16"`  // 対象郵便番号(終了)
+	Surcharge      int64          `json:"surcharge" validate:"min=0,lt=10000000000"` // 追加配送料(税込)
+	ExcludedTypes  []ShippingType `json:"excludedTypes" validate:"dive,oneof=1 2"`   // 配送不可の配送種別一覧
+}
+
 type UpdateDefaultShippingRequest struct {
-	Box60Rates        []*UpdateDefaultShippingRate `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
-	Box60Frozen       int64                        `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
-	Box80Rates        []*UpdateDefaultShippingRate `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
-	Box80Frozen       int64                        `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
-	Box100Rates       []*UpdateDefaultShippingRate `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
-	Box100Frozen      int64                        `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
-	HasFreeShipping   bool                         `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
-	FreeShippingRates int64                        `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
+	Box60Rates        []*UpdateDefaultShippingRate       `json:"box60Rates" validate:"required,dive"`                   // 箱サイズ60の通常便配送料一覧
+	Box60Frozen       int64                              `json:"box60Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ60の冷凍便追加配送料(税込)
+	Box80Rates        []*UpdateDefaultShippingRate       `json:"box80Rates" validate:"required,dive"`                   // 箱サイズ80の通常便配送料一覧
+	Box80Frozen       int64                              `json:"box80Frozen" validate:"required,min=0,lt=10000000000"`  // 箱サイズ80の冷凍便追加配送料(税込)
+	Box100Rates       []*UpdateDefaultShippingRate       `json:"box100Rates" validate:"required,dive"`                  // 箱サイズ100の通常便配送料一覧
+	Box100Frozen      int64                              `json:"box100Frozen" validate:"required,min=0,lt=10000000000"` // 箱サイズ100の冷凍便追加配送料(税込)
+	Box120Rates       []*UpdateDefaultShippingRate       `json:"box120Rates" validate:"omitempty,dive"`                 // 箱サイズ120の通常便配送料一覧
+	Box120Frozen      int64                              `json:"box120Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ120の冷凍便追加配送料(税込)
+	Box140Rates       []*UpdateDefaultShippingRate       `json:"box140Rates" validate:"omitempty,dive"`                 // 箱サイズ140の通常便配送料一覧
+	Box140Frozen      int64                              `json:"box140Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ140の冷凍便追加配送料(税込)
+	Box160Rates       []*UpdateDefaultShippingRate       `json:"box160Rates" validate:"omitempty,dive"`                 // 箱サイズ160の通常便配送料一覧
+	Box160Frozen      int64                              `json:"box160Frozen" validate:"min=0,lt=10000000000"`          // 箱サイズ160の冷凍便追加配送料(税込)
+	RegionRules       []*UpdateDefaultShippingRegionRule `json:"regionRules" validate:"max=100,dive"`                   // 地域別配送設定一覧
+	HasFreeShipping   bool                               `json:"hasFreeShipping"`                                       // 送料無料オプションの有無
+	FreeShippingRates int64                              `json:"freeShippingRates" validate:"min=0,lt=10000000000"`     // 送料無料になる金額(税込)
 }
 
 type UpdateDefaultShippingRate struct {
@@ -94,6 +162,14 @@ type UpdateDefaultShippingRate struct {
 	PrefectureCodes []int32 `json:"prefectureCodes" validate:"required,dive,min=1,max=47"` // 対象都道府県一覧
 }
 
+type UpdateDefaultShippingRegionRule struct {
+	Name           string         `json:"name" validate:"required,max=64"`           // 地域名
+	PostalCodeFrom string         `json:"postalCodeFrom" validate:"required,max=16"` // 対象郵便番号(開始)
+	PostalCodeTo   string         `json:"postalCodeTo" validate:"omitempty,max=16"`  // 対象郵便番号(終了)
+	Surcharge      int64          `json:"surcharge" validate:"min=0,lt=10000000000"` // 追加配送料(税込)
+	ExcludedTypes  []ShippingType `json:"excludedTypes" validate:"dive,oneof=1 2"`   // 配送不可の配送種別一覧
+}
+
 type ShippingResponse struct {
 	Shipping    *Shipping    `json:"shipping"`    // 配送設定情報
 	Coordinator *Coordinator `json:"coordinator"` // コーディネータ情報
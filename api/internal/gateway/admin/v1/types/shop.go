@@ -4,20 +4,22 @@ import "time"
 
 // Shop - 店舗情報
 type Shop struct {
-	ID             string         `json:"id"`             // 店舗ID
-	Name           string         `json:"name"`           // 店舗名
-	CoordinatorID  string         `json:"coordinatorId"`  // コーディネータID
-	ProducerIDs    []string       `json:"producerIds"`    // 生産者ID一覧
-	ProductTypeIDs []string       `json:"productTypeIds"` // 取り扱い品目一覧
-	BusinessDays   []time.Weekday `json:"businessDays"`   // 営業曜日(発送可能日)
-	CreatedAt      int64          `json:"createdAt"`      // 登録日時
-	UpdatedAt      int64          `json:"updatedAt"`      // 更新日時
+	ID                        string         `json:"id"`                        // 店舗ID
+	Name                      string         `json:"name"`                      // 店舗名
+	CoordinatorID             string         `json:"coordinatorId"`             // コーディネータID
+	ProducerIDs               []string       `json:"producerIds"`               // 生産者ID一覧
+	ProductTypeIDs            []string       `json:"productTypeIds"`            // 取り扱い品目一覧
+	BusinessDays              []time.Weekday `json:"businessDays"`              // 営業曜日(発送可能日)
+	InvoiceRegistrationNumber string         `json:"invoiceRegistrationNumber"` // 適格請求書発行事業者登録番号
+	CreatedAt                 int64          `json:"createdAt"`                 // 登録日時
+	UpdatedAt                 int64          `json:"updatedAt"`                 // 更新日時
 }
 
 type UpdateShopRequest struct {
-	Name           string         `json:"name" validate:"required,max=64"`                  // 店舗名
-	ProductTypeIDs []string       `json:"productTypeIds" validate:"required,dive,required"` // 取り扱い品目一覧
-	BusinessDays   []time.Weekday `json:"businessDays" validate:"max=7,unique"`             // 営業曜日(発送可能日)
+	Name                      string         `json:"name" validate:"required,max=64"`                               // 店舗名
+	ProductTypeIDs            []string       `json:"productTypeIds" validate:"required,dive,required"`              // 取り扱い品目一覧
+	BusinessDays              []time.Weekday `json:"businessDays" validate:"max=7,unique"`                          // 営業曜日(発送可能日)
+	InvoiceRegistrationNumber string         `json:"invoiceRegistrationNumber" validate:"omitempty,invoice_number"` // 適格請求書発行事業者登録番号(T+13桁)
 }
 
 type ShopResponse struct {
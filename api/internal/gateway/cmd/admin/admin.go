@@ -53,6 +53,8 @@ type app struct {
 	TiDBPassword                      string   `default:""               envconfig:"TIDB_PASSWORD"`
 	TiDBSecretName                    string   `default:""               envconfig:"TIDB_SECRET_NAME"`
 	GinMode                           string   `default:"release"        envconfig:"GIN_MODE"`
+	TrustedProxies                    []string `default:""               envconfig:"TRUSTED_PROXIES"`
+	TrustedPlatform                   string   `default:""               envconfig:"TRUSTED_PLATFORM"`
 	NewRelicLicense                   string   `default:""               envconfig:"NEW_RELIC_LICENSE"`
 	NewRelicSecretName                string   `default:""               envconfig:"NEW_RELIC_SECRET_NAME"`
 	SentryDsn                         string   `default:""               envconfig:"SENTRY_DSN"`
@@ -87,6 +89,8 @@ type app struct {
 	AdminWebURL                       string   `default:""               envconfig:"ADMIN_WEB_URL"`
 	UserWebURL                        string   `default:""               envconfig:"USER_WEB_URL"`
 	AssetsURL                         string   `default:""               envconfig:"ASSETS_URL"`
+	PDFFontPath                       string   `default:""               envconfig:"PDF_FONT_PATH"`
+	SettlementCommissionRate          float64  `default:"0.0"            envconfig:"SETTLEMENT_COMMISSION_RATE"`
 	SlackAPIToken                     string   `default:""               envconfig:"SLACK_API_TOKEN"`
 	SlackChannelID                    string   `default:""               envconfig:"SLACK_CHANNEL_ID"`
 	SlackSecretName                   string   `default:""               envconfig:"SLACK_SECRET_NAME"`
@@ -128,7 +132,10 @@ func (a *app) Build(ctx context.Context) (*BuildResult, error) {
 	}
 
 	// ルーターの構築
-	rt := a.newRouter()
+	rt, err := a.newRouter()
+	if err != nil {
+		return nil, fmt.Errorf("admin: failed to new router: %w", err)
+	}
 
 	handlers := []gateway.Handler{a.v1}
 
@@ -172,7 +179,10 @@ func (a *app) run() error {
 	}
 
 	// HTTP Serverの設定
-	rt := a.newRouter()
+	rt, err := a.newRouter()
+	if err != nil {
+		return fmt.Errorf("admin: failed to new router: %w", err)
+	}
 	hs := http.NewHTTPServer(rt, a.Port)
 
 	// Metrics Serverの設定
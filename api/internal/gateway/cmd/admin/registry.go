@@ -11,7 +11,9 @@ import (
 	"github.com/and-period/furumaru/api/pkg/geolocation"
 	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/and-period/furumaru/api/pkg/medialive"
+	"github.com/and-period/furumaru/api/pkg/pdf"
 	"github.com/and-period/furumaru/api/pkg/postalcode"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
 	"github.com/and-period/furumaru/api/pkg/secret"
 	"github.com/and-period/furumaru/api/pkg/sentry"
 	"github.com/and-period/furumaru/api/pkg/slack"
@@ -21,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
 	"github.com/and-period/furumaru/api/pkg/batch"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
@@ -34,6 +37,7 @@ type params struct {
 	adminAuth                cognito.Client
 	userAuth                 cognito.Client
 	cache                    dynamodb.Client
+	pubsub                   pubsub.PubSub
 	messengerQueue           sqs.Producer
 	mediaQueue               sqs.Producer
 	batch                    batch.Client
@@ -43,6 +47,7 @@ type params struct {
 	newRelic                 *newrelic.Application
 	sentry                   sentry.Client
 	providers                map[entity.PaymentProviderType]payment.Provider
+	trackers                 map[entity.ShippingCarrier]tracker.Tracker
 	komojuWebhookSecret      string
 	stripeSecretKey          string
 	stripeWebhookSecret      string
@@ -51,6 +56,7 @@ type params struct {
 	assetsURL                *url.URL
 	postalCode               postalcode.Client
 	geolocation              geolocation.Client
+	pdfFont                  *pdf.Font
 	now                      func() time.Time
 	debugMode                bool
 	tidbHost                 string
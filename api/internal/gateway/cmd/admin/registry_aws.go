@@ -7,6 +7,7 @@ import (
 	"github.com/and-period/furumaru/api/pkg/batch"
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
 	"github.com/and-period/furumaru/api/pkg/medialive"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
 	"github.com/and-period/furumaru/api/pkg/secret"
 	"github.com/and-period/furumaru/api/pkg/sqs"
 	"github.com/and-period/furumaru/api/pkg/storage"
@@ -53,6 +54,10 @@ func (a *app) injectAWS(ctx context.Context, p *params) error {
 		TableSuffix: a.Environment,
 	}
 	p.cache = dynamodb.NewClient(awscfg, dbParams)
+	pubsubParams := &pubsub.DynamoDBParams{
+		TableName: fmt.Sprintf("furumaru-broadcast-events-%s", a.Environment),
+	}
+	p.pubsub = pubsub.NewDynamoDB(awscfg, pubsubParams)
 
 	// AWS Batchの設定
 	p.batch = batch.NewClient(awscfg)
@@ -4,12 +4,18 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/payment"
 	komojupay "github.com/and-period/furumaru/api/internal/store/payment/komoju"
 	stripepay "github.com/and-period/furumaru/api/internal/store/payment/stripe"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	japanposttracker "github.com/and-period/furumaru/api/internal/store/tracker/japanpost"
+	sagawatracker "github.com/and-period/furumaru/api/internal/store/tracker/sagawa"
+	yamatotracker "github.com/and-period/furumaru/api/internal/store/tracker/yamato"
 	"github.com/and-period/furumaru/api/pkg/geolocation"
+	"github.com/and-period/furumaru/api/pkg/pdf"
 	"github.com/and-period/furumaru/api/pkg/postalcode"
 	"github.com/and-period/furumaru/api/pkg/sentry"
 	"github.com/and-period/furumaru/api/pkg/slack"
@@ -61,6 +67,19 @@ func (a *app) injectExternal(p *params) error {
 		p.sentry = sentryApp
 	}
 
+	// 帳票(PDF)へ埋め込むフォントの設定
+	if a.PDFFontPath != "" {
+		b, err := os.ReadFile(a.PDFFontPath)
+		if err != nil {
+			return fmt.Errorf("cmd: failed to read pdf font: %w", err)
+		}
+		font, err := pdf.ParseFont(b)
+		if err != nil {
+			return fmt.Errorf("cmd: failed to parse pdf font: %w", err)
+		}
+		p.pdfFont = font
+	}
+
 	// Slackの設定
 	if p.slackToken != "" {
 		slackParams := &slack.Params{
@@ -90,6 +109,13 @@ func (a *app) injectExternal(p *params) error {
 		p.providers[entity.PaymentProviderTypeStripe] = stripepay.NewProvider(stripeParams)
 	}
 
+	// 配送状況の取得設定 (管理画面では追跡ファイルの取り込みのみ行うため、追跡APIの設定は不要)
+	p.trackers = map[entity.ShippingCarrier]tracker.Tracker{
+		entity.ShippingCarrierYamato:    yamatotracker.NewTracker(&yamatotracker.Params{}),
+		entity.ShippingCarrierSagawa:    sagawatracker.NewTracker(&sagawatracker.Params{}),
+		entity.ShippingCarrierJapanPost: japanposttracker.NewTracker(&japanposttracker.Params{}),
+	}
+
 	// PostalCodeの設定
 	p.postalCode = postalcode.NewClient(&http.Client{})
 
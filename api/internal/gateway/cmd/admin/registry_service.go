@@ -156,6 +156,7 @@ func (a *app) newMediaService(p *params) (media.Service, error) {
 		WaitGroup:                    p.waitGroup,
 		Database:                     mediadb.NewDatabase(mysql),
 		Cache:                        p.cache,
+		PubSub:                       p.pubsub,
 		MediaLive:                    p.medialive,
 		Youtube:                      p.youtube,
 		Storage:                      p.storage,
@@ -234,15 +235,18 @@ func (a *app) newStoreService(
 		return nil, err
 	}
 	params := &storesrv.Params{
-		WaitGroup:   p.waitGroup,
-		Database:    storedb.NewDatabase(mysql),
-		Cache:       p.cache,
-		User:        user,
-		Messenger:   messenger,
-		Media:       media,
-		PostalCode:  p.postalCode,
-		Geolocation: p.geolocation,
-		Providers:   p.providers,
+		WaitGroup:                p.waitGroup,
+		Database:                 storedb.NewDatabase(mysql),
+		Cache:                    p.cache,
+		User:                     user,
+		Messenger:                messenger,
+		Media:                    media,
+		PostalCode:               p.postalCode,
+		Geolocation:              p.geolocation,
+		Providers:                p.providers,
+		PDFFont:                  p.pdfFont,
+		Trackers:                 p.trackers,
+		SettlementCommissionRate: a.SettlementCommissionRate,
 	}
 	return storesrv.NewService(params), nil
 }
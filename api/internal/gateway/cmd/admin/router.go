@@ -22,7 +22,7 @@ import (
 	"github.com/slack-go/slack"
 )
 
-func (a *app) newRouter() *gin.Engine {
+func (a *app) newRouter() (*gin.Engine, error) {
 	opts := make([]gin.HandlerFunc, 0)
 	opts = append(opts, nrgin.Middleware(a.newRelic))
 	opts = append(opts, a.accessLogger())
@@ -31,6 +31,11 @@ func (a *app) newRouter() *gin.Engine {
 	opts = append(opts, gin.Recovery())
 
 	rt := gin.New()
+	// ClientIP()がX-Forwarded-Forを無条件に信頼しないよう、信頼するプロキシを明示する
+	if err := rt.SetTrustedProxies(a.TrustedProxies); err != nil {
+		return nil, err
+	}
+	rt.TrustedPlatform = a.TrustedPlatform
 	rt.Use(opts...)
 
 	a.v1.Routes(rt.Group(""))
@@ -57,7 +62,7 @@ func (a *app) newRouter() *gin.Engine {
 	})
 	ginpprof.Register(rt)
 
-	return rt
+	return rt, nil
 }
 
 type wrapResponseWriter struct {
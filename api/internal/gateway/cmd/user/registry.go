@@ -14,7 +14,9 @@ import (
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
 	"github.com/and-period/furumaru/api/pkg/geolocation"
 	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/pdf"
 	"github.com/and-period/furumaru/api/pkg/postalcode"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
 	"github.com/and-period/furumaru/api/pkg/secret"
 	"github.com/and-period/furumaru/api/pkg/sentry"
 	"github.com/and-period/furumaru/api/pkg/slack"
@@ -33,6 +35,7 @@ type params struct {
 	tmpStorage               storage.Bucket
 	userAuth                 cognito.Client
 	cache                    dynamodb.Client
+	pubsub                   pubsub.PubSub
 	producer                 sqs.Producer
 	slack                    slack.Client
 	newRelic                 *newrelic.Application
@@ -42,6 +45,7 @@ type params struct {
 	userWebURL               *url.URL
 	postalCode               postalcode.Client
 	geolocation              geolocation.Client
+	pdfFont                  *pdf.Font
 	liffVerifier             auth.OIDCVerifier[auth.LIFFClaims]
 	jwtVerifier              auth.JWTVerifier
 	jwtGenerator             auth.JWTGenerator
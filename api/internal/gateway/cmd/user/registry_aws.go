@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
 	"github.com/and-period/furumaru/api/pkg/secret"
 	"github.com/and-period/furumaru/api/pkg/sqs"
 	"github.com/and-period/furumaru/api/pkg/storage"
@@ -47,6 +48,10 @@ func (a *app) injectAWS(ctx context.Context, p *params) error {
 		TableSuffix: a.Environment,
 	}
 	p.cache = dynamodb.NewClient(awscfg, dbParams)
+	pubsubParams := &pubsub.DynamoDBParams{
+		TableName: fmt.Sprintf("furumaru-broadcast-events-%s", a.Environment),
+	}
+	p.pubsub = pubsub.NewDynamoDB(awscfg, pubsubParams)
 
 	return nil
 }
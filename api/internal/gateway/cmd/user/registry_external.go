@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 
 	"github.com/and-period/furumaru/api/internal/gateway/user/facility/auth"
 	"github.com/and-period/furumaru/api/internal/store/entity"
@@ -12,6 +13,7 @@ import (
 	komojupay "github.com/and-period/furumaru/api/internal/store/payment/komoju"
 	stripepay "github.com/and-period/furumaru/api/internal/store/payment/stripe"
 	"github.com/and-period/furumaru/api/pkg/geolocation"
+	"github.com/and-period/furumaru/api/pkg/pdf"
 	"github.com/and-period/furumaru/api/pkg/postalcode"
 	"github.com/and-period/furumaru/api/pkg/sentry"
 	"github.com/and-period/furumaru/api/pkg/slack"
@@ -61,6 +63,19 @@ func (a *app) injectExternal(ctx context.Context, p *params) error {
 		p.sentry = sentryApp
 	}
 
+	// 帳票(PDF)へ埋め込むフォントの設定
+	if a.PDFFontPath != "" {
+		b, err := os.ReadFile(a.PDFFontPath)
+		if err != nil {
+			return fmt.Errorf("cmd: failed to read pdf font: %w", err)
+		}
+		font, err := pdf.ParseFont(b)
+		if err != nil {
+			return fmt.Errorf("cmd: failed to parse pdf font: %w", err)
+		}
+		p.pdfFont = font
+	}
+
 	// Slackの設定
 	if p.slackToken != "" {
 		slackParams := &slack.Params{
@@ -107,6 +107,7 @@ func (a *app) newMediaService(p *params) (media.Service, error) {
 		WaitGroup: p.waitGroup,
 		Database:  mediadb.NewDatabase(mysql),
 		Cache:     p.cache,
+		PubSub:    p.pubsub,
 		Storage:   p.storage,
 		Tmp:       p.tmpStorage,
 	}
@@ -172,6 +173,7 @@ func (a *app) newStoreService(
 		PostalCode:  p.postalCode,
 		Geolocation: p.geolocation,
 		Providers:   p.providers,
+		PDFFont:     p.pdfFont,
 	}
 	return storesrv.NewService(params), nil
 }
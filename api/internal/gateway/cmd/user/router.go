@@ -22,7 +22,7 @@ import (
 	"github.com/slack-go/slack"
 )
 
-func (a *app) newRouter() *gin.Engine {
+func (a *app) newRouter() (*gin.Engine, error) {
 	opts := make([]gin.HandlerFunc, 0)
 	opts = append(opts, nrgin.Middleware(a.newRelic))
 	opts = append(opts, a.accessLogger())
@@ -31,6 +31,11 @@ func (a *app) newRouter() *gin.Engine {
 	opts = append(opts, gin.Recovery())
 
 	rt := gin.New()
+	// ClientIP()がX-Forwarded-Forを無条件に信頼しないよう、信頼するプロキシを明示する
+	if err := rt.SetTrustedProxies(a.TrustedProxies); err != nil {
+		return nil, err
+	}
+	rt.TrustedPlatform = a.TrustedPlatform
 	rt.Use(opts...)
 
 	a.v1.Routes(rt.Group(""))
@@ -54,7 +59,7 @@ func (a *app) newRouter() *gin.Engine {
 	})
 	ginpprof.Register(rt)
 
-	return rt
+	return rt, nil
 }
 
 type wrapResponseWriter struct {
@@ -63,15 +68,24 @@ type wrapResponseWriter struct {
 }
 
 func (w *wrapResponseWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	if !w.streaming() {
+		w.body.Write(b)
+	}
 	return w.ResponseWriter.Write(b)
 }
 
 func (w *wrapResponseWriter) WriteString(s string) (int, error) {
-	w.body.WriteString(s)
+	if !w.streaming() {
+		w.body.WriteString(s)
+	}
 	return w.ResponseWriter.WriteString(s)
 }
 
+// streaming - 長時間接続を維持するレスポンスの場合、レスポンス内容を保持しない
+func (w *wrapResponseWriter) streaming() bool {
+	return strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream")
+}
+
 func (w *wrapResponseWriter) errorResponse() (*util.ErrorResponse, error) {
 	body := w.body.Bytes()
 	if len(body) == 0 {
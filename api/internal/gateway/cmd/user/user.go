@@ -36,53 +36,56 @@ type app struct {
 	newRelic                     *newrelic.Application
 	v1                           gateway.Handler
 	facility                     gateway.Handler
-	AppName                      string  `default:"user-gateway"   envconfig:"APP_NAME"`
-	Environment                  string  `default:"none"           envconfig:"ENV"`
-	Port                         int64   `default:"8080"           envconfig:"PORT"`
-	MetricsPort                  int64   `default:"9090"           envconfig:"METRICS_PORT"`
-	ShutdownDelaySec             int64   `default:"20"             envconfig:"SHUTDOWN_DELAY_SEC"`
-	LogPath                      string  `default:""               envconfig:"LOG_PATH"`
-	LogLevel                     string  `default:"info"           envconfig:"LOG_LEVEL"`
-	TraceSampleRate              float64 `default:"0.0"            envconfig:"TRACE_SAMPLE_RATE"`
-	DBTimeZone                   string  `default:"Asia/Tokyo"     envconfig:"DB_TIMEZONE"`
-	TiDBHost                     string  `default:"127.0.0.1"      envconfig:"TIDB_HOST"`
-	TiDBPort                     string  `default:"4000"           envconfig:"TIDB_PORT"`
-	TiDBUsername                 string  `default:""               envconfig:"TIDB_USERNAME"`
-	TiDBPassword                 string  `default:""               envconfig:"TIDB_PASSWORD"`
-	TiDBSecretName               string  `default:""               envconfig:"TIDB_SECRET_NAME"`
-	GinMode                      string  `default:"release"        envconfig:"GIN_MODE"`
-	NewRelicLicense              string  `default:""               envconfig:"NEW_RELIC_LICENSE"`
-	NewRelicSecretName           string  `default:""               envconfig:"NEW_RELIC_SECRET_NAME"`
-	SentryDsn                    string  `default:""               envconfig:"SENTRY_DSN"`
-	SentrySecretName             string  `default:""               envconfig:"SENTRY_SECRET_NAME"`
-	AWSRegion                    string  `default:"ap-northeast-1" envconfig:"AWS_REGION"`
-	S3Bucket                     string  `default:""               envconfig:"S3_BUCKET"`
-	S3TmpBucket                  string  `default:""               envconfig:"S3_TMP_BUCKET"`
-	CognitoUserPoolID            string  `default:""               envconfig:"COGNITO_USER_POOL_ID"`
-	CognitoUserAuthDomain        string  `default:""               envconfig:"COGNITO_USER_AUTH_DOMAIN"`
-	CognitoUserGoogleRedirectURL string  `default:""               envconfig:"COGNITO_USER_GOOGLE_REDIRECT_URL"`
-	CognitoUserLINERedirectURL   string  `default:""               envconfig:"COGNITO_USER_LINE_REDIRECT_URL"`
-	CognitoUserClientID          string  `default:""               envconfig:"COGNITO_USER_CLIENT_ID"`
-	SQSQueueURL                  string  `default:""               envconfig:"SQS_QUEUE_URL"`
-	SQSMockEnabled               bool    `default:"false"          envconfig:"SQS_MOCK_ENABLED"`
-	KomojuHost                   string  `default:""               envconfig:"KOMOJU_HOST"`
-	KomojuClientID               string  `default:""               envconfig:"KOMOJU_CLIENT_ID"`
-	KomojuClientPassword         string  `default:""               envconfig:"KOMOJU_CLIENT_PASSWORD"`
-	KomojuSecretName             string  `default:""               envconfig:"KOMOJU_SECRET_NAME"`
-	StripeSecretKey              string  `default:""               envconfig:"STRIPE_SECRET_KEY"`
-	StripeSecretName             string  `default:""               envconfig:"STRIPE_SECRET_NAME"`
-	GoogleSecretName             string  `default:""               envconfig:"GOOGLE_SECRET_NAME"`
-	GoogleMapsPlatformAPIKey     string  `default:""               envconfig:"GOOGLE_MAPS_PLATFORM_API_KEY"`
-	JWTIssuer                    string  `default:""               envconfig:"JWT_ISSUER"`
-	JWTSecretName                string  `default:""               envconfig:"JWT_SECRET_NAME"`
-	JWTSecret                    string  `default:""               envconfig:"JWT_SECRET"`
-	CheckoutAutoCaptured         bool    `default:"false"          envconfig:"CHECKOUT_AUTO_CAPTURED"`
-	SlackAPIToken                string  `default:""               envconfig:"SLACK_API_TOKEN"`
-	SlackChannelID               string  `default:""               envconfig:"SLACK_CHANNEL_ID"`
-	SlackSecretName              string  `default:""               envconfig:"SLACK_SECRET_NAME"`
-	CookieBaseDomain             string  `default:""               envconfig:"COOKIE_BASE_DOMAIN"`
-	AminWebURL                   string  `default:""               envconfig:"ADMIN_WEB_URL"`
-	UserWebURL                   string  `default:""               envconfig:"USER_WEB_URL"`
+	AppName                      string   `default:"user-gateway"   envconfig:"APP_NAME"`
+	Environment                  string   `default:"none"           envconfig:"ENV"`
+	Port                         int64    `default:"8080"           envconfig:"PORT"`
+	MetricsPort                  int64    `default:"9090"           envconfig:"METRICS_PORT"`
+	ShutdownDelaySec             int64    `default:"20"             envconfig:"SHUTDOWN_DELAY_SEC"`
+	LogPath                      string   `default:""               envconfig:"LOG_PATH"`
+	LogLevel                     string   `default:"info"           envconfig:"LOG_LEVEL"`
+	TraceSampleRate              float64  `default:"0.0"            envconfig:"TRACE_SAMPLE_RATE"`
+	DBTimeZone                   string   `default:"Asia/Tokyo"     envconfig:"DB_TIMEZONE"`
+	TiDBHost                     string   `default:"127.0.0.1"      envconfig:"TIDB_HOST"`
+	TiDBPort                     string   `default:"4000"           envconfig:"TIDB_PORT"`
+	TiDBUsername                 string   `default:""               envconfig:"TIDB_USERNAME"`
+	TiDBPassword                 string   `default:""               envconfig:"TIDB_PASSWORD"`
+	TiDBSecretName               string   `default:""               envconfig:"TIDB_SECRET_NAME"`
+	GinMode                      string   `default:"release"        envconfig:"GIN_MODE"`
+	TrustedProxies               []string `default:""               envconfig:"TRUSTED_PROXIES"`
+	TrustedPlatform              string   `default:""               envconfig:"TRUSTED_PLATFORM"`
+	NewRelicLicense              string   `default:""               envconfig:"NEW_RELIC_LICENSE"`
+	NewRelicSecretName           string   `default:""               envconfig:"NEW_RELIC_SECRET_NAME"`
+	SentryDsn                    string   `default:""               envconfig:"SENTRY_DSN"`
+	SentrySecretName             string   `default:""               envconfig:"SENTRY_SECRET_NAME"`
+	AWSRegion                    string   `default:"ap-northeast-1" envconfig:"AWS_REGION"`
+	S3Bucket                     string   `default:""               envconfig:"S3_BUCKET"`
+	S3TmpBucket                  string   `default:""               envconfig:"S3_TMP_BUCKET"`
+	CognitoUserPoolID            string   `default:""               envconfig:"COGNITO_USER_POOL_ID"`
+	CognitoUserAuthDomain        string   `default:""               envconfig:"COGNITO_USER_AUTH_DOMAIN"`
+	CognitoUserGoogleRedirectURL string   `default:""               envconfig:"COGNITO_USER_GOOGLE_REDIRECT_URL"`
+	CognitoUserLINERedirectURL   string   `default:""               envconfig:"COGNITO_USER_LINE_REDIRECT_URL"`
+	CognitoUserClientID          string   `default:""               envconfig:"COGNITO_USER_CLIENT_ID"`
+	SQSQueueURL                  string   `default:""               envconfig:"SQS_QUEUE_URL"`
+	SQSMockEnabled               bool     `default:"false"          envconfig:"SQS_MOCK_ENABLED"`
+	KomojuHost                   string   `default:""               envconfig:"KOMOJU_HOST"`
+	KomojuClientID               string   `default:""               envconfig:"KOMOJU_CLIENT_ID"`
+	KomojuClientPassword         string   `default:""               envconfig:"KOMOJU_CLIENT_PASSWORD"`
+	KomojuSecretName             string   `default:""               envconfig:"KOMOJU_SECRET_NAME"`
+	StripeSecretKey              string   `default:""               envconfig:"STRIPE_SECRET_KEY"`
+	StripeSecretName             string   `default:""               envconfig:"STRIPE_SECRET_NAME"`
+	GoogleSecretName             string   `default:""               envconfig:"GOOGLE_SECRET_NAME"`
+	GoogleMapsPlatformAPIKey     string   `default:""               envconfig:"GOOGLE_MAPS_PLATFORM_API_KEY"`
+	PDFFontPath                  string   `default:""               envconfig:"PDF_FONT_PATH"`
+	JWTIssuer                    string   `default:""               envconfig:"JWT_ISSUER"`
+	JWTSecretName                string   `default:""               envconfig:"JWT_SECRET_NAME"`
+	JWTSecret                    string   `default:""               envconfig:"JWT_SECRET"`
+	CheckoutAutoCaptured         bool     `default:"false"          envconfig:"CHECKOUT_AUTO_CAPTURED"`
+	SlackAPIToken                string   `default:""               envconfig:"SLACK_API_TOKEN"`
+	SlackChannelID               string   `default:""               envconfig:"SLACK_CHANNEL_ID"`
+	SlackSecretName              string   `default:""               envconfig:"SLACK_SECRET_NAME"`
+	CookieBaseDomain             string   `default:""               envconfig:"COOKIE_BASE_DOMAIN"`
+	AminWebURL                   string   `default:""               envconfig:"ADMIN_WEB_URL"`
+	UserWebURL                   string   `default:""               envconfig:"USER_WEB_URL"`
 }
 
 func NewApp() *app {
@@ -112,7 +115,10 @@ func (a *app) Build(ctx context.Context) (*BuildResult, error) {
 	}
 
 	// ルーターの構築
-	rt := a.newRouter()
+	rt, err := a.newRouter()
+	if err != nil {
+		return nil, fmt.Errorf("user: failed to new router: %w", err)
+	}
 
 	return &BuildResult{
 		Router:    rt,
@@ -149,7 +155,10 @@ func (a *app) run() error {
 	}
 
 	// HTTP Serverの設定
-	rt := a.newRouter()
+	rt, err := a.newRouter()
+	if err != nil {
+		return fmt.Errorf("user: failed to new router: %w", err)
+	}
 	hs := http.NewHTTPServer(rt, a.Port)
 
 	// Metrics Serverの設定
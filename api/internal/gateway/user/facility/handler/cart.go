@@ -90,6 +90,7 @@ func (h *handler) CalcCart(ctx *gin.Context) {
 		return
 	}
 	promotionCode := util.GetQuery(ctx, "promotion", "")
+	userID := h.getUserID(ctx)
 	coordinatorID := util.GetParam(ctx, "coordinatorId")
 
 	var (
@@ -118,7 +119,7 @@ func (h *handler) CalcCart(ctx *gin.Context) {
 		if promotionCode == "" {
 			return
 		}
-		promotion, err = h.getEnabledPromotion(ectx, promotionCode)
+		promotion, err = h.getEnabledPromotion(ectx, promotionCode, userID)
 		if errors.Is(err, exception.ErrNotFound) {
 			err = nil // エラーは返さず、プロモーション未適用状態で返す
 		}
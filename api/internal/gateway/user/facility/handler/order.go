@@ -72,6 +72,7 @@ func (h *handler) ListOrders(ctx *gin.Context) {
 		sentity.OrderStatusCompleted,
 		sentity.OrderStatusCanceled,
 		sentity.OrderStatusRefunded,
+		sentity.OrderStatusPartiallyRefunded,
 	}
 	ordersIn := &store.ListOrdersInput{
 		UserID:   h.getUserID(ctx),
@@ -32,9 +32,10 @@ func (h *handler) getPromotion(ctx context.Context, promotionID string) (*servic
 	return service.NewPromotion(promotion), nil
 }
 
-func (h *handler) getEnabledPromotion(ctx context.Context, code string) (*service.Promotion, error) {
+func (h *handler) getEnabledPromotion(ctx context.Context, code, userID string) (*service.Promotion, error) {
 	in := &store.GetPromotionByCodeInput{
 		PromotionCode: code,
+		UserID:        userID,
 		OnlyEnabled:   true,
 	}
 	promotion, err := h.store.GetPromotionByCode(ctx, in)
@@ -70,6 +70,8 @@ func NewOrderStatus(status entity.OrderStatus) OrderStatus {
 		return OrderStatus(types.OrderStatusRefunded)
 	case entity.OrderStatusFailed:
 		return OrderStatus(types.OrderStatusFailed)
+	case entity.OrderStatusPartiallyRefunded:
+		return OrderStatus(types.OrderStatusPartiallyRefunded)
 	default:
 		return OrderStatus(types.OrderStatusUnknown)
 	}
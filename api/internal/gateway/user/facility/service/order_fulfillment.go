@@ -17,6 +17,12 @@ func NewShippingSize(size entity.ShippingSize) ShippingSize {
 		return ShippingSize(types.ShippingSize80)
 	case entity.ShippingSize100:
 		return ShippingSize(types.ShippingSize100)
+	case entity.ShippingSize120:
+		return ShippingSize(types.ShippingSize120)
+	case entity.ShippingSize140:
+		return ShippingSize(types.ShippingSize140)
+	case entity.ShippingSize160:
+		return ShippingSize(types.ShippingSize160)
 	default:
 		return ShippingSize(types.ShippingSizeUnknown)
 	}
@@ -30,6 +30,21 @@ func TestShippingSize(t *testing.T) {
 			status: entity.ShippingSize100,
 			expect: ShippingSize(types.ShippingSize100),
 		},
+		{
+			name:   "size 120",
+			status: entity.ShippingSize120,
+			expect: ShippingSize(types.ShippingSize120),
+		},
+		{
+			name:   "size 140",
+			status: entity.ShippingSize140,
+			expect: ShippingSize(types.ShippingSize140),
+		},
+		{
+			name:   "size 160",
+			status: entity.ShippingSize160,
+			expect: ShippingSize(types.ShippingSize160),
+		},
 		{
 			name:   "unknown",
 			status: entity.ShippingSizeUnknown,
@@ -177,6 +177,12 @@ func TestNewOrderStatus(t *testing.T) {
 			expect:   OrderStatus(types.OrderStatusFailed),
 			response: 6,
 		},
+		{
+			name:     "partially refunded",
+			status:   entity.OrderStatusPartiallyRefunded,
+			expect:   OrderStatus(types.OrderStatusPartiallyRefunded),
+			response: 7,
+		},
 		{
 			name:     "unknown",
 			status:   entity.OrderStatusUnknown,
@@ -13,13 +13,14 @@ const (
 type OrderStatus int32
 
 const (
-	OrderStatusUnknown   OrderStatus = 0
-	OrderStatusUnpaid    OrderStatus = 1 // 支払い待ち
-	OrderStatusPreparing OrderStatus = 2 // 発送対応中
-	OrderStatusCompleted OrderStatus = 3 // 完了
-	OrderStatusCanceled  OrderStatus = 4 // キャンセル
-	OrderStatusRefunded  OrderStatus = 5 // 返金
-	OrderStatusFailed    OrderStatus = 6 // 失敗
+	OrderStatusUnknown           OrderStatus = 0
+	OrderStatusUnpaid            OrderStatus = 1 // 支払い待ち
+	OrderStatusPreparing         OrderStatus = 2 // 発送対応中
+	OrderStatusCompleted         OrderStatus = 3 // 完了
+	OrderStatusCanceled          OrderStatus = 4 // キャンセル
+	OrderStatusRefunded          OrderStatus = 5 // 返金
+	OrderStatusFailed            OrderStatus = 6 // 失敗
+	OrderStatusPartiallyRefunded OrderStatus = 7 // 一部返金
 )
 
 // Order - 注文履歴情報
@@ -8,6 +8,9 @@ const (
 	ShippingSize60      ShippingSize = 1 // 箱のサイズ:60
 	ShippingSize80      ShippingSize = 2 // 箱のサイズ:80
 	ShippingSize100     ShippingSize = 3 // 箱のサイズ:100
+	ShippingSize120     ShippingSize = 4 // 箱のサイズ:120
+	ShippingSize140     ShippingSize = 5 // 箱のサイズ:140
+	ShippingSize160     ShippingSize = 6 // 箱のサイズ:160
 )
 
 // ShippingType - 配送方法
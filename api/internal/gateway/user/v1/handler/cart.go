@@ -21,6 +21,7 @@ func (h *handler) cartRoutes(rg *gin.RouterGroup) {
 
 	r.GET("", h.GetCart)
 	r.GET("/:coordinatorId", h.CalcCart)
+	r.POST("/:coordinatorId/calculate", h.CalcCartWithDestinations)
 	r.POST("/-/items", h.AddCartItem)
 	r.DELETE("/-/items/:productId", h.RemoveCartItem)
 }
@@ -92,10 +93,12 @@ func (h *handler) GetCart(ctx *gin.Context) {
 // @Param       coordinatorId path string true "コーディネータID"
 // @Param       number query int64 false "箱数"
 // @Param       prefecture query int32 false "都道府県コード"
+// @Param       postalCode query string false "郵便番号"
 // @Param       promotion query string false "プロモーションコード"
 // @Produce     json
 // @Success     200 {object} types.CalcCartResponse
 // @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     412 {object} util.ErrorResponse "配送先に配送できない商品が含まれている"
 func (h *handler) CalcCart(ctx *gin.Context) {
 	boxNumber, err := util.GetQueryInt64(ctx, "number", 0)
 	if err != nil {
@@ -107,9 +110,50 @@ func (h *handler) CalcCart(ctx *gin.Context) {
 		h.badRequest(ctx, err)
 		return
 	}
-	promotionCode := util.GetQuery(ctx, "promotion", "")
-	coordinatorID := util.GetParam(ctx, "coordinatorId")
+	in := &store.CalcCartInput{
+		SessionID:      h.getSessionID(ctx),
+		UserID:         h.getUserID(ctx),
+		CoordinatorID:  util.GetParam(ctx, "coordinatorId"),
+		BoxNumber:      boxNumber,
+		PromotionCode:  util.GetQuery(ctx, "promotion", ""),
+		PrefectureCode: prefectureCode,
+		PostalCode:     util.GetQuery(ctx, "postalCode", ""),
+	}
+	h.calcCart(ctx, in)
+}
 
+// @Summary     買い物かご計算（配送先指定）
+// @Description 配送先ごとの送料を含め、指定されたコーディネータの買い物かごの合計金額を計算します。
+// @Tags        Cart
+// @Router      /carts/{coordinatorId}/calculate [post]
+// @Security    cookieauth
+// @Param       coordinatorId path string true "コーディネータID"
+// @Accept      json
+// @Param       request body types.CalcCartRequest true "計算条件"
+// @Produce     json
+// @Success     200 {object} types.CalcCartResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     412 {object} util.ErrorResponse "配送先に配送できない商品が含まれている"
+func (h *handler) CalcCartWithDestinations(ctx *gin.Context) {
+	req := &types.CalcCartRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	in := &store.CalcCartInput{
+		SessionID:      h.getSessionID(ctx),
+		UserID:         h.getUserID(ctx),
+		CoordinatorID:  util.GetParam(ctx, "coordinatorId"),
+		BoxNumber:      req.BoxNumber,
+		PromotionCode:  req.PromotionCode,
+		PrefectureCode: req.PrefectureCode,
+		PostalCode:     req.PostalCode,
+		Destinations:   newCheckoutDestinations(req.Destinations),
+	}
+	h.calcCart(ctx, in)
+}
+
+func (h *handler) calcCart(ctx *gin.Context, in *store.CalcCartInput) {
 	var (
 		cart        *entity.Cart
 		summary     *entity.OrderPaymentSummary
@@ -118,25 +162,18 @@ func (h *handler) CalcCart(ctx *gin.Context) {
 	)
 	eg, ectx := errgroup.WithContext(ctx)
 	eg.Go(func() (err error) {
-		in := &store.CalcCartInput{
-			SessionID:      h.getSessionID(ctx),
-			CoordinatorID:  coordinatorID,
-			BoxNumber:      boxNumber,
-			PromotionCode:  promotionCode,
-			PrefectureCode: prefectureCode,
-		}
 		cart, summary, err = h.store.CalcCart(ectx, in)
 		return
 	})
 	eg.Go(func() (err error) {
-		coordinator, err = h.getCoordinator(ectx, coordinatorID)
+		coordinator, err = h.getCoordinator(ectx, in.CoordinatorID)
 		return
 	})
 	eg.Go(func() (err error) {
-		if promotionCode == "" {
+		if in.PromotionCode == "" {
 			return
 		}
-		promotion, err = h.getEnabledPromotion(ectx, promotionCode)
+		promotion, err = h.getEnabledPromotion(ectx, in.PromotionCode, in.UserID)
 		if errors.Is(err, exception.ErrNotFound) {
 			err = nil // エラーは返さず、プロモーション未適用状態で返す
 		}
@@ -40,7 +40,7 @@ func (h *handler) checkoutRoutes(rg *gin.RouterGroup) {
 // @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
 // @Failure     401 {object} util.ErrorResponse "認証エラー"
 // @Failure     403 {object} util.ErrorResponse "決済システムがメンテナンス中 もしくは 店舗が利用停止中"
-// @Failure     412 {object} util.ErrorResponse "前提条件エラー(商品在庫が不足、無効なプロモーションなど...)"
+// @Failure     412 {object} util.ErrorResponse "前提条件エラー(商品在庫が不足、無効なプロモーション、配送不可地域など...)"
 func (h *handler) CheckoutProduct(ctx *gin.Context) {
 	req := &types.CheckoutProductRequest{}
 	if err := ctx.BindJSON(req); err != nil {
@@ -62,9 +62,16 @@ func (h *handler) CheckoutProduct(ctx *gin.Context) {
 		Total:            req.Total,
 		OrderRequest:     req.OrderRequest,
 		CheckoutProductDetail: store.CheckoutProductDetail{
-			CoordinatorID:     req.CoordinatorID,
-			BoxNumber:         req.BoxNumber,
-			ShippingAddressID: req.ShippingAddressID,
+			CoordinatorID:      req.CoordinatorID,
+			BoxNumber:          req.BoxNumber,
+			ShippingAddressID:  req.ShippingAddressID,
+			DeliveryDate:       req.DeliveryDate,
+			DeliveryTimeWindow: service.DeliveryTimeWindow(req.DeliveryTimeWindow).StoreEntity(),
+			Gift:               req.Gift,
+			NoshiType:          service.NoshiType(req.NoshiType).StoreEntity(),
+			NoshiName:          req.NoshiName,
+			MessageCard:        req.MessageCard,
+			Destinations:       newCheckoutDestinations(req.Destinations),
 		},
 	}
 	params := &checkoutParams{
@@ -75,6 +82,27 @@ func (h *handler) CheckoutProduct(ctx *gin.Context) {
 	h.checkout(ctx, params)
 }
 
+func newCheckoutDestinations(destinations []*types.CheckoutDestination) []*store.CheckoutDestination {
+	if len(destinations) == 0 {
+		return nil
+	}
+	res := make([]*store.CheckoutDestination, len(destinations))
+	for i, d := range destinations {
+		items := make([]*store.CheckoutDestinationItem, len(d.Items))
+		for j, item := range d.Items {
+			items[j] = &store.CheckoutDestinationItem{
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+			}
+		}
+		res[i] = &store.CheckoutDestination{
+			ShippingAddressID: d.ShippingAddressID,
+			Items:             items,
+		}
+	}
+	return res
+}
+
 // @Summary     体験事前決済情報取得
 // @Description 体験を決済する前に必要な情報を取得します。
 // @Tags        Checkout
@@ -113,6 +141,7 @@ func (h *handler) PreCheckoutExperience(ctx *gin.Context) {
 		return
 	}
 	promotionCode := util.GetQuery(ctx, "promotion", "")
+	userID := h.getUserID(ctx)
 
 	var (
 		experience *service.Experience
@@ -127,7 +156,7 @@ func (h *handler) PreCheckoutExperience(ctx *gin.Context) {
 		if promotionCode == "" {
 			return
 		}
-		promotion, err = h.getEnabledPromotion(ectx, promotionCode)
+		promotion, err = h.getEnabledPromotion(ectx, promotionCode, userID)
 		if errors.Is(err, exception.ErrNotFound) {
 			err = nil // エラーは返さず、プロモーション未適用状態で返す
 		}
@@ -194,6 +223,7 @@ func (h *handler) CheckoutExperience(ctx *gin.Context) {
 		OrderRequest:     req.OrderRequest,
 		CheckoutExperienceDetail: store.CheckoutExperienceDetail{
 			ExperienceID:          util.GetParam(ctx, "experienceId"),
+			SlotID:                req.SlotID,
 			AdultCount:            req.AdultCount,
 			JuniorHighSchoolCount: req.JuniorHighSchoolCount,
 			ElementarySchoolCount: req.ElementarySchoolCount,
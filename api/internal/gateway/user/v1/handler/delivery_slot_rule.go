@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        DeliverySlotRule
+// @tag.description 配送日時指定ルール関連
+func (h *handler) deliverySlotRuleRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/coordinators/:coordinatorId/delivery-slot-rules")
+
+	r.GET("", h.ListDeliverySlotRules)
+}
+
+// @Summary     配送日時指定ルール一覧取得
+// @Description コーディネータの店舗で指定可能なお届け希望日時を配送方法ごとに取得します。
+// @Tags        DeliverySlotRule
+// @Router      /coordinators/{coordinatorId}/delivery-slot-rules [get]
+// @Param       coordinatorId path string true "コーディネータID"
+// @Produce     json
+// @Success     200 {object} types.DeliverySlotRulesResponse
+// @Failure     404 {object} util.ErrorResponse "コーディネータが見つからない"
+func (h *handler) ListDeliverySlotRules(ctx *gin.Context) {
+	shop, err := h.getShopByCoordinatorID(ctx, util.GetParam(ctx, "coordinatorId"))
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	in := &store.ListDeliverySlotRulesInput{
+		ShopID: shop.ID,
+	}
+	rules, err := h.store.ListDeliverySlotRules(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.DeliverySlotRulesResponse{
+		DeliverySlotRules: service.NewDeliverySlotRules(rules, h.now()).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
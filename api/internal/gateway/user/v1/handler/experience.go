@@ -23,6 +23,7 @@ func (h *handler) experienceRoutes(rg *gin.RouterGroup) {
 	r.GET("", h.ListExperiences)
 	r.GET("/geolocation", h.ListExperiencesByGeolocation)
 	r.GET("/:experienceId", h.GetExperience)
+	r.GET("/:experienceId/slots", h.ListExperienceSlots)
 }
 
 // @Summary     体験一覧取得
@@ -265,6 +266,37 @@ func (h *handler) GetExperience(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, res)
 }
 
+// @Summary     体験枠一覧取得
+// @Description 指定された体験の予約受付中の体験枠一覧を取得します。
+// @Tags        Experience
+// @Router      /experiences/{experienceId}/slots [get]
+// @Param       experienceId path string true "体験ID"
+// @Produce     json
+// @Success     200 {object} types.ExperienceSlotsResponse
+// @Failure     404 {object} util.ErrorResponse "体験が見つかりません"
+func (h *handler) ListExperienceSlots(ctx *gin.Context) {
+	experience, err := h.getExperience(ctx, ctx.Param("experienceId"))
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	in := &store.ListExperienceSlotsInput{
+		ExperienceID:  experience.ID,
+		OnlyAccepting: true,
+	}
+	slots, err := h.store.ListExperienceSlots(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.ExperienceSlotsResponse{
+		Slots: service.NewExperienceSlots(slots).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
 func (h *handler) listExperiences(ctx context.Context, in *store.ListExperiencesInput) (service.Experiences, error) {
 	experiences, _, err := h.store.ListExperiences(ctx, in)
 	if err != nil || len(experiences) == 0 {
@@ -36,7 +36,7 @@ func (h *handler) guestCheckoutRoutes(rg *gin.RouterGroup) {
 // @Success     200 {object} types.CheckoutResponse
 // @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
 // @Failure     403 {object} util.ErrorResponse "決済システムがメンテナンス中"
-// @Failure     412 {object} util.ErrorResponse "前提条件エラー(商品在庫が不足、無効なプロモーションなど...)"
+// @Failure     412 {object} util.ErrorResponse "前提条件エラー(商品在庫が不足、無効なプロモーション、配送不可地域など...)"
 func (h *handler) GuestCheckoutProduct(ctx *gin.Context) {
 	req := &types.GuestCheckoutProductRequest{}
 	if err := ctx.BindJSON(req); err != nil {
@@ -66,19 +66,20 @@ func (h *handler) GuestCheckoutProduct(ctx *gin.Context) {
 	if req.IsSameAddress {
 		shippingAddressID = billingAddressID
 	} else {
+		// ギフト注文では請求先と異なるお届け先を指定するため、配送先住所の入力内容で登録する
 		saddressIn := &user.CreateAddressInput{
 			UserID:         userID,
-			Lastname:       req.BillingAddress.Lastname,
-			Firstname:      req.BillingAddress.Firstname,
-			LastnameKana:   req.BillingAddress.LastnameKana,
-			FirstnameKana:  req.BillingAddress.FirstnameKana,
-			PostalCode:     req.BillingAddress.PostalCode,
-			PrefectureCode: req.BillingAddress.PrefectureCode,
-			City:           req.BillingAddress.City,
-			AddressLine1:   req.BillingAddress.AddressLine1,
-			AddressLine2:   req.BillingAddress.AddressLine2,
-			PhoneNumber:    req.BillingAddress.PhoneNumber,
-			IsDefault:      true,
+			Lastname:       req.ShippingAddress.Lastname,
+			Firstname:      req.ShippingAddress.Firstname,
+			LastnameKana:   req.ShippingAddress.LastnameKana,
+			FirstnameKana:  req.ShippingAddress.FirstnameKana,
+			PostalCode:     req.ShippingAddress.PostalCode,
+			PrefectureCode: req.ShippingAddress.PrefectureCode,
+			City:           req.ShippingAddress.City,
+			AddressLine1:   req.ShippingAddress.AddressLine1,
+			AddressLine2:   req.ShippingAddress.AddressLine2,
+			PhoneNumber:    req.ShippingAddress.PhoneNumber,
+			IsDefault:      false,
 		}
 		saddress, err := h.user.CreateAddress(ctx, saddressIn)
 		if err != nil {
@@ -99,9 +100,15 @@ func (h *handler) GuestCheckoutProduct(ctx *gin.Context) {
 		Total:            req.Total,
 		OrderRequest:     req.OrderRequest,
 		CheckoutProductDetail: store.CheckoutProductDetail{
-			CoordinatorID:     req.CoordinatorID,
-			BoxNumber:         req.BoxNumber,
-			ShippingAddressID: shippingAddressID,
+			CoordinatorID:      req.CoordinatorID,
+			BoxNumber:          req.BoxNumber,
+			ShippingAddressID:  shippingAddressID,
+			DeliveryDate:       req.DeliveryDate,
+			DeliveryTimeWindow: service.DeliveryTimeWindow(req.DeliveryTimeWindow).StoreEntity(),
+			Gift:               req.Gift,
+			NoshiType:          service.NoshiType(req.NoshiType).StoreEntity(),
+			NoshiName:          req.NoshiName,
+			MessageCard:        req.MessageCard,
 		},
 	}
 	params := &checkoutParams{
@@ -156,6 +163,7 @@ func (h *handler) GuestCheckoutExperience(ctx *gin.Context) {
 		OrderRequest:     req.OrderRequest,
 		CheckoutExperienceDetail: store.CheckoutExperienceDetail{
 			ExperienceID:          util.GetParam(ctx, "experienceId"),
+			SlotID:                req.SlotID,
 			AdultCount:            req.AdultCount,
 			JuniorHighSchoolCount: req.JuniorHighSchoolCount,
 			ElementarySchoolCount: req.ElementarySchoolCount,
@@ -27,6 +27,8 @@ func (h *handler) guestLiveCommentRoutes(rg *gin.RouterGroup) {
 // @Produce     json
 // @Success     204
 // @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     403 {object} util.ErrorResponse "コメント投稿が制限されている"
+// @Failure     429 {object} util.ErrorResponse "コメント投稿の頻度が上限を超えている"
 func (h *handler) CreateGuestLiveComment(ctx *gin.Context) {
 	req := &types.CreateGuestLiveCommentRequest{}
 	if err := ctx.BindJSON(req); err != nil {
@@ -35,6 +37,8 @@ func (h *handler) CreateGuestLiveComment(ctx *gin.Context) {
 	}
 	in := &media.CreateBroadcastGuestCommentInput{
 		ScheduleID: util.GetParam(ctx, "scheduleId"),
+		SessionID:  h.getSessionID(ctx),
+		ClientIP:   ctx.ClientIP(),
 		Content:    req.Comment,
 	}
 	if _, err := h.media.CreateBroadcastGuestComment(ctx, in); err != nil {
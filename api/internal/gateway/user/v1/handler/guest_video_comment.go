@@ -27,6 +27,7 @@ func (h *handler) guestVideoCommentRoutes(rg *gin.RouterGroup) {
 // @Produce     json
 // @Success     204
 // @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     429 {object} util.ErrorResponse "コメント投稿の頻度が上限を超えている"
 func (h *handler) CreateGuestVideoComment(ctx *gin.Context) {
 	req := &types.CreateGuestVideoCommentRequest{}
 	if err := ctx.BindJSON(req); err != nil {
@@ -34,8 +35,10 @@ func (h *handler) CreateGuestVideoComment(ctx *gin.Context) {
 		return
 	}
 	in := &media.CreateVideoGuestCommentInput{
-		VideoID: util.GetParam(ctx, "videoId"),
-		Content: req.Comment,
+		VideoID:   util.GetParam(ctx, "videoId"),
+		SessionID: h.getSessionID(ctx),
+		ClientIP:  ctx.ClientIP(),
+		Content:   req.Comment,
 	}
 	if _, err := h.media.CreateVideoGuestComment(ctx, in); err != nil {
 		h.httpError(ctx, err)
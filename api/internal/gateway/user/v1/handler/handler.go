@@ -163,6 +163,7 @@ func (h *handler) Routes(rg *gin.RouterGroup) {
 	h.productRoutes(v1)
 	h.experienceRoutes(v1)
 	h.coordinatorRoutes(v1)
+	h.deliverySlotRuleRoutes(v1)
 	h.producerRoutes(v1)
 	h.promotionRoutes(v1)
 	h.postalCodeRoutes(v1)
@@ -180,9 +181,12 @@ func (h *handler) Routes(rg *gin.RouterGroup) {
 	h.checkoutRoutes(v1)
 	h.experienceReviewRoutes(v1)
 	h.liveCommentRoutes(v1)
+	h.liveEventRoutes(v1)
+	h.livePinRoutes(v1)
 	h.orderRoutes(v1)
 	h.productReviewRoutes(v1)
 	h.spotRoutes(v1)
+	h.subscriptionRoutes(v1)
 	h.videoCommentRoutes(v1)
 	h.uploadRoutes(v1)
 }
@@ -97,6 +97,8 @@ func (h *handler) ListLiveComments(ctx *gin.Context) {
 // @Success     204
 // @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
 // @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     403 {object} util.ErrorResponse "コメント投稿が制限されている"
+// @Failure     429 {object} util.ErrorResponse "コメント投稿の頻度が上限を超えている"
 func (h *handler) CreateLiveComment(ctx *gin.Context) {
 	req := &types.CreateLiveCommentRequest{}
 	if err := ctx.BindJSON(req); err != nil {
@@ -106,6 +108,8 @@ func (h *handler) CreateLiveComment(ctx *gin.Context) {
 	in := &media.CreateBroadcastCommentInput{
 		ScheduleID: util.GetParam(ctx, "scheduleId"),
 		UserID:     h.getUserID(ctx),
+		SessionID:  h.getSessionID(ctx),
+		ClientIP:   ctx.ClientIP(),
 		Content:    req.Comment,
 	}
 	if _, err := h.media.CreateBroadcastComment(ctx, in); err != nil {
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/media"
+	mentity "github.com/and-period/furumaru/api/internal/media/entity"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// プロキシ等でアイドル状態の接続が切断されないよう、定期的にコメント行を送信する
+const liveEventHeartbeatInterval = 15 * time.Second
+
+// @tag.name        LiveEvent
+// @tag.description ライブ配信イベント関連
+func (h *handler) liveEventRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/schedules/:scheduleId/events")
+
+	r.GET("", h.createBroadcastViewerLog, h.StreamLiveEvents)
+}
+
+// @Summary     ライブ配信イベント購読
+// @Description ライブ配信中のコメント投稿・コメント非表示・視聴者数・商品ピン留めのイベントをServer-Sent Eventsで配信します。
+// @Description 再接続時はLast-Event-IDヘッダー（またはcursorクエリ）に最後に受信したイベントIDを指定すると、その続きから配信します。
+// @Tags        LiveEvent
+// @Router      /schedules/{scheduleId}/events [get]
+// @Param       scheduleId path string true "スケジュールID"
+// @Param       Last-Event-ID header string false "最後に受信したイベントID"
+// @Param       cursor query string false "最後に受信したイベントID(Last-Event-IDを指定できない場合)"
+// @Produce     text/event-stream
+// @Success     200 {object} types.LiveEvent
+// @Failure     404 {object} util.ErrorResponse "ライブ配信が存在しない"
+func (h *handler) StreamLiveEvents(ctx *gin.Context) {
+	schedule, err := h.getSchedule(ctx, util.GetParam(ctx, "scheduleId"))
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	cursor := ctx.GetHeader("Last-Event-ID")
+	if cursor == "" {
+		cursor = util.GetQuery(ctx, "cursor", "")
+	}
+
+	// 接続が切断されたタイミングで購読を終了させるため、リクエストのコンテキストを利用する
+	rctx := ctx.Request.Context()
+	in := &media.SubscribeBroadcastEventsInput{
+		ScheduleID: schedule.ID,
+		Cursor:     cursor,
+	}
+	events, err := h.media.SubscribeBroadcastEvents(rctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(liveEventHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-rctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(ctx.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return // 購読が終了した場合、クライアントからの再接続に任せる
+			}
+			if err := h.writeLiveEvent(ctx, event); err != nil {
+				slog.WarnContext(rctx, "Failed to write live event",
+					slog.String("scheduleId", schedule.ID), slog.String("eventId", event.ID), log.Error(err))
+				return
+			}
+		}
+	}
+}
+
+func (h *handler) writeLiveEvent(ctx *gin.Context, event *mentity.BroadcastEvent) error {
+	var user *uentity.User
+	if event.Type == mentity.BroadcastEventTypeCommentCreated && event.Comment != nil && event.Comment.UserID != "" {
+		user = h.getLiveEventUser(ctx.Request.Context(), event.Comment.UserID)
+	}
+	data, err := json.Marshal(service.NewLiveEvent(event, user).Response())
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(ctx.Writer, "id: %s\ndata: %s\n\n", event.ID, data); err != nil {
+		return err
+	}
+	ctx.Writer.Flush()
+	return nil
+}
+
+// getLiveEventUser - コメント投稿者を取得する（同時に接続している視聴者間で問い合わせを共有する）
+func (h *handler) getLiveEventUser(ctx context.Context, userID string) *uentity.User {
+	v, err, _ := h.sharedGroup.Do("live-event-user:"+userID, func() (any, error) {
+		return h.getMember(context.WithoutCancel(ctx), userID)
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get comment user", slog.String("userId", userID), log.Error(err))
+		return nil
+	}
+	user, _ := v.(*uentity.User)
+	return user
+}
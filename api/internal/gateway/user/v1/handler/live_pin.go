@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        LivePin
+// @tag.description ライブ配信商品ピン留め関連
+func (h *handler) livePinRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/schedules/:scheduleId/pins")
+
+	r.GET("", h.ListLivePins)
+}
+
+// @Summary     ピン留め一覧取得
+// @Description ライブ配信の商品ピン留め一覧を取得します。sinceを指定した場合、指定日時以降に更新されたピン留めのみを返します。
+// @Tags        LivePin
+// @Router      /schedules/{scheduleId}/pins [get]
+// @Param       scheduleId path string true "スケジュールID"
+// @Param       since query int64 false "更新日時(この日時以降に更新されたものを取得)" example("1640962800")
+// @Produce     json
+// @Success     200 {object} types.LivePinsResponse
+func (h *handler) ListLivePins(ctx *gin.Context) {
+	schedule, err := h.getSchedule(ctx, util.GetParam(ctx, "scheduleId"))
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	since, err := util.GetQueryInt64(ctx, "since", 0)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.ListLivePinsInput{
+		ScheduleID:   schedule.ID,
+		UpdatedAtGte: jst.ParseFromUnix(since),
+	}
+	pins, err := h.store.ListLivePins(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	if len(pins) == 0 {
+		res := &types.LivePinsResponse{
+			Pins:     []*types.LivePin{},
+			Products: []*types.Product{},
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+	products, err := h.multiGetProducts(ctx, pins.ProductIDs())
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.LivePinsResponse{
+		Pins:     service.NewLivePins(pins).Response(),
+		Products: products.Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
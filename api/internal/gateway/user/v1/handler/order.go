@@ -23,6 +23,9 @@ func (h *handler) orderRoutes(rg *gin.RouterGroup) {
 
 	r.GET("", h.ListOrders)
 	r.GET("/:orderId", h.GetOrder)
+	r.GET("/:orderId/invoice", h.ExportOrderInvoice)
+	r.GET("/:orderId/claims", h.ListOrderClaims)
+	r.POST("/:orderId/claims", h.CreateOrderClaim)
 }
 
 // @Summary     注文一覧取得
@@ -71,6 +74,7 @@ func (h *handler) ListOrders(ctx *gin.Context) {
 		sentity.OrderStatusCompleted,
 		sentity.OrderStatusCanceled,
 		sentity.OrderStatusRefunded,
+		sentity.OrderStatusPartiallyRefunded,
 	}
 	ordersIn := &store.ListOrdersInput{
 		UserID:   h.getUserID(ctx),
@@ -205,6 +209,37 @@ func (h *handler) GetOrder(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, res)
 }
 
+// @Summary     領収書・請求書のPDF出力
+// @Description 注文の領収書（決済済みの場合）または請求書をインボイス制度に対応したPDF形式で出力します。
+// @Tags        Order
+// @Router      /orders/{orderId}/invoice [get]
+// @Security    bearerauth
+// @Param       orderId path string true "注文ID"
+// @Produce     application/pdf
+// @Success     200 {string} file "PDFファイル"
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     404 {object} util.ErrorResponse "注文が見つからない"
+// @Failure     412 {object} util.ErrorResponse "領収書・請求書を発行できない注文"
+func (h *handler) ExportOrderInvoice(ctx *gin.Context) {
+	in := &store.ExportOrderInvoiceInput{
+		OrderID: util.GetParam(ctx, "orderId"),
+		UserID:  h.getUserID(ctx),
+	}
+	value, err := h.store.ExportOrderInvoice(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	filename := fmt.Sprintf("invoice_%s.pdf", in.OrderID)
+	ctx.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Writer.Header().Set("Content-Type", "application/pdf")
+	if _, err := ctx.Writer.Write(value); err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
 func (h *handler) getOrder(ctx context.Context, userID, orderID string) (*service.Order, error) {
 	in := &store.GetOrderInput{
 		OrderID: orderID,
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary     返品・交換申請一覧取得
+// @Description 注文に対する返品・交換申請の一覧を取得します。
+// @Tags        Order
+// @Router      /orders/{orderId}/claims [get]
+// @Security    bearerauth
+// @Param       orderId path string true "注文ID"
+// @Param       limit query int64 false "取得件数" default(20)
+// @Param       offset query int64 false "取得開始位置" default(0)
+// @Produce     json
+// @Success     200 {object} types.OrderClaimsResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+func (h *handler) ListOrderClaims(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.ListOrderClaimsInput{
+		OrderID: util.GetParam(ctx, "orderId"),
+		UserID:  h.getUserID(ctx),
+		Limit:   limit,
+		Offset:  offset,
+	}
+	claims, total, err := h.store.ListOrderClaims(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	if len(claims) == 0 {
+		res := &types.OrderClaimsResponse{
+			Claims:     []*types.OrderClaim{},
+			Promotions: []*types.Promotion{},
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
+	products, err := h.multiGetProductsByRevision(ctx, claims.ProductRevisionIDs())
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	sclaims := service.NewOrderClaims(claims, products.MapByRevision())
+	promotions, err := h.multiGetPromotion(ctx, sclaims.PromotionIDs())
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.OrderClaimsResponse{
+		Claims:     sclaims.Response(),
+		Promotions: promotions.Response(),
+		Total:      total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     返品・交換申請
+// @Description 到着した商品の傷みや破損などについて、返品・交換を申請します。証拠画像は事前にアップロードしたURLを指定してください。
+// @Tags        Order
+// @Router      /orders/{orderId}/claims [post]
+// @Security    bearerauth
+// @Param       orderId path string true "注文ID"
+// @Accept      json
+// @Param       request body types.CreateOrderClaimRequest true "返品・交換申請"
+// @Produce     json
+// @Success     200 {object} types.OrderClaimResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     404 {object} util.ErrorResponse "注文が見つからない"
+// @Failure     412 {object} util.ErrorResponse "申請できない注文状態"
+func (h *handler) CreateOrderClaim(ctx *gin.Context) {
+	req := &types.CreateOrderClaimRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	items := make([]*store.CreateOrderClaimItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = &store.CreateOrderClaimItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	in := &store.CreateOrderClaimInput{
+		OrderID:     util.GetParam(ctx, "orderId"),
+		UserID:      h.getUserID(ctx),
+		Type:        service.OrderClaimType(req.Type).StoreEntity(),
+		Description: req.Description,
+		Items:       items,
+		ImageURLs:   req.ImageURLs,
+	}
+	claim, err := h.store.CreateOrderClaim(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	products, err := h.multiGetProductsByRevision(ctx, claim.ProductRevisionIDs())
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.OrderClaimResponse{
+		Claim: service.NewOrderClaim(claim, products.MapByRevision()).Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
@@ -33,6 +33,7 @@ func (h *handler) promotionRoutes(rg *gin.RouterGroup) {
 func (h *handler) GetPromotion(ctx *gin.Context) {
 	in := &store.GetPromotionByCodeInput{
 		PromotionCode: util.GetParam(ctx, "code"),
+		UserID:        h.getUserID(ctx),
 	}
 	promotion, err := h.store.GetPromotionByCode(ctx, in)
 	if err != nil {
@@ -87,9 +88,10 @@ func (h *handler) getPromotion(ctx context.Context, promotionID string) (*servic
 	return service.NewPromotion(promotion), nil
 }
 
-func (h *handler) getEnabledPromotion(ctx context.Context, code string) (*service.Promotion, error) {
+func (h *handler) getEnabledPromotion(ctx context.Context, code, userID string) (*service.Promotion, error) {
 	in := &store.GetPromotionByCodeInput{
 		PromotionCode: code,
+		UserID:        userID,
 		OnlyEnabled:   true,
 	}
 	promotion, err := h.store.GetPromotionByCode(ctx, in)
@@ -11,6 +11,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/gateway/util"
 	"github.com/and-period/furumaru/api/internal/media"
 	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/sync/errgroup"
 )
@@ -178,12 +179,20 @@ func (h *handler) GetSchedule(ctx *gin.Context) {
 		coordinator *service.Coordinator
 		producers   service.Producers
 		products    service.Products
+		pins        sentity.LivePins
 	)
 	eg, ectx := errgroup.WithContext(ctx)
 	eg.Go(func() (err error) {
 		coordinator, err = h.getCoordinator(ectx, schedule.CoordinatorID)
 		return
 	})
+	eg.Go(func() (err error) {
+		in := &store.ListLivePinsInput{
+			ScheduleID: schedule.ID,
+		}
+		pins, err = h.store.ListLivePins(ectx, in)
+		return
+	})
 	eg.Go(func() (err error) {
 		producers, err = h.multiGetProducers(ectx, lives.ProducerIDs())
 		return
@@ -203,6 +212,7 @@ func (h *handler) GetSchedule(ctx *gin.Context) {
 		Lives:       service.NewLives(lives).Response(),
 		Producers:   producers.Response(),
 		Products:    products.Response(),
+		Pins:        service.NewLivePins(pins).Response(),
 	}
 	ctx.JSON(http.StatusOK, res)
 }
@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/service"
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/gateway/util"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/gin-gonic/gin"
+)
+
+// @tag.name        Subscription
+// @tag.description 定期便関連
+func (h *handler) subscriptionRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/subscriptions", h.authentication)
+
+	r.GET("", h.ListSubscriptions)
+	r.POST("", h.CreateSubscription)
+	r.GET("/:subscriptionId", h.GetSubscription)
+	r.POST("/:subscriptionId/skip", h.SkipSubscription)
+	r.POST("/:subscriptionId/pause", h.PauseSubscription)
+	r.POST("/:subscriptionId/resume", h.ResumeSubscription)
+	r.POST("/:subscriptionId/cancel", h.CancelSubscription)
+}
+
+// @Summary     定期便一覧取得
+// @Description 申し込み済みの定期便の一覧を取得します。
+// @Tags        Subscription
+// @Router      /subscriptions [get]
+// @Security    bearerauth
+// @Param       limit query int64 false "取得件数" default(20)
+// @Param       offset query int64 false "取得開始位置" default(0)
+// @Produce     json
+// @Success     200 {object} types.SubscriptionsResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+func (h *handler) ListSubscriptions(ctx *gin.Context) {
+	const (
+		defaultLimit  = 20
+		defaultOffset = 0
+	)
+
+	limit, err := util.GetQueryInt64(ctx, "limit", defaultLimit)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	offset, err := util.GetQueryInt64(ctx, "offset", defaultOffset)
+	if err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+
+	in := &store.ListSubscriptionsInput{
+		UserID: h.getUserID(ctx),
+		Limit:  limit,
+		Offset: offset,
+	}
+	subscriptions, total, err := h.store.ListSubscriptions(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	if len(subscriptions) == 0 {
+		res := &types.SubscriptionsResponse{
+			Subscriptions: []*types.Subscription{},
+			Products:      []*types.Product{},
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+	products, err := h.multiGetProducts(ctx, subscriptions.ProductIDs())
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+
+	res := &types.SubscriptionsResponse{
+		Subscriptions: service.NewSubscriptions(subscriptions).Response(),
+		Products:      products.Response(),
+		Total:         total,
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Summary     定期便取得
+// @Description 定期便の詳細を取得します。
+// @Tags        Subscription
+// @Router      /subscriptions/{subscriptionId} [get]
+// @Security    bearerauth
+// @Param       subscriptionId path string true "定期便ID"
+// @Produce     json
+// @Success     200 {object} types.SubscriptionResponse
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     404 {object} util.ErrorResponse "定期便が見つからない"
+func (h *handler) GetSubscription(ctx *gin.Context) {
+	in := &store.GetSubscriptionInput{
+		SubscriptionID: util.GetParam(ctx, "subscriptionId"),
+		UserID:         h.getUserID(ctx),
+	}
+	subscription, err := h.store.GetSubscription(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.subscriptionResponse(ctx, subscription)
+}
+
+// @Summary     定期便申し込み
+// @Description 定期便を申し込みます。決済手段はStripeで作成したカードの決済手段IDを指定してください。
+// @Tags        Subscription
+// @Router      /subscriptions [post]
+// @Security    bearerauth
+// @Accept      json
+// @Param       request body types.CreateSubscriptionRequest true "定期便申し込み"
+// @Produce     json
+// @Success     200 {object} types.SubscriptionResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     403 {object} util.ErrorResponse "申し込みできない店舗"
+// @Failure     412 {object} util.ErrorResponse "販売中の商品が存在しない"
+func (h *handler) CreateSubscription(ctx *gin.Context) {
+	req := &types.CreateSubscriptionRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		h.badRequest(ctx, err)
+		return
+	}
+	items := make([]*store.CreateSubscriptionItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = &store.CreateSubscriptionItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	in := &store.CreateSubscriptionInput{
+		UserID:          h.getUserID(ctx),
+		Interval:        service.SubscriptionInterval(req.Interval).StoreEntity(),
+		Items:           items,
+		AddressID:       req.AddressID,
+		PaymentMethodID: req.PaymentMethodID,
+		StartAt:         jst.ParseFromUnix(req.StartAt),
+	}
+	subscription, err := h.store.CreateSubscription(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.subscriptionResponse(ctx, subscription)
+}
+
+// @Summary     定期便スキップ
+// @Description 次回のお届けをスキップします。
+// @Tags        Subscription
+// @Router      /subscriptions/{subscriptionId}/skip [post]
+// @Security    bearerauth
+// @Param       subscriptionId path string true "定期便ID"
+// @Produce     json
+// @Success     200 {object} types.SubscriptionResponse
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     404 {object} util.ErrorResponse "定期便が見つからない"
+// @Failure     412 {object} util.ErrorResponse "スキップできない契約状況"
+func (h *handler) SkipSubscription(ctx *gin.Context) {
+	in := &store.SkipSubscriptionInput{
+		SubscriptionID: util.GetParam(ctx, "subscriptionId"),
+		UserID:         h.getUserID(ctx),
+	}
+	subscription, err := h.store.SkipSubscription(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.subscriptionResponse(ctx, subscription)
+}
+
+// @Summary     定期便一時停止
+// @Description 定期便のお届けを一時停止します。
+// @Tags        Subscription
+// @Router      /subscriptions/{subscriptionId}/pause [post]
+// @Security    bearerauth
+// @Param       subscriptionId path string true "定期便ID"
+// @Produce     json
+// @Success     200 {object} types.SubscriptionResponse
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     404 {object} util.ErrorResponse "定期便が見つからない"
+// @Failure     412 {object} util.ErrorResponse "一時停止できない契約状況"
+func (h *handler) PauseSubscription(ctx *gin.Context) {
+	in := &store.PauseSubscriptionInput{
+		SubscriptionID: util.GetParam(ctx, "subscriptionId"),
+		UserID:         h.getUserID(ctx),
+	}
+	subscription, err := h.store.PauseSubscription(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.subscriptionResponse(ctx, subscription)
+}
+
+// @Summary     定期便再開
+// @Description 一時停止中・停止中の定期便を再開します。
+// @Tags        Subscription
+// @Router      /subscriptions/{subscriptionId}/resume [post]
+// @Security    bearerauth
+// @Param       subscriptionId path string true "定期便ID"
+// @Produce     json
+// @Success     200 {object} types.SubscriptionResponse
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     404 {object} util.ErrorResponse "定期便が見つからない"
+// @Failure     412 {object} util.ErrorResponse "再開できない契約状況"
+func (h *handler) ResumeSubscription(ctx *gin.Context) {
+	in := &store.ResumeSubscriptionInput{
+		SubscriptionID: util.GetParam(ctx, "subscriptionId"),
+		UserID:         h.getUserID(ctx),
+	}
+	subscription, err := h.store.ResumeSubscription(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.subscriptionResponse(ctx, subscription)
+}
+
+// @Summary     定期便解約
+// @Description 定期便を解約します。解約後は再開できません。
+// @Tags        Subscription
+// @Router      /subscriptions/{subscriptionId}/cancel [post]
+// @Security    bearerauth
+// @Param       subscriptionId path string true "定期便ID"
+// @Produce     json
+// @Success     200 {object} types.SubscriptionResponse
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+// @Failure     404 {object} util.ErrorResponse "定期便が見つからない"
+// @Failure     412 {object} util.ErrorResponse "解約済みの定期便"
+func (h *handler) CancelSubscription(ctx *gin.Context) {
+	in := &store.CancelSubscriptionInput{
+		SubscriptionID: util.GetParam(ctx, "subscriptionId"),
+		UserID:         h.getUserID(ctx),
+	}
+	subscription, err := h.store.CancelSubscription(ctx, in)
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	h.subscriptionResponse(ctx, subscription)
+}
+
+func (h *handler) subscriptionResponse(ctx *gin.Context, subscription *entity.Subscription) {
+	products, err := h.multiGetProducts(ctx, subscription.ProductIDs())
+	if err != nil {
+		h.httpError(ctx, err)
+		return
+	}
+	res := &types.SubscriptionResponse{
+		Subscription: service.NewSubscription(subscription).Response(),
+		Products:     products.Response(),
+	}
+	ctx.JSON(http.StatusOK, res)
+}
@@ -20,6 +20,7 @@ func (h *handler) uploadRoutes(rg *gin.RouterGroup) {
 	r.GET("/state", h.GetUploadState)
 	r.POST("/users/thumbnail", h.authentication, h.CreateUserThumbnailURL)
 	r.POST("/spots/thumbnail", h.authentication, h.CreateSpotThumbnailURL)
+	r.POST("/orders/claims/image", h.authentication, h.CreateOrderClaimImageURL)
 }
 
 // @Summary     アップロード状態取得
@@ -76,6 +77,21 @@ func (h *handler) CreateSpotThumbnailURL(ctx *gin.Context) {
 	h.getUploadURL(ctx, h.media.GetSpotThumbnailUploadURL)
 }
 
+// @Summary     返品・交換申請画像アップロードURL取得
+// @Description 返品・交換申請に添付する証拠画像をアップロードするためのURLを取得します。
+// @Tags        Upload
+// @Router      /upload/orders/claims/image [post]
+// @Security    bearerauth
+// @Accept      json
+// @Produce     json
+// @Param       body body types.GetUploadURLRequest true "ファイル情報"
+// @Success     200 {object} types.UploadURLResponse
+// @Failure     400 {object} util.ErrorResponse "バリデーションエラー"
+// @Failure     401 {object} util.ErrorResponse "認証エラー"
+func (h *handler) CreateOrderClaimImageURL(ctx *gin.Context) {
+	h.getUploadURL(ctx, h.media.GetOrderClaimImageUploadURL)
+}
+
 func (h *handler) getUploadURL(ctx *gin.Context, fn func(context.Context, *media.GenerateUploadURLInput) (*entity.UploadEvent, error)) {
 	req := &types.GetUploadURLRequest{}
 	if err := ctx.BindJSON(req); err != nil {
@@ -98,6 +98,7 @@ func (h *handler) ListVideoComments(ctx *gin.Context) {
 // @Failure     401 {object} util.ErrorResponse "認証エラー"
 // @Failure     404 {object} util.ErrorResponse "オンデマンド配信が存在しない"
 // @Failure     412 {object} util.ErrorResponse "オンデマンド配信が公開されていない"
+// @Failure     429 {object} util.ErrorResponse "コメント投稿の頻度が上限を超えている"
 func (h *handler) CreateVideoComment(ctx *gin.Context) {
 	req := &types.CreateVideoCommentRequest{}
 	if err := ctx.BindJSON(req); err != nil {
@@ -105,9 +106,11 @@ func (h *handler) CreateVideoComment(ctx *gin.Context) {
 		return
 	}
 	in := &media.CreateVideoCommentInput{
-		VideoID: util.GetParam(ctx, "videoId"),
-		UserID:  h.getUserID(ctx),
-		Content: req.Comment,
+		VideoID:   util.GetParam(ctx, "videoId"),
+		UserID:    h.getUserID(ctx),
+		SessionID: h.getSessionID(ctx),
+		ClientIP:  ctx.ClientIP(),
+		Content:   req.Comment,
 	}
 	if _, err := h.media.CreateVideoComment(ctx, in); err != nil {
 		h.httpError(ctx, err)
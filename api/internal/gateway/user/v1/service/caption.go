@@ -0,0 +1,29 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+)
+
+var captionLabels = map[string]string{
+	entity.BroadcastCaptionLanguageJapanese: "日本語",
+	entity.BroadcastCaptionLanguageEnglish:  "English",
+}
+
+// newCaptionTracks - 字幕ファイル一覧（key：言語コード,value：ファイル参照先URL）から表示順の字幕トラック一覧を生成
+func newCaptionTracks(captions map[string]string) []*types.CaptionTrack {
+	res := make([]*types.CaptionTrack, 0, len(entity.BroadcastCaptionLanguages))
+	for _, language := range entity.BroadcastCaptionLanguages {
+		captionURL, ok := captions[language]
+		if !ok || captionURL == "" {
+			continue
+		}
+		track := &types.CaptionTrack{
+			Language: language,
+			Label:    captionLabels[language],
+			URL:      captionURL,
+		}
+		res = append(res, track)
+	}
+	return res
+}
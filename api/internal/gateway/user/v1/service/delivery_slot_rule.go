@@ -0,0 +1,104 @@
+package service
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// DeliveryTimeWindow - お届け希望時間帯
+type DeliveryTimeWindow types.DeliveryTimeWindow
+
+type DeliverySlotRule struct {
+	types.DeliverySlotRule
+}
+
+type DeliverySlotRules []*DeliverySlotRule
+
+func NewDeliveryTimeWindow(window entity.DeliveryTimeWindow) DeliveryTimeWindow {
+	switch window {
+	case entity.DeliveryTimeWindowMorning:
+		return DeliveryTimeWindow(types.DeliveryTimeWindowMorning)
+	case entity.DeliveryTimeWindow1214:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1214)
+	case entity.DeliveryTimeWindow1416:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1416)
+	case entity.DeliveryTimeWindow1618:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1618)
+	case entity.DeliveryTimeWindow1820:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1820)
+	case entity.DeliveryTimeWindow1921:
+		return DeliveryTimeWindow(types.DeliveryTimeWindow1921)
+	default:
+		return DeliveryTimeWindow(types.DeliveryTimeWindowUnspecified)
+	}
+}
+
+func (w DeliveryTimeWindow) StoreEntity() entity.DeliveryTimeWindow {
+	switch types.DeliveryTimeWindow(w) {
+	case types.DeliveryTimeWindowMorning:
+		return entity.DeliveryTimeWindowMorning
+	case types.DeliveryTimeWindow1214:
+		return entity.DeliveryTimeWindow1214
+	case types.DeliveryTimeWindow1416:
+		return entity.DeliveryTimeWindow1416
+	case types.DeliveryTimeWindow1618:
+		return entity.DeliveryTimeWindow1618
+	case types.DeliveryTimeWindow1820:
+		return entity.DeliveryTimeWindow1820
+	case types.DeliveryTimeWindow1921:
+		return entity.DeliveryTimeWindow1921
+	default:
+		return entity.DeliveryTimeWindowUnspecified
+	}
+}
+
+func (w DeliveryTimeWindow) Response() types.DeliveryTimeWindow {
+	return types.DeliveryTimeWindow(w)
+}
+
+func NewDeliverySlotRule(rule *entity.DeliverySlotRule, now time.Time) *DeliverySlotRule {
+	var latestDate string
+	if latest := rule.LatestDate(now); !latest.IsZero() {
+		latestDate = jst.FormatYYYYMMDD(latest)
+	}
+	blackoutDates := rule.BlackoutDates
+	if blackoutDates == nil {
+		blackoutDates = []string{}
+	}
+	windows := make([]types.DeliveryTimeWindow, len(rule.TimeWindows))
+	for i := range rule.TimeWindows {
+		windows[i] = NewDeliveryTimeWindow(rule.TimeWindows[i]).Response()
+	}
+	return &DeliverySlotRule{
+		DeliverySlotRule: types.DeliverySlotRule{
+			ShippingType:  NewShippingType(rule.ShippingType).Response(),
+			EarliestDate:  jst.FormatYYYYMMDD(rule.EarliestDate(now)),
+			LatestDate:    latestDate,
+			BlackoutDates: blackoutDates,
+			TimeWindows:   windows,
+		},
+	}
+}
+
+func (r *DeliverySlotRule) Response() *types.DeliverySlotRule {
+	return &r.DeliverySlotRule
+}
+
+func NewDeliverySlotRules(rules entity.DeliverySlotRules, now time.Time) DeliverySlotRules {
+	res := make(DeliverySlotRules, len(rules))
+	for i := range rules {
+		res[i] = NewDeliverySlotRule(rules[i], now)
+	}
+	return res
+}
+
+func (rs DeliverySlotRules) Response() []*types.DeliverySlotRule {
+	res := make([]*types.DeliverySlotRule, len(rs))
+	for i := range rs {
+		res[i] = rs[i].Response()
+	}
+	return res
+}
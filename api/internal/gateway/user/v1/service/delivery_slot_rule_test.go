@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryTimeWindow(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		window entity.DeliveryTimeWindow
+		expect DeliveryTimeWindow
+	}{
+		{name: "morning", window: entity.DeliveryTimeWindowMorning, expect: DeliveryTimeWindow(types.DeliveryTimeWindowMorning)},
+		{name: "12-14", window: entity.DeliveryTimeWindow1214, expect: DeliveryTimeWindow(types.DeliveryTimeWindow1214)},
+		{name: "14-16", window: entity.DeliveryTimeWindow1416, expect: DeliveryTimeWindow(types.DeliveryTimeWindow1416)},
+		{name: "16-18", window: entity.DeliveryTimeWindow1618, expect: DeliveryTimeWindow(types.DeliveryTimeWindow1618)},
+		{name: "18-20", window: entity.DeliveryTimeWindow1820, expect: DeliveryTimeWindow(types.DeliveryTimeWindow1820)},
+		{name: "19-21", window: entity.DeliveryTimeWindow1921, expect: DeliveryTimeWindow(types.DeliveryTimeWindow1921)},
+		{name: "unspecified", window: entity.DeliveryTimeWindowUnspecified, expect: DeliveryTimeWindow(types.DeliveryTimeWindowUnspecified)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewDeliveryTimeWindow(tt.window)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.window, actual.StoreEntity())
+		})
+	}
+}
+
+func TestDeliverySlotRules(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	tests := []struct {
+		name   string
+		rules  entity.DeliverySlotRules
+		expect []*types.DeliverySlotRule
+	}{
+		{
+			name: "success",
+			rules: entity.DeliverySlotRules{
+				{
+					ID:             "rule-id",
+					ShopID:         "shop-id",
+					CoordinatorID:  "coordinator-id",
+					ShippingType:   entity.ShippingTypeFrozen,
+					LeadDays:       2,
+					SelectableDays: 14,
+					BlackoutDates:  []string{"20261231"},
+					TimeWindows:    []entity.DeliveryTimeWindow{entity.DeliveryTimeWindowMorning},
+				},
+				{
+					ID:            "rule-id2",
+					ShopID:        "shop-id",
+					CoordinatorID: "coordinator-id",
+					ShippingType:  entity.ShippingTypeNormal,
+					LeadDays:      3,
+				},
+			},
+			expect: []*types.DeliverySlotRule{
+				{
+					ShippingType:  types.ShippingTypeFrozen,
+					EarliestDate:  "20261020",
+					LatestDate:    "20261102",
+					BlackoutDates: []string{"20261231"},
+					TimeWindows:   []types.DeliveryTimeWindow{types.DeliveryTimeWindowMorning},
+				},
+				{
+					ShippingType:  types.ShippingTypeNormal,
+					EarliestDate:  "20261021",
+					LatestDate:    "",
+					BlackoutDates: []string{},
+					TimeWindows:   []types.DeliveryTimeWindow{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewDeliverySlotRules(tt.rules, now)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+)
+
+// ExperienceSlotStatus - 体験枠の受付状況
+type ExperienceSlotStatus types.ExperienceSlotStatus
+
+type ExperienceSlot struct {
+	types.ExperienceSlot
+}
+
+type ExperienceSlots []*ExperienceSlot
+
+func NewExperienceSlotStatus(status entity.ExperienceSlotStatus) ExperienceSlotStatus {
+	switch status {
+	case entity.ExperienceSlotStatusAccepting:
+		return ExperienceSlotStatus(types.ExperienceSlotStatusAccepting)
+	case entity.ExperienceSlotStatusSoldOut:
+		return ExperienceSlotStatus(types.ExperienceSlotStatusSoldOut)
+	case entity.ExperienceSlotStatusFinished:
+		return ExperienceSlotStatus(types.ExperienceSlotStatusFinished)
+	default:
+		return ExperienceSlotStatus(types.ExperienceSlotStatusUnknown)
+	}
+}
+
+func (s ExperienceSlotStatus) Response() types.ExperienceSlotStatus {
+	return types.ExperienceSlotStatus(s)
+}
+
+func NewExperienceSlot(slot *entity.ExperienceSlot) *ExperienceSlot {
+	return &ExperienceSlot{
+		ExperienceSlot: types.ExperienceSlot{
+			ID:           slot.ID,
+			ExperienceID: slot.ExperienceID,
+			Status:       NewExperienceSlotStatus(slot.Status).Response(),
+			Capacity:     slot.Capacity,
+			Remaining:    slot.Remaining(),
+			StartAt:      slot.StartAt.Unix(),
+			EndAt:        slot.EndAt.Unix(),
+		},
+	}
+}
+
+func (s *ExperienceSlot) Response() *types.ExperienceSlot {
+	return &s.ExperienceSlot
+}
+
+func NewExperienceSlots(slots entity.ExperienceSlots) ExperienceSlots {
+	res := make(ExperienceSlots, len(slots))
+	for i := range slots {
+		res[i] = NewExperienceSlot(slots[i])
+	}
+	return res
+}
+
+func (ss ExperienceSlots) Response() []*types.ExperienceSlot {
+	res := make([]*types.ExperienceSlot, len(ss))
+	for i := range ss {
+		res[i] = ss[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+)
+
+// NoshiType - のし種別
+type NoshiType types.NoshiType
+
+func NewNoshiType(typ entity.NoshiType) NoshiType {
+	switch typ {
+	case entity.NoshiTypeOchugen:
+		return NoshiType(types.NoshiTypeOchugen)
+	case entity.NoshiTypeOseibo:
+		return NoshiType(types.NoshiTypeOseibo)
+	case entity.NoshiTypeOiwai:
+		return NoshiType(types.NoshiTypeOiwai)
+	case entity.NoshiTypeUchiiwai:
+		return NoshiType(types.NoshiTypeUchiiwai)
+	case entity.NoshiTypeOrei:
+		return NoshiType(types.NoshiTypeOrei)
+	case entity.NoshiTypeSoshina:
+		return NoshiType(types.NoshiTypeSoshina)
+	default:
+		return NoshiType(types.NoshiTypeNone)
+	}
+}
+
+func (t NoshiType) StoreEntity() entity.NoshiType {
+	switch types.NoshiType(t) {
+	case types.NoshiTypeOchugen:
+		return entity.NoshiTypeOchugen
+	case types.NoshiTypeOseibo:
+		return entity.NoshiTypeOseibo
+	case types.NoshiTypeOiwai:
+		return entity.NoshiTypeOiwai
+	case types.NoshiTypeUchiiwai:
+		return entity.NoshiTypeUchiiwai
+	case types.NoshiTypeOrei:
+		return entity.NoshiTypeOrei
+	case types.NoshiTypeSoshina:
+		return entity.NoshiTypeSoshina
+	default:
+		return entity.NoshiTypeNone
+	}
+}
+
+func (t NoshiType) Response() types.NoshiType {
+	return types.NoshiType(t)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoshiType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    entity.NoshiType
+		expect NoshiType
+	}{
+		{name: "ochugen", typ: entity.NoshiTypeOchugen, expect: NoshiType(types.NoshiTypeOchugen)},
+		{name: "oseibo", typ: entity.NoshiTypeOseibo, expect: NoshiType(types.NoshiTypeOseibo)},
+		{name: "oiwai", typ: entity.NoshiTypeOiwai, expect: NoshiType(types.NoshiTypeOiwai)},
+		{name: "uchiiwai", typ: entity.NoshiTypeUchiiwai, expect: NoshiType(types.NoshiTypeUchiiwai)},
+		{name: "orei", typ: entity.NoshiTypeOrei, expect: NoshiType(types.NoshiTypeOrei)},
+		{name: "soshina", typ: entity.NoshiTypeSoshina, expect: NoshiType(types.NoshiTypeSoshina)},
+		{name: "none", typ: entity.NoshiTypeNone, expect: NoshiType(types.NoshiTypeNone)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewNoshiType(tt.typ)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.typ, actual.StoreEntity())
+		})
+	}
+}
@@ -15,6 +15,7 @@ type LiveComments []*LiveComment
 func NewLiveComment(comment *mentity.BroadcastComment, user *uentity.User) *LiveComment {
 	res := &LiveComment{
 		LiveComment: types.LiveComment{
+			ID:          comment.ID,
 			Comment:     comment.Content,
 			PublishedAt: comment.CreatedAt.Unix(),
 		},
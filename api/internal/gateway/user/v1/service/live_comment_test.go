@@ -80,6 +80,7 @@ func TestLiveComments(t *testing.T) {
 			expect: LiveComments{
 				{
 					LiveComment: types.LiveComment{
+						ID:           "comment-id",
 						UserID:       "user-id",
 						Username:     "username",
 						AccountID:    "account-id",
@@ -90,6 +91,7 @@ func TestLiveComments(t *testing.T) {
 				},
 				{
 					LiveComment: types.LiveComment{
+						ID:           "unknown-id",
 						UserID:       "",
 						Username:     "",
 						AccountID:    "",
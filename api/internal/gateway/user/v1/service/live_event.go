@@ -0,0 +1,70 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	mentity "github.com/and-period/furumaru/api/internal/media/entity"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+)
+
+// LiveEventType - ライブ配信イベント種別
+type LiveEventType types.LiveEventType
+
+func NewLiveEventType(typ mentity.BroadcastEventType) LiveEventType {
+	switch typ {
+	case mentity.BroadcastEventTypeCommentCreated:
+		return LiveEventType(types.LiveEventTypeComment)
+	case mentity.BroadcastEventTypeCommentDisabled:
+		return LiveEventType(types.LiveEventTypeCommentDisabled)
+	case mentity.BroadcastEventTypeViewerCount:
+		return LiveEventType(types.LiveEventTypeViewerCount)
+	case mentity.BroadcastEventTypeProductPinned:
+		return LiveEventType(types.LiveEventTypeProductPinned)
+	case mentity.BroadcastEventTypeProductUnpinned:
+		return LiveEventType(types.LiveEventTypeProductUnpinned)
+	default:
+		return LiveEventType(types.LiveEventTypeUnknown)
+	}
+}
+
+func (t LiveEventType) Response() types.LiveEventType {
+	return types.LiveEventType(t)
+}
+
+type LiveEvent struct {
+	types.LiveEvent
+}
+
+func NewLiveEvent(event *mentity.BroadcastEvent, user *uentity.User) *LiveEvent {
+	res := &LiveEvent{
+		LiveEvent: types.LiveEvent{
+			ID:        event.ID,
+			Type:      NewLiveEventType(event.Type).Response(),
+			CreatedAt: event.CreatedAt.Unix(),
+		},
+	}
+	switch {
+	case event.Type == mentity.BroadcastEventTypeCommentCreated && event.Comment != nil:
+		comment := &mentity.BroadcastComment{
+			ID:        event.Comment.CommentID,
+			UserID:    event.Comment.UserID,
+			Content:   event.Comment.Content,
+			CreatedAt: event.CreatedAt,
+		}
+		res.Comment = NewLiveComment(comment, user).Response()
+	case event.Type == mentity.BroadcastEventTypeCommentDisabled && event.Comment != nil:
+		res.CommentID = event.Comment.CommentID
+	case event.Viewer != nil:
+		res.ViewerCount = event.Viewer.Total
+	case event.Pin != nil:
+		res.Pin = &types.LiveEventPin{
+			ID:        event.Pin.LivePinID,
+			ProductID: event.Pin.ProductID,
+			Offset:    event.Pin.Offset,
+		}
+	}
+	return res
+}
+
+func (e *LiveEvent) Response() *types.LiveEvent {
+	return &e.LiveEvent
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	mentity "github.com/and-period/furumaru/api/internal/media/entity"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveEventType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    mentity.BroadcastEventType
+		expect types.LiveEventType
+	}{
+		{
+			name:   "comment",
+			typ:    mentity.BroadcastEventTypeCommentCreated,
+			expect: types.LiveEventTypeComment,
+		},
+		{
+			name:   "comment disabled",
+			typ:    mentity.BroadcastEventTypeCommentDisabled,
+			expect: types.LiveEventTypeCommentDisabled,
+		},
+		{
+			name:   "viewer count",
+			typ:    mentity.BroadcastEventTypeViewerCount,
+			expect: types.LiveEventTypeViewerCount,
+		},
+		{
+			name:   "product pinned",
+			typ:    mentity.BroadcastEventTypeProductPinned,
+			expect: types.LiveEventTypeProductPinned,
+		},
+		{
+			name:   "product unpinned",
+			typ:    mentity.BroadcastEventTypeProductUnpinned,
+			expect: types.LiveEventTypeProductUnpinned,
+		},
+		{
+			name:   "unknown",
+			typ:    mentity.BroadcastEventTypeUnknown,
+			expect: types.LiveEventTypeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewLiveEventType(tt.typ).Response())
+		})
+	}
+}
+
+func TestLiveEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	user := &uentity.User{
+		ID:         "user-id",
+		Status:     uentity.UserStatusVerified,
+		Registered: true,
+		Member: uentity.Member{
+			UserID:       "user-id",
+			AccountID:    "account-id",
+			Username:     "username",
+			ThumbnailURL: "http://example.com/thumbnail.png",
+		},
+	}
+	tests := []struct {
+		name   string
+		event  *mentity.BroadcastEvent
+		user   *uentity.User
+		expect *types.LiveEvent
+	}{
+		{
+			name: "comment",
+			event: &mentity.BroadcastEvent{
+				ID:         "event-id",
+				ScheduleID: "schedule-id",
+				Type:       mentity.BroadcastEventTypeCommentCreated,
+				Comment: &mentity.BroadcastEventComment{
+					CommentID: "comment-id",
+					UserID:    "user-id",
+					Content:   "こんにちは",
+				},
+				CreatedAt: now,
+			},
+			user: user,
+			expect: &types.LiveEvent{
+				ID:   "event-id",
+				Type: types.LiveEventTypeComment,
+				Comment: &types.LiveComment{
+					ID:           "comment-id",
+					UserID:       "user-id",
+					Username:     "username",
+					AccountID:    "account-id",
+					ThumbnailURL: "http://example.com/thumbnail.png",
+					Comment:      "こんにちは",
+					PublishedAt:  now.Unix(),
+				},
+				CreatedAt: now.Unix(),
+			},
+		},
+		{
+			name: "guest comment",
+			event: &mentity.BroadcastEvent{
+				ID:         "event-id",
+				ScheduleID: "schedule-id",
+				Type:       mentity.BroadcastEventTypeCommentCreated,
+				Comment: &mentity.BroadcastEventComment{
+					CommentID: "comment-id",
+					Content:   "こんにちは",
+				},
+				CreatedAt: now,
+			},
+			user: nil,
+			expect: &types.LiveEvent{
+				ID:   "event-id",
+				Type: types.LiveEventTypeComment,
+				Comment: &types.LiveComment{
+					ID:          "comment-id",
+					Comment:     "こんにちは",
+					PublishedAt: now.Unix(),
+				},
+				CreatedAt: now.Unix(),
+			},
+		},
+		{
+			name: "comment disabled",
+			event: &mentity.BroadcastEvent{
+				ID:         "event-id",
+				ScheduleID: "schedule-id",
+				Type:       mentity.BroadcastEventTypeCommentDisabled,
+				Comment: &mentity.BroadcastEventComment{
+					CommentID: "comment-id",
+				},
+				CreatedAt: now,
+			},
+			expect: &types.LiveEvent{
+				ID:        "event-id",
+				Type:      types.LiveEventTypeCommentDisabled,
+				CommentID: "comment-id",
+				CreatedAt: now.Unix(),
+			},
+		},
+		{
+			name: "viewer count",
+			event: &mentity.BroadcastEvent{
+				ID:         "event-id",
+				ScheduleID: "schedule-id",
+				Type:       mentity.BroadcastEventTypeViewerCount,
+				Viewer:     &mentity.BroadcastEventViewer{Total: 120},
+				CreatedAt:  now,
+			},
+			expect: &types.LiveEvent{
+				ID:          "event-id",
+				Type:        types.LiveEventTypeViewerCount,
+				ViewerCount: 120,
+				CreatedAt:   now.Unix(),
+			},
+		},
+		{
+			name: "product pinned",
+			event: &mentity.BroadcastEvent{
+				ID:         "event-id",
+				ScheduleID: "schedule-id",
+				Type:       mentity.BroadcastEventTypeProductPinned,
+				Pin: &mentity.BroadcastEventPin{
+					LivePinID: "pin-id",
+					ProductID: "product-id",
+					Offset:    60,
+				},
+				CreatedAt: now,
+			},
+			expect: &types.LiveEvent{
+				ID:   "event-id",
+				Type: types.LiveEventTypeProductPinned,
+				Pin: &types.LiveEventPin{
+					ID:        "pin-id",
+					ProductID: "product-id",
+					Offset:    60,
+				},
+				CreatedAt: now.Unix(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewLiveEvent(tt.event, tt.user)
+			assert.Equal(t, tt.expect, actual.Response())
+		})
+	}
+}
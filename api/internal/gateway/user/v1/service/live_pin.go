@@ -0,0 +1,48 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+type LivePin struct {
+	types.LivePin
+}
+
+type LivePins []*LivePin
+
+func NewLivePin(pin *entity.LivePin) *LivePin {
+	return &LivePin{
+		LivePin: types.LivePin{
+			ID:          pin.ID,
+			ProductID:   pin.ProductID,
+			Pinned:      pin.Pinned(),
+			StartOffset: pin.PinnedOffset,
+			EndOffset:   pin.UnpinnedOffset,
+			PinnedAt:    jst.Unix(pin.PinnedAt),
+			UnpinnedAt:  jst.Unix(pin.UnpinnedAt),
+			UpdatedAt:   jst.Unix(pin.UpdatedAt),
+		},
+	}
+}
+
+func (p *LivePin) Response() *types.LivePin {
+	return &p.LivePin
+}
+
+func NewLivePins(pins entity.LivePins) LivePins {
+	res := make(LivePins, len(pins))
+	for i := range pins {
+		res[i] = NewLivePin(pins[i])
+	}
+	return res
+}
+
+func (ps LivePins) Response() []*types.LivePin {
+	res := make([]*types.LivePin, len(ps))
+	for i := range ps {
+		res[i] = ps[i].Response()
+	}
+	return res
+}
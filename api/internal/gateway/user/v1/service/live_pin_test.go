@@ -0,0 +1,121 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLivePin(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		pin    *entity.LivePin
+		expect *LivePin
+	}{
+		{
+			name: "pinned",
+			pin: &entity.LivePin{
+				ID:           "pin-id",
+				ScheduleID:   "schedule-id",
+				ProductID:    "product-id",
+				AdminID:      "admin-id",
+				PinnedAt:     now,
+				PinnedOffset: 60,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			},
+			expect: &LivePin{
+				LivePin: types.LivePin{
+					ID:          "pin-id",
+					ProductID:   "product-id",
+					Pinned:      true,
+					StartOffset: 60,
+					EndOffset:   0,
+					PinnedAt:    1640962800,
+					UnpinnedAt:  0,
+					UpdatedAt:   1640962800,
+				},
+			},
+		},
+		{
+			name: "unpinned",
+			pin: &entity.LivePin{
+				ID:             "pin-id",
+				ScheduleID:     "schedule-id",
+				ProductID:      "product-id",
+				AdminID:        "admin-id",
+				PinnedAt:       now,
+				PinnedOffset:   60,
+				UnpinnedAt:     now.Add(90 * time.Second),
+				UnpinnedOffset: 150,
+				CreatedAt:      now,
+				UpdatedAt:      now.Add(90 * time.Second),
+			},
+			expect: &LivePin{
+				LivePin: types.LivePin{
+					ID:          "pin-id",
+					ProductID:   "product-id",
+					Pinned:      false,
+					StartOffset: 60,
+					EndOffset:   150,
+					PinnedAt:    1640962800,
+					UnpinnedAt:  1640962890,
+					UpdatedAt:   1640962890,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewLivePin(tt.pin)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestLivePins(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 1, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name   string
+		pins   entity.LivePins
+		expect []*types.LivePin
+	}{
+		{
+			name: "success",
+			pins: entity.LivePins{
+				{
+					ID:           "pin-id",
+					ProductID:    "product-id",
+					PinnedAt:     now,
+					PinnedOffset: 60,
+					UpdatedAt:    now,
+				},
+			},
+			expect: []*types.LivePin{
+				{
+					ID:          "pin-id",
+					ProductID:   "product-id",
+					Pinned:      true,
+					StartOffset: 60,
+					PinnedAt:    1640962800,
+					UpdatedAt:   1640962800,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewLivePins(tt.pins).Response()
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
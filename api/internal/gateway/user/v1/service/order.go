@@ -69,6 +69,8 @@ func NewOrderStatus(status entity.OrderStatus) OrderStatus {
 		return OrderStatus(types.OrderStatusRefunded)
 	case entity.OrderStatusFailed:
 		return OrderStatus(types.OrderStatusFailed)
+	case entity.OrderStatusPartiallyRefunded:
+		return OrderStatus(types.OrderStatusPartiallyRefunded)
 	default:
 		return OrderStatus(types.OrderStatusUnknown)
 	}
@@ -90,7 +92,7 @@ func NewOrder(order *entity.Order, addresses map[int64]*Address, products map[in
 		experience = exp
 	}
 	if len(order.OrderFulfillments) > 0 {
-		// 現状すべての配送先が同一になっているため
+		// 複数配送先の場合は先頭の配送先を代表とし、配送先ごとの住所は配送情報に含める
 		if address, ok := addresses[order.OrderFulfillments[0].AddressRevisionID]; ok {
 			shippingAddress = address
 		}
@@ -104,7 +106,7 @@ func NewOrder(order *entity.Order, addresses map[int64]*Address, products map[in
 			Status:          NewOrderStatus(order.Status).Response(),
 			Payment:         NewOrderPayment(&order.OrderPayment).Response(),
 			Refund:          NewOrderRefund(&order.OrderPayment).Response(),
-			Fulfillments:    NewOrderFulfillments(order.OrderFulfillments).Response(),
+			Fulfillments:    NewOrderFulfillments(order.OrderFulfillments, addresses).Response(),
 			Items:           NewOrderItems(order.OrderItems, products).Response(),
 			Experience:      NewOrderExperience(&order.OrderExperience, experience).Response(),
 			BillingAddress:  billingAddress.Response(),
@@ -30,6 +30,8 @@ func NewFulfillmentStatus(status entity.FulfillmentStatus) FulfillmentStatus {
 		return FulfillmentStatus(types.FulfillmentStatusUnfulfilled)
 	case entity.FulfillmentStatusFulfilled:
 		return FulfillmentStatus(types.FulfillmentStatusFulfilled)
+	case entity.FulfillmentStatusDelivered:
+		return FulfillmentStatus(types.FulfillmentStatusDelivered)
 	default:
 		return FulfillmentStatus(types.FulfillmentStatusUnknown)
 	}
@@ -45,6 +47,8 @@ func NewShippingCarrier(carrier entity.ShippingCarrier) ShippingCarrier {
 		return ShippingCarrier(types.ShippingCarrierYamato)
 	case entity.ShippingCarrierSagawa:
 		return ShippingCarrier(types.ShippingCarrierSagawa)
+	case entity.ShippingCarrierJapanPost:
+		return ShippingCarrier(types.ShippingCarrierJapanPost)
 	default:
 		return ShippingCarrier(types.ShippingCarrierUnknown)
 	}
@@ -62,6 +66,12 @@ func NewShippingSize(size entity.ShippingSize) ShippingSize {
 		return ShippingSize(types.ShippingSize80)
 	case entity.ShippingSize100:
 		return ShippingSize(types.ShippingSize100)
+	case entity.ShippingSize120:
+		return ShippingSize(types.ShippingSize120)
+	case entity.ShippingSize140:
+		return ShippingSize(types.ShippingSize140)
+	case entity.ShippingSize160:
+		return ShippingSize(types.ShippingSize160)
 	default:
 		return ShippingSize(types.ShippingSizeUnknown)
 	}
@@ -88,18 +98,26 @@ func (t ShippingType) Response() types.ShippingType {
 	return types.ShippingType(t)
 }
 
-func NewOrderFulfillment(fulfillment *entity.OrderFulfillment) *OrderFulfillment {
+func NewOrderFulfillment(fulfillment *entity.OrderFulfillment, address *Address) *OrderFulfillment {
+	var deliveryDate string
+	if !fulfillment.DeliveryDate.IsZero() {
+		deliveryDate = jst.FormatYYYYMMDD(fulfillment.DeliveryDate)
+	}
 	return &OrderFulfillment{
 		OrderFulfillment: types.OrderFulfillment{
-			FulfillmentID:   fulfillment.ID,
-			TrackingNumber:  fulfillment.TrackingNumber,
-			Status:          NewFulfillmentStatus(fulfillment.Status).Response(),
-			ShippingCarrier: NewShippingCarrier(fulfillment.ShippingCarrier).Response(),
-			ShippingType:    NewShippingType(fulfillment.ShippingType).Response(),
-			BoxNumber:       fulfillment.BoxNumber,
-			BoxSize:         NewShippingSize(fulfillment.BoxSize).Response(),
-			BoxRate:         fulfillment.BoxRate,
-			ShippedAt:       jst.Unix(fulfillment.ShippedAt),
+			FulfillmentID:      fulfillment.ID,
+			TrackingNumber:     fulfillment.TrackingNumber,
+			Status:             NewFulfillmentStatus(fulfillment.Status).Response(),
+			ShippingCarrier:    NewShippingCarrier(fulfillment.ShippingCarrier).Response(),
+			ShippingType:       NewShippingType(fulfillment.ShippingType).Response(),
+			BoxNumber:          fulfillment.BoxNumber,
+			BoxSize:            NewShippingSize(fulfillment.BoxSize).Response(),
+			BoxRate:            fulfillment.BoxRate,
+			ShippedAt:          jst.Unix(fulfillment.ShippedAt),
+			DeliveredAt:        jst.Unix(fulfillment.DeliveredAt),
+			DeliveryDate:       deliveryDate,
+			DeliveryTimeWindow: NewDeliveryTimeWindow(fulfillment.DeliveryTimeWindow).Response(),
+			Address:            address.Response(),
 		},
 	}
 }
@@ -108,10 +126,10 @@ func (f *OrderFulfillment) Response() *types.OrderFulfillment {
 	return &f.OrderFulfillment
 }
 
-func NewOrderFulfillments(fulfillments entity.OrderFulfillments) OrderFulfillments {
+func NewOrderFulfillments(fulfillments entity.OrderFulfillments, addresses map[int64]*Address) OrderFulfillments {
 	res := make(OrderFulfillments, len(fulfillments))
-	for i := range fulfillments {
-		res[i] = NewOrderFulfillment(fulfillments[i])
+	for i, f := range fulfillments {
+		res[i] = NewOrderFulfillment(f, addresses[f.AddressRevisionID])
 	}
 	return res
 }
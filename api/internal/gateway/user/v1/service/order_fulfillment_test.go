@@ -26,6 +26,11 @@ func TestFulfillmentStatus(t *testing.T) {
 			status: entity.FulfillmentStatusFulfilled,
 			expect: FulfillmentStatus(types.FulfillmentStatusFulfilled),
 		},
+		{
+			name:   "delivered",
+			status: entity.FulfillmentStatusDelivered,
+			expect: FulfillmentStatus(types.FulfillmentStatusDelivered),
+		},
 		{
 			name:   "unknown",
 			status: entity.FulfillmentStatusUnknown,
@@ -78,6 +83,11 @@ func TestShippingCarrier(t *testing.T) {
 			status: entity.ShippingCarrier(types.ShippingCarrierSagawa),
 			expect: ShippingCarrier(types.ShippingCarrierSagawa),
 		},
+		{
+			name:   "japan post",
+			status: entity.ShippingCarrierJapanPost,
+			expect: ShippingCarrier(types.ShippingCarrierJapanPost),
+		},
 		{
 			name:   "unknown",
 			status: entity.ShippingCarrier(types.ShippingCarrierUnknown),
@@ -135,6 +145,21 @@ func TestShippingSize(t *testing.T) {
 			status: entity.ShippingSize100,
 			expect: ShippingSize(types.ShippingSize100),
 		},
+		{
+			name:   "size 120",
+			status: entity.ShippingSize120,
+			expect: ShippingSize(types.ShippingSize120),
+		},
+		{
+			name:   "size 140",
+			status: entity.ShippingSize140,
+			expect: ShippingSize(types.ShippingSize140),
+		},
+		{
+			name:   "size 160",
+			status: entity.ShippingSize160,
+			expect: ShippingSize(types.ShippingSize160),
+		},
 		{
 			name:   "unknown",
 			status: entity.ShippingSizeUnknown,
@@ -271,6 +296,16 @@ func TestOrderFulfillment(t *testing.T) {
 					BoxSize:         ShippingSize(types.ShippingSize60).Response(),
 					BoxRate:         80,
 					ShippedAt:       1640962800,
+					Address: &types.Address{
+						Lastname:       "&.",
+						Firstname:      "購入者",
+						PostalCode:     "1000014",
+						PrefectureCode: 13,
+						City:           "千代田区",
+						AddressLine1:   "永田町1-7-1",
+						AddressLine2:   "",
+						PhoneNumber:    "090-1234-1234",
+					},
 				},
 			},
 		},
@@ -278,7 +313,7 @@ func TestOrderFulfillment(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			assert.Equal(t, tt.expect, NewOrderFulfillment(tt.fulfillment))
+			assert.Equal(t, tt.expect, NewOrderFulfillment(tt.fulfillment, tt.address))
 		})
 	}
 }
@@ -380,6 +415,16 @@ func TestOrderFulfillments(t *testing.T) {
 						BoxSize:         ShippingSize(types.ShippingSize60).Response(),
 						BoxRate:         80,
 						ShippedAt:       1640962800,
+						Address: &types.Address{
+							Lastname:       "&.",
+							Firstname:      "購入者",
+							PostalCode:     "1000014",
+							PrefectureCode: 13,
+							City:           "千代田区",
+							AddressLine1:   "永田町1-7-1",
+							AddressLine2:   "",
+							PhoneNumber:    "090-1234-1234",
+						},
 					},
 				},
 			},
@@ -388,7 +433,7 @@ func TestOrderFulfillments(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			assert.Equal(t, tt.expect, NewOrderFulfillments(tt.fulfillments))
+			assert.Equal(t, tt.expect, NewOrderFulfillments(tt.fulfillments, tt.addresses))
 		})
 	}
 }
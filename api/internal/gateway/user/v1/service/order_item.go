@@ -27,6 +27,7 @@ func NewOrderItem(item *entity.OrderItem, product *Product) *OrderItem {
 			ProductID:     productID,
 			Price:         price,
 			Quantity:      item.Quantity,
+			TaxRate:       item.TaxRate,
 		},
 		orderID: item.OrderID,
 	}
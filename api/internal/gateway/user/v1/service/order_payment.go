@@ -88,7 +88,7 @@ func NewPaymentStatus(status entity.PaymentStatus) PaymentStatus {
 	switch status {
 	case entity.PaymentStatusPending:
 		return PaymentStatus(types.PaymentStatusUnpaid)
-	case entity.PaymentStatusAuthorized, entity.PaymentStatusCaptured:
+	case entity.PaymentStatusAuthorized, entity.PaymentStatusCaptured, entity.PaymentStatusPartiallyRefunded:
 		return PaymentStatus(types.PaymentStatusPaid)
 	case entity.PaymentStatusCanceled, entity.PaymentStatusRefunded:
 		return PaymentStatus(types.PaymentStatusCanceled)
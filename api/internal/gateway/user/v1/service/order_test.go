@@ -177,6 +177,12 @@ func TestNewOrderStatus(t *testing.T) {
 			expect:   OrderStatus(types.OrderStatusFailed),
 			response: 6,
 		},
+		{
+			name:     "partially refunded",
+			status:   entity.OrderStatusPartiallyRefunded,
+			expect:   OrderStatus(types.OrderStatusPartiallyRefunded),
+			response: 7,
+		},
 		{
 			name:     "unknown",
 			status:   entity.OrderStatusUnknown,
@@ -398,6 +404,16 @@ func TestOrder(t *testing.T) {
 							BoxNumber:       1,
 							BoxSize:         ShippingSize(types.ShippingSize60).Response(),
 							ShippedAt:       1640962800,
+							Address: &types.Address{
+								Lastname:       "&.",
+								Firstname:      "購入者",
+								PostalCode:     "1000014",
+								PrefectureCode: 13,
+								City:           "千代田区",
+								AddressLine1:   "永田町1-7-1",
+								AddressLine2:   "",
+								PhoneNumber:    "090-1234-1234",
+							},
 						},
 					},
 					Refund: &types.OrderRefund{
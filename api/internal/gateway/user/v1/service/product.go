@@ -132,36 +132,38 @@ func NewProduct(product *entity.Product, category *Category, rate *ProductRate)
 	media := NewMultiProductMedia(product.Media)
 	return &Product{
 		Product: types.Product{
-			ID:                product.ID,
-			CoordinatorID:     product.CoordinatorID,
-			ProducerID:        product.ProducerID,
-			CategoryID:        categoryID,
-			ProductTypeID:     product.TypeID,
-			ProductTagIDs:     product.TagIDs,
-			Name:              product.Name,
-			Description:       product.Description,
-			Status:            NewProductStatus(product.Status).Response(),
-			Inventory:         product.Inventory,
-			Weight:            NewProductWeight(product.Weight, product.WeightUnit),
-			ItemUnit:          product.ItemUnit,
-			ItemDescription:   product.ItemDescription,
-			ThumbnailURL:      product.ThumbnailURL,
-			Media:             media.Response(),
-			Price:             product.Price,
-			ExpirationDate:    product.ExpirationDate,
-			RecommendedPoint1: point1,
-			RecommendedPoint2: point2,
-			RecommendedPoint3: point3,
-			StorageMethodType: NewStorageMethodType(product.StorageMethodType).Response(),
-			DeliveryType:      NewDeliveryType(product.DeliveryType).Response(),
-			Box60Rate:         product.Box60Rate,
-			Box80Rate:         product.Box80Rate,
-			Box100Rate:        product.Box100Rate,
-			OriginPrefecture:  product.OriginPrefecture,
-			OriginCity:        product.OriginCity,
-			Rate:              rate.Response(),
-			StartAt:           product.StartAt.Unix(),
-			EndAt:             product.EndAt.Unix(),
+			ID:                      product.ID,
+			CoordinatorID:           product.CoordinatorID,
+			ProducerID:              product.ProducerID,
+			CategoryID:              categoryID,
+			ProductTypeID:           product.TypeID,
+			ProductTagIDs:           product.TagIDs,
+			Name:                    product.Name,
+			Description:             product.Description,
+			Status:                  NewProductStatus(product.Status).Response(),
+			Inventory:               product.Inventory,
+			Weight:                  NewProductWeight(product.Weight, product.WeightUnit),
+			ItemUnit:                product.ItemUnit,
+			ItemDescription:         product.ItemDescription,
+			ThumbnailURL:            product.ThumbnailURL,
+			Media:                   media.Response(),
+			Price:                   product.Price,
+			ExpirationDate:          product.ExpirationDate,
+			RecommendedPoint1:       point1,
+			RecommendedPoint2:       point2,
+			RecommendedPoint3:       point3,
+			StorageMethodType:       NewStorageMethodType(product.StorageMethodType).Response(),
+			DeliveryType:            NewDeliveryType(product.DeliveryType).Response(),
+			Box60Rate:               product.Box60Rate,
+			Box80Rate:               product.Box80Rate,
+			Box100Rate:              product.Box100Rate,
+			OriginPrefecture:        product.OriginPrefecture,
+			OriginCity:              product.OriginCity,
+			Rate:                    rate.Response(),
+			StartAt:                 product.StartAt.Unix(),
+			EndAt:                   product.EndAt.Unix(),
+			ExpectedShippingStartAt: jst.Unix(product.ExpectedShippingStartAt),
+			ExpectedShippingEndAt:   jst.Unix(product.ExpectedShippingEndAt),
 		},
 		revisionID: product.ProductRevision.ID,
 		status:     NewProductStatus(product.Status),
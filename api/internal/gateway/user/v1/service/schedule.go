@@ -9,6 +9,7 @@ import (
 // ScheduleStatus - 開催状況
 type ScheduleStatus types.ScheduleStatus
 
+// 字幕トラックの表示名
 func NewScheduleStatus(status sentity.ScheduleStatus, archived bool) ScheduleStatus {
 	switch status {
 	case sentity.ScheduleStatusWaiting:
@@ -56,6 +57,7 @@ func NewSchedule(schedule *sentity.Schedule, broadcast *mentity.Broadcast) *Sche
 func newBroadcastDetail(broadcast *mentity.Broadcast) (string, bool, *types.ScheduleDistributionMetadata) {
 	metadata := &types.ScheduleDistributionMetadata{
 		Subtitles: map[string]string{},
+		Captions:  []*types.CaptionTrack{},
 	}
 	if broadcast == nil {
 		return "", false, metadata
@@ -64,6 +66,7 @@ func newBroadcastDetail(broadcast *mentity.Broadcast) (string, bool, *types.Sche
 	case broadcast.ArchiveURL != "":
 		if broadcast.ArchiveMetadata != nil {
 			metadata.Subtitles = broadcast.ArchiveMetadata.Subtitles
+			metadata.Captions = newCaptionTracks(broadcast.ArchiveMetadata.Subtitles)
 		}
 		return broadcast.ArchiveURL, true, metadata
 	case broadcast.Status == mentity.BroadcastStatusActive:
@@ -109,6 +109,7 @@ func TestSchedule(t *testing.T) {
 					DistributionURL: "",
 					DistributionMetadata: &types.ScheduleDistributionMetadata{
 						Subtitles: map[string]string{},
+						Captions:  []*types.CaptionTrack{},
 					},
 					StartAt: 1638284400,
 					EndAt:   1643641200,
@@ -155,6 +156,7 @@ func TestSchedule(t *testing.T) {
 					DistributionURL: "http://example.com/index.m3u8",
 					DistributionMetadata: &types.ScheduleDistributionMetadata{
 						Subtitles: map[string]string{},
+						Captions:  []*types.CaptionTrack{},
 					},
 					StartAt: 1638284400,
 					EndAt:   1643641200,
@@ -210,6 +212,10 @@ func TestSchedule(t *testing.T) {
 							"ja": "http://example.com/subtitles.jpn.vtt",
 							"en": "http://example.com/subtitles.eng.vtt",
 						},
+						Captions: []*types.CaptionTrack{
+							{Language: "ja", Label: "日本語", URL: "http://example.com/subtitles.jpn.vtt"},
+							{Language: "en", Label: "English", URL: "http://example.com/subtitles.eng.vtt"},
+						},
 					},
 					StartAt: 1638284400,
 					EndAt:   1643641200,
@@ -308,6 +314,7 @@ func TestSchedules(t *testing.T) {
 						DistributionURL: "",
 						DistributionMetadata: &types.ScheduleDistributionMetadata{
 							Subtitles: map[string]string{},
+							Captions:  []*types.CaptionTrack{},
 						},
 						StartAt: 1638284400,
 						EndAt:   1643641200,
@@ -19,6 +19,13 @@ func NewShipping(shipping *entity.Shipping) *Shipping {
 			Box80Frozen:       shipping.Box80Frozen,
 			Box100Rates:       NewShippingRates(shipping.Box100Rates).Response(),
 			Box100Frozen:      shipping.Box100Frozen,
+			Box120Rates:       NewShippingRates(shipping.Box120Rates).Response(),
+			Box120Frozen:      shipping.Box120Frozen,
+			Box140Rates:       NewShippingRates(shipping.Box140Rates).Response(),
+			Box140Frozen:      shipping.Box140Frozen,
+			Box160Rates:       NewShippingRates(shipping.Box160Rates).Response(),
+			Box160Frozen:      shipping.Box160Frozen,
+			RegionRules:       NewShippingRegionRules(shipping.RegionRules).Response(),
 			HasFreeShipping:   shipping.HasFreeShipping,
 			FreeShippingRates: shipping.FreeShippingRates,
 		},
@@ -66,3 +73,46 @@ func (rs ShippingRates) Response() []*types.ShippingRate {
 	}
 	return shippingRates
 }
+
+type ShippingRegionRule struct {
+	types.ShippingRegionRule
+}
+
+type ShippingRegionRules []*ShippingRegionRule
+
+func NewShippingRegionRule(rule *entity.ShippingRegionRule) *ShippingRegionRule {
+	excludedTypes := make([]types.ShippingType, 0, len(rule.ExcludedTypes))
+	for _, typ := range rule.ExcludedTypes {
+		excludedTypes = append(excludedTypes, NewShippingType(typ).Response())
+	}
+	return &ShippingRegionRule{
+		ShippingRegionRule: types.ShippingRegionRule{
+			Number:         rule.Number,
+			Name:           rule.Name,
+			PostalCodeFrom: rule.PostalCodeFrom,
+			PostalCodeTo:   rule.PostalCodeTo,
+			Surcharge:      rule.Surcharge,
+			ExcludedTypes:  excludedTypes,
+		},
+	}
+}
+
+func (r *ShippingRegionRule) Response() *types.ShippingRegionRule {
+	return &r.ShippingRegionRule
+}
+
+func NewShippingRegionRules(rules entity.ShippingRegionRules) ShippingRegionRules {
+	res := make(ShippingRegionRules, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, NewShippingRegionRule(rule))
+	}
+	return res
+}
+
+func (rs ShippingRegionRules) Response() []*types.ShippingRegionRule {
+	res := make([]*types.ShippingRegionRule, 0, len(rs))
+	for _, rule := range rs {
+		res = append(res, rule.Response())
+	}
+	return res
+}
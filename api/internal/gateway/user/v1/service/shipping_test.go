@@ -39,7 +39,22 @@ func TestShipping(t *testing.T) {
 					Box100Rates: entity.ShippingRates{
 						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
 					},
-					Box100Frozen:      800,
+					Box100Frozen: 800,
+					Box120Rates: entity.ShippingRates{
+						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
+					},
+					Box120Frozen: 800,
+					Box140Rates: entity.ShippingRates{
+						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
+					},
+					Box140Frozen: 800,
+					Box160Rates: entity.ShippingRates{
+						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
+					},
+					Box160Frozen: 800,
+					RegionRules: entity.ShippingRegionRules{
+						{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+					},
 					HasFreeShipping:   true,
 					FreeShippingRates: 3000,
 				},
@@ -58,7 +73,22 @@ func TestShipping(t *testing.T) {
 					Box100Rates: []*types.ShippingRate{
 						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
 					},
-					Box100Frozen:      800,
+					Box100Frozen: 800,
+					Box120Rates: []*types.ShippingRate{
+						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
+					},
+					Box120Frozen: 800,
+					Box140Rates: []*types.ShippingRate{
+						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
+					},
+					Box140Frozen: 800,
+					Box160Rates: []*types.ShippingRate{
+						{Number: 1, Name: "東京都", Price: 0, PrefectureCodes: []int32{13}},
+					},
+					Box160Frozen: 800,
+					RegionRules: []*types.ShippingRegionRule{
+						{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200, ExcludedTypes: []types.ShippingType{types.ShippingTypeFrozen}},
+					},
 					HasFreeShipping:   true,
 					FreeShippingRates: 3000,
 				},
@@ -100,6 +130,12 @@ func TestShipping_Response(t *testing.T) {
 					Box80Frozen:       800,
 					Box100Rates:       rates,
 					Box100Frozen:      800,
+					Box120Rates:       rates,
+					Box120Frozen:      800,
+					Box140Rates:       rates,
+					Box140Frozen:      800,
+					Box160Rates:       rates,
+					Box160Frozen:      800,
 					HasFreeShipping:   true,
 					FreeShippingRates: 3000,
 				},
@@ -112,6 +148,12 @@ func TestShipping_Response(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+// SubscriptionStatus - 定期便の契約状況
+type SubscriptionStatus types.SubscriptionStatus
+
+// SubscriptionInterval - 定期便のお届け間隔
+type SubscriptionInterval types.SubscriptionInterval
+
+type Subscription struct {
+	types.Subscription
+}
+
+type Subscriptions []*Subscription
+
+func NewSubscriptionStatus(status entity.SubscriptionStatus) SubscriptionStatus {
+	switch status {
+	case entity.SubscriptionStatusActive:
+		return SubscriptionStatus(types.SubscriptionStatusActive)
+	case entity.SubscriptionStatusPaused:
+		return SubscriptionStatus(types.SubscriptionStatusPaused)
+	case entity.SubscriptionStatusCanceled:
+		return SubscriptionStatus(types.SubscriptionStatusCanceled)
+	case entity.SubscriptionStatusSuspended:
+		return SubscriptionStatus(types.SubscriptionStatusSuspended)
+	default:
+		return SubscriptionStatus(types.SubscriptionStatusUnknown)
+	}
+}
+
+func (s SubscriptionStatus) Response() types.SubscriptionStatus {
+	return types.SubscriptionStatus(s)
+}
+
+func NewSubscriptionInterval(interval entity.SubscriptionInterval) SubscriptionInterval {
+	switch interval {
+	case entity.SubscriptionIntervalWeekly:
+		return SubscriptionInterval(types.SubscriptionIntervalWeekly)
+	case entity.SubscriptionIntervalBiweekly:
+		return SubscriptionInterval(types.SubscriptionIntervalBiweekly)
+	case entity.SubscriptionIntervalMonthly:
+		return SubscriptionInterval(types.SubscriptionIntervalMonthly)
+	default:
+		return SubscriptionInterval(types.SubscriptionIntervalUnknown)
+	}
+}
+
+func (i SubscriptionInterval) StoreEntity() entity.SubscriptionInterval {
+	switch types.SubscriptionInterval(i) {
+	case types.SubscriptionIntervalWeekly:
+		return entity.SubscriptionIntervalWeekly
+	case types.SubscriptionIntervalBiweekly:
+		return entity.SubscriptionIntervalBiweekly
+	case types.SubscriptionIntervalMonthly:
+		return entity.SubscriptionIntervalMonthly
+	default:
+		return entity.SubscriptionIntervalUnknown
+	}
+}
+
+func (i SubscriptionInterval) Response() types.SubscriptionInterval {
+	return types.SubscriptionInterval(i)
+}
+
+func NewSubscription(subscription *entity.Subscription) *Subscription {
+	items := make([]*types.SubscriptionItem, len(subscription.Items))
+	for i, item := range subscription.Items {
+		items[i] = &types.SubscriptionItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	return &Subscription{
+		Subscription: types.Subscription{
+			ID:            subscription.ID,
+			CoordinatorID: subscription.CoordinatorID,
+			Status:        NewSubscriptionStatus(subscription.Status).Response(),
+			Interval:      NewSubscriptionInterval(subscription.Interval).Response(),
+			Items:         items,
+			NextRunAt:     jst.Unix(subscription.NextRunAt),
+			LastOrderID:   subscription.LastOrderID,
+			FailureCount:  subscription.FailureCount,
+			PausedAt:      jst.Unix(subscription.PausedAt),
+			CanceledAt:    jst.Unix(subscription.CanceledAt),
+			CreatedAt:     jst.Unix(subscription.CreatedAt),
+			UpdatedAt:     jst.Unix(subscription.UpdatedAt),
+		},
+	}
+}
+
+func (s *Subscription) Response() *types.Subscription {
+	return &s.Subscription
+}
+
+func NewSubscriptions(subscriptions entity.Subscriptions) Subscriptions {
+	res := make(Subscriptions, len(subscriptions))
+	for i := range subscriptions {
+		res[i] = NewSubscription(subscriptions[i])
+	}
+	return res
+}
+
+func (ss Subscriptions) Response() []*types.Subscription {
+	res := make([]*types.Subscription, len(ss))
+	for i := range ss {
+		res[i] = ss[i].Response()
+	}
+	return res
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/gateway/user/v1/types"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status entity.SubscriptionStatus
+		expect types.SubscriptionStatus
+	}{
+		{name: "active", status: entity.SubscriptionStatusActive, expect: types.SubscriptionStatusActive},
+		{name: "paused", status: entity.SubscriptionStatusPaused, expect: types.SubscriptionStatusPaused},
+		{name: "canceled", status: entity.SubscriptionStatusCanceled, expect: types.SubscriptionStatusCanceled},
+		{name: "suspended", status: entity.SubscriptionStatusSuspended, expect: types.SubscriptionStatusSuspended},
+		{name: "unknown", status: entity.SubscriptionStatusUnknown, expect: types.SubscriptionStatusUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewSubscriptionStatus(tt.status).Response())
+		})
+	}
+}
+
+func TestSubscriptionInterval(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		interval entity.SubscriptionInterval
+		expect   SubscriptionInterval
+	}{
+		{name: "weekly", interval: entity.SubscriptionIntervalWeekly, expect: SubscriptionInterval(types.SubscriptionIntervalWeekly)},
+		{name: "biweekly", interval: entity.SubscriptionIntervalBiweekly, expect: SubscriptionInterval(types.SubscriptionIntervalBiweekly)},
+		{name: "monthly", interval: entity.SubscriptionIntervalMonthly, expect: SubscriptionInterval(types.SubscriptionIntervalMonthly)},
+		{name: "unknown", interval: entity.SubscriptionIntervalUnknown, expect: SubscriptionInterval(types.SubscriptionIntervalUnknown)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewSubscriptionInterval(tt.interval)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.interval, actual.StoreEntity())
+		})
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	subscriptions := entity.Subscriptions{
+		{
+			ID:            "subscription-id",
+			UserID:        "user-id",
+			CoordinatorID: "coordinator-id",
+			Status:        entity.SubscriptionStatusActive,
+			Interval:      entity.SubscriptionIntervalWeekly,
+			Items: entity.SubscriptionItems{
+				{ProductID: "product-id", Quantity: 2},
+			},
+			NextRunAt: now.AddDate(0, 0, 7),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	expect := []*types.Subscription{
+		{
+			ID:            "subscription-id",
+			CoordinatorID: "coordinator-id",
+			Status:        types.SubscriptionStatusActive,
+			Interval:      types.SubscriptionIntervalWeekly,
+			Items: []*types.SubscriptionItem{
+				{ProductID: "product-id", Quantity: 2},
+			},
+			NextRunAt:  now.AddDate(0, 0, 7).Unix(),
+			PausedAt:   0,
+			CanceledAt: 0,
+			CreatedAt:  now.Unix(),
+			UpdatedAt:  now.Unix(),
+		},
+	}
+	assert.Equal(t, expect, NewSubscriptions(subscriptions).Response())
+}
@@ -22,6 +22,7 @@ func NewVideo(v *entity.Video) *Video {
 			Description:   v.Description,
 			ThumbnailURL:  v.ThumbnailURL,
 			VideoURL:      v.VideoURL,
+			Captions:      newCaptionTracks(v.Captions),
 			PublishedAt:   v.PublishedAt.Unix(),
 		},
 	}
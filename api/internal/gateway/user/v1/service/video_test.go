@@ -23,15 +23,19 @@ func TestVideos(t *testing.T) {
 			name: "success",
 			videos: entity.Videos{
 				{
-					ID:                "video-id",
-					CoordinatorID:     "coordinator-id",
-					ProductIDs:        []string{"product-id"},
-					ExperienceIDs:     []string{"experience-id"},
-					Title:             "じゃがいもの育て方",
-					Description:       "じゃがいもの育て方の動画です。",
-					Status:            entity.VideoStatusPublished,
-					ThumbnailURL:      "https://example.com/thumbnail.jpg",
-					VideoURL:          "https://example.com/video.mp4",
+					ID:            "video-id",
+					CoordinatorID: "coordinator-id",
+					ProductIDs:    []string{"product-id"},
+					ExperienceIDs: []string{"experience-id"},
+					Title:         "じゃがいもの育て方",
+					Description:   "じゃがいもの育て方の動画です。",
+					Status:        entity.VideoStatusPublished,
+					ThumbnailURL:  "https://example.com/thumbnail.jpg",
+					VideoURL:      "https://example.com/video.mp4",
+					Captions: map[string]string{
+						entity.BroadcastCaptionLanguageEnglish:  "https://example.com/caption-en.vtt",
+						entity.BroadcastCaptionLanguageJapanese: "https://example.com/caption-ja.vtt",
+					},
 					Public:            true,
 					Limited:           false,
 					DisplayProduct:    true,
@@ -66,7 +70,11 @@ func TestVideos(t *testing.T) {
 						Description:   "じゃがいもの育て方の動画です。",
 						ThumbnailURL:  "https://example.com/thumbnail.jpg",
 						VideoURL:      "https://example.com/video.mp4",
-						PublishedAt:   now.AddDate(0, 0, -1).Unix(),
+						Captions: []*types.CaptionTrack{
+							{Language: "ja", Label: "日本語", URL: "https://example.com/caption-ja.vtt"},
+							{Language: "en", Label: "English", URL: "https://example.com/caption-en.vtt"},
+						},
+						PublishedAt: now.AddDate(0, 0, -1).Unix(),
 					},
 				},
 			},
@@ -0,0 +1,8 @@
+package types
+
+// CaptionTrack - 字幕トラック
+type CaptionTrack struct {
+	Language string `json:"language"` // 言語コード
+	Label    string `json:"label"`    // 表示名
+	URL      string `json:"url"`      // 字幕ファイル(WebVTT)URL
+}
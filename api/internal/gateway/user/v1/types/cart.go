@@ -21,6 +21,14 @@ type AddCartItemRequest struct {
 	Quantity  int64  `json:"quantity" validate:"min=1"`     // 数量
 }
 
+type CalcCartRequest struct {
+	BoxNumber      int64                  `json:"boxNumber" validate:"min=0"`                    // 箱の通番
+	PrefectureCode int32                  `json:"prefectureCode" validate:"min=0,max=47"`        // 都道府県コード
+	PostalCode     string                 `json:"postalCode" validate:"omitempty,max=16"`        // 郵便番号
+	PromotionCode  string                 `json:"promotionCode" validate:"omitempty,len=8"`      // プロモーションコード
+	Destinations   []*CheckoutDestination `json:"destinations" validate:"omitempty,max=10,dive"` // 複数配送先
+}
+
 type CartResponse struct {
 	Carts        []*Cart        `json:"carts"`        // カート一覧
 	Coordinators []*Coordinator `json:"coordinators"` // コーディネータ一覧
@@ -1,23 +1,41 @@
 package types
 
 type CheckoutProductRequest struct {
-	RequestID         string              `json:"requestId" validate:"required"`            // 支払いキー(重複判定用)
-	CoordinatorID     string              `json:"coordinatorId" validate:"required"`        // コーディネータID
-	BoxNumber         int64               `json:"boxNumber" validate:"min=0"`               // 箱の通番（箱単位で購入する場合）
-	BillingAddressID  string              `json:"billingAddressId" validate:"required"`     // 請求先住所ID
-	ShippingAddressID string              `json:"shippingAddressId" validate:"required"`    // 配送先住所ID
-	PromotionCode     string              `json:"promotionCode" validate:"omitempty,len=8"` // プロモーションコード
-	PaymentMethod     PaymentMethodType   `json:"paymentMethod" validate:"required"`        // 支払い方法
-	CreditCard        *CheckoutCreditCard `json:"creditCard" validate:"omitempty,dive"`     // クレジットカード決済情報
-	CallbackURL       string              `json:"callbackUrl" validate:"required,http_url"` // 決済完了後のリダイレクト先URL
-	Total             int64               `json:"total" validate:"min=0"`                   // 支払い合計金額（誤り検出用）
-	OrderRequest      string              `json:"orderRequest" validate:"omitempty,max=256"` // 要望・質問など自由入力
+	RequestID          string                 `json:"requestId" validate:"required"`                              // 支払いキー(重複判定用)
+	CoordinatorID      string                 `json:"coordinatorId" validate:"required"`                          // コーディネータID
+	BoxNumber          int64                  `json:"boxNumber" validate:"min=0"`                                 // 箱の通番（箱単位で購入する場合）
+	BillingAddressID   string                 `json:"billingAddressId" validate:"required"`                       // 請求先住所ID
+	ShippingAddressID  string                 `json:"shippingAddressId" validate:"required_without=Destinations"` // 配送先住所ID
+	PromotionCode      string                 `json:"promotionCode" validate:"omitempty,len=8"`                   // プロモーションコード
+	PaymentMethod      PaymentMethodType      `json:"paymentMethod" validate:"required"`                          // 支払い方法
+	CreditCard         *CheckoutCreditCard    `json:"creditCard" validate:"omitempty,dive"`                       // クレジットカード決済情報
+	CallbackURL        string                 `json:"callbackUrl" validate:"required,http_url"`                   // 決済完了後のリダイレクト先URL
+	Total              int64                  `json:"total" validate:"min=0"`                                     // 支払い合計金額（誤り検出用）
+	OrderRequest       string                 `json:"orderRequest" validate:"omitempty,max=256"`                  // 要望・質問など自由入力
+	DeliveryDate       string                 `json:"deliveryDate" validate:"omitempty,date"`                     // お届け希望日(YYYYMMDD)
+	DeliveryTimeWindow DeliveryTimeWindow     `json:"deliveryTimeWindow" validate:"min=0,max=6"`                  // お届け希望時間帯
+	Gift               bool                   `json:"gift"`                                                       // ギフト注文
+	NoshiType          NoshiType              `json:"noshiType" validate:"min=0,max=6"`                           // のし種別
+	NoshiName          string                 `json:"noshiName" validate:"omitempty,max=32"`                      // のし名入れ
+	MessageCard        string                 `json:"messageCard" validate:"omitempty,max=200"`                   // メッセージカード本文
+	Destinations       []*CheckoutDestination `json:"destinations" validate:"omitempty,max=10,dive"`              // 複数配送先（指定時は配送先住所IDより優先）
+}
+
+type CheckoutDestination struct {
+	ShippingAddressID string                     `json:"shippingAddressId" validate:"required"` // 配送先住所ID
+	Items             []*CheckoutDestinationItem `json:"items" validate:"min=1,dive"`           // 配送先へ送る商品一覧
+}
+
+type CheckoutDestinationItem struct {
+	ProductID string `json:"productId" validate:"required"` // 商品ID
+	Quantity  int64  `json:"quantity" validate:"min=1"`     // 数量
 }
 
 type CheckoutExperienceRequest struct {
 	RequestID             string              `json:"requestId" validate:"required"`                 // 支払いキー(重複判定用)
 	BillingAddressID      string              `json:"billingAddressId" validate:"required"`          // 請求先住所ID
 	PromotionCode         string              `json:"promotionCode" validate:"omitempty,len=8"`      // プロモーションコード
+	SlotID                string              `json:"slotId" validate:""`                            // 体験枠ID
 	AdultCount            int64               `json:"adultCount" validate:"min=0,max=99"`            // 大人人数
 	JuniorHighSchoolCount int64               `json:"juniorHighSchoolCount" validate:"min=0,max=99"` // 中学生人数
 	ElementarySchoolCount int64               `json:"elementarySchoolCount" validate:"min=0,max=99"` // 小学生人数
@@ -61,24 +79,31 @@ type PreCheckoutExperienceResponse struct {
 }
 
 type GuestCheckoutProductRequest struct {
-	RequestID       string                `json:"requestId" validate:"required"`                                            // 支払いキー(重複判定用)
-	CoordinatorID   string                `json:"coordinatorId" validate:"required"`                                        // コーディネータID
-	BoxNumber       int64                 `json:"boxNumber" validate:"min=0"`                                               // 箱の通番（箱単位で購入する場合）
-	PromotionCode   string                `json:"promotionCode" validate:"omitempty,len=8"`                                 // プロモーションコード
-	PaymentMethod   PaymentMethodType     `json:"paymentMethod" validate:"required"`                                        // 支払い方法
-	CreditCard      *CheckoutCreditCard   `json:"creditCard" validate:"omitempty,dive"`                                     // クレジットカード決済情報
-	CallbackURL     string                `json:"callbackUrl" validate:"required,http_url"`                                 // 決済完了後のリダイレクト先URL
-	Total           int64                 `json:"total" validate:"min=0"`                                                   // 支払い合計金額（誤り検出用）
-	OrderRequest    string                `json:"orderRequest" validate:"omitempty,max=256"`                                // 要望・質問など自由入力
-	Email           string                `json:"email" validate:"required,email"`                                          // メールアドレス
-	IsSameAddress   bool                  `json:"isSameAddress"`                                                            // 配送先住所を請求先住所と同一にする
-	BillingAddress  *GuestCheckoutAddress `json:"billingAddress" validate:"required,dive"`                                  // 請求先住所
-	ShippingAddress *GuestCheckoutAddress `json:"shippingAddress" validate:"required_without=IsSameAddress,omitempty,dive"` // 配送先住所
+	RequestID          string                `json:"requestId" validate:"required"`                                            // 支払いキー(重複判定用)
+	CoordinatorID      string                `json:"coordinatorId" validate:"required"`                                        // コーディネータID
+	BoxNumber          int64                 `json:"boxNumber" validate:"min=0"`                                               // 箱の通番（箱単位で購入する場合）
+	PromotionCode      string                `json:"promotionCode" validate:"omitempty,len=8"`                                 // プロモーションコード
+	PaymentMethod      PaymentMethodType     `json:"paymentMethod" validate:"required"`                                        // 支払い方法
+	CreditCard         *CheckoutCreditCard   `json:"creditCard" validate:"omitempty,dive"`                                     // クレジットカード決済情報
+	CallbackURL        string                `json:"callbackUrl" validate:"required,http_url"`                                 // 決済完了後のリダイレクト先URL
+	Total              int64                 `json:"total" validate:"min=0"`                                                   // 支払い合計金額（誤り検出用）
+	OrderRequest       string                `json:"orderRequest" validate:"omitempty,max=256"`                                // 要望・質問など自由入力
+	Email              string                `json:"email" validate:"required,email"`                                          // メールアドレス
+	IsSameAddress      bool                  `json:"isSameAddress"`                                                            // 配送先住所を請求先住所と同一にする
+	BillingAddress     *GuestCheckoutAddress `json:"billingAddress" validate:"required,dive"`                                  // 請求先住所
+	ShippingAddress    *GuestCheckoutAddress `json:"shippingAddress" validate:"required_without=IsSameAddress,omitempty,dive"` // 配送先住所
+	DeliveryDate       string                `json:"deliveryDate" validate:"omitempty,date"`                                   // お届け希望日(YYYYMMDD)
+	DeliveryTimeWindow DeliveryTimeWindow    `json:"deliveryTimeWindow" validate:"min=0,max=6"`                                // お届け希望時間帯
+	Gift               bool                  `json:"gift"`                                                                     // ギフト注文
+	NoshiType          NoshiType             `json:"noshiType" validate:"min=0,max=6"`                                         // のし種別
+	NoshiName          string                `json:"noshiName" validate:"omitempty,max=32"`                                    // のし名入れ
+	MessageCard        string                `json:"messageCard" validate:"omitempty,max=200"`                                 // メッセージカード本文
 }
 
 type GuestCheckoutExperienceRequest struct {
 	RequestID             string                `json:"requestId" validate:"required"`                 // 支払いキー(重複判定用)
 	PromotionCode         string                `json:"promotionCode" validate:"omitempty,len=8"`      // プロモーションコード
+	SlotID                string                `json:"slotId" validate:""`                            // 体験枠ID
 	AdultCount            int64                 `json:"adultCount" validate:"min=0,max=99"`            // 大人人数
 	JuniorHighSchoolCount int64                 `json:"juniorHighSchoolCount" validate:"min=0,max=99"` // 中学生人数
 	ElementarySchoolCount int64                 `json:"elementarySchoolCount" validate:"min=0,max=99"` // 小学生人数
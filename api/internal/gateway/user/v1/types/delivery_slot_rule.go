@@ -0,0 +1,27 @@
+package types
+
+// DeliveryTimeWindow - お届け希望時間帯
+type DeliveryTimeWindow int32
+
+const (
+	DeliveryTimeWindowUnspecified DeliveryTimeWindow = 0 // 指定なし
+	DeliveryTimeWindowMorning     DeliveryTimeWindow = 1 // 午前中
+	DeliveryTimeWindow1214        DeliveryTimeWindow = 2 // 12時〜14時
+	DeliveryTimeWindow1416        DeliveryTimeWindow = 3 // 14時〜16時
+	DeliveryTimeWindow1618        DeliveryTimeWindow = 4 // 16時〜18時
+	DeliveryTimeWindow1820        DeliveryTimeWindow = 5 // 18時〜20時
+	DeliveryTimeWindow1921        DeliveryTimeWindow = 6 // 19時〜21時
+)
+
+// DeliverySlotRule - 配送日時指定ルール
+type DeliverySlotRule struct {
+	ShippingType  ShippingType         `json:"shippingType"`  // 配送方法
+	EarliestDate  string               `json:"earliestDate"`  // 指定可能な最短お届け日(YYYYMMDD)
+	LatestDate    string               `json:"latestDate"`    // 指定可能な最長お届け日(YYYYMMDD,空文字の場合は上限なし)
+	BlackoutDates []string             `json:"blackoutDates"` // お届け不可日一覧(YYYYMMDD)
+	TimeWindows   []DeliveryTimeWindow `json:"timeWindows"`   // 指定可能な時間帯一覧
+}
+
+type DeliverySlotRulesResponse struct {
+	DeliverySlotRules []*DeliverySlotRule `json:"deliverySlotRules"` // 配送日時指定ルール一覧
+}
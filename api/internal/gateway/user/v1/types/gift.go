@@ -0,0 +1,14 @@
+package types
+
+// NoshiType - のし種別
+type NoshiType int32
+
+const (
+	NoshiTypeNone     NoshiType = 0 // のしなし
+	NoshiTypeOchugen  NoshiType = 1 // 御中元
+	NoshiTypeOseibo   NoshiType = 2 // 御歳暮
+	NoshiTypeOiwai    NoshiType = 3 // 御祝
+	NoshiTypeUchiiwai NoshiType = 4 // 内祝
+	NoshiTypeOrei     NoshiType = 5 // 御礼
+	NoshiTypeSoshina  NoshiType = 6 // 粗品
+)
@@ -2,6 +2,7 @@ package types
 
 // LiveComment - ライブ配信のコメント
 type LiveComment struct {
+	ID           string `json:"id"`           // コメントID
 	UserID       string `json:"userId"`       // ユーザーID
 	Username     string `json:"username"`     // ユーザー名
 	AccountID    string `json:"accountId"`    // アカウントID
@@ -0,0 +1,31 @@
+package types
+
+// LiveEventType - ライブ配信イベント種別
+type LiveEventType int32
+
+const (
+	LiveEventTypeUnknown         LiveEventType = 0
+	LiveEventTypeComment         LiveEventType = 1 // コメント投稿
+	LiveEventTypeCommentDisabled LiveEventType = 2 // コメント非表示
+	LiveEventTypeViewerCount     LiveEventType = 3 // 視聴者数更新
+	LiveEventTypeProductPinned   LiveEventType = 4 // 商品ピン留め
+	LiveEventTypeProductUnpinned LiveEventType = 5 // 商品ピン留め解除
+)
+
+// LiveEvent - ライブ配信イベント
+type LiveEvent struct {
+	ID          string        `json:"id"`                    // イベントID(再接続時のカーソル)
+	Type        LiveEventType `json:"type"`                  // イベント種別
+	Comment     *LiveComment  `json:"comment,omitempty"`     // 投稿されたコメント
+	CommentID   string        `json:"commentId,omitempty"`   // 非表示になったコメントID
+	ViewerCount int64         `json:"viewerCount,omitempty"` // 視聴者数
+	Pin         *LiveEventPin `json:"pin,omitempty"`         // 商品ピン留め情報
+	CreatedAt   int64         `json:"createdAt"`             // 発生日時
+}
+
+// LiveEventPin - 商品ピン留めイベント情報
+type LiveEventPin struct {
+	ID        string `json:"id"`        // ピン留めID
+	ProductID string `json:"productId"` // 商品ID
+	Offset    int64  `json:"offset"`    // 配信開始からの経過秒数
+}
@@ -0,0 +1,18 @@
+package types
+
+// LivePin - ライブ配信中の商品ピン留め情報
+type LivePin struct {
+	ID          string `json:"id"`          // ピン留めID
+	ProductID   string `json:"productId"`   // 商品ID
+	Pinned      bool   `json:"pinned"`      // ピン留め中か
+	StartOffset int64  `json:"startOffset"` // ピン留め位置(配信開始からの経過秒数)
+	EndOffset   int64  `json:"endOffset"`   // ピン留め解除位置(配信開始からの経過秒数、ピン留め中は0)
+	PinnedAt    int64  `json:"pinnedAt"`    // ピン留め日時
+	UnpinnedAt  int64  `json:"unpinnedAt"`  // ピン留め解除日時
+	UpdatedAt   int64  `json:"updatedAt"`   // 更新日時
+}
+
+type LivePinsResponse struct {
+	Pins     []*LivePin `json:"pins"`     // ピン留め一覧
+	Products []*Product `json:"products"` // 商品一覧
+}
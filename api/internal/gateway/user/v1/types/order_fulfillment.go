@@ -7,15 +7,17 @@ const (
 	FulfillmentStatusUnknown     FulfillmentStatus = 0
 	FulfillmentStatusUnfulfilled FulfillmentStatus = 1 // 未発送
 	FulfillmentStatusFulfilled   FulfillmentStatus = 2 // 発送済み
+	FulfillmentStatusDelivered   FulfillmentStatus = 3 // 配達完了
 )
 
 // ShippingCarrier - 配送会社
 type ShippingCarrier int32
 
 const (
-	ShippingCarrierUnknown ShippingCarrier = 0
-	ShippingCarrierYamato  ShippingCarrier = 1 // ヤマト運輸
-	ShippingCarrierSagawa  ShippingCarrier = 2 // 佐川急便
+	ShippingCarrierUnknown   ShippingCarrier = 0
+	ShippingCarrierYamato    ShippingCarrier = 1 // ヤマト運輸
+	ShippingCarrierSagawa    ShippingCarrier = 2 // 佐川急便
+	ShippingCarrierJapanPost ShippingCarrier = 3 // 日本郵便
 )
 
 // ShippingSize - 配送時の箱の大きさ
@@ -26,6 +28,9 @@ const (
 	ShippingSize60      ShippingSize = 1 // 箱のサイズ:60
 	ShippingSize80      ShippingSize = 2 // 箱のサイズ:80
 	ShippingSize100     ShippingSize = 3 // 箱のサイズ:100
+	ShippingSize120     ShippingSize = 4 // 箱のサイズ:120
+	ShippingSize140     ShippingSize = 5 // 箱のサイズ:140
+	ShippingSize160     ShippingSize = 6 // 箱のサイズ:160
 )
 
 // ShippingType - 配送方法
@@ -40,13 +45,17 @@ const (
 
 // OrderFulfillment - 配送情報
 type OrderFulfillment struct {
-	FulfillmentID   string            `json:"fulfillmentId"`   // 配送情報ID
-	TrackingNumber  string            `json:"trackingNumber"`  // 伝票番号
-	Status          FulfillmentStatus `json:"status"`          // 配送状況
-	ShippingCarrier ShippingCarrier   `json:"shippingCarrier"` // 配送会社
-	ShippingType    ShippingType      `json:"shippingType"`    // 配送方法
-	BoxNumber       int64             `json:"boxNumber"`       // 箱の通番
-	BoxSize         ShippingSize      `json:"boxSize"`         // 箱の大きさ
-	BoxRate         int64             `json:"boxRate"`         // 箱の占有率
-	ShippedAt       int64             `json:"shippedAt"`       // 配送日時
+	FulfillmentID      string             `json:"fulfillmentId"`      // 配送情報ID
+	TrackingNumber     string             `json:"trackingNumber"`     // 伝票番号
+	Status             FulfillmentStatus  `json:"status"`             // 配送状況
+	ShippingCarrier    ShippingCarrier    `json:"shippingCarrier"`    // 配送会社
+	ShippingType       ShippingType       `json:"shippingType"`       // 配送方法
+	BoxNumber          int64              `json:"boxNumber"`          // 箱の通番
+	BoxSize            ShippingSize       `json:"boxSize"`            // 箱の大きさ
+	BoxRate            int64              `json:"boxRate"`            // 箱の占有率
+	ShippedAt          int64              `json:"shippedAt"`          // 配送日時
+	DeliveredAt        int64              `json:"deliveredAt"`        // 配達完了日時
+	DeliveryDate       string             `json:"deliveryDate"`       // お届け希望日(YYYYMMDD)
+	DeliveryTimeWindow DeliveryTimeWindow `json:"deliveryTimeWindow"` // お届け希望時間帯
+	*Address                              // 配送先情報
 }
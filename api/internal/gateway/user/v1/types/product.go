@@ -35,36 +35,38 @@ const (
 
 // Product - 商品情報
 type Product struct {
-	ID                string            `json:"id"`                // 商品ID
-	CoordinatorID     string            `json:"coordinatorId"`     // コーディネータID
-	ProducerID        string            `json:"producerId"`        // 生産者ID
-	CategoryID        string            `json:"categoryId"`        // 商品種別ID
-	ProductTypeID     string            `json:"productTypeId"`     // 品目ID
-	ProductTagIDs     []string          `json:"productTagIds"`     // 商品タグID一覧
-	Name              string            `json:"name"`              // 商品名
-	Description       string            `json:"description"`       // 商品説明
-	Status            ProductStatus     `json:"status"`            // 販売状況
-	Inventory         int64             `json:"inventory"`         // 在庫数
-	Weight            float64           `json:"weight"`            // 重量(kg,少数第一位まで)
-	ItemUnit          string            `json:"itemUnit"`          // 数量単位
-	ItemDescription   string            `json:"itemDescription"`   // 数量単位説明
-	ThumbnailURL      string            `json:"thumbnailUrl"`      // サムネイルURL
-	Media             []*ProductMedia   `json:"media"`             // メディア一覧
-	Price             int64             `json:"price"`             // 販売価格(税込)
-	ExpirationDate    int64             `json:"expirationDate"`    // 賞味期限(単位:日)
-	RecommendedPoint1 string            `json:"recommendedPoint1"` // おすすめポイント1
-	RecommendedPoint2 string            `json:"recommendedPoint2"` // おすすめポイント2
-	RecommendedPoint3 string            `json:"recommendedPoint3"` // おすすめポイント3
-	StorageMethodType StorageMethodType `json:"storageMethodType"` // 保存方法
-	DeliveryType      DeliveryType      `json:"deliveryType"`      // 配送方法
-	Box60Rate         int64             `json:"box60Rate"`         // 箱の占有率(サイズ:60)
-	Box80Rate         int64             `json:"box80Rate"`         // 箱の占有率(サイズ:80)
-	Box100Rate        int64             `json:"box100Rate"`        // 箱の占有率(サイズ:100)
-	OriginPrefecture  string            `json:"originPrefecture"`  // 原産地(都道府県)
-	OriginCity        string            `json:"originCity"`        // 原産地(市区町村)
-	Rate              *ProductRate      `json:"rate"`              // 商品評価
-	StartAt           int64             `json:"startAt"`           // 販売開始日時
-	EndAt             int64             `json:"endAt"`             // 販売終了日時
+	ID                      string            `json:"id"`                      // 商品ID
+	CoordinatorID           string            `json:"coordinatorId"`           // コーディネータID
+	ProducerID              string            `json:"producerId"`              // 生産者ID
+	CategoryID              string            `json:"categoryId"`              // 商品種別ID
+	ProductTypeID           string            `json:"productTypeId"`           // 品目ID
+	ProductTagIDs           []string          `json:"productTagIds"`           // 商品タグID一覧
+	Name                    string            `json:"name"`                    // 商品名
+	Description             string            `json:"description"`             // 商品説明
+	Status                  ProductStatus     `json:"status"`                  // 販売状況
+	Inventory               int64             `json:"inventory"`               // 在庫数
+	Weight                  float64           `json:"weight"`                  // 重量(kg,少数第一位まで)
+	ItemUnit                string            `json:"itemUnit"`                // 数量単位
+	ItemDescription         string            `json:"itemDescription"`         // 数量単位説明
+	ThumbnailURL            string            `json:"thumbnailUrl"`            // サムネイルURL
+	Media                   []*ProductMedia   `json:"media"`                   // メディア一覧
+	Price                   int64             `json:"price"`                   // 販売価格(税込)
+	ExpirationDate          int64             `json:"expirationDate"`          // 賞味期限(単位:日)
+	RecommendedPoint1       string            `json:"recommendedPoint1"`       // おすすめポイント1
+	RecommendedPoint2       string            `json:"recommendedPoint2"`       // おすすめポイント2
+	RecommendedPoint3       string            `json:"recommendedPoint3"`       // おすすめポイント3
+	StorageMethodType       StorageMethodType `json:"storageMethodType"`       // 保存方法
+	DeliveryType            DeliveryType      `json:"deliveryType"`            // 配送方法
+	Box60Rate               int64             `json:"box60Rate"`               // 箱の占有率(サイズ:60)
+	Box80Rate               int64             `json:"box80Rate"`               // 箱の占有率(サイズ:80)
+	Box100Rate              int64             `json:"box100Rate"`              // 箱の占有率(サイズ:100)
+	OriginPrefecture        string            `json:"originPrefecture"`        // 原産地(都道府県)
+	OriginCity              string            `json:"originCity"`              // 原産地(市区町村)
+	Rate                    *ProductRate      `json:"rate"`                    // 商品評価
+	StartAt                 int64             `json:"startAt"`                 // 販売開始日時
+	EndAt                   int64             `json:"endAt"`                   // 販売終了日時
+	ExpectedShippingStartAt int64             `json:"expectedShippingStartAt"` // 出荷予定期間(開始)
+	ExpectedShippingEndAt   int64             `json:"expectedShippingEndAt"`   // 出荷予定期間(終了)
 }
 
 // ProductMedia - 商品メディア情報
@@ -27,6 +27,7 @@ type Schedule struct {
 
 type ScheduleDistributionMetadata struct {
 	Subtitles map[string]string `json:"subtitles"` // 字幕情報
+	Captions  []*CaptionTrack   `json:"captions"`  // 字幕トラック一覧
 }
 
 type ScheduleResponse struct {
@@ -35,6 +36,7 @@ type ScheduleResponse struct {
 	Lives       []*Live      `json:"lives"`       // ライブ配信一覧
 	Producers   []*Producer  `json:"producers"`   // 生産者一覧
 	Products    []*Product   `json:"products"`    // 商品一覧
+	Pins        []*LivePin   `json:"pins"`        // 商品ピン留め一覧(アーカイブ再生時のチャプター)
 }
 
 type LiveSchedulesResponse struct {
@@ -2,15 +2,22 @@ package types
 
 // Shipping - 配送設定情報
 type Shipping struct {
-	ID                string          `json:"id"`                // 配送設定ID
-	Box60Rates        []*ShippingRate `json:"box60Rates"`        // 箱サイズ60の通常（常温・冷蔵便）配送料一覧
-	Box60Frozen       int64           `json:"box60Frozen"`       // 箱サイズ60の追加（冷凍便）追加配送料(税込)
-	Box80Rates        []*ShippingRate `json:"box80Rates"`        // 箱サイズ80の通常（常温・冷蔵便）配送料一覧
-	Box80Frozen       int64           `json:"box80Frozen"`       // 箱サイズ80の追加（冷凍便）追加配送料(税込)
-	Box100Rates       []*ShippingRate `json:"box100Rates"`       // 箱サイズ100の通常（常温・冷蔵便）配送料一覧
-	Box100Frozen      int64           `json:"box100Frozen"`      // 箱サイズ100の追加（冷凍便）追加配送料(税込)
-	HasFreeShipping   bool            `json:"hasFreeShipping"`   // 送料無料オプションの有無
-	FreeShippingRates int64           `json:"freeShippingRates"` // 送料無料になる金額(税込)
+	ID                string                `json:"id"`                // 配送設定ID
+	Box60Rates        []*ShippingRate       `json:"box60Rates"`        // 箱サイズ60の通常（常温・冷蔵便）配送料一覧
+	Box60Frozen       int64                 `json:"box60Frozen"`       // 箱サイズ60の追加（冷凍便）追加配送料(税込)
+	Box80Rates        []*ShippingRate       `json:"box80Rates"`        // 箱サイズ80の通常（常温・冷蔵便）配送料一覧
+	Box80Frozen       int64                 `json:"box80Frozen"`       // 箱サイズ80の追加（冷凍便）追加配送料(税込)
+	Box100Rates       []*ShippingRate       `json:"box100Rates"`       // 箱サイズ100の通常（常温・冷蔵便）配送料一覧
+	Box100Frozen      int64                 `json:"box100Frozen"`      // 箱サイズ100の追加（冷凍便）追加配送料(税込)
+	Box120Rates       []*ShippingRate       `json:"box120Rates"`       // 箱サイズ120の通常（常温・冷蔵便）配送料一覧
+	Box120Frozen      int64                 `json:"box120Frozen"`      // 箱サイズ120の追加（冷凍便）追加配送料(税込)
+	Box140Rates       []*ShippingRate       `json:"box140Rates"`       // 箱サイズ140の通常（常温・冷蔵便）配送料一覧
+	Box140Frozen      int64                 `json:"box140Frozen"`      // 箱サイズ140の追加（冷凍便）追加配送料(税込)
+	Box160Rates       []*ShippingRate       `json:"box160Rates"`       // 箱サイズ160の通常（常温・冷蔵便）配送料一覧
+	Box160Frozen      int64                 `json:"box160Frozen"`      // 箱サイズ160の追加（冷凍便）追加配送料(税込)
+	RegionRules       []*ShippingRegionRule `json:"regionRules"`       // 地域別配送設定一覧
+	HasFreeShipping   bool                  `json:"hasFreeShipping"`   // 送料無料オプションの有無
+	FreeShippingRates int64                 `json:"freeShippingRates"` // 送料無料になる金額(税込)
 }
 
 type ShippingRate struct {
@@ -20,3 +27,12 @@ type ShippingRate struct {
 	Prefectures     []string `json:"prefectures"`     // 対象都道府県名
 	PrefectureCodes []int32  `json:"prefectureCodes"` // 対象都道府県一覧
 }
+
+type ShippingRegionRule struct {
+	Number         int64          `json:"number"`         // No.
+	Name           string         `json:"name"`           // 地域名
+	PostalCodeFrom string         `json:"postalCodeFrom"` // 対象郵便番号(開始)
+	PostalCodeTo   string         `json:"postalCodeTo"`   // 対象郵便番号(終了)
+	Surcharge      int64          `json:"surcharge"`      // 追加配送料(税込)
+	ExcludedTypes  []ShippingType `json:"excludedTypes"`  // 配送不可の配送種別一覧
+}
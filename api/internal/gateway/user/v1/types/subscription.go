@@ -0,0 +1,68 @@
+package types
+
+// SubscriptionStatus - 定期便の契約状況
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatusUnknown   SubscriptionStatus = 0
+	SubscriptionStatusActive    SubscriptionStatus = 1 // 契約中
+	SubscriptionStatusPaused    SubscriptionStatus = 2 // 一時停止中
+	SubscriptionStatusCanceled  SubscriptionStatus = 3 // 解約済み
+	SubscriptionStatusSuspended SubscriptionStatus = 4 // 停止中(更新失敗)
+)
+
+// SubscriptionInterval - 定期便のお届け間隔
+type SubscriptionInterval int32
+
+const (
+	SubscriptionIntervalUnknown  SubscriptionInterval = 0
+	SubscriptionIntervalWeekly   SubscriptionInterval = 1 // 毎週
+	SubscriptionIntervalBiweekly SubscriptionInterval = 2 // 隔週
+	SubscriptionIntervalMonthly  SubscriptionInterval = 3 // 毎月
+)
+
+// Subscription - 定期便
+type Subscription struct {
+	ID            string               `json:"id"`            // 定期便ID
+	CoordinatorID string               `json:"coordinatorId"` // コーディネータID
+	Status        SubscriptionStatus   `json:"status"`        // 契約状況
+	Interval      SubscriptionInterval `json:"interval"`      // お届け間隔
+	Items         []*SubscriptionItem  `json:"items"`         // 定期便の商品一覧
+	NextRunAt     int64                `json:"nextRunAt"`     // 次回注文日時
+	LastOrderID   string               `json:"lastOrderId"`   // 最終注文履歴ID
+	FailureCount  int64                `json:"failureCount"`  // 連続した更新失敗回数
+	PausedAt      int64                `json:"pausedAt"`      // 一時停止日時
+	CanceledAt    int64                `json:"canceledAt"`    // 解約日時
+	CreatedAt     int64                `json:"createdAt"`     // 申込日時
+	UpdatedAt     int64                `json:"updatedAt"`     // 更新日時
+}
+
+// SubscriptionItem - 定期便の商品
+type SubscriptionItem struct {
+	ProductID string `json:"productId"` // 商品ID
+	Quantity  int64  `json:"quantity"`  // 数量
+}
+
+type CreateSubscriptionRequest struct {
+	Interval        SubscriptionInterval             `json:"interval" validate:"required"`                // お届け間隔
+	Items           []*CreateSubscriptionItemRequest `json:"items" validate:"min=1,max=20,dive,required"` // 定期便の商品一覧
+	AddressID       string                           `json:"addressId" validate:"required"`               // 配送先・請求先住所ID
+	PaymentMethodID string                           `json:"paymentMethodId" validate:"required"`         // 決済手段ID(Stripe)
+	StartAt         int64                            `json:"startAt" validate:"required"`                 // 初回注文日時
+}
+
+type CreateSubscriptionItemRequest struct {
+	ProductID string `json:"productId" validate:"required"` // 商品ID
+	Quantity  int64  `json:"quantity" validate:"min=1"`     // 数量
+}
+
+type SubscriptionResponse struct {
+	Subscription *Subscription `json:"subscription"` // 定期便
+	Products     []*Product    `json:"products"`     // 商品一覧
+}
+
+type SubscriptionsResponse struct {
+	Subscriptions []*Subscription `json:"subscriptions"` // 定期便一覧
+	Products      []*Product      `json:"products"`      // 商品一覧
+	Total         int64           `json:"total"`         // 合計数
+}
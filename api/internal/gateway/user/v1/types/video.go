@@ -2,15 +2,16 @@ package types
 
 // Video - オンデマンド配信情報
 type Video struct {
-	ID            string   `json:"id"`            // オンデマンド動画ID
-	CoordinatorID string   `json:"coordinatorId"` // コーディネータID
-	ProductIDs    []string `json:"productIds"`    // 商品ID一覧
-	ExperienceIDs []string `json:"experienceIds"` // 体験ID一覧
-	Title         string   `json:"title"`         // タイトル
-	Description   string   `json:"description"`   // 説明
-	ThumbnailURL  string   `json:"thumbnailUrl"`  // サムネイルURL
-	VideoURL      string   `json:"videoUrl"`      // 動画URL
-	PublishedAt   int64    `json:"publishedAt"`   // 公開日時
+	ID            string          `json:"id"`            // オンデマンド動画ID
+	CoordinatorID string          `json:"coordinatorId"` // コーディネータID
+	ProductIDs    []string        `json:"productIds"`    // 商品ID一覧
+	ExperienceIDs []string        `json:"experienceIds"` // 体験ID一覧
+	Title         string          `json:"title"`         // タイトル
+	Description   string          `json:"description"`   // 説明
+	ThumbnailURL  string          `json:"thumbnailUrl"`  // サムネイルURL
+	VideoURL      string          `json:"videoUrl"`      // 動画URL
+	Captions      []*CaptionTrack `json:"captions"`      // 字幕トラック一覧
+	PublishedAt   int64           `json:"publishedAt"`   // 公開日時
 }
 
 // VideoSummary - オンデマンド配信の概要
@@ -0,0 +1,172 @@
+package caption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	transcribe "github.com/and-period/furumaru/api/pkg/aws/transcribe"
+	translate "github.com/and-period/furumaru/api/pkg/aws/translate"
+	"github.com/and-period/furumaru/api/pkg/storage"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstranscribe "github.com/aws/aws-sdk-go-v2/service/transcribe"
+	transcribetypes "github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+	awstranslate "github.com/aws/aws-sdk-go-v2/service/translate"
+)
+
+// 翻訳APIの1リクエストあたりの上限(10,000byte)に余裕を持たせる
+const maxTranslateBytes = 8000
+
+var transcribeLanguageCodes = map[string]transcribetypes.LanguageCode{
+	"ja": transcribetypes.LanguageCodeJaJp,
+	"en": transcribetypes.LanguageCodeEnUs,
+}
+
+type AWSParams struct {
+	Transcribe transcribe.Client
+	Translate  translate.Client
+	Storage    storage.Bucket
+}
+
+type awsRunner struct {
+	transcribe transcribe.Client
+	translate  translate.Client
+	storage    storage.Bucket
+}
+
+// NewAWSRunner - Amazon Transcribe/Amazon Translateを利用した字幕生成
+func NewAWSRunner(params *AWSParams) Runner {
+	return &awsRunner{
+		transcribe: params.Transcribe,
+		translate:  params.Translate,
+		storage:    params.Storage,
+	}
+}
+
+func (r *awsRunner) StartTranscription(ctx context.Context, job *TranscriptionJob) error {
+	languageCode, ok := transcribeLanguageCodes[job.Language]
+	if !ok {
+		return fmt.Errorf("caption: unsupported language. language=%s", job.Language)
+	}
+	in := &awstranscribe.StartTranscriptionJobInput{
+		TranscriptionJobName: aws.String(job.Name),
+		Media: &transcribetypes.Media{
+			MediaFileUri: aws.String(r.storage.GenerateS3URI(job.MediaKey)),
+		},
+		LanguageCode:     languageCode,
+		MediaFormat:      transcribetypes.MediaFormatMp4,
+		OutputBucketName: aws.String(r.storage.GetBucketName()),
+		OutputKey:        aws.String(job.OutputKey),
+		Subtitles: &transcribetypes.Subtitles{
+			Formats:          []transcribetypes.SubtitleFormat{transcribetypes.SubtitleFormatVtt},
+			OutputStartIndex: aws.Int32(0),
+		},
+	}
+	_, err := r.transcribe.StartTranscriptionJob(ctx, in)
+	var cerr *transcribetypes.ConflictException
+	if errors.As(err, &cerr) {
+		return nil // 同名のジョブが開始済み
+	}
+	return err
+}
+
+func (r *awsRunner) GetTranscription(ctx context.Context, job *TranscriptionJob) (*Transcription, error) {
+	in := &awstranscribe.GetTranscriptionJobInput{
+		TranscriptionJobName: aws.String(job.Name),
+	}
+	out, err := r.transcribe.GetTranscriptionJob(ctx, in)
+	var nerr *transcribetypes.NotFoundException
+	if errors.As(err, &nerr) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch out.TranscriptionJob.TranscriptionJobStatus {
+	case transcribetypes.TranscriptionJobStatusQueued, transcribetypes.TranscriptionJobStatusInProgress:
+		return &Transcription{Status: TranscriptionStatusInProgress}, nil
+	case transcribetypes.TranscriptionJobStatusFailed:
+		res := &Transcription{
+			Status:        TranscriptionStatusFailed,
+			FailureReason: aws.ToString(out.TranscriptionJob.FailureReason),
+		}
+		return res, nil
+	case transcribetypes.TranscriptionJobStatusCompleted:
+		content, err := r.storage.DownloadAndReadAll(ctx, job.OutputKey+".vtt")
+		if err != nil {
+			return nil, fmt.Errorf("caption: failed to download subtitle: %w", err)
+		}
+		return &Transcription{Status: TranscriptionStatusCompleted, Content: content}, nil
+	default:
+		return &Transcription{Status: TranscriptionStatusUnknown}, nil
+	}
+}
+
+func (r *awsRunner) Translate(ctx context.Context, texts []string, source, target string) ([]string, error) {
+	res := make([]string, 0, len(texts))
+	for _, chunk := range chunkTexts(texts, maxTranslateBytes) {
+		translated, err := r.translateChunk(ctx, chunk, source, target)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, translated...)
+	}
+	return res, nil
+}
+
+// translateChunk - 改行区切りでまとめて翻訳し、行数が一致しない場合は1件ずつ翻訳し直す
+func (r *awsRunner) translateChunk(ctx context.Context, texts []string, source, target string) ([]string, error) {
+	translated, err := r.translateText(ctx, strings.Join(texts, "\n"), source, target)
+	if err != nil {
+		return nil, err
+	}
+	if lines := strings.Split(translated, "\n"); len(lines) == len(texts) {
+		return lines, nil
+	}
+	res := make([]string, len(texts))
+	for i := range texts {
+		if res[i], err = r.translateText(ctx, texts[i], source, target); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (r *awsRunner) translateText(ctx context.Context, text, source, target string) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return text, nil
+	}
+	in := &awstranslate.TranslateTextInput{
+		SourceLanguageCode: aws.String(source),
+		TargetLanguageCode: aws.String(target),
+		Text:               aws.String(text),
+	}
+	out, err := r.translate.TranslateText(ctx, in)
+	if err != nil {
+		return "", fmt.Errorf("caption: failed to translate text: %w", err)
+	}
+	return aws.ToString(out.TranslatedText), nil
+}
+
+// chunkTexts - 改行区切りで連結した際に上限サイズを超えないように分割する（改行を含むテキストは空白に置換）
+func chunkTexts(texts []string, maxBytes int) [][]string {
+	var (
+		res   [][]string
+		chunk []string
+		size  int
+	)
+	for _, text := range texts {
+		text = strings.ReplaceAll(text, "\n", " ")
+		if len(chunk) > 0 && size+len(text)+1 > maxBytes {
+			res = append(res, chunk)
+			chunk, size = nil, 0
+		}
+		chunk = append(chunk, text)
+		size += len(text) + 1
+	}
+	if len(chunk) > 0 {
+		res = append(res, chunk)
+	}
+	return res
+}
@@ -0,0 +1,54 @@
+package caption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkTexts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		texts    []string
+		maxBytes int
+		expect   [][]string
+	}{
+		{
+			name:     "single chunk",
+			texts:    []string{"abc", "def"},
+			maxBytes: 10,
+			expect:   [][]string{{"abc", "def"}},
+		},
+		{
+			name:     "multiple chunks",
+			texts:    []string{"abcd", "efgh", "ijkl"},
+			maxBytes: 10,
+			expect:   [][]string{{"abcd", "efgh"}, {"ijkl"}},
+		},
+		{
+			name:     "replace new line",
+			texts:    []string{"ab\ncd"},
+			maxBytes: 10,
+			expect:   [][]string{{"ab cd"}},
+		},
+		{
+			name:     "over max bytes",
+			texts:    []string{"abcdefghijkl", "mn"},
+			maxBytes: 10,
+			expect:   [][]string{{"abcdefghijkl"}, {"mn"}},
+		},
+		{
+			name:     "empty",
+			texts:    []string{},
+			maxBytes: 10,
+			expect:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, chunkTexts(tt.texts, tt.maxBytes))
+		})
+	}
+}
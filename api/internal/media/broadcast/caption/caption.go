@@ -0,0 +1,61 @@
+//go:generate go tool mockgen -source=$GOFILE -package=mock_$GOPACKAGE -destination=./../../../../mock/media/broadcast/$GOPACKAGE/$GOFILE
+package caption
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNotFound = errors.New("caption: transcription job not found")
+
+// TranscriptionStatus - 文字起こしジョブの実行状況
+type TranscriptionStatus int32
+
+const (
+	TranscriptionStatusUnknown    TranscriptionStatus = 0
+	TranscriptionStatusInProgress TranscriptionStatus = 1 // 実行中
+	TranscriptionStatusCompleted  TranscriptionStatus = 2 // 完了
+	TranscriptionStatusFailed     TranscriptionStatus = 3 // 失敗
+)
+
+// Runner - 字幕生成ジョブの実行
+type Runner interface {
+	// 文字起こしジョブの開始（同名のジョブが開始済みの場合は何もしない）
+	StartTranscription(ctx context.Context, job *TranscriptionJob) error
+	// 文字起こしジョブの実行結果取得
+	GetTranscription(ctx context.Context, job *TranscriptionJob) (*Transcription, error)
+	// テキストの翻訳（入力と同じ順序・件数で返す）
+	Translate(ctx context.Context, texts []string, source, target string) ([]string, error)
+}
+
+// TranscriptionJob - 文字起こしジョブ
+type TranscriptionJob struct {
+	Name      string // ジョブ名
+	MediaKey  string // 入力動画のオブジェクトキー
+	OutputKey string // 字幕ファイルの出力先オブジェクトキー（拡張子なし）
+	Language  string // 音声の言語コード
+}
+
+// Transcription - 文字起こしジョブの実行結果
+type Transcription struct {
+	Status        TranscriptionStatus // 実行状況
+	Content       []byte              // 字幕ファイル(WebVTT) ※完了時のみ
+	FailureReason string              // 失敗理由
+}
+
+// TranslateWebVTT - 字幕ファイル(WebVTT)のタイミングを保持したまま、字幕テキストを翻訳する
+func TranslateWebVTT(ctx context.Context, runner Runner, content []byte, source, target string) ([]byte, error) {
+	vtt, err := ParseWebVTT(content)
+	if err != nil {
+		return nil, err
+	}
+	texts, err := runner.Translate(ctx, vtt.Texts(), source, target)
+	if err != nil {
+		return nil, err
+	}
+	translated, err := vtt.ReplaceTexts(texts)
+	if err != nil {
+		return nil, err
+	}
+	return translated.Bytes(), nil
+}
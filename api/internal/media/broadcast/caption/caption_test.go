@@ -0,0 +1,48 @@
+package caption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateWebVTT(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		content []byte
+		expect  []byte
+		hasErr  bool
+	}{
+		{
+			name:    "success",
+			content: []byte(localTranscription),
+			expect: []byte(`WEBVTT
+
+0
+00:00:00.000 --> 00:00:05.000
+[en] ふるマルのライブ配信へようこそ
+
+1
+00:00:05.000 --> 00:00:10.000
+[en] 本日の商品を紹介します
+
+`),
+			hasErr: false,
+		},
+		{
+			name:    "invalid webvtt",
+			content: []byte("invalid"),
+			expect:  nil,
+			hasErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := TranslateWebVTT(t.Context(), NewLocalRunner(), tt.content, "ja", "en")
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, string(tt.expect), string(actual))
+		})
+	}
+}
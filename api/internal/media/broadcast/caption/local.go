@@ -0,0 +1,58 @@
+package caption
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// 文字起こし結果としてローカル環境で返すWebVTT
+const localTranscription = `WEBVTT
+
+0
+00:00:00.000 --> 00:00:05.000
+ふるマルのライブ配信へようこそ
+
+1
+00:00:05.000 --> 00:00:10.000
+本日の商品を紹介します
+`
+
+type localRunner struct {
+	mu   sync.Mutex
+	jobs map[string]*TranscriptionJob
+}
+
+// NewLocalRunner - 外部サービスを利用しない字幕生成（ローカル環境・テスト用）
+func NewLocalRunner() Runner {
+	return &localRunner{
+		jobs: map[string]*TranscriptionJob{},
+	}
+}
+
+func (r *localRunner) StartTranscription(_ context.Context, job *TranscriptionJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.jobs[job.Name]; ok {
+		return nil
+	}
+	r.jobs[job.Name] = job
+	return nil
+}
+
+func (r *localRunner) GetTranscription(_ context.Context, job *TranscriptionJob) (*Transcription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.jobs[job.Name]; !ok {
+		return nil, ErrNotFound
+	}
+	return &Transcription{Status: TranscriptionStatusCompleted, Content: []byte(localTranscription)}, nil
+}
+
+func (r *localRunner) Translate(_ context.Context, texts []string, _, target string) ([]string, error) {
+	res := make([]string, len(texts))
+	for i := range texts {
+		res[i] = fmt.Sprintf("[%s] %s", target, texts[i])
+	}
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package caption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRunner(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	runner := NewLocalRunner()
+	job := &TranscriptionJob{
+		Name:      "job-name",
+		MediaKey:  "schedules/archives/schedule-id/mp4/original.mp4",
+		OutputKey: "schedules/archives/schedule-id/text/transcription-ja",
+		Language:  "ja",
+	}
+
+	_, err := runner.GetTranscription(ctx, job)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, runner.StartTranscription(ctx, job))
+	require.NoError(t, runner.StartTranscription(ctx, job))
+	actual, err := runner.GetTranscription(ctx, job)
+	require.NoError(t, err)
+	assert.Equal(t, TranscriptionStatusCompleted, actual.Status)
+	vtt, err := ParseWebVTT(actual.Content)
+	require.NoError(t, err)
+	assert.Len(t, vtt.Cues, 2)
+
+	texts, err := runner.Translate(ctx, []string{"こんにちは"}, "ja", "en")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"[en] こんにちは"}, texts)
+}
@@ -0,0 +1,208 @@
+package caption
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const webVTTHeader = "WEBVTT"
+
+var ErrInvalidWebVTT = errors.New("caption: invalid webvtt format")
+
+// WebVTT - 字幕ファイル
+type WebVTT struct {
+	Header string       // ヘッダー
+	Cues   []*WebVTTCue // キュー一覧
+}
+
+// WebVTTCue - 字幕の表示単位
+type WebVTTCue struct {
+	Identifier string // 識別子
+	Timing     string // 表示タイミング
+	Text       string // テキスト
+}
+
+// ParseWebVTT - WebVTT形式の字幕ファイルを解析する（NOTE/STYLE/REGIONブロックは破棄）
+func ParseWebVTT(content []byte) (*WebVTT, error) {
+	str := strings.TrimPrefix(string(content), "\ufeff")
+	str = strings.ReplaceAll(str, "\r\n", "\n")
+	blocks := splitBlocks(str)
+	if len(blocks) == 0 || !strings.HasPrefix(blocks[0], webVTTHeader) {
+		return nil, ErrInvalidWebVTT
+	}
+	res := &WebVTT{
+		Header: blocks[0],
+		Cues:   make([]*WebVTTCue, 0, len(blocks)-1),
+	}
+	for _, block := range blocks[1:] {
+		lines := strings.Split(block, "\n")
+		timing := -1
+		for i := range lines {
+			if strings.Contains(lines[i], "-->") {
+				timing = i
+				break
+			}
+		}
+		if timing < 0 {
+			continue
+		}
+		if timing > 1 {
+			return nil, ErrInvalidWebVTT
+		}
+		cue := &WebVTTCue{
+			Timing: lines[timing],
+			Text:   strings.Join(lines[timing+1:], "\n"),
+		}
+		if timing == 1 {
+			cue.Identifier = lines[0]
+		}
+		res.Cues = append(res.Cues, cue)
+	}
+	return res, nil
+}
+
+// Texts - キューのテキスト一覧
+func (v *WebVTT) Texts() []string {
+	res := make([]string, len(v.Cues))
+	for i := range v.Cues {
+		res[i] = v.Cues[i].Text
+	}
+	return res
+}
+
+// ReplaceTexts - 表示タイミングを維持したまま、テキストを差し替えた字幕ファイルを生成する
+func (v *WebVTT) ReplaceTexts(texts []string) (*WebVTT, error) {
+	if len(texts) != len(v.Cues) {
+		return nil, errors.New("caption: unmatch number of cues")
+	}
+	res := &WebVTT{
+		Header: v.Header,
+		Cues:   make([]*WebVTTCue, len(v.Cues)),
+	}
+	for i := range v.Cues {
+		res.Cues[i] = &WebVTTCue{
+			Identifier: v.Cues[i].Identifier,
+			Timing:     v.Cues[i].Timing,
+			Text:       texts[i],
+		}
+	}
+	return res, nil
+}
+
+// Clip - 指定した区間に表示されるキューを抜き出し、区間の先頭を起点とした表示タイミングへ変換する
+func (v *WebVTT) Clip(start, end time.Duration) (*WebVTT, error) {
+	res := &WebVTT{
+		Header: v.Header,
+		Cues:   make([]*WebVTTCue, 0, len(v.Cues)),
+	}
+	for _, cue := range v.Cues {
+		from, to, settings, err := parseWebVTTTiming(cue.Timing)
+		if err != nil {
+			return nil, err
+		}
+		if to <= start || from >= end {
+			continue
+		}
+		from, to = max(from, start)-start, min(to, end)-start
+		timing := formatWebVTTTimestamp(from) + " --> " + formatWebVTTTimestamp(to)
+		if settings != "" {
+			timing += " " + settings
+		}
+		res.Cues = append(res.Cues, &WebVTTCue{
+			Identifier: cue.Identifier,
+			Timing:     timing,
+			Text:       cue.Text,
+		})
+	}
+	return res, nil
+}
+
+func (v *WebVTT) Bytes() []byte {
+	var b strings.Builder
+	b.WriteString(v.Header)
+	b.WriteString("\n\n")
+	for _, cue := range v.Cues {
+		if cue.Identifier != "" {
+			b.WriteString(cue.Identifier)
+			b.WriteString("\n")
+		}
+		b.WriteString(cue.Timing)
+		b.WriteString("\n")
+		b.WriteString(cue.Text)
+		b.WriteString("\n\n")
+	}
+	return []byte(b.String())
+}
+
+func splitBlocks(str string) []string {
+	var (
+		res   []string
+		lines []string
+	)
+	for _, line := range strings.Split(str, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+			continue
+		}
+		if len(lines) > 0 {
+			res = append(res, strings.Join(lines, "\n"))
+			lines = nil
+		}
+	}
+	if len(lines) > 0 {
+		res = append(res, strings.Join(lines, "\n"))
+	}
+	return res
+}
+
+// parseWebVTTTiming - 表示タイミング（例: 00:00:01.000 --> 00:00:02.000 align:start）を解析する
+func parseWebVTTTiming(timing string) (time.Duration, time.Duration, string, error) {
+	from, rest, ok := strings.Cut(timing, "-->")
+	if !ok {
+		return 0, 0, "", ErrInvalidWebVTT
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return 0, 0, "", ErrInvalidWebVTT
+	}
+	start, err := parseWebVTTTimestamp(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, "", err
+	}
+	end, err := parseWebVTTTimestamp(fields[0])
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return start, end, strings.Join(fields[1:], " "), nil
+}
+
+// parseWebVTTTimestamp - タイムスタンプ（[HH:]MM:SS.ttt）を解析する
+func parseWebVTTTimestamp(str string) (time.Duration, error) {
+	clock, millis, ok := strings.Cut(str, ".")
+	if !ok || len(millis) != 3 {
+		return 0, ErrInvalidWebVTT
+	}
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, ErrInvalidWebVTT
+	}
+	units := []time.Duration{time.Hour, time.Minute, time.Second}[3-len(parts):]
+	units, parts = append(units, time.Millisecond), append(parts, millis)
+	var res time.Duration
+	for i := range parts {
+		n, err := strconv.ParseUint(parts[i], 10, 32)
+		if err != nil {
+			return 0, ErrInvalidWebVTT
+		}
+		res += time.Duration(n) * units[i]
+	}
+	return res, nil
+}
+
+func formatWebVTTTimestamp(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms%3600000/60000, ms%60000/1000, ms%1000)
+}
@@ -0,0 +1,144 @@
+package caption
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWebVTT(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		content   string
+		expect    *WebVTT
+		expectErr error
+	}{
+		{
+			name:    "success",
+			content: "\ufeffWEBVTT\r\n\r\n0\r\n00:00:00.000 --> 00:00:01.000\r\nこんにちは\r\n\r\nNOTE comment\r\n\r\n00:00:01.000 --> 00:00:02.000 align:start\r\n1行目\r\n2行目\r\n",
+			expect: &WebVTT{
+				Header: "WEBVTT",
+				Cues: []*WebVTTCue{
+					{Identifier: "0", Timing: "00:00:00.000 --> 00:00:01.000", Text: "こんにちは"},
+					{Timing: "00:00:01.000 --> 00:00:02.000 align:start", Text: "1行目\n2行目"},
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name:    "success empty cues",
+			content: "WEBVTT - title\n",
+			expect: &WebVTT{
+				Header: "WEBVTT - title",
+				Cues:   []*WebVTTCue{},
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid header",
+			content:   "00:00:00.000 --> 00:00:01.000\nこんにちは\n",
+			expect:    nil,
+			expectErr: ErrInvalidWebVTT,
+		},
+		{
+			name:      "empty content",
+			content:   "",
+			expect:    nil,
+			expectErr: ErrInvalidWebVTT,
+		},
+		{
+			name:      "invalid cue",
+			content:   "WEBVTT\n\nid\nother\n00:00:00.000 --> 00:00:01.000\nこんにちは\n",
+			expect:    nil,
+			expectErr: ErrInvalidWebVTT,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := ParseWebVTT([]byte(tt.content))
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestWebVTT_ReplaceTexts(t *testing.T) {
+	t.Parallel()
+	content := "WEBVTT\n\n0\n00:00:00.000 --> 00:00:01.000\nこんにちは\n\n00:00:01.000 --> 00:00:02.000\nさようなら\n"
+	vtt, err := ParseWebVTT([]byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"こんにちは", "さようなら"}, vtt.Texts())
+
+	actual, err := vtt.ReplaceTexts([]string{"Hello", "Goodbye"})
+	require.NoError(t, err)
+	expect := "WEBVTT\n\n0\n00:00:00.000 --> 00:00:01.000\nHello\n\n00:00:01.000 --> 00:00:02.000\nGoodbye\n\n"
+	assert.Equal(t, expect, string(actual.Bytes()))
+	assert.Equal(t, []string{"こんにちは", "さようなら"}, vtt.Texts(), "original cues must not be changed")
+
+	_, err = vtt.ReplaceTexts([]string{"Hello"})
+	assert.Error(t, err)
+}
+
+func TestWebVTT_Clip(t *testing.T) {
+	t.Parallel()
+	content := "WEBVTT\n\n" +
+		"00:00:01.000 --> 00:00:03.000\nまえ\n\n" +
+		"1\n00:00:09.500 --> 00:00:11.000 align:start\nはじめ\n\n" +
+		"01:05.250 --> 01:10.000\nなか\n\n" +
+		"00:01:58.000 --> 00:02:02.000\nおわり\n\n" +
+		"00:02:05.000 --> 00:02:06.000\nあと\n"
+	vtt, err := ParseWebVTT([]byte(content))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		vtt       *WebVTT
+		start     time.Duration
+		end       time.Duration
+		expect    string
+		expectErr error
+	}{
+		{
+			name:  "success",
+			vtt:   vtt,
+			start: 10 * time.Second,
+			end:   2 * time.Minute,
+			expect: "WEBVTT\n\n" +
+				"1\n00:00:00.000 --> 00:00:01.000 align:start\nはじめ\n\n" +
+				"00:00:55.250 --> 00:01:00.000\nなか\n\n" +
+				"00:01:48.000 --> 00:01:50.000\nおわり\n\n",
+		},
+		{
+			name:   "success empty",
+			vtt:    vtt,
+			start:  3 * time.Minute,
+			end:    4 * time.Minute,
+			expect: "WEBVTT\n\n",
+		},
+		{
+			name: "invalid timing",
+			vtt: &WebVTT{
+				Header: "WEBVTT",
+				Cues:   []*WebVTTCue{{Timing: "00:00:01 --> 00:00:02", Text: "invalid"}},
+			},
+			start:     0,
+			end:       time.Minute,
+			expectErr: ErrInvalidWebVTT,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := tt.vtt.Clip(tt.start, tt.end)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if tt.expectErr != nil {
+				return
+			}
+			assert.Equal(t, tt.expect, string(actual.Bytes()))
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package scheduler
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/url"
@@ -9,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/and-period/furumaru/api/internal/media/broadcast/caption"
 	"github.com/and-period/furumaru/api/internal/media/database"
 	"github.com/and-period/furumaru/api/internal/media/entity"
 	"github.com/and-period/furumaru/api/internal/store"
@@ -34,7 +37,9 @@ type closer struct {
 	sfn         sfn.StepFunction
 	media       medialive.MediaLive
 	convert     mediaconvert.MediaConvert
+	caption     caption.Runner
 	storageURL  func() *url.URL
+	env         string
 	bucketName  string
 	jobTemplate string
 }
@@ -62,7 +67,9 @@ func NewCloser(params *Params, opts ...Option) Scheduler {
 		sfn:         params.StepFunction,
 		media:       params.MediaLive,
 		convert:     params.MediaConvert,
+		caption:     params.Caption,
 		storageURL:  storageURL,
+		env:         params.Environment,
 		bucketName:  params.ArchiveBucketName,
 		jobTemplate: params.ConvertJobTemplate,
 	}
@@ -85,7 +92,7 @@ func (c *closer) Run(ctx context.Context, target time.Time) error {
 	return nil
 }
 
-// run - ライブ配信のリソース削除と停止処理
+// run - ライブ配信のリソース削除と停止処理、アーカイブ字幕・切り抜き動画の生成
 func (c *closer) run(ctx context.Context, target time.Time) error {
 	if err := c.removeChannel(ctx, target); err != nil {
 		slog.Error("Failed to remove channel", slog.Time("target", target), log.Error(err))
@@ -94,6 +101,12 @@ func (c *closer) run(ctx context.Context, target time.Time) error {
 	if err := c.stopChannel(ctx, target); err != nil {
 		slog.Error("Failed to stop channel", slog.Time("target", target), log.Error(err))
 	}
+	if err := c.generateCaption(ctx, target); err != nil {
+		slog.Error("Failed to generate caption", slog.Time("target", target), log.Error(err))
+	}
+	if err := c.clipArchive(ctx); err != nil {
+		slog.Error("Failed to clip archive", slog.Time("target", target), log.Error(err))
+	}
 	return nil
 }
 
@@ -143,7 +156,8 @@ func (c *closer) stopChannel(ctx context.Context, target time.Time) error {
 			slog.Info("Succeeded to stop media live", slog.String("scheduleId", schedule.ID))
 
 			params := &database.UpdateBroadcastParams{
-				Status: entity.BroadcastStatusIdle,
+				Status:  entity.BroadcastStatusIdle,
+				EndedAt: c.now(),
 			}
 			return c.db.Broadcast.Update(ctx, broadcast.ID, params)
 		})
@@ -182,7 +196,7 @@ func (c *closer) removeChannel(ctx context.Context, target time.Time) error {
 			}
 
 			slog.Info("Calling to create convert job", slog.String("scheduleId", schedule.ID))
-			if err := c.convert.CreateJob(ectx, c.jobTemplate, c.newMediaConvertJobSettings(broadcast)); err != nil {
+			if _, err := c.convert.CreateJob(ectx, c.jobTemplate, c.newMediaConvertJobSettings(broadcast)); err != nil {
 				slog.Error("Failed to create convert job", slog.String("scheduleId", schedule.ID), log.Error(err))
 				return err
 			}
@@ -215,6 +229,326 @@ func (c *closer) removeChannel(ctx context.Context, target time.Time) error {
 	return eg.Wait()
 }
 
+// generateCaption - アーカイブ動画から字幕を生成 (アーカイブ動画の作成後)
+func (c *closer) generateCaption(ctx context.Context, target time.Time) error {
+	if c.caption == nil {
+		return nil
+	}
+	in := &store.ListSchedulesInput{
+		EndAtGte: target.AddDate(0, 0, -7),   // 〜マルシェ開催終了7日経過
+		EndAtLt:  target.Add(-1 * time.Hour), // マルシェ開催終了1時間経過〜
+		NoLimit:  true,
+	}
+	schedules, total, err := c.store.ListSchedules(ctx, in)
+	if err != nil || total == 0 {
+		return err
+	}
+	slog.Debug("Got schedules to generate caption", slog.Int64("total", total))
+
+	eg, ectx := errgroup.WithContext(ctx)
+	for i := range schedules {
+		if err := c.semaphore.Acquire(ctx, 1); err != nil {
+			return err
+		}
+
+		schedule := schedules[i]
+		eg.Go(func() error {
+			defer c.semaphore.Release(1)
+			broadcast, err := c.db.Broadcast.GetByScheduleID(ectx, schedule.ID)
+			if err != nil {
+				return err
+			}
+			if broadcast.Status != entity.BroadcastStatusDisabled || broadcast.ArchiveURL == "" {
+				return nil // アーカイブ動画の作成を開始している場合のみ、字幕生成を進める
+			}
+			switch broadcast.CaptionStatus {
+			case entity.BroadcastCaptionStatusNone:
+				if broadcast.HasCaptions() {
+					return nil // 字幕が登録済みの場合は生成しない
+				}
+				return c.startTranscription(ectx, broadcast)
+			case entity.BroadcastCaptionStatusProcessing:
+				return c.publishCaption(ectx, broadcast)
+			default:
+				return nil
+			}
+		})
+	}
+	return eg.Wait()
+}
+
+func (c *closer) startTranscription(ctx context.Context, broadcast *entity.Broadcast) error {
+	job, err := c.newTranscriptionJob(broadcast)
+	if err != nil {
+		return err
+	}
+	// 動画変換が完了しているかをアーカイブ動画の有無で判定
+	if _, err := c.storage.GetMetadata(ctx, job.MediaKey); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			slog.Debug("Archive is not ready", slog.String("scheduleId", broadcast.ScheduleID))
+			return nil
+		}
+		return err
+	}
+
+	slog.Info("Calling to start transcription", slog.String("scheduleId", broadcast.ScheduleID))
+	if err := c.caption.StartTranscription(ctx, job); err != nil {
+		slog.Error("Failed to start transcription", slog.String("scheduleId", broadcast.ScheduleID), log.Error(err))
+		return err
+	}
+	slog.Info("Succeeded to start transcription", slog.String("scheduleId", broadcast.ScheduleID))
+
+	params := &database.UpdateBroadcastParams{
+		UpdateBroadcastCaptionParams: &database.UpdateBroadcastCaptionParams{
+			CaptionStatus: entity.BroadcastCaptionStatusProcessing,
+		},
+	}
+	return c.db.Broadcast.Update(ctx, broadcast.ID, params)
+}
+
+func (c *closer) publishCaption(ctx context.Context, broadcast *entity.Broadcast) error {
+	job, err := c.newTranscriptionJob(broadcast)
+	if err != nil {
+		return err
+	}
+	transcription, err := c.caption.GetTranscription(ctx, job)
+	if errors.Is(err, caption.ErrNotFound) {
+		// 文字起こしジョブの保持期間を過ぎている場合、再度実行する
+		return c.startTranscription(ctx, broadcast)
+	}
+	if err != nil {
+		return err
+	}
+	switch transcription.Status {
+	case caption.TranscriptionStatusCompleted:
+		// 字幕の公開処理へ進む
+	case caption.TranscriptionStatusFailed:
+		slog.Error("Failed to transcribe archive",
+			slog.String("scheduleId", broadcast.ScheduleID), slog.String("reason", transcription.FailureReason))
+		params := &database.UpdateBroadcastParams{
+			UpdateBroadcastCaptionParams: &database.UpdateBroadcastCaptionParams{
+				CaptionStatus: entity.BroadcastCaptionStatusFailed,
+			},
+		}
+		return c.db.Broadcast.Update(ctx, broadcast.ID, params)
+	default:
+		return nil // 文字起こしが完了するまで待機
+	}
+
+	translated, err := caption.TranslateWebVTT(ctx, c.caption, transcription.Content,
+		entity.BroadcastCaptionLanguageJapanese, entity.BroadcastCaptionLanguageEnglish)
+	if err != nil {
+		slog.Error("Failed to translate caption", slog.String("scheduleId", broadcast.ScheduleID), log.Error(err))
+		return err
+	}
+	contents := map[string][]byte{
+		entity.BroadcastCaptionLanguageJapanese: transcription.Content,
+		entity.BroadcastCaptionLanguageEnglish:  translated,
+	}
+	captions := make(map[string]string, len(contents))
+	for language, content := range contents {
+		key := entity.NewBroadcastCaptionKey(broadcast.ScheduleID, language, c.now())
+		if _, err := c.storage.Upload(ctx, key, bytes.NewReader(content), newCaptionMetadata()); err != nil {
+			return err
+		}
+		captionURL := c.storageURL()
+		captionURL.Path = "/" + key
+		captions[language] = captionURL.String()
+	}
+	slog.Info("Succeeded to publish caption", slog.String("scheduleId", broadcast.ScheduleID))
+
+	params := &database.UpdateBroadcastParams{
+		UpdateBroadcastCaptionParams: &database.UpdateBroadcastCaptionParams{
+			CaptionStatus:   entity.BroadcastCaptionStatusPublished,
+			ArchiveMetadata: broadcast.NewCaptionMetadata(captions),
+		},
+	}
+	return c.db.Broadcast.Update(ctx, broadcast.ID, params)
+}
+
+func (c *closer) newTranscriptionJob(broadcast *entity.Broadcast) (*caption.TranscriptionJob, error) {
+	mediaKey, err := broadcast.ArchiveKey()
+	if err != nil {
+		return nil, err
+	}
+	job := &caption.TranscriptionJob{
+		Name:      fmt.Sprintf("%s-%s-caption", c.env, broadcast.ID),
+		MediaKey:  mediaKey,
+		OutputKey: entity.NewBroadcastTranscriptionKey(broadcast.ScheduleID),
+		Language:  entity.BroadcastCaptionLanguageJapanese,
+	}
+	return job, nil
+}
+
+// clipArchive - アーカイブ動画から切り抜き動画を生成 (切り抜き動画の登録後)
+func (c *closer) clipArchive(ctx context.Context) error {
+	params := &database.ListBroadcastClipsParams{
+		Statuses: []entity.BroadcastClipStatus{
+			entity.BroadcastClipStatusWaiting,
+			entity.BroadcastClipStatusProcessing,
+		},
+	}
+	clips, err := c.db.BroadcastClip.List(ctx, params)
+	if err != nil || len(clips) == 0 {
+		return err
+	}
+	slog.Debug("Got clips to generate", slog.Int("total", len(clips)))
+
+	eg, ectx := errgroup.WithContext(ctx)
+	for i := range clips {
+		if err := c.semaphore.Acquire(ctx, 1); err != nil {
+			return err
+		}
+
+		clip := clips[i]
+		eg.Go(func() error {
+			defer c.semaphore.Release(1)
+			switch clip.Status {
+			case entity.BroadcastClipStatusWaiting:
+				return c.startClipJob(ectx, clip)
+			case entity.BroadcastClipStatusProcessing:
+				return c.publishClip(ectx, clip)
+			default:
+				return nil
+			}
+		})
+	}
+	return eg.Wait()
+}
+
+func (c *closer) startClipJob(ctx context.Context, clip *entity.BroadcastClip) error {
+	broadcast, err := c.db.Broadcast.GetByScheduleID(ctx, clip.ScheduleID)
+	if err != nil {
+		return err
+	}
+	archiveKey, err := broadcast.ArchiveKey()
+	if err != nil {
+		return err
+	}
+	// 動画変換が完了しているかをアーカイブ動画の有無で判定
+	if _, err := c.storage.GetMetadata(ctx, archiveKey); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			slog.Debug("Archive is not ready", slog.String("scheduleId", clip.ScheduleID), slog.String("clipId", clip.ID))
+			return nil
+		}
+		return err
+	}
+
+	slog.Info("Calling to create clip convert job", slog.String("clipId", clip.ID))
+	job, err := c.convert.CreateJob(ctx, c.jobTemplate, c.newClipJobSettings(clip, archiveKey))
+	if err != nil {
+		slog.Error("Failed to create clip convert job", slog.String("clipId", clip.ID), log.Error(err))
+		return err
+	}
+	slog.Info("Succeeded to create clip convert job", slog.String("clipId", clip.ID), slog.String("jobId", job.ID))
+
+	params := &database.UpdateBroadcastClipParams{
+		Status: entity.BroadcastClipStatusProcessing,
+		JobID:  job.ID,
+	}
+	return c.db.BroadcastClip.Update(ctx, clip.ID, params)
+}
+
+func (c *closer) publishClip(ctx context.Context, clip *entity.BroadcastClip) error {
+	job, err := c.convert.GetJob(ctx, clip.JobID)
+	if err != nil {
+		return err
+	}
+	switch job.Status {
+	case mediaconvert.JobStatusComplete:
+		// オンデマンド動画の登録処理へ進む
+	case mediaconvert.JobStatusError, mediaconvert.JobStatusCanceled:
+		slog.Error("Failed to convert clip",
+			slog.String("clipId", clip.ID), slog.String("jobId", job.ID), slog.String("reason", job.ErrorMessage))
+		params := &database.UpdateBroadcastClipParams{
+			Status:       entity.BroadcastClipStatusFailed,
+			Progress:     int64(job.PercentComplete),
+			ErrorMessage: job.ErrorMessage,
+		}
+		return c.db.BroadcastClip.Update(ctx, clip.ID, params)
+	default:
+		// 動画変換が完了するまで待機
+		params := &database.UpdateBroadcastClipParams{
+			Status:   entity.BroadcastClipStatusProcessing,
+			Progress: int64(job.PercentComplete),
+		}
+		return c.db.BroadcastClip.Update(ctx, clip.ID, params)
+	}
+
+	broadcast, err := c.db.Broadcast.GetByScheduleID(ctx, clip.ScheduleID)
+	if err != nil {
+		return err
+	}
+	if broadcast.CaptionStatus == entity.BroadcastCaptionStatusProcessing {
+		slog.Debug("Caption is not ready", slog.String("scheduleId", clip.ScheduleID), slog.String("clipId", clip.ID))
+		return nil
+	}
+	captions, err := c.clipCaptions(ctx, broadcast, clip)
+	if err != nil {
+		slog.Error("Failed to clip captions", slog.String("clipId", clip.ID), log.Error(err))
+		return err
+	}
+
+	videoURL := c.storageURL()
+	videoURL.Path = filepath.Join("/", clip.OutputPath(), archiveFilename)
+
+	// 動画の登録と切り抜きの生成完了を同一トランザクションで行い、重複登録を防ぐ
+	video := entity.NewVideo(clip.NewVideoParams(videoURL.String()))
+	video.Captions = captions
+	err = c.db.BroadcastClip.Publish(ctx, clip.ID, video)
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		slog.Info("Clip has already been published", slog.String("clipId", clip.ID))
+		return nil
+	}
+	if err != nil {
+		slog.Error("Failed to publish clip", slog.String("clipId", clip.ID), log.Error(err))
+		return err
+	}
+	slog.Info("Succeeded to publish clip", slog.String("clipId", clip.ID), slog.String("videoId", video.ID))
+	return nil
+}
+
+// clipCaptions - アーカイブ字幕から切り抜き区間の字幕ファイルを生成
+func (c *closer) clipCaptions(
+	ctx context.Context, broadcast *entity.Broadcast, clip *entity.BroadcastClip,
+) (map[string]string, error) {
+	start, end := time.Duration(clip.StartOffset)*time.Second, time.Duration(clip.EndOffset)*time.Second
+	captions := make(map[string]string, len(entity.BroadcastCaptionLanguages))
+	for _, language := range entity.BroadcastCaptionLanguages {
+		archiveURL, ok := broadcast.CaptionURL(language)
+		if !ok {
+			continue
+		}
+		content, err := c.storage.DownloadAndReadAll(ctx, archiveURL)
+		if err != nil {
+			return nil, err
+		}
+		vtt, err := caption.ParseWebVTT(content)
+		if err != nil {
+			return nil, err
+		}
+		clipped, err := vtt.Clip(start, end)
+		if err != nil {
+			return nil, err
+		}
+		key := clip.CaptionKey(language)
+		if _, err := c.storage.Upload(ctx, key, bytes.NewReader(clipped.Bytes()), newCaptionMetadata()); err != nil {
+			return nil, err
+		}
+		captionURL := c.storageURL()
+		captionURL.Path = "/" + key
+		captions[language] = captionURL.String()
+	}
+	return captions, nil
+}
+
+func newCaptionMetadata() map[string]string {
+	return map[string]string{
+		"Content-Type":  captionContentType,
+		"Cache-Control": "s-maxage=" + entity.BroadcastArchiveTextRegulation.CacheTTL.String(),
+	}
+}
+
 func (c *closer) newMediaConvertJobSettings(broadcast *entity.Broadcast) *types.JobSettings {
 	src := c.storage.GenerateS3URI(filepath.Join(newArchiveHLSPath(broadcast.ScheduleID), playlistFilename))
 	dst := c.storage.GenerateS3URI(newArchiveMP4Path(broadcast.ScheduleID))
@@ -234,3 +568,27 @@ func (c *closer) newMediaConvertJobSettings(broadcast *entity.Broadcast) *types.
 		}},
 	}
 }
+
+func (c *closer) newClipJobSettings(clip *entity.BroadcastClip, archiveKey string) *types.JobSettings {
+	src := c.storage.GenerateS3URI(archiveKey)
+	dst := c.storage.GenerateS3URI("/" + clip.OutputPath())
+
+	return &types.JobSettings{
+		Inputs: []types.Input{{
+			FileInput:      aws.String(src),
+			TimecodeSource: types.InputTimecodeSourceZerobased,
+			InputClippings: []types.InputClipping{{
+				StartTimecode: aws.String(clip.StartTimecode()),
+				EndTimecode:   aws.String(clip.EndTimecode()),
+			}},
+		}},
+		OutputGroups: []types.OutputGroup{{
+			OutputGroupSettings: &types.OutputGroupSettings{
+				Type: types.OutputGroupTypeFileGroupSettings,
+				FileGroupSettings: &types.FileGroupSettings{
+					Destination: aws.String(dst),
+				},
+			},
+		}},
+	}
+}
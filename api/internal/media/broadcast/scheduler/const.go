@@ -5,6 +5,7 @@ const (
 	archiveFilename    = "original.mp4"
 	playlistFilename   = "live.m3u8"
 	dynamicMP4InputURL = "$urlPath$"
+	captionContentType = "text/vtt"
 )
 
 // CreatePayload - 配信リソース作成リクエスト
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/and-period/furumaru/api/internal/media/broadcast/caption"
 	"github.com/and-period/furumaru/api/internal/media/database"
 	"github.com/and-period/furumaru/api/internal/media/entity"
 	"github.com/and-period/furumaru/api/internal/store"
@@ -29,6 +30,7 @@ type Params struct {
 	Database           *database.Database
 	Storage            storage.Bucket
 	Store              store.Service
+	Caption            caption.Runner
 	Environment        string
 	ArchiveBucketName  string
 	ConvertJobTemplate string
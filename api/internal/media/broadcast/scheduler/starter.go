@@ -144,7 +144,8 @@ func (s *starter) startChannel(ctx context.Context, target time.Time) error {
 			slog.Info("Succeeded to start media live", slog.String("scheduleId", schedule.ID))
 
 			params := &database.UpdateBroadcastParams{
-				Status: entity.BroadcastStatusActive,
+				Status:    entity.BroadcastStatusActive,
+				StartedAt: s.now(),
 			}
 			return s.db.Broadcast.Update(ctx, broadcast.ID, params)
 		})
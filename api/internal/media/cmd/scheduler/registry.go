@@ -6,11 +6,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/and-period/furumaru/api/internal/media/broadcast/caption"
 	"github.com/and-period/furumaru/api/internal/media/broadcast/scheduler"
 	mediadb "github.com/and-period/furumaru/api/internal/media/database/tidb"
 	"github.com/and-period/furumaru/api/internal/store"
 	storedb "github.com/and-period/furumaru/api/internal/store/database/tidb"
 	storesrv "github.com/and-period/furumaru/api/internal/store/service"
+	transcribe "github.com/and-period/furumaru/api/pkg/aws/transcribe"
+	translate "github.com/and-period/furumaru/api/pkg/aws/translate"
 	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/and-period/furumaru/api/pkg/mediaconvert"
 	"github.com/and-period/furumaru/api/pkg/medialive"
@@ -73,6 +76,20 @@ func (a *app) inject(ctx context.Context) error {
 	}
 	storageClient := storage.NewBucket(awscfg, storageParams)
 
+	// 字幕生成の設定
+	var captionRunner caption.Runner
+	switch a.CaptionRunner {
+	case "local":
+		captionRunner = caption.NewLocalRunner()
+	default:
+		captionParams := &caption.AWSParams{
+			Transcribe: transcribe.NewClient(awscfg),
+			Translate:  translate.NewClient(awscfg),
+			Storage:    storageClient,
+		}
+		captionRunner = caption.NewAWSRunner(captionParams)
+	}
+
 	// Databaseの設定
 	dbClient, err := a.newTiDB("media", params)
 	if err != nil {
@@ -94,6 +111,7 @@ func (a *app) inject(ctx context.Context) error {
 		StepFunction:       sfnClient,
 		MediaLive:          mediaLiveClient,
 		MediaConvert:       mediaConvertClient,
+		Caption:            captionRunner,
 		Environment:        a.Environment,
 		ArchiveBucketName:  a.ArchiveBucketName,
 		ConvertJobTemplate: a.MediaConvertJobTemplate,
@@ -41,6 +41,7 @@ type app struct {
 	MediaConvertRoleARN     string `default:""                envconfig:"MEDIA_CONVERT_ROLE_ARN"`
 	MediaConvertJobTemplate string `default:""                envconfig:"MEDIA_CONVERT_JOB_TEMPLATE"`
 	CDNURL                  string `default:""                envconfig:"CDN_URL"`
+	CaptionRunner           string `default:"aws"             envconfig:"CAPTION_RUNNER"`
 }
 
 func NewApp() *app {
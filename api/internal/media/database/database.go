@@ -21,12 +21,16 @@ var (
 )
 
 type Database struct {
-	Broadcast          Broadcast
-	BroadcastComment   BroadcastComment
-	BroadcastViewerLog BroadcastViewerLog
-	Video              Video
-	VideoComment       VideoComment
-	VideoViewerLog     VideoViewerLog
+	Broadcast            Broadcast
+	BroadcastComment     BroadcastComment
+	BroadcastViewerLog   BroadcastViewerLog
+	BroadcastClip        BroadcastClip
+	Video                Video
+	VideoComment         VideoComment
+	VideoViewerLog       VideoViewerLog
+	CommentNgWord        CommentNgWord
+	CommentMute          CommentMute
+	CommentModerationLog CommentModerationLog
 }
 
 type Broadcast interface {
@@ -59,10 +63,13 @@ type ListBroadcastsOrder struct {
 }
 
 type UpdateBroadcastParams struct {
-	Status entity.BroadcastStatus
+	Status    entity.BroadcastStatus
+	StartedAt time.Time
+	EndedAt   time.Time
 	*InitializeBroadcastParams
 	*UploadBroadcastArchiveParams
 	*UpdateBroadcastArchiveParams
+	*UpdateBroadcastCaptionParams
 	*UpsertYoutubeBroadcastParams
 }
 
@@ -89,6 +96,11 @@ type UpdateBroadcastArchiveParams struct {
 	ArchiveMetadata *entity.BroadcastArchiveMetadata
 }
 
+type UpdateBroadcastCaptionParams struct {
+	CaptionStatus   entity.BroadcastCaptionStatus
+	ArchiveMetadata *entity.BroadcastArchiveMetadata
+}
+
 type UpsertYoutubeBroadcastParams struct {
 	YoutubeAccount     string
 	YoutubeBroadcastID string
@@ -100,6 +112,8 @@ type UpsertYoutubeBroadcastParams struct {
 
 type BroadcastComment interface {
 	List(ctx context.Context, params *ListBroadcastCommentsParams, fields ...string) (entity.BroadcastComments, string, error)
+	Count(ctx context.Context, params *CountBroadcastCommentsParams) (int64, error)
+	Get(ctx context.Context, commentID string, fields ...string) (*entity.BroadcastComment, error)
 	Create(ctx context.Context, comment *entity.BroadcastComment) error
 	Update(ctx context.Context, commentID string, params *UpdateBroadcastCommentParams) error
 }
@@ -113,6 +127,13 @@ type ListBroadcastCommentsParams struct {
 	NextToken    string
 }
 
+type CountBroadcastCommentsParams struct {
+	BroadcastID  string
+	UserID       string
+	ClientIP     string
+	CreatedAtGte time.Time
+}
+
 type UpdateBroadcastCommentParams struct {
 	Disabled bool
 }
@@ -136,6 +157,28 @@ type AggregateBroadcastViewerLogsParams struct {
 	CreatedAtLt  time.Time
 }
 
+type BroadcastClip interface {
+	List(ctx context.Context, params *ListBroadcastClipsParams, fields ...string) (entity.BroadcastClips, error)
+	Get(ctx context.Context, clipID string, fields ...string) (*entity.BroadcastClip, error)
+	Create(ctx context.Context, clip *entity.BroadcastClip) error
+	Update(ctx context.Context, clipID string, params *UpdateBroadcastClipParams) error
+	Publish(ctx context.Context, clipID string, video *entity.Video) error
+	Delete(ctx context.Context, clipID string) error
+}
+
+type ListBroadcastClipsParams struct {
+	BroadcastID string
+	Statuses    []entity.BroadcastClipStatus
+}
+
+type UpdateBroadcastClipParams struct {
+	Status       entity.BroadcastClipStatus
+	JobID        string
+	Progress     int64
+	VideoID      string
+	ErrorMessage string
+}
+
 type Video interface {
 	List(ctx context.Context, params *ListVideosParams, fields ...string) (entity.Videos, error)
 	ListByProductID(ctx context.Context, productID string, fields ...string) (entity.Videos, error)
@@ -174,6 +217,7 @@ type UpdateVideoParams struct {
 
 type VideoComment interface {
 	List(ctx context.Context, params *ListVideoCommentsParams, fields ...string) (entity.VideoComments, string, error)
+	Count(ctx context.Context, params *CountVideoCommentsParams) (int64, error)
 	Create(ctx context.Context, comment *entity.VideoComment) error
 	Update(ctx context.Context, commentID string, params *UpdateVideoCommentParams) error
 }
@@ -187,6 +231,13 @@ type ListVideoCommentsParams struct {
 	NextToken    string
 }
 
+type CountVideoCommentsParams struct {
+	VideoID      string
+	UserID       string
+	ClientIP     string
+	CreatedAtGte time.Time
+}
+
 type UpdateVideoCommentParams struct {
 	Disabled bool
 }
@@ -221,3 +272,44 @@ func (e *Error) Error() string {
 func (e *Error) Unwrap() error {
 	return e.err
 }
+
+type CommentNgWord interface {
+	List(ctx context.Context, params *ListCommentNgWordsParams, fields ...string) (entity.CommentNgWords, error)
+	Count(ctx context.Context, params *ListCommentNgWordsParams) (int64, error)
+	Get(ctx context.Context, ngWordID string, fields ...string) (*entity.CommentNgWord, error)
+	Create(ctx context.Context, word *entity.CommentNgWord) error
+	Delete(ctx context.Context, ngWordID string) error
+}
+
+type ListCommentNgWordsParams struct {
+	CoordinatorID string
+	Limit         int
+	Offset        int
+}
+
+type CommentMute interface {
+	List(ctx context.Context, params *ListCommentMutesParams, fields ...string) (entity.CommentMutes, error)
+	Get(ctx context.Context, muteID string, fields ...string) (*entity.CommentMute, error)
+	Create(ctx context.Context, mute *entity.CommentMute) error
+	Delete(ctx context.Context, muteID string) error
+}
+
+type ListCommentMutesParams struct {
+	BroadcastID string
+	UserID      string // ユーザーID・セッションID・IPアドレスのいずれかに一致するものを取得
+	SessionID   string
+	ClientIP    string
+}
+
+type CommentModerationLog interface {
+	List(ctx context.Context, params *ListCommentModerationLogsParams, fields ...string) (entity.CommentModerationLogs, error)
+	Count(ctx context.Context, params *ListCommentModerationLogsParams) (int64, error)
+	Create(ctx context.Context, log *entity.CommentModerationLog) error
+}
+
+type ListCommentModerationLogsParams struct {
+	TargetType entity.CommentModerationTargetType
+	TargetID   string
+	Limit      int
+	Offset     int
+}
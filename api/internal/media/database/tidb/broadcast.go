@@ -125,6 +125,13 @@ func (b *broadcast) Update(ctx context.Context, broadcastID string, params *data
 	if params.Status != entity.BroadcastStatusUnknown {
 		updates["status"] = params.Status
 	}
+	if !params.StartedAt.IsZero() {
+		updates["started_at"] = params.StartedAt
+		updates["ended_at"] = nil
+	}
+	if !params.EndedAt.IsZero() {
+		updates["ended_at"] = params.EndedAt
+	}
 	if params.InitializeBroadcastParams != nil {
 		updates["input_url"] = params.InputURL
 		updates["output_url"] = params.OutputURL
@@ -143,13 +150,23 @@ func (b *broadcast) Update(ctx context.Context, broadcastID string, params *data
 		updates["archive_fixed"] = params.ArchiveFixed
 	}
 	if params.UpdateBroadcastArchiveParams != nil {
-		metadataVal, err := mysql.NewJSONColumn(params.ArchiveMetadata).Value()
+		metadataVal, err := mysql.NewJSONColumn(params.UpdateBroadcastArchiveParams.ArchiveMetadata).Value()
 		if err != nil {
 			return dbError(err)
 		}
 		updates["archive_url"] = params.UpdateBroadcastArchiveParams.ArchiveURL
 		updates["archive_metadata"] = metadataVal
 	}
+	if params.UpdateBroadcastCaptionParams != nil {
+		updates["caption_status"] = params.CaptionStatus
+		if params.UpdateBroadcastCaptionParams.ArchiveMetadata != nil {
+			metadataVal, err := mysql.NewJSONColumn(params.UpdateBroadcastCaptionParams.ArchiveMetadata).Value()
+			if err != nil {
+				return dbError(err)
+			}
+			updates["archive_metadata"] = metadataVal
+		}
+	}
 	if params.UpsertYoutubeBroadcastParams != nil {
 		updates["youtube_account"] = params.YoutubeAccount
 		updates["youtube_broadcast_id"] = params.YoutubeBroadcastID
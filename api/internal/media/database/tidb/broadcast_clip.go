@@ -0,0 +1,164 @@
+package tidb
+
+import (
+	"context"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+)
+
+const broadcastClipTable = "broadcast_clips"
+
+type broadcastClip struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewBroadcastClip(db *mysql.Client) database.BroadcastClip {
+	return &broadcastClip{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+func (c *broadcastClip) List(
+	ctx context.Context, params *database.ListBroadcastClipsParams, fields ...string,
+) (entity.BroadcastClips, error) {
+	var internal internalBroadcastClips
+
+	stmt := c.db.Statement(ctx, c.db.DB, broadcastClipTable, fields...)
+	if params.BroadcastID != "" {
+		stmt = stmt.Where("broadcast_id = ?", params.BroadcastID)
+	}
+	if len(params.Statuses) > 0 {
+		stmt = stmt.Where("status IN (?)", params.Statuses)
+	}
+	stmt = stmt.Order("created_at ASC")
+
+	if err := stmt.Find(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entities(), nil
+}
+
+func (c *broadcastClip) Get(ctx context.Context, clipID string, fields ...string) (*entity.BroadcastClip, error) {
+	var internal *internalBroadcastClip
+
+	stmt := c.db.Statement(ctx, c.db.DB, broadcastClipTable, fields...).
+		Where("id = ?", clipID)
+
+	if err := stmt.First(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entity(), nil
+}
+
+func (c *broadcastClip) Create(ctx context.Context, clip *entity.BroadcastClip) error {
+	now := c.now()
+	clip.CreatedAt, clip.UpdatedAt = now, now
+
+	internal := newInternalBroadcastClip(clip)
+
+	err := c.db.DB.WithContext(ctx).Table(broadcastClipTable).Create(&internal).Error
+	return dbError(err)
+}
+
+func (c *broadcastClip) Update(ctx context.Context, clipID string, params *database.UpdateBroadcastClipParams) error {
+	updates := map[string]interface{}{
+		"status":        params.Status,
+		"progress":      params.Progress,
+		"error_message": params.ErrorMessage,
+		"updated_at":    c.now(),
+	}
+	if params.JobID != "" {
+		updates["job_id"] = params.JobID
+	}
+	if params.VideoID != "" {
+		updates["video_id"] = params.VideoID
+	}
+	stmt := c.db.DB.WithContext(ctx).
+		Table(broadcastClipTable).
+		Where("id = ?", clipID)
+
+	err := stmt.Updates(updates).Error
+	return dbError(err)
+}
+
+func (c *broadcastClip) Publish(ctx context.Context, clipID string, v *entity.Video) error {
+	err := c.db.Transaction(ctx, func(tx *gorm.DB) error {
+		now := c.now()
+		// 同時実行時に動画を重複して登録しないよう、変換中の切り抜きのみ生成完了へ更新する
+		updates := map[string]interface{}{
+			"status":        entity.BroadcastClipStatusCompleted,
+			"progress":      100,
+			"video_id":      v.ID,
+			"error_message": "",
+			"updated_at":    now,
+		}
+		stmt := tx.WithContext(ctx).
+			Table(broadcastClipTable).
+			Where("id = ?", clipID).
+			Where("status = ?", entity.BroadcastClipStatusProcessing)
+		res := stmt.Updates(updates)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return database.ErrFailedPrecondition
+		}
+
+		videos := &video{db: c.db, now: c.now}
+		v.CreatedAt, v.UpdatedAt = now, now
+		v.VideoProducts = entity.NewVideoProducts(v.ID, v.ProductIDs)
+		v.VideoExperiences = entity.NewVideoExperiences(v.ID, v.ExperienceIDs)
+		if err := tx.WithContext(ctx).Table(videoTable).Create(&v).Error; err != nil {
+			return err
+		}
+		if err := videos.replaceProducts(ctx, tx, v.ID, v.VideoProducts); err != nil {
+			return err
+		}
+		return videos.replaceExperiences(ctx, tx, v.ID, v.VideoExperiences)
+	})
+	return dbError(err)
+}
+
+func (c *broadcastClip) Delete(ctx context.Context, clipID string) error {
+	stmt := c.db.DB.WithContext(ctx).Table(broadcastClipTable).Where("id = ?", clipID)
+
+	err := stmt.Delete(&internalBroadcastClip{}).Error
+	return dbError(err)
+}
+
+type internalBroadcastClip struct {
+	entity.BroadcastClip `gorm:"embedded"`
+	ProductIDsJSON       mysql.JSONColumn[[]string] `gorm:"default:null;column:product_ids"` // 紐付ける商品ID一覧(JSON)
+}
+
+type internalBroadcastClips []*internalBroadcastClip
+
+func newInternalBroadcastClip(clip *entity.BroadcastClip) *internalBroadcastClip {
+	return &internalBroadcastClip{
+		BroadcastClip:  *clip,
+		ProductIDsJSON: mysql.NewJSONColumn(clip.ProductIDs),
+	}
+}
+
+func (c *internalBroadcastClip) entity() *entity.BroadcastClip {
+	c.BroadcastClip.ProductIDs = c.ProductIDsJSON.Val
+	if c.BroadcastClip.ProductIDs == nil {
+		c.BroadcastClip.ProductIDs = []string{}
+	}
+	return &c.BroadcastClip
+}
+
+func (cs internalBroadcastClips) entities() entity.BroadcastClips {
+	res := make(entity.BroadcastClips, len(cs))
+	for i := range cs {
+		res[i] = cs[i].entity()
+	}
+	return res
+}
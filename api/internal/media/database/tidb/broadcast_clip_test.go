@@ -0,0 +1,464 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBroadcastClip(t *testing.T) {
+	assert.NotNil(t, NewBroadcastClip(nil))
+}
+
+func TestBroadcastClip_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	internal := make(internalBroadcastClips, 3)
+	internal[0] = testBroadcastClip("clip-id01", "broadcast-id", entity.BroadcastClipStatusWaiting, now().Add(-2*time.Minute))
+	internal[1] = testBroadcastClip("clip-id02", "broadcast-id", entity.BroadcastClipStatusProcessing, now().Add(-time.Minute))
+	internal[2] = testBroadcastClip("clip-id03", "broadcast-id", entity.BroadcastClipStatusCompleted, now())
+	err = db.DB.Table(broadcastClipTable).Create(&internal).Error
+	require.NoError(t, err)
+	clips := internal.entities()
+
+	type args struct {
+		params *database.ListBroadcastClipsParams
+	}
+	type want struct {
+		clips entity.BroadcastClips
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success by broadcast id",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListBroadcastClipsParams{
+					BroadcastID: "broadcast-id",
+				},
+			},
+			want: want{
+				clips: clips,
+				err:   nil,
+			},
+		},
+		{
+			name:  "success by statuses",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListBroadcastClipsParams{
+					Statuses: []entity.BroadcastClipStatus{
+						entity.BroadcastClipStatusWaiting,
+						entity.BroadcastClipStatusProcessing,
+					},
+				},
+			},
+			want: want{
+				clips: clips[:2],
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastClip{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.clips, actual)
+		})
+	}
+}
+
+func TestBroadcastClip_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	internal := testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusWaiting, now())
+	err = db.DB.Table(broadcastClipTable).Create(&internal).Error
+	require.NoError(t, err)
+	clip := internal.entity()
+
+	type args struct {
+		clipID string
+	}
+	type want struct {
+		clip *entity.BroadcastClip
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				clipID: "clip-id",
+			},
+			want: want{
+				clip: clip,
+				err:  nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				clipID: "other-id",
+			},
+			want: want{
+				clip: nil,
+				err:  database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastClip{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.clipID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.clip, actual)
+		})
+	}
+}
+
+func TestBroadcastClip_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	type args struct {
+		clip *entity.BroadcastClip
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				clip: testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusWaiting, now()).entity(),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				clip := testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusWaiting, now())
+				err := db.DB.Table(broadcastClipTable).Create(&clip).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				clip: testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusWaiting, now()).entity(),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, broadcastClipTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastClip{db: db, now: now}
+			err = db.Create(ctx, tt.args.clip)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestBroadcastClip_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	type args struct {
+		clipID string
+		params *database.UpdateBroadcastClipParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				clip := testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusProcessing, now())
+				err := db.DB.Table(broadcastClipTable).Create(&clip).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				clipID: "clip-id",
+				params: &database.UpdateBroadcastClipParams{
+					Status:   entity.BroadcastClipStatusCompleted,
+					Progress: 100,
+					VideoID:  "video-id",
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, broadcastClipTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastClip{db: db, now: now}
+			err = db.Update(ctx, tt.args.clipID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestBroadcastClip_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	type args struct {
+		clipID string
+		video  *entity.Video
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				clip := testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusProcessing, now())
+				err := db.DB.Table(broadcastClipTable).Create(&clip).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				clipID: "clip-id",
+				video:  testVideo("video-id", "coordinator-id", []string{"product-id"}, []string{}, now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already completed",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				clip := testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusCompleted, now())
+				err := db.DB.Table(broadcastClipTable).Create(&clip).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				clipID: "clip-id",
+				video:  testVideo("video-id", "coordinator-id", []string{"product-id"}, []string{}, now()),
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, broadcastClipTable, videoProductTable, videoExperienceTable, videoTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastClip{db: db, now: now}
+			err = db.Publish(ctx, tt.args.clipID, tt.args.video)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestBroadcastClip_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	type args struct {
+		clipID string
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				clip := testBroadcastClip("clip-id", "broadcast-id", entity.BroadcastClipStatusWaiting, now())
+				err := db.DB.Table(broadcastClipTable).Create(&clip).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				clipID: "clip-id",
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, broadcastClipTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastClip{db: db, now: now}
+			err = db.Delete(ctx, tt.args.clipID)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testBroadcastClip(
+	clipID, broadcastID string, status entity.BroadcastClipStatus, now time.Time,
+) *internalBroadcastClip {
+	clip := &entity.BroadcastClip{
+		ID:            clipID,
+		BroadcastID:   broadcastID,
+		ScheduleID:    "schedule-id",
+		CoordinatorID: "coordinator-id",
+		Title:         "じゃがいもの収穫",
+		Description:   "じゃがいもの収穫シーンです。",
+		ThumbnailURL:  "https://example.com/thumbnail.png",
+		ProductIDs:    []string{"product-id"},
+		StartOffset:   60,
+		EndOffset:     180,
+		Status:        status,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	return newInternalBroadcastClip(clip)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/media/entity"
 	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
 )
 
 const broadcastCommentTable = "broadcast_comments"
@@ -66,6 +67,36 @@ func (c *broadcastComment) List(
 	return comments, nextToken, nil
 }
 
+func (c *broadcastComment) Count(ctx context.Context, params *database.CountBroadcastCommentsParams) (int64, error) {
+	fn := func(stmt *gorm.DB) *gorm.DB {
+		stmt = stmt.Where("broadcast_id = ?", params.BroadcastID)
+		if params.UserID != "" {
+			stmt = stmt.Where("user_id = ?", params.UserID)
+		}
+		if params.ClientIP != "" {
+			stmt = stmt.Where("client_ip = ?", params.ClientIP)
+		}
+		if !params.CreatedAtGte.IsZero() {
+			stmt = stmt.Where("created_at >= ?", params.CreatedAtGte)
+		}
+		return stmt
+	}
+	total, err := c.db.Count(ctx, c.db.DB, &entity.BroadcastComment{}, fn)
+	return total, dbError(err)
+}
+
+func (c *broadcastComment) Get(ctx context.Context, commentID string, fields ...string) (*entity.BroadcastComment, error) {
+	var comment *entity.BroadcastComment
+
+	stmt := c.db.Statement(ctx, c.db.DB, broadcastCommentTable, fields...).
+		Where("id = ?", commentID)
+
+	if err := stmt.First(&comment).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return comment, nil
+}
+
 func (c *broadcastComment) Create(ctx context.Context, comment *entity.BroadcastComment) error {
 	now := c.now()
 	comment.CreatedAt, comment.UpdatedAt = now, now
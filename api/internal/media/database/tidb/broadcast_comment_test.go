@@ -110,6 +110,160 @@ func TestBroadcastComment_List(t *testing.T) {
 	}
 }
 
+func TestBroadcastComment_Count(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	comments := make(entity.BroadcastComments, 3)
+	comments[0] = testBroadcastComment("comment-id01", "broadcast-id", "user-id", now().Add(-time.Minute))
+	comments[1] = testBroadcastComment("comment-id02", "broadcast-id", "user-id", now())
+	comments[2] = testBroadcastComment("comment-id03", "broadcast-id", "", now())
+	err = db.DB.Create(&comments).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.CountBroadcastCommentsParams
+	}
+	type want struct {
+		total int64
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success by user",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.CountBroadcastCommentsParams{
+					BroadcastID:  "broadcast-id",
+					UserID:       "user-id",
+					CreatedAtGte: now().Add(-time.Second),
+				},
+			},
+			want: want{
+				total: 1,
+				err:   nil,
+			},
+		},
+		{
+			name:  "success by client ip",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.CountBroadcastCommentsParams{
+					BroadcastID: "broadcast-id",
+					ClientIP:    "127.0.0.1",
+				},
+			},
+			want: want{
+				total: 3,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastComment{db: db, now: now}
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestBroadcastComment_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	comment := testBroadcastComment("comment-id", "broadcast-id", "user-id", now())
+	err = db.DB.Create(&comment).Error
+	require.NoError(t, err)
+
+	type args struct {
+		commentID string
+	}
+	type want struct {
+		comment *entity.BroadcastComment
+		err     error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				commentID: "comment-id",
+			},
+			want: want{
+				comment: comment,
+				err:     nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				commentID: "other-id",
+			},
+			want: want{
+				comment: nil,
+				err:     database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &broadcastComment{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.commentID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.comment, actual)
+		})
+	}
+}
+
 func TestBroadcastComment_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -247,6 +401,8 @@ func testBroadcastComment(commentID, broadcastID, userID string, now time.Time)
 		ID:          commentID,
 		BroadcastID: broadcastID,
 		UserID:      userID,
+		SessionID:   "session-id",
+		ClientIP:    "127.0.0.1",
 		Content:     "こんにちは",
 		Disabled:    false,
 		CreatedAt:   now,
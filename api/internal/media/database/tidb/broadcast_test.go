@@ -486,6 +486,31 @@ func TestBroadcast_Update(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "success update caption",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+				err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				broadcastID: "broadcast-id",
+				params: &database.UpdateBroadcastParams{
+					UpdateBroadcastCaptionParams: &database.UpdateBroadcastCaptionParams{
+						CaptionStatus: entity.BroadcastCaptionStatusPublished,
+						ArchiveMetadata: &entity.BroadcastArchiveMetadata{
+							Subtitles: map[string]string{
+								"ja": "http://example.com/caption-ja.vtt",
+								"en": "http://example.com/caption-en.vtt",
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		{
 			name: "success youtube",
 			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
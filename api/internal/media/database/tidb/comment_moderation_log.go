@@ -0,0 +1,73 @@
+package tidb
+
+import (
+	"context"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+)
+
+const commentModerationLogTable = "comment_moderation_logs"
+
+type commentModerationLog struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewCommentModerationLog(db *mysql.Client) database.CommentModerationLog {
+	return &commentModerationLog{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listCommentModerationLogsParams database.ListCommentModerationLogsParams
+
+func (p listCommentModerationLogsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	stmt = stmt.Where("target_type = ? AND target_id = ?", p.TargetType, p.TargetID)
+	return stmt.Order("created_at DESC")
+}
+
+func (p listCommentModerationLogsParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (l *commentModerationLog) List(
+	ctx context.Context, params *database.ListCommentModerationLogsParams, fields ...string,
+) (entity.CommentModerationLogs, error) {
+	var logs entity.CommentModerationLogs
+
+	p := listCommentModerationLogsParams(*params)
+
+	stmt := l.db.Statement(ctx, l.db.DB, commentModerationLogTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	err := stmt.Find(&logs).Error
+	return logs, dbError(err)
+}
+
+func (l *commentModerationLog) Count(ctx context.Context, params *database.ListCommentModerationLogsParams) (int64, error) {
+	p := listCommentModerationLogsParams(*params)
+
+	total, err := l.db.Count(ctx, l.db.DB, &entity.CommentModerationLog{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (l *commentModerationLog) Create(ctx context.Context, log *entity.CommentModerationLog) error {
+	now := l.now()
+	log.CreatedAt, log.UpdatedAt = now, now
+
+	err := l.db.DB.WithContext(ctx).Table(commentModerationLogTable).Create(&log).Error
+	return dbError(err)
+}
@@ -0,0 +1,229 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCommentModerationLog(t *testing.T) {
+	assert.NotNil(t, NewCommentModerationLog(nil))
+}
+
+func TestCommentModerationLog_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	logs := make(entity.CommentModerationLogs, 3)
+	logs[0] = testCommentModerationLog("log-id01", entity.CommentModerationTargetTypeBroadcast, "broadcast-id", now())
+	logs[1] = testCommentModerationLog("log-id02", entity.CommentModerationTargetTypeBroadcast, "broadcast-id", now().Add(-time.Minute))
+	logs[2] = testCommentModerationLog("log-id03", entity.CommentModerationTargetTypeVideo, "broadcast-id", now())
+	err = db.DB.Create(&logs).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListCommentModerationLogsParams
+	}
+	type want struct {
+		logs entity.CommentModerationLogs
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentModerationLogsParams{
+					TargetType: entity.CommentModerationTargetTypeBroadcast,
+					TargetID:   "broadcast-id",
+					Limit:      1,
+					Offset:     1,
+				},
+			},
+			want: want{
+				logs: logs[1:2],
+				err:  nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &commentModerationLog{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.logs, actual)
+		})
+	}
+}
+
+func TestCommentModerationLog_Count(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	logs := make(entity.CommentModerationLogs, 3)
+	logs[0] = testCommentModerationLog("log-id01", entity.CommentModerationTargetTypeBroadcast, "broadcast-id", now())
+	logs[1] = testCommentModerationLog("log-id02", entity.CommentModerationTargetTypeBroadcast, "broadcast-id", now().Add(-time.Minute))
+	logs[2] = testCommentModerationLog("log-id03", entity.CommentModerationTargetTypeVideo, "broadcast-id", now())
+	err = db.DB.Create(&logs).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListCommentModerationLogsParams
+	}
+	type want struct {
+		total int64
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentModerationLogsParams{
+					TargetType: entity.CommentModerationTargetTypeBroadcast,
+					TargetID:   "broadcast-id",
+				},
+			},
+			want: want{
+				total: 2,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &commentModerationLog{db: db, now: now}
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestCommentModerationLog_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	type args struct {
+		log *entity.CommentModerationLog
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				log: testCommentModerationLog("log-id", entity.CommentModerationTargetTypeBroadcast, "broadcast-id", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				log := testCommentModerationLog("log-id", entity.CommentModerationTargetTypeBroadcast, "broadcast-id", now())
+				err := db.DB.Create(&log).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				log: testCommentModerationLog("log-id", entity.CommentModerationTargetTypeBroadcast, "broadcast-id", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, commentModerationLogTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &commentModerationLog{db: db, now: now}
+			err = db.Create(ctx, tt.args.log)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testCommentModerationLog(
+	logID string, targetType entity.CommentModerationTargetType, targetID string, now time.Time,
+) *entity.CommentModerationLog {
+	return &entity.CommentModerationLog{
+		ID:         logID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Action:     entity.CommentModerationActionNgWord,
+		UserID:     "user-id",
+		SessionID:  "session-id",
+		ClientIP:   "127.0.0.1",
+		Content:    "ばかじゃないの",
+		Detail:     "ばか",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
@@ -0,0 +1,84 @@
+package tidb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+)
+
+const commentMuteTable = "comment_mutes"
+
+type commentMute struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewCommentMute(db *mysql.Client) database.CommentMute {
+	return &commentMute{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+func (m *commentMute) List(
+	ctx context.Context, params *database.ListCommentMutesParams, fields ...string,
+) (entity.CommentMutes, error) {
+	var mutes entity.CommentMutes
+
+	stmt := m.db.Statement(ctx, m.db.DB, commentMuteTable, fields...).
+		Where("broadcast_id = ?", params.BroadcastID)
+
+	conds := make([]string, 0, 3)
+	args := make([]interface{}, 0, 3)
+	if params.UserID != "" {
+		conds = append(conds, "user_id = ?")
+		args = append(args, params.UserID)
+	}
+	if params.SessionID != "" {
+		conds = append(conds, "session_id = ?")
+		args = append(args, params.SessionID)
+	}
+	if params.ClientIP != "" {
+		conds = append(conds, "client_ip = ?")
+		args = append(args, params.ClientIP)
+	}
+	if len(conds) > 0 {
+		stmt = stmt.Where("("+strings.Join(conds, " OR ")+")", args...)
+	}
+	stmt = stmt.Order("created_at DESC")
+
+	err := stmt.Find(&mutes).Error
+	return mutes, dbError(err)
+}
+
+func (m *commentMute) Get(ctx context.Context, muteID string, fields ...string) (*entity.CommentMute, error) {
+	var mute *entity.CommentMute
+
+	stmt := m.db.Statement(ctx, m.db.DB, commentMuteTable, fields...).
+		Where("id = ?", muteID)
+
+	if err := stmt.First(&mute).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return mute, nil
+}
+
+func (m *commentMute) Create(ctx context.Context, mute *entity.CommentMute) error {
+	now := m.now()
+	mute.CreatedAt, mute.UpdatedAt = now, now
+
+	err := m.db.DB.WithContext(ctx).Table(commentMuteTable).Create(&mute).Error
+	return dbError(err)
+}
+
+func (m *commentMute) Delete(ctx context.Context, muteID string) error {
+	stmt := m.db.DB.WithContext(ctx).Table(commentMuteTable).Where("id = ?", muteID)
+
+	err := stmt.Delete(&entity.CommentMute{}).Error
+	return dbError(err)
+}
@@ -0,0 +1,343 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCommentMute(t *testing.T) {
+	assert.NotNil(t, NewCommentMute(nil))
+}
+
+func TestCommentMute_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	mutes := make(entity.CommentMutes, 3)
+	mutes[0] = testCommentMute("mute-id01", "broadcast-id", "user-id", "", now())
+	mutes[1] = testCommentMute("mute-id02", "broadcast-id", "", "session-id", now().Add(-time.Minute))
+	mutes[2] = testCommentMute("mute-id03", "broadcast-id", "other-id", "", now().Add(-2*time.Minute))
+	mutes[1].ClientIP = "192.0.2.1"
+	err = db.DB.Create(&mutes).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListCommentMutesParams
+	}
+	type want struct {
+		mutes entity.CommentMutes
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success all",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentMutesParams{
+					BroadcastID: "broadcast-id",
+				},
+			},
+			want: want{
+				mutes: mutes,
+				err:   nil,
+			},
+		},
+		{
+			name:  "success by user and session",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentMutesParams{
+					BroadcastID: "broadcast-id",
+					UserID:      "user-id",
+					SessionID:   "session-id",
+				},
+			},
+			want: want{
+				mutes: mutes[:2],
+				err:   nil,
+			},
+		},
+		{
+			name:  "success by session",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentMutesParams{
+					BroadcastID: "broadcast-id",
+					SessionID:   "session-id",
+				},
+			},
+			want: want{
+				mutes: mutes[1:2],
+				err:   nil,
+			},
+		},
+		{
+			name:  "success by client ip",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentMutesParams{
+					BroadcastID: "broadcast-id",
+					SessionID:   "other-session-id",
+					ClientIP:    "192.0.2.1",
+				},
+			},
+			want: want{
+				mutes: mutes[1:2],
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &commentMute{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.mutes, actual)
+		})
+	}
+}
+
+func TestCommentMute_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	mute := testCommentMute("mute-id", "broadcast-id", "user-id", "", now())
+	err = db.DB.Create(&mute).Error
+	require.NoError(t, err)
+
+	type args struct {
+		muteID string
+	}
+	type want struct {
+		mute *entity.CommentMute
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				muteID: "mute-id",
+			},
+			want: want{
+				mute: mute,
+				err:  nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				muteID: "other-id",
+			},
+			want: want{
+				mute: nil,
+				err:  database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &commentMute{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.muteID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.mute, actual)
+		})
+	}
+}
+
+func TestCommentMute_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	type args struct {
+		mute *entity.CommentMute
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				mute: testCommentMute("mute-id", "broadcast-id", "user-id", "", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				mute := testCommentMute("mute-id", "broadcast-id", "user-id", "", now())
+				err := db.DB.Create(&mute).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				mute: testCommentMute("mute-id", "broadcast-id", "user-id", "", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, commentMuteTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &commentMute{db: db, now: now}
+			err = db.Create(ctx, tt.args.mute)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestCommentMute_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	broadcast := testBroadcast("broadcast-id", "schedule-id", "coordinator-id", now())
+	err = db.DB.Table(broadcastTable).Create(&broadcast).Error
+	require.NoError(t, err)
+
+	type args struct {
+		muteID string
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				mute := testCommentMute("mute-id", "broadcast-id", "user-id", "", now())
+				err := db.DB.Create(&mute).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				muteID: "mute-id",
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, commentMuteTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &commentMute{db: db, now: now}
+			err = db.Delete(ctx, tt.args.muteID)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testCommentMute(muteID, broadcastID, userID, sessionID string, now time.Time) *entity.CommentMute {
+	return &entity.CommentMute{
+		ID:          muteID,
+		BroadcastID: broadcastID,
+		UserID:      userID,
+		SessionID:   sessionID,
+		Type:        entity.CommentMuteTypeMute,
+		Reason:      "荒らし行為",
+		AdminID:     "admin-id",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
@@ -0,0 +1,94 @@
+package tidb
+
+import (
+	"context"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+)
+
+const commentNgWordTable = "comment_ng_words"
+
+type commentNgWord struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewCommentNgWord(db *mysql.Client) database.CommentNgWord {
+	return &commentNgWord{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listCommentNgWordsParams database.ListCommentNgWordsParams
+
+func (p listCommentNgWordsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.CoordinatorID != "" {
+		stmt = stmt.Where("coordinator_id = ?", p.CoordinatorID)
+	}
+	return stmt.Order("created_at ASC")
+}
+
+func (p listCommentNgWordsParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (w *commentNgWord) List(
+	ctx context.Context, params *database.ListCommentNgWordsParams, fields ...string,
+) (entity.CommentNgWords, error) {
+	var words entity.CommentNgWords
+
+	p := listCommentNgWordsParams(*params)
+
+	stmt := w.db.Statement(ctx, w.db.DB, commentNgWordTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	err := stmt.Find(&words).Error
+	return words, dbError(err)
+}
+
+func (w *commentNgWord) Count(ctx context.Context, params *database.ListCommentNgWordsParams) (int64, error) {
+	p := listCommentNgWordsParams(*params)
+
+	total, err := w.db.Count(ctx, w.db.DB, &entity.CommentNgWord{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (w *commentNgWord) Get(ctx context.Context, ngWordID string, fields ...string) (*entity.CommentNgWord, error) {
+	var word *entity.CommentNgWord
+
+	stmt := w.db.Statement(ctx, w.db.DB, commentNgWordTable, fields...).
+		Where("id = ?", ngWordID)
+
+	if err := stmt.First(&word).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return word, nil
+}
+
+func (w *commentNgWord) Create(ctx context.Context, word *entity.CommentNgWord) error {
+	now := w.now()
+	word.CreatedAt, word.UpdatedAt = now, now
+
+	err := w.db.DB.WithContext(ctx).Table(commentNgWordTable).Create(&word).Error
+	return dbError(err)
+}
+
+func (w *commentNgWord) Delete(ctx context.Context, ngWordID string) error {
+	stmt := w.db.DB.WithContext(ctx).Table(commentNgWordTable).Where("id = ?", ngWordID)
+
+	err := stmt.Delete(&entity.CommentNgWord{}).Error
+	return dbError(err)
+}
@@ -0,0 +1,356 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCommentNgWord(t *testing.T) {
+	assert.NotNil(t, NewCommentNgWord(nil))
+}
+
+func TestCommentNgWord_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	words := make(entity.CommentNgWords, 3)
+	words[0] = testCommentNgWord("word-id01", "coordinator-id", "ばか", now().Add(-time.Minute))
+	words[1] = testCommentNgWord("word-id02", "coordinator-id", "あほ", now())
+	words[2] = testCommentNgWord("word-id03", "other-id", "ばか", now())
+	err = db.DB.Create(&words).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListCommentNgWordsParams
+	}
+	type want struct {
+		words entity.CommentNgWords
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentNgWordsParams{
+					CoordinatorID: "coordinator-id",
+					Limit:         1,
+					Offset:        1,
+				},
+			},
+			want: want{
+				words: words[1:2],
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &commentNgWord{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.words, actual)
+		})
+	}
+}
+
+func TestCommentNgWord_Count(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	words := make(entity.CommentNgWords, 3)
+	words[0] = testCommentNgWord("word-id01", "coordinator-id", "ばか", now().Add(-time.Minute))
+	words[1] = testCommentNgWord("word-id02", "coordinator-id", "あほ", now())
+	words[2] = testCommentNgWord("word-id03", "other-id", "ばか", now())
+	err = db.DB.Create(&words).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListCommentNgWordsParams
+	}
+	type want struct {
+		total int64
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListCommentNgWordsParams{
+					CoordinatorID: "coordinator-id",
+				},
+			},
+			want: want{
+				total: 2,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &commentNgWord{db: db, now: now}
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestCommentNgWord_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	word := testCommentNgWord("word-id", "coordinator-id", "ばか", now())
+	err = db.DB.Create(&word).Error
+	require.NoError(t, err)
+
+	type args struct {
+		ngWordID string
+	}
+	type want struct {
+		word *entity.CommentNgWord
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				ngWordID: "word-id",
+			},
+			want: want{
+				word: word,
+				err:  nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				ngWordID: "other-id",
+			},
+			want: want{
+				word: nil,
+				err:  database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &commentNgWord{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.ngWordID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.word, actual)
+		})
+	}
+}
+
+func TestCommentNgWord_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	type args struct {
+		word *entity.CommentNgWord
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				word: testCommentNgWord("word-id", "coordinator-id", "ばか", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				word := testCommentNgWord("word-id", "coordinator-id", "ばか", now())
+				err := db.DB.Create(&word).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				word: testCommentNgWord("word-id", "coordinator-id", "ばか", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name: "duplicate word",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				word := testCommentNgWord("other-id", "coordinator-id", "ばか", now())
+				err := db.DB.Create(&word).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				word: testCommentNgWord("word-id", "coordinator-id", "ばか", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, commentNgWordTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &commentNgWord{db: db, now: now}
+			err = db.Create(ctx, tt.args.word)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestCommentNgWord_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	type args struct {
+		ngWordID string
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				word := testCommentNgWord("word-id", "coordinator-id", "ばか", now())
+				err := db.DB.Create(&word).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				ngWordID: "word-id",
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, commentNgWordTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &commentNgWord{db: db, now: now}
+			err = db.Delete(ctx, tt.args.ngWordID)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testCommentNgWord(ngWordID, coordinatorID, word string, now time.Time) *entity.CommentNgWord {
+	return &entity.CommentNgWord{
+		ID:            ngWordID,
+		CoordinatorID: coordinatorID,
+		Word:          word,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
@@ -13,12 +13,16 @@ import (
 
 func NewDatabase(db *apmysql.Client) *database.Database {
 	return &database.Database{
-		Broadcast:          NewBroadcast(db),
-		BroadcastComment:   NewBroadcastComment(db),
-		BroadcastViewerLog: NewBroadcastViewerLog(db),
-		Video:              NewVideo(db),
-		VideoComment:       NewVideoComment(db),
-		VideoViewerLog:     NewVideoViewerLog(db),
+		Broadcast:            NewBroadcast(db),
+		BroadcastComment:     NewBroadcastComment(db),
+		BroadcastViewerLog:   NewBroadcastViewerLog(db),
+		BroadcastClip:        NewBroadcastClip(db),
+		Video:                NewVideo(db),
+		VideoComment:         NewVideoComment(db),
+		VideoViewerLog:       NewVideoViewerLog(db),
+		CommentNgWord:        NewCommentNgWord(db),
+		CommentMute:          NewCommentMute(db),
+		CommentModerationLog: NewCommentModerationLog(db),
 	}
 }
 
@@ -113,6 +113,10 @@ func schemaDir() (string, error) {
 func deleteAll(ctx context.Context) error {
 	tables := []string{
 		// テストに対応したテーブルから追記(削除順)
+		commentModerationLogTable,
+		commentMuteTable,
+		commentNgWordTable,
+		broadcastClipTable,
 		broadcastViewerLogTable,
 		broadcastCommentTable,
 		broadcastTable,
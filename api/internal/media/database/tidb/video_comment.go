@@ -9,6 +9,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/media/database"
 	"github.com/and-period/furumaru/api/internal/media/entity"
 	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
 )
 
 const videoCommentTable = "video_comments"
@@ -65,6 +66,24 @@ func (c *videoComment) List(
 	return comments, nextToken, nil
 }
 
+func (c *videoComment) Count(ctx context.Context, params *database.CountVideoCommentsParams) (int64, error) {
+	fn := func(stmt *gorm.DB) *gorm.DB {
+		stmt = stmt.Where("video_id = ?", params.VideoID)
+		if params.UserID != "" {
+			stmt = stmt.Where("user_id = ?", params.UserID)
+		}
+		if params.ClientIP != "" {
+			stmt = stmt.Where("client_ip = ?", params.ClientIP)
+		}
+		if !params.CreatedAtGte.IsZero() {
+			stmt = stmt.Where("created_at >= ?", params.CreatedAtGte)
+		}
+		return stmt
+	}
+	total, err := c.db.Count(ctx, c.db.DB, &entity.VideoComment{}, fn)
+	return total, dbError(err)
+}
+
 func (c *videoComment) Create(ctx context.Context, comment *entity.VideoComment) error {
 	now := c.now()
 	comment.CreatedAt, comment.UpdatedAt = now, now
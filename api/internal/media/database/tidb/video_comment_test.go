@@ -110,6 +110,88 @@ func TestVideoComment_List(t *testing.T) {
 	}
 }
 
+func TestVideoComment_Count(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	video := testVideo("video-id", "coordinator-id", []string{"product-id"}, []string{"experience-id"}, now())
+	err = db.DB.Create(&video).Error
+	require.NoError(t, err)
+
+	comments := make(entity.VideoComments, 3)
+	comments[0] = testVideoComment("comment-id01", "video-id", "user-id", now().Add(-time.Minute))
+	comments[1] = testVideoComment("comment-id02", "video-id", "user-id", now())
+	comments[2] = testVideoComment("comment-id03", "video-id", "", now())
+	err = db.DB.Create(&comments).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.CountVideoCommentsParams
+	}
+	type want struct {
+		total int64
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success by user",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.CountVideoCommentsParams{
+					VideoID:      "video-id",
+					UserID:       "user-id",
+					CreatedAtGte: now().Add(-time.Second),
+				},
+			},
+			want: want{
+				total: 1,
+				err:   nil,
+			},
+		},
+		{
+			name:  "success by client ip",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.CountVideoCommentsParams{
+					VideoID:  "video-id",
+					ClientIP: "127.0.0.1",
+				},
+			},
+			want: want{
+				total: 3,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			tt.setup(ctx, t, db)
+
+			db := &videoComment{db: db, now: now}
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
 func TestVideoComment_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -246,6 +328,8 @@ func testVideoComment(commentID, videoID, userID string, now time.Time) *entity.
 		ID:        commentID,
 		VideoID:   videoID,
 		UserID:    userID,
+		SessionID: "session-id",
+		ClientIP:  "127.0.0.1",
 		Content:   "とても面白いですね",
 		Disabled:  false,
 		CreatedAt: now,
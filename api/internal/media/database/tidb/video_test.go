@@ -584,6 +584,7 @@ func testVideo(videoID, coordinatorID string, productIDs, experienceIDs []string
 		Status:           entity.VideoStatusPublished,
 		ThumbnailURL:     "https://example.com/thumbnail.jpg",
 		VideoURL:         "https://example.com/video.mp4",
+		Captions:         map[string]string{entity.BroadcastCaptionLanguageJapanese: "https://example.com/caption-ja.vtt"},
 		Public:           true,
 		Limited:          false,
 		VideoProducts:    products,
@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"fmt"
+	"maps"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/and-period/furumaru/api/pkg/set"
@@ -27,6 +31,28 @@ const (
 	BroadcastStatusActive   BroadcastStatus = 4 // 配信中
 )
 
+// BroadcastCaptionStatus - アーカイブ字幕の生成状況
+type BroadcastCaptionStatus int32
+
+const (
+	BroadcastCaptionStatusNone       BroadcastCaptionStatus = 0 // 未生成
+	BroadcastCaptionStatusProcessing BroadcastCaptionStatus = 1 // 生成中
+	BroadcastCaptionStatusPublished  BroadcastCaptionStatus = 2 // 公開済み
+	BroadcastCaptionStatusFailed     BroadcastCaptionStatus = 3 // 生成失敗
+)
+
+// アーカイブ字幕の言語コード
+const (
+	BroadcastCaptionLanguageJapanese = "ja" // 日本語
+	BroadcastCaptionLanguageEnglish  = "en" // 英語
+)
+
+// BroadcastCaptionLanguages - アーカイブ字幕の対応言語（表示順）
+var BroadcastCaptionLanguages = []string{
+	BroadcastCaptionLanguageJapanese,
+	BroadcastCaptionLanguageEnglish,
+}
+
 // Broadcast - ライブ配信情報
 type Broadcast struct {
 	ID                        string                    `gorm:"primaryKey;<-:create"` // ライブ配信ID
@@ -39,6 +65,9 @@ type Broadcast struct {
 	ArchiveURL                string                    `gorm:""`                     // アーカイブ配信URL
 	ArchiveFixed              bool                      `gorm:""`                     // アーカイブ映像を編集したか
 	ArchiveMetadata           *BroadcastArchiveMetadata `gorm:"-"`                    // アーカイブメタデータ
+	CaptionStatus             BroadcastCaptionStatus    `gorm:""`                     // アーカイブ字幕の生成状況
+	StartedAt                 time.Time                 `gorm:"default:null"`         // 配信開始日時(実績)
+	EndedAt                   time.Time                 `gorm:"default:null"`         // 配信終了日時(実績)
 	CloudFrontDistributionArn string                    `gorm:"default:null"`         // CloudFrontディストリビューションARN
 	MediaLiveChannelArn       string                    `gorm:"default:null"`         // MediaLiveチャンネルARN
 	MediaLiveChannelID        string                    `gorm:"default:null"`         // MediaLiveチャンネルID
@@ -63,6 +92,13 @@ type BroadcastArchiveMetadata struct {
 	Subtitles map[string]string `json:"subtitles"` // 字幕テキスト（key：言語コード,value：ファイル参照先URL）
 }
 
+// BroadcastCaption - アーカイブ字幕
+type BroadcastCaption struct {
+	Language string // 言語コード
+	URL      string // 字幕ファイル(WebVTT)URL
+	Content  []byte // 字幕ファイル(WebVTT)
+}
+
 type NewBroadcastParams struct {
 	ScheduleID    string
 	CoordinatorID string
@@ -83,3 +119,54 @@ func (bs Broadcasts) ScheduleIDs() []string {
 		return b.ScheduleID
 	})
 }
+
+// ArchiveKey - アーカイブ動画のオブジェクトキー
+func (b *Broadcast) ArchiveKey() (string, error) {
+	u, err := url.Parse(b.ArchiveURL)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(u.Path, "/"), nil
+}
+
+// ArchiveDuration - アーカイブ動画の長さ（編集済み・配信実績がない場合は不明として0を返す）
+func (b *Broadcast) ArchiveDuration() time.Duration {
+	if b.ArchiveFixed || b.StartedAt.IsZero() || b.EndedAt.IsZero() || !b.EndedAt.After(b.StartedAt) {
+		return 0
+	}
+	return b.EndedAt.Sub(b.StartedAt)
+}
+
+// CaptionURL - 指定した言語の字幕ファイルURL
+func (b *Broadcast) CaptionURL(language string) (string, bool) {
+	if b.ArchiveMetadata == nil {
+		return "", false
+	}
+	captionURL, ok := b.ArchiveMetadata.Subtitles[language]
+	return captionURL, ok && captionURL != ""
+}
+
+// HasCaptions - 字幕ファイルが登録済みか
+func (b *Broadcast) HasCaptions() bool {
+	return b.ArchiveMetadata != nil && len(b.ArchiveMetadata.Subtitles) > 0
+}
+
+// NewCaptionMetadata - 字幕ファイルURLを差し替えたアーカイブメタデータを生成
+func (b *Broadcast) NewCaptionMetadata(captions map[string]string) *BroadcastArchiveMetadata {
+	subtitles := make(map[string]string, len(captions))
+	if b.ArchiveMetadata != nil {
+		maps.Copy(subtitles, b.ArchiveMetadata.Subtitles)
+	}
+	maps.Copy(subtitles, captions)
+	return &BroadcastArchiveMetadata{Subtitles: subtitles}
+}
+
+// NewBroadcastTranscriptionKey - 文字起こし結果の出力先オブジェクトキー（拡張子なし）
+func NewBroadcastTranscriptionKey(scheduleID string) string {
+	return fmt.Sprintf(BroadcastArchiveTextPath, scheduleID) + "/transcription-" + BroadcastCaptionLanguageJapanese
+}
+
+// NewBroadcastCaptionKey - 公開用字幕ファイルのオブジェクトキー（CDNのキャッシュを避けるため更新ごとに別名とする）
+func NewBroadcastCaptionKey(scheduleID, language string, now time.Time) string {
+	return fmt.Sprintf("%s/caption-%s-%s.vtt", fmt.Sprintf(BroadcastArchiveTextPath, scheduleID), language, now.Format("20060102150405"))
+}
@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+// BroadcastClipStatus - アーカイブ切り抜き動画の生成状況
+type BroadcastClipStatus int32
+
+const (
+	BroadcastClipStatusUnknown    BroadcastClipStatus = 0
+	BroadcastClipStatusWaiting    BroadcastClipStatus = 1 // 変換待ち
+	BroadcastClipStatusProcessing BroadcastClipStatus = 2 // 変換中
+	BroadcastClipStatusCompleted  BroadcastClipStatus = 3 // 生成完了
+	BroadcastClipStatusFailed     BroadcastClipStatus = 4 // 生成失敗
+)
+
+// BroadcastClip - ライブ配信アーカイブの切り抜き動画
+type BroadcastClip struct {
+	ID            string              `gorm:"primaryKey;<-:create"` // 切り抜き動画ID
+	BroadcastID   string              `gorm:""`                     // ライブ配信ID
+	ScheduleID    string              `gorm:""`                     // 開催スケジュールID
+	CoordinatorID string              `gorm:""`                     // コーディネータID
+	Title         string              `gorm:""`                     // タイトル
+	Description   string              `gorm:""`                     // 説明
+	ThumbnailURL  string              `gorm:""`                     // サムネイルURL
+	ProductIDs    []string            `gorm:"-"`                    // 紐付ける商品ID一覧
+	StartOffset   int64               `gorm:""`                     // 切り抜き開始位置(アーカイブ先頭からの経過秒数)
+	EndOffset     int64               `gorm:""`                     // 切り抜き終了位置(アーカイブ先頭からの経過秒数)
+	Status        BroadcastClipStatus `gorm:""`                     // 生成状況
+	JobID         string              `gorm:"default:null"`         // 動画変換ジョブID
+	Progress      int64               `gorm:""`                     // 動画変換の進捗率(%)
+	VideoID       string              `gorm:"default:null"`         // 生成したオンデマンド動画ID
+	ErrorMessage  string              `gorm:""`                     // 生成失敗時のエラー内容
+	CreatedAt     time.Time           `gorm:"<-:create"`            // 登録日時
+	UpdatedAt     time.Time           `gorm:""`                     // 更新日時
+}
+
+type BroadcastClips []*BroadcastClip
+
+type NewBroadcastClipParams struct {
+	Broadcast    *Broadcast
+	Title        string
+	Description  string
+	ThumbnailURL string
+	ProductIDs   []string
+	StartOffset  int64
+	EndOffset    int64
+}
+
+func NewBroadcastClip(params *NewBroadcastClipParams) *BroadcastClip {
+	return &BroadcastClip{
+		ID:            uuid.Base58Encode(uuid.New()),
+		BroadcastID:   params.Broadcast.ID,
+		ScheduleID:    params.Broadcast.ScheduleID,
+		CoordinatorID: params.Broadcast.CoordinatorID,
+		Title:         params.Title,
+		Description:   params.Description,
+		ThumbnailURL:  params.ThumbnailURL,
+		ProductIDs:    params.ProductIDs,
+		StartOffset:   params.StartOffset,
+		EndOffset:     params.EndOffset,
+		Status:        BroadcastClipStatusWaiting,
+	}
+}
+
+// Period - 切り抜き範囲の日時（アーカイブ先頭となる配信開始日時(実績)を基準とする）
+func (c *BroadcastClip) Period(startAt time.Time) (time.Time, time.Time) {
+	return startAt.Add(time.Duration(c.StartOffset) * time.Second), startAt.Add(time.Duration(c.EndOffset) * time.Second)
+}
+
+// StartTimecode - 切り抜き開始位置のタイムコード(HH:MM:SS:FF)
+func (c *BroadcastClip) StartTimecode() string {
+	return newBroadcastClipTimecode(c.StartOffset)
+}
+
+// EndTimecode - 切り抜き終了位置のタイムコード(HH:MM:SS:FF)
+func (c *BroadcastClip) EndTimecode() string {
+	return newBroadcastClipTimecode(c.EndOffset)
+}
+
+// OutputPath - 切り抜き動画の出力先ディレクトリ
+func (c *BroadcastClip) OutputPath() string {
+	return fmt.Sprintf(BroadcastArchiveClipPath, c.ScheduleID) + "/" + c.ID
+}
+
+// CaptionKey - 切り抜き動画用字幕ファイルのオブジェクトキー
+func (c *BroadcastClip) CaptionKey(language string) string {
+	return fmt.Sprintf("%s/caption-%s.vtt", c.OutputPath(), language)
+}
+
+// Deletable - 削除可能か（変換中・生成済みの切り抜きは削除させない）
+func (c *BroadcastClip) Deletable() bool {
+	return c.Status == BroadcastClipStatusWaiting || c.Status == BroadcastClipStatusFailed
+}
+
+// NewVideoParams - 切り抜き動画をオンデマンド配信として登録するためのパラメータ（非公開で登録する）
+func (c *BroadcastClip) NewVideoParams(videoURL string) *NewVideoParams {
+	return &NewVideoParams{
+		CoordinatorID:  c.CoordinatorID,
+		ProductIDs:     c.ProductIDs,
+		ExperienceIDs:  []string{},
+		Title:          c.Title,
+		Description:    c.Description,
+		ThumbnailURL:   c.ThumbnailURL,
+		VideoURL:       videoURL,
+		Public:         false,
+		Limited:        false,
+		DisplayProduct: len(c.ProductIDs) > 0,
+	}
+}
+
+func newBroadcastClipTimecode(offset int64) string {
+	if offset < 0 {
+		offset = 0
+	}
+	return fmt.Sprintf("%02d:%02d:%02d:00", offset/3600, offset%3600/60, offset%60)
+}
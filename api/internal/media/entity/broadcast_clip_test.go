@@ -0,0 +1,196 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcastClip(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewBroadcastClipParams
+		expect *BroadcastClip
+	}{
+		{
+			name: "success",
+			params: &NewBroadcastClipParams{
+				Broadcast: &Broadcast{
+					ID:            "broadcast-id",
+					ScheduleID:    "schedule-id",
+					CoordinatorID: "coordinator-id",
+				},
+				Title:        "じゃがいもの収穫",
+				Description:  "じゃがいもの収穫シーンです。",
+				ThumbnailURL: "https://example.com/thumbnail.png",
+				ProductIDs:   []string{"product-id"},
+				StartOffset:  60,
+				EndOffset:    180,
+			},
+			expect: &BroadcastClip{
+				BroadcastID:   "broadcast-id",
+				ScheduleID:    "schedule-id",
+				CoordinatorID: "coordinator-id",
+				Title:         "じゃがいもの収穫",
+				Description:   "じゃがいもの収穫シーンです。",
+				ThumbnailURL:  "https://example.com/thumbnail.png",
+				ProductIDs:    []string{"product-id"},
+				StartOffset:   60,
+				EndOffset:     180,
+				Status:        BroadcastClipStatusWaiting,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewBroadcastClip(tt.params)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestBroadcastClip_Period(t *testing.T) {
+	t.Parallel()
+	startAt := jst.Date(2022, 1, 1, 18, 0, 0, 0)
+	tests := []struct {
+		name        string
+		clip        *BroadcastClip
+		startAt     time.Time
+		expectStart time.Time
+		expectEnd   time.Time
+	}{
+		{
+			name:        "success",
+			clip:        &BroadcastClip{StartOffset: 90, EndOffset: 3600},
+			startAt:     startAt,
+			expectStart: jst.Date(2022, 1, 1, 18, 1, 30, 0),
+			expectEnd:   jst.Date(2022, 1, 1, 19, 0, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			start, end := tt.clip.Period(tt.startAt)
+			assert.Equal(t, tt.expectStart, start)
+			assert.Equal(t, tt.expectEnd, end)
+		})
+	}
+}
+
+func TestBroadcastClip_Timecode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		clip        *BroadcastClip
+		expectStart string
+		expectEnd   string
+	}{
+		{
+			name:        "success",
+			clip:        &BroadcastClip{StartOffset: 75, EndOffset: 3723},
+			expectStart: "00:01:15:00",
+			expectEnd:   "01:02:03:00",
+		},
+		{
+			name:        "negative offset",
+			clip:        &BroadcastClip{StartOffset: -1, EndOffset: 0},
+			expectStart: "00:00:00:00",
+			expectEnd:   "00:00:00:00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectStart, tt.clip.StartTimecode())
+			assert.Equal(t, tt.expectEnd, tt.clip.EndTimecode())
+		})
+	}
+}
+
+func TestBroadcastClip_OutputPath(t *testing.T) {
+	t.Parallel()
+	clip := &BroadcastClip{ID: "clip-id", ScheduleID: "schedule-id"}
+	assert.Equal(t, "schedules/archives/schedule-id/clips/clip-id", clip.OutputPath())
+	assert.Equal(t, "schedules/archives/schedule-id/clips/clip-id/caption-en.vtt", clip.CaptionKey("en"))
+}
+
+func TestBroadcastClip_Deletable(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status BroadcastClipStatus
+		expect bool
+	}{
+		{name: "waiting", status: BroadcastClipStatusWaiting, expect: true},
+		{name: "processing", status: BroadcastClipStatusProcessing, expect: false},
+		{name: "completed", status: BroadcastClipStatusCompleted, expect: false},
+		{name: "failed", status: BroadcastClipStatusFailed, expect: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clip := &BroadcastClip{Status: tt.status}
+			assert.Equal(t, tt.expect, clip.Deletable())
+		})
+	}
+}
+
+func TestBroadcastClip_NewVideoParams(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		clip     *BroadcastClip
+		videoURL string
+		expect   *NewVideoParams
+	}{
+		{
+			name: "with products",
+			clip: &BroadcastClip{
+				CoordinatorID: "coordinator-id",
+				Title:         "じゃがいもの収穫",
+				Description:   "じゃがいもの収穫シーンです。",
+				ThumbnailURL:  "https://example.com/thumbnail.png",
+				ProductIDs:    []string{"product-id"},
+			},
+			videoURL: "https://example.com/clip.mp4",
+			expect: &NewVideoParams{
+				CoordinatorID:  "coordinator-id",
+				ProductIDs:     []string{"product-id"},
+				ExperienceIDs:  []string{},
+				Title:          "じゃがいもの収穫",
+				Description:    "じゃがいもの収穫シーンです。",
+				ThumbnailURL:   "https://example.com/thumbnail.png",
+				VideoURL:       "https://example.com/clip.mp4",
+				Public:         false,
+				Limited:        false,
+				DisplayProduct: true,
+			},
+		},
+		{
+			name: "without products",
+			clip: &BroadcastClip{
+				CoordinatorID: "coordinator-id",
+				Title:         "じゃがいもの収穫",
+			},
+			videoURL: "https://example.com/clip.mp4",
+			expect: &NewVideoParams{
+				CoordinatorID:  "coordinator-id",
+				ExperienceIDs:  []string{},
+				Title:          "じゃがいもの収穫",
+				VideoURL:       "https://example.com/clip.mp4",
+				DisplayProduct: false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.clip.NewVideoParams(tt.videoURL))
+		})
+	}
+}
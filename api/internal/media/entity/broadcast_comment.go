@@ -12,6 +12,8 @@ type BroadcastComment struct {
 	ID          string    `gorm:"primaryKey;<-:create"` // コメントID
 	BroadcastID string    `gorm:""`                     // ライブ配信ID
 	UserID      string    `gorm:""`                     // ユーザーID
+	SessionID   string    `gorm:""`                     // セッションID(ゲスト識別用)
+	ClientIP    string    `gorm:""`                     // 投稿元IPアドレス
 	Content     string    `gorm:""`                     // コメント内容
 	Disabled    bool      `gorm:""`                     // コメント無効フラグ
 	CreatedAt   time.Time `gorm:"<-:create"`            // 登録日時
@@ -23,7 +25,10 @@ type BroadcastComments []*BroadcastComment
 type BroadcastCommentParams struct {
 	BroadcastID string
 	UserID      string
+	SessionID   string
+	ClientIP    string
 	Content     string
+	Disabled    bool
 }
 
 func NewBroadcastComment(params *BroadcastCommentParams) *BroadcastComment {
@@ -31,8 +36,10 @@ func NewBroadcastComment(params *BroadcastCommentParams) *BroadcastComment {
 		ID:          uuid.Base58Encode(uuid.New()),
 		BroadcastID: params.BroadcastID,
 		UserID:      params.UserID,
+		SessionID:   params.SessionID,
+		ClientIP:    params.ClientIP,
 		Content:     params.Content,
-		Disabled:    false,
+		Disabled:    params.Disabled,
 	}
 }
 
@@ -18,16 +18,38 @@ func TestBroadcastComment(t *testing.T) {
 			params: &BroadcastCommentParams{
 				BroadcastID: "broadcast-id",
 				UserID:      "user-id",
+				SessionID:   "session-id",
+				ClientIP:    "127.0.0.1",
 				Content:     "こんにちは",
 			},
 			expect: &BroadcastComment{
 				ID:          "",
 				BroadcastID: "broadcast-id",
 				UserID:      "user-id",
+				SessionID:   "session-id",
+				ClientIP:    "127.0.0.1",
 				Content:     "こんにちは",
 				Disabled:    false,
 			},
 		},
+		{
+			name: "success with disabled",
+			params: &BroadcastCommentParams{
+				BroadcastID: "broadcast-id",
+				SessionID:   "session-id",
+				ClientIP:    "127.0.0.1",
+				Content:     "こんにちは",
+				Disabled:    true,
+			},
+			expect: &BroadcastComment{
+				ID:          "",
+				BroadcastID: "broadcast-id",
+				SessionID:   "session-id",
+				ClientIP:    "127.0.0.1",
+				Content:     "こんにちは",
+				Disabled:    true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
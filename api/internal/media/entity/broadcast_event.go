@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/pubsub"
+)
+
+// BroadcastEventType - ライブ配信イベント種別
+type BroadcastEventType int32
+
+const (
+	BroadcastEventTypeUnknown         BroadcastEventType = 0
+	BroadcastEventTypeCommentCreated  BroadcastEventType = 1 // コメント投稿
+	BroadcastEventTypeCommentDisabled BroadcastEventType = 2 // コメント非表示
+	BroadcastEventTypeViewerCount     BroadcastEventType = 3 // 視聴者数更新
+	BroadcastEventTypeProductPinned   BroadcastEventType = 4 // 商品ピン留め
+	BroadcastEventTypeProductUnpinned BroadcastEventType = 5 // 商品ピン留め解除
+)
+
+// BroadcastEvent - ライブ配信中に視聴者へ配信するイベント
+type BroadcastEvent struct {
+	ID         string                 `json:"-"`                 // イベントID(再接続時のカーソル)
+	ScheduleID string                 `json:"scheduleId"`        // 開催スケジュールID
+	Type       BroadcastEventType     `json:"type"`              // イベント種別
+	Comment    *BroadcastEventComment `json:"comment,omitempty"` // コメント情報
+	Viewer     *BroadcastEventViewer  `json:"viewer,omitempty"`  // 視聴者情報
+	Pin        *BroadcastEventPin     `json:"pin,omitempty"`     // 商品ピン留め情報
+	CreatedAt  time.Time              `json:"createdAt"`         // 発生日時
+}
+
+type BroadcastEventComment struct {
+	CommentID string `json:"commentId"` // コメントID
+	UserID    string `json:"userId"`    // ユーザーID
+	Content   string `json:"content"`   // コメント内容
+}
+
+type BroadcastEventViewer struct {
+	Total int64 `json:"total"` // 視聴者数
+}
+
+type BroadcastEventPin struct {
+	LivePinID string `json:"livePinId"` // ピン留めID
+	ProductID string `json:"productId"` // 商品ID
+	Offset    int64  `json:"offset"`    // 配信開始からの経過秒数
+}
+
+type NewBroadcastPinEventParams struct {
+	ScheduleID string
+	LivePinID  string
+	ProductID  string
+	Pinned     bool
+	Offset     int64
+	Now        time.Time
+}
+
+// BroadcastEventTopic - イベント配信先のトピック名
+func BroadcastEventTopic(scheduleID string) string {
+	return fmt.Sprintf("broadcast-events:%s", scheduleID)
+}
+
+func NewBroadcastCommentEvent(scheduleID string, comment *BroadcastComment) *BroadcastEvent {
+	return &BroadcastEvent{
+		ScheduleID: scheduleID,
+		Type:       BroadcastEventTypeCommentCreated,
+		Comment: &BroadcastEventComment{
+			CommentID: comment.ID,
+			UserID:    comment.UserID,
+			Content:   comment.Content,
+		},
+		CreatedAt: comment.CreatedAt,
+	}
+}
+
+func NewBroadcastCommentDisabledEvent(scheduleID, commentID string, now time.Time) *BroadcastEvent {
+	return &BroadcastEvent{
+		ScheduleID: scheduleID,
+		Type:       BroadcastEventTypeCommentDisabled,
+		Comment: &BroadcastEventComment{
+			CommentID: commentID,
+		},
+		CreatedAt: now,
+	}
+}
+
+func NewBroadcastViewerEvent(scheduleID string, total int64, now time.Time) *BroadcastEvent {
+	return &BroadcastEvent{
+		ScheduleID: scheduleID,
+		Type:       BroadcastEventTypeViewerCount,
+		Viewer: &BroadcastEventViewer{
+			Total: total,
+		},
+		CreatedAt: now,
+	}
+}
+
+func NewBroadcastPinEvent(params *NewBroadcastPinEventParams) *BroadcastEvent {
+	typ := BroadcastEventTypeProductUnpinned
+	if params.Pinned {
+		typ = BroadcastEventTypeProductPinned
+	}
+	return &BroadcastEvent{
+		ScheduleID: params.ScheduleID,
+		Type:       typ,
+		Pin: &BroadcastEventPin{
+			LivePinID: params.LivePinID,
+			ProductID: params.ProductID,
+			Offset:    params.Offset,
+		},
+		CreatedAt: params.Now,
+	}
+}
+
+// NewBroadcastEventFromMessage - 配信メッセージからイベントを復元する
+func NewBroadcastEventFromMessage(msg *pubsub.Message) (*BroadcastEvent, error) {
+	event := &BroadcastEvent{}
+	if err := json.Unmarshal(msg.Data, event); err != nil {
+		return nil, fmt.Errorf("entity: failed to unmarshal broadcast event: %w", err)
+	}
+	event.ID = msg.ID
+	return event, nil
+}
+
+func (e *BroadcastEvent) Topic() string {
+	return BroadcastEventTopic(e.ScheduleID)
+}
+
+func (e *BroadcastEvent) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastCommentEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	comment := &BroadcastComment{
+		ID:          "comment-id",
+		BroadcastID: "broadcast-id",
+		UserID:      "user-id",
+		Content:     "こんにちは",
+		CreatedAt:   now,
+	}
+	expect := &BroadcastEvent{
+		ScheduleID: "schedule-id",
+		Type:       BroadcastEventTypeCommentCreated,
+		Comment: &BroadcastEventComment{
+			CommentID: "comment-id",
+			UserID:    "user-id",
+			Content:   "こんにちは",
+		},
+		CreatedAt: now,
+	}
+	actual := NewBroadcastCommentEvent("schedule-id", comment)
+	assert.Equal(t, expect, actual)
+	assert.Equal(t, "broadcast-events:schedule-id", actual.Topic())
+}
+
+func TestBroadcastCommentDisabledEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	expect := &BroadcastEvent{
+		ScheduleID: "schedule-id",
+		Type:       BroadcastEventTypeCommentDisabled,
+		Comment: &BroadcastEventComment{
+			CommentID: "comment-id",
+		},
+		CreatedAt: now,
+	}
+	actual := NewBroadcastCommentDisabledEvent("schedule-id", "comment-id", now)
+	assert.Equal(t, expect, actual)
+}
+
+func TestBroadcastViewerEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	expect := &BroadcastEvent{
+		ScheduleID: "schedule-id",
+		Type:       BroadcastEventTypeViewerCount,
+		Viewer:     &BroadcastEventViewer{Total: 120},
+		CreatedAt:  now,
+	}
+	actual := NewBroadcastViewerEvent("schedule-id", 120, now)
+	assert.Equal(t, expect, actual)
+}
+
+func TestBroadcastPinEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	tests := []struct {
+		name   string
+		params *NewBroadcastPinEventParams
+		expect *BroadcastEvent
+	}{
+		{
+			name: "pinned",
+			params: &NewBroadcastPinEventParams{
+				ScheduleID: "schedule-id",
+				LivePinID:  "pin-id",
+				ProductID:  "product-id",
+				Pinned:     true,
+				Offset:     60,
+				Now:        now,
+			},
+			expect: &BroadcastEvent{
+				ScheduleID: "schedule-id",
+				Type:       BroadcastEventTypeProductPinned,
+				Pin: &BroadcastEventPin{
+					LivePinID: "pin-id",
+					ProductID: "product-id",
+					Offset:    60,
+				},
+				CreatedAt: now,
+			},
+		},
+		{
+			name: "unpinned",
+			params: &NewBroadcastPinEventParams{
+				ScheduleID: "schedule-id",
+				LivePinID:  "pin-id",
+				ProductID:  "product-id",
+				Pinned:     false,
+				Offset:     120,
+				Now:        now,
+			},
+			expect: &BroadcastEvent{
+				ScheduleID: "schedule-id",
+				Type:       BroadcastEventTypeProductUnpinned,
+				Pin: &BroadcastEventPin{
+					LivePinID: "pin-id",
+					ProductID: "product-id",
+					Offset:    120,
+				},
+				CreatedAt: now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewBroadcastPinEvent(tt.params)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestBroadcastEvent_Marshal(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	event := NewBroadcastViewerEvent("schedule-id", 120, now)
+	data, err := event.Marshal()
+	require.NoError(t, err)
+
+	msg := &pubsub.Message{ID: "message-id", Topic: event.Topic(), Data: data}
+	actual, err := NewBroadcastEventFromMessage(msg)
+	require.NoError(t, err)
+	assert.Equal(t, "message-id", actual.ID)
+	assert.Equal(t, event.Type, actual.Type)
+	assert.Equal(t, event.Viewer, actual.Viewer)
+	assert.True(t, event.CreatedAt.Equal(actual.CreatedAt))
+
+	_, err = NewBroadcastEventFromMessage(&pubsub.Message{Data: []byte("{")})
+	assert.Error(t, err)
+}
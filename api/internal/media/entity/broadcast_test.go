@@ -2,6 +2,7 @@ package entity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -65,3 +66,186 @@ func TestBroadcasts_ScheduleID(t *testing.T) {
 		})
 	}
 }
+
+func TestBroadcast_ArchiveKey(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		broadcast *Broadcast
+		expect    string
+		hasErr    bool
+	}{
+		{
+			name: "success",
+			broadcast: &Broadcast{
+				ArchiveURL: "https://example.com/schedules/archives/schedule-id/mp4/original.mp4",
+			},
+			expect: "schedules/archives/schedule-id/mp4/original.mp4",
+			hasErr: false,
+		},
+		{
+			name: "invalid url",
+			broadcast: &Broadcast{
+				ArchiveURL: "://example.com",
+			},
+			expect: "",
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := tt.broadcast.ArchiveKey()
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestBroadcast_ArchiveDuration(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := []struct {
+		name      string
+		broadcast *Broadcast
+		expect    time.Duration
+	}{
+		{
+			name:      "success",
+			broadcast: &Broadcast{StartedAt: now.Add(-time.Hour), EndedAt: now},
+			expect:    time.Hour,
+		},
+		{
+			name:      "archive fixed",
+			broadcast: &Broadcast{StartedAt: now.Add(-time.Hour), EndedAt: now, ArchiveFixed: true},
+			expect:    0,
+		},
+		{
+			name:      "not started",
+			broadcast: &Broadcast{EndedAt: now},
+			expect:    0,
+		},
+		{
+			name:      "not ended",
+			broadcast: &Broadcast{StartedAt: now},
+			expect:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.broadcast.ArchiveDuration())
+		})
+	}
+}
+
+func TestBroadcast_CaptionURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		broadcast *Broadcast
+		language  string
+		expect    string
+		exists    bool
+		hasAny    bool
+	}{
+		{
+			name: "success",
+			broadcast: &Broadcast{
+				ArchiveMetadata: &BroadcastArchiveMetadata{
+					Subtitles: map[string]string{"ja": "https://example.com/ja.vtt"},
+				},
+			},
+			language: "ja",
+			expect:   "https://example.com/ja.vtt",
+			exists:   true,
+			hasAny:   true,
+		},
+		{
+			name: "not found language",
+			broadcast: &Broadcast{
+				ArchiveMetadata: &BroadcastArchiveMetadata{
+					Subtitles: map[string]string{"ja": "https://example.com/ja.vtt"},
+				},
+			},
+			language: "en",
+			expect:   "",
+			exists:   false,
+			hasAny:   true,
+		},
+		{
+			name:      "empty metadata",
+			broadcast: &Broadcast{},
+			language:  "ja",
+			expect:    "",
+			exists:    false,
+			hasAny:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, exists := tt.broadcast.CaptionURL(tt.language)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.exists, exists)
+			assert.Equal(t, tt.hasAny, tt.broadcast.HasCaptions())
+		})
+	}
+}
+
+func TestBroadcast_NewCaptionMetadata(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		broadcast *Broadcast
+		captions  map[string]string
+		expect    *BroadcastArchiveMetadata
+	}{
+		{
+			name: "merge captions",
+			broadcast: &Broadcast{
+				ArchiveMetadata: &BroadcastArchiveMetadata{
+					Subtitles: map[string]string{
+						"ja": "https://example.com/ja-old.vtt",
+						"en": "https://example.com/en.vtt",
+					},
+				},
+			},
+			captions: map[string]string{"ja": "https://example.com/ja-new.vtt"},
+			expect: &BroadcastArchiveMetadata{
+				Subtitles: map[string]string{
+					"ja": "https://example.com/ja-new.vtt",
+					"en": "https://example.com/en.vtt",
+				},
+			},
+		},
+		{
+			name:      "empty metadata",
+			broadcast: &Broadcast{},
+			captions:  map[string]string{"en": "https://example.com/en.vtt"},
+			expect: &BroadcastArchiveMetadata{
+				Subtitles: map[string]string{"en": "https://example.com/en.vtt"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := tt.broadcast.NewCaptionMetadata(tt.captions)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestNewBroadcastCaptionKey(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 18, 12, 30, 15, 0, time.UTC)
+	assert.Equal(t,
+		"schedules/archives/schedule-id/text/caption-en-20261018123015.vtt",
+		NewBroadcastCaptionKey("schedule-id", "en", now),
+	)
+	assert.Equal(t,
+		"schedules/archives/schedule-id/text/transcription-ja",
+		NewBroadcastTranscriptionKey("schedule-id"),
+	)
+}
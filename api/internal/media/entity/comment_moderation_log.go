@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+// CommentModerationTargetType - モデレーション対象種別
+type CommentModerationTargetType int32
+
+const (
+	CommentModerationTargetTypeUnknown   CommentModerationTargetType = 0
+	CommentModerationTargetTypeBroadcast CommentModerationTargetType = 1 // ライブ配信
+	CommentModerationTargetTypeVideo     CommentModerationTargetType = 2 // オンデマンド配信
+)
+
+// CommentModerationAction - モデレーション操作種別
+type CommentModerationAction int32
+
+const (
+	CommentModerationActionUnknown      CommentModerationAction = 0
+	CommentModerationActionNgWord       CommentModerationAction = 1 // NGワードによる投稿拒否
+	CommentModerationActionRateLimit    CommentModerationAction = 2 // 投稿頻度制限による投稿拒否
+	CommentModerationActionMuted        CommentModerationAction = 3 // ミュートによる投稿拒否
+	CommentModerationActionShadowBanned CommentModerationAction = 4 // シャドウバンによる非表示
+	CommentModerationActionMuteCreated  CommentModerationAction = 5 // ミュート登録
+	CommentModerationActionMuteDeleted  CommentModerationAction = 6 // ミュート解除
+)
+
+// CommentModerationLog - コメントモデレーション履歴
+type CommentModerationLog struct {
+	ID         string                      `gorm:"primaryKey;<-:create"` // 履歴ID
+	TargetType CommentModerationTargetType `gorm:""`                     // 対象種別
+	TargetID   string                      `gorm:""`                     // 対象ID(ライブ配信ID/オンデマンド配信ID)
+	Action     CommentModerationAction     `gorm:""`                     // 操作種別
+	CommentID  string                      `gorm:""`                     // コメントID
+	UserID     string                      `gorm:""`                     // 投稿者のユーザーID
+	SessionID  string                      `gorm:""`                     // 投稿者のセッションID
+	ClientIP   string                      `gorm:""`                     // 投稿元IPアドレス
+	Content    string                      `gorm:""`                     // コメント内容
+	Detail     string                      `gorm:""`                     // 詳細(該当NGワード、ミュート理由など)
+	AdminID    string                      `gorm:""`                     // 操作者ID(自動処理の場合は空)
+	CreatedAt  time.Time                   `gorm:"<-:create"`            // 登録日時
+	UpdatedAt  time.Time                   `gorm:""`                     // 更新日時
+}
+
+type CommentModerationLogs []*CommentModerationLog
+
+type NewCommentModerationLogParams struct {
+	TargetType CommentModerationTargetType
+	TargetID   string
+	Action     CommentModerationAction
+	CommentID  string
+	UserID     string
+	SessionID  string
+	ClientIP   string
+	Content    string
+	Detail     string
+	AdminID    string
+}
+
+func NewCommentModerationLog(params *NewCommentModerationLogParams) *CommentModerationLog {
+	return &CommentModerationLog{
+		ID:         uuid.Base58Encode(uuid.New()),
+		TargetType: params.TargetType,
+		TargetID:   params.TargetID,
+		Action:     params.Action,
+		CommentID:  params.CommentID,
+		UserID:     params.UserID,
+		SessionID:  params.SessionID,
+		ClientIP:   params.ClientIP,
+		Content:    params.Content,
+		Detail:     params.Detail,
+		AdminID:    params.AdminID,
+	}
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentModerationLog(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewCommentModerationLogParams
+		expect *CommentModerationLog
+	}{
+		{
+			name: "success",
+			params: &NewCommentModerationLogParams{
+				TargetType: CommentModerationTargetTypeBroadcast,
+				TargetID:   "broadcast-id",
+				Action:     CommentModerationActionNgWord,
+				UserID:     "user-id",
+				SessionID:  "session-id",
+				ClientIP:   "127.0.0.1",
+				Content:    "ばかじゃないの",
+				Detail:     "ばか",
+			},
+			expect: &CommentModerationLog{
+				TargetType: CommentModerationTargetTypeBroadcast,
+				TargetID:   "broadcast-id",
+				Action:     CommentModerationActionNgWord,
+				UserID:     "user-id",
+				SessionID:  "session-id",
+				ClientIP:   "127.0.0.1",
+				Content:    "ばかじゃないの",
+				Detail:     "ばか",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentModerationLog(tt.params)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
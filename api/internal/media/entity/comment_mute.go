@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+// CommentMuteType - コメントミュート種別
+type CommentMuteType int32
+
+const (
+	CommentMuteTypeUnknown   CommentMuteType = 0
+	CommentMuteTypeMute      CommentMuteType = 1 // ミュート（投稿を拒否）
+	CommentMuteTypeShadowBan CommentMuteType = 2 // シャドウバン（投稿は受け付けるが他の視聴者には表示しない）
+)
+
+// CommentMute - ライブ配信コメントのミュート情報
+// 会員はユーザーID、ゲストはセッションIDと投稿元IPアドレスで投稿者を識別する
+type CommentMute struct {
+	ID          string          `gorm:"primaryKey;<-:create"` // ミュートID
+	BroadcastID string          `gorm:""`                     // ライブ配信ID
+	UserID      string          `gorm:""`                     // ユーザーID
+	SessionID   string          `gorm:""`                     // セッションID
+	ClientIP    string          `gorm:""`                     // 投稿元IPアドレス
+	Type        CommentMuteType `gorm:""`                     // ミュート種別
+	Reason      string          `gorm:""`                     // ミュート理由
+	AdminID     string          `gorm:""`                     // 登録者ID
+	CreatedAt   time.Time       `gorm:"<-:create"`            // 登録日時
+	UpdatedAt   time.Time       `gorm:""`                     // 更新日時
+}
+
+type CommentMutes []*CommentMute
+
+type NewCommentMuteParams struct {
+	BroadcastID string
+	UserID      string
+	SessionID   string
+	ClientIP    string
+	Type        CommentMuteType
+	Reason      string
+	AdminID     string
+}
+
+func NewCommentMute(params *NewCommentMuteParams) *CommentMute {
+	mute := &CommentMute{
+		ID:          uuid.Base58Encode(uuid.New()),
+		BroadcastID: params.BroadcastID,
+		Type:        params.Type,
+		Reason:      params.Reason,
+		AdminID:     params.AdminID,
+	}
+	// 会員の場合はユーザーIDのみで識別する
+	if params.UserID != "" {
+		mute.UserID = params.UserID
+	} else {
+		// ゲストはCookieを破棄するだけでセッションIDを変えられるため、投稿元IPアドレスも保持する
+		mute.SessionID = params.SessionID
+		mute.ClientIP = params.ClientIP
+	}
+	return mute
+}
+
+// Target - ミュート対象が投稿者と一致するか
+func (m *CommentMute) Target(userID, sessionID, clientIP string) bool {
+	if m.UserID != "" {
+		return m.UserID == userID
+	}
+	if m.SessionID != "" && m.SessionID == sessionID {
+		return true
+	}
+	// IPアドレスでの照合はゲストの投稿に限る（会員はユーザーIDで識別できるため）
+	return userID == "" && m.ClientIP != "" && m.ClientIP == clientIP
+}
+
+// Find - 投稿者に適用されるミュート情報を返す（ミュートをシャドウバンより優先する）
+func (ms CommentMutes) Find(userID, sessionID, clientIP string) *CommentMute {
+	var res *CommentMute
+	for _, m := range ms {
+		if !m.Target(userID, sessionID, clientIP) {
+			continue
+		}
+		if m.Type == CommentMuteTypeMute {
+			return m
+		}
+		if res == nil {
+			res = m
+		}
+	}
+	return res
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentMute(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewCommentMuteParams
+		expect *CommentMute
+	}{
+		{
+			name: "user",
+			params: &NewCommentMuteParams{
+				BroadcastID: "broadcast-id",
+				UserID:      "user-id",
+				SessionID:   "session-id",
+				ClientIP:    "192.0.2.1",
+				Type:        CommentMuteTypeMute,
+				Reason:      "荒らし行為",
+				AdminID:     "admin-id",
+			},
+			expect: &CommentMute{
+				BroadcastID: "broadcast-id",
+				UserID:      "user-id",
+				Type:        CommentMuteTypeMute,
+				Reason:      "荒らし行為",
+				AdminID:     "admin-id",
+			},
+		},
+		{
+			name: "guest",
+			params: &NewCommentMuteParams{
+				BroadcastID: "broadcast-id",
+				SessionID:   "session-id",
+				ClientIP:    "192.0.2.1",
+				Type:        CommentMuteTypeShadowBan,
+				Reason:      "",
+				AdminID:     "admin-id",
+			},
+			expect: &CommentMute{
+				BroadcastID: "broadcast-id",
+				SessionID:   "session-id",
+				ClientIP:    "192.0.2.1",
+				Type:        CommentMuteTypeShadowBan,
+				Reason:      "",
+				AdminID:     "admin-id",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentMute(tt.params)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestCommentMutes_Find(t *testing.T) {
+	t.Parallel()
+	mutes := CommentMutes{
+		{ID: "mute-id01", BroadcastID: "broadcast-id", UserID: "user-id", Type: CommentMuteTypeShadowBan},
+		{ID: "mute-id02", BroadcastID: "broadcast-id", UserID: "user-id", Type: CommentMuteTypeMute},
+		{ID: "mute-id03", BroadcastID: "broadcast-id", SessionID: "session-id", ClientIP: "192.0.2.1", Type: CommentMuteTypeShadowBan},
+	}
+	tests := []struct {
+		name      string
+		mutes     CommentMutes
+		userID    string
+		sessionID string
+		clientIP  string
+		expect    *CommentMute
+	}{
+		{
+			name:      "user with mute",
+			mutes:     mutes,
+			userID:    "user-id",
+			sessionID: "other-session-id",
+			expect:    mutes[1],
+		},
+		{
+			name:      "user with shadow ban",
+			mutes:     mutes[:1],
+			userID:    "user-id",
+			sessionID: "",
+			expect:    mutes[0],
+		},
+		{
+			name:      "guest",
+			mutes:     mutes,
+			userID:    "",
+			sessionID: "session-id",
+			expect:    mutes[2],
+		},
+		{
+			name:      "guest with other session from same ip",
+			mutes:     mutes,
+			userID:    "",
+			sessionID: "other-session-id",
+			clientIP:  "192.0.2.1",
+			expect:    mutes[2],
+		},
+		{
+			name:      "user from same ip as muted guest",
+			mutes:     mutes[2:],
+			userID:    "other-id",
+			sessionID: "other-session-id",
+			clientIP:  "192.0.2.1",
+			expect:    nil,
+		},
+		{
+			name:      "not found",
+			mutes:     mutes,
+			userID:    "other-id",
+			sessionID: "",
+			expect:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.mutes.Find(tt.userID, tt.sessionID, tt.clientIP))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/japanese"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+// CommentNgWord - コメントNGワード情報（コーディネータ単位）
+type CommentNgWord struct {
+	ID            string    `gorm:"primaryKey;<-:create"` // NGワードID
+	CoordinatorID string    `gorm:""`                     // コーディネータID
+	Word          string    `gorm:""`                     // NGワード
+	CreatedAt     time.Time `gorm:"<-:create"`            // 登録日時
+	UpdatedAt     time.Time `gorm:""`                     // 更新日時
+}
+
+type CommentNgWords []*CommentNgWord
+
+type NewCommentNgWordParams struct {
+	CoordinatorID string
+	Word          string
+}
+
+func NewCommentNgWord(params *NewCommentNgWordParams) *CommentNgWord {
+	return &CommentNgWord{
+		ID:            uuid.Base58Encode(uuid.New()),
+		CoordinatorID: params.CoordinatorID,
+		Word:          strings.TrimSpace(params.Word),
+	}
+}
+
+// Match - コメント内容に含まれるNGワードを返す（ひらがな/カタカナ、全角/半角の違いは同一視する）
+func (ws CommentNgWords) Match(content string) (*CommentNgWord, bool) {
+	normalized := japanese.Normalize(content)
+	for _, w := range ws {
+		word := japanese.Normalize(w.Word)
+		if word == "" {
+			continue
+		}
+		if strings.Contains(normalized, word) {
+			return w, true
+		}
+	}
+	return nil, false
+}
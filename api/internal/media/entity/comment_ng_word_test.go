@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentNgWord(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewCommentNgWordParams
+		expect *CommentNgWord
+	}{
+		{
+			name: "success",
+			params: &NewCommentNgWordParams{
+				CoordinatorID: "coordinator-id",
+				Word:          " ばか ",
+			},
+			expect: &CommentNgWord{
+				CoordinatorID: "coordinator-id",
+				Word:          "ばか",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewCommentNgWord(tt.params)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestCommentNgWords_Match(t *testing.T) {
+	t.Parallel()
+	words := CommentNgWords{
+		{ID: "word-id01", CoordinatorID: "coordinator-id", Word: "ばか"},
+		{ID: "word-id02", CoordinatorID: "coordinator-id", Word: "SPAM"},
+		{ID: "word-id03", CoordinatorID: "coordinator-id", Word: "　"},
+	}
+	tests := []struct {
+		name    string
+		words   CommentNgWords
+		content string
+		expect  *CommentNgWord
+		matched bool
+	}{
+		{
+			name:    "hiragana",
+			words:   words,
+			content: "ばかじゃないの",
+			expect:  words[0],
+			matched: true,
+		},
+		{
+			name:    "katakana",
+			words:   words,
+			content: "バカじゃないの",
+			expect:  words[0],
+			matched: true,
+		},
+		{
+			name:    "half-width katakana",
+			words:   words,
+			content: "ﾊﾞｶじゃないの",
+			expect:  words[0],
+			matched: true,
+		},
+		{
+			name:    "full-width alphabet",
+			words:   words,
+			content: "ｓｐａｍです",
+			expect:  words[1],
+			matched: true,
+		},
+		{
+			name:    "separated by space",
+			words:   words,
+			content: "ば か",
+			expect:  words[0],
+			matched: true,
+		},
+		{
+			name:    "not matched",
+			words:   words,
+			content: "こんにちは",
+			expect:  nil,
+			matched: false,
+		},
+		{
+			name:    "empty",
+			words:   CommentNgWords{},
+			content: "ばか",
+			expect:  nil,
+			matched: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, ok := tt.words.Match(tt.content)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.matched, ok)
+		})
+	}
+}
@@ -24,6 +24,7 @@ const (
 	BroadcastArchiveMP4Path       = "schedules/archives/%s/mp4"    // ライブ配信後のアーカイブ動画(mp4)
 	BroadcastArchiveHLSPath       = "schedules/archives/%s/hls"    // ライブ配信後のアーカイブ動画(hls)
 	BroadcastArchiveTextPath      = "schedules/archives/%s/text"   // ライブ配信後のアーカイブ音声文字起こし
+	BroadcastArchiveClipPath      = "schedules/archives/%s/clips"  // ライブ配信後のアーカイブ切り抜き動画
 	CoordinatorThumbnailPath      = "coordinators/thumbnail"       // コーディネータサムネイル画像
 	CoordinatorHeaderPath         = "coordinators/header"          // コーディネータヘッダー画像
 	CoordinatorPromotionVideoPath = "coordinators/promotion-video" // コーディネータ紹介映像
@@ -46,6 +47,7 @@ const (
 	VideoThumbnailPath            = "videos/thumbnail"             // オンデマンド配信サムネイル画像
 	VideoMP4Path                  = "videos/mp4"                   // オンデマンド配信動画(mp4)
 	SpotThumbnailPath             = "spots/thumbnail"              // スポットサムネイル画像
+	OrderClaimImagePath           = "orders/claims/image"          // 返品・交換申請の証拠画像
 )
 
 const defaultCacheTTL = 14 * 24 * time.Hour // 2週間
@@ -223,6 +225,13 @@ var (
 		CacheTTL: defaultCacheTTL,
 		dir:      SpotThumbnailPath,
 	}
+	// 注文関連
+	OrderClaimImageRegulation = &Regulation{
+		MaxSize:  10 << 20, // 10MB
+		Formats:  set.New("image/png", "image/jpeg"),
+		CacheTTL: defaultCacheTTL,
+		dir:      OrderClaimImagePath,
+	}
 )
 
 func (r *Regulation) FileGroup() string {
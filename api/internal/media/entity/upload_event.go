@@ -125,6 +125,9 @@ func (e *UploadEvent) Reguration() (*Regulation, error) {
 		return VideoThumbnailRegulation, nil
 	case VideoMP4Path:
 		return VideoMP4Regulation, nil
+	// 注文関連
+	case OrderClaimImagePath:
+		return OrderClaimImageRegulation, nil
 	default:
 		return nil, ErrNotFoundReguration
 	}
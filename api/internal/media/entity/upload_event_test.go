@@ -286,6 +286,13 @@ func TestUploadEvent_Regulation(t *testing.T) {
 			expect:    VideoMP4Regulation,
 			expectErr: nil,
 		},
+		// 注文関連
+		{
+			name:      "order claim image",
+			event:     &UploadEvent{FileGroup: OrderClaimImagePath},
+			expect:    OrderClaimImageRegulation,
+			expectErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -28,22 +28,23 @@ const (
 type Video struct {
 	VideoProducts     `gorm:"-"`
 	VideoExperiences  `gorm:"-"`
-	ID                string      `gorm:"primaryKey;<-:create"` // オンデマンド動画ID
-	CoordinatorID     string      `gorm:""`                     // コーディネータID
-	ProductIDs        []string    `gorm:"-"`                    // 商品ID一覧
-	ExperienceIDs     []string    `gorm:"-"`                    // 体験ID一覧
-	Title             string      `gorm:""`                     // タイトル
-	Description       string      `gorm:""`                     // 説明
-	Status            VideoStatus `gorm:"-"`                    // 配信状況
-	ThumbnailURL      string      `gorm:""`                     // サムネイルURL
-	VideoURL          string      `gorm:""`                     // 動画URL
-	Public            bool        `gorm:""`                     // 公開設定
-	Limited           bool        `gorm:""`                     // 限定公開設定
-	DisplayProduct    bool        `gorm:""`                     // 商品への表示設定
-	DisplayExperience bool        `gorm:""`                     // 体験への表示設定
-	PublishedAt       time.Time   `gorm:""`                     // 公開日時
-	CreatedAt         time.Time   `gorm:"<-:create"`            // 作成日時
-	UpdatedAt         time.Time   `gorm:""`                     // 更新日時
+	ID                string            `gorm:"primaryKey;<-:create"` // オンデマンド動画ID
+	CoordinatorID     string            `gorm:""`                     // コーディネータID
+	ProductIDs        []string          `gorm:"-"`                    // 商品ID一覧
+	ExperienceIDs     []string          `gorm:"-"`                    // 体験ID一覧
+	Title             string            `gorm:""`                     // タイトル
+	Description       string            `gorm:""`                     // 説明
+	Status            VideoStatus       `gorm:"-"`                    // 配信状況
+	ThumbnailURL      string            `gorm:""`                     // サムネイルURL
+	VideoURL          string            `gorm:""`                     // 動画URL
+	Captions          map[string]string `gorm:"serializer:json"`      // 字幕ファイル一覧（key：言語コード,value：ファイル参照先URL）
+	Public            bool              `gorm:""`                     // 公開設定
+	Limited           bool              `gorm:""`                     // 限定公開設定
+	DisplayProduct    bool              `gorm:""`                     // 商品への表示設定
+	DisplayExperience bool              `gorm:""`                     // 体験への表示設定
+	PublishedAt       time.Time         `gorm:""`                     // 公開日時
+	CreatedAt         time.Time         `gorm:"<-:create"`            // 作成日時
+	UpdatedAt         time.Time         `gorm:""`                     // 更新日時
 }
 
 type Videos []*Video
@@ -12,6 +12,8 @@ type VideoComment struct {
 	ID        string    `gorm:"primaryKey;<-:create"` // コメントID
 	VideoID   string    `gorm:""`                     // オンデマンド配信ID
 	UserID    string    `gorm:""`                     // ユーザーID
+	SessionID string    `gorm:""`                     // セッションID(ゲスト識別用)
+	ClientIP  string    `gorm:""`                     // 投稿元IPアドレス
 	Content   string    `gorm:""`                     // コメント内容
 	Disabled  bool      `gorm:""`                     // コメント無効フラグ
 	CreatedAt time.Time `gorm:"<-:create"`            // 登録日時
@@ -21,18 +23,22 @@ type VideoComment struct {
 type VideoComments []*VideoComment
 
 type NewVideoCommentParams struct {
-	VideoID string
-	UserID  string
-	Content string
+	VideoID   string
+	UserID    string
+	SessionID string
+	ClientIP  string
+	Content   string
 }
 
 func NewVideoComment(params *NewVideoCommentParams) *VideoComment {
 	return &VideoComment{
-		ID:       uuid.Base58Encode(uuid.New()),
-		VideoID:  params.VideoID,
-		UserID:   params.UserID,
-		Content:  params.Content,
-		Disabled: false,
+		ID:        uuid.Base58Encode(uuid.New()),
+		VideoID:   params.VideoID,
+		UserID:    params.UserID,
+		SessionID: params.SessionID,
+		ClientIP:  params.ClientIP,
+		Content:   params.Content,
+		Disabled:  false,
 	}
 }
 
@@ -17,14 +17,18 @@ func TestVideoComment(t *testing.T) {
 		{
 			name: "success",
 			params: &NewVideoCommentParams{
-				VideoID: "video-id",
-				UserID:  "user-id",
-				Content: "とても面白いですね",
+				VideoID:   "video-id",
+				UserID:    "user-id",
+				SessionID: "session-id",
+				ClientIP:  "127.0.0.1",
+				Content:   "とても面白いですね",
 			},
 			expect: &VideoComment{
-				VideoID: "video-id",
-				UserID:  "user-id",
-				Content: "とても面白いですね",
+				VideoID:   "video-id",
+				UserID:    "user-id",
+				SessionID: "session-id",
+				ClientIP:  "127.0.0.1",
+				Content:   "とても面白いですね",
 			},
 		},
 	}
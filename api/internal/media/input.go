@@ -44,6 +44,17 @@ type UpdateBroadcastArchiveInput struct {
 	ArchiveURL string `validate:"required,url"`
 }
 
+type GetBroadcastCaptionInput struct {
+	ScheduleID string `validate:"required"`
+	Language   string `validate:"required,oneof=ja en"`
+}
+
+type UpdateBroadcastCaptionInput struct {
+	ScheduleID string `validate:"required"`
+	Language   string `validate:"required,oneof=ja en"`
+	Content    string `validate:"required"`
+}
+
 type PauseBroadcastInput struct {
 	ScheduleID string `validate:"required"`
 }
@@ -105,17 +116,59 @@ type ListBroadcastCommentsInput struct {
 type CreateBroadcastCommentInput struct {
 	ScheduleID string `validate:"required"`
 	UserID     string `validate:"required"`
+	SessionID  string `validate:""`
+	ClientIP   string `validate:"omitempty,ip_addr"`
 	Content    string `validate:"required,max=200"`
 }
 
 type CreateBroadcastGuestCommentInput struct {
 	ScheduleID string `validate:"required"`
+	SessionID  string `validate:""`
+	ClientIP   string `validate:"omitempty,ip_addr"`
 	Content    string `validate:"required,max=200"`
 }
 
 type UpdateBroadcastCommentInput struct {
-	CommentID string `validate:"required"`
-	Disabled  bool   `validate:""`
+	ScheduleID string `validate:"required"`
+	CommentID  string `validate:"required"`
+	Disabled   bool   `validate:""`
+}
+
+/**
+ * BroadcastEvent - ライブ配信イベント
+ */
+type SubscribeBroadcastEventsInput struct {
+	ScheduleID string `validate:"required"`
+	Cursor     string `validate:""`
+}
+
+type PublishBroadcastPinEventInput struct {
+	ScheduleID string `validate:"required"`
+	LivePinID  string `validate:"required"`
+	ProductID  string `validate:"required"`
+	Pinned     bool   `validate:""`
+	Offset     int64  `validate:"min=0"`
+}
+
+/**
+ * BroadcastClip - ライブ配信アーカイブ切り抜き
+ */
+type ListBroadcastClipsInput struct {
+	ScheduleID string `validate:"required"`
+}
+
+type CreateBroadcastClipInput struct {
+	ScheduleID   string `validate:"required"`
+	Title        string `validate:"required,max=128"`
+	Description  string `validate:"max=2000"`
+	ThumbnailURL string `validate:"omitempty,url"`
+	StartOffset  int64  `validate:"min=0"`
+	EndOffset    int64  `validate:"gtfield=StartOffset"`
+}
+
+type DeleteBroadcastClipInput struct {
+	ScheduleID string `validate:"required"`
+	ClipID     string `validate:"required"`
 }
 
 /**
@@ -229,14 +282,18 @@ type ListVideoCommentsInput struct {
 }
 
 type CreateVideoCommentInput struct {
-	VideoID string `validate:"required"`
-	UserID  string `validate:"required"`
-	Content string `validate:"required,max=200"`
+	VideoID   string `validate:"required"`
+	UserID    string `validate:"required"`
+	SessionID string `validate:""`
+	ClientIP  string `validate:"omitempty,ip_addr"`
+	Content   string `validate:"required,max=200"`
 }
 
 type CreateVideoGuestCommentInput struct {
-	VideoID string `validate:"required"`
-	Content string `validate:"required,max=200"`
+	VideoID   string `validate:"required"`
+	SessionID string `validate:""`
+	ClientIP  string `validate:"omitempty,ip_addr"`
+	Content   string `validate:"required,max=200"`
 }
 
 type UpdateVideoCommentInput struct {
@@ -261,3 +318,48 @@ type AggregateVideoViewerLogsInput struct {
 	CreatedAtGte time.Time                              `validate:""`
 	CreatedAtLt  time.Time                              `validate:""`
 }
+
+/**
+ * CommentModeration - コメントモデレーション
+ */
+type ListCommentNgWordsInput struct {
+	CoordinatorID string `validate:"required"`
+	Limit         int64  `validate:"required_without=NoLimit,min=0,max=200"`
+	Offset        int64  `validate:"min=0"`
+	NoLimit       bool   `validate:""`
+}
+
+type CreateCommentNgWordInput struct {
+	CoordinatorID string `validate:"required"`
+	Word          string `validate:"required,max=64"`
+}
+
+type DeleteCommentNgWordInput struct {
+	CoordinatorID string `validate:"required"`
+	NgWordID      string `validate:"required"`
+}
+
+type ListBroadcastCommentMutesInput struct {
+	ScheduleID string `validate:"required"`
+}
+
+type CreateBroadcastCommentMuteInput struct {
+	ScheduleID string                 `validate:"required"`
+	CommentID  string                 `validate:"required"`
+	Type       entity.CommentMuteType `validate:"required,oneof=1 2"`
+	Reason     string                 `validate:"max=200"`
+	AdminID    string                 `validate:"required"`
+}
+
+type DeleteBroadcastCommentMuteInput struct {
+	ScheduleID string `validate:"required"`
+	MuteID     string `validate:"required"`
+	AdminID    string `validate:"required"`
+}
+
+type ListCommentModerationLogsInput struct {
+	TargetType entity.CommentModerationTargetType `validate:"required,oneof=1 2"`
+	TargetID   string                             `validate:"required"`
+	Limit      int64                              `validate:"required,max=200"`
+	Offset     int64                              `validate:"min=0"`
+}
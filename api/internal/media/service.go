@@ -15,6 +15,8 @@ type Service interface {
 	CreateBroadcast(ctx context.Context, in *CreateBroadcastInput) (*entity.Broadcast, error)                                     // 登録
 	GetBroadcastArchiveMP4UploadURL(ctx context.Context, in *GenerateBroadcastArchiveMP4UploadInput) (*entity.UploadEvent, error) // アーカイブ動画アップロード用URLの生成
 	UpdateBroadcastArchive(ctx context.Context, in *UpdateBroadcastArchiveInput) error                                            // アーカイブ動画の更新
+	GetBroadcastCaption(ctx context.Context, in *GetBroadcastCaptionInput) (*entity.BroadcastCaption, error)                      // アーカイブ字幕の取得
+	UpdateBroadcastCaption(ctx context.Context, in *UpdateBroadcastCaptionInput) error                                            // アーカイブ字幕の更新
 	GetBroadcastLiveMP4UploadURL(ctx context.Context, in *GenerateUploadURLInput) (*entity.UploadEvent, error)                    // ライブ配信アップロード用URLの生成
 	PauseBroadcast(ctx context.Context, in *PauseBroadcastInput) error                                                            // ライブ配信の一時停止
 	UnpauseBroadcast(ctx context.Context, in *UnpauseBroadcastInput) error                                                        // ライブ配信の一時停止を解除
@@ -31,6 +33,21 @@ type Service interface {
 	CreateBroadcastComment(ctx context.Context, in *CreateBroadcastCommentInput) (*entity.BroadcastComment, error)           // ライブコメント登録
 	CreateBroadcastGuestComment(ctx context.Context, in *CreateBroadcastGuestCommentInput) (*entity.BroadcastComment, error) // ライブゲストコメント登録
 	UpdateBroadcastComment(ctx context.Context, in *UpdateBroadcastCommentInput) error                                       // ライブコメント更新
+	// BroadcastEvent - ライブ配信イベント
+	SubscribeBroadcastEvents(ctx context.Context, in *SubscribeBroadcastEventsInput) (<-chan *entity.BroadcastEvent, error) // ライブ配信イベント購読
+	PublishBroadcastPinEvent(ctx context.Context, in *PublishBroadcastPinEventInput) error                                  // 商品ピン留めイベント配信
+	// BroadcastClip - ライブ配信アーカイブ切り抜き
+	ListBroadcastClips(ctx context.Context, in *ListBroadcastClipsInput) (entity.BroadcastClips, error)   // 切り抜き動画一覧取得
+	CreateBroadcastClip(ctx context.Context, in *CreateBroadcastClipInput) (*entity.BroadcastClip, error) // 切り抜き動画登録
+	DeleteBroadcastClip(ctx context.Context, in *DeleteBroadcastClipInput) error                          // 切り抜き動画削除
+	// CommentModeration - コメントモデレーション
+	ListCommentNgWords(ctx context.Context, in *ListCommentNgWordsInput) (entity.CommentNgWords, int64, error)                      // NGワード一覧取得
+	CreateCommentNgWord(ctx context.Context, in *CreateCommentNgWordInput) (*entity.CommentNgWord, error)                           // NGワード登録
+	DeleteCommentNgWord(ctx context.Context, in *DeleteCommentNgWordInput) error                                                    // NGワード削除
+	ListBroadcastCommentMutes(ctx context.Context, in *ListBroadcastCommentMutesInput) (entity.CommentMutes, error)                 // ライブコメントミュート一覧取得
+	CreateBroadcastCommentMute(ctx context.Context, in *CreateBroadcastCommentMuteInput) (*entity.CommentMute, error)               // ライブコメント投稿者のミュート登録
+	DeleteBroadcastCommentMute(ctx context.Context, in *DeleteBroadcastCommentMuteInput) error                                      // ライブコメント投稿者のミュート解除
+	ListCommentModerationLogs(ctx context.Context, in *ListCommentModerationLogsInput) (entity.CommentModerationLogs, int64, error) // モデレーション履歴一覧取得
 	// BroadcastViewerLog - ライブ視聴履歴
 	CreateBroadcastViewerLog(ctx context.Context, in *CreateBroadcastViewerLogInput) error                                                        // ライブ配信視聴履歴登録
 	AggregateBroadcastViewerLogs(ctx context.Context, in *AggregateBroadcastViewerLogsInput) (entity.AggregatedBroadcastViewerLogs, int64, error) // ライブ配信視聴履歴集計
@@ -61,6 +78,8 @@ type Service interface {
 	GetScheduleOpeningVideoUploadURL(ctx context.Context, in *GenerateUploadURLInput) (*entity.UploadEvent, error) // オープニング動画アップロード用URLの生成
 	// Upload - スポット
 	GetSpotThumbnailUploadURL(ctx context.Context, in *GenerateUploadURLInput) (*entity.UploadEvent, error) // サムネイル画像アップロード用URLの生成
+	// Upload - 注文
+	GetOrderClaimImageUploadURL(ctx context.Context, in *GenerateUploadURLInput) (*entity.UploadEvent, error) // 返品・交換申請の証拠画像アップロード用URLの生成
 	// UploadEvent - アップロード結果
 	GetUploadEvent(ctx context.Context, in *GetUploadEventInput) (*entity.UploadEvent, error) // ファイルアップロード結果取得
 	// Video - オンデマンド配信
@@ -1,14 +1,17 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/and-period/furumaru/api/internal/exception"
 	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/broadcast/caption"
 	"github.com/and-period/furumaru/api/internal/media/database"
 	"github.com/and-period/furumaru/api/internal/media/entity"
 	"github.com/and-period/furumaru/api/internal/store"
@@ -127,6 +130,71 @@ func (s *service) UpdateBroadcastArchive(ctx context.Context, in *media.UpdateBr
 	return nil
 }
 
+func (s *service) GetBroadcastCaption(
+	ctx context.Context, in *media.GetBroadcastCaptionInput,
+) (*entity.BroadcastCaption, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	broadcast, err := s.db.Broadcast.GetByScheduleID(ctx, in.ScheduleID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	captionURL, ok := broadcast.CaptionURL(in.Language)
+	if !ok {
+		return nil, fmt.Errorf("service: this caption is not found: %w", exception.ErrNotFound)
+	}
+	content, err := s.storage.DownloadAndReadAll(ctx, captionURL)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	res := &entity.BroadcastCaption{
+		Language: in.Language,
+		URL:      captionURL,
+		Content:  content,
+	}
+	return res, nil
+}
+
+func (s *service) UpdateBroadcastCaption(ctx context.Context, in *media.UpdateBroadcastCaptionInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	vtt, err := caption.ParseWebVTT([]byte(in.Content))
+	if err != nil {
+		return fmt.Errorf("service: invalid caption format: %w: %s", exception.ErrInvalidArgument, err.Error())
+	}
+	broadcast, err := s.db.Broadcast.GetByScheduleID(ctx, in.ScheduleID)
+	if err != nil {
+		return internalError(err)
+	}
+	if broadcast.Status != entity.BroadcastStatusDisabled || broadcast.ArchiveURL == "" {
+		return fmt.Errorf("service: this broadcast has no archive: %w", exception.ErrFailedPrecondition)
+	}
+	// 字幕ファイルはアーカイブ動画と同じ配信元から参照させる
+	captionURL, err := url.Parse(broadcast.ArchiveURL)
+	if err != nil {
+		return fmt.Errorf("service: invalid archive url: %w", exception.ErrFailedPrecondition)
+	}
+	key := entity.NewBroadcastCaptionKey(broadcast.ScheduleID, in.Language, s.now())
+	metadata := map[string]string{
+		"Content-Type":  "text/vtt",
+		"Cache-Control": "s-maxage=" + entity.BroadcastArchiveTextRegulation.CacheTTL.String(),
+	}
+	if _, err := s.storage.Upload(ctx, key, bytes.NewReader(vtt.Bytes()), metadata); err != nil {
+		return internalError(err)
+	}
+	captionURL.Path = "/" + key
+	params := &database.UpdateBroadcastParams{
+		UpdateBroadcastCaptionParams: &database.UpdateBroadcastCaptionParams{
+			CaptionStatus:   entity.BroadcastCaptionStatusPublished,
+			ArchiveMetadata: broadcast.NewCaptionMetadata(map[string]string{in.Language: captionURL.String()}),
+		},
+	}
+	err = s.db.Broadcast.Update(ctx, broadcast.ID, params)
+	return internalError(err)
+}
+
 func (s *service) PauseBroadcast(ctx context.Context, in *media.PauseBroadcastInput) error {
 	if err := s.validator.Struct(in); err != nil {
 		return internalError(err)
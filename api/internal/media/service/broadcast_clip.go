@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/set"
+	"golang.org/x/sync/errgroup"
+)
+
+func (s *service) ListBroadcastClips(
+	ctx context.Context, in *media.ListBroadcastClipsInput,
+) (entity.BroadcastClips, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	broadcast, err := s.db.Broadcast.GetByScheduleID(ctx, in.ScheduleID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.ListBroadcastClipsParams{
+		BroadcastID: broadcast.ID,
+	}
+	clips, err := s.db.BroadcastClip.List(ctx, params)
+	return clips, internalError(err)
+}
+
+func (s *service) CreateBroadcastClip(
+	ctx context.Context, in *media.CreateBroadcastClipInput,
+) (*entity.BroadcastClip, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	var (
+		broadcast *entity.Broadcast
+		schedule  *sentity.Schedule
+		lives     sentity.Lives
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		broadcast, err = s.db.Broadcast.GetByScheduleID(ectx, in.ScheduleID)
+		return
+	})
+	eg.Go(func() (err error) {
+		in := &store.GetScheduleInput{
+			ScheduleID: in.ScheduleID,
+		}
+		schedule, err = s.store.GetSchedule(ectx, in)
+		return
+	})
+	eg.Go(func() (err error) {
+		in := &store.ListLivesInput{
+			ScheduleIDs: []string{in.ScheduleID},
+			NoLimit:     true,
+		}
+		lives, _, err = s.store.ListLives(ectx, in)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	if broadcast.ArchiveURL == "" {
+		return nil, fmt.Errorf("service: this broadcast has no archive: %w", exception.ErrFailedPrecondition)
+	}
+	// 切り抜き範囲はアーカイブ動画の先頭(配信開始日時)を基準とするため、配信実績から長さを判定する
+	// 長さが不明なアーカイブ(再アップロード済み・配信実績の記録前)は範囲を受け付け、範囲外の場合は動画変換の失敗として切り抜きを生成失敗にする
+	duration := broadcast.ArchiveDuration()
+	if duration > 0 && time.Duration(in.EndOffset)*time.Second > duration {
+		return nil, fmt.Errorf("service: end offset exceeds archive duration: %w", exception.ErrInvalidArgument)
+	}
+	clip := entity.NewBroadcastClip(&entity.NewBroadcastClipParams{
+		Broadcast:    broadcast,
+		Title:        in.Title,
+		Description:  in.Description,
+		ThumbnailURL: in.ThumbnailURL,
+		StartOffset:  in.StartOffset,
+		EndOffset:    in.EndOffset,
+	})
+	if clip.ThumbnailURL == "" {
+		clip.ThumbnailURL = schedule.ThumbnailURL
+	}
+	// 切り抜き範囲と配信時間が重なるライブの商品を紐付ける（配信実績がない場合は開催予定日時を基準とする）
+	archiveStartAt := broadcast.StartedAt
+	if archiveStartAt.IsZero() {
+		archiveStartAt = schedule.StartAt
+	}
+	startAt, endAt := clip.Period(archiveStartAt)
+	productIDs := set.NewEmpty[string](len(lives))
+	for _, live := range lives {
+		if !live.StartAt.Before(endAt) || !live.EndAt.After(startAt) {
+			continue
+		}
+		productIDs.Add(live.ProductIDs...)
+	}
+	clip.ProductIDs = productIDs.Slice()
+	if err := s.db.BroadcastClip.Create(ctx, clip); err != nil {
+		return nil, internalError(err)
+	}
+	return clip, nil
+}
+
+func (s *service) DeleteBroadcastClip(ctx context.Context, in *media.DeleteBroadcastClipInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	var (
+		broadcast *entity.Broadcast
+		clip      *entity.BroadcastClip
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		broadcast, err = s.db.Broadcast.GetByScheduleID(ectx, in.ScheduleID)
+		return
+	})
+	eg.Go(func() (err error) {
+		clip, err = s.db.BroadcastClip.Get(ectx, in.ClipID)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return internalError(err)
+	}
+	if clip.BroadcastID != broadcast.ID {
+		return fmt.Errorf("service: this clip is not in broadcast: %w", exception.ErrNotFound)
+	}
+	if !clip.Deletable() {
+		return fmt.Errorf("service: this clip is being processed or already completed: %w", exception.ErrFailedPrecondition)
+	}
+	err := s.db.BroadcastClip.Delete(ctx, clip.ID)
+	return internalError(err)
+}
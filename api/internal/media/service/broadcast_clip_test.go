@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/internal/store"
+	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListBroadcastClips(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+	}
+	params := &database.ListBroadcastClipsParams{
+		BroadcastID: "broadcast-id",
+	}
+	clips := entity.BroadcastClips{
+		{
+			ID:          "clip-id",
+			BroadcastID: "broadcast-id",
+			ScheduleID:  "schedule-id",
+			Title:       "じゃがいもの収穫",
+			ProductIDs:  []string{"product-id"},
+			StartOffset: 60,
+			EndOffset:   180,
+			Status:      entity.BroadcastClipStatusWaiting,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.ListBroadcastClipsInput
+		expect    entity.BroadcastClips
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastClip.EXPECT().List(ctx, params).Return(clips, nil)
+			},
+			input: &media.ListBroadcastClipsInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    clips,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.ListBroadcastClipsInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(nil, assert.AnError)
+			},
+			input: &media.ListBroadcastClipsInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list clips",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastClip.EXPECT().List(ctx, params).Return(nil, assert.AnError)
+			},
+			input: &media.ListBroadcastClipsInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ListBroadcastClips(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestCreateBroadcastClip(t *testing.T) {
+	t.Parallel()
+
+	startAt := jst.Date(2022, 1, 1, 18, 0, 0, 0)
+	broadcast := &entity.Broadcast{
+		ID:            "broadcast-id",
+		ScheduleID:    "schedule-id",
+		CoordinatorID: "coordinator-id",
+		ArchiveURL:    "https://example.com/original.mp4",
+		StartedAt:     startAt.Add(-10 * time.Minute),
+		EndedAt:       startAt.Add(2 * time.Hour),
+	}
+	schedule := &sentity.Schedule{
+		ID:           "schedule-id",
+		ThumbnailURL: "https://example.com/schedule.png",
+		StartAt:      startAt,
+	}
+	scheduleIn := &store.GetScheduleInput{
+		ScheduleID: "schedule-id",
+	}
+	livesIn := &store.ListLivesInput{
+		ScheduleIDs: []string{"schedule-id"},
+		NoLimit:     true,
+	}
+	lives := sentity.Lives{
+		{
+			ID:         "live-id01",
+			ScheduleID: "schedule-id",
+			ProductIDs: []string{"product-id01", "product-id02"},
+			StartAt:    startAt,
+			EndAt:      startAt.Add(30 * time.Minute),
+		},
+		{
+			ID:         "live-id02",
+			ScheduleID: "schedule-id",
+			ProductIDs: []string{"product-id02", "product-id03"},
+			StartAt:    startAt.Add(30 * time.Minute),
+			EndAt:      startAt.Add(time.Hour),
+		},
+		{
+			ID:         "live-id03",
+			ScheduleID: "schedule-id",
+			ProductIDs: []string{"product-id04"},
+			StartAt:    startAt.Add(time.Hour),
+			EndAt:      startAt.Add(2 * time.Hour),
+		},
+	}
+	input := &media.CreateBroadcastClipInput{
+		ScheduleID:  "schedule-id",
+		Title:       "じゃがいもの収穫",
+		Description: "じゃがいもの収穫シーンです。",
+		StartOffset: 40 * 60,
+		EndOffset:   70 * 60,
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.CreateBroadcastClipInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(schedule, nil)
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(lives, int64(3), nil)
+				mocks.db.BroadcastClip.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, clip *entity.BroadcastClip) error {
+						assert.Equal(t, "broadcast-id", clip.BroadcastID)
+						assert.Equal(t, "coordinator-id", clip.CoordinatorID)
+						assert.Equal(t, "https://example.com/schedule.png", clip.ThumbnailURL)
+						assert.ElementsMatch(t, []string{"product-id02", "product-id03"}, clip.ProductIDs)
+						assert.Equal(t, entity.BroadcastClipStatusWaiting, clip.Status)
+						return nil
+					})
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.CreateBroadcastClipInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "invalid offset",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &media.CreateBroadcastClipInput{
+				ScheduleID:  "schedule-id",
+				Title:       "じゃがいもの収穫",
+				StartOffset: 60,
+				EndOffset:   60,
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(nil, assert.AnError)
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(schedule, nil).AnyTimes()
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(lives, int64(3), nil).AnyTimes()
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to get schedule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil).AnyTimes()
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(nil, assert.AnError)
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(lives, int64(3), nil).AnyTimes()
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list lives",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil).AnyTimes()
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(schedule, nil).AnyTimes()
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(nil, int64(0), assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "archive is not uploaded",
+			setup: func(ctx context.Context, mocks *mocks) {
+				broadcast := &entity.Broadcast{ID: "broadcast-id", ScheduleID: "schedule-id"}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(schedule, nil)
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(lives, int64(3), nil)
+			},
+			input:     input,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "success archive duration is unknown",
+			setup: func(ctx context.Context, mocks *mocks) {
+				broadcast := &entity.Broadcast{
+					ID:            "broadcast-id",
+					ScheduleID:    "schedule-id",
+					CoordinatorID: "coordinator-id",
+					ArchiveURL:    "https://example.com/fixed.mp4",
+					ArchiveFixed:  true,
+				}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(schedule, nil)
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(lives, int64(3), nil)
+				mocks.db.BroadcastClip.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, clip *entity.BroadcastClip) error {
+						assert.Equal(t, int64(40*60), clip.StartOffset)
+						assert.Equal(t, int64(70*60), clip.EndOffset)
+						assert.ElementsMatch(t, []string{"product-id02", "product-id03", "product-id04"}, clip.ProductIDs)
+						return nil
+					})
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "end offset exceeds archive duration",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(schedule, nil)
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(lives, int64(3), nil)
+			},
+			input: &media.CreateBroadcastClipInput{
+				ScheduleID:  "schedule-id",
+				Title:       "じゃがいもの収穫",
+				StartOffset: 2 * 60 * 60,
+				EndOffset:   3 * 60 * 60,
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to create clip",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.store.EXPECT().GetSchedule(gomock.Any(), scheduleIn).Return(schedule, nil)
+				mocks.store.EXPECT().ListLives(gomock.Any(), livesIn).Return(lives, int64(3), nil)
+				mocks.db.BroadcastClip.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CreateBroadcastClip(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestDeleteBroadcastClip(t *testing.T) {
+	t.Parallel()
+
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+	}
+	clip := &entity.BroadcastClip{
+		ID:          "clip-id",
+		BroadcastID: "broadcast-id",
+		Status:      entity.BroadcastClipStatusWaiting,
+	}
+	input := &media.DeleteBroadcastClipInput{
+		ScheduleID: "schedule-id",
+		ClipID:     "clip-id",
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.DeleteBroadcastClipInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastClip.EXPECT().Get(gomock.Any(), "clip-id").Return(clip, nil)
+				mocks.db.BroadcastClip.EXPECT().Delete(ctx, "clip-id").Return(nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.DeleteBroadcastClipInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(nil, assert.AnError)
+				mocks.db.BroadcastClip.EXPECT().Get(gomock.Any(), "clip-id").Return(clip, nil).AnyTimes()
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to get clip",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil).AnyTimes()
+				mocks.db.BroadcastClip.EXPECT().Get(gomock.Any(), "clip-id").Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "clip is not in broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				clip := &entity.BroadcastClip{ID: "clip-id", BroadcastID: "other-id", Status: entity.BroadcastClipStatusWaiting}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastClip.EXPECT().Get(gomock.Any(), "clip-id").Return(clip, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "clip is processing",
+			setup: func(ctx context.Context, mocks *mocks) {
+				clip := &entity.BroadcastClip{ID: "clip-id", BroadcastID: "broadcast-id", Status: entity.BroadcastClipStatusProcessing}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastClip.EXPECT().Get(gomock.Any(), "clip-id").Return(clip, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to delete clip",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastClip.EXPECT().Get(gomock.Any(), "clip-id").Return(clip, nil)
+				mocks.db.BroadcastClip.EXPECT().Delete(ctx, "clip-id").Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.DeleteBroadcastClip(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
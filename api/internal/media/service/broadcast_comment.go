@@ -39,15 +39,32 @@ func (s *service) CreateBroadcastComment(ctx context.Context, in *media.CreateBr
 	if err != nil {
 		return nil, internalError(err)
 	}
+	moderation := &moderateCommentParams{
+		targetType:    entity.CommentModerationTargetTypeBroadcast,
+		targetID:      broadcast.ID,
+		coordinatorID: broadcast.CoordinatorID,
+		userID:        in.UserID,
+		sessionID:     in.SessionID,
+		clientIP:      in.ClientIP,
+		content:       in.Content,
+	}
+	shadowBanned, err := s.moderateComment(ctx, moderation)
+	if err != nil {
+		return nil, err
+	}
 	params := &entity.BroadcastCommentParams{
 		BroadcastID: broadcast.ID,
 		UserID:      in.UserID,
+		SessionID:   in.SessionID,
+		ClientIP:    in.ClientIP,
 		Content:     in.Content,
+		Disabled:    shadowBanned,
 	}
 	comment := entity.NewBroadcastComment(params)
 	if err := s.db.BroadcastComment.Create(ctx, comment); err != nil {
 		return nil, internalError(err)
 	}
+	s.notifyBroadcastComment(ctx, broadcast, comment, moderation)
 	return comment, nil
 }
 
@@ -61,14 +78,30 @@ func (s *service) CreateBroadcastGuestComment(
 	if err != nil {
 		return nil, internalError(err)
 	}
+	moderation := &moderateCommentParams{
+		targetType:    entity.CommentModerationTargetTypeBroadcast,
+		targetID:      broadcast.ID,
+		coordinatorID: broadcast.CoordinatorID,
+		sessionID:     in.SessionID,
+		clientIP:      in.ClientIP,
+		content:       in.Content,
+	}
+	shadowBanned, err := s.moderateComment(ctx, moderation)
+	if err != nil {
+		return nil, err
+	}
 	params := &entity.BroadcastCommentParams{
 		BroadcastID: broadcast.ID,
+		SessionID:   in.SessionID,
+		ClientIP:    in.ClientIP,
 		Content:     in.Content,
+		Disabled:    shadowBanned,
 	}
 	comment := entity.NewBroadcastComment(params)
 	if err := s.db.BroadcastComment.Create(ctx, comment); err != nil {
 		return nil, internalError(err)
 	}
+	s.notifyBroadcastComment(ctx, broadcast, comment, moderation)
 	return comment, nil
 }
 
@@ -79,6 +112,23 @@ func (s *service) UpdateBroadcastComment(ctx context.Context, in *media.UpdateBr
 	params := &database.UpdateBroadcastCommentParams{
 		Disabled: in.Disabled,
 	}
-	err := s.db.BroadcastComment.Update(ctx, in.CommentID, params)
-	return internalError(err)
+	if err := s.db.BroadcastComment.Update(ctx, in.CommentID, params); err != nil {
+		return internalError(err)
+	}
+	if in.Disabled {
+		event := entity.NewBroadcastCommentDisabledEvent(in.ScheduleID, in.CommentID, s.now())
+		s.notifyBroadcastEvent(ctx, event)
+	}
+	return nil
+}
+
+// notifyBroadcastComment - 投稿されたコメントを視聴者へ配信する（シャドウバン対象の場合は配信せず履歴のみ記録する）
+func (s *service) notifyBroadcastComment(
+	ctx context.Context, broadcast *entity.Broadcast, comment *entity.BroadcastComment, moderation *moderateCommentParams,
+) {
+	if comment.Disabled {
+		s.createCommentModerationLog(ctx, moderation.log(entity.CommentModerationActionShadowBanned, comment.ID, ""))
+		return
+	}
+	s.notifyBroadcastEvent(ctx, entity.NewBroadcastCommentEvent(broadcast.ScheduleID, comment))
 }
@@ -9,8 +9,10 @@ import (
 	"github.com/and-period/furumaru/api/internal/media"
 	"github.com/and-period/furumaru/api/internal/media/database"
 	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
 	"go.uber.org/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListBroadcastComments(t *testing.T) {
@@ -148,6 +150,34 @@ func TestCreateBroadcastComment(t *testing.T) {
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
+	words := entity.CommentNgWords{
+		{ID: "word-id", CoordinatorID: "coordinator-id", Word: "ばか"},
+	}
+	wordsParams := &database.ListCommentNgWordsParams{
+		CoordinatorID: "coordinator-id",
+	}
+	mutesParams := &database.ListCommentMutesParams{
+		BroadcastID: "broadcast-id",
+		UserID:      "user-id",
+		SessionID:   "session-id",
+	}
+	userParams := &database.CountBroadcastCommentsParams{
+		BroadcastID:  "broadcast-id",
+		UserID:       "user-id",
+		CreatedAtGte: now.Add(-defaultCommentRateWindow),
+	}
+	clientParams := &database.CountBroadcastCommentsParams{
+		BroadcastID:  "broadcast-id",
+		ClientIP:     "127.0.0.1",
+		CreatedAtGte: now.Add(-defaultCommentRateWindow),
+	}
+	input := &media.CreateBroadcastCommentInput{
+		ScheduleID: "schedule-id",
+		UserID:     "user-id",
+		SessionID:  "session-id",
+		ClientIP:   "127.0.0.1",
+		Content:    "こんにちは",
+	}
 
 	tests := []struct {
 		name      string
@@ -159,6 +189,10 @@ func TestCreateBroadcastComment(t *testing.T) {
 			name: "success",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
 				mocks.db.BroadcastComment.EXPECT().
 					Create(ctx, gomock.Any()).
 					DoAndReturn(func(ctx context.Context, comment *entity.BroadcastComment) error {
@@ -166,18 +200,45 @@ func TestCreateBroadcastComment(t *testing.T) {
 							ID:          comment.ID, // ignore
 							BroadcastID: "broadcast-id",
 							UserID:      "user-id",
+							SessionID:   "session-id",
+							ClientIP:    "127.0.0.1",
 							Content:     "こんにちは",
 							Disabled:    false,
 						}
 						assert.Equal(t, expect, comment)
 						return nil
 					})
+				mocks.pubsub.EXPECT().Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).Return(&pubsub.Message{}, nil)
 			},
-			input: &media.CreateBroadcastCommentInput{
-				ScheduleID: "schedule-id",
-				UserID:     "user-id",
-				Content:    "こんにちは",
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "success with shadow ban",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mutes := entity.CommentMutes{
+					{ID: "mute-id", BroadcastID: "broadcast-id", UserID: "user-id", Type: entity.CommentMuteTypeShadowBan},
+				}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(mutes, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, comment *entity.BroadcastComment) error {
+						assert.True(t, comment.Disabled)
+						return nil
+					})
+				mocks.db.CommentModerationLog.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, log *entity.CommentModerationLog) error {
+						assert.Equal(t, entity.CommentModerationActionShadowBanned, log.Action)
+						assert.NotEmpty(t, log.CommentID)
+						return nil
+					})
 			},
+			input:     input,
 			expectErr: nil,
 		},
 		{
@@ -191,32 +252,151 @@ func TestCreateBroadcastComment(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(nil, assert.AnError)
 			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list ng words",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(nil, assert.AnError)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil).AnyTimes()
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil).AnyTimes()
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil).AnyTimes()
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "muted",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mutes := entity.CommentMutes{
+					{ID: "mute-id", BroadcastID: "broadcast-id", UserID: "user-id", Type: entity.CommentMuteTypeMute},
+				}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(mutes, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.CommentModerationLog.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, log *entity.CommentModerationLog) error {
+						assert.Equal(t, entity.CommentModerationActionMuted, log.Action)
+						return nil
+					})
+			},
+			input:     input,
+			expectErr: exception.ErrForbidden,
+		},
+		{
+			name: "rate limited by user",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(defaultCommentUserRateLimit), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.CommentModerationLog.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, log *entity.CommentModerationLog) error {
+						assert.Equal(t, entity.CommentModerationActionRateLimit, log.Action)
+						return nil
+					})
+			},
+			input:     input,
+			expectErr: exception.ErrResourceExhausted,
+		},
+		{
+			name: "rate limited by client ip",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(defaultCommentClientRateLimit), nil)
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			},
+			input:     input,
+			expectErr: exception.ErrResourceExhausted,
+		},
+		{
+			name: "contains ng word",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.CommentModerationLog.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, log *entity.CommentModerationLog) error {
+						assert.Equal(t, entity.CommentModerationActionNgWord, log.Action)
+						assert.Equal(t, "ばか", log.Detail)
+						assert.Equal(t, "バカじゃないの", log.Content)
+						return nil
+					})
+			},
 			input: &media.CreateBroadcastCommentInput{
 				ScheduleID: "schedule-id",
 				UserID:     "user-id",
-				Content:    "こんにちは",
+				SessionID:  "session-id",
+				ClientIP:   "127.0.0.1",
+				Content:    "バカじゃないの",
 			},
-			expectErr: exception.ErrInternal,
+			expectErr: exception.ErrInvalidArgument,
 		},
 		{
-			name: "failed to create broadcast comment",
+			name: "contains ng word and failed to create moderation log",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
-				mocks.db.BroadcastComment.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
 			},
 			input: &media.CreateBroadcastCommentInput{
 				ScheduleID: "schedule-id",
 				UserID:     "user-id",
-				Content:    "こんにちは",
+				SessionID:  "session-id",
+				ClientIP:   "127.0.0.1",
+				Content:    "ばかじゃないの",
 			},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to create broadcast comment",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input,
 			expectErr: exception.ErrInternal,
 		},
+		{
+			name: "failed to publish broadcast event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.pubsub.EXPECT().Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
 			_, err := service.CreateBroadcastComment(ctx, tt.input)
 			assert.ErrorIs(t, err, tt.expectErr)
-		}))
+		}, withNow(now)))
 	}
 }
 
@@ -234,6 +414,25 @@ func TestCreateBroadcastGuestComment(t *testing.T) {
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
+	wordsParams := &database.ListCommentNgWordsParams{
+		CoordinatorID: "coordinator-id",
+	}
+	mutesParams := &database.ListCommentMutesParams{
+		BroadcastID: "broadcast-id",
+		SessionID:   "session-id",
+		ClientIP:    "127.0.0.1",
+	}
+	clientParams := &database.CountBroadcastCommentsParams{
+		BroadcastID:  "broadcast-id",
+		ClientIP:     "127.0.0.1",
+		CreatedAtGte: now.Add(-defaultCommentRateWindow),
+	}
+	input := &media.CreateBroadcastGuestCommentInput{
+		ScheduleID: "schedule-id",
+		SessionID:  "session-id",
+		ClientIP:   "127.0.0.1",
+		Content:    "こんにちは",
+	}
 
 	tests := []struct {
 		name      string
@@ -245,6 +444,9 @@ func TestCreateBroadcastGuestComment(t *testing.T) {
 			name: "success",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(entity.CommentNgWords{}, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
 				mocks.db.BroadcastComment.EXPECT().
 					Create(ctx, gomock.Any()).
 					DoAndReturn(func(ctx context.Context, comment *entity.BroadcastComment) error {
@@ -252,12 +454,26 @@ func TestCreateBroadcastGuestComment(t *testing.T) {
 							ID:          comment.ID, // ignore
 							BroadcastID: "broadcast-id",
 							UserID:      "",
+							SessionID:   "session-id",
+							ClientIP:    "127.0.0.1",
 							Content:     "こんにちは",
 							Disabled:    false,
 						}
 						assert.Equal(t, expect, comment)
 						return nil
 					})
+				mocks.pubsub.EXPECT().Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).Return(&pubsub.Message{}, nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "success without session",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(entity.CommentNgWords{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.pubsub.EXPECT().Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).Return(&pubsub.Message{}, nil)
 			},
 			input: &media.CreateBroadcastGuestCommentInput{
 				ScheduleID: "schedule-id",
@@ -276,30 +492,70 @@ func TestCreateBroadcastGuestComment(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(nil, assert.AnError)
 			},
-			input: &media.CreateBroadcastGuestCommentInput{
-				ScheduleID: "schedule-id",
-				Content:    "こんにちは",
-			},
+			input:     input,
 			expectErr: exception.ErrInternal,
 		},
+		{
+			name: "muted",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mutes := entity.CommentMutes{
+					{ID: "mute-id", BroadcastID: "broadcast-id", SessionID: "session-id", Type: entity.CommentMuteTypeMute},
+				}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(entity.CommentNgWords{}, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(mutes, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			},
+			input:     input,
+			expectErr: exception.ErrForbidden,
+		},
+		{
+			name: "muted by client ip",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mutes := entity.CommentMutes{
+					{ID: "mute-id", BroadcastID: "broadcast-id", SessionID: "other-session-id", ClientIP: "127.0.0.1", Type: entity.CommentMuteTypeMute},
+				}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(entity.CommentNgWords{}, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(mutes, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			},
+			input:     input,
+			expectErr: exception.ErrForbidden,
+		},
 		{
 			name: "failed to create broadcast comment",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(entity.CommentNgWords{}, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
 				mocks.db.BroadcastComment.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
 			},
-			input: &media.CreateBroadcastGuestCommentInput{
-				ScheduleID: "schedule-id",
-				Content:    "こんにちは",
-			},
+			input:     input,
 			expectErr: exception.ErrInternal,
 		},
+		{
+			name: "failed to publish broadcast event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(entity.CommentNgWords{}, nil)
+				mocks.db.CommentMute.EXPECT().List(gomock.Any(), mutesParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.BroadcastComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
+				mocks.db.BroadcastComment.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.pubsub.EXPECT().Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
 			_, err := service.CreateBroadcastGuestComment(ctx, tt.input)
 			assert.ErrorIs(t, err, tt.expectErr)
-		}))
+		}, withNow(now)))
 	}
 }
 
@@ -318,10 +574,33 @@ func TestUpdateBroadcastComment(t *testing.T) {
 			name: "success",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.BroadcastComment.EXPECT().Update(ctx, "comment-id", params).Return(nil)
+				mocks.pubsub.EXPECT().
+					Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, topic string, data []byte) (*pubsub.Message, error) {
+						event, err := entity.NewBroadcastEventFromMessage(&pubsub.Message{Data: data})
+						require.NoError(t, err)
+						assert.Equal(t, entity.BroadcastEventTypeCommentDisabled, event.Type)
+						assert.Equal(t, "comment-id", event.Comment.CommentID)
+						return &pubsub.Message{}, nil
+					})
+			},
+			input: &media.UpdateBroadcastCommentInput{
+				ScheduleID: "schedule-id",
+				CommentID:  "comment-id",
+				Disabled:   true,
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success to enable",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.UpdateBroadcastCommentParams{Disabled: false}
+				mocks.db.BroadcastComment.EXPECT().Update(ctx, "comment-id", params).Return(nil)
 			},
 			input: &media.UpdateBroadcastCommentInput{
-				CommentID: "comment-id",
-				Disabled:  true,
+				ScheduleID: "schedule-id",
+				CommentID:  "comment-id",
+				Disabled:   false,
 			},
 			expectErr: nil,
 		},
@@ -337,8 +616,9 @@ func TestUpdateBroadcastComment(t *testing.T) {
 				mocks.db.BroadcastComment.EXPECT().Update(ctx, "comment-id", params).Return(assert.AnError)
 			},
 			input: &media.UpdateBroadcastCommentInput{
-				CommentID: "comment-id",
-				Disabled:  true,
+				ScheduleID: "schedule-id",
+				CommentID:  "comment-id",
+				Disabled:   true,
 			},
 			expectErr: exception.ErrInternal,
 		},
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/log"
+)
+
+func (s *service) SubscribeBroadcastEvents(
+	ctx context.Context, in *media.SubscribeBroadcastEventsInput,
+) (<-chan *entity.BroadcastEvent, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	if _, err := s.db.Broadcast.GetByScheduleID(ctx, in.ScheduleID); err != nil {
+		return nil, internalError(err)
+	}
+	msgs, err := s.pubsub.Subscribe(ctx, entity.BroadcastEventTopic(in.ScheduleID), in.Cursor)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	events := make(chan *entity.BroadcastEvent)
+	go func() {
+		defer close(events)
+		for msg := range msgs {
+			event, err := entity.NewBroadcastEventFromMessage(msg)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to decode broadcast event",
+					slog.String("scheduleId", in.ScheduleID), slog.String("messageId", msg.ID), log.Error(err))
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+func (s *service) PublishBroadcastPinEvent(ctx context.Context, in *media.PublishBroadcastPinEventInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	params := &entity.NewBroadcastPinEventParams{
+		ScheduleID: in.ScheduleID,
+		LivePinID:  in.LivePinID,
+		ProductID:  in.ProductID,
+		Pinned:     in.Pinned,
+		Offset:     in.Offset,
+		Now:        s.now(),
+	}
+	event := entity.NewBroadcastPinEvent(params)
+	return internalError(s.publishBroadcastEvent(ctx, event))
+}
+
+func (s *service) publishBroadcastEvent(ctx context.Context, event *entity.BroadcastEvent) error {
+	data, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = s.pubsub.Publish(ctx, event.Topic(), data)
+	return err
+}
+
+// notifyBroadcastEvent - イベントを配信する（配信に失敗した場合も処理は継続する）
+func (s *service) notifyBroadcastEvent(ctx context.Context, event *entity.BroadcastEvent) {
+	if err := s.publishBroadcastEvent(ctx, event); err != nil {
+		slog.WarnContext(ctx, "Failed to publish broadcast event",
+			slog.String("scheduleId", event.ScheduleID), slog.Int("type", int(event.Type)), log.Error(err))
+	}
+}
+
+// notifyBroadcastViewerCount - 視聴者数を配信する（配信間隔内の場合は何もしない）
+func (s *service) notifyBroadcastViewerCount(ctx context.Context, broadcast *entity.Broadcast) {
+	now := s.now()
+	if v, ok := s.viewerNotifiedAt.Load(broadcast.ScheduleID); ok && now.Sub(v.(time.Time)) < s.viewerInterval {
+		return
+	}
+	s.viewerNotifiedAt.Store(broadcast.ScheduleID, now)
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		ctx := context.WithoutCancel(ctx)
+		params := &database.GetBroadcastTotalViewersParams{
+			BroadcastID: broadcast.ID,
+		}
+		total, err := s.db.BroadcastViewerLog.GetTotal(ctx, params)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to get broadcast total viewers",
+				slog.String("broadcastId", broadcast.ID), log.Error(err))
+			return
+		}
+		s.notifyBroadcastEvent(ctx, entity.NewBroadcastViewerEvent(broadcast.ScheduleID, total, now))
+	}()
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubscribeBroadcastEvents(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+	}
+	event := entity.NewBroadcastViewerEvent("schedule-id", 120, now)
+	data, err := event.Marshal()
+	require.NoError(t, err)
+	newMessages := func() <-chan *pubsub.Message {
+		ch := make(chan *pubsub.Message, 2)
+		ch <- &pubsub.Message{ID: "invalid-id", Data: []byte("{")}
+		ch <- &pubsub.Message{ID: "message-id", Data: data}
+		close(ch)
+		return ch
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.SubscribeBroadcastEventsInput
+		expect    []*entity.BroadcastEvent
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.pubsub.EXPECT().Subscribe(ctx, "broadcast-events:schedule-id", "cursor").Return(newMessages(), nil)
+			},
+			input: &media.SubscribeBroadcastEventsInput{
+				ScheduleID: "schedule-id",
+				Cursor:     "cursor",
+			},
+			expect: []*entity.BroadcastEvent{
+				{
+					ID:         "message-id",
+					ScheduleID: "schedule-id",
+					Type:       entity.BroadcastEventTypeViewerCount,
+					Viewer:     &entity.BroadcastEventViewer{Total: 120},
+					CreatedAt:  now,
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.SubscribeBroadcastEventsInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(nil, assert.AnError)
+			},
+			input: &media.SubscribeBroadcastEventsInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to subscribe",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.pubsub.EXPECT().Subscribe(ctx, "broadcast-events:schedule-id", "").Return(nil, assert.AnError)
+			},
+			input: &media.SubscribeBroadcastEventsInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			events, err := service.SubscribeBroadcastEvents(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if err != nil {
+				return
+			}
+			var actual []*entity.BroadcastEvent
+			for event := range events {
+				event.CreatedAt = event.CreatedAt.In(now.Location())
+				actual = append(actual, event)
+			}
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestPublishBroadcastPinEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	input := &media.PublishBroadcastPinEventInput{
+		ScheduleID: "schedule-id",
+		LivePinID:  "pin-id",
+		ProductID:  "product-id",
+		Pinned:     true,
+		Offset:     60,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.PublishBroadcastPinEventInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.pubsub.EXPECT().
+					Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, topic string, data []byte) (*pubsub.Message, error) {
+						event, err := entity.NewBroadcastEventFromMessage(&pubsub.Message{Data: data})
+						require.NoError(t, err)
+						expect := &entity.BroadcastEventPin{
+							LivePinID: "pin-id",
+							ProductID: "product-id",
+							Offset:    60,
+						}
+						assert.Equal(t, entity.BroadcastEventTypeProductPinned, event.Type)
+						assert.Equal(t, expect, event.Pin)
+						return &pubsub.Message{}, nil
+					})
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.PublishBroadcastPinEventInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to publish",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.pubsub.EXPECT().Publish(ctx, "broadcast-events:schedule-id", gomock.Any()).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.PublishBroadcastPinEvent(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
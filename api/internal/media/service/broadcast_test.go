@@ -362,6 +362,227 @@ func TestUpdateBroadcastArchive(t *testing.T) {
 	}
 }
 
+func TestGetBroadcastCaption(t *testing.T) {
+	t.Parallel()
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+		Status:     entity.BroadcastStatusDisabled,
+		ArchiveURL: "http://example.com/schedules/archives/schedule-id/mp4/original.mp4",
+		ArchiveMetadata: &entity.BroadcastArchiveMetadata{
+			Subtitles: map[string]string{
+				"ja": "http://example.com/schedules/archives/schedule-id/text/caption-ja.vtt",
+			},
+		},
+	}
+	content := []byte("WEBVTT\n\n00:00:00.000 --> 00:00:01.000\nこんにちは\n")
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.GetBroadcastCaptionInput
+		expect    *entity.BroadcastCaption
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.storage.EXPECT().
+					DownloadAndReadAll(ctx, "http://example.com/schedules/archives/schedule-id/text/caption-ja.vtt").
+					Return(content, nil)
+			},
+			input: &media.GetBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "ja",
+			},
+			expect: &entity.BroadcastCaption{
+				Language: "ja",
+				URL:      "http://example.com/schedules/archives/schedule-id/text/caption-ja.vtt",
+				Content:  content,
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.GetBroadcastCaptionInput{ScheduleID: "schedule-id", Language: "fr"},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(nil, assert.AnError)
+			},
+			input: &media.GetBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "ja",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "caption is not found",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+			},
+			input: &media.GetBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "en",
+			},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "failed to download caption",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.storage.EXPECT().
+					DownloadAndReadAll(ctx, "http://example.com/schedules/archives/schedule-id/text/caption-ja.vtt").
+					Return(nil, assert.AnError)
+			},
+			input: &media.GetBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "ja",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetBroadcastCaption(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestUpdateBroadcastCaption(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+		Status:     entity.BroadcastStatusDisabled,
+		ArchiveURL: "http://example.com/schedules/archives/schedule-id/mp4/original.mp4",
+		ArchiveMetadata: &entity.BroadcastArchiveMetadata{
+			Subtitles: map[string]string{
+				"ja": "http://example.com/schedules/archives/schedule-id/text/caption-ja.vtt",
+				"en": "http://example.com/schedules/archives/schedule-id/text/caption-en.vtt",
+			},
+		},
+	}
+	content := "WEBVTT\n\n00:00:00.000 --> 00:00:01.000\nHello\n"
+	key := "schedules/archives/schedule-id/text/caption-en-20261018183000.vtt"
+	dbParams := &database.UpdateBroadcastParams{
+		UpdateBroadcastCaptionParams: &database.UpdateBroadcastCaptionParams{
+			CaptionStatus: entity.BroadcastCaptionStatusPublished,
+			ArchiveMetadata: &entity.BroadcastArchiveMetadata{
+				Subtitles: map[string]string{
+					"ja": "http://example.com/schedules/archives/schedule-id/text/caption-ja.vtt",
+					"en": "http://example.com/" + key,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.UpdateBroadcastCaptionInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.storage.EXPECT().Upload(ctx, key, gomock.Any(), gomock.Any()).Return("", nil)
+				mocks.db.Broadcast.EXPECT().Update(ctx, "broadcast-id", dbParams).Return(nil)
+			},
+			input: &media.UpdateBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "en",
+				Content:    content,
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.UpdateBroadcastCaptionInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "invalid webvtt",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &media.UpdateBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "en",
+				Content:    "Hello",
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(nil, assert.AnError)
+			},
+			input: &media.UpdateBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "en",
+				Content:    content,
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "broadcast has no archive",
+			setup: func(ctx context.Context, mocks *mocks) {
+				broadcast := &entity.Broadcast{Status: entity.BroadcastStatusDisabled}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+			},
+			input: &media.UpdateBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "en",
+				Content:    content,
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to upload caption",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.storage.EXPECT().Upload(ctx, key, gomock.Any(), gomock.Any()).Return("", assert.AnError)
+			},
+			input: &media.UpdateBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "en",
+				Content:    content,
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to update broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.storage.EXPECT().Upload(ctx, key, gomock.Any(), gomock.Any()).Return("", nil)
+				mocks.db.Broadcast.EXPECT().Update(ctx, "broadcast-id", dbParams).Return(assert.AnError)
+			},
+			input: &media.UpdateBroadcastCaptionInput{
+				ScheduleID: "schedule-id",
+				Language:   "en",
+				Content:    content,
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.UpdateBroadcastCaption(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
 func TestPauseBroadcast(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -25,8 +25,11 @@ func (s *service) CreateBroadcastViewerLog(ctx context.Context, in *media.Create
 		ClientIP:    in.ClientIP,
 	}
 	log := entity.NewBroadcastViewerLog(params)
-	err = s.db.BroadcastViewerLog.Create(ctx, log)
-	return internalError(err)
+	if err := s.db.BroadcastViewerLog.Create(ctx, log); err != nil {
+		return internalError(err)
+	}
+	s.notifyBroadcastViewerCount(ctx, broadcast)
+	return nil
 }
 
 func (s *service) AggregateBroadcastViewerLogs(
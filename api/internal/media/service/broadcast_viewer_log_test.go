@@ -10,6 +10,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/media/database"
 	"github.com/and-period/furumaru/api/internal/media/entity"
 	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
 	"go.uber.org/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,6 +35,9 @@ func TestCreateBrodcastViewerLog(t *testing.T) {
 		UserAgent:   "user-agent",
 		ClientIP:    "127.0.0.1",
 	}
+	totalParams := &database.GetBroadcastTotalViewersParams{
+		BroadcastID: "broadcast-id",
+	}
 
 	tests := []struct {
 		name   string
@@ -46,6 +50,24 @@ func TestCreateBrodcastViewerLog(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
 				mocks.db.BroadcastViewerLog.EXPECT().Create(ctx, log).Return(nil)
+				mocks.db.BroadcastViewerLog.EXPECT().GetTotal(gomock.Any(), totalParams).Return(int64(120), nil)
+				mocks.pubsub.EXPECT().Publish(gomock.Any(), "broadcast-events:schedule-id", gomock.Any()).Return(&pubsub.Message{}, nil)
+			},
+			input: &media.CreateBroadcastViewerLogInput{
+				ScheduleID: "schedule-id",
+				SessionID:  "session-id",
+				UserID:     "user-id",
+				UserAgent:  "user-agent",
+				ClientIP:   "127.0.0.1",
+			},
+			expect: nil,
+		},
+		{
+			name: "success without total viewers",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastViewerLog.EXPECT().Create(ctx, log).Return(nil)
+				mocks.db.BroadcastViewerLog.EXPECT().GetTotal(gomock.Any(), totalParams).Return(int64(0), assert.AnError)
 			},
 			input: &media.CreateBroadcastViewerLogInput{
 				ScheduleID: "schedule-id",
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"golang.org/x/sync/errgroup"
+)
+
+func (s *service) ListCommentNgWords(
+	ctx context.Context, in *media.ListCommentNgWordsInput,
+) (entity.CommentNgWords, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListCommentNgWordsParams{
+		CoordinatorID: in.CoordinatorID,
+		Limit:         int(in.Limit),
+		Offset:        int(in.Offset),
+	}
+	if in.NoLimit {
+		params.Limit = 0
+	}
+	var (
+		words entity.CommentNgWords
+		total int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		words, err = s.db.CommentNgWord.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.CommentNgWord.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return words, total, nil
+}
+
+func (s *service) CreateCommentNgWord(ctx context.Context, in *media.CreateCommentNgWordInput) (*entity.CommentNgWord, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	if strings.TrimSpace(in.Word) == "" {
+		return nil, fmt.Errorf("service: ng word is blank: %w", exception.ErrInvalidArgument)
+	}
+	params := &entity.NewCommentNgWordParams{
+		CoordinatorID: in.CoordinatorID,
+		Word:          in.Word,
+	}
+	word := entity.NewCommentNgWord(params)
+	if err := s.db.CommentNgWord.Create(ctx, word); err != nil {
+		return nil, internalError(err)
+	}
+	return word, nil
+}
+
+func (s *service) DeleteCommentNgWord(ctx context.Context, in *media.DeleteCommentNgWordInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	word, err := s.db.CommentNgWord.Get(ctx, in.NgWordID)
+	if err != nil {
+		return internalError(err)
+	}
+	if word.CoordinatorID != in.CoordinatorID {
+		return fmt.Errorf("service: this ng word is not owned by coordinator: %w", exception.ErrNotFound)
+	}
+	err = s.db.CommentNgWord.Delete(ctx, word.ID)
+	return internalError(err)
+}
+
+func (s *service) ListBroadcastCommentMutes(
+	ctx context.Context, in *media.ListBroadcastCommentMutesInput,
+) (entity.CommentMutes, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	broadcast, err := s.db.Broadcast.GetByScheduleID(ctx, in.ScheduleID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.ListCommentMutesParams{
+		BroadcastID: broadcast.ID,
+	}
+	mutes, err := s.db.CommentMute.List(ctx, params)
+	return mutes, internalError(err)
+}
+
+func (s *service) CreateBroadcastCommentMute(
+	ctx context.Context, in *media.CreateBroadcastCommentMuteInput,
+) (*entity.CommentMute, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	var (
+		broadcast *entity.Broadcast
+		comment   *entity.BroadcastComment
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		broadcast, err = s.db.Broadcast.GetByScheduleID(ectx, in.ScheduleID)
+		return
+	})
+	eg.Go(func() (err error) {
+		comment, err = s.db.BroadcastComment.Get(ectx, in.CommentID)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	if comment.BroadcastID != broadcast.ID {
+		return nil, fmt.Errorf("service: this comment is not in broadcast: %w", exception.ErrNotFound)
+	}
+	if comment.UserID == "" && comment.SessionID == "" {
+		return nil, fmt.Errorf("service: this comment author cannot be identified: %w", exception.ErrFailedPrecondition)
+	}
+	params := &entity.NewCommentMuteParams{
+		BroadcastID: broadcast.ID,
+		UserID:      comment.UserID,
+		SessionID:   comment.SessionID,
+		ClientIP:    comment.ClientIP,
+		Type:        in.Type,
+		Reason:      in.Reason,
+		AdminID:     in.AdminID,
+	}
+	mute := entity.NewCommentMute(params)
+	listParams := &database.ListCommentMutesParams{
+		BroadcastID: broadcast.ID,
+		UserID:      mute.UserID,
+		SessionID:   mute.SessionID,
+	}
+	mutes, err := s.db.CommentMute.List(ctx, listParams)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if len(mutes) > 0 {
+		return nil, fmt.Errorf("service: this comment author is already muted: %w", exception.ErrAlreadyExists)
+	}
+	if err := s.db.CommentMute.Create(ctx, mute); err != nil {
+		return nil, internalError(err)
+	}
+	s.createCommentModerationLog(ctx, &entity.NewCommentModerationLogParams{
+		TargetType: entity.CommentModerationTargetTypeBroadcast,
+		TargetID:   broadcast.ID,
+		Action:     entity.CommentModerationActionMuteCreated,
+		CommentID:  comment.ID,
+		UserID:     mute.UserID,
+		SessionID:  mute.SessionID,
+		ClientIP:   mute.ClientIP,
+		Content:    comment.Content,
+		Detail:     mute.Reason,
+		AdminID:    in.AdminID,
+	})
+	return mute, nil
+}
+
+func (s *service) DeleteBroadcastCommentMute(ctx context.Context, in *media.DeleteBroadcastCommentMuteInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	var (
+		broadcast *entity.Broadcast
+		mute      *entity.CommentMute
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		broadcast, err = s.db.Broadcast.GetByScheduleID(ectx, in.ScheduleID)
+		return
+	})
+	eg.Go(func() (err error) {
+		mute, err = s.db.CommentMute.Get(ectx, in.MuteID)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return internalError(err)
+	}
+	if mute.BroadcastID != broadcast.ID {
+		return fmt.Errorf("service: this mute is not in broadcast: %w", exception.ErrNotFound)
+	}
+	if err := s.db.CommentMute.Delete(ctx, mute.ID); err != nil {
+		return internalError(err)
+	}
+	s.createCommentModerationLog(ctx, &entity.NewCommentModerationLogParams{
+		TargetType: entity.CommentModerationTargetTypeBroadcast,
+		TargetID:   broadcast.ID,
+		Action:     entity.CommentModerationActionMuteDeleted,
+		UserID:     mute.UserID,
+		SessionID:  mute.SessionID,
+		ClientIP:   mute.ClientIP,
+		AdminID:    in.AdminID,
+	})
+	return nil
+}
+
+func (s *service) ListCommentModerationLogs(
+	ctx context.Context, in *media.ListCommentModerationLogsInput,
+) (entity.CommentModerationLogs, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListCommentModerationLogsParams{
+		TargetType: in.TargetType,
+		TargetID:   in.TargetID,
+		Limit:      int(in.Limit),
+		Offset:     int(in.Offset),
+	}
+	var (
+		logs  entity.CommentModerationLogs
+		total int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		logs, err = s.db.CommentModerationLog.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.CommentModerationLog.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return logs, total, nil
+}
+
+type moderateCommentParams struct {
+	targetType    entity.CommentModerationTargetType
+	targetID      string
+	coordinatorID string
+	userID        string
+	sessionID     string
+	clientIP      string
+	content       string
+}
+
+func (p *moderateCommentParams) log(action entity.CommentModerationAction, commentID, detail string) *entity.NewCommentModerationLogParams {
+	return &entity.NewCommentModerationLogParams{
+		TargetType: p.targetType,
+		TargetID:   p.targetID,
+		Action:     action,
+		CommentID:  commentID,
+		UserID:     p.userID,
+		SessionID:  p.sessionID,
+		ClientIP:   p.clientIP,
+		Content:    p.content,
+		Detail:     detail,
+	}
+}
+
+// moderateComment - コメント投稿前の自動モデレーション
+// 投稿を受け付けない場合はエラーを、シャドウバン対象の投稿者の場合は true を返す
+func (s *service) moderateComment(ctx context.Context, params *moderateCommentParams) (bool, error) {
+	var (
+		words       entity.CommentNgWords
+		mutes       entity.CommentMutes
+		userTotal   int64
+		clientTotal int64
+	)
+	since := s.now().Add(-s.commentRateWindow)
+	eg, ectx := errgroup.WithContext(ctx)
+	if params.coordinatorID != "" {
+		eg.Go(func() (err error) {
+			in := &database.ListCommentNgWordsParams{
+				CoordinatorID: params.coordinatorID,
+			}
+			words, err = s.db.CommentNgWord.List(ectx, in)
+			return
+		})
+	}
+	// ミュートはライブ配信単位でのみ管理する
+	if params.targetType == entity.CommentModerationTargetTypeBroadcast && (params.userID != "" || params.sessionID != "") {
+		eg.Go(func() (err error) {
+			in := &database.ListCommentMutesParams{
+				BroadcastID: params.targetID,
+				UserID:      params.userID,
+				SessionID:   params.sessionID,
+			}
+			if params.userID == "" {
+				in.ClientIP = params.clientIP // ゲストはセッションIDを破棄してもIPアドレスで照合する
+			}
+			mutes, err = s.db.CommentMute.List(ectx, in)
+			return
+		})
+	}
+	if params.userID != "" && s.commentUserRateLimit > 0 {
+		eg.Go(func() (err error) {
+			userTotal, err = s.countComments(ectx, params, params.userID, "", since)
+			return
+		})
+	}
+	if params.clientIP != "" && s.commentClientRateLimit > 0 {
+		eg.Go(func() (err error) {
+			clientTotal, err = s.countComments(ectx, params, "", params.clientIP, since)
+			return
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return false, internalError(err)
+	}
+	mute := mutes.Find(params.userID, params.sessionID, params.clientIP)
+	if mute != nil && mute.Type == entity.CommentMuteTypeMute {
+		s.createCommentModerationLog(ctx, params.log(entity.CommentModerationActionMuted, "", mute.Reason))
+		return false, fmt.Errorf("service: this user is muted: %w", exception.ErrForbidden)
+	}
+	if (s.commentUserRateLimit > 0 && userTotal >= s.commentUserRateLimit) ||
+		(s.commentClientRateLimit > 0 && clientTotal >= s.commentClientRateLimit) {
+		s.createCommentModerationLog(ctx, params.log(entity.CommentModerationActionRateLimit, "", ""))
+		return false, fmt.Errorf("service: too many comments: %w", exception.ErrResourceExhausted)
+	}
+	if word, ok := words.Match(params.content); ok {
+		s.createCommentModerationLog(ctx, params.log(entity.CommentModerationActionNgWord, "", word.Word))
+		return false, fmt.Errorf("service: this comment contains ng word: %w", exception.ErrInvalidArgument)
+	}
+	return mute != nil, nil
+}
+
+func (s *service) countComments(
+	ctx context.Context, params *moderateCommentParams, userID, clientIP string, since time.Time,
+) (int64, error) {
+	switch params.targetType {
+	case entity.CommentModerationTargetTypeBroadcast:
+		in := &database.CountBroadcastCommentsParams{
+			BroadcastID:  params.targetID,
+			UserID:       userID,
+			ClientIP:     clientIP,
+			CreatedAtGte: since,
+		}
+		return s.db.BroadcastComment.Count(ctx, in)
+	case entity.CommentModerationTargetTypeVideo:
+		in := &database.CountVideoCommentsParams{
+			VideoID:      params.targetID,
+			UserID:       userID,
+			ClientIP:     clientIP,
+			CreatedAtGte: since,
+		}
+		return s.db.VideoComment.Count(ctx, in)
+	default:
+		return 0, fmt.Errorf("service: unknown moderation target type: %w", exception.ErrInvalidArgument)
+	}
+}
+
+// createCommentModerationLog - モデレーション履歴を記録する（記録に失敗した場合も処理は継続する）
+func (s *service) createCommentModerationLog(ctx context.Context, params *entity.NewCommentModerationLogParams) {
+	l := entity.NewCommentModerationLog(params)
+	if err := s.db.CommentModerationLog.Create(ctx, l); err != nil {
+		slog.WarnContext(ctx, "Failed to create comment moderation log",
+			slog.String("targetId", params.TargetID), slog.Int("action", int(params.Action)), log.Error(err))
+	}
+}
@@ -0,0 +1,739 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/media"
+	"github.com/and-period/furumaru/api/internal/media/database"
+	"github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCommentNgWords(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	params := &database.ListCommentNgWordsParams{
+		CoordinatorID: "coordinator-id",
+		Limit:         20,
+		Offset:        0,
+	}
+	words := entity.CommentNgWords{
+		{
+			ID:            "word-id",
+			CoordinatorID: "coordinator-id",
+			Word:          "ばか",
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *media.ListCommentNgWordsInput
+		expect      entity.CommentNgWords
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), params).Return(words, nil)
+				mocks.db.CommentNgWord.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &media.ListCommentNgWordsInput{
+				CoordinatorID: "coordinator-id",
+				Limit:         20,
+				Offset:        0,
+			},
+			expect:      words,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name: "success with no limit",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.ListCommentNgWordsParams{CoordinatorID: "coordinator-id"}
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), params).Return(words, nil)
+				mocks.db.CommentNgWord.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &media.ListCommentNgWordsInput{
+				CoordinatorID: "coordinator-id",
+				Limit:         20,
+				NoLimit:       true,
+			},
+			expect:      words,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &media.ListCommentNgWordsInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list ng words",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.CommentNgWord.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &media.ListCommentNgWordsInput{
+				CoordinatorID: "coordinator-id",
+				Limit:         20,
+				Offset:        0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+		{
+			name: "failed to count ng words",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), params).Return(words, nil)
+				mocks.db.CommentNgWord.EXPECT().Count(gomock.Any(), params).Return(int64(0), assert.AnError)
+			},
+			input: &media.ListCommentNgWordsInput{
+				CoordinatorID: "coordinator-id",
+				Limit:         20,
+				Offset:        0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListCommentNgWords(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}))
+	}
+}
+
+func TestCreateCommentNgWord(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.CreateCommentNgWordInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, word *entity.CommentNgWord) error {
+						expect := &entity.CommentNgWord{
+							ID:            word.ID, // ignore
+							CoordinatorID: "coordinator-id",
+							Word:          "ばか",
+						}
+						assert.Equal(t, expect, word)
+						return nil
+					})
+			},
+			input: &media.CreateCommentNgWordInput{
+				CoordinatorID: "coordinator-id",
+				Word:          " ばか ",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.CreateCommentNgWordInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "blank word",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &media.CreateCommentNgWordInput{
+				CoordinatorID: "coordinator-id",
+				Word:          "　",
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to create ng word",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &media.CreateCommentNgWordInput{
+				CoordinatorID: "coordinator-id",
+				Word:          "ばか",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CreateCommentNgWord(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestDeleteCommentNgWord(t *testing.T) {
+	t.Parallel()
+
+	word := &entity.CommentNgWord{
+		ID:            "word-id",
+		CoordinatorID: "coordinator-id",
+		Word:          "ばか",
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.DeleteCommentNgWordInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().Get(ctx, "word-id").Return(word, nil)
+				mocks.db.CommentNgWord.EXPECT().Delete(ctx, "word-id").Return(nil)
+			},
+			input: &media.DeleteCommentNgWordInput{
+				CoordinatorID: "coordinator-id",
+				NgWordID:      "word-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.DeleteCommentNgWordInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get ng word",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().Get(ctx, "word-id").Return(nil, assert.AnError)
+			},
+			input: &media.DeleteCommentNgWordInput{
+				CoordinatorID: "coordinator-id",
+				NgWordID:      "word-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "not owned by coordinator",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().Get(ctx, "word-id").Return(word, nil)
+			},
+			input: &media.DeleteCommentNgWordInput{
+				CoordinatorID: "other-id",
+				NgWordID:      "word-id",
+			},
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "failed to delete ng word",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentNgWord.EXPECT().Get(ctx, "word-id").Return(word, nil)
+				mocks.db.CommentNgWord.EXPECT().Delete(ctx, "word-id").Return(assert.AnError)
+			},
+			input: &media.DeleteCommentNgWordInput{
+				CoordinatorID: "coordinator-id",
+				NgWordID:      "word-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.DeleteCommentNgWord(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestListBroadcastCommentMutes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+	}
+	params := &database.ListCommentMutesParams{
+		BroadcastID: "broadcast-id",
+	}
+	mutes := entity.CommentMutes{
+		{
+			ID:          "mute-id",
+			BroadcastID: "broadcast-id",
+			UserID:      "user-id",
+			Type:        entity.CommentMuteTypeMute,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.ListBroadcastCommentMutesInput
+		expect    entity.CommentMutes
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, params).Return(mutes, nil)
+			},
+			input: &media.ListBroadcastCommentMutesInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    mutes,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.ListBroadcastCommentMutesInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(nil, assert.AnError)
+			},
+			input: &media.ListBroadcastCommentMutesInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list mutes",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(ctx, "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, params).Return(nil, assert.AnError)
+			},
+			input: &media.ListBroadcastCommentMutesInput{
+				ScheduleID: "schedule-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ListBroadcastCommentMutes(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestCreateBroadcastCommentMute(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+	}
+	comment := &entity.BroadcastComment{
+		ID:          "comment-id",
+		BroadcastID: "broadcast-id",
+		UserID:      "user-id",
+		SessionID:   "session-id",
+		Content:     "荒らしコメント",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	guestComment := &entity.BroadcastComment{
+		ID:          "comment-id",
+		BroadcastID: "broadcast-id",
+		SessionID:   "session-id",
+		ClientIP:    "127.0.0.1",
+		Content:     "荒らしコメント",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	userParams := &database.ListCommentMutesParams{
+		BroadcastID: "broadcast-id",
+		UserID:      "user-id",
+	}
+	guestParams := &database.ListCommentMutesParams{
+		BroadcastID: "broadcast-id",
+		SessionID:   "session-id",
+	}
+	input := &media.CreateBroadcastCommentMuteInput{
+		ScheduleID: "schedule-id",
+		CommentID:  "comment-id",
+		Type:       entity.CommentMuteTypeShadowBan,
+		Reason:     "荒らし行為",
+		AdminID:    "admin-id",
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.CreateBroadcastCommentMuteInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, userParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.CommentMute.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, mute *entity.CommentMute) error {
+						expect := &entity.CommentMute{
+							ID:          mute.ID, // ignore
+							BroadcastID: "broadcast-id",
+							UserID:      "user-id",
+							Type:        entity.CommentMuteTypeShadowBan,
+							Reason:      "荒らし行為",
+							AdminID:     "admin-id",
+						}
+						assert.Equal(t, expect, mute)
+						return nil
+					})
+				mocks.db.CommentModerationLog.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, log *entity.CommentModerationLog) error {
+						assert.Equal(t, entity.CommentModerationActionMuteCreated, log.Action)
+						assert.Equal(t, "comment-id", log.CommentID)
+						assert.Equal(t, "admin-id", log.AdminID)
+						return nil
+					})
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "success guest",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(guestComment, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, guestParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.CommentMute.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, mute *entity.CommentMute) error {
+						expect := &entity.CommentMute{
+							ID:          mute.ID, // ignore
+							BroadcastID: "broadcast-id",
+							SessionID:   "session-id",
+							ClientIP:    "127.0.0.1",
+							Type:        entity.CommentMuteTypeShadowBan,
+							Reason:      "荒らし行為",
+							AdminID:     "admin-id",
+						}
+						assert.Equal(t, expect, mute)
+						return nil
+					})
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "success with failed to create moderation log",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, userParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.CommentMute.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.CreateBroadcastCommentMuteInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(nil, assert.AnError)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil).AnyTimes()
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to get comment",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil).AnyTimes()
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "comment is not in broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				comment := &entity.BroadcastComment{ID: "comment-id", BroadcastID: "other-id", UserID: "user-id"}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "comment author cannot be identified",
+			setup: func(ctx context.Context, mocks *mocks) {
+				comment := &entity.BroadcastComment{ID: "comment-id", BroadcastID: "broadcast-id"}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to list mutes",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, userParams).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "already muted",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mutes := entity.CommentMutes{{ID: "mute-id", BroadcastID: "broadcast-id", UserID: "user-id"}}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, userParams).Return(mutes, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrAlreadyExists,
+		},
+		{
+			name: "failed to create mute",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.BroadcastComment.EXPECT().Get(gomock.Any(), "comment-id").Return(comment, nil)
+				mocks.db.CommentMute.EXPECT().List(ctx, userParams).Return(entity.CommentMutes{}, nil)
+				mocks.db.CommentMute.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CreateBroadcastCommentMute(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestDeleteBroadcastCommentMute(t *testing.T) {
+	t.Parallel()
+
+	broadcast := &entity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+	}
+	mute := &entity.CommentMute{
+		ID:          "mute-id",
+		BroadcastID: "broadcast-id",
+		UserID:      "user-id",
+		Type:        entity.CommentMuteTypeMute,
+	}
+	input := &media.DeleteBroadcastCommentMuteInput{
+		ScheduleID: "schedule-id",
+		MuteID:     "mute-id",
+		AdminID:    "admin-id",
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *media.DeleteBroadcastCommentMuteInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentMute.EXPECT().Get(gomock.Any(), "mute-id").Return(mute, nil)
+				mocks.db.CommentMute.EXPECT().Delete(ctx, "mute-id").Return(nil)
+				mocks.db.CommentModerationLog.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, log *entity.CommentModerationLog) error {
+						assert.Equal(t, entity.CommentModerationActionMuteDeleted, log.Action)
+						assert.Equal(t, "user-id", log.UserID)
+						assert.Equal(t, "admin-id", log.AdminID)
+						return nil
+					})
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &media.DeleteBroadcastCommentMuteInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(nil, assert.AnError)
+				mocks.db.CommentMute.EXPECT().Get(gomock.Any(), "mute-id").Return(mute, nil).AnyTimes()
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to get mute",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil).AnyTimes()
+				mocks.db.CommentMute.EXPECT().Get(gomock.Any(), "mute-id").Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "mute is not in broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mute := &entity.CommentMute{ID: "mute-id", BroadcastID: "other-id"}
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentMute.EXPECT().Get(gomock.Any(), "mute-id").Return(mute, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "failed to delete mute",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Broadcast.EXPECT().GetByScheduleID(gomock.Any(), "schedule-id").Return(broadcast, nil)
+				mocks.db.CommentMute.EXPECT().Get(gomock.Any(), "mute-id").Return(mute, nil)
+				mocks.db.CommentMute.EXPECT().Delete(ctx, "mute-id").Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.DeleteBroadcastCommentMute(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestListCommentModerationLogs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	params := &database.ListCommentModerationLogsParams{
+		TargetType: entity.CommentModerationTargetTypeBroadcast,
+		TargetID:   "broadcast-id",
+		Limit:      20,
+		Offset:     0,
+	}
+	logs := entity.CommentModerationLogs{
+		{
+			ID:         "log-id",
+			TargetType: entity.CommentModerationTargetTypeBroadcast,
+			TargetID:   "broadcast-id",
+			Action:     entity.CommentModerationActionNgWord,
+			UserID:     "user-id",
+			Content:    "ばかじゃないの",
+			Detail:     "ばか",
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		},
+	}
+	input := &media.ListCommentModerationLogsInput{
+		TargetType: entity.CommentModerationTargetTypeBroadcast,
+		TargetID:   "broadcast-id",
+		Limit:      20,
+		Offset:     0,
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *media.ListCommentModerationLogsInput
+		expect      entity.CommentModerationLogs
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentModerationLog.EXPECT().List(gomock.Any(), params).Return(logs, nil)
+				mocks.db.CommentModerationLog.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input:       input,
+			expect:      logs,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &media.ListCommentModerationLogsInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list logs",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentModerationLog.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.CommentModerationLog.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input:       input,
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+		{
+			name: "failed to count logs",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.CommentModerationLog.EXPECT().List(gomock.Any(), params).Return(logs, nil)
+				mocks.db.CommentModerationLog.EXPECT().Count(gomock.Any(), params).Return(int64(0), assert.AnError)
+			},
+			input:       input,
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListCommentModerationLogs(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}))
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
 	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/and-period/furumaru/api/pkg/medialive"
+	"github.com/and-period/furumaru/api/pkg/pubsub"
 	"github.com/and-period/furumaru/api/pkg/sqs"
 	"github.com/and-period/furumaru/api/pkg/storage"
 	"github.com/and-period/furumaru/api/pkg/uuid"
@@ -28,6 +29,11 @@ import (
 const (
 	defaultUploadEventTTL = 12 * time.Hour     // 12hours
 	defaultAuthYoutubeTTL = 3 * 24 * time.Hour // 3days
+	defaultViewerInterval = 10 * time.Second   // 10seconds
+
+	defaultCommentRateWindow      = 10 * time.Second // 10seconds
+	defaultCommentUserRateLimit   = 5                // 同一ユーザーの投稿上限数（集計期間内）
+	defaultCommentClientRateLimit = 20               // 同一IPアドレスの投稿上限数（集計期間内）
 )
 
 type Params struct {
@@ -42,6 +48,7 @@ type Params struct {
 	User                         user.Service
 	Store                        store.Service
 	Youtube                      youtube.Youtube
+	PubSub                       pubsub.PubSub
 	BatchUpdateArchiveDefinition string
 	BatchUpdateArchiveQueue      string
 	BatchUpdateArchiveCommand    func(broadcastID string) []string
@@ -62,18 +69,28 @@ type service struct {
 	store                        store.Service
 	media                        medialive.MediaLive
 	youtube                      youtube.Youtube
+	pubsub                       pubsub.PubSub
 	now                          func() time.Time
 	generateID                   func() string
 	uploadEventTTL               time.Duration
 	authYoutubeTTL               time.Duration
+	viewerInterval               time.Duration
+	viewerNotifiedAt             sync.Map // key: scheduleID, value: time.Time
+	commentRateWindow            time.Duration
+	commentUserRateLimit         int64
+	commentClientRateLimit       int64
 	batchUpdateArchiveDefinition string
 	batchUpdateArchiveQueue      string
 	batchUpdateArchiveCommand    func(broadcastID string) []string
 }
 
 type options struct {
-	uploadEventTTL time.Duration
-	authYoutubeTTL time.Duration
+	uploadEventTTL         time.Duration
+	authYoutubeTTL         time.Duration
+	viewerInterval         time.Duration
+	commentRateWindow      time.Duration
+	commentUserRateLimit   int64
+	commentClientRateLimit int64
 }
 
 type Option func(*options)
@@ -90,10 +107,30 @@ func WithAuthYoutubeTTL(ttl time.Duration) Option {
 	}
 }
 
+// WithViewerInterval - 視聴者数イベントを配信する最短間隔
+func WithViewerInterval(interval time.Duration) Option {
+	return func(opts *options) {
+		opts.viewerInterval = interval
+	}
+}
+
+// WithCommentRateLimit - コメント投稿頻度の制限（集計期間あたりのユーザー/IPアドレスごとの投稿上限数）
+func WithCommentRateLimit(window time.Duration, perUser, perClient int64) Option {
+	return func(opts *options) {
+		opts.commentRateWindow = window
+		opts.commentUserRateLimit = perUser
+		opts.commentClientRateLimit = perClient
+	}
+}
+
 func NewService(params *Params, opts ...Option) (media.Service, error) {
 	dopts := &options{
-		uploadEventTTL: defaultUploadEventTTL,
-		authYoutubeTTL: defaultAuthYoutubeTTL,
+		uploadEventTTL:         defaultUploadEventTTL,
+		authYoutubeTTL:         defaultAuthYoutubeTTL,
+		viewerInterval:         defaultViewerInterval,
+		commentRateWindow:      defaultCommentRateWindow,
+		commentUserRateLimit:   defaultCommentUserRateLimit,
+		commentClientRateLimit: defaultCommentClientRateLimit,
 	}
 	for i := range opts {
 		opts[i](dopts)
@@ -114,6 +151,10 @@ func NewService(params *Params, opts ...Option) (media.Service, error) {
 		url := *surl // copy
 		return &url
 	}
+	ps := params.PubSub
+	if ps == nil {
+		ps = pubsub.NewMemory()
+	}
 	return &service{
 		waitGroup:  params.WaitGroup,
 		validator:  validator.NewValidator(),
@@ -129,12 +170,17 @@ func NewService(params *Params, opts ...Option) (media.Service, error) {
 		user:       params.User,
 		store:      params.Store,
 		youtube:    params.Youtube,
+		pubsub:     ps,
 		now:        jst.Now,
 		generateID: func() string {
 			return uuid.Base58Encode(uuid.New())
 		},
 		uploadEventTTL:               dopts.uploadEventTTL,
 		authYoutubeTTL:               dopts.authYoutubeTTL,
+		viewerInterval:               dopts.viewerInterval,
+		commentRateWindow:            dopts.commentRateWindow,
+		commentUserRateLimit:         dopts.commentUserRateLimit,
+		commentClientRateLimit:       dopts.commentClientRateLimit,
 		batchUpdateArchiveDefinition: params.BatchUpdateArchiveDefinition,
 		batchUpdateArchiveQueue:      params.BatchUpdateArchiveQueue,
 		batchUpdateArchiveCommand:    params.BatchUpdateArchiveCommand,
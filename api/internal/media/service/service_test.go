@@ -13,6 +13,7 @@ import (
 	mock_batch "github.com/and-period/furumaru/api/mock/pkg/batch"
 	mock_dynamodb "github.com/and-period/furumaru/api/mock/pkg/dynamodb"
 	mock_medialive "github.com/and-period/furumaru/api/mock/pkg/medialive"
+	mock_pubsub "github.com/and-period/furumaru/api/mock/pkg/pubsub"
 	mock_sqs "github.com/and-period/furumaru/api/mock/pkg/sqs"
 	mock_storage "github.com/and-period/furumaru/api/mock/pkg/storage"
 	mock_youtube "github.com/and-period/furumaru/api/mock/pkg/youtube"
@@ -47,15 +48,20 @@ type mocks struct {
 	youtube        *mock_youtube.MockYoutube
 	youtubeService *mock_youtube.MockService
 	youtubeAuth    *mock_youtube.MockAuth
+	pubsub         *mock_pubsub.MockPubSub
 }
 
 type dbMocks struct {
-	Broadcast          *mock_database.MockBroadcast
-	BroadcastComment   *mock_database.MockBroadcastComment
-	BroadcastViewerLog *mock_database.MockBroadcastViewerLog
-	Video              *mock_database.MockVideo
-	VideoComment       *mock_database.MockVideoComment
-	VideoViewerLog     *mock_database.MockVideoViewerLog
+	Broadcast            *mock_database.MockBroadcast
+	BroadcastComment     *mock_database.MockBroadcastComment
+	BroadcastViewerLog   *mock_database.MockBroadcastViewerLog
+	BroadcastClip        *mock_database.MockBroadcastClip
+	Video                *mock_database.MockVideo
+	VideoComment         *mock_database.MockVideoComment
+	VideoViewerLog       *mock_database.MockVideoViewerLog
+	CommentNgWord        *mock_database.MockCommentNgWord
+	CommentMute          *mock_database.MockCommentMute
+	CommentModerationLog *mock_database.MockCommentModerationLog
 }
 
 type testOptions struct {
@@ -97,17 +103,22 @@ func newMocks(ctrl *gomock.Controller) *mocks {
 		youtube:        mock_youtube.NewMockYoutube(ctrl),
 		youtubeService: mock_youtube.NewMockService(ctrl),
 		youtubeAuth:    mock_youtube.NewMockAuth(ctrl),
+		pubsub:         mock_pubsub.NewMockPubSub(ctrl),
 	}
 }
 
 func newDBMocks(ctrl *gomock.Controller) *dbMocks {
 	return &dbMocks{
-		Broadcast:          mock_database.NewMockBroadcast(ctrl),
-		BroadcastComment:   mock_database.NewMockBroadcastComment(ctrl),
-		BroadcastViewerLog: mock_database.NewMockBroadcastViewerLog(ctrl),
-		Video:              mock_database.NewMockVideo(ctrl),
-		VideoComment:       mock_database.NewMockVideoComment(ctrl),
-		VideoViewerLog:     mock_database.NewMockVideoViewerLog(ctrl),
+		Broadcast:            mock_database.NewMockBroadcast(ctrl),
+		BroadcastComment:     mock_database.NewMockBroadcastComment(ctrl),
+		BroadcastViewerLog:   mock_database.NewMockBroadcastViewerLog(ctrl),
+		BroadcastClip:        mock_database.NewMockBroadcastClip(ctrl),
+		Video:                mock_database.NewMockVideo(ctrl),
+		VideoComment:         mock_database.NewMockVideoComment(ctrl),
+		VideoViewerLog:       mock_database.NewMockVideoViewerLog(ctrl),
+		CommentNgWord:        mock_database.NewMockCommentNgWord(ctrl),
+		CommentMute:          mock_database.NewMockCommentMute(ctrl),
+		CommentModerationLog: mock_database.NewMockCommentModerationLog(ctrl),
 	}
 }
 
@@ -122,12 +133,16 @@ func newService(mocks *mocks, opts ...testOption) *service {
 	params := &Params{
 		WaitGroup: &sync.WaitGroup{},
 		Database: &database.Database{
-			Broadcast:          mocks.db.Broadcast,
-			BroadcastComment:   mocks.db.BroadcastComment,
-			BroadcastViewerLog: mocks.db.BroadcastViewerLog,
-			Video:              mocks.db.Video,
-			VideoComment:       mocks.db.VideoComment,
-			VideoViewerLog:     mocks.db.VideoViewerLog,
+			Broadcast:            mocks.db.Broadcast,
+			BroadcastComment:     mocks.db.BroadcastComment,
+			BroadcastViewerLog:   mocks.db.BroadcastViewerLog,
+			BroadcastClip:        mocks.db.BroadcastClip,
+			Video:                mocks.db.Video,
+			VideoComment:         mocks.db.VideoComment,
+			VideoViewerLog:       mocks.db.VideoViewerLog,
+			CommentNgWord:        mocks.db.CommentNgWord,
+			CommentMute:          mocks.db.CommentMute,
+			CommentModerationLog: mocks.db.CommentModerationLog,
 		},
 		Cache:                        mocks.cache,
 		User:                         mocks.user,
@@ -138,6 +153,7 @@ func newService(mocks *mocks, opts ...testOption) *service {
 		Batch:                        mocks.batch,
 		MediaLive:                    mocks.media,
 		Youtube:                      mocks.youtube,
+		PubSub:                       mocks.pubsub,
 		BatchUpdateArchiveDefinition: "batch-update-archive-definition",
 		BatchUpdateArchiveQueue:      "batch-update-archive-queue",
 		BatchUpdateArchiveCommand: func(broadcastID string) []string {
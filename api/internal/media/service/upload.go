@@ -157,6 +157,13 @@ func (s *service) GetSpotThumbnailUploadURL(ctx context.Context, in *media.Gener
 	return s.generateUploadURL(ctx, in, entity.SpotThumbnailRegulation)
 }
 
+/**
+ * 注文関連
+ */
+func (s *service) GetOrderClaimImageUploadURL(ctx context.Context, in *media.GenerateUploadURLInput) (*entity.UploadEvent, error) {
+	return s.generateUploadURL(ctx, in, entity.OrderClaimImageRegulation)
+}
+
 /**
  * private
  */
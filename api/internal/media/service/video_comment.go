@@ -37,10 +37,24 @@ func (s *service) CreateVideoComment(ctx context.Context, in *media.CreateVideoC
 	if !video.Published() {
 		return nil, fmt.Errorf("service: this video is not published: %w", exception.ErrFailedPrecondition)
 	}
+	moderation := &moderateCommentParams{
+		targetType:    entity.CommentModerationTargetTypeVideo,
+		targetID:      video.ID,
+		coordinatorID: video.CoordinatorID,
+		userID:        in.UserID,
+		sessionID:     in.SessionID,
+		clientIP:      in.ClientIP,
+		content:       in.Content,
+	}
+	if _, err := s.moderateComment(ctx, moderation); err != nil {
+		return nil, err
+	}
 	params := &entity.NewVideoCommentParams{
-		VideoID: in.VideoID,
-		UserID:  in.UserID,
-		Content: in.Content,
+		VideoID:   in.VideoID,
+		UserID:    in.UserID,
+		SessionID: in.SessionID,
+		ClientIP:  in.ClientIP,
+		Content:   in.Content,
 	}
 	comment := entity.NewVideoComment(params)
 	if err := s.db.VideoComment.Create(ctx, comment); err != nil {
@@ -60,9 +74,22 @@ func (s *service) CreateVideoGuestComment(ctx context.Context, in *media.CreateV
 	if !video.Published() {
 		return nil, fmt.Errorf("service: this video is not published: %w", exception.ErrFailedPrecondition)
 	}
+	moderation := &moderateCommentParams{
+		targetType:    entity.CommentModerationTargetTypeVideo,
+		targetID:      video.ID,
+		coordinatorID: video.CoordinatorID,
+		sessionID:     in.SessionID,
+		clientIP:      in.ClientIP,
+		content:       in.Content,
+	}
+	if _, err := s.moderateComment(ctx, moderation); err != nil {
+		return nil, err
+	}
 	params := &entity.NewVideoCommentParams{
-		VideoID: in.VideoID,
-		Content: in.Content,
+		VideoID:   in.VideoID,
+		SessionID: in.SessionID,
+		ClientIP:  in.ClientIP,
+		Content:   in.Content,
 	}
 	comment := entity.NewVideoComment(params)
 	if err := s.db.VideoComment.Create(ctx, comment); err != nil {
@@ -132,6 +132,17 @@ func TestCreateVideoComment(t *testing.T) {
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
+	words := entity.CommentNgWords{
+		{ID: "word-id", CoordinatorID: "coordinator-id", Word: "つまらない"},
+	}
+	wordsParams := &database.ListCommentNgWordsParams{
+		CoordinatorID: "coordinator-id",
+	}
+	userParams := &database.CountVideoCommentsParams{
+		VideoID:      "video-id",
+		UserID:       "user-id",
+		CreatedAtGte: now.Add(-defaultCommentRateWindow),
+	}
 
 	tests := []struct {
 		name      string
@@ -143,6 +154,8 @@ func TestCreateVideoComment(t *testing.T) {
 			name: "success",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.VideoComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
 				mocks.db.VideoComment.EXPECT().
 					Create(ctx, gomock.Any()).
 					DoAndReturn(func(ctx context.Context, comment *entity.VideoComment) error {
@@ -195,10 +208,48 @@ func TestCreateVideoComment(t *testing.T) {
 			},
 			expectErr: exception.ErrFailedPrecondition,
 		},
+		{
+			name: "rate limited",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.VideoComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(defaultCommentUserRateLimit), nil)
+				mocks.db.CommentModerationLog.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, log *entity.CommentModerationLog) error {
+						assert.Equal(t, entity.CommentModerationTargetTypeVideo, log.TargetType)
+						assert.Equal(t, entity.CommentModerationActionRateLimit, log.Action)
+						return nil
+					})
+			},
+			input: &media.CreateVideoCommentInput{
+				VideoID: "video-id",
+				UserID:  "user-id",
+				Content: "面白かった",
+			},
+			expectErr: exception.ErrResourceExhausted,
+		},
+		{
+			name: "contains ng word",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.VideoComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			},
+			input: &media.CreateVideoCommentInput{
+				VideoID: "video-id",
+				UserID:  "user-id",
+				Content: "ツマラナイ動画",
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
 		{
 			name: "failed to create video comment",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.VideoComment.EXPECT().Count(gomock.Any(), userParams).Return(int64(0), nil)
 				mocks.db.VideoComment.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
 			},
 			input: &media.CreateVideoCommentInput{
@@ -214,7 +265,7 @@ func TestCreateVideoComment(t *testing.T) {
 		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
 			_, err := service.CreateVideoComment(ctx, tt.input)
 			assert.ErrorIs(t, err, tt.expectErr)
-		}))
+		}, withNow(now)))
 	}
 }
 
@@ -252,6 +303,17 @@ func TestCreateVideoGuestComment(t *testing.T) {
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
+	words := entity.CommentNgWords{
+		{ID: "word-id", CoordinatorID: "coordinator-id", Word: "つまらない"},
+	}
+	wordsParams := &database.ListCommentNgWordsParams{
+		CoordinatorID: "coordinator-id",
+	}
+	clientParams := &database.CountVideoCommentsParams{
+		VideoID:      "video-id",
+		ClientIP:     "127.0.0.1",
+		CreatedAtGte: now.Add(-defaultCommentRateWindow),
+	}
 
 	tests := []struct {
 		name      string
@@ -263,23 +325,29 @@ func TestCreateVideoGuestComment(t *testing.T) {
 			name: "success",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.VideoComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
 				mocks.db.VideoComment.EXPECT().
 					Create(ctx, gomock.Any()).
 					DoAndReturn(func(ctx context.Context, comment *entity.VideoComment) error {
 						expect := &entity.VideoComment{
-							ID:       comment.ID, // ignore
-							VideoID:  "video-id",
-							UserID:   "",
-							Content:  "面白かった",
-							Disabled: false,
+							ID:        comment.ID, // ignore
+							VideoID:   "video-id",
+							UserID:    "",
+							SessionID: "session-id",
+							ClientIP:  "127.0.0.1",
+							Content:   "面白かった",
+							Disabled:  false,
 						}
 						assert.Equal(t, expect, comment)
 						return nil
 					})
 			},
 			input: &media.CreateVideoGuestCommentInput{
-				VideoID: "video-id",
-				Content: "面白かった",
+				VideoID:   "video-id",
+				SessionID: "session-id",
+				ClientIP:  "127.0.0.1",
+				Content:   "面白かった",
 			},
 			expectErr: nil,
 		},
@@ -295,8 +363,10 @@ func TestCreateVideoGuestComment(t *testing.T) {
 				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(nil, assert.AnError)
 			},
 			input: &media.CreateVideoGuestCommentInput{
-				VideoID: "video-id",
-				Content: "面白かった",
+				VideoID:   "video-id",
+				SessionID: "session-id",
+				ClientIP:  "127.0.0.1",
+				Content:   "面白かった",
 			},
 			expectErr: exception.ErrInternal,
 		},
@@ -307,20 +377,42 @@ func TestCreateVideoGuestComment(t *testing.T) {
 				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
 			},
 			input: &media.CreateVideoGuestCommentInput{
-				VideoID: "video-id",
-				Content: "面白かった",
+				VideoID:   "video-id",
+				SessionID: "session-id",
+				ClientIP:  "127.0.0.1",
+				Content:   "面白かった",
 			},
 			expectErr: exception.ErrFailedPrecondition,
 		},
+		{
+			name: "rate limited",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.VideoComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(defaultCommentClientRateLimit), nil)
+				mocks.db.CommentModerationLog.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			},
+			input: &media.CreateVideoGuestCommentInput{
+				VideoID:   "video-id",
+				SessionID: "session-id",
+				ClientIP:  "127.0.0.1",
+				Content:   "面白かった",
+			},
+			expectErr: exception.ErrResourceExhausted,
+		},
 		{
 			name: "failed to create video comment",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Video.EXPECT().Get(ctx, "video-id").Return(video, nil)
+				mocks.db.CommentNgWord.EXPECT().List(gomock.Any(), wordsParams).Return(words, nil)
+				mocks.db.VideoComment.EXPECT().Count(gomock.Any(), clientParams).Return(int64(0), nil)
 				mocks.db.VideoComment.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
 			},
 			input: &media.CreateVideoGuestCommentInput{
-				VideoID: "video-id",
-				Content: "面白かった",
+				VideoID:   "video-id",
+				SessionID: "session-id",
+				ClientIP:  "127.0.0.1",
+				Content:   "面白かった",
 			},
 			expectErr: exception.ErrInternal,
 		},
@@ -330,7 +422,7 @@ func TestCreateVideoGuestComment(t *testing.T) {
 		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
 			_, err := service.CreateVideoGuestComment(ctx, tt.input)
 			assert.ErrorIs(t, err, tt.expectErr)
-		}))
+		}, withNow(now)))
 	}
 }
 
@@ -19,8 +19,12 @@ const (
 	EmailTemplateIDAdminResetPassword          EmailTemplateID = "admin-reset-password"           // 管理者パスワードリセット
 	EmailTemplateIDUserReceivedContact         EmailTemplateID = "user-received-contact"          // お問い合わせ受領
 	EmailTemplateIDUserOrderProductCaptured    EmailTemplateID = "user-order-product-captured"    // 商品支払い完了
+	EmailTemplateIDUserOrderGiftCaptured       EmailTemplateID = "user-order-gift-captured"       // ギフト商品支払い完了
 	EmailTemplateIDUserOrderExperienceCaptured EmailTemplateID = "user-order-experience-captured" // 体験支払い完了
 	EmailTemplateIDUserOrderShipped            EmailTemplateID = "user-order-shipped"             // 発送完了
+	EmailTemplateIDUserOrderRefunded           EmailTemplateID = "user-order-refunded"            // 返金完了
+	EmailTemplateIDUserSubscriptionFailed      EmailTemplateID = "user-subscription-failed"       // 定期便の注文失敗
+	EmailTemplateIDUserOrderClaimCoupon        EmailTemplateID = "user-order-claim-coupon"        // お詫びクーポン発行
 	EmailTemplateIDUserReviewProductRequest    EmailTemplateID = "user-review-product-request"    // 商品レビュー依頼
 	EmailTemplateIDUserReviewExperienceRequest EmailTemplateID = "user-review-experience-request" // 体験レビュー依頼
 	EmailTemplateIDUserStartLive               EmailTemplateID = "user-start-live"                // ライブ配信開始
@@ -121,6 +125,23 @@ func (b *TemplateDataBuilder) OrderFulfillment(fulfillments sentity.OrderFulfill
 	// 現時点だと同一住所への配送しか対応していないため、１つ目の情報のみ取得
 	b.data["郵便番号"] = address.PostalCode
 	b.data["住所"] = address.FullPath()
+	if !fulfillments[0].HasDeliverySlot() {
+		return b
+	}
+	b.data["お届け希望日"] = "指定なし"
+	if !fulfillments[0].DeliveryDate.IsZero() {
+		b.data["お届け希望日"] = jst.Format(fulfillments[0].DeliveryDate, "2006年01月02日")
+	}
+	b.data["お届け希望時間帯"] = fulfillments[0].DeliveryTimeWindow.String()
+	return b
+}
+
+func (b *TemplateDataBuilder) OrderGift(metadata *sentity.OrderMetadata) *TemplateDataBuilder {
+	b.data["のし"] = "なし"
+	if metadata.HasNoshi() {
+		b.data["のし"] = metadata.Noshi()
+	}
+	b.data["メッセージカード"] = metadata.MessageCard
 	return b
 }
 
@@ -153,6 +174,47 @@ func (b *TemplateDataBuilder) Shipped(message string) *TemplateDataBuilder {
 	return b
 }
 
+func (b *TemplateDataBuilder) OrderRefund(line *sentity.OrderRefundLine, products map[int64]*sentity.Product) *TemplateDataBuilder {
+	data := make([]map[string]string, 0, len(line.Items))
+	for _, item := range line.Items {
+		product, ok := products[item.ProductRevisionID]
+		if !ok {
+			product = &sentity.Product{}
+		}
+		data = append(data, newRefundItem(item, product))
+	}
+	b.data["返金金額"] = strconv.FormatInt(line.Amount, 10)
+	b.data["返金理由"] = line.Reason
+	b.data["返金商品一覧"] = data
+	return b
+}
+
+func (b *TemplateDataBuilder) SubscriptionFailed(subscription *sentity.Subscription, products map[string]*sentity.Product) *TemplateDataBuilder {
+	data := make([]map[string]string, 0, len(subscription.Items))
+	for _, item := range subscription.Items {
+		product, ok := products[item.ProductID]
+		if !ok {
+			product = &sentity.Product{}
+		}
+		data = append(data, newSubscriptionItem(item, product))
+	}
+	b.data["定期便商品一覧"] = data
+	b.data["失敗回数"] = strconv.FormatInt(subscription.FailureCount, 10)
+	if subscription.Suspended() {
+		b.data["停止"] = "true"
+	} else {
+		b.data["再試行日時"] = subscription.NextRunAt.Format("2006-01-02 15:04")
+	}
+	return b
+}
+
+func (b *TemplateDataBuilder) OrderClaimCoupon(promotion *sentity.Promotion) *TemplateDataBuilder {
+	b.data["クーポンコード"] = promotion.Code
+	b.data["割引金額"] = strconv.FormatInt(promotion.DiscountRate, 10)
+	b.data["有効期限"] = promotion.EndAt.Format("2006-01-02 15:04")
+	return b
+}
+
 func (b *TemplateDataBuilder) ReviewItems(items sentity.OrderItems, products map[int64]*sentity.Product, maker *UserURLMaker) *TemplateDataBuilder {
 	data := make([]map[string]string, 0, len(items))
 	for _, item := range items {
@@ -214,6 +276,21 @@ func newOrderItem(item *sentity.OrderItem, product *sentity.Product) map[string]
 	}
 }
 
+func newRefundItem(item *sentity.OrderRefundLineItem, product *sentity.Product) map[string]string {
+	return map[string]string{
+		"商品名":  product.Name,
+		"返金数量": strconv.FormatInt(item.Quantity, 10),
+		"返金金額": strconv.FormatInt(item.Amount, 10),
+	}
+}
+
+func newSubscriptionItem(item *sentity.SubscriptionItem, product *sentity.Product) map[string]string {
+	return map[string]string{
+		"商品名": product.Name,
+		"数量":  strconv.FormatInt(item.Quantity, 10),
+	}
+}
+
 func newReviewItem(product *sentity.Product, maker *UserURLMaker) map[string]string {
 	var thumbnailURL string
 	if strings.HasSuffix(product.ThumbnailURL, ".jpg") || strings.HasSuffix(product.ThumbnailURL, ".png") {
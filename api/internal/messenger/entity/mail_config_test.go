@@ -276,6 +276,23 @@ func TestTemplateBuilder(t *testing.T) {
 				"住所":   "東京都 千代田区 永田町1-7-1",
 			},
 		},
+		{
+			name: "order fulfillment with delivery slot",
+			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
+				fulfillments := sentity.OrderFulfillments{{
+					AddressRevisionID:  1,
+					DeliveryDate:       jst.Date(2022, 1, 5, 0, 0, 0, 0),
+					DeliveryTimeWindow: sentity.DeliveryTimeWindowMorning,
+				}}
+				return builder.OrderFulfillment(fulfillments, addresses)
+			},
+			expect: map[string]interface{}{
+				"郵便番号":     "1000014",
+				"住所":       "東京都 千代田区 永田町1-7-1",
+				"お届け希望日":   "2022年01月05日",
+				"お届け希望時間帯": "午前中",
+			},
+		},
 		{
 			name: "order fulfillments is empty",
 			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
@@ -338,6 +355,92 @@ func TestTemplateBuilder(t *testing.T) {
 				"メッセージ": "ありがとうございます",
 			},
 		},
+		{
+			name: "order refund",
+			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
+				line := &sentity.OrderRefundLine{
+					Amount: 900,
+					Reason: "商品破損のため",
+					Items: sentity.OrderRefundLineItems{
+						{ProductRevisionID: 1, Quantity: 1, Amount: 900},
+					},
+				}
+				return builder.OrderRefund(line, products)
+			},
+			expect: map[string]interface{}{
+				"返金金額": "900",
+				"返金理由": "商品破損のため",
+				"返金商品一覧": []map[string]string{{
+					"商品名":  "おいしいじゃがいも",
+					"返金数量": "1",
+					"返金金額": "900",
+				}},
+			},
+		},
+		{
+			name: "order claim coupon",
+			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
+				promotion := &sentity.Promotion{
+					Code:         "claim123",
+					DiscountRate: 500,
+					EndAt:        jst.Date(2022, 4, 2, 18, 30, 0, 0),
+				}
+				return builder.OrderClaimCoupon(promotion)
+			},
+			expect: map[string]interface{}{
+				"クーポンコード": "claim123",
+				"割引金額":    "500",
+				"有効期限":    "2022-04-02 18:30",
+			},
+		},
+		{
+			name: "subscription failed",
+			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
+				subscription := &sentity.Subscription{
+					Status:       sentity.SubscriptionStatusSuspended,
+					Items:        sentity.SubscriptionItems{{ProductID: "product-id", Quantity: 2}},
+					FailureCount: 3,
+				}
+				products := map[string]*sentity.Product{
+					"product-id": {ID: "product-id", Name: "おいしいじゃがいも"},
+				}
+				return builder.SubscriptionFailed(subscription, products)
+			},
+			expect: map[string]interface{}{
+				"定期便商品一覧": []map[string]string{{
+					"商品名": "おいしいじゃがいも",
+					"数量":  "2",
+				}},
+				"失敗回数": "3",
+				"停止":   "true",
+			},
+		},
+		{
+			name: "order gift",
+			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
+				metadata := &sentity.OrderMetadata{
+					Gift:        true,
+					NoshiType:   sentity.NoshiTypeOseibo,
+					NoshiName:   "山田",
+					MessageCard: "いつもありがとうございます。",
+				}
+				return builder.OrderGift(metadata)
+			},
+			expect: map[string]interface{}{
+				"のし":       "御歳暮（山田）",
+				"メッセージカード": "いつもありがとうございます。",
+			},
+		},
+		{
+			name: "order gift without noshi",
+			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
+				return builder.OrderGift(&sentity.OrderMetadata{Gift: true})
+			},
+			expect: map[string]interface{}{
+				"のし":       "なし",
+				"メッセージカード": "",
+			},
+		},
 		{
 			name: "review items",
 			execute: func(builder *TemplateDataBuilder) *TemplateDataBuilder {
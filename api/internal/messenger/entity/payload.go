@@ -5,14 +5,17 @@ type EventType int32
 
 const (
 	EventTypeUnknown            EventType = 0
-	EventTypeRegisterAdmin      EventType = 1 // 管理者登録通知
-	EventTypeResetAdminPassword EventType = 2 // 管理者パスワードリセット通知
-	EventTypeReceivedContact    EventType = 3 // お問い合わせ受領通知
-	EventTypeNotification       EventType = 4 // お知らせ発行通知
-	EventTypeOrderCaptured      EventType = 5 // 支払い完了通知
-	EventTypeOrderShipped       EventType = 6 // 発送完了通知
-	EventTypeStartLive          EventType = 7 // ライブ配信開始通知
-	EventTypeReviewRequest      EventType = 8 // レビュー依頼通知
+	EventTypeRegisterAdmin      EventType = 1  // 管理者登録通知
+	EventTypeResetAdminPassword EventType = 2  // 管理者パスワードリセット通知
+	EventTypeReceivedContact    EventType = 3  // お問い合わせ受領通知
+	EventTypeNotification       EventType = 4  // お知らせ発行通知
+	EventTypeOrderCaptured      EventType = 5  // 支払い完了通知
+	EventTypeOrderShipped       EventType = 6  // 発送完了通知
+	EventTypeStartLive          EventType = 7  // ライブ配信開始通知
+	EventTypeReviewRequest      EventType = 8  // レビュー依頼通知
+	EventTypeOrderRefunded      EventType = 9  // 返金完了通知
+	EventTypeSubscriptionFailed EventType = 10 // 定期便の注文失敗通知
+	EventTypeOrderClaimCoupon   EventType = 11 // お詫びクーポン発行通知
 )
 
 // UserType - 通知先ユーザー種別
@@ -213,6 +213,19 @@ type NotifyOrderShippedInput struct {
 	OrderID string `validate:"required"`
 }
 
+type NotifyOrderRefundedInput struct {
+	OrderID      string `validate:"required"`
+	RefundLineID string `validate:"required"`
+}
+
+type NotifyOrderClaimCouponInput struct {
+	ClaimID string `validate:"required"`
+}
+
+type NotifySubscriptionRenewalFailedInput struct {
+	SubscriptionID string `validate:"required"`
+}
+
 type NotifyReviewRequestInput struct {
 	OrderID string `validate:"required"`
 }
@@ -228,6 +241,11 @@ type ReserveNotificationInput struct {
 	NotificationID string `validate:"required"`
 }
 
+type ReserveReviewRequestInput struct {
+	OrderID     string    `validate:"required"`
+	DeliveredAt time.Time `validate:"required"`
+}
+
 /**
  * Thread - お問い合わせ会話履歴
  */
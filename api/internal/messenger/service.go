@@ -41,13 +41,17 @@ type Service interface {
 	NotifyRegisterAdmin(ctx context.Context, in *NotifyRegisterAdminInput) error           // 登録通知
 	NotifyResetAdminPassword(ctx context.Context, in *NotifyResetAdminPasswordInput) error // パスワードリセット通知
 	// NotifyUser - 通知関連(利用者宛)
-	NotifyStartLive(ctx context.Context, in *NotifyStartLiveInput) error         // ライブ配信開始通知
-	NotifyOrderCaptured(ctx context.Context, in *NotifyOrderCapturedInput) error // 支払い完了通知
-	NotifyOrderShipped(ctx context.Context, in *NotifyOrderShippedInput) error   // 発送完了通知
-	NotifyReviewRequest(ctx context.Context, in *NotifyReviewRequestInput) error // レビュー依頼通知
+	NotifyStartLive(ctx context.Context, in *NotifyStartLiveInput) error                                 // ライブ配信開始通知
+	NotifyOrderCaptured(ctx context.Context, in *NotifyOrderCapturedInput) error                         // 支払い完了通知
+	NotifyOrderShipped(ctx context.Context, in *NotifyOrderShippedInput) error                           // 発送完了通知
+	NotifyOrderRefunded(ctx context.Context, in *NotifyOrderRefundedInput) error                         // 返金完了通知
+	NotifyOrderClaimCoupon(ctx context.Context, in *NotifyOrderClaimCouponInput) error                   // お詫びクーポン発行通知
+	NotifySubscriptionRenewalFailed(ctx context.Context, in *NotifySubscriptionRenewalFailedInput) error // 定期便の注文失敗通知
+	NotifyReviewRequest(ctx context.Context, in *NotifyReviewRequestInput) error                         // レビュー依頼通知
 	// ReserveNotification - 通知予約関連
-	ReserveNotification(ctx context.Context, in *ReserveNotificationInput) error // お知らせ通知予約
-	ReserveStartLive(ctx context.Context, in *ReserveStartLiveInput) error       // ライブ配信開始通知予約
+	ReserveNotification(ctx context.Context, in *ReserveNotificationInput) error   // お知らせ通知予約
+	ReserveStartLive(ctx context.Context, in *ReserveStartLiveInput) error         // ライブ配信開始通知予約
+	ReserveReviewRequest(ctx context.Context, in *ReserveReviewRequestInput) error // レビュー依頼通知予約
 	// Threads - お問い合わせ会話履歴
 	ListThreads(ctx context.Context, in *ListThreadsInput) (entity.Threads, int64, error) // 一覧取得
 	GetThread(ctx context.Context, in *GetThreadInput) (*entity.Thread, error)            // １件取得
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -125,8 +126,13 @@ func (s *service) newOrderProductCaptured(ctx context.Context, order *sentity.Or
 		OrderPayment(&order.OrderPayment).
 		OrderFulfillment(order.OrderFulfillments, fulfillmentAddresses.MapByRevision()).
 		OrderItems(order.OrderItems, products.MapByRevision())
+	templateID := entity.EmailTemplateIDUserOrderProductCaptured
+	if order.Gift {
+		builder.OrderGift(&order.OrderMetadata)
+		templateID = entity.EmailTemplateIDUserOrderGiftCaptured
+	}
 	mail := &entity.MailConfig{
-		TemplateID:    entity.EmailTemplateIDUserOrderProductCaptured,
+		TemplateID:    templateID,
 		Substitutions: builder.Build(),
 	}
 	maker := entity.NewAdminURLMaker(s.adminWebURL())
@@ -246,6 +252,10 @@ func (s *service) NotifyOrderShipped(ctx context.Context, in *messenger.NotifyOr
 		Email:     mail,
 	}
 	sentAt := jst.BeginningOfDay(s.now().AddDate(0, 0, 7)).Add(18 * time.Hour) // 7日後の18時
+	if order.OrderFulfillments.Delivered() {
+		// 配達完了が確認できている場合は、実際の配達日時を起点にする
+		sentAt = newReviewRequestSentAt(order.OrderFulfillments.LatestDeliveredAt())
+	}
 	scheduleParams := &entity.NewScheduleParams{
 		MessageType: entity.ScheduleTypeReviewProductRequest,
 		MessageID:   order.ID,
@@ -260,6 +270,125 @@ func (s *service) NotifyOrderShipped(ctx context.Context, in *messenger.NotifyOr
 	return internalError(err)
 }
 
+// NotifyOrderRefunded - 返金完了
+func (s *service) NotifyOrderRefunded(ctx context.Context, in *messenger.NotifyOrderRefundedInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	orderIn := &store.GetOrderInput{
+		OrderID: in.OrderID,
+	}
+	order, err := s.store.GetOrder(ctx, orderIn)
+	if err != nil {
+		return internalError(err)
+	}
+	lineIn := &store.GetOrderRefundLineInput{
+		RefundLineID: in.RefundLineID,
+	}
+	line, err := s.store.GetOrderRefundLine(ctx, lineIn)
+	if err != nil {
+		return internalError(err)
+	}
+	if line.OrderID != order.ID {
+		return fmt.Errorf("service: unmatch order refund line: %w", exception.ErrFailedPrecondition)
+	}
+	products, err := s.multiGetProductsByRevision(ctx, order.ProductRevisionIDs())
+	if err != nil {
+		return internalError(err)
+	}
+	builder := entity.NewTemplateDataBuilder().
+		OrderPayment(&order.OrderPayment).
+		OrderRefund(line, products.MapByRevision())
+	mail := &entity.MailConfig{
+		TemplateID:    entity.EmailTemplateIDUserOrderRefunded,
+		Substitutions: builder.Build(),
+	}
+	payload := &entity.WorkerPayload{
+		QueueID:   uuid.Base58Encode(uuid.New()),
+		EventType: entity.EventTypeOrderRefunded,
+		UserType:  entity.UserTypeUser,
+		UserIDs:   []string{order.UserID},
+		Email:     mail,
+	}
+	err = s.sendMessage(ctx, payload)
+	return internalError(err)
+}
+
+// NotifyOrderClaimCoupon - お詫びクーポン発行
+func (s *service) NotifyOrderClaimCoupon(ctx context.Context, in *messenger.NotifyOrderClaimCouponInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	claimIn := &store.GetOrderClaimInput{
+		ClaimID: in.ClaimID,
+	}
+	claim, err := s.store.GetOrderClaim(ctx, claimIn)
+	if err != nil {
+		return internalError(err)
+	}
+	if claim.PromotionID == "" {
+		return fmt.Errorf("service: coupon is not issued: %w", exception.ErrFailedPrecondition)
+	}
+	promotionIn := &store.GetPromotionInput{
+		PromotionID: claim.PromotionID,
+	}
+	promotion, err := s.store.GetPromotion(ctx, promotionIn)
+	if err != nil {
+		return internalError(err)
+	}
+	builder := entity.NewTemplateDataBuilder().
+		OrderClaimCoupon(promotion)
+	mail := &entity.MailConfig{
+		TemplateID:    entity.EmailTemplateIDUserOrderClaimCoupon,
+		Substitutions: builder.Build(),
+	}
+	payload := &entity.WorkerPayload{
+		QueueID:   uuid.Base58Encode(uuid.New()),
+		EventType: entity.EventTypeOrderClaimCoupon,
+		UserType:  entity.UserTypeUser,
+		UserIDs:   []string{claim.UserID},
+		Email:     mail,
+	}
+	err = s.sendMessage(ctx, payload)
+	return internalError(err)
+}
+
+// NotifySubscriptionRenewalFailed - 定期便の注文失敗
+func (s *service) NotifySubscriptionRenewalFailed(ctx context.Context, in *messenger.NotifySubscriptionRenewalFailedInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	subscriptionIn := &store.GetSubscriptionInput{
+		SubscriptionID: in.SubscriptionID,
+	}
+	subscription, err := s.store.GetSubscription(ctx, subscriptionIn)
+	if err != nil {
+		return internalError(err)
+	}
+	productsIn := &store.MultiGetProductsInput{
+		ProductIDs: subscription.ProductIDs(),
+	}
+	products, err := s.store.MultiGetProducts(ctx, productsIn)
+	if err != nil {
+		return internalError(err)
+	}
+	builder := entity.NewTemplateDataBuilder().
+		SubscriptionFailed(subscription, products.Map())
+	mail := &entity.MailConfig{
+		TemplateID:    entity.EmailTemplateIDUserSubscriptionFailed,
+		Substitutions: builder.Build(),
+	}
+	payload := &entity.WorkerPayload{
+		QueueID:   uuid.Base58Encode(uuid.New()),
+		EventType: entity.EventTypeSubscriptionFailed,
+		UserType:  entity.UserTypeUser,
+		UserIDs:   []string{subscription.UserID},
+		Email:     mail,
+	}
+	err = s.sendMessage(ctx, payload)
+	return internalError(err)
+}
+
 // NotifyReviewRequest - レビュー依頼
 func (s *service) NotifyReviewRequest(ctx context.Context, in *messenger.NotifyReviewRequestInput) error {
 	if err := s.validator.Struct(in); err != nil {
@@ -488,6 +488,114 @@ func TestNotifyOrderCaptured(t *testing.T) {
 			},
 			expectErr: nil,
 		},
+		{
+			name: "product gift success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := order(sentity.OrderTypeProduct)
+				order.OrderMetadata = sentity.OrderMetadata{
+					OrderID:     "order-id",
+					Gift:        true,
+					NoshiType:   sentity.NoshiTypeOchugen,
+					NoshiName:   "山田",
+					MessageCard: "いつもお世話になっております。",
+				}
+				mocks.store.EXPECT().GetOrder(ctx, orderIn).Return(order, nil)
+				mocks.user.EXPECT().GetCoordinator(gomock.Any(), coordinatorIn).Return(coordinator, nil)
+				mocks.store.EXPECT().MultiGetProductsByRevision(gomock.Any(), gomock.Any()).Return(products, nil)
+				mocks.user.EXPECT().GetUser(gomock.Any(), &user.GetUserInput{UserID: "user-id"}).Return(&uentity.User{
+					ID:   "user-id",
+					Type: uentity.UserTypeMember,
+					Member: uentity.Member{
+						UserID:    "user-id",
+						Lastname:  "&.",
+						Firstname: "太郎",
+					},
+				}, nil)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), gomock.Any()).Return(addresses, nil)
+				mocks.db.ReceivedQueue.EXPECT().
+					MultiCreate(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, queues ...*entity.ReceivedQueue) error {
+						expect := []*entity.ReceivedQueue{
+							{
+								ID:         queues[0].ID, // ignore
+								NotifyType: entity.NotifyTypeEmail,
+								EventType:  entity.EventTypeOrderCaptured,
+								UserType:   entity.UserTypeUser,
+								UserIDs:    []string{"user-id"},
+								Done:       false,
+							},
+							{
+								ID:         queues[1].ID, // ignore
+								NotifyType: entity.NotifyTypeReport,
+								EventType:  entity.EventTypeOrderCaptured,
+								UserType:   entity.UserTypeUser,
+								UserIDs:    []string{"user-id"},
+								Done:       false,
+							},
+						}
+						assert.Equal(t, expect, queues)
+						return nil
+					})
+				mocks.producer.EXPECT().
+					SendMessage(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, b []byte) (string, error) {
+						payload := &entity.WorkerPayload{}
+						err := json.Unmarshal(b, payload)
+						require.NoError(t, err)
+						expect := &entity.WorkerPayload{
+							QueueID:   payload.QueueID, // ignore
+							EventType: entity.EventTypeOrderCaptured,
+							UserType:  entity.UserTypeUser,
+							UserIDs:   []string{"user-id"},
+							Email: &entity.MailConfig{
+								TemplateID: entity.EmailTemplateIDUserOrderGiftCaptured,
+								Substitutions: map[string]interface{}{
+									"注文番号":  "order-id",
+									"決済方法":  "クレジットカード決済",
+									"商品金額":  "4460",
+									"配送手数料": "0",
+									"割引金額":  "446",
+									"消費税":   "364",
+									"合計金額":  "4014",
+									"郵便番号":  "1000014",
+									"住所":    "東京都 千代田区 永田町1-7-1",
+									"のし":    "御中元（山田）",
+									"メッセージカード": "いつもお世話になっております。",
+									"商品一覧": []interface{}{
+										map[string]interface{}{
+											"商品名":      "おいしいじゃがいも",
+											"サムネイルURL": "http://example.com/image01.png",
+											"購入数":      "1",
+											"商品金額":     "2000",
+											"合計金額":     "2000",
+										},
+										map[string]interface{}{
+											"商品名":      "よく茹でたカリフラワー",
+											"サムネイルURL": "http://example.com/image02.png",
+											"購入数":      "2",
+											"商品金額":     "1230",
+											"合計金額":     "2460",
+										},
+									},
+								},
+							},
+							Report: &entity.ReportConfig{
+								TemplateID: entity.ReportTemplateIDOrderProductCaptured,
+								Overview:   "&. 太郎",
+								Author:     "&. コーディネータ",
+								Link:       "http://admin.example.com/orders/order-id",
+								ReceivedAt: now.UTC(),
+							},
+						}
+						assert.Equal(t, expect, payload)
+						return "message-id", nil
+					})
+			},
+			input: &messenger.NotifyOrderCapturedInput{
+				OrderID: "order-id",
+			},
+			expectErr: nil,
+		},
 		{
 			name: "product failed to get coordinator",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -978,6 +1086,449 @@ func TestNotifyOrderShipped(t *testing.T) {
 	}
 }
 
+func TestNotifyOrderRefunded(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2025, 1, 18, 12, 30, 0, 0)
+	orderIn := &store.GetOrderInput{
+		OrderID: "order-id",
+	}
+	lineIn := &store.GetOrderRefundLineInput{
+		RefundLineID: "line-id",
+	}
+	order := &sentity.Order{
+		OrderPayment: sentity.OrderPayment{
+			OrderID:           "order-id",
+			AddressRevisionID: 1,
+			Status:            sentity.PaymentStatusCaptured,
+			TransactionID:     "transaction-id",
+			PaymentID:         "payment-id",
+			MethodType:        sentity.PaymentMethodTypeCreditCard,
+			Subtotal:          4460,
+			Discount:          446,
+			ShippingFee:       0,
+			Tax:               364,
+			Total:             4014,
+		},
+		OrderItems: sentity.OrderItems{
+			{
+				ProductRevisionID: 1,
+				OrderID:           "order-id",
+				Quantity:          1,
+			},
+		},
+		ID:            "order-id",
+		UserID:        "user-id",
+		CoordinatorID: "coordinator-id",
+	}
+	line := &sentity.OrderRefundLine{
+		ID:      "line-id",
+		OrderID: "order-id",
+		Type:    sentity.OrderRefundLineTypeItem,
+		Status:  sentity.OrderRefundLineStatusSucceeded,
+		Amount:  1800,
+		Reason:  "商品破損のため",
+		Items: sentity.OrderRefundLineItems{
+			{ProductRevisionID: 1, Quantity: 1, Amount: 1800},
+		},
+	}
+	products := sentity.Products{
+		{
+			ID:           "product-id01",
+			Name:         "おいしいじゃがいも",
+			ThumbnailURL: "http://example.com/image01.png",
+			ProductRevision: sentity.ProductRevision{
+				ID:        1,
+				ProductID: "product-id01",
+				Price:     2000,
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *messenger.NotifyOrderRefundedInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrder(ctx, orderIn).Return(order, nil)
+				mocks.store.EXPECT().GetOrderRefundLine(ctx, lineIn).Return(line, nil)
+				mocks.store.EXPECT().MultiGetProductsByRevision(ctx, gomock.Any()).Return(products, nil)
+				mocks.db.ReceivedQueue.EXPECT().
+					MultiCreate(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, queues ...*entity.ReceivedQueue) error {
+						expect := []*entity.ReceivedQueue{
+							{
+								ID:         queues[0].ID, // ignore
+								NotifyType: entity.NotifyTypeEmail,
+								EventType:  entity.EventTypeOrderRefunded,
+								UserType:   entity.UserTypeUser,
+								UserIDs:    []string{"user-id"},
+								Done:       false,
+							},
+						}
+						assert.Equal(t, expect, queues)
+						return nil
+					})
+				mocks.producer.EXPECT().
+					SendMessage(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, b []byte) (string, error) {
+						payload := &entity.WorkerPayload{}
+						err := json.Unmarshal(b, payload)
+						require.NoError(t, err)
+						expect := &entity.WorkerPayload{
+							QueueID:   payload.QueueID, // ignore
+							EventType: entity.EventTypeOrderRefunded,
+							UserType:  entity.UserTypeUser,
+							UserIDs:   []string{"user-id"},
+							Email: &entity.MailConfig{
+								TemplateID: entity.EmailTemplateIDUserOrderRefunded,
+								Substitutions: map[string]interface{}{
+									"注文番号":  "order-id",
+									"決済方法":  "クレジットカード決済",
+									"商品金額":  "4460",
+									"配送手数料": "0",
+									"割引金額":  "446",
+									"消費税":   "364",
+									"合計金額":  "4014",
+									"返金金額":  "1800",
+									"返金理由":  "商品破損のため",
+									"返金商品一覧": []interface{}{
+										map[string]interface{}{
+											"商品名":  "おいしいじゃがいも",
+											"返金数量": "1",
+											"返金金額": "1800",
+										},
+									},
+								},
+							},
+						}
+						assert.Equal(t, expect, payload)
+						return "message-id", nil
+					})
+			},
+			input: &messenger.NotifyOrderRefundedInput{
+				OrderID:      "order-id",
+				RefundLineID: "line-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &messenger.NotifyOrderRefundedInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrder(ctx, orderIn).Return(nil, assert.AnError)
+			},
+			input: &messenger.NotifyOrderRefundedInput{
+				OrderID:      "order-id",
+				RefundLineID: "line-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to get refund line",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrder(ctx, orderIn).Return(order, nil)
+				mocks.store.EXPECT().GetOrderRefundLine(ctx, lineIn).Return(nil, assert.AnError)
+			},
+			input: &messenger.NotifyOrderRefundedInput{
+				OrderID:      "order-id",
+				RefundLineID: "line-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "unmatch refund line",
+			setup: func(ctx context.Context, mocks *mocks) {
+				line := &sentity.OrderRefundLine{ID: "line-id", OrderID: "other-id"}
+				mocks.store.EXPECT().GetOrder(ctx, orderIn).Return(order, nil)
+				mocks.store.EXPECT().GetOrderRefundLine(ctx, lineIn).Return(line, nil)
+			},
+			input: &messenger.NotifyOrderRefundedInput{
+				OrderID:      "order-id",
+				RefundLineID: "line-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to multi get products",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrder(ctx, orderIn).Return(order, nil)
+				mocks.store.EXPECT().GetOrderRefundLine(ctx, lineIn).Return(line, nil)
+				mocks.store.EXPECT().MultiGetProductsByRevision(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			input: &messenger.NotifyOrderRefundedInput{
+				OrderID:      "order-id",
+				RefundLineID: "line-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to send message",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrder(ctx, orderIn).Return(order, nil)
+				mocks.store.EXPECT().GetOrderRefundLine(ctx, lineIn).Return(line, nil)
+				mocks.store.EXPECT().MultiGetProductsByRevision(ctx, gomock.Any()).Return(products, nil)
+				mocks.db.ReceivedQueue.EXPECT().MultiCreate(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &messenger.NotifyOrderRefundedInput{
+				OrderID:      "order-id",
+				RefundLineID: "line-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.NotifyOrderRefunded(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestNotifySubscriptionRenewalFailed(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 6, 0, 0, 0)
+	subscriptionIn := &store.GetSubscriptionInput{
+		SubscriptionID: "subscription-id",
+	}
+	productsIn := &store.MultiGetProductsInput{
+		ProductIDs: []string{"product-id"},
+	}
+	subscription := &sentity.Subscription{
+		ID:           "subscription-id",
+		UserID:       "user-id",
+		Status:       sentity.SubscriptionStatusActive,
+		Items:        sentity.SubscriptionItems{{ProductID: "product-id", Quantity: 2}},
+		NextRunAt:    jst.Date(2026, 10, 19, 6, 0, 0, 0),
+		FailureCount: 1,
+	}
+	products := sentity.Products{
+		{ID: "product-id", Name: "季節の野菜セット"},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *messenger.NotifySubscriptionRenewalFailedInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetSubscription(ctx, subscriptionIn).Return(subscription, nil)
+				mocks.store.EXPECT().MultiGetProducts(ctx, productsIn).Return(products, nil)
+				mocks.db.ReceivedQueue.EXPECT().MultiCreate(ctx, gomock.Any()).Return(nil)
+				mocks.producer.EXPECT().
+					SendMessage(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, b []byte) (string, error) {
+						payload := &entity.WorkerPayload{}
+						err := json.Unmarshal(b, payload)
+						require.NoError(t, err)
+						expect := &entity.WorkerPayload{
+							QueueID:   payload.QueueID, // ignore
+							EventType: entity.EventTypeSubscriptionFailed,
+							UserType:  entity.UserTypeUser,
+							UserIDs:   []string{"user-id"},
+							Email: &entity.MailConfig{
+								TemplateID: entity.EmailTemplateIDUserSubscriptionFailed,
+								Substitutions: map[string]interface{}{
+									"定期便商品一覧": []interface{}{
+										map[string]interface{}{
+											"商品名": "季節の野菜セット",
+											"数量":  "2",
+										},
+									},
+									"失敗回数":  "1",
+									"再試行日時": "2026-10-19 06:00",
+								},
+							},
+						}
+						assert.Equal(t, expect, payload)
+						return "message-id", nil
+					})
+			},
+			input: &messenger.NotifySubscriptionRenewalFailedInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &messenger.NotifySubscriptionRenewalFailedInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get subscription",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetSubscription(ctx, subscriptionIn).Return(nil, assert.AnError)
+			},
+			input: &messenger.NotifySubscriptionRenewalFailedInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to multi get products",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetSubscription(ctx, subscriptionIn).Return(subscription, nil)
+				mocks.store.EXPECT().MultiGetProducts(ctx, productsIn).Return(nil, assert.AnError)
+			},
+			input: &messenger.NotifySubscriptionRenewalFailedInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to send message",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetSubscription(ctx, subscriptionIn).Return(subscription, nil)
+				mocks.store.EXPECT().MultiGetProducts(ctx, productsIn).Return(products, nil)
+				mocks.db.ReceivedQueue.EXPECT().MultiCreate(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &messenger.NotifySubscriptionRenewalFailedInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.NotifySubscriptionRenewalFailed(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestNotifyOrderClaimCoupon(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	claimIn := &store.GetOrderClaimInput{
+		ClaimID: "claim-id",
+	}
+	promotionIn := &store.GetPromotionInput{
+		PromotionID: "promotion-id",
+	}
+	claim := func(promotionID string) *sentity.OrderClaim {
+		return &sentity.OrderClaim{
+			ID:          "claim-id",
+			OrderID:     "order-id",
+			UserID:      "user-id",
+			Status:      sentity.OrderClaimStatusResolved,
+			PromotionID: promotionID,
+		}
+	}
+	promotion := &sentity.Promotion{
+		ID:           "promotion-id",
+		Code:         "claim123",
+		DiscountRate: 500,
+		EndAt:        jst.Date(2027, 1, 16, 18, 30, 0, 0),
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *messenger.NotifyOrderClaimCouponInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrderClaim(ctx, claimIn).Return(claim("promotion-id"), nil)
+				mocks.store.EXPECT().GetPromotion(ctx, promotionIn).Return(promotion, nil)
+				mocks.db.ReceivedQueue.EXPECT().MultiCreate(ctx, gomock.Any()).Return(nil)
+				mocks.producer.EXPECT().
+					SendMessage(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, b []byte) (string, error) {
+						payload := &entity.WorkerPayload{}
+						err := json.Unmarshal(b, payload)
+						require.NoError(t, err)
+						expect := &entity.WorkerPayload{
+							QueueID:   payload.QueueID, // ignore
+							EventType: entity.EventTypeOrderClaimCoupon,
+							UserType:  entity.UserTypeUser,
+							UserIDs:   []string{"user-id"},
+							Email: &entity.MailConfig{
+								TemplateID: entity.EmailTemplateIDUserOrderClaimCoupon,
+								Substitutions: map[string]interface{}{
+									"クーポンコード": "claim123",
+									"割引金額":    "500",
+									"有効期限":    "2027-01-16 18:30",
+								},
+							},
+						}
+						assert.Equal(t, expect, payload)
+						return "message-id", nil
+					})
+			},
+			input: &messenger.NotifyOrderClaimCouponInput{
+				ClaimID: "claim-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &messenger.NotifyOrderClaimCouponInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get order claim",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrderClaim(ctx, claimIn).Return(nil, assert.AnError)
+			},
+			input: &messenger.NotifyOrderClaimCouponInput{
+				ClaimID: "claim-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "coupon is not issued",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrderClaim(ctx, claimIn).Return(claim(""), nil)
+			},
+			input: &messenger.NotifyOrderClaimCouponInput{
+				ClaimID: "claim-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to get promotion",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrderClaim(ctx, claimIn).Return(claim("promotion-id"), nil)
+				mocks.store.EXPECT().GetPromotion(ctx, promotionIn).Return(nil, assert.AnError)
+			},
+			input: &messenger.NotifyOrderClaimCouponInput{
+				ClaimID: "claim-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to send message",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.store.EXPECT().GetOrderClaim(ctx, claimIn).Return(claim("promotion-id"), nil)
+				mocks.store.EXPECT().GetPromotion(ctx, promotionIn).Return(promotion, nil)
+				mocks.db.ReceivedQueue.EXPECT().MultiCreate(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &messenger.NotifyOrderClaimCouponInput{
+				ClaimID: "claim-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.NotifyOrderClaimCoupon(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
 func TestNotifyReviewRequest(t *testing.T) {
 	t.Parallel()
 	now := time.Date(2024, 1, 23, 18, 30, 0, 0, time.UTC)
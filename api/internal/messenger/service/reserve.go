@@ -11,6 +11,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/messenger/database"
 	"github.com/and-period/furumaru/api/internal/messenger/entity"
 	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/pkg/jst"
 )
 
 func (s *service) ReserveStartLive(ctx context.Context, in *messenger.ReserveStartLiveInput) error {
@@ -60,6 +61,31 @@ func (s *service) ReserveNotification(ctx context.Context, in *messenger.Reserve
 	return s.upsertSchedule(ctx, params)
 }
 
+// ReserveReviewRequest - 配達完了日時を起点にレビュー依頼の送信を予約する
+func (s *service) ReserveReviewRequest(ctx context.Context, in *messenger.ReserveReviewRequestInput) error {
+	const messageType = entity.ScheduleTypeReviewProductRequest
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	sentAt := newReviewRequestSentAt(in.DeliveredAt)
+	params := &upsertScheduleParams{
+		messageType: messageType,
+		messageID:   in.OrderID,
+		sentAt:      sentAt,
+		deadline:    sentAt.AddDate(0, 0, 7),
+	}
+	err := s.upsertSchedule(ctx, params)
+	if errors.Is(err, exception.ErrFailedPrecondition) {
+		return nil // 既にレビュー依頼を送信済みの場合は何もしない
+	}
+	return err
+}
+
+// newReviewRequestSentAt - 配達完了日の翌日18時
+func newReviewRequestSentAt(deliveredAt time.Time) time.Time {
+	return jst.BeginningOfDay(deliveredAt.AddDate(0, 0, 1)).Add(18 * time.Hour)
+}
+
 type upsertScheduleParams struct {
 	messageType entity.ScheduleType
 	messageID   string
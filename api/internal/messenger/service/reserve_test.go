@@ -11,6 +11,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/messenger/entity"
 	"github.com/and-period/furumaru/api/internal/store"
 	sentity "github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -173,3 +174,69 @@ func TestReserveNotification(t *testing.T) {
 		}))
 	}
 }
+
+func TestReserveReviewRequest(t *testing.T) {
+	t.Parallel()
+	deliveredAt := jst.Date(2026, 10, 18, 10, 30, 0, 0)
+	sentAt := jst.Date(2026, 10, 19, 18, 0, 0, 0)
+	schedule := &entity.Schedule{
+		MessageType: entity.ScheduleTypeReviewProductRequest,
+		MessageID:   "order-id",
+		Status:      entity.ScheduleStatusWaiting,
+		Count:       0,
+		SentAt:      sentAt,
+		Deadline:    sentAt.AddDate(0, 0, 7),
+	}
+	tests := []struct {
+		name   string
+		setup  func(ctx context.Context, mocks *mocks)
+		input  *messenger.ReserveReviewRequestInput
+		expect error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Upsert(ctx, schedule).Return(nil)
+			},
+			input: &messenger.ReserveReviewRequestInput{
+				OrderID:     "order-id",
+				DeliveredAt: deliveredAt,
+			},
+			expect: nil,
+		},
+		{
+			name: "success already sent",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Upsert(ctx, schedule).Return(database.ErrFailedPrecondition)
+			},
+			input: &messenger.ReserveReviewRequestInput{
+				OrderID:     "order-id",
+				DeliveredAt: deliveredAt,
+			},
+			expect: nil,
+		},
+		{
+			name:   "invalid argument",
+			setup:  func(ctx context.Context, mocks *mocks) {},
+			input:  &messenger.ReserveReviewRequestInput{},
+			expect: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to upsert schedule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Upsert(ctx, schedule).Return(assert.AnError)
+			},
+			input: &messenger.ReserveReviewRequestInput{
+				OrderID:     "order-id",
+				DeliveredAt: deliveredAt,
+			},
+			expect: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.ReserveReviewRequest(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expect)
+		}))
+	}
+}
@@ -0,0 +1,12 @@
+package cmd
+
+import (
+	"github.com/and-period/furumaru/api/internal/store/cmd/scheduler"
+	"github.com/spf13/cobra"
+)
+
+func RegisterCommand(registry *cobra.Command) {
+	registry.AddCommand(
+		scheduler.NewApp().Command,
+	)
+}
@@ -0,0 +1,290 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/messenger"
+	messengerdb "github.com/and-period/furumaru/api/internal/messenger/database/tidb"
+	messengersrv "github.com/and-period/furumaru/api/internal/messenger/service"
+	"github.com/and-period/furumaru/api/internal/store"
+	storedb "github.com/and-period/furumaru/api/internal/store/database/tidb"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	stripepay "github.com/and-period/furumaru/api/internal/store/payment/stripe"
+	"github.com/and-period/furumaru/api/internal/store/scheduler"
+	storesrv "github.com/and-period/furumaru/api/internal/store/service"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	japanposttracker "github.com/and-period/furumaru/api/internal/store/tracker/japanpost"
+	sagawatracker "github.com/and-period/furumaru/api/internal/store/tracker/sagawa"
+	yamatotracker "github.com/and-period/furumaru/api/internal/store/tracker/yamato"
+	"github.com/and-period/furumaru/api/internal/user"
+	userdb "github.com/and-period/furumaru/api/internal/user/database/tidb"
+	usersrv "github.com/and-period/furumaru/api/internal/user/service"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/and-period/furumaru/api/pkg/secret"
+	"github.com/and-period/furumaru/api/pkg/sqs"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"golang.org/x/sync/errgroup"
+)
+
+type params struct {
+	waitGroup       *sync.WaitGroup
+	secret          secret.Client
+	producer        sqs.Producer
+	adminWebURL     *url.URL
+	userWebURL      *url.URL
+	now             func() time.Time
+	tidbHost        string
+	tidbPort        string
+	tidbUsername    string
+	tidbPassword    string
+	sentryDsn       string
+	stripeSecretKey string
+	trackers        map[entity.ShippingCarrier]tracker.Tracker
+}
+
+func (a *app) inject(ctx context.Context) error {
+	params := &params{
+		now:       jst.Now,
+		waitGroup: &sync.WaitGroup{},
+	}
+
+	// AWS SDKの設定
+	awscfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(a.AWSRegion))
+	if err != nil {
+		return fmt.Errorf("cmd: failed to load aws config: %w", err)
+	}
+
+	// AWS Secrets Managerの設定
+	params.secret = secret.NewClient(awscfg)
+	if err := a.getSecret(ctx, params); err != nil {
+		return fmt.Errorf("cmd: failed to get secret: %w", err)
+	}
+
+	// Amazon SQSの設定
+	sqsParams := &sqs.Params{
+		QueueURL: a.SQSQueueURL,
+	}
+	params.producer = sqs.NewProducer(awscfg, sqsParams, sqs.WithDryRun(a.SQSMockEnabled))
+
+	// WebURLの設定
+	adminWebURL, err := url.Parse(a.AdminWebURL)
+	if err != nil {
+		return fmt.Errorf("cmd: failed to parse admin web url: %w", err)
+	}
+	params.adminWebURL = adminWebURL
+	userWebURL, err := url.Parse(a.UserWebURL)
+	if err != nil {
+		return fmt.Errorf("cmd: failed to parse user web url: %w", err)
+	}
+	params.userWebURL = userWebURL
+
+	// 配送状況の取得設定
+	params.trackers = a.newTrackers()
+
+	// Serviceの設定
+	storeService, err := a.newStoreService(params)
+	if err != nil {
+		return fmt.Errorf("cmd: failed to create store service: %w", err)
+	}
+
+	// Jobの設定
+	jobParams := &scheduler.Params{
+		WaitGroup: params.waitGroup,
+		Store:     storeService,
+	}
+	switch a.RunType {
+	case "RELEASE_INVENTORY":
+		a.job = scheduler.NewInventoryReleaser(jobParams)
+	case "RENEW_SUBSCRIPTION":
+		a.job = scheduler.NewSubscriptionRenewer(jobParams)
+	case "CAPTURE_PREORDER":
+		a.job = scheduler.NewPreorderCapturer(jobParams)
+	case "SYNC_DELIVERY":
+		carriers := make([]entity.ShippingCarrier, 0, len(params.trackers))
+		for carrier := range params.trackers {
+			carriers = append(carriers, carrier)
+		}
+		slices.Sort(carriers)
+		a.job = scheduler.NewDeliveryTracker(jobParams, scheduler.WithShippingCarriers(carriers...))
+	default:
+		return fmt.Errorf("cmd: unknown scheduler type. type=%s", a.RunType)
+	}
+	a.waitGroup = params.waitGroup
+	return nil
+}
+
+func (a *app) getSecret(ctx context.Context, p *params) error {
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		// データベース（TiDB）認証情報の取得
+		if a.TiDBSecretName == "" {
+			p.tidbHost = a.TiDBHost
+			p.tidbPort = a.TiDBPort
+			p.tidbUsername = a.TiDBUsername
+			p.tidbPassword = a.TiDBPassword
+			return nil
+		}
+		secrets, err := p.secret.Get(ectx, a.TiDBSecretName)
+		if err != nil {
+			return err
+		}
+		p.tidbHost = secrets["host"]
+		p.tidbPort = secrets["port"]
+		p.tidbUsername = secrets["username"]
+		p.tidbPassword = secrets["password"]
+		return nil
+	})
+	eg.Go(func() error {
+		// Sentry認証情報の取得
+		if a.SentrySecretName == "" {
+			p.sentryDsn = a.SentryDsn
+			return nil
+		}
+		secrets, err := p.secret.Get(ectx, a.SentrySecretName)
+		if err != nil {
+			return err
+		}
+		p.sentryDsn = secrets["dsn"]
+		return nil
+	})
+	eg.Go(func() error {
+		// Stripe認証情報の取得
+		if a.StripeSecretName == "" {
+			p.stripeSecretKey = a.StripeSecretKey
+			return nil
+		}
+		secrets, err := p.secret.Get(ectx, a.StripeSecretName)
+		if err != nil {
+			return err
+		}
+		p.stripeSecretKey = secrets["secretKey"]
+		return nil
+	})
+	return eg.Wait()
+}
+
+// newTrackers - 追跡APIのエンドポイントが設定されている配送業者のみ配送状況を取得する
+func (a *app) newTrackers() map[entity.ShippingCarrier]tracker.Tracker {
+	trackers := make(map[entity.ShippingCarrier]tracker.Tracker)
+	if a.YamatoTrackingEndpoint != "" {
+		params := &yamatotracker.Params{
+			Endpoint: a.YamatoTrackingEndpoint,
+			APIKey:   a.YamatoTrackingAPIKey,
+		}
+		trackers[entity.ShippingCarrierYamato] = yamatotracker.NewTracker(params)
+	}
+	if a.SagawaTrackingEndpoint != "" {
+		params := &sagawatracker.Params{
+			Endpoint: a.SagawaTrackingEndpoint,
+			APIKey:   a.SagawaTrackingAPIKey,
+		}
+		trackers[entity.ShippingCarrierSagawa] = sagawatracker.NewTracker(params)
+	}
+	if a.JapanPostTrackingEndpoint != "" {
+		params := &japanposttracker.Params{
+			Endpoint: a.JapanPostTrackingEndpoint,
+			APIKey:   a.JapanPostTrackingAPIKey,
+		}
+		trackers[entity.ShippingCarrierJapanPost] = japanposttracker.NewTracker(params)
+	}
+	return trackers
+}
+
+func (a *app) newTiDB(dbname string, p *params) (*mysql.Client, error) {
+	params := &mysql.Params{
+		Host:     p.tidbHost,
+		Port:     p.tidbPort,
+		Database: dbname,
+		Username: p.tidbUsername,
+		Password: p.tidbPassword,
+	}
+	location, err := time.LoadLocation(a.DBTimeZone)
+	if err != nil {
+		return nil, err
+	}
+	return mysql.NewTiDBClient(
+		params,
+		mysql.WithNow(p.now),
+		mysql.WithLocation(location),
+	)
+}
+
+func (a *app) newStoreService(p *params) (store.Service, error) {
+	mysql, err := a.newTiDB("stores", p)
+	if err != nil {
+		return nil, err
+	}
+	user, err := a.newUserService(p)
+	if err != nil {
+		return nil, err
+	}
+	messenger, err := a.newMessengerService(p)
+	if err != nil {
+		return nil, err
+	}
+	providers := make(map[entity.PaymentProviderType]payment.Provider)
+	if p.stripeSecretKey != "" {
+		stripeParams := &stripepay.Params{
+			SecretKey: p.stripeSecretKey,
+		}
+		providers[entity.PaymentProviderTypeStripe] = stripepay.NewProvider(stripeParams)
+	}
+	params := &storesrv.Params{
+		WaitGroup: p.waitGroup,
+		Database:  storedb.NewDatabase(mysql),
+		User:      user,
+		Messenger: messenger,
+		Providers: providers,
+		Trackers:  p.trackers,
+	}
+	return storesrv.NewService(params), nil
+}
+
+func (a *app) newUserService(p *params) (user.Service, error) {
+	mysql, err := a.newTiDB("users", p)
+	if err != nil {
+		return nil, err
+	}
+	params := &usersrv.Params{
+		WaitGroup: p.waitGroup,
+		Database:  userdb.NewDatabase(mysql),
+	}
+	return usersrv.NewService(params), nil
+}
+
+// newMessengerService - 通知内容の組み立てに利用する商品情報は、データベースのみを参照するサービスから取得する
+func (a *app) newMessengerService(p *params) (messenger.Service, error) {
+	mysql, err := a.newTiDB("messengers", p)
+	if err != nil {
+		return nil, err
+	}
+	user, err := a.newUserService(p)
+	if err != nil {
+		return nil, err
+	}
+	storeMySQL, err := a.newTiDB("stores", p)
+	if err != nil {
+		return nil, err
+	}
+	storeParams := &storesrv.Params{
+		WaitGroup: p.waitGroup,
+		Database:  storedb.NewDatabase(storeMySQL),
+	}
+	params := &messengersrv.Params{
+		WaitGroup:   p.waitGroup,
+		Producer:    p.producer,
+		AdminWebURL: p.adminWebURL,
+		UserWebURL:  p.userWebURL,
+		Database:    messengerdb.NewDatabase(mysql),
+		User:        user,
+		Store:       storesrv.NewService(storeParams),
+	}
+	return messengersrv.NewService(params), nil
+}
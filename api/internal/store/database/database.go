@@ -25,23 +25,36 @@ type Database struct {
 	AiChat                   AiChat
 	CartActionLog            CartActionLog
 	Category                 Category
+	DeliverySlotRule         DeliverySlotRule
 	Experience               Experience
 	ExperienceReview         ExperienceReview
 	ExperienceReviewReaction ExperienceReviewReaction
+	ExperienceSlot           ExperienceSlot
 	ExperienceType           ExperienceType
 	Live                     Live
+	LivePin                  LivePin
 	Order                    Order
+	OrderClaim               OrderClaim
+	OrderRefundLine          OrderRefundLine
+	PaymentEvent             PaymentEvent
 	PaymentSystem            PaymentSystem
+	PreorderBatch            PreorderBatch
 	Product                  Product
+	ProductInventoryHold     ProductInventoryHold
 	ProductReview            ProductReview
 	ProductReviewReaction    ProductReviewReaction
 	ProductTag               ProductTag
 	ProductType              ProductType
 	Promotion                Promotion
+	PromotionCode            PromotionCode
+	PromotionCodeBatch       PromotionCodeBatch
+	PromotionRedemption      PromotionRedemption
 	Schedule                 Schedule
+	SettlementStatement      SettlementStatement
 	Shipping                 Shipping
 	Spot                     Spot
 	SpotType                 SpotType
+	Subscription             Subscription
 }
 
 /**
@@ -87,6 +100,15 @@ type ListCategoriesOrder struct {
 	OrderByASC bool
 }
 
+type DeliverySlotRule interface {
+	ListByShopID(ctx context.Context, shopID string, fields ...string) (entity.DeliverySlotRules, error)
+	GetByShippingType(
+		ctx context.Context, shopID string, shippingType entity.ShippingType, fields ...string,
+	) (*entity.DeliverySlotRule, error)
+	Upsert(ctx context.Context, rule *entity.DeliverySlotRule) error
+	Delete(ctx context.Context, shopID string, shippingType entity.ShippingType) error
+}
+
 type Experience interface {
 	List(ctx context.Context, params *ListExperiencesParams, fields ...string) (entity.Experiences, error)
 	ListByGeolocation(ctx context.Context, params *ListExperiencesByGeolocationParams, fields ...string) (entity.Experiences, error)
@@ -197,6 +219,36 @@ type ExperienceReviewReaction interface {
 	GetUserReactions(ctx context.Context, experienceID, userID string) (entity.ExperienceReviewReactions, error)
 }
 
+type ExperienceSlot interface {
+	List(ctx context.Context, params *ListExperienceSlotsParams, fields ...string) (entity.ExperienceSlots, error)
+	Get(ctx context.Context, slotID string, fields ...string) (*entity.ExperienceSlot, error)
+	Create(ctx context.Context, slot *entity.ExperienceSlot) error
+	Update(ctx context.Context, slotID string, params *UpdateExperienceSlotParams) error
+	Delete(ctx context.Context, slotID string) error
+	ListReservations(ctx context.Context, params *ListExperienceSlotReservationsParams) (entity.ExperienceSlotReservations, error)
+	Reserve(ctx context.Context, reservation *entity.ExperienceSlotReservation) error
+	Confirm(ctx context.Context, orderID string) error
+	Release(ctx context.Context, orderID string) error
+}
+
+type ListExperienceSlotsParams struct {
+	ExperienceID string
+	StartAtGte   time.Time
+	StartAtLt    time.Time
+}
+
+type ListExperienceSlotReservationsParams struct {
+	Status      entity.ExperienceSlotReservationStatus
+	ExpiredAtLt time.Time
+	Limit       int
+}
+
+type UpdateExperienceSlotParams struct {
+	Capacity int64
+	StartAt  time.Time
+	EndAt    time.Time
+}
+
 type ExperienceType interface {
 	List(ctx context.Context, params *ListExperienceTypesParams, fields ...string) (entity.ExperienceTypes, error)
 	Count(ctx context.Context, params *ListExperienceTypesParams) (int64, error)
@@ -240,35 +292,94 @@ type UpdateLiveParams struct {
 	EndAt      time.Time
 }
 
+type LivePin interface {
+	List(ctx context.Context, params *ListLivePinsParams, fields ...string) (entity.LivePins, error)
+	Get(ctx context.Context, livePinID string, fields ...string) (*entity.LivePin, error)
+	Create(ctx context.Context, pin *entity.LivePin) error
+	Unpin(ctx context.Context, livePinID string, params *UnpinLivePinParams) error
+}
+
+type ListLivePinsParams struct {
+	ScheduleID   string
+	ProductID    string
+	OnlyPinned   bool
+	UpdatedAtGte time.Time
+}
+
+type UnpinLivePinParams struct {
+	UnpinnedAt     time.Time
+	UnpinnedOffset int64
+}
+
 type Order interface {
 	List(ctx context.Context, params *ListOrdersParams, fields ...string) (entity.Orders, error)
 	ListUserIDs(ctx context.Context, params *ListOrdersParams) ([]string, int64, error)
+	ListByTrackingNumbers(ctx context.Context, params *ListOrdersByTrackingNumbersParams) (entity.Orders, error)
+	ListSettlementTargets(ctx context.Context, params *ListSettlementTargetOrdersParams) (entity.Orders, error)
+	ListUndeliveredFulfillments(ctx context.Context, params *ListUndeliveredOrderFulfillmentsParams) (entity.OrderFulfillments, error)
+	ListAuthorizedPreorders(ctx context.Context, params *ListAuthorizedPreordersParams) (entity.Orders, error)
 	Count(ctx context.Context, params *ListOrdersParams) (int64, error)
 	Get(ctx context.Context, orderID string, fields ...string) (*entity.Order, error)
+	MultiGet(ctx context.Context, orderIDs []string, fields ...string) (entity.Orders, error)
+	MultiGetByManagementIDs(ctx context.Context, shopID string, managementIDs []int64, fields ...string) (entity.Orders, error)
 	GetByTransactionID(ctx context.Context, userID, transactionID string) (*entity.Order, error)
 	GetByTransactionIDWithSessionID(ctx context.Context, sessionID, transactionID string) (*entity.Order, error)
 	Create(ctx context.Context, order *entity.Order) error
 	UpdateAuthorized(ctx context.Context, orderID string, params *UpdateOrderAuthorizedParams) error
 	UpdateCaptured(ctx context.Context, orderID string, params *UpdateOrderCapturedParams) error
 	UpdateFailed(ctx context.Context, orderID string, params *UpdateOrderFailedParams) error
+	Expire(ctx context.Context, orderID string, params *ExpireOrderParams) error
+	ExtendHolds(ctx context.Context, orderID string, params *ExtendOrderHoldsParams) error
 	UpdateRefunded(ctx context.Context, orderID string, params *UpdateOrderRefundedParams) error
+	CreateFulfillment(ctx context.Context, fulfillment *entity.OrderFulfillment) error
 	UpdateFulfillment(ctx context.Context, orderID, fulfillmentID string, params *UpdateOrderFulfillmentParams) error
+	DeliverFulfillment(ctx context.Context, orderID, fulfillmentID string, deliveredAt time.Time) error
+	ShipFulfillments(ctx context.Context, params []*ShipOrderFulfillmentParams) (map[string]entity.UnmatchedShipmentReason, error)
 	Draft(ctx context.Context, orderID string, params *DraftOrderParams) error
 	Complete(ctx context.Context, orderID string, params *CompleteOrderParams) error
 	Aggregate(ctx context.Context, params *AggregateOrdersParams) (*entity.AggregatedOrder, error)
 	AggregateByUser(ctx context.Context, params *AggregateOrdersByUserParams) (entity.AggregatedUserOrders, error)
 	AggregateByPaymentMethodType(ctx context.Context, params *AggregateOrdersByPaymentMethodTypeParams) (entity.AggregatedOrderPayments, error)
+	AggregateByProduct(ctx context.Context, params *AggregateOrdersByProductParams) (entity.AggregatedOrderProducts, error)
 	AggregateByPromotion(ctx context.Context, params *AggregateOrdersByPromotionParams) (entity.AggregatedOrderPromotions, error)
 	AggregateByPeriod(ctx context.Context, params *AggregateOrdersByPeriodParams) (entity.AggregatedPeriodOrders, error)
 }
 
 type ListOrdersParams struct {
-	ShopID   string
-	UserID   string
-	Types    []entity.OrderType
-	Statuses []entity.OrderStatus
+	ShopID          string
+	UserID          string
+	PreorderBatchID string
+	Types           []entity.OrderType
+	Statuses        []entity.OrderStatus
+	CreatedAtGte    time.Time
+	CreatedAtLt     time.Time
+	Limit           int
+	Offset          int
+}
+
+type ListOrdersByTrackingNumbersParams struct {
+	ShopID          string
+	ShippingCarrier entity.ShippingCarrier
+	TrackingNumbers []string
+}
+
+type ListSettlementTargetOrdersParams struct {
+	CapturedAtLt time.Time
+	Limit        int
+	Offset       int
+}
+
+type ListUndeliveredOrderFulfillmentsParams struct {
+	ShippingCarrier entity.ShippingCarrier
+	ShippedAtGte    time.Time
+	AfterShippedAt  time.Time // ページング用カーソル(前ページ末尾の発送日時)
+	AfterID         string    // ページング用カーソル(前ページ末尾の配送ID)
+	Limit           int
+}
+
+type ListAuthorizedPreordersParams struct {
+	PaidAtLt time.Time
 	Limit    int
-	Offset   int
 }
 
 type UpdateOrderAuthorizedParams struct {
@@ -287,6 +398,14 @@ type UpdateOrderFailedParams struct {
 	IssuedAt  time.Time
 }
 
+type ExpireOrderParams struct {
+	ExpiredAt time.Time
+}
+
+type ExtendOrderHoldsParams struct {
+	ExpiredAt time.Time
+}
+
 type UpdateOrderRefundedParams struct {
 	Status       entity.PaymentStatus
 	RefundType   entity.RefundType
@@ -302,6 +421,14 @@ type UpdateOrderFulfillmentParams struct {
 	ShippedAt       time.Time
 }
 
+type ShipOrderFulfillmentParams struct {
+	OrderID         string
+	FulfillmentID   string
+	ShippingCarrier entity.ShippingCarrier
+	TrackingNumber  string
+	ShippedAt       time.Time
+}
+
 type DraftOrderParams struct {
 	ShippingMessage string
 }
@@ -329,6 +456,13 @@ type AggregateOrdersByPaymentMethodTypeParams struct {
 	CreatedAtLt        time.Time
 }
 
+type AggregateOrdersByProductParams struct {
+	ShopID       string
+	ProductIDs   []string
+	CreatedAtGte time.Time
+	CreatedAtLt  time.Time
+}
+
 type AggregateOrdersByPromotionParams struct {
 	ShopID       string
 	PromotionIDs []string
@@ -341,9 +475,86 @@ type AggregateOrdersByPeriodParams struct {
 	CreatedAtLt  time.Time
 }
 
+type OrderClaim interface {
+	List(ctx context.Context, params *ListOrderClaimsParams, fields ...string) (entity.OrderClaims, error)
+	Count(ctx context.Context, params *ListOrderClaimsParams) (int64, error)
+	Get(ctx context.Context, claimID string, fields ...string) (*entity.OrderClaim, error)
+	Create(ctx context.Context, claim *entity.OrderClaim) error
+	Update(ctx context.Context, claimID string, params *UpdateOrderClaimParams) error
+}
+
+type ListOrderClaimsParams struct {
+	ShopID   string
+	OrderID  string
+	UserID   string
+	Statuses []entity.OrderClaimStatus
+	Limit    int
+	Offset   int
+}
+
+type UpdateOrderClaimParams struct {
+	Status         entity.OrderClaimStatus
+	ReviewerID     string
+	ReviewComment  string
+	ResolutionType entity.OrderClaimResolutionType
+	ResolutionNote string
+	RefundLineID   string
+	PromotionID    string
+	FulfillmentID  string
+	ReviewedAt     time.Time
+	ResolvedAt     time.Time
+}
+
+type OrderRefundLine interface {
+	List(ctx context.Context, params *ListOrderRefundLinesParams, fields ...string) (entity.OrderRefundLines, error)
+	Get(ctx context.Context, lineID string, fields ...string) (*entity.OrderRefundLine, error)
+	Create(ctx context.Context, line *entity.OrderRefundLine) error
+	Update(ctx context.Context, lineID string, params *UpdateOrderRefundLineParams) error
+}
+
+type ListOrderRefundLinesParams struct {
+	OrderID string
+}
+
+type UpdateOrderRefundLineParams struct {
+	Status     entity.OrderRefundLineStatus
+	RefundedAt time.Time
+}
+
+type PaymentEvent interface {
+	List(ctx context.Context, params *ListPaymentEventsParams, fields ...string) (entity.PaymentEvents, error)
+	Count(ctx context.Context, params *ListPaymentEventsParams) (int64, error)
+	Get(ctx context.Context, paymentEventID string, fields ...string) (*entity.PaymentEvent, error)
+	GetByEventID(
+		ctx context.Context, providerType entity.PaymentProviderType, eventID string, fields ...string,
+	) (*entity.PaymentEvent, error)
+	Create(ctx context.Context, event *entity.PaymentEvent) error
+	Claim(ctx context.Context, paymentEventID string, params *ClaimPaymentEventParams) error
+	UpdateResult(ctx context.Context, paymentEventID string, params *UpdatePaymentEventResultParams) error
+}
+
+type ListPaymentEventsParams struct {
+	ProviderType entity.PaymentProviderType
+	Statuses     []entity.PaymentEventStatus
+	Limit        int
+	Offset       int
+}
+
+type ClaimPaymentEventParams struct {
+	StaleAt time.Time // この日時より前から処理中のイベントは中断されたものとして再処理する
+}
+
+type UpdatePaymentEventResultParams struct {
+	Status       entity.PaymentEventStatus
+	ErrorMessage string
+	AttemptCount int64
+	ProcessedAt  time.Time
+}
+
 type UpdatePaymentSystemParams struct {
 	Status       entity.PaymentSystemStatus
 	ProviderType entity.PaymentProviderType
+	FeeRate      float64
 }
 
 type PaymentSystem interface {
@@ -352,6 +563,29 @@ type PaymentSystem interface {
 	Update(ctx context.Context, methodType entity.PaymentMethodType, params *UpdatePaymentSystemParams) error
 }
 
+type PreorderBatch interface {
+	List(ctx context.Context, params *ListPreorderBatchesParams, fields ...string) (entity.PreorderBatches, error)
+	Count(ctx context.Context, params *ListPreorderBatchesParams) (int64, error)
+	Get(ctx context.Context, batchID string, fields ...string) (*entity.PreorderBatch, error)
+	GetByExpectedShipping(
+		ctx context.Context, shopID string, startAt, endAt time.Time, fields ...string,
+	) (*entity.PreorderBatch, error)
+	Create(ctx context.Context, batch *entity.PreorderBatch) error
+	Update(ctx context.Context, batchID string, params *UpdatePreorderBatchParams) error
+}
+
+type ListPreorderBatchesParams struct {
+	ShopID   string
+	Statuses []entity.PreorderBatchStatus
+	Limit    int
+	Offset   int
+}
+
+type UpdatePreorderBatchParams struct {
+	Status  entity.PreorderBatchStatus
+	ReadyAt time.Time
+}
+
 type Product interface {
 	List(ctx context.Context, params *ListProductsParams, fields ...string) (entity.Products, error)
 	Count(ctx context.Context, params *ListProductsParams) (int64, error)
@@ -403,31 +637,52 @@ type ListProductsOrder struct {
 }
 
 type UpdateProductParams struct {
-	TypeID               string
-	TagIDs               []string
-	Name                 string
-	Description          string
-	Scope                entity.ProductScope
-	Inventory            int64
-	Weight               int64
-	WeightUnit           entity.WeightUnit
-	Item                 int64
-	ItemUnit             string
-	ItemDescription      string
-	Media                entity.MultiProductMedia
-	Price                int64
-	Cost                 int64
-	ExpirationDate       int64
-	RecommendedPoints    []string
-	StorageMethodType    entity.StorageMethodType
-	DeliveryType         entity.DeliveryType
-	Box60Rate            int64
-	Box80Rate            int64
-	Box100Rate           int64
-	OriginPrefectureCode int32
-	OriginCity           string
-	StartAt              time.Time
-	EndAt                time.Time
+	TypeID                  string
+	TagIDs                  []string
+	Name                    string
+	Description             string
+	Scope                   entity.ProductScope
+	Inventory               int64
+	Weight                  int64
+	WeightUnit              entity.WeightUnit
+	Item                    int64
+	ItemUnit                string
+	ItemDescription         string
+	Media                   entity.MultiProductMedia
+	Price                   int64
+	Cost                    int64
+	TaxCategory             entity.TaxCategory
+	ExpirationDate          int64
+	RecommendedPoints       []string
+	StorageMethodType       entity.StorageMethodType
+	DeliveryType            entity.DeliveryType
+	Box60Rate               int64
+	Box80Rate               int64
+	Box100Rate              int64
+	OriginPrefectureCode    int32
+	OriginCity              string
+	StartAt                 time.Time
+	EndAt                   time.Time
+	ExpectedShippingStartAt time.Time
+	ExpectedShippingEndAt   time.Time
+}
+
+type ProductInventoryHold interface {
+	List(ctx context.Context, params *ListProductInventoryHoldsParams, fields ...string) (entity.ProductInventoryHolds, error)
+	Aggregate(ctx context.Context, params *AggregateProductInventoryHoldsParams) (entity.AggregatedProductInventoryHolds, error)
+	Hold(ctx context.Context, holds entity.ProductInventoryHolds) error
+	Sell(ctx context.Context, orderID string) error
+	Release(ctx context.Context, orderID string) error
+}
+
+type ListProductInventoryHoldsParams struct {
+	Status      entity.ProductInventoryHoldStatus
+	ExpiredAtLt time.Time
+	Limit       int
+}
+
+type AggregateProductInventoryHoldsParams struct {
+	ProductIDs []string
 }
 
 type ProductReview interface {
@@ -557,15 +812,65 @@ type ListPromotionsOrder struct {
 }
 
 type UpdatePromotionParams struct {
-	Title        string
-	Description  string
-	Public       bool
-	DiscountType entity.DiscountType
-	DiscountRate int64
-	Code         string
-	CodeType     entity.PromotionCodeType
-	StartAt      time.Time
-	EndAt        time.Time
+	Title             string
+	Description       string
+	Public            bool
+	DiscountType      entity.DiscountType
+	DiscountRate      int64
+	Rules             entity.PromotionRules
+	Code              string
+	CodeType          entity.PromotionCodeType
+	UsageLimit        int64
+	UsageLimitPerUser int64
+	FirstPurchaseOnly bool
+	StartAt           time.Time
+	EndAt             time.Time
+}
+
+type PromotionCode interface {
+	List(ctx context.Context, params *ListPromotionCodesParams, fields ...string) (entity.PromotionCodes, error)
+	Count(ctx context.Context, params *ListPromotionCodesParams) (int64, error)
+	Get(ctx context.Context, code string, fields ...string) (*entity.PromotionCode, error)
+}
+
+type ListPromotionCodesParams struct {
+	BatchID  string
+	Statuses []entity.PromotionCodeStatus
+	Limit    int
+	Offset   int
+}
+
+type PromotionCodeBatch interface {
+	List(ctx context.Context, params *ListPromotionCodeBatchesParams, fields ...string) (entity.PromotionCodeBatches, error)
+	Count(ctx context.Context, params *ListPromotionCodeBatchesParams) (int64, error)
+	Get(ctx context.Context, batchID string, fields ...string) (*entity.PromotionCodeBatch, error)
+	Create(ctx context.Context, batch *entity.PromotionCodeBatch, codes entity.PromotionCodes) error
+	Void(ctx context.Context, batchID string) error
+}
+
+type ListPromotionCodeBatchesParams struct {
+	PromotionID string
+	Limit       int
+	Offset      int
+}
+
+type PromotionRedemption interface {
+	List(ctx context.Context, params *ListPromotionRedemptionsParams) (entity.PromotionRedemptions, error)
+	Aggregate(ctx context.Context, params *AggregatePromotionRedemptionsParams) (entity.AggregatedPromotionRedemptions, error)
+	GetUsage(ctx context.Context, promotionID, userID string) (*entity.PromotionUsage, error)
+	Redeem(ctx context.Context, redemption *entity.PromotionRedemption) error
+	Confirm(ctx context.Context, orderID string) error
+	Release(ctx context.Context, orderID string) error
+}
+
+type ListPromotionRedemptionsParams struct {
+	Status      entity.PromotionRedemptionStatus
+	ExpiredAtLt time.Time
+	Limit       int
+}
+
+type AggregatePromotionRedemptionsParams struct {
+	PromotionIDs []string
 }
 
 type Schedule interface {
@@ -607,6 +912,37 @@ type ApproveScheduleParams struct {
 	ApprovedAdminID string
 }
 
+type SettlementStatement interface {
+	List(ctx context.Context, params *ListSettlementStatementsParams, fields ...string) (entity.SettlementStatements, error)
+	Count(ctx context.Context, params *ListSettlementStatementsParams) (int64, error)
+	Get(ctx context.Context, statementID string, fields ...string) (*entity.SettlementStatement, error)
+	Close(ctx context.Context, params *CloseSettlementPeriodParams) error
+	Pay(ctx context.Context, statementID string, paidAt time.Time) error
+	AggregateBalances(ctx context.Context, params *AggregateSettlementBalancesParams) (entity.SettlementBalances, error)
+}
+
+type ListSettlementStatementsParams struct {
+	PayeeType     entity.SettlementPayeeType
+	PayeeID       string
+	PeriodStartAt time.Time
+	Statuses      []entity.SettlementStatementStatus
+	Limit         int
+	Offset        int
+}
+
+type CloseSettlementPeriodParams struct {
+	PeriodStartAt time.Time
+	PeriodEndAt   time.Time
+	Statements    entity.SettlementStatements
+	Orders        entity.Orders // 精算対象の注文（精算前の状態）
+	SettledAt     time.Time
+}
+
+type AggregateSettlementBalancesParams struct {
+	PayeeType entity.SettlementPayeeType
+	PayeeID   string
+}
+
 type Shipping interface {
 	List(ctx context.Context, params *ListShippingsParams, fields ...string) (entity.Shippings, error)
 	ListByShopIDs(ctx context.Context, shopIDs []string, fields ...string) (entity.Shippings, error)
@@ -638,6 +974,13 @@ type UpdateShippingParams struct {
 	Box80Frozen       int64
 	Box100Rates       entity.ShippingRates
 	Box100Frozen      int64
+	Box120Rates       entity.ShippingRates
+	Box120Frozen      int64
+	Box140Rates       entity.ShippingRates
+	Box140Frozen      int64
+	Box160Rates       entity.ShippingRates
+	Box160Frozen      int64
+	RegionRules       entity.ShippingRegionRules
 	HasFreeShipping   bool
 	FreeShippingRates int64
 }
@@ -710,6 +1053,39 @@ type UpdateSpotTypeParams struct {
 	Name string
 }
 
+type Subscription interface {
+	List(ctx context.Context, params *ListSubscriptionsParams, fields ...string) (entity.Subscriptions, error)
+	Count(ctx context.Context, params *ListSubscriptionsParams) (int64, error)
+	Get(ctx context.Context, subscriptionID string, fields ...string) (*entity.Subscription, error)
+	Create(ctx context.Context, subscription *entity.Subscription) error
+	Update(ctx context.Context, subscriptionID string, params *UpdateSubscriptionParams) error
+	UpdateNextRunAt(ctx context.Context, subscriptionID string, params *UpdateSubscriptionNextRunAtParams) error
+}
+
+type ListSubscriptionsParams struct {
+	UserID       string
+	ShopID       string
+	Statuses     []entity.SubscriptionStatus
+	NextRunUntil time.Time // 次回注文日時がこの日時以前のもののみ取得
+	Limit        int
+	Offset       int
+}
+
+type UpdateSubscriptionParams struct {
+	Status       entity.SubscriptionStatus
+	NextRunAt    time.Time
+	LastOrderID  string
+	FailureCount int64
+	LastFailedAt time.Time
+	PausedAt     time.Time
+	CanceledAt   time.Time
+}
+
+type UpdateSubscriptionNextRunAtParams struct {
+	CurrentNextRunAt time.Time // 更新前の次回注文日時（一致する場合のみ更新）
+	NextRunAt        time.Time
+}
+
 type Error struct {
 	err error
 }
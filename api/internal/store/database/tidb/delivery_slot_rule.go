@@ -0,0 +1,118 @@
+package tidb
+
+import (
+	"context"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm/clause"
+)
+
+const deliverySlotRuleTable = "delivery_slot_rules"
+
+type deliverySlotRule struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewDeliverySlotRule(db *mysql.Client) database.DeliverySlotRule {
+	return &deliverySlotRule{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+func (r *deliverySlotRule) ListByShopID(
+	ctx context.Context, shopID string, fields ...string,
+) (entity.DeliverySlotRules, error) {
+	var internal internalDeliverySlotRules
+
+	stmt := r.db.Statement(ctx, r.db.DB, deliverySlotRuleTable, fields...).
+		Where("shop_id = ?", shopID).
+		Order("shipping_type ASC")
+
+	if err := stmt.Find(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entities(), nil
+}
+
+func (r *deliverySlotRule) GetByShippingType(
+	ctx context.Context, shopID string, shippingType entity.ShippingType, fields ...string,
+) (*entity.DeliverySlotRule, error) {
+	var internal *internalDeliverySlotRule
+
+	stmt := r.db.Statement(ctx, r.db.DB, deliverySlotRuleTable, fields...).
+		Where("shop_id = ?", shopID).
+		Where("shipping_type = ?", shippingType)
+
+	if err := stmt.First(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entity(), nil
+}
+
+func (r *deliverySlotRule) Upsert(ctx context.Context, rule *entity.DeliverySlotRule) error {
+	now := r.now()
+	rule.CreatedAt, rule.UpdatedAt = now, now
+
+	internal := newInternalDeliverySlotRule(rule)
+	updates := map[string]interface{}{
+		"coordinator_id":  rule.CoordinatorID,
+		"lead_days":       rule.LeadDays,
+		"selectable_days": rule.SelectableDays,
+		"blackout_dates":  internal.BlackoutDatesJSON,
+		"time_windows":    internal.TimeWindowsJSON,
+		"updated_at":      rule.UpdatedAt,
+	}
+	stmt := r.db.DB.WithContext(ctx).Table(deliverySlotRuleTable).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "shop_id"}, {Name: "shipping_type"}},
+		DoUpdates: clause.Assignments(updates),
+	})
+
+	err := stmt.Create(internal).Error
+	return dbError(err)
+}
+
+func (r *deliverySlotRule) Delete(ctx context.Context, shopID string, shippingType entity.ShippingType) error {
+	stmt := r.db.DB.WithContext(ctx).Table(deliverySlotRuleTable).
+		Where("shop_id = ?", shopID).
+		Where("shipping_type = ?", shippingType)
+
+	err := stmt.Delete(&internalDeliverySlotRule{}).Error
+	return dbError(err)
+}
+
+type internalDeliverySlotRule struct {
+	entity.DeliverySlotRule `gorm:"embedded"`
+	BlackoutDatesJSON       mysql.JSONColumn[[]string]                    `gorm:"default:null;column:blackout_dates"` // お届け不可日一覧(JSON)
+	TimeWindowsJSON         mysql.JSONColumn[[]entity.DeliveryTimeWindow] `gorm:"default:null;column:time_windows"`   // 指定可能な時間帯一覧(JSON)
+}
+
+type internalDeliverySlotRules []*internalDeliverySlotRule
+
+func newInternalDeliverySlotRule(rule *entity.DeliverySlotRule) *internalDeliverySlotRule {
+	return &internalDeliverySlotRule{
+		DeliverySlotRule:  *rule,
+		BlackoutDatesJSON: mysql.NewJSONColumn(rule.BlackoutDates),
+		TimeWindowsJSON:   mysql.NewJSONColumn(rule.TimeWindows),
+	}
+}
+
+func (r *internalDeliverySlotRule) entity() *entity.DeliverySlotRule {
+	rule := r.DeliverySlotRule
+	rule.BlackoutDates = r.BlackoutDatesJSON.Val
+	rule.TimeWindows = r.TimeWindowsJSON.Val
+	return &rule
+}
+
+func (rs internalDeliverySlotRules) entities() entity.DeliverySlotRules {
+	res := make(entity.DeliverySlotRules, len(rs))
+	for i := range rs {
+		res[i] = rs[i].entity()
+	}
+	return res
+}
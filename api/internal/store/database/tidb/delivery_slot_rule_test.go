@@ -0,0 +1,266 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverySlotRule(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewDeliverySlotRule(nil))
+}
+
+func TestDeliverySlotRule_ListByShopID(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	rules := make(entity.DeliverySlotRules, 3)
+	rules[0] = testDeliverySlotRule("rule-id01", "shop-id", entity.ShippingTypeFrozen, now())
+	rules[1] = testDeliverySlotRule("rule-id02", "shop-id", entity.ShippingTypeNormal, now())
+	rules[2] = testDeliverySlotRule("rule-id03", "other-shop-id", entity.ShippingTypeNormal, now())
+	for _, rule := range rules {
+		err = db.DB.Table(deliverySlotRuleTable).Create(newInternalDeliverySlotRule(rule)).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		shopID string
+	}
+	type want struct {
+		rules entity.DeliverySlotRules
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				shopID: "shop-id",
+			},
+			want: want{
+				rules: entity.DeliverySlotRules{rules[1], rules[0]},
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &deliverySlotRule{db: db, now: now}
+			actual, err := db.ListByShopID(ctx, tt.args.shopID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.rules, actual)
+		})
+	}
+}
+
+func TestDeliverySlotRule_GetByShippingType(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	r := testDeliverySlotRule("rule-id", "shop-id", entity.ShippingTypeFrozen, now())
+	err = db.DB.Table(deliverySlotRuleTable).Create(newInternalDeliverySlotRule(r)).Error
+	require.NoError(t, err)
+
+	type args struct {
+		shopID       string
+		shippingType entity.ShippingType
+	}
+	type want struct {
+		rule *entity.DeliverySlotRule
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				shopID:       "shop-id",
+				shippingType: entity.ShippingTypeFrozen,
+			},
+			want: want{
+				rule: r,
+				err:  nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				shopID:       "shop-id",
+				shippingType: entity.ShippingTypeNormal,
+			},
+			want: want{
+				rule: nil,
+				err:  database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &deliverySlotRule{db: db, now: now}
+			actual, err := db.GetByShippingType(ctx, tt.args.shopID, tt.args.shippingType)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.rule, actual)
+		})
+	}
+}
+
+func TestDeliverySlotRule_Upsert(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		rule *entity.DeliverySlotRule
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success create",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				rule: testDeliverySlotRule("rule-id", "shop-id", entity.ShippingTypeFrozen, now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "success update",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				r := testDeliverySlotRule("other-id", "shop-id", entity.ShippingTypeFrozen, now())
+				err := db.DB.Table(deliverySlotRuleTable).Create(newInternalDeliverySlotRule(r)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				rule: testDeliverySlotRule("rule-id", "shop-id", entity.ShippingTypeFrozen, now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &deliverySlotRule{db: db, now: now}
+			err = db.Upsert(ctx, tt.args.rule)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestDeliverySlotRule_Delete(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		shopID       string
+		shippingType entity.ShippingType
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				r := testDeliverySlotRule("rule-id", "shop-id", entity.ShippingTypeFrozen, now())
+				err := db.DB.Table(deliverySlotRuleTable).Create(newInternalDeliverySlotRule(r)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				shopID:       "shop-id",
+				shippingType: entity.ShippingTypeFrozen,
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &deliverySlotRule{db: db, now: now}
+			err = db.Delete(ctx, tt.args.shopID, tt.args.shippingType)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testDeliverySlotRule(id, shopID string, shippingType entity.ShippingType, now time.Time) *entity.DeliverySlotRule {
+	return &entity.DeliverySlotRule{
+		ID:             id,
+		ShopID:         shopID,
+		CoordinatorID:  "coordinator-id",
+		ShippingType:   shippingType,
+		LeadDays:       2,
+		SelectableDays: 14,
+		BlackoutDates:  []string{"20261231", "20270101"},
+		TimeWindows:    []entity.DeliveryTimeWindow{entity.DeliveryTimeWindowMorning, entity.DeliveryTimeWindow1416},
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
@@ -0,0 +1,280 @@
+package tidb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	experienceSlotTable            = "experience_slots"
+	experienceSlotReservationTable = "experience_slot_reservations"
+)
+
+type experienceSlot struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewExperienceSlot(db *mysql.Client) database.ExperienceSlot {
+	return &experienceSlot{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listExperienceSlotsParams database.ListExperienceSlotsParams
+
+func (p listExperienceSlotsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	stmt = stmt.Where("experience_id = ?", p.ExperienceID)
+	if !p.StartAtGte.IsZero() {
+		stmt = stmt.Where("start_at >= ?", p.StartAtGte)
+	}
+	if !p.StartAtLt.IsZero() {
+		stmt = stmt.Where("start_at < ?", p.StartAtLt)
+	}
+	return stmt.Order("start_at ASC")
+}
+
+func (s *experienceSlot) List(
+	ctx context.Context, params *database.ListExperienceSlotsParams, fields ...string,
+) (entity.ExperienceSlots, error) {
+	var slots entity.ExperienceSlots
+
+	p := listExperienceSlotsParams(*params)
+
+	stmt := s.db.Statement(ctx, s.db.DB, experienceSlotTable, fields...)
+	stmt = p.stmt(stmt)
+
+	if err := stmt.Find(&slots).Error; err != nil {
+		return nil, dbError(err)
+	}
+	slots.Fill(s.now())
+	return slots, nil
+}
+
+func (s *experienceSlot) Get(ctx context.Context, slotID string, fields ...string) (*entity.ExperienceSlot, error) {
+	slot, err := s.get(ctx, s.db.DB, slotID, fields...)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	slot.SetStatus(s.now())
+	return slot, nil
+}
+
+func (s *experienceSlot) Create(ctx context.Context, slot *entity.ExperienceSlot) error {
+	now := s.now()
+	slot.CreatedAt, slot.UpdatedAt = now, now
+
+	err := s.db.DB.WithContext(ctx).Table(experienceSlotTable).Create(slot).Error
+	return dbError(err)
+}
+
+func (s *experienceSlot) Update(ctx context.Context, slotID string, params *database.UpdateExperienceSlotParams) error {
+	err := s.db.Transaction(ctx, func(tx *gorm.DB) error {
+		current, err := s.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), slotID)
+		if err != nil {
+			return err
+		}
+		if params.Capacity < current.Reserved {
+			return fmt.Errorf("tidb: capacity is less than reserved: %w", database.ErrFailedPrecondition)
+		}
+
+		updates := map[string]interface{}{
+			"capacity":   params.Capacity,
+			"start_at":   params.StartAt,
+			"end_at":     params.EndAt,
+			"updated_at": s.now(),
+		}
+		stmt := tx.WithContext(ctx).Table(experienceSlotTable).Where("id = ?", slotID)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+		return s.syncSoldOut(ctx, tx, current.ExperienceID)
+	})
+	return dbError(err)
+}
+
+func (s *experienceSlot) Delete(ctx context.Context, slotID string) error {
+	err := s.db.Transaction(ctx, func(tx *gorm.DB) error {
+		current, err := s.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), slotID)
+		if err != nil {
+			return err
+		}
+		if current.Reserved > 0 {
+			return fmt.Errorf("tidb: this slot has reservations: %w", database.ErrFailedPrecondition)
+		}
+
+		updates := map[string]interface{}{
+			"deleted_at": s.now(),
+		}
+		stmt := tx.WithContext(ctx).Table(experienceSlotTable).Where("id = ?", slotID)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+		return s.syncSoldOut(ctx, tx, current.ExperienceID)
+	})
+	return dbError(err)
+}
+
+func (s *experienceSlot) ListReservations(
+	ctx context.Context, params *database.ListExperienceSlotReservationsParams,
+) (entity.ExperienceSlotReservations, error) {
+	var reservations entity.ExperienceSlotReservations
+
+	stmt := s.db.Statement(ctx, s.db.DB, experienceSlotReservationTable)
+	if params.Status != entity.ExperienceSlotReservationStatusUnknown {
+		stmt = stmt.Where("status = ?", params.Status)
+	}
+	if !params.ExpiredAtLt.IsZero() {
+		stmt = stmt.Where("expired_at < ?", params.ExpiredAtLt)
+	}
+	stmt = stmt.Order("expired_at ASC")
+	if params.Limit > 0 {
+		stmt = stmt.Limit(params.Limit)
+	}
+
+	err := stmt.Find(&reservations).Error
+	return reservations, dbError(err)
+}
+
+func (s *experienceSlot) Reserve(ctx context.Context, reservation *entity.ExperienceSlotReservation) error {
+	err := s.db.Transaction(ctx, func(tx *gorm.DB) error {
+		slot, err := s.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), reservation.SlotID)
+		if err != nil {
+			return err
+		}
+		if slot.Capacity < slot.Reserved+reservation.Quantity {
+			return fmt.Errorf("tidb: insufficient slot capacity: %w", database.ErrFailedPrecondition)
+		}
+
+		now := s.now()
+		reservation.CreatedAt, reservation.UpdatedAt = now, now
+		if err := tx.WithContext(ctx).Table(experienceSlotReservationTable).Create(reservation).Error; err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"reserved":   gorm.Expr("reserved + ?", reservation.Quantity),
+			"updated_at": now,
+		}
+		stmt := tx.WithContext(ctx).Table(experienceSlotTable).Where("id = ?", slot.ID)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+		return s.syncSoldOut(ctx, tx, slot.ExperienceID)
+	})
+	return dbError(err)
+}
+
+func (s *experienceSlot) Confirm(ctx context.Context, orderID string) error {
+	updates := map[string]interface{}{
+		"status":     entity.ExperienceSlotReservationStatusConfirmed,
+		"updated_at": s.now(),
+	}
+	stmt := s.db.DB.WithContext(ctx).
+		Table(experienceSlotReservationTable).
+		Where("order_id = ?", orderID).
+		Where("status = ?", entity.ExperienceSlotReservationStatusHeld)
+
+	err := stmt.Updates(updates).Error
+	return dbError(err)
+}
+
+func (s *experienceSlot) Release(ctx context.Context, orderID string) error {
+	err := s.db.Transaction(ctx, func(tx *gorm.DB) error {
+		return s.release(ctx, tx, orderID, s.now())
+	})
+	return dbError(err)
+}
+
+// release - 予約枠を解放し、体験枠の予約済み人数を戻す
+func (s *experienceSlot) release(ctx context.Context, tx *gorm.DB, orderID string, now time.Time) error {
+	var reservation *entity.ExperienceSlotReservation
+
+	stmt := s.db.Statement(ctx, tx, experienceSlotReservationTable).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderID)
+	err := stmt.First(&reservation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil // 予約枠の指定がない注文
+	}
+	if err != nil {
+		return err
+	}
+	if !reservation.Releasable() {
+		return nil
+	}
+
+	slot, err := s.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), reservation.SlotID)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"status":     entity.ExperienceSlotReservationStatusReleased,
+		"updated_at": now,
+	}
+	stmt = tx.WithContext(ctx).Table(experienceSlotReservationTable).Where("order_id = ?", orderID)
+	if err := stmt.Updates(updates).Error; err != nil {
+		return err
+	}
+
+	reserved := slot.Reserved - reservation.Quantity
+	if reserved < 0 {
+		reserved = 0
+	}
+	updates = map[string]interface{}{
+		"reserved":   reserved,
+		"updated_at": now,
+	}
+	stmt = tx.WithContext(ctx).Table(experienceSlotTable).Where("id = ?", slot.ID)
+	if err := stmt.Updates(updates).Error; err != nil {
+		return err
+	}
+	return s.syncSoldOut(ctx, tx, slot.ExperienceID)
+}
+
+func (s *experienceSlot) get(ctx context.Context, tx *gorm.DB, slotID string, fields ...string) (*entity.ExperienceSlot, error) {
+	var slot *entity.ExperienceSlot
+
+	stmt := s.db.Statement(ctx, tx, experienceSlotTable, fields...).
+		Where("id = ?", slotID)
+
+	if err := stmt.First(&slot).Error; err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
+
+// syncSoldOut - 開催予定の体験枠の予約状況から体験の売り切れ状態を更新
+func (s *experienceSlot) syncSoldOut(ctx context.Context, tx *gorm.DB, experienceID string) error {
+	var slots entity.ExperienceSlots
+
+	now := s.now()
+	stmt := s.db.Statement(ctx, tx, experienceSlotTable).
+		Where("experience_id = ?", experienceID).
+		Where("start_at > ?", now)
+	if err := stmt.Find(&slots).Error; err != nil {
+		return err
+	}
+	if len(slots) == 0 {
+		return nil // 体験枠を利用していない場合は手動設定を優先
+	}
+	slots.Fill(now)
+
+	updates := map[string]interface{}{
+		"sold_out":   slots.SoldOut(),
+		"updated_at": now,
+	}
+	stmt = tx.WithContext(ctx).Table(experienceTable).Where("id = ?", experienceID)
+	return stmt.Updates(updates).Error
+}
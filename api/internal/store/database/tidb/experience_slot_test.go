@@ -0,0 +1,686 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExperienceSlot(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewExperienceSlot(nil))
+}
+
+func TestExperienceSlot_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	err = createExperienceForSlot(t.Context(), db, "experience-id", now())
+	require.NoError(t, err)
+	slots := make(entity.ExperienceSlots, 3)
+	slots[0] = testExperienceSlot("slot-id01", "experience-id", 10, 0, now().AddDate(0, 0, 1))
+	slots[1] = testExperienceSlot("slot-id02", "experience-id", 10, 10, now().AddDate(0, 0, 2))
+	slots[2] = testExperienceSlot("slot-id03", "experience-id", 10, 0, now().AddDate(0, 0, -1))
+	err = db.DB.Table(experienceSlotTable).Create(&slots).Error
+	require.NoError(t, err)
+	slots.Fill(now())
+
+	type args struct {
+		params *database.ListExperienceSlotsParams
+	}
+	type want struct {
+		slots entity.ExperienceSlots
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListExperienceSlotsParams{
+					ExperienceID: "experience-id",
+					StartAtGte:   now(),
+				},
+			},
+			want: want{
+				slots: slots[:2],
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.slots, actual)
+		})
+	}
+}
+
+func TestExperienceSlot_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	err = createExperienceForSlot(t.Context(), db, "experience-id", now())
+	require.NoError(t, err)
+	slot := testExperienceSlot("slot-id", "experience-id", 10, 2, now().AddDate(0, 0, 1))
+	err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+	require.NoError(t, err)
+	slot.SetStatus(now())
+
+	type args struct {
+		slotID string
+	}
+	type want struct {
+		slot *entity.ExperienceSlot
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				slotID: "slot-id",
+			},
+			want: want{
+				slot: slot,
+				err:  nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				slotID: "",
+			},
+			want: want{
+				slot: nil,
+				err:  database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.slotID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.slot, actual)
+		})
+	}
+}
+
+func TestExperienceSlot_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		slot *entity.ExperienceSlot
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+			},
+			args: args{
+				slot: testExperienceSlot("slot-id", "experience-id", 10, 0, now().AddDate(0, 0, 1)),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 0, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				slot: testExperienceSlot("slot-id", "experience-id", 10, 0, now().AddDate(0, 0, 1)),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			err = db.Create(ctx, tt.args.slot)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestExperienceSlot_Update(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		slotID string
+		params *database.UpdateExperienceSlotParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 5, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				slotID: "slot-id",
+				params: &database.UpdateExperienceSlotParams{
+					Capacity: 5,
+					StartAt:  now().AddDate(0, 0, 2),
+					EndAt:    now().AddDate(0, 0, 2).Add(time.Hour),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "capacity is less than reserved",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 5, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				slotID: "slot-id",
+				params: &database.UpdateExperienceSlotParams{
+					Capacity: 4,
+					StartAt:  now().AddDate(0, 0, 2),
+					EndAt:    now().AddDate(0, 0, 2).Add(time.Hour),
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			err = db.Update(ctx, tt.args.slotID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestExperienceSlot_Delete(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		slotID string
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 0, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				slotID: "slot-id",
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "has reservations",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 1, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				slotID: "slot-id",
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			err = db.Delete(ctx, tt.args.slotID)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestExperienceSlot_ListReservations(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	ctx := t.Context()
+	err := deleteAll(ctx)
+	require.NoError(t, err)
+
+	err = createExperienceForSlot(ctx, db, "experience-id", now())
+	require.NoError(t, err)
+	slot := testExperienceSlot("slot-id", "experience-id", 10, 3, now().AddDate(0, 0, 1))
+	err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+	require.NoError(t, err)
+	reservations := make(entity.ExperienceSlotReservations, 3)
+	reservations[0] = testExperienceSlotReservation("order-id01", "slot-id", 1, entity.ExperienceSlotReservationStatusHeld)
+	reservations[0].ExpiredAt = now().Add(-time.Hour)
+	reservations[1] = testExperienceSlotReservation("order-id02", "slot-id", 1, entity.ExperienceSlotReservationStatusHeld)
+	reservations[1].ExpiredAt = now().Add(time.Hour)
+	reservations[2] = testExperienceSlotReservation("order-id03", "slot-id", 1, entity.ExperienceSlotReservationStatusConfirmed)
+	reservations[2].ExpiredAt = now().Add(-time.Hour)
+	err = db.DB.Table(experienceSlotReservationTable).Create(&reservations).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListExperienceSlotReservationsParams
+	}
+	type want struct {
+		orderIDs []string
+		err      error
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "success",
+			args: args{
+				params: &database.ListExperienceSlotReservationsParams{
+					Status:      entity.ExperienceSlotReservationStatusHeld,
+					ExpiredAtLt: now(),
+					Limit:       10,
+				},
+			},
+			want: want{
+				orderIDs: []string{"order-id01"},
+				err:      nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			db := &experienceSlot{db: db, now: now}
+			actual, err := db.ListReservations(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.orderIDs, actual.OrderIDs())
+		})
+	}
+}
+
+func TestExperienceSlot_Reserve(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		reservation *entity.ExperienceSlotReservation
+	}
+	type want struct {
+		reserved int64
+		soldOut  bool
+		err      error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 7, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				reservation: testExperienceSlotReservation("order-id", "slot-id", 3, entity.ExperienceSlotReservationStatusHeld),
+			},
+			want: want{
+				reserved: 10,
+				soldOut:  true,
+				err:      nil,
+			},
+		},
+		{
+			name: "insufficient capacity",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 8, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				reservation: testExperienceSlotReservation("order-id", "slot-id", 3, entity.ExperienceSlotReservationStatusHeld),
+			},
+			want: want{
+				reserved: 8,
+				soldOut:  false,
+				err:      database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			err = db.Reserve(ctx, tt.args.reservation)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			slot, err := db.get(ctx, db.db.DB, tt.args.reservation.SlotID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.reserved, slot.Reserved)
+
+			var soldOut bool
+			err = db.db.DB.Table(experienceTable).Select("sold_out").Where("id = ?", slot.ExperienceID).Scan(&soldOut).Error
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.soldOut, soldOut)
+		})
+	}
+}
+
+func TestExperienceSlot_Confirm(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		orderID string
+	}
+	type want struct {
+		status entity.ExperienceSlotReservationStatus
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createExperienceForSlot(ctx, db, "experience-id", now())
+				require.NoError(t, err)
+				slot := testExperienceSlot("slot-id", "experience-id", 10, 3, now().AddDate(0, 0, 1))
+				err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+				require.NoError(t, err)
+				reservation := testExperienceSlotReservation("order-id", "slot-id", 3, entity.ExperienceSlotReservationStatusHeld)
+				err = db.DB.Table(experienceSlotReservationTable).Create(&reservation).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				status: entity.ExperienceSlotReservationStatusConfirmed,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			err = db.Confirm(ctx, tt.args.orderID)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			var reservation *entity.ExperienceSlotReservation
+			err = db.db.DB.Table(experienceSlotReservationTable).Where("order_id = ?", tt.args.orderID).First(&reservation).Error
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.status, reservation.Status)
+		})
+	}
+}
+
+func TestExperienceSlot_Release(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		orderID string
+	}
+	type want struct {
+		reserved int64
+		err      error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				reservation := testExperienceSlotReservation("order-id", "slot-id", 3, entity.ExperienceSlotReservationStatusConfirmed)
+				err := db.DB.Table(experienceSlotReservationTable).Create(&reservation).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				reserved: 7,
+				err:      nil,
+			},
+		},
+		{
+			name: "already released",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				reservation := testExperienceSlotReservation("order-id", "slot-id", 3, entity.ExperienceSlotReservationStatusReleased)
+				err := db.DB.Table(experienceSlotReservationTable).Create(&reservation).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				reserved: 10,
+				err:      nil,
+			},
+		},
+		{
+			name:  "not reserved",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				reserved: 10,
+				err:      nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			err = createExperienceForSlot(ctx, db, "experience-id", now())
+			require.NoError(t, err)
+			slot := testExperienceSlot("slot-id", "experience-id", 10, 10, now().AddDate(0, 0, 1))
+			err = db.DB.Table(experienceSlotTable).Create(&slot).Error
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &experienceSlot{db: db, now: now}
+			err = db.Release(ctx, tt.args.orderID)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			actual, err := db.get(ctx, db.db.DB, "slot-id")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.reserved, actual.Reserved)
+		})
+	}
+}
+
+func createExperienceForSlot(ctx context.Context, db *mysql.Client, experienceID string, now time.Time) error {
+	typ := testExperienceType("experience-type-id", "じゃがいも収穫", now)
+	if err := db.DB.WithContext(ctx).Create(&typ).Error; err != nil {
+		return err
+	}
+	internal := testExperience(experienceID, "experience-type-id", "shop-id", "coordinator-id", "producer-id", 1, now)
+	if err := db.DB.WithContext(ctx).Table(experienceTable).Create(&internal).Error; err != nil {
+		return err
+	}
+	return db.DB.WithContext(ctx).Create(&internal.ExperienceRevision).Error
+}
+
+func testExperienceSlot(slotID, experienceID string, capacity, reserved int64, startAt time.Time) *entity.ExperienceSlot {
+	return &entity.ExperienceSlot{
+		ID:           slotID,
+		ExperienceID: experienceID,
+		Capacity:     capacity,
+		Reserved:     reserved,
+		StartAt:      startAt,
+		EndAt:        startAt.Add(2 * time.Hour),
+		CreatedAt:    startAt,
+		UpdatedAt:    startAt,
+	}
+}
+
+func testExperienceSlotReservation(
+	orderID, slotID string, quantity int64, status entity.ExperienceSlotReservationStatus,
+) *entity.ExperienceSlotReservation {
+	return &entity.ExperienceSlotReservation{
+		OrderID:   orderID,
+		SlotID:    slotID,
+		Quantity:  quantity,
+		Status:    status,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,105 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const livePinTable = "live_pins"
+
+type livePin struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewLivePin(db *mysql.Client) database.LivePin {
+	return &livePin{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listLivePinsParams database.ListLivePinsParams
+
+func (p listLivePinsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.ScheduleID != "" {
+		stmt = stmt.Where("schedule_id = ?", p.ScheduleID)
+	}
+	if p.ProductID != "" {
+		stmt = stmt.Where("product_id = ?", p.ProductID)
+	}
+	if p.OnlyPinned {
+		stmt = stmt.Where("unpinned_at IS NULL")
+	}
+	if !p.UpdatedAtGte.IsZero() {
+		stmt = stmt.Where("updated_at >= ?", p.UpdatedAtGte)
+	}
+	return stmt.Order("pinned_at ASC, id ASC")
+}
+
+func (l *livePin) List(ctx context.Context, params *database.ListLivePinsParams, fields ...string) (entity.LivePins, error) {
+	var pins entity.LivePins
+
+	p := listLivePinsParams(*params)
+
+	stmt := l.db.Statement(ctx, l.db.DB, livePinTable, fields...)
+	stmt = p.stmt(stmt)
+
+	err := stmt.Find(&pins).Error
+	return pins, dbError(err)
+}
+
+func (l *livePin) Get(ctx context.Context, livePinID string, fields ...string) (*entity.LivePin, error) {
+	pin, err := l.get(ctx, l.db.DB, livePinID, fields...)
+	return pin, dbError(err)
+}
+
+func (l *livePin) Create(ctx context.Context, pin *entity.LivePin) error {
+	now := l.now()
+	pin.CreatedAt, pin.UpdatedAt = now, now
+
+	err := l.db.DB.WithContext(ctx).Table(livePinTable).Create(pin).Error
+	return dbError(err)
+}
+
+func (l *livePin) Unpin(ctx context.Context, livePinID string, params *database.UnpinLivePinParams) error {
+	err := l.db.Transaction(ctx, func(tx *gorm.DB) error {
+		// ピン留め解除が重複して行われないよう、ロックして状態を検証する
+		current, err := l.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), livePinID, "unpinned_at")
+		if err != nil {
+			return err
+		}
+		if !current.Pinned() {
+			return fmt.Errorf("tidb: this pin is already unpinned: %w", database.ErrFailedPrecondition)
+		}
+
+		updates := map[string]interface{}{
+			"unpinned_at":     params.UnpinnedAt,
+			"unpinned_offset": params.UnpinnedOffset,
+			"updated_at":      l.now(),
+		}
+		stmt := tx.WithContext(ctx).Table(livePinTable).Where("id = ?", livePinID)
+		return stmt.Updates(updates).Error
+	})
+	return dbError(err)
+}
+
+func (l *livePin) get(ctx context.Context, tx *gorm.DB, livePinID string, fields ...string) (*entity.LivePin, error) {
+	var pin *entity.LivePin
+
+	stmt := l.db.Statement(ctx, tx, livePinTable, fields...).
+		Where("id = ?", livePinID)
+
+	if err := stmt.First(&pin).Error; err != nil {
+		return nil, err
+	}
+	return pin, nil
+}
@@ -0,0 +1,380 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLivePin(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewLivePin(nil))
+}
+
+func TestLivePin_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+	err = db.DB.Create(&schedule).Error
+	require.NoError(t, err)
+
+	pins := make(entity.LivePins, 3)
+	pins[0] = testLivePin("pin-id01", "schedule-id", "product-id01", now().Add(-time.Hour))
+	pins[0].UnpinnedAt = now().Add(-30 * time.Minute)
+	pins[0].UnpinnedOffset = 1800
+	pins[1] = testLivePin("pin-id02", "schedule-id", "product-id02", now())
+	pins[2] = testLivePin("pin-id03", "schedule-id", "product-id01", now().Add(time.Hour))
+	err = db.DB.Table(livePinTable).Create(&pins).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListLivePinsParams
+	}
+	type want struct {
+		pins entity.LivePins
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListLivePinsParams{
+					ScheduleID: "schedule-id",
+				},
+			},
+			want: want{
+				pins: pins,
+				err:  nil,
+			},
+		},
+		{
+			name:  "success only pinned",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListLivePinsParams{
+					ScheduleID: "schedule-id",
+					ProductID:  "product-id01",
+					OnlyPinned: true,
+				},
+			},
+			want: want{
+				pins: entity.LivePins{pins[2]},
+				err:  nil,
+			},
+		},
+		{
+			name:  "success updated at",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListLivePinsParams{
+					ScheduleID:   "schedule-id",
+					UpdatedAtGte: now(),
+				},
+			},
+			want: want{
+				pins: entity.LivePins{pins[1], pins[2]},
+				err:  nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &livePin{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.pins, actual)
+		})
+	}
+}
+
+func TestLivePin_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+	err = db.DB.Create(&schedule).Error
+	require.NoError(t, err)
+	p := testLivePin("pin-id", "schedule-id", "product-id", now())
+	err = db.DB.Table(livePinTable).Create(&p).Error
+	require.NoError(t, err)
+
+	type args struct {
+		livePinID string
+	}
+	type want struct {
+		pin *entity.LivePin
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				livePinID: "pin-id",
+			},
+			want: want{
+				pin: p,
+				err: nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				livePinID: "other-id",
+			},
+			want: want{
+				pin: nil,
+				err: database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &livePin{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.livePinID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.pin, actual)
+		})
+	}
+}
+
+func TestLivePin_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		pin *entity.LivePin
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+				err := db.DB.Create(&schedule).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				pin: testLivePin("pin-id", "schedule-id", "product-id", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+				err := db.DB.Create(&schedule).Error
+				require.NoError(t, err)
+				p := testLivePin("pin-id", "schedule-id", "product-id", now())
+				err = db.DB.Table(livePinTable).Create(&p).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				pin: testLivePin("pin-id", "schedule-id", "product-id", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name: "already pinned",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+				err := db.DB.Create(&schedule).Error
+				require.NoError(t, err)
+				p := testLivePin("pin-id01", "schedule-id", "product-id", now())
+				err = db.DB.Table(livePinTable).Create(&p).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				pin: testLivePin("pin-id02", "schedule-id", "product-id", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name: "success after unpinned",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+				err := db.DB.Create(&schedule).Error
+				require.NoError(t, err)
+				p := testLivePin("pin-id01", "schedule-id", "product-id", now())
+				p.UnpinnedAt = now()
+				err = db.DB.Table(livePinTable).Create(&p).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				pin: testLivePin("pin-id02", "schedule-id", "product-id", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &livePin{db: db, now: now}
+			err = db.Create(ctx, tt.args.pin)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestLivePin_Unpin(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		livePinID string
+		params    *database.UnpinLivePinParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+				err := db.DB.Create(&schedule).Error
+				require.NoError(t, err)
+				p := testLivePin("pin-id", "schedule-id", "product-id", now())
+				err = db.DB.Table(livePinTable).Create(&p).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				livePinID: "pin-id",
+				params: &database.UnpinLivePinParams{
+					UnpinnedAt:     now().Add(time.Minute),
+					UnpinnedOffset: 60,
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already unpinned",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+				err := db.DB.Create(&schedule).Error
+				require.NoError(t, err)
+				p := testLivePin("pin-id", "schedule-id", "product-id", now())
+				p.UnpinnedAt = now()
+				err = db.DB.Table(livePinTable).Create(&p).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				livePinID: "pin-id",
+				params: &database.UnpinLivePinParams{
+					UnpinnedAt:     now().Add(time.Minute),
+					UnpinnedOffset: 60,
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				livePinID: "pin-id",
+				params:    &database.UnpinLivePinParams{},
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &livePin{db: db, now: now}
+			err = db.Unpin(ctx, tt.args.livePinID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testLivePin(id, scheduleID, productID string, now time.Time) *entity.LivePin {
+	return &entity.LivePin{
+		ID:           id,
+		ScheduleID:   scheduleID,
+		ProductID:    productID,
+		AdminID:      "admin-id",
+		PinnedAt:     now,
+		PinnedOffset: 0,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/and-period/furumaru/api/pkg/mysql"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -43,12 +44,21 @@ func (p listOrdersParams) stmt(stmt *gorm.DB) *gorm.DB {
 	if p.UserID != "" {
 		stmt = stmt.Where("user_id = ?", p.UserID)
 	}
+	if p.PreorderBatchID != "" {
+		stmt = stmt.Where("preorder_batch_id = ?", p.PreorderBatchID)
+	}
 	if len(p.Types) > 0 {
 		stmt = stmt.Where("type IN (?)", p.Types)
 	}
 	if len(p.Statuses) > 0 {
 		stmt = stmt.Where("status IN (?)", p.Statuses)
 	}
+	if !p.CreatedAtGte.IsZero() {
+		stmt = stmt.Where("created_at >= ?", p.CreatedAtGte)
+	}
+	if !p.CreatedAtLt.IsZero() {
+		stmt = stmt.Where("created_at < ?", p.CreatedAtLt)
+	}
 	return stmt
 }
 
@@ -103,6 +113,114 @@ func (o *order) ListUserIDs(ctx context.Context, params *database.ListOrdersPara
 	return userIDs, total, nil
 }
 
+func (o *order) ListByTrackingNumbers(
+	ctx context.Context, params *database.ListOrdersByTrackingNumbersParams,
+) (entity.Orders, error) {
+	var orders entity.Orders
+	if len(params.TrackingNumbers) == 0 {
+		return orders, nil
+	}
+
+	// 伝票番号は区切り文字や空白を含めて登録されている場合があるため、tracker.NormalizeTrackingNumberと同様に除去したうえで比較する
+	sub := o.db.DB.Table(orderFulfillmentTable).
+		Select("order_id").
+		Where("shipping_carrier = ?", params.ShippingCarrier).
+		Where("REPLACE(REPLACE(REPLACE(tracking_number, '-', ''), ' ', ''), '　', '') IN (?)", params.TrackingNumbers)
+
+	stmt := o.db.Statement(ctx, o.db.DB, orderTable).
+		Where("id IN (?)", sub)
+	if params.ShopID != "" {
+		stmt = stmt.Where("shop_id = ?", params.ShopID)
+	}
+
+	if err := stmt.Find(&orders).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if err := o.fill(ctx, o.db.DB, orders...); err != nil {
+		return nil, dbError(err)
+	}
+	return orders, nil
+}
+
+func (o *order) ListSettlementTargets(
+	ctx context.Context, params *database.ListSettlementTargetOrdersParams,
+) (entity.Orders, error) {
+	var orders entity.Orders
+
+	// 精算期間の終了までに実売上となった未精算の注文と、精算後に返金が発生した注文を対象とする
+	// (精算後に実売上の通知を処理した注文も取りこぼさないよう、期間の開始日時では絞り込まない)
+	stmt := o.db.Statement(ctx, o.db.DB, orderTable, "orders.*").
+		Joins("INNER JOIN order_payments ON orders.id = order_payments.order_id").
+		Where("((order_payments.settled_at IS NULL AND order_payments.captured_at < ?)"+
+			" OR (order_payments.settled_at IS NOT NULL AND order_payments.refund_total > order_payments.settled_refund))",
+			params.CapturedAtLt).
+		Order("order_payments.captured_at ASC, orders.id ASC")
+	if params.Limit > 0 {
+		stmt = stmt.Limit(params.Limit)
+	}
+	if params.Offset > 0 {
+		stmt = stmt.Offset(params.Offset)
+	}
+
+	if err := stmt.Find(&orders).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if err := o.fill(ctx, o.db.DB, orders...); err != nil {
+		return nil, dbError(err)
+	}
+	return orders, nil
+}
+
+func (o *order) ListUndeliveredFulfillments(
+	ctx context.Context, params *database.ListUndeliveredOrderFulfillmentsParams,
+) (entity.OrderFulfillments, error) {
+	var fulfillments entity.OrderFulfillments
+
+	stmt := o.db.Statement(ctx, o.db.DB, orderFulfillmentTable).
+		Where("status = ?", entity.FulfillmentStatusFulfilled).
+		Where("shipping_carrier = ?", params.ShippingCarrier).
+		Where("tracking_number IS NOT NULL AND tracking_number != ''")
+	if !params.ShippedAtGte.IsZero() {
+		stmt = stmt.Where("shipped_at >= ?", params.ShippedAtGte)
+	}
+	if params.AfterID != "" {
+		stmt = stmt.Where("(shipped_at > ? OR (shipped_at = ? AND id > ?))",
+			params.AfterShippedAt, params.AfterShippedAt, params.AfterID)
+	}
+	if params.Limit > 0 {
+		stmt = stmt.Limit(params.Limit)
+	}
+	stmt = stmt.Order("shipped_at ASC, id ASC")
+
+	err := stmt.Find(&fulfillments).Error
+	return fulfillments, dbError(err)
+}
+
+// ListAuthorizedPreorders - 与信済みで売上確定を保留している予約注文の一覧を取得する
+func (o *order) ListAuthorizedPreorders(
+	ctx context.Context, params *database.ListAuthorizedPreordersParams,
+) (entity.Orders, error) {
+	var orders entity.Orders
+
+	stmt := o.db.Statement(ctx, o.db.DB, orderTable, "orders.*").
+		Joins("INNER JOIN order_payments ON orders.id = order_payments.order_id").
+		Where("orders.preorder_batch_id IS NOT NULL AND orders.preorder_batch_id != ''").
+		Where("order_payments.status = ?", entity.PaymentStatusAuthorized).
+		Where("order_payments.paid_at < ?", params.PaidAtLt).
+		Order("order_payments.paid_at ASC")
+	if params.Limit > 0 {
+		stmt = stmt.Limit(params.Limit)
+	}
+
+	if err := stmt.Find(&orders).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if err := o.fill(ctx, o.db.DB, orders...); err != nil {
+		return nil, dbError(err)
+	}
+	return orders, nil
+}
+
 func (o *order) Count(ctx context.Context, params *database.ListOrdersParams) (int64, error) {
 	p := listOrdersParams(*params)
 
@@ -115,6 +233,39 @@ func (o *order) Get(ctx context.Context, orderID string, fields ...string) (*ent
 	return order, dbError(err)
 }
 
+func (o *order) MultiGet(ctx context.Context, orderIDs []string, fields ...string) (entity.Orders, error) {
+	var orders entity.Orders
+
+	stmt := o.db.Statement(ctx, o.db.DB, orderTable, fields...).
+		Where("id IN (?)", orderIDs)
+
+	if err := stmt.Find(&orders).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if err := o.fill(ctx, o.db.DB, orders...); err != nil {
+		return nil, dbError(err)
+	}
+	return orders, nil
+}
+
+func (o *order) MultiGetByManagementIDs(
+	ctx context.Context, shopID string, managementIDs []int64, fields ...string,
+) (entity.Orders, error) {
+	var orders entity.Orders
+
+	stmt := o.db.Statement(ctx, o.db.DB, orderTable, fields...).
+		Where("shop_id = ?", shopID).
+		Where("management_id IN (?)", managementIDs)
+
+	if err := stmt.Find(&orders).Error; err != nil {
+		return nil, dbError(err)
+	}
+	if err := o.fill(ctx, o.db.DB, orders...); err != nil {
+		return nil, dbError(err)
+	}
+	return orders, nil
+}
+
 func (o *order) GetByTransactionID(ctx context.Context, userID, transactionID string) (*entity.Order, error) {
 	var order *entity.Order
 
@@ -186,7 +337,8 @@ func (o *order) Create(ctx context.Context, order *entity.Order) error {
 			if err := tx.WithContext(ctx).Table(orderFulfillmentTable).Create(&order.OrderFulfillments).Error; err != nil {
 				return err
 			}
-			if err := tx.WithContext(ctx).Table(orderItemTable).Create(&order.OrderItems).Error; err != nil {
+			items := newInternalOrderItems(order.OrderItems)
+			if err := tx.WithContext(ctx).Table(orderItemTable).Create(&items).Error; err != nil {
 				return err
 			}
 		case entity.OrderTypeExperience:
@@ -260,6 +412,77 @@ func (o *order) UpdateFailed(ctx context.Context, orderID string, params *databa
 	return o.updatePayment(ctx, p)
 }
 
+// Expire - 未払いの注文を期限切れにし、仮押さえした在庫・体験枠とクーポンの利用予約を解放する
+func (o *order) Expire(ctx context.Context, orderID string, params *database.ExpireOrderParams) error {
+	err := o.db.Transaction(ctx, func(tx *gorm.DB) error {
+		updates := map[string]interface{}{
+			"status":     entity.PaymentStatusExpired,
+			"failed_at":  params.ExpiredAt,
+			"updated_at": o.now(),
+		}
+		stmt := tx.WithContext(ctx).
+			Table(orderPaymentTable).
+			Where("order_id = ?", orderID).
+			Where("status IN (?)", entity.PaymentUnpaidStatuses)
+		res := stmt.Updates(updates)
+		if err := res.Error; err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("tidb: this order has already been paid: %w", database.ErrFailedPrecondition)
+		}
+
+		order := &entity.Order{ID: orderID}
+		order.SetPaymentStatus(entity.PaymentStatusExpired)
+		if err := o.updateStatus(ctx, tx, order.ID, order.Status); err != nil {
+			return err
+		}
+
+		now := o.now()
+		hold := &productInventoryHold{db: o.db, now: o.now}
+		if _, err := hold.release(ctx, tx, orderID, now); err != nil {
+			return err
+		}
+		slot := &experienceSlot{db: o.db, now: o.now}
+		if err := slot.release(ctx, tx, orderID, now); err != nil {
+			return err
+		}
+		redemption := &promotionRedemption{db: o.db, now: o.now}
+		return redemption.release(ctx, tx, orderID, now)
+	})
+	return dbError(err)
+}
+
+// ExtendHolds - 仮押さえ中の在庫・体験枠とクーポンの利用予約の確保期限を延長する
+func (o *order) ExtendHolds(ctx context.Context, orderID string, params *database.ExtendOrderHoldsParams) error {
+	err := o.db.Transaction(ctx, func(tx *gorm.DB) error {
+		updates := map[string]interface{}{
+			"expired_at": params.ExpiredAt,
+			"updated_at": o.now(),
+		}
+		stmt := tx.WithContext(ctx).
+			Table(productInventoryHoldTable).
+			Where("order_id = ?", orderID).
+			Where("status = ?", entity.ProductInventoryHoldStatusHeld)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+		stmt = tx.WithContext(ctx).
+			Table(experienceSlotReservationTable).
+			Where("order_id = ?", orderID).
+			Where("status = ?", entity.ExperienceSlotReservationStatusHeld)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+		stmt = tx.WithContext(ctx).
+			Table(promotionRedemptionTable).
+			Where("order_id = ?", orderID).
+			Where("status = ?", entity.PromotionRedemptionStatusReserved)
+		return stmt.Updates(updates).Error
+	})
+	return dbError(err)
+}
+
 func (o *order) UpdateRefunded(ctx context.Context, orderID string, params *database.UpdateOrderRefundedParams) error {
 	p := &updateOrderPaymentParams{
 		orderID:  orderID,
@@ -283,15 +506,42 @@ func (o *order) UpdateRefunded(ctx context.Context, orderID string, params *data
 	return o.updatePayment(ctx, p)
 }
 
+func (o *order) CreateFulfillment(ctx context.Context, fulfillment *entity.OrderFulfillment) error {
+	err := o.db.Transaction(ctx, func(tx *gorm.DB) error {
+		order, err := o.get(ctx, tx, fulfillment.OrderID)
+		if err != nil {
+			return err
+		}
+
+		now := o.now()
+		fulfillment.CreatedAt, fulfillment.UpdatedAt = now, now
+		if err := tx.WithContext(ctx).Table(orderFulfillmentTable).Create(&fulfillment).Error; err != nil {
+			return err
+		}
+
+		// 発送済み・完了済みの注文も、追加した配送が発送されるまでは発送準備中として扱う
+		order.OrderFulfillments = append(order.OrderFulfillments, fulfillment)
+		order.SetFulfillmentStatus(fulfillment.ID, fulfillment.Status)
+		return o.updateStatus(ctx, tx, order.ID, order.Status)
+	})
+	return dbError(err)
+}
+
 func (o *order) UpdateFulfillment(ctx context.Context, orderID, fulfillmentID string, params *database.UpdateOrderFulfillmentParams) error {
 	err := o.db.Transaction(ctx, func(tx *gorm.DB) error {
-		order, err := o.get(ctx, tx, orderID)
+		order, err := o.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), orderID)
 		if err != nil {
 			return err
 		}
 		if order.Completed() {
 			return fmt.Errorf("mysql: this order is already completed: %w", database.ErrFailedPrecondition)
 		}
+		// 配達完了済みの配送を発送済み・未発送へ戻さない
+		for _, f := range order.OrderFulfillments {
+			if f.ID == fulfillmentID && f.Delivered() {
+				return fmt.Errorf("tidb: this fulfillment is already delivered: %w", database.ErrFailedPrecondition)
+			}
+		}
 
 		updates := map[string]interface{}{
 			"status":     params.Status,
@@ -321,6 +571,130 @@ func (o *order) UpdateFulfillment(ctx context.Context, orderID, fulfillmentID st
 	return dbError(err)
 }
 
+func (o *order) DeliverFulfillment(ctx context.Context, orderID, fulfillmentID string, deliveredAt time.Time) error {
+	err := o.db.Transaction(ctx, func(tx *gorm.DB) error {
+		order, err := o.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), orderID)
+		if err != nil {
+			return err
+		}
+		var fulfillment *entity.OrderFulfillment
+		for _, f := range order.OrderFulfillments {
+			if f.ID == fulfillmentID {
+				fulfillment = f
+				break
+			}
+		}
+		if fulfillment == nil {
+			return fmt.Errorf("tidb: fulfillment is not found: %w", database.ErrNotFound)
+		}
+		if fulfillment.Delivered() {
+			return nil // 配達完了済みの場合は何もしない
+		}
+		if !fulfillment.Fulfilled() {
+			return fmt.Errorf("tidb: this fulfillment is not shipped: %w", database.ErrFailedPrecondition)
+		}
+
+		updates := map[string]interface{}{
+			"status":       entity.FulfillmentStatusDelivered,
+			"delivered_at": deliveredAt,
+			"updated_at":   o.now(),
+		}
+		stmt := tx.WithContext(ctx).
+			Table(orderFulfillmentTable).
+			Where("order_id = ?", orderID).
+			Where("id = ?", fulfillmentID)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+		if order.Completed() {
+			// 完了済みの注文は配送状況のみ更新し、注文ステータスは変更しない
+			return nil
+		}
+
+		order.SetFulfillmentStatus(fulfillmentID, entity.FulfillmentStatusDelivered)
+		return o.updateStatus(ctx, tx, order.ID, order.Status)
+	})
+	return dbError(err)
+}
+
+func (o *order) ShipFulfillments(
+	ctx context.Context, params []*database.ShipOrderFulfillmentParams,
+) (map[string]entity.UnmatchedShipmentReason, error) {
+	orderIDs := make([]string, 0, len(params))
+	grouped := make(map[string][]*database.ShipOrderFulfillmentParams, len(params))
+	for _, p := range params {
+		if _, ok := grouped[p.OrderID]; !ok {
+			orderIDs = append(orderIDs, p.OrderID)
+		}
+		grouped[p.OrderID] = append(grouped[p.OrderID], p)
+	}
+
+	var rejected map[string]entity.UnmatchedShipmentReason
+	err := o.db.Transaction(ctx, func(tx *gorm.DB) error {
+		rejected = make(map[string]entity.UnmatchedShipmentReason)
+		for _, orderID := range orderIDs {
+			order, err := o.get(ctx, tx, orderID)
+			if err != nil {
+				return err
+			}
+			// 照合後に注文状態が変わっている場合は、取り込み全体を失敗させずに該当行のみ除外する
+			if !order.Shippable() {
+				for _, p := range grouped[orderID] {
+					rejected[p.FulfillmentID] = entity.UnmatchedShipmentReasonNotShippable
+				}
+				continue
+			}
+			var shipped bool
+			for _, p := range grouped[orderID] {
+				var fulfillment *entity.OrderFulfillment
+				for _, f := range order.OrderFulfillments {
+					if f.ID == p.FulfillmentID {
+						fulfillment = f
+						break
+					}
+				}
+				if fulfillment == nil || fulfillment.Fulfilled() {
+					rejected[p.FulfillmentID] = entity.UnmatchedShipmentReasonNoFulfillment
+					continue
+				}
+				updates := map[string]interface{}{
+					"status":           entity.FulfillmentStatusFulfilled,
+					"shipping_carrier": p.ShippingCarrier,
+					"tracking_number":  p.TrackingNumber,
+					"shipped_at":       p.ShippedAt,
+					"updated_at":       o.now(),
+				}
+				stmt := tx.WithContext(ctx).
+					Table(orderFulfillmentTable).
+					Where("order_id = ?", orderID).
+					Where("id = ?", p.FulfillmentID).
+					Where("status = ?", entity.FulfillmentStatusUnfulfilled)
+				res := stmt.Updates(updates)
+				if res.Error != nil {
+					return res.Error
+				}
+				if res.RowsAffected == 0 {
+					rejected[p.FulfillmentID] = entity.UnmatchedShipmentReasonNoFulfillment
+					continue
+				}
+				order.SetFulfillmentStatus(p.FulfillmentID, entity.FulfillmentStatusFulfilled)
+				shipped = true
+			}
+			if !shipped {
+				continue
+			}
+			if err := o.updateStatus(ctx, tx, order.ID, order.Status); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return rejected, nil
+}
+
 func (o *order) Draft(ctx context.Context, orderID string, params *database.DraftOrderParams) error {
 	updates := map[string]interface{}{
 		"shipping_message": params.ShippingMessage,
@@ -427,6 +801,39 @@ func (o *order) AggregateByPaymentMethodType(
 	return payments, dbError(err)
 }
 
+func (o *order) AggregateByProduct(
+	ctx context.Context,
+	params *database.AggregateOrdersByProductParams,
+) (entity.AggregatedOrderProducts, error) {
+	var orders entity.AggregatedOrderProducts
+
+	// 同一注文内の他の商品を含めないよう、注文商品単位で購入金額を集計する
+	fields := []string{
+		"product_revisions.product_id AS product_id",
+		"COUNT(DISTINCT(orders.id)) AS order_count",
+		"COUNT(DISTINCT(orders.user_id)) AS user_count",
+		"SUM(order_items.quantity) AS quantity",
+		"SUM(product_revisions.price * order_items.quantity) AS sales_total",
+		"SUM(order_items.discount) AS discount_total",
+	}
+
+	stmt := o.db.Statement(ctx, o.db.DB, orderItemTable, fields...).
+		Joins("INNER JOIN orders ON orders.id = order_items.order_id").
+		Joins("INNER JOIN order_payments ON order_payments.order_id = order_items.order_id").
+		Joins("INNER JOIN product_revisions ON product_revisions.id = order_items.product_revision_id").
+		Where("product_revisions.product_id IN (?)", params.ProductIDs).
+		Where("order_payments.status IN (?)", entity.PaymentSuccessStatuses).
+		Where("orders.created_at >= ?", params.CreatedAtGte).
+		Where("orders.created_at < ?", params.CreatedAtLt)
+	if params.ShopID != "" {
+		stmt = stmt.Where("orders.shop_id = ?", params.ShopID)
+	}
+	stmt = stmt.Group("product_revisions.product_id")
+
+	err := stmt.Scan(&orders).Error
+	return orders, dbError(err)
+}
+
 func (o *order) AggregateByPromotion(
 	ctx context.Context,
 	params *database.AggregateOrdersByPromotionParams,
@@ -533,8 +940,13 @@ func (o *order) fill(ctx context.Context, tx *gorm.DB, orders ...*entity.Order)
 		return stmt.Find(&fulfillments).Error
 	})
 	eg.Go(func() error {
+		var internal internalOrderItems
 		stmt := o.db.Statement(ectx, tx, orderItemTable).Where("order_id IN (?)", ids)
-		return stmt.Find(&items).Error
+		if err := stmt.Find(&internal).Error; err != nil {
+			return err
+		}
+		items = internal.entities()
+		return nil
 	})
 	eg.Go(func() error {
 		var internal internalOrderExperiences
@@ -613,6 +1025,42 @@ func (o *order) updateStatus(ctx context.Context, tx *gorm.DB, orderID string, s
 	return stmt.Updates(updates).Error
 }
 
+type internalOrderItem struct {
+	entity.OrderItem `gorm:"embedded"`
+	DiscountsJSON    mysql.JSONColumn[entity.OrderItemDiscounts] `gorm:"default:null;column:discounts"` // 割引内訳(JSON)
+}
+
+type internalOrderItems []*internalOrderItem
+
+func newInternalOrderItem(item *entity.OrderItem) *internalOrderItem {
+	return &internalOrderItem{
+		OrderItem:     *item,
+		DiscountsJSON: mysql.NewJSONColumn(item.Discounts),
+	}
+}
+
+func newInternalOrderItems(items entity.OrderItems) internalOrderItems {
+	res := make(internalOrderItems, len(items))
+	for i := range items {
+		res[i] = newInternalOrderItem(items[i])
+	}
+	return res
+}
+
+func (i *internalOrderItem) entity() *entity.OrderItem {
+	item := i.OrderItem
+	item.Discounts = i.DiscountsJSON.Val
+	return &item
+}
+
+func (is internalOrderItems) entities() entity.OrderItems {
+	res := make(entity.OrderItems, len(is))
+	for i := range is {
+		res[i] = is[i].entity()
+	}
+	return res
+}
+
 type internalOrderExperience struct {
 	entity.OrderExperience `gorm:"embedded"`
 	RemarksJSON            mysql.JSONColumn[entity.OrderExperienceRemarks] `gorm:"default:null;column:remarks"` // 備考(JSON)
@@ -0,0 +1,182 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const orderClaimTable = "order_claims"
+
+type orderClaim struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewOrderClaim(db *mysql.Client) database.OrderClaim {
+	return &orderClaim{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listOrderClaimsParams database.ListOrderClaimsParams
+
+func (p listOrderClaimsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.ShopID != "" {
+		stmt = stmt.Where("shop_id = ?", p.ShopID)
+	}
+	if p.OrderID != "" {
+		stmt = stmt.Where("order_id = ?", p.OrderID)
+	}
+	if p.UserID != "" {
+		stmt = stmt.Where("user_id = ?", p.UserID)
+	}
+	if len(p.Statuses) > 0 {
+		stmt = stmt.Where("status IN (?)", p.Statuses)
+	}
+	return stmt.Order("created_at DESC")
+}
+
+func (p listOrderClaimsParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (c *orderClaim) List(
+	ctx context.Context, params *database.ListOrderClaimsParams, fields ...string,
+) (entity.OrderClaims, error) {
+	var internal internalOrderClaims
+
+	p := listOrderClaimsParams(*params)
+
+	stmt := c.db.Statement(ctx, c.db.DB, orderClaimTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	if err := stmt.Find(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entities(), nil
+}
+
+func (c *orderClaim) Count(ctx context.Context, params *database.ListOrderClaimsParams) (int64, error) {
+	p := listOrderClaimsParams(*params)
+
+	total, err := c.db.Count(ctx, c.db.DB, &entity.OrderClaim{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (c *orderClaim) Get(ctx context.Context, claimID string, fields ...string) (*entity.OrderClaim, error) {
+	claim, err := c.get(ctx, c.db.DB, claimID, fields...)
+	return claim, dbError(err)
+}
+
+func (c *orderClaim) Create(ctx context.Context, claim *entity.OrderClaim) error {
+	now := c.now()
+	claim.CreatedAt, claim.UpdatedAt = now, now
+
+	err := c.db.DB.WithContext(ctx).Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+	return dbError(err)
+}
+
+func (c *orderClaim) Update(ctx context.Context, claimID string, params *database.UpdateOrderClaimParams) error {
+	err := c.db.Transaction(ctx, func(tx *gorm.DB) error {
+		// 審査・対応が並行して行われないよう、申請情報をロックして状態遷移を検証する
+		current, err := c.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), claimID, "status")
+		if err != nil {
+			return err
+		}
+		if !current.Status.CanTransition(params.Status) {
+			return fmt.Errorf("tidb: invalid order claim status transition: %w", database.ErrFailedPrecondition)
+		}
+
+		updates := map[string]interface{}{
+			"status":     params.Status,
+			"updated_at": c.now(),
+		}
+		if params.ReviewerID != "" {
+			updates["reviewer_id"] = params.ReviewerID
+			updates["review_comment"] = params.ReviewComment
+		}
+		if params.ResolutionType != entity.OrderClaimResolutionTypeUnknown {
+			updates["resolution_type"] = params.ResolutionType
+			updates["resolution_note"] = params.ResolutionNote
+		}
+		if params.RefundLineID != "" {
+			updates["refund_line_id"] = params.RefundLineID
+		}
+		if params.PromotionID != "" {
+			updates["promotion_id"] = params.PromotionID
+		}
+		if params.FulfillmentID != "" {
+			updates["fulfillment_id"] = params.FulfillmentID
+		}
+		if !params.ReviewedAt.IsZero() {
+			updates["reviewed_at"] = params.ReviewedAt
+		}
+		if !params.ResolvedAt.IsZero() {
+			updates["resolved_at"] = params.ResolvedAt
+		}
+		stmt := tx.WithContext(ctx).Table(orderClaimTable).Where("id = ?", claimID)
+		return stmt.Updates(updates).Error
+	})
+	return dbError(err)
+}
+
+func (c *orderClaim) get(
+	ctx context.Context, tx *gorm.DB, claimID string, fields ...string,
+) (*entity.OrderClaim, error) {
+	var internal *internalOrderClaim
+
+	stmt := c.db.Statement(ctx, tx, orderClaimTable, fields...).
+		Where("id = ?", claimID)
+
+	if err := stmt.First(&internal).Error; err != nil {
+		return nil, err
+	}
+	return internal.entity(), nil
+}
+
+type internalOrderClaim struct {
+	entity.OrderClaim `gorm:"embedded"`
+	ItemsJSON         mysql.JSONColumn[entity.OrderClaimItems] `gorm:"default:null;column:items"`      // 対象商品一覧(JSON)
+	ImageURLsJSON     mysql.JSONColumn[[]string]               `gorm:"default:null;column:image_urls"` // 証拠画像URL一覧(JSON)
+}
+
+type internalOrderClaims []*internalOrderClaim
+
+func newInternalOrderClaim(claim *entity.OrderClaim) *internalOrderClaim {
+	return &internalOrderClaim{
+		OrderClaim:    *claim,
+		ItemsJSON:     mysql.NewJSONColumn(claim.Items),
+		ImageURLsJSON: mysql.NewJSONColumn(claim.ImageURLs),
+	}
+}
+
+func (c *internalOrderClaim) entity() *entity.OrderClaim {
+	claim := c.OrderClaim
+	claim.Items = c.ItemsJSON.Val
+	claim.ImageURLs = c.ImageURLsJSON.Val
+	return &claim
+}
+
+func (cs internalOrderClaims) entities() entity.OrderClaims {
+	res := make(entity.OrderClaims, len(cs))
+	for i := range cs {
+		res[i] = cs[i].entity()
+	}
+	return res
+}
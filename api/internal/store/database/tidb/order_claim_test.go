@@ -0,0 +1,373 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderClaim(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewOrderClaim(nil))
+}
+
+func TestOrderClaim_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	createTestOrderForRefund(t, db, "order-id", now())
+	claims := make(entity.OrderClaims, 2)
+	claims[0] = testOrderClaim("claim-id01", "order-id", entity.OrderClaimStatusRequested, now())
+	claims[1] = testOrderClaim("claim-id02", "order-id", entity.OrderClaimStatusApproved, now().Add(time.Hour))
+	for _, claim := range claims {
+		err = db.DB.Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		params *database.ListOrderClaimsParams
+	}
+	type want struct {
+		claims entity.OrderClaims
+		total  int64
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListOrderClaimsParams{
+					ShopID: "shop-id",
+					Limit:  10,
+				},
+			},
+			want: want{
+				claims: entity.OrderClaims{claims[1], claims[0]},
+				total:  2,
+				err:    nil,
+			},
+		},
+		{
+			name:  "success with statuses",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListOrderClaimsParams{
+					UserID:   "user-id",
+					Statuses: []entity.OrderClaimStatus{entity.OrderClaimStatusRequested},
+				},
+			},
+			want: want{
+				claims: entity.OrderClaims{claims[0]},
+				total:  1,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &orderClaim{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.claims, actual)
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestOrderClaim_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	createTestOrderForRefund(t, db, "order-id", now())
+	claim := testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusRequested, now())
+	err = db.DB.Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+	require.NoError(t, err)
+
+	type args struct {
+		claimID string
+	}
+	type want struct {
+		claim *entity.OrderClaim
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				claimID: "claim-id",
+			},
+			want: want{
+				claim: claim,
+				err:   nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				claimID: "other-id",
+			},
+			want: want{
+				claim: nil,
+				err:   database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &orderClaim{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.claimID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.claim, actual)
+		})
+	}
+}
+
+func TestOrderClaim_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		claim *entity.OrderClaim
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+			},
+			args: args{
+				claim: testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusRequested, now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+				claim := testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusRequested, now())
+				err := db.DB.Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				claim: testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusRequested, now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &orderClaim{db: db, now: now}
+			err = db.Create(ctx, tt.args.claim)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestOrderClaim_Update(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		claimID string
+		params  *database.UpdateOrderClaimParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+				claim := testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusRequested, now())
+				err := db.DB.Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				claimID: "claim-id",
+				params: &database.UpdateOrderClaimParams{
+					Status:        entity.OrderClaimStatusApproved,
+					ReviewerID:    "admin-id",
+					ReviewComment: "返金にて対応します。",
+					ReviewedAt:    now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "success to resolve with reshipment",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+				claim := testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusResolving, now())
+				err := db.DB.Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				claimID: "claim-id",
+				params: &database.UpdateOrderClaimParams{
+					Status:         entity.OrderClaimStatusResolved,
+					ResolutionType: entity.OrderClaimResolutionTypeReshipment,
+					ResolutionNote: "代替品を発送しました。",
+					FulfillmentID:  "fulfillment-id",
+					ResolvedAt:     now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "invalid transition from approved to resolved",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+				claim := testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusApproved, now())
+				err := db.DB.Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				claimID: "claim-id",
+				params: &database.UpdateOrderClaimParams{
+					Status:         entity.OrderClaimStatusResolved,
+					ResolutionType: entity.OrderClaimResolutionTypeCoupon,
+					ResolvedAt:     now(),
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name: "invalid transition",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+				claim := testOrderClaim("claim-id", "order-id", entity.OrderClaimStatusRejected, now())
+				err := db.DB.Table(orderClaimTable).Create(newInternalOrderClaim(claim)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				claimID: "claim-id",
+				params: &database.UpdateOrderClaimParams{
+					Status:         entity.OrderClaimStatusResolved,
+					ResolutionType: entity.OrderClaimResolutionTypeReshipment,
+					ResolvedAt:     now(),
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				claimID: "claim-id",
+				params: &database.UpdateOrderClaimParams{
+					Status: entity.OrderClaimStatusApproved,
+				},
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &orderClaim{db: db, now: now}
+			err = db.Update(ctx, tt.args.claimID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testOrderClaim(id, orderID string, status entity.OrderClaimStatus, now time.Time) *entity.OrderClaim {
+	return &entity.OrderClaim{
+		ID:            id,
+		OrderID:       orderID,
+		UserID:        "user-id",
+		ShopID:        "shop-id",
+		CoordinatorID: "coordinator-id",
+		Type:          entity.OrderClaimTypeSpoiled,
+		Status:        status,
+		Description:   "届いた野菜が傷んでいました。",
+		Items:         entity.OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}},
+		ImageURLs:     []string{"https://example.com/image.png"},
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
@@ -0,0 +1,141 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const orderRefundLineTable = "order_refund_lines"
+
+type orderRefundLine struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewOrderRefundLine(db *mysql.Client) database.OrderRefundLine {
+	return &orderRefundLine{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+func (l *orderRefundLine) List(
+	ctx context.Context, params *database.ListOrderRefundLinesParams, fields ...string,
+) (entity.OrderRefundLines, error) {
+	lines, err := l.list(ctx, l.db.DB, params.OrderID, fields...)
+	return lines, dbError(err)
+}
+
+func (l *orderRefundLine) Get(ctx context.Context, lineID string, fields ...string) (*entity.OrderRefundLine, error) {
+	var internal *internalOrderRefundLine
+
+	stmt := l.db.Statement(ctx, l.db.DB, orderRefundLineTable, fields...).
+		Where("id = ?", lineID)
+
+	if err := stmt.First(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entity(), nil
+}
+
+func (l *orderRefundLine) Create(ctx context.Context, line *entity.OrderRefundLine) error {
+	err := l.db.Transaction(ctx, func(tx *gorm.DB) error {
+		// 同一注文への返金処理が並行して行われないよう、支払い情報をロックする
+		var payment *entity.OrderPayment
+		stmt := tx.WithContext(ctx).
+			Table(orderPaymentTable).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ?", line.OrderID)
+		if err := stmt.First(&payment).Error; err != nil {
+			return err
+		}
+		lines, err := l.list(ctx, tx, line.OrderID)
+		if err != nil {
+			return err
+		}
+		// 商品単位の返金は、並行して登録された返金明細と合わせて購入数量を超えないかも検証する
+		var items entity.OrderItems
+		if len(line.Items) > 0 {
+			stmt := l.db.Statement(ctx, tx, orderItemTable, "product_revision_id", "quantity").
+				Where("order_id = ?", line.OrderID)
+			if err := stmt.Find(&items).Error; err != nil {
+				return err
+			}
+		}
+		if err := line.Verify(lines, payment.Total, items); err != nil {
+			return fmt.Errorf("tidb: %s: %w", err.Error(), database.ErrFailedPrecondition)
+		}
+
+		now := l.now()
+		line.CreatedAt, line.UpdatedAt = now, now
+		return tx.WithContext(ctx).Table(orderRefundLineTable).Create(newInternalOrderRefundLine(line)).Error
+	})
+	return dbError(err)
+}
+
+func (l *orderRefundLine) Update(ctx context.Context, lineID string, params *database.UpdateOrderRefundLineParams) error {
+	updates := map[string]interface{}{
+		"status":     params.Status,
+		"updated_at": l.now(),
+	}
+	if !params.RefundedAt.IsZero() {
+		updates["refunded_at"] = params.RefundedAt
+	}
+	stmt := l.db.DB.WithContext(ctx).
+		Table(orderRefundLineTable).
+		Where("id = ?", lineID)
+
+	err := stmt.Updates(updates).Error
+	return dbError(err)
+}
+
+func (l *orderRefundLine) list(
+	ctx context.Context, tx *gorm.DB, orderID string, fields ...string,
+) (entity.OrderRefundLines, error) {
+	var internal internalOrderRefundLines
+
+	stmt := l.db.Statement(ctx, tx, orderRefundLineTable, fields...).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC")
+
+	if err := stmt.Find(&internal).Error; err != nil {
+		return nil, err
+	}
+	return internal.entities(), nil
+}
+
+type internalOrderRefundLine struct {
+	entity.OrderRefundLine `gorm:"embedded"`
+	ItemsJSON              mysql.JSONColumn[entity.OrderRefundLineItems] `gorm:"default:null;column:items"` // 返金対象商品(JSON)
+}
+
+type internalOrderRefundLines []*internalOrderRefundLine
+
+func newInternalOrderRefundLine(line *entity.OrderRefundLine) *internalOrderRefundLine {
+	return &internalOrderRefundLine{
+		OrderRefundLine: *line,
+		ItemsJSON:       mysql.NewJSONColumn(line.Items),
+	}
+}
+
+func (l *internalOrderRefundLine) entity() *entity.OrderRefundLine {
+	line := l.OrderRefundLine
+	line.Items = l.ItemsJSON.Val
+	return &line
+}
+
+func (ls internalOrderRefundLines) entities() entity.OrderRefundLines {
+	res := make(entity.OrderRefundLines, len(ls))
+	for i := range ls {
+		res[i] = ls[i].entity()
+	}
+	return res
+}
@@ -0,0 +1,291 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderRefundLine(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewOrderRefundLine(nil))
+}
+
+func TestOrderRefundLine_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	createTestOrderForRefund(t, db, "order-id", now())
+	lines := make(entity.OrderRefundLines, 2)
+	lines[0] = testOrderRefundLine("line-id01", "order-id", 500, now())
+	lines[1] = testOrderRefundLine("line-id02", "order-id", 800, now().Add(time.Hour))
+	for _, line := range lines {
+		err = db.DB.Table(orderRefundLineTable).Create(newInternalOrderRefundLine(line)).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		params *database.ListOrderRefundLinesParams
+	}
+	type want struct {
+		lines entity.OrderRefundLines
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListOrderRefundLinesParams{
+					OrderID: "order-id",
+				},
+			},
+			want: want{
+				lines: lines,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &orderRefundLine{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.lines, actual)
+		})
+	}
+}
+
+func TestOrderRefundLine_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	createTestOrderForRefund(t, db, "order-id", now())
+	line := testOrderRefundLine("line-id", "order-id", 500, now())
+	err = db.DB.Table(orderRefundLineTable).Create(newInternalOrderRefundLine(line)).Error
+	require.NoError(t, err)
+
+	type args struct {
+		lineID string
+	}
+	type want struct {
+		line *entity.OrderRefundLine
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				lineID: "line-id",
+			},
+			want: want{
+				line: line,
+				err:  nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				lineID: "other-id",
+			},
+			want: want{
+				line: nil,
+				err:  database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &orderRefundLine{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.lineID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.line, actual)
+		})
+	}
+}
+
+func TestOrderRefundLine_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		line *entity.OrderRefundLine
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+			},
+			args: args{
+				line: testOrderRefundLine("line-id", "order-id", 500, now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "exceeded order total",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+				line := testOrderRefundLine("line-id01", "order-id", 2000, now())
+				err := db.DB.Table(orderRefundLineTable).Create(newInternalOrderRefundLine(line)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				line: testOrderRefundLine("line-id02", "order-id", 500, now()),
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name:  "not found order",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				line: testOrderRefundLine("line-id", "order-id", 500, now()),
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &orderRefundLine{db: db, now: now}
+			err = db.Create(ctx, tt.args.line)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestOrderRefundLine_Update(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		lineID string
+		params *database.UpdateOrderRefundLineParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				createTestOrderForRefund(t, db, "order-id", now())
+				line := testOrderRefundLine("line-id", "order-id", 500, now())
+				err := db.DB.Table(orderRefundLineTable).Create(newInternalOrderRefundLine(line)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				lineID: "line-id",
+				params: &database.UpdateOrderRefundLineParams{
+					Status:     entity.OrderRefundLineStatusSucceeded,
+					RefundedAt: now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &orderRefundLine{db: db, now: now}
+			err = db.Update(ctx, tt.args.lineID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func createTestOrderForRefund(t *testing.T, db *mysql.Client, orderID string, now time.Time) {
+	order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now)
+	err := db.DB.Create(&order).Error
+	require.NoError(t, err)
+	payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now)
+	err = db.DB.Create(&payment).Error
+	require.NoError(t, err)
+}
+
+func testOrderRefundLine(id, orderID string, amount int64, now time.Time) *entity.OrderRefundLine {
+	return &entity.OrderRefundLine{
+		ID:        id,
+		OrderID:   orderID,
+		Type:      entity.OrderRefundLineTypeAmount,
+		Status:    entity.OrderRefundLineStatusSucceeded,
+		Amount:    amount,
+		Reason:    "配送遅延のため",
+		Items:     entity.OrderRefundLineItems{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
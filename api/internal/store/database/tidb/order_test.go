@@ -2,6 +2,7 @@ package tidb
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -268,6 +269,81 @@ func TestOrder_ListUserIDs(t *testing.T) {
 	}
 }
 
+func TestOrder_ListAuthorizedPreorders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	orders := make(entity.Orders, 3)
+	orders[0] = testOrder("order-id01", "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+	orders[0].PreorderBatchID = "batch-id"
+	orders[1] = testOrder("order-id02", "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 2, now())
+	orders[1].PreorderBatchID = "batch-id"
+	orders[2] = testOrder("order-id03", "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 3, now())
+	err = db.DB.Create(&orders).Error
+	require.NoError(t, err)
+
+	payments := make(entity.OrderPayments, 3)
+	payments[0] = testOrderPayment("order-id01", 1, "transaction-id01", "payment-id01", now())
+	payments[0].Status = entity.PaymentStatusAuthorized
+	payments[0].PaidAt = now().AddDate(0, 0, -6)
+	payments[1] = testOrderPayment("order-id02", 1, "transaction-id02", "payment-id02", now())
+	payments[1].Status = entity.PaymentStatusAuthorized
+	payments[1].PaidAt = now().AddDate(0, 0, -1)
+	payments[2] = testOrderPayment("order-id03", 1, "transaction-id03", "payment-id03", now())
+	payments[2].Status = entity.PaymentStatusAuthorized
+	payments[2].PaidAt = now().AddDate(0, 0, -6)
+	err = db.DB.Create(&payments).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListAuthorizedPreordersParams
+	}
+	type want struct {
+		orderIDs []string
+		err      error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListAuthorizedPreordersParams{
+					PaidAtLt: now().AddDate(0, 0, -5),
+					Limit:    10,
+				},
+			},
+			want: want{
+				orderIDs: []string{"order-id01"},
+				err:      nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			actual, err := db.ListAuthorizedPreorders(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.ElementsMatch(t, tt.want.orderIDs, actual.IDs())
+		})
+	}
+}
+
 func TestOrder_Count(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -489,6 +565,162 @@ func TestOrder_Get(t *testing.T) {
 	}
 }
 
+func TestOrder_MultiGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	orders := make(entity.Orders, 2)
+	for i, orderID := range []string{"order-id01", "order-id02"} {
+		o := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+		err = db.DB.Create(&o).Error
+		require.NoError(t, err)
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now())
+		o.OrderPayment = *payment
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+		fulfillments := make(entity.OrderFulfillments, 1)
+		fulfillments[0] = testOrderFulfillment(fmt.Sprintf("fulfillment-id%02d", i+1), orderID, 1, 1, now())
+		o.OrderFulfillments = fulfillments
+		err = db.DB.Create(&fulfillments).Error
+		require.NoError(t, err)
+		orders[i] = o
+	}
+
+	type args struct {
+		orderIDs []string
+	}
+	type want struct {
+		orderIDs []string
+		hasErr   bool
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				orderIDs: []string{"order-id01", "order-id02", "other-id"},
+			},
+			want: want{
+				orderIDs: []string{"order-id01", "order-id02"},
+				hasErr:   false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			actual, err := db.MultiGet(ctx, tt.args.orderIDs)
+			assert.Equal(t, tt.want.hasErr, err != nil, err)
+			assert.ElementsMatch(t, tt.want.orderIDs, actual.IDs())
+			for _, o := range actual {
+				assert.Len(t, o.OrderFulfillments, 1)
+			}
+		})
+	}
+}
+
+func TestOrder_MultiGetByManagementIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	for i, orderID := range []string{"order-id01", "order-id02"} {
+		o := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, int64(i+1), now())
+		err = db.DB.Create(&o).Error
+		require.NoError(t, err)
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now())
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+		fulfillment := testOrderFulfillment(fmt.Sprintf("fulfillment-id%02d", i+1), orderID, 1, 1, now())
+		err = db.DB.Create(&fulfillment).Error
+		require.NoError(t, err)
+	}
+	o := testOrder("order-id03", "user-id", "", "other-shop-id", "other-coordinator-id", entity.OrderTypeProduct, 1, now())
+	err = db.DB.Create(&o).Error
+	require.NoError(t, err)
+	payment := testOrderPayment("order-id03", 1, "transaction-id", "payment-id", now())
+	err = db.DB.Create(&payment).Error
+	require.NoError(t, err)
+
+	type args struct {
+		shopID        string
+		managementIDs []int64
+	}
+	type want struct {
+		orderIDs []string
+		hasErr   bool
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				shopID:        "shop-id",
+				managementIDs: []int64{1, 2, 3},
+			},
+			want: want{
+				orderIDs: []string{"order-id01", "order-id02"},
+				hasErr:   false,
+			},
+		},
+		{
+			name:  "other shop",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				shopID:        "other-shop-id",
+				managementIDs: []int64{1},
+			},
+			want: want{
+				orderIDs: []string{"order-id03"},
+				hasErr:   false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			actual, err := db.MultiGetByManagementIDs(ctx, tt.args.shopID, tt.args.managementIDs)
+			assert.Equal(t, tt.want.hasErr, err != nil, err)
+			assert.ElementsMatch(t, tt.want.orderIDs, actual.IDs())
+		})
+	}
+}
+
 func TestOrder_GetByTransactionID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1249,7 +1481,7 @@ func TestOrder_UpdateFailed(t *testing.T) {
 	}
 }
 
-func TestOrder_UpdateRefunded(t *testing.T) {
+func TestOrder_Expire(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -1260,61 +1492,46 @@ func TestOrder_UpdateRefunded(t *testing.T) {
 	err := deleteAll(t.Context())
 	require.NoError(t, err)
 
-	categories := make(entity.Categories, 2)
+	categories := make(entity.Categories, 1)
 	categories[0] = testCategory("category-id01", "野菜", now())
-	categories[1] = testCategory("category-id02", "果物", now())
 	err = db.DB.Create(&categories).Error
 	require.NoError(t, err)
-	productTypes := make(entity.ProductTypes, 2)
+	productTypes := make(entity.ProductTypes, 1)
 	productTypes[0] = testProductType("type-id01", "category-id01", "野菜", now())
-	productTypes[1] = testProductType("type-id02", "category-id02", "果物", now())
 	err = db.DB.Create(&productTypes).Error
 	require.NoError(t, err)
-	pinternal := make(internalProducts, 2)
-	pinternal[0] = testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
-	pinternal[1] = testProduct("product-id02", "type-id02", "shop-id", "coordinator-id", "producer-id", []string{}, 2, now())
-	err = db.DB.Table(productTable).Create(&pinternal).Error
-	require.NoError(t, err)
-	for i := range pinternal {
-		err = db.DB.Create(&pinternal[i].ProductRevision).Error
-		require.NoError(t, err)
-	}
-	schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
-	err = db.DB.Create(&schedule).Error
-	require.NoError(t, err)
 
-	create := func(t *testing.T, orderID string, status entity.PaymentStatus, now time.Time) {
-		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now)
-		err := db.DB.Create(&order).Error
+	create := func(t *testing.T, orderID string, status entity.PaymentStatus) {
+		product := testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
+		product.Inventory = 10
+		err := db.DB.Table(productTable).Create(&product).Error
 		require.NoError(t, err)
-
-		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now)
-		payment.Status = status
-		err = db.DB.Create(&payment).Error
+		err = db.DB.Create(&product.ProductRevision).Error
 		require.NoError(t, err)
 
-		fulfillments := make(entity.OrderFulfillments, 1)
-		fulfillments[0] = testOrderFulfillment("fulfillment-id", orderID, 1, 1, now)
-		err = db.DB.Create(&fulfillments).Error
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+		order.SetPaymentStatus(status)
+		err = db.DB.Create(&order).Error
 		require.NoError(t, err)
 
-		items := make(entity.OrderItems, 2)
-		items[0] = testOrderItem("fulfillment-id", 1, orderID, now)
-		items[1] = testOrderItem("fulfillment-id", 2, orderID, now)
-		err = db.DB.Create(&items).Error
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now())
+		payment.Status = status
+		err = db.DB.Create(&payment).Error
 		require.NoError(t, err)
 
-		metadata := testOrderMetadata(orderID, now)
-		err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
+		hold := testProductInventoryHold(orderID, "product-id01", 1, 2, now(), now())
+		err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
 		require.NoError(t, err)
 	}
 
 	type args struct {
 		orderID string
-		params  *database.UpdateOrderRefundedParams
+		params  *database.ExpireOrderParams
 	}
 	type want struct {
-		err error
+		inventory int64
+		status    entity.PaymentStatus
+		err       error
 	}
 	tests := []struct {
 		name  string
@@ -1323,71 +1540,37 @@ func TestOrder_UpdateRefunded(t *testing.T) {
 		want  want
 	}{
 		{
-			name: "success canceled",
-			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
-				create(t, "order-id", entity.PaymentStatusPending, now().AddDate(0, 0, -1))
-			},
-			args: args{
-				orderID: "order-id",
-				params: &database.UpdateOrderRefundedParams{
-					Status:       entity.PaymentStatusCanceled,
-					RefundType:   entity.RefundTypeCanceled,
-					RefundTotal:  1980,
-					RefundReason: "テストです。",
-					IssuedAt:     now(),
-				},
-			},
-			want: want{
-				err: nil,
-			},
-		},
-		{
-			name: "success refunded",
+			name: "success",
 			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
-				create(t, "order-id", entity.PaymentStatusPending, now().AddDate(0, 0, -1))
+				create(t, "order-id", entity.PaymentStatusPending)
 			},
 			args: args{
 				orderID: "order-id",
-				params: &database.UpdateOrderRefundedParams{
-					Status:       entity.PaymentStatusRefunded,
-					RefundType:   entity.RefundTypeRefunded,
-					RefundTotal:  1980,
-					RefundReason: "テストです。",
-					IssuedAt:     now(),
-				},
-			},
-			want: want{
-				err: nil,
-			},
-		},
-		{
-			name:  "not found",
-			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
-			args: args{
-				orderID: "",
-				params: &database.UpdateOrderRefundedParams{
-					Status:   entity.PaymentStatusRefunded,
-					IssuedAt: now(),
+				params: &database.ExpireOrderParams{
+					ExpiredAt: now(),
 				},
 			},
 			want: want{
-				err: database.ErrNotFound,
+				inventory: 12,
+				status:    entity.PaymentStatusExpired,
+				err:       nil,
 			},
 		},
 		{
-			name: "not latest data",
+			name: "already paid",
 			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
-				create(t, "order-id", entity.PaymentStatusAuthorized, now().AddDate(0, 0, 1))
+				create(t, "order-id", entity.PaymentStatusCaptured)
 			},
 			args: args{
 				orderID: "order-id",
-				params: &database.UpdateOrderRefundedParams{
-					Status:   entity.PaymentStatusCaptured,
-					IssuedAt: now(),
+				params: &database.ExpireOrderParams{
+					ExpiredAt: now(),
 				},
 			},
 			want: want{
-				err: database.ErrFailedPrecondition,
+				inventory: 10,
+				status:    entity.PaymentStatusCaptured,
+				err:       database.ErrFailedPrecondition,
 			},
 		},
 	}
@@ -1395,14 +1578,402 @@ func TestOrder_UpdateRefunded(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := t.Context()
-			err := delete(ctx, orderItemTable, orderFulfillmentTable, orderPaymentTable, orderExperienceTable, orderMetadataTable, orderTable)
+			err := delete(ctx, productInventoryHoldTable, orderItemTable, orderFulfillmentTable, orderPaymentTable, orderExperienceTable, orderMetadataTable, orderTable, productRevisionTable, productTable)
 			require.NoError(t, err)
 
 			tt.setup(ctx, t, db)
 
 			db := &order{db: db, now: now}
-			err = db.UpdateRefunded(ctx, tt.args.orderID, tt.args.params)
+			err = db.Expire(ctx, tt.args.orderID, tt.args.params)
 			assert.ErrorIs(t, err, tt.want.err)
+
+			var product *entity.Product
+			err = db.db.DB.Table(productTable).Where("id = ?", "product-id01").First(&product).Error
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.inventory, product.Inventory)
+
+			var payment *entity.OrderPayment
+			err = db.db.DB.Table(orderPaymentTable).Where("order_id = ?", tt.args.orderID).First(&payment).Error
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.status, payment.Status)
+		})
+	}
+}
+
+func TestOrder_ExtendHolds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	categories := make(entity.Categories, 1)
+	categories[0] = testCategory("category-id01", "野菜", now())
+	err = db.DB.Create(&categories).Error
+	require.NoError(t, err)
+	productTypes := make(entity.ProductTypes, 1)
+	productTypes[0] = testProductType("type-id01", "category-id01", "野菜", now())
+	err = db.DB.Create(&productTypes).Error
+	require.NoError(t, err)
+	product := testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
+	err = db.DB.Table(productTable).Create(&product).Error
+	require.NoError(t, err)
+	err = db.DB.Create(&product.ProductRevision).Error
+	require.NoError(t, err)
+
+	type args struct {
+		orderID string
+		params  *database.ExtendOrderHoldsParams
+	}
+	type want struct {
+		expiredAt time.Time
+		err       error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				hold := testProductInventoryHold("order-id", "product-id01", 1, 2, now(), now())
+				err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+				params: &database.ExtendOrderHoldsParams{
+					ExpiredAt: now().AddDate(0, 0, 7),
+				},
+			},
+			want: want{
+				expiredAt: now().AddDate(0, 0, 7),
+				err:       nil,
+			},
+		},
+		{
+			name: "success already sold",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				hold := testProductInventoryHold("order-id", "product-id01", 1, 2, now(), now())
+				hold.Status = entity.ProductInventoryHoldStatusSold
+				err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+				params: &database.ExtendOrderHoldsParams{
+					ExpiredAt: now().AddDate(0, 0, 7),
+				},
+			},
+			want: want{
+				expiredAt: now(),
+				err:       nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, productInventoryHoldTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			err = db.ExtendHolds(ctx, tt.args.orderID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			var hold *entity.ProductInventoryHold
+			err = db.db.DB.Table(productInventoryHoldTable).Where("order_id = ?", tt.args.orderID).First(&hold).Error
+			require.NoError(t, err)
+			assert.True(t, tt.want.expiredAt.Equal(hold.ExpiredAt))
+		})
+	}
+}
+
+func TestOrder_UpdateRefunded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	categories := make(entity.Categories, 2)
+	categories[0] = testCategory("category-id01", "野菜", now())
+	categories[1] = testCategory("category-id02", "果物", now())
+	err = db.DB.Create(&categories).Error
+	require.NoError(t, err)
+	productTypes := make(entity.ProductTypes, 2)
+	productTypes[0] = testProductType("type-id01", "category-id01", "野菜", now())
+	productTypes[1] = testProductType("type-id02", "category-id02", "果物", now())
+	err = db.DB.Create(&productTypes).Error
+	require.NoError(t, err)
+	pinternal := make(internalProducts, 2)
+	pinternal[0] = testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
+	pinternal[1] = testProduct("product-id02", "type-id02", "shop-id", "coordinator-id", "producer-id", []string{}, 2, now())
+	err = db.DB.Table(productTable).Create(&pinternal).Error
+	require.NoError(t, err)
+	for i := range pinternal {
+		err = db.DB.Create(&pinternal[i].ProductRevision).Error
+		require.NoError(t, err)
+	}
+	schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+	err = db.DB.Create(&schedule).Error
+	require.NoError(t, err)
+
+	create := func(t *testing.T, orderID string, status entity.PaymentStatus, now time.Time) {
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now)
+		err := db.DB.Create(&order).Error
+		require.NoError(t, err)
+
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now)
+		payment.Status = status
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+
+		fulfillments := make(entity.OrderFulfillments, 1)
+		fulfillments[0] = testOrderFulfillment("fulfillment-id", orderID, 1, 1, now)
+		err = db.DB.Create(&fulfillments).Error
+		require.NoError(t, err)
+
+		items := make(entity.OrderItems, 2)
+		items[0] = testOrderItem("fulfillment-id", 1, orderID, now)
+		items[1] = testOrderItem("fulfillment-id", 2, orderID, now)
+		err = db.DB.Create(&items).Error
+		require.NoError(t, err)
+
+		metadata := testOrderMetadata(orderID, now)
+		err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		orderID string
+		params  *database.UpdateOrderRefundedParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success canceled",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.PaymentStatusPending, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID: "order-id",
+				params: &database.UpdateOrderRefundedParams{
+					Status:       entity.PaymentStatusCanceled,
+					RefundType:   entity.RefundTypeCanceled,
+					RefundTotal:  1980,
+					RefundReason: "テストです。",
+					IssuedAt:     now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "success refunded",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.PaymentStatusPending, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID: "order-id",
+				params: &database.UpdateOrderRefundedParams{
+					Status:       entity.PaymentStatusRefunded,
+					RefundType:   entity.RefundTypeRefunded,
+					RefundTotal:  1980,
+					RefundReason: "テストです。",
+					IssuedAt:     now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				orderID: "",
+				params: &database.UpdateOrderRefundedParams{
+					Status:   entity.PaymentStatusRefunded,
+					IssuedAt: now(),
+				},
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+		{
+			name: "not latest data",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.PaymentStatusAuthorized, now().AddDate(0, 0, 1))
+			},
+			args: args{
+				orderID: "order-id",
+				params: &database.UpdateOrderRefundedParams{
+					Status:   entity.PaymentStatusCaptured,
+					IssuedAt: now(),
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, orderItemTable, orderFulfillmentTable, orderPaymentTable, orderExperienceTable, orderMetadataTable, orderTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			err = db.UpdateRefunded(ctx, tt.args.orderID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestOrder_CreateFulfillment(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	categories := make(entity.Categories, 1)
+	categories[0] = testCategory("category-id01", "野菜", now())
+	err = db.DB.Create(&categories).Error
+	require.NoError(t, err)
+	productTypes := make(entity.ProductTypes, 1)
+	productTypes[0] = testProductType("type-id01", "category-id01", "野菜", now())
+	err = db.DB.Create(&productTypes).Error
+	require.NoError(t, err)
+	pinternal := make(internalProducts, 1)
+	pinternal[0] = testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
+	err = db.DB.Table(productTable).Create(&pinternal).Error
+	require.NoError(t, err)
+	for i := range pinternal {
+		err = db.DB.Create(&pinternal[i].ProductRevision).Error
+		require.NoError(t, err)
+	}
+
+	create := func(t *testing.T, orderID string, status entity.OrderStatus, now time.Time) {
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now)
+		order.Status = status
+		err := db.DB.Create(&order).Error
+		require.NoError(t, err)
+
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now)
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+
+		fulfillments := make(entity.OrderFulfillments, 1)
+		fulfillments[0] = testOrderFulfillment("fulfillment-id01", orderID, 1, 1, now)
+		err = db.DB.Create(&fulfillments).Error
+		require.NoError(t, err)
+
+		items := make(entity.OrderItems, 1)
+		items[0] = testOrderItem("fulfillment-id01", 1, orderID, now)
+		err = db.DB.Create(&items).Error
+		require.NoError(t, err)
+
+		metadata := testOrderMetadata(orderID, now)
+		err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		fulfillment *entity.OrderFulfillment
+	}
+	type want struct {
+		status entity.OrderStatus
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusCompleted, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				fulfillment: &entity.OrderFulfillment{
+					ID:                "fulfillment-id02",
+					OrderID:           "order-id",
+					AddressRevisionID: 1,
+					Status:            entity.FulfillmentStatusUnfulfilled,
+					ShippingCarrier:   entity.ShippingCarrierUnknown,
+					ShippingType:      entity.ShippingTypeNormal,
+					BoxNumber:         2,
+					BoxSize:           entity.ShippingSize60,
+				},
+			},
+			want: want{
+				status: entity.OrderStatusPreparing,
+				err:    nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				fulfillment: &entity.OrderFulfillment{
+					ID:      "fulfillment-id02",
+					OrderID: "order-id",
+				},
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, orderItemTable, orderFulfillmentTable, orderPaymentTable, orderExperienceTable, orderMetadataTable, orderTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			err = db.CreateFulfillment(ctx, tt.args.fulfillment)
+			assert.ErrorIs(t, err, tt.want.err)
+			if tt.want.err != nil {
+				return
+			}
+			order, err := db.Get(ctx, tt.args.fulfillment.OrderID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.status, order.Status)
+			assert.Len(t, order.OrderFulfillments, 2)
 		})
 	}
 }
@@ -1418,30 +1989,687 @@ func TestOrder_UpdateFulfillment(t *testing.T) {
 	err := deleteAll(t.Context())
 	require.NoError(t, err)
 
-	categories := make(entity.Categories, 2)
-	categories[0] = testCategory("category-id01", "野菜", now())
-	categories[1] = testCategory("category-id02", "果物", now())
-	err = db.DB.Create(&categories).Error
-	require.NoError(t, err)
-	productTypes := make(entity.ProductTypes, 2)
-	productTypes[0] = testProductType("type-id01", "category-id01", "野菜", now())
-	productTypes[1] = testProductType("type-id02", "category-id02", "果物", now())
-	err = db.DB.Create(&productTypes).Error
-	require.NoError(t, err)
-	pinternal := make(internalProducts, 2)
-	pinternal[0] = testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
-	pinternal[1] = testProduct("product-id02", "type-id02", "shop-id", "coordinator-id", "producer-id", []string{}, 2, now())
-	err = db.DB.Table(productTable).Create(&pinternal).Error
-	require.NoError(t, err)
-	for i := range pinternal {
-		err = db.DB.Create(&pinternal[i].ProductRevision).Error
-		require.NoError(t, err)
-	}
-	schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
-	err = db.DB.Create(&schedule).Error
-	require.NoError(t, err)
-
-	create := func(t *testing.T, orderID string, status entity.OrderStatus, now time.Time) {
+	categories := make(entity.Categories, 2)
+	categories[0] = testCategory("category-id01", "野菜", now())
+	categories[1] = testCategory("category-id02", "果物", now())
+	err = db.DB.Create(&categories).Error
+	require.NoError(t, err)
+	productTypes := make(entity.ProductTypes, 2)
+	productTypes[0] = testProductType("type-id01", "category-id01", "野菜", now())
+	productTypes[1] = testProductType("type-id02", "category-id02", "果物", now())
+	err = db.DB.Create(&productTypes).Error
+	require.NoError(t, err)
+	pinternal := make(internalProducts, 2)
+	pinternal[0] = testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
+	pinternal[1] = testProduct("product-id02", "type-id02", "shop-id", "coordinator-id", "producer-id", []string{}, 2, now())
+	err = db.DB.Table(productTable).Create(&pinternal).Error
+	require.NoError(t, err)
+	for i := range pinternal {
+		err = db.DB.Create(&pinternal[i].ProductRevision).Error
+		require.NoError(t, err)
+	}
+	schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+	err = db.DB.Create(&schedule).Error
+	require.NoError(t, err)
+
+	create := func(t *testing.T, orderID string, status entity.OrderStatus, now time.Time) {
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now)
+		order.Status = status
+		err := db.DB.Create(&order).Error
+		require.NoError(t, err)
+
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now)
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+
+		fulfillments := make(entity.OrderFulfillments, 1)
+		fulfillments[0] = testOrderFulfillment("fulfillment-id", orderID, 1, 1, now)
+		err = db.DB.Create(&fulfillments).Error
+		require.NoError(t, err)
+
+		items := make(entity.OrderItems, 2)
+		items[0] = testOrderItem("fulfillment-id", 1, orderID, now)
+		items[1] = testOrderItem("fulfillment-id", 2, orderID, now)
+		err = db.DB.Create(&items).Error
+		require.NoError(t, err)
+
+		metadata := testOrderMetadata(orderID, now)
+		err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		orderID       string
+		fulfillmentID string
+		params        *database.UpdateOrderFulfillmentParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success fulfilled",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusPreparing, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				params: &database.UpdateOrderFulfillmentParams{
+					Status:          entity.FulfillmentStatusFulfilled,
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					TrackingNumber:  "tracking-number",
+					ShippedAt:       now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "success uunfulfilled",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusPreparing, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				params: &database.UpdateOrderFulfillmentParams{
+					Status:          entity.FulfillmentStatusUnfulfilled,
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					TrackingNumber:  "tracking-number",
+					ShippedAt:       now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				orderID:       "",
+				fulfillmentID: "fulfillment-id",
+				params: &database.UpdateOrderFulfillmentParams{
+					Status:          entity.FulfillmentStatusFulfilled,
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					TrackingNumber:  "tracking-number",
+					ShippedAt:       now(),
+				},
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+		{
+			name: "already delivered",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusShipped, now().AddDate(0, 0, -1))
+				err := db.DB.Table(orderFulfillmentTable).
+					Where("id = ?", "fulfillment-id").
+					Update("status", entity.FulfillmentStatusDelivered).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				params: &database.UpdateOrderFulfillmentParams{
+					Status:          entity.FulfillmentStatusUnfulfilled,
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					TrackingNumber:  "tracking-number",
+					ShippedAt:       now(),
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name: "already completed",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusCompleted, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				params: &database.UpdateOrderFulfillmentParams{
+					Status:          entity.FulfillmentStatusFulfilled,
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					TrackingNumber:  "tracking-number",
+					ShippedAt:       now(),
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, orderItemTable, orderFulfillmentTable, orderPaymentTable, orderExperienceTable, orderMetadataTable, orderTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			err = db.UpdateFulfillment(ctx, tt.args.orderID, tt.args.fulfillmentID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestOrder_ListByTrackingNumbers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	categories := make(entity.Categories, 1)
+	categories[0] = testCategory("category-id", "野菜", now())
+	err = db.DB.Create(&categories).Error
+	require.NoError(t, err)
+	productTypes := make(entity.ProductTypes, 1)
+	productTypes[0] = testProductType("type-id", "category-id", "野菜", now())
+	err = db.DB.Create(&productTypes).Error
+	require.NoError(t, err)
+	pinternal := make(internalProducts, 1)
+	pinternal[0] = testProduct("product-id", "type-id", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
+	err = db.DB.Table(productTable).Create(&pinternal).Error
+	require.NoError(t, err)
+	err = db.DB.Create(&pinternal[0].ProductRevision).Error
+	require.NoError(t, err)
+
+	trackingNumbers := []string{"1234-5678-9001", "1234 5678　9002"}
+	orders := make(entity.Orders, 2)
+	for i, orderID := range []string{"order-id01", "order-id02"} {
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+		order.Status = entity.OrderStatusShipped
+		err = db.DB.Create(&order).Error
+		require.NoError(t, err)
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now())
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+		fulfillment := testOrderFulfillment(fmt.Sprintf("fulfillment-id%02d", i+1), orderID, 1, 1, now())
+		fulfillment.ShippingCarrier = entity.ShippingCarrierYamato
+		fulfillment.TrackingNumber = trackingNumbers[i]
+		err = db.DB.Create(&fulfillment).Error
+		require.NoError(t, err)
+		item := testOrderItem(fulfillment.ID, 1, orderID, now())
+		err = db.DB.Create(&item).Error
+		require.NoError(t, err)
+		metadata := testOrderMetadata(orderID, now())
+		err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
+		require.NoError(t, err)
+		order.OrderFulfillments = entity.OrderFulfillments{fulfillment}
+		orders[i] = order
+	}
+
+	type args struct {
+		params *database.ListOrdersByTrackingNumbersParams
+	}
+	type want struct {
+		orders entity.Orders
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListOrdersByTrackingNumbersParams{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					TrackingNumbers: []string{"123456789001"},
+				},
+			},
+			want: want{
+				orders: orders[:1],
+				err:    nil,
+			},
+		},
+		{
+			name:  "success with spaces",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListOrdersByTrackingNumbersParams{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					TrackingNumbers: []string{"123456789002"},
+				},
+			},
+			want: want{
+				orders: orders[1:],
+				err:    nil,
+			},
+		},
+		{
+			name:  "success other carrier",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListOrdersByTrackingNumbersParams{
+					ShippingCarrier: entity.ShippingCarrierSagawa,
+					TrackingNumbers: []string{"123456789001"},
+				},
+			},
+			want: want{
+				orders: entity.Orders{},
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			actual, err := db.ListByTrackingNumbers(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Len(t, actual, len(tt.want.orders))
+			for i := range tt.want.orders {
+				assert.Equal(t, tt.want.orders[i].ID, actual[i].ID)
+				assert.Equal(t, tt.want.orders[i].OrderFulfillments[0].TrackingNumber, actual[i].OrderFulfillments[0].TrackingNumber)
+			}
+		})
+	}
+}
+
+func TestOrder_ListSettlementTargets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	create := func(t *testing.T, orderID string, capturedAt, settledAt time.Time, refundTotal, settledRefund int64) {
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+		order.Status = entity.OrderStatusCompleted
+		err := db.DB.Create(&order).Error
+		require.NoError(t, err)
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now())
+		payment.CapturedAt = capturedAt
+		payment.SettledAt = settledAt
+		payment.RefundTotal = refundTotal
+		payment.SettledRefund = settledRefund
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+		metadata := testOrderMetadata(orderID, now())
+		err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
+		require.NoError(t, err)
+	}
+	startAt := now().AddDate(0, -1, 0)
+	create(t, "order-id01", startAt.AddDate(0, 0, 1), time.Time{}, 0, 0)              // 精算期間内に実売上
+	create(t, "order-id02", time.Time{}, time.Time{}, 0, 0)                           // 実売上前
+	create(t, "order-id03", now().AddDate(0, 0, 1), time.Time{}, 0, 0)                // 精算期間外
+	create(t, "order-id04", startAt.AddDate(0, -1, 0), startAt, 500, 0)               // 精算後に返金
+	create(t, "order-id05", startAt.AddDate(0, -1, 0), startAt, 500, 500)             // 精算済み
+	create(t, "order-id06", startAt.AddDate(0, 0, 1), startAt.AddDate(0, 0, 2), 0, 0) // 精算済み
+	create(t, "order-id07", startAt.AddDate(0, 0, -1), time.Time{}, 0, 0)             // 精算後に実売上を反映
+
+	type args struct {
+		params *database.ListSettlementTargetOrdersParams
+	}
+	type want struct {
+		orderIDs []string
+		err      error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListSettlementTargetOrdersParams{
+					CapturedAtLt: now(),
+				},
+			},
+			want: want{
+				orderIDs: []string{"order-id04", "order-id07", "order-id01"},
+				err:      nil,
+			},
+		},
+		{
+			name:  "success with pagination",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListSettlementTargetOrdersParams{
+					CapturedAtLt: now(),
+					Limit:        1,
+					Offset:       2,
+				},
+			},
+			want: want{
+				orderIDs: []string{"order-id01"},
+				err:      nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			actual, err := db.ListSettlementTargets(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.orderIDs, actual.IDs())
+		})
+	}
+}
+
+func TestOrder_ListUndeliveredFulfillments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	o := testOrder("order-id", "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+	err = db.DB.Create(&o).Error
+	require.NoError(t, err)
+
+	fulfillments := make(entity.OrderFulfillments, 5)
+	fulfillments[0] = testOrderFulfillment("fulfillment-id01", "order-id", 1, 1, now())
+	fulfillments[0].ShippingCarrier = entity.ShippingCarrierYamato
+	fulfillments[0].TrackingNumber = "123456789001"
+	fulfillments[0].ShippedAt = now().AddDate(0, 0, -1)
+	fulfillments[1] = testOrderFulfillment("fulfillment-id02", "order-id", 1, 2, now())
+	fulfillments[1].Status = entity.FulfillmentStatusDelivered
+	fulfillments[1].ShippingCarrier = entity.ShippingCarrierYamato
+	fulfillments[1].TrackingNumber = "123456789002"
+	fulfillments[1].ShippedAt = now().AddDate(0, 0, -1)
+	fulfillments[1].DeliveredAt = now()
+	fulfillments[2] = testOrderFulfillment("fulfillment-id03", "order-id", 1, 3, now())
+	fulfillments[2].ShippingCarrier = entity.ShippingCarrierSagawa
+	fulfillments[2].TrackingNumber = "123456789003"
+	fulfillments[2].ShippedAt = now().AddDate(0, 0, -1)
+	fulfillments[3] = testOrderFulfillment("fulfillment-id04", "order-id", 1, 4, now())
+	fulfillments[3].ShippingCarrier = entity.ShippingCarrierYamato
+	fulfillments[3].TrackingNumber = "123456789004"
+	fulfillments[3].ShippedAt = now().AddDate(0, 0, -3)
+	fulfillments[4] = testOrderFulfillment("fulfillment-id05", "order-id", 1, 5, now())
+	fulfillments[4].ShippingCarrier = entity.ShippingCarrierYamato
+	fulfillments[4].TrackingNumber = "123456789005"
+	fulfillments[4].ShippedAt = now().AddDate(0, 0, -1)
+	err = db.DB.Create(&fulfillments).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListUndeliveredOrderFulfillmentsParams
+	}
+	type want struct {
+		fulfillmentIDs []string
+		err            error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListUndeliveredOrderFulfillmentsParams{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					ShippedAtGte:    now().AddDate(0, 0, -7),
+					Limit:           10,
+				},
+			},
+			want: want{
+				fulfillmentIDs: []string{"fulfillment-id04", "fulfillment-id01", "fulfillment-id05"},
+				err:            nil,
+			},
+		},
+		{
+			name:  "success with limit",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListUndeliveredOrderFulfillmentsParams{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					ShippedAtGte:    now().AddDate(0, 0, -7),
+					Limit:           2,
+				},
+			},
+			want: want{
+				fulfillmentIDs: []string{"fulfillment-id04", "fulfillment-id01"},
+				err:            nil,
+			},
+		},
+		{
+			name:  "success with cursor",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListUndeliveredOrderFulfillmentsParams{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					ShippedAtGte:    now().AddDate(0, 0, -7),
+					AfterShippedAt:  now().AddDate(0, 0, -1),
+					AfterID:         "fulfillment-id01",
+					Limit:           2,
+				},
+			},
+			want: want{
+				fulfillmentIDs: []string{"fulfillment-id05"},
+				err:            nil,
+			},
+		},
+		{
+			name:  "success out of period",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListUndeliveredOrderFulfillmentsParams{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					ShippedAtGte:    now(),
+				},
+			},
+			want: want{
+				fulfillmentIDs: []string{},
+				err:            nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			actual, err := db.ListUndeliveredFulfillments(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			ids := make([]string, 0, len(actual))
+			for _, f := range actual {
+				ids = append(ids, f.ID)
+			}
+			assert.Equal(t, tt.want.fulfillmentIDs, ids)
+		})
+	}
+}
+
+func TestOrder_DeliverFulfillment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	create := func(t *testing.T, orderID string, status entity.OrderStatus, fulfillmentStatus entity.FulfillmentStatus, now time.Time) {
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now)
+		order.Status = status
+		err := db.DB.Create(&order).Error
+		require.NoError(t, err)
+
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now)
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+
+		fulfillments := make(entity.OrderFulfillments, 1)
+		fulfillments[0] = testOrderFulfillment("fulfillment-id", orderID, 1, 1, now)
+		fulfillments[0].Status = fulfillmentStatus
+		err = db.DB.Create(&fulfillments).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		orderID       string
+		fulfillmentID string
+		deliveredAt   time.Time
+	}
+	type want struct {
+		orderStatus entity.OrderStatus
+		err         error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusShipped, entity.FulfillmentStatusFulfilled, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				deliveredAt:   now(),
+			},
+			want: want{
+				orderStatus: entity.OrderStatusShipped,
+				err:         nil,
+			},
+		},
+		{
+			name: "success already completed",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusCompleted, entity.FulfillmentStatusFulfilled, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				deliveredAt:   now(),
+			},
+			want: want{
+				orderStatus: entity.OrderStatusCompleted,
+				err:         nil,
+			},
+		},
+		{
+			name: "success already delivered",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusShipped, entity.FulfillmentStatusDelivered, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				deliveredAt:   now(),
+			},
+			want: want{
+				orderStatus: entity.OrderStatusShipped,
+				err:         nil,
+			},
+		},
+		{
+			name: "not found fulfillment",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusShipped, entity.FulfillmentStatusFulfilled, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "other-id",
+				deliveredAt:   now(),
+			},
+			want: want{
+				orderStatus: entity.OrderStatusShipped,
+				err:         database.ErrNotFound,
+			},
+		},
+		{
+			name: "not shipped",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id", entity.OrderStatusPreparing, entity.FulfillmentStatusUnfulfilled, now().AddDate(0, 0, -1))
+			},
+			args: args{
+				orderID:       "order-id",
+				fulfillmentID: "fulfillment-id",
+				deliveredAt:   now(),
+			},
+			want: want{
+				orderStatus: entity.OrderStatusPreparing,
+				err:         database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := delete(ctx, orderItemTable, orderFulfillmentTable, orderPaymentTable, orderExperienceTable, orderMetadataTable, orderTable)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			err = db.DeliverFulfillment(ctx, tt.args.orderID, tt.args.fulfillmentID, tt.args.deliveredAt)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			actual, err := db.Get(ctx, tt.args.orderID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.orderStatus, actual.Status)
+		})
+	}
+}
+
+func TestOrder_ShipFulfillments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	create := func(t *testing.T, orderID string, status entity.OrderStatus, boxes int, now time.Time) {
 		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now)
 		order.Status = status
 		err := db.DB.Create(&order).Error
@@ -1451,29 +2679,21 @@ func TestOrder_UpdateFulfillment(t *testing.T) {
 		err = db.DB.Create(&payment).Error
 		require.NoError(t, err)
 
-		fulfillments := make(entity.OrderFulfillments, 1)
-		fulfillments[0] = testOrderFulfillment("fulfillment-id", orderID, 1, 1, now)
+		fulfillments := make(entity.OrderFulfillments, boxes)
+		for i := range fulfillments {
+			fulfillments[i] = testOrderFulfillment(fmt.Sprintf("%s-fulfillment-id%02d", orderID, i+1), orderID, 1, int64(i+1), now)
+		}
 		err = db.DB.Create(&fulfillments).Error
 		require.NoError(t, err)
-
-		items := make(entity.OrderItems, 2)
-		items[0] = testOrderItem("fulfillment-id", 1, orderID, now)
-		items[1] = testOrderItem("fulfillment-id", 2, orderID, now)
-		err = db.DB.Create(&items).Error
-		require.NoError(t, err)
-
-		metadata := testOrderMetadata(orderID, now)
-		err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
-		require.NoError(t, err)
 	}
 
 	type args struct {
-		orderID       string
-		fulfillmentID string
-		params        *database.UpdateOrderFulfillmentParams
+		params []*database.ShipOrderFulfillmentParams
 	}
 	type want struct {
-		err error
+		rejected      map[string]entity.UnmatchedShipmentReason
+		orderStatuses map[string]entity.OrderStatus
+		err           error
 	}
 	tests := []struct {
 		name  string
@@ -1482,77 +2702,127 @@ func TestOrder_UpdateFulfillment(t *testing.T) {
 		want  want
 	}{
 		{
-			name: "success fulfilled",
+			name: "success",
 			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
-				create(t, "order-id", entity.OrderStatusPreparing, now().AddDate(0, 0, -1))
+				create(t, "order-id01", entity.OrderStatusPreparing, 1, now())
+				create(t, "order-id02", entity.OrderStatusPreparing, 2, now())
 			},
 			args: args{
-				orderID:       "order-id",
-				fulfillmentID: "fulfillment-id",
-				params: &database.UpdateOrderFulfillmentParams{
-					Status:          entity.FulfillmentStatusFulfilled,
-					ShippingCarrier: entity.ShippingCarrierYamato,
-					TrackingNumber:  "tracking-number",
-					ShippedAt:       now(),
+				params: []*database.ShipOrderFulfillmentParams{
+					{
+						OrderID:         "order-id01",
+						FulfillmentID:   "order-id01-fulfillment-id01",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9012",
+						ShippedAt:       now(),
+					},
+					{
+						OrderID:         "order-id02",
+						FulfillmentID:   "order-id02-fulfillment-id01",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9023",
+						ShippedAt:       now(),
+					},
 				},
 			},
 			want: want{
+				rejected: map[string]entity.UnmatchedShipmentReason{},
+				orderStatuses: map[string]entity.OrderStatus{
+					"order-id01": entity.OrderStatusShipped,
+					"order-id02": entity.OrderStatusPreparing,
+				},
 				err: nil,
 			},
 		},
 		{
-			name: "success uunfulfilled",
+			name: "not found fulfillment",
 			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
-				create(t, "order-id", entity.OrderStatusPreparing, now().AddDate(0, 0, -1))
+				create(t, "order-id01", entity.OrderStatusPreparing, 1, now())
+				create(t, "order-id02", entity.OrderStatusPreparing, 1, now())
 			},
 			args: args{
-				orderID:       "order-id",
-				fulfillmentID: "fulfillment-id",
-				params: &database.UpdateOrderFulfillmentParams{
-					Status:          entity.FulfillmentStatusUnfulfilled,
-					ShippingCarrier: entity.ShippingCarrierYamato,
-					TrackingNumber:  "tracking-number",
-					ShippedAt:       now(),
+				params: []*database.ShipOrderFulfillmentParams{
+					{
+						OrderID:         "order-id01",
+						FulfillmentID:   "order-id01-fulfillment-id01",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9012",
+						ShippedAt:       now(),
+					},
+					{
+						OrderID:         "order-id02",
+						FulfillmentID:   "other-id",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9023",
+						ShippedAt:       now(),
+					},
 				},
 			},
 			want: want{
+				rejected: map[string]entity.UnmatchedShipmentReason{
+					"other-id": entity.UnmatchedShipmentReasonNoFulfillment,
+				},
+				orderStatuses: map[string]entity.OrderStatus{
+					"order-id01": entity.OrderStatusShipped, // 他の注文の取り込みは継続されること
+					"order-id02": entity.OrderStatusPreparing,
+				},
 				err: nil,
 			},
 		},
 		{
-			name:  "not found",
-			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			name: "already fulfilled",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				create(t, "order-id01", entity.OrderStatusPreparing, 2, now())
+				err := db.DB.Table(orderFulfillmentTable).
+					Where("id = ?", "order-id01-fulfillment-id01").
+					Update("status", entity.FulfillmentStatusFulfilled).Error
+				require.NoError(t, err)
+			},
 			args: args{
-				orderID:       "",
-				fulfillmentID: "fulfillment-id",
-				params: &database.UpdateOrderFulfillmentParams{
-					Status:          entity.FulfillmentStatusFulfilled,
-					ShippingCarrier: entity.ShippingCarrierYamato,
-					TrackingNumber:  "tracking-number",
-					ShippedAt:       now(),
+				params: []*database.ShipOrderFulfillmentParams{
+					{
+						OrderID:         "order-id01",
+						FulfillmentID:   "order-id01-fulfillment-id01",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9012",
+						ShippedAt:       now(),
+					},
 				},
 			},
 			want: want{
-				err: database.ErrNotFound,
+				rejected: map[string]entity.UnmatchedShipmentReason{
+					"order-id01-fulfillment-id01": entity.UnmatchedShipmentReasonNoFulfillment,
+				},
+				orderStatuses: map[string]entity.OrderStatus{
+					"order-id01": entity.OrderStatusPreparing,
+				},
+				err: nil,
 			},
 		},
 		{
 			name: "already completed",
 			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
-				create(t, "order-id", entity.OrderStatusCompleted, now().AddDate(0, 0, -1))
+				create(t, "order-id01", entity.OrderStatusCompleted, 1, now())
 			},
 			args: args{
-				orderID:       "order-id",
-				fulfillmentID: "fulfillment-id",
-				params: &database.UpdateOrderFulfillmentParams{
-					Status:          entity.FulfillmentStatusFulfilled,
-					ShippingCarrier: entity.ShippingCarrierYamato,
-					TrackingNumber:  "tracking-number",
-					ShippedAt:       now(),
+				params: []*database.ShipOrderFulfillmentParams{
+					{
+						OrderID:         "order-id01",
+						FulfillmentID:   "order-id01-fulfillment-id01",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9012",
+						ShippedAt:       now(),
+					},
 				},
 			},
 			want: want{
-				err: database.ErrFailedPrecondition,
+				rejected: map[string]entity.UnmatchedShipmentReason{
+					"order-id01-fulfillment-id01": entity.UnmatchedShipmentReasonNotShippable,
+				},
+				orderStatuses: map[string]entity.OrderStatus{
+					"order-id01": entity.OrderStatusCompleted,
+				},
+				err: nil,
 			},
 		},
 	}
@@ -1566,8 +2836,15 @@ func TestOrder_UpdateFulfillment(t *testing.T) {
 			tt.setup(ctx, t, db)
 
 			db := &order{db: db, now: now}
-			err = db.UpdateFulfillment(ctx, tt.args.orderID, tt.args.fulfillmentID, tt.args.params)
+			rejected, err := db.ShipFulfillments(ctx, tt.args.params)
 			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.rejected, rejected)
+
+			for orderID, status := range tt.want.orderStatuses {
+				actual, err := db.Get(ctx, orderID)
+				require.NoError(t, err)
+				assert.Equal(t, status, actual.Status)
+			}
 		})
 	}
 }
@@ -2140,6 +3417,148 @@ func TestOrder_AggregateByPaymentMethodType(t *testing.T) {
 	}
 }
 
+func TestOrder_AggregateByProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	categories := make(entity.Categories, 2)
+	categories[0] = testCategory("category-id01", "野菜", now())
+	categories[1] = testCategory("category-id02", "果物", now())
+	err = db.DB.Create(&categories).Error
+	require.NoError(t, err)
+	productTypes := make(entity.ProductTypes, 2)
+	productTypes[0] = testProductType("type-id01", "category-id01", "野菜", now())
+	productTypes[1] = testProductType("type-id02", "category-id02", "果物", now())
+	err = db.DB.Create(&productTypes).Error
+	require.NoError(t, err)
+	pinternal := make(internalProducts, 2)
+	pinternal[0] = testProduct("product-id01", "type-id01", "shop-id", "coordinator-id", "producer-id", []string{}, 1, now())
+	pinternal[1] = testProduct("product-id02", "type-id02", "shop-id", "coordinator-id", "producer-id", []string{}, 2, now())
+	err = db.DB.Table(productTable).Create(&pinternal).Error
+	require.NoError(t, err)
+	for i := range pinternal {
+		err = db.DB.Create(&pinternal[i].ProductRevision).Error
+		require.NoError(t, err)
+	}
+	schedule := testSchedule("schedule-id", "shop-id", "coordinator-id", now())
+	err = db.DB.Create(&schedule).Error
+	require.NoError(t, err)
+
+	orders := make(entity.Orders, 2)
+	orders[0] = testOrder("order-id01", "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+	orders[1] = testOrder("order-id02", "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 2, now())
+	err = db.DB.Create(&orders).Error
+	require.NoError(t, err)
+	payments := make(entity.OrderPayments, 2)
+	payments[0] = testOrderPayment("order-id01", 1, "transaction-id01", "payment-id", now())
+	orders[0].OrderPayment = *payments[0]
+	payments[1] = testOrderPayment("order-id02", 1, "transaction-id02", "payment-id", now())
+	orders[1].OrderPayment = *payments[1]
+	err = db.DB.Create(&payments).Error
+	require.NoError(t, err)
+	fulfillments := make(entity.OrderFulfillments, 2)
+	fulfillments[0] = testOrderFulfillment("fulfillment-id01", "order-id01", 1, 1, now())
+	orders[0].OrderFulfillments = entity.OrderFulfillments{fulfillments[0]}
+	fulfillments[1] = testOrderFulfillment("fulfillment-id02", "order-id02", 1, 2, now())
+	orders[1].OrderFulfillments = entity.OrderFulfillments{fulfillments[1]}
+	err = db.DB.Create(&fulfillments).Error
+	require.NoError(t, err)
+	// 注文01は商品01・商品02、注文02は商品02のみを購入
+	items := make(entity.OrderItems, 3)
+	items[0] = testOrderItem("fulfillment-id01", 1, "order-id01", now())
+	items[0].Quantity = 2
+	items[1] = testOrderItem("fulfillment-id01", 2, "order-id01", now())
+	orders[0].OrderItems = []*entity.OrderItem{items[0], items[1]}
+	items[2] = testOrderItem("fulfillment-id02", 2, "order-id02", now())
+	orders[1].OrderItems = []*entity.OrderItem{items[2]}
+	err = db.DB.Create(&items).Error
+	require.NoError(t, err)
+	metadata := make(entity.MultiOrderMetadata, 2)
+	metadata[0] = testOrderMetadata("order-id01", now().Add(-time.Hour))
+	orders[0].OrderMetadata = *metadata[0]
+	metadata[1] = testOrderMetadata("order-id02", now())
+	err = db.DB.Table(orderMetadataTable).Create(&metadata).Error
+	orders[1].OrderMetadata = *metadata[1]
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.AggregateOrdersByProductParams
+	}
+	type want struct {
+		orders entity.AggregatedOrderProducts
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.AggregateOrdersByProductParams{
+					ShopID:       "shop-id",
+					ProductIDs:   []string{"product-id01"},
+					CreatedAtGte: now().Add(-time.Hour),
+					CreatedAtLt:  now().Add(time.Hour),
+				},
+			},
+			want: want{
+				orders: entity.AggregatedOrderProducts{
+					{
+						ProductID:     "product-id01",
+						OrderCount:    1,
+						UserCount:     1,
+						Quantity:      2,
+						SalesTotal:    800,
+						DiscountTotal: 0,
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name:  "success out of period",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.AggregateOrdersByProductParams{
+					ShopID:       "shop-id",
+					ProductIDs:   []string{"product-id01"},
+					CreatedAtGte: now().Add(time.Hour),
+					CreatedAtLt:  now().Add(2 * time.Hour),
+				},
+			},
+			want: want{
+				orders: entity.AggregatedOrderProducts{},
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &order{db: db, now: now}
+			actual, err := db.AggregateByProduct(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.ElementsMatch(t, tt.want.orders, actual)
+		})
+	}
+}
+
 func TestOrder_AggregateByPromotion(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
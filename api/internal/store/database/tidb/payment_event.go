@@ -0,0 +1,144 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+)
+
+const paymentEventTable = "payment_events"
+
+type paymentEvent struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewPaymentEvent(db *mysql.Client) database.PaymentEvent {
+	return &paymentEvent{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listPaymentEventsParams database.ListPaymentEventsParams
+
+func (p listPaymentEventsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.ProviderType != entity.PaymentProviderTypeUnknown {
+		stmt = stmt.Where("provider_type = ?", p.ProviderType)
+	}
+	if len(p.Statuses) > 0 {
+		stmt = stmt.Where("status IN (?)", p.Statuses)
+	}
+	return stmt.Order("created_at DESC")
+}
+
+func (p listPaymentEventsParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (e *paymentEvent) List(
+	ctx context.Context, params *database.ListPaymentEventsParams, fields ...string,
+) (entity.PaymentEvents, error) {
+	var events entity.PaymentEvents
+
+	p := listPaymentEventsParams(*params)
+
+	stmt := e.db.Statement(ctx, e.db.DB, paymentEventTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	err := stmt.Find(&events).Error
+	return events, dbError(err)
+}
+
+func (e *paymentEvent) Count(ctx context.Context, params *database.ListPaymentEventsParams) (int64, error) {
+	p := listPaymentEventsParams(*params)
+
+	total, err := e.db.Count(ctx, e.db.DB, &entity.PaymentEvent{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (e *paymentEvent) Get(ctx context.Context, paymentEventID string, fields ...string) (*entity.PaymentEvent, error) {
+	var event *entity.PaymentEvent
+
+	stmt := e.db.Statement(ctx, e.db.DB, paymentEventTable, fields...).
+		Where("id = ?", paymentEventID)
+
+	if err := stmt.First(&event).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return event, nil
+}
+
+func (e *paymentEvent) GetByEventID(
+	ctx context.Context, providerType entity.PaymentProviderType, eventID string, fields ...string,
+) (*entity.PaymentEvent, error) {
+	var event *entity.PaymentEvent
+
+	stmt := e.db.Statement(ctx, e.db.DB, paymentEventTable, fields...).
+		Where("provider_type = ?", providerType).
+		Where("event_id = ?", eventID)
+
+	if err := stmt.First(&event).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return event, nil
+}
+
+func (e *paymentEvent) Create(ctx context.Context, event *entity.PaymentEvent) error {
+	now := e.now()
+	event.CreatedAt, event.UpdatedAt = now, now
+
+	err := e.db.DB.WithContext(ctx).Table(paymentEventTable).Create(event).Error
+	return dbError(err)
+}
+
+// Claim - 未処理・処理失敗のイベントを処理中にする（他で処理中の場合はErrFailedPreconditionを返す）
+func (e *paymentEvent) Claim(ctx context.Context, paymentEventID string, params *database.ClaimPaymentEventParams) error {
+	updates := map[string]interface{}{
+		"status":     entity.PaymentEventStatusProcessing,
+		"updated_at": e.now(),
+	}
+	stmt := e.db.DB.WithContext(ctx).
+		Table(paymentEventTable).
+		Where("id = ?", paymentEventID).
+		Where("status IN (?) OR (status = ? AND updated_at < ?)",
+			entity.PaymentEventProcessableStatuses, entity.PaymentEventStatusProcessing, params.StaleAt)
+
+	res := stmt.Updates(updates)
+	if err := res.Error; err != nil {
+		return dbError(err)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("tidb: this payment event is not processable: %w", database.ErrFailedPrecondition)
+	}
+	return nil
+}
+
+func (e *paymentEvent) UpdateResult(
+	ctx context.Context, paymentEventID string, params *database.UpdatePaymentEventResultParams,
+) error {
+	updates := map[string]interface{}{
+		"status":        params.Status,
+		"error_message": params.ErrorMessage,
+		"attempt_count": params.AttemptCount,
+		"processed_at":  nullTime(params.ProcessedAt),
+		"updated_at":    e.now(),
+	}
+	stmt := e.db.DB.WithContext(ctx).Table(paymentEventTable).Where("id = ?", paymentEventID)
+
+	err := stmt.Updates(updates).Error
+	return dbError(err)
+}
@@ -0,0 +1,477 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentEvent(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewPaymentEvent(nil))
+}
+
+func TestPaymentEvent_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	events := make(entity.PaymentEvents, 3)
+	events[0] = testPaymentEvent("payment-event-id01", entity.PaymentProviderTypeKomoju, "event-id01", now())
+	events[1] = testPaymentEvent("payment-event-id02", entity.PaymentProviderTypeStripe, "event-id02", now().Add(time.Hour))
+	events[1].Status = entity.PaymentEventStatusFailed
+	events[2] = testPaymentEvent("payment-event-id03", entity.PaymentProviderTypeStripe, "event-id03", now().Add(2*time.Hour))
+	err = db.DB.Table(paymentEventTable).Create(&events).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListPaymentEventsParams
+	}
+	type want struct {
+		events entity.PaymentEvents
+		total  int64
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPaymentEventsParams{
+					ProviderType: entity.PaymentProviderTypeStripe,
+					Limit:        10,
+				},
+			},
+			want: want{
+				events: entity.PaymentEvents{events[2], events[1]},
+				total:  2,
+				err:    nil,
+			},
+		},
+		{
+			name:  "success with statuses",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPaymentEventsParams{
+					Statuses: []entity.PaymentEventStatus{entity.PaymentEventStatusFailed},
+				},
+			},
+			want: want{
+				events: entity.PaymentEvents{events[1]},
+				total:  1,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &paymentEvent{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.events, actual)
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestPaymentEvent_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	e := testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now())
+	err = db.DB.Table(paymentEventTable).Create(&e).Error
+	require.NoError(t, err)
+
+	type args struct {
+		paymentEventID string
+	}
+	type want struct {
+		event *entity.PaymentEvent
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				paymentEventID: "payment-event-id",
+			},
+			want: want{
+				event: e,
+				err:   nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				paymentEventID: "other-id",
+			},
+			want: want{
+				event: nil,
+				err:   database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &paymentEvent{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.paymentEventID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.event, actual)
+		})
+	}
+}
+
+func TestPaymentEvent_GetByEventID(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	e := testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now())
+	err = db.DB.Table(paymentEventTable).Create(&e).Error
+	require.NoError(t, err)
+
+	type args struct {
+		providerType entity.PaymentProviderType
+		eventID      string
+	}
+	type want struct {
+		event *entity.PaymentEvent
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				providerType: entity.PaymentProviderTypeKomoju,
+				eventID:      "event-id",
+			},
+			want: want{
+				event: e,
+				err:   nil,
+			},
+		},
+		{
+			name:  "not found other provider",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				providerType: entity.PaymentProviderTypeStripe,
+				eventID:      "event-id",
+			},
+			want: want{
+				event: nil,
+				err:   database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &paymentEvent{db: db, now: now}
+			actual, err := db.GetByEventID(ctx, tt.args.providerType, tt.args.eventID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.event, actual)
+		})
+	}
+}
+
+func TestPaymentEvent_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		event *entity.PaymentEvent
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				event: testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists event id",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				e := testPaymentEvent("payment-event-id01", entity.PaymentProviderTypeKomoju, "event-id", now())
+				err := db.DB.Table(paymentEventTable).Create(&e).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				event: testPaymentEvent("payment-event-id02", entity.PaymentProviderTypeKomoju, "event-id", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name: "success rejected events without event id",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				e := testPaymentEvent("payment-event-id01", entity.PaymentProviderTypeKomoju, "", now())
+				e.Status = entity.PaymentEventStatusRejected
+				err := db.DB.Table(paymentEventTable).Create(&e).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				event: testPaymentEvent("payment-event-id02", entity.PaymentProviderTypeKomoju, "", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &paymentEvent{db: db, now: now}
+			err = db.Create(ctx, tt.args.event)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestPaymentEvent_Claim(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		paymentEventID string
+		params         *database.ClaimPaymentEventParams
+	}
+	type want struct {
+		status entity.PaymentEventStatus
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success pending",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				e := testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now())
+				err := db.DB.Table(paymentEventTable).Create(&e).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				paymentEventID: "payment-event-id",
+				params:         &database.ClaimPaymentEventParams{StaleAt: now().Add(-time.Minute)},
+			},
+			want: want{
+				status: entity.PaymentEventStatusProcessing,
+				err:    nil,
+			},
+		},
+		{
+			name: "success stale processing",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				e := testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now().Add(-time.Hour))
+				e.Status = entity.PaymentEventStatusProcessing
+				err := db.DB.Table(paymentEventTable).Create(&e).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				paymentEventID: "payment-event-id",
+				params:         &database.ClaimPaymentEventParams{StaleAt: now().Add(-time.Minute)},
+			},
+			want: want{
+				status: entity.PaymentEventStatusProcessing,
+				err:    nil,
+			},
+		},
+		{
+			name: "already processing",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				e := testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now())
+				e.Status = entity.PaymentEventStatusProcessing
+				err := db.DB.Table(paymentEventTable).Create(&e).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				paymentEventID: "payment-event-id",
+				params:         &database.ClaimPaymentEventParams{StaleAt: now().Add(-time.Minute)},
+			},
+			want: want{
+				status: entity.PaymentEventStatusProcessing,
+				err:    database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name: "already succeeded",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				e := testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now())
+				e.Status = entity.PaymentEventStatusSucceeded
+				err := db.DB.Table(paymentEventTable).Create(&e).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				paymentEventID: "payment-event-id",
+				params:         &database.ClaimPaymentEventParams{StaleAt: now().Add(-time.Minute)},
+			},
+			want: want{
+				status: entity.PaymentEventStatusSucceeded,
+				err:    database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &paymentEvent{db: db, now: now}
+			err = db.Claim(ctx, tt.args.paymentEventID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			event, err := db.Get(ctx, tt.args.paymentEventID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.status, event.Status)
+		})
+	}
+}
+
+func TestPaymentEvent_UpdateResult(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		paymentEventID string
+		params         *database.UpdatePaymentEventResultParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				e := testPaymentEvent("payment-event-id", entity.PaymentProviderTypeKomoju, "event-id", now())
+				err := db.DB.Table(paymentEventTable).Create(&e).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				paymentEventID: "payment-event-id",
+				params: &database.UpdatePaymentEventResultParams{
+					Status:       entity.PaymentEventStatusFailed,
+					ErrorMessage: "some error",
+					AttemptCount: 1,
+					ProcessedAt:  now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &paymentEvent{db: db, now: now}
+			err = db.UpdateResult(ctx, tt.args.paymentEventID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testPaymentEvent(id string, providerType entity.PaymentProviderType, eventID string, now time.Time) *entity.PaymentEvent {
+	return &entity.PaymentEvent{
+		ID:                id,
+		ProviderType:      providerType,
+		EventID:           eventID,
+		EventType:         "payment.captured",
+		Payload:           []byte(`{"id":"` + eventID + `"}`),
+		SignatureVerified: true,
+		Status:            entity.PaymentEventStatusPending,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
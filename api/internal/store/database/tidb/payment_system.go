@@ -55,6 +55,7 @@ func (s *paymentSystem) Update(
 	updates := map[string]interface{}{
 		"status":        params.Status,
 		"provider_type": params.ProviderType,
+		"fee_rate":      params.FeeRate,
 		"updated_at":    s.now(),
 	}
 	stmt := s.db.DB.WithContext(ctx).
@@ -0,0 +1,118 @@
+package tidb
+
+import (
+	"context"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+)
+
+const preorderBatchTable = "preorder_batches"
+
+type preorderBatch struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewPreorderBatch(db *mysql.Client) database.PreorderBatch {
+	return &preorderBatch{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listPreorderBatchesParams database.ListPreorderBatchesParams
+
+func (p listPreorderBatchesParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.ShopID != "" {
+		stmt = stmt.Where("shop_id = ?", p.ShopID)
+	}
+	if len(p.Statuses) > 0 {
+		stmt = stmt.Where("status IN (?)", p.Statuses)
+	}
+	return stmt.Order("expected_shipping_start_at ASC, created_at ASC")
+}
+
+func (p listPreorderBatchesParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (b *preorderBatch) List(
+	ctx context.Context, params *database.ListPreorderBatchesParams, fields ...string,
+) (entity.PreorderBatches, error) {
+	var batches entity.PreorderBatches
+
+	p := listPreorderBatchesParams(*params)
+
+	stmt := b.db.Statement(ctx, b.db.DB, preorderBatchTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	err := stmt.Find(&batches).Error
+	return batches, dbError(err)
+}
+
+func (b *preorderBatch) Count(ctx context.Context, params *database.ListPreorderBatchesParams) (int64, error) {
+	p := listPreorderBatchesParams(*params)
+
+	total, err := b.db.Count(ctx, b.db.DB, &entity.PreorderBatch{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (b *preorderBatch) Get(ctx context.Context, batchID string, fields ...string) (*entity.PreorderBatch, error) {
+	var batch *entity.PreorderBatch
+
+	stmt := b.db.Statement(ctx, b.db.DB, preorderBatchTable, fields...).
+		Where("id = ?", batchID)
+
+	if err := stmt.First(&batch).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return batch, nil
+}
+
+func (b *preorderBatch) GetByExpectedShipping(
+	ctx context.Context, shopID string, startAt, endAt time.Time, fields ...string,
+) (*entity.PreorderBatch, error) {
+	var batch *entity.PreorderBatch
+
+	stmt := b.db.Statement(ctx, b.db.DB, preorderBatchTable, fields...).
+		Where("shop_id = ?", shopID).
+		Where("expected_shipping_start_at = ?", startAt).
+		Where("expected_shipping_end_at = ?", endAt)
+
+	if err := stmt.First(&batch).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return batch, nil
+}
+
+func (b *preorderBatch) Create(ctx context.Context, batch *entity.PreorderBatch) error {
+	now := b.now()
+	batch.CreatedAt, batch.UpdatedAt = now, now
+
+	err := b.db.DB.WithContext(ctx).Table(preorderBatchTable).Create(batch).Error
+	return dbError(err)
+}
+
+func (b *preorderBatch) Update(ctx context.Context, batchID string, params *database.UpdatePreorderBatchParams) error {
+	updates := map[string]interface{}{
+		"status":     params.Status,
+		"ready_at":   nullTime(params.ReadyAt),
+		"updated_at": b.now(),
+	}
+	stmt := b.db.DB.WithContext(ctx).Table(preorderBatchTable).Where("id = ?", batchID)
+
+	err := stmt.Updates(updates).Error
+	return dbError(err)
+}
@@ -0,0 +1,355 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreorderBatch(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewPreorderBatch(nil))
+}
+
+func TestPreorderBatch_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	batches := make(entity.PreorderBatches, 3)
+	batches[0] = testPreorderBatch("batch-id01", "shop-id", entity.PreorderBatchStatusAccepting, now().AddDate(0, 1, 0), now())
+	batches[1] = testPreorderBatch("batch-id02", "shop-id", entity.PreorderBatchStatusReady, now().AddDate(0, 0, 7), now())
+	batches[2] = testPreorderBatch("batch-id03", "other-shop-id", entity.PreorderBatchStatusAccepting, now(), now())
+	err = db.DB.Table(preorderBatchTable).Create(&batches).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListPreorderBatchesParams
+	}
+	type want struct {
+		batches entity.PreorderBatches
+		total   int64
+		err     error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPreorderBatchesParams{
+					ShopID: "shop-id",
+					Limit:  10,
+				},
+			},
+			want: want{
+				batches: entity.PreorderBatches{batches[1], batches[0]},
+				total:   2,
+				err:     nil,
+			},
+		},
+		{
+			name:  "success with statuses",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPreorderBatchesParams{
+					Statuses: []entity.PreorderBatchStatus{entity.PreorderBatchStatusAccepting},
+				},
+			},
+			want: want{
+				batches: entity.PreorderBatches{batches[2], batches[0]},
+				total:   2,
+				err:     nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &preorderBatch{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.batches, actual)
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestPreorderBatch_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	b := testPreorderBatch("batch-id", "shop-id", entity.PreorderBatchStatusAccepting, now(), now())
+	err = db.DB.Table(preorderBatchTable).Create(&b).Error
+	require.NoError(t, err)
+
+	type args struct {
+		batchID string
+	}
+	type want struct {
+		batch *entity.PreorderBatch
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				batchID: "batch-id",
+			},
+			want: want{
+				batch: b,
+				err:   nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				batchID: "other-id",
+			},
+			want: want{
+				batch: nil,
+				err:   database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &preorderBatch{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.batchID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.batch, actual)
+		})
+	}
+}
+
+func TestPreorderBatch_GetByExpectedShipping(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	b := testPreorderBatch("batch-id", "shop-id", entity.PreorderBatchStatusAccepting, now(), now())
+	err = db.DB.Table(preorderBatchTable).Create(&b).Error
+	require.NoError(t, err)
+
+	type args struct {
+		shopID  string
+		startAt time.Time
+		endAt   time.Time
+	}
+	type want struct {
+		batch *entity.PreorderBatch
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				shopID:  "shop-id",
+				startAt: b.ExpectedShippingStartAt,
+				endAt:   b.ExpectedShippingEndAt,
+			},
+			want: want{
+				batch: b,
+				err:   nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				shopID:  "shop-id",
+				startAt: b.ExpectedShippingStartAt,
+				endAt:   b.ExpectedShippingEndAt.AddDate(0, 0, 1),
+			},
+			want: want{
+				batch: nil,
+				err:   database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &preorderBatch{db: db, now: now}
+			actual, err := db.GetByExpectedShipping(ctx, tt.args.shopID, tt.args.startAt, tt.args.endAt)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.batch, actual)
+		})
+	}
+}
+
+func TestPreorderBatch_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		batch *entity.PreorderBatch
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				batch: testPreorderBatch("batch-id", "shop-id", entity.PreorderBatchStatusAccepting, now(), now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "duplicate expected shipping",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				b := testPreorderBatch("other-id", "shop-id", entity.PreorderBatchStatusAccepting, now(), now())
+				err := db.DB.Table(preorderBatchTable).Create(&b).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				batch: testPreorderBatch("batch-id", "shop-id", entity.PreorderBatchStatusAccepting, now(), now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &preorderBatch{db: db, now: now}
+			err = db.Create(ctx, tt.args.batch)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestPreorderBatch_Update(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		batchID string
+		params  *database.UpdatePreorderBatchParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				b := testPreorderBatch("batch-id", "shop-id", entity.PreorderBatchStatusAccepting, now(), now())
+				err := db.DB.Table(preorderBatchTable).Create(&b).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				batchID: "batch-id",
+				params: &database.UpdatePreorderBatchParams{
+					Status:  entity.PreorderBatchStatusReady,
+					ReadyAt: now(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &preorderBatch{db: db, now: now}
+			err = db.Update(ctx, tt.args.batchID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testPreorderBatch(
+	id, shopID string, status entity.PreorderBatchStatus, shippingAt, now time.Time,
+) *entity.PreorderBatch {
+	return &entity.PreorderBatch{
+		ID:                      id,
+		ShopID:                  shopID,
+		CoordinatorID:           "coordinator-id",
+		Status:                  status,
+		ExpectedShippingStartAt: shippingAt,
+		ExpectedShippingEndAt:   shippingAt.AddDate(0, 0, 7),
+		CreatedAt:               now,
+		UpdatedAt:               now,
+	}
+}
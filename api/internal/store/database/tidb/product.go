@@ -170,38 +170,41 @@ func (p *product) Create(ctx context.Context, product *entity.Product) error {
 func (p *product) Update(ctx context.Context, productID string, params *database.UpdateProductParams) error {
 	now := p.now()
 	rparams := &entity.NewProductRevisionParams{
-		ProductID: productID,
-		Price:     params.Price,
-		Cost:      params.Cost,
+		ProductID:   productID,
+		Price:       params.Price,
+		Cost:        params.Cost,
+		TaxCategory: params.TaxCategory,
 	}
 	revision := entity.NewProductRevision(rparams)
 
 	err := p.db.Transaction(ctx, func(tx *gorm.DB) error {
 		updates := map[string]interface{}{
-			"product_type_id":     params.TypeID,
-			"product_tag_ids":     mysql.NewJSONColumn(params.TagIDs),
-			"name":                params.Name,
-			"description":         params.Description,
-			"media":               nil,
-			"recommended_points":  mysql.NewJSONColumn(params.RecommendedPoints),
-			"scope":               params.Scope,
-			"inventory":           params.Inventory,
-			"weight":              params.Weight,
-			"weight_unit":         params.WeightUnit,
-			"item":                params.Item,
-			"item_unit":           params.ItemUnit,
-			"item_description":    params.ItemDescription,
-			"expiration_date":     params.ExpirationDate,
-			"storage_method_type": params.StorageMethodType,
-			"delivery_type":       params.DeliveryType,
-			"box60_rate":          params.Box60Rate,
-			"box80_rate":          params.Box80Rate,
-			"box100_rate":         params.Box100Rate,
-			"origin_prefecture":   params.OriginPrefectureCode,
-			"origin_city":         params.OriginCity,
-			"start_at":            params.StartAt,
-			"end_at":              params.EndAt,
-			"updated_at":          p.now(),
+			"product_type_id":            params.TypeID,
+			"product_tag_ids":            mysql.NewJSONColumn(params.TagIDs),
+			"name":                       params.Name,
+			"description":                params.Description,
+			"media":                      nil,
+			"recommended_points":         mysql.NewJSONColumn(params.RecommendedPoints),
+			"scope":                      params.Scope,
+			"inventory":                  params.Inventory,
+			"weight":                     params.Weight,
+			"weight_unit":                params.WeightUnit,
+			"item":                       params.Item,
+			"item_unit":                  params.ItemUnit,
+			"item_description":           params.ItemDescription,
+			"expiration_date":            params.ExpirationDate,
+			"storage_method_type":        params.StorageMethodType,
+			"delivery_type":              params.DeliveryType,
+			"box60_rate":                 params.Box60Rate,
+			"box80_rate":                 params.Box80Rate,
+			"box100_rate":                params.Box100Rate,
+			"origin_prefecture":          params.OriginPrefectureCode,
+			"origin_city":                params.OriginCity,
+			"start_at":                   params.StartAt,
+			"end_at":                     params.EndAt,
+			"expected_shipping_start_at": nullTime(params.ExpectedShippingStartAt),
+			"expected_shipping_end_at":   nullTime(params.ExpectedShippingEndAt),
+			"updated_at":                 p.now(),
 		}
 		if len(params.Media) > 0 {
 			updates["media"] = mysql.NewJSONColumn(params.Media)
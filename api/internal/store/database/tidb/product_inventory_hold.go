@@ -0,0 +1,190 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const productInventoryHoldTable = "product_inventory_holds"
+
+type productInventoryHold struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewProductInventoryHold(db *mysql.Client) database.ProductInventoryHold {
+	return &productInventoryHold{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listProductInventoryHoldsParams database.ListProductInventoryHoldsParams
+
+func (p listProductInventoryHoldsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.Status != entity.ProductInventoryHoldStatusUnknown {
+		stmt = stmt.Where("status = ?", p.Status)
+	}
+	if !p.ExpiredAtLt.IsZero() {
+		stmt = stmt.Where("expired_at < ?", p.ExpiredAtLt)
+	}
+	stmt = stmt.Order("expired_at ASC")
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	return stmt
+}
+
+func (h *productInventoryHold) List(
+	ctx context.Context, params *database.ListProductInventoryHoldsParams, fields ...string,
+) (entity.ProductInventoryHolds, error) {
+	var holds entity.ProductInventoryHolds
+
+	p := listProductInventoryHoldsParams(*params)
+
+	stmt := h.db.Statement(ctx, h.db.DB, productInventoryHoldTable, fields...)
+	stmt = p.stmt(stmt)
+
+	err := stmt.Find(&holds).Error
+	return holds, dbError(err)
+}
+
+func (h *productInventoryHold) Aggregate(
+	ctx context.Context, params *database.AggregateProductInventoryHoldsParams,
+) (entity.AggregatedProductInventoryHolds, error) {
+	var holds entity.AggregatedProductInventoryHolds
+
+	fields := []string{
+		"product_id",
+		"SUM(quantity) AS quantity",
+	}
+
+	stmt := h.db.Statement(ctx, h.db.DB, productInventoryHoldTable, fields...).
+		Where("product_id IN (?)", params.ProductIDs).
+		Where("status = ?", entity.ProductInventoryHoldStatusHeld).
+		Group("product_id")
+
+	err := stmt.Scan(&holds).Error
+	return holds, dbError(err)
+}
+
+func (h *productInventoryHold) Hold(ctx context.Context, holds entity.ProductInventoryHolds) error {
+	if len(holds) == 0 {
+		return nil
+	}
+	// デッドロックを避けるため、商品IDの昇順でロックを取得する
+	sort.Slice(holds, func(i, j int) bool {
+		return holds[i].ProductID < holds[j].ProductID
+	})
+	err := h.db.Transaction(ctx, func(tx *gorm.DB) error {
+		now := h.now()
+		for _, hold := range holds {
+			var product *entity.Product
+
+			stmt := h.db.Statement(ctx, tx, productTable, "id", "inventory").
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id = ?", hold.ProductID)
+			if err := stmt.First(&product).Error; err != nil {
+				return err
+			}
+			if product.Inventory < hold.Quantity {
+				return fmt.Errorf("tidb: insufficient product inventory. productId=%s: %w",
+					hold.ProductID, database.ErrFailedPrecondition)
+			}
+
+			updates := map[string]interface{}{
+				"inventory":  gorm.Expr("inventory - ?", hold.Quantity),
+				"updated_at": now,
+			}
+			stmt = tx.WithContext(ctx).Table(productTable).Where("id = ?", hold.ProductID)
+			if err := stmt.Updates(updates).Error; err != nil {
+				return err
+			}
+			hold.CreatedAt, hold.UpdatedAt = now, now
+		}
+		return tx.WithContext(ctx).Table(productInventoryHoldTable).Create(&holds).Error
+	})
+	return dbError(err)
+}
+
+func (h *productInventoryHold) Sell(ctx context.Context, orderID string) error {
+	updates := map[string]interface{}{
+		"status":     entity.ProductInventoryHoldStatusSold,
+		"updated_at": h.now(),
+	}
+	stmt := h.db.DB.WithContext(ctx).
+		Table(productInventoryHoldTable).
+		Where("order_id = ?", orderID).
+		Where("status = ?", entity.ProductInventoryHoldStatusHeld)
+
+	err := stmt.Updates(updates).Error
+	return dbError(err)
+}
+
+func (h *productInventoryHold) Release(ctx context.Context, orderID string) error {
+	err := h.db.Transaction(ctx, func(tx *gorm.DB) error {
+		holds, err := h.release(ctx, tx, orderID, h.now())
+		if err != nil {
+			return err
+		}
+		if len(holds) == 0 {
+			return fmt.Errorf("tidb: not found inventory holds: %w", database.ErrNotFound)
+		}
+		return nil
+	})
+	return dbError(err)
+}
+
+// release - 仮押さえ中の在庫を商品へ戻す（対象の仮押さえ一覧を返す）
+func (h *productInventoryHold) release(
+	ctx context.Context, tx *gorm.DB, orderID string, now time.Time,
+) (entity.ProductInventoryHolds, error) {
+	var holds entity.ProductInventoryHolds
+
+	stmt := h.db.Statement(ctx, tx, productInventoryHoldTable).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderID).
+		Order("product_id ASC")
+	if err := stmt.Find(&holds).Error; err != nil {
+		return nil, err
+	}
+	if len(holds) == 0 {
+		return holds, nil
+	}
+
+	for _, hold := range holds {
+		if !hold.Releasable() {
+			continue
+		}
+		updates := map[string]interface{}{
+			"inventory":  gorm.Expr("inventory + ?", hold.Quantity),
+			"updated_at": now,
+		}
+		stmt := tx.WithContext(ctx).Table(productTable).Where("id = ?", hold.ProductID)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	updates := map[string]interface{}{
+		"status":     entity.ProductInventoryHoldStatusReleased,
+		"updated_at": now,
+	}
+	stmt = tx.WithContext(ctx).
+		Table(productInventoryHoldTable).
+		Where("order_id = ?", orderID).
+		Where("status = ?", entity.ProductInventoryHoldStatusHeld)
+	if err := stmt.Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
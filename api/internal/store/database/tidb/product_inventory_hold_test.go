@@ -0,0 +1,440 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductInventoryHold(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewProductInventoryHold(nil))
+}
+
+func TestProductInventoryHold_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	err = createProductForInventoryHold(t.Context(), db, "product-id", 1, now())
+	require.NoError(t, err)
+	holds := make(entity.ProductInventoryHolds, 3)
+	holds[0] = testProductInventoryHold("order-id01", "product-id", 1, 2, now().Add(-time.Hour), now())
+	holds[1] = testProductInventoryHold("order-id02", "product-id", 1, 2, now().Add(time.Hour), now())
+	holds[2] = testProductInventoryHold("order-id03", "product-id", 1, 2, now().Add(-time.Hour), now())
+	holds[2].Status = entity.ProductInventoryHoldStatusSold
+	err = db.DB.Table(productInventoryHoldTable).Create(&holds).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListProductInventoryHoldsParams
+	}
+	type want struct {
+		holds entity.ProductInventoryHolds
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListProductInventoryHoldsParams{
+					Status:      entity.ProductInventoryHoldStatusHeld,
+					ExpiredAtLt: now(),
+					Limit:       10,
+				},
+			},
+			want: want{
+				holds: holds[:1],
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &productInventoryHold{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.holds, actual)
+		})
+	}
+}
+
+func TestProductInventoryHold_Aggregate(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	err = createProductForInventoryHold(t.Context(), db, "product-id", 1, now())
+	require.NoError(t, err)
+	holds := make(entity.ProductInventoryHolds, 3)
+	holds[0] = testProductInventoryHold("order-id01", "product-id", 1, 2, now().Add(time.Hour), now())
+	holds[1] = testProductInventoryHold("order-id02", "product-id", 1, 3, now().Add(time.Hour), now())
+	holds[2] = testProductInventoryHold("order-id03", "product-id", 1, 4, now().Add(time.Hour), now())
+	holds[2].Status = entity.ProductInventoryHoldStatusSold
+	err = db.DB.Table(productInventoryHoldTable).Create(&holds).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.AggregateProductInventoryHoldsParams
+	}
+	type want struct {
+		holds entity.AggregatedProductInventoryHolds
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.AggregateProductInventoryHoldsParams{
+					ProductIDs: []string{"product-id"},
+				},
+			},
+			want: want{
+				holds: entity.AggregatedProductInventoryHolds{
+					{ProductID: "product-id", Quantity: 5},
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &productInventoryHold{db: db, now: now}
+			actual, err := db.Aggregate(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.holds, actual)
+		})
+	}
+}
+
+func TestProductInventoryHold_Hold(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		holds entity.ProductInventoryHolds
+	}
+	type want struct {
+		inventory int64
+		err       error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+			},
+			args: args{
+				holds: entity.ProductInventoryHolds{
+					testProductInventoryHold("order-id", "product-id", 1, 30, now().Add(time.Hour), now()),
+				},
+			},
+			want: want{
+				inventory: 70,
+				err:       nil,
+			},
+		},
+		{
+			name: "insufficient inventory",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+			},
+			args: args{
+				holds: entity.ProductInventoryHolds{
+					testProductInventoryHold("order-id", "product-id", 1, 101, now().Add(time.Hour), now()),
+				},
+			},
+			want: want{
+				inventory: 100,
+				err:       database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+				hold := testProductInventoryHold("order-id", "product-id", 1, 30, now().Add(time.Hour), now())
+				err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				holds: entity.ProductInventoryHolds{
+					testProductInventoryHold("order-id", "product-id", 1, 30, now().Add(time.Hour), now()),
+				},
+			},
+			want: want{
+				inventory: 100,
+				err:       database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &productInventoryHold{db: db, now: now}
+			err = db.Hold(ctx, tt.args.holds)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			inventory, err := getProductInventory(ctx, dbClient, "product-id")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.inventory, inventory)
+		})
+	}
+}
+
+func TestProductInventoryHold_Sell(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		orderID string
+	}
+	type want struct {
+		status entity.ProductInventoryHoldStatus
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+				hold := testProductInventoryHold("order-id", "product-id", 1, 30, now().Add(time.Hour), now())
+				err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				status: entity.ProductInventoryHoldStatusSold,
+				err:    nil,
+			},
+		},
+		{
+			name: "already released",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+				hold := testProductInventoryHold("order-id", "product-id", 1, 30, now().Add(time.Hour), now())
+				hold.Status = entity.ProductInventoryHoldStatusReleased
+				err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				status: entity.ProductInventoryHoldStatusReleased,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &productInventoryHold{db: db, now: now}
+			err = db.Sell(ctx, tt.args.orderID)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			var hold *entity.ProductInventoryHold
+			err = dbClient.DB.Table(productInventoryHoldTable).Where("order_id = ?", tt.args.orderID).First(&hold).Error
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.status, hold.Status)
+		})
+	}
+}
+
+func TestProductInventoryHold_Release(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		orderID string
+	}
+	type want struct {
+		inventory int64
+		err       error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+				hold := testProductInventoryHold("order-id", "product-id", 1, 30, now().Add(time.Hour), now())
+				err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				inventory: 130,
+				err:       nil,
+			},
+		},
+		{
+			name: "already sold",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+				hold := testProductInventoryHold("order-id", "product-id", 1, 30, now().Add(time.Hour), now())
+				hold.Status = entity.ProductInventoryHoldStatusSold
+				err = db.DB.Table(productInventoryHoldTable).Create(&hold).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				inventory: 100,
+				err:       nil,
+			},
+		},
+		{
+			name: "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				err := createProductForInventoryHold(ctx, db, "product-id", 1, now())
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				inventory: 100,
+				err:       database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &productInventoryHold{db: db, now: now}
+			err = db.Release(ctx, tt.args.orderID)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			inventory, err := getProductInventory(ctx, dbClient, "product-id")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.inventory, inventory)
+		})
+	}
+}
+
+func createProductForInventoryHold(ctx context.Context, db *mysql.Client, productID string, revisionID int64, now time.Time) error {
+	category := testCategory("category-id", "野菜", now)
+	if err := db.DB.WithContext(ctx).Create(&category).Error; err != nil {
+		return err
+	}
+	productType := testProductType("type-id", "category-id", "野菜", now)
+	if err := db.DB.WithContext(ctx).Create(&productType).Error; err != nil {
+		return err
+	}
+	productTag := testProductTag("tag-id", "贈答品", now)
+	if err := db.DB.WithContext(ctx).Create(&productTag).Error; err != nil {
+		return err
+	}
+	p := testProduct(productID, "type-id", "shop-id", "coordinator-id", "producer-id", []string{"tag-id"}, revisionID, now)
+	if err := db.DB.WithContext(ctx).Table(productTable).Create(&p).Error; err != nil {
+		return err
+	}
+	return db.DB.WithContext(ctx).Create(&p.ProductRevision).Error
+}
+
+func getProductInventory(ctx context.Context, db *mysql.Client, productID string) (int64, error) {
+	var product *entity.Product
+	err := db.DB.WithContext(ctx).Table(productTable).Select("inventory").Where("id = ?", productID).First(&product).Error
+	if err != nil {
+		return 0, err
+	}
+	return product.Inventory, nil
+}
+
+func testProductInventoryHold(orderID, productID string, revisionID, quantity int64, expiredAt, now time.Time) *entity.ProductInventoryHold {
+	return &entity.ProductInventoryHold{
+		OrderID:           orderID,
+		ProductID:         productID,
+		ProductRevisionID: revisionID,
+		Quantity:          quantity,
+		Status:            entity.ProductInventoryHoldStatusHeld,
+		ExpiredAt:         expiredAt,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
@@ -66,7 +66,7 @@ func (p listPromotionsParams) pagination(stmt *gorm.DB) *gorm.DB {
 }
 
 func (p *promotion) List(ctx context.Context, params *database.ListPromotionsParams, fields ...string) (entity.Promotions, error) {
-	var promotions entity.Promotions
+	var internal internalPromotions
 
 	prm := listPromotionsParams(*params)
 
@@ -74,9 +74,10 @@ func (p *promotion) List(ctx context.Context, params *database.ListPromotionsPar
 	stmt = prm.stmt(stmt)
 	stmt = prm.pagination(stmt)
 
-	if err := stmt.Find(&promotions).Error; err != nil {
+	if err := stmt.Find(&internal).Error; err != nil {
 		return nil, dbError(err)
 	}
+	promotions := internal.entities()
 	promotions.Fill(p.now())
 	return promotions, nil
 }
@@ -89,14 +90,15 @@ func (p *promotion) Count(ctx context.Context, params *database.ListPromotionsPa
 }
 
 func (p *promotion) MultiGet(ctx context.Context, promotionIDs []string, fields ...string) (entity.Promotions, error) {
-	var promotions entity.Promotions
+	var internal internalPromotions
 
 	stmt := p.db.Statement(ctx, p.db.DB, promotionTable, fields...).
 		Where("id IN (?)", promotionIDs)
 
-	if err := stmt.Find(&promotions).Error; err != nil {
+	if err := stmt.Find(&internal).Error; err != nil {
 		return nil, dbError(err)
 	}
+	promotions := internal.entities()
 	promotions.Fill(p.now())
 	return promotions, nil
 }
@@ -107,14 +109,15 @@ func (p *promotion) Get(ctx context.Context, promotionID string, fields ...strin
 }
 
 func (p *promotion) GetByCode(ctx context.Context, code string, fields ...string) (*entity.Promotion, error) {
-	var promotion *entity.Promotion
+	var internal *internalPromotion
 
 	stmt := p.db.Statement(ctx, p.db.DB, promotionTable, fields...).
 		Where("code = ?", code)
 
-	if err := stmt.First(&promotion).Error; err != nil {
+	if err := stmt.First(&internal).Error; err != nil {
 		return nil, dbError(err)
 	}
+	promotion := internal.entity()
 	promotion.Fill(p.now())
 	return promotion, nil
 }
@@ -123,22 +126,27 @@ func (p *promotion) Create(ctx context.Context, promotion *entity.Promotion) err
 	now := p.now()
 	promotion.CreatedAt, promotion.UpdatedAt = now, now
 
-	err := p.db.DB.WithContext(ctx).Table(promotionTable).Create(&promotion).Error
+	internal := newInternalPromotion(promotion)
+	err := p.db.DB.WithContext(ctx).Table(promotionTable).Create(&internal).Error
 	return dbError(err)
 }
 
 func (p *promotion) Update(ctx context.Context, promotionID string, params *database.UpdatePromotionParams) error {
 	updates := map[string]interface{}{
-		"title":         params.Title,
-		"description":   params.Description,
-		"public":        params.Public,
-		"discount_type": params.DiscountType,
-		"discount_rate": params.DiscountRate,
-		"code":          params.Code,
-		"code_type":     params.CodeType,
-		"start_at":      params.StartAt,
-		"end_at":        params.EndAt,
-		"updated_at":    p.now(),
+		"title":                params.Title,
+		"description":          params.Description,
+		"public":               params.Public,
+		"discount_type":        params.DiscountType,
+		"discount_rate":        params.DiscountRate,
+		"rules":                mysql.NewJSONColumn(params.Rules),
+		"code":                 params.Code,
+		"code_type":            params.CodeType,
+		"usage_limit":          params.UsageLimit,
+		"usage_limit_per_user": params.UsageLimitPerUser,
+		"first_purchase_only":  params.FirstPurchaseOnly,
+		"start_at":             params.StartAt,
+		"end_at":               params.EndAt,
+		"updated_at":           p.now(),
 	}
 	stmt := p.db.DB.WithContext(ctx).
 		Table(promotionTable).
@@ -160,14 +168,43 @@ func (p *promotion) Delete(ctx context.Context, promotionID string) error {
 func (p *promotion) get(
 	ctx context.Context, tx *gorm.DB, promotionID string, fields ...string,
 ) (*entity.Promotion, error) {
-	var promotion *entity.Promotion
+	var internal *internalPromotion
 
 	stmt := p.db.Statement(ctx, tx, promotionTable, fields...).
 		Where("id = ?", promotionID)
 
-	if err := stmt.First(&promotion).Error; err != nil {
+	if err := stmt.First(&internal).Error; err != nil {
 		return nil, err
 	}
+	promotion := internal.entity()
 	promotion.Fill(p.now())
 	return promotion, nil
 }
+
+type internalPromotion struct {
+	entity.Promotion `gorm:"embedded"`
+	RulesJSON        mysql.JSONColumn[entity.PromotionRules] `gorm:"default:null;column:rules"` // 適用ルール一覧(JSON)
+}
+
+type internalPromotions []*internalPromotion
+
+func newInternalPromotion(promotion *entity.Promotion) *internalPromotion {
+	return &internalPromotion{
+		Promotion: *promotion,
+		RulesJSON: mysql.NewJSONColumn(promotion.Rules),
+	}
+}
+
+func (p *internalPromotion) entity() *entity.Promotion {
+	promotion := p.Promotion
+	promotion.Rules = p.RulesJSON.Val
+	return &promotion
+}
+
+func (ps internalPromotions) entities() entity.Promotions {
+	res := make(entity.Promotions, len(ps))
+	for i := range ps {
+		res[i] = ps[i].entity()
+	}
+	return res
+}
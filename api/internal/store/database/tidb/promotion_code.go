@@ -0,0 +1,80 @@
+package tidb
+
+import (
+	"context"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+)
+
+const promotionCodeTable = "promotion_codes"
+
+type promotionCode struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewPromotionCode(db *mysql.Client) database.PromotionCode {
+	return &promotionCode{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listPromotionCodesParams database.ListPromotionCodesParams
+
+func (p listPromotionCodesParams) stmt(stmt *gorm.DB) *gorm.DB {
+	stmt = stmt.Where("batch_id = ?", p.BatchID)
+	if len(p.Statuses) > 0 {
+		stmt = stmt.Where("status IN (?)", p.Statuses)
+	}
+	return stmt.Order("code ASC")
+}
+
+func (p listPromotionCodesParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (c *promotionCode) List(
+	ctx context.Context, params *database.ListPromotionCodesParams, fields ...string,
+) (entity.PromotionCodes, error) {
+	var codes entity.PromotionCodes
+
+	p := listPromotionCodesParams(*params)
+
+	stmt := c.db.Statement(ctx, c.db.DB, promotionCodeTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	err := stmt.Find(&codes).Error
+	return codes, dbError(err)
+}
+
+func (c *promotionCode) Count(ctx context.Context, params *database.ListPromotionCodesParams) (int64, error) {
+	p := listPromotionCodesParams(*params)
+
+	total, err := c.db.Count(ctx, c.db.DB, &entity.PromotionCode{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (c *promotionCode) Get(ctx context.Context, code string, fields ...string) (*entity.PromotionCode, error) {
+	var promotionCode *entity.PromotionCode
+
+	stmt := c.db.Statement(ctx, c.db.DB, promotionCodeTable, fields...).
+		Where("code = ?", code)
+
+	if err := stmt.First(&promotionCode).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return promotionCode, nil
+}
@@ -0,0 +1,152 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	promotionCodeBatchTable = "promotion_code_batches"
+	promotionCodeChunkSize  = 1000
+)
+
+type promotionCodeBatch struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewPromotionCodeBatch(db *mysql.Client) database.PromotionCodeBatch {
+	return &promotionCodeBatch{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listPromotionCodeBatchesParams database.ListPromotionCodeBatchesParams
+
+func (p listPromotionCodeBatchesParams) stmt(stmt *gorm.DB) *gorm.DB {
+	stmt = stmt.Where("promotion_id = ?", p.PromotionID)
+	return stmt.Order("created_at DESC")
+}
+
+func (p listPromotionCodeBatchesParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (b *promotionCodeBatch) List(
+	ctx context.Context, params *database.ListPromotionCodeBatchesParams, fields ...string,
+) (entity.PromotionCodeBatches, error) {
+	var batches entity.PromotionCodeBatches
+
+	p := listPromotionCodeBatchesParams(*params)
+
+	stmt := b.db.Statement(ctx, b.db.DB, promotionCodeBatchTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	err := stmt.Find(&batches).Error
+	return batches, dbError(err)
+}
+
+func (b *promotionCodeBatch) Count(ctx context.Context, params *database.ListPromotionCodeBatchesParams) (int64, error) {
+	p := listPromotionCodeBatchesParams(*params)
+
+	total, err := b.db.Count(ctx, b.db.DB, &entity.PromotionCodeBatch{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (b *promotionCodeBatch) Get(ctx context.Context, batchID string, fields ...string) (*entity.PromotionCodeBatch, error) {
+	batch, err := b.get(ctx, b.db.DB, batchID, fields...)
+	return batch, dbError(err)
+}
+
+func (b *promotionCodeBatch) Create(ctx context.Context, batch *entity.PromotionCodeBatch, codes entity.PromotionCodes) error {
+	err := b.db.Transaction(ctx, func(tx *gorm.DB) error {
+		// プロモーションに設定済みのコードとの重複を検証
+		for chunk := range slices.Chunk(codes.Values(), promotionCodeChunkSize) {
+			var total int64
+			stmt := tx.WithContext(ctx).Table(promotionTable).Where("code IN (?)", chunk)
+			if err := stmt.Count(&total).Error; err != nil {
+				return err
+			}
+			if total > 0 {
+				return fmt.Errorf("tidb: duplicate promotion code: %w", database.ErrAlreadyExists)
+			}
+		}
+
+		now := b.now()
+		batch.CreatedAt, batch.UpdatedAt = now, now
+		if err := tx.WithContext(ctx).Table(promotionCodeBatchTable).Create(batch).Error; err != nil {
+			return err
+		}
+		for _, code := range codes {
+			code.CreatedAt, code.UpdatedAt = now, now
+		}
+		return tx.WithContext(ctx).Table(promotionCodeTable).CreateInBatches(codes, promotionCodeChunkSize).Error
+	})
+	return dbError(err)
+}
+
+func (b *promotionCodeBatch) Void(ctx context.Context, batchID string) error {
+	err := b.db.Transaction(ctx, func(tx *gorm.DB) error {
+		batch, err := b.get(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), batchID)
+		if err != nil {
+			return err
+		}
+		if !batch.Voidable() {
+			return fmt.Errorf("tidb: this batch has already been voided: %w", database.ErrFailedPrecondition)
+		}
+
+		now := b.now()
+		updates := map[string]interface{}{
+			"status":     entity.PromotionCodeBatchStatusVoided,
+			"voided_at":  now,
+			"updated_at": now,
+		}
+		stmt := tx.WithContext(ctx).Table(promotionCodeBatchTable).Where("id = ?", batchID)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+
+		// 決済待ちのコードは利用予約の取り消し時に無効化する
+		updates = map[string]interface{}{
+			"status":     entity.PromotionCodeStatusVoided,
+			"updated_at": now,
+		}
+		stmt = tx.WithContext(ctx).
+			Table(promotionCodeTable).
+			Where("batch_id = ?", batchID).
+			Where("status = ?", entity.PromotionCodeStatusAvailable)
+		return stmt.Updates(updates).Error
+	})
+	return dbError(err)
+}
+
+func (b *promotionCodeBatch) get(
+	ctx context.Context, tx *gorm.DB, batchID string, fields ...string,
+) (*entity.PromotionCodeBatch, error) {
+	var batch *entity.PromotionCodeBatch
+
+	stmt := b.db.Statement(ctx, tx, promotionCodeBatchTable, fields...).
+		Where("id = ?", batchID)
+
+	if err := stmt.First(&batch).Error; err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
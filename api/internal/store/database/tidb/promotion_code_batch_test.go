@@ -0,0 +1,406 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotionCodeBatch(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewPromotionCodeBatch(nil))
+}
+
+func TestPromotionCodeBatch_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	batches := make(entity.PromotionCodeBatches, 2)
+	batches[0] = testPromotionCodeBatch("batch-id01", "promotion-id", now())
+	batches[1] = testPromotionCodeBatch("batch-id02", "promotion-id", now().Add(time.Hour))
+	err = db.DB.Table(promotionCodeBatchTable).Create(&batches).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListPromotionCodeBatchesParams
+	}
+	type want struct {
+		batches entity.PromotionCodeBatches
+		err     error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPromotionCodeBatchesParams{
+					PromotionID: "promotion-id",
+					Limit:       1,
+					Offset:      1,
+				},
+			},
+			want: want{
+				batches: batches[:1],
+				err:     nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionCodeBatch{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.batches, actual)
+		})
+	}
+}
+
+func TestPromotionCodeBatch_Count(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	batches := make(entity.PromotionCodeBatches, 2)
+	batches[0] = testPromotionCodeBatch("batch-id01", "promotion-id", now())
+	batches[1] = testPromotionCodeBatch("batch-id02", "promotion-id", now())
+	err = db.DB.Table(promotionCodeBatchTable).Create(&batches).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListPromotionCodeBatchesParams
+	}
+	type want struct {
+		total int64
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPromotionCodeBatchesParams{
+					PromotionID: "promotion-id",
+				},
+			},
+			want: want{
+				total: 2,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionCodeBatch{db: db, now: now}
+			actual, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, actual)
+		})
+	}
+}
+
+func TestPromotionCodeBatch_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+	err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+	require.NoError(t, err)
+
+	type args struct {
+		batchID string
+	}
+	type want struct {
+		batch *entity.PromotionCodeBatch
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				batchID: "batch-id",
+			},
+			want: want{
+				batch: batch,
+				err:   nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				batchID: "other-id",
+			},
+			want: want{
+				batch: nil,
+				err:   database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionCodeBatch{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.batchID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.batch, actual)
+		})
+	}
+}
+
+func TestPromotionCodeBatch_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		batch *entity.PromotionCodeBatch
+		codes entity.PromotionCodes
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				batch: testPromotionCodeBatch("batch-id", "promotion-id", now()),
+				codes: entity.PromotionCodes{
+					testPromotionCode("ABCD2345", "batch-id", "promotion-id", now()),
+					testPromotionCode("EFGH2345", "batch-id", "promotion-id", now()),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "duplicate promotion code",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "ABCD2345", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				batch: testPromotionCodeBatch("batch-id", "promotion-id", now()),
+				codes: entity.PromotionCodes{
+					testPromotionCode("ABCD2345", "batch-id", "promotion-id", now()),
+				},
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name: "duplicate issued code",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				batch := testPromotionCodeBatch("batch-id01", "promotion-id", now())
+				err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+				require.NoError(t, err)
+				code := testPromotionCode("ABCD2345", "batch-id01", "promotion-id", now())
+				err = db.DB.Table(promotionCodeTable).Create(&code).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				batch: testPromotionCodeBatch("batch-id", "promotion-id", now()),
+				codes: entity.PromotionCodes{
+					testPromotionCode("ABCD2345", "batch-id", "promotion-id", now()),
+				},
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &promotionCodeBatch{db: db, now: now}
+			err = db.Create(ctx, tt.args.batch, tt.args.codes)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestPromotionCodeBatch_Void(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		batchID string
+	}
+	type want struct {
+		statuses map[string]entity.PromotionCodeStatus
+		err      error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+				err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+				require.NoError(t, err)
+				codes := make(entity.PromotionCodes, 2)
+				codes[0] = testPromotionCode("ABCD2345", "batch-id", "promotion-id", now())
+				codes[1] = testPromotionCode("EFGH2345", "batch-id", "promotion-id", now())
+				codes[1].Status = entity.PromotionCodeStatusRedeemed
+				err = db.DB.Table(promotionCodeTable).Create(&codes).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				batchID: "batch-id",
+			},
+			want: want{
+				statuses: map[string]entity.PromotionCodeStatus{
+					"ABCD2345": entity.PromotionCodeStatusVoided,
+					"EFGH2345": entity.PromotionCodeStatusRedeemed,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "already voided",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+				batch.Status = entity.PromotionCodeBatchStatusVoided
+				err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				batchID: "batch-id",
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				batchID: "batch-id",
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &promotionCodeBatch{db: db, now: now}
+			err = db.Void(ctx, tt.args.batchID)
+			assert.ErrorIs(t, err, tt.want.err)
+			for code, status := range tt.want.statuses {
+				actual, err := getPromotionCode(ctx, db.db, code)
+				require.NoError(t, err)
+				assert.Equal(t, status, actual.Status)
+			}
+		})
+	}
+}
+
+func testPromotionCodeBatch(batchID, promotionID string, now time.Time) *entity.PromotionCodeBatch {
+	return &entity.PromotionCodeBatch{
+		ID:          batchID,
+		PromotionID: promotionID,
+		Title:       "彦根マルシェ配布分",
+		Quantity:    2,
+		Status:      entity.PromotionCodeBatchStatusActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
@@ -0,0 +1,248 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotionCode(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewPromotionCode(nil))
+}
+
+func TestPromotionCode_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+	err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+	require.NoError(t, err)
+	codes := make(entity.PromotionCodes, 3)
+	codes[0] = testPromotionCode("ABCD2345", "batch-id", "promotion-id", now())
+	codes[1] = testPromotionCode("EFGH2345", "batch-id", "promotion-id", now())
+	codes[1].Status = entity.PromotionCodeStatusRedeemed
+	codes[2] = testPromotionCode("JKMN2345", "batch-id", "promotion-id", now())
+	err = db.DB.Table(promotionCodeTable).Create(&codes).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListPromotionCodesParams
+	}
+	type want struct {
+		codes entity.PromotionCodes
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPromotionCodesParams{
+					BatchID: "batch-id",
+					Limit:   2,
+					Offset:  1,
+				},
+			},
+			want: want{
+				codes: codes[1:],
+				err:   nil,
+			},
+		},
+		{
+			name:  "success with statuses",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPromotionCodesParams{
+					BatchID:  "batch-id",
+					Statuses: []entity.PromotionCodeStatus{entity.PromotionCodeStatusRedeemed},
+				},
+			},
+			want: want{
+				codes: codes[1:2],
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionCode{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.codes, actual)
+		})
+	}
+}
+
+func TestPromotionCode_Count(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+	err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+	require.NoError(t, err)
+	codes := make(entity.PromotionCodes, 2)
+	codes[0] = testPromotionCode("ABCD2345", "batch-id", "promotion-id", now())
+	codes[1] = testPromotionCode("EFGH2345", "batch-id", "promotion-id", now())
+	err = db.DB.Table(promotionCodeTable).Create(&codes).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListPromotionCodesParams
+	}
+	type want struct {
+		total int64
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListPromotionCodesParams{
+					BatchID: "batch-id",
+				},
+			},
+			want: want{
+				total: 2,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionCode{db: db, now: now}
+			actual, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, actual)
+		})
+	}
+}
+
+func TestPromotionCode_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+	err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+	require.NoError(t, err)
+	code := testPromotionCode("ABCD2345", "batch-id", "promotion-id", now())
+	err = db.DB.Table(promotionCodeTable).Create(&code).Error
+	require.NoError(t, err)
+
+	type args struct {
+		code string
+	}
+	type want struct {
+		code *entity.PromotionCode
+		err  error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				code: "ABCD2345",
+			},
+			want: want{
+				code: code,
+				err:  nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				code: "ZZZZ9999",
+			},
+			want: want{
+				code: nil,
+				err:  database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionCode{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.code)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.code, actual)
+		})
+	}
+}
+
+func getPromotionCode(ctx context.Context, db *mysql.Client, code string) (*entity.PromotionCode, error) {
+	var promotionCode *entity.PromotionCode
+	err := db.Statement(ctx, db.DB, promotionCodeTable).
+		Where("code = ?", code).
+		First(&promotionCode).Error
+	return promotionCode, err
+}
+
+func testPromotionCode(code, batchID, promotionID string, now time.Time) *entity.PromotionCode {
+	return &entity.PromotionCode{
+		Code:        code,
+		BatchID:     batchID,
+		PromotionID: promotionID,
+		Status:      entity.PromotionCodeStatusAvailable,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
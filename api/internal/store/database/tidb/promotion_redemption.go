@@ -0,0 +1,255 @@
+package tidb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const promotionRedemptionTable = "promotion_redemptions"
+
+type promotionRedemption struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewPromotionRedemption(db *mysql.Client) database.PromotionRedemption {
+	return &promotionRedemption{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+func (r *promotionRedemption) List(
+	ctx context.Context, params *database.ListPromotionRedemptionsParams,
+) (entity.PromotionRedemptions, error) {
+	var redemptions entity.PromotionRedemptions
+
+	stmt := r.db.Statement(ctx, r.db.DB, promotionRedemptionTable)
+	if params.Status != entity.PromotionRedemptionStatusUnknown {
+		stmt = stmt.Where("status = ?", params.Status)
+	}
+	if !params.ExpiredAtLt.IsZero() {
+		stmt = stmt.Where("expired_at < ?", params.ExpiredAtLt)
+	}
+	stmt = stmt.Order("expired_at ASC")
+	if params.Limit > 0 {
+		stmt = stmt.Limit(params.Limit)
+	}
+
+	err := stmt.Find(&redemptions).Error
+	return redemptions, dbError(err)
+}
+
+func (r *promotionRedemption) Aggregate(
+	ctx context.Context, params *database.AggregatePromotionRedemptionsParams,
+) (entity.AggregatedPromotionRedemptions, error) {
+	var redemptions entity.AggregatedPromotionRedemptions
+
+	fields := []string{
+		"promotion_id",
+		"COUNT(*) AS redemption_count",
+	}
+
+	stmt := r.db.Statement(ctx, r.db.DB, promotionRedemptionTable, fields...).
+		Where("promotion_id IN (?)", params.PromotionIDs).
+		Where("status IN (?)", entity.PromotionRedemptionActiveStatuses).
+		Group("promotion_id")
+
+	err := stmt.Scan(&redemptions).Error
+	return redemptions, dbError(err)
+}
+
+func (r *promotionRedemption) GetUsage(ctx context.Context, promotionID, userID string) (*entity.PromotionUsage, error) {
+	usage, err := r.usage(ctx, r.db.DB, promotionID, userID)
+	return usage, dbError(err)
+}
+
+func (r *promotionRedemption) Redeem(ctx context.Context, redemption *entity.PromotionRedemption) error {
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		var promotion *entity.Promotion
+
+		// 同一プロモーションの利用を直列化するため、プロモーション単位でロックを取得する
+		fields := []string{"id", "code", "code_type", "usage_limit", "usage_limit_per_user", "first_purchase_only"}
+		stmt := r.db.Statement(ctx, tx, promotionTable, fields...).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", redemption.PromotionID)
+		if err := stmt.First(&promotion).Error; err != nil {
+			return err
+		}
+
+		if promotion.HasRedemptionLimit() || promotion.FirstPurchaseOnly {
+			usage, err := r.usage(ctx, tx, redemption.PromotionID, redemption.UserID)
+			if err != nil {
+				return err
+			}
+			if err := promotion.VerifyRedemption(usage); err != nil {
+				return fmt.Errorf("tidb: %s. promotionId=%s: %w",
+					err.Error(), redemption.PromotionID, database.ErrFailedPrecondition)
+			}
+		}
+
+		now := r.now()
+		if redemption.Code != "" && redemption.Code != promotion.Code {
+			if err := r.reserveCode(ctx, tx, redemption, now); err != nil {
+				return err
+			}
+		}
+		redemption.CreatedAt, redemption.UpdatedAt = now, now
+		return tx.WithContext(ctx).Table(promotionRedemptionTable).Create(&redemption).Error
+	})
+	return dbError(err)
+}
+
+// reserveCode - 一括発行したクーポンコードを注文に紐づけて利用予約する
+func (r *promotionRedemption) reserveCode(
+	ctx context.Context, tx *gorm.DB, redemption *entity.PromotionRedemption, now time.Time,
+) error {
+	var code *entity.PromotionCode
+
+	stmt := r.db.Statement(ctx, tx, promotionCodeTable).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ?", redemption.Code)
+	if err := stmt.First(&code).Error; err != nil {
+		return err
+	}
+	if code.PromotionID != redemption.PromotionID || !code.Usable(redemption.OrderID) {
+		return fmt.Errorf("tidb: promotion code is unavailable. code=%s: %w", redemption.Code, database.ErrFailedPrecondition)
+	}
+
+	updates := map[string]interface{}{
+		"status":     entity.PromotionCodeStatusReserved,
+		"order_id":   redemption.OrderID,
+		"updated_at": now,
+	}
+	stmt = tx.WithContext(ctx).Table(promotionCodeTable).Where("code = ?", redemption.Code)
+	return stmt.Updates(updates).Error
+}
+
+func (r *promotionRedemption) Confirm(ctx context.Context, orderID string) error {
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		now := r.now()
+		updates := map[string]interface{}{
+			"status":     entity.PromotionRedemptionStatusRedeemed,
+			"updated_at": now,
+		}
+		stmt := tx.WithContext(ctx).
+			Table(promotionRedemptionTable).
+			Where("order_id = ?", orderID).
+			Where("status = ?", entity.PromotionRedemptionStatusReserved)
+		if err := stmt.Updates(updates).Error; err != nil {
+			return err
+		}
+
+		updates = map[string]interface{}{
+			"status":      entity.PromotionCodeStatusRedeemed,
+			"redeemed_at": now,
+			"updated_at":  now,
+		}
+		stmt = tx.WithContext(ctx).
+			Table(promotionCodeTable).
+			Where("order_id = ?", orderID).
+			Where("status = ?", entity.PromotionCodeStatusReserved)
+		return stmt.Updates(updates).Error
+	})
+	return dbError(err)
+}
+
+func (r *promotionRedemption) Release(ctx context.Context, orderID string) error {
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		return r.release(ctx, tx, orderID, r.now())
+	})
+	return dbError(err)
+}
+
+// release - 利用予約を取り消す
+func (r *promotionRedemption) release(ctx context.Context, tx *gorm.DB, orderID string, now time.Time) error {
+	updates := map[string]interface{}{
+		"status":     entity.PromotionRedemptionStatusCanceled,
+		"updated_at": now,
+	}
+	stmt := tx.WithContext(ctx).
+		Table(promotionRedemptionTable).
+		Where("order_id = ?", orderID).
+		Where("status = ?", entity.PromotionRedemptionStatusReserved)
+	if err := stmt.Updates(updates).Error; err != nil {
+		return err
+	}
+	return r.releaseCode(ctx, tx, orderID, now)
+}
+
+// releaseCode - 利用予約したクーポンコードを未使用に戻す（無効化済みの場合は無効のまま）
+func (r *promotionRedemption) releaseCode(ctx context.Context, tx *gorm.DB, orderID string, now time.Time) error {
+	var code *entity.PromotionCode
+
+	stmt := r.db.Statement(ctx, tx, promotionCodeTable).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderID).
+		Where("status = ?", entity.PromotionCodeStatusReserved)
+	err := stmt.First(&code).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil // 一括発行したクーポンコードを利用していない注文
+	}
+	if err != nil {
+		return err
+	}
+
+	var batch *entity.PromotionCodeBatch
+	stmt = r.db.Statement(ctx, tx, promotionCodeBatchTable, "status").Where("id = ?", code.BatchID)
+	if err := stmt.First(&batch).Error; err != nil {
+		return err
+	}
+
+	status := entity.PromotionCodeStatusAvailable
+	if !batch.Voidable() {
+		status = entity.PromotionCodeStatusVoided
+	}
+	updates := map[string]interface{}{
+		"status":     status,
+		"order_id":   nil,
+		"updated_at": now,
+	}
+	stmt = tx.WithContext(ctx).Table(promotionCodeTable).Where("code = ?", code.Code)
+	return stmt.Updates(updates).Error
+}
+
+func (r *promotionRedemption) usage(
+	ctx context.Context, tx *gorm.DB, promotionID, userID string,
+) (*entity.PromotionUsage, error) {
+	var (
+		usage = &entity.PromotionUsage{}
+		err   error
+	)
+
+	usage.TotalCount, err = r.db.Count(ctx, tx, &entity.PromotionRedemption{}, func(stmt *gorm.DB) *gorm.DB {
+		return stmt.Where("promotion_id = ?", promotionID).
+			Where("status IN (?)", entity.PromotionRedemptionActiveStatuses)
+	})
+	if err != nil || userID == "" {
+		return usage, err
+	}
+	usage.UserCount, err = r.db.Count(ctx, tx, &entity.PromotionRedemption{}, func(stmt *gorm.DB) *gorm.DB {
+		return stmt.Where("promotion_id = ?", promotionID).
+			Where("user_id = ?", userID).
+			Where("status IN (?)", entity.PromotionRedemptionActiveStatuses)
+	})
+	if err != nil {
+		return nil, err
+	}
+	usage.PurchaseCount, err = r.db.Count(ctx, tx, &entity.Order{}, func(stmt *gorm.DB) *gorm.DB {
+		return stmt.Where("user_id = ?", userID).
+			Where("status IN (?)", entity.PurchasedOrderStatuses)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
@@ -0,0 +1,565 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotionRedemption(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewPromotionRedemption(nil))
+}
+
+func TestPromotionRedemption_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	redemptions := make(entity.PromotionRedemptions, 3)
+	redemptions[0] = testPromotionRedemption("order-id01", "promotion-id", "user-id01", now())
+	redemptions[0].ExpiredAt = now().Add(-time.Hour)
+	redemptions[1] = testPromotionRedemption("order-id02", "promotion-id", "user-id02", now())
+	redemptions[1].ExpiredAt = now().Add(time.Hour)
+	redemptions[2] = testPromotionRedemption("order-id03", "promotion-id", "user-id03", now())
+	redemptions[2].Status = entity.PromotionRedemptionStatusRedeemed
+	redemptions[2].ExpiredAt = now().Add(-time.Hour)
+	err = db.DB.Table(promotionRedemptionTable).Create(&redemptions).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListPromotionRedemptionsParams
+	}
+	type want struct {
+		orderIDs []string
+		err      error
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "success",
+			args: args{
+				params: &database.ListPromotionRedemptionsParams{
+					Status:      entity.PromotionRedemptionStatusReserved,
+					ExpiredAtLt: now(),
+					Limit:       10,
+				},
+			},
+			want: want{
+				orderIDs: []string{"order-id01"},
+				err:      nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			db := &promotionRedemption{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.orderIDs, actual.OrderIDs())
+		})
+	}
+}
+
+func TestPromotionRedemption_Aggregate(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	redemptions := make(entity.PromotionRedemptions, 3)
+	redemptions[0] = testPromotionRedemption("order-id01", "promotion-id", "user-id01", now())
+	redemptions[1] = testPromotionRedemption("order-id02", "promotion-id", "user-id02", now())
+	redemptions[1].Status = entity.PromotionRedemptionStatusRedeemed
+	redemptions[2] = testPromotionRedemption("order-id03", "promotion-id", "user-id03", now())
+	redemptions[2].Status = entity.PromotionRedemptionStatusCanceled
+	err = db.DB.Table(promotionRedemptionTable).Create(&redemptions).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.AggregatePromotionRedemptionsParams
+	}
+	type want struct {
+		redemptions entity.AggregatedPromotionRedemptions
+		err         error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.AggregatePromotionRedemptionsParams{
+					PromotionIDs: []string{"promotion-id"},
+				},
+			},
+			want: want{
+				redemptions: entity.AggregatedPromotionRedemptions{
+					{PromotionID: "promotion-id", RedemptionCount: 2},
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionRedemption{db: db, now: now}
+			actual, err := db.Aggregate(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.redemptions, actual)
+		})
+	}
+}
+
+func TestPromotionRedemption_GetUsage(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	promotion := testPromotion("promotion-id", "code0001", "", now())
+	err = db.DB.Create(&promotion).Error
+	require.NoError(t, err)
+	redemptions := make(entity.PromotionRedemptions, 3)
+	redemptions[0] = testPromotionRedemption("order-id01", "promotion-id", "user-id", now())
+	redemptions[1] = testPromotionRedemption("order-id02", "promotion-id", "other-id", now())
+	redemptions[2] = testPromotionRedemption("order-id03", "promotion-id", "user-id", now())
+	redemptions[2].Status = entity.PromotionRedemptionStatusCanceled
+	err = db.DB.Table(promotionRedemptionTable).Create(&redemptions).Error
+	require.NoError(t, err)
+
+	type args struct {
+		promotionID string
+		userID      string
+	}
+	type want struct {
+		usage *entity.PromotionUsage
+		err   error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				promotionID: "promotion-id",
+				userID:      "user-id",
+			},
+			want: want{
+				usage: &entity.PromotionUsage{
+					TotalCount:    2,
+					UserCount:     1,
+					PurchaseCount: 0,
+				},
+				err: nil,
+			},
+		},
+		{
+			name:  "success without user",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				promotionID: "promotion-id",
+				userID:      "",
+			},
+			want: want{
+				usage: &entity.PromotionUsage{
+					TotalCount: 2,
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &promotionRedemption{db: db, now: now}
+			actual, err := db.GetUsage(ctx, tt.args.promotionID, tt.args.userID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.usage, actual)
+		})
+	}
+}
+
+func TestPromotionRedemption_Redeem(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		redemption *entity.PromotionRedemption
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				promotion.CodeType = entity.PromotionCodeTypeAlways
+				promotion.UsageLimit = 2
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id01", "promotion-id", "other-id", now())
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				redemption: testPromotionRedemption("order-id", "promotion-id", "user-id", now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "exceeded usage limit",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				promotion.CodeType = entity.PromotionCodeTypeAlways
+				promotion.UsageLimit = 1
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id01", "promotion-id", "other-id", now())
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				redemption: testPromotionRedemption("order-id", "promotion-id", "user-id", now()),
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name: "exceeded usage limit per user",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id01", "promotion-id", "user-id", now())
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				redemption: testPromotionRedemption("order-id", "promotion-id", "user-id", now()),
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				promotion.CodeType = entity.PromotionCodeTypeAlways
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id", "promotion-id", "user-id", now())
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				redemption: testPromotionRedemption("order-id", "promotion-id", "user-id", now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name: "success with promotion code",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				promotion.CodeType = entity.PromotionCodeTypeAlways
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+				err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+				require.NoError(t, err)
+				code := testPromotionCode("ABCD2345", "batch-id", "promotion-id", now())
+				err = db.DB.Table(promotionCodeTable).Create(&code).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				redemption: func() *entity.PromotionRedemption {
+					redemption := testPromotionRedemption("order-id", "promotion-id", "user-id", now())
+					redemption.Code = "ABCD2345"
+					return redemption
+				}(),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "promotion code already redeemed",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				promotion.CodeType = entity.PromotionCodeTypeAlways
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				batch := testPromotionCodeBatch("batch-id", "promotion-id", now())
+				err = db.DB.Table(promotionCodeBatchTable).Create(&batch).Error
+				require.NoError(t, err)
+				code := testPromotionCode("ABCD2345", "batch-id", "promotion-id", now())
+				code.Status = entity.PromotionCodeStatusRedeemed
+				code.OrderID = "order-id01"
+				err = db.DB.Table(promotionCodeTable).Create(&code).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				redemption: func() *entity.PromotionRedemption {
+					redemption := testPromotionRedemption("order-id", "promotion-id", "user-id", now())
+					redemption.Code = "ABCD2345"
+					return redemption
+				}(),
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+		{
+			name:  "not found promotion",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				redemption: testPromotionRedemption("order-id", "promotion-id", "user-id", now()),
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &promotionRedemption{db: db, now: now}
+			err = db.Redeem(ctx, tt.args.redemption)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestPromotionRedemption_Confirm(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		orderID string
+	}
+	type want struct {
+		status entity.PromotionRedemptionStatus
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id", "promotion-id", "user-id", now())
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				status: entity.PromotionRedemptionStatusRedeemed,
+				err:    nil,
+			},
+		},
+		{
+			name: "already canceled",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id", "promotion-id", "user-id", now())
+				redemption.Status = entity.PromotionRedemptionStatusCanceled
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				status: entity.PromotionRedemptionStatusCanceled,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &promotionRedemption{db: db, now: now}
+			err = db.Confirm(ctx, tt.args.orderID)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			redemption, err := getPromotionRedemption(ctx, db.db, tt.args.orderID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.status, redemption.Status)
+		})
+	}
+}
+
+func TestPromotionRedemption_Release(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		orderID string
+	}
+	type want struct {
+		status entity.PromotionRedemptionStatus
+		err    error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id", "promotion-id", "user-id", now())
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				status: entity.PromotionRedemptionStatusCanceled,
+				err:    nil,
+			},
+		},
+		{
+			name: "already redeemed",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				promotion := testPromotion("promotion-id", "code0001", "", now())
+				err := db.DB.Create(&promotion).Error
+				require.NoError(t, err)
+				redemption := testPromotionRedemption("order-id", "promotion-id", "user-id", now())
+				redemption.Status = entity.PromotionRedemptionStatusRedeemed
+				err = db.DB.Table(promotionRedemptionTable).Create(&redemption).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				orderID: "order-id",
+			},
+			want: want{
+				status: entity.PromotionRedemptionStatusRedeemed,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &promotionRedemption{db: db, now: now}
+			err = db.Release(ctx, tt.args.orderID)
+			assert.ErrorIs(t, err, tt.want.err)
+
+			redemption, err := getPromotionRedemption(ctx, db.db, tt.args.orderID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.status, redemption.Status)
+		})
+	}
+}
+
+func getPromotionRedemption(ctx context.Context, db *mysql.Client, orderID string) (*entity.PromotionRedemption, error) {
+	var redemption *entity.PromotionRedemption
+	err := db.Statement(ctx, db.DB, promotionRedemptionTable).
+		Where("order_id = ?", orderID).
+		First(&redemption).Error
+	return redemption, err
+}
+
+func testPromotionRedemption(orderID, promotionID, userID string, now time.Time) *entity.PromotionRedemption {
+	return &entity.PromotionRedemption{
+		OrderID:     orderID,
+		PromotionID: promotionID,
+		UserID:      userID,
+		Status:      entity.PromotionRedemptionStatusReserved,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
@@ -458,12 +458,23 @@ func TestPromotion_Update(t *testing.T) {
 					Title:        "夏の採れたて野菜マルシェを開催!!",
 					Description:  "採れたての夏野菜を紹介するマルシェを開催ます!!",
 					Public:       true,
-					DiscountType: entity.DiscountTypeFreeShipping,
+					DiscountType: entity.DiscountTypeRule,
 					DiscountRate: 0,
-					Code:         "code0001",
-					CodeType:     entity.PromotionCodeTypeOnce,
-					StartAt:      now(),
-					EndAt:        now().AddDate(0, 1, 0),
+					Rules: entity.PromotionRules{
+						{
+							ID:   "rule-id",
+							Type: entity.PromotionRuleTypeTieredRate,
+							Tiers: entity.PromotionRuleTiers{
+								{Threshold: 5000, Rate: 5},
+								{Threshold: 10000, Rate: 10},
+							},
+							MaxDiscount: 3000,
+						},
+					},
+					Code:     "code0001",
+					CodeType: entity.PromotionCodeTypeOnce,
+					StartAt:  now(),
+					EndAt:    now().AddDate(0, 1, 0),
 				},
 			},
 			want: want{
@@ -0,0 +1,194 @@
+package tidb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	settlementStatementTable     = "settlement_statements"
+	settlementStatementChunkSize = 200
+)
+
+type settlementStatement struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewSettlementStatement(db *mysql.Client) database.SettlementStatement {
+	return &settlementStatement{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listSettlementStatementsParams database.ListSettlementStatementsParams
+
+func (p listSettlementStatementsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.PayeeType != entity.SettlementPayeeTypeUnknown {
+		stmt = stmt.Where("payee_type = ?", p.PayeeType)
+	}
+	if p.PayeeID != "" {
+		stmt = stmt.Where("payee_id = ?", p.PayeeID)
+	}
+	if !p.PeriodStartAt.IsZero() {
+		stmt = stmt.Where("period_start_at = ?", p.PeriodStartAt)
+	}
+	if len(p.Statuses) > 0 {
+		stmt = stmt.Where("status IN (?)", p.Statuses)
+	}
+	return stmt.Order("period_start_at DESC, payee_type ASC, payee_id ASC")
+}
+
+func (p listSettlementStatementsParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (s *settlementStatement) List(
+	ctx context.Context, params *database.ListSettlementStatementsParams, fields ...string,
+) (entity.SettlementStatements, error) {
+	var statements entity.SettlementStatements
+
+	p := listSettlementStatementsParams(*params)
+
+	stmt := s.db.Statement(ctx, s.db.DB, settlementStatementTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	err := stmt.Find(&statements).Error
+	return statements, dbError(err)
+}
+
+func (s *settlementStatement) Count(ctx context.Context, params *database.ListSettlementStatementsParams) (int64, error) {
+	p := listSettlementStatementsParams(*params)
+
+	total, err := s.db.Count(ctx, s.db.DB, &entity.SettlementStatement{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (s *settlementStatement) Get(
+	ctx context.Context, statementID string, fields ...string,
+) (*entity.SettlementStatement, error) {
+	var statement *entity.SettlementStatement
+
+	stmt := s.db.Statement(ctx, s.db.DB, settlementStatementTable, fields...).
+		Where("id = ?", statementID)
+
+	if err := stmt.First(&statement).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return statement, nil
+}
+
+func (s *settlementStatement) Close(ctx context.Context, params *database.CloseSettlementPeriodParams) error {
+	err := s.db.Transaction(ctx, func(tx *gorm.DB) error {
+		// 締め済みの精算対象期間と重複する場合は、同一期間の売上を二重に計上しないよう弾く
+		var total int64
+		err := tx.WithContext(ctx).Table(settlementStatementTable).
+			Where("period_start_at < ? AND period_end_at > ?", params.PeriodEndAt, params.PeriodStartAt).
+			Count(&total).Error
+		if err != nil {
+			return err
+		}
+		if total > 0 {
+			return fmt.Errorf("tidb: settlement period overlaps with a closed period: %w", database.ErrAlreadyExists)
+		}
+		now := s.now()
+		if len(params.Statements) > 0 {
+			for _, statement := range params.Statements {
+				statement.CreatedAt, statement.UpdatedAt = now, now
+			}
+			err := tx.WithContext(ctx).Table(settlementStatementTable).CreateInBatches(params.Statements, settlementStatementChunkSize).Error
+			if err != nil {
+				return err
+			}
+		}
+		// 並行して精算処理が行われた場合に二重計上しないよう、読み込み時点の精算状況を条件に更新する
+		for _, order := range params.Orders {
+			updates := map[string]interface{}{
+				"settled_refund": order.OrderPayment.RefundTotal,
+				"updated_at":     now,
+			}
+			stmt := tx.WithContext(ctx).Table(orderPaymentTable).Where("order_id = ?", order.ID)
+			if order.OrderPayment.IsSettled() {
+				stmt = stmt.Where("settled_refund = ?", order.OrderPayment.SettledRefund)
+			} else {
+				stmt = stmt.Where("settled_at IS NULL")
+				updates["settled_at"] = params.SettledAt
+			}
+			res := stmt.Updates(updates)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return fmt.Errorf("tidb: this order has already been settled: %w", database.ErrFailedPrecondition)
+			}
+		}
+		return nil
+	})
+	return dbError(err)
+}
+
+func (s *settlementStatement) Pay(ctx context.Context, statementID string, paidAt time.Time) error {
+	err := s.db.Transaction(ctx, func(tx *gorm.DB) error {
+		var current *entity.SettlementStatement
+		stmt := s.db.Statement(ctx, tx, settlementStatementTable).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", statementID)
+		if err := stmt.First(&current).Error; err != nil {
+			return err
+		}
+		if current.IsPaid() {
+			return fmt.Errorf("tidb: this settlement statement has already been paid: %w", database.ErrFailedPrecondition)
+		}
+		current.Pay(paidAt)
+
+		updates := map[string]interface{}{
+			"status":     current.Status,
+			"paid_at":    current.PaidAt,
+			"updated_at": s.now(),
+		}
+		return tx.WithContext(ctx).Table(settlementStatementTable).Where("id = ?", statementID).Updates(updates).Error
+	})
+	return dbError(err)
+}
+
+func (s *settlementStatement) AggregateBalances(
+	ctx context.Context, params *database.AggregateSettlementBalancesParams,
+) (entity.SettlementBalances, error) {
+	var balances entity.SettlementBalances
+
+	fields := []string{
+		"payee_type",
+		"payee_id",
+		"COUNT(*) AS statement_count",
+		fmt.Sprintf("SUM(CASE WHEN status = %d THEN payout_total ELSE 0 END) AS unpaid_total", entity.SettlementStatementStatusUnpaid),
+		fmt.Sprintf("SUM(CASE WHEN status = %d THEN payout_total ELSE 0 END) AS paid_total", entity.SettlementStatementStatusPaid),
+	}
+
+	stmt := s.db.Statement(ctx, s.db.DB, settlementStatementTable, fields...)
+	if params.PayeeType != entity.SettlementPayeeTypeUnknown {
+		stmt = stmt.Where("payee_type = ?", params.PayeeType)
+	}
+	if params.PayeeID != "" {
+		stmt = stmt.Where("payee_id = ?", params.PayeeID)
+	}
+	stmt = stmt.Group("payee_type, payee_id").Order("payee_type ASC, payee_id ASC")
+
+	err := stmt.Scan(&balances).Error
+	return balances, dbError(err)
+}
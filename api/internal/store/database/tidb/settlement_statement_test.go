@@ -0,0 +1,506 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettlementStatement(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewSettlementStatement(nil))
+}
+
+func TestSettlementStatement_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	periodAt := now().AddDate(0, -1, 0)
+	statements := make(entity.SettlementStatements, 3)
+	statements[0] = testSettlementStatement("statement-id01", entity.SettlementPayeeTypeShop, "shop-id", periodAt, now())
+	statements[1] = testSettlementStatement("statement-id02", entity.SettlementPayeeTypeProducer, "producer-id", periodAt, now())
+	statements[2] = testSettlementStatement("statement-id03", entity.SettlementPayeeTypeShop, "shop-id", periodAt.AddDate(0, -1, 0), now())
+	statements[2].Status = entity.SettlementStatementStatusPaid
+	err = db.DB.Table(settlementStatementTable).Create(&statements).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.ListSettlementStatementsParams
+	}
+	type want struct {
+		statements entity.SettlementStatements
+		total      int64
+		err        error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListSettlementStatementsParams{
+					PayeeType: entity.SettlementPayeeTypeShop,
+					PayeeID:   "shop-id",
+					Limit:     10,
+				},
+			},
+			want: want{
+				statements: entity.SettlementStatements{statements[0], statements[2]},
+				total:      2,
+				err:        nil,
+			},
+		},
+		{
+			name:  "success with period and statuses",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListSettlementStatementsParams{
+					PeriodStartAt: periodAt,
+					Statuses:      []entity.SettlementStatementStatus{entity.SettlementStatementStatusUnpaid},
+				},
+			},
+			want: want{
+				statements: entity.SettlementStatements{statements[0], statements[1]},
+				total:      2,
+				err:        nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &settlementStatement{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.statements, actual)
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestSettlementStatement_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	s := testSettlementStatement("statement-id", entity.SettlementPayeeTypeShop, "shop-id", now(), now())
+	err = db.DB.Table(settlementStatementTable).Create(&s).Error
+	require.NoError(t, err)
+
+	type args struct {
+		statementID string
+	}
+	type want struct {
+		statement *entity.SettlementStatement
+		err       error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				statementID: "statement-id",
+			},
+			want: want{
+				statement: s,
+				err:       nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				statementID: "other-id",
+			},
+			want: want{
+				statement: nil,
+				err:       database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &settlementStatement{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.statementID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.statement, actual)
+		})
+	}
+}
+
+func TestSettlementStatement_Close(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	createOrder := func(t *testing.T, orderID string, settledAt time.Time, refundTotal, settledRefund int64) *entity.Order {
+		order := testOrder(orderID, "user-id", "", "shop-id", "coordinator-id", entity.OrderTypeProduct, 1, now())
+		order.Status = entity.OrderStatusCompleted
+		err := db.DB.Create(&order).Error
+		require.NoError(t, err)
+		payment := testOrderPayment(orderID, 1, "transaction-id", "payment-id", now())
+		payment.CapturedAt = now()
+		payment.SettledAt = settledAt
+		payment.RefundTotal = refundTotal
+		payment.SettledRefund = settledRefund
+		err = db.DB.Create(&payment).Error
+		require.NoError(t, err)
+		order.OrderPayment = *payment
+		return order
+	}
+
+	type args struct {
+		params func(t *testing.T) *database.CloseSettlementPeriodParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: func(t *testing.T) *database.CloseSettlementPeriodParams {
+					return &database.CloseSettlementPeriodParams{
+						PeriodStartAt: now(),
+						PeriodEndAt:   now().AddDate(0, 1, 0),
+						Statements: entity.SettlementStatements{
+							testSettlementStatement("statement-id01", entity.SettlementPayeeTypeShop, "shop-id", now(), now()),
+							testSettlementStatement("statement-id02", entity.SettlementPayeeTypeProducer, "producer-id", now(), now()),
+						},
+						Orders: entity.Orders{
+							createOrder(t, "order-id01", time.Time{}, 0, 0),
+							createOrder(t, "order-id02", now().AddDate(0, -1, 0), 500, 0),
+						},
+						SettledAt: now(),
+					}
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSettlementStatement("statement-id", entity.SettlementPayeeTypeShop, "shop-id", now(), now())
+				err := db.DB.Table(settlementStatementTable).Create(&s).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				params: func(t *testing.T) *database.CloseSettlementPeriodParams {
+					return &database.CloseSettlementPeriodParams{
+						PeriodStartAt: now(),
+						PeriodEndAt:   now().AddDate(0, 1, 0),
+						Statements: entity.SettlementStatements{
+							testSettlementStatement("statement-id01", entity.SettlementPayeeTypeShop, "shop-id", now(), now()),
+						},
+						SettledAt: now(),
+					}
+				},
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name: "overlaps with closed period",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSettlementStatement("statement-id", entity.SettlementPayeeTypeProducer, "producer-id", now(), now())
+				err := db.DB.Table(settlementStatementTable).Create(&s).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				params: func(t *testing.T) *database.CloseSettlementPeriodParams {
+					startAt := now().AddDate(0, 0, 15)
+					return &database.CloseSettlementPeriodParams{
+						PeriodStartAt: startAt,
+						PeriodEndAt:   startAt.AddDate(0, 1, 0),
+						Statements: entity.SettlementStatements{
+							testSettlementStatement("statement-id01", entity.SettlementPayeeTypeShop, "shop-id", startAt, now()),
+						},
+						SettledAt: now(),
+					}
+				},
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+		{
+			name:  "order has already been settled",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: func(t *testing.T) *database.CloseSettlementPeriodParams {
+					order := createOrder(t, "order-id", now(), 0, 0)
+					order.OrderPayment.SettledAt = time.Time{} // 精算前に読み込んだ状態
+					return &database.CloseSettlementPeriodParams{
+						PeriodStartAt: now(),
+						PeriodEndAt:   now().AddDate(0, 1, 0),
+						Statements: entity.SettlementStatements{
+							testSettlementStatement("statement-id01", entity.SettlementPayeeTypeShop, "shop-id", now(), now()),
+						},
+						Orders:    entity.Orders{order},
+						SettledAt: now(),
+					}
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+			params := tt.args.params(t)
+
+			db := &settlementStatement{db: db, now: now}
+			err = db.Close(ctx, params)
+			assert.ErrorIs(t, err, tt.want.err)
+			if tt.want.err != nil {
+				var total int64
+				err := dbClient.DB.Table(settlementStatementTable).Where("id = ?", "statement-id01").Count(&total).Error
+				require.NoError(t, err)
+				assert.Zero(t, total) // ロールバックされていること
+			}
+		})
+	}
+}
+
+func TestSettlementStatement_Pay(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		statementID string
+		paidAt      time.Time
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSettlementStatement("statement-id", entity.SettlementPayeeTypeShop, "shop-id", now(), now())
+				err := db.DB.Table(settlementStatementTable).Create(&s).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				statementID: "statement-id",
+				paidAt:      now(),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				statementID: "statement-id",
+				paidAt:      now(),
+			},
+			want: want{
+				err: database.ErrNotFound,
+			},
+		},
+		{
+			name: "already paid",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSettlementStatement("statement-id", entity.SettlementPayeeTypeShop, "shop-id", now(), now())
+				s.Pay(now())
+				err := db.DB.Table(settlementStatementTable).Create(&s).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				statementID: "statement-id",
+				paidAt:      now(),
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &settlementStatement{db: db, now: now}
+			err = db.Pay(ctx, tt.args.statementID, tt.args.paidAt)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestSettlementStatement_AggregateBalances(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	statements := make(entity.SettlementStatements, 3)
+	statements[0] = testSettlementStatement("statement-id01", entity.SettlementPayeeTypeShop, "shop-id", now().AddDate(0, -1, 0), now())
+	statements[1] = testSettlementStatement("statement-id02", entity.SettlementPayeeTypeShop, "shop-id", now().AddDate(0, -2, 0), now())
+	statements[1].Status = entity.SettlementStatementStatusPaid
+	statements[2] = testSettlementStatement("statement-id03", entity.SettlementPayeeTypeProducer, "producer-id", now().AddDate(0, -1, 0), now())
+	err = db.DB.Table(settlementStatementTable).Create(&statements).Error
+	require.NoError(t, err)
+
+	type args struct {
+		params *database.AggregateSettlementBalancesParams
+	}
+	type want struct {
+		balances entity.SettlementBalances
+		err      error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.AggregateSettlementBalancesParams{},
+			},
+			want: want{
+				balances: entity.SettlementBalances{
+					{
+						PayeeType:      entity.SettlementPayeeTypeShop,
+						PayeeID:        "shop-id",
+						StatementCount: 2,
+						UnpaidTotal:    2562,
+						PaidTotal:      2562,
+					},
+					{
+						PayeeType:      entity.SettlementPayeeTypeProducer,
+						PayeeID:        "producer-id",
+						StatementCount: 1,
+						UnpaidTotal:    2562,
+						PaidTotal:      0,
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name:  "success with payee",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.AggregateSettlementBalancesParams{
+					PayeeType: entity.SettlementPayeeTypeProducer,
+					PayeeID:   "producer-id",
+				},
+			},
+			want: want{
+				balances: entity.SettlementBalances{
+					{
+						PayeeType:      entity.SettlementPayeeTypeProducer,
+						PayeeID:        "producer-id",
+						StatementCount: 1,
+						UnpaidTotal:    2562,
+						PaidTotal:      0,
+					},
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &settlementStatement{db: db, now: now}
+			actual, err := db.AggregateBalances(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.balances, actual)
+		})
+	}
+}
+
+func testSettlementStatement(
+	id string, payeeType entity.SettlementPayeeType, payeeID string, periodAt, now time.Time,
+) *entity.SettlementStatement {
+	return &entity.SettlementStatement{
+		ID:             id,
+		PayeeType:      payeeType,
+		PayeeID:        payeeID,
+		Status:         entity.SettlementStatementStatusUnpaid,
+		PeriodStartAt:  periodAt,
+		PeriodEndAt:    periodAt.AddDate(0, 1, 0),
+		OrderCount:     2,
+		SalesTotal:     4500,
+		RefundTotal:    1500,
+		CommissionRate: 10,
+		CommissionFee:  300,
+		PaymentFee:     138,
+		PayoutTotal:    2562,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
@@ -245,6 +245,13 @@ func (s *shipping) Update(ctx context.Context, shippingID string, params *databa
 			Box80Frozen:       params.Box80Frozen,
 			Box100Rates:       params.Box100Rates,
 			Box100Frozen:      params.Box100Frozen,
+			Box120Rates:       params.Box120Rates,
+			Box120Frozen:      params.Box120Frozen,
+			Box140Rates:       params.Box140Rates,
+			Box140Frozen:      params.Box140Frozen,
+			Box160Rates:       params.Box160Rates,
+			Box160Frozen:      params.Box160Frozen,
+			RegionRules:       params.RegionRules,
 			HasFreeShipping:   params.HasFreeShipping,
 			FreeShippingRates: params.FreeShippingRates,
 		}
@@ -401,9 +408,13 @@ func (s *shipping) fill(ctx context.Context, tx *gorm.DB, shippings ...*entity.S
 
 type internalShippingRevision struct {
 	entity.ShippingRevision `gorm:"embedded"`
-	Box60RatesJSON          mysql.JSONColumn[entity.ShippingRates] `gorm:"default:null;column:box60_rates"`  // 箱サイズ60の通常便配送料一覧(JSON)
-	Box80RatesJSON          mysql.JSONColumn[entity.ShippingRates] `gorm:"default:null;column:box80_rates"`  // 箱サイズ80の通常便配送料一覧(JSON)
-	Box100RatesJSON         mysql.JSONColumn[entity.ShippingRates] `gorm:"default:null;column:box100_rates"` // 箱サイズ100の通常便配送料一覧(JSON)
+	Box60RatesJSON          mysql.JSONColumn[entity.ShippingRates]       `gorm:"default:null;column:box60_rates"`  // 箱サイズ60の通常便配送料一覧(JSON)
+	Box80RatesJSON          mysql.JSONColumn[entity.ShippingRates]       `gorm:"default:null;column:box80_rates"`  // 箱サイズ80の通常便配送料一覧(JSON)
+	Box100RatesJSON         mysql.JSONColumn[entity.ShippingRates]       `gorm:"default:null;column:box100_rates"` // 箱サイズ100の通常便配送料一覧(JSON)
+	Box120RatesJSON         mysql.JSONColumn[entity.ShippingRates]       `gorm:"default:null;column:box120_rates"` // 箱サイズ120の通常便配送料一覧(JSON)
+	Box140RatesJSON         mysql.JSONColumn[entity.ShippingRates]       `gorm:"default:null;column:box140_rates"` // 箱サイズ140の通常便配送料一覧(JSON)
+	Box160RatesJSON         mysql.JSONColumn[entity.ShippingRates]       `gorm:"default:null;column:box160_rates"` // 箱サイズ160の通常便配送料一覧(JSON)
+	RegionRulesJSON         mysql.JSONColumn[entity.ShippingRegionRules] `gorm:"default:null;column:region_rules"` // 地域別配送設定一覧(JSON)
 }
 
 type internalShippingRevisions []*internalShippingRevision
@@ -414,6 +425,10 @@ func newInternalShippingRevision(revision *entity.ShippingRevision) *internalShi
 		Box60RatesJSON:   mysql.NewJSONColumn(revision.Box60Rates),
 		Box80RatesJSON:   mysql.NewJSONColumn(revision.Box80Rates),
 		Box100RatesJSON:  mysql.NewJSONColumn(revision.Box100Rates),
+		Box120RatesJSON:  mysql.NewJSONColumn(revision.Box120Rates),
+		Box140RatesJSON:  mysql.NewJSONColumn(revision.Box140Rates),
+		Box160RatesJSON:  mysql.NewJSONColumn(revision.Box160Rates),
+		RegionRulesJSON:  mysql.NewJSONColumn(revision.RegionRules),
 	}
 }
 
@@ -422,6 +437,10 @@ func (r *internalShippingRevision) entity() *entity.ShippingRevision {
 	rev.Box60Rates = r.Box60RatesJSON.Val
 	rev.Box80Rates = r.Box80RatesJSON.Val
 	rev.Box100Rates = r.Box100RatesJSON.Val
+	rev.Box120Rates = r.Box120RatesJSON.Val
+	rev.Box140Rates = r.Box140RatesJSON.Val
+	rev.Box160Rates = r.Box160RatesJSON.Val
+	rev.RegionRules = r.RegionRulesJSON.Val
 	return &rev
 }
 
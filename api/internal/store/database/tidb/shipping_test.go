@@ -662,6 +662,13 @@ func TestShipping_Update(t *testing.T) {
 					Box80Frozen:       800,
 					Box100Rates:       s.Box100Rates,
 					Box100Frozen:      800,
+					Box120Rates:       s.Box120Rates,
+					Box120Frozen:      1000,
+					Box140Rates:       s.Box140Rates,
+					Box140Frozen:      1000,
+					Box160Rates:       s.Box160Rates,
+					Box160Frozen:      1200,
+					RegionRules:       s.RegionRules,
 					HasFreeShipping:   true,
 					FreeShippingRates: 3000,
 				},
@@ -922,6 +929,9 @@ func testShippingRevision(revisionID int64, shippingID string, now time.Time) *i
 		{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
 		{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
 	}
+	regionRules := entity.ShippingRegionRules{
+		{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+	}
 	revision := &entity.ShippingRevision{
 		ID:                revisionID,
 		ShippingID:        shippingID,
@@ -931,6 +941,13 @@ func testShippingRevision(revisionID int64, shippingID string, now time.Time) *i
 		Box80Frozen:       800,
 		Box100Rates:       rates,
 		Box100Frozen:      800,
+		Box120Rates:       rates,
+		Box120Frozen:      1000,
+		Box140Rates:       rates,
+		Box140Frozen:      1000,
+		Box160Rates:       rates,
+		Box160Frozen:      1200,
+		RegionRules:       regionRules,
 		HasFreeShipping:   true,
 		FreeShippingRates: 3000,
 		CreatedAt:         now,
@@ -0,0 +1,175 @@
+package tidb
+
+import (
+	"context"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"gorm.io/gorm"
+)
+
+const subscriptionTable = "subscriptions"
+
+type subscription struct {
+	db  *mysql.Client
+	now func() time.Time
+}
+
+func NewSubscription(db *mysql.Client) database.Subscription {
+	return &subscription{
+		db:  db,
+		now: jst.Now,
+	}
+}
+
+type listSubscriptionsParams database.ListSubscriptionsParams
+
+func (p listSubscriptionsParams) stmt(stmt *gorm.DB) *gorm.DB {
+	if p.UserID != "" {
+		stmt = stmt.Where("user_id = ?", p.UserID)
+	}
+	if p.ShopID != "" {
+		stmt = stmt.Where("shop_id = ?", p.ShopID)
+	}
+	if len(p.Statuses) > 0 {
+		stmt = stmt.Where("status IN (?)", p.Statuses)
+	}
+	if !p.NextRunUntil.IsZero() {
+		stmt = stmt.Where("next_run_at <= ?", p.NextRunUntil)
+	}
+	return stmt.Order("next_run_at ASC, created_at ASC")
+}
+
+func (p listSubscriptionsParams) pagination(stmt *gorm.DB) *gorm.DB {
+	if p.Limit > 0 {
+		stmt = stmt.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		stmt = stmt.Offset(p.Offset)
+	}
+	return stmt
+}
+
+func (s *subscription) List(
+	ctx context.Context, params *database.ListSubscriptionsParams, fields ...string,
+) (entity.Subscriptions, error) {
+	var internal internalSubscriptions
+
+	p := listSubscriptionsParams(*params)
+
+	stmt := s.db.Statement(ctx, s.db.DB, subscriptionTable, fields...)
+	stmt = p.stmt(stmt)
+	stmt = p.pagination(stmt)
+
+	if err := stmt.Find(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entities(), nil
+}
+
+func (s *subscription) Count(ctx context.Context, params *database.ListSubscriptionsParams) (int64, error) {
+	p := listSubscriptionsParams(*params)
+
+	total, err := s.db.Count(ctx, s.db.DB, &entity.Subscription{}, p.stmt)
+	return total, dbError(err)
+}
+
+func (s *subscription) Get(ctx context.Context, subscriptionID string, fields ...string) (*entity.Subscription, error) {
+	var internal *internalSubscription
+
+	stmt := s.db.Statement(ctx, s.db.DB, subscriptionTable, fields...).
+		Where("id = ?", subscriptionID)
+
+	if err := stmt.First(&internal).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return internal.entity(), nil
+}
+
+func (s *subscription) Create(ctx context.Context, subscription *entity.Subscription) error {
+	now := s.now()
+	subscription.CreatedAt, subscription.UpdatedAt = now, now
+
+	err := s.db.DB.WithContext(ctx).Table(subscriptionTable).Create(newInternalSubscription(subscription)).Error
+	return dbError(err)
+}
+
+func (s *subscription) Update(ctx context.Context, subscriptionID string, params *database.UpdateSubscriptionParams) error {
+	updates := map[string]interface{}{
+		"status":         params.Status,
+		"next_run_at":    params.NextRunAt,
+		"failure_count":  params.FailureCount,
+		"last_failed_at": nullTime(params.LastFailedAt),
+		"paused_at":      nullTime(params.PausedAt),
+		"canceled_at":    nullTime(params.CanceledAt),
+		"updated_at":     s.now(),
+	}
+	if params.LastOrderID != "" {
+		updates["last_order_id"] = params.LastOrderID
+	}
+	stmt := s.db.DB.WithContext(ctx).Table(subscriptionTable).Where("id = ?", subscriptionID)
+
+	err := stmt.Updates(updates).Error
+	return dbError(err)
+}
+
+func (s *subscription) UpdateNextRunAt(
+	ctx context.Context, subscriptionID string, params *database.UpdateSubscriptionNextRunAtParams,
+) error {
+	updates := map[string]interface{}{
+		"next_run_at": params.NextRunAt,
+		"updated_at":  s.now(),
+	}
+	stmt := s.db.DB.WithContext(ctx).
+		Table(subscriptionTable).
+		Where("id = ?", subscriptionID).
+		Where("next_run_at = ?", params.CurrentNextRunAt)
+
+	res := stmt.Updates(updates)
+	if err := res.Error; err != nil {
+		return dbError(err)
+	}
+	if res.RowsAffected == 0 {
+		return database.ErrFailedPrecondition
+	}
+	return nil
+}
+
+// nullTime - ゼロ値の場合はNULLとして更新する
+func nullTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+type internalSubscription struct {
+	entity.Subscription `gorm:"embedded"`
+	ItemsJSON           mysql.JSONColumn[entity.SubscriptionItems] `gorm:"default:null;column:items"` // 定期便の商品一覧(JSON)
+}
+
+type internalSubscriptions []*internalSubscription
+
+func newInternalSubscription(subscription *entity.Subscription) *internalSubscription {
+	return &internalSubscription{
+		Subscription: *subscription,
+		ItemsJSON:    mysql.NewJSONColumn(subscription.Items),
+	}
+}
+
+func (s *internalSubscription) entity() *entity.Subscription {
+	subscription := s.Subscription
+	subscription.Items = s.ItemsJSON.Val
+	return &subscription
+}
+
+func (ss internalSubscriptions) entities() entity.Subscriptions {
+	res := make(entity.Subscriptions, len(ss))
+	for i := range ss {
+		res[i] = ss[i].entity()
+	}
+	return res
+}
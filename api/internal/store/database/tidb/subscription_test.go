@@ -0,0 +1,367 @@
+package tidb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscription(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewSubscription(nil))
+}
+
+func TestSubscription_List(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	subscriptions := make(entity.Subscriptions, 3)
+	subscriptions[0] = testSubscription("subscription-id01", entity.SubscriptionStatusActive, now().Add(-time.Hour), now())
+	subscriptions[1] = testSubscription("subscription-id02", entity.SubscriptionStatusActive, now().Add(time.Hour), now())
+	subscriptions[2] = testSubscription("subscription-id03", entity.SubscriptionStatusPaused, now(), now())
+	for _, s := range subscriptions {
+		err = db.DB.Table(subscriptionTable).Create(newInternalSubscription(s)).Error
+		require.NoError(t, err)
+	}
+
+	type args struct {
+		params *database.ListSubscriptionsParams
+	}
+	type want struct {
+		subscriptions entity.Subscriptions
+		total         int64
+		err           error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListSubscriptionsParams{
+					UserID: "user-id",
+					Limit:  10,
+				},
+			},
+			want: want{
+				subscriptions: entity.Subscriptions{subscriptions[0], subscriptions[2], subscriptions[1]},
+				total:         3,
+				err:           nil,
+			},
+		},
+		{
+			name:  "success with next run until",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				params: &database.ListSubscriptionsParams{
+					Statuses:     []entity.SubscriptionStatus{entity.SubscriptionStatusActive},
+					NextRunUntil: now(),
+				},
+			},
+			want: want{
+				subscriptions: entity.Subscriptions{subscriptions[0]},
+				total:         1,
+				err:           nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &subscription{db: db, now: now}
+			actual, err := db.List(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.subscriptions, actual)
+			total, err := db.Count(ctx, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.total, total)
+		})
+	}
+}
+
+func TestSubscription_Get(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+	err := deleteAll(t.Context())
+	require.NoError(t, err)
+
+	s := testSubscription("subscription-id", entity.SubscriptionStatusActive, now(), now())
+	err = db.DB.Table(subscriptionTable).Create(newInternalSubscription(s)).Error
+	require.NoError(t, err)
+
+	type args struct {
+		subscriptionID string
+	}
+	type want struct {
+		subscription *entity.Subscription
+		err          error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				subscriptionID: "subscription-id",
+			},
+			want: want{
+				subscription: s,
+				err:          nil,
+			},
+		},
+		{
+			name:  "not found",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				subscriptionID: "other-id",
+			},
+			want: want{
+				subscription: nil,
+				err:          database.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			tt.setup(ctx, t, db)
+
+			db := &subscription{db: db, now: now}
+			actual, err := db.Get(ctx, tt.args.subscriptionID)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.subscription, actual)
+		})
+	}
+}
+
+func TestSubscription_Create(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		subscription *entity.Subscription
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name:  "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {},
+			args: args{
+				subscription: testSubscription("subscription-id", entity.SubscriptionStatusActive, now(), now()),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already exists",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSubscription("subscription-id", entity.SubscriptionStatusActive, now(), now())
+				err := db.DB.Table(subscriptionTable).Create(newInternalSubscription(s)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				subscription: testSubscription("subscription-id", entity.SubscriptionStatusActive, now(), now()),
+			},
+			want: want{
+				err: database.ErrAlreadyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &subscription{db: db, now: now}
+			err = db.Create(ctx, tt.args.subscription)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestSubscription_Update(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		subscriptionID string
+		params         *database.UpdateSubscriptionParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSubscription("subscription-id", entity.SubscriptionStatusActive, now(), now())
+				err := db.DB.Table(subscriptionTable).Create(newInternalSubscription(s)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				subscriptionID: "subscription-id",
+				params: &database.UpdateSubscriptionParams{
+					Status:      entity.SubscriptionStatusActive,
+					NextRunAt:   now().AddDate(0, 0, 7),
+					LastOrderID: "order-id",
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &subscription{db: db, now: now}
+			err = db.Update(ctx, tt.args.subscriptionID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func TestSubscription_UpdateNextRunAt(t *testing.T) {
+	db := dbClient
+	now := func() time.Time {
+		return current
+	}
+
+	type args struct {
+		subscriptionID string
+		params         *database.UpdateSubscriptionNextRunAtParams
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		setup func(ctx context.Context, t *testing.T, db *mysql.Client)
+		args  args
+		want  want
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSubscription("subscription-id", entity.SubscriptionStatusActive, now(), now())
+				err := db.DB.Table(subscriptionTable).Create(newInternalSubscription(s)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				subscriptionID: "subscription-id",
+				params: &database.UpdateSubscriptionNextRunAtParams{
+					CurrentNextRunAt: now(),
+					NextRunAt:        now().AddDate(0, 0, 7),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		{
+			name: "already updated",
+			setup: func(ctx context.Context, t *testing.T, db *mysql.Client) {
+				s := testSubscription("subscription-id", entity.SubscriptionStatusActive, now().AddDate(0, 0, 7), now())
+				err := db.DB.Table(subscriptionTable).Create(newInternalSubscription(s)).Error
+				require.NoError(t, err)
+			},
+			args: args{
+				subscriptionID: "subscription-id",
+				params: &database.UpdateSubscriptionNextRunAtParams{
+					CurrentNextRunAt: now(),
+					NextRunAt:        now().AddDate(0, 0, 7),
+				},
+			},
+			want: want{
+				err: database.ErrFailedPrecondition,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			err := deleteAll(ctx)
+			require.NoError(t, err)
+
+			tt.setup(ctx, t, db)
+
+			db := &subscription{db: db, now: now}
+			err = db.UpdateNextRunAt(ctx, tt.args.subscriptionID, tt.args.params)
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
+
+func testSubscription(id string, status entity.SubscriptionStatus, nextRunAt, now time.Time) *entity.Subscription {
+	return &entity.Subscription{
+		ID:                id,
+		UserID:            "user-id",
+		ShopID:            "shop-id",
+		CoordinatorID:     "coordinator-id",
+		Status:            status,
+		Interval:          entity.SubscriptionIntervalWeekly,
+		AnchorDay:         int64(nextRunAt.Day()),
+		Items:             entity.SubscriptionItems{{ProductID: "product-id", Quantity: 1}},
+		AddressRevisionID: 1,
+		ProviderType:      entity.PaymentProviderTypeStripe,
+		CustomerID:        "cus_xxx",
+		PaymentMethodID:   "pm_xxx",
+		NextRunAt:         nextRunAt,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
@@ -16,23 +16,36 @@ func NewDatabase(db *mysql.Client) *database.Database {
 		AiChat:                   NewAiChat(db),
 		CartActionLog:            NewCartActionLog(db),
 		Category:                 NewCategory(db),
+		DeliverySlotRule:         NewDeliverySlotRule(db),
 		Experience:               NewExperience(db),
 		ExperienceReview:         NewExperienceReview(db),
 		ExperienceReviewReaction: NewExperienceReviewReaction(db),
+		ExperienceSlot:           NewExperienceSlot(db),
 		ExperienceType:           NewExperienceType(db),
 		Live:                     NewLive(db),
+		LivePin:                  NewLivePin(db),
 		Order:                    NewOrder(db),
+		OrderClaim:               NewOrderClaim(db),
+		OrderRefundLine:          NewOrderRefundLine(db),
+		PaymentEvent:             NewPaymentEvent(db),
 		PaymentSystem:            NewPaymentSystem(db),
+		PreorderBatch:            NewPreorderBatch(db),
 		Product:                  NewProduct(db),
+		ProductInventoryHold:     NewProductInventoryHold(db),
 		ProductReview:            NewProductReview(db),
 		ProductReviewReaction:    NewProductReviewReaction(db),
 		ProductTag:               NewProductTag(db),
 		ProductType:              NewProductType(db),
 		Promotion:                NewPromotion(db),
+		PromotionCode:            NewPromotionCode(db),
+		PromotionCodeBatch:       NewPromotionCodeBatch(db),
+		PromotionRedemption:      NewPromotionRedemption(db),
 		Schedule:                 NewSchedule(db),
+		SettlementStatement:      NewSettlementStatement(db),
 		Shipping:                 NewShipping(db),
 		Spot:                     NewSpot(db),
 		SpotType:                 NewSpotType(db),
+		Subscription:             NewSubscription(db),
 	}
 }
 
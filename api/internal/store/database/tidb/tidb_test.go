@@ -114,21 +114,28 @@ func schemaDir() (string, error) {
 func deleteAll(ctx context.Context) error {
 	tables := []string{
 		// テストに対応したテーブルから追記(削除順)
+		deliverySlotRuleTable,
 		paymentSystemTable,
+		orderClaimTable,
+		orderRefundLineTable,
 		orderMetadataTable,
 		orderExperienceTable,
 		orderFulfillmentTable,
 		orderPaymentTable,
 		orderItemTable,
 		orderTable,
+		livePinTable,
 		liveProductTable,
 		liveTable,
 		scheduleTable,
+		experienceSlotReservationTable,
+		experienceSlotTable,
 		experienceReviewReactionTable,
 		experienceReviewTable,
 		experienceRevisionTable,
 		experienceTable,
 		experienceTypeTable,
+		productInventoryHoldTable,
 		productReviewReactionTable,
 		productReviewTable,
 		productRevisionTable,
@@ -136,11 +143,18 @@ func deleteAll(ctx context.Context) error {
 		productTagTable,
 		productTypeTable,
 		categoryTable,
+		promotionRedemptionTable,
+		promotionCodeTable,
+		promotionCodeBatchTable,
 		promotionTable,
 		shippingRevisionTable,
 		shippingTable,
 		spotTable,
 		spotTypeTable,
+		preorderBatchTable,
+		settlementStatementTable,
+		paymentEventTable,
+		subscriptionTable,
 		cartActionLogTable,
 	}
 	return delete(ctx, tables...)
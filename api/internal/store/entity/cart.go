@@ -17,10 +17,30 @@ var (
 	errNotFoundProduct          = errors.New("entity: not found product")
 )
 
+// basketSizes - 買い物かごに利用する箱のサイズ（小さい順）
+var basketSizes = []ShippingSize{
+	ShippingSize60,
+	ShippingSize80,
+	ShippingSize100,
+	ShippingSize120,
+	ShippingSize140,
+	ShippingSize160,
+}
+
 var bascketWeightLimits = map[ShippingSize]int64{
 	ShippingSize60:  2e3,  //  2kg =  2,000g
 	ShippingSize80:  5e3,  //  5kg =  5,000g
 	ShippingSize100: 10e3, // 10kg = 10,000g
+	ShippingSize120: 15e3, // 15kg = 15,000g
+	ShippingSize140: 20e3, // 20kg = 20,000g
+	ShippingSize160: 25e3, // 25kg = 25,000g
+}
+
+// largeBoxCapacities - サイズ:100の箱を100%とした場合の、大きい箱の容量
+var largeBoxCapacities = map[ShippingSize]int64{
+	ShippingSize120: 170,
+	ShippingSize140: 270,
+	ShippingSize160: 400,
 }
 
 // Cart - カート情報
@@ -437,43 +457,67 @@ func refreshCart(baskets CartBaskets, products map[string]*Product) (CartBaskets
 
 // pickCartBasket - 箱に詰める商品と詰めない商品を分割
 func pickCartBasket(products Products) (Products, Products) {
+	if len(products) == 0 {
+		return Products{}, Products{}
+	}
+	// 基本はサイズ:100の箱に詰め、先頭の商品がサイズ:100の箱に収まらない場合のみ大きい箱を利用する
+	size := calcBasketSize(products[0])
 	freeRate := int64(100)
-	freeWeight := bascketWeightLimits[ShippingSize100]
+	freeWeight := bascketWeightLimits[size]
 
 	picked := make(Products, 0, len(products)) // 箱に入ったもの
 	rest := make(Products, 0, len(products))   // 箱に入らなかったもの
 	for i, product := range products {
 		// すでにいっぱいの場合、次の箱に詰めるようにする
-		if freeRate == 0 || freeWeight == 0 {
+		if freeRate <= 0 || freeWeight <= 0 {
 			rest = append(rest, products[i:]...)
 			break
 		}
-		weight := product.WeightGram()
+		rate, weight := product.BoxRate(size), product.WeightGram()
+		// 最も大きい箱にも収まらない商品は、単独で1箱とする
+		if len(picked) == 0 && (rate > freeRate || weight > freeWeight) {
+			picked = append(picked, product)
+			freeRate, freeWeight = 0, 0
+			continue
+		}
 		// 箱に入るかの検証
-		if product.Box100Rate > freeRate || weight > freeWeight {
+		if rate > freeRate || weight > freeWeight {
 			rest = append(rest, product)
 			continue
 		}
 		picked = append(picked, product)
-		freeRate -= product.Box100Rate
+		freeRate -= rate
 		freeWeight -= weight
 	}
 
 	return picked, rest
 }
 
-// calcShippingSize - 買い物かごの占有率を見て、かごの大きさを決定
+// calcBasketSize - 商品を詰める箱のサイズを決定（サイズ:100以上で、商品が収まる最小の箱）
+func calcBasketSize(product *Product) ShippingSize {
+	weight := product.WeightGram()
+	for _, size := range basketSizes {
+		if size < ShippingSize100 {
+			continue
+		}
+		if weight <= bascketWeightLimits[size] && product.BoxRate(size) <= 100 {
+			return size
+		}
+	}
+	return basketSizes[len(basketSizes)-1]
+}
+
+// calcShippingSize - 買い物かごの重量と占有率を見て、かごの大きさを決定
 func calcShippingSize(products Products) (ShippingSize, int64) {
 	weight := products.WeightGram()
-	if weight <= bascketWeightLimits[ShippingSize60] {
-		if rate := products.Box60Rate(); rate <= 100 {
-			return ShippingSize60, rate
+	for _, size := range basketSizes {
+		if weight > bascketWeightLimits[size] {
+			continue
 		}
-	}
-	if weight <= bascketWeightLimits[ShippingSize80] {
-		if rate := products.Box80Rate(); rate <= 100 {
-			return ShippingSize80, rate
+		if rate := products.BoxRate(size); rate <= 100 {
+			return size, rate
 		}
 	}
-	return ShippingSize100, products.Box100Rate()
+	maxSize := basketSizes[len(basketSizes)-1]
+	return maxSize, products.BoxRate(maxSize)
 }
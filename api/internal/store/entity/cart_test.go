@@ -2156,6 +2156,148 @@ func TestRefreshCart(t *testing.T) {
 			},
 			hasErr: false,
 		},
+		{
+			name: "success 箱のサイズ120 重量超過の商品",
+			baskets: CartBaskets{
+				{
+					BoxNumber: 1,
+					BoxType:   ShippingTypeNormal,
+					BoxSize:   ShippingSize100,
+					Items: CartItems{
+						{ProductID: "product-id", Quantity: 2},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+			},
+			products: map[string]*Product{
+				"product-id": {
+					ID:            "product-id",
+					CoordinatorID: "coordinator-id",
+					DeliveryType:  DeliveryTypeNormal,
+					Inventory:     30,
+					Weight:        12,
+					WeightUnit:    WeightUnitKilogram,
+					Box60Rate:     600,
+					Box80Rate:     250,
+					Box100Rate:    80,
+				},
+			},
+			expect: CartBaskets{
+				{
+					BoxNumber: 1,
+					BoxType:   ShippingTypeNormal,
+					BoxSize:   ShippingSize120,
+					BoxRate:   48,
+					Items: CartItems{
+						{
+							ProductID: "product-id",
+							Quantity:  1,
+						},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+				{
+					BoxNumber: 2,
+					BoxType:   ShippingTypeNormal,
+					BoxSize:   ShippingSize120,
+					BoxRate:   48,
+					Items: CartItems{
+						{
+							ProductID: "product-id",
+							Quantity:  1,
+						},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+			},
+			hasErr: false,
+		},
+		{
+			name: "success 箱のサイズ140 大型の商品",
+			baskets: CartBaskets{
+				{
+					BoxNumber: 1,
+					BoxType:   ShippingTypeNormal,
+					BoxSize:   ShippingSize100,
+					Items: CartItems{
+						{ProductID: "product-id", Quantity: 1},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+			},
+			products: map[string]*Product{
+				"product-id": {
+					ID:            "product-id",
+					CoordinatorID: "coordinator-id",
+					DeliveryType:  DeliveryTypeNormal,
+					Inventory:     30,
+					Weight:        3,
+					WeightUnit:    WeightUnitKilogram,
+					Box60Rate:     1200,
+					Box80Rate:     500,
+					Box100Rate:    200,
+				},
+			},
+			expect: CartBaskets{
+				{
+					BoxNumber: 1,
+					BoxType:   ShippingTypeNormal,
+					BoxSize:   ShippingSize140,
+					BoxRate:   75,
+					Items: CartItems{
+						{
+							ProductID: "product-id",
+							Quantity:  1,
+						},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+			},
+			hasErr: false,
+		},
+		{
+			name: "success 箱のサイズ160 最大サイズ超過",
+			baskets: CartBaskets{
+				{
+					BoxNumber: 1,
+					BoxType:   ShippingTypeNormal,
+					BoxSize:   ShippingSize100,
+					Items: CartItems{
+						{ProductID: "product-id", Quantity: 1},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+			},
+			products: map[string]*Product{
+				"product-id": {
+					ID:            "product-id",
+					CoordinatorID: "coordinator-id",
+					DeliveryType:  DeliveryTypeNormal,
+					Inventory:     30,
+					Weight:        30,
+					WeightUnit:    WeightUnitKilogram,
+					Box60Rate:     600,
+					Box80Rate:     250,
+					Box100Rate:    100,
+				},
+			},
+			expect: CartBaskets{
+				{
+					BoxNumber: 1,
+					BoxType:   ShippingTypeNormal,
+					BoxSize:   ShippingSize160,
+					BoxRate:   25,
+					Items: CartItems{
+						{
+							ProductID: "product-id",
+							Quantity:  1,
+						},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+			},
+			hasErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
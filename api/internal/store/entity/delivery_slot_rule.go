@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+var (
+	ErrInvalidDeliverySlotRule = errors.New("entity: invalid delivery slot rule")
+	ErrDeliverySlotUnavailable = errors.New("entity: delivery slot is unavailable")
+)
+
+// DeliveryTimeWindow - お届け希望時間帯
+type DeliveryTimeWindow int32
+
+const (
+	DeliveryTimeWindowUnspecified DeliveryTimeWindow = 0 // 指定なし
+	DeliveryTimeWindowMorning     DeliveryTimeWindow = 1 // 午前中
+	DeliveryTimeWindow1214        DeliveryTimeWindow = 2 // 12時〜14時
+	DeliveryTimeWindow1416        DeliveryTimeWindow = 3 // 14時〜16時
+	DeliveryTimeWindow1618        DeliveryTimeWindow = 4 // 16時〜18時
+	DeliveryTimeWindow1820        DeliveryTimeWindow = 5 // 18時〜20時
+	DeliveryTimeWindow1921        DeliveryTimeWindow = 6 // 19時〜21時
+)
+
+// DeliverySlotRule - 配送日時指定ルール
+type DeliverySlotRule struct {
+	ID             string               `gorm:"primaryKey;<-:create"` // 配送日時指定ルールID
+	ShopID         string               `gorm:""`                     // 店舗ID
+	CoordinatorID  string               `gorm:""`                     // コーディネータID
+	ShippingType   ShippingType         `gorm:""`                     // 配送方法
+	LeadDays       int64                `gorm:""`                     // 最短お届け日までの日数
+	SelectableDays int64                `gorm:""`                     // お届け日の選択可能日数
+	BlackoutDates  []string             `gorm:"-"`                    // お届け不可日一覧(YYYYMMDD)
+	TimeWindows    []DeliveryTimeWindow `gorm:"-"`                    // 指定可能な時間帯一覧
+	CreatedAt      time.Time            `gorm:"<-:create"`            // 登録日時
+	UpdatedAt      time.Time            `gorm:""`                     // 更新日時
+}
+
+type DeliverySlotRules []*DeliverySlotRule
+
+type NewDeliverySlotRuleParams struct {
+	ShopID         string
+	CoordinatorID  string
+	ShippingType   ShippingType
+	LeadDays       int64
+	SelectableDays int64
+	BlackoutDates  []string
+	TimeWindows    []DeliveryTimeWindow
+}
+
+func (w DeliveryTimeWindow) String() string {
+	switch w {
+	case DeliveryTimeWindowMorning:
+		return "午前中"
+	case DeliveryTimeWindow1214:
+		return "12時〜14時"
+	case DeliveryTimeWindow1416:
+		return "14時〜16時"
+	case DeliveryTimeWindow1618:
+		return "16時〜18時"
+	case DeliveryTimeWindow1820:
+		return "18時〜20時"
+	case DeliveryTimeWindow1921:
+		return "19時〜21時"
+	default:
+		return "指定なし"
+	}
+}
+
+func NewDeliverySlotRule(params *NewDeliverySlotRuleParams) (*DeliverySlotRule, error) {
+	rule := &DeliverySlotRule{
+		ID:             uuid.Base58Encode(uuid.New()),
+		ShopID:         params.ShopID,
+		CoordinatorID:  params.CoordinatorID,
+		ShippingType:   params.ShippingType,
+		LeadDays:       params.LeadDays,
+		SelectableDays: params.SelectableDays,
+		BlackoutDates:  params.BlackoutDates,
+		TimeWindows:    params.TimeWindows,
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *DeliverySlotRule) Validate() error {
+	if r.ShippingType == ShippingTypePickup {
+		return fmt.Errorf("%w: pickup cannot specify delivery slot", ErrInvalidDeliverySlotRule)
+	}
+	for _, date := range r.BlackoutDates {
+		if _, err := jst.ParseFromYYYYMMDD(date); err != nil {
+			return fmt.Errorf("%w: invalid blackout date: %s", ErrInvalidDeliverySlotRule, date)
+		}
+	}
+	for _, window := range r.TimeWindows {
+		if window <= DeliveryTimeWindowUnspecified || window > DeliveryTimeWindow1921 {
+			return fmt.Errorf("%w: unknown time window: %d", ErrInvalidDeliverySlotRule, window)
+		}
+	}
+	return nil
+}
+
+// EarliestDate - 最短のお届け日
+func (r *DeliverySlotRule) EarliestDate(now time.Time) time.Time {
+	return jst.BeginningOfDay(now).AddDate(0, 0, int(r.LeadDays))
+}
+
+// LatestDate - 最長のお届け日（選択可能日数が未設定の場合は上限なし）
+func (r *DeliverySlotRule) LatestDate(now time.Time) time.Time {
+	if r.SelectableDays <= 0 {
+		return time.Time{}
+	}
+	return r.EarliestDate(now).AddDate(0, 0, int(r.SelectableDays)-1)
+}
+
+// Verify - お届け希望日時が指定可能かを検証する
+func (r *DeliverySlotRule) Verify(date time.Time, window DeliveryTimeWindow, now time.Time) error {
+	if !date.IsZero() {
+		date = jst.BeginningOfDay(date)
+		if date.Before(r.EarliestDate(now)) {
+			return fmt.Errorf("%w: delivery date is too early", ErrDeliverySlotUnavailable)
+		}
+		if latest := r.LatestDate(now); !latest.IsZero() && date.After(latest) {
+			return fmt.Errorf("%w: delivery date is too late", ErrDeliverySlotUnavailable)
+		}
+		if slices.Contains(r.BlackoutDates, jst.FormatYYYYMMDD(date)) {
+			return fmt.Errorf("%w: delivery date is blackout", ErrDeliverySlotUnavailable)
+		}
+	}
+	if window != DeliveryTimeWindowUnspecified && !slices.Contains(r.TimeWindows, window) {
+		return fmt.Errorf("%w: time window is not allowed", ErrDeliverySlotUnavailable)
+	}
+	return nil
+}
+
+func (rs DeliverySlotRules) MapByShippingType() map[ShippingType]*DeliverySlotRule {
+	res := make(map[ShippingType]*DeliverySlotRule, len(rs))
+	for _, r := range rs {
+		res[r.ShippingType] = r
+	}
+	return res
+}
@@ -0,0 +1,210 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryTimeWindow_String(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		window DeliveryTimeWindow
+		expect string
+	}{
+		{name: "morning", window: DeliveryTimeWindowMorning, expect: "午前中"},
+		{name: "12-14", window: DeliveryTimeWindow1214, expect: "12時〜14時"},
+		{name: "14-16", window: DeliveryTimeWindow1416, expect: "14時〜16時"},
+		{name: "16-18", window: DeliveryTimeWindow1618, expect: "16時〜18時"},
+		{name: "18-20", window: DeliveryTimeWindow1820, expect: "18時〜20時"},
+		{name: "19-21", window: DeliveryTimeWindow1921, expect: "19時〜21時"},
+		{name: "unspecified", window: DeliveryTimeWindowUnspecified, expect: "指定なし"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.window.String())
+		})
+	}
+}
+
+func TestDeliverySlotRule(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		params    *NewDeliverySlotRuleParams
+		expect    *DeliverySlotRule
+		expectErr error
+	}{
+		{
+			name: "success",
+			params: &NewDeliverySlotRuleParams{
+				ShopID:         "shop-id",
+				CoordinatorID:  "coordinator-id",
+				ShippingType:   ShippingTypeFrozen,
+				LeadDays:       3,
+				SelectableDays: 14,
+				BlackoutDates:  []string{"20261231"},
+				TimeWindows:    []DeliveryTimeWindow{DeliveryTimeWindowMorning, DeliveryTimeWindow1416},
+			},
+			expect: &DeliverySlotRule{
+				ShopID:         "shop-id",
+				CoordinatorID:  "coordinator-id",
+				ShippingType:   ShippingTypeFrozen,
+				LeadDays:       3,
+				SelectableDays: 14,
+				BlackoutDates:  []string{"20261231"},
+				TimeWindows:    []DeliveryTimeWindow{DeliveryTimeWindowMorning, DeliveryTimeWindow1416},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "pickup",
+			params: &NewDeliverySlotRuleParams{
+				ShopID:       "shop-id",
+				ShippingType: ShippingTypePickup,
+			},
+			expect:    nil,
+			expectErr: ErrInvalidDeliverySlotRule,
+		},
+		{
+			name: "invalid blackout date",
+			params: &NewDeliverySlotRuleParams{
+				ShopID:        "shop-id",
+				ShippingType:  ShippingTypeNormal,
+				BlackoutDates: []string{"2026-12-31"},
+			},
+			expect:    nil,
+			expectErr: ErrInvalidDeliverySlotRule,
+		},
+		{
+			name: "unknown time window",
+			params: &NewDeliverySlotRuleParams{
+				ShopID:       "shop-id",
+				ShippingType: ShippingTypeNormal,
+				TimeWindows:  []DeliveryTimeWindow{DeliveryTimeWindowUnspecified},
+			},
+			expect:    nil,
+			expectErr: ErrInvalidDeliverySlotRule,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewDeliverySlotRule(tt.params)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if tt.expect == nil {
+				assert.Nil(t, actual)
+				return
+			}
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestDeliverySlotRule_Verify(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	rule := &DeliverySlotRule{
+		ShippingType:   ShippingTypeFrozen,
+		LeadDays:       3,
+		SelectableDays: 7,
+		BlackoutDates:  []string{"20261022"},
+		TimeWindows:    []DeliveryTimeWindow{DeliveryTimeWindowMorning, DeliveryTimeWindow1416},
+	}
+	tests := []struct {
+		name      string
+		rule      *DeliverySlotRule
+		date      string
+		window    DeliveryTimeWindow
+		expectErr error
+	}{
+		{
+			name:      "success earliest date",
+			rule:      rule,
+			date:      "20261021",
+			window:    DeliveryTimeWindowMorning,
+			expectErr: nil,
+		},
+		{
+			name:      "success latest date",
+			rule:      rule,
+			date:      "20261027",
+			window:    DeliveryTimeWindowUnspecified,
+			expectErr: nil,
+		},
+		{
+			name:      "success only time window",
+			rule:      rule,
+			date:      "",
+			window:    DeliveryTimeWindow1416,
+			expectErr: nil,
+		},
+		{
+			name:      "success unlimited selectable days",
+			rule:      &DeliverySlotRule{LeadDays: 1},
+			date:      "20270101",
+			window:    DeliveryTimeWindowUnspecified,
+			expectErr: nil,
+		},
+		{
+			name:      "too early",
+			rule:      rule,
+			date:      "20261020",
+			window:    DeliveryTimeWindowUnspecified,
+			expectErr: ErrDeliverySlotUnavailable,
+		},
+		{
+			name:      "too late",
+			rule:      rule,
+			date:      "20261028",
+			window:    DeliveryTimeWindowUnspecified,
+			expectErr: ErrDeliverySlotUnavailable,
+		},
+		{
+			name:      "blackout date",
+			rule:      rule,
+			date:      "20261022",
+			window:    DeliveryTimeWindowUnspecified,
+			expectErr: ErrDeliverySlotUnavailable,
+		},
+		{
+			name:      "time window is not allowed",
+			rule:      rule,
+			date:      "20261023",
+			window:    DeliveryTimeWindow1921,
+			expectErr: ErrDeliverySlotUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var date time.Time
+			if tt.date != "" {
+				var err error
+				date, err = jst.ParseFromYYYYMMDD(tt.date)
+				require.NoError(t, err)
+			}
+			err := tt.rule.Verify(date, tt.window, now)
+			assert.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
+
+func TestDeliverySlotRules_MapByShippingType(t *testing.T) {
+	t.Parallel()
+	rules := DeliverySlotRules{
+		{ID: "rule-id01", ShippingType: ShippingTypeNormal},
+		{ID: "rule-id02", ShippingType: ShippingTypeFrozen},
+	}
+	expect := map[ShippingType]*DeliverySlotRule{
+		ShippingTypeNormal: {ID: "rule-id01", ShippingType: ShippingTypeNormal},
+		ShippingTypeFrozen: {ID: "rule-id02", ShippingType: ShippingTypeFrozen},
+	}
+	assert.Equal(t, expect, rules.MapByShippingType())
+}
@@ -0,0 +1,147 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidExperienceSlotCapacity  = errors.New("entity: invalid experience slot capacity")
+	ErrInvalidExperienceSlotTime      = errors.New("entity: invalid experience slot time")
+	ErrUnmatchExperienceSlot          = errors.New("entity: unmatch experience slot")
+	ErrExperienceSlotNotAccepting     = errors.New("entity: experience slot is not accepting")
+	ErrInsufficientExperienceCapacity = errors.New("entity: insufficient experience capacity")
+)
+
+// ExperienceSlotStatus - 体験枠の受付状況
+type ExperienceSlotStatus int32
+
+const (
+	ExperienceSlotStatusUnknown   ExperienceSlotStatus = 0
+	ExperienceSlotStatusAccepting ExperienceSlotStatus = 1 // 受付中
+	ExperienceSlotStatusSoldOut   ExperienceSlotStatus = 2 // 満席
+	ExperienceSlotStatusFinished  ExperienceSlotStatus = 3 // 受付終了
+)
+
+// ExperienceSlot - 体験枠情報
+type ExperienceSlot struct {
+	ID           string               `gorm:"primaryKey;<-:create"` // 体験枠ID
+	ExperienceID string               `gorm:""`                     // 体験ID
+	Status       ExperienceSlotStatus `gorm:"-"`                    // 受付状況
+	Capacity     int64                `gorm:""`                     // 定員
+	Reserved     int64                `gorm:""`                     // 予約済み人数(仮押さえを含む)
+	StartAt      time.Time            `gorm:""`                     // 開始日時
+	EndAt        time.Time            `gorm:""`                     // 終了日時
+	CreatedAt    time.Time            `gorm:"<-:create"`            // 登録日時
+	UpdatedAt    time.Time            `gorm:""`                     // 更新日時
+	DeletedAt    gorm.DeletedAt       `gorm:"default:null"`         // 削除日時
+}
+
+type ExperienceSlots []*ExperienceSlot
+
+type NewExperienceSlotParams struct {
+	ExperienceID string
+	Capacity     int64
+	StartAt      time.Time
+	EndAt        time.Time
+}
+
+func NewExperienceSlot(params *NewExperienceSlotParams) (*ExperienceSlot, error) {
+	slot := &ExperienceSlot{
+		ID:           uuid.Base58Encode(uuid.New()),
+		ExperienceID: params.ExperienceID,
+		Capacity:     params.Capacity,
+		Reserved:     0,
+		StartAt:      params.StartAt,
+		EndAt:        params.EndAt,
+	}
+	if err := slot.Validate(); err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
+
+func (s *ExperienceSlot) Validate() error {
+	if s.Capacity <= 0 {
+		return ErrInvalidExperienceSlotCapacity
+	}
+	if !s.StartAt.Before(s.EndAt) {
+		return ErrInvalidExperienceSlotTime
+	}
+	return nil
+}
+
+// Remaining - 残席数
+func (s *ExperienceSlot) Remaining() int64 {
+	if s == nil || s.Capacity <= s.Reserved {
+		return 0
+	}
+	return s.Capacity - s.Reserved
+}
+
+func (s *ExperienceSlot) SetStatus(now time.Time) {
+	switch {
+	case !now.Before(s.StartAt):
+		s.Status = ExperienceSlotStatusFinished
+	case s.Remaining() == 0:
+		s.Status = ExperienceSlotStatusSoldOut
+	default:
+		s.Status = ExperienceSlotStatusAccepting
+	}
+}
+
+// Reservable - 指定人数分の予約が可能かの検証
+func (s *ExperienceSlot) Reservable(experienceID string, quantity int64) error {
+	if s.ExperienceID != experienceID {
+		return ErrUnmatchExperienceSlot
+	}
+	if s.Status != ExperienceSlotStatusAccepting {
+		return ErrExperienceSlotNotAccepting
+	}
+	if quantity > s.Remaining() {
+		return ErrInsufficientExperienceCapacity
+	}
+	return nil
+}
+
+func (ss ExperienceSlots) IDs() []string {
+	return set.UniqBy(ss, func(s *ExperienceSlot) string {
+		return s.ID
+	})
+}
+
+func (ss ExperienceSlots) Fill(now time.Time) {
+	for _, s := range ss {
+		s.SetStatus(now)
+	}
+}
+
+// FilterByAccepting - 受付中の体験枠のみ抽出
+func (ss ExperienceSlots) FilterByAccepting() ExperienceSlots {
+	res := make(ExperienceSlots, 0, len(ss))
+	for _, s := range ss {
+		if s.Status != ExperienceSlotStatusAccepting {
+			continue
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+// SoldOut - 開催予定の体験枠がすべて満席かの判定（開催予定の体験枠がない場合はfalse）
+func (ss ExperienceSlots) SoldOut() bool {
+	var exists bool
+	for _, s := range ss {
+		switch s.Status {
+		case ExperienceSlotStatusAccepting:
+			return false
+		case ExperienceSlotStatusSoldOut:
+			exists = true
+		}
+	}
+	return exists
+}
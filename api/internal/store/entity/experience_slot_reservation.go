@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+)
+
+// ExperienceSlotReservationStatus - 体験枠の予約状況
+type ExperienceSlotReservationStatus int32
+
+const (
+	ExperienceSlotReservationStatusUnknown   ExperienceSlotReservationStatus = 0
+	ExperienceSlotReservationStatusHeld      ExperienceSlotReservationStatus = 1 // 仮押さえ
+	ExperienceSlotReservationStatusConfirmed ExperienceSlotReservationStatus = 2 // 予約確定
+	ExperienceSlotReservationStatusReleased  ExperienceSlotReservationStatus = 3 // 解放済み
+)
+
+// ExperienceSlotReservation - 体験枠の予約情報
+type ExperienceSlotReservation struct {
+	OrderID   string                          `gorm:"primaryKey;<-:create"`   // 注文履歴ID
+	SlotID    string                          `gorm:"<-:create"`              // 体験枠ID
+	Quantity  int64                           `gorm:"<-:create"`              // 予約人数
+	Status    ExperienceSlotReservationStatus `gorm:""`                       // 予約状況
+	ExpiredAt time.Time                       `gorm:"<-:create;default:null"` // 仮押さえ期限
+	CreatedAt time.Time                       `gorm:"<-:create"`              // 登録日時
+	UpdatedAt time.Time                       `gorm:""`                       // 更新日時
+}
+
+type ExperienceSlotReservations []*ExperienceSlotReservation
+
+type NewExperienceSlotReservationParams struct {
+	OrderID   string
+	SlotID    string
+	Quantity  int64
+	ExpiredAt time.Time
+}
+
+func NewExperienceSlotReservation(params *NewExperienceSlotReservationParams) *ExperienceSlotReservation {
+	return &ExperienceSlotReservation{
+		OrderID:   params.OrderID,
+		SlotID:    params.SlotID,
+		Quantity:  params.Quantity,
+		Status:    ExperienceSlotReservationStatusHeld,
+		ExpiredAt: params.ExpiredAt,
+	}
+}
+
+// Releasable - 予約枠の解放が可能か
+func (r *ExperienceSlotReservation) Releasable() bool {
+	if r == nil {
+		return false
+	}
+	return r.Status == ExperienceSlotReservationStatusHeld || r.Status == ExperienceSlotReservationStatusConfirmed
+}
+
+func (rs ExperienceSlotReservations) OrderIDs() []string {
+	return set.UniqBy(rs, func(r *ExperienceSlotReservation) string {
+		return r.OrderID
+	})
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExperienceSlotReservation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params *NewExperienceSlotReservationParams
+		expect *ExperienceSlotReservation
+	}{
+		{
+			name: "success",
+			params: &NewExperienceSlotReservationParams{
+				OrderID:   "order-id",
+				SlotID:    "slot-id",
+				Quantity:  3,
+				ExpiredAt: jst.Date(2026, 10, 18, 12, 30, 0, 0),
+			},
+			expect: &ExperienceSlotReservation{
+				OrderID:   "order-id",
+				SlotID:    "slot-id",
+				Quantity:  3,
+				Status:    ExperienceSlotReservationStatusHeld,
+				ExpiredAt: jst.Date(2026, 10, 18, 12, 30, 0, 0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewExperienceSlotReservation(tt.params)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestExperienceSlotReservation_Releasable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		reservation *ExperienceSlotReservation
+		expect      bool
+	}{
+		{
+			name:        "held",
+			reservation: &ExperienceSlotReservation{Status: ExperienceSlotReservationStatusHeld},
+			expect:      true,
+		},
+		{
+			name:        "confirmed",
+			reservation: &ExperienceSlotReservation{Status: ExperienceSlotReservationStatusConfirmed},
+			expect:      true,
+		},
+		{
+			name:        "released",
+			reservation: &ExperienceSlotReservation{Status: ExperienceSlotReservationStatusReleased},
+			expect:      false,
+		},
+		{
+			name:        "empty",
+			reservation: nil,
+			expect:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.reservation.Releasable())
+		})
+	}
+}
+
+func TestExperienceSlotReservations_OrderIDs(t *testing.T) {
+	t.Parallel()
+	reservations := ExperienceSlotReservations{
+		{OrderID: "order-id01", SlotID: "slot-id01"},
+		{OrderID: "order-id02", SlotID: "slot-id01"},
+		{OrderID: "order-id01", SlotID: "slot-id02"},
+	}
+	assert.ElementsMatch(t, []string{"order-id01", "order-id02"}, reservations.OrderIDs())
+}
@@ -0,0 +1,279 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExperienceSlot(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+
+	tests := []struct {
+		name   string
+		params *NewExperienceSlotParams
+		expect *ExperienceSlot
+		hasErr bool
+	}{
+		{
+			name: "success",
+			params: &NewExperienceSlotParams{
+				ExperienceID: "experience-id",
+				Capacity:     10,
+				StartAt:      now,
+				EndAt:        now.Add(2 * time.Hour),
+			},
+			expect: &ExperienceSlot{
+				ExperienceID: "experience-id",
+				Capacity:     10,
+				Reserved:     0,
+				StartAt:      now,
+				EndAt:        now.Add(2 * time.Hour),
+			},
+			hasErr: false,
+		},
+		{
+			name: "invalid capacity",
+			params: &NewExperienceSlotParams{
+				ExperienceID: "experience-id",
+				Capacity:     0,
+				StartAt:      now,
+				EndAt:        now.Add(2 * time.Hour),
+			},
+			expect: nil,
+			hasErr: true,
+		},
+		{
+			name: "invalid time",
+			params: &NewExperienceSlotParams{
+				ExperienceID: "experience-id",
+				Capacity:     10,
+				StartAt:      now,
+				EndAt:        now,
+			},
+			expect: nil,
+			hasErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewExperienceSlot(tt.params)
+			if tt.hasErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestExperienceSlot_Remaining(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		slot   *ExperienceSlot
+		expect int64
+	}{
+		{
+			name:   "remaining",
+			slot:   &ExperienceSlot{Capacity: 10, Reserved: 3},
+			expect: 7,
+		},
+		{
+			name:   "full",
+			slot:   &ExperienceSlot{Capacity: 10, Reserved: 10},
+			expect: 0,
+		},
+		{
+			name:   "over capacity",
+			slot:   &ExperienceSlot{Capacity: 5, Reserved: 10},
+			expect: 0,
+		},
+		{
+			name:   "empty",
+			slot:   nil,
+			expect: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.slot.Remaining())
+		})
+	}
+}
+
+func TestExperienceSlot_SetStatus(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+
+	tests := []struct {
+		name   string
+		slot   *ExperienceSlot
+		expect ExperienceSlotStatus
+	}{
+		{
+			name:   "accepting",
+			slot:   &ExperienceSlot{Capacity: 10, Reserved: 3, StartAt: now.Add(time.Hour)},
+			expect: ExperienceSlotStatusAccepting,
+		},
+		{
+			name:   "sold out",
+			slot:   &ExperienceSlot{Capacity: 10, Reserved: 10, StartAt: now.Add(time.Hour)},
+			expect: ExperienceSlotStatusSoldOut,
+		},
+		{
+			name:   "finished",
+			slot:   &ExperienceSlot{Capacity: 10, Reserved: 3, StartAt: now},
+			expect: ExperienceSlotStatusFinished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.slot.SetStatus(now)
+			assert.Equal(t, tt.expect, tt.slot.Status)
+		})
+	}
+}
+
+func TestExperienceSlot_Reservable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		slot         *ExperienceSlot
+		experienceID string
+		quantity     int64
+		expect       error
+	}{
+		{
+			name: "reservable",
+			slot: &ExperienceSlot{
+				ExperienceID: "experience-id",
+				Status:       ExperienceSlotStatusAccepting,
+				Capacity:     10,
+				Reserved:     7,
+			},
+			experienceID: "experience-id",
+			quantity:     3,
+			expect:       nil,
+		},
+		{
+			name: "unmatch experience",
+			slot: &ExperienceSlot{
+				ExperienceID: "other-id",
+				Status:       ExperienceSlotStatusAccepting,
+				Capacity:     10,
+				Reserved:     0,
+			},
+			experienceID: "experience-id",
+			quantity:     1,
+			expect:       ErrUnmatchExperienceSlot,
+		},
+		{
+			name: "not accepting",
+			slot: &ExperienceSlot{
+				ExperienceID: "experience-id",
+				Status:       ExperienceSlotStatusFinished,
+				Capacity:     10,
+				Reserved:     0,
+			},
+			experienceID: "experience-id",
+			quantity:     1,
+			expect:       ErrExperienceSlotNotAccepting,
+		},
+		{
+			name: "insufficient capacity",
+			slot: &ExperienceSlot{
+				ExperienceID: "experience-id",
+				Status:       ExperienceSlotStatusAccepting,
+				Capacity:     10,
+				Reserved:     8,
+			},
+			experienceID: "experience-id",
+			quantity:     3,
+			expect:       ErrInsufficientExperienceCapacity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.slot.Reservable(tt.experienceID, tt.quantity)
+			assert.ErrorIs(t, err, tt.expect)
+		})
+	}
+}
+
+func TestExperienceSlots(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+
+	tests := []struct {
+		name          string
+		slots         ExperienceSlots
+		expectIDs     []string
+		expectAccepts ExperienceSlots
+		expectSoldOut bool
+	}{
+		{
+			name: "has accepting",
+			slots: ExperienceSlots{
+				{ID: "slot-id01", Capacity: 10, Reserved: 10, StartAt: now.Add(time.Hour)},
+				{ID: "slot-id02", Capacity: 10, Reserved: 0, StartAt: now.Add(time.Hour)},
+			},
+			expectIDs: []string{"slot-id01", "slot-id02"},
+			expectAccepts: ExperienceSlots{
+				{
+					ID:       "slot-id02",
+					Status:   ExperienceSlotStatusAccepting,
+					Capacity: 10,
+					Reserved: 0,
+					StartAt:  now.Add(time.Hour),
+				},
+			},
+			expectSoldOut: false,
+		},
+		{
+			name: "all sold out",
+			slots: ExperienceSlots{
+				{ID: "slot-id01", Capacity: 10, Reserved: 10, StartAt: now.Add(time.Hour)},
+				{ID: "slot-id02", Capacity: 10, Reserved: 0, StartAt: now.Add(-time.Hour)},
+			},
+			expectIDs:     []string{"slot-id01", "slot-id02"},
+			expectAccepts: ExperienceSlots{},
+			expectSoldOut: true,
+		},
+		{
+			name:          "empty",
+			slots:         ExperienceSlots{},
+			expectIDs:     []string{},
+			expectAccepts: ExperienceSlots{},
+			expectSoldOut: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.slots.Fill(now)
+			assert.ElementsMatch(t, tt.expectIDs, tt.slots.IDs())
+			assert.Equal(t, tt.expectAccepts, tt.slots.FilterByAccepting())
+			assert.Equal(t, tt.expectSoldOut, tt.slots.SoldOut())
+		})
+	}
+}
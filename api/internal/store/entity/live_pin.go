@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+// LivePin - ライブ配信中の商品ピン留め情報
+type LivePin struct {
+	ID             string    `gorm:"primaryKey;<-:create"` // ピン留めID
+	ScheduleID     string    `gorm:"<-:create"`            // 開催スケジュールID
+	ProductID      string    `gorm:"<-:create"`            // 商品ID
+	AdminID        string    `gorm:"<-:create"`            // ピン留めした管理者ID
+	PinnedAt       time.Time `gorm:"<-:create"`            // ピン留め日時
+	PinnedOffset   int64     `gorm:"<-:create"`            // ピン留め位置(配信開始(実績)からの経過秒数)
+	UnpinnedAt     time.Time `gorm:"default:null"`         // ピン留め解除日時
+	UnpinnedOffset int64     `gorm:""`                     // ピン留め解除位置(配信開始(実績)からの経過秒数)
+	CreatedAt      time.Time `gorm:"<-:create"`            // 登録日時
+	UpdatedAt      time.Time `gorm:""`                     // 更新日時
+}
+
+type LivePins []*LivePin
+
+type NewLivePinParams struct {
+	Schedule  *Schedule
+	ProductID string
+	AdminID   string
+	StartedAt time.Time // 配信開始日時(実績)
+	PinnedAt  time.Time
+}
+
+func NewLivePin(params *NewLivePinParams) *LivePin {
+	return &LivePin{
+		ID:           uuid.Base58Encode(uuid.New()),
+		ScheduleID:   params.Schedule.ID,
+		ProductID:    params.ProductID,
+		AdminID:      params.AdminID,
+		PinnedAt:     params.PinnedAt,
+		PinnedOffset: newLivePinOffset(params.StartedAt, params.PinnedAt),
+	}
+}
+
+// newLivePinOffset - 配信開始(実績)からの経過秒数（配信開始前は0とする）
+func newLivePinOffset(startedAt, target time.Time) int64 {
+	if startedAt.IsZero() || target.Before(startedAt) {
+		return 0
+	}
+	return int64(target.Sub(startedAt) / time.Second)
+}
+
+// Pinned - ピン留め中か
+func (p *LivePin) Pinned() bool {
+	return p.UnpinnedAt.IsZero()
+}
+
+// Unpin - ピン留めを解除する
+func (p *LivePin) Unpin(unpinnedAt, startedAt time.Time) {
+	if unpinnedAt.Before(p.PinnedAt) {
+		unpinnedAt = p.PinnedAt
+	}
+	p.UnpinnedAt = unpinnedAt
+	p.UnpinnedOffset = max(p.PinnedOffset, newLivePinOffset(startedAt, unpinnedAt))
+}
+
+// SalesPeriod - 売上を紐付ける期間（ピン留め中の場合は指定日時まで、配信終了後の売上は含めない）
+func (p *LivePin) SalesPeriod(now, endedAt time.Time) (time.Time, time.Time) {
+	endAt := now
+	if !p.Pinned() {
+		endAt = p.UnpinnedAt
+	}
+	if !endedAt.IsZero() && endedAt.Before(endAt) {
+		endAt = endedAt
+	}
+	if endAt.Before(p.PinnedAt) {
+		endAt = p.PinnedAt
+	}
+	return p.PinnedAt, endAt
+}
+
+func (ps LivePins) ProductIDs() []string {
+	return set.UniqBy(ps, func(p *LivePin) string {
+		return p.ProductID
+	})
+}
+
+// Pinned - ピン留め中の商品一覧
+func (ps LivePins) Pinned() LivePins {
+	res := make(LivePins, 0, len(ps))
+	for _, p := range ps {
+		if p.Pinned() {
+			res = append(res, p)
+		}
+	}
+	return res
+}
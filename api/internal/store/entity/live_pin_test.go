@@ -0,0 +1,195 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLivePin(t *testing.T) {
+	t.Parallel()
+	startAt := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	tests := []struct {
+		name   string
+		params *NewLivePinParams
+		expect *LivePin
+	}{
+		{
+			name: "success",
+			params: &NewLivePinParams{
+				Schedule:  &Schedule{ID: "schedule-id", StartAt: startAt},
+				ProductID: "product-id",
+				AdminID:   "admin-id",
+				StartedAt: startAt.Add(30 * time.Second),
+				PinnedAt:  startAt.Add(90 * time.Second),
+			},
+			expect: &LivePin{
+				ScheduleID:   "schedule-id",
+				ProductID:    "product-id",
+				AdminID:      "admin-id",
+				PinnedAt:     startAt.Add(90 * time.Second),
+				PinnedOffset: 60,
+			},
+		},
+		{
+			name: "before start",
+			params: &NewLivePinParams{
+				Schedule:  &Schedule{ID: "schedule-id", StartAt: startAt},
+				ProductID: "product-id",
+				AdminID:   "admin-id",
+				StartedAt: startAt,
+				PinnedAt:  startAt.Add(-time.Minute),
+			},
+			expect: &LivePin{
+				ScheduleID:   "schedule-id",
+				ProductID:    "product-id",
+				AdminID:      "admin-id",
+				PinnedAt:     startAt.Add(-time.Minute),
+				PinnedOffset: 0,
+			},
+		},
+		{
+			name: "broadcast not started",
+			params: &NewLivePinParams{
+				Schedule:  &Schedule{ID: "schedule-id", StartAt: startAt},
+				ProductID: "product-id",
+				AdminID:   "admin-id",
+				StartedAt: time.Time{},
+				PinnedAt:  startAt.Add(time.Minute),
+			},
+			expect: &LivePin{
+				ScheduleID:   "schedule-id",
+				ProductID:    "product-id",
+				AdminID:      "admin-id",
+				PinnedAt:     startAt.Add(time.Minute),
+				PinnedOffset: 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewLivePin(tt.params)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestLivePin_Unpin(t *testing.T) {
+	t.Parallel()
+	startedAt := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	pinnedAt := jst.Date(2026, 10, 18, 18, 1, 30, 0)
+	tests := []struct {
+		name       string
+		pin        *LivePin
+		unpinnedAt time.Time
+		expect     *LivePin
+	}{
+		{
+			name:       "success",
+			pin:        &LivePin{PinnedAt: pinnedAt, PinnedOffset: 90},
+			unpinnedAt: pinnedAt.Add(2 * time.Minute),
+			expect: &LivePin{
+				PinnedAt:       pinnedAt,
+				PinnedOffset:   90,
+				UnpinnedAt:     pinnedAt.Add(2 * time.Minute),
+				UnpinnedOffset: 210,
+			},
+		},
+		{
+			name:       "unpinned before pinned",
+			pin:        &LivePin{PinnedAt: pinnedAt, PinnedOffset: 90},
+			unpinnedAt: pinnedAt.Add(-time.Second),
+			expect: &LivePin{
+				PinnedAt:       pinnedAt,
+				PinnedOffset:   90,
+				UnpinnedAt:     pinnedAt,
+				UnpinnedOffset: 90,
+			},
+		},
+		{
+			name:       "pinned before broadcast started",
+			pin:        &LivePin{PinnedAt: startedAt.Add(-time.Minute), PinnedOffset: 0},
+			unpinnedAt: startedAt.Add(2 * time.Minute),
+			expect: &LivePin{
+				PinnedAt:       startedAt.Add(-time.Minute),
+				PinnedOffset:   0,
+				UnpinnedAt:     startedAt.Add(2 * time.Minute),
+				UnpinnedOffset: 120,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.True(t, tt.pin.Pinned())
+			tt.pin.Unpin(tt.unpinnedAt, startedAt)
+			assert.Equal(t, tt.expect, tt.pin)
+			assert.False(t, tt.pin.Pinned())
+		})
+	}
+}
+
+func TestLivePin_SalesPeriod(t *testing.T) {
+	t.Parallel()
+	pinnedAt := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	now := pinnedAt.Add(time.Hour)
+	tests := []struct {
+		name        string
+		pin         *LivePin
+		endedAt     time.Time
+		expectStart time.Time
+		expectEnd   time.Time
+	}{
+		{
+			name:        "pinned",
+			pin:         &LivePin{PinnedAt: pinnedAt},
+			endedAt:     time.Time{},
+			expectStart: pinnedAt,
+			expectEnd:   now,
+		},
+		{
+			name:        "unpinned",
+			pin:         &LivePin{PinnedAt: pinnedAt, UnpinnedAt: pinnedAt.Add(time.Minute)},
+			endedAt:     time.Time{},
+			expectStart: pinnedAt,
+			expectEnd:   pinnedAt.Add(time.Minute),
+		},
+		{
+			name:        "pinned after broadcast ended",
+			pin:         &LivePin{PinnedAt: pinnedAt},
+			endedAt:     pinnedAt.Add(30 * time.Minute),
+			expectStart: pinnedAt,
+			expectEnd:   pinnedAt.Add(30 * time.Minute),
+		},
+		{
+			name:        "unpinned after broadcast ended",
+			pin:         &LivePin{PinnedAt: pinnedAt, UnpinnedAt: pinnedAt.Add(time.Minute)},
+			endedAt:     pinnedAt.Add(-time.Minute),
+			expectStart: pinnedAt,
+			expectEnd:   pinnedAt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			start, end := tt.pin.SalesPeriod(now, tt.endedAt)
+			assert.Equal(t, tt.expectStart, start)
+			assert.Equal(t, tt.expectEnd, end)
+		})
+	}
+}
+
+func TestLivePins(t *testing.T) {
+	t.Parallel()
+	pins := LivePins{
+		{ID: "pin-id01", ProductID: "product-id01", UnpinnedAt: jst.Date(2026, 10, 18, 18, 0, 0, 0)},
+		{ID: "pin-id02", ProductID: "product-id02"},
+		{ID: "pin-id03", ProductID: "product-id01"},
+	}
+	assert.ElementsMatch(t, []string{"product-id01", "product-id02"}, pins.ProductIDs())
+	assert.Equal(t, LivePins{pins[1], pins[2]}, pins.Pinned())
+}
@@ -21,17 +21,27 @@ const (
 type OrderStatus int32
 
 const (
-	OrderStatusUnknown   OrderStatus = 0
-	OrderStatusUnpaid    OrderStatus = 1 // 支払い待ち
-	OrderStatusWaiting   OrderStatus = 2 // 受注待ち
-	OrderStatusPreparing OrderStatus = 3 // 発送準備中
-	OrderStatusShipped   OrderStatus = 4 // 発送完了
-	OrderStatusCompleted OrderStatus = 5 // 完了
-	OrderStatusCanceled  OrderStatus = 6 // キャンセル
-	OrderStatusRefunded  OrderStatus = 7 // 返金
-	OrderStatusFailed    OrderStatus = 8 // 失敗
+	OrderStatusUnknown           OrderStatus = 0
+	OrderStatusUnpaid            OrderStatus = 1 // 支払い待ち
+	OrderStatusWaiting           OrderStatus = 2 // 受注待ち
+	OrderStatusPreparing         OrderStatus = 3 // 発送準備中
+	OrderStatusShipped           OrderStatus = 4 // 発送完了
+	OrderStatusCompleted         OrderStatus = 5 // 完了
+	OrderStatusCanceled          OrderStatus = 6 // キャンセル
+	OrderStatusRefunded          OrderStatus = 7 // 返金
+	OrderStatusFailed            OrderStatus = 8 // 失敗
+	OrderStatusPartiallyRefunded OrderStatus = 9 // 一部返金
 )
 
+// PurchasedOrderStatuses - 購入済みとして扱う注文ステータス一覧
+var PurchasedOrderStatuses = []OrderStatus{
+	OrderStatusWaiting,
+	OrderStatusPreparing,
+	OrderStatusShipped,
+	OrderStatusCompleted,
+	OrderStatusPartiallyRefunded,
+}
+
 // OrderShippingType - 発送方法
 type OrderShippingType int32
 
@@ -64,6 +74,7 @@ type Order struct {
 	ShopID            string         `gorm:"default:null"`         // 店舗ID
 	CoordinatorID     string         `gorm:""`                     // 注文受付担当者ID
 	PromotionID       string         `gorm:"default:null"`         // プロモーションID
+	PreorderBatchID   string         `gorm:"default:null"`         // 予約注文バッチID
 	ManagementID      int64          `gorm:""`                     // 管理番号
 	Type              OrderType      `gorm:""`                     // 注文種別
 	Status            OrderStatus    `gorm:""`                     // 注文ステータス
@@ -76,22 +87,28 @@ type Order struct {
 type Orders []*Order
 
 type NewProductOrderParams struct {
-	OrderID           string
-	SessionID         string
-	ShopID            string
-	CoordinatorID     string
-	Customer          *entity.User
-	BillingAddress    *entity.Address
-	ShippingAddress   *entity.Address
-	Shipping          *Shipping
-	Baskets           CartBaskets
-	Products          Products
-	PaymentMethodType PaymentMethodType
-	Promotion         *Promotion
-	Pickup            bool
-	PickupAt          time.Time
-	PickupLocation    string
-	OrderRequest      string
+	OrderID            string
+	SessionID          string
+	ShopID             string
+	CoordinatorID      string
+	Customer           *entity.User
+	BillingAddress     *entity.Address
+	ShippingAddress    *entity.Address
+	Destinations       OrderDestinations
+	Shipping           *Shipping
+	Baskets            CartBaskets
+	Products           Products
+	ProductTypes       ProductTypes
+	PaymentMethodType  PaymentMethodType
+	Promotion          *Promotion
+	PreorderBatch      *PreorderBatch
+	Pickup             bool
+	PickupAt           time.Time
+	PickupLocation     string
+	OrderRequest       string
+	DeliveryDate       time.Time
+	DeliveryTimeWindow DeliveryTimeWindow
+	Gift               *OrderGiftParams
 }
 
 type NewExperienceOrderParams struct {
@@ -109,6 +126,7 @@ type NewExperienceOrderParams struct {
 	ElementarySchoolCount int64
 	PreschoolCount        int64
 	SeniorCount           int64
+	Slot                  *ExperienceSlot
 	Transportation        string
 	RequetsedDate         string
 	RequetsedTime         string
@@ -116,30 +134,50 @@ type NewExperienceOrderParams struct {
 }
 
 func NewProductOrder(params *NewProductOrderParams) (*Order, error) {
-	var promotionID string
+	var promotionID, preorderBatchID string
 	if params.Promotion != nil {
 		promotionID = params.Promotion.ID
 	}
+	if params.PreorderBatch != nil {
+		preorderBatchID = params.PreorderBatch.ID
+	}
 	pparams := &NewProductOrderPaymentParams{
-		OrderID:    params.OrderID,
-		Pickup:     params.Pickup,
-		Address:    params.BillingAddress,
-		MethodType: params.PaymentMethodType,
-		Baskets:    params.Baskets,
-		Products:   params.Products,
-		Shipping:   params.Shipping,
-		Promotion:  params.Promotion,
+		OrderID:         params.OrderID,
+		Pickup:          params.Pickup,
+		Address:         params.BillingAddress,
+		ShippingAddress: params.ShippingAddress,
+		MethodType:      params.PaymentMethodType,
+		Baskets:         params.Baskets,
+		Destinations:    params.Destinations,
+		Products:        params.Products,
+		ProductTypes:    params.ProductTypes,
+		Shipping:        params.Shipping,
+		Promotion:       params.Promotion,
 	}
 	payment, err := NewProductOrderPayment(pparams)
 	if err != nil {
 		return nil, err
 	}
+	dparams := &NewProductPromotionDiscountsParams{
+		Baskets:      params.Baskets,
+		Products:     params.Products,
+		ProductTypes: params.ProductTypes,
+		Promotion:    params.Promotion,
+	}
+	discounts, err := NewProductPromotionDiscounts(dparams)
+	if err != nil {
+		return nil, err
+	}
 	fparams := &NewOrderFulfillmentsParams{
-		OrderID:  params.OrderID,
-		Pickup:   params.Pickup,
-		Address:  params.ShippingAddress,
-		Baskets:  params.Baskets,
-		Products: params.Products.Map(),
+		OrderID:            params.OrderID,
+		Pickup:             params.Pickup,
+		Address:            params.ShippingAddress,
+		Baskets:            params.Baskets,
+		Destinations:       params.Destinations,
+		Products:           params.Products.Map(),
+		Discounts:          discounts,
+		DeliveryDate:       params.DeliveryDate,
+		DeliveryTimeWindow: params.DeliveryTimeWindow,
 	}
 	fulfillments, items, err := NewOrderFulfillments(fparams)
 	if err != nil {
@@ -149,10 +187,12 @@ func NewProductOrder(params *NewProductOrderParams) (*Order, error) {
 		OrderID:         params.OrderID,
 		Pickup:          params.Pickup,
 		ShippingAddress: params.ShippingAddress,
+		Destinations:    params.Destinations,
 		ShippingMessage: "ご注文ありがとうございます！商品到着まで今しばらくお待ち下さい。",
 		PickupAt:        params.PickupAt,
 		PickupLocation:  params.PickupLocation,
 		OrderRequest:    params.OrderRequest,
+		Gift:            params.Gift,
 	}
 	metadata := NewOrderMetadata(mparams)
 	return &Order{
@@ -166,6 +206,7 @@ func NewProductOrder(params *NewProductOrderParams) (*Order, error) {
 		ShopID:            params.ShopID,
 		CoordinatorID:     params.CoordinatorID,
 		PromotionID:       promotionID,
+		PreorderBatchID:   preorderBatchID,
 		Type:              OrderTypeProduct,
 		Status:            OrderStatusUnpaid, // 初期ステータスは「支払い待ち」で登録
 	}, nil
@@ -200,6 +241,7 @@ func NewExperienceOrder(params *NewExperienceOrderParams) (*Order, error) {
 		ElementarySchoolCount: params.ElementarySchoolCount,
 		PreschoolCount:        params.PreschoolCount,
 		SeniorCount:           params.SeniorCount,
+		Slot:                  params.Slot,
 		Transportation:        params.Transportation,
 		RequestedDate:         params.RequetsedDate,
 		RequestedTime:         params.RequetsedTime,
@@ -208,6 +250,12 @@ func NewExperienceOrder(params *NewExperienceOrderParams) (*Order, error) {
 	if err != nil {
 		return nil, err
 	}
+	// 体験枠が指定されている場合、定員を超過していないかを検証
+	if params.Slot != nil {
+		if err := params.Slot.Reservable(params.Experience.ID, experience.Participants()); err != nil {
+			return nil, err
+		}
+	}
 	mparams := &NewOrderMetadataParams{
 		OrderID:      params.OrderID,
 		OrderRequest: params.OrderRequest,
@@ -260,6 +308,8 @@ func (o *Order) SetPaymentStatus(status PaymentStatus) {
 		o.Status = OrderStatusCanceled
 	case PaymentStatusRefunded:
 		o.Status = OrderStatusRefunded
+	case PaymentStatusPartiallyRefunded:
+		o.Status = OrderStatusPartiallyRefunded
 	case PaymentStatusFailed:
 		o.Status = OrderStatusFailed
 	case PaymentStatusExpired:
@@ -276,6 +326,10 @@ func (o *Order) SetFulfillmentStatus(fulfillmentID string, status FulfillmentSta
 			break
 		}
 	}
+	if o.Status == OrderStatusPartiallyRefunded {
+		// 一部返金済みの場合は返金状況を優先して表示する
+		return
+	}
 	if o.Fulfilled() {
 		o.Status = OrderStatusShipped
 	} else {
@@ -322,11 +376,23 @@ func (o *Order) Capturable() bool {
 	return o.Status == OrderStatusWaiting
 }
 
+// Shippable - 発送情報を登録できるか
+func (o *Order) Shippable() bool {
+	if o == nil {
+		return false
+	}
+	return o.Status == OrderStatusPreparing ||
+		o.Status == OrderStatusShipped ||
+		o.Status == OrderStatusPartiallyRefunded
+}
+
 func (o *Order) Completable() bool {
 	if o == nil {
 		return false
 	}
-	return o.Status == OrderStatusPreparing || o.Status == OrderStatusShipped
+	return o.Status == OrderStatusPreparing ||
+		o.Status == OrderStatusShipped ||
+		o.Status == OrderStatusPartiallyRefunded
 }
 
 func (o *Order) Cancelable() bool {
@@ -340,7 +406,25 @@ func (o *Order) Refundable() bool {
 	if o == nil {
 		return false
 	}
-	return o.OrderPayment.Status == PaymentStatusCaptured
+	return o.OrderPayment.Status == PaymentStatusCaptured || o.OrderPayment.Status == PaymentStatusPartiallyRefunded
+}
+
+// IsPreorder - 予約注文か
+func (o *Order) IsPreorder() bool {
+	if o == nil {
+		return false
+	}
+	return o.PreorderBatchID != ""
+}
+
+// Claimable - 商品到着後の返品・交換申請が可能か
+func (o *Order) Claimable() bool {
+	if o == nil || o.Type != OrderTypeProduct {
+		return false
+	}
+	return o.Status == OrderStatusShipped ||
+		o.Status == OrderStatusCompleted ||
+		o.Status == OrderStatusPartiallyRefunded
 }
 
 func (os Orders) IDs() []string {
@@ -402,6 +486,12 @@ func (os Orders) ExperienceRevisionIDs() []int64 {
 	return res.Slice()
 }
 
+func (os Orders) PaymentMethodTypes() []PaymentMethodType {
+	return set.UniqBy(os, func(o *Order) PaymentMethodType {
+		return o.OrderPayment.MethodType
+	})
+}
+
 func (os Orders) Fill(
 	payments map[string]*OrderPayment,
 	fulfillments map[string]OrderFulfillments,
@@ -479,6 +569,26 @@ func (ps AggregatedOrderPayments) OrderTotal() int64 {
 	return total
 }
 
+// AggregatedOrderProduct - 商品ごとの注文履歴集計情報
+type AggregatedOrderProduct struct {
+	ProductID     string // 商品ID
+	OrderCount    int64  // 注文合計回数
+	UserCount     int64  // 注文ユーザー数
+	Quantity      int64  // 購入合計数量
+	SalesTotal    int64  // 購入合計金額
+	DiscountTotal int64  // 割引合計金額
+}
+
+type AggregatedOrderProducts []*AggregatedOrderProduct
+
+func (os AggregatedOrderProducts) Map() map[string]*AggregatedOrderProduct {
+	res := make(map[string]*AggregatedOrderProduct, len(os))
+	for _, o := range os {
+		res[o.ProductID] = o
+	}
+	return res
+}
+
 // AggregatedOrderPromotion - プロモーションコード利用履歴集計情報
 type AggregatedOrderPromotion struct {
 	PromotionID   string // プロモーションID
@@ -0,0 +1,261 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+const orderClaimCouponTTL = 90 * 24 * time.Hour // お詫びクーポンの有効期間
+
+var (
+	ErrInvalidOrderClaim     = errors.New("entity: invalid order claim")
+	ErrOrderClaimUnavailable = errors.New("entity: order claim is unavailable")
+)
+
+// OrderClaimType - 返品・交換申請理由
+type OrderClaimType int32
+
+const (
+	OrderClaimTypeUnknown   OrderClaimType = 0
+	OrderClaimTypeSpoiled   OrderClaimType = 1 // 傷み・腐敗
+	OrderClaimTypeDamaged   OrderClaimType = 2 // 破損
+	OrderClaimTypeWrongItem OrderClaimType = 3 // 誤配送
+	OrderClaimTypeMissing   OrderClaimType = 4 // 数量不足
+	OrderClaimTypeOther     OrderClaimType = 5 // その他
+)
+
+// OrderClaimStatus - 返品・交換申請の審査状況
+type OrderClaimStatus int32
+
+const (
+	OrderClaimStatusUnknown   OrderClaimStatus = 0
+	OrderClaimStatusRequested OrderClaimStatus = 1 // 申請中
+	OrderClaimStatusApproved  OrderClaimStatus = 2 // 承認
+	OrderClaimStatusRejected  OrderClaimStatus = 3 // 却下
+	OrderClaimStatusResolved  OrderClaimStatus = 4 // 対応完了
+	OrderClaimStatusResolving OrderClaimStatus = 5 // 対応中
+)
+
+// OrderClaimResolutionType - 返品・交換申請の対応方法
+type OrderClaimResolutionType int32
+
+const (
+	OrderClaimResolutionTypeUnknown    OrderClaimResolutionType = 0
+	OrderClaimResolutionTypeRefund     OrderClaimResolutionType = 1 // 返金
+	OrderClaimResolutionTypeReshipment OrderClaimResolutionType = 2 // 再発送
+	OrderClaimResolutionTypeCoupon     OrderClaimResolutionType = 3 // クーポン発行
+)
+
+// OrderClaim - 返品・交換申請
+type OrderClaim struct {
+	ID             string                   `gorm:"primaryKey;<-:create"` // 返品・交換申請ID
+	OrderID        string                   `gorm:"<-:create"`            // 注文履歴ID
+	UserID         string                   `gorm:"<-:create"`            // ユーザーID
+	ShopID         string                   `gorm:"default:null"`         // 店舗ID
+	CoordinatorID  string                   `gorm:"<-:create"`            // 注文受付担当者ID
+	Type           OrderClaimType           `gorm:"<-:create"`            // 申請理由
+	Status         OrderClaimStatus         `gorm:""`                     // 審査状況
+	Description    string                   `gorm:"<-:create"`            // 申請内容
+	Items          OrderClaimItems          `gorm:"-"`                    // 対象商品一覧
+	ImageURLs      []string                 `gorm:"-"`                    // 証拠画像URL一覧
+	ReviewerID     string                   `gorm:"default:null"`         // 審査担当者ID
+	ReviewComment  string                   `gorm:""`                     // 審査コメント
+	ResolutionType OrderClaimResolutionType `gorm:""`                     // 対応方法
+	ResolutionNote string                   `gorm:""`                     // 対応内容
+	RefundLineID   string                   `gorm:"default:null"`         // 返金明細ID
+	PromotionID    string                   `gorm:"default:null"`         // お詫びクーポンのプロモーションID
+	FulfillmentID  string                   `gorm:"default:null"`         // 再発送の注文配送ID
+	ReviewedAt     time.Time                `gorm:"default:null"`         // 審査日時
+	ResolvedAt     time.Time                `gorm:"default:null"`         // 対応完了日時
+	CreatedAt      time.Time                `gorm:"<-:create"`            // 登録日時
+	UpdatedAt      time.Time                `gorm:""`                     // 更新日時
+}
+
+type OrderClaims []*OrderClaim
+
+// OrderClaimItem - 返品・交換申請の対象商品
+type OrderClaimItem struct {
+	ProductRevisionID int64 `json:"productRevisionId"` // 商品ID
+	Quantity          int64 `json:"quantity"`          // 対象数量
+}
+
+type OrderClaimItems []*OrderClaimItem
+
+type NewOrderClaimParams struct {
+	Order       *Order
+	UserID      string
+	Type        OrderClaimType
+	Description string
+	Items       OrderClaimItems
+	ImageURLs   []string
+}
+
+type NewOrderClaimReshipmentParams struct {
+	Claim *OrderClaim
+	Order *Order
+}
+
+type NewOrderClaimCouponParams struct {
+	Claim  *OrderClaim
+	Amount int64
+	Now    time.Time
+}
+
+func NewOrderClaim(params *NewOrderClaimParams) (*OrderClaim, error) {
+	if !params.Order.Claimable() {
+		return nil, fmt.Errorf("%w: order status=%d", ErrOrderClaimUnavailable, params.Order.Status)
+	}
+	if len(params.Items) == 0 {
+		return nil, fmt.Errorf("%w: items are required", ErrInvalidOrderClaim)
+	}
+	// 配送単位に分かれている注文商品を商品単位で集計する
+	quantities := make(map[int64]int64, len(params.Order.OrderItems))
+	for _, item := range params.Order.OrderItems {
+		quantities[item.ProductRevisionID] += item.Quantity
+	}
+	requested := make(map[int64]int64, len(params.Items))
+	for _, item := range params.Items {
+		quantity, ok := quantities[item.ProductRevisionID]
+		if !ok {
+			return nil, fmt.Errorf("%w: product is not ordered: %d", ErrInvalidOrderClaim, item.ProductRevisionID)
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidOrderClaim)
+		}
+		requested[item.ProductRevisionID] += item.Quantity
+		if requested[item.ProductRevisionID] > quantity {
+			return nil, fmt.Errorf("%w: quantity exceeded: %d", ErrInvalidOrderClaim, item.ProductRevisionID)
+		}
+	}
+	return &OrderClaim{
+		ID:            uuid.Base58Encode(uuid.New()),
+		OrderID:       params.Order.ID,
+		UserID:        params.UserID,
+		ShopID:        params.Order.ShopID,
+		CoordinatorID: params.Order.CoordinatorID,
+		Type:          params.Type,
+		Status:        OrderClaimStatusRequested,
+		Description:   params.Description,
+		Items:         params.Items,
+		ImageURLs:     params.ImageURLs,
+	}, nil
+}
+
+// NewOrderClaimReshipment - 返品・交換申請の対応として、対象商品を含む配送と同じ届け先への再発送を生成
+func NewOrderClaimReshipment(params *NewOrderClaimReshipmentParams) (*OrderFulfillment, error) {
+	if len(params.Claim.Items) == 0 {
+		return nil, fmt.Errorf("%w: items are required", ErrInvalidOrderClaim)
+	}
+	var (
+		fulfillmentID string
+		original      *OrderFulfillment
+		boxNumber     int64
+	)
+	for _, item := range params.Order.OrderItems {
+		if item.ProductRevisionID == params.Claim.Items[0].ProductRevisionID {
+			fulfillmentID = item.FulfillmentID
+			break
+		}
+	}
+	for _, f := range params.Order.OrderFulfillments {
+		if f.ID == fulfillmentID {
+			original = f
+		}
+		boxNumber = max(boxNumber, f.BoxNumber)
+	}
+	if original == nil {
+		return nil, fmt.Errorf("%w: fulfillment is not found", ErrOrderClaimUnavailable)
+	}
+	if original.ShippingType == ShippingTypePickup {
+		return nil, fmt.Errorf("%w: pickup order cannot be reshipped", ErrOrderClaimUnavailable)
+	}
+	return &OrderFulfillment{
+		ID:                uuid.Base58Encode(uuid.New()),
+		OrderID:           params.Order.ID,
+		AddressRevisionID: original.AddressRevisionID,
+		Status:            FulfillmentStatusUnfulfilled,
+		ShippingCarrier:   ShippingCarrierUnknown,
+		ShippingType:      original.ShippingType,
+		BoxNumber:         boxNumber + 1,
+		BoxSize:           original.BoxSize,
+		BoxRate:           original.BoxRate,
+	}, nil
+}
+
+// NewOrderClaimCoupon - 返品・交換申請の対応として、申請者向けの1回限り利用可能なクーポンを生成
+func NewOrderClaimCoupon(params *NewOrderClaimCouponParams) (*Promotion, error) {
+	code, err := newPromotionCodeValue()
+	if err != nil {
+		return nil, err
+	}
+	pparams := &NewPromotionParams{
+		ShopID:            params.Claim.ShopID,
+		Title:             "お詫びクーポン",
+		Description:       "ご注文商品の不具合に対するお詫びのクーポンです。",
+		Public:            true,
+		DiscountType:      DiscountTypeAmount,
+		DiscountRate:      params.Amount,
+		Code:              code,
+		CodeType:          PromotionCodeTypeOnce,
+		UsageLimit:        1,
+		UsageLimitPerUser: 1,
+		StartAt:           params.Now,
+		EndAt:             params.Now.Add(orderClaimCouponTTL),
+	}
+	promotion := NewPromotion(pparams)
+	if err := promotion.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOrderClaim, err)
+	}
+	return promotion, nil
+}
+
+// CanTransition - 指定した審査状況へ遷移可能か
+func (s OrderClaimStatus) CanTransition(next OrderClaimStatus) bool {
+	switch s {
+	case OrderClaimStatusRequested:
+		return next == OrderClaimStatusApproved || next == OrderClaimStatusRejected
+	case OrderClaimStatusApproved:
+		return next == OrderClaimStatusResolving
+	case OrderClaimStatusResolving:
+		// 対応に失敗した場合は承認済みに戻して再実行できるようにする
+		return next == OrderClaimStatusResolved || next == OrderClaimStatusApproved
+	default:
+		return false
+	}
+}
+
+// RefundItems - 返金対象として扱う商品一覧
+func (c *OrderClaim) RefundItems() OrderRefundLineItems {
+	res := make(OrderRefundLineItems, len(c.Items))
+	for i, item := range c.Items {
+		res[i] = &OrderRefundLineItem{
+			ProductRevisionID: item.ProductRevisionID,
+			Quantity:          item.Quantity,
+		}
+	}
+	return res
+}
+
+func (c *OrderClaim) ProductRevisionIDs() []int64 {
+	return set.UniqBy(c.Items, func(i *OrderClaimItem) int64 {
+		return i.ProductRevisionID
+	})
+}
+
+func (cs OrderClaims) UserIDs() []string {
+	return set.UniqBy(cs, func(c *OrderClaim) string {
+		return c.UserID
+	})
+}
+
+func (cs OrderClaims) ProductRevisionIDs() []int64 {
+	res := set.NewEmpty[int64](len(cs))
+	for _, c := range cs {
+		res.Add(c.ProductRevisionIDs()...)
+	}
+	return res.Slice()
+}
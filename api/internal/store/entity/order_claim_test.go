@@ -0,0 +1,278 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderClaim(t *testing.T) {
+	t.Parallel()
+	order := &Order{
+		ID:            "order-id",
+		ShopID:        "shop-id",
+		CoordinatorID: "coordinator-id",
+		Type:          OrderTypeProduct,
+		Status:        OrderStatusShipped,
+		OrderItems: OrderItems{
+			{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, OrderID: "order-id", Quantity: 2},
+			{FulfillmentID: "fulfillment-id02", ProductRevisionID: 1, OrderID: "order-id", Quantity: 1},
+			{FulfillmentID: "fulfillment-id02", ProductRevisionID: 2, OrderID: "order-id", Quantity: 1},
+		},
+	}
+	tests := []struct {
+		name      string
+		params    *NewOrderClaimParams
+		expect    *OrderClaim
+		expectErr error
+	}{
+		{
+			name: "success",
+			params: &NewOrderClaimParams{
+				Order:       order,
+				UserID:      "user-id",
+				Type:        OrderClaimTypeSpoiled,
+				Description: "届いた野菜が傷んでいました。",
+				Items:       OrderClaimItems{{ProductRevisionID: 1, Quantity: 3}},
+				ImageURLs:   []string{"https://example.com/image.png"},
+			},
+			expect: &OrderClaim{
+				OrderID:       "order-id",
+				UserID:        "user-id",
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				Type:          OrderClaimTypeSpoiled,
+				Status:        OrderClaimStatusRequested,
+				Description:   "届いた野菜が傷んでいました。",
+				Items:         OrderClaimItems{{ProductRevisionID: 1, Quantity: 3}},
+				ImageURLs:     []string{"https://example.com/image.png"},
+			},
+		},
+		{
+			name: "not shipped",
+			params: &NewOrderClaimParams{
+				Order:  &Order{ID: "order-id", Type: OrderTypeProduct, Status: OrderStatusPreparing},
+				UserID: "user-id",
+				Type:   OrderClaimTypeSpoiled,
+				Items:  OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}},
+			},
+			expectErr: ErrOrderClaimUnavailable,
+		},
+		{
+			name: "empty items",
+			params: &NewOrderClaimParams{
+				Order:  order,
+				UserID: "user-id",
+				Type:   OrderClaimTypeSpoiled,
+			},
+			expectErr: ErrInvalidOrderClaim,
+		},
+		{
+			name: "not ordered product",
+			params: &NewOrderClaimParams{
+				Order:  order,
+				UserID: "user-id",
+				Type:   OrderClaimTypeSpoiled,
+				Items:  OrderClaimItems{{ProductRevisionID: 3, Quantity: 1}},
+			},
+			expectErr: ErrInvalidOrderClaim,
+		},
+		{
+			name: "quantity exceeded",
+			params: &NewOrderClaimParams{
+				Order:  order,
+				UserID: "user-id",
+				Type:   OrderClaimTypeSpoiled,
+				Items: OrderClaimItems{
+					{ProductRevisionID: 2, Quantity: 1},
+					{ProductRevisionID: 2, Quantity: 1},
+				},
+			},
+			expectErr: ErrInvalidOrderClaim,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewOrderClaim(tt.params)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if err != nil {
+				return
+			}
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestOrderClaimCoupon(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	claim := &OrderClaim{ID: "claim-id", ShopID: "shop-id"}
+
+	actual, err := NewOrderClaimCoupon(&NewOrderClaimCouponParams{Claim: claim, Amount: 500, Now: now})
+	require.NoError(t, err)
+	assert.Equal(t, "shop-id", actual.ShopID)
+	assert.Equal(t, PromotionTargetTypeSpecificShop, actual.TargetType)
+	assert.Equal(t, DiscountTypeAmount, actual.DiscountType)
+	assert.Equal(t, int64(500), actual.DiscountRate)
+	assert.Equal(t, PromotionCodeTypeOnce, actual.CodeType)
+	assert.Len(t, actual.Code, PromotionCodeLength)
+	assert.Equal(t, int64(1), actual.UsageLimit)
+	assert.Equal(t, now, actual.StartAt)
+	assert.Equal(t, now.Add(orderClaimCouponTTL), actual.EndAt)
+
+	_, err = NewOrderClaimCoupon(&NewOrderClaimCouponParams{Claim: claim, Amount: 0, Now: now})
+	assert.ErrorIs(t, err, ErrInvalidOrderClaim)
+}
+
+func TestOrderClaimStatus_CanTransition(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status OrderClaimStatus
+		next   OrderClaimStatus
+		expect bool
+	}{
+		{name: "requested to approved", status: OrderClaimStatusRequested, next: OrderClaimStatusApproved, expect: true},
+		{name: "requested to rejected", status: OrderClaimStatusRequested, next: OrderClaimStatusRejected, expect: true},
+		{name: "requested to resolved", status: OrderClaimStatusRequested, next: OrderClaimStatusResolved, expect: false},
+		{name: "approved to resolving", status: OrderClaimStatusApproved, next: OrderClaimStatusResolving, expect: true},
+		{name: "approved to resolved", status: OrderClaimStatusApproved, next: OrderClaimStatusResolved, expect: false},
+		{name: "resolving to resolved", status: OrderClaimStatusResolving, next: OrderClaimStatusResolved, expect: true},
+		{name: "resolving to approved", status: OrderClaimStatusResolving, next: OrderClaimStatusApproved, expect: true},
+		{name: "resolving to resolving", status: OrderClaimStatusResolving, next: OrderClaimStatusResolving, expect: false},
+		{name: "approved to rejected", status: OrderClaimStatusApproved, next: OrderClaimStatusRejected, expect: false},
+		{name: "rejected to approved", status: OrderClaimStatusRejected, next: OrderClaimStatusApproved, expect: false},
+		{name: "resolved to resolved", status: OrderClaimStatusResolved, next: OrderClaimStatusResolved, expect: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.status.CanTransition(tt.next))
+		})
+	}
+}
+
+func TestOrderClaimReshipment(t *testing.T) {
+	t.Parallel()
+	order := &Order{
+		ID: "order-id",
+		OrderItems: OrderItems{
+			{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, Quantity: 1},
+			{FulfillmentID: "fulfillment-id02", ProductRevisionID: 2, Quantity: 1},
+		},
+		OrderFulfillments: OrderFulfillments{
+			{
+				ID:                "fulfillment-id01",
+				AddressRevisionID: 1,
+				ShippingType:      ShippingTypeNormal,
+				BoxNumber:         1,
+				BoxSize:           ShippingSize60,
+				BoxRate:           50,
+			},
+			{
+				ID:                "fulfillment-id02",
+				AddressRevisionID: 2,
+				ShippingType:      ShippingTypeFrozen,
+				BoxNumber:         2,
+				BoxSize:           ShippingSize80,
+				BoxRate:           80,
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		params    *NewOrderClaimReshipmentParams
+		expect    *OrderFulfillment
+		expectErr error
+	}{
+		{
+			name: "success",
+			params: &NewOrderClaimReshipmentParams{
+				Claim: &OrderClaim{Items: OrderClaimItems{{ProductRevisionID: 2, Quantity: 1}}},
+				Order: order,
+			},
+			expect: &OrderFulfillment{
+				OrderID:           "order-id",
+				AddressRevisionID: 2,
+				Status:            FulfillmentStatusUnfulfilled,
+				ShippingCarrier:   ShippingCarrierUnknown,
+				ShippingType:      ShippingTypeFrozen,
+				BoxNumber:         3,
+				BoxSize:           ShippingSize80,
+				BoxRate:           80,
+			},
+			expectErr: nil,
+		},
+		{
+			name: "empty items",
+			params: &NewOrderClaimReshipmentParams{
+				Claim: &OrderClaim{},
+				Order: order,
+			},
+			expect:    nil,
+			expectErr: ErrInvalidOrderClaim,
+		},
+		{
+			name: "not found fulfillment",
+			params: &NewOrderClaimReshipmentParams{
+				Claim: &OrderClaim{Items: OrderClaimItems{{ProductRevisionID: 3, Quantity: 1}}},
+				Order: order,
+			},
+			expect:    nil,
+			expectErr: ErrOrderClaimUnavailable,
+		},
+		{
+			name: "pickup order",
+			params: &NewOrderClaimReshipmentParams{
+				Claim: &OrderClaim{Items: OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}}},
+				Order: &Order{
+					ID:                "order-id",
+					OrderItems:        OrderItems{{FulfillmentID: "fulfillment-id", ProductRevisionID: 1}},
+					OrderFulfillments: OrderFulfillments{{ID: "fulfillment-id", ShippingType: ShippingTypePickup}},
+				},
+			},
+			expect:    nil,
+			expectErr: ErrOrderClaimUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewOrderClaimReshipment(tt.params)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if actual != nil {
+				actual.ID = "" // ignore
+			}
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestOrderClaim_RefundItems(t *testing.T) {
+	t.Parallel()
+	claim := &OrderClaim{
+		Items: OrderClaimItems{
+			{ProductRevisionID: 1, Quantity: 2},
+			{ProductRevisionID: 2, Quantity: 1},
+		},
+	}
+	expect := OrderRefundLineItems{
+		{ProductRevisionID: 1, Quantity: 2},
+		{ProductRevisionID: 2, Quantity: 1},
+	}
+	assert.Equal(t, expect, claim.RefundItems())
+}
+
+func TestOrderClaims_ProductRevisionIDs(t *testing.T) {
+	t.Parallel()
+	claims := OrderClaims{
+		{UserID: "user-id01", Items: OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}}},
+		{UserID: "user-id02", Items: OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}, {ProductRevisionID: 2, Quantity: 1}}},
+	}
+	assert.ElementsMatch(t, []int64{1, 2}, claims.ProductRevisionIDs())
+	assert.ElementsMatch(t, []string{"user-id01", "user-id02"}, claims.UserIDs())
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"errors"
+
+	"github.com/and-period/furumaru/api/internal/user/entity"
+)
+
+var (
+	ErrInvalidOrderDestination    = errors.New("entity: invalid order destination")
+	ErrUnmatchDestinationQuantity = errors.New("entity: unmatch destination quantity")
+)
+
+// OrderDestination - 配送先ごとの注文内容
+type OrderDestination struct {
+	Address *entity.Address // 配送先住所
+	Baskets CartBaskets     // 配送先へ発送する買い物かご一覧
+}
+
+type OrderDestinations []*OrderDestination
+
+type NewOrderDestinationParams struct {
+	Address  *entity.Address
+	Items    CartItems
+	Products map[string]*Product
+}
+
+// NewOrderDestination - 配送先ごとの商品数量から、発送する箱を詰め直して配送先情報を生成
+func NewOrderDestination(params *NewOrderDestinationParams) (*OrderDestination, error) {
+	if params.Address == nil || len(params.Items) == 0 {
+		return nil, ErrInvalidOrderDestination
+	}
+	for _, item := range params.Items {
+		if _, ok := params.Products[item.ProductID]; !ok {
+			return nil, errNotFoundProduct
+		}
+	}
+	baskets, err := refreshCart(CartBaskets{{Items: params.Items}}, params.Products)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderDestination{
+		Address: params.Address,
+		Baskets: baskets,
+	}, nil
+}
+
+// NewOrderDestinations - 配送先ごとの注文内容を生成（箱番号は配送先をまたいで注文全体で採番）
+func NewOrderDestinations(params []*NewOrderDestinationParams) (OrderDestinations, error) {
+	res := make(OrderDestinations, 0, len(params))
+	var boxNumber int64
+	for _, p := range params {
+		destination, err := NewOrderDestination(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, basket := range destination.Baskets {
+			boxNumber++
+			basket.BoxNumber = boxNumber
+		}
+		res = append(res, destination)
+	}
+	return res, nil
+}
+
+// CalcShippingFee - 配送先ごとの配送料金を算出（送料無料の判定は配送先ごとの購入金額で行う）
+func (d *OrderDestination) CalcShippingFee(shipping *Shipping, products map[string]*Product) (int64, error) {
+	total, err := d.Baskets.TotalPrice(products)
+	if err != nil {
+		return 0, err
+	}
+	var res int64
+	for _, basket := range d.Baskets {
+		fee, err := shipping.CalcShippingFee(basket.BoxSize, basket.BoxType, total, d.Address.PrefectureCode, d.Address.PostalCode)
+		if err != nil {
+			return 0, err
+		}
+		res += fee
+	}
+	return res, nil
+}
+
+// Verify - 配送先ごとの商品数量の合計が、購入対象の買い物かごと一致しているかを検証
+func (ds OrderDestinations) Verify(baskets CartBaskets) error {
+	expect := baskets.getQuantityByProductID()
+	actual := make(map[string]int64, len(expect))
+	for _, d := range ds {
+		for productID, quantity := range d.Baskets.getQuantityByProductID() {
+			actual[productID] += quantity
+		}
+	}
+	if len(expect) != len(actual) {
+		return ErrUnmatchDestinationQuantity
+	}
+	for productID, quantity := range expect {
+		if actual[productID] != quantity {
+			return ErrUnmatchDestinationQuantity
+		}
+	}
+	return nil
+}
+
+func (ds OrderDestinations) CalcShippingFee(shipping *Shipping, products map[string]*Product) (int64, error) {
+	var res int64
+	for _, d := range ds {
+		fee, err := d.CalcShippingFee(shipping, products)
+		if err != nil {
+			return 0, err
+		}
+		res += fee
+	}
+	return res, nil
+}
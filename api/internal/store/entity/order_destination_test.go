@@ -0,0 +1,348 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderDestination(t *testing.T) {
+	t.Parallel()
+	address := &entity.Address{
+		AddressRevision: entity.AddressRevision{ID: 1, PrefectureCode: 13, PostalCode: "1000014"},
+		ID:              "address-id",
+	}
+	products := map[string]*Product{
+		"product-id": {
+			ID:            "product-id",
+			CoordinatorID: "coordinator-id",
+			DeliveryType:  DeliveryTypeNormal,
+			Inventory:     10,
+			Weight:        500,
+			WeightUnit:    WeightUnitGram,
+			Box60Rate:     80,
+			Box80Rate:     50,
+			Box100Rate:    30,
+		},
+	}
+	tests := []struct {
+		name      string
+		params    *NewOrderDestinationParams
+		expect    *OrderDestination
+		expectErr error
+	}{
+		{
+			name: "success",
+			params: &NewOrderDestinationParams{
+				Address:  address,
+				Items:    CartItems{{ProductID: "product-id", Quantity: 1}},
+				Products: products,
+			},
+			expect: &OrderDestination{
+				Address: address,
+				Baskets: CartBaskets{
+					{
+						BoxNumber:     1,
+						BoxType:       ShippingTypeNormal,
+						BoxSize:       ShippingSize60,
+						BoxRate:       80,
+						Items:         CartItems{{ProductID: "product-id", Quantity: 1}},
+						CoordinatorID: "coordinator-id",
+					},
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "empty address",
+			params: &NewOrderDestinationParams{
+				Address:  nil,
+				Items:    CartItems{{ProductID: "product-id", Quantity: 1}},
+				Products: products,
+			},
+			expect:    nil,
+			expectErr: ErrInvalidOrderDestination,
+		},
+		{
+			name: "empty items",
+			params: &NewOrderDestinationParams{
+				Address:  address,
+				Items:    CartItems{},
+				Products: products,
+			},
+			expect:    nil,
+			expectErr: ErrInvalidOrderDestination,
+		},
+		{
+			name: "not found product",
+			params: &NewOrderDestinationParams{
+				Address:  address,
+				Items:    CartItems{{ProductID: "other-id", Quantity: 1}},
+				Products: products,
+			},
+			expect:    nil,
+			expectErr: errNotFoundProduct,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewOrderDestination(tt.params)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestOrderDestinations(t *testing.T) {
+	t.Parallel()
+	address1 := &entity.Address{
+		AddressRevision: entity.AddressRevision{ID: 1, PrefectureCode: 13, PostalCode: "1000014"},
+		ID:              "address-id01",
+	}
+	address2 := &entity.Address{
+		AddressRevision: entity.AddressRevision{ID: 2, PrefectureCode: 27, PostalCode: "5300001"},
+		ID:              "address-id02",
+	}
+	products := map[string]*Product{
+		"product-id": {
+			ID:            "product-id",
+			CoordinatorID: "coordinator-id",
+			DeliveryType:  DeliveryTypeNormal,
+			Inventory:     10,
+			Weight:        500,
+			WeightUnit:    WeightUnitGram,
+			Box60Rate:     80,
+			Box80Rate:     50,
+			Box100Rate:    30,
+		},
+	}
+	tests := []struct {
+		name      string
+		params    []*NewOrderDestinationParams
+		expect    OrderDestinations
+		expectErr error
+	}{
+		{
+			name: "success",
+			params: []*NewOrderDestinationParams{
+				{
+					Address:  address1,
+					Items:    CartItems{{ProductID: "product-id", Quantity: 4}},
+					Products: products,
+				},
+				{
+					Address:  address2,
+					Items:    CartItems{{ProductID: "product-id", Quantity: 1}},
+					Products: products,
+				},
+			},
+			expect: OrderDestinations{
+				{
+					Address: address1,
+					Baskets: CartBaskets{
+						{
+							BoxNumber:     1,
+							BoxType:       ShippingTypeNormal,
+							BoxSize:       ShippingSize100,
+							BoxRate:       90,
+							Items:         CartItems{{ProductID: "product-id", Quantity: 3}},
+							CoordinatorID: "coordinator-id",
+						},
+						{
+							BoxNumber:     2,
+							BoxType:       ShippingTypeNormal,
+							BoxSize:       ShippingSize60,
+							BoxRate:       80,
+							Items:         CartItems{{ProductID: "product-id", Quantity: 1}},
+							CoordinatorID: "coordinator-id",
+						},
+					},
+				},
+				{
+					Address: address2,
+					Baskets: CartBaskets{
+						{
+							BoxNumber:     3,
+							BoxType:       ShippingTypeNormal,
+							BoxSize:       ShippingSize60,
+							BoxRate:       80,
+							Items:         CartItems{{ProductID: "product-id", Quantity: 1}},
+							CoordinatorID: "coordinator-id",
+						},
+					},
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "invalid destination",
+			params: []*NewOrderDestinationParams{
+				{
+					Address:  address1,
+					Items:    CartItems{},
+					Products: products,
+				},
+			},
+			expect:    nil,
+			expectErr: ErrInvalidOrderDestination,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewOrderDestinations(tt.params)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestOrderDestinations_Verify(t *testing.T) {
+	t.Parallel()
+	baskets := CartBaskets{
+		{
+			BoxNumber: 1,
+			Items: CartItems{
+				{ProductID: "product-id01", Quantity: 3},
+				{ProductID: "product-id02", Quantity: 1},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		destinations OrderDestinations
+		expect       error
+	}{
+		{
+			name: "success",
+			destinations: OrderDestinations{
+				{Baskets: CartBaskets{{Items: CartItems{{ProductID: "product-id01", Quantity: 2}}}}},
+				{Baskets: CartBaskets{{Items: CartItems{
+					{ProductID: "product-id01", Quantity: 1},
+					{ProductID: "product-id02", Quantity: 1},
+				}}}},
+			},
+			expect: nil,
+		},
+		{
+			name: "insufficient quantity",
+			destinations: OrderDestinations{
+				{Baskets: CartBaskets{{Items: CartItems{{ProductID: "product-id01", Quantity: 2}}}}},
+				{Baskets: CartBaskets{{Items: CartItems{{ProductID: "product-id02", Quantity: 1}}}}},
+			},
+			expect: ErrUnmatchDestinationQuantity,
+		},
+		{
+			name: "exceeded quantity",
+			destinations: OrderDestinations{
+				{Baskets: CartBaskets{{Items: CartItems{{ProductID: "product-id01", Quantity: 3}}}}},
+				{Baskets: CartBaskets{{Items: CartItems{
+					{ProductID: "product-id01", Quantity: 1},
+					{ProductID: "product-id02", Quantity: 1},
+				}}}},
+			},
+			expect: ErrUnmatchDestinationQuantity,
+		},
+		{
+			name: "unknown product",
+			destinations: OrderDestinations{
+				{Baskets: CartBaskets{{Items: CartItems{
+					{ProductID: "product-id01", Quantity: 3},
+					{ProductID: "product-id02", Quantity: 1},
+					{ProductID: "product-id03", Quantity: 1},
+				}}}},
+			},
+			expect: ErrUnmatchDestinationQuantity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, tt.destinations.Verify(baskets), tt.expect)
+		})
+	}
+}
+
+func TestOrderDestinations_CalcShippingFee(t *testing.T) {
+	t.Parallel()
+	rates := ShippingRates{
+		{Number: 1, Name: "四国", Price: 250, PrefectureCodes: []int32{36, 37, 38, 39}},
+		{Number: 2, Name: "関東", Price: 500, PrefectureCodes: []int32{8, 9, 10, 11, 12, 13, 14}},
+	}
+	shipping := &Shipping{
+		ShippingRevision: ShippingRevision{
+			Box60Rates:        rates,
+			Box60Frozen:       800,
+			HasFreeShipping:   true,
+			FreeShippingRates: 3000,
+		},
+	}
+	products := map[string]*Product{
+		"product-id01": {ID: "product-id01", ProductRevision: ProductRevision{Price: 500}},
+		"product-id02": {ID: "product-id02", ProductRevision: ProductRevision{Price: 1980}},
+	}
+	tests := []struct {
+		name         string
+		destinations OrderDestinations
+		expect       int64
+		expectErr    error
+	}{
+		{
+			name: "success",
+			destinations: OrderDestinations{
+				{
+					Address: &entity.Address{AddressRevision: entity.AddressRevision{PrefectureCode: 13}},
+					Baskets: CartBaskets{{
+						BoxType: ShippingTypeNormal,
+						BoxSize: ShippingSize60,
+						Items:   CartItems{{ProductID: "product-id01", Quantity: 1}},
+					}},
+				},
+				{
+					Address: &entity.Address{AddressRevision: entity.AddressRevision{PrefectureCode: 37}},
+					Baskets: CartBaskets{{
+						BoxType: ShippingTypeFrozen,
+						BoxSize: ShippingSize60,
+						Items:   CartItems{{ProductID: "product-id02", Quantity: 1}},
+					}},
+				},
+				{
+					// 配送先ごとの購入金額が送料無料の条件を満たす
+					Address: &entity.Address{AddressRevision: entity.AddressRevision{PrefectureCode: 13}},
+					Baskets: CartBaskets{{
+						BoxType: ShippingTypeNormal,
+						BoxSize: ShippingSize60,
+						Items:   CartItems{{ProductID: "product-id02", Quantity: 2}},
+					}},
+				},
+			},
+			expect:    1550,
+			expectErr: nil,
+		},
+		{
+			name: "not found product",
+			destinations: OrderDestinations{
+				{
+					Address: &entity.Address{AddressRevision: entity.AddressRevision{PrefectureCode: 13}},
+					Baskets: CartBaskets{{
+						BoxType: ShippingTypeNormal,
+						BoxSize: ShippingSize60,
+						Items:   CartItems{{ProductID: "product-id03", Quantity: 1}},
+					}},
+				},
+			},
+			expect:    0,
+			expectErr: errNotFoundProduct,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := tt.destinations.CalcShippingFee(shipping, products)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
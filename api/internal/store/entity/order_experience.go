@@ -11,6 +11,7 @@ import (
 type OrderExperience struct {
 	OrderID               string                 `gorm:"primaryKey;<-:create"` // 注文履歴ID
 	ExperienceRevisionID  int64                  `gorm:"primaryKey;<-:create"` // 体験ID
+	SlotID                string                 `gorm:"default:null"`         // 体験枠ID
 	AdultCount            int64                  `gorm:""`                     // 大人人数
 	JuniorHighSchoolCount int64                  `gorm:""`                     // 中学生人数
 	ElementarySchoolCount int64                  `gorm:""`                     // 小学生人数
@@ -38,6 +39,7 @@ type NewOrderExperienceParams struct {
 	ElementarySchoolCount int64
 	PreschoolCount        int64
 	SeniorCount           int64
+	Slot                  *ExperienceSlot
 	Transportation        string
 	RequestedDate         string
 	RequestedTime         string
@@ -50,11 +52,18 @@ type NewOrderExperienceRemarksParams struct {
 }
 
 func NewOrderExperience(params *NewOrderExperienceParams) (*OrderExperience, error) {
+	var slotID string
 	rparams := &NewOrderExperienceRemarksParams{
 		Transportation: params.Transportation,
 		RequestedDate:  params.RequestedDate,
 		RequestedTime:  params.RequestedTime,
 	}
+	if params.Slot != nil {
+		// 体験枠を指定した場合、希望日時は体験枠の開始日時とする
+		slotID = params.Slot.ID
+		rparams.RequestedDate = jst.FormatYYYYMMDD(params.Slot.StartAt)
+		rparams.RequestedTime = jst.FormatHHMM(params.Slot.StartAt)
+	}
 	remarks, err := NewOrderExperienceRemarks(rparams)
 	if err != nil {
 		return nil, err
@@ -62,6 +71,7 @@ func NewOrderExperience(params *NewOrderExperienceParams) (*OrderExperience, err
 	return &OrderExperience{
 		OrderID:               params.OrderID,
 		ExperienceRevisionID:  params.Experience.ExperienceRevision.ID,
+		SlotID:                slotID,
 		AdultCount:            params.AdultCount,
 		JuniorHighSchoolCount: params.JuniorHighSchoolCount,
 		ElementarySchoolCount: params.ElementarySchoolCount,
@@ -71,6 +81,11 @@ func NewOrderExperience(params *NewOrderExperienceParams) (*OrderExperience, err
 	}, nil
 }
 
+// Participants - 参加人数
+func (e *OrderExperience) Participants() int64 {
+	return e.AdultCount + e.JuniorHighSchoolCount + e.ElementarySchoolCount + e.PreschoolCount + e.SeniorCount
+}
+
 func (os OrderExperiences) MapByOrderID() map[string]*OrderExperience {
 	m := make(map[string]*OrderExperience)
 	for _, o := range os {
@@ -96,6 +96,41 @@ func TestOrderExperience(t *testing.T) {
 			},
 			hasErr: false,
 		},
+		{
+			name: "success with slot",
+			params: &NewOrderExperienceParams{
+				OrderID: "order-id",
+				Experience: &Experience{
+					ID: "experience-id",
+					ExperienceRevision: ExperienceRevision{
+						ID:           1,
+						ExperienceID: "experience-id",
+					},
+				},
+				Slot: &ExperienceSlot{
+					ID:           "slot-id",
+					ExperienceID: "experience-id",
+					StartAt:      jst.Date(2024, 8, 24, 13, 30, 0, 0),
+					EndAt:        jst.Date(2024, 8, 24, 15, 30, 0, 0),
+				},
+				Transportation: "徒歩",
+				RequestedDate:  "20210101",
+				RequestedTime:  "1000",
+				AdultCount:     2,
+			},
+			expect: &OrderExperience{
+				OrderID:              "order-id",
+				ExperienceRevisionID: 1,
+				SlotID:               "slot-id",
+				AdultCount:           2,
+				Remarks: OrderExperienceRemarks{
+					Transportation: "徒歩",
+					RequestedDate:  jst.Date(2024, 8, 24, 0, 0, 0, 0),
+					RequestedTime:  jst.Date(0, 1, 1, 13, 30, 0, 0),
+				},
+			},
+			hasErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -108,6 +143,18 @@ func TestOrderExperience(t *testing.T) {
 	}
 }
 
+func TestOrderExperience_Participants(t *testing.T) {
+	t.Parallel()
+	experience := &OrderExperience{
+		AdultCount:            1,
+		JuniorHighSchoolCount: 2,
+		ElementarySchoolCount: 3,
+		PreschoolCount:        4,
+		SeniorCount:           5,
+	}
+	assert.Equal(t, int64(15), experience.Participants())
+}
+
 func TestNewOrderExperienceRemarks(t *testing.T) {
 	t.Parallel()
 
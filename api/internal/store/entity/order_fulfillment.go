@@ -15,15 +15,25 @@ const (
 	FulfillmentStatusUnknown     FulfillmentStatus = 0
 	FulfillmentStatusUnfulfilled FulfillmentStatus = 1 // 未発送
 	FulfillmentStatusFulfilled   FulfillmentStatus = 2 // 発送済み
+	FulfillmentStatusDelivered   FulfillmentStatus = 3 // 配達完了
 )
 
 // ShippingCarrier - 配送会社
 type ShippingCarrier int32
 
 const (
-	ShippingCarrierUnknown ShippingCarrier = 0
-	ShippingCarrierYamato  ShippingCarrier = 1 // ヤマト運輸
-	ShippingCarrierSagawa  ShippingCarrier = 2 // 佐川急便
+	ShippingCarrierUnknown   ShippingCarrier = 0
+	ShippingCarrierYamato    ShippingCarrier = 1 // ヤマト運輸
+	ShippingCarrierSagawa    ShippingCarrier = 2 // 佐川急便
+	ShippingCarrierJapanPost ShippingCarrier = 3 // 日本郵便
+)
+
+// ShippingLabelFormat - 送り状の出力形式
+type ShippingLabelFormat int32
+
+const (
+	ShippingLabelFormatDefault   ShippingLabelFormat = 0 // 配送会社の標準形式
+	ShippingLabelFormatClickPost ShippingLabelFormat = 1 // クリックポスト（日本郵便）
 )
 
 // ShippingSize - 配送時の箱の大きさ
@@ -34,40 +44,52 @@ const (
 	ShippingSize60      ShippingSize = 1 // 箱のサイズ:60
 	ShippingSize80      ShippingSize = 2 // 箱のサイズ:80
 	ShippingSize100     ShippingSize = 3 // 箱のサイズ:100
+	ShippingSize120     ShippingSize = 4 // 箱のサイズ:120
+	ShippingSize140     ShippingSize = 5 // 箱のサイズ:140
+	ShippingSize160     ShippingSize = 6 // 箱のサイズ:160
 )
 
 // OrderFulfillment - 注文配送情報
 type OrderFulfillment struct {
-	ID                string            `gorm:"primaryKey;<-:create"` // 注文配送ID
-	OrderID           string            `gorm:""`                     // 注文履歴ID
-	AddressRevisionID int64             `gorm:"default:null"`         // 配送先情報ID
-	Status            FulfillmentStatus `gorm:""`                     // 配送ステータス
-	TrackingNumber    string            `gorm:"default:null"`         // 配送伝票番号
-	ShippingCarrier   ShippingCarrier   `gorm:""`                     // 配送会社
-	ShippingType      ShippingType      `gorm:""`                     // 配送方法
-	BoxNumber         int64             `gorm:""`                     // 箱の通番
-	BoxSize           ShippingSize      `gorm:""`                     // 箱の大きさ
-	BoxRate           int64             `gorm:""`                     // 箱の占有率
-	ShippedAt         time.Time         `gorm:"default:null"`         // 配送日時
-	CreatedAt         time.Time         `gorm:"<-:create"`            // 登録日時
-	UpdatedAt         time.Time         `gorm:""`                     // 更新日時
+	ID                 string             `gorm:"primaryKey;<-:create"` // 注文配送ID
+	OrderID            string             `gorm:""`                     // 注文履歴ID
+	AddressRevisionID  int64              `gorm:"default:null"`         // 配送先情報ID
+	Status             FulfillmentStatus  `gorm:""`                     // 配送ステータス
+	TrackingNumber     string             `gorm:"default:null"`         // 配送伝票番号
+	ShippingCarrier    ShippingCarrier    `gorm:""`                     // 配送会社
+	ShippingType       ShippingType       `gorm:""`                     // 配送方法
+	BoxNumber          int64              `gorm:""`                     // 箱の通番
+	BoxSize            ShippingSize       `gorm:""`                     // 箱の大きさ
+	BoxRate            int64              `gorm:""`                     // 箱の占有率
+	DeliveryDate       time.Time          `gorm:"default:null"`         // お届け希望日
+	DeliveryTimeWindow DeliveryTimeWindow `gorm:""`                     // お届け希望時間帯
+	ShippedAt          time.Time          `gorm:"default:null"`         // 配送日時
+	DeliveredAt        time.Time          `gorm:"default:null"`         // 配達完了日時
+	CreatedAt          time.Time          `gorm:"<-:create"`            // 登録日時
+	UpdatedAt          time.Time          `gorm:""`                     // 更新日時
 }
 
 type OrderFulfillments []*OrderFulfillment
 
 type NewOrderFulfillmentParams struct {
-	OrderID string
-	Pickup  bool
-	Address *entity.Address
-	Basket  *CartBasket
+	OrderID            string
+	Pickup             bool
+	Address            *entity.Address
+	Basket             *CartBasket
+	DeliveryDate       time.Time
+	DeliveryTimeWindow DeliveryTimeWindow
 }
 
 type NewOrderFulfillmentsParams struct {
-	OrderID  string
-	Pickup   bool
-	Address  *entity.Address
-	Baskets  CartBaskets
-	Products map[string]*Product
+	OrderID            string
+	Pickup             bool
+	Address            *entity.Address
+	Baskets            CartBaskets
+	Destinations       OrderDestinations
+	Products           map[string]*Product
+	Discounts          PromotionDiscounts
+	DeliveryDate       time.Time
+	DeliveryTimeWindow DeliveryTimeWindow
 }
 
 func (s ShippingSize) String() string {
@@ -78,6 +100,12 @@ func (s ShippingSize) String() string {
 		return "80"
 	case ShippingSize100:
 		return "100"
+	case ShippingSize120:
+		return "120"
+	case ShippingSize140:
+		return "140"
+	case ShippingSize160:
+		return "160"
 	default:
 		return ""
 	}
@@ -85,65 +113,129 @@ func (s ShippingSize) String() string {
 
 func NewOrderFulfillment(params *NewOrderFulfillmentParams) *OrderFulfillment {
 	var (
-		shippingType      ShippingType
-		addressRevisionID int64
+		shippingType       ShippingType
+		addressRevisionID  int64
+		deliveryDate       time.Time
+		deliveryTimeWindow DeliveryTimeWindow
 	)
 	if params.Pickup {
 		shippingType = ShippingTypePickup
 	} else {
 		shippingType = params.Basket.BoxType
 		addressRevisionID = params.Address.AddressRevision.ID
+		deliveryDate = params.DeliveryDate
+		deliveryTimeWindow = params.DeliveryTimeWindow
 	}
 	return &OrderFulfillment{
-		ID:                uuid.Base58Encode(uuid.New()),
-		OrderID:           params.OrderID,
-		AddressRevisionID: addressRevisionID,
-		Status:            FulfillmentStatusUnfulfilled,
-		TrackingNumber:    "",
-		ShippingCarrier:   ShippingCarrierUnknown,
-		ShippingType:      shippingType,
-		BoxNumber:         params.Basket.BoxNumber,
-		BoxSize:           params.Basket.BoxSize,
-		BoxRate:           params.Basket.BoxRate,
+		ID:                 uuid.Base58Encode(uuid.New()),
+		OrderID:            params.OrderID,
+		AddressRevisionID:  addressRevisionID,
+		Status:             FulfillmentStatusUnfulfilled,
+		TrackingNumber:     "",
+		ShippingCarrier:    ShippingCarrierUnknown,
+		ShippingType:       shippingType,
+		BoxNumber:          params.Basket.BoxNumber,
+		BoxSize:            params.Basket.BoxSize,
+		BoxRate:            params.Basket.BoxRate,
+		DeliveryDate:       deliveryDate,
+		DeliveryTimeWindow: deliveryTimeWindow,
 	}
 }
 
 func NewOrderFulfillments(params *NewOrderFulfillmentsParams) (OrderFulfillments, OrderItems, error) {
-	fulfillments := make(OrderFulfillments, len(params.Baskets))
+	destinations := params.Destinations
+	if len(destinations) == 0 {
+		destinations = OrderDestinations{{Address: params.Address, Baskets: params.Baskets}}
+	}
+	fulfillments := make(OrderFulfillments, 0, len(params.Baskets))
 	items := make(OrderItems, 0, len(params.Baskets))
-	for i, basket := range params.Baskets {
-		fparams := &NewOrderFulfillmentParams{
-			OrderID: params.OrderID,
-			Pickup:  params.Pickup,
-			Address: params.Address,
-			Basket:  basket,
+	for _, destination := range destinations {
+		for _, basket := range destination.Baskets {
+			fparams := &NewOrderFulfillmentParams{
+				OrderID:            params.OrderID,
+				Pickup:             params.Pickup,
+				Address:            destination.Address,
+				Basket:             basket,
+				DeliveryDate:       params.DeliveryDate,
+				DeliveryTimeWindow: params.DeliveryTimeWindow,
+			}
+			f := NewOrderFulfillment(fparams)
+			iparams := &NewOrderItemsParams{
+				OrderID:     params.OrderID,
+				Fulfillment: f,
+				Items:       basket.Items,
+				Products:    params.Products,
+			}
+			is, err := NewOrderItems(iparams)
+			if err != nil {
+				return nil, nil, err
+			}
+			fulfillments = append(fulfillments, f)
+			items = append(items, is...)
 		}
-		f := NewOrderFulfillment(fparams)
-		iparams := &NewOrderItemsParams{
-			OrderID:     params.OrderID,
-			Fulfillment: f,
-			Items:       basket.Items,
-			Products:    params.Products,
-		}
-		is, err := NewOrderItems(iparams)
-		if err != nil {
-			return nil, nil, err
-		}
-		fulfillments[i] = f
-		items = append(items, is...)
 	}
+	items.setDiscounts(params.Discounts, params.Products)
 	return fulfillments, items, nil
 }
 
+// HasDeliverySlot - お届け希望日時が指定されているか
+func (f *OrderFulfillment) HasDeliverySlot() bool {
+	return !f.DeliveryDate.IsZero() || f.DeliveryTimeWindow != DeliveryTimeWindowUnspecified
+}
+
+// Fulfilled - 発送済みか（配達完了も含む）
+func (f *OrderFulfillment) Fulfilled() bool {
+	return f.Status == FulfillmentStatusFulfilled || f.Status == FulfillmentStatusDelivered
+}
+
+// Delivered - 配達完了しているか
+func (f *OrderFulfillment) Delivered() bool {
+	return f.Status == FulfillmentStatusDelivered
+}
+
 func (fs OrderFulfillments) Fulfilled() bool {
 	for i := range fs {
-		if fs[i].Status != FulfillmentStatusFulfilled {
+		if !fs[i].Fulfilled() {
+			return false
+		}
+	}
+	return true
+}
+
+func (fs OrderFulfillments) Delivered() bool {
+	if len(fs) == 0 {
+		return false
+	}
+	for i := range fs {
+		if !fs[i].Delivered() {
 			return false
 		}
 	}
 	return true
 }
 
+// LatestDeliveredAt - 最後に配達完了した日時
+func (fs OrderFulfillments) LatestDeliveredAt() time.Time {
+	var res time.Time
+	for i := range fs {
+		if fs[i].DeliveredAt.After(res) {
+			res = fs[i].DeliveredAt
+		}
+	}
+	return res
+}
+
+func (fs OrderFulfillments) TrackingNumbers() []string {
+	res := set.NewEmpty[string](len(fs))
+	for _, f := range fs {
+		if f.TrackingNumber == "" {
+			continue
+		}
+		res.Add(f.TrackingNumber)
+	}
+	return res.Slice()
+}
+
 func (fs OrderFulfillments) AddressRevisionIDs() []int64 {
 	res := set.NewEmpty[int64](len(fs))
 	for _, f := range fs {
@@ -2,8 +2,10 @@ package entity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -101,6 +103,57 @@ func TestOrderFulfillment(t *testing.T) {
 				BoxRate:           80,
 			},
 		},
+		{
+			name: "success with delivery slot",
+			params: &NewOrderFulfillmentParams{
+				OrderID: "order-id",
+				Address: &entity.Address{
+					AddressRevision: entity.AddressRevision{
+						ID:             1,
+						AddressID:      "address-id",
+						Lastname:       "&.",
+						Firstname:      "購入者",
+						PostalCode:     "1000014",
+						PrefectureCode: 13,
+						City:           "千代田区",
+						AddressLine1:   "永田町1-7-1",
+						AddressLine2:   "",
+						PhoneNumber:    "",
+					},
+					ID:        "address-id",
+					UserID:    "user-id",
+					IsDefault: false,
+				},
+				Basket: &CartBasket{
+					BoxNumber: 1,
+					BoxType:   ShippingTypeFrozen,
+					BoxSize:   ShippingSize60,
+					BoxRate:   80,
+					Items: []*CartItem{
+						{
+							ProductID: "product-id01",
+							Quantity:  1,
+						},
+					},
+					CoordinatorID: "coordinator-id",
+				},
+				DeliveryDate:       jst.Date(2026, 10, 21, 0, 0, 0, 0),
+				DeliveryTimeWindow: DeliveryTimeWindowMorning,
+			},
+			expect: &OrderFulfillment{
+				OrderID:            "order-id",
+				AddressRevisionID:  1,
+				Status:             FulfillmentStatusUnfulfilled,
+				TrackingNumber:     "",
+				ShippingCarrier:    ShippingCarrierUnknown,
+				ShippingType:       ShippingTypeFrozen,
+				BoxNumber:          1,
+				BoxSize:            ShippingSize60,
+				BoxRate:            80,
+				DeliveryDate:       jst.Date(2026, 10, 21, 0, 0, 0, 0),
+				DeliveryTimeWindow: DeliveryTimeWindowMorning,
+			},
+		},
 		{
 			name: "success with pickup",
 			params: &NewOrderFulfillmentParams{
@@ -252,11 +305,95 @@ func TestOrderFulfillments(t *testing.T) {
 					ProductRevisionID: 1,
 					OrderID:           "order-id",
 					Quantity:          1,
+					TaxRate:           10,
 				},
 				{
 					ProductRevisionID: 2,
 					OrderID:           "order-id",
 					Quantity:          2,
+					TaxRate:           10,
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with multiple destinations",
+			params: &NewOrderFulfillmentsParams{
+				OrderID: "order-id",
+				Destinations: OrderDestinations{
+					{
+						Address: &entity.Address{AddressRevision: entity.AddressRevision{ID: 1, PrefectureCode: 13}},
+						Baskets: CartBaskets{
+							{
+								BoxNumber:     1,
+								BoxType:       ShippingTypeNormal,
+								BoxSize:       ShippingSize60,
+								BoxRate:       80,
+								Items:         CartItems{{ProductID: "product-id01", Quantity: 1}},
+								CoordinatorID: "coordinator-id",
+							},
+						},
+					},
+					{
+						Address: &entity.Address{AddressRevision: entity.AddressRevision{ID: 2, PrefectureCode: 37}},
+						Baskets: CartBaskets{
+							{
+								BoxNumber:     1,
+								BoxType:       ShippingTypeNormal,
+								BoxSize:       ShippingSize60,
+								BoxRate:       80,
+								Items:         CartItems{{ProductID: "product-id01", Quantity: 2}},
+								CoordinatorID: "coordinator-id",
+							},
+						},
+					},
+				},
+				Products: map[string]*Product{
+					"product-id01": {
+						ID:   "product-id01",
+						Name: "じゃがいも",
+						ProductRevision: ProductRevision{
+							ID:        1,
+							ProductID: "product-id01",
+							Price:     500,
+						},
+					},
+				},
+			},
+			expectFulfillments: OrderFulfillments{
+				{
+					OrderID:           "order-id",
+					AddressRevisionID: 1,
+					Status:            FulfillmentStatusUnfulfilled,
+					ShippingCarrier:   ShippingCarrierUnknown,
+					ShippingType:      ShippingTypeNormal,
+					BoxNumber:         1,
+					BoxSize:           ShippingSize60,
+					BoxRate:           80,
+				},
+				{
+					OrderID:           "order-id",
+					AddressRevisionID: 2,
+					Status:            FulfillmentStatusUnfulfilled,
+					ShippingCarrier:   ShippingCarrierUnknown,
+					ShippingType:      ShippingTypeNormal,
+					BoxNumber:         1,
+					BoxSize:           ShippingSize60,
+					BoxRate:           80,
+				},
+			},
+			expectItems: OrderItems{
+				{
+					ProductRevisionID: 1,
+					OrderID:           "order-id",
+					Quantity:          1,
+					TaxRate:           10,
+				},
+				{
+					ProductRevisionID: 1,
+					OrderID:           "order-id",
+					Quantity:          2,
+					TaxRate:           10,
 				},
 			},
 			expectErr: nil,
@@ -341,11 +478,13 @@ func TestOrderFulfillments(t *testing.T) {
 					ProductRevisionID: 1,
 					OrderID:           "order-id",
 					Quantity:          1,
+					TaxRate:           10,
 				},
 				{
 					ProductRevisionID: 2,
 					OrderID:           "order-id",
 					Quantity:          2,
+					TaxRate:           10,
 				},
 			},
 			expectErr: nil,
@@ -414,6 +553,37 @@ func TestOrderFulfillments(t *testing.T) {
 	}
 }
 
+func TestOrderFulfillment_HasDeliverySlot(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		fulfillment *OrderFulfillment
+		expect      bool
+	}{
+		{
+			name:        "with delivery date",
+			fulfillment: &OrderFulfillment{DeliveryDate: jst.Date(2026, 10, 21, 0, 0, 0, 0)},
+			expect:      true,
+		},
+		{
+			name:        "with time window",
+			fulfillment: &OrderFulfillment{DeliveryTimeWindow: DeliveryTimeWindow1416},
+			expect:      true,
+		},
+		{
+			name:        "unspecified",
+			fulfillment: &OrderFulfillment{},
+			expect:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.fulfillment.HasDeliverySlot())
+		})
+	}
+}
+
 func TestOrderFulfillments_Fulfilled(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -455,6 +625,23 @@ func TestOrderFulfillments_Fulfilled(t *testing.T) {
 			},
 			expect: false,
 		},
+		{
+			name: "success delivered",
+			fulfillments: OrderFulfillments{
+				{
+					ID:                "fulfillment-id",
+					OrderID:           "order-id",
+					AddressRevisionID: 1,
+					Status:            FulfillmentStatusDelivered,
+					ShippingCarrier:   ShippingCarrierYamato,
+					ShippingType:      ShippingTypeNormal,
+					BoxNumber:         1,
+					BoxSize:           ShippingSize100,
+					BoxRate:           80,
+				},
+			},
+			expect: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -464,6 +651,59 @@ func TestOrderFulfillments_Fulfilled(t *testing.T) {
 	}
 }
 
+func TestOrderFulfillments_Delivered(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	tests := []struct {
+		name              string
+		fulfillments      OrderFulfillments
+		expect            bool
+		expectDeliveredAt time.Time
+	}{
+		{
+			name: "all delivered",
+			fulfillments: OrderFulfillments{
+				{ID: "fulfillment-id01", Status: FulfillmentStatusDelivered, DeliveredAt: now.Add(-time.Hour)},
+				{ID: "fulfillment-id02", Status: FulfillmentStatusDelivered, DeliveredAt: now},
+			},
+			expect:            true,
+			expectDeliveredAt: now,
+		},
+		{
+			name: "partially delivered",
+			fulfillments: OrderFulfillments{
+				{ID: "fulfillment-id01", Status: FulfillmentStatusDelivered, DeliveredAt: now},
+				{ID: "fulfillment-id02", Status: FulfillmentStatusFulfilled},
+			},
+			expect:            false,
+			expectDeliveredAt: now,
+		},
+		{
+			name:              "empty",
+			fulfillments:      OrderFulfillments{},
+			expect:            false,
+			expectDeliveredAt: time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.fulfillments.Delivered())
+			assert.Equal(t, tt.expectDeliveredAt, tt.fulfillments.LatestDeliveredAt())
+		})
+	}
+}
+
+func TestOrderFulfillments_TrackingNumbers(t *testing.T) {
+	t.Parallel()
+	fulfillments := OrderFulfillments{
+		{ID: "fulfillment-id01", TrackingNumber: "123456789012"},
+		{ID: "fulfillment-id02", TrackingNumber: ""},
+		{ID: "fulfillment-id03", TrackingNumber: "123456789012"},
+	}
+	assert.Equal(t, []string{"123456789012"}, fulfillments.TrackingNumbers())
+}
+
 func TestOrderFulfillments_AddressRevisionIDs(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
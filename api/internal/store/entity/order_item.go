@@ -8,16 +8,27 @@ import (
 
 // OrderItem - 注文商品情報
 type OrderItem struct {
-	FulfillmentID     string    `gorm:"primaryKey;<-:create"` // 注文配送ID
-	ProductRevisionID int64     `gorm:"primaryKey;<-:create"` // 商品ID
-	OrderID           string    `gorm:""`                     // 注文履歴ID
-	Quantity          int64     `gorm:""`                     // 購入数量
-	CreatedAt         time.Time `gorm:"<-:create"`            // 登録日時
-	UpdatedAt         time.Time `gorm:""`                     // 更新日時
+	FulfillmentID     string             `gorm:"primaryKey;<-:create"` // 注文配送ID
+	ProductRevisionID int64              `gorm:"primaryKey;<-:create"` // 商品ID
+	OrderID           string             `gorm:""`                     // 注文履歴ID
+	Quantity          int64              `gorm:""`                     // 購入数量
+	TaxRate           int64              `gorm:""`                     // 適用税率(%)
+	Discount          int64              `gorm:""`                     // 割引金額(税込)
+	Discounts         OrderItemDiscounts `gorm:"-"`                    // 割引内訳
+	CreatedAt         time.Time          `gorm:"<-:create"`            // 登録日時
+	UpdatedAt         time.Time          `gorm:""`                     // 更新日時
 }
 
 type OrderItems []*OrderItem
 
+// OrderItemDiscount - 注文商品の割引内訳
+type OrderItemDiscount struct {
+	RuleID string `json:"ruleId"` // プロモーション適用ルールID
+	Amount int64  `json:"amount"` // 割引金額(税込)
+}
+
+type OrderItemDiscounts []*OrderItemDiscount
+
 type NewOrderItemParams struct {
 	OrderID       string
 	FulfillmentID string
@@ -38,6 +49,7 @@ func NewOrderItem(params *NewOrderItemParams) *OrderItem {
 		ProductRevisionID: params.Product.ProductRevision.ID,
 		OrderID:           params.OrderID,
 		Quantity:          params.Item.Quantity,
+		TaxRate:           params.Product.TaxCategory.Rate(),
 	}
 }
 
@@ -86,3 +98,36 @@ func (is OrderItems) GroupByOrderID() map[string]OrderItems {
 	}
 	return res
 }
+
+// setDiscounts - 商品ごとの割引金額を購入数量に応じて注文商品へ按分
+func (is OrderItems) setDiscounts(discounts PromotionDiscounts, products map[string]*Product) {
+	items := make(map[int64]OrderItems, len(is))
+	quantities := make(map[int64]int64, len(is))
+	for _, i := range is {
+		items[i.ProductRevisionID] = append(items[i.ProductRevisionID], i)
+		quantities[i.ProductRevisionID] += i.Quantity
+	}
+	for _, d := range discounts {
+		product, ok := products[d.ProductID]
+		if !ok {
+			continue
+		}
+		targets, total := items[product.ProductRevision.ID], quantities[product.ProductRevision.ID]
+		if total == 0 {
+			continue
+		}
+		remain := d.Amount
+		for idx, item := range targets {
+			amount := remain // 端数は最後の注文商品に割り当てる
+			if idx < len(targets)-1 {
+				amount = d.Amount * item.Quantity / total
+			}
+			remain -= amount
+			if amount <= 0 {
+				continue
+			}
+			item.Discount += amount
+			item.Discounts = append(item.Discounts, &OrderItemDiscount{RuleID: d.RuleID, Amount: amount})
+		}
+	}
+}
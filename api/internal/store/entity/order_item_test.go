@@ -26,9 +26,10 @@ func TestOrderItem(t *testing.T) {
 					ID:   "product-id",
 					Name: "じゃがいも",
 					ProductRevision: ProductRevision{
-						ID:        1,
-						ProductID: "product-id01",
-						Price:     500,
+						ID:          1,
+						ProductID:   "product-id01",
+						Price:       500,
+						TaxCategory: TaxCategoryReduced,
 					},
 				},
 			},
@@ -37,6 +38,7 @@ func TestOrderItem(t *testing.T) {
 				ProductRevisionID: 1,
 				OrderID:           "order-id",
 				Quantity:          1,
+				TaxRate:           8,
 			},
 		},
 	}
@@ -109,12 +111,14 @@ func TestOrderItems(t *testing.T) {
 					ProductRevisionID: 1,
 					OrderID:           "order-id",
 					Quantity:          1,
+					TaxRate:           10,
 				},
 				{
 					FulfillmentID:     "fulfillment-id",
 					ProductRevisionID: 2,
 					OrderID:           "order-id",
 					Quantity:          2,
+					TaxRate:           10,
 				},
 			},
 			expectErr: nil,
@@ -310,3 +314,53 @@ func TestOrderItems_GroupByOrderID(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderItems_SetDiscounts(t *testing.T) {
+	t.Parallel()
+	items := OrderItems{
+		{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, OrderID: "order-id", Quantity: 1},
+		{FulfillmentID: "fulfillment-id02", ProductRevisionID: 1, OrderID: "order-id", Quantity: 2},
+		{FulfillmentID: "fulfillment-id01", ProductRevisionID: 2, OrderID: "order-id", Quantity: 1},
+	}
+	discounts := PromotionDiscounts{
+		{ProductID: "product-id01", RuleID: "rule-id01", Amount: 100},
+		{ProductID: "product-id02", RuleID: "rule-id01", Amount: 50},
+		{ProductID: "product-id02", RuleID: "rule-id02", Amount: 30},
+		{ProductID: "product-id03", RuleID: "rule-id02", Amount: 30},
+	}
+	products := map[string]*Product{
+		"product-id01": {ID: "product-id01", ProductRevision: ProductRevision{ID: 1}},
+		"product-id02": {ID: "product-id02", ProductRevision: ProductRevision{ID: 2}},
+	}
+	expect := OrderItems{
+		{
+			FulfillmentID:     "fulfillment-id01",
+			ProductRevisionID: 1,
+			OrderID:           "order-id",
+			Quantity:          1,
+			Discount:          33,
+			Discounts:         OrderItemDiscounts{{RuleID: "rule-id01", Amount: 33}},
+		},
+		{
+			FulfillmentID:     "fulfillment-id02",
+			ProductRevisionID: 1,
+			OrderID:           "order-id",
+			Quantity:          2,
+			Discount:          67,
+			Discounts:         OrderItemDiscounts{{RuleID: "rule-id01", Amount: 67}},
+		},
+		{
+			FulfillmentID:     "fulfillment-id01",
+			ProductRevisionID: 2,
+			OrderID:           "order-id",
+			Quantity:          1,
+			Discount:          80,
+			Discounts: OrderItemDiscounts{
+				{RuleID: "rule-id01", Amount: 50},
+				{RuleID: "rule-id02", Amount: 30},
+			},
+		},
+	}
+	items.setDiscounts(discounts, products)
+	assert.Equal(t, expect, items)
+}
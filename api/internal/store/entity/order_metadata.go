@@ -6,6 +6,19 @@ import (
 	"github.com/and-period/furumaru/api/internal/user/entity"
 )
 
+// NoshiType - のし種別
+type NoshiType int32
+
+const (
+	NoshiTypeNone     NoshiType = 0 // のしなし
+	NoshiTypeOchugen  NoshiType = 1 // 御中元
+	NoshiTypeOseibo   NoshiType = 2 // 御歳暮
+	NoshiTypeOiwai    NoshiType = 3 // 御祝
+	NoshiTypeUchiiwai NoshiType = 4 // 内祝
+	NoshiTypeOrei     NoshiType = 5 // 御礼
+	NoshiTypeSoshina  NoshiType = 6 // 粗品
+)
+
 // OrderMetadata - 注文付加情報
 type OrderMetadata struct {
 	OrderID         string    `gorm:"primaryKey;<-:create"` // 注文履歴ID
@@ -13,6 +26,10 @@ type OrderMetadata struct {
 	PickupAt        time.Time `gorm:"default:null"`         // 受け取り日時
 	PickupLocation  string    `gorm:"default:null"`         // 受け取り場所
 	ShippingMessage string    `gorm:"default:null"`         // 発送時メッセージ
+	Gift            bool      `gorm:""`                     // ギフト注文
+	NoshiType       NoshiType `gorm:""`                     // のし種別
+	NoshiName       string    `gorm:"default:null"`         // のし名入れ
+	MessageCard     string    `gorm:"default:null"`         // メッセージカード本文
 	CreatedAt       time.Time `gorm:"<-:create"`            // 作成日時
 	UpdatedAt       time.Time `gorm:""`                     // 更新日時
 }
@@ -23,10 +40,38 @@ type NewOrderMetadataParams struct {
 	OrderID         string
 	Pickup          bool
 	ShippingAddress *entity.Address
+	Destinations    OrderDestinations
 	ShippingMessage string
 	PickupAt        time.Time
 	PickupLocation  string
 	OrderRequest    string
+	Gift            *OrderGiftParams
+}
+
+// OrderGiftParams - ギフト注文の指定内容
+type OrderGiftParams struct {
+	NoshiType   NoshiType
+	NoshiName   string
+	MessageCard string
+}
+
+func (t NoshiType) String() string {
+	switch t {
+	case NoshiTypeOchugen:
+		return "御中元"
+	case NoshiTypeOseibo:
+		return "御歳暮"
+	case NoshiTypeOiwai:
+		return "御祝"
+	case NoshiTypeUchiiwai:
+		return "内祝"
+	case NoshiTypeOrei:
+		return "御礼"
+	case NoshiTypeSoshina:
+		return "粗品"
+	default:
+		return ""
+	}
 }
 
 func NewOrderMetadata(params *NewOrderMetadataParams) *OrderMetadata {
@@ -38,12 +83,35 @@ func NewOrderMetadata(params *NewOrderMetadataParams) *OrderMetadata {
 	case params.Pickup:
 		res.PickupAt = params.PickupAt
 		res.PickupLocation = params.PickupLocation
-	case params.ShippingAddress != nil:
+	case params.ShippingAddress != nil, len(params.Destinations) > 0:
 		res.ShippingMessage = params.ShippingMessage
+		// ギフト指定は配送先へ発送する場合のみ有効（複数配送先の場合は全配送先に適用）
+		if params.Gift != nil {
+			res.Gift = true
+			res.NoshiType = params.Gift.NoshiType
+			res.NoshiName = params.Gift.NoshiName
+			res.MessageCard = params.Gift.MessageCard
+		}
 	}
 	return res
 }
 
+// HasNoshi - のし指定の有無
+func (m *OrderMetadata) HasNoshi() bool {
+	return m.Gift && m.NoshiType != NoshiTypeNone
+}
+
+// Noshi - のし表記（表書きと名入れ）
+func (m *OrderMetadata) Noshi() string {
+	if !m.HasNoshi() {
+		return ""
+	}
+	if m.NoshiName == "" {
+		return m.NoshiType.String()
+	}
+	return m.NoshiType.String() + "（" + m.NoshiName + "）"
+}
+
 func (ms MultiOrderMetadata) MapByOrderID() map[string]*OrderMetadata {
 	res := make(map[string]*OrderMetadata, len(ms))
 	for _, m := range ms {
@@ -42,6 +42,70 @@ func TestNewOrderMetadata(t *testing.T) {
 				ShippingMessage: "ご注文ありがとうございます！",
 			},
 		},
+		{
+			name: "success with gift",
+			params: &NewOrderMetadataParams{
+				OrderID:         "order-id",
+				Pickup:          false,
+				ShippingAddress: &entity.Address{ID: "address-id"},
+				ShippingMessage: "ご注文ありがとうございます！",
+				Gift: &OrderGiftParams{
+					NoshiType:   NoshiTypeOchugen,
+					NoshiName:   "山田",
+					MessageCard: "いつもお世話になっております。",
+				},
+			},
+			expect: &OrderMetadata{
+				OrderID:         "order-id",
+				ShippingMessage: "ご注文ありがとうございます！",
+				Gift:            true,
+				NoshiType:       NoshiTypeOchugen,
+				NoshiName:       "山田",
+				MessageCard:     "いつもお世話になっております。",
+			},
+		},
+		{
+			name: "success with gift to multiple destinations",
+			params: &NewOrderMetadataParams{
+				OrderID: "order-id",
+				Pickup:  false,
+				Destinations: OrderDestinations{
+					{Address: &entity.Address{ID: "address-id01"}},
+					{Address: &entity.Address{ID: "address-id02"}},
+				},
+				ShippingMessage: "ご注文ありがとうございます！",
+				Gift: &OrderGiftParams{
+					NoshiType:   NoshiTypeOseibo,
+					NoshiName:   "山田",
+					MessageCard: "いつもお世話になっております。",
+				},
+			},
+			expect: &OrderMetadata{
+				OrderID:         "order-id",
+				ShippingMessage: "ご注文ありがとうございます！",
+				Gift:            true,
+				NoshiType:       NoshiTypeOseibo,
+				NoshiName:       "山田",
+				MessageCard:     "いつもお世話になっております。",
+			},
+		},
+		{
+			name: "success with pickup ignore gift",
+			params: &NewOrderMetadataParams{
+				OrderID:        "order-id",
+				Pickup:         true,
+				PickupAt:       jst.Date(2022, 1, 1, 10, 0, 0, 0),
+				PickupLocation: "店舗A",
+				Gift: &OrderGiftParams{
+					NoshiType: NoshiTypeOseibo,
+				},
+			},
+			expect: &OrderMetadata{
+				OrderID:        "order-id",
+				PickupAt:       jst.Date(2022, 1, 1, 10, 0, 0, 0),
+				PickupLocation: "店舗A",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -52,6 +116,42 @@ func TestNewOrderMetadata(t *testing.T) {
 	}
 }
 
+func TestOrderMetadata_Noshi(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		metadata *OrderMetadata
+		expect   string
+	}{
+		{
+			name:     "with name",
+			metadata: &OrderMetadata{Gift: true, NoshiType: NoshiTypeOseibo, NoshiName: "山田"},
+			expect:   "御歳暮（山田）",
+		},
+		{
+			name:     "without name",
+			metadata: &OrderMetadata{Gift: true, NoshiType: NoshiTypeOiwai},
+			expect:   "御祝",
+		},
+		{
+			name:     "none",
+			metadata: &OrderMetadata{Gift: true, NoshiType: NoshiTypeNone, NoshiName: "山田"},
+			expect:   "",
+		},
+		{
+			name:     "not gift",
+			metadata: &OrderMetadata{Gift: false, NoshiType: NoshiTypeOchugen},
+			expect:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.metadata.Noshi())
+		})
+	}
+}
+
 func TestMultiOrderMetadata_MapByOrderID(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -22,19 +22,21 @@ const (
 type PaymentStatus int32
 
 const (
-	PaymentStatusUnknown    PaymentStatus = 0
-	PaymentStatusPending    PaymentStatus = 1 // 保留中・未支払い
-	PaymentStatusAuthorized PaymentStatus = 2 // 仮売上・オーソリ
-	PaymentStatusCaptured   PaymentStatus = 3 // 実売上・キャプチャ
-	PaymentStatusCanceled   PaymentStatus = 4 // キャンセル
-	PaymentStatusRefunded   PaymentStatus = 5 // 返金
-	PaymentStatusFailed     PaymentStatus = 6 // 失敗
-	PaymentStatusExpired    PaymentStatus = 7 // 期限切れ
+	PaymentStatusUnknown           PaymentStatus = 0
+	PaymentStatusPending           PaymentStatus = 1 // 保留中・未支払い
+	PaymentStatusAuthorized        PaymentStatus = 2 // 仮売上・オーソリ
+	PaymentStatusCaptured          PaymentStatus = 3 // 実売上・キャプチャ
+	PaymentStatusCanceled          PaymentStatus = 4 // キャンセル
+	PaymentStatusRefunded          PaymentStatus = 5 // 返金
+	PaymentStatusFailed            PaymentStatus = 6 // 失敗
+	PaymentStatusExpired           PaymentStatus = 7 // 期限切れ
+	PaymentStatusPartiallyRefunded PaymentStatus = 8 // 一部返金
 )
 
 var (
-	PaymentSuccessStatuses  = []PaymentStatus{PaymentStatusAuthorized, PaymentStatusCaptured}
+	PaymentSuccessStatuses  = []PaymentStatus{PaymentStatusAuthorized, PaymentStatusCaptured, PaymentStatusPartiallyRefunded}
 	PaymentFailedStatuses   = []PaymentStatus{PaymentStatusFailed, PaymentStatusExpired}
+	PaymentUnpaidStatuses   = []PaymentStatus{PaymentStatusUnknown, PaymentStatusPending}
 	PaymentRefundedStatuses = []PaymentStatus{PaymentStatusCanceled, PaymentStatusRefunded}
 )
 
@@ -108,6 +110,10 @@ type OrderPayment struct {
 	Discount          int64               `gorm:""`                     // 割引金額(税込)
 	ShippingFee       int64               `gorm:""`                     // 配送手数料(税込)
 	Tax               int64               `gorm:""`                     // 消費税(内税)
+	StandardTaxTarget int64               `gorm:""`                     // 標準税率(10%)対象金額(税込)
+	StandardTax       int64               `gorm:""`                     // 標準税率(10%)消費税額(内税)
+	ReducedTaxTarget  int64               `gorm:""`                     // 軽減税率(8%)対象金額(税込)
+	ReducedTax        int64               `gorm:""`                     // 軽減税率(8%)消費税額(内税)
 	Total             int64               `gorm:""`                     // 合計金額(税込)
 	RefundTotal       int64               `gorm:""`                     // 返金金額
 	RefundType        RefundType          `gorm:""`                     // 注文キャンセル種別
@@ -118,6 +124,8 @@ type OrderPayment struct {
 	FailedAt          time.Time           `gorm:"default:null"`         // 決済失敗日時
 	CanceledAt        time.Time           `gorm:"default:null"`         // 注文キャンセル日時（実売上前）
 	RefundedAt        time.Time           `gorm:"default:null"`         // 注文キャンセル日時（実売上後）
+	SettledAt         time.Time           `gorm:"default:null"`         // 精算日時
+	SettledRefund     int64               `gorm:""`                     // 精算済み返金金額
 	CreatedAt         time.Time           `gorm:"<-:create"`            // 登録日時
 	UpdatedAt         time.Time           `gorm:""`                     // 更新日時
 }
@@ -125,14 +133,17 @@ type OrderPayment struct {
 type OrderPayments []*OrderPayment
 
 type NewProductOrderPaymentParams struct {
-	OrderID    string
-	Pickup     bool
-	MethodType PaymentMethodType
-	Address    *entity.Address
-	Baskets    CartBaskets
-	Products   Products
-	Shipping   *Shipping
-	Promotion  *Promotion
+	OrderID         string
+	Pickup          bool
+	MethodType      PaymentMethodType
+	Address         *entity.Address // 請求先住所
+	ShippingAddress *entity.Address // 配送先住所
+	Baskets         CartBaskets
+	Destinations    OrderDestinations
+	Products        Products
+	ProductTypes    ProductTypes
+	Shipping        *Shipping
+	Promotion       *Promotion
 }
 
 type NewExperienceOrderPaymentParams struct {
@@ -183,19 +194,30 @@ func NewProductOrderPayment(params *NewProductOrderPaymentParams) (*OrderPayment
 	var (
 		addressRevisionID int64
 		prefectureCode    int32
+		postalCode        string
 	)
 	if params.Address != nil {
 		addressRevisionID = params.Address.AddressRevision.ID
-		prefectureCode = params.Address.PrefectureCode
+		if err := codes.ValidatePrefectureValues(params.Address.PrefectureCode); err != nil {
+			return nil, err
+		}
+	}
+	// 配送料金・配送対象外地域の判定は、請求先ではなく配送先の住所で行う
+	if params.ShippingAddress != nil {
+		prefectureCode = params.ShippingAddress.PrefectureCode
+		postalCode = params.ShippingAddress.PostalCode
 		if err := codes.ValidatePrefectureValues(prefectureCode); err != nil {
 			return nil, err
 		}
 	}
 	sparams := &NewProductOrderPaymentSummaryParams{
 		PrefectureCode: prefectureCode,
+		PostalCode:     postalCode,
 		Pickup:         params.Pickup,
 		Baskets:        params.Baskets,
+		Destinations:   params.Destinations,
 		Products:       params.Products,
+		ProductTypes:   params.ProductTypes,
 		Shipping:       params.Shipping,
 		Promotion:      params.Promotion,
 	}
@@ -203,7 +225,7 @@ func NewProductOrderPayment(params *NewProductOrderPaymentParams) (*OrderPayment
 	if err != nil {
 		return nil, err
 	}
-	return &OrderPayment{
+	payment := &OrderPayment{
 		OrderID:           params.OrderID,
 		AddressRevisionID: addressRevisionID,
 		Status:            PaymentStatusPending,
@@ -214,7 +236,9 @@ func NewProductOrderPayment(params *NewProductOrderPaymentParams) (*OrderPayment
 		ShippingFee:       summary.ShippingFee,
 		Tax:               summary.Tax,
 		Total:             summary.Total,
-	}, nil
+	}
+	payment.setTaxBreakdown(summary.TaxBreakdown)
+	return payment, nil
 }
 
 func NewExperienceOrderPayment(params *NewExperienceOrderPaymentParams) (*OrderPayment, error) {
@@ -239,7 +263,7 @@ func NewExperienceOrderPayment(params *NewExperienceOrderPaymentParams) (*OrderP
 		SeniorCount:           params.SeniorCount,
 	}
 	summary := NewExperienceOrderPaymentSummary(sparams)
-	return &OrderPayment{
+	payment := &OrderPayment{
 		OrderID:           params.OrderID,
 		AddressRevisionID: addressRevisionID,
 		Status:            PaymentStatusPending,
@@ -250,11 +274,38 @@ func NewExperienceOrderPayment(params *NewExperienceOrderPaymentParams) (*OrderP
 		ShippingFee:       summary.ShippingFee,
 		Tax:               summary.Tax,
 		Total:             summary.Total,
-	}, nil
+	}
+	payment.setTaxBreakdown(summary.TaxBreakdown)
+	return payment, nil
+}
+
+func (p *OrderPayment) setTaxBreakdown(breakdown *OrderTaxBreakdown) {
+	if breakdown == nil {
+		return
+	}
+	p.StandardTaxTarget = breakdown.StandardTarget
+	p.StandardTax = breakdown.StandardTax
+	p.ReducedTaxTarget = breakdown.ReducedTarget
+	p.ReducedTax = breakdown.ReducedTax
+}
+
+// TaxBreakdown - 税率ごとの対象金額と消費税額
+func (p *OrderPayment) TaxBreakdown() *OrderTaxBreakdown {
+	if p.StandardTaxTarget == 0 && p.ReducedTaxTarget == 0 {
+		// 税率ごとの内訳を保持していない注文は、すべて標準税率として扱う
+		return &OrderTaxBreakdown{StandardTarget: p.Total, StandardTax: p.Tax}
+	}
+	return &OrderTaxBreakdown{
+		StandardTarget: p.StandardTaxTarget,
+		StandardTax:    p.StandardTax,
+		ReducedTarget:  p.ReducedTaxTarget,
+		ReducedTax:     p.ReducedTax,
+	}
 }
 
 func (p *OrderPayment) IsCompleted() bool {
 	return p.Status == PaymentStatusCaptured ||
+		p.Status == PaymentStatusPartiallyRefunded ||
 		p.Status == PaymentStatusCanceled ||
 		p.Status == PaymentStatusRefunded ||
 		p.Status == PaymentStatusFailed ||
@@ -265,6 +316,16 @@ func (p *OrderPayment) IsCanceled() bool {
 	return p.Status == PaymentStatusCanceled || p.Status == PaymentStatusRefunded
 }
 
+// IsSettled - 精算明細に計上済みか
+func (p *OrderPayment) IsSettled() bool {
+	return !p.SettledAt.IsZero()
+}
+
+// AuthorizationExpiredAt - 与信（仮売上）の有効期限
+func (p *OrderPayment) AuthorizationExpiredAt() time.Time {
+	return p.PaidAt.Add(PreorderAuthorizationPeriod)
+}
+
 func (p *OrderPayment) IsImmediatePayment() bool {
 	return slices.Contains(ImmediatePaymentMethodTypes, p.MethodType)
 }
@@ -14,19 +14,32 @@ var (
 
 // チェックアウト前の支払い情報
 type OrderPaymentSummary struct {
-	Subtotal    int64 // 購入金額(税込)
-	Discount    int64 // 割引金額(税込)
-	ShippingFee int64 // 配送手数料(税込)
-	Tax         int64 // 消費税(内税)
-	TaxRate     int64 // 消費税率(%)
-	Total       int64 // 合計金額
+	Subtotal      int64              // 購入金額(税込)
+	Discount      int64              // 割引金額(税込)
+	ItemDiscounts PromotionDiscounts // 商品ごとの割引内訳
+	ShippingFee   int64              // 配送手数料(税込)
+	Tax           int64              // 消費税(内税)
+	TaxRate       int64              // 消費税率(%)
+	Total         int64              // 合計金額
+	TaxBreakdown  *OrderTaxBreakdown // 税率ごとの消費税内訳
+}
+
+// OrderTaxBreakdown - 税率ごとの対象金額と消費税額（適格請求書の記載事項）
+type OrderTaxBreakdown struct {
+	StandardTarget int64 // 標準税率(10%)対象金額(税込)
+	StandardTax    int64 // 標準税率(10%)消費税額(内税)
+	ReducedTarget  int64 // 軽減税率(8%)対象金額(税込)
+	ReducedTax     int64 // 軽減税率(8%)消費税額(内税)
 }
 
 type NewProductOrderPaymentSummaryParams struct {
 	PrefectureCode int32
+	PostalCode     string
 	Pickup         bool
 	Baskets        CartBaskets
+	Destinations   OrderDestinations
 	Products       Products
+	ProductTypes   ProductTypes
 	Shipping       *Shipping
 	Promotion      *Promotion
 }
@@ -52,33 +65,72 @@ func NewProductOrderPaymentSummary(params *NewProductOrderPaymentSummaryParams)
 		return &OrderPaymentSummary{TaxRate: taxRate}, nil
 	}
 	// 商品配送料金の算出
-	for _, basket := range params.Baskets {
-		if params.Pickup {
-			break // 店頭受取の場合、配送料金は算出しない
-		}
-		if params.PrefectureCode == 0 {
-			break // 配送先都道府県の指定がない場合、配送料金は算出しない
-		}
-		fee, err := params.Shipping.CalcShippingFee(basket.BoxSize, basket.BoxType, subtotal, params.PrefectureCode)
+	if !params.Pickup && len(params.Destinations) > 0 {
+		// 複数の配送先へ発送する場合、配送先ごとに配送料金を算出
+		shippingFee, err = params.Destinations.CalcShippingFee(params.Shipping, params.Products.Map())
 		if err != nil {
 			return nil, err
 		}
-		shippingFee += fee
+	} else {
+		for _, basket := range params.Baskets {
+			if params.Pickup {
+				break // 店頭受取の場合、配送料金は算出しない
+			}
+			if params.PrefectureCode == 0 {
+				break // 配送先都道府県の指定がない場合、配送料金は算出しない
+			}
+			fee, err := params.Shipping.CalcShippingFee(basket.BoxSize, basket.BoxType, subtotal, params.PrefectureCode, params.PostalCode)
+			if err != nil {
+				return nil, err
+			}
+			shippingFee += fee
+		}
 	}
 	// 割引金額の算出
-	discount := params.Promotion.CalcDiscount(subtotal, shippingFee)
-	// 支払い金額の算出（消費税額＝税込価格÷（1+消費税率）×消費税率）
+	dparams := &NewProductPromotionDiscountsParams{
+		Baskets:      params.Baskets,
+		Products:     params.Products,
+		ProductTypes: params.ProductTypes,
+		Promotion:    params.Promotion,
+	}
+	discounts, err := NewProductPromotionDiscounts(dparams)
+	if err != nil {
+		return nil, err
+	}
+	shippingDiscount := params.Promotion.CalcShippingDiscount(shippingFee)
+	discount := discounts.Total() + shippingDiscount
+	// 税率ごとの対象金額の算出（配送手数料は標準税率）
+	breakdown := &OrderTaxBreakdown{
+		StandardTarget: shippingFee - shippingDiscount,
+	}
+	products := params.Products.Map()
+	for _, basket := range params.Baskets {
+		for _, item := range basket.Items {
+			product := products[item.ProductID] // 存在しない商品は購入金額の算出時に検証済み
+			breakdown.add(product.TaxCategory, product.Price*item.Quantity)
+		}
+	}
+	for productID, ds := range discounts.GroupByProductID() {
+		product, ok := products[productID]
+		if !ok {
+			continue
+		}
+		breakdown.add(product.TaxCategory, -ds.Total())
+	}
+	breakdown.calcTax()
+	// 支払い金額の算出
 	dsubtotal := decimal.NewFromInt(subtotal).Add(decimal.NewFromInt(shippingFee))
 	ddiscount := decimal.NewFromInt(discount)
 	dtotal := dsubtotal.Sub(ddiscount)
-	dtax := dtotal.Div(one.Add(taxPercent)).Mul(taxPercent)
 	return &OrderPaymentSummary{
-		Subtotal:    subtotal,
-		Discount:    discount,
-		ShippingFee: shippingFee,
-		Tax:         dtax.IntPart(),
-		TaxRate:     taxRate,
-		Total:       dtotal.IntPart(),
+		Subtotal:      subtotal,
+		Discount:      discount,
+		ItemDiscounts: discounts,
+		ShippingFee:   shippingFee,
+		Tax:           breakdown.Tax(),
+		TaxRate:       taxRate,
+		Total:         dtotal.IntPart(),
+		TaxBreakdown:  breakdown,
 	}, nil
 }
 
@@ -107,5 +159,35 @@ func NewExperienceOrderPaymentSummary(params *NewExperienceOrderPaymentSummaryPa
 		Tax:         dtax.IntPart(),
 		TaxRate:     taxRate,
 		Total:       dtotal.IntPart(),
+		TaxBreakdown: &OrderTaxBreakdown{
+			StandardTarget: dtotal.IntPart(),
+			StandardTax:    dtax.IntPart(),
+		},
+	}
+}
+
+func (b *OrderTaxBreakdown) add(category TaxCategory, amount int64) {
+	if category == TaxCategoryReduced {
+		b.ReducedTarget += amount
+		return
+	}
+	b.StandardTarget += amount
+}
+
+// calcTax - 税率ごとに消費税額を算出（消費税額＝税込価格÷（1+消費税率）×消費税率、端数は税率ごとに1回切り捨て）
+func (b *OrderTaxBreakdown) calcTax() {
+	b.StandardTax = calcIncludedTax(b.StandardTarget, standardTaxRate)
+	b.ReducedTax = calcIncludedTax(b.ReducedTarget, reducedTaxRate)
+}
+
+func (b *OrderTaxBreakdown) Tax() int64 {
+	return b.StandardTax + b.ReducedTax
+}
+
+func calcIncludedTax(target, rate int64) int64 {
+	if target <= 0 {
+		return 0
 	}
+	drate := decimal.NewFromInt(rate).Div(percent)
+	return decimal.NewFromInt(target).Div(one.Add(drate)).Mul(drate).IntPart()
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/user/entity"
 	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/and-period/furumaru/api/pkg/set"
 	"github.com/stretchr/testify/assert"
@@ -102,6 +103,177 @@ func TestProductOrderPaymentSummary(t *testing.T) {
 				Tax:         405,
 				TaxRate:     10,
 				Total:       4460,
+				TaxBreakdown: &OrderTaxBreakdown{
+					StandardTarget: 4460,
+					StandardTax:    405,
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with reduced tax rate",
+			params: &NewProductOrderPaymentSummaryParams{
+				PrefectureCode: 13,
+				Baskets: CartBaskets{
+					{
+						BoxNumber: 1,
+						BoxType:   ShippingTypeNormal,
+						BoxSize:   ShippingSize60,
+						Items: []*CartItem{
+							{
+								ProductID: "product-id01",
+								Quantity:  1,
+							},
+							{
+								ProductID: "product-id02",
+								Quantity:  1,
+							},
+						},
+						CoordinatorID: "coordinator-id",
+					},
+				},
+				Products: []*Product{
+					{
+						ID:   "product-id01",
+						Name: "じゃがいも",
+						ProductRevision: ProductRevision{
+							ID:          1,
+							ProductID:   "product-id01",
+							Price:       500,
+							TaxCategory: TaxCategoryReduced,
+						},
+					},
+					{
+						ID:   "product-id02",
+						Name: "包丁",
+						ProductRevision: ProductRevision{
+							ID:          2,
+							ProductID:   "product-id02",
+							Price:       1980,
+							TaxCategory: TaxCategoryStandard,
+						},
+					},
+				},
+				Shipping: &Shipping{
+					ID:            "coordinator-id",
+					CoordinatorID: "coordinator-id",
+					ShippingRevision: ShippingRevision{
+						ShippingID:        "coordinator-id",
+						Box60Rates:        rates,
+						Box60Frozen:       800,
+						HasFreeShipping:   true,
+						FreeShippingRates: 3000,
+					},
+				},
+				Promotion: nil,
+			},
+			expect: &OrderPaymentSummary{
+				Subtotal:    2480,
+				Discount:    0,
+				ShippingFee: 500,
+				Tax:         262,
+				TaxRate:     10,
+				Total:       2980,
+				TaxBreakdown: &OrderTaxBreakdown{
+					StandardTarget: 2480,
+					StandardTax:    225,
+					ReducedTarget:  500,
+					ReducedTax:     37,
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with multiple destinations",
+			params: &NewProductOrderPaymentSummaryParams{
+				PrefectureCode: 13,
+				Baskets: CartBaskets{
+					{
+						BoxNumber: 1,
+						BoxType:   ShippingTypeNormal,
+						BoxSize:   ShippingSize60,
+						Items: []*CartItem{
+							{
+								ProductID: "product-id01",
+								Quantity:  1,
+							},
+							{
+								ProductID: "product-id02",
+								Quantity:  2,
+							},
+						},
+						CoordinatorID: "coordinator-id",
+					},
+				},
+				Destinations: OrderDestinations{
+					{
+						Address: &entity.Address{AddressRevision: entity.AddressRevision{PrefectureCode: 13}},
+						Baskets: CartBaskets{{
+							BoxType: ShippingTypeNormal,
+							BoxSize: ShippingSize60,
+							Items: CartItems{
+								{ProductID: "product-id01", Quantity: 1},
+								{ProductID: "product-id02", Quantity: 1},
+							},
+						}},
+					},
+					{
+						Address: &entity.Address{AddressRevision: entity.AddressRevision{PrefectureCode: codes.PrefectureValues["kagawa"]}},
+						Baskets: CartBaskets{{
+							BoxType: ShippingTypeNormal,
+							BoxSize: ShippingSize60,
+							Items:   CartItems{{ProductID: "product-id02", Quantity: 1}},
+						}},
+					},
+				},
+				Products: []*Product{
+					{
+						ID:   "product-id01",
+						Name: "じゃがいも",
+						ProductRevision: ProductRevision{
+							ID:        1,
+							ProductID: "product-id01",
+							Price:     500,
+						},
+					},
+					{
+						ID:   "product-id02",
+						Name: "人参",
+						ProductRevision: ProductRevision{
+							ID:        2,
+							ProductID: "product-id02",
+							Price:     1980,
+						},
+					},
+				},
+				Shipping: &Shipping{
+					ID:            "coordinator-id",
+					CoordinatorID: "coordinator-id",
+					ShippingRevision: ShippingRevision{
+						ShippingID:        "coordinator-id",
+						Box60Rates:        rates,
+						Box60Frozen:       800,
+						Box80Rates:        rates,
+						Box80Frozen:       800,
+						Box100Rates:       rates,
+						Box100Frozen:      800,
+						HasFreeShipping:   true,
+						FreeShippingRates: 3000,
+					},
+				},
+				Promotion: nil,
+			},
+			expect: &OrderPaymentSummary{
+				Subtotal:    4460,
+				Discount:    0,
+				ShippingFee: 750,
+				Tax:         473,
+				TaxRate:     10,
+				Total:       5210,
+				TaxBreakdown: &OrderTaxBreakdown{
+					StandardTarget: 5210,
+					StandardTax:    473,
+				},
 			},
 			expectErr: nil,
 		},
@@ -174,12 +346,20 @@ func TestProductOrderPaymentSummary(t *testing.T) {
 				},
 			},
 			expect: &OrderPaymentSummary{
-				Subtotal:    4460,
-				Discount:    446,
+				Subtotal: 4460,
+				Discount: 446,
+				ItemDiscounts: PromotionDiscounts{
+					{ProductID: "product-id01", Amount: 50},
+					{ProductID: "product-id02", Amount: 396},
+				},
 				ShippingFee: 500,
 				Tax:         410,
 				TaxRate:     10,
 				Total:       4514,
+				TaxBreakdown: &OrderTaxBreakdown{
+					StandardTarget: 4514,
+					StandardTax:    410,
+				},
 			},
 			expectErr: nil,
 		},
@@ -328,6 +508,10 @@ func TestProductOrderPaymentSummary(t *testing.T) {
 				Tax:         405,
 				TaxRate:     10,
 				Total:       4460,
+				TaxBreakdown: &OrderTaxBreakdown{
+					StandardTarget: 4460,
+					StandardTax:    405,
+				},
 			},
 			expectErr: nil,
 		},
@@ -501,6 +685,10 @@ func TestExperienceOrderPaymentSummary(t *testing.T) {
 				Tax:         181,
 				TaxRate:     10,
 				Total:       2000,
+				TaxBreakdown: &OrderTaxBreakdown{
+					StandardTarget: 2000,
+					StandardTax:    181,
+				},
 			},
 		},
 		{
@@ -578,6 +766,10 @@ func TestExperienceOrderPaymentSummary(t *testing.T) {
 				Tax:         218,
 				TaxRate:     10,
 				Total:       2400,
+				TaxBreakdown: &OrderTaxBreakdown{
+					StandardTarget: 2400,
+					StandardTax:    218,
+				},
 			},
 		},
 		{
@@ -108,6 +108,22 @@ func TestProductOrderPayment(t *testing.T) {
 		}
 		others = append(others, val)
 	}
+	shippingAddress := &entity.Address{
+		AddressRevision: entity.AddressRevision{
+			ID:             2,
+			AddressID:      "shipping-address-id",
+			Lastname:       "&.",
+			Firstname:      "受取人",
+			PostalCode:     "1000014",
+			PrefectureCode: 13,
+			City:           "千代田区",
+			AddressLine1:   "永田町1-7-1",
+			AddressLine2:   "",
+			PhoneNumber:    "090-1234-1234",
+		},
+		ID:     "shipping-address-id",
+		UserID: "user-id",
+	}
 	rates := ShippingRates{
 		{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
 		{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
@@ -138,7 +154,8 @@ func TestProductOrderPayment(t *testing.T) {
 					ID:     "address-id",
 					UserID: "user-id",
 				},
-				MethodType: PaymentMethodTypeCreditCard,
+				ShippingAddress: shippingAddress,
+				MethodType:      PaymentMethodTypeCreditCard,
 				Baskets: CartBaskets{
 					{
 						BoxNumber: 1,
@@ -204,6 +221,8 @@ func TestProductOrderPayment(t *testing.T) {
 				Discount:          0,
 				ShippingFee:       0,
 				Tax:               405,
+				StandardTaxTarget: 4460,
+				StandardTax:       405,
 				Total:             4460,
 			},
 			expectErr: nil,
@@ -228,7 +247,8 @@ func TestProductOrderPayment(t *testing.T) {
 					ID:     "address-id",
 					UserID: "user-id",
 				},
-				MethodType: PaymentMethodTypeCreditCard,
+				ShippingAddress: shippingAddress,
+				MethodType:      PaymentMethodTypeCreditCard,
 				Baskets: []*CartBasket{
 					{
 						BoxNumber: 1,
@@ -303,6 +323,8 @@ func TestProductOrderPayment(t *testing.T) {
 				Discount:          446,
 				ShippingFee:       500,
 				Tax:               410,
+				StandardTaxTarget: 4514,
+				StandardTax:       410,
 				Total:             4514,
 			},
 			expectErr: nil,
@@ -327,7 +349,8 @@ func TestProductOrderPayment(t *testing.T) {
 					ID:     "address-id",
 					UserID: "user-id",
 				},
-				MethodType: PaymentMethodTypeCreditCard,
+				ShippingAddress: shippingAddress,
+				MethodType:      PaymentMethodTypeCreditCard,
 				Baskets: []*CartBasket{
 					{
 						BoxNumber: 1,
@@ -373,7 +396,8 @@ func TestProductOrderPayment(t *testing.T) {
 					ID:     "address-id",
 					UserID: "user-id",
 				},
-				MethodType: PaymentMethodTypeCreditCard,
+				ShippingAddress: shippingAddress,
+				MethodType:      PaymentMethodTypeCreditCard,
 				Baskets: []*CartBasket{
 					{
 						BoxNumber: 1,
@@ -425,6 +449,92 @@ func TestProductOrderPayment(t *testing.T) {
 			expect:    nil,
 			expectErr: ErrNotFoundShippingRate,
 		},
+		{
+			name: "undeliverable shipping address different from billing address",
+			params: &NewProductOrderPaymentParams{
+				OrderID: "order-id",
+				Address: &entity.Address{
+					AddressRevision: entity.AddressRevision{
+						ID:             1,
+						AddressID:      "address-id",
+						Lastname:       "&.",
+						Firstname:      "購入者",
+						PostalCode:     "1000014",
+						PrefectureCode: 13,
+						City:           "千代田区",
+						AddressLine1:   "永田町1-7-1",
+						AddressLine2:   "",
+						PhoneNumber:    "090-1234-1234",
+					},
+					ID:     "address-id",
+					UserID: "user-id",
+				},
+				ShippingAddress: &entity.Address{
+					AddressRevision: entity.AddressRevision{
+						ID:             2,
+						AddressID:      "shipping-address-id",
+						Lastname:       "&.",
+						Firstname:      "受取人",
+						PostalCode:     "1000101",
+						PrefectureCode: 13,
+						City:           "大島町",
+						AddressLine1:   "元町1-1-1",
+						AddressLine2:   "",
+						PhoneNumber:    "090-1234-1234",
+					},
+					ID:     "shipping-address-id",
+					UserID: "user-id",
+				},
+				MethodType: PaymentMethodTypeCreditCard,
+				Baskets: []*CartBasket{
+					{
+						BoxNumber: 1,
+						BoxType:   ShippingTypeFrozen,
+						BoxSize:   ShippingSize60,
+						Items: []*CartItem{
+							{
+								ProductID: "product-id01",
+								Quantity:  1,
+							},
+						},
+						CoordinatorID: "coordinator-id",
+					},
+				},
+				Products: []*Product{
+					{
+						ID:   "product-id01",
+						Name: "じゃがいも",
+						ProductRevision: ProductRevision{
+							ID:        1,
+							ProductID: "product-id01",
+							Price:     500,
+						},
+					},
+				},
+				Shipping: &Shipping{
+					ID:            "coordinator-id",
+					CoordinatorID: "coordinator-id",
+					ShippingRevision: ShippingRevision{
+						ShippingID:  "coordinator-id",
+						Box60Rates:  rates,
+						Box60Frozen: 800,
+						RegionRules: ShippingRegionRules{
+							{
+								Number:         1,
+								Name:           "伊豆諸島",
+								PostalCodeFrom: "1000100",
+								PostalCodeTo:   "1001699",
+								Surcharge:      1000,
+								ExcludedTypes:  []ShippingType{ShippingTypeFrozen},
+							},
+						},
+					},
+				},
+				Promotion: nil,
+			},
+			expect:    nil,
+			expectErr: ErrUndeliverableShippingType,
+		},
 		{
 			name: "empty address",
 			params: &NewProductOrderPaymentParams{
@@ -489,6 +599,8 @@ func TestProductOrderPayment(t *testing.T) {
 				Discount:          0,
 				ShippingFee:       0,
 				Tax:               405,
+				StandardTaxTarget: 4460,
+				StandardTax:       405,
 				Total:             4460,
 			},
 			expectErr: nil,
@@ -513,7 +625,8 @@ func TestProductOrderPayment(t *testing.T) {
 					ID:     "address-id",
 					UserID: "user-id",
 				},
-				MethodType: PaymentMethodTypeCreditCard,
+				ShippingAddress: shippingAddress,
+				MethodType:      PaymentMethodTypeCreditCard,
 				Baskets: []*CartBasket{
 					{
 						BoxNumber: 1,
@@ -673,6 +786,8 @@ func TestExperienceOrderPayment(t *testing.T) {
 				Discount:          0,
 				ShippingFee:       0,
 				Tax:               181,
+				StandardTaxTarget: 2000,
+				StandardTax:       181,
 				Total:             2000,
 			},
 			hasErr: false,
@@ -748,6 +863,8 @@ func TestExperienceOrderPayment(t *testing.T) {
 				Discount:          0,
 				ShippingFee:       0,
 				Tax:               181,
+				StandardTaxTarget: 2000,
+				StandardTax:       181,
 				Total:             2000,
 			},
 			hasErr: false,
@@ -843,6 +960,50 @@ func TestExperienceOrderPayment(t *testing.T) {
 	}
 }
 
+func TestOrderPayment_TaxBreakdown(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		payment *OrderPayment
+		expect  *OrderTaxBreakdown
+	}{
+		{
+			name: "success",
+			payment: &OrderPayment{
+				Tax:               262,
+				StandardTaxTarget: 2480,
+				StandardTax:       225,
+				ReducedTaxTarget:  500,
+				ReducedTax:        37,
+				Total:             2980,
+			},
+			expect: &OrderTaxBreakdown{
+				StandardTarget: 2480,
+				StandardTax:    225,
+				ReducedTarget:  500,
+				ReducedTax:     37,
+			},
+		},
+		{
+			name: "without breakdown",
+			payment: &OrderPayment{
+				Tax:   405,
+				Total: 4460,
+			},
+			expect: &OrderTaxBreakdown{
+				StandardTarget: 4460,
+				StandardTax:    405,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.payment.TaxBreakdown())
+		})
+	}
+}
+
 func TestOrderPayment_IsCompleted(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -947,6 +1108,13 @@ func TestOrderPayment_IsCanceled(t *testing.T) {
 	}
 }
 
+func TestOrderPayment_AuthorizationExpiredAt(t *testing.T) {
+	t.Parallel()
+	paidAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	payment := &OrderPayment{Status: PaymentStatusAuthorized, PaidAt: paidAt}
+	assert.Equal(t, time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC), payment.AuthorizationExpiredAt())
+}
+
 func TestOrderPayment_SetTransactionID(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
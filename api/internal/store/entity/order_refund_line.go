@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+var (
+	ErrInvalidOrderRefund  = errors.New("entity: invalid order refund")
+	ErrOrderRefundExceeded = errors.New("entity: order refund amount exceeded")
+)
+
+// OrderRefundLineType - 返金種別
+type OrderRefundLineType int32
+
+const (
+	OrderRefundLineTypeUnknown OrderRefundLineType = 0
+	OrderRefundLineTypeFull    OrderRefundLineType = 1 // 全額(残額)返金
+	OrderRefundLineTypeItem    OrderRefundLineType = 2 // 商品単位の返金
+	OrderRefundLineTypeAmount  OrderRefundLineType = 3 // 金額指定の返金
+)
+
+// OrderRefundLineStatus - 返金処理状況
+type OrderRefundLineStatus int32
+
+const (
+	OrderRefundLineStatusUnknown   OrderRefundLineStatus = 0
+	OrderRefundLineStatusPending   OrderRefundLineStatus = 1 // 返金処理中
+	OrderRefundLineStatusSucceeded OrderRefundLineStatus = 2 // 返金完了
+	OrderRefundLineStatusFailed    OrderRefundLineStatus = 3 // 返金失敗
+)
+
+// OrderRefundLine - 注文返金明細
+type OrderRefundLine struct {
+	ID         string                `gorm:"primaryKey;<-:create"` // 返金明細ID
+	OrderID    string                `gorm:"<-:create"`            // 注文履歴ID
+	Type       OrderRefundLineType   `gorm:"<-:create"`            // 返金種別
+	Status     OrderRefundLineStatus `gorm:""`                     // 返金処理状況
+	Amount     int64                 `gorm:"<-:create"`            // 返金金額(税込)
+	Reason     string                `gorm:"<-:create"`            // 返金理由
+	Items      OrderRefundLineItems  `gorm:"-"`                    // 返金対象商品
+	RefundedAt time.Time             `gorm:"default:null"`         // 返金日時
+	CreatedAt  time.Time             `gorm:"<-:create"`            // 登録日時
+	UpdatedAt  time.Time             `gorm:""`                     // 更新日時
+}
+
+type OrderRefundLines []*OrderRefundLine
+
+// OrderRefundLineItem - 返金対象商品
+type OrderRefundLineItem struct {
+	ProductRevisionID int64 `json:"productRevisionId"` // 商品ID
+	Quantity          int64 `json:"quantity"`          // 返金数量
+	Amount            int64 `json:"amount"`            // 返金金額(税込)
+}
+
+type OrderRefundLineItems []*OrderRefundLineItem
+
+type NewOrderRefundLineParams struct {
+	Order    *Order
+	Lines    OrderRefundLines   // 登録済みの返金明細
+	Products map[int64]*Product // key: 商品ID(リビジョン)
+	Items    OrderRefundLineItems
+	Amount   int64
+	Reason   string
+}
+
+// NewOrderRefundLine - 返金明細の生成
+// 商品・金額の指定がない場合、未返金の残額をすべて返金する
+func NewOrderRefundLine(params *NewOrderRefundLineParams) (*OrderRefundLine, error) {
+	if params.Order == nil {
+		return nil, fmt.Errorf("%w: order is required", ErrInvalidOrderRefund)
+	}
+	if len(params.Items) > 0 && params.Amount > 0 {
+		return nil, fmt.Errorf("%w: items and amount cannot be specified together", ErrInvalidOrderRefund)
+	}
+	refundable := params.Order.OrderPayment.Total - params.Lines.Total()
+	line := &OrderRefundLine{
+		ID:      uuid.Base58Encode(uuid.New()),
+		OrderID: params.Order.ID,
+		Status:  OrderRefundLineStatusPending,
+		Reason:  params.Reason,
+	}
+	switch {
+	case len(params.Items) > 0:
+		items, err := newOrderRefundLineItems(params)
+		if err != nil {
+			return nil, err
+		}
+		line.Type = OrderRefundLineTypeItem
+		line.Items = items
+		line.Amount = items.Total()
+	case params.Amount > 0:
+		line.Type = OrderRefundLineTypeAmount
+		line.Amount = params.Amount
+	default:
+		line.Type = OrderRefundLineTypeFull
+		line.Amount = refundable
+	}
+	if line.Amount <= 0 {
+		return nil, fmt.Errorf("%w: nothing to refund", ErrOrderRefundExceeded)
+	}
+	if line.Amount > refundable {
+		return nil, fmt.Errorf("%w: amount=%d, refundable=%d", ErrOrderRefundExceeded, line.Amount, refundable)
+	}
+	return line, nil
+}
+
+func newOrderRefundLineItems(params *NewOrderRefundLineParams) (OrderRefundLineItems, error) {
+	// 配送単位に分かれている注文商品を商品単位で集計する
+	quantities := make(map[int64]int64, len(params.Order.OrderItems))
+	discounts := make(map[int64]int64, len(params.Order.OrderItems))
+	for _, item := range params.Order.OrderItems {
+		quantities[item.ProductRevisionID] += item.Quantity
+		discounts[item.ProductRevisionID] += item.Discount
+	}
+	refunded := params.Lines.RefundedQuantities()
+
+	res := make(OrderRefundLineItems, 0, len(params.Items))
+	requested := make(map[int64]int64, len(params.Items))
+	for _, item := range params.Items {
+		quantity, ok := quantities[item.ProductRevisionID]
+		if !ok {
+			return nil, fmt.Errorf("%w: product is not ordered: %d", ErrInvalidOrderRefund, item.ProductRevisionID)
+		}
+		product, ok := params.Products[item.ProductRevisionID]
+		if !ok {
+			return nil, fmt.Errorf("%w: product is not found: %d", ErrInvalidOrderRefund, item.ProductRevisionID)
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidOrderRefund)
+		}
+		requested[item.ProductRevisionID] += item.Quantity
+		if refunded[item.ProductRevisionID]+requested[item.ProductRevisionID] > quantity {
+			return nil, fmt.Errorf("%w: quantity exceeded: %d", ErrOrderRefundExceeded, item.ProductRevisionID)
+		}
+		// 割引金額は購入数量に応じて按分する
+		discount := discounts[item.ProductRevisionID] * item.Quantity / quantity
+		res = append(res, &OrderRefundLineItem{
+			ProductRevisionID: item.ProductRevisionID,
+			Quantity:          item.Quantity,
+			Amount:            product.Price*item.Quantity - discount,
+		})
+	}
+	return res, nil
+}
+
+// Verify - 登録済みの返金明細と合わせて、返金金額・返金数量が注文内容を超えないかを検証
+func (l *OrderRefundLine) Verify(lines OrderRefundLines, total int64, items OrderItems) error {
+	if refunded := lines.Total(); refunded+l.Amount > total {
+		return fmt.Errorf("%w: amount=%d, refunded=%d, total=%d", ErrOrderRefundExceeded, l.Amount, refunded, total)
+	}
+	if len(l.Items) == 0 {
+		return nil
+	}
+	quantities := make(map[int64]int64, len(items))
+	for _, item := range items {
+		quantities[item.ProductRevisionID] += item.Quantity
+	}
+	refunded := lines.RefundedQuantities()
+	for _, item := range l.Items {
+		refunded[item.ProductRevisionID] += item.Quantity
+		if refunded[item.ProductRevisionID] > quantities[item.ProductRevisionID] {
+			return fmt.Errorf("%w: quantity exceeded: %d", ErrOrderRefundExceeded, item.ProductRevisionID)
+		}
+	}
+	return nil
+}
+
+func (l *OrderRefundLine) Active() bool {
+	return l.Status == OrderRefundLineStatusPending || l.Status == OrderRefundLineStatusSucceeded
+}
+
+func (ls OrderRefundLines) Total() int64 {
+	var total int64
+	for _, l := range ls {
+		if !l.Active() {
+			continue
+		}
+		total += l.Amount
+	}
+	return total
+}
+
+func (ls OrderRefundLines) RefundedQuantities() map[int64]int64 {
+	res := make(map[int64]int64, len(ls))
+	for _, l := range ls {
+		if !l.Active() {
+			continue
+		}
+		for _, item := range l.Items {
+			res[item.ProductRevisionID] += item.Quantity
+		}
+	}
+	return res
+}
+
+func (is OrderRefundLineItems) Total() int64 {
+	var total int64
+	for _, i := range is {
+		total += i.Amount
+	}
+	return total
+}
@@ -0,0 +1,251 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderRefundLine(t *testing.T) {
+	t.Parallel()
+	order := &Order{
+		ID: "order-id",
+		OrderPayment: OrderPayment{
+			OrderID: "order-id",
+			Total:   2600,
+		},
+		OrderItems: OrderItems{
+			{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, OrderID: "order-id", Quantity: 2, Discount: 200},
+			{FulfillmentID: "fulfillment-id02", ProductRevisionID: 1, OrderID: "order-id", Quantity: 1, Discount: 100},
+			{FulfillmentID: "fulfillment-id02", ProductRevisionID: 2, OrderID: "order-id", Quantity: 1, Discount: 0},
+		},
+	}
+	products := map[int64]*Product{
+		1: {ID: "product-id01", ProductRevision: ProductRevision{ID: 1, ProductID: "product-id01", Price: 500}},
+		2: {ID: "product-id02", ProductRevision: ProductRevision{ID: 2, ProductID: "product-id02", Price: 1400}},
+	}
+	tests := []struct {
+		name      string
+		params    *NewOrderRefundLineParams
+		expect    *OrderRefundLine
+		expectErr error
+	}{
+		{
+			name: "full refund",
+			params: &NewOrderRefundLineParams{
+				Order:    order,
+				Products: products,
+				Reason:   "在庫切れのため",
+			},
+			expect: &OrderRefundLine{
+				OrderID: "order-id",
+				Type:    OrderRefundLineTypeFull,
+				Status:  OrderRefundLineStatusPending,
+				Amount:  2600,
+				Reason:  "在庫切れのため",
+			},
+		},
+		{
+			name: "full refund with refunded lines",
+			params: &NewOrderRefundLineParams{
+				Order: order,
+				Lines: OrderRefundLines{
+					{Status: OrderRefundLineStatusSucceeded, Amount: 1000},
+					{Status: OrderRefundLineStatusFailed, Amount: 500},
+				},
+				Products: products,
+				Reason:   "在庫切れのため",
+			},
+			expect: &OrderRefundLine{
+				OrderID: "order-id",
+				Type:    OrderRefundLineTypeFull,
+				Status:  OrderRefundLineStatusPending,
+				Amount:  1600,
+				Reason:  "在庫切れのため",
+			},
+		},
+		{
+			name: "item refund",
+			params: &NewOrderRefundLineParams{
+				Order:    order,
+				Products: products,
+				Items: OrderRefundLineItems{
+					{ProductRevisionID: 1, Quantity: 2},
+				},
+				Reason: "商品破損のため",
+			},
+			expect: &OrderRefundLine{
+				OrderID: "order-id",
+				Type:    OrderRefundLineTypeItem,
+				Status:  OrderRefundLineStatusPending,
+				Amount:  800,
+				Reason:  "商品破損のため",
+				Items: OrderRefundLineItems{
+					{ProductRevisionID: 1, Quantity: 2, Amount: 800},
+				},
+			},
+		},
+		{
+			name: "amount refund",
+			params: &NewOrderRefundLineParams{
+				Order:    order,
+				Products: products,
+				Amount:   300,
+				Reason:   "配送遅延のため",
+			},
+			expect: &OrderRefundLine{
+				OrderID: "order-id",
+				Type:    OrderRefundLineTypeAmount,
+				Status:  OrderRefundLineStatusPending,
+				Amount:  300,
+				Reason:  "配送遅延のため",
+			},
+		},
+		{
+			name: "items and amount",
+			params: &NewOrderRefundLineParams{
+				Order:    order,
+				Products: products,
+				Items:    OrderRefundLineItems{{ProductRevisionID: 1, Quantity: 1}},
+				Amount:   300,
+			},
+			expectErr: ErrInvalidOrderRefund,
+		},
+		{
+			name: "not ordered product",
+			params: &NewOrderRefundLineParams{
+				Order:    order,
+				Products: products,
+				Items:    OrderRefundLineItems{{ProductRevisionID: 3, Quantity: 1}},
+			},
+			expectErr: ErrInvalidOrderRefund,
+		},
+		{
+			name: "quantity exceeded",
+			params: &NewOrderRefundLineParams{
+				Order: order,
+				Lines: OrderRefundLines{
+					{
+						Status: OrderRefundLineStatusSucceeded,
+						Amount: 800,
+						Items:  OrderRefundLineItems{{ProductRevisionID: 1, Quantity: 2, Amount: 800}},
+					},
+				},
+				Products: products,
+				Items:    OrderRefundLineItems{{ProductRevisionID: 1, Quantity: 2}},
+			},
+			expectErr: ErrOrderRefundExceeded,
+		},
+		{
+			name: "amount exceeded",
+			params: &NewOrderRefundLineParams{
+				Order:    order,
+				Lines:    OrderRefundLines{{Status: OrderRefundLineStatusPending, Amount: 2500}},
+				Products: products,
+				Amount:   200,
+			},
+			expectErr: ErrOrderRefundExceeded,
+		},
+		{
+			name: "already refunded",
+			params: &NewOrderRefundLineParams{
+				Order:    order,
+				Lines:    OrderRefundLines{{Status: OrderRefundLineStatusSucceeded, Amount: 2600}},
+				Products: products,
+			},
+			expectErr: ErrOrderRefundExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewOrderRefundLine(tt.params)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if err != nil {
+				return
+			}
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestOrderRefundLines_Total(t *testing.T) {
+	t.Parallel()
+	lines := OrderRefundLines{
+		{Status: OrderRefundLineStatusPending, Amount: 100},
+		{Status: OrderRefundLineStatusSucceeded, Amount: 200},
+		{Status: OrderRefundLineStatusFailed, Amount: 400},
+	}
+	assert.Equal(t, int64(300), lines.Total())
+}
+
+func TestOrderRefundLine_Verify(t *testing.T) {
+	t.Parallel()
+	items := OrderItems{
+		{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, Quantity: 2},
+		{FulfillmentID: "fulfillment-id02", ProductRevisionID: 1, Quantity: 1},
+	}
+	lines := OrderRefundLines{
+		{
+			Status: OrderRefundLineStatusSucceeded,
+			Amount: 500,
+			Items:  OrderRefundLineItems{{ProductRevisionID: 1, Quantity: 2, Amount: 500}},
+		},
+		{
+			Status: OrderRefundLineStatusFailed,
+			Amount: 250,
+			Items:  OrderRefundLineItems{{ProductRevisionID: 1, Quantity: 1, Amount: 250}},
+		},
+	}
+	tests := []struct {
+		name   string
+		line   *OrderRefundLine
+		expect error
+	}{
+		{
+			name:   "success with amount",
+			line:   &OrderRefundLine{Type: OrderRefundLineTypeAmount, Amount: 500},
+			expect: nil,
+		},
+		{
+			name: "success with items",
+			line: &OrderRefundLine{
+				Type:   OrderRefundLineTypeItem,
+				Amount: 250,
+				Items:  OrderRefundLineItems{{ProductRevisionID: 1, Quantity: 1, Amount: 250}},
+			},
+			expect: nil,
+		},
+		{
+			name:   "exceeded amount",
+			line:   &OrderRefundLine{Type: OrderRefundLineTypeAmount, Amount: 501},
+			expect: ErrOrderRefundExceeded,
+		},
+		{
+			name: "exceeded quantity",
+			line: &OrderRefundLine{
+				Type:   OrderRefundLineTypeItem,
+				Amount: 500,
+				Items:  OrderRefundLineItems{{ProductRevisionID: 1, Quantity: 2, Amount: 500}},
+			},
+			expect: ErrOrderRefundExceeded,
+		},
+		{
+			name: "not ordered product",
+			line: &OrderRefundLine{
+				Type:   OrderRefundLineTypeItem,
+				Amount: 100,
+				Items:  OrderRefundLineItems{{ProductRevisionID: 2, Quantity: 1, Amount: 100}},
+			},
+			expect: ErrOrderRefundExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.line.Verify(lines, 1000, items)
+			assert.ErrorIs(t, err, tt.expect)
+		})
+	}
+}
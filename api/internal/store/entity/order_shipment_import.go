@@ -0,0 +1,30 @@
+package entity
+
+// UnmatchedShipmentReason - 送り状発行結果の照合失敗理由
+type UnmatchedShipmentReason int32
+
+const (
+	UnmatchedShipmentReasonUnknown             UnmatchedShipmentReason = 0
+	UnmatchedShipmentReasonEmptyManagementID   UnmatchedShipmentReason = 1 // お客様管理番号が未記載
+	UnmatchedShipmentReasonEmptyTrackingNumber UnmatchedShipmentReason = 2 // 伝票番号が未記載
+	UnmatchedShipmentReasonOrderNotFound       UnmatchedShipmentReason = 3 // 注文が存在しない
+	UnmatchedShipmentReasonNotShippable        UnmatchedShipmentReason = 4 // 発送できない注文状態
+	UnmatchedShipmentReasonNoFulfillment       UnmatchedShipmentReason = 5 // 発送待ちの配送情報が残っていない
+)
+
+// UnmatchedShipment - 注文と照合できなかった送り状発行結果
+type UnmatchedShipment struct {
+	Line           int64                   // 行番号
+	ManagementID   string                  // お客様管理番号
+	TrackingNumber string                  // 伝票番号
+	Reason         UnmatchedShipmentReason // 照合失敗理由
+}
+
+type UnmatchedShipments []*UnmatchedShipment
+
+// OrderShipmentImport - 送り状発行結果の取り込み結果
+type OrderShipmentImport struct {
+	Total     int64              // 発送済みにした配送数
+	Skipped   int64              // 登録済みのため読み飛ばした行数
+	Unmatched UnmatchedShipments // 照合できなかった行
+}
@@ -300,6 +300,8 @@ func TestOrderProduct(t *testing.T) {
 					Discount:          446,
 					ShippingFee:       0,
 					Tax:               364,
+					StandardTaxTarget: 4014,
+					StandardTax:       364,
 					Total:             4014,
 				},
 				OrderFulfillments: OrderFulfillments{
@@ -320,11 +322,17 @@ func TestOrderProduct(t *testing.T) {
 						ProductRevisionID: 1,
 						OrderID:           "order-id",
 						Quantity:          1,
+						TaxRate:           10,
+						Discount:          50,
+						Discounts:         OrderItemDiscounts{{Amount: 50}},
 					},
 					{
 						ProductRevisionID: 2,
 						OrderID:           "order-id",
 						Quantity:          2,
+						TaxRate:           10,
+						Discount:          396,
+						Discounts:         OrderItemDiscounts{{Amount: 396}},
 					},
 				},
 				OrderMetadata: OrderMetadata{
@@ -465,6 +473,8 @@ func TestOrderProduct(t *testing.T) {
 					Discount:          0,
 					ShippingFee:       0,
 					Tax:               405,
+					StandardTaxTarget: 4460,
+					StandardTax:       405,
 					Total:             4460,
 				},
 				OrderFulfillments: OrderFulfillments{
@@ -485,11 +495,13 @@ func TestOrderProduct(t *testing.T) {
 						ProductRevisionID: 1,
 						OrderID:           "order-id",
 						Quantity:          1,
+						TaxRate:           10,
 					},
 					{
 						ProductRevisionID: 2,
 						OrderID:           "order-id",
 						Quantity:          2,
+						TaxRate:           10,
 					},
 				},
 				OrderMetadata: OrderMetadata{
@@ -730,6 +742,34 @@ func TestNewExperienceOrder(t *testing.T) {
 			expect: nil,
 			hasErr: true,
 		},
+		{
+			name: "insufficient experience slot capacity",
+			params: &NewExperienceOrderParams{
+				OrderID:        "order-id",
+				SessionID:      "session-id",
+				CoordinatorID:  "coordinator-id",
+				Customer:       &entity.User{ID: "user-id"},
+				BillingAddress: &entity.Address{ID: "address-id"},
+				Experience: &Experience{
+					ID:     "experience-id",
+					Status: ExperienceStatusAccepting,
+				},
+				Slot: &ExperienceSlot{
+					ID:           "slot-id",
+					ExperienceID: "experience-id",
+					Status:       ExperienceSlotStatusAccepting,
+					Capacity:     10,
+					Reserved:     8,
+					StartAt:      now.AddDate(0, 0, 1),
+					EndAt:        now.AddDate(0, 0, 1).Add(time.Hour),
+				},
+				PaymentMethodType:     PaymentMethodTypeCreditCard,
+				AdultCount:            2,
+				JuniorHighSchoolCount: 1,
+			},
+			expect: nil,
+			hasErr: true,
+		},
 		{
 			name: "missing experience",
 			params: &NewExperienceOrderParams{
@@ -1000,6 +1040,7 @@ func TestOrder_EnableAction(t *testing.T) {
 		completable bool
 		cancelable  bool
 		refundable  bool
+		claimable   bool
 	}
 	tests := []struct {
 		name  string
@@ -1021,6 +1062,7 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: false,
 				cancelable:  true,
 				refundable:  false,
+				claimable:   false,
 			},
 		},
 		{
@@ -1038,6 +1080,7 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: false,
 				cancelable:  true,
 				refundable:  false,
+				claimable:   false,
 			},
 		},
 		{
@@ -1055,12 +1098,14 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: true,
 				cancelable:  false,
 				refundable:  true,
+				claimable:   false,
 			},
 		},
 		{
 			name: "payment captured and fulfilled",
 			order: &Order{
 				ID:                "order-id",
+				Type:              OrderTypeProduct,
 				Status:            OrderStatusShipped,
 				OrderPayment:      OrderPayment{Status: PaymentStatusCaptured},
 				OrderFulfillments: OrderFulfillments{{Status: FulfillmentStatusFulfilled}},
@@ -1072,12 +1117,14 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: true,
 				cancelable:  false,
 				refundable:  true,
+				claimable:   true,
 			},
 		},
 		{
 			name: "payment captured and already completed",
 			order: &Order{
 				ID:                "order-id",
+				Type:              OrderTypeProduct,
 				Status:            OrderStatusCompleted,
 				OrderPayment:      OrderPayment{Status: PaymentStatusCaptured},
 				OrderFulfillments: OrderFulfillments{{Status: FulfillmentStatusFulfilled}},
@@ -1089,6 +1136,7 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: false,
 				cancelable:  false,
 				refundable:  true,
+				claimable:   true,
 			},
 		},
 		{
@@ -1106,6 +1154,7 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: false,
 				cancelable:  false,
 				refundable:  false,
+				claimable:   false,
 			},
 		},
 		{
@@ -1123,6 +1172,7 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: false,
 				cancelable:  false,
 				refundable:  false,
+				claimable:   false,
 			},
 		},
 		{
@@ -1140,6 +1190,7 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: false,
 				cancelable:  false,
 				refundable:  false,
+				claimable:   false,
 			},
 		},
 		{
@@ -1151,6 +1202,7 @@ func TestOrder_EnableAction(t *testing.T) {
 				completable: false,
 				cancelable:  false,
 				refundable:  false,
+				claimable:   false,
 			},
 		},
 	}
@@ -1175,6 +1227,10 @@ func TestOrder_EnableAction(t *testing.T) {
 			t.Parallel()
 			assert.Equal(t, tt.want.refundable, tt.order.Refundable())
 		})
+		t.Run("claimable "+tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want.claimable, tt.order.Claimable())
+		})
 	}
 }
 
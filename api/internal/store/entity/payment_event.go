@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+// PaymentEventStatus - 決済イベントの処理状況
+type PaymentEventStatus int32
+
+const (
+	PaymentEventStatusUnknown    PaymentEventStatus = 0
+	PaymentEventStatusPending    PaymentEventStatus = 1 // 未処理
+	PaymentEventStatusSucceeded  PaymentEventStatus = 2 // 処理済み
+	PaymentEventStatusFailed     PaymentEventStatus = 3 // 処理失敗
+	PaymentEventStatusIgnored    PaymentEventStatus = 4 // 処理対象外
+	PaymentEventStatusProcessing PaymentEventStatus = 5 // 処理中
+	PaymentEventStatusRejected   PaymentEventStatus = 6 // 受付拒否（署名検証・解析失敗）
+)
+
+// PaymentEventProcessableStatuses - 処理を開始できる処理状況
+var PaymentEventProcessableStatuses = []PaymentEventStatus{
+	PaymentEventStatusPending,
+	PaymentEventStatusFailed,
+}
+
+// PaymentEvent - 決済プロバイダーから受信したWebhookイベント
+type PaymentEvent struct {
+	ID                string              `gorm:"primaryKey;<-:create"`   // 決済イベントID
+	ProviderType      PaymentProviderType `gorm:"<-:create"`              // 決済プロバイダー種別
+	EventID           string              `gorm:"<-:create;default:null"` // 決済プロバイダーのイベントID（受付拒否時は空）
+	EventType         string              `gorm:"<-:create"`              // イベント種別
+	Payload           []byte              `gorm:"<-:create"`              // 受信したリクエストボディ
+	SignatureVerified bool                `gorm:"<-:create"`              // 署名検証済みか
+	Status            PaymentEventStatus  `gorm:""`                       // 処理状況
+	ErrorMessage      string              `gorm:""`                       // 処理失敗時のエラー内容
+	AttemptCount      int64               `gorm:""`                       // 処理回数
+	ProcessedAt       time.Time           `gorm:"default:null"`           // 最終処理日時
+	CreatedAt         time.Time           `gorm:"<-:create"`              // 受信日時
+	UpdatedAt         time.Time           `gorm:""`                       // 更新日時
+}
+
+type PaymentEvents []*PaymentEvent
+
+type NewPaymentEventParams struct {
+	ProviderType      PaymentProviderType
+	EventID           string
+	EventType         string
+	Payload           []byte
+	SignatureVerified bool
+}
+
+func NewPaymentEvent(params *NewPaymentEventParams) *PaymentEvent {
+	return &PaymentEvent{
+		ID:                uuid.Base58Encode(uuid.New()),
+		ProviderType:      params.ProviderType,
+		EventID:           params.EventID,
+		EventType:         params.EventType,
+		Payload:           params.Payload,
+		SignatureVerified: params.SignatureVerified,
+		Status:            PaymentEventStatusPending,
+	}
+}
+
+// IsCompleted - 処理が完了しているか（重複して受信した場合は再処理しない）
+func (e *PaymentEvent) IsCompleted() bool {
+	return e.Status == PaymentEventStatusSucceeded || e.Status == PaymentEventStatusIgnored
+}
+
+// Replayable - 再処理が可能か
+func (e *PaymentEvent) Replayable() bool {
+	return slices.Contains(PaymentEventProcessableStatuses, e.Status)
+}
+
+// Reject - 署名検証・解析に失敗したイベントとして受付を拒否する
+func (e *PaymentEvent) Reject(err error, now time.Time) {
+	e.Status = PaymentEventStatusRejected
+	e.ErrorMessage = err.Error()
+	e.ProcessedAt = now
+}
+
+// Proceed - 処理結果を反映する
+func (e *PaymentEvent) Proceed(ignored bool, err error, now time.Time) {
+	e.AttemptCount++
+	e.ProcessedAt = now
+	switch {
+	case err != nil:
+		e.Status = PaymentEventStatusFailed
+		e.ErrorMessage = err.Error()
+	case ignored:
+		e.Status = PaymentEventStatusIgnored
+		e.ErrorMessage = ""
+	default:
+		e.Status = PaymentEventStatusSucceeded
+		e.ErrorMessage = ""
+	}
+}
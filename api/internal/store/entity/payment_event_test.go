@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentEvent(t *testing.T) {
+	t.Parallel()
+	params := &NewPaymentEventParams{
+		ProviderType:      PaymentProviderTypeStripe,
+		EventID:           "evt_test",
+		EventType:         "payment_intent.succeeded",
+		Payload:           []byte(`{"id":"evt_test"}`),
+		SignatureVerified: true,
+	}
+	actual := NewPaymentEvent(params)
+	assert.NotEmpty(t, actual.ID)
+	actual.ID = "" // ignore
+	expect := &PaymentEvent{
+		ProviderType:      PaymentProviderTypeStripe,
+		EventID:           "evt_test",
+		EventType:         "payment_intent.succeeded",
+		Payload:           []byte(`{"id":"evt_test"}`),
+		SignatureVerified: true,
+		Status:            PaymentEventStatusPending,
+	}
+	assert.Equal(t, expect, actual)
+}
+
+func TestPaymentEvent_Status(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		status           PaymentEventStatus
+		expectCompleted  bool
+		expectReplayable bool
+	}{
+		{name: "pending", status: PaymentEventStatusPending, expectCompleted: false, expectReplayable: true},
+		{name: "succeeded", status: PaymentEventStatusSucceeded, expectCompleted: true, expectReplayable: false},
+		{name: "failed", status: PaymentEventStatusFailed, expectCompleted: false, expectReplayable: true},
+		{name: "ignored", status: PaymentEventStatusIgnored, expectCompleted: true, expectReplayable: false},
+		{name: "processing", status: PaymentEventStatusProcessing, expectCompleted: false, expectReplayable: false},
+		{name: "rejected", status: PaymentEventStatusRejected, expectCompleted: false, expectReplayable: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			event := &PaymentEvent{Status: tt.status}
+			assert.Equal(t, tt.expectCompleted, event.IsCompleted())
+			assert.Equal(t, tt.expectReplayable, event.Replayable())
+		})
+	}
+}
+
+func TestPaymentEvent_Reject(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	event := &PaymentEvent{Status: PaymentEventStatusPending}
+	event.Reject(assert.AnError, now)
+	expect := &PaymentEvent{
+		Status:       PaymentEventStatusRejected,
+		ErrorMessage: assert.AnError.Error(),
+		ProcessedAt:  now,
+	}
+	assert.Equal(t, expect, event)
+}
+
+func TestPaymentEvent_Proceed(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	tests := []struct {
+		name    string
+		event   *PaymentEvent
+		ignored bool
+		err     error
+		expect  *PaymentEvent
+	}{
+		{
+			name:    "succeeded",
+			event:   &PaymentEvent{Status: PaymentEventStatusFailed, ErrorMessage: "some error", AttemptCount: 1},
+			ignored: false,
+			err:     nil,
+			expect:  &PaymentEvent{Status: PaymentEventStatusSucceeded, AttemptCount: 2, ProcessedAt: now},
+		},
+		{
+			name:    "ignored",
+			event:   &PaymentEvent{Status: PaymentEventStatusPending},
+			ignored: true,
+			err:     nil,
+			expect:  &PaymentEvent{Status: PaymentEventStatusIgnored, AttemptCount: 1, ProcessedAt: now},
+		},
+		{
+			name:    "failed",
+			event:   &PaymentEvent{Status: PaymentEventStatusPending},
+			ignored: false,
+			err:     assert.AnError,
+			expect: &PaymentEvent{
+				Status:       PaymentEventStatusFailed,
+				ErrorMessage: assert.AnError.Error(),
+				AttemptCount: 1,
+				ProcessedAt:  now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.event.Proceed(tt.ignored, tt.err, now)
+			assert.Equal(t, tt.expect, tt.event)
+		})
+	}
+}
@@ -15,8 +15,18 @@ type PaymentSystem struct {
 	MethodType   PaymentMethodType   `gorm:"primaryKey;<-:create"` // 決済種別
 	ProviderType PaymentProviderType `gorm:"default:1"`            // 決済プロバイダー種別
 	Status       PaymentSystemStatus `gorm:""`                     // 決済システム状態
+	FeeRate      float64             `gorm:""`                     // 決済手数料率(%)
 	CreatedAt    time.Time           `gorm:"<-:create"`            // 登録日時
 	UpdatedAt    time.Time           `gorm:""`                     // 更新日時
 }
 
 type PaymentSystems []*PaymentSystem
+
+// FeeRates - 決済種別ごとの決済手数料率(%)
+func (ss PaymentSystems) FeeRates() map[PaymentMethodType]float64 {
+	res := make(map[PaymentMethodType]float64, len(ss))
+	for _, s := range ss {
+		res[s.MethodType] = s.FeeRate
+	}
+	return res
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+const (
+	// PreorderAuthorizationPeriod - 予約注文の与信を保持できる期間（カード決済の仮売上は約7日で失効する）
+	PreorderAuthorizationPeriod = 7 * 24 * time.Hour
+	// PreorderCaptureMargin - 与信の失効前に売上確定を行うまでの猶予
+	PreorderCaptureMargin = 24 * time.Hour
+)
+
+var ErrPreorderShippingMismatch = errors.New("entity: preorder products have different expected shipping periods")
+
+// PreorderBatchStatus - 予約注文バッチの出荷準備状況
+type PreorderBatchStatus int32
+
+const (
+	PreorderBatchStatusUnknown   PreorderBatchStatus = 0
+	PreorderBatchStatusAccepting PreorderBatchStatus = 1 // 予約受付中
+	PreorderBatchStatusReady     PreorderBatchStatus = 2 // 出荷準備完了
+)
+
+// PreorderBatch - 予約注文バッチ（出荷予定期間が同じ予約注文をまとめて売上確定・出荷する単位）
+type PreorderBatch struct {
+	ID                      string              `gorm:"primaryKey;<-:create"` // 予約注文バッチID
+	ShopID                  string              `gorm:"<-:create"`            // 店舗ID
+	CoordinatorID           string              `gorm:"<-:create"`            // コーディネータID
+	Status                  PreorderBatchStatus `gorm:""`                     // 出荷準備状況
+	ExpectedShippingStartAt time.Time           `gorm:"<-:create"`            // 出荷予定期間(開始)
+	ExpectedShippingEndAt   time.Time           `gorm:"<-:create"`            // 出荷予定期間(終了)
+	ReadyAt                 time.Time           `gorm:"default:null"`         // 出荷準備完了日時
+	CreatedAt               time.Time           `gorm:"<-:create"`            // 登録日時
+	UpdatedAt               time.Time           `gorm:""`                     // 更新日時
+}
+
+type PreorderBatches []*PreorderBatch
+
+type NewPreorderBatchParams struct {
+	ShopID        string
+	CoordinatorID string
+	Products      Products
+}
+
+// NewPreorderBatch - 注文対象の商品から予約注文バッチを生成する（予約商品が含まれない場合はnilを返す）
+func NewPreorderBatch(params *NewPreorderBatchParams) (*PreorderBatch, error) {
+	products := params.Products.FilterByPreorderable()
+	if len(products) == 0 {
+		return nil, nil
+	}
+	// 出荷予定期間が異なる予約商品は、出荷タイミングが揃わないため同時に注文できないようにする
+	startAt, endAt := products[0].ExpectedShippingStartAt, products[0].ExpectedShippingEndAt
+	for _, p := range products[1:] {
+		if !p.ExpectedShippingStartAt.Equal(startAt) || !p.ExpectedShippingEndAt.Equal(endAt) {
+			return nil, ErrPreorderShippingMismatch
+		}
+	}
+	return &PreorderBatch{
+		ID:                      uuid.Base58Encode(uuid.New()),
+		ShopID:                  params.ShopID,
+		CoordinatorID:           params.CoordinatorID,
+		Status:                  PreorderBatchStatusAccepting,
+		ExpectedShippingStartAt: startAt,
+		ExpectedShippingEndAt:   endAt,
+	}, nil
+}
+
+// IsReady - 出荷準備が完了し、売上確定が可能か
+func (b *PreorderBatch) IsReady() bool {
+	if b == nil {
+		return false
+	}
+	return b.Status == PreorderBatchStatusReady
+}
+
+// Ready - 出荷準備完了とする（すでに完了している場合は完了日時を更新しない）
+func (b *PreorderBatch) Ready(now time.Time) {
+	if b.IsReady() {
+		return
+	}
+	b.Status = PreorderBatchStatusReady
+	b.ReadyAt = now
+}
+
+func (bs PreorderBatches) IDs() []string {
+	return set.UniqBy(bs, func(b *PreorderBatch) string {
+		return b.ID
+	})
+}
+
+func (bs PreorderBatches) CoordinatorIDs() []string {
+	return set.UniqBy(bs, func(b *PreorderBatch) string {
+		return b.CoordinatorID
+	})
+}
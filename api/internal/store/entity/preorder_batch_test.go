@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreorderBatch(t *testing.T) {
+	t.Parallel()
+	startAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	endAt := time.Date(2026, 11, 10, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		params *NewPreorderBatchParams
+		expect *PreorderBatch
+		err    error
+	}{
+		{
+			name: "success",
+			params: &NewPreorderBatchParams{
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				Products: Products{
+					{ID: "product-id01", Status: ProductStatusForSale},
+					{ID: "product-id02", Status: ProductStatusPresale, ExpectedShippingStartAt: startAt, ExpectedShippingEndAt: endAt},
+					{ID: "product-id03", Status: ProductStatusPresale, ExpectedShippingStartAt: startAt, ExpectedShippingEndAt: endAt},
+				},
+			},
+			expect: &PreorderBatch{
+				ShopID:                  "shop-id",
+				CoordinatorID:           "coordinator-id",
+				Status:                  PreorderBatchStatusAccepting,
+				ExpectedShippingStartAt: startAt,
+				ExpectedShippingEndAt:   endAt,
+			},
+			err: nil,
+		},
+		{
+			name: "no preorder products",
+			params: &NewPreorderBatchParams{
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				Products: Products{
+					{ID: "product-id01", Status: ProductStatusForSale},
+					{ID: "product-id02", Status: ProductStatusPresale},
+				},
+			},
+			expect: nil,
+			err:    nil,
+		},
+		{
+			name: "mismatch expected shipping",
+			params: &NewPreorderBatchParams{
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				Products: Products{
+					{ID: "product-id01", Status: ProductStatusPresale, ExpectedShippingStartAt: startAt, ExpectedShippingEndAt: endAt},
+					{ID: "product-id02", Status: ProductStatusPresale, ExpectedShippingStartAt: startAt, ExpectedShippingEndAt: endAt.AddDate(0, 0, 1)},
+				},
+			},
+			expect: nil,
+			err:    ErrPreorderShippingMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewPreorderBatch(tt.params)
+			assert.ErrorIs(t, err, tt.err)
+			if actual != nil {
+				actual.ID = "" // ignore
+			}
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestPreorderBatch_Ready(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 18, 18, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		batch  *PreorderBatch
+		expect *PreorderBatch
+	}{
+		{
+			name:  "accepting",
+			batch: &PreorderBatch{Status: PreorderBatchStatusAccepting},
+			expect: &PreorderBatch{
+				Status:  PreorderBatchStatusReady,
+				ReadyAt: now,
+			},
+		},
+		{
+			name: "already ready",
+			batch: &PreorderBatch{
+				Status:  PreorderBatchStatusReady,
+				ReadyAt: now.AddDate(0, 0, -1),
+			},
+			expect: &PreorderBatch{
+				Status:  PreorderBatchStatusReady,
+				ReadyAt: now.AddDate(0, 0, -1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.batch.Ready(now)
+			assert.Equal(t, tt.expect, tt.batch)
+			assert.True(t, tt.batch.IsReady())
+		})
+	}
+}
+
+func TestPreorderBatch_IsReady(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		batch  *PreorderBatch
+		expect bool
+	}{
+		{name: "ready", batch: &PreorderBatch{Status: PreorderBatchStatusReady}, expect: true},
+		{name: "accepting", batch: &PreorderBatch{Status: PreorderBatchStatusAccepting}, expect: false},
+		{name: "empty", batch: nil, expect: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.batch.IsReady())
+		})
+	}
+}
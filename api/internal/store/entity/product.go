@@ -67,41 +67,43 @@ const (
 
 // Product - 商品情報
 type Product struct {
-	ProductRevision      `gorm:"-"`
-	ID                   string            `gorm:"primaryKey;<-:create"`     // 商品ID
-	ShopID               string            `gorm:"default:null"`             // 店舗ID
-	CoordinatorID        string            `gorm:""`                         // コーディネータID
-	ProducerID           string            `gorm:""`                         // 生産者ID
-	TypeID               string            `gorm:"column:product_type_id"`   // 品目ID
-	TagIDs               []string          `gorm:"-"`                        // 商品タグID一覧
-	Name                 string            `gorm:""`                         // 商品名
-	Description          string            `gorm:""`                         // 商品説明
-	Status               ProductStatus     `gorm:"-"`                        // 販売状況
-	Scope                ProductScope      `gorm:""`                         // 公開範囲
-	Inventory            int64             `gorm:""`                         // 在庫数
-	Weight               int64             `gorm:""`                         // 重量
-	WeightUnit           WeightUnit        `gorm:""`                         // 重量単位
-	Item                 int64             `gorm:""`                         // 数量
-	ItemUnit             string            `gorm:""`                         // 数量単位
-	ItemDescription      string            `gorm:""`                         // 数量単位説明
-	ThumbnailURL         string            `gorm:"-"`                        // サムネイルURL
-	Media                MultiProductMedia `gorm:"-"`                        // メディア一覧
-	ExpirationDate       int64             `gorm:""`                         // 賞味期限(単位:日)
-	RecommendedPoints    []string          `gorm:"-"`                        // おすすめポイント一覧
-	StorageMethodType    StorageMethodType `gorm:""`                         // 保存方法
-	DeliveryType         DeliveryType      `gorm:""`                         // 配送方法
-	Box60Rate            int64             `gorm:""`                         // 箱の占有率(サイズ:60)
-	Box80Rate            int64             `gorm:""`                         // 箱の占有率(サイズ:80)
-	Box100Rate           int64             `gorm:""`                         // 箱の占有率(サイズ:100)
-	OriginPrefecture     string            `gorm:"-"`                        // 原産地(都道府県)
-	OriginPrefectureCode int32             `gorm:"column:origin_prefecture"` // 原産地(都道府県コード)
-	OriginCity           string            `gorm:""`                         // 原産地(市区町村)
-	CoordinatorPriority  int64             `gorm:""`                         // コーディネーター並び順
-	StartAt              time.Time         `gorm:""`                         // 販売開始日時
-	EndAt                time.Time         `gorm:""`                         // 販売終了日時
-	CreatedAt            time.Time         `gorm:"<-:create"`                // 登録日時
-	UpdatedAt            time.Time         `gorm:""`                         // 更新日時
-	DeletedAt            gorm.DeletedAt    `gorm:"default:null"`             // 削除日時
+	ProductRevision         `gorm:"-"`
+	ID                      string            `gorm:"primaryKey;<-:create"`     // 商品ID
+	ShopID                  string            `gorm:"default:null"`             // 店舗ID
+	CoordinatorID           string            `gorm:""`                         // コーディネータID
+	ProducerID              string            `gorm:""`                         // 生産者ID
+	TypeID                  string            `gorm:"column:product_type_id"`   // 品目ID
+	TagIDs                  []string          `gorm:"-"`                        // 商品タグID一覧
+	Name                    string            `gorm:""`                         // 商品名
+	Description             string            `gorm:""`                         // 商品説明
+	Status                  ProductStatus     `gorm:"-"`                        // 販売状況
+	Scope                   ProductScope      `gorm:""`                         // 公開範囲
+	Inventory               int64             `gorm:""`                         // 在庫数
+	Weight                  int64             `gorm:""`                         // 重量
+	WeightUnit              WeightUnit        `gorm:""`                         // 重量単位
+	Item                    int64             `gorm:""`                         // 数量
+	ItemUnit                string            `gorm:""`                         // 数量単位
+	ItemDescription         string            `gorm:""`                         // 数量単位説明
+	ThumbnailURL            string            `gorm:"-"`                        // サムネイルURL
+	Media                   MultiProductMedia `gorm:"-"`                        // メディア一覧
+	ExpirationDate          int64             `gorm:""`                         // 賞味期限(単位:日)
+	RecommendedPoints       []string          `gorm:"-"`                        // おすすめポイント一覧
+	StorageMethodType       StorageMethodType `gorm:""`                         // 保存方法
+	DeliveryType            DeliveryType      `gorm:""`                         // 配送方法
+	Box60Rate               int64             `gorm:""`                         // 箱の占有率(サイズ:60)
+	Box80Rate               int64             `gorm:""`                         // 箱の占有率(サイズ:80)
+	Box100Rate              int64             `gorm:""`                         // 箱の占有率(サイズ:100)
+	OriginPrefecture        string            `gorm:"-"`                        // 原産地(都道府県)
+	OriginPrefectureCode    int32             `gorm:"column:origin_prefecture"` // 原産地(都道府県コード)
+	OriginCity              string            `gorm:""`                         // 原産地(市区町村)
+	CoordinatorPriority     int64             `gorm:""`                         // コーディネーター並び順
+	StartAt                 time.Time         `gorm:""`                         // 販売開始日時
+	EndAt                   time.Time         `gorm:""`                         // 販売終了日時
+	ExpectedShippingStartAt time.Time         `gorm:"default:null"`             // 出荷予定期間(開始)
+	ExpectedShippingEndAt   time.Time         `gorm:"default:null"`             // 出荷予定期間(終了)
+	CreatedAt               time.Time         `gorm:"<-:create"`                // 登録日時
+	UpdatedAt               time.Time         `gorm:""`                         // 更新日時
+	DeletedAt               gorm.DeletedAt    `gorm:"default:null"`             // 削除日時
 }
 
 type Products []*Product
@@ -115,34 +117,37 @@ type ProductMedia struct {
 type MultiProductMedia []*ProductMedia
 
 type NewProductParams struct {
-	ShopID               string
-	CoordinatorID        string
-	ProducerID           string
-	TypeID               string
-	TagIDs               []string
-	Name                 string
-	Description          string
-	Scope                ProductScope
-	Inventory            int64
-	Weight               int64
-	WeightUnit           WeightUnit
-	Item                 int64
-	ItemUnit             string
-	ItemDescription      string
-	Media                MultiProductMedia
-	Price                int64
-	Cost                 int64
-	ExpirationDate       int64
-	RecommendedPoints    []string
-	StorageMethodType    StorageMethodType
-	DeliveryType         DeliveryType
-	Box60Rate            int64
-	Box80Rate            int64
-	Box100Rate           int64
-	OriginPrefectureCode int32
-	OriginCity           string
-	StartAt              time.Time
-	EndAt                time.Time
+	ShopID                  string
+	CoordinatorID           string
+	ProducerID              string
+	TypeID                  string
+	TagIDs                  []string
+	Name                    string
+	Description             string
+	Scope                   ProductScope
+	Inventory               int64
+	Weight                  int64
+	WeightUnit              WeightUnit
+	Item                    int64
+	ItemUnit                string
+	ItemDescription         string
+	Media                   MultiProductMedia
+	Price                   int64
+	Cost                    int64
+	TaxCategory             TaxCategory
+	ExpirationDate          int64
+	RecommendedPoints       []string
+	StorageMethodType       StorageMethodType
+	DeliveryType            DeliveryType
+	Box60Rate               int64
+	Box80Rate               int64
+	Box100Rate              int64
+	OriginPrefectureCode    int32
+	OriginCity              string
+	StartAt                 time.Time
+	EndAt                   time.Time
+	ExpectedShippingStartAt time.Time
+	ExpectedShippingEndAt   time.Time
 }
 
 func NewProduct(params *NewProductParams) (*Product, error) {
@@ -152,41 +157,44 @@ func NewProduct(params *NewProductParams) (*Product, error) {
 		return nil, err
 	}
 	rparams := &NewProductRevisionParams{
-		ProductID: productID,
-		Price:     params.Price,
-		Cost:      params.Cost,
+		ProductID:   productID,
+		Price:       params.Price,
+		Cost:        params.Cost,
+		TaxCategory: params.TaxCategory,
 	}
 	revision := NewProductRevision(rparams)
 	return &Product{
-		ID:                   productID,
-		ShopID:               params.ShopID,
-		CoordinatorID:        params.CoordinatorID,
-		ProducerID:           params.ProducerID,
-		TypeID:               params.TypeID,
-		TagIDs:               params.TagIDs,
-		Name:                 params.Name,
-		Description:          params.Description,
-		Scope:                params.Scope,
-		Inventory:            params.Inventory,
-		Weight:               params.Weight,
-		WeightUnit:           params.WeightUnit,
-		Item:                 params.Item,
-		ItemUnit:             params.ItemUnit,
-		ItemDescription:      params.ItemDescription,
-		Media:                params.Media,
-		ExpirationDate:       params.ExpirationDate,
-		RecommendedPoints:    params.RecommendedPoints,
-		StorageMethodType:    params.StorageMethodType,
-		DeliveryType:         params.DeliveryType,
-		Box60Rate:            params.Box60Rate,
-		Box80Rate:            params.Box80Rate,
-		Box100Rate:           params.Box100Rate,
-		OriginPrefecture:     prefecture,
-		OriginPrefectureCode: params.OriginPrefectureCode,
-		OriginCity:           params.OriginCity,
-		StartAt:              params.StartAt,
-		EndAt:                params.EndAt,
-		ProductRevision:      *revision,
+		ID:                      productID,
+		ShopID:                  params.ShopID,
+		CoordinatorID:           params.CoordinatorID,
+		ProducerID:              params.ProducerID,
+		TypeID:                  params.TypeID,
+		TagIDs:                  params.TagIDs,
+		Name:                    params.Name,
+		Description:             params.Description,
+		Scope:                   params.Scope,
+		Inventory:               params.Inventory,
+		Weight:                  params.Weight,
+		WeightUnit:              params.WeightUnit,
+		Item:                    params.Item,
+		ItemUnit:                params.ItemUnit,
+		ItemDescription:         params.ItemDescription,
+		Media:                   params.Media,
+		ExpirationDate:          params.ExpirationDate,
+		RecommendedPoints:       params.RecommendedPoints,
+		StorageMethodType:       params.StorageMethodType,
+		DeliveryType:            params.DeliveryType,
+		Box60Rate:               params.Box60Rate,
+		Box80Rate:               params.Box80Rate,
+		Box100Rate:              params.Box100Rate,
+		OriginPrefecture:        prefecture,
+		OriginPrefectureCode:    params.OriginPrefectureCode,
+		OriginCity:              params.OriginCity,
+		StartAt:                 params.StartAt,
+		EndAt:                   params.EndAt,
+		ExpectedShippingStartAt: params.ExpectedShippingStartAt,
+		ExpectedShippingEndAt:   params.ExpectedShippingEndAt,
+		ProductRevision:         *revision,
 	}, nil
 }
 
@@ -230,6 +238,16 @@ func (p *Product) SetStatus(now time.Time) {
 	}
 }
 
+// Preorderable - 予約注文を受け付けているか（予約受付中かつ出荷予定期間が設定されている場合のみ）
+func (p *Product) Preorderable() bool {
+	return p.Status == ProductStatusPresale && !p.ExpectedShippingStartAt.IsZero()
+}
+
+// Orderable - 注文を受け付けているか
+func (p *Product) Orderable() bool {
+	return p.Status == ProductStatusForSale || p.Preorderable()
+}
+
 func (p *Product) SetThumbnail() {
 	for _, media := range p.Media {
 		if !media.IsThumbnail {
@@ -246,6 +264,23 @@ func (p *Product) WeightGram() int64 {
 	return p.Weight * 1e3
 }
 
+// BoxRate - 箱の占有率（サイズ:120以上は、サイズ:100の占有率と箱の容量比から算出）
+func (p *Product) BoxRate(size ShippingSize) int64 {
+	switch size {
+	case ShippingSize60:
+		return p.Box60Rate
+	case ShippingSize80:
+		return p.Box80Rate
+	case ShippingSize100:
+		return p.Box100Rate
+	}
+	capacity, ok := largeBoxCapacities[size]
+	if !ok {
+		return 0
+	}
+	return (p.Box100Rate*100 + capacity - 1) / capacity // 端数は切り上げ
+}
+
 func (ps Products) Fill(revisions map[string]*ProductRevision, now time.Time) {
 	for _, p := range ps {
 		revision, ok := revisions[p.ID]
@@ -280,6 +315,14 @@ func (ps Products) Box100Rate() int64 {
 	return rate
 }
 
+func (ps Products) BoxRate(size ShippingSize) int64 {
+	var rate int64
+	for i := range ps {
+		rate += ps[i].BoxRate(size)
+	}
+	return rate
+}
+
 func (ps Products) WeightGram() int64 {
 	var weight int64
 	for i := range ps {
@@ -370,6 +413,28 @@ func (ps Products) FilterBySales() Products {
 	return res
 }
 
+func (ps Products) FilterByOrderable() Products {
+	res := make(Products, 0, len(ps))
+	for _, p := range ps {
+		if !p.Orderable() {
+			continue
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+func (ps Products) FilterByPreorderable() Products {
+	res := make(Products, 0, len(ps))
+	for _, p := range ps {
+		if !p.Preorderable() {
+			continue
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
 func (ps Products) FilterByPublished() Products {
 	res := make(Products, 0, len(ps))
 	for _, p := range ps {
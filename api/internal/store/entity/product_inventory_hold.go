@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+)
+
+// ProductInventoryHoldStatus - 商品在庫の確保状況
+type ProductInventoryHoldStatus int32
+
+const (
+	ProductInventoryHoldStatusUnknown  ProductInventoryHoldStatus = 0
+	ProductInventoryHoldStatusHeld     ProductInventoryHoldStatus = 1 // 仮押さえ
+	ProductInventoryHoldStatusSold     ProductInventoryHoldStatus = 2 // 販売確定
+	ProductInventoryHoldStatusReleased ProductInventoryHoldStatus = 3 // 解放済み
+)
+
+// ProductInventoryHold - 商品在庫の確保情報
+type ProductInventoryHold struct {
+	OrderID           string                     `gorm:"primaryKey;<-:create"` // 注文履歴ID
+	ProductID         string                     `gorm:"primaryKey;<-:create"` // 商品ID
+	ProductRevisionID int64                      `gorm:"<-:create"`            // 商品変更履歴ID
+	Quantity          int64                      `gorm:"<-:create"`            // 確保数量
+	Status            ProductInventoryHoldStatus `gorm:""`                     // 確保状況
+	ExpiredAt         time.Time                  `gorm:"<-:create"`            // 確保期限
+	CreatedAt         time.Time                  `gorm:"<-:create"`            // 登録日時
+	UpdatedAt         time.Time                  `gorm:""`                     // 更新日時
+}
+
+type ProductInventoryHolds []*ProductInventoryHold
+
+// AggregatedProductInventoryHold - 商品在庫の確保数集計情報
+type AggregatedProductInventoryHold struct {
+	ProductID string `gorm:"primaryKey"` // 商品ID
+	Quantity  int64  `gorm:""`           // 仮押さえ中の数量
+}
+
+type AggregatedProductInventoryHolds []*AggregatedProductInventoryHold
+
+type NewProductInventoryHoldsParams struct {
+	OrderID   string
+	Items     OrderItems
+	Products  Products
+	ExpiredAt time.Time
+}
+
+// NewProductInventoryHolds - 注文商品から商品ごとの在庫確保情報を生成
+func NewProductInventoryHolds(params *NewProductInventoryHoldsParams) (ProductInventoryHolds, error) {
+	products := params.Products.MapByRevision()
+	holds := make(map[string]*ProductInventoryHold, len(params.Items))
+	res := make(ProductInventoryHolds, 0, len(params.Items))
+	for _, item := range params.Items {
+		product, ok := products[item.ProductRevisionID]
+		if !ok {
+			return nil, errNotFoundProduct
+		}
+		// 同一商品が複数の箱に分かれている場合は数量をまとめる
+		if hold, ok := holds[product.ID]; ok {
+			hold.Quantity += item.Quantity
+			continue
+		}
+		hold := &ProductInventoryHold{
+			OrderID:           params.OrderID,
+			ProductID:         product.ID,
+			ProductRevisionID: item.ProductRevisionID,
+			Quantity:          item.Quantity,
+			Status:            ProductInventoryHoldStatusHeld,
+			ExpiredAt:         params.ExpiredAt,
+		}
+		holds[product.ID] = hold
+		res = append(res, hold)
+	}
+	return res, nil
+}
+
+// Releasable - 確保した在庫の解放が可能か
+func (h *ProductInventoryHold) Releasable() bool {
+	if h == nil {
+		return false
+	}
+	return h.Status == ProductInventoryHoldStatusHeld
+}
+
+func (hs ProductInventoryHolds) ProductIDs() []string {
+	return set.UniqBy(hs, func(h *ProductInventoryHold) string {
+		return h.ProductID
+	})
+}
+
+func (hs ProductInventoryHolds) OrderIDs() []string {
+	return set.UniqBy(hs, func(h *ProductInventoryHold) string {
+		return h.OrderID
+	})
+}
+
+func (hs AggregatedProductInventoryHolds) Map() map[string]int64 {
+	res := make(map[string]int64, len(hs))
+	for _, h := range hs {
+		res[h.ProductID] = h.Quantity
+	}
+	return res
+}
+
+// ProductInventory - 商品在庫状況
+type ProductInventory struct {
+	ProductID string // 商品ID
+	Available int64  // 販売可能数
+	Held      int64  // 仮押さえ中の数量
+}
+
+func NewProductInventory(product *Product, held int64) *ProductInventory {
+	return &ProductInventory{
+		ProductID: product.ID,
+		Available: product.Inventory,
+		Held:      held,
+	}
+}
@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductInventoryHolds(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+
+	tests := []struct {
+		name   string
+		params *NewProductInventoryHoldsParams
+		expect ProductInventoryHolds
+		hasErr bool
+	}{
+		{
+			name: "success",
+			params: &NewProductInventoryHoldsParams{
+				OrderID: "order-id",
+				Items: OrderItems{
+					{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, OrderID: "order-id", Quantity: 2},
+					{FulfillmentID: "fulfillment-id02", ProductRevisionID: 1, OrderID: "order-id", Quantity: 1},
+					{FulfillmentID: "fulfillment-id01", ProductRevisionID: 2, OrderID: "order-id", Quantity: 3},
+				},
+				Products: Products{
+					{ID: "product-id01", ProductRevision: ProductRevision{ID: 1, ProductID: "product-id01"}},
+					{ID: "product-id02", ProductRevision: ProductRevision{ID: 2, ProductID: "product-id02"}},
+				},
+				ExpiredAt: now,
+			},
+			expect: ProductInventoryHolds{
+				{
+					OrderID:           "order-id",
+					ProductID:         "product-id01",
+					ProductRevisionID: 1,
+					Quantity:          3,
+					Status:            ProductInventoryHoldStatusHeld,
+					ExpiredAt:         now,
+				},
+				{
+					OrderID:           "order-id",
+					ProductID:         "product-id02",
+					ProductRevisionID: 2,
+					Quantity:          3,
+					Status:            ProductInventoryHoldStatusHeld,
+					ExpiredAt:         now,
+				},
+			},
+			hasErr: false,
+		},
+		{
+			name: "not found product",
+			params: &NewProductInventoryHoldsParams{
+				OrderID: "order-id",
+				Items: OrderItems{
+					{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, OrderID: "order-id", Quantity: 2},
+				},
+				Products:  Products{},
+				ExpiredAt: now,
+			},
+			expect: nil,
+			hasErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewProductInventoryHolds(tt.params)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestProductInventoryHold_Releasable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		hold   *ProductInventoryHold
+		expect bool
+	}{
+		{
+			name:   "held",
+			hold:   &ProductInventoryHold{Status: ProductInventoryHoldStatusHeld},
+			expect: true,
+		},
+		{
+			name:   "sold",
+			hold:   &ProductInventoryHold{Status: ProductInventoryHoldStatusSold},
+			expect: false,
+		},
+		{
+			name:   "released",
+			hold:   &ProductInventoryHold{Status: ProductInventoryHoldStatusReleased},
+			expect: false,
+		},
+		{
+			name:   "empty",
+			hold:   nil,
+			expect: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.hold.Releasable())
+		})
+	}
+}
+
+func TestProductInventoryHolds_Aggregated(t *testing.T) {
+	t.Parallel()
+
+	holds := ProductInventoryHolds{
+		{OrderID: "order-id01", ProductID: "product-id01"},
+		{OrderID: "order-id01", ProductID: "product-id02"},
+		{OrderID: "order-id02", ProductID: "product-id01"},
+	}
+	assert.ElementsMatch(t, []string{"product-id01", "product-id02"}, holds.ProductIDs())
+	assert.ElementsMatch(t, []string{"order-id01", "order-id02"}, holds.OrderIDs())
+
+	aggregated := AggregatedProductInventoryHolds{
+		{ProductID: "product-id01", Quantity: 3},
+		{ProductID: "product-id02", Quantity: 1},
+	}
+	assert.Equal(t, map[string]int64{"product-id01": 3, "product-id02": 1}, aggregated.Map())
+}
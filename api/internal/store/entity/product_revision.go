@@ -7,29 +7,58 @@ import (
 	"github.com/jinzhu/copier"
 )
 
+// TaxCategory - 消費税区分
+type TaxCategory int32
+
+const (
+	TaxCategoryUnknown  TaxCategory = 0
+	TaxCategoryStandard TaxCategory = 1 // 標準税率(10%)
+	TaxCategoryReduced  TaxCategory = 2 // 軽減税率(8%)
+)
+
+const (
+	standardTaxRate int64 = 10 // 標準税率(%)
+	reducedTaxRate  int64 = 8  // 軽減税率(%)
+)
+
 // ProductRevision - 商品変更履歴情報
 type ProductRevision struct {
-	ID        int64     `gorm:"primaryKey;<-:create"` // 変更履歴ID
-	ProductID string    `gorm:""`                     // 商品ID
-	Price     int64     `gorm:""`                     // 販売価格(税込)
-	Cost      int64     `gorm:""`                     // 商品原価(税込)
-	CreatedAt time.Time `gorm:"<-:create"`            // 登録日時
-	UpdatedAt time.Time `gorm:""`                     // 更新日時
+	ID          int64       `gorm:"primaryKey;<-:create"` // 変更履歴ID
+	ProductID   string      `gorm:""`                     // 商品ID
+	Price       int64       `gorm:""`                     // 販売価格(税込)
+	Cost        int64       `gorm:""`                     // 商品原価(税込)
+	TaxCategory TaxCategory `gorm:""`                     // 消費税区分
+	CreatedAt   time.Time   `gorm:"<-:create"`            // 登録日時
+	UpdatedAt   time.Time   `gorm:""`                     // 更新日時
 }
 
 type ProductRevisions []*ProductRevision
 
 type NewProductRevisionParams struct {
-	ProductID string
-	Price     int64
-	Cost      int64
+	ProductID   string
+	Price       int64
+	Cost        int64
+	TaxCategory TaxCategory
+}
+
+// Rate - 消費税率(%)を返す（未設定の場合は標準税率）
+func (c TaxCategory) Rate() int64 {
+	if c == TaxCategoryReduced {
+		return reducedTaxRate
+	}
+	return standardTaxRate
 }
 
 func NewProductRevision(params *NewProductRevisionParams) *ProductRevision {
+	category := params.TaxCategory
+	if category == TaxCategoryUnknown {
+		category = TaxCategoryStandard
+	}
 	return &ProductRevision{
-		ProductID: params.ProductID,
-		Price:     params.Price,
-		Cost:      params.Cost,
+		ProductID:   params.ProductID,
+		Price:       params.Price,
+		Cost:        params.Cost,
+		TaxCategory: category,
 	}
 }
 
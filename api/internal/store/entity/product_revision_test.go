@@ -22,9 +22,25 @@ func TestProductRevision(t *testing.T) {
 				Cost:      880,
 			},
 			expect: &ProductRevision{
-				ProductID: "product-id",
-				Price:     3980,
-				Cost:      880,
+				ProductID:   "product-id",
+				Price:       3980,
+				Cost:        880,
+				TaxCategory: TaxCategoryStandard,
+			},
+		},
+		{
+			name: "success with reduced tax",
+			params: &NewProductRevisionParams{
+				ProductID:   "product-id",
+				Price:       3980,
+				Cost:        880,
+				TaxCategory: TaxCategoryReduced,
+			},
+			expect: &ProductRevision{
+				ProductID:   "product-id",
+				Price:       3980,
+				Cost:        880,
+				TaxCategory: TaxCategoryReduced,
 			},
 		},
 	}
@@ -37,6 +53,37 @@ func TestProductRevision(t *testing.T) {
 	}
 }
 
+func TestTaxCategory_Rate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		category TaxCategory
+		expect   int64
+	}{
+		{
+			name:     "standard",
+			category: TaxCategoryStandard,
+			expect:   10,
+		},
+		{
+			name:     "reduced",
+			category: TaxCategoryReduced,
+			expect:   8,
+		},
+		{
+			name:     "unknown",
+			category: TaxCategoryUnknown,
+			expect:   10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.category.Rate())
+		})
+	}
+}
+
 func TestProductRevisions_ProductIDs(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
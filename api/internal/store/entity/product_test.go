@@ -86,8 +86,9 @@ func TestProduct(t *testing.T) {
 				StartAt:              now,
 				EndAt:                now.AddDate(1, 0, 0),
 				ProductRevision: ProductRevision{
-					Price: 400,
-					Cost:  300,
+					Price:       400,
+					Cost:        300,
+					TaxCategory: TaxCategoryStandard,
 				},
 			},
 			hasErr: false,
@@ -383,6 +384,65 @@ func TestProduct_WeightGram(t *testing.T) {
 	}
 }
 
+func TestProduct_BoxRate(t *testing.T) {
+	t.Parallel()
+	product := &Product{
+		ID:         "product-id",
+		Weight:     100,
+		WeightUnit: WeightUnitGram,
+		Box60Rate:  50,
+		Box80Rate:  40,
+		Box100Rate: 30,
+	}
+	tests := []struct {
+		name   string
+		size   ShippingSize
+		expect int64
+	}{
+		{
+			name:   "size 60",
+			size:   ShippingSize60,
+			expect: 50,
+		},
+		{
+			name:   "size 80",
+			size:   ShippingSize80,
+			expect: 40,
+		},
+		{
+			name:   "size 100",
+			size:   ShippingSize100,
+			expect: 30,
+		},
+		{
+			name:   "size 120",
+			size:   ShippingSize120,
+			expect: 18,
+		},
+		{
+			name:   "size 140",
+			size:   ShippingSize140,
+			expect: 12,
+		},
+		{
+			name:   "size 160",
+			size:   ShippingSize160,
+			expect: 8,
+		},
+		{
+			name:   "unknown",
+			size:   ShippingSizeUnknown,
+			expect: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, product.BoxRate(tt.size))
+		})
+	}
+}
+
 func TestProducts_Fill(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
@@ -552,6 +612,30 @@ func TestProducts_Box100Rate(t *testing.T) {
 	}
 }
 
+func TestProducts_BoxRate(t *testing.T) {
+	t.Parallel()
+	products := Products{
+		{
+			ID:         "product-id01",
+			Weight:     100,
+			WeightUnit: WeightUnitGram,
+			Box60Rate:  50,
+			Box80Rate:  40,
+			Box100Rate: 30,
+		},
+		{
+			ID:         "product-id02",
+			Weight:     200,
+			WeightUnit: WeightUnitGram,
+			Box60Rate:  50,
+			Box80Rate:  45,
+			Box100Rate: 40,
+		},
+	}
+	assert.Equal(t, int64(70), products.BoxRate(ShippingSize100))
+	assert.Equal(t, int64(42), products.BoxRate(ShippingSize120))
+}
+
 func TestProducts_WeightGram(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1253,6 +1337,68 @@ func TestProducts_FilterBySales(t *testing.T) {
 	}
 }
 
+func TestProducts_FilterByOrderable(t *testing.T) {
+	t.Parallel()
+	shippingAt := time.Now().AddDate(0, 1, 0)
+	tests := []struct {
+		name     string
+		products Products
+		expect   Products
+	}{
+		{
+			name: "success",
+			products: Products{
+				{ID: "product-id01", Status: ProductStatusPrivate},
+				{ID: "product-id02", Status: ProductStatusPresale},
+				{ID: "product-id03", Status: ProductStatusPresale, ExpectedShippingStartAt: shippingAt},
+				{ID: "product-id04", Status: ProductStatusForSale},
+				{ID: "product-id05", Status: ProductStatusOutOfSale},
+				{ID: "product-id06", Status: ProductStatusArchived},
+			},
+			expect: Products{
+				{ID: "product-id03", Status: ProductStatusPresale, ExpectedShippingStartAt: shippingAt},
+				{ID: "product-id04", Status: ProductStatusForSale},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := tt.products.FilterByOrderable()
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestProducts_FilterByPreorderable(t *testing.T) {
+	t.Parallel()
+	shippingAt := time.Now().AddDate(0, 1, 0)
+	tests := []struct {
+		name     string
+		products Products
+		expect   Products
+	}{
+		{
+			name: "success",
+			products: Products{
+				{ID: "product-id01", Status: ProductStatusPresale},
+				{ID: "product-id02", Status: ProductStatusPresale, ExpectedShippingStartAt: shippingAt},
+				{ID: "product-id03", Status: ProductStatusForSale, ExpectedShippingStartAt: shippingAt},
+			},
+			expect: Products{
+				{ID: "product-id02", Status: ProductStatusPresale, ExpectedShippingStartAt: shippingAt},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := tt.products.FilterByPreorderable()
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
 func TestProducts_FilterByPublished(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -39,3 +39,11 @@ func (ts ProductTypes) CategoryIDs() []string {
 		return t.CategoryID
 	})
 }
+
+func (ts ProductTypes) Map() map[string]*ProductType {
+	res := make(map[string]*ProductType, len(ts))
+	for _, t := range ts {
+		res[t.ID] = t
+	}
+	return res
+}
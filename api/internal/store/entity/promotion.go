@@ -6,10 +6,14 @@ import (
 
 	"github.com/and-period/furumaru/api/pkg/set"
 	"github.com/and-period/furumaru/api/pkg/uuid"
-	"github.com/shopspring/decimal"
 )
 
-var errInvalidDiscount = errors.New("entity: invalid discount value")
+var (
+	errInvalidDiscount           = errors.New("entity: invalid discount value")
+	errPromotionUsageLimit       = errors.New("entity: promotion usage limit exceeded")
+	errPromotionUserUsageLimit   = errors.New("entity: promotion usage limit per user exceeded")
+	errPromotionNotFirstPurchase = errors.New("entity: promotion is only for first purchase")
+)
 
 // PromotionStatus - プロモーションの状態
 type PromotionStatus int32
@@ -30,6 +34,7 @@ const (
 	DiscountTypeAmount       DiscountType = 1 // 固定額(円)
 	DiscountTypeRate         DiscountType = 2 // 料率計算(%)
 	DiscountTypeFreeShipping DiscountType = 3 // 送料無料
+	DiscountTypeRule         DiscountType = 4 // 適用ルールによる割引
 )
 
 // PromotionCodeType - プロモーションコード種別
@@ -51,36 +56,51 @@ const (
 
 // Promotion - プロモーション情報
 type Promotion struct {
-	ID           string              `gorm:"primaryKey;<-:create"` // プロモーションID
-	ShopID       string              `gorm:"default:null"`         // ショップID
-	Status       PromotionStatus     `gorm:"-"`                    // 状態
-	Title        string              `gorm:""`                     // タイトル
-	Description  string              `gorm:""`                     // 詳細説明
-	Public       bool                `gorm:""`                     // Deprecated: 公開フラグ
-	TargetType   PromotionTargetType `gorm:""`                     // 対象種別
-	DiscountType DiscountType        `gorm:""`                     // 割引計算方法
-	DiscountRate int64               `gorm:""`                     // 割引額(%/円)
-	Code         string              `gorm:"<-:create"`            // クーポンコード
-	CodeType     PromotionCodeType   `gorm:"<-:create"`            // クーポンコード種別
-	StartAt      time.Time           `gorm:""`                     // クーポン使用可能日時(開始)
-	EndAt        time.Time           `gorm:""`                     // クーポン使用可能日時(終了)
-	CreatedAt    time.Time           `gorm:"<-:create"`            // 登録日時
-	UpdatedAt    time.Time           `gorm:""`                     // 更新日時
+	ID                string              `gorm:"primaryKey;<-:create"` // プロモーションID
+	ShopID            string              `gorm:"default:null"`         // ショップID
+	Status            PromotionStatus     `gorm:"-"`                    // 状態
+	Title             string              `gorm:""`                     // タイトル
+	Description       string              `gorm:""`                     // 詳細説明
+	Public            bool                `gorm:""`                     // Deprecated: 公開フラグ
+	TargetType        PromotionTargetType `gorm:""`                     // 対象種別
+	DiscountType      DiscountType        `gorm:""`                     // 割引計算方法
+	DiscountRate      int64               `gorm:""`                     // 割引額(%/円)
+	Rules             PromotionRules      `gorm:"-"`                    // 適用ルール一覧
+	Code              string              `gorm:"<-:create"`            // クーポンコード
+	CodeType          PromotionCodeType   `gorm:"<-:create"`            // クーポンコード種別
+	UsageLimit        int64               `gorm:""`                     // 利用上限回数(全体)
+	UsageLimitPerUser int64               `gorm:""`                     // 利用上限回数(ユーザーごと)
+	FirstPurchaseOnly bool                `gorm:""`                     // 初回購入限定
+	StartAt           time.Time           `gorm:""`                     // クーポン使用可能日時(開始)
+	EndAt             time.Time           `gorm:""`                     // クーポン使用可能日時(終了)
+	CreatedAt         time.Time           `gorm:"<-:create"`            // 登録日時
+	UpdatedAt         time.Time           `gorm:""`                     // 更新日時
 }
 
 type Promotions []*Promotion
 
 type NewPromotionParams struct {
-	ShopID       string
-	Title        string
-	Description  string
-	Public       bool
-	DiscountType DiscountType
-	DiscountRate int64
-	Code         string
-	CodeType     PromotionCodeType
-	StartAt      time.Time
-	EndAt        time.Time
+	ShopID            string
+	Title             string
+	Description       string
+	Public            bool
+	DiscountType      DiscountType
+	DiscountRate      int64
+	Rules             PromotionRules
+	Code              string
+	CodeType          PromotionCodeType
+	UsageLimit        int64
+	UsageLimitPerUser int64
+	FirstPurchaseOnly bool
+	StartAt           time.Time
+	EndAt             time.Time
+}
+
+// PromotionUsage - プロモーションの利用状況
+type PromotionUsage struct {
+	TotalCount    int64 // 利用回数(全体)
+	UserCount     int64 // 利用回数(対象ユーザー)
+	PurchaseCount int64 // 購入回数(対象ユーザー)
 }
 
 func NewPromotion(params *NewPromotionParams) *Promotion {
@@ -89,18 +109,22 @@ func NewPromotion(params *NewPromotionParams) *Promotion {
 		targetType = PromotionTargetTypeSpecificShop
 	}
 	return &Promotion{
-		ID:           uuid.Base58Encode(uuid.New()),
-		ShopID:       params.ShopID,
-		Title:        params.Title,
-		Description:  params.Description,
-		Public:       params.Public,
-		TargetType:   targetType,
-		DiscountType: params.DiscountType,
-		DiscountRate: params.DiscountRate,
-		Code:         params.Code,
-		CodeType:     params.CodeType,
-		StartAt:      params.StartAt,
-		EndAt:        params.EndAt,
+		ID:                uuid.Base58Encode(uuid.New()),
+		ShopID:            params.ShopID,
+		Title:             params.Title,
+		Description:       params.Description,
+		Public:            params.Public,
+		TargetType:        targetType,
+		DiscountType:      params.DiscountType,
+		DiscountRate:      params.DiscountRate,
+		Rules:             params.Rules,
+		Code:              params.Code,
+		CodeType:          params.CodeType,
+		UsageLimit:        params.UsageLimit,
+		UsageLimitPerUser: params.UsageLimitPerUser,
+		FirstPurchaseOnly: params.FirstPurchaseOnly,
+		StartAt:           params.StartAt,
+		EndAt:             params.EndAt,
 	}
 }
 
@@ -115,19 +139,88 @@ func (p *Promotion) CalcDiscount(total int64, shippingFee int64) int64 {
 		}
 		return p.DiscountRate
 	case DiscountTypeRate:
-		if p.DiscountRate == 0 {
-			return 0
-		}
-		dtotal := decimal.NewFromInt(total)
-		rate := decimal.NewFromInt(p.DiscountRate).Div(decimal.NewFromInt(100))
-		return dtotal.Mul(rate).IntPart()
+		return calcDiscountByRate(total, p.DiscountRate)
 	case DiscountTypeFreeShipping:
 		return shippingFee
+	case DiscountTypeRule:
+		// 商品の指定がないため、対象を限定しないルールのみ適用される
+		items := PromotionItems{{Price: total, Quantity: 1}}
+		return p.Rules.Calc(items).Total()
 	default:
 		return 0
 	}
 }
 
+// CalcItemDiscounts - 商品ごとの割引内訳を算出
+func (p *Promotion) CalcItemDiscounts(items PromotionItems) PromotionDiscounts {
+	if p == nil {
+		return nil
+	}
+	switch p.DiscountType {
+	case DiscountTypeAmount:
+		rule := &PromotionRule{Type: PromotionRuleTypeAmount, DiscountRate: p.DiscountRate}
+		return PromotionRules{rule}.Calc(items)
+	case DiscountTypeRate:
+		rule := &PromotionRule{Type: PromotionRuleTypeRate, DiscountRate: p.DiscountRate}
+		return PromotionRules{rule}.Calc(items)
+	case DiscountTypeRule:
+		return p.Rules.Calc(items)
+	default:
+		return nil
+	}
+}
+
+// CalcShippingDiscount - 配送料金の割引金額を算出
+func (p *Promotion) CalcShippingDiscount(shippingFee int64) int64 {
+	if p == nil || p.DiscountType != DiscountTypeFreeShipping {
+		return 0
+	}
+	return shippingFee
+}
+
+// HasCategoryRule - カテゴリを対象とする適用ルールを含むか
+func (p *Promotion) HasCategoryRule() bool {
+	if p == nil || p.DiscountType != DiscountTypeRule {
+		return false
+	}
+	return p.Rules.HasCategoryTarget()
+}
+
+// LimitPerUser - ユーザーごとの利用上限回数（0の場合は無制限）
+func (p *Promotion) LimitPerUser() int64 {
+	if p.CodeType == PromotionCodeTypeOnce || p.FirstPurchaseOnly {
+		return 1
+	}
+	return p.UsageLimitPerUser
+}
+
+// HasRedemptionLimit - 利用回数の制限があるか
+func (p *Promotion) HasRedemptionLimit() bool {
+	return p.UsageLimit > 0 || p.LimitPerUser() > 0
+}
+
+// RemainingRedemptions - 残りの利用可能回数（全体の利用上限が未設定の場合は-1）
+func (p *Promotion) RemainingRedemptions(redeemed int64) int64 {
+	if p.UsageLimit <= 0 {
+		return -1
+	}
+	return max(p.UsageLimit-redeemed, 0)
+}
+
+// VerifyRedemption - 利用状況からプロモーションが利用可能かを検証
+func (p *Promotion) VerifyRedemption(usage *PromotionUsage) error {
+	if p.UsageLimit > 0 && usage.TotalCount >= p.UsageLimit {
+		return errPromotionUsageLimit
+	}
+	if limit := p.LimitPerUser(); limit > 0 && usage.UserCount >= limit {
+		return errPromotionUserUsageLimit
+	}
+	if p.FirstPurchaseOnly && usage.PurchaseCount > 0 {
+		return errPromotionNotFirstPurchase
+	}
+	return nil
+}
+
 func (p *Promotion) IsEnabled(shopID string) bool {
 	if p == nil {
 		return false
@@ -163,6 +256,9 @@ func (p *Promotion) SetStatus(now time.Time) {
 }
 
 func (p *Promotion) Validate() error {
+	if p.DiscountType != DiscountTypeRule {
+		p.Rules = nil // 適用ルールは割引計算方法がルール指定の場合のみ保持する
+	}
 	switch p.DiscountType {
 	case DiscountTypeAmount:
 		if p.DiscountRate <= 0 {
@@ -174,6 +270,9 @@ func (p *Promotion) Validate() error {
 		}
 	case DiscountTypeFreeShipping:
 		p.DiscountRate = 0
+	case DiscountTypeRule:
+		p.DiscountRate = 0
+		return p.Rules.Validate()
 	}
 	return nil
 }
@@ -0,0 +1,174 @@
+package entity
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+const PromotionCodeLength = 8 // クーポンコードの桁数
+
+// 読み間違えやすい文字(0,O,1,I,L)を除いた英大文字と数字
+var promotionCodeLetters = []byte("ABCDEFGHJKMNPQRSTUVWXYZ23456789")
+
+var ErrPromotionCodeUnavailable = errors.New("entity: promotion code is unavailable")
+
+// PromotionCodeBatchStatus - クーポンコード一括発行の状態
+type PromotionCodeBatchStatus int32
+
+const (
+	PromotionCodeBatchStatusUnknown PromotionCodeBatchStatus = 0
+	PromotionCodeBatchStatusActive  PromotionCodeBatchStatus = 1 // 有効
+	PromotionCodeBatchStatusVoided  PromotionCodeBatchStatus = 2 // 無効化済み
+)
+
+// PromotionCodeStatus - クーポンコードの利用状況
+type PromotionCodeStatus int32
+
+const (
+	PromotionCodeStatusUnknown   PromotionCodeStatus = 0
+	PromotionCodeStatusAvailable PromotionCodeStatus = 1 // 未使用
+	PromotionCodeStatusReserved  PromotionCodeStatus = 2 // 利用予約(決済待ち)
+	PromotionCodeStatusRedeemed  PromotionCodeStatus = 3 // 使用済み
+	PromotionCodeStatusVoided    PromotionCodeStatus = 4 // 無効
+)
+
+func (s PromotionCodeStatus) String() string {
+	switch s {
+	case PromotionCodeStatusAvailable:
+		return "未使用"
+	case PromotionCodeStatusReserved:
+		return "決済待ち"
+	case PromotionCodeStatusRedeemed:
+		return "使用済み"
+	case PromotionCodeStatusVoided:
+		return "無効"
+	default:
+		return ""
+	}
+}
+
+// PromotionCodeBatch - クーポンコード一括発行情報
+type PromotionCodeBatch struct {
+	ID          string                   `gorm:"primaryKey;<-:create"` // 一括発行ID
+	PromotionID string                   `gorm:"<-:create"`            // プロモーションID
+	Title       string                   `gorm:"<-:create"`            // 用途(配布先など)
+	Quantity    int64                    `gorm:"<-:create"`            // 発行数
+	Status      PromotionCodeBatchStatus `gorm:""`                     // 状態
+	VoidedAt    time.Time                `gorm:"default:null"`         // 無効化日時
+	CreatedAt   time.Time                `gorm:"<-:create"`            // 登録日時
+	UpdatedAt   time.Time                `gorm:""`                     // 更新日時
+}
+
+type PromotionCodeBatches []*PromotionCodeBatch
+
+// PromotionCode - クーポンコード情報（一括発行した使い切りコード）
+type PromotionCode struct {
+	Code        string              `gorm:"primaryKey;<-:create"` // クーポンコード
+	BatchID     string              `gorm:"<-:create"`            // 一括発行ID
+	PromotionID string              `gorm:"<-:create"`            // プロモーションID
+	Status      PromotionCodeStatus `gorm:""`                     // 利用状況
+	OrderID     string              `gorm:"default:null"`         // 利用した注文履歴ID
+	RedeemedAt  time.Time           `gorm:"default:null"`         // 利用日時
+	CreatedAt   time.Time           `gorm:"<-:create"`            // 登録日時
+	UpdatedAt   time.Time           `gorm:""`                     // 更新日時
+}
+
+type PromotionCodes []*PromotionCode
+
+type NewPromotionCodeBatchParams struct {
+	PromotionID string
+	Title       string
+	Quantity    int64
+}
+
+func NewPromotionCodeBatch(params *NewPromotionCodeBatchParams) *PromotionCodeBatch {
+	return &PromotionCodeBatch{
+		ID:          uuid.Base58Encode(uuid.New()),
+		PromotionID: params.PromotionID,
+		Title:       params.Title,
+		Quantity:    params.Quantity,
+		Status:      PromotionCodeBatchStatusActive,
+	}
+}
+
+// Voidable - 無効化が可能か
+func (b *PromotionCodeBatch) Voidable() bool {
+	if b == nil {
+		return false
+	}
+	return b.Status == PromotionCodeBatchStatusActive
+}
+
+// NewCodes - 発行数分の重複しないクーポンコードを生成
+func (b *PromotionCodeBatch) NewCodes() (PromotionCodes, error) {
+	res := make(PromotionCodes, 0, b.Quantity)
+	values := make(map[string]struct{}, b.Quantity)
+	for int64(len(res)) < b.Quantity {
+		value, err := newPromotionCodeValue()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := values[value]; ok {
+			continue
+		}
+		values[value] = struct{}{}
+		code := &PromotionCode{
+			Code:        value,
+			BatchID:     b.ID,
+			PromotionID: b.PromotionID,
+			Status:      PromotionCodeStatusAvailable,
+		}
+		res = append(res, code)
+	}
+	return res, nil
+}
+
+func newPromotionCodeValue() (string, error) {
+	limit := big.NewInt(int64(len(promotionCodeLetters)))
+	res := make([]byte, PromotionCodeLength)
+	for i := range res {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", fmt.Errorf("entity: failed to generate promotion code: %w", err)
+		}
+		res[i] = promotionCodeLetters[n.Int64()]
+	}
+	return string(res), nil
+}
+
+// Usable - 指定した注文でクーポンコードが利用可能か
+func (c *PromotionCode) Usable(orderID string) bool {
+	if c == nil {
+		return false
+	}
+	switch c.Status {
+	case PromotionCodeStatusAvailable:
+		return true
+	case PromotionCodeStatusReserved:
+		// 同一注文での再実行時は利用可能として扱う
+		return orderID != "" && c.OrderID == orderID
+	default:
+		return false
+	}
+}
+
+// IsEnabled - 利用者が入力可能な状態か（決済待ちを含む）
+func (c *PromotionCode) IsEnabled() bool {
+	if c == nil {
+		return false
+	}
+	return c.Status == PromotionCodeStatusAvailable || c.Status == PromotionCodeStatusReserved
+}
+
+func (cs PromotionCodes) Values() []string {
+	res := make([]string, len(cs))
+	for i := range cs {
+		res[i] = cs[i].Code
+	}
+	return res
+}
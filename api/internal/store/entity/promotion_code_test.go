@@ -0,0 +1,192 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotionCodeBatch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewPromotionCodeBatchParams
+		expect *PromotionCodeBatch
+	}{
+		{
+			name: "success",
+			params: &NewPromotionCodeBatchParams{
+				PromotionID: "promotion-id",
+				Title:       "彦根マルシェ配布分",
+				Quantity:    100,
+			},
+			expect: &PromotionCodeBatch{
+				PromotionID: "promotion-id",
+				Title:       "彦根マルシェ配布分",
+				Quantity:    100,
+				Status:      PromotionCodeBatchStatusActive,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPromotionCodeBatch(tt.params)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestPromotionCodeBatch_Voidable(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		batch  *PromotionCodeBatch
+		expect bool
+	}{
+		{
+			name:   "active",
+			batch:  &PromotionCodeBatch{Status: PromotionCodeBatchStatusActive},
+			expect: true,
+		},
+		{
+			name:   "voided",
+			batch:  &PromotionCodeBatch{Status: PromotionCodeBatchStatusVoided},
+			expect: false,
+		},
+		{
+			name:   "empty",
+			batch:  nil,
+			expect: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.batch.Voidable())
+		})
+	}
+}
+
+func TestPromotionCodeBatch_NewCodes(t *testing.T) {
+	t.Parallel()
+	batch := &PromotionCodeBatch{
+		ID:          "batch-id",
+		PromotionID: "promotion-id",
+		Quantity:    1000,
+	}
+	codes, err := batch.NewCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, 1000)
+	values := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		assert.Len(t, code.Code, PromotionCodeLength)
+		assert.Equal(t, "batch-id", code.BatchID)
+		assert.Equal(t, "promotion-id", code.PromotionID)
+		assert.Equal(t, PromotionCodeStatusAvailable, code.Status)
+		values[code.Code] = struct{}{}
+	}
+	assert.Len(t, values, 1000)
+}
+
+func TestPromotionCode_Usable(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		code    *PromotionCode
+		orderID string
+		expect  bool
+	}{
+		{
+			name:    "available",
+			code:    &PromotionCode{Status: PromotionCodeStatusAvailable},
+			orderID: "order-id",
+			expect:  true,
+		},
+		{
+			name:    "reserved by same order",
+			code:    &PromotionCode{Status: PromotionCodeStatusReserved, OrderID: "order-id"},
+			orderID: "order-id",
+			expect:  true,
+		},
+		{
+			name:    "reserved by other order",
+			code:    &PromotionCode{Status: PromotionCodeStatusReserved, OrderID: "other-id"},
+			orderID: "order-id",
+			expect:  false,
+		},
+		{
+			name:    "redeemed",
+			code:    &PromotionCode{Status: PromotionCodeStatusRedeemed, OrderID: "order-id"},
+			orderID: "order-id",
+			expect:  false,
+		},
+		{
+			name:    "voided",
+			code:    &PromotionCode{Status: PromotionCodeStatusVoided},
+			orderID: "order-id",
+			expect:  false,
+		},
+		{
+			name:    "empty",
+			code:    nil,
+			orderID: "order-id",
+			expect:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.code.Usable(tt.orderID))
+		})
+	}
+}
+
+func TestPromotionCode_IsEnabled(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		code   *PromotionCode
+		expect bool
+	}{
+		{
+			name:   "available",
+			code:   &PromotionCode{Status: PromotionCodeStatusAvailable},
+			expect: true,
+		},
+		{
+			name:   "reserved",
+			code:   &PromotionCode{Status: PromotionCodeStatusReserved},
+			expect: true,
+		},
+		{
+			name:   "redeemed",
+			code:   &PromotionCode{Status: PromotionCodeStatusRedeemed},
+			expect: false,
+		},
+		{
+			name:   "voided",
+			code:   &PromotionCode{Status: PromotionCodeStatusVoided},
+			expect: false,
+		},
+		{
+			name:   "empty",
+			code:   nil,
+			expect: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.code.IsEnabled())
+		})
+	}
+}
+
+func TestPromotionCodes_Values(t *testing.T) {
+	t.Parallel()
+	codes := PromotionCodes{{Code: "ABCD2345"}, {Code: "EFGH2345"}}
+	assert.Equal(t, []string{"ABCD2345", "EFGH2345"}, codes.Values())
+}
@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+)
+
+// PromotionRedemptionStatus - プロモーションの利用状況
+type PromotionRedemptionStatus int32
+
+const (
+	PromotionRedemptionStatusUnknown  PromotionRedemptionStatus = 0
+	PromotionRedemptionStatusReserved PromotionRedemptionStatus = 1 // 利用予約(決済待ち)
+	PromotionRedemptionStatusRedeemed PromotionRedemptionStatus = 2 // 利用確定
+	PromotionRedemptionStatusCanceled PromotionRedemptionStatus = 3 // 取り消し
+)
+
+// PromotionRedemptionActiveStatuses - 利用回数として計上する状態一覧
+var PromotionRedemptionActiveStatuses = []PromotionRedemptionStatus{
+	PromotionRedemptionStatusReserved,
+	PromotionRedemptionStatusRedeemed,
+}
+
+// PromotionRedemption - プロモーションの利用情報
+type PromotionRedemption struct {
+	OrderID     string                    `gorm:"primaryKey;<-:create"`   // 注文履歴ID
+	PromotionID string                    `gorm:"<-:create"`              // プロモーションID
+	UserID      string                    `gorm:"<-:create"`              // ユーザーID
+	Code        string                    `gorm:"<-:create"`              // 利用したクーポンコード
+	Status      PromotionRedemptionStatus `gorm:""`                       // 利用状況
+	ExpiredAt   time.Time                 `gorm:"<-:create;default:null"` // 利用予約期限
+	CreatedAt   time.Time                 `gorm:"<-:create"`              // 登録日時
+	UpdatedAt   time.Time                 `gorm:""`                       // 更新日時
+}
+
+type PromotionRedemptions []*PromotionRedemption
+
+// AggregatedPromotionRedemption - プロモーションの利用回数集計情報
+type AggregatedPromotionRedemption struct {
+	PromotionID     string `gorm:"primaryKey"` // プロモーションID
+	RedemptionCount int64  `gorm:""`           // 利用回数
+}
+
+type AggregatedPromotionRedemptions []*AggregatedPromotionRedemption
+
+type NewPromotionRedemptionParams struct {
+	OrderID     string
+	PromotionID string
+	UserID      string
+	Code        string
+	ExpiredAt   time.Time
+}
+
+func NewPromotionRedemption(params *NewPromotionRedemptionParams) *PromotionRedemption {
+	return &PromotionRedemption{
+		OrderID:     params.OrderID,
+		PromotionID: params.PromotionID,
+		UserID:      params.UserID,
+		Code:        params.Code,
+		Status:      PromotionRedemptionStatusReserved,
+		ExpiredAt:   params.ExpiredAt,
+	}
+}
+
+// Releasable - 利用予約の取り消しが可能か
+func (r *PromotionRedemption) Releasable() bool {
+	if r == nil {
+		return false
+	}
+	return r.Status == PromotionRedemptionStatusReserved
+}
+
+func (rs PromotionRedemptions) OrderIDs() []string {
+	return set.UniqBy(rs, func(r *PromotionRedemption) string {
+		return r.OrderID
+	})
+}
+
+func (as AggregatedPromotionRedemptions) Map() map[string]*AggregatedPromotionRedemption {
+	res := make(map[string]*AggregatedPromotionRedemption, len(as))
+	for _, a := range as {
+		res[a.PromotionID] = a
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromotionRedemption(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewPromotionRedemptionParams
+		expect *PromotionRedemption
+	}{
+		{
+			name: "success",
+			params: &NewPromotionRedemptionParams{
+				OrderID:     "order-id",
+				PromotionID: "promotion-id",
+				UserID:      "user-id",
+				Code:        "ABCD2345",
+				ExpiredAt:   jst.Date(2024, 8, 24, 19, 0, 0, 0),
+			},
+			expect: &PromotionRedemption{
+				OrderID:     "order-id",
+				PromotionID: "promotion-id",
+				UserID:      "user-id",
+				Code:        "ABCD2345",
+				Status:      PromotionRedemptionStatusReserved,
+				ExpiredAt:   jst.Date(2024, 8, 24, 19, 0, 0, 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPromotionRedemption(tt.params)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestPromotionRedemption_Releasable(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		redemption *PromotionRedemption
+		expect     bool
+	}{
+		{
+			name:       "reserved",
+			redemption: &PromotionRedemption{Status: PromotionRedemptionStatusReserved},
+			expect:     true,
+		},
+		{
+			name:       "redeemed",
+			redemption: &PromotionRedemption{Status: PromotionRedemptionStatusRedeemed},
+			expect:     false,
+		},
+		{
+			name:       "canceled",
+			redemption: &PromotionRedemption{Status: PromotionRedemptionStatusCanceled},
+			expect:     false,
+		},
+		{
+			name:       "empty",
+			redemption: nil,
+			expect:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.redemption.Releasable())
+		})
+	}
+}
+
+func TestPromotionRedemptions_OrderIDs(t *testing.T) {
+	t.Parallel()
+	redemptions := PromotionRedemptions{
+		{OrderID: "order-id01", PromotionID: "promotion-id"},
+		{OrderID: "order-id02", PromotionID: "promotion-id"},
+	}
+	assert.ElementsMatch(t, []string{"order-id01", "order-id02"}, redemptions.OrderIDs())
+}
+
+func TestAggregatedPromotionRedemptions_Map(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		redemptions AggregatedPromotionRedemptions
+		expect      map[string]*AggregatedPromotionRedemption
+	}{
+		{
+			name: "success",
+			redemptions: AggregatedPromotionRedemptions{
+				{PromotionID: "promotion-id", RedemptionCount: 2},
+			},
+			expect: map[string]*AggregatedPromotionRedemption{
+				"promotion-id": {PromotionID: "promotion-id", RedemptionCount: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.redemptions.Map())
+		})
+	}
+}
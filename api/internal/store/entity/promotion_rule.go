@@ -0,0 +1,373 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var errInvalidPromotionRule = errors.New("entity: invalid promotion rule")
+
+// PromotionRuleType - プロモーション適用ルール種別
+type PromotionRuleType int32
+
+const (
+	PromotionRuleTypeUnknown    PromotionRuleType = 0
+	PromotionRuleTypeAmount     PromotionRuleType = 1 // 固定額割引(円)
+	PromotionRuleTypeRate       PromotionRuleType = 2 // 料率割引(%)
+	PromotionRuleTypeTieredRate PromotionRuleType = 3 // 段階料率割引(%)
+	PromotionRuleTypeBuyXGetY   PromotionRuleType = 4 // N個購入でM個無料
+)
+
+// PromotionRule - プロモーション適用ルール
+type PromotionRule struct {
+	ID             string             `json:"id"`             // ルールID
+	Type           PromotionRuleType  `json:"type"`           // ルール種別
+	ProductIDs     []string           `json:"productIds"`     // 対象商品ID一覧
+	ProductTypeIDs []string           `json:"productTypeIds"` // 対象品目ID一覧
+	CategoryIDs    []string           `json:"categoryIds"`    // 対象カテゴリID一覧
+	MinimumAmount  int64              `json:"minimumAmount"`  // 適用に必要な対象商品の購入金額(税込)
+	DiscountRate   int64              `json:"discountRate"`   // 割引額(%/円)
+	Tiers          PromotionRuleTiers `json:"tiers"`          // 段階料率一覧
+	BuyQuantity    int64              `json:"buyQuantity"`    // 購入数量(N個購入)
+	FreeQuantity   int64              `json:"freeQuantity"`   // 無料数量(M個無料)
+	MaxDiscount    int64              `json:"maxDiscount"`    // 割引上限額(円)
+}
+
+type PromotionRules []*PromotionRule
+
+// PromotionRuleTier - 段階料率
+type PromotionRuleTier struct {
+	Threshold int64 `json:"threshold"` // 適用に必要な購入金額(税込)
+	Rate      int64 `json:"rate"`      // 割引率(%)
+}
+
+type PromotionRuleTiers []*PromotionRuleTier
+
+// PromotionItem - 割引計算対象の商品
+type PromotionItem struct {
+	ProductID     string // 商品ID
+	ProductTypeID string // 品目ID
+	CategoryID    string // カテゴリID
+	Price         int64  // 販売価格(税込)
+	Quantity      int64  // 購入数量
+}
+
+type PromotionItems []*PromotionItem
+
+// PromotionDiscount - 商品ごとの割引内訳
+type PromotionDiscount struct {
+	ProductID string // 商品ID
+	RuleID    string // 適用ルールID
+	Amount    int64  // 割引金額(税込)
+}
+
+type PromotionDiscounts []*PromotionDiscount
+
+type NewPromotionRuleParams struct {
+	Type           PromotionRuleType
+	ProductIDs     []string
+	ProductTypeIDs []string
+	CategoryIDs    []string
+	MinimumAmount  int64
+	DiscountRate   int64
+	Tiers          PromotionRuleTiers
+	BuyQuantity    int64
+	FreeQuantity   int64
+	MaxDiscount    int64
+}
+
+type NewPromotionItemsParams struct {
+	Baskets      CartBaskets
+	Products     map[string]*Product
+	ProductTypes map[string]*ProductType
+}
+
+type NewProductPromotionDiscountsParams struct {
+	Baskets      CartBaskets
+	Products     Products
+	ProductTypes ProductTypes
+	Promotion    *Promotion
+}
+
+func NewPromotionRule(params *NewPromotionRuleParams) *PromotionRule {
+	return &PromotionRule{
+		ID:             uuid.Base58Encode(uuid.New()),
+		Type:           params.Type,
+		ProductIDs:     params.ProductIDs,
+		ProductTypeIDs: params.ProductTypeIDs,
+		CategoryIDs:    params.CategoryIDs,
+		MinimumAmount:  params.MinimumAmount,
+		DiscountRate:   params.DiscountRate,
+		Tiers:          params.Tiers,
+		BuyQuantity:    params.BuyQuantity,
+		FreeQuantity:   params.FreeQuantity,
+		MaxDiscount:    params.MaxDiscount,
+	}
+}
+
+func (r *PromotionRule) Validate() error {
+	if r.MinimumAmount < 0 || r.MaxDiscount < 0 {
+		return errInvalidPromotionRule
+	}
+	switch r.Type {
+	case PromotionRuleTypeAmount:
+		if r.DiscountRate <= 0 {
+			return errInvalidDiscount
+		}
+	case PromotionRuleTypeRate:
+		if r.DiscountRate <= 0 || r.DiscountRate > 100 {
+			return errInvalidDiscount
+		}
+	case PromotionRuleTypeTieredRate:
+		return r.Tiers.Validate()
+	case PromotionRuleTypeBuyXGetY:
+		if r.BuyQuantity <= 0 || r.FreeQuantity <= 0 {
+			return errInvalidPromotionRule
+		}
+	default:
+		return errInvalidPromotionRule
+	}
+	return nil
+}
+
+// IsTarget - 割引対象の商品か（対象の指定がない場合はすべての商品が対象）
+func (r *PromotionRule) IsTarget(item *PromotionItem) bool {
+	if len(r.ProductIDs) == 0 && len(r.ProductTypeIDs) == 0 && len(r.CategoryIDs) == 0 {
+		return true
+	}
+	if item.ProductID != "" && slices.Contains(r.ProductIDs, item.ProductID) {
+		return true
+	}
+	if item.ProductTypeID != "" && slices.Contains(r.ProductTypeIDs, item.ProductTypeID) {
+		return true
+	}
+	return item.CategoryID != "" && slices.Contains(r.CategoryIDs, item.CategoryID)
+}
+
+// calc - 適用ルールに基づいて商品ごとの割引金額を算出
+// remains には商品ごとの割引適用後の残額を保持し、割引額が残額を超えないようにする
+func (r *PromotionRule) calc(items PromotionItems, remains []int64) PromotionDiscounts {
+	var subtotal, remain int64
+	targets := make([]int, 0, len(items))
+	for i, item := range items {
+		if !r.IsTarget(item) {
+			continue
+		}
+		targets = append(targets, i)
+		subtotal += item.Price * item.Quantity
+		remain += remains[i]
+	}
+	if subtotal == 0 || remain == 0 || subtotal < r.MinimumAmount {
+		return nil
+	}
+	amounts := make([]int64, len(items))
+	switch r.Type {
+	case PromotionRuleTypeAmount:
+		amounts = allocateDiscount(min(r.DiscountRate, remain), targets, remains)
+	case PromotionRuleTypeRate:
+		amounts = allocateDiscount(calcDiscountByRate(remain, r.DiscountRate), targets, remains)
+	case PromotionRuleTypeTieredRate:
+		rate := r.Tiers.Rate(subtotal)
+		amounts = allocateDiscount(calcDiscountByRate(remain, rate), targets, remains)
+	case PromotionRuleTypeBuyXGetY:
+		for _, i := range targets {
+			sets := items[i].Quantity / (r.BuyQuantity + r.FreeQuantity)
+			amounts[i] = min(sets*r.FreeQuantity*items[i].Price, remains[i])
+		}
+	}
+	// 割引上限額を超える場合、後ろの商品から割引額を減らす
+	if r.MaxDiscount > 0 {
+		var total int64
+		for _, amount := range amounts {
+			total += amount
+		}
+		for i := len(amounts) - 1; i >= 0 && total > r.MaxDiscount; i-- {
+			diff := min(amounts[i], total-r.MaxDiscount)
+			amounts[i] -= diff
+			total -= diff
+		}
+	}
+	res := make(PromotionDiscounts, 0, len(targets))
+	for _, i := range targets {
+		if amounts[i] <= 0 {
+			continue
+		}
+		remains[i] -= amounts[i]
+		discount := &PromotionDiscount{
+			ProductID: items[i].ProductID,
+			RuleID:    r.ID,
+			Amount:    amounts[i],
+		}
+		res = append(res, discount)
+	}
+	return res
+}
+
+func (rs PromotionRules) Validate() error {
+	if len(rs) == 0 {
+		return errInvalidPromotionRule
+	}
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasCategoryTarget - カテゴリを対象とするルールを含むか
+func (rs PromotionRules) HasCategoryTarget() bool {
+	for _, r := range rs {
+		if len(r.CategoryIDs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Calc - ルールを順に適用し、商品ごとの割引内訳を算出
+func (rs PromotionRules) Calc(items PromotionItems) PromotionDiscounts {
+	remains := make([]int64, len(items))
+	for i, item := range items {
+		remains[i] = item.Price * item.Quantity
+	}
+	res := make(PromotionDiscounts, 0, len(items))
+	for _, r := range rs {
+		res = append(res, r.calc(items, remains)...)
+	}
+	return res
+}
+
+func (ts PromotionRuleTiers) Validate() error {
+	if len(ts) == 0 {
+		return errInvalidPromotionRule
+	}
+	thresholds := make(map[int64]struct{}, len(ts))
+	for _, t := range ts {
+		if t.Threshold < 0 || t.Rate <= 0 || t.Rate > 100 {
+			return errInvalidDiscount
+		}
+		if _, ok := thresholds[t.Threshold]; ok {
+			return errInvalidPromotionRule
+		}
+		thresholds[t.Threshold] = struct{}{}
+	}
+	return nil
+}
+
+// Rate - 購入金額に応じた割引率を取得（条件を満たす段階のうち、最も購入金額の高い段階を採用）
+func (ts PromotionRuleTiers) Rate(subtotal int64) int64 {
+	var threshold, rate int64 = -1, 0
+	for _, t := range ts {
+		if subtotal < t.Threshold || t.Threshold <= threshold {
+			continue
+		}
+		threshold, rate = t.Threshold, t.Rate
+	}
+	return rate
+}
+
+// NewPromotionItems - 買い物かごの内容から割引計算対象の商品一覧を生成
+func NewPromotionItems(params *NewPromotionItemsParams) (PromotionItems, error) {
+	res := make(PromotionItems, 0, len(params.Baskets))
+	items := make(map[string]*PromotionItem, len(params.Baskets))
+	for _, basket := range params.Baskets {
+		for _, cartItem := range basket.Items {
+			if item, ok := items[cartItem.ProductID]; ok {
+				item.Quantity += cartItem.Quantity
+				continue
+			}
+			product, ok := params.Products[cartItem.ProductID]
+			if !ok {
+				return nil, errNotFoundProduct
+			}
+			item := &PromotionItem{
+				ProductID:     product.ID,
+				ProductTypeID: product.TypeID,
+				Price:         product.Price,
+				Quantity:      cartItem.Quantity,
+			}
+			if typ, ok := params.ProductTypes[product.TypeID]; ok {
+				item.CategoryID = typ.CategoryID
+			}
+			items[product.ID] = item
+			res = append(res, item)
+		}
+	}
+	return res, nil
+}
+
+// NewProductPromotionDiscounts - 購入商品に対する商品ごとの割引内訳を算出
+func NewProductPromotionDiscounts(params *NewProductPromotionDiscountsParams) (PromotionDiscounts, error) {
+	if params.Promotion == nil {
+		return nil, nil
+	}
+	iparams := &NewPromotionItemsParams{
+		Baskets:      params.Baskets,
+		Products:     params.Products.Map(),
+		ProductTypes: params.ProductTypes.Map(),
+	}
+	items, err := NewPromotionItems(iparams)
+	if err != nil {
+		return nil, err
+	}
+	return params.Promotion.CalcItemDiscounts(items), nil
+}
+
+func (ds PromotionDiscounts) Total() int64 {
+	var total int64
+	for _, d := range ds {
+		total += d.Amount
+	}
+	return total
+}
+
+func (ds PromotionDiscounts) GroupByProductID() map[string]PromotionDiscounts {
+	res := make(map[string]PromotionDiscounts, len(ds))
+	for _, d := range ds {
+		res[d.ProductID] = append(res[d.ProductID], d)
+	}
+	return res
+}
+
+// allocateDiscount - 割引金額を割引前の残額に応じて対象商品へ按分
+func allocateDiscount(discount int64, targets []int, remains []int64) []int64 {
+	res := make([]int64, len(remains))
+	var total int64
+	for _, i := range targets {
+		total += remains[i]
+	}
+	if total == 0 || discount <= 0 {
+		return res
+	}
+	discount = min(discount, total)
+	dtotal := decimal.NewFromInt(total)
+	ddiscount := decimal.NewFromInt(discount)
+	var allocated int64
+	for _, i := range targets {
+		res[i] = ddiscount.Mul(decimal.NewFromInt(remains[i])).Div(dtotal).IntPart()
+		allocated += res[i]
+	}
+	// 端数は先頭の商品から順に割り当てる
+	for _, i := range targets {
+		if allocated >= discount {
+			break
+		}
+		diff := min(discount-allocated, remains[i]-res[i])
+		res[i] += diff
+		allocated += diff
+	}
+	return res
+}
+
+func calcDiscountByRate(total, rate int64) int64 {
+	if rate == 0 {
+		return 0
+	}
+	dtotal := decimal.NewFromInt(total)
+	drate := decimal.NewFromInt(rate).Div(decimal.NewFromInt(100))
+	return dtotal.Mul(drate).IntPart()
+}
@@ -0,0 +1,372 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromotionRule(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewPromotionRuleParams
+		expect *PromotionRule
+	}{
+		{
+			name: "success",
+			params: &NewPromotionRuleParams{
+				Type:          PromotionRuleTypeTieredRate,
+				ProductIDs:    []string{"product-id"},
+				MinimumAmount: 3000,
+				Tiers: PromotionRuleTiers{
+					{Threshold: 5000, Rate: 5},
+					{Threshold: 10000, Rate: 10},
+				},
+				MaxDiscount: 2000,
+			},
+			expect: &PromotionRule{
+				Type:          PromotionRuleTypeTieredRate,
+				ProductIDs:    []string{"product-id"},
+				MinimumAmount: 3000,
+				Tiers: PromotionRuleTiers{
+					{Threshold: 5000, Rate: 5},
+					{Threshold: 10000, Rate: 10},
+				},
+				MaxDiscount: 2000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewPromotionRule(tt.params)
+			assert.NotEmpty(t, actual.ID)
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestPromotionRule_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		rule   *PromotionRule
+		hasErr bool
+	}{
+		{
+			name:   "amount",
+			rule:   &PromotionRule{Type: PromotionRuleTypeAmount, DiscountRate: 500},
+			hasErr: false,
+		},
+		{
+			name:   "invalid amount",
+			rule:   &PromotionRule{Type: PromotionRuleTypeAmount, DiscountRate: 0},
+			hasErr: true,
+		},
+		{
+			name:   "rate",
+			rule:   &PromotionRule{Type: PromotionRuleTypeRate, DiscountRate: 10},
+			hasErr: false,
+		},
+		{
+			name:   "invalid rate",
+			rule:   &PromotionRule{Type: PromotionRuleTypeRate, DiscountRate: 101},
+			hasErr: true,
+		},
+		{
+			name: "tiered rate",
+			rule: &PromotionRule{
+				Type:  PromotionRuleTypeTieredRate,
+				Tiers: PromotionRuleTiers{{Threshold: 5000, Rate: 5}, {Threshold: 10000, Rate: 10}},
+			},
+			hasErr: false,
+		},
+		{
+			name:   "empty tiers",
+			rule:   &PromotionRule{Type: PromotionRuleTypeTieredRate},
+			hasErr: true,
+		},
+		{
+			name: "duplicate tiers",
+			rule: &PromotionRule{
+				Type:  PromotionRuleTypeTieredRate,
+				Tiers: PromotionRuleTiers{{Threshold: 5000, Rate: 5}, {Threshold: 5000, Rate: 10}},
+			},
+			hasErr: true,
+		},
+		{
+			name:   "buy x get y",
+			rule:   &PromotionRule{Type: PromotionRuleTypeBuyXGetY, BuyQuantity: 2, FreeQuantity: 1},
+			hasErr: false,
+		},
+		{
+			name:   "invalid buy x get y",
+			rule:   &PromotionRule{Type: PromotionRuleTypeBuyXGetY, BuyQuantity: 2},
+			hasErr: true,
+		},
+		{
+			name:   "invalid max discount",
+			rule:   &PromotionRule{Type: PromotionRuleTypeAmount, DiscountRate: 500, MaxDiscount: -1},
+			hasErr: true,
+		},
+		{
+			name:   "unknown type",
+			rule:   &PromotionRule{Type: PromotionRuleTypeUnknown},
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.rule.Validate()
+			assert.Equal(t, tt.hasErr, err != nil, err)
+		})
+	}
+}
+
+func TestPromotionRule_IsTarget(t *testing.T) {
+	t.Parallel()
+	item := &PromotionItem{
+		ProductID:     "product-id",
+		ProductTypeID: "product-type-id",
+		CategoryID:    "category-id",
+	}
+	tests := []struct {
+		name   string
+		rule   *PromotionRule
+		expect bool
+	}{
+		{
+			name:   "all products",
+			rule:   &PromotionRule{},
+			expect: true,
+		},
+		{
+			name:   "match product",
+			rule:   &PromotionRule{ProductIDs: []string{"product-id"}},
+			expect: true,
+		},
+		{
+			name:   "match product type",
+			rule:   &PromotionRule{ProductTypeIDs: []string{"product-type-id"}},
+			expect: true,
+		},
+		{
+			name:   "match category",
+			rule:   &PromotionRule{CategoryIDs: []string{"category-id"}},
+			expect: true,
+		},
+		{
+			name:   "unmatch",
+			rule:   &PromotionRule{ProductIDs: []string{"other-id"}, CategoryIDs: []string{"other-id"}},
+			expect: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.rule.IsTarget(item))
+		})
+	}
+}
+
+func TestPromotionRules_Calc(t *testing.T) {
+	t.Parallel()
+	items := func() PromotionItems {
+		return PromotionItems{
+			{ProductID: "product-id01", ProductTypeID: "type-id01", CategoryID: "category-id01", Price: 1000, Quantity: 3},
+			{ProductID: "product-id02", ProductTypeID: "type-id02", CategoryID: "category-id02", Price: 500, Quantity: 4},
+		}
+	}
+	tests := []struct {
+		name   string
+		rules  PromotionRules
+		items  PromotionItems
+		expect PromotionDiscounts
+	}{
+		{
+			name:  "amount for all products",
+			rules: PromotionRules{{ID: "rule-id", Type: PromotionRuleTypeAmount, DiscountRate: 1000}},
+			items: items(),
+			expect: PromotionDiscounts{
+				{ProductID: "product-id01", RuleID: "rule-id", Amount: 600},
+				{ProductID: "product-id02", RuleID: "rule-id", Amount: 400},
+			},
+		},
+		{
+			name:  "rate for specific product",
+			rules: PromotionRules{{ID: "rule-id", Type: PromotionRuleTypeRate, ProductIDs: []string{"product-id02"}, DiscountRate: 10}},
+			items: items(),
+			expect: PromotionDiscounts{
+				{ProductID: "product-id02", RuleID: "rule-id", Amount: 200},
+			},
+		},
+		{
+			name: "tiered rate",
+			rules: PromotionRules{{
+				ID:    "rule-id",
+				Type:  PromotionRuleTypeTieredRate,
+				Tiers: PromotionRuleTiers{{Threshold: 5000, Rate: 5}, {Threshold: 10000, Rate: 10}},
+			}},
+			items: items(),
+			expect: PromotionDiscounts{
+				{ProductID: "product-id01", RuleID: "rule-id", Amount: 150},
+				{ProductID: "product-id02", RuleID: "rule-id", Amount: 100},
+			},
+		},
+		{
+			name: "tiered rate under threshold",
+			rules: PromotionRules{{
+				ID:    "rule-id",
+				Type:  PromotionRuleTypeTieredRate,
+				Tiers: PromotionRuleTiers{{Threshold: 10000, Rate: 10}},
+			}},
+			items:  items(),
+			expect: PromotionDiscounts{},
+		},
+		{
+			name:   "minimum amount",
+			rules:  PromotionRules{{ID: "rule-id", Type: PromotionRuleTypeRate, CategoryIDs: []string{"category-id02"}, MinimumAmount: 3000, DiscountRate: 10}},
+			items:  items(),
+			expect: PromotionDiscounts{},
+		},
+		{
+			name:  "buy x get y",
+			rules: PromotionRules{{ID: "rule-id", Type: PromotionRuleTypeBuyXGetY, BuyQuantity: 2, FreeQuantity: 1}},
+			items: items(),
+			expect: PromotionDiscounts{
+				{ProductID: "product-id01", RuleID: "rule-id", Amount: 1000},
+				{ProductID: "product-id02", RuleID: "rule-id", Amount: 500},
+			},
+		},
+		{
+			name:  "max discount",
+			rules: PromotionRules{{ID: "rule-id", Type: PromotionRuleTypeBuyXGetY, BuyQuantity: 2, FreeQuantity: 1, MaxDiscount: 1200}},
+			items: items(),
+			expect: PromotionDiscounts{
+				{ProductID: "product-id01", RuleID: "rule-id", Amount: 1000},
+				{ProductID: "product-id02", RuleID: "rule-id", Amount: 200},
+			},
+		},
+		{
+			name: "multiple rules",
+			rules: PromotionRules{
+				{ID: "rule-id01", Type: PromotionRuleTypeAmount, ProductTypeIDs: []string{"type-id02"}, DiscountRate: 1800},
+				{ID: "rule-id02", Type: PromotionRuleTypeRate, DiscountRate: 10},
+			},
+			items: items(),
+			expect: PromotionDiscounts{
+				{ProductID: "product-id02", RuleID: "rule-id01", Amount: 1800},
+				{ProductID: "product-id01", RuleID: "rule-id02", Amount: 300},
+				{ProductID: "product-id02", RuleID: "rule-id02", Amount: 20},
+			},
+		},
+		{
+			name:   "empty items",
+			rules:  PromotionRules{{ID: "rule-id", Type: PromotionRuleTypeAmount, DiscountRate: 1000}},
+			items:  PromotionItems{},
+			expect: PromotionDiscounts{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := tt.rules.Calc(tt.items)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestPromotionRules_HasCategoryTarget(t *testing.T) {
+	t.Parallel()
+	assert.True(t, PromotionRules{{}, {CategoryIDs: []string{"category-id"}}}.HasCategoryTarget())
+	assert.False(t, PromotionRules{{ProductIDs: []string{"product-id"}}}.HasCategoryTarget())
+}
+
+func TestPromotionRuleTiers_Rate(t *testing.T) {
+	t.Parallel()
+	tiers := PromotionRuleTiers{
+		{Threshold: 10000, Rate: 10},
+		{Threshold: 5000, Rate: 5},
+	}
+	tests := []struct {
+		name     string
+		subtotal int64
+		expect   int64
+	}{
+		{name: "under threshold", subtotal: 4999, expect: 0},
+		{name: "first tier", subtotal: 5000, expect: 5},
+		{name: "second tier", subtotal: 12000, expect: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tiers.Rate(tt.subtotal))
+		})
+	}
+}
+
+func TestPromotionItems(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *NewPromotionItemsParams
+		expect PromotionItems
+		hasErr bool
+	}{
+		{
+			name: "success",
+			params: &NewPromotionItemsParams{
+				Baskets: CartBaskets{
+					{BoxNumber: 1, Items: CartItems{{ProductID: "product-id01", Quantity: 1}, {ProductID: "product-id02", Quantity: 2}}},
+					{BoxNumber: 2, Items: CartItems{{ProductID: "product-id01", Quantity: 2}}},
+				},
+				Products: map[string]*Product{
+					"product-id01": {ID: "product-id01", TypeID: "type-id01", ProductRevision: ProductRevision{Price: 500}},
+					"product-id02": {ID: "product-id02", TypeID: "type-id02", ProductRevision: ProductRevision{Price: 1980}},
+				},
+				ProductTypes: map[string]*ProductType{
+					"type-id01": {ID: "type-id01", CategoryID: "category-id01"},
+				},
+			},
+			expect: PromotionItems{
+				{ProductID: "product-id01", ProductTypeID: "type-id01", CategoryID: "category-id01", Price: 500, Quantity: 3},
+				{ProductID: "product-id02", ProductTypeID: "type-id02", Price: 1980, Quantity: 2},
+			},
+			hasErr: false,
+		},
+		{
+			name: "not found product",
+			params: &NewPromotionItemsParams{
+				Baskets:  CartBaskets{{BoxNumber: 1, Items: CartItems{{ProductID: "product-id01", Quantity: 1}}}},
+				Products: map[string]*Product{},
+			},
+			expect: nil,
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewPromotionItems(tt.params)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestPromotionDiscounts(t *testing.T) {
+	t.Parallel()
+	discounts := PromotionDiscounts{
+		{ProductID: "product-id01", RuleID: "rule-id01", Amount: 100},
+		{ProductID: "product-id02", RuleID: "rule-id01", Amount: 200},
+		{ProductID: "product-id01", RuleID: "rule-id02", Amount: 50},
+	}
+	assert.Equal(t, int64(350), discounts.Total())
+	assert.Equal(t, map[string]PromotionDiscounts{
+		"product-id01": {discounts[0], discounts[2]},
+		"product-id02": {discounts[1]},
+	}, discounts.GroupByProductID())
+}
@@ -146,6 +146,19 @@ func TestPromotion_CalcDiscount(t *testing.T) {
 			shippingFee: 500,
 			expect:      500,
 		},
+		{
+			name: "適用ルールによる割引 対象を限定しないルールのみ適用",
+			promotion: &Promotion{
+				DiscountType: DiscountTypeRule,
+				Rules: PromotionRules{
+					{Type: PromotionRuleTypeTieredRate, Tiers: PromotionRuleTiers{{Threshold: 1000, Rate: 5}, {Threshold: 5000, Rate: 10}}},
+					{Type: PromotionRuleTypeAmount, ProductIDs: []string{"product-id"}, DiscountRate: 500},
+				},
+			},
+			total:       1980,
+			shippingFee: 500,
+			expect:      99,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -224,6 +237,125 @@ func TestPromotion_IsEnabled(t *testing.T) {
 	}
 }
 
+func TestPromotion_LimitPerUser(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		promotion *Promotion
+		expect    int64
+	}{
+		{
+			name:      "once",
+			promotion: &Promotion{CodeType: PromotionCodeTypeOnce, UsageLimitPerUser: 3},
+			expect:    1,
+		},
+		{
+			name:      "first purchase only",
+			promotion: &Promotion{CodeType: PromotionCodeTypeAlways, FirstPurchaseOnly: true},
+			expect:    1,
+		},
+		{
+			name:      "always",
+			promotion: &Promotion{CodeType: PromotionCodeTypeAlways, UsageLimitPerUser: 3},
+			expect:    3,
+		},
+		{
+			name:      "unlimited",
+			promotion: &Promotion{CodeType: PromotionCodeTypeAlways},
+			expect:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.promotion.LimitPerUser())
+		})
+	}
+}
+
+func TestPromotion_RemainingRedemptions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		promotion *Promotion
+		redeemed  int64
+		expect    int64
+	}{
+		{
+			name:      "remaining",
+			promotion: &Promotion{UsageLimit: 10},
+			redeemed:  3,
+			expect:    7,
+		},
+		{
+			name:      "exhausted",
+			promotion: &Promotion{UsageLimit: 10},
+			redeemed:  12,
+			expect:    0,
+		},
+		{
+			name:      "unlimited",
+			promotion: &Promotion{},
+			redeemed:  3,
+			expect:    -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.promotion.RemainingRedemptions(tt.redeemed))
+		})
+	}
+}
+
+func TestPromotion_VerifyRedemption(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		promotion *Promotion
+		usage     *PromotionUsage
+		expect    error
+	}{
+		{
+			name:      "success",
+			promotion: &Promotion{CodeType: PromotionCodeTypeAlways, UsageLimit: 10, UsageLimitPerUser: 2},
+			usage:     &PromotionUsage{TotalCount: 9, UserCount: 1},
+			expect:    nil,
+		},
+		{
+			name:      "success unlimited",
+			promotion: &Promotion{CodeType: PromotionCodeTypeAlways},
+			usage:     &PromotionUsage{TotalCount: 100, UserCount: 100, PurchaseCount: 100},
+			expect:    nil,
+		},
+		{
+			name:      "exceeded usage limit",
+			promotion: &Promotion{CodeType: PromotionCodeTypeAlways, UsageLimit: 10},
+			usage:     &PromotionUsage{TotalCount: 10},
+			expect:    errPromotionUsageLimit,
+		},
+		{
+			name:      "exceeded usage limit per user",
+			promotion: &Promotion{CodeType: PromotionCodeTypeOnce},
+			usage:     &PromotionUsage{TotalCount: 1, UserCount: 1},
+			expect:    errPromotionUserUsageLimit,
+		},
+		{
+			name:      "not first purchase",
+			promotion: &Promotion{CodeType: PromotionCodeTypeAlways, FirstPurchaseOnly: true},
+			usage:     &PromotionUsage{PurchaseCount: 1},
+			expect:    errPromotionNotFirstPurchase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.promotion.VerifyRedemption(tt.usage)
+			assert.ErrorIs(t, err, tt.expect)
+		})
+	}
+}
+
 func TestPromotion_Validate(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -276,6 +408,24 @@ func TestPromotion_Validate(t *testing.T) {
 			},
 			expect: errInvalidDiscount,
 		},
+		{
+			name: "rule success",
+			promotion: &Promotion{
+				DiscountType: DiscountTypeRule,
+				Rules: PromotionRules{
+					{Type: PromotionRuleTypeBuyXGetY, BuyQuantity: 2, FreeQuantity: 1},
+				},
+			},
+			expect: nil,
+		},
+		{
+			name: "rule error",
+			promotion: &Promotion{
+				DiscountType: DiscountTypeRule,
+				Rules:        PromotionRules{},
+			},
+			expect: errInvalidPromotionRule,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -285,6 +435,79 @@ func TestPromotion_Validate(t *testing.T) {
 	}
 }
 
+func TestPromotion_CalcItemDiscounts(t *testing.T) {
+	t.Parallel()
+	items := PromotionItems{
+		{ProductID: "product-id01", Price: 500, Quantity: 1},
+		{ProductID: "product-id02", Price: 1980, Quantity: 2},
+	}
+	tests := []struct {
+		name      string
+		promotion *Promotion
+		expect    PromotionDiscounts
+	}{
+		{
+			name:      "empty",
+			promotion: nil,
+			expect:    nil,
+		},
+		{
+			name:      "amount",
+			promotion: &Promotion{DiscountType: DiscountTypeAmount, DiscountRate: 1000},
+			expect: PromotionDiscounts{
+				{ProductID: "product-id01", Amount: 113},
+				{ProductID: "product-id02", Amount: 887},
+			},
+		},
+		{
+			name:      "rate",
+			promotion: &Promotion{DiscountType: DiscountTypeRate, DiscountRate: 10},
+			expect: PromotionDiscounts{
+				{ProductID: "product-id01", Amount: 50},
+				{ProductID: "product-id02", Amount: 396},
+			},
+		},
+		{
+			name:      "free shipping",
+			promotion: &Promotion{DiscountType: DiscountTypeFreeShipping},
+			expect:    nil,
+		},
+		{
+			name: "rule",
+			promotion: &Promotion{
+				DiscountType: DiscountTypeRule,
+				Rules: PromotionRules{
+					{ID: "rule-id", Type: PromotionRuleTypeRate, ProductIDs: []string{"product-id01"}, DiscountRate: 20},
+				},
+			},
+			expect: PromotionDiscounts{
+				{ProductID: "product-id01", RuleID: "rule-id", Amount: 100},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.promotion.CalcItemDiscounts(items))
+		})
+	}
+}
+
+func TestPromotion_CalcShippingDiscount(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, int64(500), (&Promotion{DiscountType: DiscountTypeFreeShipping}).CalcShippingDiscount(500))
+	assert.Equal(t, int64(0), (&Promotion{DiscountType: DiscountTypeRate, DiscountRate: 10}).CalcShippingDiscount(500))
+	assert.Equal(t, int64(0), (*Promotion)(nil).CalcShippingDiscount(500))
+}
+
+func TestPromotion_HasCategoryRule(t *testing.T) {
+	t.Parallel()
+	rules := PromotionRules{{Type: PromotionRuleTypeRate, CategoryIDs: []string{"category-id"}, DiscountRate: 10}}
+	assert.True(t, (&Promotion{DiscountType: DiscountTypeRule, Rules: rules}).HasCategoryRule())
+	assert.False(t, (&Promotion{DiscountType: DiscountTypeRate, Rules: rules}).HasCategoryRule())
+	assert.False(t, (*Promotion)(nil).HasCategoryRule())
+}
+
 func TestPromotions_IDs(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -0,0 +1,281 @@
+package entity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementPayeeType - 精算先種別
+type SettlementPayeeType int32
+
+const (
+	SettlementPayeeTypeUnknown     SettlementPayeeType = 0
+	SettlementPayeeTypeShop        SettlementPayeeType = 1 // 店舗
+	SettlementPayeeTypeCoordinator SettlementPayeeType = 2 // コーディネータ
+	SettlementPayeeTypeProducer    SettlementPayeeType = 3 // 生産者
+)
+
+// SettlementStatementStatus - 精算明細の支払い状況
+type SettlementStatementStatus int32
+
+const (
+	SettlementStatementStatusUnknown SettlementStatementStatus = 0
+	SettlementStatementStatusUnpaid  SettlementStatementStatus = 1 // 未払い
+	SettlementStatementStatusPaid    SettlementStatementStatus = 2 // 支払い済み
+)
+
+func (t SettlementPayeeType) String() string {
+	switch t {
+	case SettlementPayeeTypeShop:
+		return "店舗"
+	case SettlementPayeeTypeCoordinator:
+		return "コーディネータ"
+	case SettlementPayeeTypeProducer:
+		return "生産者"
+	default:
+		return ""
+	}
+}
+
+func (s SettlementStatementStatus) String() string {
+	switch s {
+	case SettlementStatementStatusUnpaid:
+		return "未払い"
+	case SettlementStatementStatusPaid:
+		return "支払い済み"
+	default:
+		return ""
+	}
+}
+
+// SettlementStatement - 精算明細（精算対象期間ごとの支払い金額）
+type SettlementStatement struct {
+	ID             string                    `gorm:"primaryKey;<-:create"` // 精算明細ID
+	PayeeType      SettlementPayeeType       `gorm:"<-:create"`            // 精算先種別
+	PayeeID        string                    `gorm:"<-:create"`            // 精算先ID
+	Status         SettlementStatementStatus `gorm:""`                     // 支払い状況
+	PeriodStartAt  time.Time                 `gorm:"<-:create"`            // 精算対象期間(開始)
+	PeriodEndAt    time.Time                 `gorm:"<-:create"`            // 精算対象期間(終了)
+	OrderCount     int64                     `gorm:"<-:create"`            // 対象注文数
+	SalesTotal     int64                     `gorm:"<-:create"`            // 売上金額(税込)
+	RefundTotal    int64                     `gorm:"<-:create"`            // 返金金額(税込)
+	CommissionRate float64                   `gorm:"<-:create"`            // 販売手数料率(%)
+	CommissionFee  int64                     `gorm:"<-:create"`            // 販売手数料
+	PaymentFee     int64                     `gorm:"<-:create"`            // 決済手数料
+	PayoutTotal    int64                     `gorm:"<-:create"`            // 支払い金額
+	PaidAt         time.Time                 `gorm:"default:null"`         // 支払い日時
+	CreatedAt      time.Time                 `gorm:"<-:create"`            // 登録日時
+	UpdatedAt      time.Time                 `gorm:""`                     // 更新日時
+}
+
+type SettlementStatements []*SettlementStatement
+
+// SettlementBalance - 精算先ごとの支払い残高
+type SettlementBalance struct {
+	PayeeType      SettlementPayeeType // 精算先種別
+	PayeeID        string              // 精算先ID
+	StatementCount int64               // 精算明細数
+	UnpaidTotal    int64               // 未払い金額
+	PaidTotal      int64               // 支払い済み金額
+}
+
+type SettlementBalances []*SettlementBalance
+
+type NewSettlementStatementsParams struct {
+	PeriodStartAt  time.Time
+	PeriodEndAt    time.Time
+	CommissionRate float64            // 販売手数料率(%)
+	Orders         Orders             // 精算対象の注文履歴
+	Products       map[int64]*Product // 商品変更履歴ID -> 商品
+	PaymentSystems PaymentSystems     // 決済手数料率の設定
+}
+
+// NewSettlementStatements - 注文履歴から店舗・コーディネータ・生産者ごとの精算明細を生成する
+//
+// 注文１件の支払い金額を精算先で分配する。生産者には自身の商品の購入金額(割引後)を、店舗(未設定の場合はコーディネータ)には
+// 生産者への分配額を差し引いた残額を計上する。返金金額・販売手数料・決済手数料は分配額の割合で按分する。
+// 精算済みの注文は、前回の精算以降に発生した返金金額のみを調整として計上し、対象注文数には含めない。
+func NewSettlementStatements(params *NewSettlementStatementsParams) SettlementStatements {
+	feeRates := params.PaymentSystems.FeeRates()
+	statements := make(map[settlementPayee]*SettlementStatement)
+	get := func(payeeType SettlementPayeeType, payeeID string) *SettlementStatement {
+		payee := settlementPayee{payeeType: payeeType, payeeID: payeeID}
+		if s, ok := statements[payee]; ok {
+			return s
+		}
+		s := &SettlementStatement{
+			ID:             uuid.Base58Encode(uuid.New()),
+			PayeeType:      payeeType,
+			PayeeID:        payeeID,
+			Status:         SettlementStatementStatusUnpaid,
+			PeriodStartAt:  params.PeriodStartAt,
+			PeriodEndAt:    params.PeriodEndAt,
+			CommissionRate: params.CommissionRate,
+		}
+		statements[payee] = s
+		return s
+	}
+	for _, order := range params.Orders {
+		payment := order.OrderPayment
+		if payment.CapturedAt.IsZero() {
+			continue // 実売上前の注文は精算対象外
+		}
+		total := &settlementAmount{
+			sales:         payment.Total,
+			refund:        payment.RefundTotal,
+			commissionFee: calcRateAmount(payment.Total-payment.RefundTotal, params.CommissionRate),
+			paymentFee:    calcRateAmount(payment.Total, feeRates[payment.MethodType]),
+			adjustment:    payment.IsSettled(),
+		}
+		if total.adjustment {
+			// 売上・決済手数料は精算済みのため、追加の返金金額と販売手数料の差額のみを計上する
+			total.sales, total.refund = 0, payment.RefundTotal-payment.SettledRefund
+			total.commissionFee -= calcRateAmount(payment.Total-payment.SettledRefund, params.CommissionRate)
+			total.paymentFee = 0
+		}
+		rest := *total
+		for producerID, amount := range order.OrderItems.ProducerAmounts(params.Products) {
+			producer := total.prorate(amount, payment.Total)
+			get(SettlementPayeeTypeProducer, producerID).add(producer)
+			rest.sub(producer)
+		}
+		payeeType, payeeID := order.settlementPayee()
+		if payeeType == SettlementPayeeTypeUnknown {
+			continue
+		}
+		get(payeeType, payeeID).add(&rest)
+	}
+	res := make(SettlementStatements, 0, len(statements))
+	for _, s := range statements {
+		s.calcPayout()
+		res = append(res, s)
+	}
+	res.SortByPayee()
+	return res
+}
+
+// settlementAmount - 注文１件分の精算金額
+type settlementAmount struct {
+	sales         int64 // 売上金額
+	refund        int64 // 返金金額
+	commissionFee int64 // 販売手数料
+	paymentFee    int64 // 決済手数料
+	adjustment    bool  // 精算済み注文の調整か
+}
+
+// prorate - 注文の支払い金額に占める分配額の割合で按分する
+func (a *settlementAmount) prorate(part, total int64) *settlementAmount {
+	res := &settlementAmount{
+		refund:        prorate(a.refund, part, total),
+		commissionFee: prorate(a.commissionFee, part, total),
+		paymentFee:    prorate(a.paymentFee, part, total),
+		adjustment:    a.adjustment,
+	}
+	if !a.adjustment {
+		res.sales = part
+	}
+	return res
+}
+
+func (a *settlementAmount) sub(other *settlementAmount) {
+	a.sales -= other.sales
+	a.refund -= other.refund
+	a.commissionFee -= other.commissionFee
+	a.paymentFee -= other.paymentFee
+}
+
+type settlementPayee struct {
+	payeeType SettlementPayeeType
+	payeeID   string
+}
+
+// settlementPayee - 生産者への分配額を差し引いた残額の精算先（店舗 > コーディネータの優先順）
+func (o *Order) settlementPayee() (SettlementPayeeType, string) {
+	switch {
+	case o.ShopID != "":
+		return SettlementPayeeTypeShop, o.ShopID
+	case o.CoordinatorID != "":
+		return SettlementPayeeTypeCoordinator, o.CoordinatorID
+	default:
+		return SettlementPayeeTypeUnknown, ""
+	}
+}
+
+// add - 注文１件分の分配額を加算する
+func (s *SettlementStatement) add(amount *settlementAmount) {
+	if !amount.adjustment {
+		s.OrderCount++
+	}
+	s.SalesTotal += amount.sales
+	s.RefundTotal += amount.refund
+	s.CommissionFee += amount.commissionFee
+	s.PaymentFee += amount.paymentFee
+}
+
+// calcPayout - 支払い金額を算出する
+func (s *SettlementStatement) calcPayout() {
+	s.PayoutTotal = s.SalesTotal - s.RefundTotal - s.CommissionFee - s.PaymentFee
+}
+
+// IsPaid - 支払い済みか
+func (s *SettlementStatement) IsPaid() bool {
+	return s.Status == SettlementStatementStatusPaid
+}
+
+// Pay - 支払い済みにする
+func (s *SettlementStatement) Pay(now time.Time) {
+	if s.IsPaid() {
+		return
+	}
+	s.Status = SettlementStatementStatusPaid
+	s.PaidAt = now
+}
+
+func (ss SettlementStatements) SortByPayee() {
+	sort.SliceStable(ss, func(i, j int) bool {
+		if ss[i].PayeeType != ss[j].PayeeType {
+			return ss[i].PayeeType < ss[j].PayeeType
+		}
+		return ss[i].PayeeID < ss[j].PayeeID
+	})
+}
+
+func (ss SettlementStatements) PayeeIDs(payeeType SettlementPayeeType) []string {
+	res := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s.PayeeType == payeeType {
+			res = append(res, s.PayeeID)
+		}
+	}
+	return res
+}
+
+// ProducerAmounts - 生産者ごとの購入金額(割引後)
+func (is OrderItems) ProducerAmounts(products map[int64]*Product) map[string]int64 {
+	res := make(map[string]int64)
+	for _, item := range is {
+		product, ok := products[item.ProductRevisionID]
+		if !ok || product.ProducerID == "" {
+			continue
+		}
+		res[product.ProducerID] += product.Price*item.Quantity - item.Discount
+	}
+	return res
+}
+
+func calcRateAmount(amount int64, rate float64) int64 {
+	if amount <= 0 || rate <= 0 {
+		return 0
+	}
+	return decimal.NewFromInt(amount).Mul(decimal.NewFromFloat(rate)).Div(decimal.NewFromInt(100)).IntPart()
+}
+
+func prorate(amount, part, total int64) int64 {
+	if amount == 0 || total <= 0 {
+		return 0
+	}
+	return decimal.NewFromInt(amount).Mul(decimal.NewFromInt(part)).Div(decimal.NewFromInt(total)).IntPart()
+}
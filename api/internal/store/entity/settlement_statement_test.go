@@ -0,0 +1,340 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettlementStatements(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	products := map[int64]*Product{
+		1: {ID: "product-id01", ProducerID: "producer-id01", ProductRevision: ProductRevision{ID: 1, Price: 1000}},
+		2: {ID: "product-id02", ProducerID: "producer-id02", ProductRevision: ProductRevision{ID: 2, Price: 1500}},
+	}
+	systems := PaymentSystems{
+		{MethodType: PaymentMethodTypeCreditCard, FeeRate: 3.6},
+		{MethodType: PaymentMethodTypeKonbini, FeeRate: 2},
+	}
+	tests := []struct {
+		name   string
+		params *NewSettlementStatementsParams
+		expect SettlementStatements
+	}{
+		{
+			name: "success",
+			params: &NewSettlementStatementsParams{
+				PeriodStartAt:  startAt,
+				PeriodEndAt:    now,
+				CommissionRate: 10,
+				Orders: Orders{
+					{
+						ID:            "order-id01",
+						ShopID:        "shop-id",
+						CoordinatorID: "coordinator-id",
+						OrderPayment: OrderPayment{
+							MethodType:  PaymentMethodTypeCreditCard,
+							Subtotal:    2500,
+							ShippingFee: 500,
+							Total:       3000,
+							CapturedAt:  now,
+						},
+						OrderItems: OrderItems{
+							{ProductRevisionID: 1, Quantity: 1},
+							{ProductRevisionID: 2, Quantity: 1},
+						},
+					},
+					{
+						ID:            "order-id02",
+						ShopID:        "shop-id",
+						CoordinatorID: "coordinator-id",
+						OrderPayment: OrderPayment{
+							MethodType:  PaymentMethodTypeKonbini,
+							Subtotal:    1500,
+							Total:       1500,
+							RefundTotal: 1500,
+							CapturedAt:  now,
+						},
+						OrderItems: OrderItems{
+							{ProductRevisionID: 2, Quantity: 1},
+						},
+					},
+					{
+						ID:            "order-id03",
+						ShopID:        "shop-id",
+						CoordinatorID: "coordinator-id",
+						OrderPayment: OrderPayment{
+							MethodType: PaymentMethodTypeCreditCard,
+							Subtotal:   1000,
+							Total:      1000,
+						},
+						OrderItems: OrderItems{
+							{ProductRevisionID: 1, Quantity: 1},
+						},
+					},
+				},
+				Products:       products,
+				PaymentSystems: systems,
+			},
+			expect: SettlementStatements{
+				{
+					PayeeType:      SettlementPayeeTypeShop,
+					PayeeID:        "shop-id",
+					Status:         SettlementStatementStatusUnpaid,
+					PeriodStartAt:  startAt,
+					PeriodEndAt:    now,
+					OrderCount:     2,
+					SalesTotal:     500,
+					RefundTotal:    0,
+					CommissionRate: 10,
+					CommissionFee:  50,
+					PaymentFee:     18,
+					PayoutTotal:    432,
+				},
+				{
+					PayeeType:      SettlementPayeeTypeProducer,
+					PayeeID:        "producer-id01",
+					Status:         SettlementStatementStatusUnpaid,
+					PeriodStartAt:  startAt,
+					PeriodEndAt:    now,
+					OrderCount:     1,
+					SalesTotal:     1000,
+					RefundTotal:    0,
+					CommissionRate: 10,
+					CommissionFee:  100,
+					PaymentFee:     36,
+					PayoutTotal:    864,
+				},
+				{
+					PayeeType:      SettlementPayeeTypeProducer,
+					PayeeID:        "producer-id02",
+					Status:         SettlementStatementStatusUnpaid,
+					PeriodStartAt:  startAt,
+					PeriodEndAt:    now,
+					OrderCount:     2,
+					SalesTotal:     3000,
+					RefundTotal:    1500,
+					CommissionRate: 10,
+					CommissionFee:  150,
+					PaymentFee:     84,
+					PayoutTotal:    1266,
+				},
+			},
+		},
+		{
+			name: "adjustment for refund after settlement",
+			params: &NewSettlementStatementsParams{
+				PeriodStartAt:  startAt,
+				PeriodEndAt:    now,
+				CommissionRate: 10,
+				Orders: Orders{
+					{
+						ID:            "order-id01",
+						ShopID:        "shop-id",
+						CoordinatorID: "coordinator-id",
+						OrderPayment: OrderPayment{
+							MethodType:    PaymentMethodTypeCreditCard,
+							Subtotal:      2500,
+							ShippingFee:   500,
+							Total:         3000,
+							RefundTotal:   1500,
+							CapturedAt:    startAt.AddDate(0, -1, 0),
+							SettledAt:     startAt,
+							SettledRefund: 0,
+						},
+						OrderItems: OrderItems{
+							{ProductRevisionID: 1, Quantity: 1},
+							{ProductRevisionID: 2, Quantity: 1},
+						},
+					},
+				},
+				Products:       products,
+				PaymentSystems: systems,
+			},
+			expect: SettlementStatements{
+				{
+					PayeeType:      SettlementPayeeTypeShop,
+					PayeeID:        "shop-id",
+					Status:         SettlementStatementStatusUnpaid,
+					PeriodStartAt:  startAt,
+					PeriodEndAt:    now,
+					OrderCount:     0,
+					SalesTotal:     0,
+					RefundTotal:    250,
+					CommissionRate: 10,
+					CommissionFee:  -25,
+					PaymentFee:     0,
+					PayoutTotal:    -225,
+				},
+				{
+					PayeeType:      SettlementPayeeTypeProducer,
+					PayeeID:        "producer-id01",
+					Status:         SettlementStatementStatusUnpaid,
+					PeriodStartAt:  startAt,
+					PeriodEndAt:    now,
+					OrderCount:     0,
+					RefundTotal:    500,
+					CommissionRate: 10,
+					CommissionFee:  -50,
+					PayoutTotal:    -450,
+				},
+				{
+					PayeeType:      SettlementPayeeTypeProducer,
+					PayeeID:        "producer-id02",
+					Status:         SettlementStatementStatusUnpaid,
+					PeriodStartAt:  startAt,
+					PeriodEndAt:    now,
+					OrderCount:     0,
+					RefundTotal:    750,
+					CommissionRate: 10,
+					CommissionFee:  -75,
+					PayoutTotal:    -675,
+				},
+			},
+		},
+		{
+			name: "empty",
+			params: &NewSettlementStatementsParams{
+				PeriodStartAt:  startAt,
+				PeriodEndAt:    now,
+				CommissionRate: 10,
+				Orders:         Orders{},
+			},
+			expect: SettlementStatements{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewSettlementStatements(tt.params)
+			for _, s := range actual {
+				assert.NotEmpty(t, s.ID)
+				s.ID = "" // ignore
+			}
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestSettlementStatements_SplitOrderTotal(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	products := map[int64]*Product{
+		1: {ID: "product-id01", ProducerID: "producer-id01", ProductRevision: ProductRevision{ID: 1, Price: 1200}},
+		2: {ID: "product-id02", ProducerID: "producer-id02", ProductRevision: ProductRevision{ID: 2, Price: 800}},
+	}
+	systems := PaymentSystems{
+		{MethodType: PaymentMethodTypeCreditCard, FeeRate: 3.6},
+	}
+	tests := []struct {
+		name  string
+		order *Order
+	}{
+		{
+			name: "shop",
+			order: &Order{
+				ID:            "order-id",
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				OrderPayment: OrderPayment{
+					MethodType:  PaymentMethodTypeCreditCard,
+					Subtotal:    3200,
+					Discount:    300,
+					ShippingFee: 800,
+					Total:       3700,
+					RefundTotal: 1000,
+					CapturedAt:  now,
+				},
+				OrderItems: OrderItems{
+					{ProductRevisionID: 1, Quantity: 2, Discount: 200},
+					{ProductRevisionID: 2, Quantity: 1, Discount: 100},
+				},
+			},
+		},
+		{
+			name: "coordinator",
+			order: &Order{
+				ID:            "order-id",
+				CoordinatorID: "coordinator-id",
+				OrderPayment: OrderPayment{
+					MethodType:  PaymentMethodTypeCreditCard,
+					Subtotal:    2000,
+					ShippingFee: 1000,
+					Total:       3000,
+					CapturedAt:  now,
+				},
+				OrderItems: OrderItems{
+					{ProductRevisionID: 1, Quantity: 1},
+					{ProductRevisionID: 2, Quantity: 1},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			params := &NewSettlementStatementsParams{
+				PeriodStartAt:  now.AddDate(0, -1, 0),
+				PeriodEndAt:    now,
+				CommissionRate: 10,
+				Orders:         Orders{tt.order},
+				Products:       products,
+				PaymentSystems: systems,
+			}
+			actual := NewSettlementStatements(params)
+			var sales, refund, payout int64
+			for _, s := range actual {
+				assert.GreaterOrEqual(t, s.PayoutTotal, int64(0), s.PayeeID)
+				sales += s.SalesTotal
+				refund += s.RefundTotal
+				payout += s.PayoutTotal + s.CommissionFee + s.PaymentFee
+			}
+			assert.Equal(t, tt.order.Total, sales)
+			assert.Equal(t, tt.order.RefundTotal, refund)
+			assert.Equal(t, tt.order.Total-tt.order.RefundTotal, payout)
+		})
+	}
+}
+
+func TestSettlementStatement_Pay(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	tests := []struct {
+		name      string
+		statement *SettlementStatement
+		expect    *SettlementStatement
+	}{
+		{
+			name:      "unpaid",
+			statement: &SettlementStatement{Status: SettlementStatementStatusUnpaid},
+			expect:    &SettlementStatement{Status: SettlementStatementStatusPaid, PaidAt: now},
+		},
+		{
+			name:      "already paid",
+			statement: &SettlementStatement{Status: SettlementStatementStatusPaid, PaidAt: now.AddDate(0, 0, -1)},
+			expect:    &SettlementStatement{Status: SettlementStatementStatusPaid, PaidAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.statement.Pay(now)
+			assert.Equal(t, tt.expect, tt.statement)
+		})
+	}
+}
+
+func TestSettlementStatements_PayeeIDs(t *testing.T) {
+	t.Parallel()
+	statements := SettlementStatements{
+		{PayeeType: SettlementPayeeTypeShop, PayeeID: "shop-id"},
+		{PayeeType: SettlementPayeeTypeProducer, PayeeID: "producer-id01"},
+		{PayeeType: SettlementPayeeTypeProducer, PayeeID: "producer-id02"},
+	}
+	assert.Equal(t, []string{"shop-id"}, statements.PayeeIDs(SettlementPayeeTypeShop))
+	assert.Equal(t, []string{"producer-id01", "producer-id02"}, statements.PayeeIDs(SettlementPayeeTypeProducer))
+	assert.Equal(t, []string{}, statements.PayeeIDs(SettlementPayeeTypeCoordinator))
+}
@@ -53,6 +53,13 @@ type NewShippingParams struct {
 	Box80Frozen       int64
 	Box100Rates       ShippingRates
 	Box100Frozen      int64
+	Box120Rates       ShippingRates
+	Box120Frozen      int64
+	Box140Rates       ShippingRates
+	Box140Frozen      int64
+	Box160Rates       ShippingRates
+	Box160Frozen      int64
+	RegionRules       ShippingRegionRules
 	HasFreeShipping   bool
 	FreeShippingRates int64
 	InUse             bool
@@ -79,6 +86,13 @@ func NewShipping(params *NewShippingParams) *Shipping {
 		Box80Frozen:       params.Box80Frozen,
 		Box100Rates:       params.Box100Rates,
 		Box100Frozen:      params.Box100Frozen,
+		Box120Rates:       params.Box120Rates,
+		Box120Frozen:      params.Box120Frozen,
+		Box140Rates:       params.Box140Rates,
+		Box140Frozen:      params.Box140Frozen,
+		Box160Rates:       params.Box160Rates,
+		Box160Frozen:      params.Box160Frozen,
+		RegionRules:       params.RegionRules,
 		HasFreeShipping:   params.HasFreeShipping,
 		FreeShippingRates: params.FreeShippingRates,
 	}
@@ -98,10 +112,17 @@ func (s *Shipping) IsDefault() bool {
 }
 
 func (s *Shipping) CalcShippingFee(
-	shippingSize ShippingSize, shippingType ShippingType, total int64, prefectureCode int32,
+	shippingSize ShippingSize, shippingType ShippingType, total int64, prefectureCode int32, postalCode string,
 ) (int64, error) {
+	var surcharge int64
+	if rule := s.RegionRules.Find(postalCode); rule != nil {
+		if !rule.Deliverable(shippingType) {
+			return 0, ErrUndeliverableShippingType
+		}
+		surcharge = rule.Surcharge
+	}
 	if s.HasFreeShipping && total >= s.FreeShippingRates {
-		return 0, nil // 送料設定による無料配送
+		return surcharge, nil // 送料設定による無料配送（地域別の追加配送料は対象外）
 	}
 	var (
 		rate       *ShippingRate
@@ -124,13 +145,28 @@ func (s *Shipping) CalcShippingFee(
 			additional = s.Box100Frozen
 		}
 		rate, err = s.Box100Rates.Find(prefectureCode)
+	case ShippingSize120:
+		if shippingType == ShippingTypeFrozen {
+			additional = s.Box120Frozen
+		}
+		rate, err = s.Box120Rates.Find(prefectureCode)
+	case ShippingSize140:
+		if shippingType == ShippingTypeFrozen {
+			additional = s.Box140Frozen
+		}
+		rate, err = s.Box140Rates.Find(prefectureCode)
+	case ShippingSize160:
+		if shippingType == ShippingTypeFrozen {
+			additional = s.Box160Frozen
+		}
+		rate, err = s.Box160Rates.Find(prefectureCode)
 	default:
 		return 0, ErrUnknownShippingSize
 	}
 	if err != nil {
 		return 0, err
 	}
-	return rate.Price + additional, nil
+	return rate.Price + additional + surcharge, nil
 }
 
 func (s *Shipping) Fill(revision *ShippingRevision) {
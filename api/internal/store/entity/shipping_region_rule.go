@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/and-period/furumaru/api/pkg/postalcode"
+	"github.com/and-period/furumaru/api/pkg/set"
+)
+
+var (
+	ErrUndeliverableShippingType   = errors.New("entity: undeliverable shipping type for destination")
+	errInvalidShippingRegionRule   = errors.New("entity: invalid shipping region rule format")
+	errNotUniqueShippingRegionRule = errors.New("entity: shipping region rule number must be unique")
+)
+
+// ShippingRegionRule - 地域別配送設定（離島など郵便番号の範囲ごとの追加配送料・配送不可設定）
+type ShippingRegionRule struct {
+	Number         int64          `json:"number"`         // No.
+	Name           string         `json:"name"`           // 地域名
+	PostalCodeFrom string         `json:"postalCodeFrom"` // 対象郵便番号(開始)
+	PostalCodeTo   string         `json:"postalCodeTo"`   // 対象郵便番号(終了)
+	Surcharge      int64          `json:"surcharge"`      // 追加配送料(税込)
+	ExcludedTypes  []ShippingType `json:"excludedTypes"`  // 配送不可の配送種別一覧
+}
+
+type ShippingRegionRules []*ShippingRegionRule
+
+type NewShippingRegionRuleParams struct {
+	Number         int64
+	Name           string
+	PostalCodeFrom string
+	PostalCodeTo   string
+	Surcharge      int64
+	ExcludedTypes  []ShippingType
+}
+
+func NewShippingRegionRule(params *NewShippingRegionRuleParams) (*ShippingRegionRule, error) {
+	from, err := postalcode.Normalize(params.PostalCodeFrom)
+	if err != nil {
+		return nil, err
+	}
+	to := from // 終了が未指定の場合は単一の郵便番号として扱う
+	if params.PostalCodeTo != "" {
+		to, err = postalcode.Normalize(params.PostalCodeTo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ShippingRegionRule{
+		Number:         params.Number,
+		Name:           params.Name,
+		PostalCodeFrom: from,
+		PostalCodeTo:   to,
+		Surcharge:      params.Surcharge,
+		ExcludedTypes:  params.ExcludedTypes,
+	}, nil
+}
+
+// Contains - 郵便番号が対象範囲に含まれるか
+func (r *ShippingRegionRule) Contains(postalCode string) bool {
+	code, err := postalcode.Normalize(postalCode)
+	if err != nil {
+		return false
+	}
+	return r.PostalCodeFrom <= code && code <= r.PostalCodeTo
+}
+
+// Deliverable - 配送種別が配送可能か
+func (r *ShippingRegionRule) Deliverable(shippingType ShippingType) bool {
+	for _, typ := range r.ExcludedTypes {
+		if typ == shippingType {
+			return false
+		}
+	}
+	return true
+}
+
+// Find - 郵便番号に該当する地域別配送設定を取得（該当しない場合はnil）
+func (rs ShippingRegionRules) Find(postalCode string) *ShippingRegionRule {
+	if postalCode == "" {
+		return nil
+	}
+	for _, r := range rs {
+		if r.Contains(postalCode) {
+			return r
+		}
+	}
+	return nil
+}
+
+func (rs ShippingRegionRules) Validate() error {
+	set := set.NewEmpty[int64](len(rs))
+	for _, r := range rs {
+		if r.Number < 1 || r.Surcharge < 0 { // No.・追加配送料の形式チェック
+			return errInvalidShippingRegionRule
+		}
+		if r.PostalCodeFrom > r.PostalCodeTo { // 郵便番号の範囲チェック
+			return errInvalidShippingRegionRule
+		}
+		for _, typ := range r.ExcludedTypes {
+			if typ != ShippingTypeNormal && typ != ShippingTypeFrozen {
+				return errInvalidShippingRegionRule
+			}
+		}
+		if _, exists := set.FindOrAdd(r.Number); exists { // No.の重複チェック
+			return errNotUniqueShippingRegionRule
+		}
+	}
+	return nil
+}
+
+func (rs ShippingRegionRules) Marshal() ([]byte, error) {
+	if len(rs) == 0 {
+		return []byte{}, nil
+	}
+	return json.Marshal(rs)
+}
@@ -0,0 +1,230 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShippingRegionRule(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		params    *NewShippingRegionRuleParams
+		expect    *ShippingRegionRule
+		expectErr bool
+	}{
+		{
+			name: "success",
+			params: &NewShippingRegionRuleParams{
+				Number:         1,
+				Name:           "伊豆諸島",
+				PostalCodeFrom: "100-0100",
+				PostalCodeTo:   "100-1699",
+				Surcharge:      1200,
+				ExcludedTypes:  []ShippingType{ShippingTypeFrozen},
+			},
+			expect: &ShippingRegionRule{
+				Number:         1,
+				Name:           "伊豆諸島",
+				PostalCodeFrom: "1000100",
+				PostalCodeTo:   "1001699",
+				Surcharge:      1200,
+				ExcludedTypes:  []ShippingType{ShippingTypeFrozen},
+			},
+			expectErr: false,
+		},
+		{
+			name: "success single postal code",
+			params: &NewShippingRegionRuleParams{
+				Number:         1,
+				Name:           "沖の島",
+				PostalCodeFrom: "7880111",
+				Surcharge:      1000,
+			},
+			expect: &ShippingRegionRule{
+				Number:         1,
+				Name:           "沖の島",
+				PostalCodeFrom: "7880111",
+				PostalCodeTo:   "7880111",
+				Surcharge:      1000,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid postal code from",
+			params: &NewShippingRegionRuleParams{
+				Number:         1,
+				Name:           "伊豆諸島",
+				PostalCodeFrom: "100",
+				PostalCodeTo:   "100-1699",
+			},
+			expect:    nil,
+			expectErr: true,
+		},
+		{
+			name: "invalid postal code to",
+			params: &NewShippingRegionRuleParams{
+				Number:         1,
+				Name:           "伊豆諸島",
+				PostalCodeFrom: "100-0100",
+				PostalCodeTo:   "100",
+			},
+			expect:    nil,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewShippingRegionRule(tt.params)
+			assert.Equal(t, tt.expectErr, err != nil)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestShippingRegionRule_Deliverable(t *testing.T) {
+	t.Parallel()
+	rule := &ShippingRegionRule{
+		Number:         1,
+		Name:           "伊豆諸島",
+		PostalCodeFrom: "1000100",
+		PostalCodeTo:   "1001699",
+		ExcludedTypes:  []ShippingType{ShippingTypeFrozen},
+	}
+	assert.True(t, rule.Deliverable(ShippingTypeNormal))
+	assert.False(t, rule.Deliverable(ShippingTypeFrozen))
+}
+
+func TestShippingRegionRules_Find(t *testing.T) {
+	t.Parallel()
+	rules := ShippingRegionRules{
+		{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200},
+		{Number: 2, Name: "奄美群島", PostalCodeFrom: "8916100", PostalCodeTo: "8919299", Surcharge: 1500},
+		{Number: 3, Name: "奄美大島", PostalCodeFrom: "8940000", PostalCodeTo: "8949999", Surcharge: 1000},
+	}
+	tests := []struct {
+		name       string
+		rules      ShippingRegionRules
+		postalCode string
+		expect     *ShippingRegionRule
+	}{
+		{
+			name:       "found",
+			rules:      rules,
+			postalCode: "100-1101",
+			expect:     rules[0],
+		},
+		{
+			name:       "found lower bound",
+			rules:      rules,
+			postalCode: "8940000",
+			expect:     rules[2],
+		},
+		{
+			name:       "not found",
+			rules:      rules,
+			postalCode: "1000014",
+			expect:     nil,
+		},
+		{
+			name:       "invalid postal code",
+			rules:      rules,
+			postalCode: "abc",
+			expect:     nil,
+		},
+		{
+			name:       "empty postal code",
+			rules:      rules,
+			postalCode: "",
+			expect:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.rules.Find(tt.postalCode))
+		})
+	}
+}
+
+func TestShippingRegionRules_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		rules     ShippingRegionRules
+		expectErr error
+	}{
+		{
+			name: "success",
+			rules: ShippingRegionRules{
+				{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200},
+				{Number: 2, Name: "小笠原諸島", PostalCodeFrom: "1002100", PostalCodeTo: "1002299", ExcludedTypes: []ShippingType{ShippingTypeFrozen}},
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "success empty",
+			rules:     ShippingRegionRules{},
+			expectErr: nil,
+		},
+		{
+			name: "invalid number",
+			rules: ShippingRegionRules{
+				{Number: 0, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699"},
+			},
+			expectErr: errInvalidShippingRegionRule,
+		},
+		{
+			name: "invalid surcharge",
+			rules: ShippingRegionRules{
+				{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: -1},
+			},
+			expectErr: errInvalidShippingRegionRule,
+		},
+		{
+			name: "invalid postal code range",
+			rules: ShippingRegionRules{
+				{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1001699", PostalCodeTo: "1000100"},
+			},
+			expectErr: errInvalidShippingRegionRule,
+		},
+		{
+			name: "invalid excluded type",
+			rules: ShippingRegionRules{
+				{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", ExcludedTypes: []ShippingType{ShippingTypePickup}},
+			},
+			expectErr: errInvalidShippingRegionRule,
+		},
+		{
+			name: "duplicate number",
+			rules: ShippingRegionRules{
+				{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699"},
+				{Number: 1, Name: "小笠原諸島", PostalCodeFrom: "1002100", PostalCodeTo: "1002299"},
+			},
+			expectErr: errNotUniqueShippingRegionRule,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, tt.rules.Validate(), tt.expectErr)
+		})
+	}
+}
+
+func TestShippingRegionRules_Marshal(t *testing.T) {
+	t.Parallel()
+	rules := ShippingRegionRules{
+		{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200, ExcludedTypes: []ShippingType{ShippingTypeFrozen}},
+	}
+	actual, err := rules.Marshal()
+	assert.NoError(t, err)
+	expect := `[{"number":1,"name":"伊豆諸島","postalCodeFrom":"1000100","postalCodeTo":"1001699","surcharge":1200,"excludedTypes":[2]}]`
+	assert.JSONEq(t, expect, string(actual))
+
+	actual, err = ShippingRegionRules{}.Marshal()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{}, actual)
+}
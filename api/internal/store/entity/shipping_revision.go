@@ -14,18 +14,25 @@ var ErrNotFoundShippingRate = errors.New("entity: not found shipping rate")
 
 // ShippingRevision - 配送設定変更履歴情報
 type ShippingRevision struct {
-	ID                int64         `gorm:"primarykey;<-:create"` // 変更履歴ID
-	ShippingID        string        `gorm:""`                     // 配送設定ID
-	Box60Rates        ShippingRates `gorm:"-"`                    // 箱サイズ60の通常便配送料一覧
-	Box60Frozen       int64         `gorm:""`                     // 箱サイズ60の冷凍便追加配送料(税込)
-	Box80Rates        ShippingRates `gorm:"-"`                    // 箱サイズ80の通常便配送料一覧
-	Box80Frozen       int64         `gorm:""`                     // 箱サイズ80の冷凍便追加配送料(税込)
-	Box100Rates       ShippingRates `gorm:"-"`                    // 箱サイズ100の通常便配送料一覧
-	Box100Frozen      int64         `gorm:""`                     // 箱サイズ100の冷凍便追加配送料(税込)
-	HasFreeShipping   bool          `gorm:""`                     // 送料無料オプションの有無
-	FreeShippingRates int64         `gorm:""`                     // 送料無料になる金額(税込)
-	CreatedAt         time.Time     `gorm:"<-:create"`            // 登録日時
-	UpdatedAt         time.Time     `gorm:""`                     // 更新日時
+	ID                int64               `gorm:"primarykey;<-:create"` // 変更履歴ID
+	ShippingID        string              `gorm:""`                     // 配送設定ID
+	Box60Rates        ShippingRates       `gorm:"-"`                    // 箱サイズ60の通常便配送料一覧
+	Box60Frozen       int64               `gorm:""`                     // 箱サイズ60の冷凍便追加配送料(税込)
+	Box80Rates        ShippingRates       `gorm:"-"`                    // 箱サイズ80の通常便配送料一覧
+	Box80Frozen       int64               `gorm:""`                     // 箱サイズ80の冷凍便追加配送料(税込)
+	Box100Rates       ShippingRates       `gorm:"-"`                    // 箱サイズ100の通常便配送料一覧
+	Box100Frozen      int64               `gorm:""`                     // 箱サイズ100の冷凍便追加配送料(税込)
+	Box120Rates       ShippingRates       `gorm:"-"`                    // 箱サイズ120の通常便配送料一覧
+	Box120Frozen      int64               `gorm:""`                     // 箱サイズ120の冷凍便追加配送料(税込)
+	Box140Rates       ShippingRates       `gorm:"-"`                    // 箱サイズ140の通常便配送料一覧
+	Box140Frozen      int64               `gorm:""`                     // 箱サイズ140の冷凍便追加配送料(税込)
+	Box160Rates       ShippingRates       `gorm:"-"`                    // 箱サイズ160の通常便配送料一覧
+	Box160Frozen      int64               `gorm:""`                     // 箱サイズ160の冷凍便追加配送料(税込)
+	RegionRules       ShippingRegionRules `gorm:"-"`                    // 地域別配送設定一覧
+	HasFreeShipping   bool                `gorm:""`                     // 送料無料オプションの有無
+	FreeShippingRates int64               `gorm:""`                     // 送料無料になる金額(税込)
+	CreatedAt         time.Time           `gorm:"<-:create"`            // 登録日時
+	UpdatedAt         time.Time           `gorm:""`                     // 更新日時
 }
 
 type ShippingRevisions []*ShippingRevision
@@ -49,6 +56,13 @@ type NewShippingRevisionParams struct {
 	Box80Frozen       int64
 	Box100Rates       ShippingRates
 	Box100Frozen      int64
+	Box120Rates       ShippingRates
+	Box120Frozen      int64
+	Box140Rates       ShippingRates
+	Box140Frozen      int64
+	Box160Rates       ShippingRates
+	Box160Frozen      int64
+	RegionRules       ShippingRegionRules
 	HasFreeShipping   bool
 	FreeShippingRates int64
 }
@@ -62,6 +76,13 @@ func NewShippingRevision(params *NewShippingRevisionParams) *ShippingRevision {
 		Box80Frozen:       params.Box80Frozen,
 		Box100Rates:       params.Box100Rates,
 		Box100Frozen:      params.Box100Frozen,
+		Box120Rates:       params.Box120Rates,
+		Box120Frozen:      params.Box120Frozen,
+		Box140Rates:       params.Box140Rates,
+		Box140Frozen:      params.Box140Frozen,
+		Box160Rates:       params.Box160Rates,
+		Box160Frozen:      params.Box160Frozen,
+		RegionRules:       params.RegionRules,
 		HasFreeShipping:   params.HasFreeShipping,
 		FreeShippingRates: params.FreeShippingRates,
 	}
@@ -71,6 +92,9 @@ func (r *ShippingRevision) Fill() {
 	r.Box60Rates.Fill()
 	r.Box80Rates.Fill()
 	r.Box100Rates.Fill()
+	r.Box120Rates.Fill()
+	r.Box140Rates.Fill()
+	r.Box160Rates.Fill()
 }
 
 func (rs ShippingRevisions) ShippingIDs() []string {
@@ -154,6 +154,20 @@ func TestShipping_CalcShippingFee(t *testing.T) {
 		{Number: 1, Name: "四国(東部)", Price: 250, PrefectureCodes: pref1},
 		{Number: 2, Name: "四国(西部)", Price: 500, PrefectureCodes: pref2},
 	}
+	largeRates := ShippingRates{
+		{Number: 1, Name: "四国(東部)", Price: 1250, PrefectureCodes: pref1},
+		{Number: 2, Name: "四国(西部)", Price: 1500, PrefectureCodes: pref2},
+	}
+	regionRules := ShippingRegionRules{
+		{
+			Number:         1,
+			Name:           "沖の島",
+			PostalCodeFrom: "7880111",
+			PostalCodeTo:   "7880112",
+			Surcharge:      1000,
+			ExcludedTypes:  []ShippingType{ShippingTypeFrozen},
+		},
+	}
 	tests := []struct {
 		name           string
 		shipping       *Shipping
@@ -161,6 +175,7 @@ func TestShipping_CalcShippingFee(t *testing.T) {
 		shippingType   ShippingType
 		total          int64
 		prefectureCode int32
+		postalCode     string
 		expect         int64
 		expectErr      error
 	}{
@@ -332,6 +347,201 @@ func TestShipping_CalcShippingFee(t *testing.T) {
 			expect:         1300,
 			expectErr:      nil,
 		},
+		{
+			name: "success normal box 120",
+			shipping: &Shipping{
+				ID:        "shipping-id",
+				CreatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				UpdatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				ShippingRevision: ShippingRevision{
+					Box60Rates:        rates,
+					Box60Frozen:       800,
+					Box80Rates:        rates,
+					Box80Frozen:       800,
+					Box100Rates:       rates,
+					Box100Frozen:      800,
+					Box120Rates:       largeRates,
+					Box120Frozen:      1000,
+					Box140Rates:       largeRates,
+					Box140Frozen:      1000,
+					Box160Rates:       largeRates,
+					Box160Frozen:      1000,
+					HasFreeShipping:   true,
+					FreeShippingRates: 3000,
+				},
+			},
+			shippingSize:   ShippingSize120,
+			shippingType:   ShippingTypeNormal,
+			total:          2980,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			expect:         1500,
+			expectErr:      nil,
+		},
+		{
+			name: "success frozen box 140",
+			shipping: &Shipping{
+				ID:        "shipping-id",
+				CreatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				UpdatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				ShippingRevision: ShippingRevision{
+					Box60Rates:        rates,
+					Box60Frozen:       800,
+					Box80Rates:        rates,
+					Box80Frozen:       800,
+					Box100Rates:       rates,
+					Box100Frozen:      800,
+					Box120Rates:       largeRates,
+					Box120Frozen:      1000,
+					Box140Rates:       largeRates,
+					Box140Frozen:      1000,
+					Box160Rates:       largeRates,
+					Box160Frozen:      1000,
+					HasFreeShipping:   true,
+					FreeShippingRates: 3000,
+				},
+			},
+			shippingSize:   ShippingSize140,
+			shippingType:   ShippingTypeFrozen,
+			total:          2980,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			expect:         2500,
+			expectErr:      nil,
+		},
+		{
+			name: "success normal box 160",
+			shipping: &Shipping{
+				ID:        "shipping-id",
+				CreatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				UpdatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				ShippingRevision: ShippingRevision{
+					Box60Rates:        rates,
+					Box60Frozen:       800,
+					Box80Rates:        rates,
+					Box80Frozen:       800,
+					Box100Rates:       rates,
+					Box100Frozen:      800,
+					Box120Rates:       largeRates,
+					Box120Frozen:      1000,
+					Box140Rates:       largeRates,
+					Box140Frozen:      1000,
+					Box160Rates:       largeRates,
+					Box160Frozen:      1000,
+					HasFreeShipping:   true,
+					FreeShippingRates: 3000,
+				},
+			},
+			shippingSize:   ShippingSize160,
+			shippingType:   ShippingTypeNormal,
+			total:          2980,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			expect:         1500,
+			expectErr:      nil,
+		},
+		{
+			name: "not found box 160 rates",
+			shipping: &Shipping{
+				ID: "shipping-id",
+				ShippingRevision: ShippingRevision{
+					Box60Rates:  rates,
+					Box80Rates:  rates,
+					Box100Rates: rates,
+				},
+			},
+			shippingSize:   ShippingSize160,
+			shippingType:   ShippingTypeNormal,
+			total:          2980,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			expect:         0,
+			expectErr:      ErrNotFoundShippingRate,
+		},
+		{
+			name: "success region surcharge",
+			shipping: &Shipping{
+				ID:        "shipping-id",
+				CreatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				UpdatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				ShippingRevision: ShippingRevision{
+					Box60Rates:        rates,
+					Box60Frozen:       800,
+					RegionRules:       regionRules,
+					HasFreeShipping:   true,
+					FreeShippingRates: 3000,
+				},
+			},
+			shippingSize:   ShippingSize60,
+			shippingType:   ShippingTypeNormal,
+			total:          2980,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			postalCode:     "788-0111",
+			expect:         1500,
+			expectErr:      nil,
+		},
+		{
+			name: "success region surcharge with free shipping",
+			shipping: &Shipping{
+				ID:        "shipping-id",
+				CreatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				UpdatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				ShippingRevision: ShippingRevision{
+					Box60Rates:        rates,
+					Box60Frozen:       800,
+					RegionRules:       regionRules,
+					HasFreeShipping:   true,
+					FreeShippingRates: 3000,
+				},
+			},
+			shippingSize:   ShippingSize60,
+			shippingType:   ShippingTypeNormal,
+			total:          3000,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			postalCode:     "7880112",
+			expect:         1000,
+			expectErr:      nil,
+		},
+		{
+			name: "success out of region",
+			shipping: &Shipping{
+				ID:        "shipping-id",
+				CreatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				UpdatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				ShippingRevision: ShippingRevision{
+					Box60Rates:        rates,
+					Box60Frozen:       800,
+					RegionRules:       regionRules,
+					HasFreeShipping:   true,
+					FreeShippingRates: 3000,
+				},
+			},
+			shippingSize:   ShippingSize60,
+			shippingType:   ShippingTypeFrozen,
+			total:          2980,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			postalCode:     "7800870",
+			expect:         1300,
+			expectErr:      nil,
+		},
+		{
+			name: "undeliverable shipping type",
+			shipping: &Shipping{
+				ID:        "shipping-id",
+				CreatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				UpdatedAt: jst.Date(2022, 7, 3, 18, 30, 0, 0),
+				ShippingRevision: ShippingRevision{
+					Box60Rates:        rates,
+					Box60Frozen:       800,
+					RegionRules:       regionRules,
+					HasFreeShipping:   true,
+					FreeShippingRates: 3000,
+				},
+			},
+			shippingSize:   ShippingSize60,
+			shippingType:   ShippingTypeFrozen,
+			total:          3000,
+			prefectureCode: codes.PrefectureValues["kochi"],
+			postalCode:     "7880111",
+			expect:         0,
+			expectErr:      ErrUndeliverableShippingType,
+		},
 		{
 			name: "unknown shipping size",
 			shipping: &Shipping{
@@ -360,7 +570,7 @@ func TestShipping_CalcShippingFee(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			actual, err := tt.shipping.CalcShippingFee(tt.shippingSize, tt.shippingType, tt.total, tt.prefectureCode)
+			actual, err := tt.shipping.CalcShippingFee(tt.shippingSize, tt.shippingType, tt.total, tt.prefectureCode, tt.postalCode)
 			assert.ErrorIs(t, err, tt.expectErr)
 			assert.Equal(t, tt.expect, actual)
 		})
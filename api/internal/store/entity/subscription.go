@@ -0,0 +1,289 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/set"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+)
+
+const (
+	SubscriptionMaxRenewalFailures = 3                    // 定期便の停止までに許容する更新失敗回数
+	subscriptionRetryInterval      = 24 * time.Hour       // 定期便の更新失敗時の再試行間隔
+	subscriptionMaxSkipAhead       = 180 * 24 * time.Hour // 定期便のスキップ可能な期間
+)
+
+var (
+	ErrInvalidSubscription     = errors.New("entity: invalid subscription")
+	ErrSubscriptionUnavailable = errors.New("entity: subscription is unavailable")
+)
+
+// SubscriptionStatus - 定期便の契約状況
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatusUnknown   SubscriptionStatus = 0
+	SubscriptionStatusActive    SubscriptionStatus = 1 // 契約中
+	SubscriptionStatusPaused    SubscriptionStatus = 2 // 一時停止中
+	SubscriptionStatusCanceled  SubscriptionStatus = 3 // 解約済み
+	SubscriptionStatusSuspended SubscriptionStatus = 4 // 停止中(更新失敗)
+)
+
+// SubscriptionInterval - 定期便のお届け間隔
+type SubscriptionInterval int32
+
+const (
+	SubscriptionIntervalUnknown  SubscriptionInterval = 0
+	SubscriptionIntervalWeekly   SubscriptionInterval = 1 // 毎週
+	SubscriptionIntervalBiweekly SubscriptionInterval = 2 // 隔週
+	SubscriptionIntervalMonthly  SubscriptionInterval = 3 // 毎月
+)
+
+// Subscription - 定期便
+type Subscription struct {
+	ID                string               `gorm:"primaryKey;<-:create"` // 定期便ID
+	UserID            string               `gorm:"<-:create"`            // ユーザーID
+	ShopID            string               `gorm:"default:null"`         // 店舗ID
+	CoordinatorID     string               `gorm:"<-:create"`            // コーディネータID
+	Status            SubscriptionStatus   `gorm:""`                     // 契約状況
+	Interval          SubscriptionInterval `gorm:"<-:create"`            // お届け間隔
+	AnchorDay         int64                `gorm:"<-:create"`            // 毎月のお届け基準日(1〜31)
+	Items             SubscriptionItems    `gorm:"-"`                    // 定期便の商品一覧
+	AddressRevisionID int64                `gorm:"<-:create"`            // 配送先・請求先住所履歴ID
+	ProviderType      PaymentProviderType  `gorm:"<-:create"`            // 決済プロバイダー種別
+	CustomerID        string               `gorm:"<-:create"`            // 決済プロバイダーの顧客ID
+	PaymentMethodID   string               `gorm:"<-:create"`            // 決済プロバイダーに保存した決済手段ID
+	NextRunAt         time.Time            `gorm:""`                     // 次回注文日時
+	LastOrderID       string               `gorm:"default:null"`         // 最終注文履歴ID
+	FailureCount      int64                `gorm:""`                     // 連続した更新失敗回数
+	LastFailedAt      time.Time            `gorm:"default:null"`         // 最終更新失敗日時
+	PausedAt          time.Time            `gorm:"default:null"`         // 一時停止日時
+	CanceledAt        time.Time            `gorm:"default:null"`         // 解約日時
+	CreatedAt         time.Time            `gorm:"<-:create"`            // 登録日時
+	UpdatedAt         time.Time            `gorm:""`                     // 更新日時
+}
+
+type Subscriptions []*Subscription
+
+// SubscriptionItem - 定期便の商品
+type SubscriptionItem struct {
+	ProductID string `json:"productId"` // 商品ID
+	Quantity  int64  `json:"quantity"`  // 数量
+}
+
+type SubscriptionItems []*SubscriptionItem
+
+type NewSubscriptionParams struct {
+	UserID            string
+	ShopID            string
+	Interval          SubscriptionInterval
+	Items             SubscriptionItems
+	Products          Products
+	AddressRevisionID int64
+	ProviderType      PaymentProviderType
+	CustomerID        string
+	PaymentMethodID   string
+	StartAt           time.Time
+}
+
+func NewSubscription(params *NewSubscriptionParams) (*Subscription, error) {
+	if !params.Interval.valid() {
+		return nil, fmt.Errorf("%w: unknown interval=%d", ErrInvalidSubscription, params.Interval)
+	}
+	if len(params.Items) == 0 {
+		return nil, fmt.Errorf("%w: items are required", ErrInvalidSubscription)
+	}
+	// 定期便はコーディネータ単位で配送するため、同一コーディネータの商品のみ登録可能とする
+	products := params.Products.Map()
+	var coordinatorID string
+	for _, item := range params.Items {
+		product, ok := products[item.ProductID]
+		if !ok {
+			return nil, fmt.Errorf("%w: product is not found: %s", ErrInvalidSubscription, item.ProductID)
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidSubscription)
+		}
+		if coordinatorID != "" && coordinatorID != product.CoordinatorID {
+			return nil, fmt.Errorf("%w: products must belong to the same coordinator", ErrInvalidSubscription)
+		}
+		coordinatorID = product.CoordinatorID
+	}
+	return &Subscription{
+		ID:                uuid.Base58Encode(uuid.New()),
+		UserID:            params.UserID,
+		ShopID:            params.ShopID,
+		CoordinatorID:     coordinatorID,
+		Status:            SubscriptionStatusActive,
+		Interval:          params.Interval,
+		AnchorDay:         int64(params.StartAt.Day()),
+		Items:             params.Items,
+		AddressRevisionID: params.AddressRevisionID,
+		ProviderType:      params.ProviderType,
+		CustomerID:        params.CustomerID,
+		PaymentMethodID:   params.PaymentMethodID,
+		NextRunAt:         params.StartAt,
+	}, nil
+}
+
+func (i SubscriptionInterval) valid() bool {
+	switch i {
+	case SubscriptionIntervalWeekly, SubscriptionIntervalBiweekly, SubscriptionIntervalMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Next - 基準日時の次回お届け日時
+// 毎月の場合は基準日(anchorDay)の翌月同日とし、月末を超える場合は月末日に丸める（例: 1/31 → 2/28 → 3/31）
+func (i SubscriptionInterval) Next(base time.Time, anchorDay int64) time.Time {
+	switch i {
+	case SubscriptionIntervalWeekly:
+		return base.Add(7 * 24 * time.Hour)
+	case SubscriptionIntervalBiweekly:
+		return base.Add(14 * 24 * time.Hour)
+	case SubscriptionIntervalMonthly:
+		day := int(anchorDay)
+		if day <= 0 {
+			day = base.Day()
+		}
+		year, month, _ := base.Date()
+		lastDay := time.Date(year, month+2, 0, 0, 0, 0, 0, base.Location()).Day()
+		return time.Date(year, month+1, min(day, lastDay), base.Hour(), base.Minute(), base.Second(), base.Nanosecond(), base.Location())
+	default:
+		return base
+	}
+}
+
+// next - 基準日時の次回お届け日時
+func (s *Subscription) next(base time.Time) time.Time {
+	return s.Interval.Next(base, s.AnchorDay)
+}
+
+// Renewable - 指定日時時点で注文を作成すべきか
+func (s *Subscription) Renewable(now time.Time) bool {
+	return s.Status == SubscriptionStatusActive && !s.NextRunAt.After(now)
+}
+
+// Skip - 次回のお届けを1回分スキップする
+func (s *Subscription) Skip(now time.Time) error {
+	if s.Status != SubscriptionStatusActive {
+		return fmt.Errorf("%w: status=%d", ErrSubscriptionUnavailable, s.Status)
+	}
+	next := s.next(s.NextRunAt)
+	if next.Sub(now) > subscriptionMaxSkipAhead {
+		return fmt.Errorf("%w: cannot skip any further", ErrSubscriptionUnavailable)
+	}
+	s.NextRunAt = next
+	return nil
+}
+
+// Pause - 定期便を一時停止する
+func (s *Subscription) Pause(now time.Time) error {
+	if s.Status != SubscriptionStatusActive {
+		return fmt.Errorf("%w: status=%d", ErrSubscriptionUnavailable, s.Status)
+	}
+	s.Status = SubscriptionStatusPaused
+	s.PausedAt = now
+	return nil
+}
+
+// Resume - 一時停止中・停止中の定期便を再開する
+func (s *Subscription) Resume(now time.Time) error {
+	if s.Status != SubscriptionStatusPaused && s.Status != SubscriptionStatusSuspended {
+		return fmt.Errorf("%w: status=%d", ErrSubscriptionUnavailable, s.Status)
+	}
+	// 停止中に過ぎたお届け日は遡って注文しない
+	base := jst.BeginningOfDay(now)
+	for s.NextRunAt.Before(base) {
+		s.NextRunAt = s.next(s.NextRunAt)
+	}
+	s.Status = SubscriptionStatusActive
+	s.FailureCount = 0
+	s.PausedAt = time.Time{}
+	return nil
+}
+
+// Cancel - 定期便を解約する
+func (s *Subscription) Cancel(now time.Time) error {
+	if s.Status == SubscriptionStatusCanceled {
+		return fmt.Errorf("%w: already canceled", ErrSubscriptionUnavailable)
+	}
+	s.Status = SubscriptionStatusCanceled
+	s.CanceledAt = now
+	return nil
+}
+
+// Renewed - 注文の作成に成功した
+func (s *Subscription) Renewed(orderID string, now time.Time) {
+	s.LastOrderID = orderID
+	s.FailureCount = 0
+	s.LastFailedAt = time.Time{}
+	s.NextRunAt = s.NextPeriod(now)
+}
+
+// NextPeriod - 指定日時より後となる次回注文日時
+func (s *Subscription) NextPeriod(now time.Time) time.Time {
+	next := s.NextRunAt
+	for !next.After(now) {
+		next = s.next(next)
+	}
+	return next
+}
+
+// RenewFailed - 注文の作成に失敗した（一定回数失敗した場合は停止する）
+func (s *Subscription) RenewFailed(now time.Time) {
+	s.FailureCount++
+	s.LastFailedAt = now
+	if s.FailureCount >= SubscriptionMaxRenewalFailures {
+		s.Status = SubscriptionStatusSuspended
+		return
+	}
+	s.NextRunAt = now.Add(subscriptionRetryInterval)
+}
+
+// Suspended - 更新失敗により停止されているか
+func (s *Subscription) Suspended() bool {
+	return s.Status == SubscriptionStatusSuspended
+}
+
+func (s *Subscription) ProductIDs() []string {
+	return set.UniqBy(s.Items, func(i *SubscriptionItem) string {
+		return i.ProductID
+	})
+}
+
+// Cart - 定期便の商品を詰めた買い物かごを生成する
+func (s *Subscription) Cart(products Products, now time.Time) (*Cart, error) {
+	cart := NewCart(&CartParams{SessionID: s.ID, Now: now})
+	for _, item := range s.Items {
+		cart.AddItem(item.ProductID, item.Quantity)
+	}
+	if err := cart.Baskets.VerifyQuantities(products.Map()); err != nil {
+		return nil, err
+	}
+	if err := cart.Refresh(products); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+func (ss Subscriptions) ProductIDs() []string {
+	res := set.NewEmpty[string](len(ss))
+	for _, s := range ss {
+		res.Add(s.ProductIDs()...)
+	}
+	return res.Slice()
+}
+
+func (ss Subscriptions) IDs() []string {
+	res := make([]string, len(ss))
+	for i := range ss {
+		res[i] = ss[i].ID
+	}
+	return res
+}
@@ -0,0 +1,398 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	products := Products{
+		{ID: "product-id01", CoordinatorID: "coordinator-id"},
+		{ID: "product-id02", CoordinatorID: "coordinator-id"},
+		{ID: "product-id03", CoordinatorID: "other-id"},
+	}
+	tests := []struct {
+		name      string
+		params    *NewSubscriptionParams
+		expect    *Subscription
+		expectErr error
+	}{
+		{
+			name: "success",
+			params: &NewSubscriptionParams{
+				UserID:            "user-id",
+				ShopID:            "shop-id",
+				Interval:          SubscriptionIntervalBiweekly,
+				Items:             SubscriptionItems{{ProductID: "product-id01", Quantity: 1}, {ProductID: "product-id02", Quantity: 2}},
+				Products:          products,
+				AddressRevisionID: 1,
+				ProviderType:      PaymentProviderTypeStripe,
+				CustomerID:        "cus_xxx",
+				PaymentMethodID:   "pm_xxx",
+				StartAt:           now,
+			},
+			expect: &Subscription{
+				UserID:            "user-id",
+				ShopID:            "shop-id",
+				CoordinatorID:     "coordinator-id",
+				Status:            SubscriptionStatusActive,
+				Interval:          SubscriptionIntervalBiweekly,
+				AnchorDay:         18,
+				Items:             SubscriptionItems{{ProductID: "product-id01", Quantity: 1}, {ProductID: "product-id02", Quantity: 2}},
+				AddressRevisionID: 1,
+				ProviderType:      PaymentProviderTypeStripe,
+				CustomerID:        "cus_xxx",
+				PaymentMethodID:   "pm_xxx",
+				NextRunAt:         now,
+			},
+		},
+		{
+			name: "unknown interval",
+			params: &NewSubscriptionParams{
+				Interval: SubscriptionIntervalUnknown,
+				Items:    SubscriptionItems{{ProductID: "product-id01", Quantity: 1}},
+				Products: products,
+				StartAt:  now,
+			},
+			expectErr: ErrInvalidSubscription,
+		},
+		{
+			name: "empty items",
+			params: &NewSubscriptionParams{
+				Interval: SubscriptionIntervalWeekly,
+				Products: products,
+				StartAt:  now,
+			},
+			expectErr: ErrInvalidSubscription,
+		},
+		{
+			name: "product not found",
+			params: &NewSubscriptionParams{
+				Interval: SubscriptionIntervalWeekly,
+				Items:    SubscriptionItems{{ProductID: "unknown", Quantity: 1}},
+				Products: products,
+				StartAt:  now,
+			},
+			expectErr: ErrInvalidSubscription,
+		},
+		{
+			name: "invalid quantity",
+			params: &NewSubscriptionParams{
+				Interval: SubscriptionIntervalWeekly,
+				Items:    SubscriptionItems{{ProductID: "product-id01", Quantity: 0}},
+				Products: products,
+				StartAt:  now,
+			},
+			expectErr: ErrInvalidSubscription,
+		},
+		{
+			name: "multiple coordinators",
+			params: &NewSubscriptionParams{
+				Interval: SubscriptionIntervalWeekly,
+				Items:    SubscriptionItems{{ProductID: "product-id01", Quantity: 1}, {ProductID: "product-id03", Quantity: 1}},
+				Products: products,
+				StartAt:  now,
+			},
+			expectErr: ErrInvalidSubscription,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewSubscription(tt.params)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if tt.expectErr != nil {
+				return
+			}
+			actual.ID = "" // ignore
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestSubscriptionInterval_Next(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		interval  SubscriptionInterval
+		base      time.Time
+		anchorDay int64
+		expect    time.Time
+	}{
+		{
+			name:      "weekly",
+			interval:  SubscriptionIntervalWeekly,
+			base:      jst.Date(2026, 1, 31, 0, 0, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2026, 2, 7, 0, 0, 0, 0),
+		},
+		{
+			name:      "biweekly",
+			interval:  SubscriptionIntervalBiweekly,
+			base:      jst.Date(2026, 1, 31, 0, 0, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2026, 2, 14, 0, 0, 0, 0),
+		},
+		{
+			name:      "monthly from jan 31",
+			interval:  SubscriptionIntervalMonthly,
+			base:      jst.Date(2026, 1, 31, 9, 30, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2026, 2, 28, 9, 30, 0, 0),
+		},
+		{
+			name:      "monthly from feb 28",
+			interval:  SubscriptionIntervalMonthly,
+			base:      jst.Date(2026, 2, 28, 0, 0, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2026, 3, 31, 0, 0, 0, 0),
+		},
+		{
+			name:      "monthly from jan 31 in leap year",
+			interval:  SubscriptionIntervalMonthly,
+			base:      jst.Date(2028, 1, 31, 0, 0, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2028, 2, 29, 0, 0, 0, 0),
+		},
+		{
+			name:      "monthly from feb 29",
+			interval:  SubscriptionIntervalMonthly,
+			base:      jst.Date(2028, 2, 29, 0, 0, 0, 0),
+			anchorDay: 30,
+			expect:    jst.Date(2028, 3, 30, 0, 0, 0, 0),
+		},
+		{
+			name:      "monthly from mar 31",
+			interval:  SubscriptionIntervalMonthly,
+			base:      jst.Date(2026, 3, 31, 0, 0, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2026, 4, 30, 0, 0, 0, 0),
+		},
+		{
+			name:      "monthly across year",
+			interval:  SubscriptionIntervalMonthly,
+			base:      jst.Date(2026, 12, 31, 0, 0, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2027, 1, 31, 0, 0, 0, 0),
+		},
+		{
+			name:      "monthly without anchor day",
+			interval:  SubscriptionIntervalMonthly,
+			base:      jst.Date(2026, 1, 15, 0, 0, 0, 0),
+			anchorDay: 0,
+			expect:    jst.Date(2026, 2, 15, 0, 0, 0, 0),
+		},
+		{
+			name:      "unknown",
+			interval:  SubscriptionIntervalUnknown,
+			base:      jst.Date(2026, 1, 31, 0, 0, 0, 0),
+			anchorDay: 31,
+			expect:    jst.Date(2026, 1, 31, 0, 0, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.interval.Next(tt.base, tt.anchorDay))
+		})
+	}
+}
+
+func TestSubscription_Renewable(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	tests := []struct {
+		name         string
+		subscription *Subscription
+		expect       bool
+	}{
+		{
+			name:         "renewable",
+			subscription: &Subscription{Status: SubscriptionStatusActive, NextRunAt: now},
+			expect:       true,
+		},
+		{
+			name:         "not yet",
+			subscription: &Subscription{Status: SubscriptionStatusActive, NextRunAt: now.Add(time.Hour)},
+			expect:       false,
+		},
+		{
+			name:         "paused",
+			subscription: &Subscription{Status: SubscriptionStatusPaused, NextRunAt: now},
+			expect:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, tt.subscription.Renewable(now))
+		})
+	}
+}
+
+func TestSubscription_Skip(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		s := &Subscription{Status: SubscriptionStatusActive, Interval: SubscriptionIntervalWeekly, NextRunAt: now}
+		require.NoError(t, s.Skip(now))
+		assert.Equal(t, now.AddDate(0, 0, 7), s.NextRunAt)
+	})
+	t.Run("success monthly at end of month", func(t *testing.T) {
+		t.Parallel()
+		s := &Subscription{
+			Status:    SubscriptionStatusActive,
+			Interval:  SubscriptionIntervalMonthly,
+			AnchorDay: 31,
+			NextRunAt: jst.Date(2027, 1, 31, 0, 0, 0, 0),
+		}
+		require.NoError(t, s.Skip(now))
+		assert.Equal(t, jst.Date(2027, 2, 28, 0, 0, 0, 0), s.NextRunAt)
+		require.NoError(t, s.Skip(now))
+		assert.Equal(t, jst.Date(2027, 3, 31, 0, 0, 0, 0), s.NextRunAt)
+	})
+	t.Run("too far ahead", func(t *testing.T) {
+		t.Parallel()
+		s := &Subscription{Status: SubscriptionStatusActive, Interval: SubscriptionIntervalMonthly, NextRunAt: now.AddDate(0, 6, 0)}
+		assert.ErrorIs(t, s.Skip(now), ErrSubscriptionUnavailable)
+	})
+	t.Run("paused", func(t *testing.T) {
+		t.Parallel()
+		s := &Subscription{Status: SubscriptionStatusPaused, Interval: SubscriptionIntervalWeekly, NextRunAt: now}
+		assert.ErrorIs(t, s.Skip(now), ErrSubscriptionUnavailable)
+	})
+}
+
+func TestSubscription_PauseAndResume(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 12, 0, 0, 0)
+	s := &Subscription{
+		Status:    SubscriptionStatusActive,
+		Interval:  SubscriptionIntervalWeekly,
+		NextRunAt: jst.Date(2026, 10, 1, 0, 0, 0, 0),
+	}
+	require.NoError(t, s.Pause(now))
+	assert.Equal(t, SubscriptionStatusPaused, s.Status)
+	assert.Equal(t, now, s.PausedAt)
+	assert.ErrorIs(t, s.Pause(now), ErrSubscriptionUnavailable)
+
+	require.NoError(t, s.Resume(now))
+	assert.Equal(t, SubscriptionStatusActive, s.Status)
+	assert.True(t, s.PausedAt.IsZero())
+	assert.Equal(t, jst.Date(2026, 10, 22, 0, 0, 0, 0), s.NextRunAt)
+	assert.ErrorIs(t, s.Resume(now), ErrSubscriptionUnavailable)
+}
+
+func TestSubscription_Cancel(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	s := &Subscription{Status: SubscriptionStatusSuspended}
+	require.NoError(t, s.Cancel(now))
+	assert.Equal(t, SubscriptionStatusCanceled, s.Status)
+	assert.Equal(t, now, s.CanceledAt)
+	assert.ErrorIs(t, s.Cancel(now), ErrSubscriptionUnavailable)
+}
+
+func TestSubscription_Renewed(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 6, 0, 0, 0)
+	s := &Subscription{
+		Status:       SubscriptionStatusActive,
+		Interval:     SubscriptionIntervalBiweekly,
+		NextRunAt:    jst.Date(2026, 10, 18, 0, 0, 0, 0),
+		FailureCount: 2,
+		LastFailedAt: now.AddDate(0, 0, -1),
+	}
+	s.Renewed("order-id", now)
+	assert.Equal(t, "order-id", s.LastOrderID)
+	assert.Zero(t, s.FailureCount)
+	assert.True(t, s.LastFailedAt.IsZero())
+	assert.Equal(t, jst.Date(2026, 11, 1, 0, 0, 0, 0), s.NextRunAt)
+}
+
+func TestSubscription_NextPeriod(t *testing.T) {
+	t.Parallel()
+	s := &Subscription{
+		Interval:  SubscriptionIntervalWeekly,
+		NextRunAt: jst.Date(2026, 10, 4, 0, 0, 0, 0),
+	}
+	actual := s.NextPeriod(jst.Date(2026, 10, 18, 6, 0, 0, 0))
+	assert.Equal(t, jst.Date(2026, 10, 25, 0, 0, 0, 0), actual)
+	assert.Equal(t, jst.Date(2026, 10, 4, 0, 0, 0, 0), s.NextRunAt)
+
+	// 月末基準の毎月の定期便は、短い月を経由しても基準日へ戻る
+	s = &Subscription{
+		Interval:  SubscriptionIntervalMonthly,
+		AnchorDay: 31,
+		NextRunAt: jst.Date(2026, 1, 31, 0, 0, 0, 0),
+	}
+	actual = s.NextPeriod(jst.Date(2026, 3, 1, 0, 0, 0, 0))
+	assert.Equal(t, jst.Date(2026, 3, 31, 0, 0, 0, 0), actual)
+}
+
+func TestSubscription_RenewFailed(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 6, 0, 0, 0)
+	s := &Subscription{
+		Status:    SubscriptionStatusActive,
+		Interval:  SubscriptionIntervalWeekly,
+		NextRunAt: jst.Date(2026, 10, 18, 0, 0, 0, 0),
+	}
+	for i := 1; i < SubscriptionMaxRenewalFailures; i++ {
+		s.RenewFailed(now)
+		assert.Equal(t, int64(i), s.FailureCount)
+		assert.Equal(t, now.Add(24*time.Hour), s.NextRunAt)
+		assert.False(t, s.Suspended())
+	}
+	s.RenewFailed(now)
+	assert.True(t, s.Suspended())
+	assert.Equal(t, now, s.LastFailedAt)
+}
+
+func TestSubscription_Cart(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	products := Products{
+		{
+			ID:            "product-id",
+			CoordinatorID: "coordinator-id",
+			DeliveryType:  DeliveryTypeNormal,
+			Inventory:     10,
+			Box60Rate:     50,
+			Box80Rate:     40,
+			Box100Rate:    30,
+		},
+	}
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		s := &Subscription{ID: "subscription-id", Items: SubscriptionItems{{ProductID: "product-id", Quantity: 2}}}
+		cart, err := s.Cart(products, now)
+		require.NoError(t, err)
+		require.Len(t, cart.Baskets, 1)
+		assert.Equal(t, "coordinator-id", cart.Baskets[0].CoordinatorID)
+		assert.Equal(t, CartItems{{ProductID: "product-id", Quantity: 2}}, cart.Baskets[0].Items)
+	})
+	t.Run("insufficient stock", func(t *testing.T) {
+		t.Parallel()
+		s := &Subscription{ID: "subscription-id", Items: SubscriptionItems{{ProductID: "product-id", Quantity: 11}}}
+		_, err := s.Cart(products, now)
+		assert.ErrorIs(t, err, ErrInsufficientProductStock)
+	})
+}
+
+func TestSubscriptions_ProductIDs(t *testing.T) {
+	t.Parallel()
+	ss := Subscriptions{
+		{ID: "subscription-id01", Items: SubscriptionItems{{ProductID: "product-id01"}, {ProductID: "product-id02"}}},
+		{ID: "subscription-id02", Items: SubscriptionItems{{ProductID: "product-id01"}}},
+	}
+	assert.ElementsMatch(t, []string{"product-id01", "product-id02"}, ss.ProductIDs())
+	assert.Equal(t, []string{"subscription-id01", "subscription-id02"}, ss.IDs())
+}
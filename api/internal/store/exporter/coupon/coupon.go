@@ -0,0 +1,63 @@
+package coupon
+
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+)
+
+var receiptHeaders = []string{
+	"クーポンコード",
+	"プロモーション名",
+	"用途",
+	"利用状況",
+	"利用開始日時",
+	"利用終了日時",
+}
+
+// Receipt - クーポンコード配布情報
+type Receipt struct {
+	Code           string    // クーポンコード
+	PromotionTitle string    // プロモーション名
+	BatchTitle     string    // 用途
+	Status         string    // 利用状況
+	StartAt        time.Time // 利用開始日時
+	EndAt          time.Time // 利用終了日時
+}
+
+type ReceiptsParams struct {
+	Promotion *entity.Promotion
+	Batch     *entity.PromotionCodeBatch
+	Codes     entity.PromotionCodes
+}
+
+func NewReceipts(params *ReceiptsParams) []exporter.Receipt {
+	res := make([]exporter.Receipt, len(params.Codes))
+	for i, code := range params.Codes {
+		res[i] = &Receipt{
+			Code:           code.Code,
+			PromotionTitle: params.Promotion.Title,
+			BatchTitle:     params.Batch.Title,
+			Status:         code.Status.String(),
+			StartAt:        params.Promotion.StartAt,
+			EndAt:          params.Promotion.EndAt,
+		}
+	}
+	return res
+}
+
+func (r *Receipt) Header() []string {
+	return receiptHeaders
+}
+
+func (r *Receipt) Record() []string {
+	return []string{
+		r.Code,
+		r.PromotionTitle,
+		r.BatchTitle,
+		r.Status,
+		r.StartAt.Format(time.DateTime),
+		r.EndAt.Format(time.DateTime),
+	}
+}
@@ -0,0 +1,98 @@
+package coupon
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceipts(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	params := &ReceiptsParams{
+		Promotion: &entity.Promotion{
+			ID:      "promotion-id",
+			Title:   "夏の採れたて野菜マルシェ",
+			StartAt: now,
+			EndAt:   now.AddDate(0, 1, 0),
+		},
+		Batch: &entity.PromotionCodeBatch{
+			ID:          "batch-id",
+			PromotionID: "promotion-id",
+			Title:       "彦根マルシェ配布分",
+			Quantity:    2,
+			Status:      entity.PromotionCodeBatchStatusActive,
+		},
+		Codes: entity.PromotionCodes{
+			{Code: "ABCD2345", BatchID: "batch-id", PromotionID: "promotion-id", Status: entity.PromotionCodeStatusAvailable},
+			{Code: "WXYZ6789", BatchID: "batch-id", PromotionID: "promotion-id", Status: entity.PromotionCodeStatusRedeemed},
+		},
+	}
+	expect := []exporter.Receipt{
+		&Receipt{
+			Code:           "ABCD2345",
+			PromotionTitle: "夏の採れたて野菜マルシェ",
+			BatchTitle:     "彦根マルシェ配布分",
+			Status:         "未使用",
+			StartAt:        now,
+			EndAt:          now.AddDate(0, 1, 0),
+		},
+		&Receipt{
+			Code:           "WXYZ6789",
+			PromotionTitle: "夏の採れたて野菜マルシェ",
+			BatchTitle:     "彦根マルシェ配布分",
+			Status:         "使用済み",
+			StartAt:        now,
+			EndAt:          now.AddDate(0, 1, 0),
+		},
+	}
+	actual := NewReceipts(params)
+	assert.Equal(t, expect, actual)
+}
+
+func TestReceipt_Write(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	receipt := &Receipt{
+		Code:           "ABCD2345",
+		PromotionTitle: "夏野菜",
+		BatchTitle:     "配布",
+		Status:         "未使用",
+		StartAt:        now,
+		EndAt:          now.AddDate(0, 1, 0),
+	}
+	tests := []struct {
+		name         string
+		encodingType codes.CharacterEncodingType
+		receipt      *Receipt
+		expect       string
+	}{
+		{
+			name:         "success utf-8",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			receipt:      receipt,
+			expect: "クーポンコード,プロモーション名,用途,利用状況,利用開始日時,利用終了日時\n" +
+				"ABCD2345,夏野菜,配布,未使用,2024-01-23 18:30:00,2024-02-23 18:30:00\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			writer := exporter.NewExporter(buf, tt.encodingType)
+			err := writer.WriteHeader(&Receipt{})
+			require.NoError(t, err)
+			err = writer.WriteBody(tt.receipt)
+			require.NoError(t, err)
+			err = writer.Flush()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, buf.String())
+		})
+	}
+}
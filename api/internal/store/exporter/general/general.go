@@ -7,6 +7,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/exporter"
 	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 )
 
 var receiptHeaders = []string{
@@ -161,8 +162,14 @@ func (r *Receipt) SetPaymentDetails(payment *entity.OrderPayment) {
 }
 
 func (r *Receipt) SetFulfillmentDetails(fulfillment *entity.OrderFulfillment) {
-	r.ExpectedDelveryDate = ""       // TODO: 購入フローの改修時に対応
-	r.ExpectedDeliveryTimeFrame = "" // TODO: 購入フローの改修時に対応
+	r.ExpectedDelveryDate = ""
+	if !fulfillment.DeliveryDate.IsZero() {
+		r.ExpectedDelveryDate = jst.Format(fulfillment.DeliveryDate, time.DateOnly)
+	}
+	r.ExpectedDeliveryTimeFrame = ""
+	if fulfillment.DeliveryTimeWindow != entity.DeliveryTimeWindowUnspecified {
+		r.ExpectedDeliveryTimeFrame = fulfillment.DeliveryTimeWindow.String()
+	}
 	r.ShippingType = fulfillment.ShippingType.String()
 	r.ShippingSize = fulfillment.BoxSize.String()
 }
@@ -43,17 +43,19 @@ func TestReceipts(t *testing.T) {
 				},
 				OrderFulfillments: entity.OrderFulfillments{
 					{
-						ID:                "fulfillment-id",
-						OrderID:           "order-id",
-						AddressRevisionID: 1,
-						Status:            entity.FulfillmentStatusUnfulfilled,
-						TrackingNumber:    "",
-						ShippingCarrier:   entity.ShippingCarrierUnknown,
-						ShippingType:      entity.ShippingTypeNormal,
-						BoxNumber:         1,
-						BoxSize:           entity.ShippingSize60,
-						CreatedAt:         now,
-						UpdatedAt:         now,
+						ID:                 "fulfillment-id",
+						OrderID:            "order-id",
+						AddressRevisionID:  1,
+						Status:             entity.FulfillmentStatusUnfulfilled,
+						TrackingNumber:     "",
+						ShippingCarrier:    entity.ShippingCarrierUnknown,
+						ShippingType:       entity.ShippingTypeNormal,
+						BoxNumber:          1,
+						BoxSize:            entity.ShippingSize60,
+						DeliveryDate:       jst.Date(2026, 10, 25, 0, 0, 0, 0),
+						DeliveryTimeWindow: entity.DeliveryTimeWindowMorning,
+						CreatedAt:          now,
+						UpdatedAt:          now,
 					},
 				},
 				OrderItems: entity.OrderItems{
@@ -138,8 +140,8 @@ func TestReceipts(t *testing.T) {
 			OrderID:                   "order-id",
 			UserID:                    "user-id",
 			CoordinatorID:             "coordinator-id",
-			ExpectedDelveryDate:       "",
-			ExpectedDeliveryTimeFrame: "",
+			ExpectedDelveryDate:       "2026-10-25",
+			ExpectedDeliveryTimeFrame: "午前中",
 			DeliveryName:              "&. 購入者",
 			DeliveryNameKana:          "あんどどっと こうにゅうしゃ",
 			DeliveryPhoneNumber:       "090-1234-5678",
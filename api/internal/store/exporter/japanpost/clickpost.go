@@ -0,0 +1,126 @@
+package japanpost
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+)
+
+// ErrClickPostUnavailable - クリックポストで発送できない配送情報が含まれている
+var ErrClickPostUnavailable = errors.New("japanpost: click post is unavailable for this fulfillment")
+
+const (
+	clickPostMaxBoxSize = entity.ShippingSize60 // クリックポストで扱える箱の大きさ（A4サイズ・厚さ3cm以内）
+	clickPostMaxWeight  = 1e3                   // クリックポストで扱える重量（1kg = 1,000g）
+)
+
+var clickPostReceiptHeaders = []string{
+	"お届け先郵便番号",
+	"お届け先氏名",
+	"お届け先敬称",
+	"お届け先住所1行目",
+	"お届け先住所2行目",
+	"お届け先住所3行目",
+	"お届け先住所4行目",
+	"内容品",
+}
+
+// ClickPostReceipt - 日本郵便 クリックポスト（まとめ申込）
+type ClickPostReceipt struct {
+	DeliveryPostalCode string // お届け先郵便番号
+	DeliveryName       string // お届け先氏名
+	DeliveryHonorific  string // お届け先敬称
+	DeliveryAddress1   string // お届け先住所1行目
+	DeliveryAddress2   string // お届け先住所2行目
+	DeliveryAddress3   string // お届け先住所3行目
+	DeliveryAddress4   string // お届け先住所4行目
+	ProductName        string // 内容品
+}
+
+func NewClickPostReceipt(params *ReceiptParams) exporter.Receipt {
+	receipt := &ClickPostReceipt{}
+	receipt.SetDeliveryDetails(params.Addresses[params.Fulfillment.AddressRevisionID])
+	receipt.SetProductDetails(params.Items, params.Products)
+	return receipt
+}
+
+func (r *ClickPostReceipt) SetDeliveryDetails(address *uentity.Address) {
+	if address == nil {
+		return
+	}
+	r.DeliveryPostalCode = address.PostalCode
+	r.DeliveryName = address.Name()
+	r.DeliveryHonorific = "様" // 敬称は「様」固定
+	r.DeliveryAddress1 = address.Prefecture
+	r.DeliveryAddress2 = address.City
+	r.DeliveryAddress3 = address.AddressLine1
+	r.DeliveryAddress4 = address.AddressLine2
+}
+
+func (r *ClickPostReceipt) SetProductDetails(items entity.OrderItems, products map[int64]*entity.Product) {
+	r.ProductName = newProductName(items, products)
+}
+
+func (r *ClickPostReceipt) Header() []string {
+	return clickPostReceiptHeaders
+}
+
+func (r *ClickPostReceipt) Record() []string {
+	return []string{
+		r.DeliveryPostalCode,
+		r.DeliveryName,
+		r.DeliveryHonorific,
+		r.DeliveryAddress1,
+		r.DeliveryAddress2,
+		r.DeliveryAddress3,
+		r.DeliveryAddress4,
+		r.ProductName,
+	}
+}
+
+func NewClickPostReceipts(params *ReceiptsParams) ([]exporter.Receipt, error) {
+	res := make([]exporter.Receipt, 0, len(params.Orders))
+	for _, order := range params.Orders {
+		itemsMap := order.GroupByFulfillmentID()
+		for _, fulfillment := range order.OrderFulfillments {
+			items := itemsMap[fulfillment.ID]
+			if err := validateClickPost(fulfillment, items, params.Products); err != nil {
+				return nil, fmt.Errorf("%w: orderId=%s, fulfillmentId=%s", err, order.ID, fulfillment.ID)
+			}
+			in := &ReceiptParams{
+				Order:       order,
+				Fulfillment: fulfillment,
+				Items:       items,
+				Addresses:   params.Addresses,
+				Products:    params.Products,
+			}
+			res = append(res, NewClickPostReceipt(in))
+		}
+	}
+	return res, nil
+}
+
+// validateClickPost - クリックポストの引受条件（保冷不可・サイズ・重量）を満たしているか
+func validateClickPost(fulfillment *entity.OrderFulfillment, items entity.OrderItems, products map[int64]*entity.Product) error {
+	if fulfillment.ShippingType == entity.ShippingTypeFrozen {
+		return fmt.Errorf("%w: frozen shipping is not supported", ErrClickPostUnavailable)
+	}
+	if fulfillment.BoxSize > clickPostMaxBoxSize {
+		return fmt.Errorf("%w: box size is too large", ErrClickPostUnavailable)
+	}
+	var weight int64
+	for _, item := range items {
+		product, ok := products[item.ProductRevisionID]
+		if !ok {
+			continue
+		}
+		weight += product.WeightGram() * item.Quantity
+	}
+	if weight > clickPostMaxWeight {
+		return fmt.Errorf("%w: weight is too heavy: weight=%dg", ErrClickPostUnavailable, weight)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package japanpost
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClickPostReceipts(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := []struct {
+		name      string
+		params    func() *ReceiptsParams
+		expect    []exporter.Receipt
+		expectErr error
+	}{
+		{
+			name: "success",
+			params: func() *ReceiptsParams {
+				params := testReceiptsParams(now)
+				order := params.Orders[0]
+				order.OrderFulfillments = order.OrderFulfillments[:1]
+				order.OrderFulfillments[0].BoxSize = entity.ShippingSize60
+				return params
+			},
+			expect: []exporter.Receipt{
+				&ClickPostReceipt{
+					DeliveryPostalCode: "1000014",
+					DeliveryName:       "&. 購入者",
+					DeliveryHonorific:  "様",
+					DeliveryAddress1:   "東京都",
+					DeliveryAddress2:   "千代田区",
+					DeliveryAddress3:   "永田町1-7-1",
+					DeliveryAddress4:   "",
+					ProductName:        "新鮮なじゃがいも 他",
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "frozen shipping",
+			params: func() *ReceiptsParams {
+				params := testReceiptsParams(now)
+				order := params.Orders[0]
+				order.OrderFulfillments = order.OrderFulfillments[1:]
+				return params
+			},
+			expect:    nil,
+			expectErr: ErrClickPostUnavailable,
+		},
+		{
+			name: "box size is too large",
+			params: func() *ReceiptsParams {
+				params := testReceiptsParams(now)
+				order := params.Orders[0]
+				order.OrderFulfillments = order.OrderFulfillments[:1]
+				order.OrderFulfillments[0].BoxSize = entity.ShippingSize80
+				return params
+			},
+			expect:    nil,
+			expectErr: ErrClickPostUnavailable,
+		},
+		{
+			name: "weight is too heavy",
+			params: func() *ReceiptsParams {
+				params := testReceiptsParams(now)
+				order := params.Orders[0]
+				order.OrderFulfillments = order.OrderFulfillments[:1]
+				order.OrderFulfillments[0].BoxSize = entity.ShippingSize60
+				params.Products[1].Weight = 600
+				params.Products[1].WeightUnit = entity.WeightUnitGram
+				params.Products[2].Weight = 500
+				params.Products[2].WeightUnit = entity.WeightUnitGram
+				return params
+			},
+			expect:    nil,
+			expectErr: ErrClickPostUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewClickPostReceipts(tt.params())
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestClickPostReceipt_Write(t *testing.T) {
+	t.Parallel()
+	receipt := &ClickPostReceipt{
+		DeliveryPostalCode: "1000014",
+		DeliveryName:       "&. 利用者",
+		DeliveryHonorific:  "様",
+		DeliveryAddress1:   "東京都",
+		DeliveryAddress2:   "千代田区",
+		DeliveryAddress3:   "永田町1-7-1",
+		ProductName:        "新鮮なじゃがいも",
+	}
+	tests := []struct {
+		name         string
+		encodingType codes.CharacterEncodingType
+		receipt      *ClickPostReceipt
+		expect       string
+	}{
+		{
+			name:         "success utf-8",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			receipt:      receipt,
+			expect: "お届け先郵便番号,お届け先氏名,お届け先敬称,お届け先住所1行目,お届け先住所2行目,お届け先住所3行目,お届け先住所4行目,内容品\n" +
+				"1000014,&. 利用者,様,東京都,千代田区,永田町1-7-1,,新鮮なじゃがいも\n",
+		},
+		{
+			name:         "success shift-jis",
+			encodingType: codes.CharacterEncodingTypeShiftJIS,
+			receipt:      receipt,
+			expect: "\x82\xa8\x93͂\xaf\x90\xe6\x97X\x95֔ԍ\x86,\x82\xa8\x93͂\xaf\x90掁\x96\xbc,\x82\xa8\x93͂\xaf\x90\xe6\x8ch\x8f\xcc,\x82\xa8\x93͂\xaf\x90\xe6\x8fZ\x8f\x8a1\x8ds\x96\xda,\x82\xa8\x93͂\xaf\x90\xe6\x8fZ\x8f\x8a2\x8ds\x96\xda,\x82\xa8\x93͂\xaf\x90\xe6\x8fZ\x8f\x8a3\x8ds\x96\xda,\x82\xa8\x93͂\xaf\x90\xe6\x8fZ\x8f\x8a4\x8ds\x96\xda,\x93\xe0\x97e\x95i\n" +
+				"1000014,&. \x97\x98\x97p\x8e\xd2,\x97l,\x93\x8c\x8b\x9e\x93s,\x90\xe7\x91\xe3\x93c\x8b\xe6,\x89i\x93c\x92\xac1-7-1,,\x90V\x91N\x82Ȃ\xb6\x82Ⴊ\x82\xa2\x82\xe0\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			writer := exporter.NewExporter(buf, tt.encodingType)
+			err := writer.WriteHeader(&ClickPostReceipt{})
+			require.NoError(t, err)
+			err = writer.WriteBody(tt.receipt)
+			require.NoError(t, err)
+			err = writer.Flush()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, buf.String())
+		})
+	}
+}
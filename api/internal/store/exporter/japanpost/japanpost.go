@@ -0,0 +1,304 @@
+package japanpost
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+var receiptHeaders = []string{
+	"お客様側管理番号",
+	"発送予定日",
+	"郵便種別",
+	"支払元",
+	"保冷区分",
+	"サイズ",
+	"個数",
+	"お届け先郵便番号",
+	"お届け先住所1",
+	"お届け先住所2",
+	"お届け先住所3",
+	"お届け先名称1",
+	"お届け先名称2",
+	"お届け先敬称",
+	"お届け先電話番号",
+	"ご依頼主郵便番号",
+	"ご依頼主住所1",
+	"ご依頼主住所2",
+	"ご依頼主住所3",
+	"ご依頼主名称1",
+	"ご依頼主名称2",
+	"ご依頼主電話番号",
+	"品名",
+	"配達希望日",
+	"配達時間帯",
+	"記事",
+}
+
+// MailType - 郵便種別
+type MailType string
+
+const (
+	MailTypeYuPack MailType = "0" // ゆうパック
+)
+
+// PaymentType - 支払元
+type PaymentType string
+
+const (
+	PaymentTypePrepayment PaymentType = "0" // 元払い
+)
+
+// CoolType - 保冷区分
+type CoolType string
+
+const (
+	CoolTypeNormal  CoolType = "0" // 通常
+	CoolTypeChilled CoolType = "1" // チルドゆうパック
+)
+
+// Size - ゆうパックのサイズ
+type Size string
+
+const (
+	SizeUnknown Size = ""    // 未指定（ゆうプリR上で入力）
+	Size60      Size = "60"  // 60サイズ
+	Size80      Size = "80"  // 80サイズ
+	Size100     Size = "100" // 100サイズ
+	Size120     Size = "120" // 120サイズ
+	Size140     Size = "140" // 140サイズ
+	Size160     Size = "160" // 160サイズ
+)
+
+// DeliveryTimeFrame - 配達時間帯
+type DeliveryTimeFrame string
+
+const (
+	DeliveryTimeFrameNone    DeliveryTimeFrame = ""   // 指定なし
+	DeliveryTimeFrameMorning DeliveryTimeFrame = "51" // 午前中
+	DeliveryTimeFrame1214    DeliveryTimeFrame = "52" // 12時頃〜14時頃
+	DeliveryTimeFrame1416    DeliveryTimeFrame = "53" // 14時頃〜16時頃
+	DeliveryTimeFrame1618    DeliveryTimeFrame = "54" // 16時頃〜18時頃
+	DeliveryTimeFrame1820    DeliveryTimeFrame = "55" // 18時頃〜20時頃
+	DeliveryTimeFrame1921    DeliveryTimeFrame = "56" // 19時頃〜21時頃
+)
+
+// Receipt - 日本郵便 ゆうプリR（ゆうパック）
+type Receipt struct {
+	OrderID               string            // お客様側管理番号
+	ExpectedShippingDate  string            // 発送予定日
+	MailType              MailType          // 郵便種別
+	PaymentType           PaymentType       // 支払元
+	CoolType              CoolType          // 保冷区分
+	Size                  Size              // サイズ
+	Quantity              int64             // 個数
+	DeliveryPostalCode    string            // お届け先郵便番号
+	DeliveryAddress1      string            // お届け先住所1
+	DeliveryAddress2      string            // お届け先住所2
+	DeliveryAddress3      string            // お届け先住所3
+	DeliveryLastname      string            // お届け先名称1
+	DeliveryFirstname     string            // お届け先名称2
+	DeliveryHonorific     string            // お届け先敬称
+	DeliveryPhoneNumber   string            // お届け先電話番号
+	ClientPostalCode      string            // ご依頼主郵便番号
+	ClientAddress1        string            // ご依頼主住所1
+	ClientAddress2        string            // ご依頼主住所2
+	ClientAddress3        string            // ご依頼主住所3
+	ClientLastname        string            // ご依頼主名称1
+	ClientFirstname       string            // ご依頼主名称2
+	ClientPhoneNumber     string            // ご依頼主電話番号
+	ProductName           string            // 品名
+	ExpectedDeliveryDate  string            // 配達希望日
+	ExpectedDeliveryFrame DeliveryTimeFrame // 配達時間帯
+	Note                  string            // 記事
+}
+
+type ReceiptParams struct {
+	Order       *entity.Order
+	Fulfillment *entity.OrderFulfillment
+	Items       entity.OrderItems
+	Addresses   map[int64]*uentity.Address
+	Products    map[int64]*entity.Product
+}
+
+type ReceiptsParams struct {
+	Orders    entity.Orders
+	Addresses map[int64]*uentity.Address
+	Products  map[int64]*entity.Product
+}
+
+func NewCoolType(typ entity.ShippingType) CoolType {
+	switch typ {
+	case entity.ShippingTypeFrozen:
+		// ゆうパックは冷凍に対応していないため、チルドとして出力する
+		return CoolTypeChilled
+	default:
+		return CoolTypeNormal
+	}
+}
+
+func NewSize(size entity.ShippingSize) Size {
+	switch size {
+	case entity.ShippingSize60:
+		return Size60
+	case entity.ShippingSize80:
+		return Size80
+	case entity.ShippingSize100:
+		return Size100
+	case entity.ShippingSize120:
+		return Size120
+	case entity.ShippingSize140:
+		return Size140
+	case entity.ShippingSize160:
+		return Size160
+	default:
+		return SizeUnknown
+	}
+}
+
+func NewDeliveryTimeFrame(window entity.DeliveryTimeWindow) DeliveryTimeFrame {
+	switch window {
+	case entity.DeliveryTimeWindowMorning:
+		return DeliveryTimeFrameMorning
+	case entity.DeliveryTimeWindow1214:
+		return DeliveryTimeFrame1214
+	case entity.DeliveryTimeWindow1416:
+		return DeliveryTimeFrame1416
+	case entity.DeliveryTimeWindow1618:
+		return DeliveryTimeFrame1618
+	case entity.DeliveryTimeWindow1820:
+		return DeliveryTimeFrame1820
+	case entity.DeliveryTimeWindow1921:
+		return DeliveryTimeFrame1921
+	default:
+		return DeliveryTimeFrameNone
+	}
+}
+
+func NewReceipt(params *ReceiptParams) exporter.Receipt {
+	receipt := &Receipt{}
+	receipt.SetReceiptDetails(params.Fulfillment)
+	receipt.SetDeliveryDetails(params.Addresses[params.Fulfillment.AddressRevisionID])
+	receipt.SetClientDetails(params.Addresses[params.Order.AddressRevisionID])
+	receipt.SetProductDetails(params.Items, params.Products)
+	return receipt
+}
+
+func (r *Receipt) SetReceiptDetails(fulfillment *entity.OrderFulfillment) {
+	r.OrderID = fulfillment.OrderID
+	r.ExpectedShippingDate = ""
+	r.MailType = MailTypeYuPack
+	r.PaymentType = PaymentTypePrepayment // 支払い時に送料も含めているため
+	r.CoolType = NewCoolType(fulfillment.ShippingType)
+	r.Size = NewSize(fulfillment.BoxSize)
+	r.Quantity = 1 // 1固定
+	r.ExpectedDeliveryDate = ""
+	if !fulfillment.DeliveryDate.IsZero() {
+		r.ExpectedDeliveryDate = jst.Format(fulfillment.DeliveryDate, "2006/01/02")
+	}
+	r.ExpectedDeliveryFrame = NewDeliveryTimeFrame(fulfillment.DeliveryTimeWindow)
+	r.Note = ""
+}
+
+func (r *Receipt) SetDeliveryDetails(address *uentity.Address) {
+	if address == nil {
+		return
+	}
+	r.DeliveryPostalCode = address.PostalCode
+	r.DeliveryAddress1 = address.Prefecture
+	r.DeliveryAddress2 = strings.Join([]string{address.City, address.AddressLine1}, " ")
+	r.DeliveryAddress3 = address.AddressLine2
+	r.DeliveryLastname = address.Lastname
+	r.DeliveryFirstname = address.Firstname
+	r.DeliveryHonorific = "様" // 敬称は「様」固定
+	r.DeliveryPhoneNumber = address.PhoneNumber
+}
+
+func (r *Receipt) SetClientDetails(address *uentity.Address) {
+	if address == nil {
+		return
+	}
+	r.ClientPostalCode = address.PostalCode
+	r.ClientAddress1 = address.Prefecture
+	r.ClientAddress2 = strings.Join([]string{address.City, address.AddressLine1}, " ")
+	r.ClientAddress3 = address.AddressLine2
+	r.ClientLastname = address.Lastname
+	r.ClientFirstname = address.Firstname
+	r.ClientPhoneNumber = address.PhoneNumber
+}
+
+func (r *Receipt) SetProductDetails(items entity.OrderItems, products map[int64]*entity.Product) {
+	r.ProductName = newProductName(items, products)
+}
+
+func (r *Receipt) Header() []string {
+	return receiptHeaders
+}
+
+func (r *Receipt) Record() []string {
+	return []string{
+		r.OrderID,
+		r.ExpectedShippingDate,
+		string(r.MailType),
+		string(r.PaymentType),
+		string(r.CoolType),
+		string(r.Size),
+		strconv.FormatInt(r.Quantity, 10),
+		r.DeliveryPostalCode,
+		r.DeliveryAddress1,
+		r.DeliveryAddress2,
+		r.DeliveryAddress3,
+		r.DeliveryLastname,
+		r.DeliveryFirstname,
+		r.DeliveryHonorific,
+		r.DeliveryPhoneNumber,
+		r.ClientPostalCode,
+		r.ClientAddress1,
+		r.ClientAddress2,
+		r.ClientAddress3,
+		r.ClientLastname,
+		r.ClientFirstname,
+		r.ClientPhoneNumber,
+		r.ProductName,
+		r.ExpectedDeliveryDate,
+		string(r.ExpectedDeliveryFrame),
+		r.Note,
+	}
+}
+
+func NewReceipts(params *ReceiptsParams) []exporter.Receipt {
+	res := make([]exporter.Receipt, 0, len(params.Orders))
+	for _, order := range params.Orders {
+		itemsMap := order.GroupByFulfillmentID()
+		for _, fulfillment := range order.OrderFulfillments {
+			in := &ReceiptParams{
+				Order:       order,
+				Fulfillment: fulfillment,
+				Items:       itemsMap[fulfillment.ID],
+				Addresses:   params.Addresses,
+				Products:    params.Products,
+			}
+			res = append(res, NewReceipt(in))
+		}
+	}
+	return res
+}
+
+// newProductName - 品名（先頭の商品名、複数ある場合は「他」を付与する）
+func newProductName(items entity.OrderItems, products map[int64]*entity.Product) string {
+	if len(items) < 1 {
+		return ""
+	}
+	product, ok := products[items[0].ProductRevisionID]
+	if !ok {
+		return ""
+	}
+	if len(items) < 2 {
+		return product.Name
+	}
+	return product.Name + " 他"
+}
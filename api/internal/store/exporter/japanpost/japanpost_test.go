@@ -0,0 +1,332 @@
+package japanpost
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReceiptsParams(now time.Time) *ReceiptsParams {
+	return &ReceiptsParams{
+		Orders: entity.Orders{
+			{
+				ID:            "order-id",
+				UserID:        "user-id",
+				CoordinatorID: "coordinator-id",
+				CreatedAt:     now,
+				UpdatedAt:     now,
+				OrderPayment: entity.OrderPayment{
+					OrderID:           "order-id",
+					AddressRevisionID: 1,
+				},
+				OrderFulfillments: entity.OrderFulfillments{
+					{
+						ID:                 "fulfillment-id01",
+						OrderID:            "order-id",
+						AddressRevisionID:  1,
+						Status:             entity.FulfillmentStatusUnfulfilled,
+						ShippingType:       entity.ShippingTypeNormal,
+						BoxNumber:          1,
+						BoxSize:            entity.ShippingSize80,
+						DeliveryDate:       jst.Date(2026, 10, 25, 0, 0, 0, 0),
+						DeliveryTimeWindow: entity.DeliveryTimeWindow1214,
+						CreatedAt:          now,
+						UpdatedAt:          now,
+					},
+					{
+						ID:                "fulfillment-id02",
+						OrderID:           "order-id",
+						AddressRevisionID: 1,
+						Status:            entity.FulfillmentStatusUnfulfilled,
+						ShippingType:      entity.ShippingTypeFrozen,
+						BoxNumber:         2,
+						BoxSize:           entity.ShippingSize60,
+						CreatedAt:         now,
+						UpdatedAt:         now,
+					},
+				},
+				OrderItems: entity.OrderItems{
+					{
+						FulfillmentID:     "fulfillment-id01",
+						ProductRevisionID: 1,
+						OrderID:           "order-id",
+						Quantity:          1,
+						CreatedAt:         now,
+						UpdatedAt:         now,
+					},
+					{
+						FulfillmentID:     "fulfillment-id01",
+						ProductRevisionID: 2,
+						OrderID:           "order-id",
+						Quantity:          1,
+						CreatedAt:         now,
+						UpdatedAt:         now,
+					},
+					{
+						FulfillmentID:     "fulfillment-id02",
+						ProductRevisionID: 2,
+						OrderID:           "order-id",
+						Quantity:          1,
+						CreatedAt:         now,
+						UpdatedAt:         now,
+					},
+				},
+			},
+		},
+		Addresses: map[int64]*uentity.Address{
+			1: {
+				AddressRevision: uentity.AddressRevision{
+					ID:             1,
+					AddressID:      "address-id",
+					Lastname:       "&.",
+					Firstname:      "購入者",
+					LastnameKana:   "あんどどっと",
+					FirstnameKana:  "こうにゅうしゃ",
+					PostalCode:     "1000014",
+					Prefecture:     "東京都",
+					PrefectureCode: 13,
+					City:           "千代田区",
+					AddressLine1:   "永田町1-7-1",
+					AddressLine2:   "",
+					PhoneNumber:    "090-1234-5678",
+				},
+				ID:        "address-id",
+				UserID:    "user-id",
+				IsDefault: true,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		Products: map[int64]*entity.Product{
+			1: {
+				ID:   "product-id01",
+				Name: "新鮮なじゃがいも",
+				ProductRevision: entity.ProductRevision{
+					ID:        1,
+					ProductID: "product-id01",
+					Price:     400,
+				},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			2: {
+				ID:   "product-id02",
+				Name: "冷凍みかん",
+				ProductRevision: entity.ProductRevision{
+					ID:        2,
+					ProductID: "product-id02",
+					Price:     500,
+				},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}
+}
+
+func TestCoolType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		typ    entity.ShippingType
+		expect CoolType
+	}{
+		{
+			name:   "normal",
+			typ:    entity.ShippingTypeNormal,
+			expect: CoolTypeNormal,
+		},
+		{
+			name:   "frozen",
+			typ:    entity.ShippingTypeFrozen,
+			expect: CoolTypeChilled,
+		},
+		{
+			name:   "unknown",
+			typ:    entity.ShippingTypeUnknown,
+			expect: CoolTypeNormal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewCoolType(tt.typ))
+		})
+	}
+}
+
+func TestSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		size   entity.ShippingSize
+		expect Size
+	}{
+		{
+			name:   "size 60",
+			size:   entity.ShippingSize60,
+			expect: Size60,
+		},
+		{
+			name:   "size 80",
+			size:   entity.ShippingSize80,
+			expect: Size80,
+		},
+		{
+			name:   "size 100",
+			size:   entity.ShippingSize100,
+			expect: Size100,
+		},
+		{
+			name:   "size 120",
+			size:   entity.ShippingSize120,
+			expect: Size120,
+		},
+		{
+			name:   "size 140",
+			size:   entity.ShippingSize140,
+			expect: Size140,
+		},
+		{
+			name:   "size 160",
+			size:   entity.ShippingSize160,
+			expect: Size160,
+		},
+		{
+			name:   "unknown",
+			size:   entity.ShippingSizeUnknown,
+			expect: SizeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NewSize(tt.size))
+		})
+	}
+}
+
+func TestReceipts(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	params := testReceiptsParams(now)
+	expect := []exporter.Receipt{
+		&Receipt{
+			OrderID:               "order-id",
+			ExpectedShippingDate:  "",
+			MailType:              MailTypeYuPack,
+			PaymentType:           PaymentTypePrepayment,
+			CoolType:              CoolTypeNormal,
+			Size:                  Size80,
+			Quantity:              1,
+			DeliveryPostalCode:    "1000014",
+			DeliveryAddress1:      "東京都",
+			DeliveryAddress2:      "千代田区 永田町1-7-1",
+			DeliveryAddress3:      "",
+			DeliveryLastname:      "&.",
+			DeliveryFirstname:     "購入者",
+			DeliveryHonorific:     "様",
+			DeliveryPhoneNumber:   "090-1234-5678",
+			ClientPostalCode:      "1000014",
+			ClientAddress1:        "東京都",
+			ClientAddress2:        "千代田区 永田町1-7-1",
+			ClientAddress3:        "",
+			ClientLastname:        "&.",
+			ClientFirstname:       "購入者",
+			ClientPhoneNumber:     "090-1234-5678",
+			ProductName:           "新鮮なじゃがいも 他",
+			ExpectedDeliveryDate:  "2026/10/25",
+			ExpectedDeliveryFrame: DeliveryTimeFrame1214,
+			Note:                  "",
+		},
+		&Receipt{
+			OrderID:               "order-id",
+			ExpectedShippingDate:  "",
+			MailType:              MailTypeYuPack,
+			PaymentType:           PaymentTypePrepayment,
+			CoolType:              CoolTypeChilled,
+			Size:                  Size60,
+			Quantity:              1,
+			DeliveryPostalCode:    "1000014",
+			DeliveryAddress1:      "東京都",
+			DeliveryAddress2:      "千代田区 永田町1-7-1",
+			DeliveryAddress3:      "",
+			DeliveryLastname:      "&.",
+			DeliveryFirstname:     "購入者",
+			DeliveryHonorific:     "様",
+			DeliveryPhoneNumber:   "090-1234-5678",
+			ClientPostalCode:      "1000014",
+			ClientAddress1:        "東京都",
+			ClientAddress2:        "千代田区 永田町1-7-1",
+			ClientAddress3:        "",
+			ClientLastname:        "&.",
+			ClientFirstname:       "購入者",
+			ClientPhoneNumber:     "090-1234-5678",
+			ProductName:           "冷凍みかん",
+			ExpectedDeliveryDate:  "",
+			ExpectedDeliveryFrame: DeliveryTimeFrameNone,
+			Note:                  "",
+		},
+	}
+	actual := NewReceipts(params)
+	assert.Equal(t, expect, actual)
+}
+
+func TestReceipt_Write(t *testing.T) {
+	t.Parallel()
+	receipt := &Receipt{
+		OrderID:               "order-id",
+		MailType:              MailTypeYuPack,
+		PaymentType:           PaymentTypePrepayment,
+		CoolType:              CoolTypeChilled,
+		Size:                  Size80,
+		Quantity:              1,
+		DeliveryLastname:      "&.",
+		DeliveryFirstname:     "利用者",
+		DeliveryHonorific:     "様",
+		ExpectedDeliveryFrame: DeliveryTimeFrame1214,
+	}
+	tests := []struct {
+		name         string
+		encodingType codes.CharacterEncodingType
+		receipt      *Receipt
+		expect       string
+	}{
+		{
+			name:         "success utf-8",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			receipt:      receipt,
+			expect: "お客様側管理番号,発送予定日,郵便種別,支払元,保冷区分,サイズ,個数,お届け先郵便番号,お届け先住所1,お届け先住所2,お届け先住所3,お届け先名称1,お届け先名称2,お届け先敬称,お届け先電話番号,ご依頼主郵便番号,ご依頼主住所1,ご依頼主住所2,ご依頼主住所3,ご依頼主名称1,ご依頼主名称2,ご依頼主電話番号,品名,配達希望日,配達時間帯,記事\n" +
+				"order-id,,0,0,1,80,1,,,,,&.,利用者,様,,,,,,,,,,,52,\n",
+		},
+		{
+			name:         "success shift-jis",
+			encodingType: codes.CharacterEncodingTypeShiftJIS,
+			receipt:      receipt,
+			expect: "\x82\xa8\x8bq\x97l\x91\xa4\x8aǗ\x9d\x94ԍ\x86,\x94\xad\x91\x97\x97\\\x92\xe8\x93\xfa,\x97X\x95֎\xed\x95\xca,\x8ex\x95\xa5\x8c\xb3,\x95ۗ\xe2\x8b敪,\x83T\x83C\x83Y,\x8c\u0090\x94,\x82\xa8\x93͂\xaf\x90\xe6\x97X\x95֔ԍ\x86,\x82\xa8\x93͂\xaf\x90\xe6\x8fZ\x8f\x8a1,\x82\xa8\x93͂\xaf\x90\xe6\x8fZ\x8f\x8a2,\x82\xa8\x93͂\xaf\x90\xe6\x8fZ\x8f\x8a3,\x82\xa8\x93͂\xaf\x90於\x8f\xcc1,\x82\xa8\x93͂\xaf\x90於\x8f\xcc2,\x82\xa8\x93͂\xaf\x90\xe6\x8ch\x8f\xcc,\x82\xa8\x93͂\xaf\x90\xe6\x93d\x98b\x94ԍ\x86,\x82\xb2\x88˗\x8a\x8e\xe5\x97X\x95֔ԍ\x86,\x82\xb2\x88˗\x8a\x8e\xe5\x8fZ\x8f\x8a1,\x82\xb2\x88˗\x8a\x8e\xe5\x8fZ\x8f\x8a2,\x82\xb2\x88˗\x8a\x8e\xe5\x8fZ\x8f\x8a3,\x82\xb2\x88˗\x8a\x8e喼\x8f\xcc1,\x82\xb2\x88˗\x8a\x8e喼\x8f\xcc2,\x82\xb2\x88˗\x8a\x8e\xe5\x93d\x98b\x94ԍ\x86,\x95i\x96\xbc,\x94z\x92B\x8a\xf3\x96]\x93\xfa,\x94z\x92B\x8e\x9e\x8aԑ\xd1,\x8bL\x8e\x96\n" +
+				"order-id,,0,0,1,80,1,,,,,&.,\x97\x98\x97p\x8e\xd2,\x97l,,,,,,,,,,,52,\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			writer := exporter.NewExporter(buf, tt.encodingType)
+			err := writer.WriteHeader(&Receipt{})
+			require.NoError(t, err)
+			err = writer.WriteBody(tt.receipt)
+			require.NoError(t, err)
+			err = writer.Flush()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, buf.String())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/exporter"
 	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 )
 
 var receiptHeaders = []string{
@@ -214,6 +215,25 @@ func NewShippingType(typ entity.ShippingType) ShippingType {
 	}
 }
 
+func NewDeliveryTimeFrame(window entity.DeliveryTimeWindow) DeliveryTimeFrame {
+	switch window {
+	case entity.DeliveryTimeWindowMorning:
+		return DeliveryTimeFrameMorning
+	case entity.DeliveryTimeWindow1214:
+		return DeliveryTimeFrame1214
+	case entity.DeliveryTimeWindow1416:
+		return DeliveryTimeFrame1416
+	case entity.DeliveryTimeWindow1618:
+		return DeliveryTimeFrame1618
+	case entity.DeliveryTimeWindow1820:
+		return DeliveryTimeFrame1820
+	case entity.DeliveryTimeWindow1921:
+		return DeliveryTimeFrame1921
+	default:
+		return DeliveryTimeFrameNone
+	}
+}
+
 func NewReceipt(params *ReceiptParams) exporter.Receipt {
 	receipt := &Receipt{}
 	receipt.SetReceiptDetails(params.Order, params.Fulfillment)
@@ -221,6 +241,7 @@ func NewReceipt(params *ReceiptParams) exporter.Receipt {
 	receipt.SetClientDetails(params.Addresses[params.Order.AddressRevisionID])
 	receipt.SetProductDetails(params.Items, params.Products)
 	receipt.SetTagProductDetails()
+	receipt.SetGiftDetails(params.Order)
 	return receipt
 }
 
@@ -234,8 +255,11 @@ func (r *Receipt) SetReceiptDetails(order *entity.Order, fulfillment *entity.Ord
 	r.ShipmentQuantity = 1 // 1固定
 	r.SpeedSpecification = ""
 	r.ShippingType = NewShippingType(fulfillment.ShippingType)
-	r.DeliveryDate = ""      // TODO: 購入フローの改修時に対応
-	r.DeliveryTimeFrame = "" // TODO: 購入フローの改修時に対応
+	r.DeliveryDate = ""
+	if !fulfillment.DeliveryDate.IsZero() {
+		r.DeliveryDate = jst.FormatYYYYMMDD(fulfillment.DeliveryDate)
+	}
+	r.DeliveryTimeFrame = NewDeliveryTimeFrame(fulfillment.DeliveryTimeWindow)
 	r.DeliveryTime = ""
 	r.DeliveryAmount = 0
 	r.DeliveryTax = 0
@@ -354,6 +378,18 @@ func (r *Receipt) SetTagProductDetails() {
 	r.TagProduct11Name = ""
 }
 
+// SetGiftDetails - ギフト注文の場合、購入者をご依頼主として金額を伏せた送り状にする
+func (r *Receipt) SetGiftDetails(order *entity.Order) {
+	if !order.Gift {
+		return
+	}
+	r.Tagpackaging = "ギフト"
+	r.TagProduct1Name = order.Noshi()
+	r.DeliveryAmount = 0
+	r.DeliveryTax = 0
+	r.InsuranceAmount = 0
+}
+
 func (r *Receipt) Header() []string {
 	return receiptHeaders
 }
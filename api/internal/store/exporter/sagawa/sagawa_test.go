@@ -9,6 +9,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/exporter"
 	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,17 +43,19 @@ func TestReceipts(t *testing.T) {
 				},
 				OrderFulfillments: entity.OrderFulfillments{
 					{
-						ID:                "fulfillment-id",
-						OrderID:           "order-id",
-						AddressRevisionID: 1,
-						Status:            entity.FulfillmentStatusUnfulfilled,
-						TrackingNumber:    "",
-						ShippingCarrier:   entity.ShippingCarrierUnknown,
-						ShippingType:      entity.ShippingTypeNormal,
-						BoxNumber:         1,
-						BoxSize:           entity.ShippingSize60,
-						CreatedAt:         now,
-						UpdatedAt:         now,
+						ID:                 "fulfillment-id",
+						OrderID:            "order-id",
+						AddressRevisionID:  1,
+						Status:             entity.FulfillmentStatusUnfulfilled,
+						TrackingNumber:     "",
+						ShippingCarrier:    entity.ShippingCarrierUnknown,
+						ShippingType:       entity.ShippingTypeNormal,
+						BoxNumber:          1,
+						BoxSize:            entity.ShippingSize60,
+						DeliveryDate:       jst.Date(2026, 10, 25, 0, 0, 0, 0),
+						DeliveryTimeWindow: entity.DeliveryTimeWindowMorning,
+						CreatedAt:          now,
+						UpdatedAt:          now,
 					},
 				},
 				OrderItems: entity.OrderItems{
@@ -178,8 +181,8 @@ func TestReceipts(t *testing.T) {
 			ShipmentQuantity:                  1,
 			SpeedSpecification:                "",
 			ShippingType:                      "001",
-			DeliveryDate:                      "",
-			DeliveryTimeFrame:                 "",
+			DeliveryDate:                      "20261025",
+			DeliveryTimeFrame:                 "01",
 			DeliveryTime:                      "",
 			DeliveryAmount:                    0,
 			DeliveryTax:                       0,
@@ -285,3 +288,40 @@ func TestReceipt_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestReceipt_SetGiftDetails(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		order  *entity.Order
+		expect *Receipt
+	}{
+		{
+			name: "gift with noshi",
+			order: &entity.Order{
+				OrderMetadata: entity.OrderMetadata{
+					Gift:      true,
+					NoshiType: entity.NoshiTypeOchugen,
+					NoshiName: "山田",
+				},
+			},
+			expect: &Receipt{
+				Tagpackaging:    "ギフト",
+				TagProduct1Name: "御中元（山田）",
+			},
+		},
+		{
+			name:   "not gift",
+			order:  &entity.Order{},
+			expect: &Receipt{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := &Receipt{}
+			actual.SetGiftDetails(tt.order)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package settlement
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+var receiptHeaders = []string{
+	"精算明細ID",
+	"精算先種別",
+	"精算先ID",
+	"精算先名",
+	"精算対象期間(開始)",
+	"精算対象期間(終了)",
+	"対象注文数",
+	"売上金額",
+	"返金金額",
+	"販売手数料率(%)",
+	"販売手数料",
+	"決済手数料",
+	"支払い金額",
+	"支払い状況",
+	"支払い日時",
+}
+
+// Receipt - 精算明細情報
+type Receipt struct {
+	StatementID    string    // 精算明細ID
+	PayeeType      string    // 精算先種別
+	PayeeID        string    // 精算先ID
+	PayeeName      string    // 精算先名
+	PeriodStartAt  time.Time // 精算対象期間(開始)
+	PeriodEndAt    time.Time // 精算対象期間(終了)
+	OrderCount     int64     // 対象注文数
+	SalesTotal     int64     // 売上金額
+	RefundTotal    int64     // 返金金額
+	CommissionRate float64   // 販売手数料率(%)
+	CommissionFee  int64     // 販売手数料
+	PaymentFee     int64     // 決済手数料
+	PayoutTotal    int64     // 支払い金額
+	Status         string    // 支払い状況
+	PaidAt         time.Time // 支払い日時
+}
+
+type ReceiptsParams struct {
+	Statements entity.SettlementStatements
+	PayeeNames map[string]string // 精算先ID -> 精算先名
+}
+
+func NewReceipts(params *ReceiptsParams) []exporter.Receipt {
+	res := make([]exporter.Receipt, len(params.Statements))
+	for i, s := range params.Statements {
+		res[i] = &Receipt{
+			StatementID:    s.ID,
+			PayeeType:      s.PayeeType.String(),
+			PayeeID:        s.PayeeID,
+			PayeeName:      params.PayeeNames[s.PayeeID],
+			PeriodStartAt:  s.PeriodStartAt,
+			PeriodEndAt:    s.PeriodEndAt,
+			OrderCount:     s.OrderCount,
+			SalesTotal:     s.SalesTotal,
+			RefundTotal:    s.RefundTotal,
+			CommissionRate: s.CommissionRate,
+			CommissionFee:  s.CommissionFee,
+			PaymentFee:     s.PaymentFee,
+			PayoutTotal:    s.PayoutTotal,
+			Status:         s.Status.String(),
+			PaidAt:         s.PaidAt,
+		}
+	}
+	return res
+}
+
+func (r *Receipt) Header() []string {
+	return receiptHeaders
+}
+
+func (r *Receipt) Record() []string {
+	return []string{
+		r.StatementID,
+		r.PayeeType,
+		r.PayeeID,
+		r.PayeeName,
+		jst.Format(r.PeriodStartAt, time.DateTime),
+		jst.Format(r.PeriodEndAt, time.DateTime),
+		strconv.FormatInt(r.OrderCount, 10),
+		strconv.FormatInt(r.SalesTotal, 10),
+		strconv.FormatInt(r.RefundTotal, 10),
+		strconv.FormatFloat(r.CommissionRate, 'f', -1, 64),
+		strconv.FormatInt(r.CommissionFee, 10),
+		strconv.FormatInt(r.PaymentFee, 10),
+		strconv.FormatInt(r.PayoutTotal, 10),
+		r.Status,
+		r.formatPaidAt(),
+	}
+}
+
+func (r *Receipt) formatPaidAt() string {
+	if r.PaidAt.IsZero() {
+		return ""
+	}
+	return jst.Format(r.PaidAt, time.DateTime)
+}
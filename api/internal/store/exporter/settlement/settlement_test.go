@@ -0,0 +1,132 @@
+package settlement
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceipts(t *testing.T) {
+	t.Parallel()
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	endAt := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	params := &ReceiptsParams{
+		Statements: entity.SettlementStatements{
+			{
+				ID:             "statement-id",
+				PayeeType:      entity.SettlementPayeeTypeShop,
+				PayeeID:        "shop-id",
+				Status:         entity.SettlementStatementStatusUnpaid,
+				PeriodStartAt:  startAt,
+				PeriodEndAt:    endAt,
+				OrderCount:     2,
+				SalesTotal:     4500,
+				RefundTotal:    1500,
+				CommissionRate: 10,
+				CommissionFee:  300,
+				PaymentFee:     138,
+				PayoutTotal:    2562,
+			},
+		},
+		PayeeNames: map[string]string{"shop-id": "&.農園"},
+	}
+	expect := []exporter.Receipt{
+		&Receipt{
+			StatementID:    "statement-id",
+			PayeeType:      "店舗",
+			PayeeID:        "shop-id",
+			PayeeName:      "&.農園",
+			PeriodStartAt:  startAt,
+			PeriodEndAt:    endAt,
+			OrderCount:     2,
+			SalesTotal:     4500,
+			RefundTotal:    1500,
+			CommissionRate: 10,
+			CommissionFee:  300,
+			PaymentFee:     138,
+			PayoutTotal:    2562,
+			Status:         "未払い",
+		},
+	}
+	actual := NewReceipts(params)
+	assert.Equal(t, expect, actual)
+}
+
+func TestReceipt_Write(t *testing.T) {
+	t.Parallel()
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	endAt := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	header := "精算明細ID,精算先種別,精算先ID,精算先名,精算対象期間(開始),精算対象期間(終了),対象注文数,売上金額,返金金額," +
+		"販売手数料率(%),販売手数料,決済手数料,支払い金額,支払い状況,支払い日時\n"
+	tests := []struct {
+		name         string
+		encodingType codes.CharacterEncodingType
+		receipt      *Receipt
+		expect       string
+	}{
+		{
+			name:         "success unpaid",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			receipt: &Receipt{
+				StatementID:    "statement-id",
+				PayeeType:      "生産者",
+				PayeeID:        "producer-id",
+				PayeeName:      "&.生産者",
+				PeriodStartAt:  startAt,
+				PeriodEndAt:    endAt,
+				OrderCount:     1,
+				SalesTotal:     1000,
+				CommissionRate: 3.5,
+				CommissionFee:  35,
+				PaymentFee:     36,
+				PayoutTotal:    929,
+				Status:         "未払い",
+			},
+			expect: header +
+				"statement-id,生産者,producer-id,&.生産者,2026-09-01 00:00:00,2026-10-01 00:00:00,1,1000,0,3.5,35,36,929,未払い,\n",
+		},
+		{
+			name:         "success paid",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			receipt: &Receipt{
+				StatementID:    "statement-id",
+				PayeeType:      "店舗",
+				PayeeID:        "shop-id",
+				PayeeName:      "&.農園",
+				PeriodStartAt:  startAt,
+				PeriodEndAt:    endAt,
+				OrderCount:     2,
+				SalesTotal:     4500,
+				RefundTotal:    1500,
+				CommissionRate: 10,
+				CommissionFee:  300,
+				PaymentFee:     138,
+				PayoutTotal:    2562,
+				Status:         "支払い済み",
+				PaidAt:         endAt.AddDate(0, 0, 24),
+			},
+			expect: header +
+				"statement-id,店舗,shop-id,&.農園,2026-09-01 00:00:00,2026-10-01 00:00:00,2,4500,1500,10,300,138,2562,支払い済み,2026-10-25 00:00:00\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			writer := exporter.NewExporter(buf, tt.encodingType)
+			err := writer.WriteHeader(&Receipt{})
+			require.NoError(t, err)
+			err = writer.WriteBody(tt.receipt)
+			require.NoError(t, err)
+			err = writer.Flush()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, buf.String())
+		})
+	}
+}
@@ -1,14 +1,20 @@
 package yamato
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/exporter"
 	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 	"golang.org/x/text/width"
 )
 
+// ErrUnsupportedDeliveryTimeWindow - ヤマト運輸で指定できないお届け希望時間帯が含まれている
+var ErrUnsupportedDeliveryTimeWindow = errors.New("yamato: unsupported delivery time window")
+
 var receiptHeaders = []string{
 	"お客様管理番号",
 	"送り状種類",
@@ -268,6 +274,23 @@ func NewShippingType(typ entity.ShippingType) ShippingType {
 	}
 }
 
+func NewDeliveryTimeFrame(window entity.DeliveryTimeWindow) DeliveryTimeFrame {
+	switch window {
+	case entity.DeliveryTimeWindowMorning:
+		return DeliveryTimeFrameMorning
+	case entity.DeliveryTimeWindow1416:
+		return DeliveryTimeFrame1416
+	case entity.DeliveryTimeWindow1618:
+		return DeliveryTimeFrame1618
+	case entity.DeliveryTimeWindow1820:
+		return DeliveryTimeFrame1820
+	case entity.DeliveryTimeWindow1921:
+		return DeliveryTimeFrame1921
+	default:
+		return DeliveryTimeFrameNone
+	}
+}
+
 func NewReceipt(params *ReceiptParams) exporter.Receipt {
 	receipt := &Receipt{}
 	receipt.SetReceiptDetails(params.Fulfillment)
@@ -279,6 +302,7 @@ func NewReceipt(params *ReceiptParams) exporter.Receipt {
 	receipt.SetDeliveryNotificationDetails()
 	receipt.SetMailDropNotificationDetails()
 	receipt.SetSearchDetails(params.Order)
+	receipt.SetGiftDetails(params.Order)
 	return receipt
 }
 
@@ -286,10 +310,13 @@ func (r *Receipt) SetReceiptDetails(fulfillment *entity.OrderFulfillment) {
 	r.OrderID = fulfillment.OrderID
 	r.ServiceType = ServiceTypePrepayment // 0：発払い（支払い時に送料も含めているため）
 	r.ShippingType = NewShippingType(fulfillment.ShippingType)
-	r.YamatoOrderID = ""                                // データ入力用は空白を指定
-	r.ExpectedShippingDate = ""                         // TODO: 購入フローの改修時に対応
-	r.ExpectedDeliveryDate = ""                         // TODO: 購入フローの改修時に対応
-	r.ExpectedDeliveryTimeFrame = DeliveryTimeFrameNone // TODO: 購入フローの改修時に対応
+	r.YamatoOrderID = ""        // データ入力用は空白を指定
+	r.ExpectedShippingDate = "" // TODO: 購入フローの改修時に対応
+	r.ExpectedDeliveryDate = ""
+	if !fulfillment.DeliveryDate.IsZero() {
+		r.ExpectedDeliveryDate = jst.Format(fulfillment.DeliveryDate, "2006/01/02")
+	}
+	r.ExpectedDeliveryTimeFrame = NewDeliveryTimeFrame(fulfillment.DeliveryTimeWindow)
 	r.Handling1 = ""
 	r.Handling2 = ""
 	r.Note = ""
@@ -423,6 +450,17 @@ func (r *Receipt) SetSearchDetails(order *entity.Order) {
 	r.SearchKey5Value = ""
 }
 
+// SetGiftDetails - ギフト注文の場合、購入者をご依頼主として金額を伏せた送り状にする
+func (r *Receipt) SetGiftDetails(order *entity.Order) {
+	if !order.Gift {
+		return
+	}
+	r.Handling1 = "ギフト"
+	r.Note = order.Noshi()
+	r.WebCollectDeliveryAmount = 0
+	r.WebCollectDeliveryTax = 0
+}
+
 func (r *Receipt) Header() []string {
 	return receiptHeaders
 }
@@ -526,11 +564,16 @@ func (r *Receipt) Record() []string {
 	}
 }
 
-func NewReceipts(params *ReceiptsParams) []exporter.Receipt {
+func NewReceipts(params *ReceiptsParams) ([]exporter.Receipt, error) {
 	res := make([]exporter.Receipt, 0, len(params.Orders))
 	for _, order := range params.Orders {
 		itemsMap := order.GroupByFulfillmentID()
 		for _, fulfillment := range order.OrderFulfillments {
+			// ヤマト運輸は12〜14時の時間帯指定に対応していないため、指定なしに読み替えずに出力を中断する
+			if fulfillment.DeliveryTimeWindow == entity.DeliveryTimeWindow1214 {
+				return nil, fmt.Errorf("%w: orderId=%s, fulfillmentId=%s, timeWindow=%s",
+					ErrUnsupportedDeliveryTimeWindow, order.ID, fulfillment.ID, fulfillment.DeliveryTimeWindow)
+			}
 			in := &ReceiptParams{
 				Order:       order,
 				Fulfillment: fulfillment,
@@ -541,5 +584,5 @@ func NewReceipts(params *ReceiptsParams) []exporter.Receipt {
 			res = append(res, NewReceipt(in))
 		}
 	}
-	return res
+	return res, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/exporter"
 	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,17 +43,19 @@ func TestReceipts(t *testing.T) {
 				},
 				OrderFulfillments: entity.OrderFulfillments{
 					{
-						ID:                "fulfillment-id",
-						OrderID:           "order-id",
-						AddressRevisionID: 1,
-						Status:            entity.FulfillmentStatusUnfulfilled,
-						TrackingNumber:    "",
-						ShippingCarrier:   entity.ShippingCarrierUnknown,
-						ShippingType:      entity.ShippingTypeNormal,
-						BoxNumber:         1,
-						BoxSize:           entity.ShippingSize60,
-						CreatedAt:         now,
-						UpdatedAt:         now,
+						ID:                 "fulfillment-id",
+						OrderID:            "order-id",
+						AddressRevisionID:  1,
+						Status:             entity.FulfillmentStatusUnfulfilled,
+						TrackingNumber:     "",
+						ShippingCarrier:    entity.ShippingCarrierUnknown,
+						ShippingType:       entity.ShippingTypeNormal,
+						BoxNumber:          1,
+						BoxSize:            entity.ShippingSize60,
+						DeliveryDate:       jst.Date(2026, 10, 25, 0, 0, 0, 0),
+						DeliveryTimeWindow: entity.DeliveryTimeWindowMorning,
+						CreatedAt:          now,
+						UpdatedAt:          now,
 					},
 				},
 				OrderItems: entity.OrderItems{
@@ -139,8 +142,8 @@ func TestReceipts(t *testing.T) {
 			ShippingType:                           ShippingTypeNormal,
 			YamatoOrderID:                          "",
 			ExpectedShippingDate:                   "",
-			ExpectedDeliveryDate:                   "",
-			ExpectedDeliveryTimeFrame:              DeliveryTimeFrameNone,
+			ExpectedDeliveryDate:                   "2026/10/25",
+			ExpectedDeliveryTimeFrame:              DeliveryTimeFrameMorning,
 			DeliveryCode:                           "1",
 			DeliveryPhoneNumber:                    "090-1234-5678",
 			DeliveryPhoneNumberExtension:           "",
@@ -230,10 +233,33 @@ func TestReceipts(t *testing.T) {
 			MailDropCompletionClientEmailMessage:   "",
 		},
 	}
-	actual := NewReceipts(params)
+	actual, err := NewReceipts(params)
+	require.NoError(t, err)
 	assert.Equal(t, expect, actual)
 }
 
+func TestReceipts_UnsupportedDeliveryTimeWindow(t *testing.T) {
+	t.Parallel()
+	params := &ReceiptsParams{
+		Orders: entity.Orders{
+			{
+				ID: "order-id",
+				OrderFulfillments: entity.OrderFulfillments{
+					{
+						ID:                 "fulfillment-id",
+						OrderID:            "order-id",
+						ShippingType:       entity.ShippingTypeNormal,
+						DeliveryTimeWindow: entity.DeliveryTimeWindow1214,
+					},
+				},
+			},
+		},
+	}
+	actual, err := NewReceipts(params)
+	assert.ErrorIs(t, err, ErrUnsupportedDeliveryTimeWindow)
+	assert.Nil(t, actual)
+}
+
 func TestReceipt_Write(t *testing.T) {
 	t.Parallel()
 	receipt := &Receipt{
@@ -279,3 +305,40 @@ func TestReceipt_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestReceipt_SetGiftDetails(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		order  *entity.Order
+		expect *Receipt
+	}{
+		{
+			name: "gift with noshi",
+			order: &entity.Order{
+				OrderMetadata: entity.OrderMetadata{
+					Gift:      true,
+					NoshiType: entity.NoshiTypeOchugen,
+					NoshiName: "山田",
+				},
+			},
+			expect: &Receipt{
+				Handling1: "ギフト",
+				Note:      "御中元（山田）",
+			},
+		},
+		{
+			name:   "not gift",
+			order:  &entity.Order{},
+			expect: &Receipt{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := &Receipt{}
+			actual.SetGiftDetails(tt.order)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+var ErrMissingColumn = errors.New("importer: missing required column")
+
+type Importer interface {
+	Parse(r io.Reader, encodingType codes.CharacterEncodingType) (Shipments, error)
+}
+
+// Shipment - 送り状発行結果の1行
+type Shipment struct {
+	Line           int64  // 行番号
+	ManagementID   string // お客様管理番号（注文ID）
+	TrackingNumber string // 伝票番号
+}
+
+type Shipments []*Shipment
+
+// Columns - 送り状発行結果の列名（候補を先頭から順に探索する）
+type Columns struct {
+	ManagementIDs   []string // お客様管理番号
+	TrackingNumbers []string // 伝票番号
+}
+
+type importer struct {
+	columns *Columns
+}
+
+func NewImporter(columns *Columns) Importer {
+	return &importer{
+		columns: columns,
+	}
+}
+
+func (i *importer) Parse(r io.Reader, encodingType codes.CharacterEncodingType) (Shipments, error) {
+	if encodingType == codes.CharacterEncodingTypeShiftJIS {
+		r = transform.NewReader(r, japanese.ShiftJIS.NewDecoder())
+	}
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return Shipments{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("importer: failed to read csv header: %w", err)
+	}
+	for idx := range header {
+		header[idx] = strings.TrimSpace(strings.TrimPrefix(header[idx], "\ufeff"))
+	}
+	managementIDIdx := findColumn(header, i.columns.ManagementIDs)
+	if managementIDIdx < 0 {
+		return nil, fmt.Errorf("%w: columns=%v", ErrMissingColumn, i.columns.ManagementIDs)
+	}
+	trackingNumberIdx := findColumn(header, i.columns.TrackingNumbers)
+	if trackingNumberIdx < 0 {
+		return nil, fmt.Errorf("%w: columns=%v", ErrMissingColumn, i.columns.TrackingNumbers)
+	}
+
+	res := make(Shipments, 0)
+	for line := int64(2); ; line++ {
+		values, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("importer: failed to read csv record. line=%d: %w", line, err)
+		}
+		shipment := &Shipment{
+			Line:           line,
+			ManagementID:   getValue(values, managementIDIdx),
+			TrackingNumber: getValue(values, trackingNumberIdx),
+		}
+		if shipment.ManagementID == "" && shipment.TrackingNumber == "" {
+			continue // 空行は読み飛ばす
+		}
+		res = append(res, shipment)
+	}
+	return res, nil
+}
+
+func findColumn(header []string, candidates []string) int {
+	for _, candidate := range candidates {
+		for idx := range header {
+			if header[idx] == candidate {
+				return idx
+			}
+		}
+	}
+	return -1
+}
+
+func getValue(values []string, idx int) string {
+	if idx >= len(values) {
+		return ""
+	}
+	return strings.TrimSpace(values[idx])
+}
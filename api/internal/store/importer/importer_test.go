@@ -0,0 +1,100 @@
+package importer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+func TestImporter_Parse(t *testing.T) {
+	t.Parallel()
+	columns := &Columns{
+		ManagementIDs:   []string{"お客様管理番号", "ManagementID"},
+		TrackingNumbers: []string{"伝票番号"},
+	}
+	shiftJIS := func(s string) string {
+		res, _, err := transform.String(japanese.ShiftJIS.NewEncoder(), s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	tests := []struct {
+		name         string
+		body         string
+		encodingType codes.CharacterEncodingType
+		expect       Shipments
+		hasErr       bool
+	}{
+		{
+			name:         "success utf-8",
+			body:         "\ufeffお客様管理番号,品名,伝票番号\n order-id ,野菜,1234-5678-9012\n,,\norder-id2,果物,\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect: Shipments{
+				{Line: 2, ManagementID: "order-id", TrackingNumber: "1234-5678-9012"},
+				{Line: 4, ManagementID: "order-id2", TrackingNumber: ""},
+			},
+			hasErr: false,
+		},
+		{
+			name:         "success shift-jis",
+			body:         shiftJIS("伝票番号,お客様管理番号\n1234-5678-9012,order-id\n"),
+			encodingType: codes.CharacterEncodingTypeShiftJIS,
+			expect: Shipments{
+				{Line: 2, ManagementID: "order-id", TrackingNumber: "1234-5678-9012"},
+			},
+			hasErr: false,
+		},
+		{
+			name:         "success alternative column",
+			body:         "ManagementID,伝票番号\norder-id,123456789012\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect: Shipments{
+				{Line: 2, ManagementID: "order-id", TrackingNumber: "123456789012"},
+			},
+			hasErr: false,
+		},
+		{
+			name:         "success short record",
+			body:         "伝票番号,お客様管理番号\n123456789012\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect: Shipments{
+				{Line: 2, ManagementID: "", TrackingNumber: "123456789012"},
+			},
+			hasErr: false,
+		},
+		{
+			name:         "success empty",
+			body:         "",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect:       Shipments{},
+			hasErr:       false,
+		},
+		{
+			name:         "missing management id column",
+			body:         "注文番号,伝票番号\norder-id,123456789012\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect:       nil,
+			hasErr:       true,
+		},
+		{
+			name:         "missing tracking number column",
+			body:         "お客様管理番号,送り状\norder-id,123456789012\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect:       nil,
+			hasErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewImporter(columns).Parse(bytes.NewBufferString(tt.body), tt.encodingType)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
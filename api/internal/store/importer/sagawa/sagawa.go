@@ -0,0 +1,13 @@
+// Package sagawa - 佐川急便 e飛伝Ⅲの出荷実績データ
+package sagawa
+
+import "github.com/and-period/furumaru/api/internal/store/importer"
+
+var columns = &importer.Columns{
+	ManagementIDs:   []string{"お客様管理番号", "ManagementID"},
+	TrackingNumbers: []string{"お問い合せ送り状No.", "お問合せ送り状No.", "送り状番号"},
+}
+
+func NewImporter() importer.Importer {
+	return importer.NewImporter(columns)
+}
@@ -0,0 +1,46 @@
+package sagawa
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImporter_Parse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		body   string
+		expect importer.Shipments
+		hasErr bool
+	}{
+		{
+			name: "success",
+			body: "お届け先名称１,お客様管理番号,出荷日,お問い合せ送り状No.\n" +
+				"山田,order-id,2026/10/18,123456789012\n" +
+				"佐藤,order-id2,2026/10/18,123456789023\n",
+			expect: importer.Shipments{
+				{Line: 2, ManagementID: "order-id", TrackingNumber: "123456789012"},
+				{Line: 3, ManagementID: "order-id2", TrackingNumber: "123456789023"},
+			},
+			hasErr: false,
+		},
+		{
+			name:   "invalid format",
+			body:   "伝票番号,お客様管理番号\n123456789012,order-id\n",
+			expect: nil,
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewImporter().Parse(bytes.NewBufferString(tt.body), codes.CharacterEncodingTypeUTF8)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Package yamato - ヤマト運輸 B2クラウドの発行済データ
+package yamato
+
+import "github.com/and-period/furumaru/api/internal/store/importer"
+
+var columns = &importer.Columns{
+	ManagementIDs:   []string{"お客様管理番号", "ManagementID"},
+	TrackingNumbers: []string{"伝票番号", "送り状番号"},
+}
+
+func NewImporter() importer.Importer {
+	return importer.NewImporter(columns)
+}
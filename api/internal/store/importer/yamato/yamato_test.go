@@ -0,0 +1,46 @@
+package yamato
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImporter_Parse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		body   string
+		expect importer.Shipments
+		hasErr bool
+	}{
+		{
+			name: "success",
+			body: "お客様管理番号,送り状種類,クール区分,伝票番号,出荷予定日\n" +
+				"order-id,0,0,1234-5678-9012,2026/10/18\n" +
+				"order-id2,0,2,1234-5678-9023,2026/10/18\n",
+			expect: importer.Shipments{
+				{Line: 2, ManagementID: "order-id", TrackingNumber: "1234-5678-9012"},
+				{Line: 3, ManagementID: "order-id2", TrackingNumber: "1234-5678-9023"},
+			},
+			hasErr: false,
+		},
+		{
+			name:   "invalid format",
+			body:   "お問い合せ送り状No.,お客様管理番号\n123456789012,order-id\n",
+			expect: nil,
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := NewImporter().Parse(bytes.NewBufferString(tt.body), codes.CharacterEncodingTypeUTF8)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"io"
 	"time"
 
 	"github.com/and-period/furumaru/api/internal/codes"
@@ -15,12 +16,15 @@ type GetCartInput struct {
 }
 
 type CalcCartInput struct {
-	SessionID      string `validate:"required"`
-	CoordinatorID  string `validate:"required"`
-	BoxNumber      int64  `validate:"min=0"`
-	PromotionCode  string `validate:"omitempty,len=8"`
-	PrefectureCode int32  `validate:"min=0,max=47"`
-	Pickup         bool   `validate:""`
+	SessionID      string                 `validate:"required"`
+	UserID         string                 `validate:"required_with=Destinations"`
+	CoordinatorID  string                 `validate:"required"`
+	BoxNumber      int64                  `validate:"min=0"`
+	PromotionCode  string                 `validate:"omitempty,len=8"`
+	PrefectureCode int32                  `validate:"min=0,max=47"`
+	PostalCode     string                 `validate:"omitempty,max=16"`
+	Pickup         bool                   `validate:""`
+	Destinations   []*CheckoutDestination `validate:"excluded_with=Pickup,omitempty,max=10,dive,required"`
 }
 
 type AddCartItemInput struct {
@@ -153,16 +157,34 @@ type CheckoutDetail struct {
 }
 
 type CheckoutProductDetail struct {
-	CoordinatorID     string    `validate:"required"`
-	BoxNumber         int64     `validate:"min=0"`
-	ShippingAddressID string    `validate:"required_without=Pickup"`
-	Pickup            bool      `validate:""`
-	PickupAt          time.Time `validate:"required_with=Pickup"`
-	PickupLocation    string    `validate:"required_with=Pickup"`
+	CoordinatorID      string                    `validate:"required"`
+	BoxNumber          int64                     `validate:"min=0"`
+	ShippingAddressID  string                    `validate:"required_without_all=Pickup Destinations"`
+	Pickup             bool                      `validate:""`
+	PickupAt           time.Time                 `validate:"required_with=Pickup"`
+	PickupLocation     string                    `validate:"required_with=Pickup"`
+	DeliveryDate       string                    `validate:"omitempty,date"`
+	DeliveryTimeWindow entity.DeliveryTimeWindow `validate:"min=0,max=6"`
+	Gift               bool                      `validate:"excluded_with=Pickup"`
+	NoshiType          entity.NoshiType          `validate:"min=0,max=6"`
+	NoshiName          string                    `validate:"max=32"`
+	MessageCard        string                    `validate:"max=200"`
+	Destinations       []*CheckoutDestination    `validate:"excluded_with=Pickup,omitempty,max=10,dive,required"`
+}
+
+type CheckoutDestination struct {
+	ShippingAddressID string                     `validate:"required"`
+	Items             []*CheckoutDestinationItem `validate:"min=1,dive,required"`
+}
+
+type CheckoutDestinationItem struct {
+	ProductID string `validate:"required"`
+	Quantity  int64  `validate:"min=1"`
 }
 
 type CheckoutExperienceDetail struct {
 	ExperienceID          string `validate:"required"`
+	SlotID                string `validate:""`
 	AdultCount            int64  `validate:"min=0,max=99"`
 	JuniorHighSchoolCount int64  `validate:"min=0,max=99"`
 	ElementarySchoolCount int64  `validate:"min=0,max=99"`
@@ -199,6 +221,28 @@ type NotifyPaymentPayload struct {
 	Status    entity.PaymentStatus `validate:"required"`
 }
 
+/**
+ * DeliverySlotRule - 配送日時指定ルール
+ */
+type ListDeliverySlotRulesInput struct {
+	ShopID string `validate:"required"`
+}
+
+type UpsertDeliverySlotRuleInput struct {
+	ShopID         string                      `validate:"required"`
+	CoordinatorID  string                      `validate:"required"`
+	ShippingType   entity.ShippingType         `validate:"required,oneof=1 2"`
+	LeadDays       int64                       `validate:"min=0,max=30"`
+	SelectableDays int64                       `validate:"min=0,max=90"`
+	BlackoutDates  []string                    `validate:"max=366,dive,date"`
+	TimeWindows    []entity.DeliveryTimeWindow `validate:"max=6,unique,dive,min=1,max=6"`
+}
+
+type DeleteDeliverySlotRuleInput struct {
+	ShopID       string              `validate:"required"`
+	ShippingType entity.ShippingType `validate:"required,oneof=1 2"`
+}
+
 /**
  * Experience - 体験
  */
@@ -385,6 +429,36 @@ type GetUserExperienceReviewReactionsInput struct {
 	UserID       string `validate:"required"`
 }
 
+/**
+ * ExperienceSlot - 体験枠
+ */
+type ListExperienceSlotsInput struct {
+	ExperienceID  string `validate:"required"`
+	OnlyAccepting bool   `validate:""`
+}
+
+type GetExperienceSlotInput struct {
+	ExperienceSlotID string `validate:"required"`
+}
+
+type CreateExperienceSlotInput struct {
+	ExperienceID string    `validate:"required"`
+	Capacity     int64     `validate:"min=1"`
+	StartAt      time.Time `validate:"required"`
+	EndAt        time.Time `validate:"required,gtfield=StartAt"`
+}
+
+type UpdateExperienceSlotInput struct {
+	ExperienceSlotID string    `validate:"required"`
+	Capacity         int64     `validate:"min=1"`
+	StartAt          time.Time `validate:"required"`
+	EndAt            time.Time `validate:"required,gtfield=StartAt"`
+}
+
+type DeleteExperienceSlotInput struct {
+	ExperienceSlotID string `validate:"required"`
+}
+
 /**
  * ExperienceType - 体験種別
  */
@@ -452,16 +526,33 @@ type DeleteLiveInput struct {
 	LiveID string `validate:"required"`
 }
 
+type ListLivePinsInput struct {
+	ScheduleID   string    `validate:"required"`
+	UpdatedAtGte time.Time `validate:""`
+}
+
+type PinLiveProductInput struct {
+	ScheduleID string `validate:"required"`
+	ProductID  string `validate:"required"`
+	AdminID    string `validate:"required"`
+}
+
+type UnpinLiveProductInput struct {
+	ScheduleID string `validate:"required"`
+	LivePinID  string `validate:"required"`
+}
+
 /**
  * Order - 注文履歴
  */
 type ListOrdersInput struct {
-	ShopID   string               `validate:""`
-	UserID   string               `validate:""`
-	Types    []entity.OrderType   `validate:""`
-	Statuses []entity.OrderStatus `validate:""`
-	Limit    int64                `validate:"required,max=200"`
-	Offset   int64                `validate:"min=0"`
+	ShopID          string               `validate:""`
+	UserID          string               `validate:""`
+	PreorderBatchID string               `validate:""`
+	Types           []entity.OrderType   `validate:""`
+	Statuses        []entity.OrderStatus `validate:""`
+	Limit           int64                `validate:"required,max=200"`
+	Offset          int64                `validate:"min=0"`
 }
 
 type ListOrderUserIDsInput struct {
@@ -502,14 +593,29 @@ type CancelOrderInput struct {
 }
 
 type RefundOrderInput struct {
-	OrderID     string `validate:"required"`
-	Description string `validate:"required"`
+	OrderID     string             `validate:"required"`
+	Description string             `validate:"required"`
+	Amount      int64              `validate:"min=0"`
+	Items       []*RefundOrderItem `validate:"dive,required"`
+}
+
+type RefundOrderItem struct {
+	ProductRevisionID int64 `validate:"required"`
+	Quantity          int64 `validate:"min=1"`
+}
+
+type ListOrderRefundLinesInput struct {
+	OrderID string `validate:"required"`
+}
+
+type GetOrderRefundLineInput struct {
+	RefundLineID string `validate:"required"`
 }
 
 type UpdateOrderFulfillmentInput struct {
 	OrderID         string                 `validate:"required"`
 	FulfillmentID   string                 `validate:"required"`
-	ShippingCarrier entity.ShippingCarrier `validate:"required,oneof=1 2"`
+	ShippingCarrier entity.ShippingCarrier `validate:"required,oneof=1 2 3"`
 	TrackingNumber  string                 `validate:"required"`
 }
 
@@ -530,6 +636,13 @@ type AggregateOrdersByPaymentMethodTypeInput struct {
 	CreatedAtLt  time.Time `validate:""`
 }
 
+type AggregateOrdersByProductInput struct {
+	ShopID       string    `validate:""`
+	ProductIDs   []string  `validate:"min=1,dive,required"`
+	CreatedAtGte time.Time `validate:""`
+	CreatedAtLt  time.Time `validate:""`
+}
+
 type AggregateOrdersByPromotionInput struct {
 	ShopID       string   `validate:""`
 	PromotionIDs []string `validate:"dive,required"`
@@ -544,10 +657,117 @@ type AggregateOrdersByPeriodInput struct {
 
 type ExportOrdersInput struct {
 	ShopID          string                      `validate:""`
-	ShippingCarrier entity.ShippingCarrier      `validate:"oneof=0 1 2"`
+	PreorderBatchID string                      `validate:""`
+	ShippingCarrier entity.ShippingCarrier      `validate:"oneof=0 1 2 3"`
+	LabelFormat     entity.ShippingLabelFormat  `validate:"oneof=0 1"`
 	EncodingType    codes.CharacterEncodingType `validate:"oneof=0 1"`
 }
 
+type ExportOrderInvoiceInput struct {
+	OrderID string `validate:"required"`
+	UserID  string `validate:""`
+}
+
+/**
+ * OrderClaim - 返品・交換申請
+ */
+type ListOrderClaimsInput struct {
+	ShopID   string                    `validate:""`
+	OrderID  string                    `validate:""`
+	UserID   string                    `validate:""`
+	Statuses []entity.OrderClaimStatus `validate:""`
+	Limit    int64                     `validate:"required,max=200"`
+	Offset   int64                     `validate:"min=0"`
+}
+
+type GetOrderClaimInput struct {
+	ClaimID string `validate:"required"`
+}
+
+type CreateOrderClaimInput struct {
+	OrderID     string                  `validate:"required"`
+	UserID      string                  `validate:"required"`
+	Type        entity.OrderClaimType   `validate:"required,oneof=1 2 3 4 5"`
+	Description string                  `validate:"required,max=2000"`
+	Items       []*CreateOrderClaimItem `validate:"min=1,dive,required"`
+	ImageURLs   []string                `validate:"max=5,dive,url"`
+}
+
+type CreateOrderClaimItem struct {
+	ProductID string `validate:"required"`
+	Quantity  int64  `validate:"min=1"`
+}
+
+type ApproveOrderClaimInput struct {
+	ClaimID string `validate:"required"`
+	AdminID string `validate:"required"`
+	Comment string `validate:"max=2000"`
+}
+
+type RejectOrderClaimInput struct {
+	ClaimID string `validate:"required"`
+	AdminID string `validate:"required"`
+	Comment string `validate:"required,max=2000"`
+}
+
+type ResolveOrderClaimInput struct {
+	ClaimID        string                          `validate:"required"`
+	ResolutionType entity.OrderClaimResolutionType `validate:"required,oneof=1 2 3"`
+	Note           string                          `validate:"max=2000"`
+	CouponAmount   int64                           `validate:"required_if=ResolutionType 3,min=0"`
+}
+
+/**
+ * OrderTracking - 配送状況
+ */
+type ImportOrderTrackingsInput struct {
+	ShopID          string                      `validate:""`
+	ShippingCarrier entity.ShippingCarrier      `validate:"required,oneof=1 2 3"`
+	EncodingType    codes.CharacterEncodingType `validate:"oneof=0 1"`
+	File            io.Reader                   `validate:"required"`
+}
+
+type SyncOrderDeliveriesInput struct {
+	ShippingCarrier entity.ShippingCarrier `validate:"required,oneof=1 2 3"`
+	ShippedAtGte    time.Time              `validate:"required"`
+}
+
+/**
+ * OrderShipment - 送り状発行結果
+ */
+type ImportOrderShipmentsInput struct {
+	ShopID          string                      `validate:""`
+	ShippingCarrier entity.ShippingCarrier      `validate:"required,oneof=1 2"`
+	EncodingType    codes.CharacterEncodingType `validate:"oneof=0 1"`
+	File            io.Reader                   `validate:"required"`
+}
+
+/**
+ * PaymentEvent - 決済イベント
+ */
+type ReceivePaymentEventInput struct {
+	ProviderType      entity.PaymentProviderType `validate:"required,oneof=1 2"`
+	EventType         string                     `validate:"max=256"`
+	Payload           []byte                     `validate:"required"`
+	SignatureVerified bool                       `validate:""`
+	SignatureRequired bool                       `validate:""`
+}
+
+type ListPaymentEventsInput struct {
+	ProviderType entity.PaymentProviderType  `validate:"oneof=0 1 2"`
+	Statuses     []entity.PaymentEventStatus `validate:"dive,oneof=1 2 3 4 5 6"`
+	Limit        int64                       `validate:"required,max=200"`
+	Offset       int64                       `validate:"min=0"`
+}
+
+type GetPaymentEventInput struct {
+	PaymentEventID string `validate:"required"`
+}
+
+type ReplayPaymentEventInput struct {
+	PaymentEventID string `validate:"required"`
+}
+
 /**
  * PaymentSystem - 決済システム
  */
@@ -563,8 +783,29 @@ type UpdatePaymentStatusInput struct {
 	MethodType   entity.PaymentMethodType   `validate:"required"`
 	Status       entity.PaymentSystemStatus `validate:"required"`
 	ProviderType entity.PaymentProviderType `validate:"required"`
+	FeeRate      float64                    `validate:"min=0,max=100"`
+}
+
+/**
+ * PreorderBatch - 予約注文バッチ
+ */
+type ListPreorderBatchesInput struct {
+	ShopID   string                       `validate:""`
+	Statuses []entity.PreorderBatchStatus `validate:""`
+	Limit    int64                        `validate:"required,max=200"`
+	Offset   int64                        `validate:"min=0"`
+}
+
+type GetPreorderBatchInput struct {
+	PreorderBatchID string `validate:"required"`
 }
 
+type ReadyPreorderBatchInput struct {
+	PreorderBatchID string `validate:"required"`
+}
+
+type CaptureExpiringPreordersInput struct{}
+
 /**
  * PostalCode - 郵便番号
  */
@@ -623,35 +864,44 @@ type GetProductInput struct {
 	ProductID string `validate:"required"`
 }
 
+type GetProductInventoryInput struct {
+	ProductID string `validate:"required"`
+}
+
+type ReleaseExpiredProductInventoryHoldsInput struct{}
+
 type CreateProductInput struct {
-	ShopID               string                   `validate:"required"`
-	CoordinatorID        string                   `validate:"required"`
-	ProducerID           string                   `validate:"required"`
-	TypeID               string                   `validate:"required"`
-	TagIDs               []string                 `validate:"max=8,dive,required"`
-	Name                 string                   `validate:"required,max=128"`
-	Description          string                   `validate:"required,max=20000"`
-	Scope                entity.ProductScope      `validate:""`
-	Inventory            int64                    `validate:"min=0"`
-	Weight               int64                    `validate:"min=0"`
-	WeightUnit           entity.WeightUnit        `validate:"required,oneof=1 2"`
-	Item                 int64                    `validate:"min=1"`
-	ItemUnit             string                   `validate:"required,max=16"`
-	ItemDescription      string                   `validate:"required,max=64"`
-	Media                []*CreateProductMedia    `validate:"max=8,unique=URL"`
-	Price                int64                    `validate:"min=0"`
-	Cost                 int64                    `validate:"min=0"`
-	ExpirationDate       int64                    `validate:"min=0"`
-	RecommendedPoints    []string                 `validate:"max=3,dive,max=128"`
-	StorageMethodType    entity.StorageMethodType `validate:"required,oneof=1 2 3 4"`
-	DeliveryType         entity.DeliveryType      `validate:"required,oneof=1 2 3"`
-	Box60Rate            int64                    `validate:"min=0,max=600"`
-	Box80Rate            int64                    `validate:"min=0,max=250"`
-	Box100Rate           int64                    `validate:"min=0,max=100"`
-	OriginPrefectureCode int32                    `validate:"required"`
-	OriginCity           string                   `validate:"max=32"`
-	StartAt              time.Time                `validate:"required"`
-	EndAt                time.Time                `validate:"required,gtfield=StartAt"`
+	ShopID                  string                   `validate:"required"`
+	CoordinatorID           string                   `validate:"required"`
+	ProducerID              string                   `validate:"required"`
+	TypeID                  string                   `validate:"required"`
+	TagIDs                  []string                 `validate:"max=8,dive,required"`
+	Name                    string                   `validate:"required,max=128"`
+	Description             string                   `validate:"required,max=20000"`
+	Scope                   entity.ProductScope      `validate:""`
+	Inventory               int64                    `validate:"min=0"`
+	Weight                  int64                    `validate:"min=0"`
+	WeightUnit              entity.WeightUnit        `validate:"required,oneof=1 2"`
+	Item                    int64                    `validate:"min=1"`
+	ItemUnit                string                   `validate:"required,max=16"`
+	ItemDescription         string                   `validate:"required,max=64"`
+	Media                   []*CreateProductMedia    `validate:"max=8,unique=URL"`
+	Price                   int64                    `validate:"min=0"`
+	Cost                    int64                    `validate:"min=0"`
+	TaxCategory             entity.TaxCategory       `validate:"omitempty,oneof=1 2"`
+	ExpirationDate          int64                    `validate:"min=0"`
+	RecommendedPoints       []string                 `validate:"max=3,dive,max=128"`
+	StorageMethodType       entity.StorageMethodType `validate:"required,oneof=1 2 3 4"`
+	DeliveryType            entity.DeliveryType      `validate:"required,oneof=1 2 3"`
+	Box60Rate               int64                    `validate:"min=0,max=2400"`
+	Box80Rate               int64                    `validate:"min=0,max=1000"`
+	Box100Rate              int64                    `validate:"min=0,max=400"`
+	OriginPrefectureCode    int32                    `validate:"required"`
+	OriginCity              string                   `validate:"max=32"`
+	StartAt                 time.Time                `validate:"required"`
+	EndAt                   time.Time                `validate:"required,gtfield=StartAt"`
+	ExpectedShippingStartAt time.Time                `validate:"required_with=ExpectedShippingEndAt"`
+	ExpectedShippingEndAt   time.Time                `validate:"required_with=ExpectedShippingStartAt,omitempty,gtefield=ExpectedShippingStartAt"`
 }
 
 type CreateProductMedia struct {
@@ -660,32 +910,35 @@ type CreateProductMedia struct {
 }
 
 type UpdateProductInput struct {
-	ProductID            string                   `validate:"required"`
-	TypeID               string                   `validate:"required"`
-	TagIDs               []string                 `validate:"max=8,dive,required"`
-	Name                 string                   `validate:"required,max=128"`
-	Description          string                   `validate:"required,max=20000"`
-	Scope                entity.ProductScope      `validate:""`
-	Inventory            int64                    `validate:"min=0"`
-	Weight               int64                    `validate:"min=0"`
-	WeightUnit           entity.WeightUnit        `validate:"required,oneof=1 2"`
-	Item                 int64                    `validate:"min=1"`
-	ItemUnit             string                   `validate:"required,max=16"`
-	ItemDescription      string                   `validate:"required,max=64"`
-	Media                []*UpdateProductMedia    `validate:"max=8,unique=URL"`
-	Price                int64                    `validate:"min=0"`
-	Cost                 int64                    `validate:"min=0"`
-	ExpirationDate       int64                    `validate:"min=0"`
-	RecommendedPoints    []string                 `validate:"max=3,dive,max=128"`
-	StorageMethodType    entity.StorageMethodType `validate:"required,oneof=1 2 3 4"`
-	DeliveryType         entity.DeliveryType      `validate:"required,oneof=1 2 3"`
-	Box60Rate            int64                    `validate:"min=0,max=600"`
-	Box80Rate            int64                    `validate:"min=0,max=250"`
-	Box100Rate           int64                    `validate:"min=0,max=100"`
-	OriginPrefectureCode int32                    `validate:"required"`
-	OriginCity           string                   `validate:"max=32"`
-	StartAt              time.Time                `validate:"required"`
-	EndAt                time.Time                `validate:"required,gtfield=StartAt"`
+	ProductID               string                   `validate:"required"`
+	TypeID                  string                   `validate:"required"`
+	TagIDs                  []string                 `validate:"max=8,dive,required"`
+	Name                    string                   `validate:"required,max=128"`
+	Description             string                   `validate:"required,max=20000"`
+	Scope                   entity.ProductScope      `validate:""`
+	Inventory               int64                    `validate:"min=0"`
+	Weight                  int64                    `validate:"min=0"`
+	WeightUnit              entity.WeightUnit        `validate:"required,oneof=1 2"`
+	Item                    int64                    `validate:"min=1"`
+	ItemUnit                string                   `validate:"required,max=16"`
+	ItemDescription         string                   `validate:"required,max=64"`
+	Media                   []*UpdateProductMedia    `validate:"max=8,unique=URL"`
+	Price                   int64                    `validate:"min=0"`
+	Cost                    int64                    `validate:"min=0"`
+	TaxCategory             entity.TaxCategory       `validate:"omitempty,oneof=1 2"`
+	ExpirationDate          int64                    `validate:"min=0"`
+	RecommendedPoints       []string                 `validate:"max=3,dive,max=128"`
+	StorageMethodType       entity.StorageMethodType `validate:"required,oneof=1 2 3 4"`
+	DeliveryType            entity.DeliveryType      `validate:"required,oneof=1 2 3"`
+	Box60Rate               int64                    `validate:"min=0,max=2400"`
+	Box80Rate               int64                    `validate:"min=0,max=1000"`
+	Box100Rate              int64                    `validate:"min=0,max=400"`
+	OriginPrefectureCode    int32                    `validate:"required"`
+	OriginCity              string                   `validate:"max=32"`
+	StartAt                 time.Time                `validate:"required"`
+	EndAt                   time.Time                `validate:"required,gtfield=StartAt"`
+	ExpectedShippingStartAt time.Time                `validate:"required_with=ExpectedShippingEndAt"`
+	ExpectedShippingEndAt   time.Time                `validate:"required_with=ExpectedShippingStartAt,omitempty,gtefield=ExpectedShippingStartAt"`
 }
 
 type UpdateProductMedia struct {
@@ -889,40 +1142,106 @@ type GetPromotionInput struct {
 type GetPromotionByCodeInput struct {
 	PromotionCode string `validate:"required"`
 	ShopID        string `validate:""`
+	UserID        string `validate:""`
 	OnlyEnabled   bool   `validate:""`
 }
 
 type CreatePromotionInput struct {
-	AdminID      string                   `validate:"required"`
-	Title        string                   `validate:"required,max=64"`
-	Description  string                   `validate:"required,max=2000"`
-	Public       bool                     `validate:""`
-	DiscountType entity.DiscountType      `validate:"required,oneof=1 2 3"`
-	DiscountRate int64                    `validate:"min=0"`
-	Code         string                   `validate:"len=8"`
-	CodeType     entity.PromotionCodeType `validate:"required,oneof=1 2"`
-	StartAt      time.Time                `validate:"required"`
-	EndAt        time.Time                `validate:"required,gtfield=StartAt"`
+	AdminID           string                   `validate:"required"`
+	Title             string                   `validate:"required,max=64"`
+	Description       string                   `validate:"required,max=2000"`
+	Public            bool                     `validate:""`
+	DiscountType      entity.DiscountType      `validate:"required,oneof=1 2 3 4"`
+	DiscountRate      int64                    `validate:"min=0"`
+	Rules             []*PromotionRule         `validate:"dive,required"`
+	Code              string                   `validate:"len=8"`
+	CodeType          entity.PromotionCodeType `validate:"required,oneof=1 2"`
+	UsageLimit        int64                    `validate:"min=0"`
+	UsageLimitPerUser int64                    `validate:"min=0"`
+	FirstPurchaseOnly bool                     `validate:""`
+	StartAt           time.Time                `validate:"required"`
+	EndAt             time.Time                `validate:"required,gtfield=StartAt"`
 }
 
 type UpdatePromotionInput struct {
-	PromotionID  string                   `validate:"required"`
-	AdminID      string                   `validate:"required"`
-	Title        string                   `validate:"required,max=64"`
-	Description  string                   `validate:"required,max=2000"`
-	Public       bool                     `validate:""`
-	DiscountType entity.DiscountType      `validate:"required,oneof=1 2 3"`
-	DiscountRate int64                    `validate:"min=0"`
-	Code         string                   `validate:"len=8"`
-	CodeType     entity.PromotionCodeType `validate:"required,oneof=1 2"`
-	StartAt      time.Time                `validate:"required"`
-	EndAt        time.Time                `validate:"required,gtfield=StartAt"`
+	PromotionID       string                   `validate:"required"`
+	AdminID           string                   `validate:"required"`
+	Title             string                   `validate:"required,max=64"`
+	Description       string                   `validate:"required,max=2000"`
+	Public            bool                     `validate:""`
+	DiscountType      entity.DiscountType      `validate:"required,oneof=1 2 3 4"`
+	DiscountRate      int64                    `validate:"min=0"`
+	Rules             []*PromotionRule         `validate:"dive,required"`
+	Code              string                   `validate:"len=8"`
+	CodeType          entity.PromotionCodeType `validate:"required,oneof=1 2"`
+	UsageLimit        int64                    `validate:"min=0"`
+	UsageLimitPerUser int64                    `validate:"min=0"`
+	FirstPurchaseOnly bool                     `validate:""`
+	StartAt           time.Time                `validate:"required"`
+	EndAt             time.Time                `validate:"required,gtfield=StartAt"`
 }
 
 type DeletePromotionInput struct {
 	PromotionID string `validate:"required"`
 }
 
+type AggregatePromotionRedemptionsInput struct {
+	PromotionIDs []string `validate:"dive,required"`
+}
+
+type PromotionRule struct {
+	Type           entity.PromotionRuleType `validate:"required,oneof=1 2 3 4"`
+	ProductIDs     []string                 `validate:"dive,required"`
+	ProductTypeIDs []string                 `validate:"dive,required"`
+	CategoryIDs    []string                 `validate:"dive,required"`
+	MinimumAmount  int64                    `validate:"min=0"`
+	DiscountRate   int64                    `validate:"min=0"`
+	Tiers          []*PromotionRuleTier     `validate:"dive,required"`
+	BuyQuantity    int64                    `validate:"min=0"`
+	FreeQuantity   int64                    `validate:"min=0"`
+	MaxDiscount    int64                    `validate:"min=0"`
+}
+
+type PromotionRuleTier struct {
+	Threshold int64 `validate:"min=0"`
+	Rate      int64 `validate:"min=1,max=100"`
+}
+
+/**
+ * PromotionCode - クーポンコード(一括発行)
+ */
+type ListPromotionCodeBatchesInput struct {
+	PromotionID string `validate:"required"`
+	Limit       int64  `validate:"required,max=200"`
+	Offset      int64  `validate:"min=0"`
+}
+
+type GetPromotionCodeBatchInput struct {
+	BatchID string `validate:"required"`
+}
+
+type CreatePromotionCodeBatchInput struct {
+	PromotionID string `validate:"required"`
+	Title       string `validate:"required,max=64"`
+	Quantity    int64  `validate:"min=1,max=10000"`
+}
+
+type VoidPromotionCodeBatchInput struct {
+	BatchID string `validate:"required"`
+}
+
+type ListPromotionCodesInput struct {
+	BatchID  string                       `validate:"required"`
+	Statuses []entity.PromotionCodeStatus `validate:"dive,oneof=1 2 3 4"`
+	Limit    int64                        `validate:"required,max=200"`
+	Offset   int64                        `validate:"min=0"`
+}
+
+type ExportPromotionCodesInput struct {
+	BatchID      string                      `validate:"required"`
+	EncodingType codes.CharacterEncodingType `validate:"oneof=0 1"`
+}
+
 /**
  * Schedule - マルシェ開催スケジュール
  */
@@ -987,6 +1306,46 @@ type PublishScheduleInput struct {
 	Public     bool   `validate:""`
 }
 
+/**
+ * SettlementStatement - 精算明細
+ */
+type ListSettlementStatementsInput struct {
+	PayeeType     entity.SettlementPayeeType         `validate:"oneof=0 1 2 3"`
+	PayeeID       string                             `validate:""`
+	PeriodStartAt time.Time                          `validate:""`
+	Statuses      []entity.SettlementStatementStatus `validate:"dive,oneof=1 2"`
+	Limit         int64                              `validate:"required,max=200"`
+	Offset        int64                              `validate:"min=0"`
+}
+
+type GetSettlementStatementInput struct {
+	SettlementStatementID string `validate:"required"`
+}
+
+type CloseSettlementPeriodInput struct {
+	PeriodStartAt time.Time `validate:"required"`
+	PeriodEndAt   time.Time `validate:"required,gtfield=PeriodStartAt"`
+}
+
+type PaySettlementStatementInput struct {
+	SettlementStatementID string `validate:"required"`
+}
+
+type AggregateSettlementBalancesInput struct {
+	PayeeType entity.SettlementPayeeType `validate:"oneof=0 1 2 3"`
+	PayeeID   string                     `validate:""`
+}
+
+type ExportSettlementStatementsInput struct {
+	PeriodStartAt time.Time                   `validate:"required"`
+	PayeeType     entity.SettlementPayeeType  `validate:"oneof=0 1 2 3"`
+	EncodingType  codes.CharacterEncodingType `validate:"oneof=0 1"`
+}
+
+type ExportSettlementStatementInput struct {
+	SettlementStatementID string `validate:"required"`
+}
+
 /**
  * Shipping - 配送設定
  */
@@ -1023,18 +1382,25 @@ type GetShippingByCoordinatorIDInput struct {
 }
 
 type CreateShippingInput struct {
-	ShopID            string                `validate:"required"`
-	CoordinatorID     string                `validate:"required"`
-	Name              string                `validate:"required,max=64"`
-	Box60Rates        []*CreateShippingRate `validate:"required,dive,required"`
-	Box60Frozen       int64                 `validate:"min=0,lt=10000000000"`
-	Box80Rates        []*CreateShippingRate `validate:"required,dive,required"`
-	Box80Frozen       int64                 `validate:"min=0,lt=10000000000"`
-	Box100Rates       []*CreateShippingRate `validate:"required,dive,required"`
-	Box100Frozen      int64                 `validate:"min=0,lt=10000000000"`
-	HasFreeShipping   bool                  `validate:""`
-	FreeShippingRates int64                 `validate:"min=0,lt=10000000000"`
-	InUse             bool                  `validate:""`
+	ShopID            string                      `validate:"required"`
+	CoordinatorID     string                      `validate:"required"`
+	Name              string                      `validate:"required,max=64"`
+	Box60Rates        []*CreateShippingRate       `validate:"required,dive,required"`
+	Box60Frozen       int64                       `validate:"min=0,lt=10000000000"`
+	Box80Rates        []*CreateShippingRate       `validate:"required,dive,required"`
+	Box80Frozen       int64                       `validate:"min=0,lt=10000000000"`
+	Box100Rates       []*CreateShippingRate       `validate:"required,dive,required"`
+	Box100Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	Box120Rates       []*CreateShippingRate       `validate:"omitempty,dive,required"`
+	Box120Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	Box140Rates       []*CreateShippingRate       `validate:"omitempty,dive,required"`
+	Box140Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	Box160Rates       []*CreateShippingRate       `validate:"omitempty,dive,required"`
+	Box160Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	RegionRules       []*CreateShippingRegionRule `validate:"max=100,dive,required"`
+	HasFreeShipping   bool                        `validate:""`
+	FreeShippingRates int64                       `validate:"min=0,lt=10000000000"`
+	InUse             bool                        `validate:""`
 }
 
 type CreateShippingRate struct {
@@ -1043,17 +1409,32 @@ type CreateShippingRate struct {
 	PrefectureCodes []int32 `validate:"required"`
 }
 
+type CreateShippingRegionRule struct {
+	Name           string                `validate:"required,max=64"`
+	PostalCodeFrom string                `validate:"required,max=16"`
+	PostalCodeTo   string                `validate:"omitempty,max=16"`
+	Surcharge      int64                 `validate:"min=0,lt=10000000000"`
+	ExcludedTypes  []entity.ShippingType `validate:"dive,oneof=1 2"`
+}
+
 type UpdateShippingInput struct {
-	ShippingID        string                `validate:"required"`
-	Name              string                `validate:"required,max=64"`
-	Box60Rates        []*UpdateShippingRate `validate:"required,dive,required"`
-	Box60Frozen       int64                 `validate:"min=0,lt=10000000000"`
-	Box80Rates        []*UpdateShippingRate `validate:"required,dive,required"`
-	Box80Frozen       int64                 `validate:"min=0,lt=10000000000"`
-	Box100Rates       []*UpdateShippingRate `validate:"required,dive,required"`
-	Box100Frozen      int64                 `validate:"min=0,lt=10000000000"`
-	HasFreeShipping   bool                  `validate:""`
-	FreeShippingRates int64                 `validate:"min=0,lt=10000000000"`
+	ShippingID        string                      `validate:"required"`
+	Name              string                      `validate:"required,max=64"`
+	Box60Rates        []*UpdateShippingRate       `validate:"required,dive,required"`
+	Box60Frozen       int64                       `validate:"min=0,lt=10000000000"`
+	Box80Rates        []*UpdateShippingRate       `validate:"required,dive,required"`
+	Box80Frozen       int64                       `validate:"min=0,lt=10000000000"`
+	Box100Rates       []*UpdateShippingRate       `validate:"required,dive,required"`
+	Box100Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	Box120Rates       []*UpdateShippingRate       `validate:"omitempty,dive,required"`
+	Box120Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	Box140Rates       []*UpdateShippingRate       `validate:"omitempty,dive,required"`
+	Box140Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	Box160Rates       []*UpdateShippingRate       `validate:"omitempty,dive,required"`
+	Box160Frozen      int64                       `validate:"min=0,lt=10000000000"`
+	RegionRules       []*UpdateShippingRegionRule `validate:"max=100,dive,required"`
+	HasFreeShipping   bool                        `validate:""`
+	FreeShippingRates int64                       `validate:"min=0,lt=10000000000"`
 }
 
 type UpdateShippingRate struct {
@@ -1062,20 +1443,35 @@ type UpdateShippingRate struct {
 	PrefectureCodes []int32 `validate:"required"`
 }
 
+type UpdateShippingRegionRule struct {
+	Name           string                `validate:"required,max=64"`
+	PostalCodeFrom string                `validate:"required,max=16"`
+	PostalCodeTo   string                `validate:"omitempty,max=16"`
+	Surcharge      int64                 `validate:"min=0,lt=10000000000"`
+	ExcludedTypes  []entity.ShippingType `validate:"dive,oneof=1 2"`
+}
+
 type UpdateShippingInUseInput struct {
 	ShopID     string `validate:"required"`
 	ShippingID string `validate:"required"`
 }
 
 type UpdateDefaultShippingInput struct {
-	Box60Rates        []*UpdateDefaultShippingRate `validate:"required,dive,required"`
-	Box60Frozen       int64                        `validate:"min=0,lt=10000000000"`
-	Box80Rates        []*UpdateDefaultShippingRate `validate:"required,dive,required"`
-	Box80Frozen       int64                        `validate:"min=0,lt=10000000000"`
-	Box100Rates       []*UpdateDefaultShippingRate `validate:"required,dive,required"`
-	Box100Frozen      int64                        `validate:"min=0,lt=10000000000"`
-	HasFreeShipping   bool                         `validate:""`
-	FreeShippingRates int64                        `validate:"min=0,lt=10000000000"`
+	Box60Rates        []*UpdateDefaultShippingRate       `validate:"required,dive,required"`
+	Box60Frozen       int64                              `validate:"min=0,lt=10000000000"`
+	Box80Rates        []*UpdateDefaultShippingRate       `validate:"required,dive,required"`
+	Box80Frozen       int64                              `validate:"min=0,lt=10000000000"`
+	Box100Rates       []*UpdateDefaultShippingRate       `validate:"required,dive,required"`
+	Box100Frozen      int64                              `validate:"min=0,lt=10000000000"`
+	Box120Rates       []*UpdateDefaultShippingRate       `validate:"omitempty,dive,required"`
+	Box120Frozen      int64                              `validate:"min=0,lt=10000000000"`
+	Box140Rates       []*UpdateDefaultShippingRate       `validate:"omitempty,dive,required"`
+	Box140Frozen      int64                              `validate:"min=0,lt=10000000000"`
+	Box160Rates       []*UpdateDefaultShippingRate       `validate:"omitempty,dive,required"`
+	Box160Frozen      int64                              `validate:"min=0,lt=10000000000"`
+	RegionRules       []*UpdateDefaultShippingRegionRule `validate:"max=100,dive,required"`
+	HasFreeShipping   bool                               `validate:""`
+	FreeShippingRates int64                              `validate:"min=0,lt=10000000000"`
 }
 
 type UpdateDefaultShippingRate struct {
@@ -1084,6 +1480,14 @@ type UpdateDefaultShippingRate struct {
 	PrefectureCodes []int32 `validate:"required"`
 }
 
+type UpdateDefaultShippingRegionRule struct {
+	Name           string                `validate:"required,max=64"`
+	PostalCodeFrom string                `validate:"required,max=16"`
+	PostalCodeTo   string                `validate:"omitempty,max=16"`
+	Surcharge      int64                 `validate:"min=0,lt=10000000000"`
+	ExcludedTypes  []entity.ShippingType `validate:"dive,oneof=1 2"`
+}
+
 type DeleteShippingInput struct {
 	ShippingID string `validate:"required"`
 }
@@ -1184,6 +1588,61 @@ type DeleteSpotTypeInput struct {
 	SpotTypeID string `validate:"required"`
 }
 
+/**
+ * Subscription - 定期便
+ */
+type ListSubscriptionsInput struct {
+	UserID       string                      `validate:""`
+	ShopID       string                      `validate:""`
+	Statuses     []entity.SubscriptionStatus `validate:""`
+	NextRunUntil time.Time                   `validate:""`
+	Limit        int64                       `validate:"max=200"`
+	Offset       int64                       `validate:"min=0"`
+}
+
+type GetSubscriptionInput struct {
+	SubscriptionID string `validate:"required"`
+	UserID         string `validate:""`
+}
+
+type CreateSubscriptionInput struct {
+	UserID          string                      `validate:"required"`
+	Interval        entity.SubscriptionInterval `validate:"required,oneof=1 2 3"`
+	Items           []*CreateSubscriptionItem   `validate:"min=1,max=20,dive,required"`
+	AddressID       string                      `validate:"required"`
+	PaymentMethodID string                      `validate:"required"`
+	StartAt         time.Time                   `validate:"required"`
+}
+
+type CreateSubscriptionItem struct {
+	ProductID string `validate:"required"`
+	Quantity  int64  `validate:"min=1"`
+}
+
+type SkipSubscriptionInput struct {
+	SubscriptionID string `validate:"required"`
+	UserID         string `validate:"required"`
+}
+
+type PauseSubscriptionInput struct {
+	SubscriptionID string `validate:"required"`
+	UserID         string `validate:"required"`
+}
+
+type ResumeSubscriptionInput struct {
+	SubscriptionID string `validate:"required"`
+	UserID         string `validate:"required"`
+}
+
+type CancelSubscriptionInput struct {
+	SubscriptionID string `validate:"required"`
+	UserID         string `validate:"required"`
+}
+
+type RenewSubscriptionInput struct {
+	SubscriptionID string `validate:"required"`
+}
+
 /**
  * AiChat - AIチャット
  */
@@ -0,0 +1,290 @@
+// Package invoice - 適格請求書（インボイス制度）に対応した領収書・請求書の出力
+package invoice
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/pdf"
+)
+
+const (
+	TitleReceipt = "領収書"
+	TitleInvoice = "請求書"
+
+	reducedTaxMark = "※" // 軽減税率対象である旨の記号
+)
+
+// Invoice - 領収書・請求書の記載内容
+type Invoice struct {
+	Title              string                    // 表題（領収書 or 請求書）
+	IssuedAt           time.Time                 // 発行日
+	OrderID            string                    // 注文履歴ID
+	ManagementID       int64                     // 注文管理番号
+	OrderedAt          time.Time                 // 取引年月日
+	RecipientName      string                    // 交付を受ける者の氏名
+	IssuerName         string                    // 発行事業者名
+	RegistrationNumber string                    // 適格請求書発行事業者登録番号
+	PaymentMethod      string                    // 決済手段
+	Items              Items                     // 取引内容
+	Subtotal           int64                     // 購入金額(税込)
+	Discount           int64                     // 割引金額(税込)
+	ShippingFee        int64                     // 配送手数料(税込)
+	Total              int64                     // 合計金額(税込)
+	Breakdown          *entity.OrderTaxBreakdown // 税率ごとの対象金額と消費税額
+}
+
+// Item - 取引内容
+type Item struct {
+	Name     string // 品名
+	Price    int64  // 単価(税込)
+	Quantity int64  // 数量
+	TaxRate  int64  // 適用税率(%)
+}
+
+type Items []*Item
+
+type Params struct {
+	Order      *entity.Order
+	Shop       *uentity.Shop
+	Address    *uentity.Address          // 請求先住所
+	Products   map[int64]*entity.Product // 商品変更履歴ID -> 商品
+	Experience *entity.Experience        // 体験（体験注文の場合）
+	Now        time.Time
+}
+
+func NewInvoice(params *Params) *Invoice {
+	invoice := &Invoice{IssuedAt: params.Now}
+	invoice.SetOrderDetails(params.Order)
+	invoice.SetIssuerDetails(params.Shop)
+	invoice.SetRecipientDetails(params.Address)
+	invoice.SetPaymentDetails(&params.Order.OrderPayment)
+	switch params.Order.Type {
+	case entity.OrderTypeProduct:
+		invoice.SetProductDetails(params.Order.OrderItems, params.Products)
+	case entity.OrderTypeExperience:
+		invoice.SetExperienceDetails(&params.Order.OrderPayment, params.Experience)
+	}
+	return invoice
+}
+
+func (i *Invoice) SetOrderDetails(order *entity.Order) {
+	i.OrderID = order.ID
+	i.ManagementID = order.ManagementID
+}
+
+func (i *Invoice) SetIssuerDetails(shop *uentity.Shop) {
+	if shop == nil {
+		return
+	}
+	i.IssuerName = shop.Name
+	i.RegistrationNumber = shop.InvoiceRegistrationNumber
+}
+
+func (i *Invoice) SetRecipientDetails(address *uentity.Address) {
+	if address == nil {
+		return
+	}
+	i.RecipientName = address.Name()
+}
+
+func (i *Invoice) SetPaymentDetails(payment *entity.OrderPayment) {
+	i.Title = TitleInvoice
+	if !payment.PaidAt.IsZero() {
+		i.Title = TitleReceipt
+	}
+	i.OrderedAt = payment.OrderedAt
+	i.PaymentMethod = payment.MethodType.String()
+	i.Subtotal = payment.Subtotal
+	i.Discount = payment.Discount
+	i.ShippingFee = payment.ShippingFee
+	i.Total = payment.Total
+	i.Breakdown = payment.TaxBreakdown()
+}
+
+// SetProductDetails - 注文商品を商品ごとに集計して取引内容へ設定
+func (i *Invoice) SetProductDetails(items entity.OrderItems, products map[int64]*entity.Product) {
+	quantities := make(map[int64]int64, len(items))
+	rates := make(map[int64]int64, len(items))
+	for _, item := range items {
+		quantities[item.ProductRevisionID] += item.Quantity
+		rates[item.ProductRevisionID] = item.TaxRate
+	}
+	revisionIDs := make([]int64, 0, len(quantities))
+	for revisionID := range quantities {
+		revisionIDs = append(revisionIDs, revisionID)
+	}
+	sort.Slice(revisionIDs, func(a, b int) bool { return revisionIDs[a] < revisionIDs[b] })
+	i.Items = make(Items, 0, len(revisionIDs))
+	for _, revisionID := range revisionIDs {
+		product, ok := products[revisionID]
+		if !ok {
+			continue
+		}
+		rate := rates[revisionID]
+		if rate == 0 {
+			rate = product.TaxCategory.Rate()
+		}
+		i.Items = append(i.Items, &Item{
+			Name:     product.Name,
+			Price:    product.Price,
+			Quantity: quantities[revisionID],
+			TaxRate:  rate,
+		})
+	}
+}
+
+func (i *Invoice) SetExperienceDetails(payment *entity.OrderPayment, experience *entity.Experience) {
+	if experience == nil {
+		return
+	}
+	i.Items = Items{{
+		Name:     experience.Title,
+		Price:    payment.Subtotal,
+		Quantity: 1,
+		TaxRate:  entity.TaxCategoryStandard.Rate(),
+	}}
+}
+
+// IsQualified - 適格請求書の記載事項を満たしているか（登録番号の記載があるか）
+func (i *Invoice) IsQualified() bool {
+	return i.RegistrationNumber != ""
+}
+
+// HasReducedTaxItem - 軽減税率の対象商品を含むか
+func (i *Invoice) HasReducedTaxItem() bool {
+	for _, item := range i.Items {
+		if item.IsReduced() {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Item) IsReduced() bool {
+	return i.TaxRate == entity.TaxCategoryReduced.Rate()
+}
+
+func (i *Item) Amount() int64 {
+	return i.Price * i.Quantity
+}
+
+// Write - PDF形式で出力
+func (i *Invoice) Write(w io.Writer, opts ...pdf.Option) error {
+	const (
+		left   = 48.0
+		right  = pdf.PageWidthA4 - 48.0
+		bottom = pdf.PageHeightA4 - 64.0
+	)
+	doc := pdf.NewDocument(opts...)
+	doc.AddPage()
+
+	y := 64.0
+	doc.Text(left, y, 20, i.Title)
+	doc.TextRight(right, y, 9, "発行日: "+jst.Format(i.IssuedAt, "2006年01月02日"))
+	y += 36
+	doc.Text(left, y, 14, i.RecipientName+" 様")
+	doc.Line(left, y+6, left+240, y+6, 0.8)
+	doc.TextRight(right, y, 11, i.IssuerName)
+	if i.IsQualified() {
+		doc.TextRight(right, y+16, 9, "登録番号: "+i.RegistrationNumber)
+	}
+	y += 44
+	doc.Text(left, y, 14, "合計金額 "+yen(i.Total)+"（税込）")
+	y += 28
+	doc.Text(left, y, 9, "注文番号: "+i.OrderID)
+	if i.ManagementID > 0 {
+		doc.TextRight(right, y, 9, "注文管理番号: "+strconv.FormatInt(i.ManagementID, 10))
+	}
+	y += 14
+	if !i.OrderedAt.IsZero() {
+		doc.Text(left, y, 9, "取引年月日: "+jst.Format(i.OrderedAt, "2006年01月02日"))
+	}
+	if i.PaymentMethod != "" {
+		doc.TextRight(right, y, 9, "お支払い方法: "+i.PaymentMethod)
+	}
+
+	// 取引内容
+	y += 28
+	doc.Text(left, y, 9, "品名")
+	doc.TextRight(right-200, y, 9, "単価")
+	doc.TextRight(right-140, y, 9, "数量")
+	doc.TextRight(right-80, y, 9, "税率")
+	doc.TextRight(right, y, 9, "金額")
+	doc.Line(left, y+5, right, y+5, 0.5)
+	for _, item := range i.Items {
+		if y += 18; y > bottom {
+			doc.AddPage()
+			y = 64
+		}
+		name := item.Name
+		if item.IsReduced() {
+			name += " " + reducedTaxMark
+		}
+		doc.Text(left, y, 9, name)
+		doc.TextRight(right-200, y, 9, yen(item.Price))
+		doc.TextRight(right-140, y, 9, strconv.FormatInt(item.Quantity, 10))
+		doc.TextRight(right-80, y, 9, fmt.Sprintf("%d%%", item.TaxRate))
+		doc.TextRight(right, y, 9, yen(item.Amount()))
+	}
+	if y+160 > bottom {
+		doc.AddPage()
+		y = 64
+	}
+	doc.Line(left, y+6, right, y+6, 0.5)
+
+	// 合計
+	summaries := [][2]string{
+		{"小計", yen(i.Subtotal)},
+		{"配送手数料", yen(i.ShippingFee)},
+		{"割引金額", "-" + yen(i.Discount)},
+		{"合計金額", yen(i.Total)},
+	}
+	for _, s := range summaries {
+		y += 18
+		doc.Text(right-200, y, 9, s[0])
+		doc.TextRight(right, y, 9, s[1])
+	}
+
+	// 税率ごとの内訳
+	y += 28
+	doc.Text(left, y, 9, "税率別内訳")
+	doc.Line(left, y+5, right, y+5, 0.5)
+	breakdowns := [][3]string{
+		{"10%対象", yen(i.Breakdown.StandardTarget), yen(i.Breakdown.StandardTax)},
+		{"8%対象", yen(i.Breakdown.ReducedTarget), yen(i.Breakdown.ReducedTax)},
+	}
+	for _, b := range breakdowns {
+		y += 18
+		doc.Text(left, y, 9, b[0])
+		doc.TextRight(right-140, y, 9, b[1]+"（税込）")
+		doc.TextRight(right, y, 9, "内消費税 "+b[2])
+	}
+	if i.HasReducedTaxItem() {
+		y += 24
+		doc.Text(left, y, 8, reducedTaxMark+"印は軽減税率(8%)対象商品です。")
+	}
+	if i.Title == TitleReceipt {
+		y += 16
+		doc.Text(left, y, 8, "上記の金額を正に領収いたしました。")
+	}
+	return doc.Write(w)
+}
+
+func yen(amount int64) string {
+	str := strconv.FormatInt(amount, 10)
+	sign := ""
+	if amount < 0 {
+		sign, str = "-", str[1:]
+	}
+	for n := len(str) - 3; n > 0; n -= 3 {
+		str = str[:n] + "," + str[n:]
+	}
+	return sign + "¥" + str
+}
@@ -0,0 +1,224 @@
+package invoice
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvoice(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 12, 0, 0, 0)
+	shop := &uentity.Shop{
+		ID:                        "shop-id",
+		Name:                      "&.農園",
+		InvoiceRegistrationNumber: "T1234567890123",
+	}
+	address := &uentity.Address{
+		AddressRevision: uentity.AddressRevision{
+			ID:        1,
+			Lastname:  "&.",
+			Firstname: "購入者",
+		},
+	}
+	products := map[int64]*entity.Product{
+		1: {
+			ID:   "product-id01",
+			Name: "じゃがいも",
+			ProductRevision: entity.ProductRevision{
+				ID:          1,
+				Price:       500,
+				TaxCategory: entity.TaxCategoryReduced,
+			},
+		},
+		2: {
+			ID:   "product-id02",
+			Name: "包丁",
+			ProductRevision: entity.ProductRevision{
+				ID:          2,
+				Price:       1980,
+				TaxCategory: entity.TaxCategoryStandard,
+			},
+		},
+	}
+	tests := []struct {
+		name   string
+		params *Params
+		expect *Invoice
+	}{
+		{
+			name: "product order",
+			params: &Params{
+				Order: &entity.Order{
+					ID:           "order-id",
+					ManagementID: 1,
+					Type:         entity.OrderTypeProduct,
+					OrderPayment: entity.OrderPayment{
+						MethodType:        entity.PaymentMethodTypeCreditCard,
+						Subtotal:          2980,
+						ShippingFee:       500,
+						Tax:               262,
+						StandardTaxTarget: 2480,
+						StandardTax:       225,
+						ReducedTaxTarget:  1000,
+						ReducedTax:        74,
+						Discount:          0,
+						Total:             3480,
+						OrderedAt:         now,
+						PaidAt:            now,
+					},
+					OrderItems: entity.OrderItems{
+						{FulfillmentID: "fulfillment-id01", ProductRevisionID: 2, Quantity: 1, TaxRate: 10},
+						{FulfillmentID: "fulfillment-id01", ProductRevisionID: 1, Quantity: 1, TaxRate: 8},
+						{FulfillmentID: "fulfillment-id02", ProductRevisionID: 1, Quantity: 1, TaxRate: 8},
+					},
+				},
+				Shop:     shop,
+				Address:  address,
+				Products: products,
+				Now:      now,
+			},
+			expect: &Invoice{
+				Title:              TitleReceipt,
+				IssuedAt:           now,
+				OrderID:            "order-id",
+				ManagementID:       1,
+				OrderedAt:          now,
+				RecipientName:      "&. 購入者",
+				IssuerName:         "&.農園",
+				RegistrationNumber: "T1234567890123",
+				PaymentMethod:      "クレジットカード決済",
+				Items: Items{
+					{Name: "じゃがいも", Price: 500, Quantity: 2, TaxRate: 8},
+					{Name: "包丁", Price: 1980, Quantity: 1, TaxRate: 10},
+				},
+				Subtotal:    2980,
+				Discount:    0,
+				ShippingFee: 500,
+				Total:       3480,
+				Breakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 2480,
+					StandardTax:    225,
+					ReducedTarget:  1000,
+					ReducedTax:     74,
+				},
+			},
+		},
+		{
+			name: "experience order without payment",
+			params: &Params{
+				Order: &entity.Order{
+					ID:   "order-id",
+					Type: entity.OrderTypeExperience,
+					OrderPayment: entity.OrderPayment{
+						MethodType: entity.PaymentMethodTypeBankTransfer,
+						Subtotal:   2000,
+						Tax:        181,
+						Total:      2000,
+						OrderedAt:  now,
+					},
+				},
+				Shop:       &uentity.Shop{Name: "&.農園"},
+				Address:    address,
+				Experience: &entity.Experience{Title: "じゃがいも収穫体験"},
+				Now:        now,
+			},
+			expect: &Invoice{
+				Title:         TitleInvoice,
+				IssuedAt:      now,
+				OrderID:       "order-id",
+				OrderedAt:     now,
+				RecipientName: "&. 購入者",
+				IssuerName:    "&.農園",
+				PaymentMethod: "銀行振込決済",
+				Items: Items{
+					{Name: "じゃがいも収穫体験", Price: 2000, Quantity: 1, TaxRate: 10},
+				},
+				Subtotal: 2000,
+				Total:    2000,
+				Breakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 2000,
+					StandardTax:    181,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual := NewInvoice(tt.params)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestInvoice_Write(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		invoice *Invoice
+	}{
+		{
+			name: "qualified invoice",
+			invoice: &Invoice{
+				Title:              TitleReceipt,
+				IssuedAt:           jst.Date(2026, 10, 18, 12, 0, 0, 0),
+				OrderID:            "order-id",
+				RecipientName:      "&. 購入者",
+				IssuerName:         "&.農園",
+				RegistrationNumber: "T1234567890123",
+				Items: Items{
+					{Name: "じゃがいも", Price: 500, Quantity: 2, TaxRate: 8},
+				},
+				Subtotal:  1000,
+				Total:     1000,
+				Breakdown: &entity.OrderTaxBreakdown{ReducedTarget: 1000, ReducedTax: 74},
+			},
+		},
+		{
+			name: "many items",
+			invoice: &Invoice{
+				Title:     TitleInvoice,
+				Items:     make(Items, 0),
+				Breakdown: &entity.OrderTaxBreakdown{},
+			},
+		},
+	}
+	for i := 0; i < 50; i++ {
+		tests[1].invoice.Items = append(tests[1].invoice.Items, &Item{Name: "商品", Price: 100, Quantity: 1, TaxRate: 10})
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			require.NoError(t, tt.invoice.Write(buf))
+			assert.True(t, strings.HasPrefix(buf.String(), "%PDF-"))
+		})
+	}
+}
+
+func TestYen(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		amount int64
+		expect string
+	}{
+		{amount: 0, expect: "¥0"},
+		{amount: 980, expect: "¥980"},
+		{amount: 1000, expect: "¥1,000"},
+		{amount: 1234567, expect: "¥1,234,567"},
+		{amount: -1500, expect: "-¥1,500"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expect, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, yen(tt.amount))
+		})
+	}
+}
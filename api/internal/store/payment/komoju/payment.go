@@ -67,14 +67,26 @@ func (p *provider) RefundPayment(ctx context.Context, params *payment.RefundPara
 		Amount:      params.Amount,
 		Description: params.Description,
 	}
+	idempotencyKey := params.IdempotencyKey
+	if idempotencyKey == "" {
+		idempotencyKey = params.PaymentID
+	}
 	req := &apiParams{
 		Host:           p.host,
 		Method:         http.MethodPost,
 		Path:           path,
 		Params:         []interface{}{params.PaymentID},
 		Body:           body,
-		IdempotencyKey: params.PaymentID,
+		IdempotencyKey: idempotencyKey,
 	}
 	res := &paymentResponse{}
 	return p.client.do(ctx, req, res)
 }
+
+func (p *provider) SavePaymentMethod(_ context.Context, _ *payment.SavePaymentMethodParams) (*payment.SavePaymentMethodResult, error) {
+	return nil, ErrNotImplemented
+}
+
+func (p *provider) ChargeSavedPaymentMethod(_ context.Context, _ *payment.ChargeSavedPaymentMethodParams) (*payment.ChargeResult, error) {
+	return nil, ErrNotImplemented
+}
@@ -4,8 +4,46 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
 )
 
+// WebhookEventType represents a KOMOJU webhook event type.
+type WebhookEventType string
+
+const (
+	WebhookEventTypePing              WebhookEventType = "ping"
+	WebhookEventTypePaymentAuthorized WebhookEventType = "payment.authorized"
+	WebhookEventTypePaymentCaptured   WebhookEventType = "payment.captured"
+	WebhookEventTypePaymentCancelled  WebhookEventType = "payment.cancelled"
+	WebhookEventTypePaymentRefunded   WebhookEventType = "payment.refunded"
+	WebhookEventTypePaymentFailed     WebhookEventType = "payment.failed"
+	WebhookEventTypePaymentExpired    WebhookEventType = "payment.expired"
+)
+
+type webhookEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	Payload   *webhookPayment `json:"data"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type webhookPayment struct {
+	ID                  string           `json:"id"`
+	ExternalOrderNumber string           `json:"external_order_num,omitempty"`
+	Refunds             []*webhookRefund `json:"refunds"`
+}
+
+type webhookRefund struct {
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+}
+
 // VerifyWebhookSignature verifies the KOMOJU webhook signature using HMAC-SHA256.
 func VerifyWebhookSignature(body []byte, signature, secret string) bool {
 	if signature == "" || secret == "" {
@@ -16,3 +54,54 @@ func VerifyWebhookSignature(body []byte, signature, secret string) bool {
 	expected := hex.EncodeToString(mac.Sum(nil))
 	return hmac.Equal([]byte(expected), []byte(signature))
 }
+
+// ParseWebhookEvent parses the KOMOJU webhook request body.
+// eventType is the value of the X-Komoju-Event header. If it is empty, the type in the body is used.
+func ParseWebhookEvent(eventType string, body []byte) (*payment.WebhookEvent, error) {
+	event := &webhookEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("komoju: failed to parse webhook event: %w", err)
+	}
+	if eventType == "" {
+		eventType = event.Type
+	}
+	res := &payment.WebhookEvent{
+		EventID:   event.ID,
+		EventType: eventType,
+		IssuedAt:  event.CreatedAt,
+	}
+	switch WebhookEventType(eventType) {
+	case WebhookEventTypePaymentAuthorized:
+		res.Action, res.Status = payment.WebhookEventActionAuthorized, entity.PaymentStatusAuthorized
+	case WebhookEventTypePaymentCaptured:
+		res.Action, res.Status = payment.WebhookEventActionCaptured, entity.PaymentStatusCaptured
+	case WebhookEventTypePaymentFailed:
+		res.Action, res.Status = payment.WebhookEventActionFailed, entity.PaymentStatusFailed
+	case WebhookEventTypePaymentExpired:
+		res.Action, res.Status = payment.WebhookEventActionFailed, entity.PaymentStatusExpired
+	case WebhookEventTypePaymentCancelled:
+		res.Action, res.Status = payment.WebhookEventActionRefunded, entity.PaymentStatusCanceled
+		res.RefundType = entity.RefundTypeCanceled
+	case WebhookEventTypePaymentRefunded:
+		res.Action, res.Status = payment.WebhookEventActionRefunded, entity.PaymentStatusRefunded
+		res.RefundType = entity.RefundTypeRefunded
+	default:
+		res.Action = payment.WebhookEventActionNone
+		return res, nil
+	}
+	if event.Payload == nil {
+		return nil, fmt.Errorf("komoju: webhook event data is empty")
+	}
+	res.OrderID = event.Payload.ExternalOrderNumber
+	res.PaymentID = event.Payload.ID
+	if res.Action != payment.WebhookEventActionRefunded {
+		return res, nil
+	}
+	reasons := make([]string, 0, len(event.Payload.Refunds))
+	for _, refund := range event.Payload.Refunds {
+		res.RefundTotal += refund.Amount
+		reasons = append(reasons, refund.Description)
+	}
+	res.RefundReason = strings.Join(reasons, "\n")
+	return res, nil
+}
@@ -0,0 +1,291 @@
+package komoju
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+	body := []byte(`{"id":"evt_test"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+	tests := []struct {
+		name      string
+		signature string
+		secret    string
+		expect    bool
+	}{
+		{
+			name:      "success",
+			signature: signature,
+			secret:    "secret",
+			expect:    true,
+		},
+		{
+			name:      "unmatch signature",
+			signature: signature,
+			secret:    "other",
+			expect:    false,
+		},
+		{
+			name:      "empty signature",
+			signature: "",
+			secret:    "secret",
+			expect:    false,
+		},
+		{
+			name:      "empty secret",
+			signature: signature,
+			secret:    "",
+			expect:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, VerifyWebhookSignature(body, tt.signature, tt.secret))
+		})
+	}
+}
+
+func TestParseWebhookEvent(t *testing.T) {
+	t.Parallel()
+	newBody := func(t *testing.T, eventType string, data any) []byte {
+		event := map[string]any{
+			"id":         "evt_test",
+			"type":       eventType,
+			"created_at": "2023-11-14T22:13:20Z",
+		}
+		if data != nil {
+			event["data"] = data
+		}
+		body, err := json.Marshal(event)
+		require.NoError(t, err)
+		return body
+	}
+	issuedAt := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	data := map[string]any{
+		"id":                 "payment-id",
+		"external_order_num": "order-id",
+	}
+	tests := []struct {
+		name      string
+		eventType string
+		body      func(t *testing.T) []byte
+		expect    *payment.WebhookEvent
+		expectErr bool
+	}{
+		{
+			name:      "payment authorized",
+			eventType: "payment.authorized",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.authorized", data)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "payment.authorized",
+				Action:    payment.WebhookEventActionAuthorized,
+				OrderID:   "order-id",
+				PaymentID: "payment-id",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusAuthorized,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "payment captured",
+			eventType: "payment.captured",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.captured", data)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "payment.captured",
+				Action:    payment.WebhookEventActionCaptured,
+				OrderID:   "order-id",
+				PaymentID: "payment-id",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusCaptured,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "payment failed",
+			eventType: "payment.failed",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.failed", data)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "payment.failed",
+				Action:    payment.WebhookEventActionFailed,
+				OrderID:   "order-id",
+				PaymentID: "payment-id",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusFailed,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "payment expired",
+			eventType: "payment.expired",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.expired", data)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "payment.expired",
+				Action:    payment.WebhookEventActionFailed,
+				OrderID:   "order-id",
+				PaymentID: "payment-id",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusExpired,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "payment cancelled",
+			eventType: "payment.cancelled",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.cancelled", data)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:    "evt_test",
+				EventType:  "payment.cancelled",
+				Action:     payment.WebhookEventActionRefunded,
+				OrderID:    "order-id",
+				PaymentID:  "payment-id",
+				IssuedAt:   issuedAt,
+				Status:     entity.PaymentStatusCanceled,
+				RefundType: entity.RefundTypeCanceled,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "payment refunded with multiple refunds",
+			eventType: "payment.refunded",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.refunded", map[string]any{
+					"id":                 "payment-id",
+					"external_order_num": "order-id",
+					"refunds": []map[string]any{
+						{"amount": 1000, "description": "商品の欠品"},
+						{"amount": 500, "description": "配送遅延"},
+					},
+				})
+			},
+			expect: &payment.WebhookEvent{
+				EventID:      "evt_test",
+				EventType:    "payment.refunded",
+				Action:       payment.WebhookEventActionRefunded,
+				OrderID:      "order-id",
+				PaymentID:    "payment-id",
+				IssuedAt:     issuedAt,
+				Status:       entity.PaymentStatusRefunded,
+				RefundType:   entity.RefundTypeRefunded,
+				RefundReason: "商品の欠品\n配送遅延",
+				RefundTotal:  1500,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "event type from body",
+			eventType: "",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.captured", data)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "payment.captured",
+				Action:    payment.WebhookEventActionCaptured,
+				OrderID:   "order-id",
+				PaymentID: "payment-id",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusCaptured,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "event type from header",
+			eventType: "payment.authorized",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.captured", data)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "payment.authorized",
+				Action:    payment.WebhookEventActionAuthorized,
+				OrderID:   "order-id",
+				PaymentID: "payment-id",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusAuthorized,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "ping",
+			eventType: "ping",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "ping", nil)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "ping",
+				Action:    payment.WebhookEventActionNone,
+				IssuedAt:  issuedAt,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "unexpected event",
+			eventType: "customer.created",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "customer.created", map[string]any{})
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "customer.created",
+				Action:    payment.WebhookEventActionNone,
+				IssuedAt:  issuedAt,
+			},
+			expectErr: false,
+		},
+		{
+			name:      "empty event data",
+			eventType: "payment.captured",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "payment.captured", nil)
+			},
+			expect:    nil,
+			expectErr: true,
+		},
+		{
+			name:      "invalid body",
+			eventType: "payment.captured",
+			body: func(_ *testing.T) []byte {
+				return []byte(`invalid-json`)
+			},
+			expect:    nil,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := ParseWebhookEvent(tt.eventType, tt.body(t))
+			assert.Equal(t, tt.expectErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -28,6 +28,10 @@ type Provider interface {
 	CancelPayment(ctx context.Context, paymentID string) error
 	RefundPayment(ctx context.Context, params *RefundParams) error
 
+	// Saved payment method (recurring)
+	SavePaymentMethod(ctx context.Context, params *SavePaymentMethodParams) (*SavePaymentMethodResult, error)
+	ChargeSavedPaymentMethod(ctx context.Context, params *ChargeSavedPaymentMethodParams) (*ChargeResult, error)
+
 	// Error classification
 	IsSessionFailed(err error) bool
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/and-period/furumaru/api/internal/store/payment"
+	pkgstripe "github.com/and-period/furumaru/api/pkg/stripe"
 	lib "github.com/stripe/stripe-go/v82"
 )
 
@@ -28,6 +29,12 @@ func (p *provider) CancelPayment(ctx context.Context, paymentID string) error {
 }
 
 func (p *provider) RefundPayment(ctx context.Context, params *payment.RefundParams) error {
-	_, err := p.client.Refund(ctx, params.PaymentID, params.Amount, params.Description)
+	in := &pkgstripe.RefundParams{
+		PaymentIntentID: params.PaymentID,
+		Amount:          params.Amount,
+		Reason:          params.Description,
+		IdempotencyKey:  params.IdempotencyKey,
+	}
+	_, err := p.client.Refund(ctx, in)
 	return err
 }
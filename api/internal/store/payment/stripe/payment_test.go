@@ -8,6 +8,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/payment"
 	mock "github.com/and-period/furumaru/api/mock/pkg/stripe"
+	pkgstripe "github.com/and-period/furumaru/api/pkg/stripe"
 	lib "github.com/stripe/stripe-go/v82"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -159,25 +160,39 @@ func TestRefundPayment(t *testing.T) {
 		{
 			name: "success",
 			setup: func(ctx context.Context, m *mock.MockClient) {
-				m.EXPECT().Refund(ctx, "pi_xxx", int64(500), "customer request").
+				in := &pkgstripe.RefundParams{
+					PaymentIntentID: "pi_xxx",
+					Amount:          500,
+					Reason:          "customer request",
+					IdempotencyKey:  "refund-id",
+				}
+				m.EXPECT().Refund(ctx, in).
 					Return(&lib.Refund{}, nil)
 			},
 			params: &payment.RefundParams{
-				PaymentID:   "pi_xxx",
-				Amount:      500,
-				Description: "customer request",
+				PaymentID:      "pi_xxx",
+				Amount:         500,
+				Description:    "customer request",
+				IdempotencyKey: "refund-id",
 			},
 		},
 		{
 			name: "error",
 			setup: func(ctx context.Context, m *mock.MockClient) {
-				m.EXPECT().Refund(ctx, "pi_xxx", int64(500), "customer request").
+				in := &pkgstripe.RefundParams{
+					PaymentIntentID: "pi_xxx",
+					Amount:          500,
+					Reason:          "customer request",
+					IdempotencyKey:  "refund-id",
+				}
+				m.EXPECT().Refund(ctx, in).
 					Return(nil, errors.New("stripe error"))
 			},
 			params: &payment.RefundParams{
-				PaymentID:   "pi_xxx",
-				Amount:      500,
-				Description: "customer request",
+				PaymentID:      "pi_xxx",
+				Amount:         500,
+				Description:    "customer request",
+				IdempotencyKey: "refund-id",
 			},
 			expectErr: errors.New("stripe error"),
 		},
@@ -0,0 +1,49 @@
+package stripe
+
+import (
+	"context"
+
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	pkgstripe "github.com/and-period/furumaru/api/pkg/stripe"
+)
+
+func (p *provider) SavePaymentMethod(ctx context.Context, params *payment.SavePaymentMethodParams) (*payment.SavePaymentMethodResult, error) {
+	cin := &pkgstripe.CreateCustomerParams{
+		UserID: params.UserID,
+		Name:   params.Name,
+		Email:  params.Email,
+	}
+	customer, err := p.client.CreateCustomer(ctx, cin)
+	if err != nil {
+		return nil, err
+	}
+	pm, err := p.client.AttachPayment(ctx, customer.ID, params.PaymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	return &payment.SavePaymentMethodResult{
+		CustomerID:      customer.ID,
+		PaymentMethodID: pm.ID,
+	}, nil
+}
+
+func (p *provider) ChargeSavedPaymentMethod(ctx context.Context, params *payment.ChargeSavedPaymentMethodParams) (*payment.ChargeResult, error) {
+	in := &pkgstripe.OffSessionOrderParams{
+		CustomerID:      params.CustomerID,
+		PaymentMethodID: params.PaymentMethodID,
+		Amount:          params.Amount,
+		Description:     params.Description,
+		IdempotencyKey:  params.OrderID,
+		Metadata: map[string]string{
+			"order_id": params.OrderID,
+		},
+	}
+	pi, err := p.client.OffSessionOrder(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &payment.ChargeResult{
+		PaymentID: pi.ID,
+		Status:    convertPaymentIntentStatus(pi.Status),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	mock "github.com/and-period/furumaru/api/mock/pkg/stripe"
+	pkgstripe "github.com/and-period/furumaru/api/pkg/stripe"
+	"github.com/stretchr/testify/assert"
+	lib "github.com/stripe/stripe-go/v82"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSavePaymentMethod(t *testing.T) {
+	t.Parallel()
+	params := &payment.SavePaymentMethodParams{
+		UserID:          "user-id",
+		Name:            "あんど どっと",
+		Email:           "test@example.com",
+		PaymentMethodID: "pm_xxx",
+	}
+	customerIn := &pkgstripe.CreateCustomerParams{
+		UserID: "user-id",
+		Name:   "あんど どっと",
+		Email:  "test@example.com",
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, m *mock.MockClient)
+		expect    *payment.SavePaymentMethodResult
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, m *mock.MockClient) {
+				m.EXPECT().CreateCustomer(ctx, customerIn).Return(&lib.Customer{ID: "cus_xxx"}, nil)
+				m.EXPECT().AttachPayment(ctx, "cus_xxx", "pm_xxx").Return(&lib.PaymentMethod{ID: "pm_xxx"}, nil)
+			},
+			expect: &payment.SavePaymentMethodResult{
+				CustomerID:      "cus_xxx",
+				PaymentMethodID: "pm_xxx",
+			},
+		},
+		{
+			name: "failed to create customer",
+			setup: func(ctx context.Context, m *mock.MockClient) {
+				m.EXPECT().CreateCustomer(ctx, customerIn).Return(nil, errors.New("stripe error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "failed to attach payment",
+			setup: func(ctx context.Context, m *mock.MockClient) {
+				m.EXPECT().CreateCustomer(ctx, customerIn).Return(&lib.Customer{ID: "cus_xxx"}, nil)
+				m.EXPECT().AttachPayment(ctx, "cus_xxx", "pm_xxx").Return(nil, errors.New("stripe error"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockClient(ctrl)
+			ctx := context.Background()
+			tt.setup(ctx, client)
+			p := &provider{client: client}
+			result, err := p.SavePaymentMethod(ctx, params)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+func TestChargeSavedPaymentMethod(t *testing.T) {
+	t.Parallel()
+	params := &payment.ChargeSavedPaymentMethodParams{
+		OrderID:         "order-id",
+		CustomerID:      "cus_xxx",
+		PaymentMethodID: "pm_xxx",
+		Amount:          3980,
+		Description:     "定期便",
+	}
+	in := &pkgstripe.OffSessionOrderParams{
+		CustomerID:      "cus_xxx",
+		PaymentMethodID: "pm_xxx",
+		Amount:          3980,
+		Description:     "定期便",
+		IdempotencyKey:  "order-id",
+		Metadata:        map[string]string{"order_id": "order-id"},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, m *mock.MockClient)
+		expect    *payment.ChargeResult
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, m *mock.MockClient) {
+				m.EXPECT().OffSessionOrder(ctx, in).Return(&lib.PaymentIntent{
+					ID:     "pi_xxx",
+					Status: lib.PaymentIntentStatusRequiresCapture,
+				}, nil)
+			},
+			expect: &payment.ChargeResult{
+				PaymentID: "pi_xxx",
+				Status:    entity.PaymentStatusAuthorized,
+			},
+		},
+		{
+			name: "error",
+			setup: func(ctx context.Context, m *mock.MockClient) {
+				m.EXPECT().OffSessionOrder(ctx, in).Return(nil, errors.New("card declined"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockClient(ctrl)
+			ctx := context.Background()
+			tt.setup(ctx, client)
+			p := &provider{client: client}
+			result, err := p.ChargeSavedPaymentMethod(ctx, params)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	lib "github.com/stripe/stripe-go/v82"
+)
+
+const (
+	EventTypePaymentIntentAmountCapturableUpdated = "payment_intent.amount_capturable_updated"
+	EventTypePaymentIntentSucceeded               = "payment_intent.succeeded"
+	EventTypePaymentIntentPaymentFailed           = "payment_intent.payment_failed"
+	EventTypePaymentIntentCanceled                = "payment_intent.canceled"
+	EventTypeChargeRefunded                       = "charge.refunded"
+)
+
+// ParseWebhookEvent parses the Stripe webhook request body.
+// The signature must be verified before calling this function.
+func ParseWebhookEvent(body []byte) (*payment.WebhookEvent, error) {
+	event := &lib.Event{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("stripe: failed to parse webhook event: %w", err)
+	}
+	res := &payment.WebhookEvent{
+		EventID:   event.ID,
+		EventType: string(event.Type),
+		IssuedAt:  time.Unix(event.Created, 0),
+	}
+	var err error
+	switch event.Type {
+	case EventTypePaymentIntentAmountCapturableUpdated:
+		err = parsePaymentIntentEvent(res, event, payment.WebhookEventActionAuthorized, entity.PaymentStatusAuthorized)
+	case EventTypePaymentIntentSucceeded:
+		err = parsePaymentIntentEvent(res, event, payment.WebhookEventActionCaptured, entity.PaymentStatusCaptured)
+	case EventTypePaymentIntentPaymentFailed:
+		err = parsePaymentIntentEvent(res, event, payment.WebhookEventActionFailed, entity.PaymentStatusFailed)
+	case EventTypePaymentIntentCanceled:
+		err = parsePaymentIntentEvent(res, event, payment.WebhookEventActionRefunded, entity.PaymentStatusCanceled)
+	case EventTypeChargeRefunded:
+		err = parseChargeRefundedEvent(res, event)
+	default:
+		res.Action = payment.WebhookEventActionNone
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func parsePaymentIntentEvent(
+	res *payment.WebhookEvent, event *lib.Event, action payment.WebhookEventAction, status entity.PaymentStatus,
+) error {
+	pi := &lib.PaymentIntent{}
+	if err := unmarshalEventData(event, pi); err != nil {
+		return err
+	}
+	res.Action = action
+	res.OrderID = pi.Metadata["order_id"]
+	res.PaymentID = pi.ID
+	res.Status = status
+	if status == entity.PaymentStatusCanceled {
+		res.RefundType = entity.RefundTypeCanceled
+		res.RefundReason = string(pi.CancellationReason)
+	}
+	return nil
+}
+
+func parseChargeRefundedEvent(res *payment.WebhookEvent, event *lib.Event) error {
+	charge := &lib.Charge{}
+	if err := unmarshalEventData(event, charge); err != nil {
+		return err
+	}
+	res.Action = payment.WebhookEventActionRefunded
+	res.OrderID = charge.Metadata["order_id"]
+	if charge.PaymentIntent != nil {
+		res.PaymentID = charge.PaymentIntent.ID
+	}
+	res.Status = entity.PaymentStatusRefunded
+	res.RefundType = entity.RefundTypeRefunded
+	if charge.Refunds == nil {
+		return nil
+	}
+	reasons := make([]string, 0, len(charge.Refunds.Data))
+	for _, r := range charge.Refunds.Data {
+		res.RefundTotal += r.Amount
+		if r.Reason != "" {
+			reasons = append(reasons, string(r.Reason))
+		}
+	}
+	res.RefundReason = strings.Join(reasons, "\n")
+	return nil
+}
+
+func unmarshalEventData(event *lib.Event, v any) error {
+	if event.Data == nil {
+		return fmt.Errorf("stripe: webhook event data is empty")
+	}
+	if err := json.Unmarshal(event.Data.Raw, v); err != nil {
+		return fmt.Errorf("stripe: failed to parse webhook event data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lib "github.com/stripe/stripe-go/v82"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	t.Parallel()
+	newBody := func(t *testing.T, eventType string, object any) []byte {
+		raw, err := json.Marshal(object)
+		require.NoError(t, err)
+		body, err := json.Marshal(map[string]any{
+			"id":      "evt_test",
+			"type":    eventType,
+			"created": 1700000000,
+			"data":    map[string]json.RawMessage{"object": raw},
+		})
+		require.NoError(t, err)
+		return body
+	}
+	issuedAt := time.Unix(1700000000, 0)
+	pi := &lib.PaymentIntent{
+		ID:       "pi_test",
+		Metadata: map[string]string{"order_id": "order-id"},
+	}
+	tests := []struct {
+		name      string
+		body      func(t *testing.T) []byte
+		expect    *payment.WebhookEvent
+		expectErr bool
+	}{
+		{
+			name: "payment intent authorized",
+			body: func(t *testing.T) []byte {
+				return newBody(t, EventTypePaymentIntentAmountCapturableUpdated, pi)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: EventTypePaymentIntentAmountCapturableUpdated,
+				Action:    payment.WebhookEventActionAuthorized,
+				OrderID:   "order-id",
+				PaymentID: "pi_test",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusAuthorized,
+			},
+			expectErr: false,
+		},
+		{
+			name: "payment intent succeeded",
+			body: func(t *testing.T) []byte {
+				return newBody(t, EventTypePaymentIntentSucceeded, pi)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: EventTypePaymentIntentSucceeded,
+				Action:    payment.WebhookEventActionCaptured,
+				OrderID:   "order-id",
+				PaymentID: "pi_test",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusCaptured,
+			},
+			expectErr: false,
+		},
+		{
+			name: "payment intent failed",
+			body: func(t *testing.T) []byte {
+				return newBody(t, EventTypePaymentIntentPaymentFailed, pi)
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: EventTypePaymentIntentPaymentFailed,
+				Action:    payment.WebhookEventActionFailed,
+				OrderID:   "order-id",
+				PaymentID: "pi_test",
+				IssuedAt:  issuedAt,
+				Status:    entity.PaymentStatusFailed,
+			},
+			expectErr: false,
+		},
+		{
+			name: "payment intent canceled",
+			body: func(t *testing.T) []byte {
+				return newBody(t, EventTypePaymentIntentCanceled, &lib.PaymentIntent{
+					ID:                 "pi_test",
+					Metadata:           map[string]string{"order_id": "order-id"},
+					CancellationReason: lib.PaymentIntentCancellationReasonRequestedByCustomer,
+				})
+			},
+			expect: &payment.WebhookEvent{
+				EventID:      "evt_test",
+				EventType:    EventTypePaymentIntentCanceled,
+				Action:       payment.WebhookEventActionRefunded,
+				OrderID:      "order-id",
+				PaymentID:    "pi_test",
+				IssuedAt:     issuedAt,
+				Status:       entity.PaymentStatusCanceled,
+				RefundType:   entity.RefundTypeCanceled,
+				RefundReason: string(lib.PaymentIntentCancellationReasonRequestedByCustomer),
+			},
+			expectErr: false,
+		},
+		{
+			name: "charge refunded with multiple refunds",
+			body: func(t *testing.T) []byte {
+				return newBody(t, EventTypeChargeRefunded, &lib.Charge{
+					ID:            "ch_test",
+					Metadata:      map[string]string{"order_id": "order-id"},
+					PaymentIntent: &lib.PaymentIntent{ID: "pi_test"},
+					Refunds: &lib.RefundList{
+						Data: []*lib.Refund{
+							{Amount: 1000, Reason: lib.RefundReasonRequestedByCustomer},
+							{Amount: 500, Reason: lib.RefundReasonDuplicate},
+						},
+					},
+				})
+			},
+			expect: &payment.WebhookEvent{
+				EventID:      "evt_test",
+				EventType:    EventTypeChargeRefunded,
+				Action:       payment.WebhookEventActionRefunded,
+				OrderID:      "order-id",
+				PaymentID:    "pi_test",
+				IssuedAt:     issuedAt,
+				Status:       entity.PaymentStatusRefunded,
+				RefundType:   entity.RefundTypeRefunded,
+				RefundReason: string(lib.RefundReasonRequestedByCustomer) + "\n" + string(lib.RefundReasonDuplicate),
+				RefundTotal:  1500,
+			},
+			expectErr: false,
+		},
+		{
+			name: "charge refunded without refund details",
+			body: func(t *testing.T) []byte {
+				return newBody(t, EventTypeChargeRefunded, &lib.Charge{
+					ID:            "ch_test",
+					Metadata:      map[string]string{"order_id": "order-id"},
+					PaymentIntent: &lib.PaymentIntent{ID: "pi_test"},
+				})
+			},
+			expect: &payment.WebhookEvent{
+				EventID:    "evt_test",
+				EventType:  EventTypeChargeRefunded,
+				Action:     payment.WebhookEventActionRefunded,
+				OrderID:    "order-id",
+				PaymentID:  "pi_test",
+				IssuedAt:   issuedAt,
+				Status:     entity.PaymentStatusRefunded,
+				RefundType: entity.RefundTypeRefunded,
+			},
+			expectErr: false,
+		},
+		{
+			name: "unexpected event",
+			body: func(t *testing.T) []byte {
+				return newBody(t, "customer.created", map[string]any{})
+			},
+			expect: &payment.WebhookEvent{
+				EventID:   "evt_test",
+				EventType: "customer.created",
+				Action:    payment.WebhookEventActionNone,
+				IssuedAt:  issuedAt,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid body",
+			body: func(_ *testing.T) []byte {
+				return []byte(`invalid-json`)
+			},
+			expect:    nil,
+			expectErr: true,
+		},
+		{
+			name: "invalid event data",
+			body: func(_ *testing.T) []byte {
+				return []byte(`{"id":"evt_test","type":"payment_intent.succeeded","created":1700000000,"data":{"object":"invalid"}}`)
+			},
+			expect:    nil,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := ParseWebhookEvent(tt.body(t))
+			assert.Equal(t, tt.expectErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package payment
 
-import "github.com/and-period/furumaru/api/internal/store/entity"
+import (
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+)
 
 // CreateSessionParams contains parameters for creating a payment session.
 type CreateSessionParams struct {
@@ -128,7 +132,62 @@ type PaymentResult struct {
 
 // RefundParams contains parameters for refunding a payment.
 type RefundParams struct {
-	PaymentID   string // ペイメントID
-	Amount      int64  // 返金金額
-	Description string // 返金理由
+	PaymentID      string // ペイメントID
+	Amount         int64  // 返金金額
+	Description    string // 返金理由
+	IdempotencyKey string // 冪等キー（未指定の場合はペイメントIDを使用）
+}
+
+// SavePaymentMethodParams contains parameters for saving a payment method for recurring charges.
+type SavePaymentMethodParams struct {
+	UserID          string // ユーザーID
+	Name            string // 氏名
+	Email           string // メールアドレス
+	PaymentMethodID string // フロントエンドで登録した決済手段ID
+}
+
+// SavePaymentMethodResult is the result of saving a payment method.
+type SavePaymentMethodResult struct {
+	CustomerID      string // 決済プロバイダーの顧客ID
+	PaymentMethodID string // 保存した決済手段ID
+}
+
+// ChargeSavedPaymentMethodParams contains parameters for charging a saved payment method without the customer present.
+type ChargeSavedPaymentMethodParams struct {
+	OrderID         string // 注文ID（冪等キーとして使用）
+	CustomerID      string // 決済プロバイダーの顧客ID
+	PaymentMethodID string // 保存した決済手段ID
+	Amount          int64  // 支払い金額
+	Description     string // 支払い内容
+}
+
+// ChargeResult is the result of charging a saved payment method.
+type ChargeResult struct {
+	PaymentID string               // ペイメントID
+	Status    entity.PaymentStatus // 決済ステータス
+}
+
+// WebhookEventAction represents the operation to be performed for a webhook event.
+type WebhookEventAction int32
+
+const (
+	WebhookEventActionNone       WebhookEventAction = 0 // 処理対象外
+	WebhookEventActionAuthorized WebhookEventAction = 1 // 与信
+	WebhookEventActionCaptured   WebhookEventAction = 2 // 売上確定
+	WebhookEventActionFailed     WebhookEventAction = 3 // 決済失敗
+	WebhookEventActionRefunded   WebhookEventAction = 4 // 返金・キャンセル
+)
+
+// WebhookEvent is the provider-independent representation of a webhook event.
+type WebhookEvent struct {
+	EventID      string               // イベントID
+	EventType    string               // イベント種別
+	Action       WebhookEventAction   // 実行する処理
+	OrderID      string               // 注文ID
+	PaymentID    string               // ペイメントID
+	IssuedAt     time.Time            // イベント発生日時
+	Status       entity.PaymentStatus // 決済ステータス
+	RefundType   entity.RefundType    // 返金種別
+	RefundReason string               // 返金理由
+	RefundTotal  int64                // 返金金額
 }
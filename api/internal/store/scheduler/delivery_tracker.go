@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/log"
+)
+
+// defaultTrackingPeriod - 発送から配達完了を追跡する期間
+const defaultTrackingPeriod = 14 * 24 * time.Hour
+
+// deliveryTracker - 配送業者の追跡APIから配達完了を取得し、注文の配送状況に反映する
+type deliveryTracker struct {
+	now       func() time.Time
+	waitGroup *sync.WaitGroup
+	store     store.Service
+	carriers  []entity.ShippingCarrier
+	period    time.Duration
+}
+
+type DeliveryTrackerOption func(*deliveryTracker)
+
+// WithShippingCarriers - 追跡対象の配送業者 (追跡APIの設定がある配送業者のみ指定する)
+func WithShippingCarriers(carriers ...entity.ShippingCarrier) DeliveryTrackerOption {
+	return func(t *deliveryTracker) {
+		t.carriers = carriers
+	}
+}
+
+func NewDeliveryTracker(params *Params, opts ...DeliveryTrackerOption) Scheduler {
+	t := &deliveryTracker{
+		now:       jst.Now,
+		waitGroup: params.WaitGroup,
+		store:     params.Store,
+		carriers: []entity.ShippingCarrier{
+			entity.ShippingCarrierYamato,
+			entity.ShippingCarrierSagawa,
+			entity.ShippingCarrierJapanPost,
+		},
+		period: defaultTrackingPeriod,
+	}
+	for i := range opts {
+		opts[i](t)
+	}
+	return t
+}
+
+func (t *deliveryTracker) Lambda(ctx context.Context) (err error) {
+	slog.Debug("Started Lambda function", slog.Time("now", t.now()))
+	defer func() {
+		slog.Debug("Finished Lambda function", slog.Time("now", t.now()), log.Error(err))
+	}()
+
+	return t.run(ctx, t.now())
+}
+
+func (t *deliveryTracker) Run(ctx context.Context, target time.Time) error {
+	return t.run(ctx, target)
+}
+
+func (t *deliveryTracker) run(ctx context.Context, target time.Time) error {
+	// 1社の追跡APIの障害で他社の同期を止めないよう、失敗は件数のみ集計する
+	var failed int64
+	for _, carrier := range t.carriers {
+		in := &store.SyncOrderDeliveriesInput{
+			ShippingCarrier: carrier,
+			ShippedAtGte:    target.Add(-t.period),
+		}
+		total, err := t.store.SyncOrderDeliveries(ctx, in)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to sync order deliveries",
+				slog.Int("carrier", int(carrier)), slog.Time("target", target), log.Error(err))
+			failed++
+			continue
+		}
+		slog.InfoContext(ctx, "Synced order deliveries", slog.Int("carrier", int(carrier)), slog.Int64("total", total))
+	}
+	if failed > 0 {
+		return fmt.Errorf("scheduler: failed to sync order deliveries. count=%d", failed)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	mock_store "github.com/and-period/furumaru/api/mock/store"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeliveryTracker(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewDeliveryTracker(&Params{}, WithShippingCarriers(entity.ShippingCarrierYamato)))
+}
+
+func TestDeliveryTracker_Run(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	yamatoIn := &store.SyncOrderDeliveriesInput{
+		ShippingCarrier: entity.ShippingCarrierYamato,
+		ShippedAtGte:    jst.Date(2026, 10, 4, 18, 0, 0, 0),
+	}
+	sagawaIn := &store.SyncOrderDeliveriesInput{
+		ShippingCarrier: entity.ShippingCarrierSagawa,
+		ShippedAtGte:    jst.Date(2026, 10, 4, 18, 0, 0, 0),
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(ctx context.Context, store *mock_store.MockService)
+		hasErr bool
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, store *mock_store.MockService) {
+				store.EXPECT().SyncOrderDeliveries(ctx, yamatoIn).Return(int64(2), nil)
+				store.EXPECT().SyncOrderDeliveries(ctx, sagawaIn).Return(int64(0), nil)
+			},
+			hasErr: false,
+		},
+		{
+			name: "failed to sync order deliveries",
+			setup: func(ctx context.Context, store *mock_store.MockService) {
+				store.EXPECT().SyncOrderDeliveries(ctx, yamatoIn).Return(int64(0), assert.AnError)
+				store.EXPECT().SyncOrderDeliveries(ctx, sagawaIn).Return(int64(1), nil)
+			},
+			hasErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_store.NewMockService(ctrl)
+			tt.setup(ctx, store)
+
+			tracker := &deliveryTracker{
+				now:       func() time.Time { return now },
+				waitGroup: &sync.WaitGroup{},
+				store:     store,
+				carriers:  []entity.ShippingCarrier{entity.ShippingCarrierYamato, entity.ShippingCarrierSagawa},
+				period:    defaultTrackingPeriod,
+			}
+			err := tracker.Run(ctx, now)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+		})
+	}
+}
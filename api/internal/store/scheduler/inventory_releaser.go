@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/log"
+)
+
+// inventoryReleaser - 確保期限切れの商品在庫を解放する
+type inventoryReleaser struct {
+	now       func() time.Time
+	waitGroup *sync.WaitGroup
+	store     store.Service
+}
+
+func NewInventoryReleaser(params *Params) Scheduler {
+	return &inventoryReleaser{
+		now:       jst.Now,
+		waitGroup: params.WaitGroup,
+		store:     params.Store,
+	}
+}
+
+func (r *inventoryReleaser) Lambda(ctx context.Context) (err error) {
+	slog.Debug("Started Lambda function", slog.Time("now", r.now()))
+	defer func() {
+		slog.Debug("Finished Lambda function", slog.Time("now", r.now()), log.Error(err))
+	}()
+
+	return r.run(ctx, r.now())
+}
+
+func (r *inventoryReleaser) Run(ctx context.Context, target time.Time) error {
+	return r.run(ctx, target)
+}
+
+// run - 確保期限の判定は実行時刻を基準にするため、targetはログ出力のみに利用する
+func (r *inventoryReleaser) run(ctx context.Context, target time.Time) error {
+	in := &store.ReleaseExpiredProductInventoryHoldsInput{}
+	if err := r.store.ReleaseExpiredProductInventoryHolds(ctx, in); err != nil {
+		slog.ErrorContext(ctx, "Failed to release expired inventory holds", slog.Time("target", target), log.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	mock_store "github.com/and-period/furumaru/api/mock/store"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInventoryReleaser(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewInventoryReleaser(&Params{}))
+}
+
+func TestInventoryReleaser_Run(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	in := &store.ReleaseExpiredProductInventoryHoldsInput{}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, store *mock_store.MockService)
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, store *mock_store.MockService) {
+				store.EXPECT().ReleaseExpiredProductInventoryHolds(ctx, in).Return(nil)
+			},
+			expectErr: nil,
+		},
+		{
+			name: "failed to release expired inventory holds",
+			setup: func(ctx context.Context, store *mock_store.MockService) {
+				store.EXPECT().ReleaseExpiredProductInventoryHolds(ctx, in).Return(assert.AnError)
+			},
+			expectErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_store.NewMockService(ctrl)
+			tt.setup(ctx, store)
+
+			releaser := &inventoryReleaser{
+				now:       func() time.Time { return now },
+				waitGroup: &sync.WaitGroup{},
+				store:     store,
+			}
+			err := releaser.Run(ctx, now)
+			assert.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
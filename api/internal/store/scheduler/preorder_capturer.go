@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/log"
+)
+
+// preorderCapturer - 与信の失効が近い予約注文を売上確定する
+type preorderCapturer struct {
+	now       func() time.Time
+	waitGroup *sync.WaitGroup
+	store     store.Service
+}
+
+func NewPreorderCapturer(params *Params) Scheduler {
+	return &preorderCapturer{
+		now:       jst.Now,
+		waitGroup: params.WaitGroup,
+		store:     params.Store,
+	}
+}
+
+func (c *preorderCapturer) Lambda(ctx context.Context) (err error) {
+	slog.Debug("Started Lambda function", slog.Time("now", c.now()))
+	defer func() {
+		slog.Debug("Finished Lambda function", slog.Time("now", c.now()), log.Error(err))
+	}()
+
+	return c.run(ctx, c.now())
+}
+
+func (c *preorderCapturer) Run(ctx context.Context, target time.Time) error {
+	return c.run(ctx, target)
+}
+
+// run - 与信期限の判定は実行時刻を基準にするため、targetはログ出力のみに利用する
+func (c *preorderCapturer) run(ctx context.Context, target time.Time) error {
+	in := &store.CaptureExpiringPreordersInput{}
+	if err := c.store.CaptureExpiringPreorders(ctx, in); err != nil {
+		slog.ErrorContext(ctx, "Failed to capture expiring preorders", slog.Time("target", target), log.Error(err))
+		return err
+	}
+	return nil
+}
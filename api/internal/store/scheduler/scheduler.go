@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+)
+
+type Scheduler interface {
+	Run(ctx context.Context, target time.Time) error
+	Lambda(ctx context.Context) error
+}
+
+type Params struct {
+	WaitGroup *sync.WaitGroup
+	Store     store.Service
+}
@@ -0,0 +1,105 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+// subscriptionRenewer - 次回注文日時を迎えた定期便の注文を作成する
+type subscriptionRenewer struct {
+	now       func() time.Time
+	waitGroup *sync.WaitGroup
+	semaphore *semaphore.Weighted
+	store     store.Service
+}
+
+type options struct {
+	concurrency int64
+}
+
+type Option func(*options)
+
+func WithConcurrency(concurrency int64) Option {
+	return func(opts *options) {
+		opts.concurrency = concurrency
+	}
+}
+
+func NewSubscriptionRenewer(params *Params, opts ...Option) Scheduler {
+	dopts := &options{
+		concurrency: 2,
+	}
+	for i := range opts {
+		opts[i](dopts)
+	}
+	return &subscriptionRenewer{
+		now:       jst.Now,
+		waitGroup: params.WaitGroup,
+		semaphore: semaphore.NewWeighted(dopts.concurrency),
+		store:     params.Store,
+	}
+}
+
+func (r *subscriptionRenewer) Lambda(ctx context.Context) (err error) {
+	slog.Debug("Started Lambda function", slog.Time("now", r.now()))
+	defer func() {
+		slog.Debug("Finished Lambda function", slog.Time("now", r.now()), log.Error(err))
+	}()
+
+	return r.run(ctx, r.now())
+}
+
+func (r *subscriptionRenewer) Run(ctx context.Context, target time.Time) error {
+	return r.run(ctx, target)
+}
+
+func (r *subscriptionRenewer) run(ctx context.Context, target time.Time) error {
+	in := &store.ListSubscriptionsInput{
+		Statuses:     []entity.SubscriptionStatus{entity.SubscriptionStatusActive},
+		NextRunUntil: target,
+	}
+	subscriptions, _, err := r.store.ListSubscriptions(ctx, in)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list subscriptions", slog.Time("target", target), log.Error(err))
+		return err
+	}
+
+	// 1件の失敗で他の定期便の注文作成を止めないよう、失敗は件数のみ集計する
+	var failed atomic.Int64
+	eg := &errgroup.Group{}
+	for i := range subscriptions {
+		if err := r.semaphore.Acquire(ctx, 1); err != nil {
+			return err
+		}
+
+		subscription := subscriptions[i]
+		eg.Go(func() error {
+			defer r.semaphore.Release(1)
+			in := &store.RenewSubscriptionInput{
+				SubscriptionID: subscription.ID,
+			}
+			if err := r.store.RenewSubscription(ctx, in); err != nil {
+				slog.ErrorContext(ctx, "Failed to renew subscription",
+					slog.String("subscriptionId", subscription.ID), log.Error(err))
+				failed.Add(1)
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("scheduler: failed to renew subscriptions. count=%d", n)
+	}
+	return nil
+}
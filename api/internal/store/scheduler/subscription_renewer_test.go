@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	mock_store "github.com/and-period/furumaru/api/mock/store"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestSubscriptionRenewer(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, NewSubscriptionRenewer(&Params{}, WithConcurrency(1)))
+}
+
+func TestSubscriptionRenewer_Run(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	listIn := &store.ListSubscriptionsInput{
+		Statuses:     []entity.SubscriptionStatus{entity.SubscriptionStatusActive},
+		NextRunUntil: now,
+	}
+	subscriptions := entity.Subscriptions{
+		{ID: "subscription-id01", Status: entity.SubscriptionStatusActive, NextRunAt: now},
+		{ID: "subscription-id02", Status: entity.SubscriptionStatusActive, NextRunAt: now},
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, store *mock_store.MockService)
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mock *mock_store.MockService) {
+				mock.EXPECT().ListSubscriptions(ctx, listIn).Return(subscriptions, int64(2), nil)
+				mock.EXPECT().RenewSubscription(ctx, &store.RenewSubscriptionInput{SubscriptionID: "subscription-id01"}).Return(nil)
+				mock.EXPECT().RenewSubscription(ctx, &store.RenewSubscriptionInput{SubscriptionID: "subscription-id02"}).Return(nil)
+			},
+			expectErr: false,
+		},
+		{
+			name: "failed to list subscriptions",
+			setup: func(ctx context.Context, mock *mock_store.MockService) {
+				mock.EXPECT().ListSubscriptions(ctx, listIn).Return(nil, int64(0), assert.AnError)
+			},
+			expectErr: true,
+		},
+		{
+			name: "failed to renew subscription",
+			setup: func(ctx context.Context, mock *mock_store.MockService) {
+				mock.EXPECT().ListSubscriptions(ctx, listIn).Return(subscriptions, int64(2), nil)
+				mock.EXPECT().RenewSubscription(ctx, &store.RenewSubscriptionInput{SubscriptionID: "subscription-id01"}).Return(assert.AnError)
+				mock.EXPECT().RenewSubscription(ctx, &store.RenewSubscriptionInput{SubscriptionID: "subscription-id02"}).Return(nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_store.NewMockService(ctrl)
+			tt.setup(ctx, store)
+
+			renewer := &subscriptionRenewer{
+				now:       func() time.Time { return now },
+				waitGroup: &sync.WaitGroup{},
+				semaphore: semaphore.NewWeighted(2),
+				store:     store,
+			}
+			err := renewer.Run(ctx, now)
+			assert.Equal(t, tt.expectErr, err != nil)
+		})
+	}
+}
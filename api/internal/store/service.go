@@ -10,11 +10,11 @@ import (
 
 type Service interface {
 	// AiChat - AIチャット
-	CreateAiChatSession(ctx context.Context, in *CreateAiChatSessionInput) (*entity.AiChatSession, error)   // セッション作成
-	GetAiChatSession(ctx context.Context, in *GetAiChatSessionInput) (*entity.AiChatSession, error)         // セッション取得
-	ListAiChatSessions(ctx context.Context, in *ListAiChatSessionsInput) (entity.AiChatSessions, error)    // セッション一覧取得
-	CreateAiChatMessage(ctx context.Context, in *CreateAiChatMessageInput) (*entity.AiChatMessage, error)  // メッセージ作成
-	ListAiChatMessages(ctx context.Context, in *ListAiChatMessagesInput) (entity.AiChatMessages, error)    // メッセージ一覧取得
+	CreateAiChatSession(ctx context.Context, in *CreateAiChatSessionInput) (*entity.AiChatSession, error) // セッション作成
+	GetAiChatSession(ctx context.Context, in *GetAiChatSessionInput) (*entity.AiChatSession, error)       // セッション取得
+	ListAiChatSessions(ctx context.Context, in *ListAiChatSessionsInput) (entity.AiChatSessions, error)   // セッション一覧取得
+	CreateAiChatMessage(ctx context.Context, in *CreateAiChatMessageInput) (*entity.AiChatMessage, error) // メッセージ作成
+	ListAiChatMessages(ctx context.Context, in *ListAiChatMessagesInput) (entity.AiChatMessages, error)   // メッセージ一覧取得
 	// Cart - 買い物かご
 	GetCart(ctx context.Context, in *GetCartInput) (*entity.Cart, error)                                // 取得
 	CalcCart(ctx context.Context, in *CalcCartInput) (*entity.Cart, *entity.OrderPaymentSummary, error) // 購入前の支払い情報取得
@@ -43,6 +43,10 @@ type Service interface {
 	NotifyPaymentCaptured(ctx context.Context, in *NotifyPaymentCapturedInput) error                       // 支払い通知（実売上）
 	NotifyPaymentFailed(ctx context.Context, in *NotifyPaymentFailedInput) error                           // 支払い通知（失敗）
 	NotifyPaymentRefunded(ctx context.Context, in *NotifyPaymentRefundedInput) error                       // 返金通知
+	// DeliverySlotRule - 配送日時指定ルール
+	ListDeliverySlotRules(ctx context.Context, in *ListDeliverySlotRulesInput) (entity.DeliverySlotRules, error)   // 一覧取得
+	UpsertDeliverySlotRule(ctx context.Context, in *UpsertDeliverySlotRuleInput) (*entity.DeliverySlotRule, error) // 登録または更新
+	DeleteDeliverySlotRule(ctx context.Context, in *DeleteDeliverySlotRuleInput) error                             // 削除
 	// Experience - 体験
 	ListExperiences(ctx context.Context, in *ListExperiencesInput) (entity.Experiences, int64, error)                      // 一覧取得
 	ListExperiencesByGeolocation(ctx context.Context, in *ListExperiencesByGeolocationInput) (entity.Experiences, error)   // 一覧取得（座標指定）
@@ -52,7 +56,7 @@ type Service interface {
 	CreateExperience(ctx context.Context, in *CreateExperienceInput) (*entity.Experience, error)                           // 登録
 	UpdateExperience(ctx context.Context, in *UpdateExperienceInput) error                                                 // 更新
 	DeleteExperience(ctx context.Context, in *DeleteExperienceInput) error                                                 // 削除
-	UpdateExperiencesPriority(ctx context.Context, in *UpdateExperiencesPriorityInput) error                             // 並び順更新
+	UpdateExperiencesPriority(ctx context.Context, in *UpdateExperiencesPriorityInput) error                               // 並び順更新
 	// ExperienceReview - 体験レビュー
 	ListExperienceReviews(ctx context.Context, in *ListExperienceReviewsInput) (entity.ExperienceReviews, string, error)             // 一覧取得
 	GetExperienceReview(ctx context.Context, in *GetExperienceReviewInput) (*entity.ExperienceReview, error)                         // １件取得
@@ -64,6 +68,12 @@ type Service interface {
 	UpsertExperienceReviewReaction(ctx context.Context, in *UpsertExperienceReviewReactionInput) (*entity.ExperienceReviewReaction, error)     // リアクション登録または更新
 	DeleteExperienceReviewReaction(ctx context.Context, in *DeleteExperienceReviewReactionInput) error                                         // リアクション削除
 	GetUserExperienceReviewReactions(ctx context.Context, in *GetUserExperienceReviewReactionsInput) (entity.ExperienceReviewReactions, error) // ユーザーのリアクション一覧取得
+	// ExperienceSlot - 体験枠
+	ListExperienceSlots(ctx context.Context, in *ListExperienceSlotsInput) (entity.ExperienceSlots, error)   // 一覧取得
+	GetExperienceSlot(ctx context.Context, in *GetExperienceSlotInput) (*entity.ExperienceSlot, error)       // １件取得
+	CreateExperienceSlot(ctx context.Context, in *CreateExperienceSlotInput) (*entity.ExperienceSlot, error) // 登録
+	UpdateExperienceSlot(ctx context.Context, in *UpdateExperienceSlotInput) error                           // 更新
+	DeleteExperienceSlot(ctx context.Context, in *DeleteExperienceSlotInput) error                           // 削除
 	// ExperienceType - 体験種別
 	ListExperienceTypes(ctx context.Context, in *ListExperienceTypesInput) (entity.ExperienceTypes, int64, error)  // 一覧取得
 	MultiGetExperienceTypes(ctx context.Context, in *MultiGetExperienceTypesInput) (entity.ExperienceTypes, error) // 一覧取得（ID指定）
@@ -77,6 +87,10 @@ type Service interface {
 	CreateLive(ctx context.Context, in *CreateLiveInput) (*entity.Live, error)      // 登録
 	UpdateLive(ctx context.Context, in *UpdateLiveInput) error                      // 更新
 	DeleteLive(ctx context.Context, in *DeleteLiveInput) error                      // 削除
+	// LivePin - ライブ配信中の商品ピン留め
+	ListLivePins(ctx context.Context, in *ListLivePinsInput) (entity.LivePins, error)     // 一覧取得
+	PinLiveProduct(ctx context.Context, in *PinLiveProductInput) (*entity.LivePin, error) // ピン留め
+	UnpinLiveProduct(ctx context.Context, in *UnpinLiveProductInput) error                // ピン留め解除
 	// Order - 注文履歴
 	ListOrders(ctx context.Context, in *ListOrdersInput) (entity.Orders, int64, error)                                                           // 一覧取得
 	ListOrderUserIDs(ctx context.Context, in *ListOrderUserIDsInput) ([]string, int64, error)                                                    // 注文したユーザーID一覧取得
@@ -88,17 +102,43 @@ type Service interface {
 	CompleteExperienceOrder(ctx context.Context, in *CompleteExperienceOrderInput) error                                                         // 注文対応完了（商品）
 	CancelOrder(ctx context.Context, in *CancelOrderInput) error                                                                                 // 注文キャンセル
 	RefundOrder(ctx context.Context, in *RefundOrderInput) error                                                                                 // 注文返金依頼
+	ListOrderRefundLines(ctx context.Context, in *ListOrderRefundLinesInput) (entity.OrderRefundLines, error)                                    // 注文返金明細一覧取得
+	GetOrderRefundLine(ctx context.Context, in *GetOrderRefundLineInput) (*entity.OrderRefundLine, error)                                        // 注文返金明細取得
 	UpdateOrderFulfillment(ctx context.Context, in *UpdateOrderFulfillmentInput) error                                                           // 注文配送情報更新
 	AggregateOrders(ctx context.Context, in *AggregateOrdersInput) (*entity.AggregatedOrder, error)                                              // 注文履歴集計結果取得
 	AggregateOrdersByUser(ctx context.Context, in *AggregateOrdersByUserInput) (entity.AggregatedUserOrders, error)                              // ユーザーごとの注文履歴集計結果取得
 	AggregateOrdersByPaymentMethodType(ctx context.Context, in *AggregateOrdersByPaymentMethodTypeInput) (entity.AggregatedOrderPayments, error) // 支払い方法ごとの注文履歴集計結果取得
+	AggregateOrdersByProduct(ctx context.Context, in *AggregateOrdersByProductInput) (entity.AggregatedOrderProducts, error)                     // 商品ごとの注文履歴集計結果取得
 	AggregateOrdersByPromotion(ctx context.Context, in *AggregateOrdersByPromotionInput) (entity.AggregatedOrderPromotions, error)               // プロモーション利用履歴集計結果取得
 	AggregateOrdersByPeriod(ctx context.Context, in *AggregateOrdersByPeriodInput) (entity.AggregatedPeriodOrders, error)                        // 期間ごとの注文履歴集計結果取得
 	ExportOrders(ctx context.Context, in *ExportOrdersInput) ([]byte, error)                                                                     // 注文履歴一覧CSV出力
+	ExportOrderInvoice(ctx context.Context, in *ExportOrderInvoiceInput) ([]byte, error)                                                         // 領収書・請求書PDF出力
+	// OrderClaim - 返品・交換申請
+	ListOrderClaims(ctx context.Context, in *ListOrderClaimsInput) (entity.OrderClaims, int64, error) // 一覧取得
+	GetOrderClaim(ctx context.Context, in *GetOrderClaimInput) (*entity.OrderClaim, error)            // １件取得
+	CreateOrderClaim(ctx context.Context, in *CreateOrderClaimInput) (*entity.OrderClaim, error)      // 申請
+	ApproveOrderClaim(ctx context.Context, in *ApproveOrderClaimInput) error                          // 承認
+	RejectOrderClaim(ctx context.Context, in *RejectOrderClaimInput) error                            // 却下
+	ResolveOrderClaim(ctx context.Context, in *ResolveOrderClaimInput) error                          // 対応完了
+	// OrderTracking - 配送状況
+	ImportOrderTrackings(ctx context.Context, in *ImportOrderTrackingsInput) (int64, error) // 追跡ファイル取り込み
+	SyncOrderDeliveries(ctx context.Context, in *SyncOrderDeliveriesInput) (int64, error)   // 追跡APIとの配達状況同期
+	// OrderShipment - 送り状発行結果
+	ImportOrderShipments(ctx context.Context, in *ImportOrderShipmentsInput) (*entity.OrderShipmentImport, error) // 送り状発行結果取り込み
+	// PaymentEvent - 決済イベント
+	ReceivePaymentEvent(ctx context.Context, in *ReceivePaymentEventInput) error                            // 受信（保存と処理）
+	ListPaymentEvents(ctx context.Context, in *ListPaymentEventsInput) (entity.PaymentEvents, int64, error) // 一覧取得
+	GetPaymentEvent(ctx context.Context, in *GetPaymentEventInput) (*entity.PaymentEvent, error)            // １件取得
+	ReplayPaymentEvent(ctx context.Context, in *ReplayPaymentEventInput) error                              // 再処理
 	// PaymentSystem - 決済システム
 	MultiGetPaymentSystems(ctx context.Context, in *MultiGetPaymentSystemsInput) (entity.PaymentSystems, error) // 一覧取得(種別指定)
 	GetPaymentSystem(ctx context.Context, in *GetPaymentSystemInput) (*entity.PaymentSystem, error)             // １件取得
 	UpdatePaymentSystem(ctx context.Context, in *UpdatePaymentStatusInput) error                                // 更新
+	// PreorderBatch - 予約注文バッチ
+	ListPreorderBatches(ctx context.Context, in *ListPreorderBatchesInput) (entity.PreorderBatches, int64, error) // 一覧取得
+	GetPreorderBatch(ctx context.Context, in *GetPreorderBatchInput) (*entity.PreorderBatch, error)              // １件取得
+	ReadyPreorderBatch(ctx context.Context, in *ReadyPreorderBatchInput) error                                   // 出荷準備完了（予約注文の売上確定）
+	CaptureExpiringPreorders(ctx context.Context, in *CaptureExpiringPreordersInput) error                       // 与信失効前の予約注文の売上確定
 	// PostalCode - 郵便番号
 	SearchPostalCode(ctx context.Context, in *SearchPostalCodeInput) (*entity.PostalCode, error) // 検索
 	// Product - 商品
@@ -109,7 +149,10 @@ type Service interface {
 	CreateProduct(ctx context.Context, in *CreateProductInput) (*entity.Product, error)                           // 登録
 	UpdateProduct(ctx context.Context, in *UpdateProductInput) error                                              // 更新
 	DeleteProduct(ctx context.Context, in *DeleteProductInput) error                                              // 削除
-	UpdateProductsPriority(ctx context.Context, in *UpdateProductsPriorityInput) error                          // 並び順更新
+	UpdateProductsPriority(ctx context.Context, in *UpdateProductsPriorityInput) error                            // 並び順更新
+	// ProductInventory - 商品在庫
+	GetProductInventory(ctx context.Context, in *GetProductInventoryInput) (*entity.ProductInventory, error)     // 在庫状況取得
+	ReleaseExpiredProductInventoryHolds(ctx context.Context, in *ReleaseExpiredProductInventoryHoldsInput) error // 期限切れの仮押さえ解放
 	// ProductReview - 商品レビュー
 	ListProductReviews(ctx context.Context, in *ListProductReviewsInput) (entity.ProductReviews, string, error)             // 一覧取得
 	GetProductReview(ctx context.Context, in *GetProductReviewInput) (*entity.ProductReview, error)                         // １件取得
@@ -143,6 +186,15 @@ type Service interface {
 	CreatePromotion(ctx context.Context, in *CreatePromotionInput) (*entity.Promotion, error)       // 登録
 	UpdatePromotion(ctx context.Context, in *UpdatePromotionInput) error                            // 更新
 	DeletePromotion(ctx context.Context, in *DeletePromotionInput) error                            // 削除
+	// PromotionCode - クーポンコード(一括発行)
+	ListPromotionCodeBatches(ctx context.Context, in *ListPromotionCodeBatchesInput) (entity.PromotionCodeBatches, int64, error) // 一括発行一覧取得
+	GetPromotionCodeBatch(ctx context.Context, in *GetPromotionCodeBatchInput) (*entity.PromotionCodeBatch, error)               // 一括発行取得
+	CreatePromotionCodeBatch(ctx context.Context, in *CreatePromotionCodeBatchInput) (*entity.PromotionCodeBatch, error)         // 一括発行
+	VoidPromotionCodeBatch(ctx context.Context, in *VoidPromotionCodeBatchInput) error                                           // 一括発行分の無効化
+	ListPromotionCodes(ctx context.Context, in *ListPromotionCodesInput) (entity.PromotionCodes, int64, error)                   // クーポンコード一覧取得
+	ExportPromotionCodes(ctx context.Context, in *ExportPromotionCodesInput) ([]byte, error)                                     // クーポンコードのCSV出力
+	// PromotionRedemption - プロモーション利用履歴
+	AggregatePromotionRedemptions(ctx context.Context, in *AggregatePromotionRedemptionsInput) (entity.AggregatedPromotionRedemptions, error) // 利用回数集計結果取得
 	// Schedule - マルシェ開催スケジュール
 	ListSchedules(ctx context.Context, in *ListSchedulesInput) (entity.Schedules, int64, error)  // 一覧取得
 	MultiGetSchedules(ctx context.Context, in *MultiGetSchedulesInput) (entity.Schedules, error) // 一覧取得(ID指定)
@@ -152,6 +204,14 @@ type Service interface {
 	DeleteSchedule(ctx context.Context, in *DeleteScheduleInput) error                           // 削除
 	ApproveSchedule(ctx context.Context, in *ApproveScheduleInput) error                         // 承認
 	PublishSchedule(ctx context.Context, in *PublishScheduleInput) error                         // 公開
+	// SettlementStatement - 精算明細
+	ListSettlementStatements(ctx context.Context, in *ListSettlementStatementsInput) (entity.SettlementStatements, int64, error) // 一覧取得
+	GetSettlementStatement(ctx context.Context, in *GetSettlementStatementInput) (*entity.SettlementStatement, error)            // １件取得
+	CloseSettlementPeriod(ctx context.Context, in *CloseSettlementPeriodInput) (entity.SettlementStatements, error)              // 精算対象期間の締め（精算明細の作成）
+	PaySettlementStatement(ctx context.Context, in *PaySettlementStatementInput) error                                           // 支払い済みにする
+	AggregateSettlementBalances(ctx context.Context, in *AggregateSettlementBalancesInput) (entity.SettlementBalances, error)    // 精算先ごとの支払い残高取得
+	ExportSettlementStatements(ctx context.Context, in *ExportSettlementStatementsInput) ([]byte, error)                         // 一覧CSV出力
+	ExportSettlementStatement(ctx context.Context, in *ExportSettlementStatementInput) ([]byte, error)                           // 支払明細書PDF出力
 	// Shipping - 配送設定
 	ListShippingsByShopID(ctx context.Context, in *ListShippingsByShopIDInput) (entity.Shippings, int64, error)      // 一覧取得(店舗ID指定)
 	ListShippingsByShopIDs(ctx context.Context, in *ListShippingsByShopIDsInput) (entity.Shippings, error)           // 一覧取得(店舗ID指定)
@@ -180,4 +240,13 @@ type Service interface {
 	CreateSpotType(ctx context.Context, in *CreateSpotTypeInput) (*entity.SpotType, error)       // 登録
 	UpdateSpotType(ctx context.Context, in *UpdateSpotTypeInput) error                           // 更新
 	DeleteSpotType(ctx context.Context, in *DeleteSpotTypeInput) error                           // 削除
+	// Subscription - 定期便
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsInput) (entity.Subscriptions, int64, error) // 一覧取得
+	GetSubscription(ctx context.Context, in *GetSubscriptionInput) (*entity.Subscription, error)            // １件取得
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionInput) (*entity.Subscription, error)      // 申込
+	SkipSubscription(ctx context.Context, in *SkipSubscriptionInput) (*entity.Subscription, error)          // 次回お届けのスキップ
+	PauseSubscription(ctx context.Context, in *PauseSubscriptionInput) (*entity.Subscription, error)        // 一時停止
+	ResumeSubscription(ctx context.Context, in *ResumeSubscriptionInput) (*entity.Subscription, error)      // 再開
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionInput) (*entity.Subscription, error)      // 解約
+	RenewSubscription(ctx context.Context, in *RenewSubscriptionInput) error                                // 定期注文の作成
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/store"
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/user"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
 	"github.com/and-period/furumaru/api/pkg/backoff"
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
 	"github.com/and-period/furumaru/api/pkg/log"
@@ -50,6 +51,7 @@ func (s *service) CalcCart(ctx context.Context, in *store.CalcCartInput) (*entit
 		shipping  *entity.Shipping
 		cart      *entity.Cart
 		promotion *entity.Promotion
+		addresses map[string]*uentity.Address
 	)
 	eg, ectx := errgroup.WithContext(ctx)
 	// カートの取得
@@ -57,6 +59,14 @@ func (s *service) CalcCart(ctx context.Context, in *store.CalcCartInput) (*entit
 		cart, err = s.getCart(ectx, in.SessionID)
 		return
 	})
+	// 配送先住所の取得（複数配送先の場合）
+	eg.Go(func() (err error) {
+		if len(in.Destinations) == 0 {
+			return
+		}
+		addresses, err = s.getDestinationAddresses(ectx, in.UserID, in.Destinations)
+		return
+	})
 	// 配送設定の取得
 	eg.Go(func() (err error) {
 		shipping, err = s.getShippingByCoordinatorID(ectx, in.CoordinatorID)
@@ -67,7 +77,10 @@ func (s *service) CalcCart(ctx context.Context, in *store.CalcCartInput) (*entit
 		if in.PromotionCode == "" {
 			return
 		}
-		promotion, err = s.db.Promotion.GetByCode(ectx, in.PromotionCode)
+		promotion, err = s.getPromotionByCode(ectx, in.PromotionCode)
+		if errors.Is(err, entity.ErrPromotionCodeUnavailable) {
+			err = nil // 使用済みのコードはプロモーション未適用として計算する
+		}
 		if promotion.IsEnabled(shop.ID) {
 			return
 		}
@@ -88,11 +101,23 @@ func (s *service) CalcCart(ctx context.Context, in *store.CalcCartInput) (*entit
 	if err != nil {
 		return nil, nil, internalError(err)
 	}
+	// 複数配送先の場合、注文時と同じく配送先ごとに箱詰めして送料を計算する
+	destinations, err := s.newOrderDestinations(in.Destinations, addresses, baskets, products)
+	if err != nil {
+		return nil, nil, err
+	}
+	productTypes, err := s.listPromotionProductTypes(ctx, promotion, products)
+	if err != nil {
+		return nil, nil, internalError(err)
+	}
 	params := &entity.NewProductOrderPaymentSummaryParams{
 		PrefectureCode: in.PrefectureCode,
+		PostalCode:     in.PostalCode,
 		Pickup:         in.Pickup,
 		Baskets:        baskets,
+		Destinations:   destinations,
 		Products:       products,
+		ProductTypes:   productTypes,
 		Shipping:       shipping,
 		Promotion:      promotion,
 	}
@@ -112,7 +137,7 @@ func (s *service) AddCartItem(ctx context.Context, in *store.AddCartItemInput) e
 	if err != nil {
 		return internalError(err)
 	}
-	if product.Status != entity.ProductStatusForSale {
+	if !product.Orderable() {
 		return fmt.Errorf("service: this product is out of sale: %w", exception.ErrForbidden)
 	}
 	cart, err := s.getCart(ctx, in.SessionID)
@@ -198,7 +223,7 @@ func (s *service) refreshCart(ctx context.Context, cart *entity.Cart) error {
 	if err != nil {
 		return err
 	}
-	if err := cart.Refresh(products.FilterBySales()); err != nil {
+	if err := cart.Refresh(products.FilterByOrderable()); err != nil {
 		return err
 	}
 	now := s.now()
@@ -8,13 +8,14 @@ import (
 	"github.com/and-period/furumaru/api/internal/codes"
 	"github.com/and-period/furumaru/api/internal/exception"
 	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/user"
 	uentity "github.com/and-period/furumaru/api/internal/user/entity"
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
 	"github.com/and-period/furumaru/api/pkg/set"
-	"go.uber.org/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 )
 
 func TestGetCart(t *testing.T) {
@@ -405,12 +406,74 @@ func TestCalcCart(t *testing.T) {
 			},
 			expectCart: cart,
 			expectSummary: &entity.OrderPaymentSummary{
-				Subtotal:    1000,
-				Discount:    100,
+				Subtotal: 1000,
+				Discount: 100,
+				ItemDiscounts: entity.PromotionDiscounts{
+					{ProductID: "product-id", Amount: 100},
+				},
 				ShippingFee: 500,
 				Tax:         127,
 				TaxRate:     10,
 				Total:       1400,
+				TaxBreakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 1400,
+					StandardTax:    127,
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with category rule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				shopIn := &user.GetShopByCoordinatorIDInput{CoordinatorID: "coordinator-id"}
+				shop := &uentity.Shop{ID: "shop-id"}
+				promotion := &entity.Promotion{
+					ID:           "promotion-id",
+					Status:       entity.PromotionStatusEnabled,
+					TargetType:   entity.PromotionTargetTypeAllShop,
+					DiscountType: entity.DiscountTypeRule,
+					Rules: entity.PromotionRules{
+						{
+							ID:           "rule-id",
+							Type:         entity.PromotionRuleTypeRate,
+							CategoryIDs:  []string{"category-id"},
+							DiscountRate: 20,
+						},
+					},
+					Code: "code1234",
+				}
+				products := products(30)
+				products[0].TypeID = "product-type-id"
+				productTypes := entity.ProductTypes{{ID: "product-type-id", CategoryID: "category-id"}}
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+				cartmocks(mocks, cart.SessionID, cart, nil)
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products, nil)
+				mocks.db.ProductType.EXPECT().MultiGet(ctx, []string{"product-type-id"}).Return(productTypes, nil)
+			},
+			input: &store.CalcCartInput{
+				SessionID:      "session-id",
+				CoordinatorID:  "coordinator-id",
+				BoxNumber:      0,
+				PromotionCode:  "code1234",
+				PrefectureCode: 13,
+			},
+			expectCart: cart,
+			expectSummary: &entity.OrderPaymentSummary{
+				Subtotal: 1000,
+				Discount: 200,
+				ItemDiscounts: entity.PromotionDiscounts{
+					{ProductID: "product-id", RuleID: "rule-id", Amount: 200},
+				},
+				ShippingFee: 500,
+				Tax:         118,
+				TaxRate:     10,
+				Total:       1300,
+				TaxBreakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 1300,
+					StandardTax:    118,
+				},
 			},
 			expectErr: nil,
 		},
@@ -439,6 +502,45 @@ func TestCalcCart(t *testing.T) {
 				Tax:         90,
 				TaxRate:     10,
 				Total:       1000,
+				TaxBreakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 1000,
+					StandardTax:    90,
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with used promotion code",
+			setup: func(ctx context.Context, mocks *mocks) {
+				shopIn := &user.GetShopByCoordinatorIDInput{CoordinatorID: "coordinator-id"}
+				shop := &uentity.Shop{ID: "shop-id"}
+				code := &entity.PromotionCode{Code: "ABCD2345", PromotionID: "promotion-id", Status: entity.PromotionCodeStatusRedeemed}
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+				cartmocks(mocks, cart.SessionID, cart, nil)
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "ABCD2345").Return(nil, database.ErrNotFound)
+				mocks.db.PromotionCode.EXPECT().Get(gomock.Any(), "ABCD2345").Return(code, nil)
+				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products(30), nil)
+			},
+			input: &store.CalcCartInput{
+				SessionID:      "session-id",
+				CoordinatorID:  "coordinator-id",
+				BoxNumber:      0,
+				PromotionCode:  "ABCD2345",
+				PrefectureCode: 0,
+			},
+			expectCart: cart,
+			expectSummary: &entity.OrderPaymentSummary{
+				Subtotal:    1000,
+				Discount:    0,
+				ShippingFee: 0,
+				Tax:         90,
+				TaxRate:     10,
+				Total:       1000,
+				TaxBreakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 1000,
+					StandardTax:    90,
+				},
 			},
 			expectErr: nil,
 		},
@@ -462,15 +564,115 @@ func TestCalcCart(t *testing.T) {
 			},
 			expectCart: cart,
 			expectSummary: &entity.OrderPaymentSummary{
-				Subtotal:    1000,
-				Discount:    100,
+				Subtotal: 1000,
+				Discount: 100,
+				ItemDiscounts: entity.PromotionDiscounts{
+					{ProductID: "product-id", Amount: 100},
+				},
 				ShippingFee: 0,
 				Tax:         81,
 				TaxRate:     10,
 				Total:       900,
+				TaxBreakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 900,
+					StandardTax:    81,
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with destinations",
+			setup: func(ctx context.Context, mocks *mocks) {
+				shopIn := &user.GetShopByCoordinatorIDInput{CoordinatorID: "coordinator-id"}
+				shop := &uentity.Shop{ID: "shop-id"}
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+				cartmocks(mocks, cart.SessionID, cart, nil)
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+				mocks.user.EXPECT().
+					GetAddress(gomock.Any(), &user.GetAddressInput{UserID: "user-id", AddressID: "address-tokyo"}).
+					Return(&uentity.Address{ID: "address-tokyo", AddressRevision: uentity.AddressRevision{PrefectureCode: 13}}, nil)
+				mocks.user.EXPECT().
+					GetAddress(gomock.Any(), &user.GetAddressInput{UserID: "user-id", AddressID: "address-ehime"}).
+					Return(&uentity.Address{ID: "address-ehime", AddressRevision: uentity.AddressRevision{PrefectureCode: 38}}, nil)
+				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products(30), nil)
+			},
+			input: &store.CalcCartInput{
+				SessionID:     "session-id",
+				UserID:        "user-id",
+				CoordinatorID: "coordinator-id",
+				BoxNumber:     0,
+				Destinations: []*store.CheckoutDestination{
+					{
+						ShippingAddressID: "address-tokyo",
+						Items:             []*store.CheckoutDestinationItem{{ProductID: "product-id", Quantity: 1}},
+					},
+					{
+						ShippingAddressID: "address-ehime",
+						Items:             []*store.CheckoutDestinationItem{{ProductID: "product-id", Quantity: 1}},
+					},
+				},
+			},
+			expectCart: cart,
+			expectSummary: &entity.OrderPaymentSummary{
+				Subtotal:    1000,
+				Discount:    0,
+				ShippingFee: 750,
+				Tax:         159,
+				TaxRate:     10,
+				Total:       1750,
+				TaxBreakdown: &entity.OrderTaxBreakdown{
+					StandardTarget: 1750,
+					StandardTax:    159,
+				},
 			},
 			expectErr: nil,
 		},
+		{
+			name: "failed to unmatch destination quantity",
+			setup: func(ctx context.Context, mocks *mocks) {
+				shopIn := &user.GetShopByCoordinatorIDInput{CoordinatorID: "coordinator-id"}
+				shop := &uentity.Shop{ID: "shop-id"}
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+				cartmocks(mocks, cart.SessionID, cart, nil)
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+				mocks.user.EXPECT().
+					GetAddress(gomock.Any(), &user.GetAddressInput{UserID: "user-id", AddressID: "address-tokyo"}).
+					Return(&uentity.Address{ID: "address-tokyo", AddressRevision: uentity.AddressRevision{PrefectureCode: 13}}, nil)
+				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products(30), nil)
+			},
+			input: &store.CalcCartInput{
+				SessionID:     "session-id",
+				UserID:        "user-id",
+				CoordinatorID: "coordinator-id",
+				BoxNumber:     0,
+				Destinations: []*store.CheckoutDestination{
+					{
+						ShippingAddressID: "address-tokyo",
+						Items:             []*store.CheckoutDestinationItem{{ProductID: "product-id", Quantity: 1}},
+					},
+				},
+			},
+			expectCart:    nil,
+			expectSummary: nil,
+			expectErr:     exception.ErrInvalidArgument,
+		},
+		{
+			name:  "failed to destinations without user",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.CalcCartInput{
+				SessionID:     "session-id",
+				CoordinatorID: "coordinator-id",
+				Destinations: []*store.CheckoutDestination{
+					{
+						ShippingAddressID: "address-tokyo",
+						Items:             []*store.CheckoutDestinationItem{{ProductID: "product-id", Quantity: 2}},
+					},
+				},
+			},
+			expectCart:    nil,
+			expectSummary: nil,
+			expectErr:     exception.ErrInvalidArgument,
+		},
 		{
 			name:          "invalid argument",
 			setup:         func(ctx context.Context, mocks *mocks) {},
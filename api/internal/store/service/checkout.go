@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/and-period/furumaru/api/internal/exception"
 	"github.com/and-period/furumaru/api/internal/store"
@@ -17,7 +19,6 @@ import (
 	"github.com/and-period/furumaru/api/pkg/japanese"
 	"github.com/and-period/furumaru/api/pkg/log"
 	"golang.org/x/sync/errgroup"
-	"golang.org/x/sync/semaphore"
 )
 
 var errDuplicateOrder = errors.New("service: duplicate order")
@@ -283,7 +284,8 @@ type checkoutParams struct {
 	customer          *uentity.User
 	billingAddress    *uentity.Address
 	shippingAddress   *uentity.Address
-	useHostedPage     bool // trueの場合、payFnを呼ばずKOMOJUホスト決済ページURLを返す
+	destinations      map[string]*uentity.Address // 配送先住所ID -> 配送先住所（複数配送先の場合）
+	useHostedPage     bool                        // trueの場合、payFnを呼ばずKOMOJUホスト決済ページURLを返す
 	payFn             func(ctx context.Context, prov payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error)
 }
 
@@ -342,6 +344,14 @@ func (s *service) checkout(ctx context.Context, params *checkoutParams) (string,
 		params.shippingAddress, err = s.user.GetAddress(ectx, in)
 		return
 	})
+	// 複数配送先の住所取得
+	eg.Go(func() (err error) {
+		if len(params.payload.Destinations) == 0 {
+			return
+		}
+		params.destinations, err = s.getDestinationAddresses(ectx, params.payload.UserID, params.payload.Destinations)
+		return
+	})
 	if err := eg.Wait(); err != nil {
 		return "", internalError(err)
 	}
@@ -382,7 +392,7 @@ func (s *service) checkoutProduct(ctx context.Context, params *checkoutParams) (
 		if params.payload.PromotionCode == "" {
 			return
 		}
-		promotion, err = s.db.Promotion.GetByCode(ectx, params.payload.PromotionCode)
+		promotion, err = s.getPromotionByCode(ectx, params.payload.PromotionCode)
 		return
 	})
 	err = eg.Wait()
@@ -393,7 +403,7 @@ func (s *service) checkoutProduct(ctx context.Context, params *checkoutParams) (
 		return "", internalError(err)
 	}
 	// 配送先住所の必須チェック
-	if !params.payload.Pickup && params.shippingAddress == nil {
+	if !params.payload.Pickup && params.shippingAddress == nil && len(params.destinations) == 0 {
 		return "", fmt.Errorf("service: shipping address is required: %w", exception.ErrFailedPrecondition)
 	}
 	// プロモーションの有効性検証
@@ -413,8 +423,8 @@ func (s *service) checkoutProduct(ctx context.Context, params *checkoutParams) (
 	if err != nil {
 		return "", internalError(err)
 	}
-	products = products.FilterBySales()
-	// 商品がすべて販売中かの確認
+	products = products.FilterByOrderable()
+	// 商品がすべて販売中（または予約受付中）かの確認
 	if len(products) > 0 && len(productIDs) != len(products) {
 		slog.WarnContext(ctx, "Failed because there are products outside the sales period",
 			slog.String("userId", params.payload.UserID), slog.String("sessionId", params.payload.SessionID),
@@ -428,24 +438,60 @@ func (s *service) checkoutProduct(ctx context.Context, params *checkoutParams) (
 			slog.String("coordinatorId", params.payload.CoordinatorID), slog.Int64("boxNumber", params.payload.BoxNumber))
 		return "", fmt.Errorf("service: insufficient stock: %w: %s", exception.ErrFailedPrecondition, err.Error())
 	}
+	// 複数配送先の場合、配送先ごとの箱詰めと数量の検証
+	destinations, err := s.newOrderDestinations(params.payload.Destinations, params.destinations, baskets, products)
+	if err != nil {
+		return "", err
+	}
+	// お届け希望日時の検証
+	deliveryDate, err := s.verifyDeliverySlot(ctx, shop.ID, baskets, params.payload)
+	if err != nil {
+		return "", err
+	}
+	// 予約商品が含まれる場合、出荷予定期間ごとの予約注文バッチを取得
+	preorderBatch, err := s.getPreorderBatch(ctx, shop.ID, params.payload.CoordinatorID, products)
+	if err != nil {
+		return "", err
+	}
+	// 予約注文は与信のみ行い出荷準備完了時に売上確定するため、後払いの決済手段は利用できないようにする
+	if preorderBatch != nil && slices.Contains(entity.DeferredPaymentMethodTypes, params.paymentMethodType) {
+		return "", fmt.Errorf("service: deferred payment is not available for preorder: %w", exception.ErrFailedPrecondition)
+	}
+	// 割引対象判定に利用する品目一覧を取得
+	productTypes, err := s.listPromotionProductTypes(ctx, promotion, products)
+	if err != nil {
+		return "", internalError(err)
+	}
 	// 注文インスタンスの生成
 	oparams := &entity.NewProductOrderParams{
-		OrderID:           params.payload.RequestID,
-		SessionID:         params.payload.SessionID,
-		ShopID:            shop.ID,
-		CoordinatorID:     params.payload.CoordinatorID,
-		Customer:          params.customer,
-		BillingAddress:    params.billingAddress,
-		ShippingAddress:   params.shippingAddress,
-		Shipping:          shipping,
-		Baskets:           baskets,
-		Products:          products,
-		PaymentMethodType: params.paymentMethodType,
-		Promotion:         promotion,
-		Pickup:            params.payload.Pickup,
-		PickupAt:          params.payload.PickupAt,
-		PickupLocation:    params.payload.PickupLocation,
-		OrderRequest:      params.payload.OrderRequest,
+		OrderID:            params.payload.RequestID,
+		SessionID:          params.payload.SessionID,
+		ShopID:             shop.ID,
+		CoordinatorID:      params.payload.CoordinatorID,
+		Customer:           params.customer,
+		BillingAddress:     params.billingAddress,
+		ShippingAddress:    params.shippingAddress,
+		Destinations:       destinations,
+		Shipping:           shipping,
+		Baskets:            baskets,
+		Products:           products,
+		ProductTypes:       productTypes,
+		PaymentMethodType:  params.paymentMethodType,
+		Promotion:          promotion,
+		PreorderBatch:      preorderBatch,
+		Pickup:             params.payload.Pickup,
+		PickupAt:           params.payload.PickupAt,
+		PickupLocation:     params.payload.PickupLocation,
+		OrderRequest:       params.payload.OrderRequest,
+		DeliveryDate:       deliveryDate,
+		DeliveryTimeWindow: params.payload.DeliveryTimeWindow,
+	}
+	if params.payload.Gift {
+		oparams.Gift = &entity.OrderGiftParams{
+			NoshiType:   params.payload.NoshiType,
+			NoshiName:   params.payload.NoshiName,
+			MessageCard: params.payload.MessageCard,
+		}
 	}
 	order, err := entity.NewProductOrder(oparams)
 	if err != nil {
@@ -459,6 +505,15 @@ func (s *service) checkoutProduct(ctx context.Context, params *checkoutParams) (
 			slog.Int64("payload.total", params.payload.Total), slog.Any("payment", order.OrderPayment))
 		return "", fmt.Errorf("service: unmatch total: %w", exception.ErrInvalidArgument)
 	}
+	// 商品在庫の仮押さえ
+	if err := s.holdProductInventories(ctx, order, products); err != nil {
+		return "", err
+	}
+	// プロモーション利用枠の確保
+	if err := s.redeemPromotion(ctx, order, params.payload.PromotionCode); err != nil {
+		s.releaseProductInventories(context.Background(), order)
+		return "", err
+	}
 	// 支払い処理
 	var (
 		redirectURL string
@@ -473,9 +528,15 @@ func (s *service) checkoutProduct(ctx context.Context, params *checkoutParams) (
 		return redirectURL, nil
 	}
 	if err != nil {
+		s.releaseProductInventories(context.Background(), order)
+		s.releasePromotionRedemption(context.Background(), order)
 		return "", err
 	}
-	s.waitGroup.Add(3)
+	if order.Total == 0 {
+		s.sellProductInventories(ctx, order)
+		s.confirmPromotionRedemption(ctx, order)
+	}
+	s.waitGroup.Add(2)
 	// 支払い完了後の処理
 	go func() {
 		defer s.waitGroup.Done()
@@ -494,17 +555,14 @@ func (s *service) checkoutProduct(ctx context.Context, params *checkoutParams) (
 				slog.Int("methodType", int(params.paymentMethodType)), log.Error(err))
 		}
 	}()
-	// 商品の在庫を減算
-	go func() {
-		defer s.waitGroup.Done()
-		s.decreaseProductInventories(context.Background(), order.OrderItems)
-	}()
 	return redirectURL, nil
 }
 
 func (s *service) checkoutExperience(ctx context.Context, params *checkoutParams) (string, error) {
 	var (
 		experience *entity.Experience
+		slot       *entity.ExperienceSlot
+		slots      entity.ExperienceSlots
 		promotion  *entity.Promotion
 	)
 	eg, ectx := errgroup.WithContext(ctx)
@@ -513,12 +571,32 @@ func (s *service) checkoutExperience(ctx context.Context, params *checkoutParams
 		experience, err = s.db.Experience.Get(ectx, params.payload.ExperienceID)
 		return
 	})
+	// 体験枠の取得
+	eg.Go(func() (err error) {
+		if params.payload.SlotID == "" {
+			return
+		}
+		slot, err = s.db.ExperienceSlot.Get(ectx, params.payload.SlotID)
+		return
+	})
+	// 開催予定の体験枠の取得（体験枠の指定有無の検証用）
+	eg.Go(func() (err error) {
+		if params.payload.SlotID != "" {
+			return
+		}
+		in := &database.ListExperienceSlotsParams{
+			ExperienceID: params.payload.ExperienceID,
+			StartAtGte:   s.now(),
+		}
+		slots, err = s.db.ExperienceSlot.List(ectx, in)
+		return
+	})
 	// プロモーションの取得
 	eg.Go(func() (err error) {
 		if params.payload.PromotionCode == "" {
 			return
 		}
-		promotion, err = s.db.Promotion.GetByCode(ectx, params.payload.PromotionCode)
+		promotion, err = s.getPromotionByCode(ectx, params.payload.PromotionCode)
 		return
 	})
 	err := eg.Wait()
@@ -531,6 +609,10 @@ func (s *service) checkoutExperience(ctx context.Context, params *checkoutParams
 	if params.payload.Pickup {
 		return "", fmt.Errorf("service: experience order cannot be pickup: %w", exception.ErrForbidden)
 	}
+	// 体験枠を利用している体験の場合、定員管理のため体験枠の指定を必須とする
+	if len(slots) > 0 {
+		return "", fmt.Errorf("service: experience slot is required: %w", exception.ErrInvalidArgument)
+	}
 	// プロモーションの有効性検証
 	if params.payload.PromotionCode != "" && !promotion.IsEnabled(experience.ShopID) {
 		slog.WarnContext(ctx, "Failed to disable promotion",
@@ -563,6 +645,7 @@ func (s *service) checkoutExperience(ctx context.Context, params *checkoutParams
 		Customer:              params.customer,
 		BillingAddress:        params.billingAddress,
 		Experience:            experience,
+		Slot:                  slot,
 		PaymentMethodType:     params.paymentMethodType,
 		Promotion:             promotion,
 		AdultCount:            params.payload.AdultCount,
@@ -587,6 +670,15 @@ func (s *service) checkoutExperience(ctx context.Context, params *checkoutParams
 			slog.Int64("payload.total", params.payload.Total), slog.Any("payment", order.OrderPayment))
 		return "", fmt.Errorf("service: unmatch total: %w", exception.ErrInvalidArgument)
 	}
+	// 体験枠の仮押さえ
+	if err := s.reserveExperienceSlot(ctx, order); err != nil {
+		return "", err
+	}
+	// プロモーション利用枠の確保
+	if err := s.redeemPromotion(ctx, order, params.payload.PromotionCode); err != nil {
+		s.releaseExperienceSlot(context.Background(), order)
+		return "", err
+	}
 	var (
 		redirectURL string
 		afterFn     func(context.Context)
@@ -601,8 +693,14 @@ func (s *service) checkoutExperience(ctx context.Context, params *checkoutParams
 		return redirectURL, nil
 	}
 	if err != nil {
+		s.releaseExperienceSlot(context.Background(), order)
+		s.releasePromotionRedemption(context.Background(), order)
 		return "", err
 	}
+	if order.Total == 0 {
+		s.confirmExperienceSlot(ctx, order)
+		s.confirmPromotionRedemption(ctx, order)
+	}
 	s.waitGroup.Add(1)
 	// 支払い完了後の処理
 	go func() {
@@ -703,6 +801,147 @@ func (s *service) executeFreeOrder(
 	return redirectURL, afterFn, nil
 }
 
+func (s *service) reserveExperienceSlot(ctx context.Context, order *entity.Order) error {
+	if order.OrderExperience.SlotID == "" {
+		return nil
+	}
+	params := &entity.NewExperienceSlotReservationParams{
+		OrderID:   order.ID,
+		SlotID:    order.OrderExperience.SlotID,
+		Quantity:  order.OrderExperience.Participants(),
+		ExpiredAt: s.holdExpiredAt(order),
+	}
+	reservation := entity.NewExperienceSlotReservation(params)
+	err := s.db.ExperienceSlot.Reserve(ctx, reservation)
+	if errors.Is(err, database.ErrAlreadyExists) {
+		// 同一注文で仮押さえ済みの場合は何もしない
+		return nil
+	}
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		slog.WarnContext(ctx, "Failed to reserve experience slot",
+			slog.String("orderId", order.ID), slog.String("slotId", reservation.SlotID), slog.Int64("quantity", reservation.Quantity))
+		return fmt.Errorf("service: insufficient experience capacity: %w", exception.ErrFailedPrecondition)
+	}
+	return internalError(err)
+}
+
+func (s *service) confirmExperienceSlot(ctx context.Context, order *entity.Order) {
+	if order.OrderExperience.SlotID == "" {
+		return
+	}
+	if err := s.db.ExperienceSlot.Confirm(ctx, order.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to confirm experience slot", slog.String("orderId", order.ID), log.Error(err))
+	}
+}
+
+func (s *service) releaseExperienceSlot(ctx context.Context, order *entity.Order) {
+	if order.OrderExperience.SlotID == "" {
+		return
+	}
+	if err := s.db.ExperienceSlot.Release(ctx, order.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to release experience slot", slog.String("orderId", order.ID), log.Error(err))
+	}
+}
+
+// holdExpiredAt - 在庫・体験枠・プロモーション利用枠の仮押さえ期限
+func (s *service) holdExpiredAt(order *entity.Order) time.Time {
+	ttl := s.inventoryHoldTTL
+	if order.IsDeferredPayment() {
+		ttl = s.deferredHoldTTL
+	}
+	return s.now().Add(ttl)
+}
+
+func (s *service) holdProductInventories(ctx context.Context, order *entity.Order, products entity.Products) error {
+	params := &entity.NewProductInventoryHoldsParams{
+		OrderID:   order.ID,
+		Items:     order.OrderItems,
+		Products:  products,
+		ExpiredAt: s.holdExpiredAt(order),
+	}
+	holds, err := entity.NewProductInventoryHolds(params)
+	if err != nil {
+		return internalError(err)
+	}
+	err = s.db.ProductInventoryHold.Hold(ctx, holds)
+	if errors.Is(err, database.ErrAlreadyExists) {
+		// 同一注文で仮押さえ済みの場合は何もしない
+		return nil
+	}
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		slog.WarnContext(ctx, "Failed to hold product inventories", slog.String("orderId", order.ID), log.Error(err))
+		return fmt.Errorf("service: insufficient stock: %w", exception.ErrFailedPrecondition)
+	}
+	return internalError(err)
+}
+
+func (s *service) sellProductInventories(ctx context.Context, order *entity.Order) {
+	if order.Type != entity.OrderTypeProduct {
+		return
+	}
+	if err := s.db.ProductInventoryHold.Sell(ctx, order.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to sell product inventories", slog.String("orderId", order.ID), log.Error(err))
+	}
+}
+
+func (s *service) releaseProductInventories(ctx context.Context, order *entity.Order) {
+	if order.Type != entity.OrderTypeProduct {
+		return
+	}
+	err := s.db.ProductInventoryHold.Release(ctx, order.ID)
+	if errors.Is(err, database.ErrNotFound) {
+		// 在庫の仮押さえ導入前の注文の場合、在庫数を直接戻す
+		s.increaseProductInventories(ctx, order.OrderItems)
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to release product inventories", slog.String("orderId", order.ID), log.Error(err))
+	}
+}
+
+func (s *service) redeemPromotion(ctx context.Context, order *entity.Order, code string) error {
+	if order.PromotionID == "" {
+		return nil
+	}
+	params := &entity.NewPromotionRedemptionParams{
+		OrderID:     order.ID,
+		PromotionID: order.PromotionID,
+		UserID:      order.UserID,
+		Code:        code,
+		ExpiredAt:   s.holdExpiredAt(order),
+	}
+	redemption := entity.NewPromotionRedemption(params)
+	err := s.db.PromotionRedemption.Redeem(ctx, redemption)
+	if errors.Is(err, database.ErrAlreadyExists) {
+		// 同一注文で確保済みの場合は何もしない
+		return nil
+	}
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		slog.WarnContext(ctx, "Failed to redeem promotion",
+			slog.String("orderId", order.ID), slog.String("promotionId", order.PromotionID), log.Error(err))
+		return fmt.Errorf("service: promotion redemption limit exceeded: %w", exception.ErrFailedPrecondition)
+	}
+	return internalError(err)
+}
+
+func (s *service) confirmPromotionRedemption(ctx context.Context, order *entity.Order) {
+	if order.PromotionID == "" {
+		return
+	}
+	if err := s.db.PromotionRedemption.Confirm(ctx, order.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to confirm promotion redemption", slog.String("orderId", order.ID), log.Error(err))
+	}
+}
+
+func (s *service) releasePromotionRedemption(ctx context.Context, order *entity.Order) {
+	if order.PromotionID == "" {
+		return
+	}
+	if err := s.db.PromotionRedemption.Release(ctx, order.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to release promotion redemption", slog.String("orderId", order.ID), log.Error(err))
+	}
+}
+
 func (s *service) getShippingByCoordinatorID(ctx context.Context, coordinatorID string) (*entity.Shipping, error) {
 	shipping, err := s.db.Shipping.GetByCoordinatorID(ctx, coordinatorID)
 	if errors.Is(err, database.ErrNotFound) {
@@ -712,25 +951,57 @@ func (s *service) getShippingByCoordinatorID(ctx context.Context, coordinatorID
 	return shipping, err
 }
 
-func (s *service) decreaseProductInventories(ctx context.Context, items entity.OrderItems) {
-	sem := semaphore.NewWeighted(3)
-	for _, item := range items {
-		if err := sem.Acquire(ctx, 1); err != nil {
-			slog.ErrorContext(ctx, "Failed to semaphore acuire", slog.Any("item", item), log.Error(err))
-			return
+func (s *service) getDestinationAddresses(
+	ctx context.Context, userID string, destinations []*store.CheckoutDestination,
+) (map[string]*uentity.Address, error) {
+	res := make(map[string]*uentity.Address, len(destinations))
+	for _, d := range destinations {
+		if _, ok := res[d.ShippingAddressID]; ok {
+			continue
 		}
-		s.waitGroup.Add(1)
-		go func(item *entity.OrderItem) {
-			defer func() {
-				sem.Release(1)
-				s.waitGroup.Done()
-			}()
-			err := s.decreaseProductInventory(ctx, item.ProductRevisionID, item.Quantity)
-			if err != nil {
-				slog.ErrorContext(ctx, "Failed to decrease product inventory", slog.Any("item", item), log.Error(err))
-			}
-		}(item)
+		in := &user.GetAddressInput{
+			UserID:    userID,
+			AddressID: d.ShippingAddressID,
+		}
+		address, err := s.user.GetAddress(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		res[d.ShippingAddressID] = address
+	}
+	return res, nil
+}
+
+func (s *service) newOrderDestinations(
+	destinations []*store.CheckoutDestination,
+	addresses map[string]*uentity.Address,
+	baskets entity.CartBaskets,
+	products entity.Products,
+) (entity.OrderDestinations, error) {
+	if len(destinations) == 0 {
+		return nil, nil
+	}
+	productMap := products.Map()
+	dparams := make([]*entity.NewOrderDestinationParams, 0, len(destinations))
+	for _, d := range destinations {
+		items := make(entity.CartItems, 0, len(d.Items))
+		for _, item := range d.Items {
+			items = append(items, entity.NewCartItem(item.ProductID, item.Quantity))
+		}
+		dparams = append(dparams, &entity.NewOrderDestinationParams{
+			Address:  addresses[d.ShippingAddressID],
+			Items:    items,
+			Products: productMap,
+		})
+	}
+	res, err := entity.NewOrderDestinations(dparams)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to new order destination: %w: %s", exception.ErrInvalidArgument, err.Error())
+	}
+	if err := res.Verify(baskets); err != nil {
+		return nil, fmt.Errorf("service: unmatch destination quantity: %w: %s", exception.ErrInvalidArgument, err.Error())
 	}
+	return res, nil
 }
 
 func (s *service) decreaseProductInventory(ctx context.Context, revisionID, quantity int64) error {
@@ -43,6 +43,25 @@ func (s *service) NotifyPaymentAuthorized(ctx context.Context, in *store.NotifyP
 		return internalError(err)
 	}
 
+	// 予約注文の場合、出荷準備が完了するまで売上確定を保留する
+	hold, err := s.holdPreorderCapture(ctx, order)
+	if err != nil {
+		return internalError(err)
+	}
+	if hold {
+		slog.InfoContext(ctx, "Order is authorized but waiting for preorder shipping",
+			slog.String("orderId", in.OrderID), slog.String("preorderBatchId", order.PreorderBatchID))
+		// 仮押さえの期限切れで解放されないよう、与信の有効期限まで延長する
+		eparams := &database.ExtendOrderHoldsParams{
+			ExpiredAt: in.IssuedAt.Add(entity.PreorderAuthorizationPeriod),
+		}
+		if err := s.db.Order.ExtendHolds(ctx, in.OrderID, eparams); err != nil {
+			return internalError(err)
+		}
+		s.removeCartItemAfterAuthorized(ctx, order)
+		return nil
+	}
+
 	prov, err := s.getProviderByType(order.OrderPayment.ProviderType)
 	if err != nil {
 		return internalError(err)
@@ -61,7 +80,12 @@ func (s *service) NotifyPaymentAuthorized(ctx context.Context, in *store.NotifyP
 		return internalError(err)
 	}
 
-	// 即時決済の場合、買い物かごからの削除処理も追加で行う
+	s.removeCartItemAfterAuthorized(ctx, order)
+	return nil
+}
+
+// removeCartItemAfterAuthorized - 即時決済の場合、買い物かごからの削除処理も追加で行う
+func (s *service) removeCartItemAfterAuthorized(ctx context.Context, order *entity.Order) {
 	s.waitGroup.Add(1)
 	go func() {
 		defer s.waitGroup.Done()
@@ -69,10 +93,9 @@ func (s *service) NotifyPaymentAuthorized(ctx context.Context, in *store.NotifyP
 			return
 		}
 		if err := s.removeCartItemByOrder(ctx, order); err != nil {
-			slog.ErrorContext(ctx, "Failed to remove cart item by order", slog.String("orderId", in.OrderID), log.Error(err))
+			slog.ErrorContext(ctx, "Failed to remove cart item by order", slog.String("orderId", order.ID), log.Error(err))
 		}
 	}()
-	return nil
 }
 
 func (s *service) NotifyPaymentCaptured(ctx context.Context, in *store.NotifyPaymentCapturedInput) error {
@@ -104,6 +127,9 @@ func (s *service) NotifyPaymentCaptured(ctx context.Context, in *store.NotifyPay
 	s.waitGroup.Add(1)
 	go func() {
 		defer s.waitGroup.Done()
+		s.sellProductInventories(context.Background(), order)
+		s.confirmExperienceSlot(context.Background(), order)
+		s.confirmPromotionRedemption(context.Background(), order)
 		if err := s.notifyPaymentCompleted(context.Background(), order); err != nil {
 			slog.ErrorContext(ctx, "Failed to notify payment completed", slog.String("orderId", in.OrderID), log.Error(err))
 		}
@@ -139,10 +165,12 @@ func (s *service) NotifyPaymentFailed(ctx context.Context, in *store.NotifyPayme
 	}
 
 	s.waitGroup.Add(1)
-	// 確保していた商品在庫の開放
+	// 確保していた商品在庫・体験枠・プロモーション利用枠の開放
 	go func() {
 		defer s.waitGroup.Done()
-		s.increaseProductInventories(context.Background(), order.OrderItems)
+		s.releaseProductInventories(context.Background(), order)
+		s.releaseExperienceSlot(context.Background(), order)
+		s.releasePromotionRedemption(context.Background(), order)
 	}()
 	return nil
 }
@@ -151,19 +179,49 @@ func (s *service) NotifyPaymentRefunded(ctx context.Context, in *store.NotifyPay
 	if err := s.validator.Struct(in); err != nil {
 		return internalError(err)
 	}
+	order, err := s.db.Order.Get(ctx, in.OrderID)
+	if err != nil {
+		return internalError(err)
+	}
+	status := in.Status
+	// 返金額が注文金額に満たない場合は一部返金として扱う
+	partial := in.Status == entity.PaymentStatusRefunded && in.Total > 0 && in.Total < order.OrderPayment.Total
+	if partial {
+		status = entity.PaymentStatusPartiallyRefunded
+	}
 	params := &database.UpdateOrderRefundedParams{
-		Status:       in.Status,
+		Status:       status,
 		RefundType:   in.Type,
 		RefundTotal:  in.Total,
 		RefundReason: in.Reason,
 		IssuedAt:     in.IssuedAt,
 	}
-	err := s.db.Order.UpdateRefunded(ctx, in.OrderID, params)
+	err = s.db.Order.UpdateRefunded(ctx, in.OrderID, params)
 	if errors.Is(err, database.ErrFailedPrecondition) {
 		slog.WarnContext(ctx, "Order can't be refunded", slog.String("orderId", in.OrderID), slog.Time("issuedAt", in.IssuedAt))
 		return nil
 	}
-	return internalError(err)
+	if err != nil {
+		return internalError(err)
+	}
+	if partial {
+		// 一部返金の場合、販売確定済みの商品在庫・体験枠はそのまま保持する
+		return nil
+	}
+
+	s.waitGroup.Add(1)
+	// 確保していた商品在庫・体験枠の開放（販売確定済み、または確保していない注文の場合は何もしない）
+	go func() {
+		defer s.waitGroup.Done()
+		err := s.db.ProductInventoryHold.Release(context.Background(), in.OrderID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			slog.ErrorContext(ctx, "Failed to release product inventories", slog.String("orderId", in.OrderID), log.Error(err))
+		}
+		if err := s.db.ExperienceSlot.Release(context.Background(), in.OrderID); err != nil {
+			slog.ErrorContext(ctx, "Failed to release experience slot", slog.String("orderId", in.OrderID), log.Error(err))
+		}
+	}()
+	return nil
 }
 
 func (s *service) notifyPaymentCompleted(ctx context.Context, order *entity.Order) error {
@@ -81,6 +81,9 @@ func TestNotifyPaymentAuthorized(t *testing.T) {
 		PaymentID: "payment-id",
 		IssuedAt:  now,
 	}
+	extendParams := &database.ExtendOrderHoldsParams{
+		ExpiredAt: now.Add(entity.PreorderAuthorizationPeriod),
+	}
 	showResult := &payment.PaymentResult{
 		Status: entity.PaymentStatusAuthorized,
 	}
@@ -109,6 +112,91 @@ func TestNotifyPaymentAuthorized(t *testing.T) {
 			},
 			expect: nil,
 		},
+		{
+			name: "success when preorder is waiting for shipping",
+			setup: func(ctx context.Context, mocks *mocks) {
+				o := order(entity.PaymentMethodTypeCreditCard)
+				o.PreorderBatchID = "batch-id"
+				batch := &entity.PreorderBatch{ID: "batch-id", Status: entity.PreorderBatchStatusAccepting}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(o, nil)
+				mocks.db.Order.EXPECT().UpdateAuthorized(ctx, "order-id", params).Return(nil)
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch, nil)
+				mocks.db.Order.EXPECT().ExtendHolds(ctx, "order-id", extendParams).Return(nil)
+				mocks.cache.EXPECT().Get(gomock.Any(), &entity.Cart{SessionID: "session-id"}).Return(assert.AnError)
+			},
+			input: &store.NotifyPaymentAuthorizedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					IssuedAt:  now,
+					Status:    entity.PaymentStatusAuthorized,
+				},
+			},
+			expect: nil,
+		},
+		{
+			name: "failed to extend holds for preorder",
+			setup: func(ctx context.Context, mocks *mocks) {
+				o := order(entity.PaymentMethodTypeCreditCard)
+				o.PreorderBatchID = "batch-id"
+				batch := &entity.PreorderBatch{ID: "batch-id", Status: entity.PreorderBatchStatusAccepting}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(o, nil)
+				mocks.db.Order.EXPECT().UpdateAuthorized(ctx, "order-id", params).Return(nil)
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch, nil)
+				mocks.db.Order.EXPECT().ExtendHolds(ctx, "order-id", extendParams).Return(assert.AnError)
+			},
+			input: &store.NotifyPaymentAuthorizedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					IssuedAt:  now,
+					Status:    entity.PaymentStatusAuthorized,
+				},
+			},
+			expect: exception.ErrInternal,
+		},
+		{
+			name: "success when preorder is ready for shipping",
+			setup: func(ctx context.Context, mocks *mocks) {
+				o := order(entity.PaymentMethodTypeCreditCard)
+				o.PreorderBatchID = "batch-id"
+				batch := &entity.PreorderBatch{ID: "batch-id", Status: entity.PreorderBatchStatusReady}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(o, nil)
+				mocks.db.Order.EXPECT().UpdateAuthorized(ctx, "order-id", params).Return(nil)
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(showResult, nil)
+				mocks.payment.EXPECT().CapturePayment(ctx, "payment-id").Return(nil)
+				mocks.cache.EXPECT().Get(gomock.Any(), &entity.Cart{SessionID: "session-id"}).Return(assert.AnError)
+			},
+			input: &store.NotifyPaymentAuthorizedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					IssuedAt:  now,
+					Status:    entity.PaymentStatusAuthorized,
+				},
+			},
+			expect: nil,
+		},
+		{
+			name: "failed to get preorder batch",
+			setup: func(ctx context.Context, mocks *mocks) {
+				o := order(entity.PaymentMethodTypeCreditCard)
+				o.PreorderBatchID = "batch-id"
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(o, nil)
+				mocks.db.Order.EXPECT().UpdateAuthorized(ctx, "order-id", params).Return(nil)
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(nil, assert.AnError)
+			},
+			input: &store.NotifyPaymentAuthorizedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					IssuedAt:  now,
+					Status:    entity.PaymentStatusAuthorized,
+				},
+			},
+			expect: exception.ErrInternal,
+		},
 		{
 			name: "success when not immediate payment",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -247,6 +335,7 @@ func TestNotifyPaymentCaptured(t *testing.T) {
 		SessionID:     "session-id",
 		PromotionID:   "",
 		CoordinatorID: "coordinator-id",
+		Type:          entity.OrderTypeProduct,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 		OrderPayment: entity.OrderPayment{
@@ -316,6 +405,7 @@ func TestNotifyPaymentCaptured(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
 				mocks.db.Order.EXPECT().UpdateCaptured(ctx, "order-id", params).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Sell(gomock.Any(), "order-id").Return(nil)
 				mocks.messenger.EXPECT().NotifyOrderCaptured(gomock.Any(), in).Return(assert.AnError)
 			},
 			input: &store.NotifyPaymentCapturedInput{
@@ -328,6 +418,32 @@ func TestNotifyPaymentCaptured(t *testing.T) {
 			},
 			expect: nil,
 		},
+		{
+			name: "success with experience slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := &entity.Order{
+					ID:   "order-id",
+					Type: entity.OrderTypeExperience,
+					OrderExperience: entity.OrderExperience{
+						OrderID: "order-id",
+						SlotID:  "slot-id",
+					},
+				}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateCaptured(ctx, "order-id", params).Return(nil)
+				mocks.db.ExperienceSlot.EXPECT().Confirm(gomock.Any(), "order-id").Return(nil)
+				mocks.messenger.EXPECT().NotifyOrderCaptured(gomock.Any(), in).Return(nil)
+			},
+			input: &store.NotifyPaymentCapturedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					Status:    entity.PaymentStatusCaptured,
+					IssuedAt:  now,
+				},
+			},
+			expect: nil,
+		},
 		{
 			name:   "invalid argument",
 			setup:  func(ctx context.Context, mocks *mocks) {},
@@ -412,6 +528,7 @@ func TestNotifyPaymentFailed(t *testing.T) {
 		SessionID:     "session-id",
 		PromotionID:   "",
 		CoordinatorID: "coordinator-id",
+		Type:          entity.OrderTypeProduct,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 		OrderPayment: entity.OrderPayment{
@@ -479,6 +596,24 @@ func TestNotifyPaymentFailed(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
 				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", params).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+			},
+			input: &store.NotifyPaymentFailedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					Status:    entity.PaymentStatusFailed,
+					IssuedAt:  now,
+				},
+			},
+			expect: nil,
+		},
+		{
+			name: "success without inventory holds",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", params).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(database.ErrNotFound)
 				mocks.db.Product.EXPECT().DecreaseInventory(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
 			},
 			input: &store.NotifyPaymentFailedInput{
@@ -491,6 +626,31 @@ func TestNotifyPaymentFailed(t *testing.T) {
 			},
 			expect: nil,
 		},
+		{
+			name: "success with experience slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := &entity.Order{
+					ID:   "order-id",
+					Type: entity.OrderTypeExperience,
+					OrderExperience: entity.OrderExperience{
+						OrderID: "order-id",
+						SlotID:  "slot-id",
+					},
+				}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", params).Return(nil)
+				mocks.db.ExperienceSlot.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+			},
+			input: &store.NotifyPaymentFailedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					Status:    entity.PaymentStatusFailed,
+					IssuedAt:  now,
+				},
+			},
+			expect: nil,
+		},
 		{
 			name:   "invalid argument",
 			setup:  func(ctx context.Context, mocks *mocks) {},
@@ -562,6 +722,7 @@ func TestNotifyPaymentFailed(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
 				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", params).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(database.ErrNotFound)
 				mocks.db.Product.EXPECT().DecreaseInventory(gomock.Any(), gomock.Any(), gomock.Any()).Return(assert.AnError).MinTimes(1)
 			},
 			input: &store.NotifyPaymentFailedInput{
@@ -574,6 +735,23 @@ func TestNotifyPaymentFailed(t *testing.T) {
 			},
 			expect: nil,
 		},
+		{
+			name: "failed to release product inventories",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", params).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(assert.AnError)
+			},
+			input: &store.NotifyPaymentFailedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:   "order-id",
+					PaymentID: "payment-id",
+					Status:    entity.PaymentStatusFailed,
+					IssuedAt:  now,
+				},
+			},
+			expect: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
@@ -593,6 +771,14 @@ func TestNotifyPaymentRefunded(t *testing.T) {
 		RefundReason: "在庫不足のため。",
 		IssuedAt:     now,
 	}
+	order := &entity.Order{
+		ID: "order-id",
+		OrderPayment: entity.OrderPayment{
+			OrderID: "order-id",
+			Status:  entity.PaymentStatusCaptured,
+			Total:   1980,
+		},
+	}
 	tests := []struct {
 		name   string
 		setup  func(ctx context.Context, mocks *mocks)
@@ -602,7 +788,10 @@ func TestNotifyPaymentRefunded(t *testing.T) {
 		{
 			name: "success",
 			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
 				mocks.db.Order.EXPECT().UpdateRefunded(ctx, "order-id", params).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(database.ErrNotFound)
+				mocks.db.ExperienceSlot.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.NotifyPaymentRefundedInput{
 				NotifyPaymentPayload: store.NotifyPaymentPayload{
@@ -616,15 +805,58 @@ func TestNotifyPaymentRefunded(t *testing.T) {
 			},
 			expect: nil,
 		},
+		{
+			name: "success partially refunded",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.UpdateOrderRefundedParams{
+					Status:       entity.PaymentStatusPartiallyRefunded,
+					RefundType:   entity.RefundTypeRefunded,
+					RefundTotal:  500,
+					RefundReason: "一部商品の破損のため。",
+					IssuedAt:     now,
+				}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateRefunded(ctx, "order-id", params).Return(nil)
+			},
+			input: &store.NotifyPaymentRefundedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:  "order-id",
+					Status:   entity.PaymentStatusRefunded,
+					IssuedAt: now,
+				},
+				Type:   entity.RefundTypeRefunded,
+				Total:  500,
+				Reason: "一部商品の破損のため。",
+			},
+			expect: nil,
+		},
 		{
 			name:   "invalid argument",
 			setup:  func(ctx context.Context, mocks *mocks) {},
 			input:  &store.NotifyPaymentRefundedInput{},
 			expect: exception.ErrInvalidArgument,
 		},
+		{
+			name: "failed to get order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(nil, assert.AnError)
+			},
+			input: &store.NotifyPaymentRefundedInput{
+				NotifyPaymentPayload: store.NotifyPaymentPayload{
+					OrderID:  "order-id",
+					Status:   entity.PaymentStatusRefunded,
+					IssuedAt: now,
+				},
+				Type:   entity.RefundTypeRefunded,
+				Total:  1980,
+				Reason: "在庫不足のため。",
+			},
+			expect: exception.ErrInternal,
+		},
 		{
 			name: "failed to update payment status",
 			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
 				mocks.db.Order.EXPECT().UpdateRefunded(ctx, "order-id", params).Return(assert.AnError)
 			},
 			input: &store.NotifyPaymentRefundedInput{
@@ -642,6 +874,7 @@ func TestNotifyPaymentRefunded(t *testing.T) {
 		{
 			name: "already updated",
 			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
 				mocks.db.Order.EXPECT().UpdateRefunded(ctx, "order-id", params).Return(database.ErrFailedPrecondition)
 			},
 			input: &store.NotifyPaymentRefundedInput{
@@ -9,6 +9,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/codes"
 	"github.com/and-period/furumaru/api/internal/exception"
 	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/payment"
 	komojupay "github.com/and-period/furumaru/api/internal/store/payment/komoju"
@@ -299,6 +300,8 @@ func TestCheckoutCreditCard(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypeCreditCard)
 				mocks.payment.EXPECT().OrderCreditCard(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutCreditCardInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -389,6 +392,8 @@ func TestCheckoutPayPay(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypePayPay)
 				mocks.payment.EXPECT().OrderPayPay(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutPayPayInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -474,6 +479,8 @@ func TestCheckoutLinePay(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypeLinePay)
 				mocks.payment.EXPECT().OrderLinePay(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutLinePayInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -559,6 +566,8 @@ func TestCheckoutMerpay(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypeMerpay)
 				mocks.payment.EXPECT().OrderMerpay(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutMerpayInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -644,6 +653,8 @@ func TestCheckoutRakutenPay(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypeRakutenPay)
 				mocks.payment.EXPECT().OrderRakutenPay(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutRakutenPayInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -729,6 +740,8 @@ func TestCheckoutAUPay(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypeAUPay)
 				mocks.payment.EXPECT().OrderAUPay(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutAUPayInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -816,6 +829,8 @@ func TestCheckoutPaidy(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypePaidy)
 				mocks.payment.EXPECT().OrderPaidy(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutPaidyInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -907,6 +922,8 @@ func TestCheckoutBankTransfer(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypeBankTransfer)
 				mocks.payment.EXPECT().OrderBankTransfer(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutBankTransferInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -998,6 +1015,8 @@ func TestCheckoutPayEasy(t *testing.T) {
 			setup: func(ctx context.Context, mocks *mocks) {
 				checkoutProductMocks(mocks, t, now, entity.PaymentMethodTypePayEasy)
 				mocks.payment.EXPECT().OrderPayEasy(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			input: &store.CheckoutPayEasyInput{
 				CheckoutDetail: store.CheckoutDetail{
@@ -1080,6 +1099,8 @@ func checkoutProductMocks(
 			ShippingFee:       500,
 			Tax:               127,
 			Total:             1400,
+			StandardTaxTarget: 1400,
+			StandardTax:       127,
 			OrderedAt:         now,
 		},
 		OrderFulfillments: entity.OrderFulfillments{
@@ -1100,6 +1121,9 @@ func checkoutProductMocks(
 				OrderID:           "order-id",
 				ProductRevisionID: 1,
 				Quantity:          2,
+				TaxRate:           10,
+				Discount:          100,
+				Discounts:         entity.OrderItemDiscounts{{Amount: 100}},
 			},
 		},
 		OrderMetadata: entity.OrderMetadata{
@@ -1267,7 +1291,8 @@ func checkoutProductMocks(
 		})
 	m.db.Product.EXPECT().MultiGet(gomock.Any(), []string{}).Return(entity.Products{}, nil).AnyTimes()
 	m.cache.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(assert.AnError).AnyTimes()
-	m.db.Product.EXPECT().DecreaseInventory(gomock.Any(), int64(1), int64(2)).Return(nil).AnyTimes()
+	m.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+	m.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
 }
 
 func TestCheckoutProduct(t *testing.T) {
@@ -1440,6 +1465,8 @@ func TestCheckoutProduct(t *testing.T) {
 				ShippingFee:       500,
 				Tax:               127,
 				Total:             1400,
+				StandardTaxTarget: 1400,
+				StandardTax:       127,
 				OrderedAt:         now,
 			},
 			OrderFulfillments: entity.OrderFulfillments{
@@ -1460,6 +1487,9 @@ func TestCheckoutProduct(t *testing.T) {
 					OrderID:           "order-id",
 					ProductRevisionID: 1,
 					Quantity:          2,
+					TaxRate:           10,
+					Discount:          100,
+					Discounts:         entity.OrderItemDiscounts{{Amount: 100}},
 				},
 			},
 			OrderMetadata: entity.OrderMetadata{
@@ -1491,6 +1521,16 @@ func TestCheckoutProduct(t *testing.T) {
 		}
 		mocks.db.Order.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(fn)
 	}
+	holds := entity.ProductInventoryHolds{
+		{
+			OrderID:           "order-id",
+			ProductID:         "product-id",
+			ProductRevisionID: 1,
+			Quantity:          2,
+			Status:            entity.ProductInventoryHoldStatusHeld,
+			ExpiredAt:         now.Add(defaultDeferredInventoryHoldTTL),
+		},
+	}
 	tests := []struct {
 		name      string
 		setup     func(ctx context.Context, mocks *mocks)
@@ -1518,7 +1558,8 @@ func TestCheckoutProduct(t *testing.T) {
 				mocks.payment.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(session, nil)
 				mocks.payment.EXPECT().IsSessionFailed(gomock.Any()).Return(false)
 				mocks.cache.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(assert.AnError)
-				mocks.db.Product.EXPECT().DecreaseInventory(gomock.Any(), int64(1), int64(2)).Return(nil).AnyTimes()
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), holds).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -1577,7 +1618,10 @@ func TestCheckoutProduct(t *testing.T) {
 					return nil
 				})
 				mocks.cache.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(assert.AnError)
-				mocks.db.Product.EXPECT().DecreaseInventory(gomock.Any(), int64(1), int64(2)).Return(nil).AnyTimes()
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Sell(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Confirm(gomock.Any(), "order-id").Return(nil)
 				mocks.messenger.EXPECT().NotifyOrderCaptured(gomock.Any(), gomock.Any()).Return(assert.AnError)
 			},
 			params: &checkoutParams{
@@ -1623,6 +1667,8 @@ func TestCheckoutProduct(t *testing.T) {
 					ShippingFee:       0,
 					Tax:               81,
 					Total:             900,
+					StandardTaxTarget: 900,
+					StandardTax:       81,
 					OrderedAt:         now,
 				}
 				order.OrderFulfillments = entity.OrderFulfillments{
@@ -1660,7 +1706,8 @@ func TestCheckoutProduct(t *testing.T) {
 				mocks.payment.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(session, nil)
 				mocks.payment.EXPECT().IsSessionFailed(gomock.Any()).Return(false)
 				mocks.cache.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(assert.AnError)
-				mocks.db.Product.EXPECT().DecreaseInventory(gomock.Any(), int64(1), int64(2)).Return(nil).AnyTimes()
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), holds).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2115,6 +2162,88 @@ func TestCheckoutProduct(t *testing.T) {
 			expect:    "",
 			expectErr: exception.ErrFailedPrecondition,
 		},
+		{
+			name: "failed to list delivery slot rules",
+			setup: func(ctx context.Context, mocks *mocks) {
+				cartmocks(mocks, cart.SessionID, cart, nil)
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil).Times(2)
+				mocks.user.EXPECT().GetShopByCoordinatorID(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products(30), nil)
+				mocks.db.DeliverySlotRule.EXPECT().ListByShopID(ctx, "shop-id").Return(nil, assert.AnError)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutProductDetail: store.CheckoutProductDetail{
+						CoordinatorID:      "coordinator-id",
+						BoxNumber:          0,
+						ShippingAddressID:  "address-id",
+						DeliveryDate:       "",
+						DeliveryTimeWindow: entity.DeliveryTimeWindowMorning,
+					},
+					Type:             entity.OrderTypeProduct,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            1400,
+				},
+				paymentMethodType: entity.PaymentMethodTypeKonbini,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "",
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to unavailable delivery slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				cartmocks(mocks, cart.SessionID, cart, nil)
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil).Times(2)
+				mocks.user.EXPECT().GetShopByCoordinatorID(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products(30), nil)
+				mocks.db.DeliverySlotRule.EXPECT().ListByShopID(ctx, "shop-id").Return(entity.DeliverySlotRules{}, nil)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutProductDetail: store.CheckoutProductDetail{
+						CoordinatorID:      "coordinator-id",
+						BoxNumber:          0,
+						ShippingAddressID:  "address-id",
+						DeliveryDate:       "",
+						DeliveryTimeWindow: entity.DeliveryTimeWindowMorning,
+					},
+					Type:             entity.OrderTypeProduct,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            1400,
+				},
+				paymentMethodType: entity.PaymentMethodTypeKonbini,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "",
+			expectErr: exception.ErrFailedPrecondition,
+		},
 		{
 			name: "failed to checksum",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -2153,6 +2282,45 @@ func TestCheckoutProduct(t *testing.T) {
 			expect:    "",
 			expectErr: exception.ErrInvalidArgument,
 		},
+		{
+			name: "failed to hold product inventories",
+			setup: func(ctx context.Context, mocks *mocks) {
+				cartmocks(mocks, cart.SessionID, cart, nil)
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil).Times(2)
+				mocks.user.EXPECT().GetShopByCoordinatorID(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products(30), nil)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), holds).Return(database.ErrFailedPrecondition)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutProductDetail: store.CheckoutProductDetail{
+						CoordinatorID:     "coordinator-id",
+						BoxNumber:         0,
+						ShippingAddressID: "address-id",
+					},
+					Type:             entity.OrderTypeProduct,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            1400,
+				},
+				paymentMethodType: entity.PaymentMethodTypeKonbini,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "",
+			expectErr: exception.ErrFailedPrecondition,
+		},
 		{
 			name: "failed to create session",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -2169,6 +2337,10 @@ func TestCheckoutProduct(t *testing.T) {
 					Status:       entity.PaymentSystemStatusInUse,
 				}, nil)
 				mocks.payment.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2214,6 +2386,10 @@ func TestCheckoutProduct(t *testing.T) {
 					Status:       entity.PaymentSystemStatusInUse,
 				}, nil)
 				mocks.payment.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(session, nil)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2260,6 +2436,8 @@ func TestCheckoutProduct(t *testing.T) {
 				}, nil)
 				mocks.payment.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(session, nil)
 				mocks.payment.EXPECT().IsSessionFailed(gomock.Any()).Return(true)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2303,6 +2481,10 @@ func TestCheckoutProduct(t *testing.T) {
 				}, nil)
 				mocks.payment.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(session, nil)
 				mocks.payment.EXPECT().IsSessionFailed(gomock.Any()).Return(false)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2353,6 +2535,10 @@ func TestCheckoutProduct(t *testing.T) {
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Product.EXPECT().MultiGet(ctx, []string{"product-id"}).Return(products, nil)
 				mocks.db.Order.EXPECT().Create(gomock.Any(), gomock.Any()).Return(assert.AnError)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2533,6 +2719,8 @@ func TestCheckoutExperience(t *testing.T) {
 				ShippingFee:       0,
 				Tax:               294,
 				Total:             3240,
+				StandardTaxTarget: 3240,
+				StandardTax:       294,
 				OrderedAt:         now,
 			},
 			OrderExperience: entity.OrderExperience{
@@ -2562,6 +2750,28 @@ func TestCheckoutExperience(t *testing.T) {
 			Status:        entity.OrderStatusUnpaid,
 		}
 	}
+	slot := func() *entity.ExperienceSlot {
+		return &entity.ExperienceSlot{
+			ID:           "slot-id",
+			ExperienceID: "experience-id",
+			Status:       entity.ExperienceSlotStatusAccepting,
+			Capacity:     10,
+			Reserved:     0,
+			StartAt:      jst.Date(2024, 1, 2, 18, 30, 0, 0),
+			EndAt:        jst.Date(2024, 1, 2, 20, 30, 0, 0),
+		}
+	}
+	reservation := &entity.ExperienceSlotReservation{
+		OrderID:   "order-id",
+		SlotID:    "slot-id",
+		Quantity:  4,
+		Status:    entity.ExperienceSlotReservationStatusHeld,
+		ExpiredAt: now.Add(defaultInventoryHoldTTL),
+	}
+	slotsParams := &database.ListExperienceSlotsParams{
+		ExperienceID: "experience-id",
+		StartAtGte:   now,
+	}
 	ordermocks := func(mocks *mocks, order *entity.Order, err error) {
 		fn := func(_ context.Context, in *entity.Order) error {
 			require.Equal(t, order, in)
@@ -2584,7 +2794,9 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
 				mocks.db.PaymentSystem.EXPECT().Get(gomock.Any(), entity.PaymentMethodTypeCreditCard).Return(&entity.PaymentSystem{
 					MethodType:   entity.PaymentMethodTypeCreditCard,
 					ProviderType: entity.PaymentProviderTypeKomoju,
@@ -2626,6 +2838,195 @@ func TestCheckoutExperience(t *testing.T) {
 			expect:    "http://example.com/redirect",
 			expectErr: nil,
 		},
+		{
+			name: "success with experience slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := order()
+				order.OrderExperience.SlotID = "slot-id"
+				ordermocks(mocks, order, nil)
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().Get(gomock.Any(), "slot-id").Return(slot(), nil)
+				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.ExperienceSlot.EXPECT().Reserve(gomock.Any(), reservation).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PaymentSystem.EXPECT().Get(gomock.Any(), entity.PaymentMethodTypeCreditCard).Return(&entity.PaymentSystem{
+					MethodType:   entity.PaymentMethodTypeCreditCard,
+					ProviderType: entity.PaymentProviderTypeKomoju,
+					Status:       entity.PaymentSystemStatusInUse,
+				}, nil)
+				mocks.payment.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(session, nil)
+				mocks.payment.EXPECT().IsSessionFailed(gomock.Any()).Return(false)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutExperienceDetail: store.CheckoutExperienceDetail{
+						ExperienceID:          "experience-id",
+						SlotID:                "slot-id",
+						AdultCount:            2,
+						JuniorHighSchoolCount: 2,
+						ElementarySchoolCount: 0,
+						PreschoolCount:        0,
+						SeniorCount:           0,
+						Transportation:        "車で伺います。",
+					},
+					Type:             entity.OrderTypeExperience,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            3240,
+				},
+				paymentMethodType: entity.PaymentMethodTypeCreditCard,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "http://example.com/redirect",
+			expectErr: nil,
+		},
+		{
+			name: "insufficient experience slot capacity",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().Get(gomock.Any(), "slot-id").Return(slot(), nil)
+				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.ExperienceSlot.EXPECT().Reserve(gomock.Any(), reservation).Return(database.ErrFailedPrecondition)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutExperienceDetail: store.CheckoutExperienceDetail{
+						ExperienceID:          "experience-id",
+						SlotID:                "slot-id",
+						AdultCount:            2,
+						JuniorHighSchoolCount: 2,
+						ElementarySchoolCount: 0,
+						PreschoolCount:        0,
+						SeniorCount:           0,
+						Transportation:        "車で伺います。",
+					},
+					Type:             entity.OrderTypeExperience,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            3240,
+				},
+				paymentMethodType: entity.PaymentMethodTypeCreditCard,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "",
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "exceeded promotion redemption limit",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().Get(gomock.Any(), "slot-id").Return(slot(), nil)
+				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.ExperienceSlot.EXPECT().Reserve(gomock.Any(), reservation).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(database.ErrFailedPrecondition)
+				mocks.db.ExperienceSlot.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutExperienceDetail: store.CheckoutExperienceDetail{
+						ExperienceID:          "experience-id",
+						SlotID:                "slot-id",
+						AdultCount:            2,
+						JuniorHighSchoolCount: 2,
+						ElementarySchoolCount: 0,
+						PreschoolCount:        0,
+						SeniorCount:           0,
+						Transportation:        "車で伺います。",
+					},
+					Type:             entity.OrderTypeExperience,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            3240,
+				},
+				paymentMethodType: entity.PaymentMethodTypeCreditCard,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "",
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to execute payment with experience slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().Get(gomock.Any(), "slot-id").Return(slot(), nil)
+				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.ExperienceSlot.EXPECT().Reserve(gomock.Any(), reservation).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PaymentSystem.EXPECT().Get(gomock.Any(), entity.PaymentMethodTypeCreditCard).Return(nil, assert.AnError)
+				mocks.db.ExperienceSlot.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutExperienceDetail: store.CheckoutExperienceDetail{
+						ExperienceID:          "experience-id",
+						SlotID:                "slot-id",
+						AdultCount:            2,
+						JuniorHighSchoolCount: 2,
+						ElementarySchoolCount: 0,
+						PreschoolCount:        0,
+						SeniorCount:           0,
+						Transportation:        "車で伺います。",
+					},
+					Type:             entity.OrderTypeExperience,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            3240,
+				},
+				paymentMethodType: entity.PaymentMethodTypeCreditCard,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "",
+			expectErr: exception.ErrInternal,
+		},
 		{
 			name: "success without payment",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -2646,7 +3047,10 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Confirm(gomock.Any(), "order-id").Return(nil)
 				mocks.db.Order.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in *entity.Order) error {
 					assert.Equal(t, int64(0), in.Total)
 					return nil
@@ -2797,6 +3201,7 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(nil, assert.AnError)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil).AnyTimes()
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2838,6 +3243,7 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(nil, assert.AnError)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2872,6 +3278,48 @@ func TestCheckoutExperience(t *testing.T) {
 			expect:    "",
 			expectErr: exception.ErrInternal,
 		},
+		{
+			name: "experience slot is required",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
+				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{slot()}, nil)
+			},
+			params: &checkoutParams{
+				payload: &store.CheckoutDetail{
+					CheckoutExperienceDetail: store.CheckoutExperienceDetail{
+						ExperienceID:          "experience-id",
+						AdultCount:            2,
+						JuniorHighSchoolCount: 2,
+						ElementarySchoolCount: 0,
+						PreschoolCount:        0,
+						SeniorCount:           0,
+						Transportation:        "車で伺います。",
+						RequestedDate:         "20240102",
+						RequestedTime:         "1830",
+					},
+					Type:             entity.OrderTypeExperience,
+					RequestID:        "order-id",
+					UserID:           "user-id",
+					SessionID:        "session-id",
+					PromotionCode:    "code1234",
+					BillingAddressID: "address-id",
+					CallbackURL:      "http://example.com/callback",
+					Total:            3240,
+				},
+				paymentMethodType: entity.PaymentMethodTypeCreditCard,
+				payFn: func(ctx context.Context, _ payment.Provider, sessionID string, params *checkoutDetailParams) (*payment.OrderResult, error) {
+					res := &payment.OrderResult{
+						RedirectURL: "http://example.com/redirect",
+					}
+					return res, nil
+				},
+			},
+			expect:    "",
+			expectErr: exception.ErrInvalidArgument,
+		},
 		{
 			name: "failed to disable promotion",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -2880,6 +3328,7 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2922,6 +3371,7 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 			},
 			params: &checkoutParams{
 				payload: &store.CheckoutDetail{
@@ -2963,6 +3413,7 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(nil, assert.AnError)
 			},
 			params: &checkoutParams{
@@ -3005,6 +3456,7 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
 			},
 			params: &checkoutParams{
@@ -3047,7 +3499,10 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 				mocks.db.PaymentSystem.EXPECT().Get(gomock.Any(), entity.PaymentMethodTypeCreditCard).Return(&entity.PaymentSystem{
 					MethodType:   entity.PaymentMethodTypeCreditCard,
 					ProviderType: entity.PaymentProviderTypeKomoju,
@@ -3096,7 +3551,10 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 				mocks.db.PaymentSystem.EXPECT().Get(gomock.Any(), entity.PaymentMethodTypeCreditCard).Return(&entity.PaymentSystem{
 					MethodType:   entity.PaymentMethodTypeCreditCard,
 					ProviderType: entity.PaymentProviderTypeKomoju,
@@ -3145,7 +3603,9 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
 				mocks.db.PaymentSystem.EXPECT().Get(gomock.Any(), entity.PaymentMethodTypeCreditCard).Return(&entity.PaymentSystem{
 					MethodType:   entity.PaymentMethodTypeCreditCard,
 					ProviderType: entity.PaymentProviderTypeKomoju,
@@ -3192,7 +3652,10 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 				mocks.db.PaymentSystem.EXPECT().Get(gomock.Any(), entity.PaymentMethodTypeCreditCard).Return(&entity.PaymentSystem{
 					MethodType:   entity.PaymentMethodTypeCreditCard,
 					ProviderType: entity.PaymentProviderTypeKomoju,
@@ -3251,7 +3714,10 @@ func TestCheckoutExperience(t *testing.T) {
 				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
 				mocks.db.Promotion.EXPECT().GetByCode(gomock.Any(), "code1234").Return(promotion, nil)
 				mocks.db.Experience.EXPECT().Get(gomock.Any(), "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().List(gomock.Any(), slotsParams).Return(entity.ExperienceSlots{}, nil)
 				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.db.PromotionRedemption.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
 				mocks.db.Order.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
 			},
 			params: &checkoutParams{
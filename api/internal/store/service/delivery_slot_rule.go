@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+func (s *service) ListDeliverySlotRules(
+	ctx context.Context, in *store.ListDeliverySlotRulesInput,
+) (entity.DeliverySlotRules, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	rules, err := s.db.DeliverySlotRule.ListByShopID(ctx, in.ShopID)
+	return rules, internalError(err)
+}
+
+func (s *service) UpsertDeliverySlotRule(
+	ctx context.Context, in *store.UpsertDeliverySlotRuleInput,
+) (*entity.DeliverySlotRule, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &entity.NewDeliverySlotRuleParams{
+		ShopID:         in.ShopID,
+		CoordinatorID:  in.CoordinatorID,
+		ShippingType:   in.ShippingType,
+		LeadDays:       in.LeadDays,
+		SelectableDays: in.SelectableDays,
+		BlackoutDates:  in.BlackoutDates,
+		TimeWindows:    in.TimeWindows,
+	}
+	rule, err := entity.NewDeliverySlotRule(params)
+	if err != nil {
+		return nil, fmt.Errorf("service: invalid delivery slot rule: %w: %s", exception.ErrInvalidArgument, err.Error())
+	}
+	if err := s.db.DeliverySlotRule.Upsert(ctx, rule); err != nil {
+		return nil, internalError(err)
+	}
+	// 更新時は既存のルールIDが維持されるため、登録後の情報を取得し直す
+	rule, err = s.db.DeliverySlotRule.GetByShippingType(ctx, in.ShopID, in.ShippingType)
+	return rule, internalError(err)
+}
+
+func (s *service) DeleteDeliverySlotRule(ctx context.Context, in *store.DeleteDeliverySlotRuleInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	err := s.db.DeliverySlotRule.Delete(ctx, in.ShopID, in.ShippingType)
+	return internalError(err)
+}
+
+// verifyDeliverySlot - お届け希望日時が配送日時指定ルールを満たしているかを検証し、お届け希望日を返す
+func (s *service) verifyDeliverySlot(
+	ctx context.Context, shopID string, baskets entity.CartBaskets, payload *store.CheckoutDetail,
+) (time.Time, error) {
+	if payload.DeliveryDate == "" && payload.DeliveryTimeWindow == entity.DeliveryTimeWindowUnspecified {
+		return time.Time{}, nil
+	}
+	if payload.Pickup {
+		return time.Time{}, fmt.Errorf("service: pickup order cannot specify delivery slot: %w", exception.ErrInvalidArgument)
+	}
+	var (
+		date time.Time
+		err  error
+	)
+	if payload.DeliveryDate != "" {
+		date, err = jst.ParseFromYYYYMMDD(payload.DeliveryDate)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("service: invalid delivery date: %w: %s", exception.ErrInvalidArgument, err.Error())
+		}
+	}
+	rules, err := s.db.DeliverySlotRule.ListByShopID(ctx, shopID)
+	if err != nil {
+		return time.Time{}, internalError(err)
+	}
+	rulesMap := rules.MapByShippingType()
+	for _, basket := range baskets {
+		rule, ok := rulesMap[basket.BoxType]
+		if !ok {
+			return time.Time{}, fmt.Errorf("service: delivery slot is not available: %w", exception.ErrFailedPrecondition)
+		}
+		err := rule.Verify(date, payload.DeliveryTimeWindow, s.now())
+		if errors.Is(err, entity.ErrDeliverySlotUnavailable) {
+			return time.Time{}, fmt.Errorf("service: %w: %s", exception.ErrFailedPrecondition, err.Error())
+		}
+		if err != nil {
+			return time.Time{}, internalError(err)
+		}
+	}
+	return date, nil
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDeliverySlotRules(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	rules := entity.DeliverySlotRules{
+		{
+			ID:             "rule-id",
+			ShopID:         "shop-id",
+			CoordinatorID:  "coordinator-id",
+			ShippingType:   entity.ShippingTypeFrozen,
+			LeadDays:       2,
+			SelectableDays: 14,
+			BlackoutDates:  []string{"20261231"},
+			TimeWindows:    []entity.DeliveryTimeWindow{entity.DeliveryTimeWindowMorning},
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ListDeliverySlotRulesInput
+		expect    entity.DeliverySlotRules
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.DeliverySlotRule.EXPECT().ListByShopID(ctx, "shop-id").Return(rules, nil)
+			},
+			input: &store.ListDeliverySlotRulesInput{
+				ShopID: "shop-id",
+			},
+			expect:    rules,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ListDeliverySlotRulesInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list delivery slot rules",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.DeliverySlotRule.EXPECT().ListByShopID(ctx, "shop-id").Return(nil, assert.AnError)
+			},
+			input: &store.ListDeliverySlotRulesInput{
+				ShopID: "shop-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ListDeliverySlotRules(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestUpsertDeliverySlotRule(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	rule := &entity.DeliverySlotRule{
+		ID:             "rule-id",
+		ShopID:         "shop-id",
+		CoordinatorID:  "coordinator-id",
+		ShippingType:   entity.ShippingTypeFrozen,
+		LeadDays:       2,
+		SelectableDays: 14,
+		BlackoutDates:  []string{"20261231"},
+		TimeWindows:    []entity.DeliveryTimeWindow{entity.DeliveryTimeWindowMorning},
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.UpsertDeliverySlotRuleInput
+		expect    *entity.DeliverySlotRule
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.DeliverySlotRule.EXPECT().
+					Upsert(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, r *entity.DeliverySlotRule) error {
+						expect := &entity.DeliverySlotRule{
+							ID:             r.ID, // ignore
+							ShopID:         "shop-id",
+							CoordinatorID:  "coordinator-id",
+							ShippingType:   entity.ShippingTypeFrozen,
+							LeadDays:       2,
+							SelectableDays: 14,
+							BlackoutDates:  []string{"20261231"},
+							TimeWindows:    []entity.DeliveryTimeWindow{entity.DeliveryTimeWindowMorning},
+						}
+						require.Equal(t, expect, r)
+						return nil
+					})
+				mocks.db.DeliverySlotRule.EXPECT().GetByShippingType(ctx, "shop-id", entity.ShippingTypeFrozen).Return(rule, nil)
+			},
+			input: &store.UpsertDeliverySlotRuleInput{
+				ShopID:         "shop-id",
+				CoordinatorID:  "coordinator-id",
+				ShippingType:   entity.ShippingTypeFrozen,
+				LeadDays:       2,
+				SelectableDays: 14,
+				BlackoutDates:  []string{"20261231"},
+				TimeWindows:    []entity.DeliveryTimeWindow{entity.DeliveryTimeWindowMorning},
+			},
+			expect:    rule,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.UpsertDeliverySlotRuleInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "invalid pickup",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.UpsertDeliverySlotRuleInput{
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				ShippingType:  entity.ShippingTypePickup,
+			},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to upsert delivery slot rule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.DeliverySlotRule.EXPECT().Upsert(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &store.UpsertDeliverySlotRuleInput{
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				ShippingType:  entity.ShippingTypeFrozen,
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to get delivery slot rule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.DeliverySlotRule.EXPECT().Upsert(ctx, gomock.Any()).Return(nil)
+				mocks.db.DeliverySlotRule.EXPECT().GetByShippingType(ctx, "shop-id", entity.ShippingTypeFrozen).Return(nil, assert.AnError)
+			},
+			input: &store.UpsertDeliverySlotRuleInput{
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				ShippingType:  entity.ShippingTypeFrozen,
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.UpsertDeliverySlotRule(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestDeleteDeliverySlotRule(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.DeleteDeliverySlotRuleInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.DeliverySlotRule.EXPECT().Delete(ctx, "shop-id", entity.ShippingTypeFrozen).Return(nil)
+			},
+			input: &store.DeleteDeliverySlotRuleInput{
+				ShopID:       "shop-id",
+				ShippingType: entity.ShippingTypeFrozen,
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.DeleteDeliverySlotRuleInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to delete delivery slot rule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.DeliverySlotRule.EXPECT().Delete(ctx, "shop-id", entity.ShippingTypeFrozen).Return(assert.AnError)
+			},
+			input: &store.DeleteDeliverySlotRuleInput{
+				ShopID:       "shop-id",
+				ShippingType: entity.ShippingTypeFrozen,
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.DeleteDeliverySlotRule(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+)
+
+func (s *service) ListExperienceSlots(ctx context.Context, in *store.ListExperienceSlotsInput) (entity.ExperienceSlots, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.ListExperienceSlotsParams{
+		ExperienceID: in.ExperienceID,
+	}
+	if in.OnlyAccepting {
+		params.StartAtGte = s.now()
+	}
+	slots, err := s.db.ExperienceSlot.List(ctx, params)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if in.OnlyAccepting {
+		slots = slots.FilterByAccepting()
+	}
+	return slots, nil
+}
+
+func (s *service) GetExperienceSlot(ctx context.Context, in *store.GetExperienceSlotInput) (*entity.ExperienceSlot, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	slot, err := s.db.ExperienceSlot.Get(ctx, in.ExperienceSlotID)
+	return slot, internalError(err)
+}
+
+func (s *service) CreateExperienceSlot(ctx context.Context, in *store.CreateExperienceSlotInput) (*entity.ExperienceSlot, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	if _, err := s.db.Experience.Get(ctx, in.ExperienceID); err != nil {
+		return nil, internalError(err)
+	}
+	params := &entity.NewExperienceSlotParams{
+		ExperienceID: in.ExperienceID,
+		Capacity:     in.Capacity,
+		StartAt:      in.StartAt,
+		EndAt:        in.EndAt,
+	}
+	slot, err := entity.NewExperienceSlot(params)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if err := s.db.ExperienceSlot.Create(ctx, slot); err != nil {
+		return nil, internalError(err)
+	}
+	slot.SetStatus(s.now())
+	return slot, nil
+}
+
+func (s *service) UpdateExperienceSlot(ctx context.Context, in *store.UpdateExperienceSlotInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	slot := &entity.ExperienceSlot{
+		ID:       in.ExperienceSlotID,
+		Capacity: in.Capacity,
+		StartAt:  in.StartAt,
+		EndAt:    in.EndAt,
+	}
+	if err := slot.Validate(); err != nil {
+		return internalError(err)
+	}
+	params := &database.UpdateExperienceSlotParams{
+		Capacity: slot.Capacity,
+		StartAt:  slot.StartAt,
+		EndAt:    slot.EndAt,
+	}
+	err := s.db.ExperienceSlot.Update(ctx, in.ExperienceSlotID, params)
+	return internalError(err)
+}
+
+func (s *service) DeleteExperienceSlot(ctx context.Context, in *store.DeleteExperienceSlotInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	err := s.db.ExperienceSlot.Delete(ctx, in.ExperienceSlotID)
+	return internalError(err)
+}
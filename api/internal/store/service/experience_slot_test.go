@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListExperienceSlots(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+	slots := func() entity.ExperienceSlots {
+		return entity.ExperienceSlots{
+			{
+				ID:           "slot-id01",
+				ExperienceID: "experience-id",
+				Status:       entity.ExperienceSlotStatusAccepting,
+				Capacity:     10,
+				Reserved:     2,
+				StartAt:      now.AddDate(0, 0, 1),
+				EndAt:        now.AddDate(0, 0, 1).Add(2 * time.Hour),
+			},
+			{
+				ID:           "slot-id02",
+				ExperienceID: "experience-id",
+				Status:       entity.ExperienceSlotStatusSoldOut,
+				Capacity:     10,
+				Reserved:     10,
+				StartAt:      now.AddDate(0, 0, 2),
+				EndAt:        now.AddDate(0, 0, 2).Add(2 * time.Hour),
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ListExperienceSlotsInput
+		expect    entity.ExperienceSlots
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.ListExperienceSlotsParams{
+					ExperienceID: "experience-id",
+				}
+				mocks.db.ExperienceSlot.EXPECT().List(ctx, params).Return(slots(), nil)
+			},
+			input: &store.ListExperienceSlotsInput{
+				ExperienceID: "experience-id",
+			},
+			expect:    slots(),
+			expectErr: nil,
+		},
+		{
+			name: "success only accepting",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.ListExperienceSlotsParams{
+					ExperienceID: "experience-id",
+					StartAtGte:   now,
+				}
+				mocks.db.ExperienceSlot.EXPECT().List(ctx, params).Return(slots(), nil)
+			},
+			input: &store.ListExperienceSlotsInput{
+				ExperienceID:  "experience-id",
+				OnlyAccepting: true,
+			},
+			expect:    slots()[:1],
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ListExperienceSlotsInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list experience slots",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ExperienceSlot.EXPECT().List(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			input: &store.ListExperienceSlotsInput{
+				ExperienceID: "experience-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ListExperienceSlots(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestGetExperienceSlot(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+	slot := &entity.ExperienceSlot{
+		ID:           "slot-id",
+		ExperienceID: "experience-id",
+		Status:       entity.ExperienceSlotStatusAccepting,
+		Capacity:     10,
+		Reserved:     2,
+		StartAt:      now.AddDate(0, 0, 1),
+		EndAt:        now.AddDate(0, 0, 1).Add(2 * time.Hour),
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetExperienceSlotInput
+		expect    *entity.ExperienceSlot
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ExperienceSlot.EXPECT().Get(ctx, "slot-id").Return(slot, nil)
+			},
+			input: &store.GetExperienceSlotInput{
+				ExperienceSlotID: "slot-id",
+			},
+			expect:    slot,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetExperienceSlotInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get experience slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ExperienceSlot.EXPECT().Get(ctx, "slot-id").Return(nil, assert.AnError)
+			},
+			input: &store.GetExperienceSlotInput{
+				ExperienceSlotID: "slot-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetExperienceSlot(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestCreateExperienceSlot(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+	experience := &entity.Experience{
+		ID:     "experience-id",
+		Status: entity.ExperienceStatusAccepting,
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.CreateExperienceSlotInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Experience.EXPECT().Get(ctx, "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, slot *entity.ExperienceSlot) error {
+						expect := &entity.ExperienceSlot{
+							ID:           slot.ID, // ignore
+							ExperienceID: "experience-id",
+							Capacity:     10,
+							StartAt:      now.AddDate(0, 0, 1),
+							EndAt:        now.AddDate(0, 0, 1).Add(2 * time.Hour),
+						}
+						assert.Equal(t, expect, slot)
+						return nil
+					})
+			},
+			input: &store.CreateExperienceSlotInput{
+				ExperienceID: "experience-id",
+				Capacity:     10,
+				StartAt:      now.AddDate(0, 0, 1),
+				EndAt:        now.AddDate(0, 0, 1).Add(2 * time.Hour),
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.CreateExperienceSlotInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get experience",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Experience.EXPECT().Get(ctx, "experience-id").Return(nil, assert.AnError)
+			},
+			input: &store.CreateExperienceSlotInput{
+				ExperienceID: "experience-id",
+				Capacity:     10,
+				StartAt:      now.AddDate(0, 0, 1),
+				EndAt:        now.AddDate(0, 0, 1).Add(2 * time.Hour),
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to create experience slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Experience.EXPECT().Get(ctx, "experience-id").Return(experience, nil)
+				mocks.db.ExperienceSlot.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &store.CreateExperienceSlotInput{
+				ExperienceID: "experience-id",
+				Capacity:     10,
+				StartAt:      now.AddDate(0, 0, 1),
+				EndAt:        now.AddDate(0, 0, 1).Add(2 * time.Hour),
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CreateExperienceSlot(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestUpdateExperienceSlot(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+	params := &database.UpdateExperienceSlotParams{
+		Capacity: 20,
+		StartAt:  now.AddDate(0, 0, 1),
+		EndAt:    now.AddDate(0, 0, 1).Add(2 * time.Hour),
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.UpdateExperienceSlotInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ExperienceSlot.EXPECT().Update(ctx, "slot-id", params).Return(nil)
+			},
+			input: &store.UpdateExperienceSlotInput{
+				ExperienceSlotID: "slot-id",
+				Capacity:         20,
+				StartAt:          now.AddDate(0, 0, 1),
+				EndAt:            now.AddDate(0, 0, 1).Add(2 * time.Hour),
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.UpdateExperienceSlotInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "capacity is less than reserved",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ExperienceSlot.EXPECT().Update(ctx, "slot-id", params).Return(database.ErrFailedPrecondition)
+			},
+			input: &store.UpdateExperienceSlotInput{
+				ExperienceSlotID: "slot-id",
+				Capacity:         20,
+				StartAt:          now.AddDate(0, 0, 1),
+				EndAt:            now.AddDate(0, 0, 1).Add(2 * time.Hour),
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.UpdateExperienceSlot(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestDeleteExperienceSlot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.DeleteExperienceSlotInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ExperienceSlot.EXPECT().Delete(ctx, "slot-id").Return(nil)
+			},
+			input: &store.DeleteExperienceSlotInput{
+				ExperienceSlotID: "slot-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.DeleteExperienceSlotInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to delete experience slot",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ExperienceSlot.EXPECT().Delete(ctx, "slot-id").Return(assert.AnError)
+			},
+			input: &store.DeleteExperienceSlotInput{
+				ExperienceSlotID: "slot-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.DeleteExperienceSlot(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/media"
+	mentity "github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// 配信が延長される場合があるため、ライブ配信の停止処理（開催終了1時間後）まではピン留めを許可する
+const livePinGracePeriod = time.Hour
+
+func (s *service) ListLivePins(ctx context.Context, in *store.ListLivePinsInput) (entity.LivePins, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.ListLivePinsParams{
+		ScheduleID:   in.ScheduleID,
+		UpdatedAtGte: in.UpdatedAtGte,
+	}
+	pins, err := s.db.LivePin.List(ctx, params)
+	return pins, internalError(err)
+}
+
+func (s *service) PinLiveProduct(ctx context.Context, in *store.PinLiveProductInput) (*entity.LivePin, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	var (
+		schedule  *entity.Schedule
+		lives     entity.Lives
+		broadcast *mentity.Broadcast
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		schedule, err = s.db.Schedule.Get(ectx, in.ScheduleID)
+		return
+	})
+	eg.Go(func() (err error) {
+		params := &database.ListLivesParams{
+			ScheduleIDs: []string{in.ScheduleID},
+		}
+		lives, err = s.db.Live.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		broadcast, err = s.getLiveBroadcast(ectx, in.ScheduleID)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	now := s.now()
+	if now.Before(schedule.StartAt) || now.After(schedule.EndAt.Add(livePinGracePeriod)) || !broadcast.EndedAt.IsZero() {
+		return nil, fmt.Errorf("service: this schedule is not on air: %w", exception.ErrFailedPrecondition)
+	}
+	if !slices.Contains(lives.ProductIDs(), in.ProductID) {
+		return nil, fmt.Errorf("service: this product is not in schedule: %w", exception.ErrInvalidArgument)
+	}
+	params := &entity.NewLivePinParams{
+		Schedule:  schedule,
+		ProductID: in.ProductID,
+		AdminID:   in.AdminID,
+		StartedAt: broadcast.StartedAt,
+		PinnedAt:  now,
+	}
+	pin := entity.NewLivePin(params)
+	// ピン留め中の商品の重複は一意制約で弾く
+	if err := s.db.LivePin.Create(ctx, pin); err != nil {
+		return nil, internalError(err)
+	}
+	s.notifyLivePin(ctx, pin)
+	return pin, nil
+}
+
+func (s *service) UnpinLiveProduct(ctx context.Context, in *store.UnpinLiveProductInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	pin, err := s.db.LivePin.Get(ctx, in.LivePinID)
+	if err != nil {
+		return internalError(err)
+	}
+	if pin.ScheduleID != in.ScheduleID {
+		return fmt.Errorf("service: this pin is not in schedule: %w", exception.ErrNotFound)
+	}
+	if !pin.Pinned() {
+		return fmt.Errorf("service: this pin is already unpinned: %w", exception.ErrFailedPrecondition)
+	}
+	broadcast, err := s.getLiveBroadcast(ctx, pin.ScheduleID)
+	if err != nil {
+		return internalError(err)
+	}
+	pin.Unpin(s.now(), broadcast.StartedAt)
+	params := &database.UnpinLivePinParams{
+		UnpinnedAt:     pin.UnpinnedAt,
+		UnpinnedOffset: pin.UnpinnedOffset,
+	}
+	if err := s.db.LivePin.Unpin(ctx, pin.ID, params); err != nil {
+		return internalError(err)
+	}
+	s.notifyLivePin(ctx, pin)
+	return nil
+}
+
+// getLiveBroadcast - ピン留め位置の基準となるライブ配信（未作成の場合は配信実績なしとして扱う）
+func (s *service) getLiveBroadcast(ctx context.Context, scheduleID string) (*mentity.Broadcast, error) {
+	in := &media.GetBroadcastByScheduleIDInput{
+		ScheduleID: scheduleID,
+	}
+	broadcast, err := s.media.GetBroadcastByScheduleID(ctx, in)
+	if errors.Is(err, exception.ErrNotFound) {
+		return &mentity.Broadcast{ScheduleID: scheduleID}, nil
+	}
+	return broadcast, err
+}
+
+// notifyLivePin - 視聴者へピン留めの変更を配信する（配信に失敗した場合も処理は継続する）
+func (s *service) notifyLivePin(ctx context.Context, pin *entity.LivePin) {
+	in := &media.PublishBroadcastPinEventInput{
+		ScheduleID: pin.ScheduleID,
+		LivePinID:  pin.ID,
+		ProductID:  pin.ProductID,
+		Pinned:     pin.Pinned(),
+		Offset:     pin.PinnedOffset,
+	}
+	if !pin.Pinned() {
+		in.Offset = pin.UnpinnedOffset
+	}
+	if err := s.media.PublishBroadcastPinEvent(ctx, in); err != nil {
+		slog.WarnContext(ctx, "Failed to publish live pin event", slog.String("livePinId", pin.ID), log.Error(err))
+	}
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/media"
+	mentity "github.com/and-period/furumaru/api/internal/media/entity"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListLivePins(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 0, 0, 0)
+	params := &database.ListLivePinsParams{
+		ScheduleID:   "schedule-id",
+		UpdatedAtGte: now,
+	}
+	pins := entity.LivePins{
+		{
+			ID:           "pin-id",
+			ScheduleID:   "schedule-id",
+			ProductID:    "product-id",
+			AdminID:      "admin-id",
+			PinnedAt:     now,
+			PinnedOffset: 60,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ListLivePinsInput
+		expect    entity.LivePins
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.LivePin.EXPECT().List(ctx, params).Return(pins, nil)
+			},
+			input: &store.ListLivePinsInput{
+				ScheduleID:   "schedule-id",
+				UpdatedAtGte: now,
+			},
+			expect:    pins,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ListLivePinsInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list live pins",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.LivePin.EXPECT().List(ctx, params).Return(nil, assert.AnError)
+			},
+			input: &store.ListLivePinsInput{
+				ScheduleID:   "schedule-id",
+				UpdatedAtGte: now,
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ListLivePins(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestPinLiveProduct(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 5, 0, 0)
+	schedule := &entity.Schedule{
+		ID:      "schedule-id",
+		StartAt: jst.Date(2026, 10, 18, 18, 0, 0, 0),
+		EndAt:   jst.Date(2026, 10, 18, 20, 0, 0, 0),
+	}
+	lives := entity.Lives{
+		{ID: "live-id", ScheduleID: "schedule-id", ProductIDs: []string{"product-id"}},
+	}
+	livesParams := &database.ListLivesParams{
+		ScheduleIDs: []string{"schedule-id"},
+	}
+	broadcastIn := &media.GetBroadcastByScheduleIDInput{
+		ScheduleID: "schedule-id",
+	}
+	broadcast := &mentity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+		StartedAt:  jst.Date(2026, 10, 18, 18, 1, 0, 0),
+	}
+	input := &store.PinLiveProductInput{
+		ScheduleID: "schedule-id",
+		ProductID:  "product-id",
+		AdminID:    "admin-id",
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.PinLiveProductInput
+		expect    *entity.LivePin
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(broadcast, nil)
+				mocks.db.LivePin.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, pin *entity.LivePin) error {
+						expect := &entity.LivePin{
+							ID:           pin.ID, // ignore
+							ScheduleID:   "schedule-id",
+							ProductID:    "product-id",
+							AdminID:      "admin-id",
+							PinnedAt:     now,
+							PinnedOffset: 240,
+						}
+						assert.Equal(t, expect, pin)
+						return nil
+					})
+				mocks.media.EXPECT().
+					PublishBroadcastPinEvent(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, in *media.PublishBroadcastPinEventInput) error {
+						assert.Equal(t, "schedule-id", in.ScheduleID)
+						assert.Equal(t, "product-id", in.ProductID)
+						assert.True(t, in.Pinned)
+						assert.Equal(t, int64(240), in.Offset)
+						return nil
+					})
+			},
+			input: input,
+			expect: &entity.LivePin{
+				ScheduleID:   "schedule-id",
+				ProductID:    "product-id",
+				AdminID:      "admin-id",
+				PinnedAt:     now,
+				PinnedOffset: 240,
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.PinLiveProductInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get schedule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(nil, assert.AnError)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil).AnyTimes()
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(broadcast, nil).AnyTimes()
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "schedule is not on air",
+			setup: func(ctx context.Context, mocks *mocks) {
+				schedule := &entity.Schedule{
+					ID:      "schedule-id",
+					StartAt: jst.Date(2026, 10, 18, 19, 0, 0, 0),
+					EndAt:   jst.Date(2026, 10, 18, 20, 0, 0, 0),
+				}
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(broadcast, nil)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "product is not in schedule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(entity.Lives{}, nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(broadcast, nil)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "success without broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(nil, exception.ErrNotFound)
+				mocks.db.LivePin.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.media.EXPECT().PublishBroadcastPinEvent(ctx, gomock.Any()).Return(nil)
+			},
+			input: input,
+			expect: &entity.LivePin{
+				ScheduleID:   "schedule-id",
+				ProductID:    "product-id",
+				AdminID:      "admin-id",
+				PinnedAt:     now,
+				PinnedOffset: 0,
+			},
+			expectErr: nil,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil).AnyTimes()
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil).AnyTimes()
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "broadcast has already ended",
+			setup: func(ctx context.Context, mocks *mocks) {
+				broadcast := &mentity.Broadcast{
+					ID:         "broadcast-id",
+					ScheduleID: "schedule-id",
+					StartedAt:  jst.Date(2026, 10, 18, 18, 1, 0, 0),
+					EndedAt:    jst.Date(2026, 10, 18, 18, 4, 0, 0),
+				}
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(broadcast, nil)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "already pinned",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(broadcast, nil)
+				mocks.db.LivePin.EXPECT().Create(ctx, gomock.Any()).Return(database.ErrAlreadyExists)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrAlreadyExists,
+		},
+		{
+			name: "failed to create live pin",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Schedule.EXPECT().Get(gomock.Any(), "schedule-id").Return(schedule, nil)
+				mocks.db.Live.EXPECT().List(gomock.Any(), livesParams).Return(lives, nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(gomock.Any(), broadcastIn).Return(broadcast, nil)
+				mocks.db.LivePin.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.PinLiveProduct(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if actual != nil {
+				actual.ID = "" // ignore
+			}
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestUnpinLiveProduct(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 10, 0, 0)
+	pin := func() *entity.LivePin {
+		return &entity.LivePin{
+			ID:           "pin-id",
+			ScheduleID:   "schedule-id",
+			ProductID:    "product-id",
+			PinnedAt:     now.Add(-5 * time.Minute),
+			PinnedOffset: 300,
+		}
+	}
+	params := &database.UnpinLivePinParams{
+		UnpinnedAt:     now,
+		UnpinnedOffset: 600,
+	}
+	publishIn := &media.PublishBroadcastPinEventInput{
+		ScheduleID: "schedule-id",
+		LivePinID:  "pin-id",
+		ProductID:  "product-id",
+		Pinned:     false,
+		Offset:     600,
+	}
+	broadcastIn := &media.GetBroadcastByScheduleIDInput{
+		ScheduleID: "schedule-id",
+	}
+	broadcast := &mentity.Broadcast{
+		ID:         "broadcast-id",
+		ScheduleID: "schedule-id",
+		StartedAt:  now.Add(-10 * time.Minute),
+	}
+	input := &store.UnpinLiveProductInput{
+		ScheduleID: "schedule-id",
+		LivePinID:  "pin-id",
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.UnpinLiveProductInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.LivePin.EXPECT().Get(ctx, "pin-id").Return(pin(), nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(ctx, broadcastIn).Return(broadcast, nil)
+				mocks.db.LivePin.EXPECT().Unpin(ctx, "pin-id", params).Return(nil)
+				mocks.media.EXPECT().PublishBroadcastPinEvent(ctx, publishIn).Return(nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "success without publishing event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.LivePin.EXPECT().Get(ctx, "pin-id").Return(pin(), nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(ctx, broadcastIn).Return(broadcast, nil)
+				mocks.db.LivePin.EXPECT().Unpin(ctx, "pin-id", params).Return(nil)
+				mocks.media.EXPECT().PublishBroadcastPinEvent(ctx, publishIn).Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.UnpinLiveProductInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get live pin",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.LivePin.EXPECT().Get(ctx, "pin-id").Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "other schedule",
+			setup: func(ctx context.Context, mocks *mocks) {
+				pin := pin()
+				pin.ScheduleID = "other-id"
+				mocks.db.LivePin.EXPECT().Get(ctx, "pin-id").Return(pin, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "already unpinned",
+			setup: func(ctx context.Context, mocks *mocks) {
+				pin := pin()
+				pin.UnpinnedAt = now.Add(-time.Minute)
+				mocks.db.LivePin.EXPECT().Get(ctx, "pin-id").Return(pin, nil)
+			},
+			input:     input,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to get broadcast",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.LivePin.EXPECT().Get(ctx, "pin-id").Return(pin(), nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(ctx, broadcastIn).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to unpin",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.LivePin.EXPECT().Get(ctx, "pin-id").Return(pin(), nil)
+				mocks.media.EXPECT().GetBroadcastByScheduleID(ctx, broadcastIn).Return(broadcast, nil)
+				mocks.db.LivePin.EXPECT().Unpin(ctx, "pin-id", params).Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.UnpinLiveProduct(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
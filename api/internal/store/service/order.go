@@ -3,6 +3,7 @@ package service
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -15,12 +16,15 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/exporter"
 	"github.com/and-period/furumaru/api/internal/store/exporter/general"
+	"github.com/and-period/furumaru/api/internal/store/exporter/japanpost"
 	"github.com/and-period/furumaru/api/internal/store/exporter/sagawa"
 	"github.com/and-period/furumaru/api/internal/store/exporter/yamato"
+	"github.com/and-period/furumaru/api/internal/store/invoice"
 	"github.com/and-period/furumaru/api/internal/store/payment"
 	"github.com/and-period/furumaru/api/internal/user"
 	uentity "github.com/and-period/furumaru/api/internal/user/entity"
 	"github.com/and-period/furumaru/api/pkg/log"
+	"github.com/and-period/furumaru/api/pkg/pdf"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -29,12 +33,13 @@ func (s *service) ListOrders(ctx context.Context, in *store.ListOrdersInput) (en
 		return nil, 0, internalError(err)
 	}
 	params := &database.ListOrdersParams{
-		ShopID:   in.ShopID,
-		UserID:   in.UserID,
-		Types:    in.Types,
-		Statuses: in.Statuses,
-		Limit:    int(in.Limit),
-		Offset:   int(in.Offset),
+		ShopID:          in.ShopID,
+		UserID:          in.UserID,
+		PreorderBatchID: in.PreorderBatchID,
+		Types:           in.Types,
+		Statuses:        in.Statuses,
+		Limit:           int(in.Limit),
+		Offset:          int(in.Offset),
 	}
 	var (
 		orders entity.Orders
@@ -202,20 +207,112 @@ func (s *service) RefundOrder(ctx context.Context, in *store.RefundOrderInput) e
 	if err != nil {
 		return internalError(err)
 	}
+	items := make(entity.OrderRefundLineItems, len(in.Items))
+	for i := range in.Items {
+		items[i] = &entity.OrderRefundLineItem{
+			ProductRevisionID: in.Items[i].ProductRevisionID,
+			Quantity:          in.Items[i].Quantity,
+		}
+	}
+	_, err = s.refundOrder(ctx, order, items, in.Amount, in.Description)
+	return err
+}
+
+func (s *service) refundOrder(
+	ctx context.Context, order *entity.Order, items entity.OrderRefundLineItems, amount int64, reason string,
+) (*entity.OrderRefundLine, error) {
 	if !order.Refundable() {
-		return fmt.Errorf("service: this order cannot be refund: %w", exception.ErrFailedPrecondition)
+		return nil, fmt.Errorf("service: this order cannot be refund: %w", exception.ErrFailedPrecondition)
 	}
 	prov, err := s.getProviderByType(order.OrderPayment.ProviderType)
 	if err != nil {
-		return internalError(err)
+		return nil, internalError(err)
+	}
+	lines, err := s.db.OrderRefundLine.List(ctx, &database.ListOrderRefundLinesParams{OrderID: order.ID})
+	if err != nil {
+		return nil, internalError(err)
+	}
+	revisionIDs := make([]int64, len(items))
+	for i := range items {
+		revisionIDs[i] = items[i].ProductRevisionID
+	}
+	var products entity.Products
+	if len(revisionIDs) > 0 {
+		products, err = s.db.Product.MultiGetByRevision(ctx, revisionIDs)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+	params := &entity.NewOrderRefundLineParams{
+		Order:    order,
+		Lines:    lines,
+		Products: products.MapByRevision(),
+		Items:    items,
+		Amount:   amount,
+		Reason:   reason,
+	}
+	line, err := entity.NewOrderRefundLine(params)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if err := s.db.OrderRefundLine.Create(ctx, line); err != nil {
+		return nil, internalError(err)
 	}
+	// 返金明細IDを冪等キーとし、同一注文への複数回の一部返金を区別する
 	rparams := &payment.RefundParams{
-		PaymentID:   order.PaymentID,
-		Amount:      order.Total,
-		Description: in.Description,
+		PaymentID:      order.PaymentID,
+		Amount:         line.Amount,
+		Description:    reason,
+		IdempotencyKey: line.ID,
+	}
+	if err := prov.RefundPayment(ctx, rparams); err != nil {
+		uparams := &database.UpdateOrderRefundLineParams{
+			Status: entity.OrderRefundLineStatusFailed,
+		}
+		if uerr := s.db.OrderRefundLine.Update(ctx, line.ID, uparams); uerr != nil {
+			slog.ErrorContext(ctx, "Failed to update order refund line", slog.String("refundLineId", line.ID), log.Error(uerr))
+		}
+		return nil, internalError(err)
 	}
-	err = prov.RefundPayment(ctx, rparams)
-	return internalError(err)
+	uparams := &database.UpdateOrderRefundLineParams{
+		Status:     entity.OrderRefundLineStatusSucceeded,
+		RefundedAt: s.now(),
+	}
+	if err := s.db.OrderRefundLine.Update(ctx, line.ID, uparams); err != nil {
+		return nil, internalError(err)
+	}
+
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		in := &messenger.NotifyOrderRefundedInput{
+			OrderID:      order.ID,
+			RefundLineID: line.ID,
+		}
+		if err := s.messenger.NotifyOrderRefunded(context.Background(), in); err != nil {
+			slog.ErrorContext(ctx, "Failed to notify order refunded", slog.String("orderId", order.ID), log.Error(err))
+		}
+	}()
+	return line, nil
+}
+
+func (s *service) ListOrderRefundLines(ctx context.Context, in *store.ListOrderRefundLinesInput) (entity.OrderRefundLines, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.ListOrderRefundLinesParams{
+		OrderID: in.OrderID,
+	}
+	lines, err := s.db.OrderRefundLine.List(ctx, params)
+	return lines, internalError(err)
+}
+
+func (s *service) GetOrderRefundLine(ctx context.Context, in *store.GetOrderRefundLineInput) (*entity.OrderRefundLine, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	line, err := s.db.OrderRefundLine.Get(ctx, in.RefundLineID)
+	return line, internalError(err)
 }
 
 func (s *service) UpdateOrderFulfillment(ctx context.Context, in *store.UpdateOrderFulfillmentInput) error {
@@ -274,6 +371,23 @@ func (s *service) AggregateOrdersByPaymentMethodType(
 	return orders, internalError(err)
 }
 
+func (s *service) AggregateOrdersByProduct(
+	ctx context.Context,
+	in *store.AggregateOrdersByProductInput,
+) (entity.AggregatedOrderProducts, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.AggregateOrdersByProductParams{
+		ShopID:       in.ShopID,
+		ProductIDs:   in.ProductIDs,
+		CreatedAtGte: in.CreatedAtGte,
+		CreatedAtLt:  in.CreatedAtLt,
+	}
+	orders, err := s.db.Order.AggregateByProduct(ctx, params)
+	return orders, internalError(err)
+}
+
 func (s *service) AggregateOrdersByPromotion(
 	ctx context.Context,
 	in *store.AggregateOrdersByPromotionInput,
@@ -310,9 +424,13 @@ func (s *service) ExportOrders(ctx context.Context, in *store.ExportOrdersInput)
 	if err := s.validator.Struct(in); err != nil {
 		return nil, internalError(err)
 	}
+	if in.LabelFormat == entity.ShippingLabelFormatClickPost && in.ShippingCarrier != entity.ShippingCarrierJapanPost {
+		return nil, fmt.Errorf("service: click post is only available for japan post: %w", exception.ErrInvalidArgument)
+	}
 	params := &database.ListOrdersParams{
-		ShopID:   in.ShopID,
-		Statuses: []entity.OrderStatus{entity.OrderStatusPreparing},
+		ShopID:          in.ShopID,
+		PreorderBatchID: in.PreorderBatchID,
+		Statuses:        []entity.OrderStatus{entity.OrderStatusPreparing},
 	}
 	orders, err := s.db.Order.List(ctx, params)
 	if err != nil {
@@ -344,9 +462,10 @@ func (s *service) ExportOrders(ctx context.Context, in *store.ExportOrdersInput)
 		return nil, internalError(err)
 	}
 	payload := &exportOrdersParams{
-		orders:    orders,
-		addresses: addresses.MapByRevision(),
-		products:  products.MapByRevision(),
+		encodingType: in.EncodingType,
+		orders:       orders,
+		addresses:    addresses.MapByRevision(),
+		products:     products.MapByRevision(),
 	}
 	buf := &bytes.Buffer{}
 	switch in.ShippingCarrier {
@@ -354,15 +473,108 @@ func (s *service) ExportOrders(ctx context.Context, in *store.ExportOrdersInput)
 		err = s.exportYamatoOrders(buf, payload)
 	case entity.ShippingCarrierSagawa:
 		err = s.exportSagawaOrders(buf, payload)
+	case entity.ShippingCarrierJapanPost:
+		if in.LabelFormat == entity.ShippingLabelFormatClickPost {
+			err = s.exportClickPostOrders(buf, payload)
+		} else {
+			err = s.exportJapanPostOrders(buf, payload)
+		}
 	default:
 		err = s.exportGeneralOrders(buf, payload)
 	}
+	if errors.Is(err, yamato.ErrUnsupportedDeliveryTimeWindow) || errors.Is(err, japanpost.ErrClickPostUnavailable) {
+		return nil, fmt.Errorf("service: %s: %w", err.Error(), exception.ErrFailedPrecondition)
+	}
 	if err != nil {
 		return nil, internalError(err)
 	}
 	return buf.Bytes(), nil
 }
 
+func (s *service) ExportOrderInvoice(ctx context.Context, in *store.ExportOrderInvoiceInput) ([]byte, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	order, err := s.db.Order.Get(ctx, in.OrderID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if in.UserID != "" && order.UserID != in.UserID {
+		return nil, fmt.Errorf("service: this order is not owned by the user: %w", exception.ErrNotFound)
+	}
+	switch order.Status {
+	case entity.OrderStatusCanceled, entity.OrderStatusFailed, entity.OrderStatusRefunded, entity.OrderStatusPartiallyRefunded:
+		// 返金済みの注文に対して全額の領収書を発行しないよう弾く
+		return nil, fmt.Errorf("service: this order cannot issue invoice: %w", exception.ErrFailedPrecondition)
+	}
+	var (
+		shop       *uentity.Shop
+		addresses  uentity.Addresses
+		products   entity.Products
+		experience *entity.Experience
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		if order.ShopID == "" {
+			in := &user.GetShopByCoordinatorIDInput{
+				CoordinatorID: order.CoordinatorID,
+			}
+			shop, err = s.user.GetShopByCoordinatorID(ectx, in)
+			return
+		}
+		in := &user.GetShopInput{
+			ShopID: order.ShopID,
+		}
+		shop, err = s.user.GetShop(ectx, in)
+		return
+	})
+	eg.Go(func() (err error) {
+		in := &user.MultiGetAddressesByRevisionInput{
+			AddressRevisionIDs: []int64{order.OrderPayment.AddressRevisionID},
+		}
+		addresses, err = s.user.MultiGetAddressesByRevision(ectx, in)
+		return
+	})
+	eg.Go(func() (err error) {
+		if order.Type != entity.OrderTypeProduct {
+			return
+		}
+		products, err = s.db.Product.MultiGetByRevision(ectx, entity.Orders{order}.ProductRevisionIDs())
+		return
+	})
+	eg.Go(func() error {
+		if order.Type != entity.OrderTypeExperience {
+			return nil
+		}
+		experiences, err := s.db.Experience.MultiGetByRevision(ectx, []int64{order.ExperienceRevisionID})
+		if err != nil || len(experiences) == 0 {
+			return err
+		}
+		experience = experiences[0]
+		return nil
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	var address *uentity.Address
+	if len(addresses) > 0 {
+		address = addresses[0]
+	}
+	params := &invoice.Params{
+		Order:      order,
+		Shop:       shop,
+		Address:    address,
+		Products:   products.MapByRevision(),
+		Experience: experience,
+		Now:        s.now(),
+	}
+	buf := &bytes.Buffer{}
+	if err := invoice.NewInvoice(params).Write(buf, pdf.WithFont(s.pdfFont)); err != nil {
+		return nil, internalError(err)
+	}
+	return buf.Bytes(), nil
+}
+
 type exportOrdersParams struct {
 	encodingType codes.CharacterEncodingType
 	orders       entity.Orders
@@ -399,7 +611,10 @@ func (s *service) exportYamatoOrders(writer io.Writer, payload *exportOrdersPara
 		Addresses: payload.addresses,
 		Products:  payload.products,
 	}
-	receipts := yamato.NewReceipts(params)
+	receipts, err := yamato.NewReceipts(params)
+	if err != nil {
+		return err
+	}
 	for i := range receipts {
 		if err := client.WriteBody(receipts[i]); err != nil {
 			return err
@@ -426,3 +641,44 @@ func (s *service) exportSagawaOrders(writer io.Writer, payload *exportOrdersPara
 	}
 	return client.Flush()
 }
+
+func (s *service) exportJapanPostOrders(writer io.Writer, payload *exportOrdersParams) error {
+	client := exporter.NewExporter(writer, payload.encodingType)
+	if err := client.WriteHeader(&japanpost.Receipt{}); err != nil {
+		return err
+	}
+	params := &japanpost.ReceiptsParams{
+		Orders:    payload.orders,
+		Addresses: payload.addresses,
+		Products:  payload.products,
+	}
+	receipts := japanpost.NewReceipts(params)
+	for i := range receipts {
+		if err := client.WriteBody(receipts[i]); err != nil {
+			return err
+		}
+	}
+	return client.Flush()
+}
+
+func (s *service) exportClickPostOrders(writer io.Writer, payload *exportOrdersParams) error {
+	client := exporter.NewExporter(writer, payload.encodingType)
+	if err := client.WriteHeader(&japanpost.ClickPostReceipt{}); err != nil {
+		return err
+	}
+	params := &japanpost.ReceiptsParams{
+		Orders:    payload.orders,
+		Addresses: payload.addresses,
+		Products:  payload.products,
+	}
+	receipts, err := japanpost.NewClickPostReceipts(params)
+	if err != nil {
+		return err
+	}
+	for i := range receipts {
+		if err := client.WriteBody(receipts[i]); err != nil {
+			return err
+		}
+	}
+	return client.Flush()
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/messenger"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"golang.org/x/sync/errgroup"
+)
+
+func (s *service) ListOrderClaims(ctx context.Context, in *store.ListOrderClaimsInput) (entity.OrderClaims, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListOrderClaimsParams{
+		ShopID:   in.ShopID,
+		OrderID:  in.OrderID,
+		UserID:   in.UserID,
+		Statuses: in.Statuses,
+		Limit:    int(in.Limit),
+		Offset:   int(in.Offset),
+	}
+	var (
+		claims entity.OrderClaims
+		total  int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		claims, err = s.db.OrderClaim.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.OrderClaim.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return claims, total, nil
+}
+
+func (s *service) GetOrderClaim(ctx context.Context, in *store.GetOrderClaimInput) (*entity.OrderClaim, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	claim, err := s.db.OrderClaim.Get(ctx, in.ClaimID)
+	return claim, internalError(err)
+}
+
+func (s *service) CreateOrderClaim(ctx context.Context, in *store.CreateOrderClaimInput) (*entity.OrderClaim, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	order, err := s.db.Order.Get(ctx, in.OrderID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if order.UserID != in.UserID {
+		return nil, fmt.Errorf("service: order is not found: %w", exception.ErrNotFound)
+	}
+	products, err := s.db.Product.MultiGetByRevision(ctx, order.ProductRevisionIDs())
+	if err != nil {
+		return nil, internalError(err)
+	}
+	// 利用者からは商品IDで指定されるため、注文時点の商品リビジョンに変換する
+	revisions := make(map[string]int64, len(products))
+	for _, p := range products {
+		revisions[p.ID] = p.ProductRevision.ID
+	}
+	items := make(entity.OrderClaimItems, len(in.Items))
+	for i, item := range in.Items {
+		revisionID, ok := revisions[item.ProductID]
+		if !ok {
+			return nil, fmt.Errorf("service: product is not ordered: %s: %w", item.ProductID, exception.ErrInvalidArgument)
+		}
+		items[i] = &entity.OrderClaimItem{
+			ProductRevisionID: revisionID,
+			Quantity:          item.Quantity,
+		}
+	}
+	params := &entity.NewOrderClaimParams{
+		Order:       order,
+		UserID:      in.UserID,
+		Type:        in.Type,
+		Description: in.Description,
+		Items:       items,
+		ImageURLs:   in.ImageURLs,
+	}
+	claim, err := entity.NewOrderClaim(params)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if err := s.db.OrderClaim.Create(ctx, claim); err != nil {
+		return nil, internalError(err)
+	}
+	return claim, nil
+}
+
+func (s *service) ApproveOrderClaim(ctx context.Context, in *store.ApproveOrderClaimInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	params := &database.UpdateOrderClaimParams{
+		Status:        entity.OrderClaimStatusApproved,
+		ReviewerID:    in.AdminID,
+		ReviewComment: in.Comment,
+		ReviewedAt:    s.now(),
+	}
+	err := s.db.OrderClaim.Update(ctx, in.ClaimID, params)
+	return internalError(err)
+}
+
+func (s *service) RejectOrderClaim(ctx context.Context, in *store.RejectOrderClaimInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	params := &database.UpdateOrderClaimParams{
+		Status:        entity.OrderClaimStatusRejected,
+		ReviewerID:    in.AdminID,
+		ReviewComment: in.Comment,
+		ReviewedAt:    s.now(),
+	}
+	err := s.db.OrderClaim.Update(ctx, in.ClaimID, params)
+	return internalError(err)
+}
+
+func (s *service) ResolveOrderClaim(ctx context.Context, in *store.ResolveOrderClaimInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	claim, err := s.db.OrderClaim.Get(ctx, in.ClaimID)
+	if err != nil {
+		return internalError(err)
+	}
+	if !claim.Status.CanTransition(entity.OrderClaimStatusResolving) {
+		return fmt.Errorf("service: this claim has not been approved: %w", exception.ErrFailedPrecondition)
+	}
+	// 返金・クーポン発行が重複して行われないよう、対応中へ遷移できた場合のみ対応を実行する
+	rparams := &database.UpdateOrderClaimParams{
+		Status: entity.OrderClaimStatusResolving,
+	}
+	if err := s.db.OrderClaim.Update(ctx, in.ClaimID, rparams); err != nil {
+		return internalError(err)
+	}
+	params, err := s.resolveOrderClaim(ctx, claim, in)
+	if err != nil {
+		aparams := &database.UpdateOrderClaimParams{
+			Status: entity.OrderClaimStatusApproved,
+		}
+		if uerr := s.db.OrderClaim.Update(context.Background(), in.ClaimID, aparams); uerr != nil {
+			slog.ErrorContext(ctx, "Failed to revert order claim status", slog.String("claimId", in.ClaimID), log.Error(uerr))
+		}
+		return err
+	}
+	if err := s.db.OrderClaim.Update(ctx, in.ClaimID, params); err != nil {
+		return internalError(err)
+	}
+	if in.ResolutionType != entity.OrderClaimResolutionTypeCoupon {
+		return nil
+	}
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		in := &messenger.NotifyOrderClaimCouponInput{
+			ClaimID: claim.ID,
+		}
+		if err := s.messenger.NotifyOrderClaimCoupon(context.Background(), in); err != nil {
+			slog.ErrorContext(ctx, "Failed to notify order claim coupon", slog.String("claimId", claim.ID), log.Error(err))
+		}
+	}()
+	return nil
+}
+
+// resolveOrderClaim - 対応方法に応じて返金・再発送・クーポン発行を行う
+func (s *service) resolveOrderClaim(
+	ctx context.Context, claim *entity.OrderClaim, in *store.ResolveOrderClaimInput,
+) (*database.UpdateOrderClaimParams, error) {
+	params := &database.UpdateOrderClaimParams{
+		Status:         entity.OrderClaimStatusResolved,
+		ResolutionType: in.ResolutionType,
+		ResolutionNote: in.Note,
+		ResolvedAt:     s.now(),
+	}
+	switch in.ResolutionType {
+	case entity.OrderClaimResolutionTypeRefund:
+		order, err := s.db.Order.Get(ctx, claim.OrderID)
+		if err != nil {
+			return nil, internalError(err)
+		}
+		line, err := s.refundOrder(ctx, order, claim.RefundItems(), 0, claim.Description)
+		if err != nil {
+			return nil, err
+		}
+		params.RefundLineID = line.ID
+	case entity.OrderClaimResolutionTypeReshipment:
+		order, err := s.db.Order.Get(ctx, claim.OrderID)
+		if err != nil {
+			return nil, internalError(err)
+		}
+		fparams := &entity.NewOrderClaimReshipmentParams{
+			Claim: claim,
+			Order: order,
+		}
+		fulfillment, err := entity.NewOrderClaimReshipment(fparams)
+		if err != nil {
+			return nil, internalError(err)
+		}
+		if err := s.db.Order.CreateFulfillment(ctx, fulfillment); err != nil {
+			return nil, internalError(err)
+		}
+		params.FulfillmentID = fulfillment.ID
+	case entity.OrderClaimResolutionTypeCoupon:
+		cparams := &entity.NewOrderClaimCouponParams{
+			Claim:  claim,
+			Amount: in.CouponAmount,
+			Now:    s.now(),
+		}
+		promotion, err := entity.NewOrderClaimCoupon(cparams)
+		if err != nil {
+			return nil, internalError(err)
+		}
+		if err := s.db.Promotion.Create(ctx, promotion); err != nil {
+			return nil, internalError(err)
+		}
+		params.PromotionID = promotion.ID
+	}
+	return params, nil
+}
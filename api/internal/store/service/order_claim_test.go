@@ -0,0 +1,600 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/messenger"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListOrderClaims(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	params := &database.ListOrderClaimsParams{
+		ShopID:   "shop-id",
+		Statuses: []entity.OrderClaimStatus{entity.OrderClaimStatusRequested},
+		Limit:    20,
+		Offset:   0,
+	}
+	claims := entity.OrderClaims{
+		{
+			ID:        "claim-id",
+			OrderID:   "order-id",
+			UserID:    "user-id",
+			ShopID:    "shop-id",
+			Type:      entity.OrderClaimTypeSpoiled,
+			Status:    entity.OrderClaimStatusRequested,
+			Items:     entity.OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}},
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *store.ListOrderClaimsInput
+		expect      entity.OrderClaims
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().List(gomock.Any(), params).Return(claims, nil)
+				mocks.db.OrderClaim.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListOrderClaimsInput{
+				ShopID:   "shop-id",
+				Statuses: []entity.OrderClaimStatus{entity.OrderClaimStatusRequested},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      claims,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &store.ListOrderClaimsInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list claims",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.OrderClaim.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListOrderClaimsInput{
+				ShopID:   "shop-id",
+				Statuses: []entity.OrderClaimStatus{entity.OrderClaimStatusRequested},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListOrderClaims(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}))
+	}
+}
+
+func TestGetOrderClaim(t *testing.T) {
+	t.Parallel()
+	claim := &entity.OrderClaim{
+		ID:      "claim-id",
+		OrderID: "order-id",
+		Status:  entity.OrderClaimStatusRequested,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetOrderClaimInput
+		expect    *entity.OrderClaim
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim, nil)
+			},
+			input:     &store.GetOrderClaimInput{ClaimID: "claim-id"},
+			expect:    claim,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetOrderClaimInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "not found",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(nil, database.ErrNotFound)
+			},
+			input:     &store.GetOrderClaimInput{ClaimID: "claim-id"},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetOrderClaim(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestCreateOrderClaim(t *testing.T) {
+	t.Parallel()
+	order := &entity.Order{
+		ID:            "order-id",
+		UserID:        "user-id",
+		ShopID:        "shop-id",
+		CoordinatorID: "coordinator-id",
+		Type:          entity.OrderTypeProduct,
+		Status:        entity.OrderStatusShipped,
+		OrderItems: entity.OrderItems{
+			{FulfillmentID: "fulfillment-id", ProductRevisionID: 1, OrderID: "order-id", Quantity: 2},
+		},
+	}
+	products := entity.Products{
+		{
+			ID:              "product-id",
+			ProductRevision: entity.ProductRevision{ID: 1, ProductID: "product-id", Price: 500},
+		},
+	}
+	input := func() *store.CreateOrderClaimInput {
+		return &store.CreateOrderClaimInput{
+			OrderID:     "order-id",
+			UserID:      "user-id",
+			Type:        entity.OrderClaimTypeSpoiled,
+			Description: "届いた野菜が傷んでいました。",
+			Items:       []*store.CreateOrderClaimItem{{ProductID: "product-id", Quantity: 1}},
+			ImageURLs:   []string{"https://example.com/image.png"},
+		}
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.CreateOrderClaimInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(products, nil)
+				mocks.db.OrderClaim.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, claim *entity.OrderClaim) error {
+						assert.Equal(t, "order-id", claim.OrderID)
+						assert.Equal(t, "shop-id", claim.ShopID)
+						assert.Equal(t, entity.OrderClaimStatusRequested, claim.Status)
+						assert.Equal(t, entity.OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}}, claim.Items)
+						return nil
+					})
+			},
+			input:     input(),
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.CreateOrderClaimInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "other user's order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := &entity.Order{ID: "order-id", UserID: "other-id"}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+			},
+			input:     input(),
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "not ordered product",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(entity.Products{}, nil)
+			},
+			input:     input(),
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "order is not shipped",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := &entity.Order{
+					ID:         "order-id",
+					UserID:     "user-id",
+					Type:       entity.OrderTypeProduct,
+					Status:     entity.OrderStatusPreparing,
+					OrderItems: order.OrderItems,
+				}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(products, nil)
+			},
+			input:     input(),
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to create claim",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(products, nil)
+				mocks.db.OrderClaim.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input(),
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CreateOrderClaim(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestApproveOrderClaim(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	params := &database.UpdateOrderClaimParams{
+		Status:        entity.OrderClaimStatusApproved,
+		ReviewerID:    "admin-id",
+		ReviewComment: "返金にて対応いたします。",
+		ReviewedAt:    now,
+	}
+	tests := []struct {
+		name   string
+		setup  func(ctx context.Context, mocks *mocks)
+		input  *store.ApproveOrderClaimInput
+		expect error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", params).Return(nil)
+			},
+			input: &store.ApproveOrderClaimInput{
+				ClaimID: "claim-id",
+				AdminID: "admin-id",
+				Comment: "返金にて対応いたします。",
+			},
+			expect: nil,
+		},
+		{
+			name:   "invalid argument",
+			setup:  func(ctx context.Context, mocks *mocks) {},
+			input:  &store.ApproveOrderClaimInput{},
+			expect: exception.ErrInvalidArgument,
+		},
+		{
+			name: "already reviewed",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", params).Return(database.ErrFailedPrecondition)
+			},
+			input: &store.ApproveOrderClaimInput{
+				ClaimID: "claim-id",
+				AdminID: "admin-id",
+				Comment: "返金にて対応いたします。",
+			},
+			expect: exception.ErrFailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.ApproveOrderClaim(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expect)
+		}, withNow(now)))
+	}
+}
+
+func TestRejectOrderClaim(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	params := &database.UpdateOrderClaimParams{
+		Status:        entity.OrderClaimStatusRejected,
+		ReviewerID:    "admin-id",
+		ReviewComment: "お客様都合による返品は承っておりません。",
+		ReviewedAt:    now,
+	}
+	tests := []struct {
+		name   string
+		setup  func(ctx context.Context, mocks *mocks)
+		input  *store.RejectOrderClaimInput
+		expect error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", params).Return(nil)
+			},
+			input: &store.RejectOrderClaimInput{
+				ClaimID: "claim-id",
+				AdminID: "admin-id",
+				Comment: "お客様都合による返品は承っておりません。",
+			},
+			expect: nil,
+		},
+		{
+			name:  "empty comment",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.RejectOrderClaimInput{
+				ClaimID: "claim-id",
+				AdminID: "admin-id",
+			},
+			expect: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to update claim",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", params).Return(assert.AnError)
+			},
+			input: &store.RejectOrderClaimInput{
+				ClaimID: "claim-id",
+				AdminID: "admin-id",
+				Comment: "お客様都合による返品は承っておりません。",
+			},
+			expect: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.RejectOrderClaim(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expect)
+		}, withNow(now)))
+	}
+}
+
+func TestResolveOrderClaim(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	claim := func(status entity.OrderClaimStatus) *entity.OrderClaim {
+		return &entity.OrderClaim{
+			ID:          "claim-id",
+			OrderID:     "order-id",
+			UserID:      "user-id",
+			ShopID:      "shop-id",
+			Type:        entity.OrderClaimTypeSpoiled,
+			Status:      status,
+			Description: "届いた野菜が傷んでいました。",
+			Items:       entity.OrderClaimItems{{ProductRevisionID: 1, Quantity: 1}},
+		}
+	}
+	order := &entity.Order{
+		ID:     "order-id",
+		UserID: "user-id",
+		Type:   entity.OrderTypeProduct,
+		Status: entity.OrderStatusCompleted,
+		OrderPayment: entity.OrderPayment{
+			OrderID:      "order-id",
+			PaymentID:    "payment-id",
+			Status:       entity.PaymentStatusCaptured,
+			ProviderType: entity.PaymentProviderTypeKomoju,
+			Total:        1500,
+		},
+		OrderItems: entity.OrderItems{
+			{FulfillmentID: "fulfillment-id", ProductRevisionID: 1, OrderID: "order-id", Quantity: 2},
+		},
+		OrderFulfillments: entity.OrderFulfillments{
+			{
+				ID:                "fulfillment-id",
+				OrderID:           "order-id",
+				AddressRevisionID: 1,
+				Status:            entity.FulfillmentStatusFulfilled,
+				ShippingType:      entity.ShippingTypeNormal,
+				BoxNumber:         1,
+				BoxSize:           entity.ShippingSize60,
+			},
+		},
+	}
+	resolving := &database.UpdateOrderClaimParams{
+		Status: entity.OrderClaimStatusResolving,
+	}
+	approved := &database.UpdateOrderClaimParams{
+		Status: entity.OrderClaimStatusApproved,
+	}
+	products := entity.Products{
+		{
+			ID:              "product-id",
+			ProductRevision: entity.ProductRevision{ID: 1, ProductID: "product-id", Price: 500},
+		},
+	}
+	tests := []struct {
+		name   string
+		setup  func(ctx context.Context, mocks *mocks)
+		input  *store.ResolveOrderClaimInput
+		expect error
+	}{
+		{
+			name: "success refund",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim(entity.OrderClaimStatusApproved), nil)
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", resolving).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, &database.ListOrderRefundLinesParams{OrderID: "order-id"}).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(products, nil)
+				mocks.db.OrderRefundLine.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.payment.EXPECT().
+					RefundPayment(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, params *payment.RefundParams) error {
+						assert.Equal(t, int64(500), params.Amount)
+						return nil
+					})
+				mocks.db.OrderRefundLine.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				mocks.messenger.EXPECT().NotifyOrderRefunded(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.OrderClaim.EXPECT().
+					Update(ctx, "claim-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, claimID string, params *database.UpdateOrderClaimParams) error {
+						assert.Equal(t, entity.OrderClaimStatusResolved, params.Status)
+						assert.Equal(t, entity.OrderClaimResolutionTypeRefund, params.ResolutionType)
+						assert.NotEmpty(t, params.RefundLineID)
+						assert.Equal(t, now, params.ResolvedAt)
+						return nil
+					})
+			},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeRefund,
+			},
+			expect: nil,
+		},
+		{
+			name: "success reshipment",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim(entity.OrderClaimStatusApproved), nil)
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", resolving).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().
+					CreateFulfillment(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fulfillment *entity.OrderFulfillment) error {
+						assert.Equal(t, "order-id", fulfillment.OrderID)
+						assert.Equal(t, int64(1), fulfillment.AddressRevisionID)
+						assert.Equal(t, entity.FulfillmentStatusUnfulfilled, fulfillment.Status)
+						assert.Equal(t, int64(2), fulfillment.BoxNumber)
+						return nil
+					})
+				mocks.db.OrderClaim.EXPECT().
+					Update(ctx, "claim-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, claimID string, params *database.UpdateOrderClaimParams) error {
+						assert.Equal(t, entity.OrderClaimStatusResolved, params.Status)
+						assert.Equal(t, entity.OrderClaimResolutionTypeReshipment, params.ResolutionType)
+						assert.Equal(t, "代替品を発送しました。", params.ResolutionNote)
+						assert.NotEmpty(t, params.FulfillmentID)
+						assert.Equal(t, now, params.ResolvedAt)
+						return nil
+					})
+			},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeReshipment,
+				Note:           "代替品を発送しました。",
+			},
+			expect: nil,
+		},
+		{
+			name: "success coupon",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim(entity.OrderClaimStatusApproved), nil)
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", resolving).Return(nil)
+				mocks.db.Promotion.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, promotion *entity.Promotion) error {
+						assert.Equal(t, "shop-id", promotion.ShopID)
+						assert.Equal(t, int64(300), promotion.DiscountRate)
+						assert.Equal(t, entity.PromotionCodeTypeOnce, promotion.CodeType)
+						return nil
+					})
+				mocks.db.OrderClaim.EXPECT().
+					Update(ctx, "claim-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, claimID string, params *database.UpdateOrderClaimParams) error {
+						assert.Equal(t, entity.OrderClaimResolutionTypeCoupon, params.ResolutionType)
+						assert.NotEmpty(t, params.PromotionID)
+						return nil
+					})
+				mocks.messenger.EXPECT().NotifyOrderClaimCoupon(gomock.Any(), &messenger.NotifyOrderClaimCouponInput{ClaimID: "claim-id"}).Return(nil)
+			},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeCoupon,
+				CouponAmount:   300,
+			},
+			expect: nil,
+		},
+		{
+			name:  "coupon without amount",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeCoupon,
+			},
+			expect: exception.ErrInvalidArgument,
+		},
+		{
+			name: "not approved",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim(entity.OrderClaimStatusRequested), nil)
+			},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeReshipment,
+			},
+			expect: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "already resolving",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim(entity.OrderClaimStatusApproved), nil)
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", resolving).Return(database.ErrFailedPrecondition)
+			},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeRefund,
+			},
+			expect: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to create fulfillment",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim(entity.OrderClaimStatusApproved), nil)
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", resolving).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().CreateFulfillment(ctx, gomock.Any()).Return(assert.AnError)
+				mocks.db.OrderClaim.EXPECT().Update(gomock.Any(), "claim-id", approved).Return(nil)
+			},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeReshipment,
+			},
+			expect: exception.ErrInternal,
+		},
+		{
+			name: "failed to refund",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderClaim.EXPECT().Get(ctx, "claim-id").Return(claim(entity.OrderClaimStatusApproved), nil)
+				mocks.db.OrderClaim.EXPECT().Update(ctx, "claim-id", resolving).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, &database.ListOrderRefundLinesParams{OrderID: "order-id"}).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(products, nil)
+				mocks.db.OrderRefundLine.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.payment.EXPECT().RefundPayment(ctx, gomock.Any()).Return(errors.New("some error"))
+				mocks.db.OrderRefundLine.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.OrderClaim.EXPECT().Update(gomock.Any(), "claim-id", approved).Return(nil)
+			},
+			input: &store.ResolveOrderClaimInput{
+				ClaimID:        "claim-id",
+				ResolutionType: entity.OrderClaimResolutionTypeRefund,
+			},
+			expect: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.ResolveOrderClaim(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expect)
+		}, withNow(now)))
+	}
+}
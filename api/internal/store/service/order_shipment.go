@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/importer"
+	"github.com/and-period/furumaru/api/internal/store/importer/sagawa"
+	"github.com/and-period/furumaru/api/internal/store/importer/yamato"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/set"
+)
+
+func (s *service) ImportOrderShipments(ctx context.Context, in *store.ImportOrderShipmentsInput) (*entity.OrderShipmentImport, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	var client importer.Importer
+	switch in.ShippingCarrier {
+	case entity.ShippingCarrierYamato:
+		client = yamato.NewImporter()
+	case entity.ShippingCarrierSagawa:
+		client = sagawa.NewImporter()
+	default:
+		return nil, fmt.Errorf("service: unsupported shipping carrier: %w", exception.ErrInvalidArgument)
+	}
+	shipments, err := client.Parse(in.File, in.EncodingType)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to parse shipment file: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	orderIDs := set.NewEmpty[string](len(shipments))
+	for _, shipment := range shipments {
+		if shipment.ManagementID == "" {
+			continue
+		}
+		orderIDs.Add(shipment.ManagementID)
+	}
+	var orders entity.Orders
+	if orderIDs.Len() > 0 {
+		orders, err = s.db.Order.MultiGet(ctx, orderIDs.Slice())
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+	// 注文IDで照合できなかった行は注文管理番号として照合する（注文管理番号は店舗内でのみ一意のため、店舗指定時に限る）
+	managementIDs := set.NewEmpty[int64](len(shipments))
+	if in.ShopID != "" {
+		exists := set.New(orders.IDs()...)
+		for _, shipment := range shipments {
+			if shipment.ManagementID == "" || exists.Contains(shipment.ManagementID) {
+				continue
+			}
+			managementID, err := strconv.ParseInt(shipment.ManagementID, 10, 64)
+			if err != nil || managementID <= 0 {
+				continue
+			}
+			managementIDs.Add(managementID)
+		}
+	}
+	var managedOrders entity.Orders
+	if managementIDs.Len() > 0 {
+		managedOrders, err = s.db.Order.MultiGetByManagementIDs(ctx, in.ShopID, managementIDs.Slice())
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
+	params := &matchOrderShipmentsParams{
+		shopID:        in.ShopID,
+		carrier:       in.ShippingCarrier,
+		shipments:     shipments,
+		orders:        orders,
+		managedOrders: managedOrders,
+	}
+	fulfillments, shipped, res := s.matchOrderShipments(params)
+	if len(fulfillments) == 0 {
+		return res, nil
+	}
+	rejected, err := s.db.Order.ShipFulfillments(ctx, fulfillments)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	// 照合後に注文や配送情報の状態が変わっていた行は、照合できなかった行として返す
+	for _, fulfillment := range fulfillments {
+		reason, ok := rejected[fulfillment.FulfillmentID]
+		if !ok {
+			res.Total++
+			continue
+		}
+		shipment := shipped[fulfillment.FulfillmentID]
+		unmatched := &entity.UnmatchedShipment{
+			Line:           shipment.Line,
+			ManagementID:   shipment.ManagementID,
+			TrackingNumber: shipment.TrackingNumber,
+			Reason:         reason,
+		}
+		res.Unmatched = append(res.Unmatched, unmatched)
+	}
+	sort.SliceStable(res.Unmatched, func(i, j int) bool {
+		return res.Unmatched[i].Line < res.Unmatched[j].Line
+	})
+	return res, nil
+}
+
+type matchOrderShipmentsParams struct {
+	shopID        string
+	carrier       entity.ShippingCarrier
+	shipments     importer.Shipments
+	orders        entity.Orders
+	managedOrders entity.Orders
+}
+
+// matchOrderShipments - 送り状発行結果を注文と照合し、発送待ちの配送情報へ箱の通番順に伝票番号を割り当てる
+func (s *service) matchOrderShipments(
+	params *matchOrderShipmentsParams,
+) ([]*database.ShipOrderFulfillmentParams, map[string]*importer.Shipment, *entity.OrderShipmentImport) {
+	sortFulfillments := func(order *entity.Order) {
+		sort.SliceStable(order.OrderFulfillments, func(i, j int) bool {
+			return order.OrderFulfillments[i].BoxNumber < order.OrderFulfillments[j].BoxNumber
+		})
+	}
+	orders := make(map[string]*entity.Order, len(params.orders))
+	for _, order := range params.orders {
+		if params.shopID != "" && order.ShopID != params.shopID {
+			continue
+		}
+		sortFulfillments(order)
+		orders[order.ID] = order
+	}
+	managedOrders := make(map[string]*entity.Order, len(params.managedOrders))
+	for _, order := range params.managedOrders {
+		if order.ShopID != params.shopID {
+			continue
+		}
+		sortFulfillments(order)
+		managedOrders[strconv.FormatInt(order.ManagementID, 10)] = order
+	}
+	now := s.now()
+	fulfillments := make([]*database.ShipOrderFulfillmentParams, 0, len(params.shipments))
+	shipped := make(map[string]*importer.Shipment, len(params.shipments))
+	res := &entity.OrderShipmentImport{Unmatched: entity.UnmatchedShipments{}}
+	for _, shipment := range params.shipments {
+		order, reason := orders[shipment.ManagementID], entity.UnmatchedShipmentReasonUnknown
+		if order == nil {
+			order = managedOrders[shipment.ManagementID]
+		}
+		var fulfillment *entity.OrderFulfillment
+		switch {
+		case shipment.ManagementID == "":
+			reason = entity.UnmatchedShipmentReasonEmptyManagementID
+		case shipment.TrackingNumber == "":
+			reason = entity.UnmatchedShipmentReasonEmptyTrackingNumber
+		case order == nil:
+			reason = entity.UnmatchedShipmentReasonOrderNotFound
+		case hasTrackingNumber(order.OrderFulfillments, params.carrier, shipment.TrackingNumber):
+			res.Skipped++ // 取り込み済みの伝票番号
+			continue
+		case !order.Shippable():
+			reason = entity.UnmatchedShipmentReasonNotShippable
+		default:
+			for _, f := range order.OrderFulfillments {
+				if !f.Fulfilled() {
+					fulfillment = f
+					break
+				}
+			}
+			if fulfillment == nil {
+				reason = entity.UnmatchedShipmentReasonNoFulfillment
+			}
+		}
+		if fulfillment == nil {
+			unmatched := &entity.UnmatchedShipment{
+				Line:           shipment.Line,
+				ManagementID:   shipment.ManagementID,
+				TrackingNumber: shipment.TrackingNumber,
+				Reason:         reason,
+			}
+			res.Unmatched = append(res.Unmatched, unmatched)
+			continue
+		}
+		// 同一ファイル内の後続行で同じ配送情報に割り当てないよう、取り込み結果を反映しておく
+		fulfillment.Status = entity.FulfillmentStatusFulfilled
+		fulfillment.ShippingCarrier = params.carrier
+		fulfillment.TrackingNumber = shipment.TrackingNumber
+		fulfillments = append(fulfillments, &database.ShipOrderFulfillmentParams{
+			OrderID:         order.ID,
+			FulfillmentID:   fulfillment.ID,
+			ShippingCarrier: params.carrier,
+			TrackingNumber:  shipment.TrackingNumber,
+			ShippedAt:       now,
+		})
+		shipped[fulfillment.ID] = shipment
+	}
+	return fulfillments, shipped, res
+}
+
+func hasTrackingNumber(fulfillments entity.OrderFulfillments, carrier entity.ShippingCarrier, trackingNumber string) bool {
+	normalized := tracker.NormalizeTrackingNumber(trackingNumber)
+	for _, f := range fulfillments {
+		if f.ShippingCarrier == carrier && tracker.NormalizeTrackingNumber(f.TrackingNumber) == normalized {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,403 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImportOrderShipments(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	newOrder := func(orderID, shopID string, status entity.OrderStatus, fulfillments ...*entity.OrderFulfillment) *entity.Order {
+		return &entity.Order{
+			ID:                orderID,
+			ShopID:            shopID,
+			ManagementID:      1,
+			Type:              entity.OrderTypeProduct,
+			Status:            status,
+			OrderFulfillments: fulfillments,
+		}
+	}
+	newFulfillment := func(id string, boxNumber int64, status entity.FulfillmentStatus, trackingNumber string) *entity.OrderFulfillment {
+		f := &entity.OrderFulfillment{
+			ID:        id,
+			BoxNumber: boxNumber,
+			Status:    status,
+		}
+		if trackingNumber != "" {
+			f.ShippingCarrier = entity.ShippingCarrierYamato
+			f.TrackingNumber = trackingNumber
+		}
+		return f
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     func() *store.ImportOrderShipmentsInput
+		expect    *entity.OrderShipmentImport
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				orders := entity.Orders{
+					newOrder("order-id01", "shop-id", entity.OrderStatusPreparing,
+						newFulfillment("fulfillment-id02", 2, entity.FulfillmentStatusUnfulfilled, ""),
+						newFulfillment("fulfillment-id01", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+					newOrder("order-id02", "shop-id", entity.OrderStatusShipped,
+						newFulfillment("fulfillment-id03", 1, entity.FulfillmentStatusFulfilled, "1234-5678-9034"),
+					),
+					newOrder("order-id03", "shop-id", entity.OrderStatusCanceled,
+						newFulfillment("fulfillment-id04", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+					newOrder("order-id04", "other-shop-id", entity.OrderStatusPreparing,
+						newFulfillment("fulfillment-id05", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+				}
+				orderIDs := []string{"order-id01", "order-id02", "order-id03", "order-id04", "order-id05"}
+				mocks.db.Order.EXPECT().MultiGet(ctx, gomock.InAnyOrder(orderIDs)).Return(orders, nil)
+				params := []*database.ShipOrderFulfillmentParams{
+					{
+						OrderID:         "order-id01",
+						FulfillmentID:   "fulfillment-id01",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9012",
+						ShippedAt:       now,
+					},
+					{
+						OrderID:         "order-id01",
+						FulfillmentID:   "fulfillment-id02",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "1234-5678-9023",
+						ShippedAt:       now,
+					},
+				}
+				mocks.db.Order.EXPECT().ShipFulfillments(ctx, params).Return(map[string]entity.UnmatchedShipmentReason{}, nil)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				body := "お客様管理番号,伝票番号\n" +
+					"order-id01,1234-5678-9012\n" +
+					"order-id01,1234-5678-9023\n" +
+					"order-id01,1234-5678-9012\n" + // 同一ファイル内の重複
+					"order-id01,1234-5678-9045\n" + // 箱数を超過
+					"order-id02,123456789034\n" + // 取り込み済み
+					"order-id03,1234-5678-9056\n" +
+					"order-id04,1234-5678-9067\n" +
+					"order-id05,1234-5678-9078\n" +
+					",1234-5678-9089\n" +
+					"order-id01,\n"
+				return &store.ImportOrderShipmentsInput{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					EncodingType:    codes.CharacterEncodingTypeUTF8,
+					File:            bytes.NewBufferString(body),
+				}
+			},
+			expect: &entity.OrderShipmentImport{
+				Total:   2,
+				Skipped: 2,
+				Unmatched: entity.UnmatchedShipments{
+					{
+						Line:           5,
+						ManagementID:   "order-id01",
+						TrackingNumber: "1234-5678-9045",
+						Reason:         entity.UnmatchedShipmentReasonNoFulfillment,
+					},
+					{
+						Line:           7,
+						ManagementID:   "order-id03",
+						TrackingNumber: "1234-5678-9056",
+						Reason:         entity.UnmatchedShipmentReasonNotShippable,
+					},
+					{
+						Line:           8,
+						ManagementID:   "order-id04",
+						TrackingNumber: "1234-5678-9067",
+						Reason:         entity.UnmatchedShipmentReasonOrderNotFound,
+					},
+					{
+						Line:           9,
+						ManagementID:   "order-id05",
+						TrackingNumber: "1234-5678-9078",
+						Reason:         entity.UnmatchedShipmentReasonOrderNotFound,
+					},
+					{
+						Line:           10,
+						ManagementID:   "",
+						TrackingNumber: "1234-5678-9089",
+						Reason:         entity.UnmatchedShipmentReasonEmptyManagementID,
+					},
+					{
+						Line:           11,
+						ManagementID:   "order-id01",
+						TrackingNumber: "",
+						Reason:         entity.UnmatchedShipmentReasonEmptyTrackingNumber,
+					},
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success sagawa",
+			setup: func(ctx context.Context, mocks *mocks) {
+				orders := entity.Orders{
+					newOrder("order-id", "shop-id", entity.OrderStatusPreparing,
+						newFulfillment("fulfillment-id", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+				}
+				mocks.db.Order.EXPECT().MultiGet(ctx, []string{"order-id"}).Return(orders, nil)
+				params := []*database.ShipOrderFulfillmentParams{
+					{
+						OrderID:         "order-id",
+						FulfillmentID:   "fulfillment-id",
+						ShippingCarrier: entity.ShippingCarrierSagawa,
+						TrackingNumber:  "123456789012",
+						ShippedAt:       now,
+					},
+				}
+				mocks.db.Order.EXPECT().ShipFulfillments(ctx, params).Return(map[string]entity.UnmatchedShipmentReason{}, nil)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierSagawa,
+					File:            bytes.NewBufferString("お客様管理番号,お問い合せ送り状No.\norder-id,123456789012\n"),
+				}
+			},
+			expect: &entity.OrderShipmentImport{
+				Total:     1,
+				Skipped:   0,
+				Unmatched: entity.UnmatchedShipments{},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with management id",
+			setup: func(ctx context.Context, mocks *mocks) {
+				orders := entity.Orders{
+					newOrder("order-id", "shop-id", entity.OrderStatusPreparing,
+						newFulfillment("fulfillment-id", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+				}
+				mocks.db.Order.EXPECT().MultiGet(ctx, []string{"1"}).Return(entity.Orders{}, nil)
+				mocks.db.Order.EXPECT().MultiGetByManagementIDs(ctx, "shop-id", []int64{1}).Return(orders, nil)
+				params := []*database.ShipOrderFulfillmentParams{
+					{
+						OrderID:         "order-id",
+						FulfillmentID:   "fulfillment-id",
+						ShippingCarrier: entity.ShippingCarrierYamato,
+						TrackingNumber:  "123456789012",
+						ShippedAt:       now,
+					},
+				}
+				mocks.db.Order.EXPECT().ShipFulfillments(ctx, params).Return(map[string]entity.UnmatchedShipmentReason{}, nil)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString("お客様管理番号,伝票番号\n1,123456789012\n"),
+				}
+			},
+			expect: &entity.OrderShipmentImport{
+				Total:     1,
+				Skipped:   0,
+				Unmatched: entity.UnmatchedShipments{},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success without shop id does not match management id",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().MultiGet(ctx, []string{"1"}).Return(entity.Orders{}, nil)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString("お客様管理番号,伝票番号\n1,123456789012\n"),
+				}
+			},
+			expect: &entity.OrderShipmentImport{
+				Total:   0,
+				Skipped: 0,
+				Unmatched: entity.UnmatchedShipments{
+					{
+						Line:           2,
+						ManagementID:   "1",
+						TrackingNumber: "123456789012",
+						Reason:         entity.UnmatchedShipmentReasonOrderNotFound,
+					},
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success with rejected fulfillments",
+			setup: func(ctx context.Context, mocks *mocks) {
+				orders := entity.Orders{
+					newOrder("order-id01", "shop-id", entity.OrderStatusPreparing,
+						newFulfillment("fulfillment-id01", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+					newOrder("order-id02", "shop-id", entity.OrderStatusPreparing,
+						newFulfillment("fulfillment-id02", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+				}
+				mocks.db.Order.EXPECT().MultiGet(ctx, gomock.InAnyOrder([]string{"order-id01", "order-id02"})).Return(orders, nil)
+				rejected := map[string]entity.UnmatchedShipmentReason{
+					"fulfillment-id01": entity.UnmatchedShipmentReasonNotShippable,
+				}
+				mocks.db.Order.EXPECT().ShipFulfillments(ctx, gomock.Len(2)).Return(rejected, nil)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				body := "お客様管理番号,伝票番号\n" +
+					"order-id01,123456789012\n" +
+					"order-id02,123456789023\n"
+				return &store.ImportOrderShipmentsInput{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString(body),
+				}
+			},
+			expect: &entity.OrderShipmentImport{
+				Total:   1,
+				Skipped: 0,
+				Unmatched: entity.UnmatchedShipments{
+					{
+						Line:           2,
+						ManagementID:   "order-id01",
+						TrackingNumber: "123456789012",
+						Reason:         entity.UnmatchedShipmentReasonNotShippable,
+					},
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success no matched",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().MultiGet(ctx, []string{"order-id"}).Return(entity.Orders{}, nil)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString("お客様管理番号,伝票番号\norder-id,123456789012\n"),
+				}
+			},
+			expect: &entity.OrderShipmentImport{
+				Total:   0,
+				Skipped: 0,
+				Unmatched: entity.UnmatchedShipments{
+					{
+						Line:           2,
+						ManagementID:   "order-id",
+						TrackingNumber: "123456789012",
+						Reason:         entity.UnmatchedShipmentReasonOrderNotFound,
+					},
+				},
+			},
+			expectErr: nil,
+		},
+		{
+			name:  "invalid argument",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{}
+			},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "unsupported shipping carrier",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShippingCarrier: entity.ShippingCarrierJapanPost,
+					File:            bytes.NewBufferString(""),
+				}
+			},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "failed to parse",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString("注文番号,伝票番号\norder-id,123456789012\n"),
+				}
+			},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to multi get orders",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().MultiGet(ctx, []string{"order-id"}).Return(nil, assert.AnError)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString("お客様管理番号,伝票番号\norder-id,123456789012\n"),
+				}
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to multi get orders by management ids",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().MultiGet(ctx, []string{"1"}).Return(entity.Orders{}, nil)
+				mocks.db.Order.EXPECT().MultiGetByManagementIDs(ctx, "shop-id", []int64{1}).Return(nil, assert.AnError)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShopID:          "shop-id",
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString("お客様管理番号,伝票番号\n1,123456789012\n"),
+				}
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to ship fulfillments",
+			setup: func(ctx context.Context, mocks *mocks) {
+				orders := entity.Orders{
+					newOrder("order-id", "shop-id", entity.OrderStatusPreparing,
+						newFulfillment("fulfillment-id", 1, entity.FulfillmentStatusUnfulfilled, ""),
+					),
+				}
+				mocks.db.Order.EXPECT().MultiGet(ctx, []string{"order-id"}).Return(orders, nil)
+				mocks.db.Order.EXPECT().ShipFulfillments(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			input: func() *store.ImportOrderShipmentsInput {
+				return &store.ImportOrderShipmentsInput{
+					ShippingCarrier: entity.ShippingCarrierYamato,
+					File:            bytes.NewBufferString("お客様管理番号,伝票番号\norder-id,123456789012\n"),
+				}
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ImportOrderShipments(ctx, tt.input())
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
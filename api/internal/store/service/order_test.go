@@ -1132,10 +1132,34 @@ func TestRefundOrder(t *testing.T) {
 			},
 		},
 	}
-	params := &payment.RefundParams{
-		PaymentID:   "payment-id",
-		Amount:      1600,
-		Description: "在庫が不足していたため。",
+	products := entity.Products{
+		{
+			ID: "product-id01",
+			ProductRevision: entity.ProductRevision{
+				ID:        1,
+				ProductID: "product-id01",
+				Price:     500,
+			},
+		},
+	}
+	refund := func(amount int64) func(ctx context.Context, params *payment.RefundParams) error {
+		return func(ctx context.Context, params *payment.RefundParams) error {
+			assert.Equal(t, "payment-id", params.PaymentID)
+			assert.Equal(t, amount, params.Amount)
+			assert.Equal(t, "在庫が不足していたため。", params.Description)
+			assert.NotEmpty(t, params.IdempotencyKey)
+			return nil
+		}
+	}
+	succeeded := &database.UpdateOrderRefundLineParams{
+		Status:     entity.OrderRefundLineStatusSucceeded,
+		RefundedAt: now,
+	}
+	failed := &database.UpdateOrderRefundLineParams{
+		Status: entity.OrderRefundLineStatusFailed,
+	}
+	listParams := &database.ListOrderRefundLinesParams{
+		OrderID: "order-id",
 	}
 	tests := []struct {
 		name   string
@@ -1144,10 +1168,20 @@ func TestRefundOrder(t *testing.T) {
 		expect error
 	}{
 		{
-			name: "success",
+			name: "success full refund",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
-				mocks.payment.EXPECT().RefundPayment(ctx, params).Return(nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.OrderRefundLine.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, line *entity.OrderRefundLine) error {
+						assert.Equal(t, entity.OrderRefundLineTypeFull, line.Type)
+						assert.Equal(t, int64(1600), line.Amount)
+						return nil
+					})
+				mocks.payment.EXPECT().RefundPayment(ctx, gomock.Any()).DoAndReturn(refund(1600))
+				mocks.db.OrderRefundLine.EXPECT().Update(ctx, gomock.Any(), succeeded).Return(nil)
+				mocks.messenger.EXPECT().NotifyOrderRefunded(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			input: &store.RefundOrderInput{
 				OrderID:     "order-id",
@@ -1155,6 +1189,52 @@ func TestRefundOrder(t *testing.T) {
 			},
 			expect: nil,
 		},
+		{
+			name: "success item refund",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(products, nil)
+				mocks.db.OrderRefundLine.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, line *entity.OrderRefundLine) error {
+						assert.Equal(t, entity.OrderRefundLineTypeItem, line.Type)
+						assert.Equal(t, int64(500), line.Amount)
+						return nil
+					})
+				mocks.payment.EXPECT().RefundPayment(ctx, gomock.Any()).DoAndReturn(refund(500))
+				mocks.db.OrderRefundLine.EXPECT().Update(ctx, gomock.Any(), succeeded).Return(nil)
+				mocks.messenger.EXPECT().NotifyOrderRefunded(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			input: &store.RefundOrderInput{
+				OrderID:     "order-id",
+				Description: "在庫が不足していたため。",
+				Items: []*store.RefundOrderItem{
+					{ProductRevisionID: 1, Quantity: 1},
+				},
+			},
+			expect: nil,
+		},
+		{
+			name: "success amount refund",
+			setup: func(ctx context.Context, mocks *mocks) {
+				lines := entity.OrderRefundLines{
+					{ID: "line-id", Status: entity.OrderRefundLineStatusSucceeded, Amount: 1000},
+				}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(lines, nil)
+				mocks.db.OrderRefundLine.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.payment.EXPECT().RefundPayment(ctx, gomock.Any()).DoAndReturn(refund(300))
+				mocks.db.OrderRefundLine.EXPECT().Update(ctx, gomock.Any(), succeeded).Return(nil)
+				mocks.messenger.EXPECT().NotifyOrderRefunded(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			input: &store.RefundOrderInput{
+				OrderID:     "order-id",
+				Description: "在庫が不足していたため。",
+				Amount:      300,
+			},
+			expect: nil,
+		},
 		{
 			name:   "invalid argument",
 			setup:  func(ctx context.Context, mocks *mocks) {},
@@ -1184,11 +1264,85 @@ func TestRefundOrder(t *testing.T) {
 			},
 			expect: exception.ErrFailedPrecondition,
 		},
+		{
+			name: "failed to list refund lines",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(nil, assert.AnError)
+			},
+			input: &store.RefundOrderInput{
+				OrderID:     "order-id",
+				Description: "在庫が不足していたため。",
+			},
+			expect: exception.ErrInternal,
+		},
+		{
+			name: "failed to multi get products",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(ctx, []int64{1}).Return(nil, assert.AnError)
+			},
+			input: &store.RefundOrderInput{
+				OrderID:     "order-id",
+				Description: "在庫が不足していたため。",
+				Items: []*store.RefundOrderItem{
+					{ProductRevisionID: 1, Quantity: 1},
+				},
+			},
+			expect: exception.ErrInternal,
+		},
+		{
+			name: "refund amount exceeded",
+			setup: func(ctx context.Context, mocks *mocks) {
+				lines := entity.OrderRefundLines{
+					{ID: "line-id", Status: entity.OrderRefundLineStatusSucceeded, Amount: 1600},
+				}
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(lines, nil)
+			},
+			input: &store.RefundOrderInput{
+				OrderID:     "order-id",
+				Description: "在庫が不足していたため。",
+			},
+			expect: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to create refund line",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.OrderRefundLine.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &store.RefundOrderInput{
+				OrderID:     "order-id",
+				Description: "在庫が不足していたため。",
+			},
+			expect: exception.ErrInternal,
+		},
 		{
 			name: "failed to refund",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
-				mocks.payment.EXPECT().RefundPayment(ctx, params).Return(assert.AnError)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.OrderRefundLine.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.payment.EXPECT().RefundPayment(ctx, gomock.Any()).Return(assert.AnError)
+				mocks.db.OrderRefundLine.EXPECT().Update(ctx, gomock.Any(), failed).Return(nil)
+			},
+			input: &store.RefundOrderInput{
+				OrderID:     "order-id",
+				Description: "在庫が不足していたため。",
+			},
+			expect: exception.ErrInternal,
+		},
+		{
+			name: "failed to update refund line",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, listParams).Return(entity.OrderRefundLines{}, nil)
+				mocks.db.OrderRefundLine.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.payment.EXPECT().RefundPayment(ctx, gomock.Any()).Return(nil)
+				mocks.db.OrderRefundLine.EXPECT().Update(ctx, gomock.Any(), succeeded).Return(assert.AnError)
 			},
 			input: &store.RefundOrderInput{
 				OrderID:     "order-id",
@@ -1201,6 +1355,131 @@ func TestRefundOrder(t *testing.T) {
 		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
 			err := service.RefundOrder(ctx, tt.input)
 			assert.ErrorIs(t, err, tt.expect)
+		}, withNow(now)))
+	}
+}
+
+func TestListOrderRefundLines(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 10, 10, 18, 30, 0, 0)
+	lines := entity.OrderRefundLines{
+		{
+			ID:         "line-id",
+			OrderID:    "order-id",
+			Type:       entity.OrderRefundLineTypeAmount,
+			Status:     entity.OrderRefundLineStatusSucceeded,
+			Amount:     500,
+			Reason:     "配送遅延のため",
+			RefundedAt: now,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		},
+	}
+	params := &database.ListOrderRefundLinesParams{
+		OrderID: "order-id",
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ListOrderRefundLinesInput
+		expect    entity.OrderRefundLines
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, params).Return(lines, nil)
+			},
+			input: &store.ListOrderRefundLinesInput{
+				OrderID: "order-id",
+			},
+			expect:    lines,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ListOrderRefundLinesInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list refund lines",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderRefundLine.EXPECT().List(ctx, params).Return(nil, assert.AnError)
+			},
+			input: &store.ListOrderRefundLinesInput{
+				OrderID: "order-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ListOrderRefundLines(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestGetOrderRefundLine(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2022, 10, 10, 18, 30, 0, 0)
+	line := &entity.OrderRefundLine{
+		ID:         "line-id",
+		OrderID:    "order-id",
+		Type:       entity.OrderRefundLineTypeAmount,
+		Status:     entity.OrderRefundLineStatusSucceeded,
+		Amount:     500,
+		Reason:     "配送遅延のため",
+		RefundedAt: now,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetOrderRefundLineInput
+		expect    *entity.OrderRefundLine
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderRefundLine.EXPECT().Get(ctx, "line-id").Return(line, nil)
+			},
+			input: &store.GetOrderRefundLineInput{
+				RefundLineID: "line-id",
+			},
+			expect:    line,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetOrderRefundLineInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get refund line",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.OrderRefundLine.EXPECT().Get(ctx, "line-id").Return(nil, assert.AnError)
+			},
+			input: &store.GetOrderRefundLineInput{
+				RefundLineID: "line-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetOrderRefundLine(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
 		}))
 	}
 }
@@ -1445,6 +1724,80 @@ func TestAggregateOrdersByPaymentMethodType(t *testing.T) {
 	}
 }
 
+func TestAggregateOrdersByProduct(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	params := &database.AggregateOrdersByProductParams{
+		ShopID:       "shop-id",
+		ProductIDs:   []string{"product-id"},
+		CreatedAtGte: now.Add(-time.Hour),
+		CreatedAtLt:  now,
+	}
+	orders := entity.AggregatedOrderProducts{
+		{
+			ProductID:     "product-id",
+			OrderCount:    2,
+			UserCount:     1,
+			Quantity:      3,
+			SalesTotal:    3000,
+			DiscountTotal: 300,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.AggregateOrdersByProductInput
+		expect    entity.AggregatedOrderProducts
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().AggregateByProduct(ctx, params).Return(orders, nil)
+			},
+			input: &store.AggregateOrdersByProductInput{
+				ShopID:       "shop-id",
+				ProductIDs:   []string{"product-id"},
+				CreatedAtGte: now.Add(-time.Hour),
+				CreatedAtLt:  now,
+			},
+			expect:    orders,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.AggregateOrdersByProductInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to aggregate",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().AggregateByProduct(ctx, params).Return(nil, assert.AnError)
+			},
+			input: &store.AggregateOrdersByProductInput{
+				ShopID:       "shop-id",
+				ProductIDs:   []string{"product-id"},
+				CreatedAtGte: now.Add(-time.Hour),
+				CreatedAtLt:  now,
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.AggregateOrdersByProduct(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
 func TestAggregateOrdersByPromotion(t *testing.T) {
 	t.Parallel()
 
@@ -1807,6 +2160,89 @@ func TestExportOrders(t *testing.T) {
 			expect:    "お届け先コード取得区分,お届け先コード,お届け先電話番号,お届け先郵便番号,お届け先住所１,お届け先住所２,お届け先住所３,お届け先名称１,お届け先名称２,お客様管理番号,お客様コード,部署ご担当者コード,取得区分,部署ご担当者コード,部署ご担当者名称,荷送人電話番号,ご依頼主コード取得区分,ご依頼主コード,ご依頼主電話番号,ご依頼主郵便番号,ご依頼主住所１,ご依頼主住所２,ご依頼主名称１,ご依頼主名称２,荷姿,品名１,品名２,品名３,品名４,品名５,荷札荷姿,荷札品名1,荷札品名2,荷札品名3,荷札品名4,荷札品名5,荷札品名6,荷札品名7,荷札品名8,荷札品名9,荷札品名10,荷札品名11,出荷個数,スピード指定,クール便指定,配達日,配達指定時間帯,配達指定時間（時分）,代引金額,消費税,決済種別,保険金額,指定シール1,指定シール2,指定シール3,営業所受取,ＳＲＣ区分,営業所受取営業所コード,元着区分,メールアドレス,ご不在時連絡先,出荷日,お問い合せ送り状No.,出荷場印字区分,集約解除指定,編集1,編集2,編集3,編集4,編集5,編集6,編集7,編集8,編集9,編集10\n",
 			expectErr: nil,
 		},
+		{
+			name: "success japan post with body",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil)
+			},
+			input: &store.ExportOrdersInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierJapanPost,
+				EncodingType:    codes.CharacterEncodingTypeUTF8,
+			},
+			expect: "お客様側管理番号,発送予定日,郵便種別,支払元,保冷区分,サイズ,個数,お届け先郵便番号,お届け先住所1,お届け先住所2,お届け先住所3,お届け先名称1,お届け先名称2,お届け先敬称,お届け先電話番号,ご依頼主郵便番号,ご依頼主住所1,ご依頼主住所2,ご依頼主住所3,ご依頼主名称1,ご依頼主名称2,ご依頼主電話番号,品名,配達希望日,配達時間帯,記事\n" +
+				"order-id,,0,0,0,60,1,1000014,東京都,千代田区 永田町1-7-1,,&.,購入者,様,090-1234-1234,1000014,東京都,千代田区 永田町1-7-1,,&.,購入者,090-1234-1234,新鮮なじゃがいも,,,\n",
+			expectErr: nil,
+		},
+		{
+			name: "success click post with body",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil)
+			},
+			input: &store.ExportOrdersInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierJapanPost,
+				LabelFormat:     entity.ShippingLabelFormatClickPost,
+				EncodingType:    codes.CharacterEncodingTypeUTF8,
+			},
+			expect: "お届け先郵便番号,お届け先氏名,お届け先敬称,お届け先住所1行目,お届け先住所2行目,お届け先住所3行目,お届け先住所4行目,内容品\n" +
+				"1000014,&. 購入者,様,東京都,千代田区,永田町1-7-1,,新鮮なじゃがいも\n",
+			expectErr: nil,
+		},
+		{
+			name: "yamato unsupported delivery time window",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := *orders[0]
+				fulfillment := *order.OrderFulfillments[0]
+				fulfillment.DeliveryTimeWindow = entity.DeliveryTimeWindow1214
+				order.OrderFulfillments = entity.OrderFulfillments{&fulfillment}
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(entity.Orders{&order}, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil)
+			},
+			input: &store.ExportOrdersInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeUTF8,
+			},
+			expect:    "",
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "click post unavailable",
+			setup: func(ctx context.Context, mocks *mocks) {
+				product := *products[0]
+				product.Weight = 2
+				product.WeightUnit = entity.WeightUnitKilogram
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(entity.Products{&product}, nil)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil)
+			},
+			input: &store.ExportOrdersInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierJapanPost,
+				LabelFormat:     entity.ShippingLabelFormatClickPost,
+				EncodingType:    codes.CharacterEncodingTypeUTF8,
+			},
+			expect:    "",
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name:  "click post without japan post",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.ExportOrdersInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				LabelFormat:     entity.ShippingLabelFormatClickPost,
+				EncodingType:    codes.CharacterEncodingTypeUTF8,
+			},
+			expect:    "",
+			expectErr: exception.ErrInvalidArgument,
+		},
 		{
 			name:  "invalid argument",
 			setup: func(ctx context.Context, mocks *mocks) {},
@@ -1869,3 +2305,181 @@ func TestExportOrders(t *testing.T) {
 		}))
 	}
 }
+
+func TestExportOrderInvoice(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	order := func(status entity.OrderStatus) *entity.Order {
+		return &entity.Order{
+			ID:            "order-id",
+			UserID:        "user-id",
+			ShopID:        "shop-id",
+			CoordinatorID: "coordinator-id",
+			ManagementID:  1,
+			Type:          entity.OrderTypeProduct,
+			Status:        status,
+			OrderPayment: entity.OrderPayment{
+				OrderID:           "order-id",
+				AddressRevisionID: 1,
+				Status:            entity.PaymentStatusCaptured,
+				MethodType:        entity.PaymentMethodTypeCreditCard,
+				Subtotal:          400,
+				ShippingFee:       550,
+				Tax:               79,
+				StandardTaxTarget: 550,
+				StandardTax:       50,
+				ReducedTaxTarget:  400,
+				ReducedTax:        29,
+				Total:             950,
+				OrderedAt:         now,
+				PaidAt:            now,
+			},
+			OrderItems: entity.OrderItems{
+				{
+					FulfillmentID:     "fulfillment-id",
+					OrderID:           "order-id",
+					ProductRevisionID: 1,
+					Quantity:          1,
+					TaxRate:           8,
+				},
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+	shopIn := &user.GetShopInput{
+		ShopID: "shop-id",
+	}
+	shop := &uentity.Shop{
+		ID:                        "shop-id",
+		CoordinatorID:             "coordinator-id",
+		Name:                      "&.農園",
+		InvoiceRegistrationNumber: "T1234567890123",
+	}
+	addressesIn := &user.MultiGetAddressesByRevisionInput{
+		AddressRevisionIDs: []int64{1},
+	}
+	addresses := uentity.Addresses{
+		{
+			ID:     "address-id",
+			UserID: "user-id",
+			AddressRevision: uentity.AddressRevision{
+				ID:        1,
+				Lastname:  "&.",
+				Firstname: "購入者",
+			},
+		},
+	}
+	products := entity.Products{
+		{
+			ID:   "product-id",
+			Name: "新鮮なじゃがいも",
+			ProductRevision: entity.ProductRevision{
+				ID:          1,
+				ProductID:   "product-id",
+				Price:       400,
+				TaxCategory: entity.TaxCategoryReduced,
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ExportOrderInvoiceInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order(entity.OrderStatusCompleted), nil)
+				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(shop, nil)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil)
+			},
+			input: &store.ExportOrderInvoiceInput{
+				OrderID: "order-id",
+				UserID:  "user-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ExportOrderInvoiceInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(nil, assert.AnError)
+			},
+			input: &store.ExportOrderInvoiceInput{
+				OrderID: "order-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "not owned by user",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order(entity.OrderStatusCompleted), nil)
+			},
+			input: &store.ExportOrderInvoiceInput{
+				OrderID: "order-id",
+				UserID:  "other-id",
+			},
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "canceled order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order(entity.OrderStatusCanceled), nil)
+			},
+			input: &store.ExportOrderInvoiceInput{
+				OrderID: "order-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "refunded order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order(entity.OrderStatusRefunded), nil)
+			},
+			input: &store.ExportOrderInvoiceInput{
+				OrderID: "order-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "partially refunded order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order(entity.OrderStatusPartiallyRefunded), nil)
+			},
+			input: &store.ExportOrderInvoiceInput{
+				OrderID: "order-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to get shop",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order(entity.OrderStatusCompleted), nil)
+				mocks.user.EXPECT().GetShop(gomock.Any(), shopIn).Return(nil, assert.AnError)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil).AnyTimes()
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil).AnyTimes()
+			},
+			input: &store.ExportOrderInvoiceInput{
+				OrderID: "order-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ExportOrderInvoice(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if tt.expectErr == nil {
+				assert.Contains(t, string(actual), "%PDF-")
+			}
+		}, withNow(now)))
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/messenger"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/log"
+)
+
+// 一度に配送状況を照会する配送の最大件数
+const syncOrderDeliveriesLimit = 200
+
+func (s *service) ImportOrderTrackings(ctx context.Context, in *store.ImportOrderTrackingsInput) (int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return 0, internalError(err)
+	}
+	t, err := s.getTracker(in.ShippingCarrier)
+	if err != nil {
+		return 0, err
+	}
+	events, err := t.Parse(in.File, in.EncodingType)
+	if err != nil {
+		return 0, fmt.Errorf("service: failed to parse tracking file: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	params := &applyTrackingEventsParams{
+		shopID:  in.ShopID,
+		carrier: in.ShippingCarrier,
+		events:  events,
+	}
+	return s.applyTrackingEvents(ctx, params)
+}
+
+func (s *service) SyncOrderDeliveries(ctx context.Context, in *store.SyncOrderDeliveriesInput) (int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return 0, internalError(err)
+	}
+	t, err := s.getTracker(in.ShippingCarrier)
+	if err != nil {
+		return 0, err
+	}
+	// 照会できない配送が滞留しても後続の配送が処理されるよう、発送日時とIDをカーソルに対象期間全体を走査する
+	params := &database.ListUndeliveredOrderFulfillmentsParams{
+		ShippingCarrier: in.ShippingCarrier,
+		ShippedAtGte:    in.ShippedAtGte,
+		Limit:           syncOrderDeliveriesLimit,
+	}
+	var total int64
+	for {
+		fulfillments, err := s.db.Order.ListUndeliveredFulfillments(ctx, params)
+		if err != nil {
+			return total, internalError(err)
+		}
+		if len(fulfillments) == 0 {
+			return total, nil
+		}
+		count, err := s.trackDeliveries(ctx, t, in.ShippingCarrier, fulfillments)
+		total += count
+		if err != nil {
+			return total, err
+		}
+		if len(fulfillments) < syncOrderDeliveriesLimit {
+			return total, nil
+		}
+		last := fulfillments[len(fulfillments)-1]
+		params.AfterShippedAt, params.AfterID = last.ShippedAt, last.ID
+	}
+}
+
+// trackDeliveries - 追跡APIで配送状況を照会し、配達完了した配送を反映する
+func (s *service) trackDeliveries(
+	ctx context.Context, t tracker.Tracker, carrier entity.ShippingCarrier, fulfillments entity.OrderFulfillments,
+) (int64, error) {
+	trackingNumbers := make([]string, 0, len(fulfillments))
+	for _, trackingNumber := range fulfillments.TrackingNumbers() {
+		trackingNumbers = append(trackingNumbers, tracker.NormalizeTrackingNumber(trackingNumber))
+	}
+	events, err := t.Track(ctx, trackingNumbers)
+	if err != nil {
+		return 0, fmt.Errorf("service: failed to track deliveries: %s: %w", err.Error(), exception.ErrUnavailable)
+	}
+	params := &applyTrackingEventsParams{
+		carrier: carrier,
+		events:  events,
+	}
+	return s.applyTrackingEvents(ctx, params)
+}
+
+type applyTrackingEventsParams struct {
+	shopID  string
+	carrier entity.ShippingCarrier
+	events  tracker.Events
+}
+
+// applyTrackingEvents - 配達完了イベントを注文の配送状況に反映し、反映した配送数を返す
+func (s *service) applyTrackingEvents(ctx context.Context, params *applyTrackingEventsParams) (int64, error) {
+	delivered := params.events.Delivered()
+	if len(delivered) == 0 {
+		return 0, nil
+	}
+	trackingNumbers := make([]string, 0, len(delivered))
+	for trackingNumber := range delivered {
+		trackingNumbers = append(trackingNumbers, trackingNumber)
+	}
+	listParams := &database.ListOrdersByTrackingNumbersParams{
+		ShopID:          params.shopID,
+		ShippingCarrier: params.carrier,
+		TrackingNumbers: trackingNumbers,
+	}
+	orders, err := s.db.Order.ListByTrackingNumbers(ctx, listParams)
+	if err != nil {
+		return 0, internalError(err)
+	}
+	var total int64
+	for _, order := range orders {
+		var updated bool
+		for _, f := range order.OrderFulfillments {
+			if f.ShippingCarrier != params.carrier || f.Delivered() {
+				continue
+			}
+			event, ok := delivered[tracker.NormalizeTrackingNumber(f.TrackingNumber)]
+			if !ok {
+				continue
+			}
+			if err := s.db.Order.DeliverFulfillment(ctx, order.ID, f.ID, event.OccurredAt); err != nil {
+				return total, internalError(err)
+			}
+			f.Status, f.DeliveredAt = entity.FulfillmentStatusDelivered, event.OccurredAt
+			updated = true
+			total++
+		}
+		if !updated || !order.OrderFulfillments.Delivered() {
+			continue
+		}
+		if err := s.completeDeliveredOrder(ctx, order); err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// completeDeliveredOrder - すべての配送が完了した注文を対応完了にし、配達日時を起点にレビュー依頼を予約する
+func (s *service) completeDeliveredOrder(ctx context.Context, order *entity.Order) error {
+	if order.Completable() {
+		params := &database.CompleteOrderParams{
+			ShippingMessage: order.ShippingMessage,
+			CompletedAt:     s.now(),
+		}
+		if err := s.db.Order.Complete(ctx, order.ID, params); err != nil {
+			return internalError(err)
+		}
+	}
+	if order.Type != entity.OrderTypeProduct {
+		return nil
+	}
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		in := &messenger.ReserveReviewRequestInput{
+			OrderID:     order.ID,
+			DeliveredAt: order.OrderFulfillments.LatestDeliveredAt(),
+		}
+		if err := s.messenger.ReserveReviewRequest(context.Background(), in); err != nil {
+			slog.Error("Failed to reserve review request", slog.String("orderId", order.ID), log.Error(err))
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,395 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/messenger"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImportOrderTrackings(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	deliveredAt := jst.Date(2026, 10, 18, 10, 30, 0, 0)
+	file := bytes.NewBufferString("dummy")
+	events := tracker.Events{
+		{
+			TrackingNumber: "123456789012",
+			Type:           tracker.EventTypeOutForDelivery,
+			OccurredAt:     deliveredAt.Add(-2 * time.Hour),
+		},
+		{
+			TrackingNumber: "123456789012",
+			Type:           tracker.EventTypeDelivered,
+			OccurredAt:     deliveredAt,
+		},
+	}
+	newOrder := func(status entity.OrderStatus, fulfillments ...*entity.OrderFulfillment) *entity.Order {
+		return &entity.Order{
+			ID:                "order-id",
+			ShopID:            "shop-id",
+			Type:              entity.OrderTypeProduct,
+			Status:            status,
+			OrderFulfillments: fulfillments,
+			OrderMetadata: entity.OrderMetadata{
+				OrderID:         "order-id",
+				ShippingMessage: "ご注文ありがとうございます！",
+			},
+		}
+	}
+	newFulfillment := func(id, trackingNumber string, status entity.FulfillmentStatus) *entity.OrderFulfillment {
+		return &entity.OrderFulfillment{
+			ID:              id,
+			OrderID:         "order-id",
+			Status:          status,
+			ShippingCarrier: entity.ShippingCarrierYamato,
+			TrackingNumber:  trackingNumber,
+		}
+	}
+	listParams := &database.ListOrdersByTrackingNumbersParams{
+		ShopID:          "shop-id",
+		ShippingCarrier: entity.ShippingCarrierYamato,
+		TrackingNumbers: []string{"123456789012"},
+	}
+	completeParams := &database.CompleteOrderParams{
+		ShippingMessage: "ご注文ありがとうございます！",
+		CompletedAt:     now,
+	}
+	reserveIn := &messenger.ReserveReviewRequestInput{
+		OrderID:     "order-id",
+		DeliveredAt: deliveredAt,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ImportOrderTrackingsInput
+		expect    int64
+		expectErr error
+	}{
+		{
+			name: "success all delivered",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := newOrder(entity.OrderStatusShipped, newFulfillment("fulfillment-id", "1234-5678-9012", entity.FulfillmentStatusFulfilled))
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, listParams).Return(entity.Orders{order}, nil)
+				mocks.db.Order.EXPECT().DeliverFulfillment(ctx, "order-id", "fulfillment-id", deliveredAt).Return(nil)
+				mocks.db.Order.EXPECT().Complete(ctx, "order-id", completeParams).Return(nil)
+				mocks.messenger.EXPECT().ReserveReviewRequest(gomock.Any(), reserveIn).Return(assert.AnError)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    1,
+			expectErr: nil,
+		},
+		{
+			name: "success already completed",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := newOrder(entity.OrderStatusCompleted, newFulfillment("fulfillment-id", "123456789012", entity.FulfillmentStatusFulfilled))
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, listParams).Return(entity.Orders{order}, nil)
+				mocks.db.Order.EXPECT().DeliverFulfillment(ctx, "order-id", "fulfillment-id", deliveredAt).Return(nil)
+				mocks.messenger.EXPECT().ReserveReviewRequest(gomock.Any(), reserveIn).Return(nil)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    1,
+			expectErr: nil,
+		},
+		{
+			name: "success partially delivered",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := newOrder(
+					entity.OrderStatusShipped,
+					newFulfillment("fulfillment-id01", "123456789012", entity.FulfillmentStatusFulfilled),
+					newFulfillment("fulfillment-id02", "987654321098", entity.FulfillmentStatusFulfilled),
+				)
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, listParams).Return(entity.Orders{order}, nil)
+				mocks.db.Order.EXPECT().DeliverFulfillment(ctx, "order-id", "fulfillment-id01", deliveredAt).Return(nil)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    1,
+			expectErr: nil,
+		},
+		{
+			name: "success no delivered events",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(events[:1], nil)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    0,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ImportOrderTrackingsInput{},
+			expect:    0,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to parse",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(nil, assert.AnError)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    0,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list orders",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, listParams).Return(nil, assert.AnError)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    0,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to deliver fulfillment",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := newOrder(entity.OrderStatusShipped, newFulfillment("fulfillment-id", "123456789012", entity.FulfillmentStatusFulfilled))
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, listParams).Return(entity.Orders{order}, nil)
+				mocks.db.Order.EXPECT().DeliverFulfillment(ctx, "order-id", "fulfillment-id", deliveredAt).Return(assert.AnError)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    0,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to complete order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				order := newOrder(entity.OrderStatusShipped, newFulfillment("fulfillment-id", "123456789012", entity.FulfillmentStatusFulfilled))
+				mocks.tracker.EXPECT().Parse(file, codes.CharacterEncodingTypeShiftJIS).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, listParams).Return(entity.Orders{order}, nil)
+				mocks.db.Order.EXPECT().DeliverFulfillment(ctx, "order-id", "fulfillment-id", deliveredAt).Return(nil)
+				mocks.db.Order.EXPECT().Complete(ctx, "order-id", completeParams).Return(assert.AnError)
+			},
+			input: &store.ImportOrderTrackingsInput{
+				ShopID:          "shop-id",
+				ShippingCarrier: entity.ShippingCarrierYamato,
+				EncodingType:    codes.CharacterEncodingTypeShiftJIS,
+				File:            file,
+			},
+			expect:    1,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ImportOrderTrackings(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestSyncOrderDeliveries(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 18, 30, 0, 0)
+	deliveredAt := jst.Date(2026, 10, 18, 10, 30, 0, 0)
+	fulfillments := entity.OrderFulfillments{
+		{
+			ID:              "fulfillment-id",
+			OrderID:         "order-id",
+			Status:          entity.FulfillmentStatusFulfilled,
+			ShippingCarrier: entity.ShippingCarrierSagawa,
+			TrackingNumber:  "1234-5678-9012",
+		},
+	}
+	events := tracker.Events{
+		{
+			TrackingNumber: "123456789012",
+			Type:           tracker.EventTypeDelivered,
+			OccurredAt:     deliveredAt,
+		},
+	}
+	newOrder := func() *entity.Order {
+		return &entity.Order{
+			ID:     "order-id",
+			ShopID: "shop-id",
+			Type:   entity.OrderTypeProduct,
+			Status: entity.OrderStatusShipped,
+			OrderFulfillments: entity.OrderFulfillments{
+				{
+					ID:              "fulfillment-id",
+					OrderID:         "order-id",
+					Status:          entity.FulfillmentStatusFulfilled,
+					ShippingCarrier: entity.ShippingCarrierSagawa,
+					TrackingNumber:  "1234-5678-9012",
+				},
+			},
+		}
+	}
+	listParams := &database.ListUndeliveredOrderFulfillmentsParams{
+		ShippingCarrier: entity.ShippingCarrierSagawa,
+		ShippedAtGte:    now.AddDate(0, 0, -14),
+		Limit:           syncOrderDeliveriesLimit,
+	}
+	ordersParams := &database.ListOrdersByTrackingNumbersParams{
+		ShippingCarrier: entity.ShippingCarrierSagawa,
+		TrackingNumbers: []string{"123456789012"},
+	}
+	pending := make(entity.OrderFulfillments, syncOrderDeliveriesLimit)
+	pendingTrackingNumbers := make([]string, syncOrderDeliveriesLimit)
+	for i := range pending {
+		pending[i] = &entity.OrderFulfillment{
+			ID:              fmt.Sprintf("pending-id%03d", i),
+			OrderID:         fmt.Sprintf("pending-order-id%03d", i),
+			Status:          entity.FulfillmentStatusFulfilled,
+			ShippingCarrier: entity.ShippingCarrierSagawa,
+			TrackingNumber:  fmt.Sprintf("999999999%03d", i),
+			ShippedAt:       now.AddDate(0, 0, -10),
+		}
+		pendingTrackingNumbers[i] = pending[i].TrackingNumber
+	}
+	nextListParams := &database.ListUndeliveredOrderFulfillmentsParams{
+		ShippingCarrier: entity.ShippingCarrierSagawa,
+		ShippedAtGte:    now.AddDate(0, 0, -14),
+		AfterShippedAt:  now.AddDate(0, 0, -10),
+		AfterID:         fmt.Sprintf("pending-id%03d", syncOrderDeliveriesLimit-1),
+		Limit:           syncOrderDeliveriesLimit,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.SyncOrderDeliveriesInput
+		expect    int64
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListUndeliveredFulfillments(ctx, listParams).Return(fulfillments, nil)
+				mocks.tracker.EXPECT().Track(ctx, []string{"123456789012"}).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, ordersParams).Return(entity.Orders{newOrder()}, nil)
+				mocks.db.Order.EXPECT().DeliverFulfillment(ctx, "order-id", "fulfillment-id", deliveredAt).Return(nil)
+				mocks.db.Order.EXPECT().Complete(ctx, "order-id", gomock.Any()).Return(nil)
+				mocks.messenger.EXPECT().ReserveReviewRequest(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			input: &store.SyncOrderDeliveriesInput{
+				ShippingCarrier: entity.ShippingCarrierSagawa,
+				ShippedAtGte:    now.AddDate(0, 0, -14),
+			},
+			expect:    1,
+			expectErr: nil,
+		},
+		{
+			name: "success multiple pages",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListUndeliveredFulfillments(ctx, listParams).Return(pending, nil)
+				mocks.tracker.EXPECT().Track(ctx, gomock.InAnyOrder(pendingTrackingNumbers)).Return(tracker.Events{}, nil)
+				mocks.db.Order.EXPECT().ListUndeliveredFulfillments(ctx, nextListParams).Return(fulfillments, nil)
+				mocks.tracker.EXPECT().Track(ctx, []string{"123456789012"}).Return(events, nil)
+				mocks.db.Order.EXPECT().ListByTrackingNumbers(ctx, ordersParams).Return(entity.Orders{newOrder()}, nil)
+				mocks.db.Order.EXPECT().DeliverFulfillment(ctx, "order-id", "fulfillment-id", deliveredAt).Return(nil)
+				mocks.db.Order.EXPECT().Complete(ctx, "order-id", gomock.Any()).Return(nil)
+				mocks.messenger.EXPECT().ReserveReviewRequest(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			input: &store.SyncOrderDeliveriesInput{
+				ShippingCarrier: entity.ShippingCarrierSagawa,
+				ShippedAtGte:    now.AddDate(0, 0, -14),
+			},
+			expect:    1,
+			expectErr: nil,
+		},
+		{
+			name: "success empty",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListUndeliveredFulfillments(ctx, listParams).Return(entity.OrderFulfillments{}, nil)
+			},
+			input: &store.SyncOrderDeliveriesInput{
+				ShippingCarrier: entity.ShippingCarrierSagawa,
+				ShippedAtGte:    now.AddDate(0, 0, -14),
+			},
+			expect:    0,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.SyncOrderDeliveriesInput{},
+			expect:    0,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list undelivered fulfillments",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListUndeliveredFulfillments(ctx, listParams).Return(nil, assert.AnError)
+			},
+			input: &store.SyncOrderDeliveriesInput{
+				ShippingCarrier: entity.ShippingCarrierSagawa,
+				ShippedAtGte:    now.AddDate(0, 0, -14),
+			},
+			expect:    0,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to track",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListUndeliveredFulfillments(ctx, listParams).Return(fulfillments, nil)
+				mocks.tracker.EXPECT().Track(ctx, []string{"123456789012"}).Return(nil, assert.AnError)
+			},
+			input: &store.SyncOrderDeliveriesInput{
+				ShippingCarrier: entity.ShippingCarrierSagawa,
+				ShippedAtGte:    now.AddDate(0, 0, -14),
+			},
+			expect:    0,
+			expectErr: exception.ErrUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.SyncOrderDeliveries(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
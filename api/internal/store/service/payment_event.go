@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/internal/store/payment/komoju"
+	"github.com/and-period/furumaru/api/internal/store/payment/stripe"
+	"github.com/and-period/furumaru/api/pkg/backoff"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	paymentEventMaxRetries        = 2                      // 決済イベント処理の最大再試行回数
+	paymentEventRetryInterval     = 500 * time.Millisecond // 決済イベント処理の再試行間隔
+	paymentEventProcessingTimeout = 5 * time.Minute        // 処理中のまま中断されたとみなすまでの時間
+)
+
+func (s *service) ReceivePaymentEvent(ctx context.Context, in *store.ReceivePaymentEventInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	// 署名検証・解析に失敗したイベントも、受信結果を記録したうえで受付を拒否する
+	if in.SignatureRequired && !in.SignatureVerified {
+		err := fmt.Errorf("service: failed to verify payment event signature: %w", exception.ErrUnauthenticated)
+		return s.rejectPaymentEvent(ctx, in, err)
+	}
+	webhook, err := parsePaymentEvent(in.ProviderType, in.EventType, in.Payload)
+	if err != nil {
+		return s.rejectPaymentEvent(ctx, in, err)
+	}
+	event, err := s.db.PaymentEvent.GetByEventID(ctx, in.ProviderType, webhook.EventID)
+	if errors.Is(err, database.ErrNotFound) {
+		params := &entity.NewPaymentEventParams{
+			ProviderType:      in.ProviderType,
+			EventID:           webhook.EventID,
+			EventType:         webhook.EventType,
+			Payload:           in.Payload,
+			SignatureVerified: in.SignatureVerified,
+		}
+		event = entity.NewPaymentEvent(params)
+		err = s.db.PaymentEvent.Create(ctx, event)
+	}
+	if err != nil {
+		return internalError(err)
+	}
+	// 同一イベントを重複して受信した場合、処理済みであれば再処理しない
+	if event.IsCompleted() {
+		slog.InfoContext(ctx, "Received duplicate payment event",
+			slog.String("paymentEventId", event.ID), slog.String("eventId", event.EventID))
+		return nil
+	}
+	err = s.claimPaymentEvent(ctx, event)
+	if errors.Is(err, exception.ErrFailedPrecondition) {
+		// 再送されたイベントや管理画面からの再実行で処理中の場合
+		slog.InfoContext(ctx, "Payment event is already being processed",
+			slog.String("paymentEventId", event.ID), slog.String("eventId", event.EventID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.processPaymentEvent(ctx, event, webhook)
+}
+
+func (s *service) rejectPaymentEvent(ctx context.Context, in *store.ReceivePaymentEventInput, reason error) error {
+	params := &entity.NewPaymentEventParams{
+		ProviderType:      in.ProviderType,
+		EventType:         in.EventType,
+		Payload:           in.Payload,
+		SignatureVerified: in.SignatureVerified,
+	}
+	event := entity.NewPaymentEvent(params)
+	event.Reject(reason, s.now())
+	if err := s.db.PaymentEvent.Create(ctx, event); err != nil {
+		slog.ErrorContext(ctx, "Failed to create rejected payment event",
+			slog.Int("providerType", int(in.ProviderType)), slog.String("eventType", in.EventType), log.Error(err))
+	}
+	return reason
+}
+
+func (s *service) ListPaymentEvents(
+	ctx context.Context, in *store.ListPaymentEventsInput,
+) (entity.PaymentEvents, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListPaymentEventsParams{
+		ProviderType: in.ProviderType,
+		Statuses:     in.Statuses,
+		Limit:        int(in.Limit),
+		Offset:       int(in.Offset),
+	}
+	var (
+		events entity.PaymentEvents
+		total  int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		events, err = s.db.PaymentEvent.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.PaymentEvent.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return events, total, nil
+}
+
+func (s *service) GetPaymentEvent(ctx context.Context, in *store.GetPaymentEventInput) (*entity.PaymentEvent, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	event, err := s.db.PaymentEvent.Get(ctx, in.PaymentEventID)
+	return event, internalError(err)
+}
+
+func (s *service) ReplayPaymentEvent(ctx context.Context, in *store.ReplayPaymentEventInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	event, err := s.db.PaymentEvent.Get(ctx, in.PaymentEventID)
+	if err != nil {
+		return internalError(err)
+	}
+	if !event.Replayable() {
+		return fmt.Errorf("service: this payment event is not replayable: %w", exception.ErrFailedPrecondition)
+	}
+	webhook, err := parsePaymentEvent(event.ProviderType, event.EventType, event.Payload)
+	if err != nil {
+		return err
+	}
+	if err := s.claimPaymentEvent(ctx, event); err != nil {
+		return err
+	}
+	return s.processPaymentEvent(ctx, event, webhook)
+}
+
+// claimPaymentEvent - 同一イベントを重複して処理しないよう、処理中として確保する
+func (s *service) claimPaymentEvent(ctx context.Context, event *entity.PaymentEvent) error {
+	params := &database.ClaimPaymentEventParams{
+		StaleAt: s.now().Add(-paymentEventProcessingTimeout),
+	}
+	err := s.db.PaymentEvent.Claim(ctx, event.ID, params)
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		return fmt.Errorf("service: this payment event is being processed: %w", exception.ErrFailedPrecondition)
+	}
+	return internalError(err)
+}
+
+func (s *service) processPaymentEvent(ctx context.Context, event *entity.PaymentEvent, webhook *payment.WebhookEvent) error {
+	ignored := webhook.Action == payment.WebhookEventActionNone
+	var err error
+	if !ignored {
+		exec := func() error {
+			return s.dispatchPaymentEvent(ctx, webhook)
+		}
+		// 決済プロバイダーのタイムアウト前に応答するため、リクエスト内での再試行は短い間隔で数回までとする
+		// （失敗した場合は決済プロバイダーからの再送、または管理画面からの再実行で処理する）
+		retry := backoff.NewFixedIntervalBackoff(paymentEventRetryInterval, paymentEventMaxRetries)
+		err = backoff.Retry(ctx, retry, exec, backoff.WithRetryablel(s.isRetryable))
+	}
+	event.Proceed(ignored, err, s.now())
+	params := &database.UpdatePaymentEventResultParams{
+		Status:       event.Status,
+		ErrorMessage: event.ErrorMessage,
+		AttemptCount: event.AttemptCount,
+		ProcessedAt:  event.ProcessedAt,
+	}
+	if uerr := s.db.PaymentEvent.UpdateResult(ctx, event.ID, params); uerr != nil {
+		slog.ErrorContext(ctx, "Failed to update payment event result",
+			slog.String("paymentEventId", event.ID), log.Error(uerr))
+		if err == nil {
+			return internalError(uerr)
+		}
+	}
+	return err
+}
+
+func (s *service) dispatchPaymentEvent(ctx context.Context, webhook *payment.WebhookEvent) error {
+	payload := store.NotifyPaymentPayload{
+		OrderID:   webhook.OrderID,
+		PaymentID: webhook.PaymentID,
+		IssuedAt:  webhook.IssuedAt,
+		Status:    webhook.Status,
+	}
+	switch webhook.Action {
+	case payment.WebhookEventActionAuthorized:
+		return s.NotifyPaymentAuthorized(ctx, &store.NotifyPaymentAuthorizedInput{NotifyPaymentPayload: payload})
+	case payment.WebhookEventActionCaptured:
+		return s.NotifyPaymentCaptured(ctx, &store.NotifyPaymentCapturedInput{NotifyPaymentPayload: payload})
+	case payment.WebhookEventActionFailed:
+		return s.NotifyPaymentFailed(ctx, &store.NotifyPaymentFailedInput{NotifyPaymentPayload: payload})
+	case payment.WebhookEventActionRefunded:
+		in := &store.NotifyPaymentRefundedInput{
+			NotifyPaymentPayload: payload,
+			Type:                 webhook.RefundType,
+			Reason:               webhook.RefundReason,
+			Total:                webhook.RefundTotal,
+		}
+		return s.NotifyPaymentRefunded(ctx, in)
+	default:
+		return fmt.Errorf("service: unknown payment event action. action=%d: %w", webhook.Action, exception.ErrInvalidArgument)
+	}
+}
+
+func parsePaymentEvent(
+	providerType entity.PaymentProviderType, eventType string, payload []byte,
+) (*payment.WebhookEvent, error) {
+	var (
+		event *payment.WebhookEvent
+		err   error
+	)
+	switch providerType {
+	case entity.PaymentProviderTypeKomoju:
+		event, err = komoju.ParseWebhookEvent(eventType, payload)
+	case entity.PaymentProviderTypeStripe:
+		event, err = stripe.ParseWebhookEvent(payload)
+	default:
+		return nil, fmt.Errorf("service: unknown payment provider type. type=%d: %w", providerType, exception.ErrInvalidArgument)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to parse payment event: %w: %s", exception.ErrInvalidArgument, err.Error())
+	}
+	if event.EventID == "" {
+		return nil, fmt.Errorf("service: payment event id is empty: %w", exception.ErrInvalidArgument)
+	}
+	return event, nil
+}
@@ -0,0 +1,520 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReceivePaymentEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 12, 0, 0, 0)
+	payload := []byte(`{"id":"event-id","type":"payment.captured","data":{"id":"payment-id","external_order_num":"order-id"},"created_at":"2026-10-18T12:00:00+09:00"}`)
+	ping := []byte(`{"id":"ping-id","type":"ping","created_at":"2026-10-18T12:00:00+09:00"}`)
+	order := &entity.Order{ID: "order-id"}
+	event := func(status entity.PaymentEventStatus) *entity.PaymentEvent {
+		return &entity.PaymentEvent{
+			ID:                "payment-event-id",
+			ProviderType:      entity.PaymentProviderTypeKomoju,
+			EventID:           "event-id",
+			EventType:         "payment.captured",
+			Payload:           payload,
+			SignatureVerified: true,
+			Status:            status,
+		}
+	}
+	input := &store.ReceivePaymentEventInput{
+		ProviderType:      entity.PaymentProviderTypeKomoju,
+		EventType:         "payment.captured",
+		Payload:           payload,
+		SignatureVerified: true,
+		SignatureRequired: true,
+	}
+	claimParams := &database.ClaimPaymentEventParams{
+		StaleAt: now.Add(-paymentEventProcessingTimeout),
+	}
+	rejected := func(message string) any {
+		return gomock.Cond(func(event *entity.PaymentEvent) bool {
+			return event.EventID == "" &&
+				event.Status == entity.PaymentEventStatusRejected &&
+				strings.Contains(event.ErrorMessage, message) &&
+				event.ProcessedAt.Equal(now)
+		})
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ReceivePaymentEventInput
+		expectErr error
+	}{
+		{
+			name: "success new event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(nil, database.ErrNotFound)
+				mocks.db.PaymentEvent.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, event *entity.PaymentEvent) error {
+						assert.Equal(t, "event-id", event.EventID)
+						assert.Equal(t, payload, event.Payload)
+						assert.True(t, event.SignatureVerified)
+						assert.Equal(t, entity.PaymentEventStatusPending, event.Status)
+						return nil
+					})
+				mocks.db.PaymentEvent.EXPECT().Claim(ctx, gomock.Any(), claimParams).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateCaptured(ctx, "order-id", gomock.Any()).Return(database.ErrFailedPrecondition)
+				mocks.db.PaymentEvent.EXPECT().
+					UpdateResult(ctx, gomock.Any(), &database.UpdatePaymentEventResultParams{
+						Status:       entity.PaymentEventStatusSucceeded,
+						AttemptCount: 1,
+						ProcessedAt:  now,
+					}).
+					Return(nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "success ignored event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "ping-id").
+					Return(nil, database.ErrNotFound)
+				mocks.db.PaymentEvent.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mocks.db.PaymentEvent.EXPECT().Claim(ctx, gomock.Any(), claimParams).Return(nil)
+				mocks.db.PaymentEvent.EXPECT().
+					UpdateResult(ctx, gomock.Any(), &database.UpdatePaymentEventResultParams{
+						Status:       entity.PaymentEventStatusIgnored,
+						AttemptCount: 1,
+						ProcessedAt:  now,
+					}).
+					Return(nil)
+			},
+			input: &store.ReceivePaymentEventInput{
+				ProviderType: entity.PaymentProviderTypeKomoju,
+				EventType:    "ping",
+				Payload:      ping,
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success duplicate completed event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(event(entity.PaymentEventStatusSucceeded), nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "success retry failed event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(event(entity.PaymentEventStatusFailed), nil)
+				mocks.db.PaymentEvent.EXPECT().Claim(ctx, "payment-event-id", claimParams).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateCaptured(ctx, "order-id", gomock.Any()).Return(database.ErrFailedPrecondition)
+				mocks.db.PaymentEvent.EXPECT().
+					UpdateResult(ctx, "payment-event-id", &database.UpdatePaymentEventResultParams{
+						Status:       entity.PaymentEventStatusSucceeded,
+						AttemptCount: 1,
+						ProcessedAt:  now,
+					}).
+					Return(nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ReceivePaymentEventInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "invalid payload",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Create(ctx, rejected("failed to parse payment event")).Return(nil)
+			},
+			input: &store.ReceivePaymentEventInput{
+				ProviderType: entity.PaymentProviderTypeKomoju,
+				Payload:      []byte(`invalid-json`),
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "empty event id",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Create(ctx, rejected("payment event id is empty")).Return(nil)
+			},
+			input: &store.ReceivePaymentEventInput{
+				ProviderType: entity.PaymentProviderTypeKomoju,
+				Payload:      []byte(`{"type":"ping"}`),
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "invalid signature",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Create(ctx, rejected("failed to verify payment event signature")).Return(nil)
+			},
+			input: &store.ReceivePaymentEventInput{
+				ProviderType:      entity.PaymentProviderTypeKomoju,
+				EventType:         "payment.captured",
+				Payload:           payload,
+				SignatureVerified: false,
+				SignatureRequired: true,
+			},
+			expectErr: exception.ErrUnauthenticated,
+		},
+		{
+			name: "invalid signature and failed to create payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input: &store.ReceivePaymentEventInput{
+				ProviderType:      entity.PaymentProviderTypeKomoju,
+				EventType:         "payment.captured",
+				Payload:           payload,
+				SignatureVerified: false,
+				SignatureRequired: true,
+			},
+			expectErr: exception.ErrUnauthenticated,
+		},
+		{
+			name: "success already processing event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(event(entity.PaymentEventStatusProcessing), nil)
+				mocks.db.PaymentEvent.EXPECT().
+					Claim(ctx, "payment-event-id", claimParams).
+					Return(database.ErrFailedPrecondition)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name: "failed to claim payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(event(entity.PaymentEventStatusPending), nil)
+				mocks.db.PaymentEvent.EXPECT().
+					Claim(ctx, "payment-event-id", claimParams).
+					Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to get payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(nil, assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to create payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(nil, database.ErrNotFound)
+				mocks.db.PaymentEvent.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to process payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(event(entity.PaymentEventStatusPending), nil)
+				mocks.db.PaymentEvent.EXPECT().Claim(ctx, "payment-event-id", claimParams).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(nil, database.ErrNotFound)
+				mocks.db.PaymentEvent.EXPECT().
+					UpdateResult(ctx, "payment-event-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, params *database.UpdatePaymentEventResultParams) error {
+						assert.Equal(t, entity.PaymentEventStatusFailed, params.Status)
+						assert.NotEmpty(t, params.ErrorMessage)
+						assert.Equal(t, int64(1), params.AttemptCount)
+						return nil
+					})
+			},
+			input:     input,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "failed to update payment event result",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().
+					GetByEventID(ctx, entity.PaymentProviderTypeKomoju, "event-id").
+					Return(event(entity.PaymentEventStatusPending), nil)
+				mocks.db.PaymentEvent.EXPECT().Claim(ctx, "payment-event-id", claimParams).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(order, nil)
+				mocks.db.Order.EXPECT().UpdateCaptured(ctx, "order-id", gomock.Any()).Return(database.ErrFailedPrecondition)
+				mocks.db.PaymentEvent.EXPECT().UpdateResult(ctx, "payment-event-id", gomock.Any()).Return(assert.AnError)
+			},
+			input:     input,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.ReceivePaymentEvent(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestListPaymentEvents(t *testing.T) {
+	t.Parallel()
+	params := &database.ListPaymentEventsParams{
+		ProviderType: entity.PaymentProviderTypeStripe,
+		Statuses:     []entity.PaymentEventStatus{entity.PaymentEventStatusFailed},
+		Limit:        20,
+		Offset:       0,
+	}
+	events := entity.PaymentEvents{
+		{
+			ID:           "payment-event-id",
+			ProviderType: entity.PaymentProviderTypeStripe,
+			EventID:      "evt_test",
+			Status:       entity.PaymentEventStatusFailed,
+		},
+	}
+	input := &store.ListPaymentEventsInput{
+		ProviderType: entity.PaymentProviderTypeStripe,
+		Statuses:     []entity.PaymentEventStatus{entity.PaymentEventStatusFailed},
+		Limit:        20,
+		Offset:       0,
+	}
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *store.ListPaymentEventsInput
+		expect      entity.PaymentEvents
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().List(gomock.Any(), params).Return(events, nil)
+				mocks.db.PaymentEvent.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input:       input,
+			expect:      events,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &store.ListPaymentEventsInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list payment events",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.PaymentEvent.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input:       input,
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+		{
+			name: "failed to count payment events",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().List(gomock.Any(), params).Return(events, nil)
+				mocks.db.PaymentEvent.EXPECT().Count(gomock.Any(), params).Return(int64(0), assert.AnError)
+			},
+			input:       input,
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListPaymentEvents(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}))
+	}
+}
+
+func TestGetPaymentEvent(t *testing.T) {
+	t.Parallel()
+	event := &entity.PaymentEvent{
+		ID:           "payment-event-id",
+		ProviderType: entity.PaymentProviderTypeStripe,
+		EventID:      "evt_test",
+		Status:       entity.PaymentEventStatusSucceeded,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetPaymentEventInput
+		expect    *entity.PaymentEvent
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Get(ctx, "payment-event-id").Return(event, nil)
+			},
+			input:     &store.GetPaymentEventInput{PaymentEventID: "payment-event-id"},
+			expect:    event,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetPaymentEventInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Get(ctx, "payment-event-id").Return(nil, database.ErrNotFound)
+			},
+			input:     &store.GetPaymentEventInput{PaymentEventID: "payment-event-id"},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetPaymentEvent(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestReplayPaymentEvent(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 12, 0, 0, 0)
+	payload := []byte(`{"id":"evt_test","type":"payment_intent.succeeded","created":1700000000,"data":{"object":{"id":"pi_test","metadata":{"order_id":"order-id"}}}}`)
+	event := func(status entity.PaymentEventStatus) *entity.PaymentEvent {
+		return &entity.PaymentEvent{
+			ID:                "payment-event-id",
+			ProviderType:      entity.PaymentProviderTypeStripe,
+			EventID:           "evt_test",
+			EventType:         "payment_intent.succeeded",
+			Payload:           payload,
+			SignatureVerified: true,
+			Status:            status,
+			AttemptCount:      1,
+			ProcessedAt:       now.Add(-time.Hour),
+		}
+	}
+	input := &store.ReplayPaymentEventInput{PaymentEventID: "payment-event-id"}
+	claimParams := &database.ClaimPaymentEventParams{
+		StaleAt: now.Add(-paymentEventProcessingTimeout),
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ReplayPaymentEventInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Get(ctx, "payment-event-id").Return(event(entity.PaymentEventStatusFailed), nil)
+				mocks.db.PaymentEvent.EXPECT().Claim(ctx, "payment-event-id", claimParams).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(&entity.Order{ID: "order-id"}, nil)
+				mocks.db.Order.EXPECT().
+					UpdateCaptured(ctx, "order-id", &database.UpdateOrderCapturedParams{
+						PaymentID: "pi_test",
+						IssuedAt:  time.Unix(1700000000, 0),
+					}).
+					Return(database.ErrFailedPrecondition)
+				mocks.db.PaymentEvent.EXPECT().
+					UpdateResult(ctx, "payment-event-id", &database.UpdatePaymentEventResultParams{
+						Status:       entity.PaymentEventStatusSucceeded,
+						AttemptCount: 2,
+						ProcessedAt:  now,
+					}).
+					Return(nil)
+			},
+			input:     input,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ReplayPaymentEventInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Get(ctx, "payment-event-id").Return(nil, database.ErrNotFound)
+			},
+			input:     input,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "already succeeded",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Get(ctx, "payment-event-id").Return(event(entity.PaymentEventStatusSucceeded), nil)
+			},
+			input:     input,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "already processing",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Get(ctx, "payment-event-id").Return(event(entity.PaymentEventStatusPending), nil)
+				mocks.db.PaymentEvent.EXPECT().
+					Claim(ctx, "payment-event-id", claimParams).
+					Return(database.ErrFailedPrecondition)
+			},
+			input:     input,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to process payment event",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PaymentEvent.EXPECT().Get(ctx, "payment-event-id").Return(event(entity.PaymentEventStatusFailed), nil)
+				mocks.db.PaymentEvent.EXPECT().Claim(ctx, "payment-event-id", claimParams).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id").Return(nil, database.ErrNotFound)
+				mocks.db.PaymentEvent.EXPECT().UpdateResult(ctx, "payment-event-id", gomock.Any()).Return(nil)
+			},
+			input:     input,
+			expectErr: exception.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.ReplayPaymentEvent(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
@@ -31,6 +31,7 @@ func (s *service) UpdatePaymentSystem(ctx context.Context, in *store.UpdatePayme
 	params := &database.UpdatePaymentSystemParams{
 		Status:       in.Status,
 		ProviderType: in.ProviderType,
+		FeeRate:      in.FeeRate,
 	}
 	err := s.db.PaymentSystem.Update(ctx, in.MethodType, params)
 	return internalError(err)
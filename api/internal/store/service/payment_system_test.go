@@ -143,6 +143,7 @@ func TestUpdatePaymentSystem(t *testing.T) {
 				params := &database.UpdatePaymentSystemParams{
 					Status:       entity.PaymentSystemStatusOutage,
 					ProviderType: entity.PaymentProviderTypeKomoju,
+					FeeRate:      3.6,
 				}
 				mocks.db.PaymentSystem.EXPECT().
 					Update(ctx, entity.PaymentMethodTypeCreditCard, params).
@@ -152,6 +153,7 @@ func TestUpdatePaymentSystem(t *testing.T) {
 				MethodType:   entity.PaymentMethodTypeCreditCard,
 				Status:       entity.PaymentSystemStatusOutage,
 				ProviderType: entity.PaymentProviderTypeKomoju,
+				FeeRate:      3.6,
 			},
 			expectErr: nil,
 		},
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// 一度の実行で売上確定する予約注文の最大件数
+const captureExpiringPreordersLimit = 200
+
+func (s *service) ListPreorderBatches(
+	ctx context.Context, in *store.ListPreorderBatchesInput,
+) (entity.PreorderBatches, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListPreorderBatchesParams{
+		ShopID:   in.ShopID,
+		Statuses: in.Statuses,
+		Limit:    int(in.Limit),
+		Offset:   int(in.Offset),
+	}
+	var (
+		batches entity.PreorderBatches
+		total   int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		batches, err = s.db.PreorderBatch.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.PreorderBatch.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return batches, total, nil
+}
+
+func (s *service) GetPreorderBatch(ctx context.Context, in *store.GetPreorderBatchInput) (*entity.PreorderBatch, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	batch, err := s.db.PreorderBatch.Get(ctx, in.PreorderBatchID)
+	return batch, internalError(err)
+}
+
+func (s *service) ReadyPreorderBatch(ctx context.Context, in *store.ReadyPreorderBatchInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	batch, err := s.db.PreorderBatch.Get(ctx, in.PreorderBatchID)
+	if err != nil {
+		return internalError(err)
+	}
+	batch.Ready(s.now())
+	params := &database.UpdatePreorderBatchParams{
+		Status:  batch.Status,
+		ReadyAt: batch.ReadyAt,
+	}
+	if err := s.db.PreorderBatch.Update(ctx, batch.ID, params); err != nil {
+		return internalError(err)
+	}
+	// 与信済みの予約注文をまとめて売上確定する（売上確定後の処理は決済プロバイダーからの通知で行う）
+	oparams := &database.ListOrdersParams{
+		PreorderBatchID: batch.ID,
+		Statuses:        []entity.OrderStatus{entity.OrderStatusWaiting},
+	}
+	orders, err := s.db.Order.List(ctx, oparams)
+	if err != nil {
+		return internalError(err)
+	}
+	var failures int
+	for _, order := range orders {
+		if err := s.capturePreorder(ctx, order); err != nil {
+			slog.ErrorContext(ctx, "Failed to capture preorder",
+				slog.String("preorderBatchId", batch.ID), slog.String("orderId", order.ID), log.Error(err))
+			failures++
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("service: failed to capture preorders. failures=%d: %w", failures, exception.ErrInternal)
+	}
+	return nil
+}
+
+// CaptureExpiringPreorders - 与信の失効が近い予約注文は、出荷準備の完了を待たずに売上確定する
+func (s *service) CaptureExpiringPreorders(ctx context.Context, in *store.CaptureExpiringPreordersInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	params := &database.ListAuthorizedPreordersParams{
+		PaidAtLt: s.now().Add(entity.PreorderCaptureMargin - entity.PreorderAuthorizationPeriod),
+		Limit:    captureExpiringPreordersLimit,
+	}
+	orders, err := s.db.Order.ListAuthorizedPreorders(ctx, params)
+	if err != nil {
+		return internalError(err)
+	}
+	var failures int
+	for _, order := range orders {
+		if err := s.capturePreorder(ctx, order); err != nil {
+			slog.ErrorContext(ctx, "Failed to capture expiring preorder",
+				slog.String("preorderBatchId", order.PreorderBatchID), slog.String("orderId", order.ID), log.Error(err))
+			failures++
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("service: failed to capture expiring preorders. failures=%d: %w", failures, exception.ErrInternal)
+	}
+	return nil
+}
+
+func (s *service) capturePreorder(ctx context.Context, order *entity.Order) error {
+	prov, err := s.getProviderByType(order.OrderPayment.ProviderType)
+	if err != nil {
+		return err
+	}
+	// 売上確定の通知を受け取る前に再実行された場合に備え、与信状態のもののみ売上確定する
+	result, err := prov.ShowPayment(ctx, order.PaymentID)
+	if err != nil {
+		return err
+	}
+	switch result.Status {
+	case entity.PaymentStatusAuthorized:
+		return prov.CapturePayment(ctx, order.PaymentID)
+	case entity.PaymentStatusExpired, entity.PaymentStatusCanceled:
+		// 与信が失効している場合は売上確定できないため、注文を期限切れとして確保していた在庫等を開放する
+		return s.expireAuthorizedPreorder(ctx, order)
+	default:
+		return nil
+	}
+}
+
+func (s *service) expireAuthorizedPreorder(ctx context.Context, order *entity.Order) error {
+	slog.WarnContext(ctx, "Preorder authorization has expired", slog.String("orderId", order.ID))
+	params := &database.UpdateOrderFailedParams{
+		Status:   entity.PaymentStatusExpired,
+		IssuedAt: s.now(),
+	}
+	err := s.db.Order.UpdateFailed(ctx, order.ID, params)
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		// 決済プロバイダーからの通知で更新済みの場合
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		s.releaseProductInventories(context.Background(), order)
+		s.releaseExperienceSlot(context.Background(), order)
+		s.releasePromotionRedemption(context.Background(), order)
+	}()
+	return nil
+}
+
+// extendAuthorizedHolds - 与信済みの注文は与信の有効期限まで仮押さえを延長する（期限を過ぎている場合は売上確定を試みる）
+func (s *service) extendAuthorizedHolds(ctx context.Context, order *entity.Order) error {
+	expiredAt := order.OrderPayment.AuthorizationExpiredAt()
+	if !s.now().Before(expiredAt) {
+		return s.capturePreorder(ctx, order)
+	}
+	params := &database.ExtendOrderHoldsParams{
+		ExpiredAt: expiredAt,
+	}
+	return s.db.Order.ExtendHolds(ctx, order.ID, params)
+}
+
+// getPreorderBatch - 予約商品が含まれる場合、出荷予定期間ごとの予約注文バッチを取得（存在しない場合は作成）する
+func (s *service) getPreorderBatch(
+	ctx context.Context, shopID, coordinatorID string, products entity.Products,
+) (*entity.PreorderBatch, error) {
+	params := &entity.NewPreorderBatchParams{
+		ShopID:        shopID,
+		CoordinatorID: coordinatorID,
+		Products:      products,
+	}
+	batch, err := entity.NewPreorderBatch(params)
+	if err != nil {
+		return nil, fmt.Errorf("service: invalid preorder: %w: %s", exception.ErrFailedPrecondition, err.Error())
+	}
+	if batch == nil {
+		return nil, nil
+	}
+	current, err := s.db.PreorderBatch.GetByExpectedShipping(ctx, shopID, batch.ExpectedShippingStartAt, batch.ExpectedShippingEndAt)
+	if err == nil {
+		return current, nil
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, internalError(err)
+	}
+	err = s.db.PreorderBatch.Create(ctx, batch)
+	if errors.Is(err, database.ErrAlreadyExists) {
+		// 同時に作成された場合は、作成済みの予約注文バッチを利用する
+		current, err = s.db.PreorderBatch.GetByExpectedShipping(ctx, shopID, batch.ExpectedShippingStartAt, batch.ExpectedShippingEndAt)
+		return current, internalError(err)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	return batch, nil
+}
+
+// holdPreorderCapture - 予約注文の出荷準備が完了していない場合、売上確定を保留する
+func (s *service) holdPreorderCapture(ctx context.Context, order *entity.Order) (bool, error) {
+	if !order.IsPreorder() {
+		return false, nil
+	}
+	batch, err := s.db.PreorderBatch.Get(ctx, order.PreorderBatchID)
+	if err != nil {
+		return false, err
+	}
+	return !batch.IsReady(), nil
+}
@@ -0,0 +1,426 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListPreorderBatches(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	params := &database.ListPreorderBatchesParams{
+		ShopID:   "shop-id",
+		Statuses: []entity.PreorderBatchStatus{entity.PreorderBatchStatusAccepting},
+		Limit:    20,
+		Offset:   0,
+	}
+	batches := entity.PreorderBatches{
+		{
+			ID:                      "batch-id",
+			ShopID:                  "shop-id",
+			CoordinatorID:           "coordinator-id",
+			Status:                  entity.PreorderBatchStatusAccepting,
+			ExpectedShippingStartAt: now.AddDate(0, 1, 0),
+			ExpectedShippingEndAt:   now.AddDate(0, 1, 7),
+			CreatedAt:               now,
+			UpdatedAt:               now,
+		},
+	}
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *store.ListPreorderBatchesInput
+		expect      entity.PreorderBatches
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().List(gomock.Any(), params).Return(batches, nil)
+				mocks.db.PreorderBatch.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListPreorderBatchesInput{
+				ShopID:   "shop-id",
+				Statuses: []entity.PreorderBatchStatus{entity.PreorderBatchStatusAccepting},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      batches,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &store.ListPreorderBatchesInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list preorder batches",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.PreorderBatch.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListPreorderBatchesInput{
+				ShopID:   "shop-id",
+				Statuses: []entity.PreorderBatchStatus{entity.PreorderBatchStatusAccepting},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+		{
+			name: "failed to count preorder batches",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().List(gomock.Any(), params).Return(batches, nil)
+				mocks.db.PreorderBatch.EXPECT().Count(gomock.Any(), params).Return(int64(0), assert.AnError)
+			},
+			input: &store.ListPreorderBatchesInput{
+				ShopID:   "shop-id",
+				Statuses: []entity.PreorderBatchStatus{entity.PreorderBatchStatusAccepting},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListPreorderBatches(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}, withNow(now)))
+	}
+}
+
+func TestGetPreorderBatch(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	batch := &entity.PreorderBatch{
+		ID:                      "batch-id",
+		ShopID:                  "shop-id",
+		CoordinatorID:           "coordinator-id",
+		Status:                  entity.PreorderBatchStatusAccepting,
+		ExpectedShippingStartAt: now.AddDate(0, 1, 0),
+		ExpectedShippingEndAt:   now.AddDate(0, 1, 7),
+		CreatedAt:               now,
+		UpdatedAt:               now,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetPreorderBatchInput
+		expect    *entity.PreorderBatch
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch, nil)
+			},
+			input: &store.GetPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expect:    batch,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetPreorderBatchInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get preorder batch",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(nil, assert.AnError)
+			},
+			input: &store.GetPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetPreorderBatch(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestReadyPreorderBatch(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	batch := func() *entity.PreorderBatch {
+		return &entity.PreorderBatch{
+			ID:                      "batch-id",
+			ShopID:                  "shop-id",
+			CoordinatorID:           "coordinator-id",
+			Status:                  entity.PreorderBatchStatusAccepting,
+			ExpectedShippingStartAt: now.AddDate(0, 1, 0),
+			ExpectedShippingEndAt:   now.AddDate(0, 1, 7),
+			CreatedAt:               now,
+			UpdatedAt:               now,
+		}
+	}
+	params := &database.UpdatePreorderBatchParams{
+		Status:  entity.PreorderBatchStatusReady,
+		ReadyAt: now,
+	}
+	ordersParams := &database.ListOrdersParams{
+		PreorderBatchID: "batch-id",
+		Statuses:        []entity.OrderStatus{entity.OrderStatusWaiting},
+	}
+	orders := entity.Orders{
+		{
+			ID:              "order-id",
+			PreorderBatchID: "batch-id",
+			Type:            entity.OrderTypeProduct,
+			Status:          entity.OrderStatusWaiting,
+			OrderPayment: entity.OrderPayment{
+				OrderID:      "order-id",
+				PaymentID:    "payment-id",
+				ProviderType: entity.PaymentProviderTypeKomoju,
+				Status:       entity.PaymentStatusAuthorized,
+			},
+		},
+	}
+	authorized := &payment.PaymentResult{Status: entity.PaymentStatusAuthorized}
+	captured := &payment.PaymentResult{Status: entity.PaymentStatusCaptured}
+	expired := &payment.PaymentResult{Status: entity.PaymentStatusExpired}
+	failedParams := &database.UpdateOrderFailedParams{
+		Status:   entity.PaymentStatusExpired,
+		IssuedAt: now,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ReadyPreorderBatchInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(nil)
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(authorized, nil)
+				mocks.payment.EXPECT().CapturePayment(ctx, "payment-id").Return(nil)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success already captured",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(nil)
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(captured, nil)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success authorization expired",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(nil)
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(expired, nil)
+				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", failedParams).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), "order-id").Return(nil)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success authorization expired already updated",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(nil)
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(expired, nil)
+				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", failedParams).Return(database.ErrFailedPrecondition)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ReadyPreorderBatchInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get preorder batch",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(nil, assert.AnError)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to update preorder batch",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(assert.AnError)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list orders",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(nil)
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(nil, assert.AnError)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to capture payment",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(nil)
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(authorized, nil)
+				mocks.payment.EXPECT().CapturePayment(ctx, "payment-id").Return(assert.AnError)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to expire order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PreorderBatch.EXPECT().Get(ctx, "batch-id").Return(batch(), nil)
+				mocks.db.PreorderBatch.EXPECT().Update(ctx, "batch-id", params).Return(nil)
+				mocks.db.Order.EXPECT().List(ctx, ordersParams).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(expired, nil)
+				mocks.db.Order.EXPECT().UpdateFailed(ctx, "order-id", failedParams).Return(assert.AnError)
+			},
+			input: &store.ReadyPreorderBatchInput{
+				PreorderBatchID: "batch-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.ReadyPreorderBatch(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestCaptureExpiringPreorders(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	params := &database.ListAuthorizedPreordersParams{
+		PaidAtLt: now.Add(entity.PreorderCaptureMargin - entity.PreorderAuthorizationPeriod),
+		Limit:    captureExpiringPreordersLimit,
+	}
+	orders := entity.Orders{
+		{
+			ID:              "order-id",
+			PreorderBatchID: "batch-id",
+			Type:            entity.OrderTypeProduct,
+			Status:          entity.OrderStatusWaiting,
+			OrderPayment: entity.OrderPayment{
+				OrderID:      "order-id",
+				PaymentID:    "payment-id",
+				ProviderType: entity.PaymentProviderTypeKomoju,
+				Status:       entity.PaymentStatusAuthorized,
+				PaidAt:       now.AddDate(0, 0, -6),
+			},
+		},
+	}
+	authorized := &payment.PaymentResult{Status: entity.PaymentStatusAuthorized}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.CaptureExpiringPreordersInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListAuthorizedPreorders(ctx, params).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(authorized, nil)
+				mocks.payment.EXPECT().CapturePayment(ctx, "payment-id").Return(nil)
+			},
+			input:     &store.CaptureExpiringPreordersInput{},
+			expectErr: nil,
+		},
+		{
+			name: "success empty",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListAuthorizedPreorders(ctx, params).Return(entity.Orders{}, nil)
+			},
+			input:     &store.CaptureExpiringPreordersInput{},
+			expectErr: nil,
+		},
+		{
+			name: "failed to list authorized preorders",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListAuthorizedPreorders(ctx, params).Return(nil, assert.AnError)
+			},
+			input:     &store.CaptureExpiringPreordersInput{},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to capture payment",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListAuthorizedPreorders(ctx, params).Return(orders, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").Return(authorized, nil)
+				mocks.payment.EXPECT().CapturePayment(ctx, "payment-id").Return(assert.AnError)
+			},
+			input:     &store.CaptureExpiringPreordersInput{},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.CaptureExpiringPreorders(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
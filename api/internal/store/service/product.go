@@ -165,34 +165,37 @@ func (s *service) CreateProduct(ctx context.Context, in *store.CreateProductInpu
 		return nil, internalError(err)
 	}
 	params := &entity.NewProductParams{
-		ShopID:               in.ShopID,
-		CoordinatorID:        in.CoordinatorID,
-		ProducerID:           in.ProducerID,
-		TypeID:               in.TypeID,
-		TagIDs:               in.TagIDs,
-		Name:                 in.Name,
-		Description:          in.Description,
-		Scope:                in.Scope,
-		Inventory:            in.Inventory,
-		Weight:               in.Weight,
-		WeightUnit:           in.WeightUnit,
-		Item:                 in.Item,
-		ItemUnit:             in.ItemUnit,
-		ItemDescription:      in.ItemDescription,
-		Media:                media,
-		Price:                in.Price,
-		Cost:                 in.Cost,
-		ExpirationDate:       in.ExpirationDate,
-		RecommendedPoints:    in.RecommendedPoints,
-		StorageMethodType:    in.StorageMethodType,
-		DeliveryType:         in.DeliveryType,
-		Box60Rate:            in.Box60Rate,
-		Box80Rate:            in.Box80Rate,
-		Box100Rate:           in.Box100Rate,
-		OriginPrefectureCode: in.OriginPrefectureCode,
-		OriginCity:           in.OriginCity,
-		StartAt:              in.StartAt,
-		EndAt:                in.EndAt,
+		ShopID:                  in.ShopID,
+		CoordinatorID:           in.CoordinatorID,
+		ProducerID:              in.ProducerID,
+		TypeID:                  in.TypeID,
+		TagIDs:                  in.TagIDs,
+		Name:                    in.Name,
+		Description:             in.Description,
+		Scope:                   in.Scope,
+		Inventory:               in.Inventory,
+		Weight:                  in.Weight,
+		WeightUnit:              in.WeightUnit,
+		Item:                    in.Item,
+		ItemUnit:                in.ItemUnit,
+		ItemDescription:         in.ItemDescription,
+		Media:                   media,
+		Price:                   in.Price,
+		Cost:                    in.Cost,
+		TaxCategory:             in.TaxCategory,
+		ExpirationDate:          in.ExpirationDate,
+		RecommendedPoints:       in.RecommendedPoints,
+		StorageMethodType:       in.StorageMethodType,
+		DeliveryType:            in.DeliveryType,
+		Box60Rate:               in.Box60Rate,
+		Box80Rate:               in.Box80Rate,
+		Box100Rate:              in.Box100Rate,
+		OriginPrefectureCode:    in.OriginPrefectureCode,
+		OriginCity:              in.OriginCity,
+		StartAt:                 in.StartAt,
+		EndAt:                   in.EndAt,
+		ExpectedShippingStartAt: in.ExpectedShippingStartAt,
+		ExpectedShippingEndAt:   in.ExpectedShippingEndAt,
 	}
 	product, err := entity.NewProduct(params)
 	if err != nil {
@@ -219,31 +222,34 @@ func (s *service) UpdateProduct(ctx context.Context, in *store.UpdateProductInpu
 		return fmt.Errorf("api: invalid media format: %s: %w", err.Error(), exception.ErrInvalidArgument)
 	}
 	params := &database.UpdateProductParams{
-		TypeID:               in.TypeID,
-		TagIDs:               in.TagIDs,
-		Name:                 in.Name,
-		Description:          in.Description,
-		Scope:                in.Scope,
-		Inventory:            in.Inventory,
-		Weight:               in.Weight,
-		WeightUnit:           in.WeightUnit,
-		Item:                 in.Item,
-		ItemUnit:             in.ItemUnit,
-		ItemDescription:      in.ItemDescription,
-		Media:                media,
-		Price:                in.Price,
-		Cost:                 in.Cost,
-		ExpirationDate:       in.ExpirationDate,
-		RecommendedPoints:    in.RecommendedPoints,
-		StorageMethodType:    in.StorageMethodType,
-		DeliveryType:         in.DeliveryType,
-		Box60Rate:            in.Box60Rate,
-		Box80Rate:            in.Box80Rate,
-		Box100Rate:           in.Box100Rate,
-		OriginPrefectureCode: in.OriginPrefectureCode,
-		OriginCity:           in.OriginCity,
-		StartAt:              in.StartAt,
-		EndAt:                in.EndAt,
+		TypeID:                  in.TypeID,
+		TagIDs:                  in.TagIDs,
+		Name:                    in.Name,
+		Description:             in.Description,
+		Scope:                   in.Scope,
+		Inventory:               in.Inventory,
+		Weight:                  in.Weight,
+		WeightUnit:              in.WeightUnit,
+		Item:                    in.Item,
+		ItemUnit:                in.ItemUnit,
+		ItemDescription:         in.ItemDescription,
+		Media:                   media,
+		Price:                   in.Price,
+		Cost:                    in.Cost,
+		TaxCategory:             in.TaxCategory,
+		ExpirationDate:          in.ExpirationDate,
+		RecommendedPoints:       in.RecommendedPoints,
+		StorageMethodType:       in.StorageMethodType,
+		DeliveryType:            in.DeliveryType,
+		Box60Rate:               in.Box60Rate,
+		Box80Rate:               in.Box80Rate,
+		Box100Rate:              in.Box100Rate,
+		OriginPrefectureCode:    in.OriginPrefectureCode,
+		OriginCity:              in.OriginCity,
+		StartAt:                 in.StartAt,
+		EndAt:                   in.EndAt,
+		ExpectedShippingStartAt: in.ExpectedShippingStartAt,
+		ExpectedShippingEndAt:   in.ExpectedShippingEndAt,
 	}
 	if err := s.db.Product.Update(ctx, in.ProductID, params); err != nil {
 		return internalError(err)
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// 一度の実行で解放する仮押さえの最大件数
+const releaseExpiredInventoryHoldsLimit = 200
+
+func (s *service) GetProductInventory(ctx context.Context, in *store.GetProductInventoryInput) (*entity.ProductInventory, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	var (
+		product *entity.Product
+		holds   entity.AggregatedProductInventoryHolds
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		product, err = s.db.Product.Get(ectx, in.ProductID)
+		return
+	})
+	eg.Go(func() (err error) {
+		params := &database.AggregateProductInventoryHoldsParams{
+			ProductIDs: []string{in.ProductID},
+		}
+		holds, err = s.db.ProductInventoryHold.Aggregate(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	return entity.NewProductInventory(product, holds.Map()[in.ProductID]), nil
+}
+
+func (s *service) ReleaseExpiredProductInventoryHolds(ctx context.Context, in *store.ReleaseExpiredProductInventoryHoldsInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	params := &database.ListProductInventoryHoldsParams{
+		Status:      entity.ProductInventoryHoldStatusHeld,
+		ExpiredAtLt: s.now(),
+		Limit:       releaseExpiredInventoryHoldsLimit,
+	}
+	holds, err := s.db.ProductInventoryHold.List(ctx, params)
+	if err != nil {
+		return internalError(err)
+	}
+	rparams := &database.ListExperienceSlotReservationsParams{
+		Status:      entity.ExperienceSlotReservationStatusHeld,
+		ExpiredAtLt: s.now(),
+		Limit:       releaseExpiredInventoryHoldsLimit,
+	}
+	reservations, err := s.db.ExperienceSlot.ListReservations(ctx, rparams)
+	if err != nil {
+		return internalError(err)
+	}
+	pparams := &database.ListPromotionRedemptionsParams{
+		Status:      entity.PromotionRedemptionStatusReserved,
+		ExpiredAtLt: s.now(),
+		Limit:       releaseExpiredInventoryHoldsLimit,
+	}
+	redemptions, err := s.db.PromotionRedemption.List(ctx, pparams)
+	if err != nil {
+		return internalError(err)
+	}
+	// 在庫・体験枠の解放時に合わせて解放されるため、それ以外の注文のみ対象とする
+	released := make(map[string]struct{}, len(holds)+len(reservations))
+	var errs []error
+	for _, orderID := range holds.OrderIDs() {
+		released[orderID] = struct{}{}
+		if err := s.releaseExpiredProductInventoryHold(ctx, orderID); err != nil {
+			slog.ErrorContext(ctx, "Failed to release expired inventory hold", slog.String("orderId", orderID), log.Error(err))
+			errs = append(errs, err)
+		}
+	}
+	for _, orderID := range reservations.OrderIDs() {
+		released[orderID] = struct{}{}
+		if err := s.releaseExpiredExperienceSlotReservation(ctx, orderID); err != nil {
+			slog.ErrorContext(ctx, "Failed to release expired experience slot reservation", slog.String("orderId", orderID), log.Error(err))
+			errs = append(errs, err)
+		}
+	}
+	for _, orderID := range redemptions.OrderIDs() {
+		if _, ok := released[orderID]; ok {
+			continue
+		}
+		if err := s.releaseExpiredPromotionRedemption(ctx, orderID); err != nil {
+			slog.ErrorContext(ctx, "Failed to release expired promotion redemption", slog.String("orderId", orderID), log.Error(err))
+			errs = append(errs, err)
+		}
+	}
+	return internalError(errors.Join(errs...))
+}
+
+func (s *service) releaseExpiredProductInventoryHold(ctx context.Context, orderID string) error {
+	order, err := s.db.Order.Get(ctx, orderID)
+	if errors.Is(err, database.ErrNotFound) {
+		// 注文履歴の登録前に処理が中断された場合
+		return errors.Join(
+			s.db.ProductInventoryHold.Release(ctx, orderID),
+			s.db.PromotionRedemption.Release(ctx, orderID),
+		)
+	}
+	if err != nil {
+		return err
+	}
+	switch order.OrderPayment.Status {
+	case entity.PaymentStatusCaptured:
+		// 実売上の通知を取りこぼしている場合は販売確定として扱う
+		return errors.Join(
+			s.db.ProductInventoryHold.Sell(ctx, orderID),
+			s.db.PromotionRedemption.Confirm(ctx, orderID),
+		)
+	case entity.PaymentStatusAuthorized:
+		// 実売上化の処理中（予約注文は出荷準備の完了待ち）のため、与信の有効期限まで解放しない
+		return s.extendAuthorizedHolds(ctx, order)
+	case entity.PaymentStatusUnknown, entity.PaymentStatusPending:
+		return s.expireUnpaidOrder(ctx, order)
+	default:
+		return errors.Join(
+			s.db.ProductInventoryHold.Release(ctx, orderID),
+			s.db.PromotionRedemption.Release(ctx, orderID),
+		)
+	}
+}
+
+func (s *service) releaseExpiredExperienceSlotReservation(ctx context.Context, orderID string) error {
+	order, err := s.db.Order.Get(ctx, orderID)
+	if errors.Is(err, database.ErrNotFound) {
+		// 注文履歴の登録前に処理が中断された場合
+		return errors.Join(
+			s.db.ExperienceSlot.Release(ctx, orderID),
+			s.db.PromotionRedemption.Release(ctx, orderID),
+		)
+	}
+	if err != nil {
+		return err
+	}
+	switch order.OrderPayment.Status {
+	case entity.PaymentStatusCaptured:
+		// 実売上の通知を取りこぼしている場合は予約確定として扱う
+		return errors.Join(
+			s.db.ExperienceSlot.Confirm(ctx, orderID),
+			s.db.PromotionRedemption.Confirm(ctx, orderID),
+		)
+	case entity.PaymentStatusAuthorized:
+		// 実売上化の処理中（予約注文は出荷準備の完了待ち）のため、与信の有効期限まで解放しない
+		return s.extendAuthorizedHolds(ctx, order)
+	case entity.PaymentStatusUnknown, entity.PaymentStatusPending:
+		return s.expireUnpaidOrder(ctx, order)
+	default:
+		return errors.Join(
+			s.db.ExperienceSlot.Release(ctx, orderID),
+			s.db.PromotionRedemption.Release(ctx, orderID),
+		)
+	}
+}
+
+func (s *service) releaseExpiredPromotionRedemption(ctx context.Context, orderID string) error {
+	order, err := s.db.Order.Get(ctx, orderID)
+	if errors.Is(err, database.ErrNotFound) {
+		// 注文履歴の登録前に処理が中断された場合
+		return s.db.PromotionRedemption.Release(ctx, orderID)
+	}
+	if err != nil {
+		return err
+	}
+	switch order.OrderPayment.Status {
+	case entity.PaymentStatusCaptured:
+		// 実売上の通知を取りこぼしている場合は利用確定として扱う
+		return s.db.PromotionRedemption.Confirm(ctx, orderID)
+	case entity.PaymentStatusAuthorized:
+		// 実売上化の処理中（予約注文は出荷準備の完了待ち）のため、与信の有効期限まで解放しない
+		return s.extendAuthorizedHolds(ctx, order)
+	case entity.PaymentStatusUnknown, entity.PaymentStatusPending:
+		return s.expireUnpaidOrder(ctx, order)
+	default:
+		return s.db.PromotionRedemption.Release(ctx, orderID)
+	}
+}
+
+// expireUnpaidOrder - 未払いの注文は決済を取り消したうえで、仮押さえの解放と同時に注文を期限切れにする
+func (s *service) expireUnpaidOrder(ctx context.Context, order *entity.Order) error {
+	if order.PaymentID != "" {
+		prov, err := s.getProviderByType(order.OrderPayment.ProviderType)
+		if err != nil {
+			return err
+		}
+		if err := prov.CancelPayment(ctx, order.PaymentID); err != nil {
+			return err
+		}
+	}
+	params := &database.ExpireOrderParams{
+		ExpiredAt: s.now(),
+	}
+	err := s.db.Order.Expire(ctx, order.ID, params)
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		// 期限切れの処理中に支払いが完了した場合
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetProductInventory(t *testing.T) {
+	t.Parallel()
+
+	product := &entity.Product{
+		ID:        "product-id",
+		Inventory: 30,
+	}
+	params := &database.AggregateProductInventoryHoldsParams{
+		ProductIDs: []string{"product-id"},
+	}
+	holds := entity.AggregatedProductInventoryHolds{
+		{ProductID: "product-id", Quantity: 5},
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetProductInventoryInput
+		expect    *entity.ProductInventory
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Product.EXPECT().Get(gomock.Any(), "product-id").Return(product, nil)
+				mocks.db.ProductInventoryHold.EXPECT().Aggregate(gomock.Any(), params).Return(holds, nil)
+			},
+			input: &store.GetProductInventoryInput{
+				ProductID: "product-id",
+			},
+			expect: &entity.ProductInventory{
+				ProductID: "product-id",
+				Available: 30,
+				Held:      5,
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success without holds",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Product.EXPECT().Get(gomock.Any(), "product-id").Return(product, nil)
+				mocks.db.ProductInventoryHold.EXPECT().Aggregate(gomock.Any(), params).Return(entity.AggregatedProductInventoryHolds{}, nil)
+			},
+			input: &store.GetProductInventoryInput{
+				ProductID: "product-id",
+			},
+			expect: &entity.ProductInventory{
+				ProductID: "product-id",
+				Available: 30,
+				Held:      0,
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetProductInventoryInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get product",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Product.EXPECT().Get(gomock.Any(), "product-id").Return(nil, database.ErrNotFound)
+				mocks.db.ProductInventoryHold.EXPECT().Aggregate(gomock.Any(), params).Return(holds, nil).AnyTimes()
+			},
+			input: &store.GetProductInventoryInput{
+				ProductID: "product-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "failed to aggregate holds",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Product.EXPECT().Get(gomock.Any(), "product-id").Return(product, nil).AnyTimes()
+				mocks.db.ProductInventoryHold.EXPECT().Aggregate(gomock.Any(), params).Return(nil, assert.AnError)
+			},
+			input: &store.GetProductInventoryInput{
+				ProductID: "product-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetProductInventory(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestReleaseExpiredProductInventoryHolds(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 8, 24, 18, 30, 0, 0)
+	params := &database.ListProductInventoryHoldsParams{
+		Status:      entity.ProductInventoryHoldStatusHeld,
+		ExpiredAtLt: now,
+		Limit:       releaseExpiredInventoryHoldsLimit,
+	}
+	rparams := &database.ListExperienceSlotReservationsParams{
+		Status:      entity.ExperienceSlotReservationStatusHeld,
+		ExpiredAtLt: now,
+		Limit:       releaseExpiredInventoryHoldsLimit,
+	}
+	pparams := &database.ListPromotionRedemptionsParams{
+		Status:      entity.PromotionRedemptionStatusReserved,
+		ExpiredAtLt: now,
+		Limit:       releaseExpiredInventoryHoldsLimit,
+	}
+	expireParams := &database.ExpireOrderParams{
+		ExpiredAt: now,
+	}
+	extendParams := &database.ExtendOrderHoldsParams{
+		ExpiredAt: now.Add(-time.Hour).Add(entity.PreorderAuthorizationPeriod),
+	}
+	holds := entity.ProductInventoryHolds{
+		{OrderID: "order-id01", ProductID: "product-id01"},
+		{OrderID: "order-id01", ProductID: "product-id02"},
+		{OrderID: "order-id02", ProductID: "product-id01"},
+		{OrderID: "order-id03", ProductID: "product-id01"},
+		{OrderID: "order-id04", ProductID: "product-id01"},
+	}
+	order := func(orderID string, status entity.PaymentStatus) *entity.Order {
+		return &entity.Order{
+			ID:   orderID,
+			Type: entity.OrderTypeProduct,
+			OrderPayment: entity.OrderPayment{
+				OrderID: orderID,
+				Status:  status,
+			},
+		}
+	}
+	authorized := func(orderID string) *entity.Order {
+		order := order(orderID, entity.PaymentStatusAuthorized)
+		order.PaymentID = "payment-id"
+		order.ProviderType = entity.PaymentProviderTypeKomoju
+		order.PaidAt = now.Add(-time.Hour)
+		return order
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ReleaseExpiredProductInventoryHoldsInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(holds, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(entity.PromotionRedemptions{}, nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id01").Return(order("order-id01", entity.PaymentStatusPending), nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id02").Return(order("order-id02", entity.PaymentStatusCaptured), nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id03").Return(authorized("order-id03"), nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id04").Return(nil, database.ErrNotFound)
+				mocks.db.Order.EXPECT().Expire(ctx, "order-id01", expireParams).Return(nil)
+				mocks.db.Order.EXPECT().ExtendHolds(ctx, "order-id03", extendParams).Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Sell(ctx, "order-id02").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Confirm(ctx, "order-id02").Return(nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(ctx, "order-id04").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(ctx, "order-id04").Return(nil)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: nil,
+		},
+		{
+			name: "success to expire deferred payment order",
+			setup: func(ctx context.Context, mocks *mocks) {
+				holds := entity.ProductInventoryHolds{
+					{OrderID: "order-id01", ProductID: "product-id01"},
+				}
+				order := order("order-id01", entity.PaymentStatusPending)
+				order.PaymentID = "payment-id"
+				order.ProviderType = entity.PaymentProviderTypeKomoju
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(holds, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(entity.PromotionRedemptions{}, nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id01").Return(order, nil)
+				mocks.payment.EXPECT().CancelPayment(ctx, "payment-id").Return(nil)
+				mocks.db.Order.EXPECT().Expire(ctx, "order-id01", expireParams).Return(nil)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: nil,
+		},
+		{
+			name: "success already paid",
+			setup: func(ctx context.Context, mocks *mocks) {
+				holds := entity.ProductInventoryHolds{
+					{OrderID: "order-id01", ProductID: "product-id01"},
+				}
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(holds, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(entity.PromotionRedemptions{}, nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id01").Return(order("order-id01", entity.PaymentStatusPending), nil)
+				mocks.db.Order.EXPECT().Expire(ctx, "order-id01", expireParams).Return(database.ErrFailedPrecondition)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: nil,
+		},
+		{
+			name: "success to capture lapsing authorization",
+			setup: func(ctx context.Context, mocks *mocks) {
+				holds := entity.ProductInventoryHolds{
+					{OrderID: "order-id01", ProductID: "product-id01"},
+				}
+				order := authorized("order-id01")
+				order.PaidAt = now.Add(-entity.PreorderAuthorizationPeriod)
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(holds, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(entity.PromotionRedemptions{}, nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id01").Return(order, nil)
+				mocks.payment.EXPECT().ShowPayment(ctx, "payment-id").
+					Return(&payment.PaymentResult{Status: entity.PaymentStatusAuthorized}, nil)
+				mocks.payment.EXPECT().CapturePayment(ctx, "payment-id").Return(nil)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: nil,
+		},
+		{
+			name: "success to release experience slot reservations",
+			setup: func(ctx context.Context, mocks *mocks) {
+				reservations := entity.ExperienceSlotReservations{
+					{OrderID: "order-id01", SlotID: "slot-id"},
+					{OrderID: "order-id02", SlotID: "slot-id"},
+					{OrderID: "order-id03", SlotID: "slot-id"},
+					{OrderID: "order-id04", SlotID: "slot-id"},
+					{OrderID: "order-id05", SlotID: "slot-id"},
+				}
+				experience := func(orderID string, status entity.PaymentStatus) *entity.Order {
+					order := order(orderID, status)
+					order.Type = entity.OrderTypeExperience
+					return order
+				}
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(entity.ProductInventoryHolds{}, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(reservations, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(entity.PromotionRedemptions{}, nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id01").Return(experience("order-id01", entity.PaymentStatusPending), nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id02").Return(experience("order-id02", entity.PaymentStatusCaptured), nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id03").Return(authorized("order-id03"), nil)
+				mocks.db.Order.EXPECT().ExtendHolds(ctx, "order-id03", extendParams).Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id04").Return(nil, database.ErrNotFound)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id05").Return(experience("order-id05", entity.PaymentStatusFailed), nil)
+				mocks.db.Order.EXPECT().Expire(ctx, "order-id01", expireParams).Return(nil)
+				mocks.db.ExperienceSlot.EXPECT().Confirm(ctx, "order-id02").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Confirm(ctx, "order-id02").Return(nil)
+				mocks.db.ExperienceSlot.EXPECT().Release(ctx, "order-id04").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(ctx, "order-id04").Return(nil)
+				mocks.db.ExperienceSlot.EXPECT().Release(ctx, "order-id05").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(ctx, "order-id05").Return(nil)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: nil,
+		},
+		{
+			name: "success to release promotion redemptions",
+			setup: func(ctx context.Context, mocks *mocks) {
+				holds := entity.ProductInventoryHolds{
+					{OrderID: "order-id01", ProductID: "product-id01"},
+				}
+				redemptions := entity.PromotionRedemptions{
+					{OrderID: "order-id01", PromotionID: "promotion-id"},
+					{OrderID: "order-id02", PromotionID: "promotion-id"},
+					{OrderID: "order-id03", PromotionID: "promotion-id"},
+					{OrderID: "order-id04", PromotionID: "promotion-id"},
+					{OrderID: "order-id05", PromotionID: "promotion-id"},
+				}
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(holds, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(redemptions, nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id01").Return(order("order-id01", entity.PaymentStatusFailed), nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(ctx, "order-id01").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(ctx, "order-id01").Return(nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id02").Return(order("order-id02", entity.PaymentStatusPending), nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id03").Return(order("order-id03", entity.PaymentStatusCaptured), nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id04").Return(nil, database.ErrNotFound)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id05").Return(order("order-id05", entity.PaymentStatusCanceled), nil)
+				mocks.db.Order.EXPECT().Expire(ctx, "order-id02", expireParams).Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Confirm(ctx, "order-id03").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(ctx, "order-id04").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(ctx, "order-id05").Return(nil)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: nil,
+		},
+		{
+			name: "success empty",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(entity.ProductInventoryHolds{}, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(entity.PromotionRedemptions{}, nil)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: nil,
+		},
+		{
+			name: "failed to list holds",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(nil, assert.AnError)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list reservations",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(entity.ProductInventoryHolds{}, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(nil, assert.AnError)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list redemptions",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(entity.ProductInventoryHolds{}, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(nil, assert.AnError)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to release",
+			setup: func(ctx context.Context, mocks *mocks) {
+				holds := entity.ProductInventoryHolds{
+					{OrderID: "order-id01", ProductID: "product-id01"},
+					{OrderID: "order-id02", ProductID: "product-id01"},
+				}
+				mocks.db.ProductInventoryHold.EXPECT().List(ctx, params).Return(holds, nil)
+				mocks.db.ExperienceSlot.EXPECT().ListReservations(ctx, rparams).Return(entity.ExperienceSlotReservations{}, nil)
+				mocks.db.PromotionRedemption.EXPECT().List(ctx, pparams).Return(entity.PromotionRedemptions{}, nil)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id01").Return(nil, assert.AnError)
+				mocks.db.Order.EXPECT().Get(ctx, "order-id02").Return(order("order-id02", entity.PaymentStatusFailed), nil)
+				mocks.db.ProductInventoryHold.EXPECT().Release(ctx, "order-id02").Return(nil)
+				mocks.db.PromotionRedemption.EXPECT().Release(ctx, "order-id02").Return(nil)
+			},
+			input:     &store.ReleaseExpiredProductInventoryHoldsInput{},
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.ReleaseExpiredProductInventoryHolds(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
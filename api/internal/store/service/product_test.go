@@ -513,9 +513,10 @@ func TestCreateProduct(t *testing.T) {
 							StartAt:              now.AddDate(0, -1, 0),
 							EndAt:                now.AddDate(0, 1, 0),
 							ProductRevision: entity.ProductRevision{
-								ProductID: product.ID,
-								Price:     400,
-								Cost:      300,
+								ProductID:   product.ID,
+								Price:       400,
+								Cost:        300,
+								TaxCategory: entity.TaxCategoryStandard,
 							},
 						}
 						assert.Equal(t, expect, product)
@@ -107,13 +107,24 @@ func (s *service) GetPromotionByCode(ctx context.Context, in *store.GetPromotion
 	if err := s.validator.Struct(in); err != nil {
 		return nil, internalError(err)
 	}
-	promotion, err := s.db.Promotion.GetByCode(ctx, in.PromotionCode)
+	promotion, err := s.getPromotionByCode(ctx, in.PromotionCode)
+	if in.OnlyEnabled && errors.Is(err, entity.ErrPromotionCodeUnavailable) {
+		return nil, fmt.Errorf("this promotion code is unavailable: %w", exception.ErrNotFound)
+	}
 	if err != nil {
 		return nil, internalError(err)
 	}
 	if in.OnlyEnabled && !promotion.IsEnabled(in.ShopID) {
 		return nil, fmt.Errorf("this promotion is disabled: %w", exception.ErrNotFound)
 	}
+	err = s.verifyPromotionRedemption(ctx, promotion, in.UserID)
+	if in.OnlyEnabled && errors.Is(err, exception.ErrFailedPrecondition) {
+		// 利用可能なプロモーションのみ取得する場合、利用上限到達も未検出として扱う
+		return nil, fmt.Errorf("this promotion has reached its usage limit: %w", exception.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
 	return promotion, nil
 }
 
@@ -146,16 +157,20 @@ func (s *service) CreatePromotion(ctx context.Context, in *store.CreatePromotion
 	}
 
 	params := &entity.NewPromotionParams{
-		ShopID:       shopID,
-		Title:        in.Title,
-		Description:  in.Description,
-		Public:       in.Public,
-		DiscountType: in.DiscountType,
-		DiscountRate: in.DiscountRate,
-		Code:         in.Code,
-		CodeType:     in.CodeType,
-		StartAt:      in.StartAt,
-		EndAt:        in.EndAt,
+		ShopID:            shopID,
+		Title:             in.Title,
+		Description:       in.Description,
+		Public:            in.Public,
+		DiscountType:      in.DiscountType,
+		DiscountRate:      in.DiscountRate,
+		Rules:             newPromotionRules(in.Rules),
+		Code:              in.Code,
+		CodeType:          in.CodeType,
+		UsageLimit:        in.UsageLimit,
+		UsageLimitPerUser: in.UsageLimitPerUser,
+		FirstPurchaseOnly: in.FirstPurchaseOnly,
+		StartAt:           in.StartAt,
+		EndAt:             in.EndAt,
 	}
 	promotion := entity.NewPromotion(params)
 	if err := promotion.Validate(); err != nil {
@@ -171,6 +186,15 @@ func (s *service) UpdatePromotion(ctx context.Context, in *store.UpdatePromotion
 	if err := s.validator.Struct(in); err != nil {
 		return internalError(err)
 	}
+	// 割引内容の検証
+	discount := &entity.Promotion{
+		DiscountType: in.DiscountType,
+		DiscountRate: in.DiscountRate,
+		Rules:        newPromotionRules(in.Rules),
+	}
+	if err := discount.Validate(); err != nil {
+		return fmt.Errorf("api: validation error: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
 	adminIn := &user.GetAdminInput{
 		AdminID: in.AdminID,
 	}
@@ -208,15 +232,19 @@ func (s *service) UpdatePromotion(ctx context.Context, in *store.UpdatePromotion
 	}
 
 	params := &database.UpdatePromotionParams{
-		Title:        in.Title,
-		Description:  in.Description,
-		Public:       in.Public,
-		DiscountType: in.DiscountType,
-		DiscountRate: in.DiscountRate,
-		Code:         in.Code,
-		CodeType:     in.CodeType,
-		StartAt:      in.StartAt,
-		EndAt:        in.EndAt,
+		Title:             in.Title,
+		Description:       in.Description,
+		Public:            in.Public,
+		DiscountType:      discount.DiscountType,
+		DiscountRate:      discount.DiscountRate,
+		Rules:             discount.Rules,
+		Code:              in.Code,
+		CodeType:          in.CodeType,
+		UsageLimit:        in.UsageLimit,
+		UsageLimitPerUser: in.UsageLimitPerUser,
+		FirstPurchaseOnly: in.FirstPurchaseOnly,
+		StartAt:           in.StartAt,
+		EndAt:             in.EndAt,
 	}
 	err = s.db.Promotion.Update(ctx, in.PromotionID, params)
 	return internalError(err)
@@ -229,3 +257,87 @@ func (s *service) DeletePromotion(ctx context.Context, in *store.DeletePromotion
 	err := s.db.Promotion.Delete(ctx, in.PromotionID)
 	return internalError(err)
 }
+
+func (s *service) AggregatePromotionRedemptions(
+	ctx context.Context, in *store.AggregatePromotionRedemptionsInput,
+) (entity.AggregatedPromotionRedemptions, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.AggregatePromotionRedemptionsParams{
+		PromotionIDs: in.PromotionIDs,
+	}
+	redemptions, err := s.db.PromotionRedemption.Aggregate(ctx, params)
+	return redemptions, internalError(err)
+}
+
+// verifyPromotionRedemption - 利用回数の上限に達していないかを検証（確定は決済時に行う）
+func (s *service) verifyPromotionRedemption(ctx context.Context, promotion *entity.Promotion, userID string) error {
+	if !promotion.HasRedemptionLimit() {
+		return nil
+	}
+	usage, err := s.db.PromotionRedemption.GetUsage(ctx, promotion.ID, userID)
+	if err != nil {
+		return internalError(err)
+	}
+	if err := promotion.VerifyRedemption(usage); err != nil {
+		return fmt.Errorf("service: %s: %w", err.Error(), exception.ErrFailedPrecondition)
+	}
+	return nil
+}
+
+// getPromotionByCode - クーポンコードからプロモーションを取得（一括発行したコードも対象）
+func (s *service) getPromotionByCode(ctx context.Context, code string) (*entity.Promotion, error) {
+	promotion, err := s.db.Promotion.GetByCode(ctx, code)
+	if !errors.Is(err, database.ErrNotFound) {
+		return promotion, err
+	}
+	pcode, err := s.db.PromotionCode.Get(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if !pcode.IsEnabled() {
+		return nil, fmt.Errorf("service: this code has already been used: %w", entity.ErrPromotionCodeUnavailable)
+	}
+	return s.db.Promotion.Get(ctx, pcode.PromotionID)
+}
+
+func newPromotionRules(in []*store.PromotionRule) entity.PromotionRules {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make(entity.PromotionRules, len(in))
+	for i, rule := range in {
+		tiers := make(entity.PromotionRuleTiers, len(rule.Tiers))
+		for j, tier := range rule.Tiers {
+			tiers[j] = &entity.PromotionRuleTier{
+				Threshold: tier.Threshold,
+				Rate:      tier.Rate,
+			}
+		}
+		params := &entity.NewPromotionRuleParams{
+			Type:           rule.Type,
+			ProductIDs:     rule.ProductIDs,
+			ProductTypeIDs: rule.ProductTypeIDs,
+			CategoryIDs:    rule.CategoryIDs,
+			MinimumAmount:  rule.MinimumAmount,
+			DiscountRate:   rule.DiscountRate,
+			Tiers:          tiers,
+			BuyQuantity:    rule.BuyQuantity,
+			FreeQuantity:   rule.FreeQuantity,
+			MaxDiscount:    rule.MaxDiscount,
+		}
+		res[i] = entity.NewPromotionRule(params)
+	}
+	return res
+}
+
+// listPromotionProductTypes - カテゴリを対象とする適用ルールがある場合のみ、割引対象判定に必要な品目一覧を取得
+func (s *service) listPromotionProductTypes(
+	ctx context.Context, promotion *entity.Promotion, products entity.Products,
+) (entity.ProductTypes, error) {
+	if !promotion.HasCategoryRule() {
+		return nil, nil
+	}
+	return s.db.ProductType.MultiGet(ctx, products.ProductTypeIDs())
+}
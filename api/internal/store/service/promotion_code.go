@@ -0,0 +1,186 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	"github.com/and-period/furumaru/api/internal/store/exporter/coupon"
+	"golang.org/x/sync/errgroup"
+)
+
+// コード重複時の再生成回数
+const createPromotionCodeBatchRetries = 3
+
+func (s *service) ListPromotionCodeBatches(
+	ctx context.Context, in *store.ListPromotionCodeBatchesInput,
+) (entity.PromotionCodeBatches, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListPromotionCodeBatchesParams{
+		PromotionID: in.PromotionID,
+		Limit:       int(in.Limit),
+		Offset:      int(in.Offset),
+	}
+	var (
+		batches entity.PromotionCodeBatches
+		total   int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		batches, err = s.db.PromotionCodeBatch.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.PromotionCodeBatch.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return batches, total, nil
+}
+
+func (s *service) GetPromotionCodeBatch(
+	ctx context.Context, in *store.GetPromotionCodeBatchInput,
+) (*entity.PromotionCodeBatch, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	batch, err := s.db.PromotionCodeBatch.Get(ctx, in.BatchID)
+	return batch, internalError(err)
+}
+
+func (s *service) CreatePromotionCodeBatch(
+	ctx context.Context, in *store.CreatePromotionCodeBatchInput,
+) (*entity.PromotionCodeBatch, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	if _, err := s.db.Promotion.Get(ctx, in.PromotionID); err != nil {
+		return nil, internalError(err)
+	}
+	params := &entity.NewPromotionCodeBatchParams{
+		PromotionID: in.PromotionID,
+		Title:       in.Title,
+		Quantity:    in.Quantity,
+	}
+	batch := entity.NewPromotionCodeBatch(params)
+	var err error
+	for range createPromotionCodeBatchRetries {
+		var codes entity.PromotionCodes
+		codes, err = batch.NewCodes()
+		if err != nil {
+			return nil, internalError(err)
+		}
+		err = s.db.PromotionCodeBatch.Create(ctx, batch, codes)
+		if !errors.Is(err, database.ErrAlreadyExists) {
+			break
+		}
+		// 既存のコードと重複した場合は再生成する
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	return batch, nil
+}
+
+func (s *service) VoidPromotionCodeBatch(ctx context.Context, in *store.VoidPromotionCodeBatchInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	err := s.db.PromotionCodeBatch.Void(ctx, in.BatchID)
+	return internalError(err)
+}
+
+func (s *service) ListPromotionCodes(
+	ctx context.Context, in *store.ListPromotionCodesInput,
+) (entity.PromotionCodes, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListPromotionCodesParams{
+		BatchID:  in.BatchID,
+		Statuses: in.Statuses,
+		Limit:    int(in.Limit),
+		Offset:   int(in.Offset),
+	}
+	var (
+		codes entity.PromotionCodes
+		total int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		codes, err = s.db.PromotionCode.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.PromotionCode.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return codes, total, nil
+}
+
+func (s *service) ExportPromotionCodes(ctx context.Context, in *store.ExportPromotionCodesInput) ([]byte, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	batch, err := s.db.PromotionCodeBatch.Get(ctx, in.BatchID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	var (
+		promotion *entity.Promotion
+		codes     entity.PromotionCodes
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		promotion, err = s.db.Promotion.Get(ectx, batch.PromotionID)
+		return
+	})
+	eg.Go(func() (err error) {
+		params := &database.ListPromotionCodesParams{
+			BatchID: batch.ID,
+		}
+		codes, err = s.db.PromotionCode.List(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	payload := &coupon.ReceiptsParams{
+		Promotion: promotion,
+		Batch:     batch,
+		Codes:     codes,
+	}
+	buf := &bytes.Buffer{}
+	if err := s.exportPromotionCodes(buf, in, payload); err != nil {
+		return nil, internalError(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *service) exportPromotionCodes(
+	writer io.Writer, in *store.ExportPromotionCodesInput, params *coupon.ReceiptsParams,
+) error {
+	client := exporter.NewExporter(writer, in.EncodingType)
+	if err := client.WriteHeader(&coupon.Receipt{}); err != nil {
+		return err
+	}
+	receipts := coupon.NewReceipts(params)
+	for i := range receipts {
+		if err := client.WriteBody(receipts[i]); err != nil {
+			return err
+		}
+	}
+	return client.Flush()
+}
@@ -0,0 +1,515 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListPromotionCodeBatches(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	params := &database.ListPromotionCodeBatchesParams{
+		PromotionID: "promotion-id",
+		Limit:       20,
+		Offset:      0,
+	}
+	batches := entity.PromotionCodeBatches{
+		{
+			ID:          "batch-id",
+			PromotionID: "promotion-id",
+			Title:       "彦根マルシェ配布分",
+			Quantity:    100,
+			Status:      entity.PromotionCodeBatchStatusActive,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *store.ListPromotionCodeBatchesInput
+		expect      entity.PromotionCodeBatches
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().List(gomock.Any(), params).Return(batches, nil)
+				mocks.db.PromotionCodeBatch.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListPromotionCodeBatchesInput{
+				PromotionID: "promotion-id",
+				Limit:       20,
+				Offset:      0,
+			},
+			expect:      batches,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &store.ListPromotionCodeBatchesInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list batches",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.PromotionCodeBatch.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListPromotionCodeBatchesInput{
+				PromotionID: "promotion-id",
+				Limit:       20,
+				Offset:      0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+		{
+			name: "failed to count batches",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().List(gomock.Any(), params).Return(batches, nil)
+				mocks.db.PromotionCodeBatch.EXPECT().Count(gomock.Any(), params).Return(int64(0), assert.AnError)
+			},
+			input: &store.ListPromotionCodeBatchesInput{
+				PromotionID: "promotion-id",
+				Limit:       20,
+				Offset:      0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListPromotionCodeBatches(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}, withNow(now)))
+	}
+}
+
+func TestGetPromotionCodeBatch(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	batch := &entity.PromotionCodeBatch{
+		ID:          "batch-id",
+		PromotionID: "promotion-id",
+		Title:       "彦根マルシェ配布分",
+		Quantity:    100,
+		Status:      entity.PromotionCodeBatchStatusActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetPromotionCodeBatchInput
+		expect    *entity.PromotionCodeBatch
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().Get(ctx, "batch-id").Return(batch, nil)
+			},
+			input: &store.GetPromotionCodeBatchInput{
+				BatchID: "batch-id",
+			},
+			expect:    batch,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetPromotionCodeBatchInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get batch",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().Get(ctx, "batch-id").Return(nil, assert.AnError)
+			},
+			input: &store.GetPromotionCodeBatchInput{
+				BatchID: "batch-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetPromotionCodeBatch(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestCreatePromotionCodeBatch(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	promotion := &entity.Promotion{ID: "promotion-id"}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.CreatePromotionCodeBatchInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Promotion.EXPECT().Get(ctx, "promotion-id").Return(promotion, nil)
+				mocks.db.PromotionCodeBatch.EXPECT().
+					Create(ctx, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, batch *entity.PromotionCodeBatch, codes entity.PromotionCodes) error {
+						assert.Equal(t, "promotion-id", batch.PromotionID)
+						assert.Equal(t, "彦根マルシェ配布分", batch.Title)
+						assert.Equal(t, int64(3), batch.Quantity)
+						assert.Equal(t, entity.PromotionCodeBatchStatusActive, batch.Status)
+						assert.Len(t, codes, 3)
+						return nil
+					})
+			},
+			input: &store.CreatePromotionCodeBatchInput{
+				PromotionID: "promotion-id",
+				Title:       "彦根マルシェ配布分",
+				Quantity:    3,
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success after regenerate codes",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Promotion.EXPECT().Get(ctx, "promotion-id").Return(promotion, nil)
+				mocks.db.PromotionCodeBatch.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(database.ErrAlreadyExists)
+				mocks.db.PromotionCodeBatch.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			input: &store.CreatePromotionCodeBatchInput{
+				PromotionID: "promotion-id",
+				Title:       "彦根マルシェ配布分",
+				Quantity:    3,
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.CreatePromotionCodeBatchInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get promotion",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Promotion.EXPECT().Get(ctx, "promotion-id").Return(nil, assert.AnError)
+			},
+			input: &store.CreatePromotionCodeBatchInput{
+				PromotionID: "promotion-id",
+				Title:       "彦根マルシェ配布分",
+				Quantity:    3,
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to create batch",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Promotion.EXPECT().Get(ctx, "promotion-id").Return(promotion, nil)
+				mocks.db.PromotionCodeBatch.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			input: &store.CreatePromotionCodeBatchInput{
+				PromotionID: "promotion-id",
+				Title:       "彦根マルシェ配布分",
+				Quantity:    3,
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "exceeded retries",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Promotion.EXPECT().Get(ctx, "promotion-id").Return(promotion, nil)
+				mocks.db.PromotionCodeBatch.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(database.ErrAlreadyExists).Times(3)
+			},
+			input: &store.CreatePromotionCodeBatchInput{
+				PromotionID: "promotion-id",
+				Title:       "彦根マルシェ配布分",
+				Quantity:    3,
+			},
+			expectErr: exception.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CreatePromotionCodeBatch(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestVoidPromotionCodeBatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.VoidPromotionCodeBatchInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().Void(ctx, "batch-id").Return(nil)
+			},
+			input: &store.VoidPromotionCodeBatchInput{
+				BatchID: "batch-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.VoidPromotionCodeBatchInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "already voided",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().Void(ctx, "batch-id").Return(database.ErrFailedPrecondition)
+			},
+			input: &store.VoidPromotionCodeBatchInput{
+				BatchID: "batch-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.VoidPromotionCodeBatch(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}))
+	}
+}
+
+func TestListPromotionCodes(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	params := &database.ListPromotionCodesParams{
+		BatchID:  "batch-id",
+		Statuses: []entity.PromotionCodeStatus{entity.PromotionCodeStatusAvailable},
+		Limit:    20,
+		Offset:   0,
+	}
+	pcodes := entity.PromotionCodes{
+		{
+			Code:        "ABCD2345",
+			BatchID:     "batch-id",
+			PromotionID: "promotion-id",
+			Status:      entity.PromotionCodeStatusAvailable,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *store.ListPromotionCodesInput
+		expect      entity.PromotionCodes
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCode.EXPECT().List(gomock.Any(), params).Return(pcodes, nil)
+				mocks.db.PromotionCode.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListPromotionCodesInput{
+				BatchID:  "batch-id",
+				Statuses: []entity.PromotionCodeStatus{entity.PromotionCodeStatusAvailable},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      pcodes,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &store.ListPromotionCodesInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list codes",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCode.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.PromotionCode.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListPromotionCodesInput{
+				BatchID:  "batch-id",
+				Statuses: []entity.PromotionCodeStatus{entity.PromotionCodeStatusAvailable},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+		{
+			name: "failed to count codes",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCode.EXPECT().List(gomock.Any(), params).Return(pcodes, nil)
+				mocks.db.PromotionCode.EXPECT().Count(gomock.Any(), params).Return(int64(0), assert.AnError)
+			},
+			input: &store.ListPromotionCodesInput{
+				BatchID:  "batch-id",
+				Statuses: []entity.PromotionCodeStatus{entity.PromotionCodeStatusAvailable},
+				Limit:    20,
+				Offset:   0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListPromotionCodes(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}, withNow(now)))
+	}
+}
+
+func TestExportPromotionCodes(t *testing.T) {
+	t.Parallel()
+
+	now := jst.Date(2024, 1, 23, 18, 30, 0, 0)
+	batch := &entity.PromotionCodeBatch{
+		ID:          "batch-id",
+		PromotionID: "promotion-id",
+		Title:       "彦根マルシェ配布分",
+		Quantity:    1,
+		Status:      entity.PromotionCodeBatchStatusActive,
+	}
+	promotion := &entity.Promotion{
+		ID:      "promotion-id",
+		Title:   "夏の採れたて野菜マルシェ",
+		StartAt: now,
+		EndAt:   now.AddDate(0, 1, 0),
+	}
+	params := &database.ListPromotionCodesParams{
+		BatchID: "batch-id",
+	}
+	pcodes := entity.PromotionCodes{
+		{
+			Code:        "ABCD2345",
+			BatchID:     "batch-id",
+			PromotionID: "promotion-id",
+			Status:      entity.PromotionCodeStatusAvailable,
+		},
+	}
+	header := "クーポンコード,プロモーション名,用途,利用状況,利用開始日時,利用終了日時\n"
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ExportPromotionCodesInput
+		expect    string
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().Get(ctx, "batch-id").Return(batch, nil)
+				mocks.db.Promotion.EXPECT().Get(gomock.Any(), "promotion-id").Return(promotion, nil)
+				mocks.db.PromotionCode.EXPECT().List(gomock.Any(), params).Return(pcodes, nil)
+			},
+			input: &store.ExportPromotionCodesInput{
+				BatchID:      "batch-id",
+				EncodingType: codes.CharacterEncodingTypeUTF8,
+			},
+			expect: header +
+				"ABCD2345,夏の採れたて野菜マルシェ,彦根マルシェ配布分,未使用,2024-01-23 18:30:00,2024-02-23 18:30:00\n",
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ExportPromotionCodesInput{},
+			expect:    "",
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get batch",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().Get(ctx, "batch-id").Return(nil, assert.AnError)
+			},
+			input: &store.ExportPromotionCodesInput{
+				BatchID:      "batch-id",
+				EncodingType: codes.CharacterEncodingTypeUTF8,
+			},
+			expect:    "",
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to list codes",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionCodeBatch.EXPECT().Get(ctx, "batch-id").Return(batch, nil)
+				mocks.db.Promotion.EXPECT().Get(gomock.Any(), "promotion-id").Return(promotion, nil)
+				mocks.db.PromotionCode.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+			},
+			input: &store.ExportPromotionCodesInput{
+				BatchID:      "batch-id",
+				EncodingType: codes.CharacterEncodingTypeUTF8,
+			},
+			expect:    "",
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ExportPromotionCodes(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if tt.expectErr != nil {
+				return
+			}
+			require.NotNil(t, actual)
+			assert.Equal(t, tt.expect, string(actual))
+		}, withNow(now)))
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/and-period/furumaru/api/pkg/jst"
 	"go.uber.org/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListPromotions(t *testing.T) {
@@ -301,13 +302,71 @@ func TestGetPromotionByCode(t *testing.T) {
 			name: "success",
 			setup: func(ctx context.Context, mocks *mocks) {
 				mocks.db.Promotion.EXPECT().GetByCode(ctx, "code0001").Return(promotion, nil)
+				mocks.db.PromotionRedemption.EXPECT().GetUsage(ctx, "promotion-id", "user-id").Return(&entity.PromotionUsage{}, nil)
 			},
 			input: &store.GetPromotionByCodeInput{
 				PromotionCode: "code0001",
+				UserID:        "user-id",
 			},
 			expect:    promotion,
 			expectErr: nil,
 		},
+		{
+			name: "success without redemption limit",
+			setup: func(ctx context.Context, mocks *mocks) {
+				promotion := &entity.Promotion{ID: "promotion-id", CodeType: entity.PromotionCodeTypeAlways}
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "code0001").Return(promotion, nil)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "code0001",
+				UserID:        "user-id",
+			},
+			expect:    &entity.Promotion{ID: "promotion-id", CodeType: entity.PromotionCodeTypeAlways},
+			expectErr: nil,
+		},
+		{
+			name: "success with issued code",
+			setup: func(ctx context.Context, mocks *mocks) {
+				code := &entity.PromotionCode{Code: "ABCD2345", PromotionID: "promotion-id", Status: entity.PromotionCodeStatusAvailable}
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "ABCD2345").Return(nil, database.ErrNotFound)
+				mocks.db.PromotionCode.EXPECT().Get(ctx, "ABCD2345").Return(code, nil)
+				mocks.db.Promotion.EXPECT().Get(ctx, "promotion-id").Return(promotion, nil)
+				mocks.db.PromotionRedemption.EXPECT().GetUsage(ctx, "promotion-id", "user-id").Return(&entity.PromotionUsage{}, nil)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "ABCD2345",
+				UserID:        "user-id",
+			},
+			expect:    promotion,
+			expectErr: nil,
+		},
+		{
+			name: "issued code already redeemed",
+			setup: func(ctx context.Context, mocks *mocks) {
+				code := &entity.PromotionCode{Code: "ABCD2345", PromotionID: "promotion-id", Status: entity.PromotionCodeStatusRedeemed}
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "ABCD2345").Return(nil, database.ErrNotFound)
+				mocks.db.PromotionCode.EXPECT().Get(ctx, "ABCD2345").Return(code, nil)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "ABCD2345",
+				UserID:        "user-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "not found issued code",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "ABCD2345").Return(nil, database.ErrNotFound)
+				mocks.db.PromotionCode.EXPECT().Get(ctx, "ABCD2345").Return(nil, database.ErrNotFound)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "ABCD2345",
+				UserID:        "user-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
 		{
 			name:      "invalid argument",
 			setup:     func(ctx context.Context, mocks *mocks) {},
@@ -339,6 +398,69 @@ func TestGetPromotionByCode(t *testing.T) {
 			expect:    nil,
 			expectErr: exception.ErrNotFound,
 		},
+		{
+			name: "failed to get usage",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "code0001").Return(promotion, nil)
+				mocks.db.PromotionRedemption.EXPECT().GetUsage(ctx, "promotion-id", "user-id").Return(nil, assert.AnError)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "code0001",
+				UserID:        "user-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "exceeded redemption limit",
+			setup: func(ctx context.Context, mocks *mocks) {
+				usage := &entity.PromotionUsage{TotalCount: 10, UserCount: 1}
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "code0001").Return(promotion, nil)
+				mocks.db.PromotionRedemption.EXPECT().GetUsage(ctx, "promotion-id", "user-id").Return(usage, nil)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "code0001",
+				UserID:        "user-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "exceeded redemption limit with only enabled",
+			setup: func(ctx context.Context, mocks *mocks) {
+				promotion := &entity.Promotion{
+					ID:         "promotion-id",
+					Status:     entity.PromotionStatusEnabled,
+					TargetType: entity.PromotionTargetTypeAllShop,
+					CodeType:   entity.PromotionCodeTypeOnce,
+				}
+				usage := &entity.PromotionUsage{TotalCount: 10, UserCount: 1}
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "code0001").Return(promotion, nil)
+				mocks.db.PromotionRedemption.EXPECT().GetUsage(ctx, "promotion-id", "user-id").Return(usage, nil)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "code0001",
+				UserID:        "user-id",
+				OnlyEnabled:   true,
+			},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "issued code already redeemed with only enabled",
+			setup: func(ctx context.Context, mocks *mocks) {
+				code := &entity.PromotionCode{Code: "ABCD2345", PromotionID: "promotion-id", Status: entity.PromotionCodeStatusRedeemed}
+				mocks.db.Promotion.EXPECT().GetByCode(ctx, "ABCD2345").Return(nil, database.ErrNotFound)
+				mocks.db.PromotionCode.EXPECT().Get(ctx, "ABCD2345").Return(code, nil)
+			},
+			input: &store.GetPromotionByCodeInput{
+				PromotionCode: "ABCD2345",
+				UserID:        "user-id",
+				OnlyEnabled:   true,
+			},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -461,6 +583,87 @@ func TestCreatePromotion(t *testing.T) {
 			},
 			expectErr: nil,
 		},
+		{
+			name: "success with rules",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetAdmin(ctx, adminIn).Return(admin(uentity.AdminTypeAdministrator), nil)
+				mocks.db.Promotion.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, promotion *entity.Promotion) error {
+						require.Len(t, promotion.Rules, 1)
+						expect := &entity.Promotion{
+							ID:           promotion.ID, // ignore
+							ShopID:       "",
+							Title:        "プロモーションタイトル",
+							Description:  "プロモーションの詳細です。",
+							Public:       true,
+							TargetType:   entity.PromotionTargetTypeAllShop,
+							DiscountType: entity.DiscountTypeRule,
+							DiscountRate: 0,
+							Rules: entity.PromotionRules{
+								{
+									ID:          promotion.Rules[0].ID, // ignore
+									Type:        entity.PromotionRuleTypeTieredRate,
+									CategoryIDs: []string{"category-id"},
+									Tiers: entity.PromotionRuleTiers{
+										{Threshold: 5000, Rate: 5},
+										{Threshold: 10000, Rate: 10},
+									},
+									MaxDiscount: 3000,
+								},
+							},
+							Code:     "excode01",
+							CodeType: entity.PromotionCodeTypeAlways,
+							StartAt:  jst.Date(2022, 8, 1, 0, 0, 0, 0),
+							EndAt:    jst.Date(2022, 9, 1, 0, 0, 0, 0),
+						}
+						assert.Equal(t, expect, promotion)
+						return nil
+					})
+			},
+			input: &store.CreatePromotionInput{
+				AdminID:      "admin-id",
+				Title:        "プロモーションタイトル",
+				Description:  "プロモーションの詳細です。",
+				Public:       true,
+				DiscountType: entity.DiscountTypeRule,
+				Rules: []*store.PromotionRule{
+					{
+						Type:        entity.PromotionRuleTypeTieredRate,
+						CategoryIDs: []string{"category-id"},
+						Tiers: []*store.PromotionRuleTier{
+							{Threshold: 5000, Rate: 5},
+							{Threshold: 10000, Rate: 10},
+						},
+						MaxDiscount: 3000,
+					},
+				},
+				Code:     "excode01",
+				CodeType: entity.PromotionCodeTypeAlways,
+				StartAt:  jst.Date(2022, 8, 1, 0, 0, 0, 0),
+				EndAt:    jst.Date(2022, 9, 1, 0, 0, 0, 0),
+			},
+			expectErr: nil,
+		},
+		{
+			name: "invalid promotion rules",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetAdmin(ctx, adminIn).Return(admin(uentity.AdminTypeAdministrator), nil)
+			},
+			input: &store.CreatePromotionInput{
+				AdminID:      "admin-id",
+				Title:        "プロモーションタイトル",
+				Description:  "プロモーションの詳細です。",
+				Public:       true,
+				DiscountType: entity.DiscountTypeRule,
+				Rules:        []*store.PromotionRule{},
+				Code:         "excode01",
+				CodeType:     entity.PromotionCodeTypeAlways,
+				StartAt:      jst.Date(2022, 8, 1, 0, 0, 0, 0),
+				EndAt:        jst.Date(2022, 9, 1, 0, 0, 0, 0),
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
 		{
 			name:      "invalid argument",
 			setup:     func(ctx context.Context, mocks *mocks) {},
@@ -693,6 +896,26 @@ func TestUpdatePromotion(t *testing.T) {
 			input:     &store.UpdatePromotionInput{},
 			expectErr: exception.ErrInvalidArgument,
 		},
+		{
+			name:  "invalid promotion rules",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.UpdatePromotionInput{
+				AdminID:      "admin-id",
+				PromotionID:  "promotion-id",
+				Title:        "プロモーションタイトル",
+				Description:  "プロモーションの詳細です。",
+				Public:       true,
+				DiscountType: entity.DiscountTypeRule,
+				Rules: []*store.PromotionRule{
+					{Type: entity.PromotionRuleTypeBuyXGetY, BuyQuantity: 2},
+				},
+				Code:     "excode01",
+				CodeType: entity.PromotionCodeTypeAlways,
+				StartAt:  jst.Date(2022, 8, 1, 0, 0, 0, 0),
+				EndAt:    jst.Date(2022, 9, 1, 0, 0, 0, 0),
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
 		{
 			name: "failed to get admin",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -899,3 +1122,65 @@ func TestDeletePromotion(t *testing.T) {
 		}))
 	}
 }
+
+func TestAggregatePromotionRedemptions(t *testing.T) {
+	t.Parallel()
+
+	params := &database.AggregatePromotionRedemptionsParams{
+		PromotionIDs: []string{"promotion-id"},
+	}
+	redemptions := entity.AggregatedPromotionRedemptions{
+		{
+			PromotionID:     "promotion-id",
+			RedemptionCount: 2,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.AggregatePromotionRedemptionsInput
+		expect    entity.AggregatedPromotionRedemptions
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionRedemption.EXPECT().Aggregate(ctx, params).Return(redemptions, nil)
+			},
+			input: &store.AggregatePromotionRedemptionsInput{
+				PromotionIDs: []string{"promotion-id"},
+			},
+			expect:    redemptions,
+			expectErr: nil,
+		},
+		{
+			name:  "invalid argument",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.AggregatePromotionRedemptionsInput{
+				PromotionIDs: []string{""},
+			},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to aggregate",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.PromotionRedemption.EXPECT().Aggregate(ctx, params).Return(nil, assert.AnError)
+			},
+			input: &store.AggregatePromotionRedemptionsInput{
+				PromotionIDs: []string{"promotion-id"},
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.AggregatePromotionRedemptions(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
@@ -14,11 +14,13 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/database"
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
 	"github.com/and-period/furumaru/api/internal/user"
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
 	"github.com/and-period/furumaru/api/pkg/geolocation"
 	"github.com/and-period/furumaru/api/pkg/ivs"
 	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/pdf"
 	"github.com/and-period/furumaru/api/pkg/postalcode"
 	"github.com/and-period/furumaru/api/pkg/uuid"
 	"github.com/and-period/furumaru/api/pkg/validator"
@@ -29,8 +31,10 @@ import (
 var errUnmatchProducts = errors.New("service: umnatch products")
 
 const (
-	defaultCartTTL             = 14 * 24 * time.Hour // 14days
-	defaultCartRefreshInterval = 2 * time.Hour       // 2hours
+	defaultCartTTL                  = 14 * 24 * time.Hour // 14days
+	defaultCartRefreshInterval      = 2 * time.Hour       // 2hours
+	defaultInventoryHoldTTL         = 30 * time.Minute    // 30minutes
+	defaultDeferredInventoryHoldTTL = 7 * 24 * time.Hour  // 7days
 )
 
 type Params struct {
@@ -44,6 +48,10 @@ type Params struct {
 	Geolocation geolocation.Client
 	Ivs         ivs.Client
 	Providers   map[entity.PaymentProviderType]payment.Provider
+	Trackers    map[entity.ShippingCarrier]tracker.Tracker
+	PDFFont     *pdf.Font
+	// 精算時に差し引く販売手数料率(%)
+	SettlementCommissionRate float64
 }
 
 type service struct {
@@ -61,13 +69,20 @@ type service struct {
 	geolocation         geolocation.Client
 	ivs                 ivs.Client
 	providers           map[entity.PaymentProviderType]payment.Provider
+	trackers            map[entity.ShippingCarrier]tracker.Tracker
+	pdfFont             *pdf.Font
+	commissionRate      float64
 	cartTTL             time.Duration
 	cartRefreshInterval time.Duration
+	inventoryHoldTTL    time.Duration
+	deferredHoldTTL     time.Duration
 }
 
 type options struct {
 	cartTTL             time.Duration
 	cartRefreshInterval time.Duration
+	inventoryHoldTTL    time.Duration
+	deferredHoldTTL     time.Duration
 }
 
 type Option func(*options)
@@ -84,10 +99,24 @@ func WithCartRefreshInterval(interval time.Duration) Option {
 	}
 }
 
+func WithInventoryHoldTTL(ttl time.Duration) Option {
+	return func(opts *options) {
+		opts.inventoryHoldTTL = ttl
+	}
+}
+
+func WithDeferredInventoryHoldTTL(ttl time.Duration) Option {
+	return func(opts *options) {
+		opts.deferredHoldTTL = ttl
+	}
+}
+
 func NewService(params *Params, opts ...Option) store.Service {
 	dopts := &options{
 		cartTTL:             defaultCartTTL,
 		cartRefreshInterval: defaultCartRefreshInterval,
+		inventoryHoldTTL:    defaultInventoryHoldTTL,
+		deferredHoldTTL:     defaultDeferredInventoryHoldTTL,
 	}
 	for i := range opts {
 		opts[i](dopts)
@@ -96,6 +125,10 @@ func NewService(params *Params, opts ...Option) store.Service {
 	if providers == nil {
 		providers = make(map[entity.PaymentProviderType]payment.Provider)
 	}
+	trackers := params.Trackers
+	if trackers == nil {
+		trackers = make(map[entity.ShippingCarrier]tracker.Tracker)
+	}
 	return &service{
 		now: jst.Now,
 		generateID: func() string {
@@ -113,8 +146,13 @@ func NewService(params *Params, opts ...Option) store.Service {
 		geolocation:         params.Geolocation,
 		ivs:                 params.Ivs,
 		providers:           providers,
+		trackers:            trackers,
+		pdfFont:             params.PDFFont,
+		commissionRate:      params.SettlementCommissionRate,
 		cartTTL:             dopts.cartTTL,
 		cartRefreshInterval: defaultCartRefreshInterval,
+		inventoryHoldTTL:    dopts.inventoryHoldTTL,
+		deferredHoldTTL:     dopts.deferredHoldTTL,
 	}
 }
 
@@ -142,6 +180,15 @@ func (s *service) getProviderByType(providerType entity.PaymentProviderType) (pa
 	return prov, nil
 }
 
+// getTracker は配送会社から配送状況の取得に利用するクライアントを返す。
+func (s *service) getTracker(carrier entity.ShippingCarrier) (tracker.Tracker, error) {
+	t, ok := s.trackers[carrier]
+	if !ok {
+		return nil, fmt.Errorf("service: unsupported shipping carrier: %d: %w", carrier, exception.ErrFailedPrecondition)
+	}
+	return t, nil
+}
+
 func (s *service) isRetryable(err error) bool {
 	return errors.Is(err, exception.ErrDeadlineExceeded) ||
 		errors.Is(err, exception.ErrInternal) ||
@@ -260,8 +307,22 @@ func entityError(err error) error {
 	}
 
 	switch {
+	case errors.Is(err, entity.ErrInvalidExperienceSlotCapacity),
+		errors.Is(err, entity.ErrInvalidExperienceSlotTime),
+		errors.Is(err, entity.ErrUnmatchExperienceSlot),
+		errors.Is(err, entity.ErrInvalidOrderRefund),
+		errors.Is(err, entity.ErrInvalidOrderClaim),
+		errors.Is(err, entity.ErrInvalidSubscription):
+		return exception.ErrInvalidArgument
 	case errors.Is(err, entity.ErrNotFoundShippingRate),
-		errors.Is(err, entity.ErrUnknownShippingSize):
+		errors.Is(err, entity.ErrUnknownShippingSize),
+		errors.Is(err, entity.ErrUndeliverableShippingType),
+		errors.Is(err, entity.ErrExperienceSlotNotAccepting),
+		errors.Is(err, entity.ErrInsufficientExperienceCapacity),
+		errors.Is(err, entity.ErrPromotionCodeUnavailable),
+		errors.Is(err, entity.ErrOrderRefundExceeded),
+		errors.Is(err, entity.ErrOrderClaimUnavailable),
+		errors.Is(err, entity.ErrSubscriptionUnavailable):
 		return exception.ErrFailedPrecondition
 	default:
 		return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/and-period/furumaru/api/internal/store/database"
 	"github.com/and-period/furumaru/api/internal/store/entity"
 	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
 	mock_media "github.com/and-period/furumaru/api/mock/media"
 	mock_messenger "github.com/and-period/furumaru/api/mock/messenger"
 	mock_dynamodb "github.com/and-period/furumaru/api/mock/pkg/dynamodb"
@@ -18,6 +19,7 @@ import (
 	mock_postalcode "github.com/and-period/furumaru/api/mock/pkg/postalcode"
 	mock_database "github.com/and-period/furumaru/api/mock/store/database"
 	mock_payment "github.com/and-period/furumaru/api/mock/store/payment"
+	mock_tracker "github.com/and-period/furumaru/api/mock/store/tracker"
 	mock_user "github.com/and-period/furumaru/api/mock/user"
 	"github.com/and-period/furumaru/api/pkg/dynamodb"
 	"github.com/and-period/furumaru/api/pkg/jst"
@@ -38,28 +40,42 @@ type mocks struct {
 	geolocation   *mock_geolocation.MockClient
 	ivs           *mock_ivs.MockClient
 	payment *mock_payment.MockProvider
+	tracker *mock_tracker.MockTracker
 }
 
 type dbMocks struct {
 	CartActionLog            *mock_database.MockCartActionLog
 	Category                 *mock_database.MockCategory
+	DeliverySlotRule         *mock_database.MockDeliverySlotRule
 	Experience               *mock_database.MockExperience
 	ExperienceReview         *mock_database.MockExperienceReview
 	ExperienceReviewReaction *mock_database.MockExperienceReviewReaction
+	ExperienceSlot           *mock_database.MockExperienceSlot
 	ExperienceType           *mock_database.MockExperienceType
 	Live                     *mock_database.MockLive
+	LivePin                  *mock_database.MockLivePin
 	Order                    *mock_database.MockOrder
+	OrderClaim               *mock_database.MockOrderClaim
+	OrderRefundLine          *mock_database.MockOrderRefundLine
+	PaymentEvent             *mock_database.MockPaymentEvent
 	PaymentSystem            *mock_database.MockPaymentSystem
+	PreorderBatch            *mock_database.MockPreorderBatch
 	Product                  *mock_database.MockProduct
+	ProductInventoryHold     *mock_database.MockProductInventoryHold
 	ProductReview            *mock_database.MockProductReview
 	ProductReviewReaction    *mock_database.MockProductReviewReaction
 	ProductTag               *mock_database.MockProductTag
 	ProductType              *mock_database.MockProductType
 	Promotion                *mock_database.MockPromotion
+	PromotionRedemption      *mock_database.MockPromotionRedemption
+	PromotionCode            *mock_database.MockPromotionCode
+	PromotionCodeBatch       *mock_database.MockPromotionCodeBatch
 	Schedule                 *mock_database.MockSchedule
+	SettlementStatement      *mock_database.MockSettlementStatement
 	Shipping                 *mock_database.MockShipping
 	Spot                     *mock_database.MockSpot
 	SpotType                 *mock_database.MockSpotType
+	Subscription             *mock_database.MockSubscription
 }
 
 type testOptions struct {
@@ -89,6 +105,7 @@ func newMocks(ctrl *gomock.Controller) *mocks {
 		geolocation:   mock_geolocation.NewMockClient(ctrl),
 		ivs:           mock_ivs.NewMockClient(ctrl),
 		payment: mock_payment.NewMockProvider(ctrl),
+		tracker: mock_tracker.NewMockTracker(ctrl),
 	}
 }
 
@@ -96,23 +113,36 @@ func newDBMocks(ctrl *gomock.Controller) *dbMocks {
 	return &dbMocks{
 		CartActionLog:            mock_database.NewMockCartActionLog(ctrl),
 		Category:                 mock_database.NewMockCategory(ctrl),
+		DeliverySlotRule:         mock_database.NewMockDeliverySlotRule(ctrl),
 		Experience:               mock_database.NewMockExperience(ctrl),
 		ExperienceReview:         mock_database.NewMockExperienceReview(ctrl),
 		ExperienceReviewReaction: mock_database.NewMockExperienceReviewReaction(ctrl),
+		ExperienceSlot:           mock_database.NewMockExperienceSlot(ctrl),
 		ExperienceType:           mock_database.NewMockExperienceType(ctrl),
 		Live:                     mock_database.NewMockLive(ctrl),
+		LivePin:                  mock_database.NewMockLivePin(ctrl),
 		Order:                    mock_database.NewMockOrder(ctrl),
+		OrderClaim:               mock_database.NewMockOrderClaim(ctrl),
+		OrderRefundLine:          mock_database.NewMockOrderRefundLine(ctrl),
+		PaymentEvent:             mock_database.NewMockPaymentEvent(ctrl),
 		PaymentSystem:            mock_database.NewMockPaymentSystem(ctrl),
+		PreorderBatch:            mock_database.NewMockPreorderBatch(ctrl),
 		Product:                  mock_database.NewMockProduct(ctrl),
+		ProductInventoryHold:     mock_database.NewMockProductInventoryHold(ctrl),
 		ProductReview:            mock_database.NewMockProductReview(ctrl),
 		ProductReviewReaction:    mock_database.NewMockProductReviewReaction(ctrl),
 		ProductTag:               mock_database.NewMockProductTag(ctrl),
 		ProductType:              mock_database.NewMockProductType(ctrl),
 		Promotion:                mock_database.NewMockPromotion(ctrl),
+		PromotionRedemption:      mock_database.NewMockPromotionRedemption(ctrl),
+		PromotionCode:            mock_database.NewMockPromotionCode(ctrl),
+		PromotionCodeBatch:       mock_database.NewMockPromotionCodeBatch(ctrl),
 		Schedule:                 mock_database.NewMockSchedule(ctrl),
+		SettlementStatement:      mock_database.NewMockSettlementStatement(ctrl),
 		Shipping:                 mock_database.NewMockShipping(ctrl),
 		Spot:                     mock_database.NewMockSpot(ctrl),
 		SpotType:                 mock_database.NewMockSpotType(ctrl),
+		Subscription:             mock_database.NewMockSubscription(ctrl),
 	}
 }
 
@@ -128,23 +158,36 @@ func newService(mocks *mocks, opts ...testOption) *service {
 		Database: &database.Database{
 			CartActionLog:            mocks.db.CartActionLog,
 			Category:                 mocks.db.Category,
+			DeliverySlotRule:         mocks.db.DeliverySlotRule,
 			Experience:               mocks.db.Experience,
 			ExperienceReview:         mocks.db.ExperienceReview,
 			ExperienceReviewReaction: mocks.db.ExperienceReviewReaction,
+			ExperienceSlot:           mocks.db.ExperienceSlot,
 			ExperienceType:           mocks.db.ExperienceType,
 			Live:                     mocks.db.Live,
+			LivePin:                  mocks.db.LivePin,
 			Order:                    mocks.db.Order,
+			OrderClaim:               mocks.db.OrderClaim,
+			OrderRefundLine:          mocks.db.OrderRefundLine,
+			PaymentEvent:             mocks.db.PaymentEvent,
 			PaymentSystem:            mocks.db.PaymentSystem,
+			PreorderBatch:            mocks.db.PreorderBatch,
 			Product:                  mocks.db.Product,
+			ProductInventoryHold:     mocks.db.ProductInventoryHold,
 			ProductReview:            mocks.db.ProductReview,
 			ProductReviewReaction:    mocks.db.ProductReviewReaction,
 			ProductTag:               mocks.db.ProductTag,
 			ProductType:              mocks.db.ProductType,
 			Promotion:                mocks.db.Promotion,
+			PromotionRedemption:      mocks.db.PromotionRedemption,
+			PromotionCode:            mocks.db.PromotionCode,
+			PromotionCodeBatch:       mocks.db.PromotionCodeBatch,
 			Schedule:                 mocks.db.Schedule,
+			SettlementStatement:      mocks.db.SettlementStatement,
 			Shipping:                 mocks.db.Shipping,
 			Spot:                     mocks.db.Spot,
 			SpotType:                 mocks.db.SpotType,
+			Subscription:             mocks.db.Subscription,
 		},
 		Cache:       mocks.cache,
 		User:        mocks.user,
@@ -157,6 +200,11 @@ func newService(mocks *mocks, opts ...testOption) *service {
 			entity.PaymentProviderTypeKomoju: mocks.payment,
 			entity.PaymentProviderTypeStripe: mocks.payment,
 		},
+		Trackers: map[entity.ShippingCarrier]tracker.Tracker{
+			entity.ShippingCarrierYamato:    mocks.tracker,
+			entity.ShippingCarrierSagawa:    mocks.tracker,
+			entity.ShippingCarrierJapanPost: mocks.tracker,
+		},
 	}
 	service := NewService(params).(*service)
 	service.now = func() time.Time {
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/exporter"
+	"github.com/and-period/furumaru/api/internal/store/exporter/settlement"
+	"github.com/and-period/furumaru/api/internal/store/statement"
+	"github.com/and-period/furumaru/api/internal/user"
+	"github.com/and-period/furumaru/api/pkg/pdf"
+	"golang.org/x/sync/errgroup"
+)
+
+const settlementTargetsLimit = 500 // 精算対象の注文の取得件数（1回あたり）
+
+func (s *service) ListSettlementStatements(
+	ctx context.Context, in *store.ListSettlementStatementsInput,
+) (entity.SettlementStatements, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListSettlementStatementsParams{
+		PayeeType:     in.PayeeType,
+		PayeeID:       in.PayeeID,
+		PeriodStartAt: in.PeriodStartAt,
+		Statuses:      in.Statuses,
+		Limit:         int(in.Limit),
+		Offset:        int(in.Offset),
+	}
+	var (
+		statements entity.SettlementStatements
+		total      int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		statements, err = s.db.SettlementStatement.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.SettlementStatement.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return statements, total, nil
+}
+
+func (s *service) GetSettlementStatement(
+	ctx context.Context, in *store.GetSettlementStatementInput,
+) (*entity.SettlementStatement, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	statement, err := s.db.SettlementStatement.Get(ctx, in.SettlementStatementID)
+	return statement, internalError(err)
+}
+
+func (s *service) CloseSettlementPeriod(
+	ctx context.Context, in *store.CloseSettlementPeriodInput,
+) (entity.SettlementStatements, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	if in.PeriodEndAt.After(s.now()) {
+		return nil, fmt.Errorf("service: settlement period has not ended yet: %w", exception.ErrFailedPrecondition)
+	}
+	orders, err := s.listSettlementTargets(ctx, in.PeriodEndAt)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	var (
+		products entity.Products
+		systems  entity.PaymentSystems
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		revisionIDs := orders.ProductRevisionIDs()
+		if len(revisionIDs) == 0 {
+			return
+		}
+		products, err = s.db.Product.MultiGetByRevision(ectx, revisionIDs)
+		return
+	})
+	eg.Go(func() (err error) {
+		methodTypes := orders.PaymentMethodTypes()
+		if len(methodTypes) == 0 {
+			return
+		}
+		systems, err = s.db.PaymentSystem.MultiGet(ectx, methodTypes)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	sparams := &entity.NewSettlementStatementsParams{
+		PeriodStartAt:  in.PeriodStartAt,
+		PeriodEndAt:    in.PeriodEndAt,
+		CommissionRate: s.commissionRate,
+		Orders:         orders,
+		Products:       products.MapByRevision(),
+		PaymentSystems: systems,
+	}
+	statements := entity.NewSettlementStatements(sparams)
+	cparams := &database.CloseSettlementPeriodParams{
+		PeriodStartAt: in.PeriodStartAt,
+		PeriodEndAt:   in.PeriodEndAt,
+		Statements:    statements,
+		Orders:        orders,
+		SettledAt:     s.now(),
+	}
+	if err := s.db.SettlementStatement.Close(ctx, cparams); err != nil {
+		return nil, internalError(err)
+	}
+	return statements, nil
+}
+
+// listSettlementTargets - 精算対象の注文を一定件数ずつ取得する
+func (s *service) listSettlementTargets(ctx context.Context, endAt time.Time) (entity.Orders, error) {
+	res := entity.Orders{}
+	for offset := 0; ; offset += settlementTargetsLimit {
+		params := &database.ListSettlementTargetOrdersParams{
+			CapturedAtLt: endAt,
+			Limit:        settlementTargetsLimit,
+			Offset:       offset,
+		}
+		orders, err := s.db.Order.ListSettlementTargets(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, orders...)
+		if len(orders) < settlementTargetsLimit {
+			return res, nil
+		}
+	}
+}
+
+func (s *service) PaySettlementStatement(ctx context.Context, in *store.PaySettlementStatementInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	err := s.db.SettlementStatement.Pay(ctx, in.SettlementStatementID, s.now())
+	return internalError(err)
+}
+
+func (s *service) AggregateSettlementBalances(
+	ctx context.Context, in *store.AggregateSettlementBalancesInput,
+) (entity.SettlementBalances, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.AggregateSettlementBalancesParams{
+		PayeeType: in.PayeeType,
+		PayeeID:   in.PayeeID,
+	}
+	balances, err := s.db.SettlementStatement.AggregateBalances(ctx, params)
+	return balances, internalError(err)
+}
+
+func (s *service) ExportSettlementStatements(ctx context.Context, in *store.ExportSettlementStatementsInput) ([]byte, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	params := &database.ListSettlementStatementsParams{
+		PayeeType:     in.PayeeType,
+		PeriodStartAt: in.PeriodStartAt,
+	}
+	statements, err := s.db.SettlementStatement.List(ctx, params)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	names, err := s.getSettlementPayeeNames(ctx, statements)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	buf := &bytes.Buffer{}
+	client := exporter.NewExporter(buf, in.EncodingType)
+	if err := client.WriteHeader(&settlement.Receipt{}); err != nil {
+		return nil, internalError(err)
+	}
+	receipts := settlement.NewReceipts(&settlement.ReceiptsParams{
+		Statements: statements,
+		PayeeNames: names,
+	})
+	for _, receipt := range receipts {
+		if err := client.WriteBody(receipt); err != nil {
+			return nil, internalError(err)
+		}
+	}
+	if err := client.Flush(); err != nil {
+		return nil, internalError(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *service) ExportSettlementStatement(ctx context.Context, in *store.ExportSettlementStatementInput) ([]byte, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	st, err := s.db.SettlementStatement.Get(ctx, in.SettlementStatementID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	names, err := s.getSettlementPayeeNames(ctx, entity.SettlementStatements{st})
+	if err != nil {
+		return nil, internalError(err)
+	}
+	params := &statement.Params{
+		Statement: st,
+		PayeeName: names[st.PayeeID],
+		Now:       s.now(),
+	}
+	buf := &bytes.Buffer{}
+	if err := statement.NewStatement(params).Write(buf, pdf.WithFont(s.pdfFont)); err != nil {
+		return nil, internalError(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// getSettlementPayeeNames - 精算先ID -> 精算先名（店舗名・コーディネータ名・生産者名）
+func (s *service) getSettlementPayeeNames(
+	ctx context.Context, statements entity.SettlementStatements,
+) (map[string]string, error) {
+	var (
+		shopNames        map[string]string
+		coordinatorNames map[string]string
+		producerNames    map[string]string
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		shopIDs := statements.PayeeIDs(entity.SettlementPayeeTypeShop)
+		if len(shopIDs) == 0 {
+			return nil
+		}
+		shops, err := s.user.MultiGetShops(ectx, &user.MultiGetShopsInput{ShopIDs: shopIDs})
+		if err != nil {
+			return err
+		}
+		shopNames = make(map[string]string, len(shops))
+		for _, shop := range shops {
+			shopNames[shop.ID] = shop.Name
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		coordinatorIDs := statements.PayeeIDs(entity.SettlementPayeeTypeCoordinator)
+		if len(coordinatorIDs) == 0 {
+			return nil
+		}
+		in := &user.MultiGetCoordinatorsInput{
+			CoordinatorIDs: coordinatorIDs,
+			WithDeleted:    true,
+		}
+		coordinators, err := s.user.MultiGetCoordinators(ectx, in)
+		if err != nil {
+			return err
+		}
+		coordinatorNames = make(map[string]string, len(coordinators))
+		for _, coordinator := range coordinators {
+			coordinatorNames[coordinator.AdminID] = coordinator.Username
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		producerIDs := statements.PayeeIDs(entity.SettlementPayeeTypeProducer)
+		if len(producerIDs) == 0 {
+			return nil
+		}
+		in := &user.MultiGetProducersInput{
+			ProducerIDs: producerIDs,
+			WithDeleted: true,
+		}
+		producers, err := s.user.MultiGetProducers(ectx, in)
+		if err != nil {
+			return err
+		}
+		producerNames = make(map[string]string, len(producers))
+		for _, producer := range producers {
+			producerNames[producer.AdminID] = producer.Username
+		}
+		return nil
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	res := make(map[string]string, len(statements))
+	maps.Copy(res, shopNames)
+	maps.Copy(res, coordinatorNames)
+	maps.Copy(res, producerNames)
+	return res, nil
+}
@@ -0,0 +1,676 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/user"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSettlementStatements(t *testing.T) {
+	t.Parallel()
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	params := &database.ListSettlementStatementsParams{
+		PayeeType:     entity.SettlementPayeeTypeShop,
+		PeriodStartAt: startAt,
+		Statuses:      []entity.SettlementStatementStatus{entity.SettlementStatementStatusUnpaid},
+		Limit:         20,
+		Offset:        0,
+	}
+	statements := entity.SettlementStatements{
+		{
+			ID:            "statement-id",
+			PayeeType:     entity.SettlementPayeeTypeShop,
+			PayeeID:       "shop-id",
+			Status:        entity.SettlementStatementStatusUnpaid,
+			PeriodStartAt: startAt,
+			PeriodEndAt:   startAt.AddDate(0, 1, 0),
+		},
+	}
+	input := &store.ListSettlementStatementsInput{
+		PayeeType:     entity.SettlementPayeeTypeShop,
+		PeriodStartAt: startAt,
+		Statuses:      []entity.SettlementStatementStatus{entity.SettlementStatementStatusUnpaid},
+		Limit:         20,
+		Offset:        0,
+	}
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *store.ListSettlementStatementsInput
+		expect      entity.SettlementStatements
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().List(gomock.Any(), params).Return(statements, nil)
+				mocks.db.SettlementStatement.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input:       input,
+			expect:      statements,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:        "invalid argument",
+			setup:       func(ctx context.Context, mocks *mocks) {},
+			input:       &store.ListSettlementStatementsInput{},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list settlement statements",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.SettlementStatement.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input:       input,
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+		{
+			name: "failed to count settlement statements",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().List(gomock.Any(), params).Return(statements, nil)
+				mocks.db.SettlementStatement.EXPECT().Count(gomock.Any(), params).Return(int64(0), assert.AnError)
+			},
+			input:       input,
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListSettlementStatements(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}))
+	}
+}
+
+func TestGetSettlementStatement(t *testing.T) {
+	t.Parallel()
+	statement := &entity.SettlementStatement{
+		ID:        "statement-id",
+		PayeeType: entity.SettlementPayeeTypeShop,
+		PayeeID:   "shop-id",
+		Status:    entity.SettlementStatementStatusUnpaid,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetSettlementStatementInput
+		expect    *entity.SettlementStatement
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Get(ctx, "statement-id").Return(statement, nil)
+			},
+			input:     &store.GetSettlementStatementInput{SettlementStatementID: "statement-id"},
+			expect:    statement,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetSettlementStatementInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get settlement statement",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Get(ctx, "statement-id").Return(nil, assert.AnError)
+			},
+			input:     &store.GetSettlementStatementInput{SettlementStatementID: "statement-id"},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetSettlementStatement(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestCloseSettlementPeriod(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	endAt := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	ordersParams := &database.ListSettlementTargetOrdersParams{
+		CapturedAtLt: endAt,
+		Limit:        settlementTargetsLimit,
+		Offset:       0,
+	}
+	orders := entity.Orders{
+		{
+			ID:            "order-id",
+			ShopID:        "shop-id",
+			CoordinatorID: "coordinator-id",
+			OrderPayment: entity.OrderPayment{
+				MethodType:  entity.PaymentMethodTypeCreditCard,
+				Subtotal:    3000,
+				ShippingFee: 500,
+				Total:       3500,
+				CapturedAt:  startAt.AddDate(0, 0, 1),
+			},
+			OrderItems: entity.OrderItems{
+				{ProductRevisionID: 1, Quantity: 3},
+			},
+		},
+	}
+	products := entity.Products{
+		{
+			ID:              "product-id",
+			ProducerID:      "producer-id",
+			ProductRevision: entity.ProductRevision{ID: 1, Price: 1000},
+		},
+	}
+	systems := entity.PaymentSystems{
+		{MethodType: entity.PaymentMethodTypeCreditCard, FeeRate: 3.6},
+	}
+	statements := entity.SettlementStatements{
+		{
+			PayeeType:      entity.SettlementPayeeTypeShop,
+			PayeeID:        "shop-id",
+			Status:         entity.SettlementStatementStatusUnpaid,
+			PeriodStartAt:  startAt,
+			PeriodEndAt:    endAt,
+			OrderCount:     1,
+			SalesTotal:     500,
+			CommissionRate: 10,
+			CommissionFee:  50,
+			PaymentFee:     18,
+			PayoutTotal:    432,
+		},
+		{
+			PayeeType:      entity.SettlementPayeeTypeProducer,
+			PayeeID:        "producer-id",
+			Status:         entity.SettlementStatementStatusUnpaid,
+			PeriodStartAt:  startAt,
+			PeriodEndAt:    endAt,
+			OrderCount:     1,
+			SalesTotal:     3000,
+			CommissionRate: 10,
+			CommissionFee:  300,
+			PaymentFee:     108,
+			PayoutTotal:    2592,
+		},
+	}
+	input := &store.CloseSettlementPeriodInput{
+		PeriodStartAt: startAt,
+		PeriodEndAt:   endAt,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.CloseSettlementPeriodInput
+		expect    entity.SettlementStatements
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListSettlementTargets(ctx, ordersParams).Return(orders, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil)
+				mocks.db.PaymentSystem.EXPECT().
+					MultiGet(gomock.Any(), []entity.PaymentMethodType{entity.PaymentMethodTypeCreditCard}).
+					Return(systems, nil)
+				mocks.db.SettlementStatement.EXPECT().
+					Close(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, params *database.CloseSettlementPeriodParams) error {
+						assert.Equal(t, startAt, params.PeriodStartAt)
+						assert.Equal(t, endAt, params.PeriodEndAt)
+						assert.Equal(t, orders, params.Orders)
+						assert.Len(t, params.Statements, 2)
+						assert.Equal(t, now, params.SettledAt)
+						return nil
+					})
+			},
+			input:     input,
+			expect:    statements,
+			expectErr: nil,
+		},
+		{
+			name: "success without orders",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListSettlementTargets(ctx, ordersParams).Return(entity.Orders{}, nil)
+				params := &database.CloseSettlementPeriodParams{
+					PeriodStartAt: startAt,
+					PeriodEndAt:   endAt,
+					Statements:    entity.SettlementStatements{},
+					Orders:        entity.Orders{},
+					SettledAt:     now,
+				}
+				mocks.db.SettlementStatement.EXPECT().Close(ctx, params).Return(nil)
+			},
+			input:     input,
+			expect:    entity.SettlementStatements{},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.CloseSettlementPeriodInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "period has not ended yet",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.CloseSettlementPeriodInput{
+				PeriodStartAt: endAt,
+				PeriodEndAt:   now.AddDate(0, 0, 1),
+			},
+			expect:    nil,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to list orders",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListSettlementTargets(ctx, ordersParams).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to multi get products",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListSettlementTargets(ctx, ordersParams).Return(orders, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(nil, assert.AnError)
+				mocks.db.PaymentSystem.EXPECT().
+					MultiGet(gomock.Any(), []entity.PaymentMethodType{entity.PaymentMethodTypeCreditCard}).
+					Return(systems, nil).AnyTimes()
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to multi get payment systems",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListSettlementTargets(ctx, ordersParams).Return(orders, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil).AnyTimes()
+				mocks.db.PaymentSystem.EXPECT().
+					MultiGet(gomock.Any(), []entity.PaymentMethodType{entity.PaymentMethodTypeCreditCard}).
+					Return(nil, assert.AnError)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to close settlement period",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListSettlementTargets(ctx, ordersParams).Return(orders, nil)
+				mocks.db.Product.EXPECT().MultiGetByRevision(gomock.Any(), []int64{1}).Return(products, nil)
+				mocks.db.PaymentSystem.EXPECT().
+					MultiGet(gomock.Any(), []entity.PaymentMethodType{entity.PaymentMethodTypeCreditCard}).
+					Return(systems, nil)
+				mocks.db.SettlementStatement.EXPECT().Close(ctx, gomock.Any()).Return(database.ErrFailedPrecondition)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "already closed",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Order.EXPECT().ListSettlementTargets(ctx, ordersParams).Return(entity.Orders{}, nil)
+				mocks.db.SettlementStatement.EXPECT().Close(ctx, gomock.Any()).Return(database.ErrAlreadyExists)
+			},
+			input:     input,
+			expect:    nil,
+			expectErr: exception.ErrAlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			service.commissionRate = 10
+			actual, err := service.CloseSettlementPeriod(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			for _, s := range actual {
+				assert.NotEmpty(t, s.ID)
+				s.ID = "" // ignore
+			}
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestPaySettlementStatement(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.PaySettlementStatementInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Pay(ctx, "statement-id", now).Return(nil)
+			},
+			input:     &store.PaySettlementStatementInput{SettlementStatementID: "statement-id"},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.PaySettlementStatementInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "not found",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Pay(ctx, "statement-id", now).Return(database.ErrNotFound)
+			},
+			input:     &store.PaySettlementStatementInput{SettlementStatementID: "statement-id"},
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "already paid",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Pay(ctx, "statement-id", now).Return(database.ErrFailedPrecondition)
+			},
+			input:     &store.PaySettlementStatementInput{SettlementStatementID: "statement-id"},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to pay settlement statement",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Pay(ctx, "statement-id", now).Return(assert.AnError)
+			},
+			input:     &store.PaySettlementStatementInput{SettlementStatementID: "statement-id"},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.PaySettlementStatement(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestAggregateSettlementBalances(t *testing.T) {
+	t.Parallel()
+	params := &database.AggregateSettlementBalancesParams{
+		PayeeType: entity.SettlementPayeeTypeProducer,
+	}
+	balances := entity.SettlementBalances{
+		{
+			PayeeType:      entity.SettlementPayeeTypeProducer,
+			PayeeID:        "producer-id",
+			StatementCount: 2,
+			UnpaidTotal:    2592,
+			PaidTotal:      1000,
+		},
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.AggregateSettlementBalancesInput
+		expect    entity.SettlementBalances
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().AggregateBalances(ctx, params).Return(balances, nil)
+			},
+			input:     &store.AggregateSettlementBalancesInput{PayeeType: entity.SettlementPayeeTypeProducer},
+			expect:    balances,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.AggregateSettlementBalancesInput{PayeeType: -1},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to aggregate settlement balances",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().AggregateBalances(ctx, params).Return(nil, assert.AnError)
+			},
+			input:     &store.AggregateSettlementBalancesInput{PayeeType: entity.SettlementPayeeTypeProducer},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.AggregateSettlementBalances(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}))
+	}
+}
+
+func TestExportSettlementStatements(t *testing.T) {
+	t.Parallel()
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	endAt := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	params := &database.ListSettlementStatementsParams{
+		PeriodStartAt: startAt,
+	}
+	statements := entity.SettlementStatements{
+		{
+			ID:             "statement-id01",
+			PayeeType:      entity.SettlementPayeeTypeShop,
+			PayeeID:        "shop-id",
+			Status:         entity.SettlementStatementStatusUnpaid,
+			PeriodStartAt:  startAt,
+			PeriodEndAt:    endAt,
+			OrderCount:     1,
+			SalesTotal:     3000,
+			CommissionRate: 10,
+			CommissionFee:  300,
+			PaymentFee:     108,
+			PayoutTotal:    2592,
+		},
+		{
+			ID:             "statement-id02",
+			PayeeType:      entity.SettlementPayeeTypeCoordinator,
+			PayeeID:        "coordinator-id",
+			Status:         entity.SettlementStatementStatusUnpaid,
+			PeriodStartAt:  startAt,
+			PeriodEndAt:    endAt,
+			OrderCount:     1,
+			SalesTotal:     3000,
+			CommissionRate: 10,
+			CommissionFee:  300,
+			PaymentFee:     108,
+			PayoutTotal:    2592,
+		},
+		{
+			ID:             "statement-id03",
+			PayeeType:      entity.SettlementPayeeTypeProducer,
+			PayeeID:        "producer-id",
+			Status:         entity.SettlementStatementStatusPaid,
+			PeriodStartAt:  startAt,
+			PeriodEndAt:    endAt,
+			OrderCount:     1,
+			SalesTotal:     3000,
+			CommissionRate: 10,
+			CommissionFee:  300,
+			PaymentFee:     108,
+			PayoutTotal:    2592,
+			PaidAt:         endAt.AddDate(0, 0, 24),
+		},
+	}
+	shopsIn := &user.MultiGetShopsInput{ShopIDs: []string{"shop-id"}}
+	shops := uentity.Shops{{ID: "shop-id", Name: "&.農園"}}
+	coordinatorsIn := &user.MultiGetCoordinatorsInput{CoordinatorIDs: []string{"coordinator-id"}, WithDeleted: true}
+	coordinators := uentity.Coordinators{{AdminID: "coordinator-id", Username: "&.コーディネータ"}}
+	producersIn := &user.MultiGetProducersInput{ProducerIDs: []string{"producer-id"}, WithDeleted: true}
+	producers := uentity.Producers{{AdminID: "producer-id", Username: "&.生産者"}}
+	header := "精算明細ID,精算先種別,精算先ID,精算先名,精算対象期間(開始),精算対象期間(終了),対象注文数,売上金額,返金金額," +
+		"販売手数料率(%),販売手数料,決済手数料,支払い金額,支払い状況,支払い日時\n"
+	input := &store.ExportSettlementStatementsInput{
+		PeriodStartAt: startAt,
+		EncodingType:  codes.CharacterEncodingTypeUTF8,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ExportSettlementStatementsInput
+		expect    string
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().List(ctx, params).Return(statements, nil)
+				mocks.user.EXPECT().MultiGetShops(gomock.Any(), shopsIn).Return(shops, nil)
+				mocks.user.EXPECT().MultiGetCoordinators(gomock.Any(), coordinatorsIn).Return(coordinators, nil)
+				mocks.user.EXPECT().MultiGetProducers(gomock.Any(), producersIn).Return(producers, nil)
+			},
+			input: input,
+			expect: header +
+				"statement-id01,店舗,shop-id,&.農園,2026-09-01 00:00:00,2026-10-01 00:00:00,1,3000,0,10,300,108,2592,未払い,\n" +
+				"statement-id02,コーディネータ,coordinator-id,&.コーディネータ,2026-09-01 00:00:00,2026-10-01 00:00:00,1,3000,0,10,300,108,2592,未払い,\n" +
+				"statement-id03,生産者,producer-id,&.生産者,2026-09-01 00:00:00,2026-10-01 00:00:00,1,3000,0,10,300,108,2592,支払い済み,2026-10-25 00:00:00\n",
+			expectErr: nil,
+		},
+		{
+			name: "success without body",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().List(ctx, params).Return(entity.SettlementStatements{}, nil)
+			},
+			input:     input,
+			expect:    header,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ExportSettlementStatementsInput{},
+			expect:    "",
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list settlement statements",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().List(ctx, params).Return(nil, assert.AnError)
+			},
+			input:     input,
+			expect:    "",
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to multi get shops",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().List(ctx, params).Return(statements, nil)
+				mocks.user.EXPECT().MultiGetShops(gomock.Any(), shopsIn).Return(nil, assert.AnError)
+				mocks.user.EXPECT().MultiGetCoordinators(gomock.Any(), coordinatorsIn).Return(coordinators, nil).AnyTimes()
+				mocks.user.EXPECT().MultiGetProducers(gomock.Any(), producersIn).Return(producers, nil).AnyTimes()
+			},
+			input:     input,
+			expect:    "",
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ExportSettlementStatements(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, string(actual))
+		}))
+	}
+}
+
+func TestExportSettlementStatement(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	statement := &entity.SettlementStatement{
+		ID:             "statement-id",
+		PayeeType:      entity.SettlementPayeeTypeProducer,
+		PayeeID:        "producer-id",
+		Status:         entity.SettlementStatementStatusUnpaid,
+		PeriodStartAt:  startAt,
+		PeriodEndAt:    startAt.AddDate(0, 1, 0),
+		OrderCount:     1,
+		SalesTotal:     3000,
+		CommissionRate: 10,
+		CommissionFee:  300,
+		PaymentFee:     108,
+		PayoutTotal:    2592,
+	}
+	producersIn := &user.MultiGetProducersInput{ProducerIDs: []string{"producer-id"}, WithDeleted: true}
+	producers := uentity.Producers{{AdminID: "producer-id", Username: "&.生産者"}}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ExportSettlementStatementInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Get(ctx, "statement-id").Return(statement, nil)
+				mocks.user.EXPECT().MultiGetProducers(gomock.Any(), producersIn).Return(producers, nil)
+			},
+			input:     &store.ExportSettlementStatementInput{SettlementStatementID: "statement-id"},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ExportSettlementStatementInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get settlement statement",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Get(ctx, "statement-id").Return(nil, assert.AnError)
+			},
+			input:     &store.ExportSettlementStatementInput{SettlementStatementID: "statement-id"},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to multi get producers",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.SettlementStatement.EXPECT().Get(ctx, "statement-id").Return(statement, nil)
+				mocks.user.EXPECT().MultiGetProducers(gomock.Any(), producersIn).Return(nil, assert.AnError)
+			},
+			input:     &store.ExportSettlementStatementInput{SettlementStatementID: "statement-id"},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.ExportSettlementStatement(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if tt.expectErr == nil {
+				assert.Contains(t, string(actual), "%PDF-")
+			}
+		}, withNow(now)))
+	}
+}
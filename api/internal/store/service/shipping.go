@@ -102,6 +102,22 @@ func (s *service) CreateShipping(ctx context.Context, in *store.CreateShippingIn
 	if err != nil {
 		return nil, fmt.Errorf("api: invalid box 100 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
 	}
+	box120Rates, box120Frozen, err := newLargeBoxRates(in.Box120Rates, in.Box120Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromCreate)
+	if err != nil {
+		return nil, fmt.Errorf("api: invalid box 120 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	box140Rates, box140Frozen, err := newLargeBoxRates(in.Box140Rates, in.Box140Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromCreate)
+	if err != nil {
+		return nil, fmt.Errorf("api: invalid box 140 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	box160Rates, box160Frozen, err := newLargeBoxRates(in.Box160Rates, in.Box160Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromCreate)
+	if err != nil {
+		return nil, fmt.Errorf("api: invalid box 160 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	regionRules, err := s.newShippingRegionRulesFromCreate(in.RegionRules)
+	if err != nil {
+		return nil, fmt.Errorf("api: invalid region rules format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
 
 	var inUse bool
 	// 現在の配送設定を取得
@@ -124,6 +140,13 @@ func (s *service) CreateShipping(ctx context.Context, in *store.CreateShippingIn
 		Box80Frozen:       in.Box80Frozen,
 		Box100Rates:       box100Rates,
 		Box100Frozen:      in.Box100Frozen,
+		Box120Rates:       box120Rates,
+		Box120Frozen:      box120Frozen,
+		Box140Rates:       box140Rates,
+		Box140Frozen:      box140Frozen,
+		Box160Rates:       box160Rates,
+		Box160Frozen:      box160Frozen,
+		RegionRules:       regionRules,
 		HasFreeShipping:   in.HasFreeShipping,
 		FreeShippingRates: in.FreeShippingRates,
 		InUse:             inUse,
@@ -151,6 +174,22 @@ func (s *service) UpdateShipping(ctx context.Context, in *store.UpdateShippingIn
 	if err != nil {
 		return fmt.Errorf("api: invalid box 100 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
 	}
+	box120Rates, box120Frozen, err := newLargeBoxRates(in.Box120Rates, in.Box120Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromUpdate)
+	if err != nil {
+		return fmt.Errorf("api: invalid box 120 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	box140Rates, box140Frozen, err := newLargeBoxRates(in.Box140Rates, in.Box140Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromUpdate)
+	if err != nil {
+		return fmt.Errorf("api: invalid box 140 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	box160Rates, box160Frozen, err := newLargeBoxRates(in.Box160Rates, in.Box160Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromUpdate)
+	if err != nil {
+		return fmt.Errorf("api: invalid box 160 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	regionRules, err := s.newShippingRegionRulesFromUpdate(in.RegionRules)
+	if err != nil {
+		return fmt.Errorf("api: invalid region rules format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
 	params := &database.UpdateShippingParams{
 		Name:              in.Name,
 		Box60Rates:        box60Rates,
@@ -159,6 +198,13 @@ func (s *service) UpdateShipping(ctx context.Context, in *store.UpdateShippingIn
 		Box80Frozen:       in.Box80Frozen,
 		Box100Rates:       box100Rates,
 		Box100Frozen:      in.Box100Frozen,
+		Box120Rates:       box120Rates,
+		Box120Frozen:      box120Frozen,
+		Box140Rates:       box140Rates,
+		Box140Frozen:      box140Frozen,
+		Box160Rates:       box160Rates,
+		Box160Frozen:      box160Frozen,
+		RegionRules:       regionRules,
 		HasFreeShipping:   in.HasFreeShipping,
 		FreeShippingRates: in.FreeShippingRates,
 	}
@@ -190,6 +236,22 @@ func (s *service) UpdateDefaultShipping(ctx context.Context, in *store.UpdateDef
 	if err != nil {
 		return fmt.Errorf("api: invalid box 100 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
 	}
+	box120Rates, box120Frozen, err := newLargeBoxRates(in.Box120Rates, in.Box120Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromUpdateDefault)
+	if err != nil {
+		return fmt.Errorf("api: invalid box 120 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	box140Rates, box140Frozen, err := newLargeBoxRates(in.Box140Rates, in.Box140Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromUpdateDefault)
+	if err != nil {
+		return fmt.Errorf("api: invalid box 140 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	box160Rates, box160Frozen, err := newLargeBoxRates(in.Box160Rates, in.Box160Frozen, box100Rates, in.Box100Frozen, s.newShippingRatesFromUpdateDefault)
+	if err != nil {
+		return fmt.Errorf("api: invalid box 160 rates format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
+	regionRules, err := s.newShippingRegionRulesFromUpdateDefault(in.RegionRules)
+	if err != nil {
+		return fmt.Errorf("api: invalid region rules format: %s: %w", err.Error(), exception.ErrInvalidArgument)
+	}
 	params := &database.UpdateShippingParams{
 		Box60Rates:        box60Rates,
 		Box60Frozen:       in.Box60Frozen,
@@ -197,6 +259,13 @@ func (s *service) UpdateDefaultShipping(ctx context.Context, in *store.UpdateDef
 		Box80Frozen:       in.Box80Frozen,
 		Box100Rates:       box100Rates,
 		Box100Frozen:      in.Box100Frozen,
+		Box120Rates:       box120Rates,
+		Box120Frozen:      box120Frozen,
+		Box140Rates:       box140Rates,
+		Box140Frozen:      box140Frozen,
+		Box160Rates:       box160Rates,
+		Box160Frozen:      box160Frozen,
+		RegionRules:       regionRules,
 		HasFreeShipping:   in.HasFreeShipping,
 		FreeShippingRates: in.FreeShippingRates,
 	}
@@ -212,6 +281,17 @@ func (s *service) DeleteShipping(ctx context.Context, in *store.DeleteShippingIn
 	return internalError(err)
 }
 
+// newLargeBoxRates - 箱サイズ120以上の配送料が未指定の場合は、箱サイズ100の配送料を引き継ぐ（既存クライアントとの互換性のため）
+func newLargeBoxRates[T any](
+	in []T, frozen int64, box100Rates entity.ShippingRates, box100Frozen int64, fn func([]T) (entity.ShippingRates, error),
+) (entity.ShippingRates, int64, error) {
+	if len(in) == 0 {
+		return box100Rates, box100Frozen, nil
+	}
+	rates, err := fn(in)
+	return rates, frozen, err
+}
+
 func (s *service) newShippingRatesFromUpdateDefault(in []*store.UpdateDefaultShippingRate) (entity.ShippingRates, error) {
 	rates := make(entity.ShippingRates, len(in))
 	for i := range in {
@@ -244,3 +324,72 @@ func (s *service) newShippingRatesFromUpdate(in []*store.UpdateShippingRate) (en
 	}
 	return rates, nil
 }
+
+func (s *service) newShippingRegionRulesFromUpdateDefault(in []*store.UpdateDefaultShippingRegionRule) (entity.ShippingRegionRules, error) {
+	rules := make(entity.ShippingRegionRules, len(in))
+	for i := range in {
+		params := &entity.NewShippingRegionRuleParams{
+			Number:         int64(i + 1),
+			Name:           in[i].Name,
+			PostalCodeFrom: in[i].PostalCodeFrom,
+			PostalCodeTo:   in[i].PostalCodeTo,
+			Surcharge:      in[i].Surcharge,
+			ExcludedTypes:  in[i].ExcludedTypes,
+		}
+		rule, err := entity.NewShippingRegionRule(params)
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *service) newShippingRegionRulesFromCreate(in []*store.CreateShippingRegionRule) (entity.ShippingRegionRules, error) {
+	rules := make(entity.ShippingRegionRules, len(in))
+	for i := range in {
+		params := &entity.NewShippingRegionRuleParams{
+			Number:         int64(i + 1),
+			Name:           in[i].Name,
+			PostalCodeFrom: in[i].PostalCodeFrom,
+			PostalCodeTo:   in[i].PostalCodeTo,
+			Surcharge:      in[i].Surcharge,
+			ExcludedTypes:  in[i].ExcludedTypes,
+		}
+		rule, err := entity.NewShippingRegionRule(params)
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *service) newShippingRegionRulesFromUpdate(in []*store.UpdateShippingRegionRule) (entity.ShippingRegionRules, error) {
+	rules := make(entity.ShippingRegionRules, len(in))
+	for i := range in {
+		params := &entity.NewShippingRegionRuleParams{
+			Number:         int64(i + 1),
+			Name:           in[i].Name,
+			PostalCodeFrom: in[i].PostalCodeFrom,
+			PostalCodeTo:   in[i].PostalCodeTo,
+			Surcharge:      in[i].Surcharge,
+			ExcludedTypes:  in[i].ExcludedTypes,
+		}
+		rule, err := entity.NewShippingRegionRule(params)
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -589,6 +589,9 @@ func TestCreateShipping(t *testing.T) {
 		{Name: "四国", Price: 250, PrefectureCodes: shikoku},
 		{Name: "その他", Price: 500, PrefectureCodes: others},
 	}
+	regionRules := []*store.CreateShippingRegionRule{
+		{Name: "伊豆諸島", PostalCodeFrom: "100-0100", PostalCodeTo: "100-1699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+	}
 
 	tests := []struct {
 		name      string
@@ -627,6 +630,24 @@ func TestCreateShipping(t *testing.T) {
 									{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
 								},
 								Box100Frozen:      800,
+								Box120Rates: entity.ShippingRates{
+									{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+									{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+								},
+								Box120Frozen:      800,
+								Box140Rates: entity.ShippingRates{
+									{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+									{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+								},
+								Box140Frozen:      800,
+								Box160Rates: entity.ShippingRates{
+									{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+									{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+								},
+								Box160Frozen:      800,
+								RegionRules: entity.ShippingRegionRules{
+									{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+								},
 								HasFreeShipping:   true,
 								FreeShippingRates: 3000,
 							},
@@ -646,6 +667,13 @@ func TestCreateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -674,6 +702,13 @@ func TestCreateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -699,6 +734,13 @@ func TestCreateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -717,6 +759,13 @@ func TestCreateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -737,6 +786,25 @@ func TestCreateShipping(t *testing.T) {
 			},
 			expectErr: exception.ErrInvalidArgument,
 		},
+		{
+			name:  "invalid region rules",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.CreateShippingInput{
+				Name:          "配送設定",
+				ShopID:        "shop-id",
+				CoordinatorID: "coordinator-id",
+				Box60Rates:    rates,
+				Box80Rates:    rates,
+				Box100Rates:   rates,
+				Box120Rates:   rates,
+				Box140Rates:   rates,
+				Box160Rates:   rates,
+				RegionRules: []*store.CreateShippingRegionRule{
+					{Name: "伊豆諸島", PostalCodeFrom: "100-1699", PostalCodeTo: "100-0100"},
+				},
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
 		{
 			name: "failed to get by coordinator id",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -752,6 +820,13 @@ func TestCreateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -773,6 +848,13 @@ func TestCreateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -809,6 +891,9 @@ func TestUpdateShipping(t *testing.T) {
 		{Name: "四国", Price: 250, PrefectureCodes: shikoku},
 		{Name: "その他", Price: 500, PrefectureCodes: others},
 	}
+	regionRules := []*store.UpdateShippingRegionRule{
+		{Name: "伊豆諸島", PostalCodeFrom: "100-0100", PostalCodeTo: "100-1699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+	}
 	params := &database.UpdateShippingParams{
 		Name: "配送設定",
 		Box60Rates: entity.ShippingRates{
@@ -826,6 +911,24 @@ func TestUpdateShipping(t *testing.T) {
 			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
 		},
 		Box100Frozen:      800,
+		Box120Rates: entity.ShippingRates{
+			{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+		},
+		Box120Frozen:      800,
+		Box140Rates: entity.ShippingRates{
+			{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+		},
+		Box140Frozen:      800,
+		Box160Rates: entity.ShippingRates{
+			{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+		},
+		Box160Frozen:      800,
+		RegionRules: entity.ShippingRegionRules{
+			{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+		},
 		HasFreeShipping:   true,
 		FreeShippingRates: 3000,
 	}
@@ -850,6 +953,33 @@ func TestUpdateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
+				HasFreeShipping:   true,
+				FreeShippingRates: 3000,
+			},
+			expectErr: nil,
+		},
+		{
+			name: "success without large box rates",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Shipping.EXPECT().Update(ctx, "shipping-id", params).Return(nil)
+			},
+			input: &store.UpdateShippingInput{
+				Name:              "配送設定",
+				ShippingID:        "shipping-id",
+				Box60Rates:        rates,
+				Box60Frozen:       800,
+				Box80Rates:        rates,
+				Box80Frozen:       800,
+				Box100Rates:       rates,
+				Box100Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -874,6 +1004,13 @@ func TestUpdateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -892,6 +1029,13 @@ func TestUpdateShipping(t *testing.T) {
 				Box80Frozen:  800,
 				Box100Rates:  rates,
 				Box100Frozen: 800,
+				Box120Rates:  rates,
+				Box120Frozen: 800,
+				Box140Rates:  rates,
+				Box140Frozen: 800,
+				Box160Rates:  rates,
+				Box160Frozen: 800,
+				RegionRules:  regionRules,
 			},
 			expectErr: exception.ErrInvalidArgument,
 		},
@@ -908,6 +1052,13 @@ func TestUpdateShipping(t *testing.T) {
 				Box80Frozen:  800,
 				Box100Rates:  []*store.UpdateShippingRate{},
 				Box100Frozen: 800,
+				Box120Rates:  rates,
+				Box120Frozen: 800,
+				Box140Rates:  rates,
+				Box140Frozen: 800,
+				Box160Rates:  rates,
+				Box160Frozen: 800,
+				RegionRules:  regionRules,
 			},
 			expectErr: exception.ErrInvalidArgument,
 		},
@@ -925,6 +1076,13 @@ func TestUpdateShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -1007,6 +1165,9 @@ func TestUpdateDefaultShipping(t *testing.T) {
 		{Name: "四国", Price: 250, PrefectureCodes: shikoku},
 		{Name: "その他", Price: 500, PrefectureCodes: others},
 	}
+	regionRules := []*store.UpdateDefaultShippingRegionRule{
+		{Name: "伊豆諸島", PostalCodeFrom: "100-0100", PostalCodeTo: "100-1699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+	}
 	params := &database.UpdateShippingParams{
 		Box60Rates: entity.ShippingRates{
 			{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
@@ -1023,6 +1184,24 @@ func TestUpdateDefaultShipping(t *testing.T) {
 			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
 		},
 		Box100Frozen:      800,
+		Box120Rates: entity.ShippingRates{
+			{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+		},
+		Box120Frozen:      800,
+		Box140Rates: entity.ShippingRates{
+			{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+		},
+		Box140Frozen:      800,
+		Box160Rates: entity.ShippingRates{
+			{Number: 1, Name: "四国", Price: 250, PrefectureCodes: shikoku},
+			{Number: 2, Name: "その他", Price: 500, PrefectureCodes: others},
+		},
+		Box160Frozen:      800,
+		RegionRules: entity.ShippingRegionRules{
+			{Number: 1, Name: "伊豆諸島", PostalCodeFrom: "1000100", PostalCodeTo: "1001699", Surcharge: 1200, ExcludedTypes: []entity.ShippingType{entity.ShippingTypeFrozen}},
+		},
 		HasFreeShipping:   true,
 		FreeShippingRates: 3000,
 	}
@@ -1045,6 +1224,13 @@ func TestUpdateDefaultShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -1067,6 +1253,13 @@ func TestUpdateDefaultShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -1083,6 +1276,13 @@ func TestUpdateDefaultShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -1099,6 +1299,13 @@ func TestUpdateDefaultShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       []*store.UpdateDefaultShippingRate{},
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
@@ -1116,6 +1323,13 @@ func TestUpdateDefaultShipping(t *testing.T) {
 				Box80Frozen:       800,
 				Box100Rates:       rates,
 				Box100Frozen:      800,
+				Box120Rates:       rates,
+				Box120Frozen:      800,
+				Box140Rates:       rates,
+				Box140Frozen:      800,
+				Box160Rates:       rates,
+				Box160Frozen:      800,
+				RegionRules:       regionRules,
 				HasFreeShipping:   true,
 				FreeShippingRates: 3000,
 			},
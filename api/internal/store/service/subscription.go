@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/messenger"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/internal/user"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/log"
+	"github.com/and-period/furumaru/api/pkg/uuid"
+	"golang.org/x/sync/errgroup"
+)
+
+func (s *service) ListSubscriptions(ctx context.Context, in *store.ListSubscriptionsInput) (entity.Subscriptions, int64, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, 0, internalError(err)
+	}
+	params := &database.ListSubscriptionsParams{
+		UserID:       in.UserID,
+		ShopID:       in.ShopID,
+		Statuses:     in.Statuses,
+		NextRunUntil: in.NextRunUntil,
+		Limit:        int(in.Limit),
+		Offset:       int(in.Offset),
+	}
+	var (
+		subscriptions entity.Subscriptions
+		total         int64
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		subscriptions, err = s.db.Subscription.List(ectx, params)
+		return
+	})
+	eg.Go(func() (err error) {
+		total, err = s.db.Subscription.Count(ectx, params)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, 0, internalError(err)
+	}
+	return subscriptions, total, nil
+}
+
+func (s *service) GetSubscription(ctx context.Context, in *store.GetSubscriptionInput) (*entity.Subscription, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	subscription, err := s.db.Subscription.Get(ctx, in.SubscriptionID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if in.UserID != "" && subscription.UserID != in.UserID {
+		return nil, fmt.Errorf("service: subscription is not found: %w", exception.ErrNotFound)
+	}
+	return subscription, nil
+}
+
+func (s *service) CreateSubscription(ctx context.Context, in *store.CreateSubscriptionInput) (*entity.Subscription, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	if in.StartAt.Before(jst.BeginningOfDay(s.now())) {
+		return nil, fmt.Errorf("service: start date must not be in the past: %w", exception.ErrInvalidArgument)
+	}
+	items := make(entity.SubscriptionItems, len(in.Items))
+	productIDs := make([]string, len(in.Items))
+	for i, item := range in.Items {
+		items[i] = &entity.SubscriptionItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+		productIDs[i] = item.ProductID
+	}
+	var (
+		customer *uentity.User
+		address  *uentity.Address
+		products entity.Products
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		in := &user.GetUserInput{
+			UserID: in.UserID,
+		}
+		customer, err = s.user.GetUser(ectx, in)
+		return
+	})
+	eg.Go(func() (err error) {
+		in := &user.GetAddressInput{
+			UserID:    in.UserID,
+			AddressID: in.AddressID,
+		}
+		address, err = s.user.GetAddress(ectx, in)
+		return
+	})
+	eg.Go(func() (err error) {
+		products, err = s.db.Product.MultiGet(ectx, productIDs)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	products = products.FilterBySales()
+	if len(products) == 0 {
+		return nil, fmt.Errorf("service: there are no products on sale: %w", exception.ErrFailedPrecondition)
+	}
+	shop, err := s.user.GetShopByCoordinatorID(ctx, &user.GetShopByCoordinatorIDInput{
+		CoordinatorID: products[0].CoordinatorID,
+	})
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if !shop.Enabled() {
+		return nil, fmt.Errorf("service: shop is disabled: %w", exception.ErrForbidden)
+	}
+	// 定期注文は購入者不在で決済するため、決済手段を保存可能なStripeを利用する
+	prov, err := s.getProviderByType(entity.PaymentProviderTypeStripe)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	sparams := &payment.SavePaymentMethodParams{
+		UserID:          customer.ID,
+		Name:            customer.Name(),
+		Email:           customer.Email(),
+		PaymentMethodID: in.PaymentMethodID,
+	}
+	saved, err := prov.SavePaymentMethod(ctx, sparams)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	params := &entity.NewSubscriptionParams{
+		UserID:            in.UserID,
+		ShopID:            shop.ID,
+		Interval:          in.Interval,
+		Items:             items,
+		Products:          products,
+		AddressRevisionID: address.AddressRevision.ID,
+		ProviderType:      entity.PaymentProviderTypeStripe,
+		CustomerID:        saved.CustomerID,
+		PaymentMethodID:   saved.PaymentMethodID,
+		StartAt:           in.StartAt,
+	}
+	subscription, err := entity.NewSubscription(params)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if err := s.db.Subscription.Create(ctx, subscription); err != nil {
+		return nil, internalError(err)
+	}
+	return subscription, nil
+}
+
+func (s *service) SkipSubscription(ctx context.Context, in *store.SkipSubscriptionInput) (*entity.Subscription, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	return s.updateSubscription(ctx, in.SubscriptionID, in.UserID, (*entity.Subscription).Skip)
+}
+
+func (s *service) PauseSubscription(ctx context.Context, in *store.PauseSubscriptionInput) (*entity.Subscription, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	return s.updateSubscription(ctx, in.SubscriptionID, in.UserID, (*entity.Subscription).Pause)
+}
+
+func (s *service) ResumeSubscription(ctx context.Context, in *store.ResumeSubscriptionInput) (*entity.Subscription, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	return s.updateSubscription(ctx, in.SubscriptionID, in.UserID, (*entity.Subscription).Resume)
+}
+
+func (s *service) CancelSubscription(ctx context.Context, in *store.CancelSubscriptionInput) (*entity.Subscription, error) {
+	if err := s.validator.Struct(in); err != nil {
+		return nil, internalError(err)
+	}
+	return s.updateSubscription(ctx, in.SubscriptionID, in.UserID, (*entity.Subscription).Cancel)
+}
+
+func (s *service) updateSubscription(
+	ctx context.Context, subscriptionID, userID string, fn func(*entity.Subscription, time.Time) error,
+) (*entity.Subscription, error) {
+	subscription, err := s.db.Subscription.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if subscription.UserID != userID {
+		return nil, fmt.Errorf("service: subscription is not found: %w", exception.ErrNotFound)
+	}
+	if err := fn(subscription, s.now()); err != nil {
+		return nil, internalError(err)
+	}
+	if err := s.db.Subscription.Update(ctx, subscription.ID, newUpdateSubscriptionParams(subscription)); err != nil {
+		return nil, internalError(err)
+	}
+	return subscription, nil
+}
+
+func (s *service) RenewSubscription(ctx context.Context, in *store.RenewSubscriptionInput) error {
+	if err := s.validator.Struct(in); err != nil {
+		return internalError(err)
+	}
+	now := s.now()
+	subscription, err := s.db.Subscription.Get(ctx, in.SubscriptionID)
+	if err != nil {
+		return internalError(err)
+	}
+	if !subscription.Renewable(now) {
+		return nil
+	}
+	// 同一期間の二重決済を防ぐため、決済前に次回注文日時を進めて今回の期間を確保する
+	period := subscription.NextRunAt
+	claimParams := &database.UpdateSubscriptionNextRunAtParams{
+		CurrentNextRunAt: period,
+		NextRunAt:        subscription.NextPeriod(now),
+	}
+	err = s.db.Subscription.UpdateNextRunAt(ctx, subscription.ID, claimParams)
+	if errors.Is(err, database.ErrFailedPrecondition) {
+		// 他の処理で既に更新済み
+		return nil
+	}
+	if err != nil {
+		return internalError(err)
+	}
+	order, err := s.createSubscriptionOrder(ctx, subscription, now)
+	if errors.Is(err, exception.ErrFailedPrecondition) {
+		// 販売期間外・在庫不足などで注文できない場合、再試行の上で購入者へ通知する
+		slog.WarnContext(ctx, "Failed to create subscription order",
+			slog.String("subscriptionId", subscription.ID), log.Error(err))
+		return s.renewSubscriptionFailed(ctx, subscription, now)
+	}
+	if err != nil {
+		// 決済前に失敗した場合は、次回の実行で再試行できるよう確保した期間を戻す
+		rollbackParams := &database.UpdateSubscriptionNextRunAtParams{
+			CurrentNextRunAt: claimParams.NextRunAt,
+			NextRunAt:        period,
+		}
+		if err := s.db.Subscription.UpdateNextRunAt(context.Background(), subscription.ID, rollbackParams); err != nil {
+			slog.ErrorContext(ctx, "Failed to rollback subscription next run at",
+				slog.String("subscriptionId", subscription.ID), log.Error(err))
+		}
+		return err
+	}
+	prov, err := s.getProviderByType(subscription.ProviderType)
+	if err != nil {
+		return internalError(err)
+	}
+	cparams := &payment.ChargeSavedPaymentMethodParams{
+		OrderID:         order.ID,
+		CustomerID:      subscription.CustomerID,
+		PaymentMethodID: subscription.PaymentMethodID,
+		Amount:          order.Total,
+		Description:     fmt.Sprintf("定期便 %s", subscription.ID),
+	}
+	result, err := prov.ChargeSavedPaymentMethod(ctx, cparams)
+	if err != nil || result.Status == entity.PaymentStatusFailed {
+		slog.WarnContext(ctx, "Failed to charge saved payment method",
+			slog.String("subscriptionId", subscription.ID), slog.String("orderId", order.ID), log.Error(err))
+		fparams := &database.UpdateOrderFailedParams{
+			Status:   entity.PaymentStatusFailed,
+			IssuedAt: now,
+		}
+		if result != nil {
+			fparams.PaymentID = result.PaymentID
+		}
+		if err := s.db.Order.UpdateFailed(ctx, order.ID, fparams); err != nil {
+			slog.ErrorContext(ctx, "Failed to update subscription order to failed",
+				slog.String("orderId", order.ID), log.Error(err))
+		}
+		s.releaseProductInventories(context.Background(), order)
+		return s.renewSubscriptionFailed(ctx, subscription, now)
+	}
+	// 仮売上・実売上の反映は決済プロバイダーからの通知で行う
+	subscription.Renewed(order.ID, now)
+	err = s.db.Subscription.Update(ctx, subscription.ID, newUpdateSubscriptionParams(subscription))
+	return internalError(err)
+}
+
+func (s *service) createSubscriptionOrder(
+	ctx context.Context, subscription *entity.Subscription, now time.Time,
+) (*entity.Order, error) {
+	var (
+		customer  *uentity.User
+		addresses uentity.Addresses
+		products  entity.Products
+		shipping  *entity.Shipping
+		shop      *uentity.Shop
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		in := &user.GetUserInput{
+			UserID: subscription.UserID,
+		}
+		customer, err = s.user.GetUser(ectx, in)
+		return
+	})
+	eg.Go(func() (err error) {
+		in := &user.MultiGetAddressesByRevisionInput{
+			AddressRevisionIDs: []int64{subscription.AddressRevisionID},
+		}
+		addresses, err = s.user.MultiGetAddressesByRevision(ectx, in)
+		return
+	})
+	eg.Go(func() (err error) {
+		products, err = s.db.Product.MultiGet(ectx, subscription.ProductIDs())
+		return
+	})
+	eg.Go(func() (err error) {
+		shipping, err = s.getShippingByCoordinatorID(ectx, subscription.CoordinatorID)
+		return
+	})
+	eg.Go(func() (err error) {
+		in := &user.GetShopByCoordinatorIDInput{
+			CoordinatorID: subscription.CoordinatorID,
+		}
+		shop, err = s.user.GetShopByCoordinatorID(ectx, in)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, internalError(err)
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("service: address is not found: %w", exception.ErrFailedPrecondition)
+	}
+	if !shop.Enabled() {
+		return nil, fmt.Errorf("service: shop is disabled: %w", exception.ErrFailedPrecondition)
+	}
+	products = products.FilterBySales()
+	if len(products) != len(subscription.ProductIDs()) {
+		return nil, fmt.Errorf("service: there are products outside the sales period: %w", exception.ErrFailedPrecondition)
+	}
+	cart, err := subscription.Cart(products, now)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to build cart: %w: %s", exception.ErrFailedPrecondition, err.Error())
+	}
+	params := &entity.NewProductOrderParams{
+		OrderID:           uuid.Base58Encode(uuid.New()),
+		SessionID:         subscription.ID,
+		ShopID:            shop.ID,
+		CoordinatorID:     subscription.CoordinatorID,
+		Customer:          customer,
+		BillingAddress:    addresses[0],
+		ShippingAddress:   addresses[0],
+		Shipping:          shipping,
+		Baskets:           cart.Baskets,
+		Products:          products,
+		PaymentMethodType: entity.PaymentMethodTypeCreditCard,
+	}
+	order, err := entity.NewProductOrder(params)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	order.OrderPayment.ProviderType = subscription.ProviderType
+	order.SetTransaction("", now)
+	if err := s.holdProductInventories(ctx, order, products); err != nil {
+		return nil, err
+	}
+	if err := s.db.Order.Create(ctx, order); err != nil {
+		s.releaseProductInventories(context.Background(), order)
+		return nil, internalError(err)
+	}
+	return order, nil
+}
+
+func (s *service) renewSubscriptionFailed(ctx context.Context, subscription *entity.Subscription, now time.Time) error {
+	subscription.RenewFailed(now)
+	if err := s.db.Subscription.Update(ctx, subscription.ID, newUpdateSubscriptionParams(subscription)); err != nil {
+		return internalError(err)
+	}
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		in := &messenger.NotifySubscriptionRenewalFailedInput{
+			SubscriptionID: subscription.ID,
+		}
+		if err := s.messenger.NotifySubscriptionRenewalFailed(context.Background(), in); err != nil {
+			slog.ErrorContext(ctx, "Failed to notify subscription renewal failed",
+				slog.String("subscriptionId", subscription.ID), log.Error(err))
+		}
+	}()
+	return nil
+}
+
+func newUpdateSubscriptionParams(subscription *entity.Subscription) *database.UpdateSubscriptionParams {
+	return &database.UpdateSubscriptionParams{
+		Status:       subscription.Status,
+		NextRunAt:    subscription.NextRunAt,
+		LastOrderID:  subscription.LastOrderID,
+		FailureCount: subscription.FailureCount,
+		LastFailedAt: subscription.LastFailedAt,
+		PausedAt:     subscription.PausedAt,
+		CanceledAt:   subscription.CanceledAt,
+	}
+}
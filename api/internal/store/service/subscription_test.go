@@ -0,0 +1,870 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/exception"
+	"github.com/and-period/furumaru/api/internal/messenger"
+	"github.com/and-period/furumaru/api/internal/store"
+	"github.com/and-period/furumaru/api/internal/store/database"
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/internal/store/payment"
+	"github.com/and-period/furumaru/api/internal/user"
+	uentity "github.com/and-period/furumaru/api/internal/user/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSubscriptions(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	params := &database.ListSubscriptionsParams{
+		UserID: "user-id",
+		Limit:  20,
+		Offset: 0,
+	}
+	subscriptions := entity.Subscriptions{
+		{
+			ID:        "subscription-id",
+			UserID:    "user-id",
+			Status:    entity.SubscriptionStatusActive,
+			Interval:  entity.SubscriptionIntervalMonthly,
+			NextRunAt: now,
+		},
+	}
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, mocks *mocks)
+		input       *store.ListSubscriptionsInput
+		expect      entity.Subscriptions
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().List(gomock.Any(), params).Return(subscriptions, nil)
+				mocks.db.Subscription.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListSubscriptionsInput{
+				UserID: "user-id",
+				Limit:  20,
+				Offset: 0,
+			},
+			expect:      subscriptions,
+			expectTotal: 1,
+			expectErr:   nil,
+		},
+		{
+			name:  "invalid argument",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &store.ListSubscriptionsInput{
+				Limit: 201,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to list subscriptions",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().List(gomock.Any(), params).Return(nil, assert.AnError)
+				mocks.db.Subscription.EXPECT().Count(gomock.Any(), params).Return(int64(1), nil)
+			},
+			input: &store.ListSubscriptionsInput{
+				UserID: "user-id",
+				Limit:  20,
+				Offset: 0,
+			},
+			expect:      nil,
+			expectTotal: 0,
+			expectErr:   exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, total, err := service.ListSubscriptions(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+			assert.Equal(t, tt.expectTotal, total)
+		}, withNow(now)))
+	}
+}
+
+func TestGetSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	subscription := &entity.Subscription{
+		ID:        "subscription-id",
+		UserID:    "user-id",
+		Status:    entity.SubscriptionStatusActive,
+		NextRunAt: now,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.GetSubscriptionInput
+		expect    *entity.Subscription
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription, nil)
+			},
+			input: &store.GetSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expect:    subscription,
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.GetSubscriptionInput{},
+			expect:    nil,
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "other user",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription, nil)
+			},
+			input: &store.GetSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "other-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "failed to get subscription",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(nil, assert.AnError)
+			},
+			input: &store.GetSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expect:    nil,
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			actual, err := service.GetSubscription(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+			assert.Equal(t, tt.expect, actual)
+		}, withNow(now)))
+	}
+}
+
+func TestCreateSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	startAt := jst.Date(2026, 11, 1, 0, 0, 0, 0)
+	customerIn := &user.GetUserInput{
+		UserID: "user-id",
+	}
+	customer := &uentity.User{
+		Member: uentity.Member{
+			UserID:    "user-id",
+			Email:     "test@example.com",
+			Lastname:  "テスト",
+			Firstname: "ユーザー",
+		},
+		ID:   "user-id",
+		Type: uentity.UserTypeMember,
+	}
+	addressIn := &user.GetAddressInput{
+		UserID:    "user-id",
+		AddressID: "address-id",
+	}
+	address := &uentity.Address{
+		AddressRevision: uentity.AddressRevision{ID: 1, AddressID: "address-id"},
+		ID:              "address-id",
+		UserID:          "user-id",
+	}
+	shopIn := &user.GetShopByCoordinatorIDInput{
+		CoordinatorID: "coordinator-id",
+	}
+	shop := &uentity.Shop{
+		ID:            "shop-id",
+		CoordinatorID: "coordinator-id",
+		Activated:     true,
+	}
+	products := func() entity.Products {
+		return entity.Products{
+			{
+				ID:            "product-id",
+				CoordinatorID: "coordinator-id",
+				Status:        entity.ProductStatusForSale,
+			},
+		}
+	}
+	saveParams := &payment.SavePaymentMethodParams{
+		UserID:          "user-id",
+		Name:            "テスト ユーザー",
+		Email:           "test@example.com",
+		PaymentMethodID: "pm_xxx",
+	}
+	saved := &payment.SavePaymentMethodResult{
+		CustomerID:      "cus_xxx",
+		PaymentMethodID: "pm_xxx",
+	}
+	input := func() *store.CreateSubscriptionInput {
+		return &store.CreateSubscriptionInput{
+			UserID:          "user-id",
+			Interval:        entity.SubscriptionIntervalMonthly,
+			Items:           []*store.CreateSubscriptionItem{{ProductID: "product-id", Quantity: 1}},
+			AddressID:       "address-id",
+			PaymentMethodID: "pm_xxx",
+			StartAt:         startAt,
+		}
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.CreateSubscriptionInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Product.EXPECT().MultiGet(gomock.Any(), []string{"product-id"}).Return(products(), nil)
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+				mocks.payment.EXPECT().SavePaymentMethod(ctx, saveParams).Return(saved, nil)
+				mocks.db.Subscription.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, subscription *entity.Subscription) error {
+						expect := &entity.Subscription{
+							ID:                subscription.ID, // ignore
+							UserID:            "user-id",
+							ShopID:            "shop-id",
+							CoordinatorID:     "coordinator-id",
+							Status:            entity.SubscriptionStatusActive,
+							Interval:          entity.SubscriptionIntervalMonthly,
+							AnchorDay:         int64(startAt.Day()),
+							Items:             entity.SubscriptionItems{{ProductID: "product-id", Quantity: 1}},
+							AddressRevisionID: 1,
+							ProviderType:      entity.PaymentProviderTypeStripe,
+							CustomerID:        "cus_xxx",
+							PaymentMethodID:   "pm_xxx",
+							NextRunAt:         startAt,
+						}
+						assert.Equal(t, expect, subscription)
+						return nil
+					})
+			},
+			input:     input(),
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.CreateSubscriptionInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name:  "start date in the past",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: func() *store.CreateSubscriptionInput {
+				in := input()
+				in.StartAt = now.AddDate(0, 0, -1)
+				return in
+			}(),
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "products are not on sale",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Product.EXPECT().MultiGet(gomock.Any(), []string{"product-id"}).Return(entity.Products{}, nil)
+			},
+			input:     input(),
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "shop is disabled",
+			setup: func(ctx context.Context, mocks *mocks) {
+				shop := &uentity.Shop{ID: "shop-id", Activated: false}
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Product.EXPECT().MultiGet(gomock.Any(), []string{"product-id"}).Return(products(), nil)
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+			},
+			input:     input(),
+			expectErr: exception.ErrForbidden,
+		},
+		{
+			name: "failed to save payment method",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Product.EXPECT().MultiGet(gomock.Any(), []string{"product-id"}).Return(products(), nil)
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+				mocks.payment.EXPECT().SavePaymentMethod(ctx, saveParams).Return(nil, assert.AnError)
+			},
+			input:     input(),
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "failed to create subscription",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+				mocks.user.EXPECT().GetAddress(gomock.Any(), addressIn).Return(address, nil)
+				mocks.db.Product.EXPECT().MultiGet(gomock.Any(), []string{"product-id"}).Return(products(), nil)
+				mocks.user.EXPECT().GetShopByCoordinatorID(ctx, shopIn).Return(shop, nil)
+				mocks.payment.EXPECT().SavePaymentMethod(ctx, saveParams).Return(saved, nil)
+				mocks.db.Subscription.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			input:     input(),
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CreateSubscription(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestSkipSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	subscription := func(status entity.SubscriptionStatus) *entity.Subscription {
+		return &entity.Subscription{
+			ID:        "subscription-id",
+			UserID:    "user-id",
+			Status:    status,
+			Interval:  entity.SubscriptionIntervalWeekly,
+			NextRunAt: now.AddDate(0, 0, 3),
+		}
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.SkipSubscriptionInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.UpdateSubscriptionParams{
+					Status:    entity.SubscriptionStatusActive,
+					NextRunAt: now.AddDate(0, 0, 10),
+				}
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(entity.SubscriptionStatusActive), nil)
+				mocks.db.Subscription.EXPECT().Update(ctx, "subscription-id", params).Return(nil)
+			},
+			input: &store.SkipSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.SkipSubscriptionInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "other user",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(entity.SubscriptionStatusActive), nil)
+			},
+			input: &store.SkipSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "other-id",
+			},
+			expectErr: exception.ErrNotFound,
+		},
+		{
+			name: "paused subscription",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(entity.SubscriptionStatusPaused), nil)
+			},
+			input: &store.SkipSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+		{
+			name: "failed to update subscription",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(entity.SubscriptionStatusActive), nil)
+				mocks.db.Subscription.EXPECT().Update(ctx, "subscription-id", gomock.Any()).Return(assert.AnError)
+			},
+			input: &store.SkipSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.SkipSubscription(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestPauseSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	subscription := &entity.Subscription{
+		ID:        "subscription-id",
+		UserID:    "user-id",
+		Status:    entity.SubscriptionStatusActive,
+		Interval:  entity.SubscriptionIntervalWeekly,
+		NextRunAt: now,
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.PauseSubscriptionInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.UpdateSubscriptionParams{
+					Status:    entity.SubscriptionStatusPaused,
+					NextRunAt: now,
+					PausedAt:  now,
+				}
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription, nil)
+				mocks.db.Subscription.EXPECT().Update(ctx, "subscription-id", params).Return(nil)
+			},
+			input: &store.PauseSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.PauseSubscriptionInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.PauseSubscription(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestResumeSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	subscription := &entity.Subscription{
+		ID:           "subscription-id",
+		UserID:       "user-id",
+		Status:       entity.SubscriptionStatusSuspended,
+		Interval:     entity.SubscriptionIntervalWeekly,
+		NextRunAt:    now.AddDate(0, 0, -10),
+		FailureCount: 3,
+		LastFailedAt: now.AddDate(0, 0, -8),
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.ResumeSubscriptionInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.UpdateSubscriptionParams{
+					Status:       entity.SubscriptionStatusActive,
+					NextRunAt:    now.AddDate(0, 0, 4),
+					LastFailedAt: now.AddDate(0, 0, -8),
+				}
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription, nil)
+				mocks.db.Subscription.EXPECT().Update(ctx, "subscription-id", params).Return(nil)
+			},
+			input: &store.ResumeSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.ResumeSubscriptionInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.ResumeSubscription(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestCancelSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 0, 0, 0, 0)
+	subscription := func(status entity.SubscriptionStatus) *entity.Subscription {
+		return &entity.Subscription{
+			ID:        "subscription-id",
+			UserID:    "user-id",
+			Status:    status,
+			Interval:  entity.SubscriptionIntervalWeekly,
+			NextRunAt: now,
+		}
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, mocks *mocks)
+		input     *store.CancelSubscriptionInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, mocks *mocks) {
+				params := &database.UpdateSubscriptionParams{
+					Status:     entity.SubscriptionStatusCanceled,
+					NextRunAt:  now,
+					CanceledAt: now,
+				}
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(entity.SubscriptionStatusActive), nil)
+				mocks.db.Subscription.EXPECT().Update(ctx, "subscription-id", params).Return(nil)
+			},
+			input: &store.CancelSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, mocks *mocks) {},
+			input:     &store.CancelSubscriptionInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "already canceled",
+			setup: func(ctx context.Context, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(entity.SubscriptionStatusCanceled), nil)
+			},
+			input: &store.CancelSubscriptionInput{
+				SubscriptionID: "subscription-id",
+				UserID:         "user-id",
+			},
+			expectErr: exception.ErrFailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(tt.setup, func(ctx context.Context, t *testing.T, service *service) {
+			_, err := service.CancelSubscription(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
+
+func TestRenewSubscription(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 6, 0, 0, 0)
+	rates := entity.ShippingRates{
+		{Number: 1, Name: "全国一律", Price: 500, PrefectureCodes: []int32{13}},
+	}
+	subscription := func() *entity.Subscription {
+		return &entity.Subscription{
+			ID:                "subscription-id",
+			UserID:            "user-id",
+			ShopID:            "shop-id",
+			CoordinatorID:     "coordinator-id",
+			Status:            entity.SubscriptionStatusActive,
+			Interval:          entity.SubscriptionIntervalWeekly,
+			Items:             entity.SubscriptionItems{{ProductID: "product-id", Quantity: 2}},
+			AddressRevisionID: 1,
+			ProviderType:      entity.PaymentProviderTypeStripe,
+			CustomerID:        "cus_xxx",
+			PaymentMethodID:   "pm_xxx",
+			NextRunAt:         jst.Date(2026, 10, 18, 0, 0, 0, 0),
+		}
+	}
+	customerIn := &user.GetUserInput{
+		UserID: "user-id",
+	}
+	customer := &uentity.User{
+		Member: uentity.Member{UserID: "user-id", Email: "test@example.com"},
+		ID:     "user-id",
+		Type:   uentity.UserTypeMember,
+	}
+	addressesIn := &user.MultiGetAddressesByRevisionInput{
+		AddressRevisionIDs: []int64{1},
+	}
+	addresses := uentity.Addresses{
+		{
+			AddressRevision: uentity.AddressRevision{ID: 1, AddressID: "address-id", PrefectureCode: 13},
+			ID:              "address-id",
+			UserID:          "user-id",
+		},
+	}
+	products := func(inventory int64) entity.Products {
+		return entity.Products{
+			{
+				ID:            "product-id",
+				CoordinatorID: "coordinator-id",
+				Status:        entity.ProductStatusForSale,
+				DeliveryType:  entity.DeliveryTypeNormal,
+				Inventory:     inventory,
+				Box60Rate:     50,
+				Box80Rate:     40,
+				Box100Rate:    30,
+				ProductRevision: entity.ProductRevision{
+					ID:        1,
+					ProductID: "product-id",
+					Price:     1000,
+				},
+			},
+		}
+	}
+	shipping := &entity.Shipping{
+		ShippingRevision: entity.ShippingRevision{
+			ShippingID:  "shipping-id",
+			Box60Rates:  rates,
+			Box80Rates:  rates,
+			Box100Rates: rates,
+		},
+		ID:            "shipping-id",
+		CoordinatorID: "coordinator-id",
+	}
+	shopIn := &user.GetShopByCoordinatorIDInput{
+		CoordinatorID: "coordinator-id",
+	}
+	shop := &uentity.Shop{
+		ID:            "shop-id",
+		CoordinatorID: "coordinator-id",
+		Activated:     true,
+	}
+	ordermocks := func(mocks *mocks, inventory int64) {
+		mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(customer, nil)
+		mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil)
+		mocks.db.Product.EXPECT().MultiGet(gomock.Any(), []string{"product-id"}).Return(products(inventory), nil)
+		mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil)
+		mocks.user.EXPECT().GetShopByCoordinatorID(gomock.Any(), shopIn).Return(shop, nil)
+	}
+	claimParams := &database.UpdateSubscriptionNextRunAtParams{
+		CurrentNextRunAt: jst.Date(2026, 10, 18, 0, 0, 0, 0),
+		NextRunAt:        jst.Date(2026, 10, 25, 0, 0, 0, 0),
+	}
+	failedmocks := func(mocks *mocks, failureCount int64) {
+		params := &database.UpdateSubscriptionParams{
+			Status:       entity.SubscriptionStatusActive,
+			NextRunAt:    now.Add(24 * time.Hour),
+			FailureCount: failureCount,
+			LastFailedAt: now,
+		}
+		notifyIn := &messenger.NotifySubscriptionRenewalFailedInput{
+			SubscriptionID: "subscription-id",
+		}
+		mocks.db.Subscription.EXPECT().Update(gomock.Any(), "subscription-id", params).Return(nil)
+		mocks.messenger.EXPECT().NotifySubscriptionRenewalFailed(gomock.Any(), notifyIn).Return(nil)
+	}
+	tests := []struct {
+		name      string
+		setup     func(ctx context.Context, t *testing.T, mocks *mocks)
+		input     *store.RenewSubscriptionInput
+		expectErr error
+	}{
+		{
+			name: "success",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				var orderID string
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(), nil)
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(ctx, "subscription-id", claimParams).Return(nil)
+				ordermocks(mocks, 10)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.Order.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, order *entity.Order) error {
+						orderID = order.ID
+						assert.Equal(t, "user-id", order.UserID)
+						assert.Equal(t, "shop-id", order.ShopID)
+						assert.Equal(t, entity.PaymentMethodTypeCreditCard, order.OrderPayment.MethodType)
+						assert.Equal(t, entity.PaymentProviderTypeStripe, order.OrderPayment.ProviderType)
+						assert.Equal(t, entity.PaymentStatusPending, order.OrderPayment.Status)
+						return nil
+					})
+				mocks.payment.EXPECT().
+					ChargeSavedPaymentMethod(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, params *payment.ChargeSavedPaymentMethodParams) (*payment.ChargeResult, error) {
+						assert.Equal(t, orderID, params.OrderID)
+						assert.Equal(t, "cus_xxx", params.CustomerID)
+						assert.Equal(t, "pm_xxx", params.PaymentMethodID)
+						return &payment.ChargeResult{PaymentID: "pi_xxx", Status: entity.PaymentStatusAuthorized}, nil
+					})
+				mocks.db.Subscription.EXPECT().
+					Update(gomock.Any(), "subscription-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, subscriptionID string, params *database.UpdateSubscriptionParams) error {
+						expect := &database.UpdateSubscriptionParams{
+							Status:      entity.SubscriptionStatusActive,
+							NextRunAt:   jst.Date(2026, 10, 25, 0, 0, 0, 0),
+							LastOrderID: orderID,
+						}
+						assert.Equal(t, expect, params)
+						return nil
+					})
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "not renewable",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				subscription := subscription()
+				subscription.Status = entity.SubscriptionStatusPaused
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription, nil)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name:      "invalid argument",
+			setup:     func(ctx context.Context, t *testing.T, mocks *mocks) {},
+			input:     &store.RenewSubscriptionInput{},
+			expectErr: exception.ErrInvalidArgument,
+		},
+		{
+			name: "failed to get subscription",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(nil, assert.AnError)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "already renewed by another process",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(), nil)
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(ctx, "subscription-id", claimParams).Return(database.ErrFailedPrecondition)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "failed to claim renewal",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(), nil)
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(ctx, "subscription-id", claimParams).Return(assert.AnError)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+		{
+			name: "insufficient stock",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(), nil)
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(ctx, "subscription-id", claimParams).Return(nil)
+				ordermocks(mocks, 1)
+				failedmocks(mocks, 1)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "failed to charge",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(), nil)
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(ctx, "subscription-id", claimParams).Return(nil)
+				ordermocks(mocks, 10)
+				mocks.db.ProductInventoryHold.EXPECT().Hold(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.db.Order.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.payment.EXPECT().ChargeSavedPaymentMethod(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+				mocks.db.Order.EXPECT().
+					UpdateFailed(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, orderID string, params *database.UpdateOrderFailedParams) error {
+						assert.Equal(t, entity.PaymentStatusFailed, params.Status)
+						return nil
+					})
+				mocks.db.ProductInventoryHold.EXPECT().Release(gomock.Any(), gomock.Any()).Return(nil)
+				failedmocks(mocks, 1)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "suspended after max failures",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				subscription := subscription()
+				subscription.FailureCount = entity.SubscriptionMaxRenewalFailures - 1
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription, nil)
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(ctx, "subscription-id", claimParams).Return(nil)
+				ordermocks(mocks, 1)
+				mocks.db.Subscription.EXPECT().
+					Update(gomock.Any(), "subscription-id", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, subscriptionID string, params *database.UpdateSubscriptionParams) error {
+						assert.Equal(t, entity.SubscriptionStatusSuspended, params.Status)
+						return nil
+					})
+				mocks.messenger.EXPECT().NotifySubscriptionRenewalFailed(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: nil,
+		},
+		{
+			name: "failed to get user",
+			setup: func(ctx context.Context, t *testing.T, mocks *mocks) {
+				rollbackParams := &database.UpdateSubscriptionNextRunAtParams{
+					CurrentNextRunAt: jst.Date(2026, 10, 25, 0, 0, 0, 0),
+					NextRunAt:        jst.Date(2026, 10, 18, 0, 0, 0, 0),
+				}
+				mocks.db.Subscription.EXPECT().Get(ctx, "subscription-id").Return(subscription(), nil)
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(ctx, "subscription-id", claimParams).Return(nil)
+				mocks.user.EXPECT().GetUser(gomock.Any(), customerIn).Return(nil, assert.AnError)
+				mocks.user.EXPECT().MultiGetAddressesByRevision(gomock.Any(), addressesIn).Return(addresses, nil).AnyTimes()
+				mocks.db.Product.EXPECT().MultiGet(gomock.Any(), []string{"product-id"}).Return(products(10), nil).AnyTimes()
+				mocks.db.Shipping.EXPECT().GetByCoordinatorID(gomock.Any(), "coordinator-id").Return(shipping, nil).AnyTimes()
+				mocks.user.EXPECT().GetShopByCoordinatorID(gomock.Any(), shopIn).Return(shop, nil).AnyTimes()
+				mocks.db.Subscription.EXPECT().UpdateNextRunAt(gomock.Any(), "subscription-id", rollbackParams).Return(nil)
+			},
+			input: &store.RenewSubscriptionInput{
+				SubscriptionID: "subscription-id",
+			},
+			expectErr: exception.ErrInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, testService(func(ctx context.Context, mocks *mocks) {
+			tt.setup(ctx, t, mocks)
+		}, func(ctx context.Context, t *testing.T, service *service) {
+			err := service.RenewSubscription(ctx, tt.input)
+			require.ErrorIs(t, err, tt.expectErr)
+		}, withNow(now)))
+	}
+}
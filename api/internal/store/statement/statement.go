@@ -0,0 +1,126 @@
+// Package statement - 精算明細（支払明細書）の出力
+package statement
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/and-period/furumaru/api/pkg/pdf"
+)
+
+const title = "支払明細書"
+
+// Statement - 支払明細書の記載内容
+type Statement struct {
+	IssuedAt       time.Time // 発行日
+	StatementID    string    // 精算明細ID
+	PayeeType      string    // 精算先種別
+	PayeeName      string    // 精算先名
+	PeriodStartAt  time.Time // 精算対象期間(開始)
+	PeriodEndAt    time.Time // 精算対象期間(終了)
+	OrderCount     int64     // 対象注文数
+	SalesTotal     int64     // 売上金額
+	RefundTotal    int64     // 返金金額
+	CommissionRate float64   // 販売手数料率(%)
+	CommissionFee  int64     // 販売手数料
+	PaymentFee     int64     // 決済手数料
+	PayoutTotal    int64     // 支払い金額
+	Status         string    // 支払い状況
+	PaidAt         time.Time // 支払い日時
+}
+
+type Params struct {
+	Statement *entity.SettlementStatement
+	PayeeName string
+	Now       time.Time
+}
+
+func NewStatement(params *Params) *Statement {
+	s := params.Statement
+	return &Statement{
+		IssuedAt:       params.Now,
+		StatementID:    s.ID,
+		PayeeType:      s.PayeeType.String(),
+		PayeeName:      params.PayeeName,
+		PeriodStartAt:  s.PeriodStartAt,
+		PeriodEndAt:    s.PeriodEndAt,
+		OrderCount:     s.OrderCount,
+		SalesTotal:     s.SalesTotal,
+		RefundTotal:    s.RefundTotal,
+		CommissionRate: s.CommissionRate,
+		CommissionFee:  s.CommissionFee,
+		PaymentFee:     s.PaymentFee,
+		PayoutTotal:    s.PayoutTotal,
+		Status:         s.Status.String(),
+		PaidAt:         s.PaidAt,
+	}
+}
+
+// Write - PDF形式で出力
+func (s *Statement) Write(w io.Writer, opts ...pdf.Option) error {
+	const (
+		left  = 48.0
+		right = pdf.PageWidthA4 - 48.0
+	)
+	doc := pdf.NewDocument(opts...)
+	doc.AddPage()
+
+	y := 64.0
+	doc.Text(left, y, 20, title)
+	doc.TextRight(right, y, 9, "発行日: "+jst.Format(s.IssuedAt, "2006年01月02日"))
+	y += 36
+	doc.Text(left, y, 14, s.PayeeName+" 様")
+	doc.Line(left, y+6, left+240, y+6, 0.8)
+	doc.TextRight(right, y, 9, "精算明細ID: "+s.StatementID)
+	y += 44
+	doc.Text(left, y, 14, "お支払い金額 "+yen(s.PayoutTotal))
+	y += 28
+	// 精算対象期間は終了日時を含まないため、前日までの期間として表記する
+	period := jst.Format(s.PeriodStartAt, "2006年01月02日") + " 〜 " + jst.Format(s.PeriodEndAt.AddDate(0, 0, -1), "2006年01月02日")
+	doc.Text(left, y, 9, "精算対象期間: "+period)
+	doc.TextRight(right, y, 9, "精算先種別: "+s.PayeeType)
+	y += 14
+	doc.Text(left, y, 9, "対象注文数: "+strconv.FormatInt(s.OrderCount, 10)+"件")
+	status := "支払い状況: " + s.Status
+	if !s.PaidAt.IsZero() {
+		status += "（" + jst.Format(s.PaidAt, "2006年01月02日") + "）"
+	}
+	doc.TextRight(right, y, 9, status)
+
+	// 内訳
+	y += 28
+	doc.Text(left, y, 9, "項目")
+	doc.TextRight(right, y, 9, "金額")
+	doc.Line(left, y+5, right, y+5, 0.5)
+	details := [][2]string{
+		{"売上金額(税込)", yen(s.SalesTotal)},
+		{"返金金額(税込)", "-" + yen(s.RefundTotal)},
+		{"販売手数料(" + strconv.FormatFloat(s.CommissionRate, 'f', -1, 64) + "%)", "-" + yen(s.CommissionFee)},
+		{"決済手数料", "-" + yen(s.PaymentFee)},
+	}
+	for _, d := range details {
+		y += 18
+		doc.Text(left, y, 9, d[0])
+		doc.TextRight(right, y, 9, d[1])
+	}
+	doc.Line(left, y+6, right, y+6, 0.5)
+	y += 20
+	doc.Text(left, y, 10, "お支払い金額")
+	doc.TextRight(right, y, 10, yen(s.PayoutTotal))
+	return doc.Write(w)
+}
+
+func yen(amount int64) string {
+	str := strconv.FormatInt(amount, 10)
+	sign := ""
+	if amount < 0 {
+		sign, str = "-", str[1:]
+	}
+	for n := len(str) - 3; n > 0; n -= 3 {
+		str = str[:n] + "," + str[n:]
+	}
+	return sign + "¥" + str
+}
@@ -0,0 +1,80 @@
+package statement
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/store/entity"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatement(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 12, 0, 0, 0)
+	startAt := jst.Date(2026, 9, 1, 0, 0, 0, 0)
+	endAt := jst.Date(2026, 10, 1, 0, 0, 0, 0)
+	params := &Params{
+		Statement: &entity.SettlementStatement{
+			ID:             "statement-id",
+			PayeeType:      entity.SettlementPayeeTypeProducer,
+			PayeeID:        "producer-id",
+			Status:         entity.SettlementStatementStatusPaid,
+			PeriodStartAt:  startAt,
+			PeriodEndAt:    endAt,
+			OrderCount:     2,
+			SalesTotal:     3000,
+			RefundTotal:    1500,
+			CommissionRate: 10,
+			CommissionFee:  150,
+			PaymentFee:     84,
+			PayoutTotal:    1266,
+			PaidAt:         now,
+		},
+		PayeeName: "&.生産者",
+		Now:       now,
+	}
+	expect := &Statement{
+		IssuedAt:       now,
+		StatementID:    "statement-id",
+		PayeeType:      "生産者",
+		PayeeName:      "&.生産者",
+		PeriodStartAt:  startAt,
+		PeriodEndAt:    endAt,
+		OrderCount:     2,
+		SalesTotal:     3000,
+		RefundTotal:    1500,
+		CommissionRate: 10,
+		CommissionFee:  150,
+		PaymentFee:     84,
+		PayoutTotal:    1266,
+		Status:         "支払い済み",
+		PaidAt:         now,
+	}
+	actual := NewStatement(params)
+	assert.Equal(t, expect, actual)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, actual.Write(buf))
+	assert.True(t, strings.HasPrefix(buf.String(), "%PDF-"))
+}
+
+func TestYen(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		amount int64
+		expect string
+	}{
+		{amount: 0, expect: "¥0"},
+		{amount: 1266, expect: "¥1,266"},
+		{amount: -2562, expect: "-¥2,562"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expect, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, yen(tt.amount))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package tracker
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// Record - 追跡ファイルの1行 (列名をキーとする)
+type Record map[string]string
+
+// Get - 列名に対応する値を取得する (前後の空白は除去する)
+func (r Record) Get(column string) string {
+	return strings.TrimSpace(r[column])
+}
+
+// ParseCSV - ヘッダー行付きの追跡ファイルを読み込み、追跡イベントの一覧に変換する
+func ParseCSV(r io.Reader, encodingType codes.CharacterEncodingType, parser RecordParser) (Events, error) {
+	if encodingType == codes.CharacterEncodingTypeShiftJIS {
+		r = transform.NewReader(r, japanese.ShiftJIS.NewDecoder())
+	}
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return Events{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracker: failed to read csv header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+
+	res := make(Events, 0)
+	for line := 2; ; line++ {
+		values, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tracker: failed to read csv record. line=%d: %w", line, err)
+		}
+		record := make(Record, len(header))
+		for i := range header {
+			if i < len(values) {
+				record[header[i]] = values[i]
+			}
+		}
+		event, err := parser(record)
+		if err != nil {
+			return nil, fmt.Errorf("tracker: failed to parse csv record. line=%d: %w", line, err)
+		}
+		if event == nil {
+			continue
+		}
+		res = append(res, event)
+	}
+	return res, nil
+}
+
+// NormalizeTrackingNumber - 伝票番号から区切り文字を除去する
+func NormalizeTrackingNumber(trackingNumber string) string {
+	return strings.NewReplacer("-", "", " ", "", "　", "").Replace(strings.TrimSpace(trackingNumber))
+}
@@ -0,0 +1,113 @@
+package tracker
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+	parser := func(record Record) (*Event, error) {
+		if record.Get("番号") == "" {
+			return nil, nil
+		}
+		if record.Get("状況") == "error" {
+			return nil, errors.New("some error")
+		}
+		event := &Event{
+			TrackingNumber: record.Get("番号"),
+			Status:         record.Get("状況"),
+		}
+		return event, nil
+	}
+	shiftJIS := func(s string) string {
+		res, _, err := transform.String(japanese.ShiftJIS.NewEncoder(), s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	tests := []struct {
+		name         string
+		body         string
+		encodingType codes.CharacterEncodingType
+		expect       Events
+		hasErr       bool
+	}{
+		{
+			name:         "success utf-8",
+			body:         "\ufeff番号,状況\n123456789012, 配達完了 \n,\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect: Events{
+				{TrackingNumber: "123456789012", Status: "配達完了"},
+			},
+			hasErr: false,
+		},
+		{
+			name:         "success shift-jis",
+			body:         shiftJIS("番号,状況\n123456789012,配達完了\n"),
+			encodingType: codes.CharacterEncodingTypeShiftJIS,
+			expect: Events{
+				{TrackingNumber: "123456789012", Status: "配達完了"},
+			},
+			hasErr: false,
+		},
+		{
+			name:         "success short record",
+			body:         "番号,状況\n123456789012\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect: Events{
+				{TrackingNumber: "123456789012", Status: ""},
+			},
+			hasErr: false,
+		},
+		{
+			name:         "success empty",
+			body:         "",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect:       Events{},
+			hasErr:       false,
+		},
+		{
+			name:         "failed to parse record",
+			body:         "番号,状況\n123456789012,error\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect:       nil,
+			hasErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := ParseCSV(bytes.NewBufferString(tt.body), tt.encodingType, parser)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestNormalizeTrackingNumber(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{name: "hyphen", input: "1234-5678-9012", expect: "123456789012"},
+		{name: "space", input: " 1234 5678　9012 ", expect: "123456789012"},
+		{name: "empty", input: strings.Repeat(" ", 3), expect: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, NormalizeTrackingNumber(tt.input))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package tracker
+
+import (
+	"strings"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/set"
+)
+
+// EventType - 追跡イベント種別
+type EventType int32
+
+const (
+	EventTypeUnknown        EventType = 0
+	EventTypeAccepted       EventType = 1 // 荷受
+	EventTypeInTransit      EventType = 2 // 輸送中
+	EventTypeOutForDelivery EventType = 3 // 配達中
+	EventTypeDelivered      EventType = 4 // 配達完了
+	EventTypeAbsent         EventType = 5 // 不在持ち戻り
+	EventTypeReturned       EventType = 6 // 返送
+)
+
+// Event - 追跡イベント
+type Event struct {
+	TrackingNumber string    // 伝票番号
+	Type           EventType // 追跡イベント種別
+	Status         string    // 配送業者の表示する配送状況
+	Location       string    // 取扱店
+	OccurredAt     time.Time // 発生日時
+}
+
+type Events []*Event
+
+// StatusRule - 配送業者の表示する配送状況と追跡イベント種別の対応
+type StatusRule struct {
+	Keyword string
+	Type    EventType
+}
+
+type StatusRules []*StatusRule
+
+// EventType - 配送状況に含まれるキーワードから追跡イベント種別を判定する (先に定義したものを優先)
+func (rs StatusRules) EventType(status string) EventType {
+	for _, r := range rs {
+		if strings.Contains(status, r.Keyword) {
+			return r.Type
+		}
+	}
+	return EventTypeUnknown
+}
+
+func (e *Event) Delivered() bool {
+	return e.Type == EventTypeDelivered
+}
+
+func (es Events) TrackingNumbers() []string {
+	res := set.NewEmpty[string](len(es))
+	for _, e := range es {
+		res.Add(e.TrackingNumber)
+	}
+	return res.Slice()
+}
+
+// Delivered - 伝票番号ごとの配達完了イベント (複数ある場合は最初に配達完了したもの)
+func (es Events) Delivered() map[string]*Event {
+	res := make(map[string]*Event, len(es))
+	for _, e := range es {
+		if !e.Delivered() {
+			continue
+		}
+		if current, ok := res[e.TrackingNumber]; ok && !e.OccurredAt.Before(current.OccurredAt) {
+			continue
+		}
+		res[e.TrackingNumber] = e
+	}
+	return res
+}
@@ -0,0 +1,45 @@
+package tracker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusRules_EventType(t *testing.T) {
+	t.Parallel()
+	rules := StatusRules{
+		{Keyword: "配達完了", Type: EventTypeDelivered},
+		{Keyword: "配達", Type: EventTypeOutForDelivery},
+	}
+	tests := []struct {
+		name   string
+		status string
+		expect EventType
+	}{
+		{name: "delivered", status: "配達完了", expect: EventTypeDelivered},
+		{name: "out for delivery", status: "配達中", expect: EventTypeOutForDelivery},
+		{name: "unknown", status: "調査中", expect: EventTypeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, rules.EventType(tt.status))
+		})
+	}
+}
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+	now := jst.Date(2026, 10, 18, 10, 30, 0, 0)
+	events := Events{
+		{TrackingNumber: "123456789012", Type: EventTypeOutForDelivery, OccurredAt: now.Add(-time.Hour)},
+		{TrackingNumber: "123456789012", Type: EventTypeDelivered, OccurredAt: now.Add(time.Hour)},
+		{TrackingNumber: "123456789012", Type: EventTypeDelivered, OccurredAt: now},
+		{TrackingNumber: "987654321098", Type: EventTypeAbsent, OccurredAt: now},
+	}
+	assert.ElementsMatch(t, []string{"123456789012", "987654321098"}, events.TrackingNumbers())
+	assert.Equal(t, map[string]*Event{"123456789012": events[2]}, events.Delivered())
+}
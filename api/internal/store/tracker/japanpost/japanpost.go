@@ -0,0 +1,64 @@
+// Package japanpost - 日本郵便の配送状況
+package japanpost
+
+import (
+	"fmt"
+
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+const (
+	columnTrackingNumber = "お問い合わせ番号"
+	columnOccurredAt     = "状態発生日"
+	columnStatus         = "配送履歴"
+	columnLocation       = "取扱局"
+)
+
+var statusRules = tracker.StatusRules{
+	{Keyword: "お届け済み", Type: tracker.EventTypeDelivered},
+	{Keyword: "ご不在", Type: tracker.EventTypeAbsent},
+	{Keyword: "返送", Type: tracker.EventTypeReturned},
+	{Keyword: "持ち出し中", Type: tracker.EventTypeOutForDelivery},
+	{Keyword: "通過", Type: tracker.EventTypeInTransit},
+	{Keyword: "到着", Type: tracker.EventTypeInTransit},
+	{Keyword: "引受", Type: tracker.EventTypeAccepted},
+}
+
+type Params struct {
+	Endpoint string // 追跡APIのエンドポイント
+	APIKey   string // 追跡APIの認証キー
+}
+
+func NewTracker(params *Params, opts ...tracker.PollerOption) tracker.Tracker {
+	pollerParams := &tracker.PollerParams{
+		Endpoint: params.Endpoint,
+		APIKey:   params.APIKey,
+	}
+	return tracker.NewTracker(tracker.NewPoller(pollerParams, opts...), parseRecord)
+}
+
+// parseRecord - 個別番号検索結果の1行を追跡イベントに変換する
+// e.g.) お問い合わせ番号,状態発生日,配送履歴,取扱局
+//
+//	1234-5678-9012,2026/10/18 10:30,お届け先にお届け済み,渋谷郵便局
+func parseRecord(record tracker.Record) (*tracker.Event, error) {
+	trackingNumber := tracker.NormalizeTrackingNumber(record.Get(columnTrackingNumber))
+	if trackingNumber == "" {
+		return nil, nil
+	}
+	value := record.Get(columnOccurredAt)
+	occurredAt, err := jst.Parse("2006/01/02 15:04", value)
+	if err != nil {
+		return nil, fmt.Errorf("japanpost: invalid occurred at. value=%s: %w", value, err)
+	}
+	status := record.Get(columnStatus)
+	event := &tracker.Event{
+		TrackingNumber: trackingNumber,
+		Type:           statusRules.EventType(status),
+		Status:         status,
+		Location:       record.Get(columnLocation),
+		OccurredAt:     occurredAt,
+	}
+	return event, nil
+}
@@ -0,0 +1,100 @@
+package japanpost
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker_Parse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		body   string
+		expect tracker.Events
+		hasErr bool
+	}{
+		{
+			name: "success",
+			body: "お問い合わせ番号,状態発生日,配送履歴,取扱局\n" +
+				"1234-5678-9012,2026/10/16 18:00,引受,品川郵便局\n" +
+				"1234-5678-9012,2026/10/17 02:15,到着,東京国際郵便局\n" +
+				"1234-5678-9012,2026/10/18 08:40,持ち出し中,渋谷郵便局\n" +
+				"1234-5678-9012,2026/10/18 10:30,お届け先にお届け済み,渋谷郵便局\n" +
+				"9876-5432-1098,2026/10/18 11:00,差出人に返送,新宿郵便局\n",
+			expect: tracker.Events{
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeAccepted,
+					Status:         "引受",
+					Location:       "品川郵便局",
+					OccurredAt:     jst.Date(2026, 10, 16, 18, 0, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeInTransit,
+					Status:         "到着",
+					Location:       "東京国際郵便局",
+					OccurredAt:     jst.Date(2026, 10, 17, 2, 15, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeOutForDelivery,
+					Status:         "持ち出し中",
+					Location:       "渋谷郵便局",
+					OccurredAt:     jst.Date(2026, 10, 18, 8, 40, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeDelivered,
+					Status:         "お届け先にお届け済み",
+					Location:       "渋谷郵便局",
+					OccurredAt:     jst.Date(2026, 10, 18, 10, 30, 0, 0),
+				},
+				{
+					TrackingNumber: "987654321098",
+					Type:           tracker.EventTypeReturned,
+					Status:         "差出人に返送",
+					Location:       "新宿郵便局",
+					OccurredAt:     jst.Date(2026, 10, 18, 11, 0, 0, 0),
+				},
+			},
+			hasErr: false,
+		},
+		{
+			name: "invalid occurred at",
+			body: "お問い合わせ番号,状態発生日,配送履歴,取扱局\n" +
+				"1234-5678-9012,,お届け先にお届け済み,渋谷郵便局\n",
+			expect: nil,
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tracker := NewTracker(&Params{})
+			actual, err := tracker.Parse(bytes.NewBufferString(tt.body), codes.CharacterEncodingTypeUTF8)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestTracker_Track(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tracker := NewTracker(&Params{Endpoint: server.URL})
+	_, err := tracker.Track(context.Background(), []string{"123456789012"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Poller - 配送業者の追跡APIから配送状況ファイルを取得する
+type Poller interface {
+	// Poll - 伝票番号を分割して追跡APIを呼び出し、レスポンスごとにfnを実行する
+	Poll(ctx context.Context, trackingNumbers []string, fn func(r io.Reader) error) error
+}
+
+type poller struct {
+	client    *http.Client
+	endpoint  string
+	apiKey    string
+	chunkSize int
+}
+
+type PollerParams struct {
+	Endpoint string // 追跡APIのエンドポイント
+	APIKey   string // 追跡APIの認証キー
+}
+
+type pollerOptions struct {
+	client    *http.Client
+	chunkSize int
+}
+
+type PollerOption func(*pollerOptions)
+
+func WithHTTPClient(client *http.Client) PollerOption {
+	return func(opts *pollerOptions) {
+		opts.client = client
+	}
+}
+
+func WithChunkSize(size int) PollerOption {
+	return func(opts *pollerOptions) {
+		opts.chunkSize = size
+	}
+}
+
+func NewPoller(params *PollerParams, opts ...PollerOption) Poller {
+	dopts := &pollerOptions{
+		client:    &http.Client{Timeout: 30 * time.Second},
+		chunkSize: 50,
+	}
+	for i := range opts {
+		opts[i](dopts)
+	}
+	return &poller{
+		client:    dopts.client,
+		endpoint:  params.Endpoint,
+		apiKey:    params.APIKey,
+		chunkSize: dopts.chunkSize,
+	}
+}
+
+func (p *poller) Poll(ctx context.Context, trackingNumbers []string, fn func(r io.Reader) error) error {
+	for start := 0; start < len(trackingNumbers); start += p.chunkSize {
+		end := min(start+p.chunkSize, len(trackingNumbers))
+		if err := p.poll(ctx, trackingNumbers[start:end], fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *poller) poll(ctx context.Context, trackingNumbers []string, fn func(r io.Reader) error) error {
+	u, err := url.Parse(p.endpoint)
+	if err != nil {
+		return fmt.Errorf("tracker: failed to parse endpoint: %w", err)
+	}
+	query := u.Query()
+	query.Set("trackingNumbers", strings.Join(trackingNumbers, ","))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("tracker: failed to create request: %w", err)
+	}
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("tracker: failed to request: %w", err)
+	}
+	defer res.Body.Close() //nolint:errcheck
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("tracker: unexpected status code. status=%d", res.StatusCode)
+	}
+	return fn(res.Body)
+}
@@ -0,0 +1,79 @@
+package tracker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoller(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		status          int
+		trackingNumbers []string
+		expectQueries   []string
+		expectBodies    []string
+		hasErr          bool
+	}{
+		{
+			name:            "success",
+			status:          http.StatusOK,
+			trackingNumbers: []string{"1", "2", "3"},
+			expectQueries:   []string{"1,2", "3"},
+			expectBodies:    []string{"body:1,2", "body:3"},
+			hasErr:          false,
+		},
+		{
+			name:            "success empty",
+			status:          http.StatusOK,
+			trackingNumbers: []string{},
+			expectQueries:   []string{},
+			expectBodies:    []string{},
+			hasErr:          false,
+		},
+		{
+			name:            "unexpected status",
+			status:          http.StatusInternalServerError,
+			trackingNumbers: []string{"1"},
+			expectQueries:   []string{"1"},
+			expectBodies:    []string{},
+			hasErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			queries := make([]string, 0)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer api-key", r.Header.Get("Authorization"))
+				query := r.URL.Query().Get("trackingNumbers")
+				queries = append(queries, query)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("body:" + query))
+			}))
+			defer server.Close()
+
+			params := &PollerParams{
+				Endpoint: server.URL,
+				APIKey:   "api-key",
+			}
+			poller := NewPoller(params, WithHTTPClient(server.Client()), WithChunkSize(2))
+			bodies := make([]string, 0)
+			err := poller.Poll(context.Background(), tt.trackingNumbers, func(r io.Reader) error {
+				body, err := io.ReadAll(r)
+				require.NoError(t, err)
+				bodies = append(bodies, string(body))
+				return nil
+			})
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expectQueries, queries)
+			assert.Equal(t, tt.expectBodies, bodies)
+		})
+	}
+}
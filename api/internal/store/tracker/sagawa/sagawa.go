@@ -0,0 +1,63 @@
+// Package sagawa - 佐川急便の配送状況
+package sagawa
+
+import (
+	"fmt"
+
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+const (
+	columnTrackingNumber = "お問い合せ送り状NO"
+	columnStatus         = "詳細"
+	columnOccurredAt     = "日時"
+	columnLocation       = "担当営業所"
+)
+
+var statusRules = tracker.StatusRules{
+	{Keyword: "配達完了", Type: tracker.EventTypeDelivered},
+	{Keyword: "ご不在", Type: tracker.EventTypeAbsent},
+	{Keyword: "返送", Type: tracker.EventTypeReturned},
+	{Keyword: "配達中", Type: tracker.EventTypeOutForDelivery},
+	{Keyword: "輸送中", Type: tracker.EventTypeInTransit},
+	{Keyword: "集荷", Type: tracker.EventTypeAccepted},
+}
+
+type Params struct {
+	Endpoint string // 追跡APIのエンドポイント
+	APIKey   string // 追跡APIの認証キー
+}
+
+func NewTracker(params *Params, opts ...tracker.PollerOption) tracker.Tracker {
+	pollerParams := &tracker.PollerParams{
+		Endpoint: params.Endpoint,
+		APIKey:   params.APIKey,
+	}
+	return tracker.NewTracker(tracker.NewPoller(pollerParams, opts...), parseRecord)
+}
+
+// parseRecord - 荷物追跡結果の1行を追跡イベントに変換する
+// e.g.) お問い合せ送り状NO,詳細,日時,担当営業所
+//
+//	123456789012,配達完了,2026/10/18 10:30,東京営業所
+func parseRecord(record tracker.Record) (*tracker.Event, error) {
+	trackingNumber := tracker.NormalizeTrackingNumber(record.Get(columnTrackingNumber))
+	if trackingNumber == "" {
+		return nil, nil
+	}
+	value := record.Get(columnOccurredAt)
+	occurredAt, err := jst.Parse("2006/01/02 15:04", value)
+	if err != nil {
+		return nil, fmt.Errorf("sagawa: invalid occurred at. value=%s: %w", value, err)
+	}
+	status := record.Get(columnStatus)
+	event := &tracker.Event{
+		TrackingNumber: trackingNumber,
+		Type:           statusRules.EventType(status),
+		Status:         status,
+		Location:       record.Get(columnLocation),
+		OccurredAt:     occurredAt,
+	}
+	return event, nil
+}
@@ -0,0 +1,118 @@
+package sagawa
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+func TestTracker_Parse(t *testing.T) {
+	t.Parallel()
+	shiftJIS := func(s string) string {
+		res, _, err := transform.String(japanese.ShiftJIS.NewEncoder(), s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	tests := []struct {
+		name         string
+		body         string
+		encodingType codes.CharacterEncodingType
+		expect       tracker.Events
+		hasErr       bool
+	}{
+		{
+			name: "success",
+			body: shiftJIS("お問い合せ送り状NO,詳細,日時,担当営業所\n" +
+				"123456789012,集荷,2026/10/16 18:00,品川営業所\n" +
+				"123456789012,輸送中,2026/10/17 02:15,東京営業所\n" +
+				"123456789012,配達中,2026/10/18 08:40,渋谷営業所\n" +
+				"123456789012,配達完了,2026/10/18 10:30,渋谷営業所\n" +
+				"987654321098,ご不在,2026/10/18 11:00,新宿営業所\n"),
+			encodingType: codes.CharacterEncodingTypeShiftJIS,
+			expect: tracker.Events{
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeAccepted,
+					Status:         "集荷",
+					Location:       "品川営業所",
+					OccurredAt:     jst.Date(2026, 10, 16, 18, 0, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeInTransit,
+					Status:         "輸送中",
+					Location:       "東京営業所",
+					OccurredAt:     jst.Date(2026, 10, 17, 2, 15, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeOutForDelivery,
+					Status:         "配達中",
+					Location:       "渋谷営業所",
+					OccurredAt:     jst.Date(2026, 10, 18, 8, 40, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeDelivered,
+					Status:         "配達完了",
+					Location:       "渋谷営業所",
+					OccurredAt:     jst.Date(2026, 10, 18, 10, 30, 0, 0),
+				},
+				{
+					TrackingNumber: "987654321098",
+					Type:           tracker.EventTypeAbsent,
+					Status:         "ご不在",
+					Location:       "新宿営業所",
+					OccurredAt:     jst.Date(2026, 10, 18, 11, 0, 0, 0),
+				},
+			},
+			hasErr: false,
+		},
+		{
+			name: "invalid occurred at",
+			body: "お問い合せ送り状NO,詳細,日時,担当営業所\n" +
+				"123456789012,配達完了,20261018,渋谷営業所\n",
+			encodingType: codes.CharacterEncodingTypeUTF8,
+			expect:       nil,
+			hasErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tracker := NewTracker(&Params{})
+			actual, err := tracker.Parse(bytes.NewBufferString(tt.body), tt.encodingType)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestTracker_Track(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer api-key", r.Header.Get("Authorization"))
+		assert.Equal(t, "123456789012", r.URL.Query().Get("trackingNumbers"))
+		_, _ = w.Write([]byte("お問い合せ送り状NO,詳細,日時,担当営業所\n" +
+			"123456789012,配達完了,2026/10/18 10:30,渋谷営業所\n"))
+	}))
+	defer server.Close()
+
+	tracker := NewTracker(&Params{Endpoint: server.URL, APIKey: "api-key"})
+	actual, err := tracker.Track(context.Background(), []string{"123456789012"})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.True(t, actual[0].Delivered())
+	assert.Equal(t, jst.Date(2026, 10, 18, 10, 30, 0, 0), actual[0].OccurredAt)
+}
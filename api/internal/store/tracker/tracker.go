@@ -0,0 +1,50 @@
+//go:generate go tool mockgen -source=$GOFILE -package=mock_tracker -destination=./../../../mock/store/tracker/tracker.go
+package tracker
+
+import (
+	"context"
+	"io"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+)
+
+// Tracker - 配送業者ごとの配送状況の取得
+type Tracker interface {
+	// Parse - 配送業者が提供する追跡ファイルを解析する
+	Parse(r io.Reader, encodingType codes.CharacterEncodingType) (Events, error)
+	// Track - 配送業者の追跡APIから配送状況を取得する
+	Track(ctx context.Context, trackingNumbers []string) (Events, error)
+}
+
+// RecordParser - 追跡ファイルの1行を追跡イベントに変換する (対象外の行はnilを返す)
+type RecordParser func(record Record) (*Event, error)
+
+type tracker struct {
+	poller Poller
+	parser RecordParser
+}
+
+// NewTracker - 追跡ファイルの形式と追跡APIのクライアントから配送状況取得用のクライアントを生成する
+func NewTracker(poller Poller, parser RecordParser) Tracker {
+	return &tracker{
+		poller: poller,
+		parser: parser,
+	}
+}
+
+func (t *tracker) Parse(r io.Reader, encodingType codes.CharacterEncodingType) (Events, error) {
+	return ParseCSV(r, encodingType, t.parser)
+}
+
+func (t *tracker) Track(ctx context.Context, trackingNumbers []string) (Events, error) {
+	res := make(Events, 0, len(trackingNumbers))
+	err := t.poller.Poll(ctx, trackingNumbers, func(r io.Reader) error {
+		events, err := ParseCSV(r, codes.CharacterEncodingTypeUTF8, t.parser)
+		if err != nil {
+			return err
+		}
+		res = append(res, events...)
+		return nil
+	})
+	return res, err
+}
@@ -0,0 +1,67 @@
+// Package yamato - ヤマト運輸の配送状況
+package yamato
+
+import (
+	"fmt"
+
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/jst"
+)
+
+const (
+	columnTrackingNumber = "伝票番号"
+	columnStatus         = "荷物状況"
+	columnDate           = "日付"
+	columnTime           = "時刻"
+	columnLocation       = "担当店名"
+)
+
+var statusRules = tracker.StatusRules{
+	{Keyword: "配達完了", Type: tracker.EventTypeDelivered},
+	{Keyword: "投函完了", Type: tracker.EventTypeDelivered},
+	{Keyword: "持戻", Type: tracker.EventTypeAbsent},
+	{Keyword: "返品", Type: tracker.EventTypeReturned},
+	{Keyword: "配達中", Type: tracker.EventTypeOutForDelivery},
+	{Keyword: "作業店通過", Type: tracker.EventTypeInTransit},
+	{Keyword: "輸送中", Type: tracker.EventTypeInTransit},
+	{Keyword: "荷物受付", Type: tracker.EventTypeAccepted},
+	{Keyword: "発送済み", Type: tracker.EventTypeAccepted},
+}
+
+type Params struct {
+	Endpoint string // 追跡APIのエンドポイント
+	APIKey   string // 追跡APIの認証キー
+}
+
+func NewTracker(params *Params, opts ...tracker.PollerOption) tracker.Tracker {
+	pollerParams := &tracker.PollerParams{
+		Endpoint: params.Endpoint,
+		APIKey:   params.APIKey,
+	}
+	return tracker.NewTracker(tracker.NewPoller(pollerParams, opts...), parseRecord)
+}
+
+// parseRecord - 荷物問い合わせ結果の1行を追跡イベントに変換する
+// e.g.) 伝票番号,荷物状況,日付,時刻,担当店名
+//
+//	1234-5678-9012,配達完了,2026/10/18,10:30,渋谷センター
+func parseRecord(record tracker.Record) (*tracker.Event, error) {
+	trackingNumber := tracker.NormalizeTrackingNumber(record.Get(columnTrackingNumber))
+	if trackingNumber == "" {
+		return nil, nil
+	}
+	value := fmt.Sprintf("%s %s", record.Get(columnDate), record.Get(columnTime))
+	occurredAt, err := jst.Parse("2006/01/02 15:04", value)
+	if err != nil {
+		return nil, fmt.Errorf("yamato: invalid occurred at. value=%s: %w", value, err)
+	}
+	status := record.Get(columnStatus)
+	event := &tracker.Event{
+		TrackingNumber: trackingNumber,
+		Type:           statusRules.EventType(status),
+		Status:         status,
+		Location:       record.Get(columnLocation),
+		OccurredAt:     occurredAt,
+	}
+	return event, nil
+}
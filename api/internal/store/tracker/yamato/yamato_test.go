@@ -0,0 +1,106 @@
+package yamato
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/and-period/furumaru/api/internal/codes"
+	"github.com/and-period/furumaru/api/internal/store/tracker"
+	"github.com/and-period/furumaru/api/pkg/jst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker_Parse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		body   string
+		expect tracker.Events
+		hasErr bool
+	}{
+		{
+			name: "success",
+			body: "伝票番号,荷物状況,日付,時刻,担当店名\n" +
+				"1234-5678-9012,荷物受付,2026/10/16,18:00,品川センター\n" +
+				"1234-5678-9012,作業店通過,2026/10/17,02:15,羽田クロノゲート\n" +
+				"1234-5678-9012,配達中,2026/10/18,08:40,渋谷センター\n" +
+				"1234-5678-9012,配達完了,2026/10/18,10:30,渋谷センター\n" +
+				"9876-5432-1098,ご不在のため持戻,2026/10/18,11:00,新宿センター\n" +
+				",,,,\n",
+			expect: tracker.Events{
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeAccepted,
+					Status:         "荷物受付",
+					Location:       "品川センター",
+					OccurredAt:     jst.Date(2026, 10, 16, 18, 0, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeInTransit,
+					Status:         "作業店通過",
+					Location:       "羽田クロノゲート",
+					OccurredAt:     jst.Date(2026, 10, 17, 2, 15, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeOutForDelivery,
+					Status:         "配達中",
+					Location:       "渋谷センター",
+					OccurredAt:     jst.Date(2026, 10, 18, 8, 40, 0, 0),
+				},
+				{
+					TrackingNumber: "123456789012",
+					Type:           tracker.EventTypeDelivered,
+					Status:         "配達完了",
+					Location:       "渋谷センター",
+					OccurredAt:     jst.Date(2026, 10, 18, 10, 30, 0, 0),
+				},
+				{
+					TrackingNumber: "987654321098",
+					Type:           tracker.EventTypeAbsent,
+					Status:         "ご不在のため持戻",
+					Location:       "新宿センター",
+					OccurredAt:     jst.Date(2026, 10, 18, 11, 0, 0, 0),
+				},
+			},
+			hasErr: false,
+		},
+		{
+			name: "invalid occurred at",
+			body: "伝票番号,荷物状況,日付,時刻,担当店名\n" +
+				"1234-5678-9012,配達完了,2026-10-18,10:30,渋谷センター\n",
+			expect: nil,
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tracker := NewTracker(&Params{})
+			actual, err := tracker.Parse(bytes.NewBufferString(tt.body), codes.CharacterEncodingTypeUTF8)
+			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestTracker_Track(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "123456789012", r.URL.Query().Get("trackingNumbers"))
+		_, _ = w.Write([]byte("伝票番号,荷物状況,日付,時刻,担当店名\n" +
+			"1234-5678-9012,配達完了,2026/10/18,10:30,渋谷センター\n"))
+	}))
+	defer server.Close()
+
+	tracker := NewTracker(&Params{Endpoint: server.URL})
+	actual, err := tracker.Track(context.Background(), []string{"123456789012"})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.True(t, actual[0].Delivered())
+	assert.Equal(t, jst.Date(2026, 10, 18, 10, 30, 0, 0), actual[0].OccurredAt)
+}
@@ -360,9 +360,10 @@ type ListShopsParams struct {
 }
 
 type UpdateShopParams struct {
-	Name           string
-	ProductTypeIDs []string
-	BusinessDays   []time.Weekday
+	Name                      string
+	ProductTypeIDs            []string
+	BusinessDays              []time.Weekday
+	InvoiceRegistrationNumber string
 }
 
 type ListShopProducersParams struct {
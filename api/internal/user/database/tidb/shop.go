@@ -126,10 +126,11 @@ func (s *shop) Update(ctx context.Context, shopID string, params *database.Updat
 	}
 
 	updates := map[string]interface{}{
-		"name":             params.Name,
-		"product_type_ids": productTypeIDsVal,
-		"business_days":    businessDaysVal,
-		"updated_at":       s.now(),
+		"name":                        params.Name,
+		"product_type_ids":            productTypeIDsVal,
+		"business_days":               businessDaysVal,
+		"invoice_registration_number": params.InvoiceRegistrationNumber,
+		"updated_at":                  s.now(),
 	}
 	stmt := s.db.DB.WithContext(ctx).Table(shopTable).Where("id = ?", shopID)
 
@@ -10,16 +10,17 @@ import (
 
 // Shop - 店舗情報
 type Shop struct {
-	ID             string         `gorm:"primaryKey;<-:create"` // 店舗ID
-	CoordinatorID  string         `gorm:""`                     // コーディネータID
-	ProducerIDs    []string       `gorm:"-"`                    // 生産者ID一覧
-	ProductTypeIDs []string       `gorm:"-"`                    // 取り扱い商品種別ID一覧
-	BusinessDays   []time.Weekday `gorm:"-"`                    // 営業曜日(発送可能日)一覧
-	Name           string         `gorm:""`                     // 店舗名
-	Activated      bool           `gorm:""`                     // 有効フラグ
-	CreatedAt      time.Time      `gorm:"<-:create"`            // 登録日時
-	UpdatedAt      time.Time      `gorm:""`                     // 更新日時
-	DeletedAt      gorm.DeletedAt `gorm:"default:null"`         // 削除日時
+	ID                        string         `gorm:"primaryKey;<-:create"` // 店舗ID
+	CoordinatorID             string         `gorm:""`                     // コーディネータID
+	ProducerIDs               []string       `gorm:"-"`                    // 生産者ID一覧
+	ProductTypeIDs            []string       `gorm:"-"`                    // 取り扱い商品種別ID一覧
+	BusinessDays              []time.Weekday `gorm:"-"`                    // 営業曜日(発送可能日)一覧
+	Name                      string         `gorm:""`                     // 店舗名
+	InvoiceRegistrationNumber string         `gorm:""`                     // 適格請求書発行事業者登録番号(T+13桁)
+	Activated                 bool           `gorm:""`                     // 有効フラグ
+	CreatedAt                 time.Time      `gorm:"<-:create"`            // 登録日時
+	UpdatedAt                 time.Time      `gorm:""`                     // 更新日時
+	DeletedAt                 gorm.DeletedAt `gorm:"default:null"`         // 削除日時
 }
 
 type Shops []*Shop
@@ -46,6 +47,11 @@ func (s *Shop) Enabled() bool {
 	return s.Activated && s.DeletedAt.Time.IsZero()
 }
 
+// IsQualifiedInvoiceIssuer - 適格請求書発行事業者として登録されているか
+func (s *Shop) IsQualifiedInvoiceIssuer() bool {
+	return s.InvoiceRegistrationNumber != ""
+}
+
 func (s *Shop) Fill(producers ShopProducers) {
 	s.ProducerIDs = producers.ProducerIDs()
 }
@@ -561,10 +561,11 @@ type GetShopByCoordinatorIDInput struct {
 }
 
 type UpdateShopInput struct {
-	ShopID         string         `validate:"required"`
-	Name           string         `validate:"required,max=64"`
-	ProductTypeIDs []string       `validate:"dive,required"`
-	BusinessDays   []time.Weekday `validate:"max=7,unique"`
+	ShopID                    string         `validate:"required"`
+	Name                      string         `validate:"required,max=64"`
+	ProductTypeIDs            []string       `validate:"dive,required"`
+	BusinessDays              []time.Weekday `validate:"max=7,unique"`
+	InvoiceRegistrationNumber string         `validate:"omitempty,invoice_number"`
 }
 
 type RelateShopProducerInput struct {
@@ -93,9 +93,10 @@ func (s *service) UpdateShop(ctx context.Context, in *user.UpdateShopInput) erro
 		return fmt.Errorf("service: contains invalid product type ids: %w", exception.ErrInvalidArgument)
 	}
 	params := &database.UpdateShopParams{
-		Name:           in.Name,
-		ProductTypeIDs: in.ProductTypeIDs,
-		BusinessDays:   in.BusinessDays,
+		Name:                      in.Name,
+		ProductTypeIDs:            in.ProductTypeIDs,
+		BusinessDays:              in.BusinessDays,
+		InvoiceRegistrationNumber: in.InvoiceRegistrationNumber,
 	}
 	err = s.db.Shop.Update(ctx, in.ShopID, params)
 	return internalError(err)
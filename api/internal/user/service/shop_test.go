@@ -367,9 +367,10 @@ func TestUpdateShop(t *testing.T) {
 		},
 	}
 	params := &database.UpdateShopParams{
-		Name:           "テスト店舗",
-		ProductTypeIDs: []string{"product-type-id"},
-		BusinessDays:   []time.Weekday{time.Monday, time.Tuesday},
+		Name:                      "テスト店舗",
+		ProductTypeIDs:            []string{"product-type-id"},
+		BusinessDays:              []time.Weekday{time.Monday, time.Tuesday},
+		InvoiceRegistrationNumber: "T1234567890123",
 	}
 
 	tests := []struct {
@@ -387,10 +388,11 @@ func TestUpdateShop(t *testing.T) {
 				mocks.db.Shop.EXPECT().Update(ctx, "shop-id", params).Return(nil)
 			},
 			input: &user.UpdateShopInput{
-				ShopID:         "shop-id",
-				Name:           "テスト店舗",
-				ProductTypeIDs: []string{"product-type-id"},
-				BusinessDays:   []time.Weekday{time.Monday, time.Tuesday},
+				ShopID:                    "shop-id",
+				Name:                      "テスト店舗",
+				ProductTypeIDs:            []string{"product-type-id"},
+				BusinessDays:              []time.Weekday{time.Monday, time.Tuesday},
+				InvoiceRegistrationNumber: "T1234567890123",
 			},
 			expectErr: nil,
 		},
@@ -400,6 +402,18 @@ func TestUpdateShop(t *testing.T) {
 			input:     &user.UpdateShopInput{},
 			expectErr: exception.ErrInvalidArgument,
 		},
+		{
+			name:  "invalid invoice registration number",
+			setup: func(ctx context.Context, mocks *mocks) {},
+			input: &user.UpdateShopInput{
+				ShopID:                    "shop-id",
+				Name:                      "テスト店舗",
+				ProductTypeIDs:            []string{"product-type-id"},
+				BusinessDays:              []time.Weekday{time.Monday, time.Tuesday},
+				InvoiceRegistrationNumber: "1234567890123",
+			},
+			expectErr: exception.ErrInvalidArgument,
+		},
 		{
 			name: "failed to get product types",
 			setup: func(ctx context.Context, mocks *mocks) {
@@ -439,10 +453,11 @@ func TestUpdateShop(t *testing.T) {
 				mocks.db.Shop.EXPECT().Update(ctx, "shop-id", params).Return(assert.AnError)
 			},
 			input: &user.UpdateShopInput{
-				ShopID:         "shop-id",
-				Name:           "テスト店舗",
-				ProductTypeIDs: []string{"product-type-id"},
-				BusinessDays:   []time.Weekday{time.Monday, time.Tuesday},
+				ShopID:                    "shop-id",
+				Name:                      "テスト店舗",
+				ProductTypeIDs:            []string{"product-type-id"},
+				BusinessDays:              []time.Weekday{time.Monday, time.Tuesday},
+				InvoiceRegistrationNumber: "T1234567890123",
 			},
 			expectErr: exception.ErrInternal,
 		},
@@ -1,6 +1,11 @@
 package japanese
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
 
 var hiragana2katakana = strings.NewReplacer(
 	"が", "ガ", "ぎ", "ギ", "ぐ", "グ", "げ", "ゲ", "ご", "ゴ",
@@ -27,3 +32,16 @@ var hiragana2katakana = strings.NewReplacer(
 func HiraganaToKatakana(hiragana string) string {
 	return hiragana2katakana.Replace(hiragana)
 }
+
+// Normalize - 表記揺れを吸収するための正規化
+// 全角英数字・半角カナの幅を揃え（NFKC）、英字を小文字、ひらがなをカタカナに変換し、空白を除去する
+func Normalize(str string) string {
+	str = strings.ToLower(norm.NFKC.String(str))
+	str = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, str)
+	return HiraganaToKatakana(str)
+}
@@ -37,3 +37,44 @@ func TestHiraganaToKatakana(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		str    string
+		expect string
+	}{
+		{
+			name:   "ひらがな",
+			str:    "ばかやろう",
+			expect: "バカヤロウ",
+		},
+		{
+			name:   "半角カナ",
+			str:    "ﾊﾞｶﾔﾛｳ",
+			expect: "バカヤロウ",
+		},
+		{
+			name:   "全角英数字",
+			str:    "ＳＰＡＭ１２３",
+			expect: "spam123",
+		},
+		{
+			name:   "空白を含む",
+			str:    "ば　か や\tろう",
+			expect: "バカヤロウ",
+		},
+		{
+			name:   "漢字",
+			str:    "嶺上開花",
+			expect: "嶺上開花",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, Normalize(tt.str))
+		})
+	}
+}
@@ -12,7 +12,10 @@ import (
 )
 
 type MediaConvert interface {
-	CreateJob(ctx context.Context, template string, settings *types.JobSettings) error
+	// 変換ジョブの作成
+	CreateJob(ctx context.Context, template string, settings *types.JobSettings) (*Job, error)
+	// 変換ジョブの取得
+	GetJob(ctx context.Context, jobID string) (*Job, error)
 }
 
 type Params struct {
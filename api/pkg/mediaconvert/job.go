@@ -8,12 +8,58 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
 )
 
-func (c *client) CreateJob(ctx context.Context, template string, settings *types.JobSettings) error {
+// JobStatus - 変換ジョブの状態
+// @see - https://docs.aws.amazon.com/mediaconvert/latest/ug/how-mediaconvert-jobs-progress.html
+type JobStatus string
+
+const (
+	JobStatusSubmitted   JobStatus = "SUBMITTED"   // 受付済み
+	JobStatusProgressing JobStatus = "PROGRESSING" // 変換中
+	JobStatusComplete    JobStatus = "COMPLETE"    // 完了
+	JobStatusCanceled    JobStatus = "CANCELED"    // キャンセル
+	JobStatusError       JobStatus = "ERROR"       // 失敗
+)
+
+// Job - 変換ジョブ
+type Job struct {
+	ID              string    // ジョブID
+	Status          JobStatus // ジョブの状態
+	PercentComplete int32     // 進捗率(%)
+	ErrorMessage    string    // エラー内容
+}
+
+func (c *client) CreateJob(ctx context.Context, template string, settings *types.JobSettings) (*Job, error) {
 	in := &mediaconvert.CreateJobInput{
 		Role:        c.role,
 		Settings:    settings,
 		JobTemplate: aws.String(template),
 	}
-	_, err := c.convert.CreateJob(ctx, in)
-	return err
+	out, err := c.convert.CreateJob(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return newJob(out.Job), nil
+}
+
+func (c *client) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	in := &mediaconvert.GetJobInput{
+		Id: aws.String(jobID),
+	}
+	out, err := c.convert.GetJob(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return newJob(out.Job), nil
+}
+
+func newJob(job *types.Job) *Job {
+	if job == nil {
+		return &Job{}
+	}
+	return &Job{
+		ID:              aws.ToString(job.Id),
+		Status:          JobStatus(job.Status),
+		PercentComplete: aws.ToInt32(job.JobPercentComplete),
+		ErrorMessage:    aws.ToString(job.ErrorMessage),
+	}
 }
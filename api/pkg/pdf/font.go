@@ -0,0 +1,521 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"unicode/utf16"
+)
+
+var (
+	ErrUnsupportedFont = errors.New("pdf: unsupported font format")
+	ErrInvalidFont     = errors.New("pdf: invalid font data")
+)
+
+// サブセットフォントへ含めるテーブル（グリフの描画に必要なもののみ）
+var subsetTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// Font - PDFへ埋め込むTrueTypeフォント
+type Font struct {
+	name       string            // PostScript名
+	tables     map[string][]byte // テーブル名 -> テーブルデータ
+	unitsPerEm int64             // 1emあたりのユニット数
+	bbox       [4]int64          // 外接矩形(xMin, yMin, xMax, yMax)
+	ascent     int64             // アセンダー
+	descent    int64             // ディセンダー
+	capHeight  int64             // 大文字の高さ
+	numGlyphs  int               // グリフ数
+	advances   []uint16          // グリフID -> 送り幅
+	glyphs     map[rune]uint16   // 文字 -> グリフID
+	offsets    []uint32          // グリフID -> glyfテーブル内のオフセット(グリフ数+1件)
+}
+
+// ParseFont - TrueType(glyf形式)のフォントファイルを読み込む
+func ParseFont(b []byte) (*Font, error) {
+	if len(b) < 12 {
+		return nil, ErrInvalidFont
+	}
+	switch binary.BigEndian.Uint32(b) {
+	case 0x00010000, 0x74727565: // TrueType
+	default:
+		return nil, ErrUnsupportedFont // CFF形式(OTTO)・フォントコレクション(ttcf)は非対応
+	}
+	numTables := int(binary.BigEndian.Uint16(b[4:]))
+	if len(b) < 12+numTables*16 {
+		return nil, ErrInvalidFont
+	}
+	tables := make(map[string][]byte, numTables)
+	for i := range numTables {
+		record := b[12+i*16:]
+		offset := int(binary.BigEndian.Uint32(record[8:]))
+		length := int(binary.BigEndian.Uint32(record[12:]))
+		if offset < 0 || length < 0 || offset+length > len(b) {
+			return nil, ErrInvalidFont
+		}
+		tables[string(record[:4])] = b[offset : offset+length]
+	}
+	for _, tag := range []string{"cmap", "glyf", "head", "hhea", "hmtx", "loca", "maxp"} {
+		if _, ok := tables[tag]; !ok {
+			return nil, fmt.Errorf("%w: %q table is not found", ErrUnsupportedFont, tag)
+		}
+	}
+	f := &Font{tables: tables}
+	if err := f.parseMetrics(); err != nil {
+		return nil, err
+	}
+	if err := f.parseOffsets(); err != nil {
+		return nil, err
+	}
+	glyphs, err := parseCmap(tables["cmap"])
+	if err != nil {
+		return nil, err
+	}
+	f.glyphs = glyphs
+	f.name = parseName(tables["name"])
+	return f, nil
+}
+
+func (f *Font) parseMetrics() error {
+	head, hhea, maxp, hmtx := f.tables["head"], f.tables["hhea"], f.tables["maxp"], f.tables["hmtx"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return ErrInvalidFont
+	}
+	f.unitsPerEm = int64(binary.BigEndian.Uint16(head[18:]))
+	if f.unitsPerEm == 0 {
+		return ErrInvalidFont
+	}
+	for i := range f.bbox {
+		f.bbox[i] = int64(int16(binary.BigEndian.Uint16(head[36+i*2:])))
+	}
+	f.ascent = int64(int16(binary.BigEndian.Uint16(hhea[4:])))
+	f.descent = int64(int16(binary.BigEndian.Uint16(hhea[6:])))
+	f.capHeight = f.ascent
+	if os2 := f.tables["OS/2"]; len(os2) >= 90 && binary.BigEndian.Uint16(os2) >= 2 {
+		f.capHeight = int64(int16(binary.BigEndian.Uint16(os2[88:])))
+	}
+	f.numGlyphs = int(binary.BigEndian.Uint16(maxp[4:]))
+	numberOfHMetrics := int(binary.BigEndian.Uint16(hhea[34:]))
+	if numberOfHMetrics == 0 || numberOfHMetrics > f.numGlyphs || len(hmtx) < numberOfHMetrics*4 {
+		return ErrInvalidFont
+	}
+	f.advances = make([]uint16, f.numGlyphs)
+	for i := range f.advances {
+		// 送り幅の記載がないグリフは、最後に記載された送り幅を引き継ぐ
+		f.advances[i] = binary.BigEndian.Uint16(hmtx[min(i, numberOfHMetrics-1)*4:])
+	}
+	return nil
+}
+
+func (f *Font) parseOffsets() error {
+	head, loca, glyf := f.tables["head"], f.tables["loca"], f.tables["glyf"]
+	long := binary.BigEndian.Uint16(head[50:]) == 1
+	f.offsets = make([]uint32, f.numGlyphs+1)
+	for i := range f.offsets {
+		switch {
+		case long && len(loca) >= (i+1)*4:
+			f.offsets[i] = binary.BigEndian.Uint32(loca[i*4:])
+		case !long && len(loca) >= (i+1)*2:
+			f.offsets[i] = uint32(binary.BigEndian.Uint16(loca[i*2:])) * 2
+		default:
+			return ErrInvalidFont
+		}
+		if f.offsets[i] > uint32(len(glyf)) || (i > 0 && f.offsets[i] < f.offsets[i-1]) {
+			return ErrInvalidFont
+		}
+	}
+	return nil
+}
+
+// parseCmap - 文字とグリフIDの対応表を読み込む（Unicodeのformat 4, 12に対応）
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, ErrInvalidFont
+	}
+	var (
+		subtable []byte
+		priority int
+	)
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := range numTables {
+		record := cmap[4+i*8:]
+		if len(record) < 8 {
+			return nil, ErrInvalidFont
+		}
+		platformID := binary.BigEndian.Uint16(record)
+		encodingID := binary.BigEndian.Uint16(record[2:])
+		offset := int(binary.BigEndian.Uint32(record[4:]))
+		if offset+4 > len(cmap) {
+			return nil, ErrInvalidFont
+		}
+		var p int
+		switch {
+		case platformID == 3 && encodingID == 10, platformID == 0 && encodingID >= 4:
+			p = 2 // Unicode全範囲
+		case platformID == 3 && encodingID == 1, platformID == 0:
+			p = 1 // Unicode基本多言語面
+		}
+		if p > priority {
+			subtable, priority = cmap[offset:], p
+		}
+	}
+	if subtable == nil {
+		return nil, fmt.Errorf("%w: unicode cmap is not found", ErrUnsupportedFont)
+	}
+	switch binary.BigEndian.Uint16(subtable) {
+	case 4:
+		return parseCmapFormat4(subtable)
+	case 12:
+		return parseCmapFormat12(subtable)
+	default:
+		return nil, fmt.Errorf("%w: unsupported cmap format", ErrUnsupportedFont)
+	}
+}
+
+func parseCmapFormat4(b []byte) (map[rune]uint16, error) {
+	if len(b) < 14 {
+		return nil, ErrInvalidFont
+	}
+	segCount := int(binary.BigEndian.Uint16(b[6:])) / 2
+	ends, starts, deltas, ranges := 14, 16+segCount*2, 16+segCount*4, 16+segCount*6
+	if len(b) < ranges+segCount*2 {
+		return nil, ErrInvalidFont
+	}
+	res := make(map[rune]uint16)
+	for i := range segCount {
+		end := binary.BigEndian.Uint16(b[ends+i*2:])
+		start := binary.BigEndian.Uint16(b[starts+i*2:])
+		delta := binary.BigEndian.Uint16(b[deltas+i*2:])
+		rangeOffset := int(binary.BigEndian.Uint16(b[ranges+i*2:]))
+		for c := uint32(start); c <= uint32(end) && c != 0xffff; c++ {
+			var glyph uint16
+			if rangeOffset == 0 {
+				glyph = uint16(c) + delta
+			} else {
+				pos := ranges + i*2 + rangeOffset + int(c-uint32(start))*2
+				if pos+2 > len(b) {
+					return nil, ErrInvalidFont
+				}
+				if glyph = binary.BigEndian.Uint16(b[pos:]); glyph != 0 {
+					glyph += delta
+				}
+			}
+			if glyph != 0 {
+				res[rune(c)] = glyph
+			}
+		}
+	}
+	return res, nil
+}
+
+func parseCmapFormat12(b []byte) (map[rune]uint16, error) {
+	if len(b) < 16 {
+		return nil, ErrInvalidFont
+	}
+	numGroups := int(binary.BigEndian.Uint32(b[12:]))
+	if len(b) < 16+numGroups*12 {
+		return nil, ErrInvalidFont
+	}
+	res := make(map[rune]uint16)
+	for i := range numGroups {
+		group := b[16+i*12:]
+		start := binary.BigEndian.Uint32(group)
+		end := binary.BigEndian.Uint32(group[4:])
+		glyph := binary.BigEndian.Uint32(group[8:])
+		if end < start || end > 0x10ffff {
+			return nil, ErrInvalidFont
+		}
+		for c := start; c <= end; c++ {
+			res[rune(c)] = uint16(glyph + c - start)
+		}
+	}
+	return res, nil
+}
+
+// parseName - PostScript名を取得（取得できない場合は既定の名前を返す）
+func parseName(name []byte) string {
+	const defaultName = "EmbeddedFont"
+	if len(name) < 6 {
+		return defaultName
+	}
+	count := int(binary.BigEndian.Uint16(name[2:]))
+	storage := int(binary.BigEndian.Uint16(name[4:]))
+	for i := range count {
+		record := name[6+i*12:]
+		if len(record) < 12 || binary.BigEndian.Uint16(record[6:]) != 6 {
+			continue
+		}
+		platformID := binary.BigEndian.Uint16(record)
+		length := int(binary.BigEndian.Uint16(record[8:]))
+		offset := storage + int(binary.BigEndian.Uint16(record[10:]))
+		if offset+length > len(name) {
+			continue
+		}
+		value := name[offset : offset+length]
+		if platformID == 0 || platformID == 3 {
+			u := make([]uint16, len(value)/2)
+			for j := range u {
+				u[j] = binary.BigEndian.Uint16(value[j*2:])
+			}
+			value = []byte(string(utf16.Decode(u)))
+		}
+		if res := sanitizeName(string(value)); res != "" {
+			return res
+		}
+	}
+	return defaultName
+}
+
+// sanitizeName - PDFの名前オブジェクトとして使用できる文字のみに絞り込む
+func sanitizeName(name string) string {
+	b := &bytes.Buffer{}
+	for _, r := range name {
+		if r > 0x20 && r < 0x7f && !bytes.ContainsRune([]byte("()<>[]{}/%#"), r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// glyph - 文字に対応するグリフID（フォントに含まれない文字は.notdefとする）
+func (f *Font) glyph(r rune) uint16 {
+	return f.glyphs[r]
+}
+
+// width - グリフの送り幅(1000分率)
+func (f *Font) width(glyph uint16) int64 {
+	if int(glyph) >= len(f.advances) {
+		return 0
+	}
+	return int64(f.advances[glyph]) * 1000 / f.unitsPerEm
+}
+
+// scale - フォント単位から1000分率へ変換
+func (f *Font) scale(v int64) int64 {
+	return v * 1000 / f.unitsPerEm
+}
+
+// subset - 使用するグリフのみを含むフォントを生成（グリフIDは元フォントのまま維持する）
+func (f *Font) subset(used map[uint16]rune) ([]byte, error) {
+	glyf := f.tables["glyf"]
+	keep := make(map[uint16]struct{}, len(used)+1)
+	queue := []uint16{0} // .notdefは常に含める
+	for glyph := range used {
+		queue = append(queue, glyph)
+	}
+	for len(queue) > 0 {
+		glyph := queue[0]
+		queue = queue[1:]
+		if _, ok := keep[glyph]; ok || int(glyph) >= f.numGlyphs {
+			continue
+		}
+		keep[glyph] = struct{}{}
+		components, err := compositeGlyphs(glyf[f.offsets[glyph]:f.offsets[glyph+1]])
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, components...)
+	}
+
+	// 使用しないグリフのデータを空にしてglyf/locaテーブルを再構築
+	newGlyf := &bytes.Buffer{}
+	newLoca := make([]byte, (f.numGlyphs+1)*4)
+	for i := range f.numGlyphs {
+		binary.BigEndian.PutUint32(newLoca[i*4:], uint32(newGlyf.Len()))
+		if _, ok := keep[uint16(i)]; !ok {
+			continue
+		}
+		newGlyf.Write(glyf[f.offsets[i]:f.offsets[i+1]])
+		for newGlyf.Len()%4 != 0 {
+			newGlyf.WriteByte(0)
+		}
+	}
+	binary.BigEndian.PutUint32(newLoca[f.numGlyphs*4:], uint32(newGlyf.Len()))
+
+	head := slices.Clone(f.tables["head"])
+	binary.BigEndian.PutUint32(head[8:], 0)  // checkSumAdjustment
+	binary.BigEndian.PutUint16(head[50:], 1) // indexToLocFormat(long)
+
+	tables := make(map[string][]byte, len(subsetTables))
+	for _, tag := range subsetTables {
+		if table, ok := f.tables[tag]; ok {
+			tables[tag] = table
+		}
+	}
+	tables["cmap"] = newCmap(used)
+	tables["post"] = newPost(f.tables["post"])
+	tables["glyf"] = newGlyf.Bytes()
+	tables["loca"] = newLoca
+	tables["head"] = head
+	return writeFont(tables), nil
+}
+
+// newPost - グリフ名を持たないpostテーブル(format 3.0)を生成
+func newPost(post []byte) []byte {
+	b := make([]byte, 32)
+	copy(b, post)
+	binary.BigEndian.PutUint32(b, 0x00030000)
+	return b
+}
+
+// newCmap - 使用する文字のみを含むcmapテーブル(format 4)を生成
+func newCmap(used map[uint16]rune) []byte {
+	type mapping struct {
+		code  uint16
+		glyph uint16
+	}
+	mappings := make([]mapping, 0, len(used))
+	for glyph, r := range used {
+		if r < 0xffff {
+			mappings = append(mappings, mapping{code: uint16(r), glyph: glyph})
+		}
+	}
+	slices.SortFunc(mappings, func(a, b mapping) int {
+		return int(a.code) - int(b.code)
+	})
+	segCount := len(mappings) + 1 // 終端セグメント(0xFFFF)を含む
+	length := 16 + segCount*8
+	b := make([]byte, 12+length)
+	binary.BigEndian.PutUint16(b[2:], 1)  // numTables
+	binary.BigEndian.PutUint16(b[4:], 3)  // platformID(Windows)
+	binary.BigEndian.PutUint16(b[6:], 1)  // encodingID(Unicode BMP)
+	binary.BigEndian.PutUint32(b[8:], 12) // offset
+	sub := b[12:]
+	binary.BigEndian.PutUint16(sub, 4)
+	binary.BigEndian.PutUint16(sub[2:], uint16(length))
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= segCount {
+		entrySelector++
+	}
+	binary.BigEndian.PutUint16(sub[6:], uint16(segCount*2))
+	binary.BigEndian.PutUint16(sub[8:], uint16(2<<entrySelector))
+	binary.BigEndian.PutUint16(sub[10:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(sub[12:], uint16(segCount*2-2<<entrySelector))
+	ends, starts, deltas := 14, 16+segCount*2, 16+segCount*4
+	for i, m := range mappings {
+		binary.BigEndian.PutUint16(sub[ends+i*2:], m.code)
+		binary.BigEndian.PutUint16(sub[starts+i*2:], m.code)
+		binary.BigEndian.PutUint16(sub[deltas+i*2:], m.glyph-m.code)
+	}
+	last := segCount - 1
+	binary.BigEndian.PutUint16(sub[ends+last*2:], 0xffff)
+	binary.BigEndian.PutUint16(sub[starts+last*2:], 0xffff)
+	binary.BigEndian.PutUint16(sub[deltas+last*2:], 1)
+	return b
+}
+
+// compositeGlyphs - 複合グリフが参照するグリフID一覧
+func compositeGlyphs(data []byte) ([]uint16, error) {
+	const (
+		argsAreWords    = 0x0001
+		hasScale        = 0x0008
+		moreComponents  = 0x0020
+		hasXYScale      = 0x0040
+		hasTwoByTwo     = 0x0080
+		compositeHeader = 10
+	)
+	if len(data) < compositeHeader || int16(binary.BigEndian.Uint16(data)) >= 0 {
+		return nil, nil // 空のグリフ・単純グリフ
+	}
+	res := make([]uint16, 0)
+	pos := compositeHeader
+	for {
+		if pos+4 > len(data) {
+			return nil, ErrInvalidFont
+		}
+		flags := binary.BigEndian.Uint16(data[pos:])
+		res = append(res, binary.BigEndian.Uint16(data[pos+2:]))
+		pos += 4
+		if flags&argsAreWords != 0 {
+			pos += 4
+		} else {
+			pos += 2
+		}
+		switch {
+		case flags&hasScale != 0:
+			pos += 2
+		case flags&hasXYScale != 0:
+			pos += 4
+		case flags&hasTwoByTwo != 0:
+			pos += 8
+		}
+		if flags&moreComponents == 0 {
+			return res, nil
+		}
+	}
+}
+
+// writeFont - テーブル一覧からTrueTypeフォントファイルを生成
+func writeFont(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+
+	numTables := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= numTables {
+		entrySelector++
+	}
+	searchRange := (1 << entrySelector) * 16
+
+	buf := &bytes.Buffer{}
+	header := make([]byte, 12+numTables*16)
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(numTables))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(numTables*16-searchRange))
+	buf.Write(header)
+
+	var headOffset int
+	for i, tag := range tags {
+		table := tables[tag]
+		record := header[12+i*16:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(buf.Len()))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+		if tag == "head" {
+			headOffset = buf.Len()
+		}
+		buf.Write(table)
+		for buf.Len()%4 != 0 {
+			buf.WriteByte(0)
+		}
+	}
+	res := buf.Bytes()
+	copy(res, header)
+	if _, ok := tables["head"]; ok {
+		binary.BigEndian.PutUint32(res[headOffset+8:], 0xb1b0afba-checksum(res))
+	}
+	return res
+}
+
+func checksum(b []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(b); i += 4 {
+		var word [4]byte
+		copy(word[:], b[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// subsetTag - サブセットフォント名の接頭辞（使用グリフから決まる英大文字6文字）
+func subsetTag(glyphs []uint16) string {
+	h := fnv.New32a()
+	for _, glyph := range glyphs {
+		_ = binary.Write(h, binary.BigEndian, glyph)
+	}
+	sum := h.Sum32()
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = byte('A' + sum%26)
+		sum /= 26
+	}
+	return string(tag)
+}
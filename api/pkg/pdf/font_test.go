@@ -0,0 +1,115 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+)
+
+func TestParseFont(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		data      []byte
+		expectErr error
+	}{
+		{
+			name:      "success",
+			data:      goregular.TTF,
+			expectErr: nil,
+		},
+		{
+			name:      "empty",
+			data:      []byte{},
+			expectErr: ErrInvalidFont,
+		},
+		{
+			name:      "open type (cff)",
+			data:      append([]byte("OTTO"), make([]byte, 8)...),
+			expectErr: ErrUnsupportedFont,
+		},
+		{
+			name:      "broken table",
+			data:      goregular.TTF[:128],
+			expectErr: ErrInvalidFont,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			font, err := ParseFont(tt.data)
+			assert.ErrorIs(t, err, tt.expectErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, "GoRegular", font.name)
+			assert.NotZero(t, font.glyph('A'))
+			assert.Zero(t, font.glyph('領'))
+		})
+	}
+}
+
+func TestFont_Subset(t *testing.T) {
+	t.Parallel()
+	font, err := ParseFont(goregular.TTF)
+	require.NoError(t, err)
+	glyph := font.glyph('A')
+	subset, err := font.subset(map[uint16]rune{glyph: 'A'})
+	require.NoError(t, err)
+	assert.Less(t, len(subset), len(goregular.TTF))
+
+	actual, err := sfnt.Parse(subset)
+	require.NoError(t, err)
+	assert.Equal(t, font.numGlyphs, actual.NumGlyphs())
+	buf := &sfnt.Buffer{}
+	segments, err := actual.LoadGlyph(buf, sfnt.GlyphIndex(glyph), 1000, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, segments)
+	segments, err = actual.LoadGlyph(buf, sfnt.GlyphIndex(font.glyph('B')), 1000, nil)
+	require.NoError(t, err)
+	assert.Empty(t, segments)
+}
+
+func TestDocument_WithFont(t *testing.T) {
+	t.Parallel()
+	font, err := ParseFont(goregular.TTF)
+	require.NoError(t, err)
+	doc := NewDocument(WithFont(font))
+	doc.Text(40, 40, 12, "Total")
+	doc.TextRight(555, 60, 10, "1,000")
+	buf := &bytes.Buffer{}
+	require.NoError(t, doc.Write(buf))
+	actual := buf.String()
+	assert.Contains(t, actual, "/Subtype /CIDFontType2")
+	assert.Contains(t, actual, "/Encoding /Identity-H")
+	assert.Contains(t, actual, "/FontFile2 8 0 R")
+	assert.Contains(t, actual, "/ToUnicode 9 0 R")
+	assert.Regexp(t, `/BaseFont /[A-Z]{6}\+GoRegular`, actual)
+	assert.NotContains(t, actual, "HeiseiKakuGo-W5")
+	assert.Contains(t, actual, "beginbfchar")
+
+	// 埋め込んだサブセットフォントが読み込めること
+	matches := regexp.MustCompile(`(?s)/Length (\d+) /Length1 (\d+) /Filter /FlateDecode >>\nstream\n`).
+		FindStringSubmatchIndex(actual)
+	require.Len(t, matches, 6)
+	length, err := strconv.Atoi(actual[matches[2]:matches[3]])
+	require.NoError(t, err)
+	zr, err := zlib.NewReader(bytes.NewReader(buf.Bytes()[matches[1] : matches[1]+length]))
+	require.NoError(t, err)
+	subset, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Equal(t, actual[matches[4]:matches[5]], strconv.Itoa(len(subset)))
+	_, err = sfnt.Parse(subset)
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 2.5*10, NewDocument().TextWidth("1,000", 10), 0.001)
+	assert.InDelta(t, float64(font.width(font.glyph('1')))*10/1000, doc.TextWidth("1", 10), 0.001)
+}
@@ -0,0 +1,264 @@
+// Package pdf - 帳票出力用の最小限のPDF生成処理
+//
+// TrueTypeフォントを指定した場合は使用する文字のみのサブセットを埋め込む。
+// 指定しない場合は、PDF閲覧ソフトに標準で搭載されている日本語CIDフォント(HeiseiKakuGo-W5)を参照する。
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	PageWidthA4  float64 = 595.28 // A4縦の幅(pt)
+	PageHeightA4 float64 = 841.89 // A4縦の高さ(pt)
+
+	fontName = "HeiseiKakuGo-W5"
+)
+
+// Document - PDFドキュメント
+type Document struct {
+	width  float64
+	height float64
+	pages  []*bytes.Buffer
+	font   *Font
+	used   map[uint16]rune // 使用したグリフID -> 文字
+}
+
+type options struct {
+	font *Font
+}
+
+type Option func(*options)
+
+// WithFont - 埋め込むフォントを指定
+func WithFont(font *Font) Option {
+	return func(opts *options) {
+		opts.font = font
+	}
+}
+
+// NewDocument - A4縦のPDFドキュメントを生成
+func NewDocument(opts ...Option) *Document {
+	dopts := &options{}
+	for i := range opts {
+		opts[i](dopts)
+	}
+	return &Document{
+		width:  PageWidthA4,
+		height: PageHeightA4,
+		font:   dopts.font,
+		used:   make(map[uint16]rune),
+	}
+}
+
+// AddPage - ページを追加し、以降の描画対象とする
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// Text - 指定位置(左上原点)に文字列を描画
+func (d *Document) Text(x, y, size float64, text string) {
+	page := d.current()
+	fmt.Fprintf(page, "BT /F1 %s Tf %s %s Td <%s> Tj ET\n",
+		number(size), number(x), number(d.height-y), d.encode(text))
+}
+
+// TextRight - 指定位置(左上原点)に右揃えで文字列を描画
+func (d *Document) TextRight(x, y, size float64, text string) {
+	d.Text(x-d.TextWidth(text, size), y, size, text)
+}
+
+// TextWidth - 文字列の描画幅(pt)を算出
+func (d *Document) TextWidth(text string, size float64) float64 {
+	if d.font == nil {
+		return TextWidth(text, size)
+	}
+	var width int64
+	for _, r := range text {
+		width += d.font.width(d.font.glyph(r))
+	}
+	return float64(width) * size / 1000
+}
+
+// Line - 指定位置(左上原点)間に線を描画
+func (d *Document) Line(x1, y1, x2, y2, width float64) {
+	page := d.current()
+	fmt.Fprintf(page, "%s w %s %s m %s %s l S\n",
+		number(width), number(x1), number(d.height-y1), number(x2), number(d.height-y2))
+}
+
+// Write - PDF形式で出力
+func (d *Document) Write(w io.Writer) error {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	buf := &bytes.Buffer{}
+	offsets := make([]int, 0)
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// 1: Catalog, 2: Pages, 3: Font, 4: CIDFont, 5: FontDescriptor, 6~: Page/Contents, 以降: FontFile2/ToUnicode
+	const firstPage = 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+i*2)
+	}
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %s %s] >>",
+		strings.Join(kids, " "), len(d.pages), number(d.width), number(d.height)))
+	if d.font == nil {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /UniJIS-UCS2-H /DescendantFonts [4 0 R] >>", fontName))
+		object(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /%s"+
+			" /CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 2 >>"+
+			" /FontDescriptor 5 0 R /DW 1000 /W [1 95 500] >>", fontName))
+		object(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [-92 -250 1010 922]"+
+			" /ItalicAngle 0 /Ascent 752 /Descent -221 /CapHeight 737 /StemV 114 >>", fontName))
+	} else {
+		fontFile := firstPage + len(d.pages)*2
+		glyphs := slices.Sorted(maps.Keys(d.used))
+		name := subsetTag(glyphs) + "+" + d.font.name
+		font := d.font
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H"+
+			" /DescendantFonts [4 0 R] /ToUnicode %d 0 R >>", name, fontFile+1))
+		object(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s"+
+			" /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >>"+
+			" /FontDescriptor 5 0 R /DW 1000 /W [%s] /CIDToGIDMap /Identity >>", name, d.widths(glyphs)))
+		object(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [%d %d %d %d]"+
+			" /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+			name, font.scale(font.bbox[0]), font.scale(font.bbox[1]), font.scale(font.bbox[2]), font.scale(font.bbox[3]),
+			font.scale(font.ascent), font.scale(font.descent), font.scale(font.capHeight), fontFile))
+	}
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			firstPage+i*2+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+	if d.font != nil {
+		subset, err := d.font.subset(d.used)
+		if err != nil {
+			return err
+		}
+		compressed := &bytes.Buffer{}
+		zw := zlib.NewWriter(compressed)
+		if _, err := zw.Write(subset); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		object(fmt.Sprintf("<< /Length %d /Length1 %d /Filter /FlateDecode >>\nstream\n%s\nendstream",
+			compressed.Len(), len(subset), compressed.String()))
+		cmap := d.toUnicode()
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(cmap), cmap))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (d *Document) current() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// TextWidth - 文字列の描画幅(pt)を算出（半角英数字は全角の半分の幅として扱う）
+func TextWidth(text string, size float64) float64 {
+	var width float64
+	for _, r := range text {
+		if r >= 0x20 && r <= 0x7e {
+			width += size / 2
+			continue
+		}
+		width += size
+	}
+	return width
+}
+
+// encode - 描画する文字列をフォントに応じた16進数文字列へ変換
+func (d *Document) encode(text string) string {
+	if d.font == nil {
+		return encode(text)
+	}
+	// 埋め込みフォントの場合は、グリフIDをそのまま文字コードとする(Identity-H)
+	b := &strings.Builder{}
+	for _, r := range text {
+		glyph := d.font.glyph(r)
+		if glyph != 0 {
+			d.used[glyph] = r
+		}
+		fmt.Fprintf(b, "%04X", glyph)
+	}
+	return b.String()
+}
+
+// widths - 使用するグリフの送り幅一覧(/W配列)
+func (d *Document) widths(glyphs []uint16) string {
+	entries := make([]string, len(glyphs))
+	for i, glyph := range glyphs {
+		entries[i] = fmt.Sprintf("%d [%d]", glyph, d.font.width(glyph))
+	}
+	return strings.Join(entries, " ")
+}
+
+// toUnicode - テキスト抽出用のグリフIDと文字の対応表(CMap)
+func (d *Document) toUnicode() string {
+	const maxEntries = 100 // bfcharの1ブロックあたりの上限
+	glyphs := slices.Sorted(maps.Keys(d.used))
+	b := &strings.Builder{}
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	for chunk := range slices.Chunk(glyphs, maxEntries) {
+		fmt.Fprintf(b, "%d beginbfchar\n", len(chunk))
+		for _, glyph := range chunk {
+			fmt.Fprintf(b, "<%04X> <%s>\n", glyph, encodeUTF16(d.used[glyph]))
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.String()
+}
+
+func encodeUTF16(r rune) string {
+	b := &strings.Builder{}
+	for _, u := range utf16.Encode([]rune{r}) {
+		fmt.Fprintf(b, "%04X", u)
+	}
+	return b.String()
+}
+
+// encode - UCS-2(ビッグエンディアン)の16進数文字列へ変換（基本多言語面以外の文字は「?」に置き換える）
+func encode(text string) string {
+	b := &strings.Builder{}
+	for _, r := range text {
+		if r > 0xffff || utf16.IsSurrogate(r) {
+			r = '?'
+		}
+		fmt.Fprintf(b, "%04X", r)
+	}
+	return b.String()
+}
+
+func number(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
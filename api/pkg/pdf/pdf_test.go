@@ -0,0 +1,134 @@
+package pdf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		setup  func(d *Document)
+		pages  int
+		expect []string
+	}{
+		{
+			name: "success",
+			setup: func(d *Document) {
+				d.AddPage()
+				d.Text(40, 40, 12, "領収書")
+				d.TextRight(555, 60, 10, "¥1,000")
+				d.Line(40, 70, 555, 70, 0.5)
+			},
+			pages: 1,
+			expect: []string{
+				"/BaseFont /HeiseiKakuGo-W5",
+				"<981853CE66F8> Tj",
+				"40.00 771.89 m 555.00 771.89 l S",
+			},
+		},
+		{
+			name: "multiple pages",
+			setup: func(d *Document) {
+				d.Text(40, 40, 12, "page1")
+				d.AddPage()
+				d.Text(40, 40, 12, "page2")
+			},
+			pages:  2,
+			expect: []string{"/Count 2"},
+		},
+		{
+			name:   "empty",
+			setup:  func(d *Document) {},
+			pages:  1,
+			expect: []string{"/Count 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			doc := NewDocument()
+			tt.setup(doc)
+			buf := &bytes.Buffer{}
+			require.NoError(t, doc.Write(buf))
+			actual := buf.String()
+			assert.True(t, strings.HasPrefix(actual, "%PDF-1.4\n"))
+			assert.True(t, strings.HasSuffix(actual, "%%EOF\n"))
+			assert.Equal(t, tt.pages, strings.Count(actual, "/Type /Page /Parent"))
+			for _, expect := range tt.expect {
+				assert.Contains(t, actual, expect)
+			}
+		})
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		text   string
+		size   float64
+		expect float64
+	}{
+		{
+			name:   "ascii",
+			text:   "1,000",
+			size:   10,
+			expect: 25,
+		},
+		{
+			name:   "japanese",
+			text:   "領収書",
+			size:   10,
+			expect: 30,
+		},
+		{
+			name:   "mixed",
+			text:   "¥1,000円",
+			size:   10,
+			expect: 45,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, TextWidth(tt.text, tt.size))
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		text   string
+		expect string
+	}{
+		{
+			name:   "ascii",
+			text:   "T1",
+			expect: "00540031",
+		},
+		{
+			name:   "japanese",
+			text:   "税",
+			expect: "7A0E",
+		},
+		{
+			name:   "outside bmp",
+			text:   "𠮷",
+			expect: "003F",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expect, encode(tt.text))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package postalcode
+
+import (
+	"strings"
+
+	"golang.org/x/text/width"
+)
+
+const postalCodeLength = 7
+
+// Normalize - 郵便番号を7桁の半角数字に整形
+func Normalize(code string) (string, error) {
+	code = width.Narrow.String(code)
+	code = strings.NewReplacer("〒", "", "-", "", "ー", "", "−", "", " ", "").Replace(code)
+	if len(code) != postalCodeLength {
+		return "", ErrInvalidArgument
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return "", ErrInvalidArgument
+		}
+	}
+	return code, nil
+}
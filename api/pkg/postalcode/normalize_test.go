@@ -0,0 +1,56 @@
+package postalcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		code   string
+		expect string
+		hasErr bool
+	}{
+		{
+			name:   "success",
+			code:   "1000014",
+			expect: "1000014",
+			hasErr: false,
+		},
+		{
+			name:   "success with hyphen",
+			code:   "100-0014",
+			expect: "1000014",
+			hasErr: false,
+		},
+		{
+			name:   "success full width",
+			code:   "〒１００－００１４",
+			expect: "1000014",
+			hasErr: false,
+		},
+		{
+			name:   "invalid length",
+			code:   "100001",
+			expect: "",
+			hasErr: true,
+		},
+		{
+			name:   "invalid character",
+			code:   "100001a",
+			expect: "",
+			hasErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := Normalize(tt.code)
+			assert.Equal(t, tt.hasErr, err != nil)
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+}
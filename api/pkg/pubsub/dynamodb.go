@@ -0,0 +1,299 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultLookback     = 5 * time.Second
+	defaultMessageTTL   = 24 * time.Hour
+	localHistorySize    = 100
+)
+
+type DynamoDBParams struct {
+	TableName string // テーブル名（パーティションキー: topic, ソートキー: id）
+}
+
+type dynamoDB struct {
+	db       *dynamodb.Client
+	table    *string
+	local    *memory
+	interval time.Duration
+	lookback time.Duration
+	ttl      time.Duration
+	now      func() time.Time
+	mu       sync.Mutex
+	pollers  map[string]*dynamoDBPoller
+}
+
+type dynamoDBPoller struct {
+	cancel      context.CancelFunc
+	subscribers int
+}
+
+type dynamoDBItem struct {
+	Message
+	ExpiresAt int64 `dynamodbav:"expiresAt"` // TTL
+}
+
+type dynamoDBOptions struct {
+	maxRetries int
+	interval   time.Duration
+	lookback   time.Duration
+	ttl        time.Duration
+	now        func() time.Time
+}
+
+type DynamoDBOption func(*dynamoDBOptions)
+
+// WithPollInterval - 新着メッセージの取得間隔
+func WithPollInterval(interval time.Duration) DynamoDBOption {
+	return func(opts *dynamoDBOptions) {
+		opts.interval = interval
+	}
+}
+
+// WithLookback - 他インスタンスとの時刻ずれを考慮して遡って取得する期間
+func WithLookback(lookback time.Duration) DynamoDBOption {
+	return func(opts *dynamoDBOptions) {
+		opts.lookback = lookback
+	}
+}
+
+// WithMessageTTL - メッセージの保持期間
+func WithMessageTTL(ttl time.Duration) DynamoDBOption {
+	return func(opts *dynamoDBOptions) {
+		opts.ttl = ttl
+	}
+}
+
+func WithMaxRetries(maxRetries int) DynamoDBOption {
+	return func(opts *dynamoDBOptions) {
+		opts.maxRetries = maxRetries
+	}
+}
+
+// NewDynamoDB - Amazon DynamoDBを利用したメッセージ配信
+// 購読中のトピックはインスタンスごとに1つのポーリングで新着を取得し、同一インスタンス内の購読者へ配信する
+func NewDynamoDB(cfg aws.Config, params *DynamoDBParams, opts ...DynamoDBOption) PubSub {
+	dopts := &dynamoDBOptions{
+		maxRetries: retry.DefaultMaxAttempts,
+		interval:   defaultPollInterval,
+		lookback:   defaultLookback,
+		ttl:        defaultMessageTTL,
+		now:        time.Now,
+	}
+	for i := range opts {
+		opts[i](dopts)
+	}
+	cli := dynamodb.NewFromConfig(cfg, func(o *dynamodb.Options) {
+		o.Retryer = retry.NewStandard(func(o *retry.StandardOptions) {
+			o.MaxAttempts = dopts.maxRetries
+		})
+	})
+	return &dynamoDB{
+		db:       cli,
+		table:    aws.String(params.TableName),
+		local:    newMemory(WithHistorySize(localHistorySize), WithNow(dopts.now)),
+		interval: dopts.interval,
+		lookback: dopts.lookback,
+		ttl:      dopts.ttl,
+		now:      dopts.now,
+		pollers:  map[string]*dynamoDBPoller{},
+	}
+}
+
+func (d *dynamoDB) Publish(ctx context.Context, topic string, data []byte) (*Message, error) {
+	if err := validate(topic); err != nil {
+		return nil, err
+	}
+	now := d.now()
+	item := &dynamoDBItem{
+		Message: Message{
+			ID:          NewMessageID(now),
+			Topic:       topic,
+			Data:        data,
+			PublishedAt: now,
+		},
+		ExpiresAt: now.Add(d.ttl).Unix(),
+	}
+	av, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, fmt.Errorf("pubsub: failed to marshal message: %w", err)
+	}
+	in := &dynamodb.PutItemInput{
+		TableName: d.table,
+		Item:      av,
+	}
+	if _, err := d.db.PutItem(ctx, in); err != nil {
+		return nil, fmt.Errorf("pubsub: failed to put message: %w", err)
+	}
+	return &item.Message, nil
+}
+
+func (d *dynamoDB) Subscribe(ctx context.Context, topic, cursor string) (<-chan *Message, error) {
+	if err := validate(topic); err != nil {
+		return nil, err
+	}
+	// 取りこぼしを防ぐため、再送分を取得する前にポーリングを開始する
+	d.acquire(topic)
+	var replay []*Message
+	if cursor != "" {
+		msgs, err := d.query(ctx, topic, cursor)
+		if err != nil {
+			d.release(topic)
+			return nil, err
+		}
+		replay = msgs
+		if len(replay) > 0 {
+			cursor = replay[len(replay)-1].ID
+		}
+	}
+	local, err := d.local.Subscribe(ctx, topic, cursor)
+	if err != nil {
+		d.release(topic)
+		return nil, err
+	}
+	if len(replay) == 0 {
+		go func() {
+			<-ctx.Done()
+			d.release(topic)
+		}()
+		return local, nil
+	}
+	res := make(chan *Message)
+	go func() {
+		defer d.release(topic)
+		defer close(res)
+		send := func(msg *Message) bool {
+			select {
+			case res <- msg:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for _, msg := range replay {
+			if !send(msg) {
+				return
+			}
+		}
+		for msg := range local {
+			if !send(msg) {
+				return
+			}
+		}
+	}()
+	return res, nil
+}
+
+func (d *dynamoDB) acquire(topic string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if p, ok := d.pollers[topic]; ok {
+		p.subscribers++
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	d.pollers[topic] = &dynamoDBPoller{cancel: cancel, subscribers: 1}
+	go d.poll(ctx, topic)
+}
+
+func (d *dynamoDB) release(topic string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	p, ok := d.pollers[topic]
+	if !ok {
+		return
+	}
+	p.subscribers--
+	if p.subscribers > 0 {
+		return
+	}
+	p.cancel()
+	delete(d.pollers, topic)
+	d.local.remove(topic)
+}
+
+// poll - 新着メッセージを定期的に取得し、インスタンス内の購読者へ配信する
+func (d *dynamoDB) poll(ctx context.Context, topic string) {
+	startCursor := newCursor(d.now())
+	seen := map[string]time.Time{}
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		now := d.now()
+		cursor := newCursor(now.Add(-d.lookback))
+		if cursor < startCursor {
+			cursor = startCursor
+		}
+		msgs, err := d.query(ctx, topic, cursor)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to poll messages", slog.String("topic", topic), log.Error(err))
+			continue
+		}
+		for _, msg := range msgs {
+			if _, ok := seen[msg.ID]; ok {
+				continue
+			}
+			seen[msg.ID] = msg.PublishedAt
+			d.local.deliver(msg)
+		}
+		for id, publishedAt := range seen {
+			if now.Sub(publishedAt) > 2*d.lookback {
+				delete(seen, id)
+			}
+		}
+	}
+}
+
+func (d *dynamoDB) query(ctx context.Context, topic, cursor string) ([]*Message, error) {
+	in := &dynamodb.QueryInput{
+		TableName:              d.table,
+		KeyConditionExpression: aws.String("#topic = :topic AND #id > :cursor"),
+		ExpressionAttributeNames: map[string]string{
+			"#topic": "topic",
+			"#id":    "id",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":topic":  &types.AttributeValueMemberS{Value: topic},
+			":cursor": &types.AttributeValueMemberS{Value: cursor},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+	var res []*Message
+	for {
+		out, err := d.db.Query(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("pubsub: failed to query messages: %w", err)
+		}
+		items := make([]*dynamoDBItem, 0, len(out.Items))
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &items); err != nil {
+			return nil, fmt.Errorf("pubsub: failed to unmarshal messages: %w", err)
+		}
+		for _, item := range items {
+			res = append(res, &item.Message)
+		}
+		if len(out.LastEvaluatedKey) == 0 {
+			return res, nil
+		}
+		in.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
@@ -0,0 +1,177 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHistorySize = 1000
+	defaultBufferSize  = 100
+)
+
+type memory struct {
+	mu          sync.Mutex
+	topics      map[string]*memoryTopic
+	historySize int
+	bufferSize  int
+	now         func() time.Time
+}
+
+type memoryTopic struct {
+	history     []*Message                     // 再接続時に再送するための配信履歴
+	subscribers map[*memorySubscriber]struct{} // 購読者一覧
+}
+
+type memorySubscriber struct {
+	ch chan *Message
+}
+
+type memoryOptions struct {
+	historySize int
+	bufferSize  int
+	now         func() time.Time
+}
+
+type MemoryOption func(*memoryOptions)
+
+// WithHistorySize - トピックごとに保持する配信履歴の件数
+func WithHistorySize(size int) MemoryOption {
+	return func(opts *memoryOptions) {
+		opts.historySize = size
+	}
+}
+
+// WithBufferSize - 購読者ごとの受信バッファの件数（超過した購読者は切断する）
+func WithBufferSize(size int) MemoryOption {
+	return func(opts *memoryOptions) {
+		opts.bufferSize = size
+	}
+}
+
+func WithNow(now func() time.Time) MemoryOption {
+	return func(opts *memoryOptions) {
+		opts.now = now
+	}
+}
+
+// NewMemory - プロセス内でのメッセージ配信（テスト・ローカル環境向け）
+func NewMemory(opts ...MemoryOption) PubSub {
+	return newMemory(opts...)
+}
+
+func newMemory(opts ...MemoryOption) *memory {
+	dopts := &memoryOptions{
+		historySize: defaultHistorySize,
+		bufferSize:  defaultBufferSize,
+		now:         time.Now,
+	}
+	for i := range opts {
+		opts[i](dopts)
+	}
+	return &memory{
+		topics:      map[string]*memoryTopic{},
+		historySize: dopts.historySize,
+		bufferSize:  dopts.bufferSize,
+		now:         dopts.now,
+	}
+}
+
+func (m *memory) Publish(_ context.Context, topic string, data []byte) (*Message, error) {
+	if err := validate(topic); err != nil {
+		return nil, err
+	}
+	now := m.now()
+	msg := &Message{
+		ID:          NewMessageID(now),
+		Topic:       topic,
+		Data:        data,
+		PublishedAt: now,
+	}
+	m.deliver(msg)
+	return msg, nil
+}
+
+func (m *memory) Subscribe(ctx context.Context, topic, cursor string) (<-chan *Message, error) {
+	if err := validate(topic); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	t := m.topic(topic)
+	var replay []*Message
+	if cursor != "" {
+		for _, msg := range t.history {
+			if msg.ID > cursor {
+				replay = append(replay, msg)
+			}
+		}
+	}
+	sub := &memorySubscriber{
+		ch: make(chan *Message, len(replay)+m.bufferSize),
+	}
+	for _, msg := range replay {
+		sub.ch <- msg
+	}
+	t.subscribers[sub] = struct{}{}
+	m.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		m.unsubscribe(topic, sub)
+	}()
+	return sub.ch, nil
+}
+
+// deliver - 配信履歴に追加し、購読者へメッセージを送信する
+func (m *memory) deliver(msg *Message) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t := m.topic(msg.Topic)
+	t.history = append(t.history, msg)
+	if over := len(t.history) - m.historySize; over > 0 {
+		t.history = t.history[over:]
+	}
+	for sub := range t.subscribers {
+		select {
+		case sub.ch <- msg:
+		default:
+			// 受信が滞留している購読者は切断し、カーソル指定での再接続を促す
+			delete(t.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+func (m *memory) unsubscribe(topic string, sub *memorySubscriber) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.topics[topic]
+	if !ok {
+		return
+	}
+	if _, ok := t.subscribers[sub]; !ok {
+		return // 切断済み
+	}
+	delete(t.subscribers, sub)
+	close(sub.ch)
+}
+
+// topic - トピック情報を取得する（ロック取得済みであること）
+func (m *memory) topic(name string) *memoryTopic {
+	t, ok := m.topics[name]
+	if !ok {
+		t = &memoryTopic{subscribers: map[*memorySubscriber]struct{}{}}
+		m.topics[name] = t
+	}
+	return t
+}
+
+// remove - 購読者のいないトピックを削除する
+func (m *memory) remove(topic string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t, ok := m.topics[topic]; ok && len(t.subscribers) == 0 {
+		delete(m.topics, topic)
+	}
+}
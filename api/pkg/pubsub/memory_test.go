@@ -0,0 +1,121 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	ps := NewMemory()
+	ch, err := ps.Subscribe(ctx, "topic", "")
+	require.NoError(t, err)
+
+	msg, err := ps.Publish(ctx, "topic", []byte("hello"))
+	require.NoError(t, err)
+	_, err = ps.Publish(ctx, "other", []byte("other"))
+	require.NoError(t, err)
+
+	actual := receive(t, ch)
+	assert.Equal(t, msg, actual)
+	assert.Equal(t, "topic", actual.Topic)
+	assert.Equal(t, []byte("hello"), actual.Data)
+	select {
+	case msg := <-ch:
+		t.Fatalf("unexpected message: %v", msg)
+	default:
+	}
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		_, ok := <-ch
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestMemory_Resume(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	now := time.Date(2026, 10, 18, 18, 0, 0, 0, time.UTC)
+	ps := NewMemory(WithNow(func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}))
+
+	first, err := ps.Publish(ctx, "topic", []byte("first"))
+	require.NoError(t, err)
+	second, err := ps.Publish(ctx, "topic", []byte("second"))
+	require.NoError(t, err)
+
+	ch, err := ps.Subscribe(ctx, "topic", first.ID)
+	require.NoError(t, err)
+	third, err := ps.Publish(ctx, "topic", []byte("third"))
+	require.NoError(t, err)
+
+	assert.Equal(t, second, receive(t, ch))
+	assert.Equal(t, third, receive(t, ch))
+}
+
+func TestMemory_HistorySize(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	ps := NewMemory(WithHistorySize(1))
+
+	first, err := ps.Publish(ctx, "topic", []byte("first"))
+	require.NoError(t, err)
+	_, err = ps.Publish(ctx, "topic", []byte("second"))
+	require.NoError(t, err)
+	third, err := ps.Publish(ctx, "topic", []byte("third"))
+	require.NoError(t, err)
+
+	ch, err := ps.Subscribe(ctx, "topic", first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, third, receive(t, ch))
+}
+
+func TestMemory_SlowSubscriber(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	ps := NewMemory(WithBufferSize(1))
+
+	ch, err := ps.Subscribe(ctx, "topic", "")
+	require.NoError(t, err)
+	first, err := ps.Publish(ctx, "topic", []byte("first"))
+	require.NoError(t, err)
+	_, err = ps.Publish(ctx, "topic", []byte("second"))
+	require.NoError(t, err)
+
+	assert.Equal(t, first, receive(t, ch))
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestMemory_InvalidArgument(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	ps := NewMemory()
+
+	_, err := ps.Publish(ctx, "", []byte("hello"))
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = ps.Subscribe(ctx, "", "")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func receive(t *testing.T, ch <-chan *Message) *Message {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		require.True(t, ok)
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+		return nil
+	}
+}
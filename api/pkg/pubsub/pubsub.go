@@ -0,0 +1,47 @@
+//go:generate go tool mockgen -source=$GOFILE -package mock_$GOPACKAGE -destination=./../../mock/pkg/$GOPACKAGE/$GOFILE
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/and-period/furumaru/api/pkg/random"
+)
+
+var ErrInvalidArgument = errors.New("pubsub: invalid argument")
+
+// PubSub - トピック単位のメッセージ配信
+type PubSub interface {
+	// Publish - トピックにメッセージを配信する
+	Publish(ctx context.Context, topic string, data []byte) (*Message, error)
+	// Subscribe - トピックを購読する（cursorを指定した場合、cursor以降のメッセージから受信する）
+	// 購読はctxの終了、または受信が滞留した場合に終了し、チャネルが閉じられる
+	Subscribe(ctx context.Context, topic, cursor string) (<-chan *Message, error)
+}
+
+// Message - 配信メッセージ
+type Message struct {
+	ID          string    `dynamodbav:"id"`          // メッセージID（トピック内で時系列順にソート可能）
+	Topic       string    `dynamodbav:"topic"`       // トピック
+	Data        []byte    `dynamodbav:"data"`        // メッセージ内容
+	PublishedAt time.Time `dynamodbav:"publishedAt"` // 配信日時
+}
+
+// NewMessageID - 配信日時順にソート可能なメッセージIDを生成する
+func NewMessageID(publishedAt time.Time) string {
+	return fmt.Sprintf("%s-%s", newCursor(publishedAt), random.NewStrings(8))
+}
+
+// newCursor - 指定日時より後に配信されたメッセージを取得するためのカーソル
+func newCursor(t time.Time) string {
+	return fmt.Sprintf("%020d", t.UnixNano())
+}
+
+func validate(topic string) error {
+	if topic == "" {
+		return fmt.Errorf("%w: topic is required", ErrInvalidArgument)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMessageID(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 18, 18, 0, 0, 0, time.UTC)
+	prev := NewMessageID(now)
+	next := NewMessageID(now.Add(time.Nanosecond))
+	assert.Len(t, prev, 29)
+	assert.Less(t, prev, next)
+	assert.Less(t, newCursor(now), prev)
+	assert.Less(t, prev, newCursor(now.Add(time.Nanosecond)))
+}
@@ -29,6 +29,8 @@ type Client interface {
 	// #############################################
 	// 決済要求
 	Order(ctx context.Context, in *OrderParams) (*stripe.PaymentIntent, error)
+	// 決済要求(保存済み決済手段・顧客不在)
+	OffSessionOrder(ctx context.Context, in *OffSessionOrderParams) (*stripe.PaymentIntent, error)
 	// 決済要求(ゲストユーザー)
 	GuestOrder(ctx context.Context, in *GuestOrderParams) (*stripe.PaymentIntent, error)
 	// 決済確定
@@ -38,7 +40,7 @@ type Client interface {
 	// 決済情報取得
 	GetPaymentIntent(ctx context.Context, paymentIntentID string) (*stripe.PaymentIntent, error)
 	// 返金
-	Refund(ctx context.Context, paymentIntentID string, amount int64, reason string) (*stripe.Refund, error)
+	Refund(ctx context.Context, in *RefundParams) (*stripe.Refund, error)
 	// #############################################
 	// 決済方法 (共通)
 	// #############################################
@@ -17,6 +17,15 @@ type OrderParams struct {
 	Metadata          map[string]string
 }
 
+type OffSessionOrderParams struct {
+	CustomerID      string
+	PaymentMethodID string
+	Amount          int64
+	Description     string
+	IdempotencyKey  string
+	Metadata        map[string]string
+}
+
 type GuestOrderParams struct {
 	Email             string
 	PaymentMethodType stripe.PaymentMethodType
@@ -134,6 +143,41 @@ func (c *client) GuestOrder(ctx context.Context, in *GuestOrderParams) (*stripe.
 	return pi, nil
 }
 
+// 顧客が不在の状態で、保存済みの決済手段を利用して決済を確定する（定期便など）
+// reference: https://stripe.com/docs/payments/save-and-reuse#charge-saved-payment-method
+func (c *client) OffSessionOrder(ctx context.Context, in *OffSessionOrderParams) (*stripe.PaymentIntent, error) {
+	params := &stripe.PaymentIntentParams{
+		Params: stripe.Params{
+			Context:  ctx,
+			Metadata: in.Metadata,
+		},
+		Customer:      stripe.String(in.CustomerID),
+		Description:   nullString(in.Description),
+		Amount:        stripe.Int64(in.Amount),
+		Currency:      stripe.String(string(stripe.CurrencyJPY)),
+		PaymentMethod: stripe.String(in.PaymentMethodID),
+		CaptureMethod: stripe.String(string(stripe.PaymentIntentCaptureMethodManual)),
+		Confirm:       stripe.Bool(true),
+		OffSession:    stripe.Bool(true),
+	}
+	if in.IdempotencyKey != "" {
+		params.SetIdempotencyKey(in.IdempotencyKey)
+	}
+	var pi *stripe.PaymentIntent
+	orderFn := func() (err error) {
+		pi, err = c.paymentintent.New(params)
+		return err
+	}
+	if err := c.do(ctx, orderFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to off-session order",
+			slog.String("customerId", in.CustomerID),
+			slog.String("paymentMethodId", in.PaymentMethodID),
+			log.Error(err))
+		return nil, err
+	}
+	return pi, nil
+}
+
 // reference: https://stripe.com/docs/api/payment_intents/capture
 func (c *client) Capture(ctx context.Context, transactionID string) (*stripe.PaymentIntent, error) {
 	params := &stripe.PaymentIntentCaptureParams{
@@ -8,13 +8,27 @@ import (
 	"github.com/stripe/stripe-go/v82"
 )
 
+type RefundParams struct {
+	PaymentIntentID string
+	Amount          int64
+	Reason          string
+	IdempotencyKey  string
+	Metadata        map[string]string
+}
+
 // reference: https://stripe.com/docs/api/refunds/create
-func (c *client) Refund(ctx context.Context, paymentIntentID string, amount int64, reason string) (*stripe.Refund, error) {
+func (c *client) Refund(ctx context.Context, in *RefundParams) (*stripe.Refund, error) {
 	params := &stripe.RefundParams{
-		Params:        stripe.Params{Context: ctx},
-		PaymentIntent: stripe.String(paymentIntentID),
-		Amount:        stripe.Int64(amount),
-		Reason:        nullString(reason),
+		Params: stripe.Params{
+			Context:  ctx,
+			Metadata: in.Metadata,
+		},
+		PaymentIntent: stripe.String(in.PaymentIntentID),
+		Amount:        stripe.Int64(in.Amount),
+		Reason:        nullString(in.Reason),
+	}
+	if in.IdempotencyKey != "" {
+		params.SetIdempotencyKey(in.IdempotencyKey)
 	}
 	var r *stripe.Refund
 	refundFn := func() (err error) {
@@ -23,8 +37,8 @@ func (c *client) Refund(ctx context.Context, paymentIntentID string, amount int6
 	}
 	if err := c.do(ctx, refundFn); err != nil {
 		slog.ErrorContext(ctx, "Failed to refund",
-			slog.String("paymentIntentId", paymentIntentID),
-			slog.Int64("amount", amount),
+			slog.String("paymentIntentId", in.PaymentIntentID),
+			slog.Int64("amount", in.Amount),
 			log.Error(err))
 		return nil, err
 	}
@@ -45,6 +45,7 @@ const (
 	passwordString    = "^[a-zA-Z0-9_!@#$_%^&*.?()-=+]*$"
 	phoneNumberString = "^0\\d{1,4}-\\d{1,4}-\\d{4}$"
 	e164String        = "^\\+[1-9]?[0-9]{7,14}$"
+	invoiceString     = "^T\\d{13}$"
 )
 
 //nolint:errcheck
@@ -60,6 +61,7 @@ func NewValidator(opts ...Option) Validator {
 	hiraganaRegex := regexp.MustCompile(hiraganaString, 0)
 	passwordRegex := compilePasswordRegex(dopts.password)
 	phoneNumberRegex := regexp.MustCompile(phoneNumberString, 0)
+	invoiceRegex := regexp.MustCompile(invoiceString, 0)
 
 	// hiragana - 正規表現を使用して平仮名のみであるかの検証
 	v.RegisterValidation("hiragana", validateHiragana(hiraganaRegex))
@@ -71,6 +73,8 @@ func NewValidator(opts ...Option) Validator {
 	v.RegisterValidation("date", validateDate())
 	// time - 時刻の形式であるかの検証
 	v.RegisterValidation("time", validateTime())
+	// invoice_number - 正規表現を利用して適格請求書発行事業者の登録番号（T+13桁）の形式であるかの検証
+	v.RegisterValidation("invoice_number", validateInvoiceNumber(invoiceRegex))
 
 	// カスタムバリデーション
 	if dopts.custom != nil {
@@ -107,6 +111,13 @@ func validatePhoneNumber(regex *regexp.Regexp) func(fl validator.FieldLevel) boo
 	}
 }
 
+func validateInvoiceNumber(regex *regexp.Regexp) func(fl validator.FieldLevel) bool {
+	return func(fl validator.FieldLevel) bool {
+		match, _ := regex.MatchString(fl.Field().String())
+		return match
+	}
+}
+
 func validateDate() func(fl validator.FieldLevel) bool {
 	return func(fl validator.FieldLevel) bool {
 		_, err := time.Parse("20060102", fl.Field().String())
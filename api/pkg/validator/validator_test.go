@@ -17,6 +17,7 @@ func TestValidator(t *testing.T) {
 		PhoneNumber string `validate:"omitempty,phone_number"`
 		Date        string `validate:"omitempty,date"`
 		Time        string `validate:"omitempty,time"`
+		Invoice     string `validate:"omitempty,invoice_number"`
 	}
 	tests := []struct {
 		name   string
@@ -214,6 +215,27 @@ func TestValidator(t *testing.T) {
 			opts:   []Option{},
 			hasErr: true,
 		},
+		{
+			name: "valid invoice_number",
+			input: &input{
+				Invoice: "T1234567890123",
+			},
+			hasErr: false,
+		},
+		{
+			name: "invalid invoice_number without prefix",
+			input: &input{
+				Invoice: "1234567890123",
+			},
+			hasErr: true,
+		},
+		{
+			name: "invalid invoice_number length",
+			input: &input{
+				Invoice: "T123456789012",
+			},
+			hasErr: true,
+		},
 		{
 			name: "invalid date format",
 			input: &input{
@@ -0,0 +1 @@
+ALTER TABLE `media`.`broadcasts` ADD COLUMN `caption_status` INT NOT NULL DEFAULT 0 AFTER `archive_metadata`;
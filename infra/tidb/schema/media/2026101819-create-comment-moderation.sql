@@ -0,0 +1,54 @@
+ALTER TABLE `media`.`broadcast_comments` ADD COLUMN `session_id` VARCHAR(22) NOT NULL DEFAULT '' AFTER `user_id`;
+ALTER TABLE `media`.`broadcast_comments` ADD COLUMN `client_ip` VARCHAR(45) NOT NULL DEFAULT '' AFTER `session_id`;
+CREATE INDEX `idx_broadcast_comments_user_id` ON `media`.`broadcast_comments` (`broadcast_id`, `user_id`, `created_at`);
+CREATE INDEX `idx_broadcast_comments_client_ip` ON `media`.`broadcast_comments` (`broadcast_id`, `client_ip`, `created_at`);
+
+ALTER TABLE `media`.`video_comments` ADD COLUMN `session_id` VARCHAR(22) NOT NULL DEFAULT '' AFTER `user_id`;
+ALTER TABLE `media`.`video_comments` ADD COLUMN `client_ip` VARCHAR(45) NOT NULL DEFAULT '' AFTER `session_id`;
+CREATE INDEX `idx_video_comments_user_id` ON `media`.`video_comments` (`video_id`, `user_id`, `created_at`);
+CREATE INDEX `idx_video_comments_client_ip` ON `media`.`video_comments` (`video_id`, `client_ip`, `created_at`);
+
+CREATE TABLE IF NOT EXISTS `media`.`comment_ng_words` (
+  `id`             VARCHAR(22) NOT NULL, -- NGワードID
+  `coordinator_id` VARCHAR(22) NOT NULL, -- コーディネータID
+  `word`           VARCHAR(64) NOT NULL, -- NGワード
+  `created_at`     DATETIME(3) NOT NULL, -- 登録日時
+  `updated_at`     DATETIME(3) NOT NULL, -- 更新日時
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `ui_comment_ng_words_coordinator_id_word` (`coordinator_id`, `word`)
+);
+
+CREATE TABLE IF NOT EXISTS `media`.`comment_mutes` (
+  `id`           VARCHAR(22)  NOT NULL, -- ミュートID
+  `broadcast_id` VARCHAR(22)  NOT NULL, -- ライブ配信ID
+  `user_id`      VARCHAR(22)  NOT NULL, -- ユーザーID
+  `session_id`   VARCHAR(22)  NOT NULL, -- セッションID
+  `type`         INT          NOT NULL, -- ミュート種別
+  `reason`       VARCHAR(256) NOT NULL, -- ミュート理由
+  `admin_id`     VARCHAR(22)  NOT NULL, -- 登録者ID
+  `created_at`   DATETIME(3)  NOT NULL, -- 登録日時
+  `updated_at`   DATETIME(3)  NOT NULL, -- 更新日時
+  PRIMARY KEY (`id`),
+  INDEX `idx_comment_mutes_broadcast_id` (`broadcast_id`, `user_id`, `session_id`),
+  CONSTRAINT `fk_comment_mutes_broadcast_id`
+    FOREIGN KEY (`broadcast_id`) REFERENCES `media`.`broadcasts` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `media`.`comment_moderation_logs` (
+  `id`          VARCHAR(22)  NOT NULL, -- 履歴ID
+  `target_type` INT          NOT NULL, -- 対象種別
+  `target_id`   VARCHAR(22)  NOT NULL, -- 対象ID
+  `action`      INT          NOT NULL, -- 操作種別
+  `comment_id`  VARCHAR(22)  NOT NULL, -- コメントID
+  `user_id`     VARCHAR(22)  NOT NULL, -- 投稿者のユーザーID
+  `session_id`  VARCHAR(22)  NOT NULL, -- 投稿者のセッションID
+  `client_ip`   VARCHAR(45)  NOT NULL, -- 投稿元IPアドレス
+  `content`     VARCHAR(256) NOT NULL, -- コメント内容
+  `detail`      VARCHAR(256) NOT NULL, -- 詳細
+  `admin_id`    VARCHAR(22)  NOT NULL, -- 操作者ID
+  `created_at`  DATETIME(3)  NOT NULL, -- 登録日時
+  `updated_at`  DATETIME(3)  NOT NULL, -- 更新日時
+  PRIMARY KEY (`id`),
+  INDEX `idx_comment_moderation_logs_target` (`target_type`, `target_id`, `created_at` DESC)
+);
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS `media`.`broadcast_clips` (
+  `id`             VARCHAR(22)  NOT NULL,              -- 切り抜き動画ID
+  `broadcast_id`   VARCHAR(22)  NOT NULL,              -- ライブ配信ID
+  `schedule_id`    VARCHAR(22)  NOT NULL,              -- 開催スケジュールID
+  `coordinator_id` VARCHAR(22)  NOT NULL,              -- コーディネータID
+  `title`          VARCHAR(255) NOT NULL,              -- タイトル
+  `description`    TEXT         NOT NULL,              -- 説明
+  `thumbnail_url`  TEXT         NOT NULL,              -- サムネイルURL
+  `product_ids`    JSON         NULL DEFAULT NULL,     -- 紐付ける商品ID一覧
+  `start_offset`   BIGINT       NOT NULL,              -- 切り抜き開始位置(配信開始からの経過秒数)
+  `end_offset`     BIGINT       NOT NULL,              -- 切り抜き終了位置(配信開始からの経過秒数)
+  `status`         INT          NOT NULL,              -- 生成状況
+  `job_id`         VARCHAR(64)  NULL DEFAULT NULL,     -- 動画変換ジョブID
+  `progress`       BIGINT       NOT NULL DEFAULT 0,    -- 動画変換の進捗率(%)
+  `video_id`       VARCHAR(22)  NULL DEFAULT NULL,     -- 生成したオンデマンド動画ID
+  `error_message`  TEXT         NOT NULL,              -- 生成失敗時のエラー内容
+  `created_at`     DATETIME(3)  NOT NULL,              -- 登録日時
+  `updated_at`     DATETIME(3)  NOT NULL,              -- 更新日時
+  PRIMARY KEY (`id`),
+  INDEX `idx_broadcast_clips_broadcast_id` (`broadcast_id`, `created_at`),
+  INDEX `idx_broadcast_clips_status` (`status`),
+  CONSTRAINT `fk_broadcast_clips_broadcast_id`
+    FOREIGN KEY (`broadcast_id`) REFERENCES `media`.`broadcasts` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE `media`.`broadcasts` ADD COLUMN `started_at` DATETIME(3) NULL DEFAULT NULL AFTER `caption_status`;
+ALTER TABLE `media`.`broadcasts` ADD COLUMN `ended_at` DATETIME(3) NULL DEFAULT NULL AFTER `started_at`;
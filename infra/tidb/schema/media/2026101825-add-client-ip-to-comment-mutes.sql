@@ -0,0 +1,2 @@
+ALTER TABLE `media`.`comment_mutes` ADD COLUMN `client_ip` VARCHAR(45) NOT NULL DEFAULT '' AFTER `session_id`;
+CREATE INDEX `idx_comment_mutes_client_ip` ON `media`.`comment_mutes` (`broadcast_id`, `client_ip`);
@@ -0,0 +1 @@
+ALTER TABLE `media`.`videos` ADD COLUMN `captions` JSON NULL DEFAULT NULL AFTER `video_url`;
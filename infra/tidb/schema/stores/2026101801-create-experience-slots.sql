@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS `stores`.`experience_slots` (
+  `id`            VARCHAR(22) NOT NULL,           -- 体験枠ID
+  `experience_id` VARCHAR(22) NOT NULL,           -- 体験ID
+  `capacity`      BIGINT      NOT NULL,           -- 定員
+  `reserved`      BIGINT      NOT NULL DEFAULT 0, -- 予約済み人数(仮押さえを含む)
+  `start_at`      DATETIME(3) NOT NULL,           -- 開始日時
+  `end_at`        DATETIME(3) NOT NULL,           -- 終了日時
+  `created_at`    DATETIME(3) NOT NULL,           -- 登録日時
+  `updated_at`    DATETIME(3) NOT NULL,           -- 更新日時
+  `deleted_at`    DATETIME(3) NULL DEFAULT NULL,  -- 削除日時
+  PRIMARY KEY (`id`),
+  KEY `idx_experience_id_start_at` (`experience_id`, `start_at`),
+  CONSTRAINT `fk_experience_slots_experience_id`
+    FOREIGN KEY (`experience_id`) REFERENCES `stores`.`experiences` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `stores`.`experience_slot_reservations` (
+  `order_id`   VARCHAR(22) NOT NULL, -- 注文履歴ID
+  `slot_id`    VARCHAR(22) NOT NULL, -- 体験枠ID
+  `quantity`   BIGINT      NOT NULL, -- 予約人数
+  `status`     INT         NOT NULL, -- 予約状況
+  `created_at` DATETIME(3) NOT NULL, -- 登録日時
+  `updated_at` DATETIME(3) NOT NULL, -- 更新日時
+  PRIMARY KEY (`order_id`),
+  KEY `idx_slot_id_status` (`slot_id`, `status`),
+  CONSTRAINT `fk_experience_slot_reservations_slot_id`
+    FOREIGN KEY (`slot_id`) REFERENCES `stores`.`experience_slots` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+ALTER TABLE `stores`.`order_experiences` ADD COLUMN `slot_id` VARCHAR(22) NULL DEFAULT NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `stores`.`product_inventory_holds` (
+  `order_id`            VARCHAR(22) NOT NULL, -- 注文履歴ID
+  `product_id`          VARCHAR(22) NOT NULL, -- 商品ID
+  `product_revision_id` BIGINT      NOT NULL, -- 商品変更履歴ID
+  `quantity`            BIGINT      NOT NULL, -- 確保数量
+  `status`              INT         NOT NULL, -- 確保状況
+  `expired_at`          DATETIME(3) NOT NULL, -- 確保期限
+  `created_at`          DATETIME(3) NOT NULL, -- 登録日時
+  `updated_at`          DATETIME(3) NOT NULL, -- 更新日時
+  PRIMARY KEY (`order_id`, `product_id`),
+  KEY `idx_product_id_status` (`product_id`, `status`),
+  KEY `idx_status_expired_at` (`status`, `expired_at`),
+  CONSTRAINT `fk_product_inventory_holds_product_id`
+    FOREIGN KEY (`product_id`) REFERENCES `stores`.`products` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
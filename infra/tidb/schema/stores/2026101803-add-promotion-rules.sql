@@ -0,0 +1,4 @@
+ALTER TABLE `stores`.`promotions` ADD COLUMN `rules` JSON NULL DEFAULT NULL;
+
+ALTER TABLE `stores`.`order_items` ADD COLUMN `discount` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`order_items` ADD COLUMN `discounts` JSON NULL DEFAULT NULL;
@@ -0,0 +1,17 @@
+ALTER TABLE `stores`.`promotions` ADD COLUMN `usage_limit` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`promotions` ADD COLUMN `usage_limit_per_user` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`promotions` ADD COLUMN `first_purchase_only` TINYINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS `stores`.`promotion_redemptions` (
+  `order_id`     VARCHAR(22) NOT NULL, -- 注文履歴ID
+  `promotion_id` VARCHAR(22) NOT NULL, -- プロモーションID
+  `user_id`      VARCHAR(22) NOT NULL, -- ユーザーID
+  `status`       INT         NOT NULL, -- 利用状況
+  `created_at`   DATETIME(3) NOT NULL, -- 登録日時
+  `updated_at`   DATETIME(3) NOT NULL, -- 更新日時
+  PRIMARY KEY (`order_id`),
+  KEY `idx_promotion_id_user_id_status` (`promotion_id`, `user_id`, `status`),
+  CONSTRAINT `fk_promotion_redemptions_promotion_id`
+    FOREIGN KEY (`promotion_id`) REFERENCES `stores`.`promotions` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS `stores`.`promotion_code_batches` (
+  `id`           VARCHAR(22) NOT NULL,          -- 一括発行ID
+  `promotion_id` VARCHAR(22) NOT NULL,          -- プロモーションID
+  `title`        VARCHAR(64) NOT NULL,          -- 用途(配布先など)
+  `quantity`     BIGINT      NOT NULL,          -- 発行数
+  `status`       INT         NOT NULL,          -- 状態
+  `voided_at`    DATETIME(3) NULL DEFAULT NULL, -- 無効化日時
+  `created_at`   DATETIME(3) NOT NULL,          -- 登録日時
+  `updated_at`   DATETIME(3) NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  KEY `idx_promotion_id_created_at` (`promotion_id`, `created_at`),
+  CONSTRAINT `fk_promotion_code_batches_promotion_id`
+    FOREIGN KEY (`promotion_id`) REFERENCES `stores`.`promotions` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `stores`.`promotion_codes` (
+  `code`         VARCHAR(8)  NOT NULL,          -- クーポンコード
+  `batch_id`     VARCHAR(22) NOT NULL,          -- 一括発行ID
+  `promotion_id` VARCHAR(22) NOT NULL,          -- プロモーションID
+  `status`       INT         NOT NULL,          -- 利用状況
+  `order_id`     VARCHAR(22) NULL DEFAULT NULL, -- 利用した注文履歴ID
+  `redeemed_at`  DATETIME(3) NULL DEFAULT NULL, -- 利用日時
+  `created_at`   DATETIME(3) NOT NULL,          -- 登録日時
+  `updated_at`   DATETIME(3) NOT NULL,          -- 更新日時
+  PRIMARY KEY (`code`),
+  KEY `idx_batch_id_status` (`batch_id`, `status`),
+  KEY `idx_order_id` (`order_id`),
+  CONSTRAINT `fk_promotion_codes_batch_id`
+    FOREIGN KEY (`batch_id`) REFERENCES `stores`.`promotion_code_batches` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+ALTER TABLE `stores`.`promotion_redemptions` ADD COLUMN `code` VARCHAR(8) NOT NULL DEFAULT '' AFTER `user_id`;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `stores`.`order_refund_lines` (
+  `id`          VARCHAR(22)  NOT NULL,          -- 返金明細ID
+  `order_id`    VARCHAR(22)  NOT NULL,          -- 注文履歴ID
+  `type`        INT          NOT NULL,          -- 返金種別
+  `status`      INT          NOT NULL,          -- 返金処理状況
+  `amount`      BIGINT       NOT NULL,          -- 返金金額(税込)
+  `reason`      VARCHAR(256) NOT NULL,          -- 返金理由
+  `items`       JSON         NULL DEFAULT NULL, -- 返金対象商品(JSON)
+  `refunded_at` DATETIME(3)  NULL DEFAULT NULL, -- 返金日時
+  `created_at`  DATETIME(3)  NOT NULL,          -- 登録日時
+  `updated_at`  DATETIME(3)  NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  KEY `idx_order_id_created_at` (`order_id`, `created_at`),
+  CONSTRAINT `fk_order_refund_lines_order_id`
+    FOREIGN KEY (`order_id`) REFERENCES `stores`.`orders` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS `stores`.`order_claims` (
+  `id`              VARCHAR(22)  NOT NULL,          -- 返品・交換申請ID
+  `order_id`        VARCHAR(22)  NOT NULL,          -- 注文履歴ID
+  `user_id`         VARCHAR(22)  NOT NULL,          -- ユーザーID
+  `shop_id`         VARCHAR(22)  NULL DEFAULT NULL, -- 店舗ID
+  `coordinator_id`  VARCHAR(22)  NOT NULL,          -- 注文受付担当者ID
+  `type`            INT          NOT NULL,          -- 申請理由
+  `status`          INT          NOT NULL,          -- 審査状況
+  `description`     TEXT         NOT NULL,          -- 申請内容
+  `items`           JSON         NULL DEFAULT NULL, -- 対象商品一覧(JSON)
+  `image_urls`      JSON         NULL DEFAULT NULL, -- 証拠画像URL一覧(JSON)
+  `reviewer_id`     VARCHAR(22)  NULL DEFAULT NULL, -- 審査担当者ID
+  `review_comment`  TEXT         NOT NULL,          -- 審査コメント
+  `resolution_type` INT          NOT NULL,          -- 対応方法
+  `resolution_note` TEXT         NOT NULL,          -- 対応内容
+  `refund_line_id`  VARCHAR(22)  NULL DEFAULT NULL, -- 返金明細ID
+  `promotion_id`    VARCHAR(22)  NULL DEFAULT NULL, -- お詫びクーポンのプロモーションID
+  `reviewed_at`     DATETIME(3)  NULL DEFAULT NULL, -- 審査日時
+  `resolved_at`     DATETIME(3)  NULL DEFAULT NULL, -- 対応完了日時
+  `created_at`      DATETIME(3)  NOT NULL,          -- 登録日時
+  `updated_at`      DATETIME(3)  NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  KEY `idx_order_id_created_at` (`order_id`, `created_at`),
+  KEY `idx_user_id_created_at` (`user_id`, `created_at`),
+  KEY `idx_shop_id_status` (`shop_id`, `status`),
+  CONSTRAINT `fk_order_claims_order_id`
+    FOREIGN KEY (`order_id`) REFERENCES `stores`.`orders` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
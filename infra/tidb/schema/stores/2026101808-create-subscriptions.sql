@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS `stores`.`subscriptions` (
+  `id`                  VARCHAR(22)  NOT NULL,          -- 定期便ID
+  `user_id`             VARCHAR(22)  NOT NULL,          -- ユーザーID
+  `shop_id`             VARCHAR(22)  NULL DEFAULT NULL, -- 店舗ID
+  `coordinator_id`      VARCHAR(22)  NOT NULL,          -- コーディネータID
+  `status`              INT          NOT NULL,          -- 契約状況
+  `interval`            INT          NOT NULL,          -- お届け間隔
+  `items`               JSON         NULL DEFAULT NULL, -- 定期便の商品一覧(JSON)
+  `address_revision_id` BIGINT       NOT NULL,          -- 配送先・請求先住所履歴ID
+  `provider_type`       INT          NOT NULL,          -- 決済プロバイダー種別
+  `customer_id`         VARCHAR(256) NOT NULL,          -- 決済プロバイダーの顧客ID
+  `payment_method_id`   VARCHAR(256) NOT NULL,          -- 決済プロバイダーに保存した決済手段ID
+  `next_run_at`         DATETIME(3)  NOT NULL,          -- 次回注文日時
+  `last_order_id`       VARCHAR(22)  NULL DEFAULT NULL, -- 最終注文履歴ID
+  `failure_count`       BIGINT       NOT NULL,          -- 連続した更新失敗回数
+  `last_failed_at`      DATETIME(3)  NULL DEFAULT NULL, -- 最終更新失敗日時
+  `paused_at`           DATETIME(3)  NULL DEFAULT NULL, -- 一時停止日時
+  `canceled_at`         DATETIME(3)  NULL DEFAULT NULL, -- 解約日時
+  `created_at`          DATETIME(3)  NOT NULL,          -- 登録日時
+  `updated_at`          DATETIME(3)  NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id_created_at` (`user_id`, `created_at`),
+  KEY `idx_status_next_run_at` (`status`, `next_run_at`)
+);
@@ -0,0 +1,19 @@
+ALTER TABLE `stores`.`products` ADD COLUMN `expected_shipping_start_at` DATETIME(3) NULL DEFAULT NULL;
+ALTER TABLE `stores`.`products` ADD COLUMN `expected_shipping_end_at` DATETIME(3) NULL DEFAULT NULL;
+ALTER TABLE `stores`.`orders` ADD COLUMN `preorder_batch_id` VARCHAR(22) NULL DEFAULT NULL;
+ALTER TABLE `stores`.`orders` ADD INDEX `idx_preorder_batch_id_status` (`preorder_batch_id`, `status`);
+
+CREATE TABLE IF NOT EXISTS `stores`.`preorder_batches` (
+  `id`                         VARCHAR(22) NOT NULL,          -- 予約注文バッチID
+  `shop_id`                    VARCHAR(22) NOT NULL,          -- 店舗ID
+  `coordinator_id`             VARCHAR(22) NOT NULL,          -- コーディネータID
+  `status`                     INT         NOT NULL,          -- 出荷準備状況
+  `expected_shipping_start_at` DATETIME(3) NOT NULL,          -- 出荷予定期間(開始)
+  `expected_shipping_end_at`   DATETIME(3) NOT NULL,          -- 出荷予定期間(終了)
+  `ready_at`                   DATETIME(3) NULL DEFAULT NULL, -- 出荷準備完了日時
+  `created_at`                 DATETIME(3) NOT NULL,          -- 登録日時
+  `updated_at`                 DATETIME(3) NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `ui_preorder_batches_shop_id_expected_shipping` (`shop_id`, `expected_shipping_start_at`, `expected_shipping_end_at`),
+  KEY `idx_shop_id_expected_shipping_start_at` (`shop_id`, `expected_shipping_start_at`)
+);
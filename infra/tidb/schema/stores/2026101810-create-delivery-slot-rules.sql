@@ -0,0 +1,17 @@
+ALTER TABLE `stores`.`order_fulfillments` ADD COLUMN `delivery_date` DATETIME(3) NULL DEFAULT NULL;
+ALTER TABLE `stores`.`order_fulfillments` ADD COLUMN `delivery_time_window` INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS `stores`.`delivery_slot_rules` (
+  `id`              VARCHAR(22) NOT NULL,          -- 配送日時指定ルールID
+  `shop_id`         VARCHAR(22) NOT NULL,          -- 店舗ID
+  `coordinator_id`  VARCHAR(22) NOT NULL,          -- コーディネータID
+  `shipping_type`   INT         NOT NULL,          -- 配送方法
+  `lead_days`       BIGINT      NOT NULL,          -- 最短お届け日までの日数
+  `selectable_days` BIGINT      NOT NULL,          -- お届け日の選択可能日数
+  `blackout_dates`  JSON        NULL DEFAULT NULL, -- お届け不可日一覧(YYYYMMDD)
+  `time_windows`    JSON        NULL DEFAULT NULL, -- 指定可能な時間帯一覧
+  `created_at`      DATETIME(3) NOT NULL,          -- 登録日時
+  `updated_at`      DATETIME(3) NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `ui_delivery_slot_rules_shop_id_shipping_type` (`shop_id`, `shipping_type`)
+);
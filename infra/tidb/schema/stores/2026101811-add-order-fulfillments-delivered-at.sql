@@ -0,0 +1,3 @@
+ALTER TABLE `stores`.`order_fulfillments` ADD COLUMN `delivered_at` DATETIME(3) NULL DEFAULT NULL;
+
+CREATE INDEX `idx_order_fulfillments_shipping_carrier_status` ON `stores`.`order_fulfillments` (`shipping_carrier`, `status`, `shipped_at`);
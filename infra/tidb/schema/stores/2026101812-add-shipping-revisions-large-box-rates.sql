@@ -0,0 +1,12 @@
+ALTER TABLE `stores`.`shipping_revisions` ADD COLUMN `box120_rates` json DEFAULT NULL AFTER `box100_frozen`;
+ALTER TABLE `stores`.`shipping_revisions` ADD COLUMN `box120_frozen` bigint NOT NULL DEFAULT 0 AFTER `box120_rates`;
+ALTER TABLE `stores`.`shipping_revisions` ADD COLUMN `box140_rates` json DEFAULT NULL AFTER `box120_frozen`;
+ALTER TABLE `stores`.`shipping_revisions` ADD COLUMN `box140_frozen` bigint NOT NULL DEFAULT 0 AFTER `box140_rates`;
+ALTER TABLE `stores`.`shipping_revisions` ADD COLUMN `box160_rates` json DEFAULT NULL AFTER `box140_frozen`;
+ALTER TABLE `stores`.`shipping_revisions` ADD COLUMN `box160_frozen` bigint NOT NULL DEFAULT 0 AFTER `box160_rates`;
+
+-- 既存の配送設定は箱サイズ100の配送料を引き継ぐ
+UPDATE `stores`.`shipping_revisions`
+   SET `box120_rates` = `box100_rates`, `box120_frozen` = `box100_frozen`,
+       `box140_rates` = `box100_rates`, `box140_frozen` = `box100_frozen`,
+       `box160_rates` = `box100_rates`, `box160_frozen` = `box100_frozen`;
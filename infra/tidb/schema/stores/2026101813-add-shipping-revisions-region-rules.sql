@@ -0,0 +1 @@
+ALTER TABLE `stores`.`shipping_revisions` ADD COLUMN `region_rules` json DEFAULT NULL AFTER `box160_frozen`;
@@ -0,0 +1,4 @@
+ALTER TABLE `stores`.`order_metadata` ADD COLUMN `gift` TINYINT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`order_metadata` ADD COLUMN `noshi_type` INT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`order_metadata` ADD COLUMN `noshi_name` VARCHAR(32) NULL DEFAULT NULL;
+ALTER TABLE `stores`.`order_metadata` ADD COLUMN `message_card` TEXT NULL DEFAULT NULL;
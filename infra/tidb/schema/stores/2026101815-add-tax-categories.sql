@@ -0,0 +1,6 @@
+ALTER TABLE `stores`.`product_revisions` ADD COLUMN `tax_category` INT NOT NULL DEFAULT 1;
+ALTER TABLE `stores`.`order_items` ADD COLUMN `tax_rate` BIGINT NOT NULL DEFAULT 10;
+ALTER TABLE `stores`.`order_payments` ADD COLUMN `standard_tax_target` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`order_payments` ADD COLUMN `standard_tax` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`order_payments` ADD COLUMN `reduced_tax_target` BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE `stores`.`order_payments` ADD COLUMN `reduced_tax` BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,23 @@
+ALTER TABLE `stores`.`payment_systems` ADD COLUMN `fee_rate` DOUBLE NOT NULL DEFAULT 0 AFTER `status`;
+
+CREATE TABLE IF NOT EXISTS `stores`.`settlement_statements` (
+  `id`              VARCHAR(22) NOT NULL,          -- 精算明細ID
+  `payee_type`      INT         NOT NULL,          -- 精算先種別
+  `payee_id`        VARCHAR(22) NOT NULL,          -- 精算先ID
+  `status`          INT         NOT NULL,          -- 支払い状況
+  `period_start_at` DATETIME(3) NOT NULL,          -- 精算対象期間(開始)
+  `period_end_at`   DATETIME(3) NOT NULL,          -- 精算対象期間(終了)
+  `order_count`     BIGINT      NOT NULL,          -- 対象注文数
+  `sales_total`     BIGINT      NOT NULL,          -- 売上金額
+  `refund_total`    BIGINT      NOT NULL,          -- 返金金額
+  `commission_rate` DOUBLE      NOT NULL,          -- 販売手数料率(%)
+  `commission_fee`  BIGINT      NOT NULL,          -- 販売手数料
+  `payment_fee`     BIGINT      NOT NULL,          -- 決済手数料
+  `payout_total`    BIGINT      NOT NULL,          -- 支払い金額
+  `paid_at`         DATETIME(3) NULL DEFAULT NULL, -- 支払い日時
+  `created_at`      DATETIME(3) NOT NULL,          -- 登録日時
+  `updated_at`      DATETIME(3) NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `ui_settlement_statements_payee_period` (`payee_type`, `payee_id`, `period_start_at`),
+  KEY `idx_period_start_at` (`period_start_at`)
+);
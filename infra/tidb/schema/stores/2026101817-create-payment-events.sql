@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `stores`.`payment_events` (
+  `id`                 VARCHAR(22)  NOT NULL,          -- 決済イベントID
+  `provider_type`      INT          NOT NULL,          -- 決済プロバイダ種別
+  `event_id`           VARCHAR(256) NOT NULL,          -- 決済プロバイダ側のイベントID
+  `event_type`         VARCHAR(256) NOT NULL,          -- イベント種別
+  `payload`            MEDIUMBLOB   NOT NULL,          -- 受信したリクエストボディ
+  `signature_verified` TINYINT(1)   NOT NULL,          -- 署名検証結果
+  `status`             INT          NOT NULL,          -- 処理状況
+  `error_message`      TEXT         NOT NULL,          -- エラー内容
+  `attempt_count`      BIGINT       NOT NULL,          -- 処理試行回数
+  `processed_at`       DATETIME(3)  NULL DEFAULT NULL, -- 処理日時
+  `created_at`         DATETIME(3)  NOT NULL,          -- 登録日時
+  `updated_at`         DATETIME(3)  NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `ui_payment_events_provider_event` (`provider_type`, `event_id`),
+  KEY `idx_created_at` (`created_at`)
+);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS `stores`.`live_pins` (
+  `id`              VARCHAR(22) NOT NULL,          -- ピン留めID
+  `schedule_id`     VARCHAR(22) NOT NULL,          -- 開催スケジュールID
+  `product_id`      VARCHAR(22) NOT NULL,          -- 商品ID
+  `admin_id`        VARCHAR(22) NOT NULL,          -- ピン留めした管理者ID
+  `pinned_at`       DATETIME(3) NOT NULL,          -- ピン留め日時
+  `pinned_offset`   BIGINT      NOT NULL,          -- ピン留め位置(配信開始からの経過秒数)
+  `unpinned_at`     DATETIME(3) NULL DEFAULT NULL, -- ピン留め解除日時
+  `unpinned_offset` BIGINT      NOT NULL,          -- ピン留め解除位置(配信開始からの経過秒数)
+  `created_at`      DATETIME(3) NOT NULL,          -- 登録日時
+  `updated_at`      DATETIME(3) NOT NULL,          -- 更新日時
+  PRIMARY KEY (`id`),
+  KEY `idx_live_pins_schedule_pinned` (`schedule_id`, `pinned_at`),
+  KEY `idx_live_pins_schedule_updated` (`schedule_id`, `updated_at`),
+  CONSTRAINT `fk_live_pins_schedule_id`
+    FOREIGN KEY (`schedule_id`) REFERENCES `stores`.`schedules` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
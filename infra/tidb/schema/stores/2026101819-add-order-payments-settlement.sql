@@ -0,0 +1,4 @@
+ALTER TABLE `stores`.`order_payments` ADD COLUMN `settled_at` DATETIME(3) NULL DEFAULT NULL;
+ALTER TABLE `stores`.`order_payments` ADD COLUMN `settled_refund` BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX `idx_order_payments_settled_at_captured_at` ON `stores`.`order_payments` (`settled_at`, `captured_at`);
@@ -0,0 +1,3 @@
+ALTER TABLE `stores`.`experience_slot_reservations` ADD COLUMN `expired_at` DATETIME(3) NULL DEFAULT NULL;
+
+CREATE INDEX `idx_experience_slot_reservations_status_expired_at` ON `stores`.`experience_slot_reservations` (`status`, `expired_at`);
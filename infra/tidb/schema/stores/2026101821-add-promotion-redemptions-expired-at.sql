@@ -0,0 +1,3 @@
+ALTER TABLE `stores`.`promotion_redemptions` ADD COLUMN `expired_at` DATETIME(3) NULL DEFAULT NULL;
+
+CREATE INDEX `idx_promotion_redemptions_status_expired_at` ON `stores`.`promotion_redemptions` (`status`, `expired_at`);
@@ -0,0 +1 @@
+ALTER TABLE `stores`.`order_claims` ADD COLUMN `fulfillment_id` VARCHAR(22) NULL DEFAULT NULL;
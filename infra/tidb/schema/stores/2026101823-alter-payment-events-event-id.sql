@@ -0,0 +1 @@
+ALTER TABLE `stores`.`payment_events` MODIFY COLUMN `event_id` VARCHAR(256) NULL DEFAULT NULL;
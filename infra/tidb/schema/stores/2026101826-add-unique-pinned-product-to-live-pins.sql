@@ -0,0 +1,3 @@
+ALTER TABLE `stores`.`live_pins` ADD COLUMN `pinned_product_id` VARCHAR(22) GENERATED ALWAYS AS (IF(`unpinned_at` IS NULL, `product_id`, NULL)) VIRTUAL AFTER `unpinned_offset`;
+
+CREATE UNIQUE INDEX `ui_live_pins_schedule_pinned_product` ON `stores`.`live_pins` (`schedule_id`, `pinned_product_id`);
@@ -0,0 +1,4 @@
+ALTER TABLE `stores`.`subscriptions` ADD COLUMN `anchor_day` INT NOT NULL DEFAULT 0 AFTER `interval`;
+
+-- 既存の定期便は次回注文日時の日付を毎月のお届け基準日とする
+UPDATE `stores`.`subscriptions` SET `anchor_day` = DAY(`next_run_at`);
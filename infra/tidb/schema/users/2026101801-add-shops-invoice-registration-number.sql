@@ -0,0 +1 @@
+ALTER TABLE `users`.`shops` ADD COLUMN `invoice_registration_number` VARCHAR(14) NOT NULL DEFAULT '';